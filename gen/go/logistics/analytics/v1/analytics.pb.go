@@ -291,8 +291,11 @@ type FixedCostConfig struct {
 	PerEdgeCost       float64 `protobuf:"fixed64,6,opt,name=per_edge_cost,json=perEdgeCost,proto3" json:"per_edge_cost,omitempty"`                   // Стоимость за каждое используемое ребро
 	// Затраты на единицу потока
 	PerUnitHandlingCost float64 `protobuf:"fixed64,7,opt,name=per_unit_handling_cost,json=perUnitHandlingCost,proto3" json:"per_unit_handling_cost,omitempty"` // Стоимость обработки единицы товара
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// Стоимость открытия отдельных складов по ID узла; склады без записи
+	// стоят warehouse_cost
+	WarehouseCosts map[int64]float64 `protobuf:"bytes,8,rep,name=warehouse_costs,json=warehouseCosts,proto3" json:"warehouse_costs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *FixedCostConfig) Reset() {
@@ -374,6 +377,13 @@ func (x *FixedCostConfig) GetPerUnitHandlingCost() float64 {
 	return 0
 }
 
+func (x *FixedCostConfig) GetWarehouseCosts() map[int64]float64 {
+	if x != nil {
+		return x.WarehouseCosts
+	}
+	return nil
+}
+
 type CalculateCostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TotalCost     float64                `protobuf:"fixed64,1,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
@@ -679,12 +689,30 @@ func (x *FindBottlenecksResponse) GetRecommendations() []*Recommendation {
 	return nil
 }
 
+// Предельная ценность ребра считается по остаточной сети решения: насыщенное
+// ребро (u,v) ограничивает максимальный поток, если u достижим из истока, а
+// из v достижим сток (ребро входит в минимальный разрез). Иначе расширение
+// может только удешевить поток — за счёт перенаправления по циклу
+// отрицательной стоимости через ребро
 type Bottleneck struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Edge          *v1.Edge               `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
-	Utilization   float64                `protobuf:"fixed64,2,opt,name=utilization,proto3" json:"utilization,omitempty"`                    // Текущая загрузка (0-1)
-	ImpactScore   float64                `protobuf:"fixed64,3,opt,name=impact_score,json=impactScore,proto3" json:"impact_score,omitempty"` // Влияние на общий поток (0-1)
-	Severity      BottleneckSeverity     `protobuf:"varint,4,opt,name=severity,proto3,enum=logistics.analytics.v1.BottleneckSeverity" json:"severity,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Edge        *v1.Edge               `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	Utilization float64                `protobuf:"fixed64,2,opt,name=utilization,proto3" json:"utilization,omitempty"` // Текущая загрузка (0-1)
+	// Относительный выигрыш от удвоения пропускной способности: flow_gain от
+	// текущего потока или cost_saving от текущей стоимости. Узкие места
+	// упорядочены по in_min_cut, затем по impact_score
+	ImpactScore float64            `protobuf:"fixed64,3,opt,name=impact_score,json=impactScore,proto3" json:"impact_score,omitempty"`
+	Severity    BottleneckSeverity `protobuf:"varint,4,opt,name=severity,proto3,enum=logistics.analytics.v1.BottleneckSeverity" json:"severity,omitempty"`
+	InMinCut    bool               `protobuf:"varint,5,opt,name=in_min_cut,json=inMinCut,proto3" json:"in_min_cut,omitempty"`
+	// Прирост максимального потока на единицу пропускной способности (0 или 1)
+	MarginalFlowGain float64 `protobuf:"fixed64,6,opt,name=marginal_flow_gain,json=marginalFlowGain,proto3" json:"marginal_flow_gain,omitempty"`
+	// Прирост максимального потока при удвоении пропускной способности: оценка
+	// по самым широким остаточным путям исток → u и v → сток
+	FlowGain float64 `protobuf:"fixed64,7,opt,name=flow_gain,json=flowGain,proto3" json:"flow_gain,omitempty"`
+	// Снижение стоимости на единицу пропускной способности при том же потоке
+	MarginalCostSaving float64 `protobuf:"fixed64,8,opt,name=marginal_cost_saving,json=marginalCostSaving,proto3" json:"marginal_cost_saving,omitempty"`
+	// Снижение стоимости при удвоении пропускной способности
+	CostSaving    float64 `protobuf:"fixed64,9,opt,name=cost_saving,json=costSaving,proto3" json:"cost_saving,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -747,13 +775,50 @@ func (x *Bottleneck) GetSeverity() BottleneckSeverity {
 	return BottleneckSeverity_BOTTLENECK_SEVERITY_UNSPECIFIED
 }
 
+func (x *Bottleneck) GetInMinCut() bool {
+	if x != nil {
+		return x.InMinCut
+	}
+	return false
+}
+
+func (x *Bottleneck) GetMarginalFlowGain() float64 {
+	if x != nil {
+		return x.MarginalFlowGain
+	}
+	return 0
+}
+
+func (x *Bottleneck) GetFlowGain() float64 {
+	if x != nil {
+		return x.FlowGain
+	}
+	return 0
+}
+
+func (x *Bottleneck) GetMarginalCostSaving() float64 {
+	if x != nil {
+		return x.MarginalCostSaving
+	}
+	return 0
+}
+
+func (x *Bottleneck) GetCostSaving() float64 {
+	if x != nil {
+		return x.CostSaving
+	}
+	return 0
+}
+
 type Recommendation struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Type                 string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "increase_capacity", "add_edge", etc.
-	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AffectedEdge         *v1.EdgeKey            `protobuf:"bytes,3,opt,name=affected_edge,json=affectedEdge,proto3" json:"affected_edge,omitempty"`
-	EstimatedImprovement float64                `protobuf:"fixed64,4,opt,name=estimated_improvement,json=estimatedImprovement,proto3" json:"estimated_improvement,omitempty"` // Ожидаемое улучшение потока в %
-	EstimatedCost        float64                `protobuf:"fixed64,5,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`                      // Примерная стоимость изменения
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Type         string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "increase_capacity", "add_edge", etc.
+	Description  string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AffectedEdge *v1.EdgeKey            `protobuf:"bytes,3,opt,name=affected_edge,json=affectedEdge,proto3" json:"affected_edge,omitempty"`
+	// Ожидаемое улучшение в %: прирост потока или снижение стоимости
+	// (Bottleneck.impact_score × 100)
+	EstimatedImprovement float64 `protobuf:"fixed64,4,opt,name=estimated_improvement,json=estimatedImprovement,proto3" json:"estimated_improvement,omitempty"`
+	EstimatedCost        float64 `protobuf:"fixed64,5,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"` // Примерная стоимость изменения
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -1299,6 +1364,370 @@ func (x *ScenarioResult) GetImprovementVsBaseline() float64 {
 	return 0
 }
 
+type BuildOriginDestinationMatrixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Graph         *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"` // Решённый граф с current_flow
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildOriginDestinationMatrixRequest) Reset() {
+	*x = BuildOriginDestinationMatrixRequest{}
+	mi := &file_logistics_analytics_v1_analytics_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildOriginDestinationMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildOriginDestinationMatrixRequest) ProtoMessage() {}
+
+func (x *BuildOriginDestinationMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_analytics_v1_analytics_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildOriginDestinationMatrixRequest.ProtoReflect.Descriptor instead.
+func (*BuildOriginDestinationMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_logistics_analytics_v1_analytics_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BuildOriginDestinationMatrixRequest) GetGraph() *v1.Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+// Поток раскладывается на пути; путь относится к первому складу на нём
+// (или к началу пути) и к последней точке доставки (или к концу пути)
+type BuildOriginDestinationMatrixResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Cells         []*OriginDestinationCell  `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`               // По складу, затем по точке доставки
+	Origins       []*OriginDestinationTotal `protobuf:"bytes,2,rep,name=origins,proto3" json:"origins,omitempty"`           // Итоги по складам
+	Destinations  []*OriginDestinationTotal `protobuf:"bytes,3,rep,name=destinations,proto3" json:"destinations,omitempty"` // Итоги по точкам доставки
+	TotalVolume   float64                   `protobuf:"fixed64,4,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	TotalCost     float64                   `protobuf:"fixed64,5,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	AverageCost   float64                   `protobuf:"fixed64,6,opt,name=average_cost,json=averageCost,proto3" json:"average_cost,omitempty"`       // Стоимость единицы потока
+	AverageLength float64                   `protobuf:"fixed64,7,opt,name=average_length,json=averageLength,proto3" json:"average_length,omitempty"` // Средняя длина пути, взвешенная по объёму
+	PathCount     int32                     `protobuf:"varint,8,opt,name=path_count,json=pathCount,proto3" json:"path_count,omitempty"`
+	// Поток в циклах никуда не доставляется, но его стоимость входит
+	// в стоимость решения
+	CycleVolume   float64 `protobuf:"fixed64,9,opt,name=cycle_volume,json=cycleVolume,proto3" json:"cycle_volume,omitempty"`
+	CycleCost     float64 `protobuf:"fixed64,10,opt,name=cycle_cost,json=cycleCost,proto3" json:"cycle_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildOriginDestinationMatrixResponse) Reset() {
+	*x = BuildOriginDestinationMatrixResponse{}
+	mi := &file_logistics_analytics_v1_analytics_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildOriginDestinationMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildOriginDestinationMatrixResponse) ProtoMessage() {}
+
+func (x *BuildOriginDestinationMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_analytics_v1_analytics_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildOriginDestinationMatrixResponse.ProtoReflect.Descriptor instead.
+func (*BuildOriginDestinationMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_logistics_analytics_v1_analytics_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetCells() []*OriginDestinationCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetOrigins() []*OriginDestinationTotal {
+	if x != nil {
+		return x.Origins
+	}
+	return nil
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetDestinations() []*OriginDestinationTotal {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetTotalVolume() float64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetAverageCost() float64 {
+	if x != nil {
+		return x.AverageCost
+	}
+	return 0
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetAverageLength() float64 {
+	if x != nil {
+		return x.AverageLength
+	}
+	return 0
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetPathCount() int32 {
+	if x != nil {
+		return x.PathCount
+	}
+	return 0
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetCycleVolume() float64 {
+	if x != nil {
+		return x.CycleVolume
+	}
+	return 0
+}
+
+func (x *BuildOriginDestinationMatrixResponse) GetCycleCost() float64 {
+	if x != nil {
+		return x.CycleCost
+	}
+	return 0
+}
+
+type OriginDestinationCell struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OriginId         int64                  `protobuf:"varint,1,opt,name=origin_id,json=originId,proto3" json:"origin_id,omitempty"`
+	OriginName       string                 `protobuf:"bytes,2,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
+	DestinationId    int64                  `protobuf:"varint,3,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	DestinationName  string                 `protobuf:"bytes,4,opt,name=destination_name,json=destinationName,proto3" json:"destination_name,omitempty"`
+	Volume           float64                `protobuf:"fixed64,5,opt,name=volume,proto3" json:"volume,omitempty"`
+	TotalCost        float64                `protobuf:"fixed64,6,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	AverageCost      float64                `protobuf:"fixed64,7,opt,name=average_cost,json=averageCost,proto3" json:"average_cost,omitempty"`       // Стоимость единицы потока
+	AverageLength    float64                `protobuf:"fixed64,8,opt,name=average_length,json=averageLength,proto3" json:"average_length,omitempty"` // Средняя длина пути, взвешенная по объёму
+	PathCount        int32                  `protobuf:"varint,9,opt,name=path_count,json=pathCount,proto3" json:"path_count,omitempty"`
+	DestinationShare float64                `protobuf:"fixed64,10,opt,name=destination_share,json=destinationShare,proto3" json:"destination_share,omitempty"` // Доля в объёме точки доставки, 0-1
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OriginDestinationCell) Reset() {
+	*x = OriginDestinationCell{}
+	mi := &file_logistics_analytics_v1_analytics_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OriginDestinationCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginDestinationCell) ProtoMessage() {}
+
+func (x *OriginDestinationCell) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_analytics_v1_analytics_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginDestinationCell.ProtoReflect.Descriptor instead.
+func (*OriginDestinationCell) Descriptor() ([]byte, []int) {
+	return file_logistics_analytics_v1_analytics_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OriginDestinationCell) GetOriginId() int64 {
+	if x != nil {
+		return x.OriginId
+	}
+	return 0
+}
+
+func (x *OriginDestinationCell) GetOriginName() string {
+	if x != nil {
+		return x.OriginName
+	}
+	return ""
+}
+
+func (x *OriginDestinationCell) GetDestinationId() int64 {
+	if x != nil {
+		return x.DestinationId
+	}
+	return 0
+}
+
+func (x *OriginDestinationCell) GetDestinationName() string {
+	if x != nil {
+		return x.DestinationName
+	}
+	return ""
+}
+
+func (x *OriginDestinationCell) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *OriginDestinationCell) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *OriginDestinationCell) GetAverageCost() float64 {
+	if x != nil {
+		return x.AverageCost
+	}
+	return 0
+}
+
+func (x *OriginDestinationCell) GetAverageLength() float64 {
+	if x != nil {
+		return x.AverageLength
+	}
+	return 0
+}
+
+func (x *OriginDestinationCell) GetPathCount() int32 {
+	if x != nil {
+		return x.PathCount
+	}
+	return 0
+}
+
+func (x *OriginDestinationCell) GetDestinationShare() float64 {
+	if x != nil {
+		return x.DestinationShare
+	}
+	return 0
+}
+
+type OriginDestinationTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Volume        float64                `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	TotalCost     float64                `protobuf:"fixed64,4,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	AverageCost   float64                `protobuf:"fixed64,5,opt,name=average_cost,json=averageCost,proto3" json:"average_cost,omitempty"`
+	AverageLength float64                `protobuf:"fixed64,6,opt,name=average_length,json=averageLength,proto3" json:"average_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OriginDestinationTotal) Reset() {
+	*x = OriginDestinationTotal{}
+	mi := &file_logistics_analytics_v1_analytics_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OriginDestinationTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginDestinationTotal) ProtoMessage() {}
+
+func (x *OriginDestinationTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_analytics_v1_analytics_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginDestinationTotal.ProtoReflect.Descriptor instead.
+func (*OriginDestinationTotal) Descriptor() ([]byte, []int) {
+	return file_logistics_analytics_v1_analytics_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OriginDestinationTotal) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *OriginDestinationTotal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OriginDestinationTotal) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *OriginDestinationTotal) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *OriginDestinationTotal) GetAverageCost() float64 {
+	if x != nil {
+		return x.AverageCost
+	}
+	return 0
+}
+
+func (x *OriginDestinationTotal) GetAverageLength() float64 {
+	if x != nil {
+		return x.AverageLength
+	}
+	return 0
+}
+
 var File_logistics_analytics_v1_analytics_proto protoreflect.FileDescriptor
 
 const file_logistics_analytics_v1_analytics_proto_rawDesc = "" +
@@ -1318,7 +1747,7 @@ const file_logistics_analytics_v1_analytics_proto_rawDesc = "" +
 	"\x0emarkup_percent\x18\a \x01(\x01R\rmarkupPercent\x1aB\n" +
 	"\x14CostMultipliersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xd5\x03\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xfe\x04\n" +
 	"\x0fFixedCostConfig\x12%\n" +
 	"\x0ewarehouse_cost\x18\x01 \x01(\x01R\rwarehouseCost\x12.\n" +
 	"\x13delivery_point_cost\x18\x02 \x01(\x01R\x11deliveryPointCost\x12+\n" +
@@ -1326,9 +1755,13 @@ const file_logistics_analytics_v1_analytics_proto_rawDesc = "" +
 	"\x14road_type_base_costs\x18\x04 \x03(\v2>.logistics.analytics.v1.FixedCostConfig.RoadTypeBaseCostsEntryR\x11roadTypeBaseCosts\x12.\n" +
 	"\x13base_operation_cost\x18\x05 \x01(\x01R\x11baseOperationCost\x12\"\n" +
 	"\rper_edge_cost\x18\x06 \x01(\x01R\vperEdgeCost\x123\n" +
-	"\x16per_unit_handling_cost\x18\a \x01(\x01R\x13perUnitHandlingCost\x1aD\n" +
+	"\x16per_unit_handling_cost\x18\a \x01(\x01R\x13perUnitHandlingCost\x12d\n" +
+	"\x0fwarehouse_costs\x18\b \x03(\v2;.logistics.analytics.v1.FixedCostConfig.WarehouseCostsEntryR\x0ewarehouseCosts\x1aD\n" +
 	"\x16RoadTypeBaseCostsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1aA\n" +
+	"\x13WarehouseCostsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x97\x01\n" +
 	"\x15CalculateCostResponse\x12\x1d\n" +
 	"\n" +
@@ -1363,13 +1796,20 @@ const file_logistics_analytics_v1_analytics_proto_rawDesc = "" +
 	"\x05top_n\x18\x03 \x01(\x05R\x04topN\"\xb1\x01\n" +
 	"\x17FindBottlenecksResponse\x12D\n" +
 	"\vbottlenecks\x18\x01 \x03(\v2\".logistics.analytics.v1.BottleneckR\vbottlenecks\x12P\n" +
-	"\x0frecommendations\x18\x02 \x03(\v2&.logistics.analytics.v1.RecommendationR\x0frecommendations\"\xc8\x01\n" +
+	"\x0frecommendations\x18\x02 \x03(\v2&.logistics.analytics.v1.RecommendationR\x0frecommendations\"\x84\x03\n" +
 	"\n" +
 	"Bottleneck\x12-\n" +
 	"\x04edge\x18\x01 \x01(\v2\x19.logistics.common.v1.EdgeR\x04edge\x12 \n" +
 	"\vutilization\x18\x02 \x01(\x01R\vutilization\x12!\n" +
 	"\fimpact_score\x18\x03 \x01(\x01R\vimpactScore\x12F\n" +
-	"\bseverity\x18\x04 \x01(\x0e2*.logistics.analytics.v1.BottleneckSeverityR\bseverity\"\xe5\x01\n" +
+	"\bseverity\x18\x04 \x01(\x0e2*.logistics.analytics.v1.BottleneckSeverityR\bseverity\x12\x1c\n" +
+	"\n" +
+	"in_min_cut\x18\x05 \x01(\bR\binMinCut\x12,\n" +
+	"\x12marginal_flow_gain\x18\x06 \x01(\x01R\x10marginalFlowGain\x12\x1b\n" +
+	"\tflow_gain\x18\a \x01(\x01R\bflowGain\x120\n" +
+	"\x14marginal_cost_saving\x18\b \x01(\x01R\x12marginalCostSaving\x12\x1f\n" +
+	"\vcost_saving\x18\t \x01(\x01R\n" +
+	"costSaving\"\xe5\x01\n" +
 	"\x0eRecommendation\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12A\n" +
@@ -1417,7 +1857,47 @@ const file_logistics_analytics_v1_analytics_proto_rawDesc = "" +
 	"\n" +
 	"efficiency\x18\x04 \x01(\x01R\n" +
 	"efficiency\x126\n" +
-	"\x17improvement_vs_baseline\x18\x05 \x01(\x01R\x15improvementVsBaseline*\xa4\x01\n" +
+	"\x17improvement_vs_baseline\x18\x05 \x01(\x01R\x15improvementVsBaseline\"W\n" +
+	"#BuildOriginDestinationMatrixRequest\x120\n" +
+	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\"\xf6\x03\n" +
+	"$BuildOriginDestinationMatrixResponse\x12C\n" +
+	"\x05cells\x18\x01 \x03(\v2-.logistics.analytics.v1.OriginDestinationCellR\x05cells\x12H\n" +
+	"\aorigins\x18\x02 \x03(\v2..logistics.analytics.v1.OriginDestinationTotalR\aorigins\x12R\n" +
+	"\fdestinations\x18\x03 \x03(\v2..logistics.analytics.v1.OriginDestinationTotalR\fdestinations\x12!\n" +
+	"\ftotal_volume\x18\x04 \x01(\x01R\vtotalVolume\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x05 \x01(\x01R\ttotalCost\x12!\n" +
+	"\faverage_cost\x18\x06 \x01(\x01R\vaverageCost\x12%\n" +
+	"\x0eaverage_length\x18\a \x01(\x01R\raverageLength\x12\x1d\n" +
+	"\n" +
+	"path_count\x18\b \x01(\x05R\tpathCount\x12!\n" +
+	"\fcycle_volume\x18\t \x01(\x01R\vcycleVolume\x12\x1d\n" +
+	"\n" +
+	"cycle_cost\x18\n" +
+	" \x01(\x01R\tcycleCost\"\xf4\x02\n" +
+	"\x15OriginDestinationCell\x12\x1b\n" +
+	"\torigin_id\x18\x01 \x01(\x03R\boriginId\x12\x1f\n" +
+	"\vorigin_name\x18\x02 \x01(\tR\n" +
+	"originName\x12%\n" +
+	"\x0edestination_id\x18\x03 \x01(\x03R\rdestinationId\x12)\n" +
+	"\x10destination_name\x18\x04 \x01(\tR\x0fdestinationName\x12\x16\n" +
+	"\x06volume\x18\x05 \x01(\x01R\x06volume\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x06 \x01(\x01R\ttotalCost\x12!\n" +
+	"\faverage_cost\x18\a \x01(\x01R\vaverageCost\x12%\n" +
+	"\x0eaverage_length\x18\b \x01(\x01R\raverageLength\x12\x1d\n" +
+	"\n" +
+	"path_count\x18\t \x01(\x05R\tpathCount\x12+\n" +
+	"\x11destination_share\x18\n" +
+	" \x01(\x01R\x10destinationShare\"\xc6\x01\n" +
+	"\x16OriginDestinationTotal\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06volume\x18\x03 \x01(\x01R\x06volume\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x04 \x01(\x01R\ttotalCost\x12!\n" +
+	"\faverage_cost\x18\x05 \x01(\x01R\vaverageCost\x12%\n" +
+	"\x0eaverage_length\x18\x06 \x01(\x01R\raverageLength*\xa4\x01\n" +
 	"\x13CostCalculationMode\x12%\n" +
 	"!COST_CALCULATION_MODE_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cCOST_CALCULATION_MODE_SIMPLE\x10\x01\x12$\n" +
@@ -1428,12 +1908,13 @@ const file_logistics_analytics_v1_analytics_proto_rawDesc = "" +
 	"\x17BOTTLENECK_SEVERITY_LOW\x10\x01\x12\x1e\n" +
 	"\x1aBOTTLENECK_SEVERITY_MEDIUM\x10\x02\x12\x1c\n" +
 	"\x18BOTTLENECK_SEVERITY_HIGH\x10\x03\x12 \n" +
-	"\x1cBOTTLENECK_SEVERITY_CRITICAL\x10\x042\xd3\x03\n" +
+	"\x1cBOTTLENECK_SEVERITY_CRITICAL\x10\x042\xef\x04\n" +
 	"\x10AnalyticsService\x12l\n" +
 	"\rCalculateCost\x12,.logistics.analytics.v1.CalculateCostRequest\x1a-.logistics.analytics.v1.CalculateCostResponse\x12r\n" +
 	"\x0fFindBottlenecks\x12..logistics.analytics.v1.FindBottlenecksRequest\x1a/.logistics.analytics.v1.FindBottlenecksResponse\x12f\n" +
 	"\vAnalyzeFlow\x12*.logistics.analytics.v1.AnalyzeFlowRequest\x1a+.logistics.analytics.v1.AnalyzeFlowResponse\x12u\n" +
-	"\x10CompareScenarios\x12/.logistics.analytics.v1.CompareScenariosRequest\x1a0.logistics.analytics.v1.CompareScenariosResponseB\xdb\x01\n" +
+	"\x10CompareScenarios\x12/.logistics.analytics.v1.CompareScenariosRequest\x1a0.logistics.analytics.v1.CompareScenariosResponse\x12\x99\x01\n" +
+	"\x1cBuildOriginDestinationMatrix\x12;.logistics.analytics.v1.BuildOriginDestinationMatrixRequest\x1a<.logistics.analytics.v1.BuildOriginDestinationMatrixResponseB\xdb\x01\n" +
 	"\x1acom.logistics.analytics.v1B\x0eAnalyticsProtoP\x01Z3logistics/gen/go/logistics/analytics/v1;analyticsv1\xa2\x02\x03LAX\xaa\x02\x16Logistics.Analytics.V1\xca\x02\x16Logistics\\Analytics\\V1\xe2\x02\"Logistics\\Analytics\\V1\\GPBMetadata\xea\x02\x18Logistics::Analytics::V1b\x06proto3"
 
 var (
@@ -1449,75 +1930,87 @@ func file_logistics_analytics_v1_analytics_proto_rawDescGZIP() []byte {
 }
 
 var file_logistics_analytics_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_logistics_analytics_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_logistics_analytics_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_logistics_analytics_v1_analytics_proto_goTypes = []any{
-	(CostCalculationMode)(0),         // 0: logistics.analytics.v1.CostCalculationMode
-	(BottleneckSeverity)(0),          // 1: logistics.analytics.v1.BottleneckSeverity
-	(*CalculateCostRequest)(nil),     // 2: logistics.analytics.v1.CalculateCostRequest
-	(*CostOptions)(nil),              // 3: logistics.analytics.v1.CostOptions
-	(*FixedCostConfig)(nil),          // 4: logistics.analytics.v1.FixedCostConfig
-	(*CalculateCostResponse)(nil),    // 5: logistics.analytics.v1.CalculateCostResponse
-	(*CostBreakdown)(nil),            // 6: logistics.analytics.v1.CostBreakdown
-	(*FindBottlenecksRequest)(nil),   // 7: logistics.analytics.v1.FindBottlenecksRequest
-	(*FindBottlenecksResponse)(nil),  // 8: logistics.analytics.v1.FindBottlenecksResponse
-	(*Bottleneck)(nil),               // 9: logistics.analytics.v1.Bottleneck
-	(*Recommendation)(nil),           // 10: logistics.analytics.v1.Recommendation
-	(*AnalyzeFlowRequest)(nil),       // 11: logistics.analytics.v1.AnalyzeFlowRequest
-	(*AnalysisOptions)(nil),          // 12: logistics.analytics.v1.AnalysisOptions
-	(*AnalyzeFlowResponse)(nil),      // 13: logistics.analytics.v1.AnalyzeFlowResponse
-	(*EfficiencyReport)(nil),         // 14: logistics.analytics.v1.EfficiencyReport
-	(*CompareScenariosRequest)(nil),  // 15: logistics.analytics.v1.CompareScenariosRequest
-	(*CompareScenariosResponse)(nil), // 16: logistics.analytics.v1.CompareScenariosResponse
-	(*ScenarioResult)(nil),           // 17: logistics.analytics.v1.ScenarioResult
-	nil,                              // 18: logistics.analytics.v1.CostOptions.CostMultipliersEntry
-	nil,                              // 19: logistics.analytics.v1.FixedCostConfig.RoadTypeBaseCostsEntry
-	nil,                              // 20: logistics.analytics.v1.CostBreakdown.CostByRoadTypeEntry
-	nil,                              // 21: logistics.analytics.v1.CostBreakdown.CostByNodeTypeEntry
-	(*v1.Graph)(nil),                 // 22: logistics.common.v1.Graph
-	(*v1.Edge)(nil),                  // 23: logistics.common.v1.Edge
-	(*v1.EdgeKey)(nil),               // 24: logistics.common.v1.EdgeKey
-	(*v1.FlowStatistics)(nil),        // 25: logistics.common.v1.FlowStatistics
-	(*v1.GraphStatistics)(nil),       // 26: logistics.common.v1.GraphStatistics
+	(CostCalculationMode)(0),                     // 0: logistics.analytics.v1.CostCalculationMode
+	(BottleneckSeverity)(0),                      // 1: logistics.analytics.v1.BottleneckSeverity
+	(*CalculateCostRequest)(nil),                 // 2: logistics.analytics.v1.CalculateCostRequest
+	(*CostOptions)(nil),                          // 3: logistics.analytics.v1.CostOptions
+	(*FixedCostConfig)(nil),                      // 4: logistics.analytics.v1.FixedCostConfig
+	(*CalculateCostResponse)(nil),                // 5: logistics.analytics.v1.CalculateCostResponse
+	(*CostBreakdown)(nil),                        // 6: logistics.analytics.v1.CostBreakdown
+	(*FindBottlenecksRequest)(nil),               // 7: logistics.analytics.v1.FindBottlenecksRequest
+	(*FindBottlenecksResponse)(nil),              // 8: logistics.analytics.v1.FindBottlenecksResponse
+	(*Bottleneck)(nil),                           // 9: logistics.analytics.v1.Bottleneck
+	(*Recommendation)(nil),                       // 10: logistics.analytics.v1.Recommendation
+	(*AnalyzeFlowRequest)(nil),                   // 11: logistics.analytics.v1.AnalyzeFlowRequest
+	(*AnalysisOptions)(nil),                      // 12: logistics.analytics.v1.AnalysisOptions
+	(*AnalyzeFlowResponse)(nil),                  // 13: logistics.analytics.v1.AnalyzeFlowResponse
+	(*EfficiencyReport)(nil),                     // 14: logistics.analytics.v1.EfficiencyReport
+	(*CompareScenariosRequest)(nil),              // 15: logistics.analytics.v1.CompareScenariosRequest
+	(*CompareScenariosResponse)(nil),             // 16: logistics.analytics.v1.CompareScenariosResponse
+	(*ScenarioResult)(nil),                       // 17: logistics.analytics.v1.ScenarioResult
+	(*BuildOriginDestinationMatrixRequest)(nil),  // 18: logistics.analytics.v1.BuildOriginDestinationMatrixRequest
+	(*BuildOriginDestinationMatrixResponse)(nil), // 19: logistics.analytics.v1.BuildOriginDestinationMatrixResponse
+	(*OriginDestinationCell)(nil),                // 20: logistics.analytics.v1.OriginDestinationCell
+	(*OriginDestinationTotal)(nil),               // 21: logistics.analytics.v1.OriginDestinationTotal
+	nil,                                          // 22: logistics.analytics.v1.CostOptions.CostMultipliersEntry
+	nil,                                          // 23: logistics.analytics.v1.FixedCostConfig.RoadTypeBaseCostsEntry
+	nil,                                          // 24: logistics.analytics.v1.FixedCostConfig.WarehouseCostsEntry
+	nil,                                          // 25: logistics.analytics.v1.CostBreakdown.CostByRoadTypeEntry
+	nil,                                          // 26: logistics.analytics.v1.CostBreakdown.CostByNodeTypeEntry
+	(*v1.Graph)(nil),                             // 27: logistics.common.v1.Graph
+	(*v1.Edge)(nil),                              // 28: logistics.common.v1.Edge
+	(*v1.EdgeKey)(nil),                           // 29: logistics.common.v1.EdgeKey
+	(*v1.FlowStatistics)(nil),                    // 30: logistics.common.v1.FlowStatistics
+	(*v1.GraphStatistics)(nil),                   // 31: logistics.common.v1.GraphStatistics
 }
 var file_logistics_analytics_v1_analytics_proto_depIdxs = []int32{
-	22, // 0: logistics.analytics.v1.CalculateCostRequest.graph:type_name -> logistics.common.v1.Graph
+	27, // 0: logistics.analytics.v1.CalculateCostRequest.graph:type_name -> logistics.common.v1.Graph
 	3,  // 1: logistics.analytics.v1.CalculateCostRequest.options:type_name -> logistics.analytics.v1.CostOptions
-	18, // 2: logistics.analytics.v1.CostOptions.cost_multipliers:type_name -> logistics.analytics.v1.CostOptions.CostMultipliersEntry
+	22, // 2: logistics.analytics.v1.CostOptions.cost_multipliers:type_name -> logistics.analytics.v1.CostOptions.CostMultipliersEntry
 	4,  // 3: logistics.analytics.v1.CostOptions.fixed_costs:type_name -> logistics.analytics.v1.FixedCostConfig
 	0,  // 4: logistics.analytics.v1.CostOptions.mode:type_name -> logistics.analytics.v1.CostCalculationMode
-	19, // 5: logistics.analytics.v1.FixedCostConfig.road_type_base_costs:type_name -> logistics.analytics.v1.FixedCostConfig.RoadTypeBaseCostsEntry
-	6,  // 6: logistics.analytics.v1.CalculateCostResponse.breakdown:type_name -> logistics.analytics.v1.CostBreakdown
-	20, // 7: logistics.analytics.v1.CostBreakdown.cost_by_road_type:type_name -> logistics.analytics.v1.CostBreakdown.CostByRoadTypeEntry
-	21, // 8: logistics.analytics.v1.CostBreakdown.cost_by_node_type:type_name -> logistics.analytics.v1.CostBreakdown.CostByNodeTypeEntry
-	22, // 9: logistics.analytics.v1.FindBottlenecksRequest.graph:type_name -> logistics.common.v1.Graph
-	9,  // 10: logistics.analytics.v1.FindBottlenecksResponse.bottlenecks:type_name -> logistics.analytics.v1.Bottleneck
-	10, // 11: logistics.analytics.v1.FindBottlenecksResponse.recommendations:type_name -> logistics.analytics.v1.Recommendation
-	23, // 12: logistics.analytics.v1.Bottleneck.edge:type_name -> logistics.common.v1.Edge
-	1,  // 13: logistics.analytics.v1.Bottleneck.severity:type_name -> logistics.analytics.v1.BottleneckSeverity
-	24, // 14: logistics.analytics.v1.Recommendation.affected_edge:type_name -> logistics.common.v1.EdgeKey
-	22, // 15: logistics.analytics.v1.AnalyzeFlowRequest.graph:type_name -> logistics.common.v1.Graph
-	12, // 16: logistics.analytics.v1.AnalyzeFlowRequest.options:type_name -> logistics.analytics.v1.AnalysisOptions
-	25, // 17: logistics.analytics.v1.AnalyzeFlowResponse.flow_stats:type_name -> logistics.common.v1.FlowStatistics
-	26, // 18: logistics.analytics.v1.AnalyzeFlowResponse.graph_stats:type_name -> logistics.common.v1.GraphStatistics
-	5,  // 19: logistics.analytics.v1.AnalyzeFlowResponse.cost:type_name -> logistics.analytics.v1.CalculateCostResponse
-	8,  // 20: logistics.analytics.v1.AnalyzeFlowResponse.bottlenecks:type_name -> logistics.analytics.v1.FindBottlenecksResponse
-	14, // 21: logistics.analytics.v1.AnalyzeFlowResponse.efficiency:type_name -> logistics.analytics.v1.EfficiencyReport
-	22, // 22: logistics.analytics.v1.CompareScenariosRequest.baseline:type_name -> logistics.common.v1.Graph
-	22, // 23: logistics.analytics.v1.CompareScenariosRequest.scenarios:type_name -> logistics.common.v1.Graph
-	17, // 24: logistics.analytics.v1.CompareScenariosResponse.results:type_name -> logistics.analytics.v1.ScenarioResult
-	2,  // 25: logistics.analytics.v1.AnalyticsService.CalculateCost:input_type -> logistics.analytics.v1.CalculateCostRequest
-	7,  // 26: logistics.analytics.v1.AnalyticsService.FindBottlenecks:input_type -> logistics.analytics.v1.FindBottlenecksRequest
-	11, // 27: logistics.analytics.v1.AnalyticsService.AnalyzeFlow:input_type -> logistics.analytics.v1.AnalyzeFlowRequest
-	15, // 28: logistics.analytics.v1.AnalyticsService.CompareScenarios:input_type -> logistics.analytics.v1.CompareScenariosRequest
-	5,  // 29: logistics.analytics.v1.AnalyticsService.CalculateCost:output_type -> logistics.analytics.v1.CalculateCostResponse
-	8,  // 30: logistics.analytics.v1.AnalyticsService.FindBottlenecks:output_type -> logistics.analytics.v1.FindBottlenecksResponse
-	13, // 31: logistics.analytics.v1.AnalyticsService.AnalyzeFlow:output_type -> logistics.analytics.v1.AnalyzeFlowResponse
-	16, // 32: logistics.analytics.v1.AnalyticsService.CompareScenarios:output_type -> logistics.analytics.v1.CompareScenariosResponse
-	29, // [29:33] is the sub-list for method output_type
-	25, // [25:29] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	23, // 5: logistics.analytics.v1.FixedCostConfig.road_type_base_costs:type_name -> logistics.analytics.v1.FixedCostConfig.RoadTypeBaseCostsEntry
+	24, // 6: logistics.analytics.v1.FixedCostConfig.warehouse_costs:type_name -> logistics.analytics.v1.FixedCostConfig.WarehouseCostsEntry
+	6,  // 7: logistics.analytics.v1.CalculateCostResponse.breakdown:type_name -> logistics.analytics.v1.CostBreakdown
+	25, // 8: logistics.analytics.v1.CostBreakdown.cost_by_road_type:type_name -> logistics.analytics.v1.CostBreakdown.CostByRoadTypeEntry
+	26, // 9: logistics.analytics.v1.CostBreakdown.cost_by_node_type:type_name -> logistics.analytics.v1.CostBreakdown.CostByNodeTypeEntry
+	27, // 10: logistics.analytics.v1.FindBottlenecksRequest.graph:type_name -> logistics.common.v1.Graph
+	9,  // 11: logistics.analytics.v1.FindBottlenecksResponse.bottlenecks:type_name -> logistics.analytics.v1.Bottleneck
+	10, // 12: logistics.analytics.v1.FindBottlenecksResponse.recommendations:type_name -> logistics.analytics.v1.Recommendation
+	28, // 13: logistics.analytics.v1.Bottleneck.edge:type_name -> logistics.common.v1.Edge
+	1,  // 14: logistics.analytics.v1.Bottleneck.severity:type_name -> logistics.analytics.v1.BottleneckSeverity
+	29, // 15: logistics.analytics.v1.Recommendation.affected_edge:type_name -> logistics.common.v1.EdgeKey
+	27, // 16: logistics.analytics.v1.AnalyzeFlowRequest.graph:type_name -> logistics.common.v1.Graph
+	12, // 17: logistics.analytics.v1.AnalyzeFlowRequest.options:type_name -> logistics.analytics.v1.AnalysisOptions
+	30, // 18: logistics.analytics.v1.AnalyzeFlowResponse.flow_stats:type_name -> logistics.common.v1.FlowStatistics
+	31, // 19: logistics.analytics.v1.AnalyzeFlowResponse.graph_stats:type_name -> logistics.common.v1.GraphStatistics
+	5,  // 20: logistics.analytics.v1.AnalyzeFlowResponse.cost:type_name -> logistics.analytics.v1.CalculateCostResponse
+	8,  // 21: logistics.analytics.v1.AnalyzeFlowResponse.bottlenecks:type_name -> logistics.analytics.v1.FindBottlenecksResponse
+	14, // 22: logistics.analytics.v1.AnalyzeFlowResponse.efficiency:type_name -> logistics.analytics.v1.EfficiencyReport
+	27, // 23: logistics.analytics.v1.CompareScenariosRequest.baseline:type_name -> logistics.common.v1.Graph
+	27, // 24: logistics.analytics.v1.CompareScenariosRequest.scenarios:type_name -> logistics.common.v1.Graph
+	17, // 25: logistics.analytics.v1.CompareScenariosResponse.results:type_name -> logistics.analytics.v1.ScenarioResult
+	27, // 26: logistics.analytics.v1.BuildOriginDestinationMatrixRequest.graph:type_name -> logistics.common.v1.Graph
+	20, // 27: logistics.analytics.v1.BuildOriginDestinationMatrixResponse.cells:type_name -> logistics.analytics.v1.OriginDestinationCell
+	21, // 28: logistics.analytics.v1.BuildOriginDestinationMatrixResponse.origins:type_name -> logistics.analytics.v1.OriginDestinationTotal
+	21, // 29: logistics.analytics.v1.BuildOriginDestinationMatrixResponse.destinations:type_name -> logistics.analytics.v1.OriginDestinationTotal
+	2,  // 30: logistics.analytics.v1.AnalyticsService.CalculateCost:input_type -> logistics.analytics.v1.CalculateCostRequest
+	7,  // 31: logistics.analytics.v1.AnalyticsService.FindBottlenecks:input_type -> logistics.analytics.v1.FindBottlenecksRequest
+	11, // 32: logistics.analytics.v1.AnalyticsService.AnalyzeFlow:input_type -> logistics.analytics.v1.AnalyzeFlowRequest
+	15, // 33: logistics.analytics.v1.AnalyticsService.CompareScenarios:input_type -> logistics.analytics.v1.CompareScenariosRequest
+	18, // 34: logistics.analytics.v1.AnalyticsService.BuildOriginDestinationMatrix:input_type -> logistics.analytics.v1.BuildOriginDestinationMatrixRequest
+	5,  // 35: logistics.analytics.v1.AnalyticsService.CalculateCost:output_type -> logistics.analytics.v1.CalculateCostResponse
+	8,  // 36: logistics.analytics.v1.AnalyticsService.FindBottlenecks:output_type -> logistics.analytics.v1.FindBottlenecksResponse
+	13, // 37: logistics.analytics.v1.AnalyticsService.AnalyzeFlow:output_type -> logistics.analytics.v1.AnalyzeFlowResponse
+	16, // 38: logistics.analytics.v1.AnalyticsService.CompareScenarios:output_type -> logistics.analytics.v1.CompareScenariosResponse
+	19, // 39: logistics.analytics.v1.AnalyticsService.BuildOriginDestinationMatrix:output_type -> logistics.analytics.v1.BuildOriginDestinationMatrixResponse
+	35, // [35:40] is the sub-list for method output_type
+	30, // [30:35] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_logistics_analytics_v1_analytics_proto_init() }
@@ -1531,7 +2024,7 @@ func file_logistics_analytics_v1_analytics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_logistics_analytics_v1_analytics_proto_rawDesc), len(file_logistics_analytics_v1_analytics_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   20,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AnalyticsService_CalculateCost_FullMethodName    = "/logistics.analytics.v1.AnalyticsService/CalculateCost"
-	AnalyticsService_FindBottlenecks_FullMethodName  = "/logistics.analytics.v1.AnalyticsService/FindBottlenecks"
-	AnalyticsService_AnalyzeFlow_FullMethodName      = "/logistics.analytics.v1.AnalyticsService/AnalyzeFlow"
-	AnalyticsService_CompareScenarios_FullMethodName = "/logistics.analytics.v1.AnalyticsService/CompareScenarios"
+	AnalyticsService_CalculateCost_FullMethodName                = "/logistics.analytics.v1.AnalyticsService/CalculateCost"
+	AnalyticsService_FindBottlenecks_FullMethodName              = "/logistics.analytics.v1.AnalyticsService/FindBottlenecks"
+	AnalyticsService_AnalyzeFlow_FullMethodName                  = "/logistics.analytics.v1.AnalyticsService/AnalyzeFlow"
+	AnalyticsService_CompareScenarios_FullMethodName             = "/logistics.analytics.v1.AnalyticsService/CompareScenarios"
+	AnalyticsService_BuildOriginDestinationMatrix_FullMethodName = "/logistics.analytics.v1.AnalyticsService/BuildOriginDestinationMatrix"
 )
 
 // AnalyticsServiceClient is the client API for AnalyticsService service.
@@ -37,6 +38,8 @@ type AnalyticsServiceClient interface {
 	AnalyzeFlow(ctx context.Context, in *AnalyzeFlowRequest, opts ...grpc.CallOption) (*AnalyzeFlowResponse, error)
 	// Сравнение сценариев
 	CompareScenarios(ctx context.Context, in *CompareScenariosRequest, opts ...grpc.CallOption) (*CompareScenariosResponse, error)
+	// Матрица корреспонденций «склад × точка доставки»
+	BuildOriginDestinationMatrix(ctx context.Context, in *BuildOriginDestinationMatrixRequest, opts ...grpc.CallOption) (*BuildOriginDestinationMatrixResponse, error)
 }
 
 type analyticsServiceClient struct {
@@ -87,6 +90,16 @@ func (c *analyticsServiceClient) CompareScenarios(ctx context.Context, in *Compa
 	return out, nil
 }
 
+func (c *analyticsServiceClient) BuildOriginDestinationMatrix(ctx context.Context, in *BuildOriginDestinationMatrixRequest, opts ...grpc.CallOption) (*BuildOriginDestinationMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuildOriginDestinationMatrixResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_BuildOriginDestinationMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalyticsServiceServer is the server API for AnalyticsService service.
 // All implementations must embed UnimplementedAnalyticsServiceServer
 // for forward compatibility.
@@ -99,6 +112,8 @@ type AnalyticsServiceServer interface {
 	AnalyzeFlow(context.Context, *AnalyzeFlowRequest) (*AnalyzeFlowResponse, error)
 	// Сравнение сценариев
 	CompareScenarios(context.Context, *CompareScenariosRequest) (*CompareScenariosResponse, error)
+	// Матрица корреспонденций «склад × точка доставки»
+	BuildOriginDestinationMatrix(context.Context, *BuildOriginDestinationMatrixRequest) (*BuildOriginDestinationMatrixResponse, error)
 	mustEmbedUnimplementedAnalyticsServiceServer()
 }
 
@@ -121,6 +136,9 @@ func (UnimplementedAnalyticsServiceServer) AnalyzeFlow(context.Context, *Analyze
 func (UnimplementedAnalyticsServiceServer) CompareScenarios(context.Context, *CompareScenariosRequest) (*CompareScenariosResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompareScenarios not implemented")
 }
+func (UnimplementedAnalyticsServiceServer) BuildOriginDestinationMatrix(context.Context, *BuildOriginDestinationMatrixRequest) (*BuildOriginDestinationMatrixResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BuildOriginDestinationMatrix not implemented")
+}
 func (UnimplementedAnalyticsServiceServer) mustEmbedUnimplementedAnalyticsServiceServer() {}
 func (UnimplementedAnalyticsServiceServer) testEmbeddedByValue()                          {}
 
@@ -214,6 +232,24 @@ func _AnalyticsService_CompareScenarios_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyticsService_BuildOriginDestinationMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildOriginDestinationMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).BuildOriginDestinationMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_BuildOriginDestinationMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).BuildOriginDestinationMatrix(ctx, req.(*BuildOriginDestinationMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AnalyticsService_ServiceDesc is the grpc.ServiceDesc for AnalyticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +273,10 @@ var AnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompareScenarios",
 			Handler:    _AnalyticsService_CompareScenarios_Handler,
 		},
+		{
+			MethodName: "BuildOriginDestinationMatrix",
+			Handler:    _AnalyticsService_BuildOriginDestinationMatrix_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "logistics/analytics/v1/analytics.proto",
@@ -45,6 +45,9 @@ const (
 	// AnalyticsServiceCompareScenariosProcedure is the fully-qualified name of the AnalyticsService's
 	// CompareScenarios RPC.
 	AnalyticsServiceCompareScenariosProcedure = "/logistics.analytics.v1.AnalyticsService/CompareScenarios"
+	// AnalyticsServiceBuildOriginDestinationMatrixProcedure is the fully-qualified name of the
+	// AnalyticsService's BuildOriginDestinationMatrix RPC.
+	AnalyticsServiceBuildOriginDestinationMatrixProcedure = "/logistics.analytics.v1.AnalyticsService/BuildOriginDestinationMatrix"
 )
 
 // AnalyticsServiceClient is a client for the logistics.analytics.v1.AnalyticsService service.
@@ -57,6 +60,8 @@ type AnalyticsServiceClient interface {
 	AnalyzeFlow(context.Context, *connect.Request[v1.AnalyzeFlowRequest]) (*connect.Response[v1.AnalyzeFlowResponse], error)
 	// Сравнение сценариев
 	CompareScenarios(context.Context, *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error)
+	// Матрица корреспонденций «склад × точка доставки»
+	BuildOriginDestinationMatrix(context.Context, *connect.Request[v1.BuildOriginDestinationMatrixRequest]) (*connect.Response[v1.BuildOriginDestinationMatrixResponse], error)
 }
 
 // NewAnalyticsServiceClient constructs a client for the logistics.analytics.v1.AnalyticsService
@@ -94,15 +99,22 @@ func NewAnalyticsServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(analyticsServiceMethods.ByName("CompareScenarios")),
 			connect.WithClientOptions(opts...),
 		),
+		buildOriginDestinationMatrix: connect.NewClient[v1.BuildOriginDestinationMatrixRequest, v1.BuildOriginDestinationMatrixResponse](
+			httpClient,
+			baseURL+AnalyticsServiceBuildOriginDestinationMatrixProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("BuildOriginDestinationMatrix")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // analyticsServiceClient implements AnalyticsServiceClient.
 type analyticsServiceClient struct {
-	calculateCost    *connect.Client[v1.CalculateCostRequest, v1.CalculateCostResponse]
-	findBottlenecks  *connect.Client[v1.FindBottlenecksRequest, v1.FindBottlenecksResponse]
-	analyzeFlow      *connect.Client[v1.AnalyzeFlowRequest, v1.AnalyzeFlowResponse]
-	compareScenarios *connect.Client[v1.CompareScenariosRequest, v1.CompareScenariosResponse]
+	calculateCost                *connect.Client[v1.CalculateCostRequest, v1.CalculateCostResponse]
+	findBottlenecks              *connect.Client[v1.FindBottlenecksRequest, v1.FindBottlenecksResponse]
+	analyzeFlow                  *connect.Client[v1.AnalyzeFlowRequest, v1.AnalyzeFlowResponse]
+	compareScenarios             *connect.Client[v1.CompareScenariosRequest, v1.CompareScenariosResponse]
+	buildOriginDestinationMatrix *connect.Client[v1.BuildOriginDestinationMatrixRequest, v1.BuildOriginDestinationMatrixResponse]
 }
 
 // CalculateCost calls logistics.analytics.v1.AnalyticsService.CalculateCost.
@@ -125,6 +137,12 @@ func (c *analyticsServiceClient) CompareScenarios(ctx context.Context, req *conn
 	return c.compareScenarios.CallUnary(ctx, req)
 }
 
+// BuildOriginDestinationMatrix calls
+// logistics.analytics.v1.AnalyticsService.BuildOriginDestinationMatrix.
+func (c *analyticsServiceClient) BuildOriginDestinationMatrix(ctx context.Context, req *connect.Request[v1.BuildOriginDestinationMatrixRequest]) (*connect.Response[v1.BuildOriginDestinationMatrixResponse], error) {
+	return c.buildOriginDestinationMatrix.CallUnary(ctx, req)
+}
+
 // AnalyticsServiceHandler is an implementation of the logistics.analytics.v1.AnalyticsService
 // service.
 type AnalyticsServiceHandler interface {
@@ -136,6 +154,8 @@ type AnalyticsServiceHandler interface {
 	AnalyzeFlow(context.Context, *connect.Request[v1.AnalyzeFlowRequest]) (*connect.Response[v1.AnalyzeFlowResponse], error)
 	// Сравнение сценариев
 	CompareScenarios(context.Context, *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error)
+	// Матрица корреспонденций «склад × точка доставки»
+	BuildOriginDestinationMatrix(context.Context, *connect.Request[v1.BuildOriginDestinationMatrixRequest]) (*connect.Response[v1.BuildOriginDestinationMatrixResponse], error)
 }
 
 // NewAnalyticsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -169,6 +189,12 @@ func NewAnalyticsServiceHandler(svc AnalyticsServiceHandler, opts ...connect.Han
 		connect.WithSchema(analyticsServiceMethods.ByName("CompareScenarios")),
 		connect.WithHandlerOptions(opts...),
 	)
+	analyticsServiceBuildOriginDestinationMatrixHandler := connect.NewUnaryHandler(
+		AnalyticsServiceBuildOriginDestinationMatrixProcedure,
+		svc.BuildOriginDestinationMatrix,
+		connect.WithSchema(analyticsServiceMethods.ByName("BuildOriginDestinationMatrix")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/logistics.analytics.v1.AnalyticsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AnalyticsServiceCalculateCostProcedure:
@@ -179,6 +205,8 @@ func NewAnalyticsServiceHandler(svc AnalyticsServiceHandler, opts ...connect.Han
 			analyticsServiceAnalyzeFlowHandler.ServeHTTP(w, r)
 		case AnalyticsServiceCompareScenariosProcedure:
 			analyticsServiceCompareScenariosHandler.ServeHTTP(w, r)
+		case AnalyticsServiceBuildOriginDestinationMatrixProcedure:
+			analyticsServiceBuildOriginDestinationMatrixHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -203,3 +231,7 @@ func (UnimplementedAnalyticsServiceHandler) AnalyzeFlow(context.Context, *connec
 func (UnimplementedAnalyticsServiceHandler) CompareScenarios(context.Context, *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.analytics.v1.AnalyticsService.CompareScenarios is not implemented"))
 }
+
+func (UnimplementedAnalyticsServiceHandler) BuildOriginDestinationMatrix(context.Context, *connect.Request[v1.BuildOriginDestinationMatrixRequest]) (*connect.Response[v1.BuildOriginDestinationMatrixResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.analytics.v1.AnalyticsService.BuildOriginDestinationMatrix is not implemented"))
+}
@@ -24,12 +24,13 @@ const (
 type Algorithm int32
 
 const (
-	Algorithm_ALGORITHM_UNSPECIFIED    Algorithm = 0
-	Algorithm_ALGORITHM_EDMONDS_KARP   Algorithm = 1
-	Algorithm_ALGORITHM_DINIC          Algorithm = 2
-	Algorithm_ALGORITHM_MIN_COST       Algorithm = 3
-	Algorithm_ALGORITHM_PUSH_RELABEL   Algorithm = 4
-	Algorithm_ALGORITHM_FORD_FULKERSON Algorithm = 5
+	Algorithm_ALGORITHM_UNSPECIFIED     Algorithm = 0
+	Algorithm_ALGORITHM_EDMONDS_KARP    Algorithm = 1
+	Algorithm_ALGORITHM_DINIC           Algorithm = 2
+	Algorithm_ALGORITHM_MIN_COST        Algorithm = 3
+	Algorithm_ALGORITHM_PUSH_RELABEL    Algorithm = 4
+	Algorithm_ALGORITHM_FORD_FULKERSON  Algorithm = 5
+	Algorithm_ALGORITHM_NETWORK_SIMPLEX Algorithm = 6
 )
 
 // Enum value maps for Algorithm.
@@ -41,14 +42,16 @@ var (
 		3: "ALGORITHM_MIN_COST",
 		4: "ALGORITHM_PUSH_RELABEL",
 		5: "ALGORITHM_FORD_FULKERSON",
+		6: "ALGORITHM_NETWORK_SIMPLEX",
 	}
 	Algorithm_value = map[string]int32{
-		"ALGORITHM_UNSPECIFIED":    0,
-		"ALGORITHM_EDMONDS_KARP":   1,
-		"ALGORITHM_DINIC":          2,
-		"ALGORITHM_MIN_COST":       3,
-		"ALGORITHM_PUSH_RELABEL":   4,
-		"ALGORITHM_FORD_FULKERSON": 5,
+		"ALGORITHM_UNSPECIFIED":     0,
+		"ALGORITHM_EDMONDS_KARP":    1,
+		"ALGORITHM_DINIC":           2,
+		"ALGORITHM_MIN_COST":        3,
+		"ALGORITHM_PUSH_RELABEL":    4,
+		"ALGORITHM_FORD_FULKERSON":  5,
+		"ALGORITHM_NETWORK_SIMPLEX": 6,
 	}
 )
 
@@ -254,9 +257,12 @@ func (FlowStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type EdgeKey struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Идентификатор ребра (Edge.id) для выбора одного из параллельных рёбер;
+	// 0 — все рёбра между from и to
+	EdgeId        int64 `protobuf:"varint,3,opt,name=edge_id,json=edgeId,proto3" json:"edge_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -305,6 +311,13 @@ func (x *EdgeKey) GetTo() int64 {
 	return 0
 }
 
+func (x *EdgeKey) GetEdgeId() int64 {
+	if x != nil {
+		return x.EdgeId
+	}
+	return 0
+}
+
 type Node struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -314,10 +327,13 @@ type Node struct {
 	Name     string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
 	Metadata map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Для min-cost flow с множественными источниками/стоками
-	Supply        float64 `protobuf:"fixed64,7,opt,name=supply,proto3" json:"supply,omitempty"` // > 0 для источников
-	Demand        float64 `protobuf:"fixed64,8,opt,name=demand,proto3" json:"demand,omitempty"` // > 0 для стоков
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Supply float64 `protobuf:"fixed64,7,opt,name=supply,proto3" json:"supply,omitempty"` // > 0 для источников
+	Demand float64 `protobuf:"fixed64,8,opt,name=demand,proto3" json:"demand,omitempty"` // > 0 для стоков
+	// Вместимость склада узла: сколько груза может ждать в узле между шагами
+	// динамической симуляции. 0 — хранение в узле запрещено
+	StorageCapacity float64 `protobuf:"fixed64,9,opt,name=storage_capacity,json=storageCapacity,proto3" json:"storage_capacity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Node) Reset() {
@@ -406,6 +422,13 @@ func (x *Node) GetDemand() float64 {
 	return 0
 }
 
+func (x *Node) GetStorageCapacity() float64 {
+	if x != nil {
+		return x.StorageCapacity
+	}
+	return 0
+}
+
 type Edge struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
@@ -416,6 +439,12 @@ type Edge struct {
 	RoadType      RoadType               `protobuf:"varint,6,opt,name=road_type,json=roadType,proto3,enum=logistics.common.v1.RoadType" json:"road_type,omitempty"`
 	CurrentFlow   float64                `protobuf:"fixed64,7,opt,name=current_flow,json=currentFlow,proto3" json:"current_flow,omitempty"`
 	Bidirectional bool                   `protobuf:"varint,8,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
+	// Минимальный поток по ребру (нижняя граница, 0 ≤ min_flow ≤ capacity).
+	// Для двунаправленных рёбер действует только в направлении from → to
+	MinFlow float64 `protobuf:"fixed64,9,opt,name=min_flow,json=minFlow,proto3" json:"min_flow,omitempty"`
+	// Стабильный идентификатор ребра, различающий параллельные рёбра между одной
+	// парой узлов. Если 0 — используется порядковый номер ребра в графе (с 1)
+	Id            int64 `protobuf:"varint,10,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -506,6 +535,20 @@ func (x *Edge) GetBidirectional() bool {
 	return false
 }
 
+func (x *Edge) GetMinFlow() float64 {
+	if x != nil {
+		return x.MinFlow
+	}
+	return 0
+}
+
+func (x *Edge) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 type Graph struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Nodes         []*Node                `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
@@ -666,6 +709,7 @@ type FlowEdge struct {
 	Capacity      float64                `protobuf:"fixed64,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	Cost          float64                `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
 	Utilization   float64                `protobuf:"fixed64,6,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	EdgeId        int64                  `protobuf:"varint,7,opt,name=edge_id,json=edgeId,proto3" json:"edge_id,omitempty"` // Идентификатор исходного ребра (Edge.id)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -742,6 +786,13 @@ func (x *FlowEdge) GetUtilization() float64 {
 	return 0
 }
 
+func (x *FlowEdge) GetEdgeId() int64 {
+	if x != nil {
+		return x.EdgeId
+	}
+	return 0
+}
+
 type FlowResult struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	MaxFlow           float64                `protobuf:"fixed64,1,opt,name=max_flow,json=maxFlow,proto3" json:"max_flow,omitempty"`
@@ -752,8 +803,20 @@ type FlowResult struct {
 	Iterations        int32                  `protobuf:"varint,6,opt,name=iterations,proto3" json:"iterations,omitempty"`
 	ComputationTimeMs float64                `protobuf:"fixed64,7,opt,name=computation_time_ms,json=computationTimeMs,proto3" json:"computation_time_ms,omitempty"`
 	ErrorMessage      string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Балансы узлов при множественных источниках/стоках
+	SourceBalances []*NodeBalance `protobuf:"bytes,9,rep,name=source_balances,json=sourceBalances,proto3" json:"source_balances,omitempty"` // Отгружено каждым складом
+	SinkBalances   []*NodeBalance `protobuf:"bytes,10,rep,name=sink_balances,json=sinkBalances,proto3" json:"sink_balances,omitempty"`      // Получено каждой точкой доставки
+	// Транспортная задача (SOLVE_MODE_TRANSPORTATION)
+	UnmetDemands   []*DemandShortfall `protobuf:"bytes,11,rep,name=unmet_demands,json=unmetDemands,proto3" json:"unmet_demands,omitempty"`       // Точки доставки с неудовлетворённым спросом
+	NodePotentials []*NodePotential   `protobuf:"bytes,12,rep,name=node_potentials,json=nodePotentials,proto3" json:"node_potentials,omitempty"` // Двойственные потенциалы (теневые цены) узлов
+	// Узлы, для которых нельзя выполнить минимальные потоки рёбер (статус INFEASIBLE)
+	LowerBoundViolations []*LowerBoundViolation `protobuf:"bytes,13,rep,name=lower_bound_violations,json=lowerBoundViolations,proto3" json:"lower_bound_violations,omitempty"`
+	// Минимальный разрез (только при SolveOptions.return_min_cut)
+	MinCut *MinCut `protobuf:"bytes,14,opt,name=min_cut,json=minCut,proto3" json:"min_cut,omitempty"`
+	// Разложение потока (только при SolveOptions.return_decomposition)
+	Decomposition *FlowDecomposition `protobuf:"bytes,15,opt,name=decomposition,proto3" json:"decomposition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FlowResult) Reset() {
@@ -842,6 +905,537 @@ func (x *FlowResult) GetErrorMessage() string {
 	return ""
 }
 
+func (x *FlowResult) GetSourceBalances() []*NodeBalance {
+	if x != nil {
+		return x.SourceBalances
+	}
+	return nil
+}
+
+func (x *FlowResult) GetSinkBalances() []*NodeBalance {
+	if x != nil {
+		return x.SinkBalances
+	}
+	return nil
+}
+
+func (x *FlowResult) GetUnmetDemands() []*DemandShortfall {
+	if x != nil {
+		return x.UnmetDemands
+	}
+	return nil
+}
+
+func (x *FlowResult) GetNodePotentials() []*NodePotential {
+	if x != nil {
+		return x.NodePotentials
+	}
+	return nil
+}
+
+func (x *FlowResult) GetLowerBoundViolations() []*LowerBoundViolation {
+	if x != nil {
+		return x.LowerBoundViolations
+	}
+	return nil
+}
+
+func (x *FlowResult) GetMinCut() *MinCut {
+	if x != nil {
+		return x.MinCut
+	}
+	return nil
+}
+
+func (x *FlowResult) GetDecomposition() *FlowDecomposition {
+	if x != nil {
+		return x.Decomposition
+	}
+	return nil
+}
+
+// Разложение итогового потока на пути и циклы, доступное для любого
+// алгоритма: строится по потокам рёбер, а не по найденным увеличивающим путям
+type FlowDecomposition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пути от узлов, отгружающих поток, к узлам, принимающим его; cost —
+	// стоимость всего потока пути, length — длина пути
+	Paths []*Path `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Циклы потока: первый узел совпадает с последним
+	Cycles        []*Path `protobuf:"bytes,2,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowDecomposition) Reset() {
+	*x = FlowDecomposition{}
+	mi := &file_logistics_common_v1_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowDecomposition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowDecomposition) ProtoMessage() {}
+
+func (x *FlowDecomposition) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_common_v1_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowDecomposition.ProtoReflect.Descriptor instead.
+func (*FlowDecomposition) Descriptor() ([]byte, []int) {
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FlowDecomposition) GetPaths() []*Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *FlowDecomposition) GetCycles() []*Path {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+type NodeBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Supply        float64                `protobuf:"fixed64,2,opt,name=supply,proto3" json:"supply,omitempty"`                                 // Заявленное предложение узла
+	Demand        float64                `protobuf:"fixed64,3,opt,name=demand,proto3" json:"demand,omitempty"`                                 // Заявленный спрос узла
+	Shipped       float64                `protobuf:"fixed64,4,opt,name=shipped,proto3" json:"shipped,omitempty"`                               // Фактически отгружено
+	Received      float64                `protobuf:"fixed64,5,opt,name=received,proto3" json:"received,omitempty"`                             // Фактически получено
+	UnmetDemand   float64                `protobuf:"fixed64,6,opt,name=unmet_demand,json=unmetDemand,proto3" json:"unmet_demand,omitempty"`    // Неудовлетворённый спрос
+	UnusedSupply  float64                `protobuf:"fixed64,7,opt,name=unused_supply,json=unusedSupply,proto3" json:"unused_supply,omitempty"` // Неиспользованное предложение
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeBalance) Reset() {
+	*x = NodeBalance{}
+	mi := &file_logistics_common_v1_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBalance) ProtoMessage() {}
+
+func (x *NodeBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_common_v1_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBalance.ProtoReflect.Descriptor instead.
+func (*NodeBalance) Descriptor() ([]byte, []int) {
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NodeBalance) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *NodeBalance) GetSupply() float64 {
+	if x != nil {
+		return x.Supply
+	}
+	return 0
+}
+
+func (x *NodeBalance) GetDemand() float64 {
+	if x != nil {
+		return x.Demand
+	}
+	return 0
+}
+
+func (x *NodeBalance) GetShipped() float64 {
+	if x != nil {
+		return x.Shipped
+	}
+	return 0
+}
+
+func (x *NodeBalance) GetReceived() float64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *NodeBalance) GetUnmetDemand() float64 {
+	if x != nil {
+		return x.UnmetDemand
+	}
+	return 0
+}
+
+func (x *NodeBalance) GetUnusedSupply() float64 {
+	if x != nil {
+		return x.UnusedSupply
+	}
+	return 0
+}
+
+type DemandShortfall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Demand        float64                `protobuf:"fixed64,2,opt,name=demand,proto3" json:"demand,omitempty"`       // Требуемый объём
+	Received      float64                `protobuf:"fixed64,3,opt,name=received,proto3" json:"received,omitempty"`   // Фактически получено
+	Shortfall     float64                `protobuf:"fixed64,4,opt,name=shortfall,proto3" json:"shortfall,omitempty"` // Недопоставка (demand - received)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DemandShortfall) Reset() {
+	*x = DemandShortfall{}
+	mi := &file_logistics_common_v1_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DemandShortfall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemandShortfall) ProtoMessage() {}
+
+func (x *DemandShortfall) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_common_v1_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DemandShortfall.ProtoReflect.Descriptor instead.
+func (*DemandShortfall) Descriptor() ([]byte, []int) {
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DemandShortfall) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *DemandShortfall) GetDemand() float64 {
+	if x != nil {
+		return x.Demand
+	}
+	return 0
+}
+
+func (x *DemandShortfall) GetReceived() float64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *DemandShortfall) GetShortfall() float64 {
+	if x != nil {
+		return x.Shortfall
+	}
+	return 0
+}
+
+// Дисбаланс минимальных потоков в узле: imbalance > 0 — минимальный входящий
+// поток превышает минимальный исходящий, imbalance < 0 — наоборот
+type LowerBoundViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Imbalance     float64                `protobuf:"fixed64,2,opt,name=imbalance,proto3" json:"imbalance,omitempty"`   // Дисбаланс минимальных потоков
+	Unresolved    float64                `protobuf:"fixed64,3,opt,name=unresolved,proto3" json:"unresolved,omitempty"` // Часть |imbalance|, которую не удалось провести через сеть
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LowerBoundViolation) Reset() {
+	*x = LowerBoundViolation{}
+	mi := &file_logistics_common_v1_common_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowerBoundViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowerBoundViolation) ProtoMessage() {}
+
+func (x *LowerBoundViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_common_v1_common_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowerBoundViolation.ProtoReflect.Descriptor instead.
+func (*LowerBoundViolation) Descriptor() ([]byte, []int) {
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LowerBoundViolation) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *LowerBoundViolation) GetImbalance() float64 {
+	if x != nil {
+		return x.Imbalance
+	}
+	return 0
+}
+
+func (x *LowerBoundViolation) GetUnresolved() float64 {
+	if x != nil {
+		return x.Unresolved
+	}
+	return 0
+}
+
+// Минимальный разрез по итоговой остаточной сети: узлы, достижимые из
+// источника, и рёбра, ведущие из этого множества наружу
+type MinCut struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SourceSide []int64                `protobuf:"varint,1,rep,packed,name=source_side,json=sourceSide,proto3" json:"source_side,omitempty"` // Узлы на стороне источника (по возрастанию ID)
+	Edges      []*CutEdge             `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`                                     // Рёбра разреза (узкие места)
+	Value      float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`                                   // Пропускная способность разреза (= максимальный поток)
+	// Мульти-терминальный режим: склады, чьё предложение целиком вошло в разрез,
+	// и точки доставки, чей спрос целиком вошёл в разрез
+	SaturatedSupplies []int64 `protobuf:"varint,4,rep,packed,name=saturated_supplies,json=saturatedSupplies,proto3" json:"saturated_supplies,omitempty"`
+	SaturatedDemands  []int64 `protobuf:"varint,5,rep,packed,name=saturated_demands,json=saturatedDemands,proto3" json:"saturated_demands,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MinCut) Reset() {
+	*x = MinCut{}
+	mi := &file_logistics_common_v1_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MinCut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinCut) ProtoMessage() {}
+
+func (x *MinCut) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_common_v1_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinCut.ProtoReflect.Descriptor instead.
+func (*MinCut) Descriptor() ([]byte, []int) {
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MinCut) GetSourceSide() []int64 {
+	if x != nil {
+		return x.SourceSide
+	}
+	return nil
+}
+
+func (x *MinCut) GetEdges() []*CutEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *MinCut) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *MinCut) GetSaturatedSupplies() []int64 {
+	if x != nil {
+		return x.SaturatedSupplies
+	}
+	return nil
+}
+
+func (x *MinCut) GetSaturatedDemands() []int64 {
+	if x != nil {
+		return x.SaturatedDemands
+	}
+	return nil
+}
+
+// Ребро разреза в направлении от стороны источника к стороне стока
+type CutEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	EdgeId        int64                  `protobuf:"varint,3,opt,name=edge_id,json=edgeId,proto3" json:"edge_id,omitempty"` // Edge.id (или 1-based позиция ребра, если id не задан)
+	Capacity      float64                `protobuf:"fixed64,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CutEdge) Reset() {
+	*x = CutEdge{}
+	mi := &file_logistics_common_v1_common_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CutEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CutEdge) ProtoMessage() {}
+
+func (x *CutEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_common_v1_common_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CutEdge.ProtoReflect.Descriptor instead.
+func (*CutEdge) Descriptor() ([]byte, []int) {
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CutEdge) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *CutEdge) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *CutEdge) GetEdgeId() int64 {
+	if x != nil {
+		return x.EdgeId
+	}
+	return 0
+}
+
+func (x *CutEdge) GetCapacity() float64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+// Потенциалы определены с точностью до константы (минимальный = 0):
+// разность потенциалов двух узлов — предельная стоимость доставки единицы между ними
+type NodePotential struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Potential     float64                `protobuf:"fixed64,2,opt,name=potential,proto3" json:"potential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodePotential) Reset() {
+	*x = NodePotential{}
+	mi := &file_logistics_common_v1_common_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodePotential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePotential) ProtoMessage() {}
+
+func (x *NodePotential) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_common_v1_common_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePotential.ProtoReflect.Descriptor instead.
+func (*NodePotential) Descriptor() ([]byte, []int) {
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NodePotential) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *NodePotential) GetPotential() float64 {
+	if x != nil {
+		return x.Potential
+	}
+	return 0
+}
+
 type GraphStatistics struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	NodeCount          int64                  `protobuf:"varint,1,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
@@ -858,7 +1452,7 @@ type GraphStatistics struct {
 
 func (x *GraphStatistics) Reset() {
 	*x = GraphStatistics{}
-	mi := &file_logistics_common_v1_common_proto_msgTypes[7]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -870,7 +1464,7 @@ func (x *GraphStatistics) String() string {
 func (*GraphStatistics) ProtoMessage() {}
 
 func (x *GraphStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_common_v1_common_proto_msgTypes[7]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1477,7 @@ func (x *GraphStatistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphStatistics.ProtoReflect.Descriptor instead.
 func (*GraphStatistics) Descriptor() ([]byte, []int) {
-	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{7}
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GraphStatistics) GetNodeCount() int64 {
@@ -956,7 +1550,7 @@ type FlowStatistics struct {
 
 func (x *FlowStatistics) Reset() {
 	*x = FlowStatistics{}
-	mi := &file_logistics_common_v1_common_proto_msgTypes[8]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1562,7 @@ func (x *FlowStatistics) String() string {
 func (*FlowStatistics) ProtoMessage() {}
 
 func (x *FlowStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_common_v1_common_proto_msgTypes[8]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1575,7 @@ func (x *FlowStatistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowStatistics.ProtoReflect.Descriptor instead.
 func (*FlowStatistics) Descriptor() ([]byte, []int) {
-	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{8}
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FlowStatistics) GetTotalFlow() float64 {
@@ -1037,7 +1631,7 @@ type ValidationError struct {
 
 func (x *ValidationError) Reset() {
 	*x = ValidationError{}
-	mi := &file_logistics_common_v1_common_proto_msgTypes[9]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1643,7 @@ func (x *ValidationError) String() string {
 func (*ValidationError) ProtoMessage() {}
 
 func (x *ValidationError) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_common_v1_common_proto_msgTypes[9]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1656,7 @@ func (x *ValidationError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidationError.ProtoReflect.Descriptor instead.
 func (*ValidationError) Descriptor() ([]byte, []int) {
-	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{9}
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ValidationError) GetField() string {
@@ -1096,7 +1690,7 @@ type ValidationResult struct {
 
 func (x *ValidationResult) Reset() {
 	*x = ValidationResult{}
-	mi := &file_logistics_common_v1_common_proto_msgTypes[10]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1108,7 +1702,7 @@ func (x *ValidationResult) String() string {
 func (*ValidationResult) ProtoMessage() {}
 
 func (x *ValidationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_common_v1_common_proto_msgTypes[10]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,7 +1715,7 @@ func (x *ValidationResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidationResult.ProtoReflect.Descriptor instead.
 func (*ValidationResult) Descriptor() ([]byte, []int) {
-	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{10}
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ValidationResult) GetIsValid() bool {
@@ -1151,7 +1745,7 @@ type ErrorDetail struct {
 
 func (x *ErrorDetail) Reset() {
 	*x = ErrorDetail{}
-	mi := &file_logistics_common_v1_common_proto_msgTypes[11]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1163,7 +1757,7 @@ func (x *ErrorDetail) String() string {
 func (*ErrorDetail) ProtoMessage() {}
 
 func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_common_v1_common_proto_msgTypes[11]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1176,7 +1770,7 @@ func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
 func (*ErrorDetail) Descriptor() ([]byte, []int) {
-	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{11}
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ErrorDetail) GetCode() string {
@@ -1217,7 +1811,7 @@ type PaginationRequest struct {
 
 func (x *PaginationRequest) Reset() {
 	*x = PaginationRequest{}
-	mi := &file_logistics_common_v1_common_proto_msgTypes[12]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1229,7 +1823,7 @@ func (x *PaginationRequest) String() string {
 func (*PaginationRequest) ProtoMessage() {}
 
 func (x *PaginationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_common_v1_common_proto_msgTypes[12]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,7 +1836,7 @@ func (x *PaginationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationRequest.ProtoReflect.Descriptor instead.
 func (*PaginationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{12}
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PaginationRequest) GetPage() int32 {
@@ -1273,7 +1867,7 @@ type PaginationResponse struct {
 
 func (x *PaginationResponse) Reset() {
 	*x = PaginationResponse{}
-	mi := &file_logistics_common_v1_common_proto_msgTypes[13]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1285,7 +1879,7 @@ func (x *PaginationResponse) String() string {
 func (*PaginationResponse) ProtoMessage() {}
 
 func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_common_v1_common_proto_msgTypes[13]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1892,7 @@ func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationResponse.ProtoReflect.Descriptor instead.
 func (*PaginationResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{13}
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PaginationResponse) GetCurrentPage() int32 {
@@ -1353,7 +1947,7 @@ type TimeRange struct {
 
 func (x *TimeRange) Reset() {
 	*x = TimeRange{}
-	mi := &file_logistics_common_v1_common_proto_msgTypes[14]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1365,7 +1959,7 @@ func (x *TimeRange) String() string {
 func (*TimeRange) ProtoMessage() {}
 
 func (x *TimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_common_v1_common_proto_msgTypes[14]
+	mi := &file_logistics_common_v1_common_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +1972,7 @@ func (x *TimeRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
 func (*TimeRange) Descriptor() ([]byte, []int) {
-	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{14}
+	return file_logistics_common_v1_common_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TimeRange) GetStartTimestamp() int64 {
@@ -1399,10 +1993,11 @@ var File_logistics_common_v1_common_proto protoreflect.FileDescriptor
 
 const file_logistics_common_v1_common_proto_rawDesc = "" +
 	"\n" +
-	" logistics/common/v1/common.proto\x12\x13logistics.common.v1\"-\n" +
+	" logistics/common/v1/common.proto\x12\x13logistics.common.v1\"F\n" +
 	"\aEdgeKey\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
-	"\x02to\x18\x02 \x01(\x03R\x02to\"\xab\x02\n" +
+	"\x02to\x18\x02 \x01(\x03R\x02to\x12\x17\n" +
+	"\aedge_id\x18\x03 \x01(\x03R\x06edgeId\"\xd6\x02\n" +
 	"\x04Node\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\f\n" +
 	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
@@ -1411,10 +2006,11 @@ const file_logistics_common_v1_common_proto_rawDesc = "" +
 	"\x04name\x18\x05 \x01(\tR\x04name\x12C\n" +
 	"\bmetadata\x18\x06 \x03(\v2'.logistics.common.v1.Node.MetadataEntryR\bmetadata\x12\x16\n" +
 	"\x06supply\x18\a \x01(\x01R\x06supply\x12\x16\n" +
-	"\x06demand\x18\b \x01(\x01R\x06demand\x1a;\n" +
+	"\x06demand\x18\b \x01(\x01R\x06demand\x12)\n" +
+	"\x10storage_capacity\x18\t \x01(\x01R\x0fstorageCapacity\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf7\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa2\x02\n" +
 	"\x04Edge\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\x03R\x02to\x12\x1a\n" +
@@ -1423,7 +2019,10 @@ const file_logistics_common_v1_common_proto_rawDesc = "" +
 	"\x06length\x18\x05 \x01(\x01R\x06length\x12:\n" +
 	"\troad_type\x18\x06 \x01(\x0e2\x1d.logistics.common.v1.RoadTypeR\broadType\x12!\n" +
 	"\fcurrent_flow\x18\a \x01(\x01R\vcurrentFlow\x12$\n" +
-	"\rbidirectional\x18\b \x01(\bR\rbidirectional\"\xb6\x02\n" +
+	"\rbidirectional\x18\b \x01(\bR\rbidirectional\x12\x19\n" +
+	"\bmin_flow\x18\t \x01(\x01R\aminFlow\x12\x0e\n" +
+	"\x02id\x18\n" +
+	" \x01(\x03R\x02id\"\xb6\x02\n" +
 	"\x05Graph\x12/\n" +
 	"\x05nodes\x18\x01 \x03(\v2\x19.logistics.common.v1.NodeR\x05nodes\x12/\n" +
 	"\x05edges\x18\x02 \x03(\v2\x19.logistics.common.v1.EdgeR\x05edges\x12\x1b\n" +
@@ -1438,14 +2037,15 @@ const file_logistics_common_v1_common_proto_rawDesc = "" +
 	"\bnode_ids\x18\x01 \x03(\x03R\anodeIds\x12\x12\n" +
 	"\x04flow\x18\x02 \x01(\x01R\x04flow\x12\x12\n" +
 	"\x04cost\x18\x03 \x01(\x01R\x04cost\x12\x16\n" +
-	"\x06length\x18\x04 \x01(\x01R\x06length\"\x94\x01\n" +
+	"\x06length\x18\x04 \x01(\x01R\x06length\"\xad\x01\n" +
 	"\bFlowEdge\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\x03R\x02to\x12\x12\n" +
 	"\x04flow\x18\x03 \x01(\x01R\x04flow\x12\x1a\n" +
 	"\bcapacity\x18\x04 \x01(\x01R\bcapacity\x12\x12\n" +
 	"\x04cost\x18\x05 \x01(\x01R\x04cost\x12 \n" +
-	"\vutilization\x18\x06 \x01(\x01R\vutilization\"\xda\x02\n" +
+	"\vutilization\x18\x06 \x01(\x01R\vutilization\x12\x17\n" +
+	"\aedge_id\x18\a \x01(\x03R\x06edgeId\"\xe8\x06\n" +
 	"\n" +
 	"FlowResult\x12\x19\n" +
 	"\bmax_flow\x18\x01 \x01(\x01R\amaxFlow\x12\x1d\n" +
@@ -1458,7 +2058,52 @@ const file_logistics_common_v1_common_proto_rawDesc = "" +
 	"iterations\x18\x06 \x01(\x05R\n" +
 	"iterations\x12.\n" +
 	"\x13computation_time_ms\x18\a \x01(\x01R\x11computationTimeMs\x12#\n" +
-	"\rerror_message\x18\b \x01(\tR\ferrorMessage\"\xbe\x02\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12I\n" +
+	"\x0fsource_balances\x18\t \x03(\v2 .logistics.common.v1.NodeBalanceR\x0esourceBalances\x12E\n" +
+	"\rsink_balances\x18\n" +
+	" \x03(\v2 .logistics.common.v1.NodeBalanceR\fsinkBalances\x12I\n" +
+	"\runmet_demands\x18\v \x03(\v2$.logistics.common.v1.DemandShortfallR\funmetDemands\x12K\n" +
+	"\x0fnode_potentials\x18\f \x03(\v2\".logistics.common.v1.NodePotentialR\x0enodePotentials\x12^\n" +
+	"\x16lower_bound_violations\x18\r \x03(\v2(.logistics.common.v1.LowerBoundViolationR\x14lowerBoundViolations\x124\n" +
+	"\amin_cut\x18\x0e \x01(\v2\x1b.logistics.common.v1.MinCutR\x06minCut\x12L\n" +
+	"\rdecomposition\x18\x0f \x01(\v2&.logistics.common.v1.FlowDecompositionR\rdecomposition\"w\n" +
+	"\x11FlowDecomposition\x12/\n" +
+	"\x05paths\x18\x01 \x03(\v2\x19.logistics.common.v1.PathR\x05paths\x121\n" +
+	"\x06cycles\x18\x02 \x03(\v2\x19.logistics.common.v1.PathR\x06cycles\"\xd4\x01\n" +
+	"\vNodeBalance\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12\x16\n" +
+	"\x06supply\x18\x02 \x01(\x01R\x06supply\x12\x16\n" +
+	"\x06demand\x18\x03 \x01(\x01R\x06demand\x12\x18\n" +
+	"\ashipped\x18\x04 \x01(\x01R\ashipped\x12\x1a\n" +
+	"\breceived\x18\x05 \x01(\x01R\breceived\x12!\n" +
+	"\funmet_demand\x18\x06 \x01(\x01R\vunmetDemand\x12#\n" +
+	"\runused_supply\x18\a \x01(\x01R\funusedSupply\"|\n" +
+	"\x0fDemandShortfall\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12\x16\n" +
+	"\x06demand\x18\x02 \x01(\x01R\x06demand\x12\x1a\n" +
+	"\breceived\x18\x03 \x01(\x01R\breceived\x12\x1c\n" +
+	"\tshortfall\x18\x04 \x01(\x01R\tshortfall\"l\n" +
+	"\x13LowerBoundViolation\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12\x1c\n" +
+	"\timbalance\x18\x02 \x01(\x01R\timbalance\x12\x1e\n" +
+	"\n" +
+	"unresolved\x18\x03 \x01(\x01R\n" +
+	"unresolved\"\xcf\x01\n" +
+	"\x06MinCut\x12\x1f\n" +
+	"\vsource_side\x18\x01 \x03(\x03R\n" +
+	"sourceSide\x122\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1c.logistics.common.v1.CutEdgeR\x05edges\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12-\n" +
+	"\x12saturated_supplies\x18\x04 \x03(\x03R\x11saturatedSupplies\x12+\n" +
+	"\x11saturated_demands\x18\x05 \x03(\x03R\x10saturatedDemands\"b\n" +
+	"\aCutEdge\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x03R\x02to\x12\x17\n" +
+	"\aedge_id\x18\x03 \x01(\x03R\x06edgeId\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x01R\bcapacity\"F\n" +
+	"\rNodePotential\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12\x1c\n" +
+	"\tpotential\x18\x02 \x01(\x01R\tpotential\"\xbe\x02\n" +
 	"\x0fGraphStatistics\x12\x1d\n" +
 	"\n" +
 	"node_count\x18\x01 \x01(\x03R\tnodeCount\x12\x1d\n" +
@@ -1508,14 +2153,15 @@ const file_logistics_common_v1_common_proto_rawDesc = "" +
 	"\fhas_previous\x18\x06 \x01(\bR\vhasPrevious\"Y\n" +
 	"\tTimeRange\x12'\n" +
 	"\x0fstart_timestamp\x18\x01 \x01(\x03R\x0estartTimestamp\x12#\n" +
-	"\rend_timestamp\x18\x02 \x01(\x03R\fendTimestamp*\xa9\x01\n" +
+	"\rend_timestamp\x18\x02 \x01(\x03R\fendTimestamp*\xc8\x01\n" +
 	"\tAlgorithm\x12\x19\n" +
 	"\x15ALGORITHM_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16ALGORITHM_EDMONDS_KARP\x10\x01\x12\x13\n" +
 	"\x0fALGORITHM_DINIC\x10\x02\x12\x16\n" +
 	"\x12ALGORITHM_MIN_COST\x10\x03\x12\x1a\n" +
 	"\x16ALGORITHM_PUSH_RELABEL\x10\x04\x12\x1c\n" +
-	"\x18ALGORITHM_FORD_FULKERSON\x10\x05*\xa2\x01\n" +
+	"\x18ALGORITHM_FORD_FULKERSON\x10\x05\x12\x1d\n" +
+	"\x19ALGORITHM_NETWORK_SIMPLEX\x10\x06*\xa2\x01\n" +
 	"\bNodeType\x12\x19\n" +
 	"\x15NODE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13NODE_TYPE_WAREHOUSE\x10\x01\x12\x1c\n" +
@@ -1553,49 +2199,66 @@ func file_logistics_common_v1_common_proto_rawDescGZIP() []byte {
 }
 
 var file_logistics_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_logistics_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_logistics_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_logistics_common_v1_common_proto_goTypes = []any{
-	(Algorithm)(0),             // 0: logistics.common.v1.Algorithm
-	(NodeType)(0),              // 1: logistics.common.v1.NodeType
-	(RoadType)(0),              // 2: logistics.common.v1.RoadType
-	(FlowStatus)(0),            // 3: logistics.common.v1.FlowStatus
-	(*EdgeKey)(nil),            // 4: logistics.common.v1.EdgeKey
-	(*Node)(nil),               // 5: logistics.common.v1.Node
-	(*Edge)(nil),               // 6: logistics.common.v1.Edge
-	(*Graph)(nil),              // 7: logistics.common.v1.Graph
-	(*Path)(nil),               // 8: logistics.common.v1.Path
-	(*FlowEdge)(nil),           // 9: logistics.common.v1.FlowEdge
-	(*FlowResult)(nil),         // 10: logistics.common.v1.FlowResult
-	(*GraphStatistics)(nil),    // 11: logistics.common.v1.GraphStatistics
-	(*FlowStatistics)(nil),     // 12: logistics.common.v1.FlowStatistics
-	(*ValidationError)(nil),    // 13: logistics.common.v1.ValidationError
-	(*ValidationResult)(nil),   // 14: logistics.common.v1.ValidationResult
-	(*ErrorDetail)(nil),        // 15: logistics.common.v1.ErrorDetail
-	(*PaginationRequest)(nil),  // 16: logistics.common.v1.PaginationRequest
-	(*PaginationResponse)(nil), // 17: logistics.common.v1.PaginationResponse
-	(*TimeRange)(nil),          // 18: logistics.common.v1.TimeRange
-	nil,                        // 19: logistics.common.v1.Node.MetadataEntry
-	nil,                        // 20: logistics.common.v1.Graph.MetadataEntry
-	nil,                        // 21: logistics.common.v1.ErrorDetail.MetadataEntry
+	(Algorithm)(0),              // 0: logistics.common.v1.Algorithm
+	(NodeType)(0),               // 1: logistics.common.v1.NodeType
+	(RoadType)(0),               // 2: logistics.common.v1.RoadType
+	(FlowStatus)(0),             // 3: logistics.common.v1.FlowStatus
+	(*EdgeKey)(nil),             // 4: logistics.common.v1.EdgeKey
+	(*Node)(nil),                // 5: logistics.common.v1.Node
+	(*Edge)(nil),                // 6: logistics.common.v1.Edge
+	(*Graph)(nil),               // 7: logistics.common.v1.Graph
+	(*Path)(nil),                // 8: logistics.common.v1.Path
+	(*FlowEdge)(nil),            // 9: logistics.common.v1.FlowEdge
+	(*FlowResult)(nil),          // 10: logistics.common.v1.FlowResult
+	(*FlowDecomposition)(nil),   // 11: logistics.common.v1.FlowDecomposition
+	(*NodeBalance)(nil),         // 12: logistics.common.v1.NodeBalance
+	(*DemandShortfall)(nil),     // 13: logistics.common.v1.DemandShortfall
+	(*LowerBoundViolation)(nil), // 14: logistics.common.v1.LowerBoundViolation
+	(*MinCut)(nil),              // 15: logistics.common.v1.MinCut
+	(*CutEdge)(nil),             // 16: logistics.common.v1.CutEdge
+	(*NodePotential)(nil),       // 17: logistics.common.v1.NodePotential
+	(*GraphStatistics)(nil),     // 18: logistics.common.v1.GraphStatistics
+	(*FlowStatistics)(nil),      // 19: logistics.common.v1.FlowStatistics
+	(*ValidationError)(nil),     // 20: logistics.common.v1.ValidationError
+	(*ValidationResult)(nil),    // 21: logistics.common.v1.ValidationResult
+	(*ErrorDetail)(nil),         // 22: logistics.common.v1.ErrorDetail
+	(*PaginationRequest)(nil),   // 23: logistics.common.v1.PaginationRequest
+	(*PaginationResponse)(nil),  // 24: logistics.common.v1.PaginationResponse
+	(*TimeRange)(nil),           // 25: logistics.common.v1.TimeRange
+	nil,                         // 26: logistics.common.v1.Node.MetadataEntry
+	nil,                         // 27: logistics.common.v1.Graph.MetadataEntry
+	nil,                         // 28: logistics.common.v1.ErrorDetail.MetadataEntry
 }
 var file_logistics_common_v1_common_proto_depIdxs = []int32{
 	1,  // 0: logistics.common.v1.Node.type:type_name -> logistics.common.v1.NodeType
-	19, // 1: logistics.common.v1.Node.metadata:type_name -> logistics.common.v1.Node.MetadataEntry
+	26, // 1: logistics.common.v1.Node.metadata:type_name -> logistics.common.v1.Node.MetadataEntry
 	2,  // 2: logistics.common.v1.Edge.road_type:type_name -> logistics.common.v1.RoadType
 	5,  // 3: logistics.common.v1.Graph.nodes:type_name -> logistics.common.v1.Node
 	6,  // 4: logistics.common.v1.Graph.edges:type_name -> logistics.common.v1.Edge
-	20, // 5: logistics.common.v1.Graph.metadata:type_name -> logistics.common.v1.Graph.MetadataEntry
+	27, // 5: logistics.common.v1.Graph.metadata:type_name -> logistics.common.v1.Graph.MetadataEntry
 	9,  // 6: logistics.common.v1.FlowResult.edges:type_name -> logistics.common.v1.FlowEdge
 	8,  // 7: logistics.common.v1.FlowResult.paths:type_name -> logistics.common.v1.Path
 	3,  // 8: logistics.common.v1.FlowResult.status:type_name -> logistics.common.v1.FlowStatus
-	4,  // 9: logistics.common.v1.FlowStatistics.bottlenecks:type_name -> logistics.common.v1.EdgeKey
-	13, // 10: logistics.common.v1.ValidationResult.errors:type_name -> logistics.common.v1.ValidationError
-	21, // 11: logistics.common.v1.ErrorDetail.metadata:type_name -> logistics.common.v1.ErrorDetail.MetadataEntry
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	12, // 9: logistics.common.v1.FlowResult.source_balances:type_name -> logistics.common.v1.NodeBalance
+	12, // 10: logistics.common.v1.FlowResult.sink_balances:type_name -> logistics.common.v1.NodeBalance
+	13, // 11: logistics.common.v1.FlowResult.unmet_demands:type_name -> logistics.common.v1.DemandShortfall
+	17, // 12: logistics.common.v1.FlowResult.node_potentials:type_name -> logistics.common.v1.NodePotential
+	14, // 13: logistics.common.v1.FlowResult.lower_bound_violations:type_name -> logistics.common.v1.LowerBoundViolation
+	15, // 14: logistics.common.v1.FlowResult.min_cut:type_name -> logistics.common.v1.MinCut
+	11, // 15: logistics.common.v1.FlowResult.decomposition:type_name -> logistics.common.v1.FlowDecomposition
+	8,  // 16: logistics.common.v1.FlowDecomposition.paths:type_name -> logistics.common.v1.Path
+	8,  // 17: logistics.common.v1.FlowDecomposition.cycles:type_name -> logistics.common.v1.Path
+	16, // 18: logistics.common.v1.MinCut.edges:type_name -> logistics.common.v1.CutEdge
+	4,  // 19: logistics.common.v1.FlowStatistics.bottlenecks:type_name -> logistics.common.v1.EdgeKey
+	20, // 20: logistics.common.v1.ValidationResult.errors:type_name -> logistics.common.v1.ValidationError
+	28, // 21: logistics.common.v1.ErrorDetail.metadata:type_name -> logistics.common.v1.ErrorDetail.MetadataEntry
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_logistics_common_v1_common_proto_init() }
@@ -1609,7 +2272,7 @@ func file_logistics_common_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_logistics_common_v1_common_proto_rawDesc), len(file_logistics_common_v1_common_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   18,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
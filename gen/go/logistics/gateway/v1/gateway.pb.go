@@ -24,6 +24,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SolveMode int32
+
+const (
+	SolveMode_SOLVE_MODE_UNSPECIFIED    SolveMode = 0
+	SolveMode_SOLVE_MODE_MAX_FLOW       SolveMode = 1
+	SolveMode_SOLVE_MODE_TRANSPORTATION SolveMode = 2 // supply/demand как жёсткие ограничения, минимальная стоимость
+)
+
+// Enum value maps for SolveMode.
+var (
+	SolveMode_name = map[int32]string{
+		0: "SOLVE_MODE_UNSPECIFIED",
+		1: "SOLVE_MODE_MAX_FLOW",
+		2: "SOLVE_MODE_TRANSPORTATION",
+	}
+	SolveMode_value = map[string]int32{
+		"SOLVE_MODE_UNSPECIFIED":    0,
+		"SOLVE_MODE_MAX_FLOW":       1,
+		"SOLVE_MODE_TRANSPORTATION": 2,
+	}
+)
+
+func (x SolveMode) Enum() *SolveMode {
+	p := new(SolveMode)
+	*p = x
+	return p
+}
+
+func (x SolveMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SolveMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[0].Descriptor()
+}
+
+func (SolveMode) Type() protoreflect.EnumType {
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[0]
+}
+
+func (x SolveMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SolveMode.Descriptor instead.
+func (SolveMode) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{0}
+}
+
 type ValidationLevel int32
 
 const (
@@ -63,11 +112,11 @@ func (x ValidationLevel) String() string {
 }
 
 func (ValidationLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_gateway_v1_gateway_proto_enumTypes[0].Descriptor()
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[1].Descriptor()
 }
 
 func (ValidationLevel) Type() protoreflect.EnumType {
-	return &file_logistics_gateway_v1_gateway_proto_enumTypes[0]
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[1]
 }
 
 func (x ValidationLevel) Number() protoreflect.EnumNumber {
@@ -76,7 +125,7 @@ func (x ValidationLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ValidationLevel.Descriptor instead.
 func (ValidationLevel) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{0}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{1}
 }
 
 type BottleneckSeverity int32
@@ -118,11 +167,11 @@ func (x BottleneckSeverity) String() string {
 }
 
 func (BottleneckSeverity) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_gateway_v1_gateway_proto_enumTypes[1].Descriptor()
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[2].Descriptor()
 }
 
 func (BottleneckSeverity) Type() protoreflect.EnumType {
-	return &file_logistics_gateway_v1_gateway_proto_enumTypes[1]
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[2]
 }
 
 func (x BottleneckSeverity) Number() protoreflect.EnumNumber {
@@ -131,7 +180,7 @@ func (x BottleneckSeverity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BottleneckSeverity.Descriptor instead.
 func (BottleneckSeverity) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{1}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
 }
 
 type ModificationType int32
@@ -176,11 +225,11 @@ func (x ModificationType) String() string {
 }
 
 func (ModificationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_gateway_v1_gateway_proto_enumTypes[2].Descriptor()
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[3].Descriptor()
 }
 
 func (ModificationType) Type() protoreflect.EnumType {
-	return &file_logistics_gateway_v1_gateway_proto_enumTypes[2]
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[3]
 }
 
 func (x ModificationType) Number() protoreflect.EnumNumber {
@@ -189,7 +238,7 @@ func (x ModificationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModificationType.Descriptor instead.
 func (ModificationType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
 }
 
 type ModificationTarget int32
@@ -228,11 +277,11 @@ func (x ModificationTarget) String() string {
 }
 
 func (ModificationTarget) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_gateway_v1_gateway_proto_enumTypes[3].Descriptor()
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[4].Descriptor()
 }
 
 func (ModificationTarget) Type() protoreflect.EnumType {
-	return &file_logistics_gateway_v1_gateway_proto_enumTypes[3]
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[4]
 }
 
 func (x ModificationTarget) Number() protoreflect.EnumNumber {
@@ -241,7 +290,7 @@ func (x ModificationTarget) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModificationTarget.Descriptor instead.
 func (ModificationTarget) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
 }
 
 type ImpactLevel int32
@@ -286,11 +335,11 @@ func (x ImpactLevel) String() string {
 }
 
 func (ImpactLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_gateway_v1_gateway_proto_enumTypes[4].Descriptor()
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[5].Descriptor()
 }
 
 func (ImpactLevel) Type() protoreflect.EnumType {
-	return &file_logistics_gateway_v1_gateway_proto_enumTypes[4]
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[5]
 }
 
 func (x ImpactLevel) Number() protoreflect.EnumNumber {
@@ -299,7 +348,169 @@ func (x ImpactLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ImpactLevel.Descriptor instead.
 func (ImpactLevel) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{5}
+}
+
+type CorrelationMeasure int32
+
+const (
+	CorrelationMeasure_CORRELATION_MEASURE_UNSPECIFIED CorrelationMeasure = 0 // Same as CORRELATION_MEASURE_GAUSSIAN
+	CorrelationMeasure_CORRELATION_MEASURE_GAUSSIAN    CorrelationMeasure = 1
+	CorrelationMeasure_CORRELATION_MEASURE_SPEARMAN    CorrelationMeasure = 2
+	CorrelationMeasure_CORRELATION_MEASURE_KENDALL     CorrelationMeasure = 3
+)
+
+// Enum value maps for CorrelationMeasure.
+var (
+	CorrelationMeasure_name = map[int32]string{
+		0: "CORRELATION_MEASURE_UNSPECIFIED",
+		1: "CORRELATION_MEASURE_GAUSSIAN",
+		2: "CORRELATION_MEASURE_SPEARMAN",
+		3: "CORRELATION_MEASURE_KENDALL",
+	}
+	CorrelationMeasure_value = map[string]int32{
+		"CORRELATION_MEASURE_UNSPECIFIED": 0,
+		"CORRELATION_MEASURE_GAUSSIAN":    1,
+		"CORRELATION_MEASURE_SPEARMAN":    2,
+		"CORRELATION_MEASURE_KENDALL":     3,
+	}
+)
+
+func (x CorrelationMeasure) Enum() *CorrelationMeasure {
+	p := new(CorrelationMeasure)
+	*p = x
+	return p
+}
+
+func (x CorrelationMeasure) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CorrelationMeasure) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[6].Descriptor()
+}
+
+func (CorrelationMeasure) Type() protoreflect.EnumType {
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[6]
+}
+
+func (x CorrelationMeasure) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CorrelationMeasure.Descriptor instead.
+func (CorrelationMeasure) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
+}
+
+type MonteCarloStopReason int32
+
+const (
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_UNSPECIFIED MonteCarloStopReason = 0
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_COMPLETED   MonteCarloStopReason = 1
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CONVERGED   MonteCarloStopReason = 2
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_TIME_BUDGET MonteCarloStopReason = 3
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CANCELED    MonteCarloStopReason = 4
+)
+
+// Enum value maps for MonteCarloStopReason.
+var (
+	MonteCarloStopReason_name = map[int32]string{
+		0: "MONTE_CARLO_STOP_REASON_UNSPECIFIED",
+		1: "MONTE_CARLO_STOP_REASON_COMPLETED",
+		2: "MONTE_CARLO_STOP_REASON_CONVERGED",
+		3: "MONTE_CARLO_STOP_REASON_TIME_BUDGET",
+		4: "MONTE_CARLO_STOP_REASON_CANCELED",
+	}
+	MonteCarloStopReason_value = map[string]int32{
+		"MONTE_CARLO_STOP_REASON_UNSPECIFIED": 0,
+		"MONTE_CARLO_STOP_REASON_COMPLETED":   1,
+		"MONTE_CARLO_STOP_REASON_CONVERGED":   2,
+		"MONTE_CARLO_STOP_REASON_TIME_BUDGET": 3,
+		"MONTE_CARLO_STOP_REASON_CANCELED":    4,
+	}
+)
+
+func (x MonteCarloStopReason) Enum() *MonteCarloStopReason {
+	p := new(MonteCarloStopReason)
+	*p = x
+	return p
+}
+
+func (x MonteCarloStopReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MonteCarloStopReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[7].Descriptor()
+}
+
+func (MonteCarloStopReason) Type() protoreflect.EnumType {
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[7]
+}
+
+func (x MonteCarloStopReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MonteCarloStopReason.Descriptor instead.
+func (MonteCarloStopReason) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{7}
+}
+
+type SamplingMethod int32
+
+const (
+	SamplingMethod_SAMPLING_METHOD_UNSPECIFIED     SamplingMethod = 0 // Same as SAMPLING_METHOD_RANDOM
+	SamplingMethod_SAMPLING_METHOD_RANDOM          SamplingMethod = 1
+	SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE SamplingMethod = 2
+	SamplingMethod_SAMPLING_METHOD_SOBOL           SamplingMethod = 3
+	SamplingMethod_SAMPLING_METHOD_ANTITHETIC      SamplingMethod = 4
+)
+
+// Enum value maps for SamplingMethod.
+var (
+	SamplingMethod_name = map[int32]string{
+		0: "SAMPLING_METHOD_UNSPECIFIED",
+		1: "SAMPLING_METHOD_RANDOM",
+		2: "SAMPLING_METHOD_LATIN_HYPERCUBE",
+		3: "SAMPLING_METHOD_SOBOL",
+		4: "SAMPLING_METHOD_ANTITHETIC",
+	}
+	SamplingMethod_value = map[string]int32{
+		"SAMPLING_METHOD_UNSPECIFIED":     0,
+		"SAMPLING_METHOD_RANDOM":          1,
+		"SAMPLING_METHOD_LATIN_HYPERCUBE": 2,
+		"SAMPLING_METHOD_SOBOL":           3,
+		"SAMPLING_METHOD_ANTITHETIC":      4,
+	}
+)
+
+func (x SamplingMethod) Enum() *SamplingMethod {
+	p := new(SamplingMethod)
+	*p = x
+	return p
+}
+
+func (x SamplingMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SamplingMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[8].Descriptor()
+}
+
+func (SamplingMethod) Type() protoreflect.EnumType {
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[8]
+}
+
+func (x SamplingMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SamplingMethod.Descriptor instead.
+func (SamplingMethod) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{8}
 }
 
 type DistributionType int32
@@ -338,11 +549,11 @@ func (x DistributionType) String() string {
 }
 
 func (DistributionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_gateway_v1_gateway_proto_enumTypes[5].Descriptor()
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[9].Descriptor()
 }
 
 func (DistributionType) Type() protoreflect.EnumType {
-	return &file_logistics_gateway_v1_gateway_proto_enumTypes[5]
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[9]
 }
 
 func (x DistributionType) Number() protoreflect.EnumNumber {
@@ -351,7 +562,59 @@ func (x DistributionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DistributionType.Descriptor instead.
 func (DistributionType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{5}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{9}
+}
+
+type SensitivityMethod int32
+
+const (
+	SensitivityMethod_SENSITIVITY_METHOD_UNSPECIFIED   SensitivityMethod = 0 // Same as SENSITIVITY_METHOD_ONE_AT_A_TIME
+	SensitivityMethod_SENSITIVITY_METHOD_ONE_AT_A_TIME SensitivityMethod = 1
+	SensitivityMethod_SENSITIVITY_METHOD_MORRIS        SensitivityMethod = 2 // Elementary effects screening
+	SensitivityMethod_SENSITIVITY_METHOD_SOBOL         SensitivityMethod = 3 // Variance-based first- and total-order indices
+)
+
+// Enum value maps for SensitivityMethod.
+var (
+	SensitivityMethod_name = map[int32]string{
+		0: "SENSITIVITY_METHOD_UNSPECIFIED",
+		1: "SENSITIVITY_METHOD_ONE_AT_A_TIME",
+		2: "SENSITIVITY_METHOD_MORRIS",
+		3: "SENSITIVITY_METHOD_SOBOL",
+	}
+	SensitivityMethod_value = map[string]int32{
+		"SENSITIVITY_METHOD_UNSPECIFIED":   0,
+		"SENSITIVITY_METHOD_ONE_AT_A_TIME": 1,
+		"SENSITIVITY_METHOD_MORRIS":        2,
+		"SENSITIVITY_METHOD_SOBOL":         3,
+	}
+)
+
+func (x SensitivityMethod) Enum() *SensitivityMethod {
+	p := new(SensitivityMethod)
+	*p = x
+	return p
+}
+
+func (x SensitivityMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SensitivityMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[10].Descriptor()
+}
+
+func (SensitivityMethod) Type() protoreflect.EnumType {
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[10]
+}
+
+func (x SensitivityMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SensitivityMethod.Descriptor instead.
+func (SensitivityMethod) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{10}
 }
 
 type ReportFormat int32
@@ -399,11 +662,11 @@ func (x ReportFormat) String() string {
 }
 
 func (ReportFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_gateway_v1_gateway_proto_enumTypes[6].Descriptor()
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[11].Descriptor()
 }
 
 func (ReportFormat) Type() protoreflect.EnumType {
-	return &file_logistics_gateway_v1_gateway_proto_enumTypes[6]
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[11]
 }
 
 func (x ReportFormat) Number() protoreflect.EnumNumber {
@@ -412,7 +675,7 @@ func (x ReportFormat) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReportFormat.Descriptor instead.
 func (ReportFormat) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
 }
 
 type ReportType int32
@@ -460,11 +723,11 @@ func (x ReportType) String() string {
 }
 
 func (ReportType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_gateway_v1_gateway_proto_enumTypes[7].Descriptor()
+	return file_logistics_gateway_v1_gateway_proto_enumTypes[12].Descriptor()
 }
 
 func (ReportType) Type() protoreflect.EnumType {
-	return &file_logistics_gateway_v1_gateway_proto_enumTypes[7]
+	return &file_logistics_gateway_v1_gateway_proto_enumTypes[12]
 }
 
 func (x ReportType) Number() protoreflect.EnumNumber {
@@ -473,7 +736,7 @@ func (x ReportType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReportType.Descriptor instead.
 func (ReportType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{7}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{12}
 }
 
 type HealthResponse struct {
@@ -2236,13 +2499,15 @@ func (x *BatchSolveResult) GetErrorMessage() string {
 }
 
 type SolveOptions struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TimeoutSeconds float64                `protobuf:"fixed64,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
-	ReturnPaths    bool                   `protobuf:"varint,2,opt,name=return_paths,json=returnPaths,proto3" json:"return_paths,omitempty"`
-	MaxIterations  int32                  `protobuf:"varint,3,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
-	Epsilon        float64                `protobuf:"fixed64,4,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TimeoutSeconds      float64                `protobuf:"fixed64,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	ReturnPaths         bool                   `protobuf:"varint,2,opt,name=return_paths,json=returnPaths,proto3" json:"return_paths,omitempty"`
+	MaxIterations       int32                  `protobuf:"varint,3,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	Epsilon             float64                `protobuf:"fixed64,4,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	Mode                SolveMode              `protobuf:"varint,5,opt,name=mode,proto3,enum=logistics.gateway.v1.SolveMode" json:"mode,omitempty"`
+	ReturnDecomposition bool                   `protobuf:"varint,6,opt,name=return_decomposition,json=returnDecomposition,proto3" json:"return_decomposition,omitempty"` // Flow decomposition into paths and cycles
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *SolveOptions) Reset() {
@@ -2303,6 +2568,20 @@ func (x *SolveOptions) GetEpsilon() float64 {
 	return 0
 }
 
+func (x *SolveOptions) GetMode() SolveMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SolveMode_SOLVE_MODE_UNSPECIFIED
+}
+
+func (x *SolveOptions) GetReturnDecomposition() bool {
+	if x != nil {
+		return x.ReturnDecomposition
+	}
+	return false
+}
+
 type SolveMetrics struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	ComputationTimeMs    float64                `protobuf:"fixed64,1,opt,name=computation_time_ms,json=computationTimeMs,proto3" json:"computation_time_ms,omitempty"`
@@ -3539,8 +3818,11 @@ type Bottleneck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Edge          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
 	Utilization   float64                `protobuf:"fixed64,2,opt,name=utilization,proto3" json:"utilization,omitempty"`
-	ImpactScore   float64                `protobuf:"fixed64,3,opt,name=impact_score,json=impactScore,proto3" json:"impact_score,omitempty"`
+	ImpactScore   float64                `protobuf:"fixed64,3,opt,name=impact_score,json=impactScore,proto3" json:"impact_score,omitempty"` // Relative gain from doubling the edge capacity
 	Severity      BottleneckSeverity     `protobuf:"varint,4,opt,name=severity,proto3,enum=logistics.gateway.v1.BottleneckSeverity" json:"severity,omitempty"`
+	InMinCut      bool                   `protobuf:"varint,5,opt,name=in_min_cut,json=inMinCut,proto3" json:"in_min_cut,omitempty"`
+	FlowGain      float64                `protobuf:"fixed64,6,opt,name=flow_gain,json=flowGain,proto3" json:"flow_gain,omitempty"`       // Max flow increase from doubling the edge capacity
+	CostSaving    float64                `protobuf:"fixed64,7,opt,name=cost_saving,json=costSaving,proto3" json:"cost_saving,omitempty"` // Cost reduction from doubling the edge capacity
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3603,6 +3885,27 @@ func (x *Bottleneck) GetSeverity() BottleneckSeverity {
 	return BottleneckSeverity_BOTTLENECK_SEVERITY_UNSPECIFIED
 }
 
+func (x *Bottleneck) GetInMinCut() bool {
+	if x != nil {
+		return x.InMinCut
+	}
+	return false
+}
+
+func (x *Bottleneck) GetFlowGain() float64 {
+	if x != nil {
+		return x.FlowGain
+	}
+	return 0
+}
+
+func (x *Bottleneck) GetCostSaving() float64 {
+	if x != nil {
+		return x.CostSaving
+	}
+	return 0
+}
+
 type Recommendation struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Type                 string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -4173,6 +4476,7 @@ type SolveResult struct {
 	ComputationTimeMs float64                `protobuf:"fixed64,6,opt,name=computation_time_ms,json=computationTimeMs,proto3" json:"computation_time_ms,omitempty"`
 	PathsFound        int32                  `protobuf:"varint,7,opt,name=paths_found,json=pathsFound,proto3" json:"paths_found,omitempty"`
 	Paths             []*v1.Path             `protobuf:"bytes,8,rep,name=paths,proto3" json:"paths,omitempty"`
+	Decomposition     *v1.FlowDecomposition  `protobuf:"bytes,9,opt,name=decomposition,proto3" json:"decomposition,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -4263,6 +4567,13 @@ func (x *SolveResult) GetPaths() []*v1.Path {
 	return nil
 }
 
+func (x *SolveResult) GetDecomposition() *v1.FlowDecomposition {
+	if x != nil {
+		return x.Decomposition
+	}
+	return nil
+}
+
 type WhatIfRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BaselineGraph *v1.Graph              `protobuf:"bytes,1,opt,name=baseline_graph,json=baselineGraph,proto3" json:"baseline_graph,omitempty"`
@@ -4668,11 +4979,12 @@ func (x *ScenarioComparison) GetImpactLevel() ImpactLevel {
 }
 
 type MonteCarloRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Graph         *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
-	Config        *MonteCarloConfig      `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	Uncertainties []*UncertaintySpec     `protobuf:"bytes,3,rep,name=uncertainties,proto3" json:"uncertainties,omitempty"`
-	Algorithm     v1.Algorithm           `protobuf:"varint,4,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Graph         *v1.Graph               `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Config        *MonteCarloConfig       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Uncertainties []*UncertaintySpec      `protobuf:"bytes,3,rep,name=uncertainties,proto3" json:"uncertainties,omitempty"`
+	Algorithm     v1.Algorithm            `protobuf:"varint,4,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	Correlation   *UncertaintyCorrelation `protobuf:"bytes,5,opt,name=correlation,proto3" json:"correlation,omitempty"` // Independent uncertainties when unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4735,30 +5047,37 @@ func (x *MonteCarloRequest) GetAlgorithm() v1.Algorithm {
 	return v1.Algorithm(0)
 }
 
-type MonteCarloConfig struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	NumIterations   int32                  `protobuf:"varint,1,opt,name=num_iterations,json=numIterations,proto3" json:"num_iterations,omitempty"`
-	RandomSeed      int64                  `protobuf:"varint,2,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`
-	ConfidenceLevel float64                `protobuf:"fixed64,3,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
-	Parallel        bool                   `protobuf:"varint,4,opt,name=parallel,proto3" json:"parallel,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *MonteCarloRequest) GetCorrelation() *UncertaintyCorrelation {
+	if x != nil {
+		return x.Correlation
+	}
+	return nil
 }
 
-func (x *MonteCarloConfig) Reset() {
-	*x = MonteCarloConfig{}
+// Gaussian copula between uncertainties; each marginal distribution is preserved
+type UncertaintyCorrelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Measure       CorrelationMeasure     `protobuf:"varint,1,opt,name=measure,proto3,enum=logistics.gateway.v1.CorrelationMeasure" json:"measure,omitempty"`
+	Matrix        []float64              `protobuf:"fixed64,2,rep,packed,name=matrix,proto3" json:"matrix,omitempty"` // Full n×n matrix, row-major, n = len(uncertainties)
+	Groups        []*CorrelationGroup    `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`          // Or groups with one pairwise correlation each
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UncertaintyCorrelation) Reset() {
+	*x = UncertaintyCorrelation{}
 	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonteCarloConfig) String() string {
+func (x *UncertaintyCorrelation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonteCarloConfig) ProtoMessage() {}
+func (*UncertaintyCorrelation) ProtoMessage() {}
 
-func (x *MonteCarloConfig) ProtoReflect() protoreflect.Message {
+func (x *UncertaintyCorrelation) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4770,44 +5089,199 @@ func (x *MonteCarloConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonteCarloConfig.ProtoReflect.Descriptor instead.
-func (*MonteCarloConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use UncertaintyCorrelation.ProtoReflect.Descriptor instead.
+func (*UncertaintyCorrelation) Descriptor() ([]byte, []int) {
 	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *MonteCarloConfig) GetNumIterations() int32 {
+func (x *UncertaintyCorrelation) GetMeasure() CorrelationMeasure {
 	if x != nil {
-		return x.NumIterations
+		return x.Measure
 	}
-	return 0
+	return CorrelationMeasure_CORRELATION_MEASURE_UNSPECIFIED
 }
 
-func (x *MonteCarloConfig) GetRandomSeed() int64 {
+func (x *UncertaintyCorrelation) GetMatrix() []float64 {
 	if x != nil {
-		return x.RandomSeed
+		return x.Matrix
 	}
-	return 0
+	return nil
 }
 
-func (x *MonteCarloConfig) GetConfidenceLevel() float64 {
+func (x *UncertaintyCorrelation) GetGroups() []*CorrelationGroup {
 	if x != nil {
-		return x.ConfidenceLevel
+		return x.Groups
 	}
-	return 0
+	return nil
 }
 
-func (x *MonteCarloConfig) GetParallel() bool {
-	if x != nil {
-		return x.Parallel
-	}
-	return false
+type CorrelationGroup struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UncertaintyIndices []int32                `protobuf:"varint,1,rep,packed,name=uncertainty_indices,json=uncertaintyIndices,proto3" json:"uncertainty_indices,omitempty"`
+	Correlation        float64                `protobuf:"fixed64,2,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-type UncertaintySpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Edge          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
-	NodeId        int64                  `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Target        ModificationTarget     `protobuf:"varint,3,opt,name=target,proto3,enum=logistics.gateway.v1.ModificationTarget" json:"target,omitempty"`
+func (x *CorrelationGroup) Reset() {
+	*x = CorrelationGroup{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrelationGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelationGroup) ProtoMessage() {}
+
+func (x *CorrelationGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelationGroup.ProtoReflect.Descriptor instead.
+func (*CorrelationGroup) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CorrelationGroup) GetUncertaintyIndices() []int32 {
+	if x != nil {
+		return x.UncertaintyIndices
+	}
+	return nil
+}
+
+func (x *CorrelationGroup) GetCorrelation() float64 {
+	if x != nil {
+		return x.Correlation
+	}
+	return 0
+}
+
+type MonteCarloConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NumIterations    int32                  `protobuf:"varint,1,opt,name=num_iterations,json=numIterations,proto3" json:"num_iterations,omitempty"`
+	RandomSeed       int64                  `protobuf:"varint,2,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`
+	ConfidenceLevel  float64                `protobuf:"fixed64,3,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Parallel         bool                   `protobuf:"varint,4,opt,name=parallel,proto3" json:"parallel,omitempty"`
+	ReturnSamples    bool                   `protobuf:"varint,5,opt,name=return_samples,json=returnSamples,proto3" json:"return_samples,omitempty"`                 // Return per-iteration samples
+	ReplayIterations []int32                `protobuf:"varint,6,rep,packed,name=replay_iterations,json=replayIterations,proto3" json:"replay_iterations,omitempty"` // Re-run only these iterations (requires random_seed)
+	SamplingMethod   SamplingMethod         `protobuf:"varint,7,opt,name=sampling_method,json=samplingMethod,proto3,enum=logistics.gateway.v1.SamplingMethod" json:"sampling_method,omitempty"`
+	// Stop once the relative CI half-width of both mean flow and mean cost is at most
+	// this value; num_iterations is the upper bound (0 = run all iterations)
+	TargetRelativeCiHalfWidth float64 `protobuf:"fixed64,8,opt,name=target_relative_ci_half_width,json=targetRelativeCiHalfWidth,proto3" json:"target_relative_ci_half_width,omitempty"`
+	TimeBudgetSeconds         float64 `protobuf:"fixed64,9,opt,name=time_budget_seconds,json=timeBudgetSeconds,proto3" json:"time_budget_seconds,omitempty"` // 0 = unlimited
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *MonteCarloConfig) Reset() {
+	*x = MonteCarloConfig{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonteCarloConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonteCarloConfig) ProtoMessage() {}
+
+func (x *MonteCarloConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonteCarloConfig.ProtoReflect.Descriptor instead.
+func (*MonteCarloConfig) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MonteCarloConfig) GetNumIterations() int32 {
+	if x != nil {
+		return x.NumIterations
+	}
+	return 0
+}
+
+func (x *MonteCarloConfig) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *MonteCarloConfig) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *MonteCarloConfig) GetParallel() bool {
+	if x != nil {
+		return x.Parallel
+	}
+	return false
+}
+
+func (x *MonteCarloConfig) GetReturnSamples() bool {
+	if x != nil {
+		return x.ReturnSamples
+	}
+	return false
+}
+
+func (x *MonteCarloConfig) GetReplayIterations() []int32 {
+	if x != nil {
+		return x.ReplayIterations
+	}
+	return nil
+}
+
+func (x *MonteCarloConfig) GetSamplingMethod() SamplingMethod {
+	if x != nil {
+		return x.SamplingMethod
+	}
+	return SamplingMethod_SAMPLING_METHOD_UNSPECIFIED
+}
+
+func (x *MonteCarloConfig) GetTargetRelativeCiHalfWidth() float64 {
+	if x != nil {
+		return x.TargetRelativeCiHalfWidth
+	}
+	return 0
+}
+
+func (x *MonteCarloConfig) GetTimeBudgetSeconds() float64 {
+	if x != nil {
+		return x.TimeBudgetSeconds
+	}
+	return 0
+}
+
+type UncertaintySpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edge          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	NodeId        int64                  `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Target        ModificationTarget     `protobuf:"varint,3,opt,name=target,proto3,enum=logistics.gateway.v1.ModificationTarget" json:"target,omitempty"`
 	Distribution  *Distribution          `protobuf:"bytes,4,opt,name=distribution,proto3" json:"distribution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -4815,7 +5289,7 @@ type UncertaintySpec struct {
 
 func (x *UncertaintySpec) Reset() {
 	*x = UncertaintySpec{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[59]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4827,7 +5301,7 @@ func (x *UncertaintySpec) String() string {
 func (*UncertaintySpec) ProtoMessage() {}
 
 func (x *UncertaintySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[59]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4840,7 +5314,7 @@ func (x *UncertaintySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UncertaintySpec.ProtoReflect.Descriptor instead.
 func (*UncertaintySpec) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{59}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *UncertaintySpec) GetEdge() *v1.EdgeKey {
@@ -4883,7 +5357,7 @@ type Distribution struct {
 
 func (x *Distribution) Reset() {
 	*x = Distribution{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[60]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4895,7 +5369,7 @@ func (x *Distribution) String() string {
 func (*Distribution) ProtoMessage() {}
 
 func (x *Distribution) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[60]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4908,7 +5382,7 @@ func (x *Distribution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Distribution.ProtoReflect.Descriptor instead.
 func (*Distribution) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{60}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *Distribution) GetType() DistributionType {
@@ -4940,20 +5414,24 @@ func (x *Distribution) GetParam3() float64 {
 }
 
 type MonteCarloResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	FlowStats     *MonteCarloStats       `protobuf:"bytes,2,opt,name=flow_stats,json=flowStats,proto3" json:"flow_stats,omitempty"`
-	CostStats     *MonteCarloStats       `protobuf:"bytes,3,opt,name=cost_stats,json=costStats,proto3" json:"cost_stats,omitempty"`
-	RiskAnalysis  *RiskAnalysis          `protobuf:"bytes,4,opt,name=risk_analysis,json=riskAnalysis,proto3" json:"risk_analysis,omitempty"`
-	Metadata      *SimulationMetadata    `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Success             bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	FlowStats           *MonteCarloStats       `protobuf:"bytes,2,opt,name=flow_stats,json=flowStats,proto3" json:"flow_stats,omitempty"`
+	CostStats           *MonteCarloStats       `protobuf:"bytes,3,opt,name=cost_stats,json=costStats,proto3" json:"cost_stats,omitempty"`
+	RiskAnalysis        *RiskAnalysis          `protobuf:"bytes,4,opt,name=risk_analysis,json=riskAnalysis,proto3" json:"risk_analysis,omitempty"`
+	Metadata            *SimulationMetadata    `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ErrorMessage        string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	RandomSeed          int64                  `protobuf:"varint,7,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"` // Seed used (generated when the request had 0)
+	Samples             []*MonteCarloSample    `protobuf:"bytes,8,rep,name=samples,proto3" json:"samples,omitempty"`                          // Only with return_samples
+	StopReason          MonteCarloStopReason   `protobuf:"varint,9,opt,name=stop_reason,json=stopReason,proto3,enum=logistics.gateway.v1.MonteCarloStopReason" json:"stop_reason,omitempty"`
+	CompletedIterations int32                  `protobuf:"varint,10,opt,name=completed_iterations,json=completedIterations,proto3" json:"completed_iterations,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *MonteCarloResponse) Reset() {
 	*x = MonteCarloResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[61]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4965,7 +5443,7 @@ func (x *MonteCarloResponse) String() string {
 func (*MonteCarloResponse) ProtoMessage() {}
 
 func (x *MonteCarloResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[61]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4978,7 +5456,7 @@ func (x *MonteCarloResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonteCarloResponse.ProtoReflect.Descriptor instead.
 func (*MonteCarloResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{61}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *MonteCarloResponse) GetSuccess() bool {
@@ -5023,6 +5501,110 @@ func (x *MonteCarloResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *MonteCarloResponse) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *MonteCarloResponse) GetSamples() []*MonteCarloSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *MonteCarloResponse) GetStopReason() MonteCarloStopReason {
+	if x != nil {
+		return x.StopReason
+	}
+	return MonteCarloStopReason_MONTE_CARLO_STOP_REASON_UNSPECIFIED
+}
+
+func (x *MonteCarloResponse) GetCompletedIterations() int32 {
+	if x != nil {
+		return x.CompletedIterations
+	}
+	return 0
+}
+
+type MonteCarloSample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iteration     int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Multipliers   []float64              `protobuf:"fixed64,2,rep,packed,name=multipliers,proto3" json:"multipliers,omitempty"` // In the order of the request uncertainties
+	Flow          float64                `protobuf:"fixed64,3,opt,name=flow,proto3" json:"flow,omitempty"`
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonteCarloSample) Reset() {
+	*x = MonteCarloSample{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonteCarloSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonteCarloSample) ProtoMessage() {}
+
+func (x *MonteCarloSample) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonteCarloSample.ProtoReflect.Descriptor instead.
+func (*MonteCarloSample) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MonteCarloSample) GetIteration() int32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *MonteCarloSample) GetMultipliers() []float64 {
+	if x != nil {
+		return x.Multipliers
+	}
+	return nil
+}
+
+func (x *MonteCarloSample) GetFlow() float64 {
+	if x != nil {
+		return x.Flow
+	}
+	return 0
+}
+
+func (x *MonteCarloSample) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *MonteCarloSample) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type MonteCarloStats struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	Mean                   float64                `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
@@ -5032,13 +5614,15 @@ type MonteCarloStats struct {
 	Median                 float64                `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
 	ConfidenceIntervalLow  float64                `protobuf:"fixed64,6,opt,name=confidence_interval_low,json=confidenceIntervalLow,proto3" json:"confidence_interval_low,omitempty"`
 	ConfidenceIntervalHigh float64                `protobuf:"fixed64,7,opt,name=confidence_interval_high,json=confidenceIntervalHigh,proto3" json:"confidence_interval_high,omitempty"`
+	EffectiveSampleSize    float64                `protobuf:"fixed64,8,opt,name=effective_sample_size,json=effectiveSampleSize,proto3" json:"effective_sample_size,omitempty"`   // Independent random samples giving the same precision of the mean
+	RelativeCiHalfWidth    float64                `protobuf:"fixed64,9,opt,name=relative_ci_half_width,json=relativeCiHalfWidth,proto3" json:"relative_ci_half_width,omitempty"` // CI half-width divided by |mean|
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
 func (x *MonteCarloStats) Reset() {
 	*x = MonteCarloStats{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[62]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5050,7 +5634,7 @@ func (x *MonteCarloStats) String() string {
 func (*MonteCarloStats) ProtoMessage() {}
 
 func (x *MonteCarloStats) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[62]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5063,7 +5647,7 @@ func (x *MonteCarloStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonteCarloStats.ProtoReflect.Descriptor instead.
 func (*MonteCarloStats) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{62}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *MonteCarloStats) GetMean() float64 {
@@ -5115,6 +5699,20 @@ func (x *MonteCarloStats) GetConfidenceIntervalHigh() float64 {
 	return 0
 }
 
+func (x *MonteCarloStats) GetEffectiveSampleSize() float64 {
+	if x != nil {
+		return x.EffectiveSampleSize
+	}
+	return 0
+}
+
+func (x *MonteCarloStats) GetRelativeCiHalfWidth() float64 {
+	if x != nil {
+		return x.RelativeCiHalfWidth
+	}
+	return 0
+}
+
 type RiskAnalysis struct {
 	state                     protoimpl.MessageState `protogen:"open.v1"`
 	ProbabilityBelowThreshold float64                `protobuf:"fixed64,1,opt,name=probability_below_threshold,json=probabilityBelowThreshold,proto3" json:"probability_below_threshold,omitempty"`
@@ -5127,7 +5725,7 @@ type RiskAnalysis struct {
 
 func (x *RiskAnalysis) Reset() {
 	*x = RiskAnalysis{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[63]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5139,7 +5737,7 @@ func (x *RiskAnalysis) String() string {
 func (*RiskAnalysis) ProtoMessage() {}
 
 func (x *RiskAnalysis) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[63]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5152,7 +5750,7 @@ func (x *RiskAnalysis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RiskAnalysis.ProtoReflect.Descriptor instead.
 func (*RiskAnalysis) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{63}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *RiskAnalysis) GetProbabilityBelowThreshold() float64 {
@@ -5184,22 +5782,25 @@ func (x *RiskAnalysis) GetBestCaseFlow() float64 {
 }
 
 type MonteCarloProgressEvent struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Iteration       int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
-	TotalIterations int32                  `protobuf:"varint,2,opt,name=total_iterations,json=totalIterations,proto3" json:"total_iterations,omitempty"`
-	ProgressPercent float64                `protobuf:"fixed64,3,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
-	CurrentMeanFlow float64                `protobuf:"fixed64,4,opt,name=current_mean_flow,json=currentMeanFlow,proto3" json:"current_mean_flow,omitempty"`
-	CurrentStdDev   float64                `protobuf:"fixed64,5,opt,name=current_std_dev,json=currentStdDev,proto3" json:"current_std_dev,omitempty"`
-	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	IsFinal         bool                   `protobuf:"varint,7,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"`
-	FinalResult     *MonteCarloResponse    `protobuf:"bytes,8,opt,name=final_result,json=finalResult,proto3" json:"final_result,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	Iteration                    int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	TotalIterations              int32                  `protobuf:"varint,2,opt,name=total_iterations,json=totalIterations,proto3" json:"total_iterations,omitempty"`
+	ProgressPercent              float64                `protobuf:"fixed64,3,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	CurrentMeanFlow              float64                `protobuf:"fixed64,4,opt,name=current_mean_flow,json=currentMeanFlow,proto3" json:"current_mean_flow,omitempty"`
+	CurrentStdDev                float64                `protobuf:"fixed64,5,opt,name=current_std_dev,json=currentStdDev,proto3" json:"current_std_dev,omitempty"`
+	Status                       string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	IsFinal                      bool                   `protobuf:"varint,7,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"`
+	FinalResult                  *MonteCarloResponse    `protobuf:"bytes,8,opt,name=final_result,json=finalResult,proto3" json:"final_result,omitempty"`
+	CiHalfWidth                  float64                `protobuf:"fixed64,9,opt,name=ci_half_width,json=ciHalfWidth,proto3" json:"ci_half_width,omitempty"`                            // CI half-width of the mean flow
+	RelativeCiHalfWidth          float64                `protobuf:"fixed64,10,opt,name=relative_ci_half_width,json=relativeCiHalfWidth,proto3" json:"relative_ci_half_width,omitempty"` // Largest relative CI half-width of flow and cost
+	EstimatedIterationsRemaining int32                  `protobuf:"varint,11,opt,name=estimated_iterations_remaining,json=estimatedIterationsRemaining,proto3" json:"estimated_iterations_remaining,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *MonteCarloProgressEvent) Reset() {
 	*x = MonteCarloProgressEvent{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[64]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5211,7 +5812,7 @@ func (x *MonteCarloProgressEvent) String() string {
 func (*MonteCarloProgressEvent) ProtoMessage() {}
 
 func (x *MonteCarloProgressEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[64]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5224,7 +5825,7 @@ func (x *MonteCarloProgressEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonteCarloProgressEvent.ProtoReflect.Descriptor instead.
 func (*MonteCarloProgressEvent) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{64}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *MonteCarloProgressEvent) GetIteration() int32 {
@@ -5283,18 +5884,40 @@ func (x *MonteCarloProgressEvent) GetFinalResult() *MonteCarloResponse {
 	return nil
 }
 
+func (x *MonteCarloProgressEvent) GetCiHalfWidth() float64 {
+	if x != nil {
+		return x.CiHalfWidth
+	}
+	return 0
+}
+
+func (x *MonteCarloProgressEvent) GetRelativeCiHalfWidth() float64 {
+	if x != nil {
+		return x.RelativeCiHalfWidth
+	}
+	return 0
+}
+
+func (x *MonteCarloProgressEvent) GetEstimatedIterationsRemaining() int32 {
+	if x != nil {
+		return x.EstimatedIterationsRemaining
+	}
+	return 0
+}
+
 type SensitivityRequest struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Graph         *v1.Graph               `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
 	Parameters    []*SensitivityParameter `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty"`
 	Algorithm     v1.Algorithm            `protobuf:"varint,3,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	Config        *SensitivityConfig      `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SensitivityRequest) Reset() {
 	*x = SensitivityRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[65]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5306,7 +5929,7 @@ func (x *SensitivityRequest) String() string {
 func (*SensitivityRequest) ProtoMessage() {}
 
 func (x *SensitivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[65]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5319,7 +5942,7 @@ func (x *SensitivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SensitivityRequest.ProtoReflect.Descriptor instead.
 func (*SensitivityRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{65}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *SensitivityRequest) GetGraph() *v1.Graph {
@@ -5343,6 +5966,105 @@ func (x *SensitivityRequest) GetAlgorithm() v1.Algorithm {
 	return v1.Algorithm(0)
 }
 
+func (x *SensitivityRequest) GetConfig() *SensitivityConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SensitivityConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Method          SensitivityMethod      `protobuf:"varint,1,opt,name=method,proto3,enum=logistics.gateway.v1.SensitivityMethod" json:"method,omitempty"`
+	FindThresholds  bool                   `protobuf:"varint,2,opt,name=find_thresholds,json=findThresholds,proto3" json:"find_thresholds,omitempty"`
+	NumTrajectories int32                  `protobuf:"varint,3,opt,name=num_trajectories,json=numTrajectories,proto3" json:"num_trajectories,omitempty"` // Morris trajectories (default 10)
+	NumLevels       int32                  `protobuf:"varint,4,opt,name=num_levels,json=numLevels,proto3" json:"num_levels,omitempty"`                   // Morris grid levels, even (default 4)
+	NumSamples      int32                  `protobuf:"varint,5,opt,name=num_samples,json=numSamples,proto3" json:"num_samples,omitempty"`                // Sobol base sample size (default 256)
+	RandomSeed      int64                  `protobuf:"varint,6,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`                // 0 = random seed
+	MaxWorkers      int32                  `protobuf:"varint,7,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`                // Parallel solves (default 4)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SensitivityConfig) Reset() {
+	*x = SensitivityConfig{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensitivityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitivityConfig) ProtoMessage() {}
+
+func (x *SensitivityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitivityConfig.ProtoReflect.Descriptor instead.
+func (*SensitivityConfig) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SensitivityConfig) GetMethod() SensitivityMethod {
+	if x != nil {
+		return x.Method
+	}
+	return SensitivityMethod_SENSITIVITY_METHOD_UNSPECIFIED
+}
+
+func (x *SensitivityConfig) GetFindThresholds() bool {
+	if x != nil {
+		return x.FindThresholds
+	}
+	return false
+}
+
+func (x *SensitivityConfig) GetNumTrajectories() int32 {
+	if x != nil {
+		return x.NumTrajectories
+	}
+	return 0
+}
+
+func (x *SensitivityConfig) GetNumLevels() int32 {
+	if x != nil {
+		return x.NumLevels
+	}
+	return 0
+}
+
+func (x *SensitivityConfig) GetNumSamples() int32 {
+	if x != nil {
+		return x.NumSamples
+	}
+	return 0
+}
+
+func (x *SensitivityConfig) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *SensitivityConfig) GetMaxWorkers() int32 {
+	if x != nil {
+		return x.MaxWorkers
+	}
+	return 0
+}
+
 type SensitivityParameter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Edge          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
@@ -5357,7 +6079,7 @@ type SensitivityParameter struct {
 
 func (x *SensitivityParameter) Reset() {
 	*x = SensitivityParameter{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[66]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5369,7 +6091,7 @@ func (x *SensitivityParameter) String() string {
 func (*SensitivityParameter) ProtoMessage() {}
 
 func (x *SensitivityParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[66]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5382,7 +6104,7 @@ func (x *SensitivityParameter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SensitivityParameter.ProtoReflect.Descriptor instead.
 func (*SensitivityParameter) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{66}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *SensitivityParameter) GetEdge() *v1.EdgeKey {
@@ -5440,7 +6162,7 @@ type SensitivityResponse struct {
 
 func (x *SensitivityResponse) Reset() {
 	*x = SensitivityResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[67]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5452,7 +6174,7 @@ func (x *SensitivityResponse) String() string {
 func (*SensitivityResponse) ProtoMessage() {}
 
 func (x *SensitivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[67]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5465,7 +6187,7 @@ func (x *SensitivityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SensitivityResponse.ProtoReflect.Descriptor instead.
 func (*SensitivityResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{67}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *SensitivityResponse) GetSuccess() bool {
@@ -5509,13 +6231,15 @@ type SensitivityResult struct {
 	Curve            []*SensitivityPoint    `protobuf:"bytes,2,rep,name=curve,proto3" json:"curve,omitempty"`
 	Elasticity       float64                `protobuf:"fixed64,3,opt,name=elasticity,proto3" json:"elasticity,omitempty"`
 	SensitivityIndex float64                `protobuf:"fixed64,4,opt,name=sensitivity_index,json=sensitivityIndex,proto3" json:"sensitivity_index,omitempty"`
+	Morris           *MorrisIndices         `protobuf:"bytes,5,opt,name=morris,proto3" json:"morris,omitempty"`
+	Sobol            *SobolIndices          `protobuf:"bytes,6,opt,name=sobol,proto3" json:"sobol,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SensitivityResult) Reset() {
 	*x = SensitivityResult{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[68]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5527,7 +6251,7 @@ func (x *SensitivityResult) String() string {
 func (*SensitivityResult) ProtoMessage() {}
 
 func (x *SensitivityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[68]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5540,7 +6264,7 @@ func (x *SensitivityResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SensitivityResult.ProtoReflect.Descriptor instead.
 func (*SensitivityResult) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{68}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *SensitivityResult) GetParameterId() string {
@@ -5557,16 +6281,159 @@ func (x *SensitivityResult) GetCurve() []*SensitivityPoint {
 	return nil
 }
 
-func (x *SensitivityResult) GetElasticity() float64 {
+func (x *SensitivityResult) GetElasticity() float64 {
+	if x != nil {
+		return x.Elasticity
+	}
+	return 0
+}
+
+func (x *SensitivityResult) GetSensitivityIndex() float64 {
+	if x != nil {
+		return x.SensitivityIndex
+	}
+	return 0
+}
+
+func (x *SensitivityResult) GetMorris() *MorrisIndices {
+	if x != nil {
+		return x.Morris
+	}
+	return nil
+}
+
+func (x *SensitivityResult) GetSobol() *SobolIndices {
+	if x != nil {
+		return x.Sobol
+	}
+	return nil
+}
+
+// Elementary effects measure the flow change over the whole multiplier range
+type MorrisIndices struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mu            float64                `protobuf:"fixed64,1,opt,name=mu,proto3" json:"mu,omitempty"`
+	MuStar        float64                `protobuf:"fixed64,2,opt,name=mu_star,json=muStar,proto3" json:"mu_star,omitempty"`
+	Sigma         float64                `protobuf:"fixed64,3,opt,name=sigma,proto3" json:"sigma,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MorrisIndices) Reset() {
+	*x = MorrisIndices{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MorrisIndices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MorrisIndices) ProtoMessage() {}
+
+func (x *MorrisIndices) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MorrisIndices.ProtoReflect.Descriptor instead.
+func (*MorrisIndices) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MorrisIndices) GetMu() float64 {
+	if x != nil {
+		return x.Mu
+	}
+	return 0
+}
+
+func (x *MorrisIndices) GetMuStar() float64 {
+	if x != nil {
+		return x.MuStar
+	}
+	return 0
+}
+
+func (x *MorrisIndices) GetSigma() float64 {
+	if x != nil {
+		return x.Sigma
+	}
+	return 0
+}
+
+type SobolIndices struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FirstOrder     float64                `protobuf:"fixed64,1,opt,name=first_order,json=firstOrder,proto3" json:"first_order,omitempty"`
+	TotalOrder     float64                `protobuf:"fixed64,2,opt,name=total_order,json=totalOrder,proto3" json:"total_order,omitempty"`
+	FirstOrderConf float64                `protobuf:"fixed64,3,opt,name=first_order_conf,json=firstOrderConf,proto3" json:"first_order_conf,omitempty"` // 95% bootstrap CI half-width
+	TotalOrderConf float64                `protobuf:"fixed64,4,opt,name=total_order_conf,json=totalOrderConf,proto3" json:"total_order_conf,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SobolIndices) Reset() {
+	*x = SobolIndices{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SobolIndices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SobolIndices) ProtoMessage() {}
+
+func (x *SobolIndices) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SobolIndices.ProtoReflect.Descriptor instead.
+func (*SobolIndices) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SobolIndices) GetFirstOrder() float64 {
+	if x != nil {
+		return x.FirstOrder
+	}
+	return 0
+}
+
+func (x *SobolIndices) GetTotalOrder() float64 {
+	if x != nil {
+		return x.TotalOrder
+	}
+	return 0
+}
+
+func (x *SobolIndices) GetFirstOrderConf() float64 {
 	if x != nil {
-		return x.Elasticity
+		return x.FirstOrderConf
 	}
 	return 0
 }
 
-func (x *SensitivityResult) GetSensitivityIndex() float64 {
+func (x *SobolIndices) GetTotalOrderConf() float64 {
 	if x != nil {
-		return x.SensitivityIndex
+		return x.TotalOrderConf
 	}
 	return 0
 }
@@ -5582,7 +6449,7 @@ type SensitivityPoint struct {
 
 func (x *SensitivityPoint) Reset() {
 	*x = SensitivityPoint{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[69]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5594,7 +6461,7 @@ func (x *SensitivityPoint) String() string {
 func (*SensitivityPoint) ProtoMessage() {}
 
 func (x *SensitivityPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[69]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5607,7 +6474,7 @@ func (x *SensitivityPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SensitivityPoint.ProtoReflect.Descriptor instead.
 func (*SensitivityPoint) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{69}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *SensitivityPoint) GetParameterValue() float64 {
@@ -5643,7 +6510,7 @@ type ParameterRanking struct {
 
 func (x *ParameterRanking) Reset() {
 	*x = ParameterRanking{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[70]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5655,7 +6522,7 @@ func (x *ParameterRanking) String() string {
 func (*ParameterRanking) ProtoMessage() {}
 
 func (x *ParameterRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[70]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5668,7 +6535,7 @@ func (x *ParameterRanking) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParameterRanking.ProtoReflect.Descriptor instead.
 func (*ParameterRanking) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{70}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *ParameterRanking) GetParameterId() string {
@@ -5710,7 +6577,7 @@ type ResilienceRequest struct {
 
 func (x *ResilienceRequest) Reset() {
 	*x = ResilienceRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[71]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5722,7 +6589,7 @@ func (x *ResilienceRequest) String() string {
 func (*ResilienceRequest) ProtoMessage() {}
 
 func (x *ResilienceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[71]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5735,7 +6602,7 @@ func (x *ResilienceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResilienceRequest.ProtoReflect.Descriptor instead.
 func (*ResilienceRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{71}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ResilienceRequest) GetGraph() *v1.Graph {
@@ -5763,14 +6630,15 @@ type ResilienceConfig struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	MaxFailuresToTest     int32                  `protobuf:"varint,1,opt,name=max_failures_to_test,json=maxFailuresToTest,proto3" json:"max_failures_to_test,omitempty"`
 	TestCascadingFailures bool                   `protobuf:"varint,2,opt,name=test_cascading_failures,json=testCascadingFailures,proto3" json:"test_cascading_failures,omitempty"`
-	LoadFactor            float64                `protobuf:"fixed64,3,opt,name=load_factor,json=loadFactor,proto3" json:"load_factor,omitempty"`
+	LoadFactor            float64                `protobuf:"fixed64,3,opt,name=load_factor,json=loadFactor,proto3" json:"load_factor,omitempty"`                       // Utilization that fails an overloaded edge (default 0.9)
+	MaxCandidateEdges     int32                  `protobuf:"varint,4,opt,name=max_candidate_edges,json=maxCandidateEdges,proto3" json:"max_candidate_edges,omitempty"` // Edges enumerated for N-k and cascades (default 20)
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *ResilienceConfig) Reset() {
 	*x = ResilienceConfig{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[72]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5782,7 +6650,7 @@ func (x *ResilienceConfig) String() string {
 func (*ResilienceConfig) ProtoMessage() {}
 
 func (x *ResilienceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[72]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5795,7 +6663,7 @@ func (x *ResilienceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResilienceConfig.ProtoReflect.Descriptor instead.
 func (*ResilienceConfig) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{72}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ResilienceConfig) GetMaxFailuresToTest() int32 {
@@ -5819,20 +6687,30 @@ func (x *ResilienceConfig) GetLoadFactor() float64 {
 	return 0
 }
 
+func (x *ResilienceConfig) GetMaxCandidateEdges() int32 {
+	if x != nil {
+		return x.MaxCandidateEdges
+	}
+	return 0
+}
+
 type ResilienceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Metrics       *ResilienceMetrics     `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	Weaknesses    []*ResilienceWeakness  `protobuf:"bytes,3,rep,name=weaknesses,proto3" json:"weaknesses,omitempty"`
-	Metadata      *SimulationMetadata    `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Success      bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Metrics      *ResilienceMetrics     `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Weaknesses   []*ResilienceWeakness  `protobuf:"bytes,3,rep,name=weaknesses,proto3" json:"weaknesses,omitempty"`
+	Metadata     *SimulationMetadata    `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Minimal edge pairs whose joint failure is worse than their separate failures
+	CriticalEdgePairs []*EdgePair        `protobuf:"bytes,6,rep,name=critical_edge_pairs,json=criticalEdgePairs,proto3" json:"critical_edge_pairs,omitempty"`
+	Cascades          []*CascadeScenario `protobuf:"bytes,7,rep,name=cascades,proto3" json:"cascades,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ResilienceResponse) Reset() {
 	*x = ResilienceResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[73]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5844,7 +6722,7 @@ func (x *ResilienceResponse) String() string {
 func (*ResilienceResponse) ProtoMessage() {}
 
 func (x *ResilienceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[73]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5857,7 +6735,7 @@ func (x *ResilienceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResilienceResponse.ProtoReflect.Descriptor instead.
 func (*ResilienceResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{73}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ResilienceResponse) GetSuccess() bool {
@@ -5895,6 +6773,216 @@ func (x *ResilienceResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *ResilienceResponse) GetCriticalEdgePairs() []*EdgePair {
+	if x != nil {
+		return x.CriticalEdgePairs
+	}
+	return nil
+}
+
+func (x *ResilienceResponse) GetCascades() []*CascadeScenario {
+	if x != nil {
+		return x.Cascades
+	}
+	return nil
+}
+
+type EdgePair struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Edge1          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge1,proto3" json:"edge1,omitempty"`
+	Edge2          *v1.EdgeKey            `protobuf:"bytes,2,opt,name=edge2,proto3" json:"edge2,omitempty"`
+	CombinedImpact float64                `protobuf:"fixed64,3,opt,name=combined_impact,json=combinedImpact,proto3" json:"combined_impact,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EdgePair) Reset() {
+	*x = EdgePair{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgePair) ProtoMessage() {}
+
+func (x *EdgePair) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgePair.ProtoReflect.Descriptor instead.
+func (*EdgePair) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *EdgePair) GetEdge1() *v1.EdgeKey {
+	if x != nil {
+		return x.Edge1
+	}
+	return nil
+}
+
+func (x *EdgePair) GetEdge2() *v1.EdgeKey {
+	if x != nil {
+		return x.Edge2
+	}
+	return nil
+}
+
+func (x *EdgePair) GetCombinedImpact() float64 {
+	if x != nil {
+		return x.CombinedImpact
+	}
+	return 0
+}
+
+type CascadeScenario struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitialFailure *v1.EdgeKey            `protobuf:"bytes,1,opt,name=initial_failure,json=initialFailure,proto3" json:"initial_failure,omitempty"`
+	Steps          []*CascadeStep         `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	FinalFlow      float64                `protobuf:"fixed64,3,opt,name=final_flow,json=finalFlow,proto3" json:"final_flow,omitempty"`
+	FlowReduction  float64                `protobuf:"fixed64,4,opt,name=flow_reduction,json=flowReduction,proto3" json:"flow_reduction,omitempty"`
+	FailedEdges    int32                  `protobuf:"varint,5,opt,name=failed_edges,json=failedEdges,proto3" json:"failed_edges,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CascadeScenario) Reset() {
+	*x = CascadeScenario{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CascadeScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CascadeScenario) ProtoMessage() {}
+
+func (x *CascadeScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CascadeScenario.ProtoReflect.Descriptor instead.
+func (*CascadeScenario) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CascadeScenario) GetInitialFailure() *v1.EdgeKey {
+	if x != nil {
+		return x.InitialFailure
+	}
+	return nil
+}
+
+func (x *CascadeScenario) GetSteps() []*CascadeStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CascadeScenario) GetFinalFlow() float64 {
+	if x != nil {
+		return x.FinalFlow
+	}
+	return 0
+}
+
+func (x *CascadeScenario) GetFlowReduction() float64 {
+	if x != nil {
+		return x.FlowReduction
+	}
+	return 0
+}
+
+func (x *CascadeScenario) GetFailedEdges() int32 {
+	if x != nil {
+		return x.FailedEdges
+	}
+	return 0
+}
+
+type CascadeStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	FailedEdges   []*v1.EdgeKey          `protobuf:"bytes,2,rep,name=failed_edges,json=failedEdges,proto3" json:"failed_edges,omitempty"`
+	FlowAfter     float64                `protobuf:"fixed64,3,opt,name=flow_after,json=flowAfter,proto3" json:"flow_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CascadeStep) Reset() {
+	*x = CascadeStep{}
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CascadeStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CascadeStep) ProtoMessage() {}
+
+func (x *CascadeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CascadeStep.ProtoReflect.Descriptor instead.
+func (*CascadeStep) Descriptor() ([]byte, []int) {
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CascadeStep) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *CascadeStep) GetFailedEdges() []*v1.EdgeKey {
+	if x != nil {
+		return x.FailedEdges
+	}
+	return nil
+}
+
+func (x *CascadeStep) GetFlowAfter() float64 {
+	if x != nil {
+		return x.FlowAfter
+	}
+	return 0
+}
+
 type ResilienceMetrics struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	OverallScore           float64                `protobuf:"fixed64,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
@@ -5908,7 +6996,7 @@ type ResilienceMetrics struct {
 
 func (x *ResilienceMetrics) Reset() {
 	*x = ResilienceMetrics{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[74]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5920,7 +7008,7 @@ func (x *ResilienceMetrics) String() string {
 func (*ResilienceMetrics) ProtoMessage() {}
 
 func (x *ResilienceMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[74]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5933,7 +7021,7 @@ func (x *ResilienceMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResilienceMetrics.ProtoReflect.Descriptor instead.
 func (*ResilienceMetrics) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{74}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ResilienceMetrics) GetOverallScore() float64 {
@@ -5984,7 +7072,7 @@ type ResilienceWeakness struct {
 
 func (x *ResilienceWeakness) Reset() {
 	*x = ResilienceWeakness{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[75]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5996,7 +7084,7 @@ func (x *ResilienceWeakness) String() string {
 func (*ResilienceWeakness) ProtoMessage() {}
 
 func (x *ResilienceWeakness) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[75]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6009,7 +7097,7 @@ func (x *ResilienceWeakness) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResilienceWeakness.ProtoReflect.Descriptor instead.
 func (*ResilienceWeakness) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{75}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *ResilienceWeakness) GetDescription() string {
@@ -6058,7 +7146,7 @@ type FailureSimulationRequest struct {
 
 func (x *FailureSimulationRequest) Reset() {
 	*x = FailureSimulationRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[76]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6070,7 +7158,7 @@ func (x *FailureSimulationRequest) String() string {
 func (*FailureSimulationRequest) ProtoMessage() {}
 
 func (x *FailureSimulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[76]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6083,7 +7171,7 @@ func (x *FailureSimulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailureSimulationRequest.ProtoReflect.Descriptor instead.
 func (*FailureSimulationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{76}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *FailureSimulationRequest) GetGraph() *v1.Graph {
@@ -6119,7 +7207,7 @@ type FailureScenario struct {
 
 func (x *FailureScenario) Reset() {
 	*x = FailureScenario{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[77]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6131,7 +7219,7 @@ func (x *FailureScenario) String() string {
 func (*FailureScenario) ProtoMessage() {}
 
 func (x *FailureScenario) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[77]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6144,7 +7232,7 @@ func (x *FailureScenario) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailureScenario.ProtoReflect.Descriptor instead.
 func (*FailureScenario) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{77}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *FailureScenario) GetName() string {
@@ -6189,7 +7277,7 @@ type FailureSimulationResponse struct {
 
 func (x *FailureSimulationResponse) Reset() {
 	*x = FailureSimulationResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[78]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6201,7 +7289,7 @@ func (x *FailureSimulationResponse) String() string {
 func (*FailureSimulationResponse) ProtoMessage() {}
 
 func (x *FailureSimulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[78]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6214,7 +7302,7 @@ func (x *FailureSimulationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailureSimulationResponse.ProtoReflect.Descriptor instead.
 func (*FailureSimulationResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{78}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *FailureSimulationResponse) GetSuccess() bool {
@@ -6272,7 +7360,7 @@ type FailureScenarioResult struct {
 
 func (x *FailureScenarioResult) Reset() {
 	*x = FailureScenarioResult{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[79]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6284,7 +7372,7 @@ func (x *FailureScenarioResult) String() string {
 func (*FailureScenarioResult) ProtoMessage() {}
 
 func (x *FailureScenarioResult) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[79]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6297,7 +7385,7 @@ func (x *FailureScenarioResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailureScenarioResult.ProtoReflect.Descriptor instead.
 func (*FailureScenarioResult) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{79}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *FailureScenarioResult) GetScenarioName() string {
@@ -6346,7 +7434,7 @@ type FailureStats struct {
 
 func (x *FailureStats) Reset() {
 	*x = FailureStats{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[80]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6358,7 +7446,7 @@ func (x *FailureStats) String() string {
 func (*FailureStats) ProtoMessage() {}
 
 func (x *FailureStats) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[80]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6371,7 +7459,7 @@ func (x *FailureStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailureStats.ProtoReflect.Descriptor instead.
 func (*FailureStats) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{80}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *FailureStats) GetExpectedFlowLoss() float64 {
@@ -6406,7 +7494,7 @@ type CriticalElementsRequest struct {
 
 func (x *CriticalElementsRequest) Reset() {
 	*x = CriticalElementsRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[81]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6418,7 +7506,7 @@ func (x *CriticalElementsRequest) String() string {
 func (*CriticalElementsRequest) ProtoMessage() {}
 
 func (x *CriticalElementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[81]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6431,7 +7519,7 @@ func (x *CriticalElementsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CriticalElementsRequest.ProtoReflect.Descriptor instead.
 func (*CriticalElementsRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{81}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CriticalElementsRequest) GetGraph() *v1.Graph {
@@ -6467,7 +7555,7 @@ type CriticalElementsConfig struct {
 
 func (x *CriticalElementsConfig) Reset() {
 	*x = CriticalElementsConfig{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[82]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6479,7 +7567,7 @@ func (x *CriticalElementsConfig) String() string {
 func (*CriticalElementsConfig) ProtoMessage() {}
 
 func (x *CriticalElementsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[82]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6492,7 +7580,7 @@ func (x *CriticalElementsConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CriticalElementsConfig.ProtoReflect.Descriptor instead.
 func (*CriticalElementsConfig) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{82}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *CriticalElementsConfig) GetAnalyzeEdges() bool {
@@ -6538,7 +7626,7 @@ type CriticalElementsResponse struct {
 
 func (x *CriticalElementsResponse) Reset() {
 	*x = CriticalElementsResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[83]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6550,7 +7638,7 @@ func (x *CriticalElementsResponse) String() string {
 func (*CriticalElementsResponse) ProtoMessage() {}
 
 func (x *CriticalElementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[83]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6563,7 +7651,7 @@ func (x *CriticalElementsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CriticalElementsResponse.ProtoReflect.Descriptor instead.
 func (*CriticalElementsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{83}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *CriticalElementsResponse) GetSuccess() bool {
@@ -6628,7 +7716,7 @@ type CriticalEdge struct {
 
 func (x *CriticalEdge) Reset() {
 	*x = CriticalEdge{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[84]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6640,7 +7728,7 @@ func (x *CriticalEdge) String() string {
 func (*CriticalEdge) ProtoMessage() {}
 
 func (x *CriticalEdge) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[84]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6653,7 +7741,7 @@ func (x *CriticalEdge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CriticalEdge.ProtoReflect.Descriptor instead.
 func (*CriticalEdge) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{84}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *CriticalEdge) GetEdge() *v1.EdgeKey {
@@ -6704,7 +7792,7 @@ type CriticalNode struct {
 
 func (x *CriticalNode) Reset() {
 	*x = CriticalNode{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[85]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6716,7 +7804,7 @@ func (x *CriticalNode) String() string {
 func (*CriticalNode) ProtoMessage() {}
 
 func (x *CriticalNode) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[85]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6729,7 +7817,7 @@ func (x *CriticalNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CriticalNode.ProtoReflect.Descriptor instead.
 func (*CriticalNode) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{85}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *CriticalNode) GetNodeId() int64 {
@@ -6781,7 +7869,7 @@ type SimulationMetadata struct {
 
 func (x *SimulationMetadata) Reset() {
 	*x = SimulationMetadata{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[86]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6793,7 +7881,7 @@ func (x *SimulationMetadata) String() string {
 func (*SimulationMetadata) ProtoMessage() {}
 
 func (x *SimulationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[86]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6806,7 +7894,7 @@ func (x *SimulationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationMetadata.ProtoReflect.Descriptor instead.
 func (*SimulationMetadata) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{86}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *SimulationMetadata) GetSimulationId() string {
@@ -6860,7 +7948,7 @@ type GetSimulationRequest struct {
 
 func (x *GetSimulationRequest) Reset() {
 	*x = GetSimulationRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[87]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6872,7 +7960,7 @@ func (x *GetSimulationRequest) String() string {
 func (*GetSimulationRequest) ProtoMessage() {}
 
 func (x *GetSimulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[87]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6885,7 +7973,7 @@ func (x *GetSimulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSimulationRequest.ProtoReflect.Descriptor instead.
 func (*GetSimulationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{87}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetSimulationRequest) GetSimulationId() string {
@@ -6906,7 +7994,7 @@ type ListSimulationsRequest struct {
 
 func (x *ListSimulationsRequest) Reset() {
 	*x = ListSimulationsRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[88]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6918,7 +8006,7 @@ func (x *ListSimulationsRequest) String() string {
 func (*ListSimulationsRequest) ProtoMessage() {}
 
 func (x *ListSimulationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[88]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6931,7 +8019,7 @@ func (x *ListSimulationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSimulationsRequest.ProtoReflect.Descriptor instead.
 func (*ListSimulationsRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{88}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *ListSimulationsRequest) GetLimit() int32 {
@@ -6966,7 +8054,7 @@ type ListSimulationsResponse struct {
 
 func (x *ListSimulationsResponse) Reset() {
 	*x = ListSimulationsResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[89]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6978,7 +8066,7 @@ func (x *ListSimulationsResponse) String() string {
 func (*ListSimulationsResponse) ProtoMessage() {}
 
 func (x *ListSimulationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[89]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6991,7 +8079,7 @@ func (x *ListSimulationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSimulationsResponse.ProtoReflect.Descriptor instead.
 func (*ListSimulationsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{89}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *ListSimulationsResponse) GetSimulations() []*SimulationRecord {
@@ -7029,7 +8117,7 @@ type SimulationRecord struct {
 
 func (x *SimulationRecord) Reset() {
 	*x = SimulationRecord{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[90]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7041,7 +8129,7 @@ func (x *SimulationRecord) String() string {
 func (*SimulationRecord) ProtoMessage() {}
 
 func (x *SimulationRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[90]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7054,7 +8142,7 @@ func (x *SimulationRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationRecord.ProtoReflect.Descriptor instead.
 func (*SimulationRecord) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{90}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *SimulationRecord) GetId() string {
@@ -7108,7 +8196,7 @@ type DeleteSimulationRequest struct {
 
 func (x *DeleteSimulationRequest) Reset() {
 	*x = DeleteSimulationRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[91]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7120,7 +8208,7 @@ func (x *DeleteSimulationRequest) String() string {
 func (*DeleteSimulationRequest) ProtoMessage() {}
 
 func (x *DeleteSimulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[91]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7133,7 +8221,7 @@ func (x *DeleteSimulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSimulationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSimulationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{91}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *DeleteSimulationRequest) GetSimulationId() string {
@@ -7155,7 +8243,7 @@ type SaveCalculationRequest struct {
 
 func (x *SaveCalculationRequest) Reset() {
 	*x = SaveCalculationRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[92]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7167,7 +8255,7 @@ func (x *SaveCalculationRequest) String() string {
 func (*SaveCalculationRequest) ProtoMessage() {}
 
 func (x *SaveCalculationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[92]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7180,7 +8268,7 @@ func (x *SaveCalculationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveCalculationRequest.ProtoReflect.Descriptor instead.
 func (*SaveCalculationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{92}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *SaveCalculationRequest) GetName() string {
@@ -7221,7 +8309,7 @@ type SaveCalculationResponse struct {
 
 func (x *SaveCalculationResponse) Reset() {
 	*x = SaveCalculationResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[93]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7233,7 +8321,7 @@ func (x *SaveCalculationResponse) String() string {
 func (*SaveCalculationResponse) ProtoMessage() {}
 
 func (x *SaveCalculationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[93]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7246,7 +8334,7 @@ func (x *SaveCalculationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveCalculationResponse.ProtoReflect.Descriptor instead.
 func (*SaveCalculationResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{93}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *SaveCalculationResponse) GetCalculationId() string {
@@ -7272,7 +8360,7 @@ type GetCalculationRequest struct {
 
 func (x *GetCalculationRequest) Reset() {
 	*x = GetCalculationRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[94]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7284,7 +8372,7 @@ func (x *GetCalculationRequest) String() string {
 func (*GetCalculationRequest) ProtoMessage() {}
 
 func (x *GetCalculationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[94]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7297,7 +8385,7 @@ func (x *GetCalculationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCalculationRequest.ProtoReflect.Descriptor instead.
 func (*GetCalculationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{94}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *GetCalculationRequest) GetCalculationId() string {
@@ -7323,7 +8411,7 @@ type ListCalculationsRequest struct {
 
 func (x *ListCalculationsRequest) Reset() {
 	*x = ListCalculationsRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[95]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7335,7 +8423,7 @@ func (x *ListCalculationsRequest) String() string {
 func (*ListCalculationsRequest) ProtoMessage() {}
 
 func (x *ListCalculationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[95]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7348,7 +8436,7 @@ func (x *ListCalculationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCalculationsRequest.ProtoReflect.Descriptor instead.
 func (*ListCalculationsRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{95}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *ListCalculationsRequest) GetLimit() int32 {
@@ -7418,7 +8506,7 @@ type ListCalculationsResponse struct {
 
 func (x *ListCalculationsResponse) Reset() {
 	*x = ListCalculationsResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[96]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7430,7 +8518,7 @@ func (x *ListCalculationsResponse) String() string {
 func (*ListCalculationsResponse) ProtoMessage() {}
 
 func (x *ListCalculationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[96]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7443,7 +8531,7 @@ func (x *ListCalculationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCalculationsResponse.ProtoReflect.Descriptor instead.
 func (*ListCalculationsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{96}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *ListCalculationsResponse) GetCalculations() []*CalculationSummary {
@@ -7481,7 +8569,7 @@ type CalculationRecord struct {
 
 func (x *CalculationRecord) Reset() {
 	*x = CalculationRecord{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[97]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7493,7 +8581,7 @@ func (x *CalculationRecord) String() string {
 func (*CalculationRecord) ProtoMessage() {}
 
 func (x *CalculationRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[97]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7506,7 +8594,7 @@ func (x *CalculationRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculationRecord.ProtoReflect.Descriptor instead.
 func (*CalculationRecord) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{97}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *CalculationRecord) GetCalculationId() string {
@@ -7569,7 +8657,7 @@ type CalculationSummary struct {
 
 func (x *CalculationSummary) Reset() {
 	*x = CalculationSummary{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[98]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7581,7 +8669,7 @@ func (x *CalculationSummary) String() string {
 func (*CalculationSummary) ProtoMessage() {}
 
 func (x *CalculationSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[98]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7594,7 +8682,7 @@ func (x *CalculationSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculationSummary.ProtoReflect.Descriptor instead.
 func (*CalculationSummary) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{98}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *CalculationSummary) GetCalculationId() string {
@@ -7676,7 +8764,7 @@ type DeleteCalculationRequest struct {
 
 func (x *DeleteCalculationRequest) Reset() {
 	*x = DeleteCalculationRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[99]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7688,7 +8776,7 @@ func (x *DeleteCalculationRequest) String() string {
 func (*DeleteCalculationRequest) ProtoMessage() {}
 
 func (x *DeleteCalculationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[99]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7701,7 +8789,7 @@ func (x *DeleteCalculationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCalculationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCalculationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{99}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *DeleteCalculationRequest) GetCalculationId() string {
@@ -7721,7 +8809,7 @@ type GetStatisticsRequest struct {
 
 func (x *GetStatisticsRequest) Reset() {
 	*x = GetStatisticsRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[100]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7733,7 +8821,7 @@ func (x *GetStatisticsRequest) String() string {
 func (*GetStatisticsRequest) ProtoMessage() {}
 
 func (x *GetStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[100]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7746,7 +8834,7 @@ func (x *GetStatisticsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatisticsRequest.ProtoReflect.Descriptor instead.
 func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{100}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetStatisticsRequest) GetStartTime() *timestamppb.Timestamp {
@@ -7777,7 +8865,7 @@ type StatisticsResponse struct {
 
 func (x *StatisticsResponse) Reset() {
 	*x = StatisticsResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[101]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7789,7 +8877,7 @@ func (x *StatisticsResponse) String() string {
 func (*StatisticsResponse) ProtoMessage() {}
 
 func (x *StatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[101]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7802,7 +8890,7 @@ func (x *StatisticsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatisticsResponse.ProtoReflect.Descriptor instead.
 func (*StatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{101}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *StatisticsResponse) GetTotalCalculations() int32 {
@@ -7858,7 +8946,7 @@ type DailyStats struct {
 
 func (x *DailyStats) Reset() {
 	*x = DailyStats{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[102]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7870,7 +8958,7 @@ func (x *DailyStats) String() string {
 func (*DailyStats) ProtoMessage() {}
 
 func (x *DailyStats) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[102]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7883,7 +8971,7 @@ func (x *DailyStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DailyStats.ProtoReflect.Descriptor instead.
 func (*DailyStats) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{102}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *DailyStats) GetDate() string {
@@ -7927,7 +9015,7 @@ type GenerateReportRequest struct {
 
 func (x *GenerateReportRequest) Reset() {
 	*x = GenerateReportRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[103]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7939,7 +9027,7 @@ func (x *GenerateReportRequest) String() string {
 func (*GenerateReportRequest) ProtoMessage() {}
 
 func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[103]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7952,7 +9040,7 @@ func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateReportRequest.ProtoReflect.Descriptor instead.
 func (*GenerateReportRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{103}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GenerateReportRequest) GetType() ReportType {
@@ -8072,7 +9160,7 @@ type ReportOptions struct {
 
 func (x *ReportOptions) Reset() {
 	*x = ReportOptions{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[104]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8084,7 +9172,7 @@ func (x *ReportOptions) String() string {
 func (*ReportOptions) ProtoMessage() {}
 
 func (x *ReportOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[104]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8097,7 +9185,7 @@ func (x *ReportOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportOptions.ProtoReflect.Descriptor instead.
 func (*ReportOptions) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{104}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *ReportOptions) GetTitle() string {
@@ -8230,7 +9318,7 @@ type FlowReportSource struct {
 
 func (x *FlowReportSource) Reset() {
 	*x = FlowReportSource{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[105]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8242,7 +9330,7 @@ func (x *FlowReportSource) String() string {
 func (*FlowReportSource) ProtoMessage() {}
 
 func (x *FlowReportSource) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[105]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8255,7 +9343,7 @@ func (x *FlowReportSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowReportSource.ProtoReflect.Descriptor instead.
 func (*FlowReportSource) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{105}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *FlowReportSource) GetGraph() *v1.Graph {
@@ -8289,7 +9377,7 @@ type AnalyticsReportSource struct {
 
 func (x *AnalyticsReportSource) Reset() {
 	*x = AnalyticsReportSource{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[106]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8301,7 +9389,7 @@ func (x *AnalyticsReportSource) String() string {
 func (*AnalyticsReportSource) ProtoMessage() {}
 
 func (x *AnalyticsReportSource) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[106]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8314,7 +9402,7 @@ func (x *AnalyticsReportSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyticsReportSource.ProtoReflect.Descriptor instead.
 func (*AnalyticsReportSource) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{106}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *AnalyticsReportSource) GetGraph() *v1.Graph {
@@ -8342,7 +9430,7 @@ type SimulationReportSource struct {
 
 func (x *SimulationReportSource) Reset() {
 	*x = SimulationReportSource{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[107]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8354,7 +9442,7 @@ func (x *SimulationReportSource) String() string {
 func (*SimulationReportSource) ProtoMessage() {}
 
 func (x *SimulationReportSource) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[107]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8367,7 +9455,7 @@ func (x *SimulationReportSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationReportSource.ProtoReflect.Descriptor instead.
 func (*SimulationReportSource) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{107}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *SimulationReportSource) GetBaselineGraph() *v1.Graph {
@@ -8402,7 +9490,7 @@ type HistoryReportSource struct {
 
 func (x *HistoryReportSource) Reset() {
 	*x = HistoryReportSource{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[108]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8414,7 +9502,7 @@ func (x *HistoryReportSource) String() string {
 func (*HistoryReportSource) ProtoMessage() {}
 
 func (x *HistoryReportSource) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[108]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8427,7 +9515,7 @@ func (x *HistoryReportSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryReportSource.ProtoReflect.Descriptor instead.
 func (*HistoryReportSource) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{108}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *HistoryReportSource) GetStartTime() *timestamppb.Timestamp {
@@ -8463,7 +9551,7 @@ type GenerateReportResponse struct {
 
 func (x *GenerateReportResponse) Reset() {
 	*x = GenerateReportResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[109]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8475,7 +9563,7 @@ func (x *GenerateReportResponse) String() string {
 func (*GenerateReportResponse) ProtoMessage() {}
 
 func (x *GenerateReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[109]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8488,7 +9576,7 @@ func (x *GenerateReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateReportResponse.ProtoReflect.Descriptor instead.
 func (*GenerateReportResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{109}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *GenerateReportResponse) GetSuccess() bool {
@@ -8536,7 +9624,7 @@ type ReportInfo struct {
 
 func (x *ReportInfo) Reset() {
 	*x = ReportInfo{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[110]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8548,7 +9636,7 @@ func (x *ReportInfo) String() string {
 func (*ReportInfo) ProtoMessage() {}
 
 func (x *ReportInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[110]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8561,7 +9649,7 @@ func (x *ReportInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportInfo.ProtoReflect.Descriptor instead.
 func (*ReportInfo) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{110}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *ReportInfo) GetReportId() string {
@@ -8636,7 +9724,7 @@ type GetReportRequest struct {
 
 func (x *GetReportRequest) Reset() {
 	*x = GetReportRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[111]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8648,7 +9736,7 @@ func (x *GetReportRequest) String() string {
 func (*GetReportRequest) ProtoMessage() {}
 
 func (x *GetReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[111]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8661,7 +9749,7 @@ func (x *GetReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReportRequest.ProtoReflect.Descriptor instead.
 func (*GetReportRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{111}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *GetReportRequest) GetReportId() string {
@@ -8680,7 +9768,7 @@ type DownloadReportRequest struct {
 
 func (x *DownloadReportRequest) Reset() {
 	*x = DownloadReportRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[112]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8692,7 +9780,7 @@ func (x *DownloadReportRequest) String() string {
 func (*DownloadReportRequest) ProtoMessage() {}
 
 func (x *DownloadReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[112]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8705,7 +9793,7 @@ func (x *DownloadReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadReportRequest.ProtoReflect.Descriptor instead.
 func (*DownloadReportRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{112}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *DownloadReportRequest) GetReportId() string {
@@ -8727,7 +9815,7 @@ type ReportChunk struct {
 
 func (x *ReportChunk) Reset() {
 	*x = ReportChunk{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[113]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8739,7 +9827,7 @@ func (x *ReportChunk) String() string {
 func (*ReportChunk) ProtoMessage() {}
 
 func (x *ReportChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[113]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8752,7 +9840,7 @@ func (x *ReportChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportChunk.ProtoReflect.Descriptor instead.
 func (*ReportChunk) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{113}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *ReportChunk) GetChunkIndex() int32 {
@@ -8794,7 +9882,7 @@ type ReportRecord struct {
 
 func (x *ReportRecord) Reset() {
 	*x = ReportRecord{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[114]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8806,7 +9894,7 @@ func (x *ReportRecord) String() string {
 func (*ReportRecord) ProtoMessage() {}
 
 func (x *ReportRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[114]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8819,7 +9907,7 @@ func (x *ReportRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportRecord.ProtoReflect.Descriptor instead.
 func (*ReportRecord) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{114}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *ReportRecord) GetReportId() string {
@@ -8857,7 +9945,7 @@ type ListReportsRequest struct {
 
 func (x *ListReportsRequest) Reset() {
 	*x = ListReportsRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[115]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8869,7 +9957,7 @@ func (x *ListReportsRequest) String() string {
 func (*ListReportsRequest) ProtoMessage() {}
 
 func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[115]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8882,7 +9970,7 @@ func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
 func (*ListReportsRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{115}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *ListReportsRequest) GetLimit() int32 {
@@ -8938,7 +10026,7 @@ type ListReportsResponse struct {
 
 func (x *ListReportsResponse) Reset() {
 	*x = ListReportsResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[116]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8950,7 +10038,7 @@ func (x *ListReportsResponse) String() string {
 func (*ListReportsResponse) ProtoMessage() {}
 
 func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[116]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8963,7 +10051,7 @@ func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
 func (*ListReportsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{116}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *ListReportsResponse) GetReports() []*ReportInfo {
@@ -8996,7 +10084,7 @@ type DeleteReportRequest struct {
 
 func (x *DeleteReportRequest) Reset() {
 	*x = DeleteReportRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[117]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9008,7 +10096,7 @@ func (x *DeleteReportRequest) String() string {
 func (*DeleteReportRequest) ProtoMessage() {}
 
 func (x *DeleteReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[117]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9021,7 +10109,7 @@ func (x *DeleteReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReportRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReportRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{117}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *DeleteReportRequest) GetReportId() string {
@@ -9040,7 +10128,7 @@ type ReportFormatsResponse struct {
 
 func (x *ReportFormatsResponse) Reset() {
 	*x = ReportFormatsResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[118]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9052,7 +10140,7 @@ func (x *ReportFormatsResponse) String() string {
 func (*ReportFormatsResponse) ProtoMessage() {}
 
 func (x *ReportFormatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[118]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9065,7 +10153,7 @@ func (x *ReportFormatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportFormatsResponse.ProtoReflect.Descriptor instead.
 func (*ReportFormatsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{118}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *ReportFormatsResponse) GetFormats() []*ReportFormatInfo {
@@ -9090,7 +10178,7 @@ type ReportFormatInfo struct {
 
 func (x *ReportFormatInfo) Reset() {
 	*x = ReportFormatInfo{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[119]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9102,7 +10190,7 @@ func (x *ReportFormatInfo) String() string {
 func (*ReportFormatInfo) ProtoMessage() {}
 
 func (x *ReportFormatInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[119]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9115,7 +10203,7 @@ func (x *ReportFormatInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportFormatInfo.ProtoReflect.Descriptor instead.
 func (*ReportFormatInfo) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{119}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *ReportFormatInfo) GetFormat() ReportFormat {
@@ -9183,7 +10271,7 @@ type GetAuditLogsRequest struct {
 
 func (x *GetAuditLogsRequest) Reset() {
 	*x = GetAuditLogsRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[120]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9195,7 +10283,7 @@ func (x *GetAuditLogsRequest) String() string {
 func (*GetAuditLogsRequest) ProtoMessage() {}
 
 func (x *GetAuditLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[120]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9208,7 +10296,7 @@ func (x *GetAuditLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetAuditLogsRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{120}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *GetAuditLogsRequest) GetStartTime() *timestamppb.Timestamp {
@@ -9278,7 +10366,7 @@ type AuditLogsResponse struct {
 
 func (x *AuditLogsResponse) Reset() {
 	*x = AuditLogsResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[121]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9290,7 +10378,7 @@ func (x *AuditLogsResponse) String() string {
 func (*AuditLogsResponse) ProtoMessage() {}
 
 func (x *AuditLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[121]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9303,7 +10391,7 @@ func (x *AuditLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditLogsResponse.ProtoReflect.Descriptor instead.
 func (*AuditLogsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{121}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *AuditLogsResponse) GetEntries() []*AuditEntry {
@@ -9349,7 +10437,7 @@ type AuditEntry struct {
 
 func (x *AuditEntry) Reset() {
 	*x = AuditEntry{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[122]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9361,7 +10449,7 @@ func (x *AuditEntry) String() string {
 func (*AuditEntry) ProtoMessage() {}
 
 func (x *AuditEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[122]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9374,7 +10462,7 @@ func (x *AuditEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
 func (*AuditEntry) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{122}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *AuditEntry) GetId() string {
@@ -9488,7 +10576,7 @@ type GetUserActivityRequest struct {
 
 func (x *GetUserActivityRequest) Reset() {
 	*x = GetUserActivityRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[123]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9500,7 +10588,7 @@ func (x *GetUserActivityRequest) String() string {
 func (*GetUserActivityRequest) ProtoMessage() {}
 
 func (x *GetUserActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[123]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9513,7 +10601,7 @@ func (x *GetUserActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserActivityRequest.ProtoReflect.Descriptor instead.
 func (*GetUserActivityRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{123}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *GetUserActivityRequest) GetUserId() string {
@@ -9562,7 +10650,7 @@ type UserActivityResponse struct {
 
 func (x *UserActivityResponse) Reset() {
 	*x = UserActivityResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[124]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9574,7 +10662,7 @@ func (x *UserActivityResponse) String() string {
 func (*UserActivityResponse) ProtoMessage() {}
 
 func (x *UserActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[124]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9587,7 +10675,7 @@ func (x *UserActivityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserActivityResponse.ProtoReflect.Descriptor instead.
 func (*UserActivityResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{124}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *UserActivityResponse) GetEntries() []*AuditEntry {
@@ -9626,7 +10714,7 @@ type UserActivitySummary struct {
 
 func (x *UserActivitySummary) Reset() {
 	*x = UserActivitySummary{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[125]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9638,7 +10726,7 @@ func (x *UserActivitySummary) String() string {
 func (*UserActivitySummary) ProtoMessage() {}
 
 func (x *UserActivitySummary) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[125]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9651,7 +10739,7 @@ func (x *UserActivitySummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserActivitySummary.ProtoReflect.Descriptor instead.
 func (*UserActivitySummary) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{125}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *UserActivitySummary) GetTotalActions() int32 {
@@ -9714,7 +10802,7 @@ type GetAuditStatsRequest struct {
 
 func (x *GetAuditStatsRequest) Reset() {
 	*x = GetAuditStatsRequest{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[126]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9726,7 +10814,7 @@ func (x *GetAuditStatsRequest) String() string {
 func (*GetAuditStatsRequest) ProtoMessage() {}
 
 func (x *GetAuditStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[126]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9739,7 +10827,7 @@ func (x *GetAuditStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetAuditStatsRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{126}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *GetAuditStatsRequest) GetStartTime() *timestamppb.Timestamp {
@@ -9778,7 +10866,7 @@ type AuditStatsResponse struct {
 
 func (x *AuditStatsResponse) Reset() {
 	*x = AuditStatsResponse{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[127]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9790,7 +10878,7 @@ func (x *AuditStatsResponse) String() string {
 func (*AuditStatsResponse) ProtoMessage() {}
 
 func (x *AuditStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[127]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9803,7 +10891,7 @@ func (x *AuditStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditStatsResponse.ProtoReflect.Descriptor instead.
 func (*AuditStatsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{127}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *AuditStatsResponse) GetTotalEvents() int64 {
@@ -9867,7 +10955,7 @@ type AuditStatsPoint struct {
 
 func (x *AuditStatsPoint) Reset() {
 	*x = AuditStatsPoint{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[128]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9879,7 +10967,7 @@ func (x *AuditStatsPoint) String() string {
 func (*AuditStatsPoint) ProtoMessage() {}
 
 func (x *AuditStatsPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[128]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9892,7 +10980,7 @@ func (x *AuditStatsPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditStatsPoint.ProtoReflect.Descriptor instead.
 func (*AuditStatsPoint) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{128}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *AuditStatsPoint) GetTimestamp() *timestamppb.Timestamp {
@@ -9941,7 +11029,7 @@ type RequestMetadata struct {
 
 func (x *RequestMetadata) Reset() {
 	*x = RequestMetadata{}
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[129]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9953,7 +11041,7 @@ func (x *RequestMetadata) String() string {
 func (*RequestMetadata) ProtoMessage() {}
 
 func (x *RequestMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[129]
+	mi := &file_logistics_gateway_v1_gateway_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9966,7 +11054,7 @@ func (x *RequestMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestMetadata.ProtoReflect.Descriptor instead.
 func (*RequestMetadata) Descriptor() ([]byte, []int) {
-	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{129}
+	return file_logistics_gateway_v1_gateway_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *RequestMetadata) GetRequestId() string {
@@ -10210,12 +11298,14 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\n" +
 	"total_cost\x18\x04 \x01(\x01R\ttotalCost\x12.\n" +
 	"\x13computation_time_ms\x18\x05 \x01(\x01R\x11computationTimeMs\x12#\n" +
-	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"\x9b\x01\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"\x83\x02\n" +
 	"\fSolveOptions\x12'\n" +
 	"\x0ftimeout_seconds\x18\x01 \x01(\x01R\x0etimeoutSeconds\x12!\n" +
 	"\freturn_paths\x18\x02 \x01(\bR\vreturnPaths\x12%\n" +
 	"\x0emax_iterations\x18\x03 \x01(\x05R\rmaxIterations\x12\x18\n" +
-	"\aepsilon\x18\x04 \x01(\x01R\aepsilon\"\xc0\x01\n" +
+	"\aepsilon\x18\x04 \x01(\x01R\aepsilon\x123\n" +
+	"\x04mode\x18\x05 \x01(\x0e2\x1f.logistics.gateway.v1.SolveModeR\x04mode\x121\n" +
+	"\x14return_decomposition\x18\x06 \x01(\bR\x13returnDecomposition\"\xc0\x01\n" +
 	"\fSolveMetrics\x12.\n" +
 	"\x13computation_time_ms\x18\x01 \x01(\x01R\x11computationTimeMs\x12\x1e\n" +
 	"\n" +
@@ -10330,13 +11420,18 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x05top_n\x18\x03 \x01(\x05R\x04topN\"\xa9\x01\n" +
 	"\x13BottlenecksResponse\x12B\n" +
 	"\vbottlenecks\x18\x01 \x03(\v2 .logistics.gateway.v1.BottleneckR\vbottlenecks\x12N\n" +
-	"\x0frecommendations\x18\x02 \x03(\v2$.logistics.gateway.v1.RecommendationR\x0frecommendations\"\xc9\x01\n" +
+	"\x0frecommendations\x18\x02 \x03(\v2$.logistics.gateway.v1.RecommendationR\x0frecommendations\"\xa5\x02\n" +
 	"\n" +
 	"Bottleneck\x120\n" +
 	"\x04edge\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x04edge\x12 \n" +
 	"\vutilization\x18\x02 \x01(\x01R\vutilization\x12!\n" +
 	"\fimpact_score\x18\x03 \x01(\x01R\vimpactScore\x12D\n" +
-	"\bseverity\x18\x04 \x01(\x0e2(.logistics.gateway.v1.BottleneckSeverityR\bseverity\"\xe5\x01\n" +
+	"\bseverity\x18\x04 \x01(\x0e2(.logistics.gateway.v1.BottleneckSeverityR\bseverity\x12\x1c\n" +
+	"\n" +
+	"in_min_cut\x18\x05 \x01(\bR\binMinCut\x12\x1b\n" +
+	"\tflow_gain\x18\x06 \x01(\x01R\bflowGain\x12\x1f\n" +
+	"\vcost_saving\x18\a \x01(\x01R\n" +
+	"costSaving\"\xe5\x01\n" +
 	"\x0eRecommendation\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12A\n" +
@@ -10385,7 +11480,7 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"efficiency\x18\a \x01(\v2&.logistics.gateway.v1.EfficiencyReportR\n" +
 	"efficiency\x12B\n" +
 	"\n" +
-	"flow_stats\x18\b \x01(\v2#.logistics.common.v1.FlowStatisticsR\tflowStats\"\xe1\x02\n" +
+	"flow_stats\x18\b \x01(\v2#.logistics.common.v1.FlowStatisticsR\tflowStats\"\xaf\x03\n" +
 	"\vSolveResult\x12=\n" +
 	"\fsolved_graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\vsolvedGraph\x12\x19\n" +
 	"\bmax_flow\x18\x02 \x01(\x01R\amaxFlow\x12\x1d\n" +
@@ -10398,7 +11493,8 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x13computation_time_ms\x18\x06 \x01(\x01R\x11computationTimeMs\x12\x1f\n" +
 	"\vpaths_found\x18\a \x01(\x05R\n" +
 	"pathsFound\x12/\n" +
-	"\x05paths\x18\b \x03(\v2\x19.logistics.common.v1.PathR\x05paths\"\x99\x02\n" +
+	"\x05paths\x18\b \x03(\v2\x19.logistics.common.v1.PathR\x05paths\x12L\n" +
+	"\rdecomposition\x18\t \x01(\v2&.logistics.common.v1.FlowDecompositionR\rdecomposition\"\x99\x02\n" +
 	"\rWhatIfRequest\x12A\n" +
 	"\x0ebaseline_graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\rbaselineGraph\x12H\n" +
 	"\rmodifications\x18\x02 \x03(\v2\".logistics.gateway.v1.ModificationR\rmodifications\x12<\n" +
@@ -10436,18 +11532,31 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"costChange\x12.\n" +
 	"\x13cost_change_percent\x18\x04 \x01(\x01R\x11costChangePercent\x12%\n" +
 	"\x0eimpact_summary\x18\x05 \x01(\tR\rimpactSummary\x12D\n" +
-	"\fimpact_level\x18\x06 \x01(\x0e2!.logistics.gateway.v1.ImpactLevelR\vimpactLevel\"\x90\x02\n" +
+	"\fimpact_level\x18\x06 \x01(\x0e2!.logistics.gateway.v1.ImpactLevelR\vimpactLevel\"\xe0\x02\n" +
 	"\x11MonteCarloRequest\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12>\n" +
 	"\x06config\x18\x02 \x01(\v2&.logistics.gateway.v1.MonteCarloConfigR\x06config\x12K\n" +
 	"\runcertainties\x18\x03 \x03(\v2%.logistics.gateway.v1.UncertaintySpecR\runcertainties\x12<\n" +
-	"\talgorithm\x18\x04 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\xa1\x01\n" +
+	"\talgorithm\x18\x04 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\x12N\n" +
+	"\vcorrelation\x18\x05 \x01(\v2,.logistics.gateway.v1.UncertaintyCorrelationR\vcorrelation\"\xb4\x01\n" +
+	"\x16UncertaintyCorrelation\x12B\n" +
+	"\ameasure\x18\x01 \x01(\x0e2(.logistics.gateway.v1.CorrelationMeasureR\ameasure\x12\x16\n" +
+	"\x06matrix\x18\x02 \x03(\x01R\x06matrix\x12>\n" +
+	"\x06groups\x18\x03 \x03(\v2&.logistics.gateway.v1.CorrelationGroupR\x06groups\"e\n" +
+	"\x10CorrelationGroup\x12/\n" +
+	"\x13uncertainty_indices\x18\x01 \x03(\x05R\x12uncertaintyIndices\x12 \n" +
+	"\vcorrelation\x18\x02 \x01(\x01R\vcorrelation\"\xb6\x03\n" +
 	"\x10MonteCarloConfig\x12%\n" +
 	"\x0enum_iterations\x18\x01 \x01(\x05R\rnumIterations\x12\x1f\n" +
 	"\vrandom_seed\x18\x02 \x01(\x03R\n" +
 	"randomSeed\x12)\n" +
 	"\x10confidence_level\x18\x03 \x01(\x01R\x0fconfidenceLevel\x12\x1a\n" +
-	"\bparallel\x18\x04 \x01(\bR\bparallel\"\xe6\x01\n" +
+	"\bparallel\x18\x04 \x01(\bR\bparallel\x12%\n" +
+	"\x0ereturn_samples\x18\x05 \x01(\bR\rreturnSamples\x12+\n" +
+	"\x11replay_iterations\x18\x06 \x03(\x05R\x10replayIterations\x12M\n" +
+	"\x0fsampling_method\x18\a \x01(\x0e2$.logistics.gateway.v1.SamplingMethodR\x0esamplingMethod\x12@\n" +
+	"\x1dtarget_relative_ci_half_width\x18\b \x01(\x01R\x19targetRelativeCiHalfWidth\x12.\n" +
+	"\x13time_budget_seconds\x18\t \x01(\x01R\x11timeBudgetSeconds\"\xe6\x01\n" +
 	"\x0fUncertaintySpec\x120\n" +
 	"\x04edge\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x04edge\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\x03R\x06nodeId\x12@\n" +
@@ -10457,7 +11566,7 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x04type\x18\x01 \x01(\x0e2&.logistics.gateway.v1.DistributionTypeR\x04type\x12\x16\n" +
 	"\x06param1\x18\x02 \x01(\x01R\x06param1\x12\x16\n" +
 	"\x06param2\x18\x03 \x01(\x01R\x06param2\x12\x16\n" +
-	"\x06param3\x18\x04 \x01(\x01R\x06param3\"\xee\x02\n" +
+	"\x06param3\x18\x04 \x01(\x01R\x06param3\"\xd1\x04\n" +
 	"\x12MonteCarloResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12D\n" +
 	"\n" +
@@ -10466,7 +11575,20 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"cost_stats\x18\x03 \x01(\v2%.logistics.gateway.v1.MonteCarloStatsR\tcostStats\x12G\n" +
 	"\rrisk_analysis\x18\x04 \x01(\v2\".logistics.gateway.v1.RiskAnalysisR\friskAnalysis\x12D\n" +
 	"\bmetadata\x18\x05 \x01(\v2(.logistics.gateway.v1.SimulationMetadataR\bmetadata\x12#\n" +
-	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"\xec\x01\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12\x1f\n" +
+	"\vrandom_seed\x18\a \x01(\x03R\n" +
+	"randomSeed\x12@\n" +
+	"\asamples\x18\b \x03(\v2&.logistics.gateway.v1.MonteCarloSampleR\asamples\x12K\n" +
+	"\vstop_reason\x18\t \x01(\x0e2*.logistics.gateway.v1.MonteCarloStopReasonR\n" +
+	"stopReason\x121\n" +
+	"\x14completed_iterations\x18\n" +
+	" \x01(\x05R\x13completedIterations\"\x90\x01\n" +
+	"\x10MonteCarloSample\x12\x1c\n" +
+	"\titeration\x18\x01 \x01(\x05R\titeration\x12 \n" +
+	"\vmultipliers\x18\x02 \x03(\x01R\vmultipliers\x12\x12\n" +
+	"\x04flow\x18\x03 \x01(\x01R\x04flow\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xd5\x02\n" +
 	"\x0fMonteCarloStats\x12\x12\n" +
 	"\x04mean\x18\x01 \x01(\x01R\x04mean\x12\x17\n" +
 	"\astd_dev\x18\x02 \x01(\x01R\x06stdDev\x12\x10\n" +
@@ -10474,12 +11596,14 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x03max\x18\x04 \x01(\x01R\x03max\x12\x16\n" +
 	"\x06median\x18\x05 \x01(\x01R\x06median\x126\n" +
 	"\x17confidence_interval_low\x18\x06 \x01(\x01R\x15confidenceIntervalLow\x128\n" +
-	"\x18confidence_interval_high\x18\a \x01(\x01R\x16confidenceIntervalHigh\"\xc0\x01\n" +
+	"\x18confidence_interval_high\x18\a \x01(\x01R\x16confidenceIntervalHigh\x122\n" +
+	"\x15effective_sample_size\x18\b \x01(\x01R\x13effectiveSampleSize\x123\n" +
+	"\x16relative_ci_half_width\x18\t \x01(\x01R\x13relativeCiHalfWidth\"\xc0\x01\n" +
 	"\fRiskAnalysis\x12>\n" +
 	"\x1bprobability_below_threshold\x18\x01 \x01(\x01R\x19probabilityBelowThreshold\x12\"\n" +
 	"\rvalue_at_risk\x18\x02 \x01(\x01R\vvalueAtRisk\x12&\n" +
 	"\x0fworst_case_flow\x18\x03 \x01(\x01R\rworstCaseFlow\x12$\n" +
-	"\x0ebest_case_flow\x18\x04 \x01(\x01R\fbestCaseFlow\"\xe1\x02\n" +
+	"\x0ebest_case_flow\x18\x04 \x01(\x01R\fbestCaseFlow\"\x80\x04\n" +
 	"\x17MonteCarloProgressEvent\x12\x1c\n" +
 	"\titeration\x18\x01 \x01(\x05R\titeration\x12)\n" +
 	"\x10total_iterations\x18\x02 \x01(\x05R\x0ftotalIterations\x12)\n" +
@@ -10488,13 +11612,30 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x0fcurrent_std_dev\x18\x05 \x01(\x01R\rcurrentStdDev\x12\x16\n" +
 	"\x06status\x18\x06 \x01(\tR\x06status\x12\x19\n" +
 	"\bis_final\x18\a \x01(\bR\aisFinal\x12K\n" +
-	"\ffinal_result\x18\b \x01(\v2(.logistics.gateway.v1.MonteCarloResponseR\vfinalResult\"\xd0\x01\n" +
+	"\ffinal_result\x18\b \x01(\v2(.logistics.gateway.v1.MonteCarloResponseR\vfinalResult\x12\"\n" +
+	"\rci_half_width\x18\t \x01(\x01R\vciHalfWidth\x123\n" +
+	"\x16relative_ci_half_width\x18\n" +
+	" \x01(\x01R\x13relativeCiHalfWidth\x12D\n" +
+	"\x1eestimated_iterations_remaining\x18\v \x01(\x05R\x1cestimatedIterationsRemaining\"\x91\x02\n" +
 	"\x12SensitivityRequest\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12J\n" +
 	"\n" +
 	"parameters\x18\x02 \x03(\v2*.logistics.gateway.v1.SensitivityParameterR\n" +
 	"parameters\x12<\n" +
-	"\talgorithm\x18\x03 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\x8e\x02\n" +
+	"\talgorithm\x18\x03 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\x12?\n" +
+	"\x06config\x18\x04 \x01(\v2'.logistics.gateway.v1.SensitivityConfigR\x06config\"\xaa\x02\n" +
+	"\x11SensitivityConfig\x12?\n" +
+	"\x06method\x18\x01 \x01(\x0e2'.logistics.gateway.v1.SensitivityMethodR\x06method\x12'\n" +
+	"\x0ffind_thresholds\x18\x02 \x01(\bR\x0efindThresholds\x12)\n" +
+	"\x10num_trajectories\x18\x03 \x01(\x05R\x0fnumTrajectories\x12\x1d\n" +
+	"\n" +
+	"num_levels\x18\x04 \x01(\x05R\tnumLevels\x12\x1f\n" +
+	"\vnum_samples\x18\x05 \x01(\x05R\n" +
+	"numSamples\x12\x1f\n" +
+	"\vrandom_seed\x18\x06 \x01(\x03R\n" +
+	"randomSeed\x12\x1f\n" +
+	"\vmax_workers\x18\a \x01(\x05R\n" +
+	"maxWorkers\"\x8e\x02\n" +
 	"\x14SensitivityParameter\x120\n" +
 	"\x04edge\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x04edge\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\x03R\x06nodeId\x12@\n" +
@@ -10507,14 +11648,27 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\aresults\x18\x02 \x03(\v2'.logistics.gateway.v1.SensitivityResultR\aresults\x12B\n" +
 	"\brankings\x18\x03 \x03(\v2&.logistics.gateway.v1.ParameterRankingR\brankings\x12D\n" +
 	"\bmetadata\x18\x04 \x01(\v2(.logistics.gateway.v1.SimulationMetadataR\bmetadata\x12#\n" +
-	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\xc1\x01\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\xb8\x02\n" +
 	"\x11SensitivityResult\x12!\n" +
 	"\fparameter_id\x18\x01 \x01(\tR\vparameterId\x12<\n" +
 	"\x05curve\x18\x02 \x03(\v2&.logistics.gateway.v1.SensitivityPointR\x05curve\x12\x1e\n" +
 	"\n" +
 	"elasticity\x18\x03 \x01(\x01R\n" +
 	"elasticity\x12+\n" +
-	"\x11sensitivity_index\x18\x04 \x01(\x01R\x10sensitivityIndex\"y\n" +
+	"\x11sensitivity_index\x18\x04 \x01(\x01R\x10sensitivityIndex\x12;\n" +
+	"\x06morris\x18\x05 \x01(\v2#.logistics.gateway.v1.MorrisIndicesR\x06morris\x128\n" +
+	"\x05sobol\x18\x06 \x01(\v2\".logistics.gateway.v1.SobolIndicesR\x05sobol\"N\n" +
+	"\rMorrisIndices\x12\x0e\n" +
+	"\x02mu\x18\x01 \x01(\x01R\x02mu\x12\x17\n" +
+	"\amu_star\x18\x02 \x01(\x01R\x06muStar\x12\x14\n" +
+	"\x05sigma\x18\x03 \x01(\x01R\x05sigma\"\xa4\x01\n" +
+	"\fSobolIndices\x12\x1f\n" +
+	"\vfirst_order\x18\x01 \x01(\x01R\n" +
+	"firstOrder\x12\x1f\n" +
+	"\vtotal_order\x18\x02 \x01(\x01R\n" +
+	"totalOrder\x12(\n" +
+	"\x10first_order_conf\x18\x03 \x01(\x01R\x0efirstOrderConf\x12(\n" +
+	"\x10total_order_conf\x18\x04 \x01(\x01R\x0etotalOrderConf\"y\n" +
 	"\x10SensitivityPoint\x12'\n" +
 	"\x0fparameter_value\x18\x01 \x01(\x01R\x0eparameterValue\x12\x1d\n" +
 	"\n" +
@@ -10529,12 +11683,13 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x11ResilienceRequest\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12>\n" +
 	"\x06config\x18\x02 \x01(\v2&.logistics.gateway.v1.ResilienceConfigR\x06config\x12<\n" +
-	"\talgorithm\x18\x03 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\x9c\x01\n" +
+	"\talgorithm\x18\x03 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\xcc\x01\n" +
 	"\x10ResilienceConfig\x12/\n" +
 	"\x14max_failures_to_test\x18\x01 \x01(\x05R\x11maxFailuresToTest\x126\n" +
 	"\x17test_cascading_failures\x18\x02 \x01(\bR\x15testCascadingFailures\x12\x1f\n" +
 	"\vload_factor\x18\x03 \x01(\x01R\n" +
-	"loadFactor\"\xa6\x02\n" +
+	"loadFactor\x12.\n" +
+	"\x13max_candidate_edges\x18\x04 \x01(\x05R\x11maxCandidateEdges\"\xb9\x03\n" +
 	"\x12ResilienceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12A\n" +
 	"\ametrics\x18\x02 \x01(\v2'.logistics.gateway.v1.ResilienceMetricsR\ametrics\x12H\n" +
@@ -10542,7 +11697,25 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"weaknesses\x18\x03 \x03(\v2(.logistics.gateway.v1.ResilienceWeaknessR\n" +
 	"weaknesses\x12D\n" +
 	"\bmetadata\x18\x04 \x01(\v2(.logistics.gateway.v1.SimulationMetadataR\bmetadata\x12#\n" +
-	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\xe7\x01\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12N\n" +
+	"\x13critical_edge_pairs\x18\x06 \x03(\v2\x1e.logistics.gateway.v1.EdgePairR\x11criticalEdgePairs\x12A\n" +
+	"\bcascades\x18\a \x03(\v2%.logistics.gateway.v1.CascadeScenarioR\bcascades\"\x9b\x01\n" +
+	"\bEdgePair\x122\n" +
+	"\x05edge1\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x05edge1\x122\n" +
+	"\x05edge2\x18\x02 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x05edge2\x12'\n" +
+	"\x0fcombined_impact\x18\x03 \x01(\x01R\x0ecombinedImpact\"\xfa\x01\n" +
+	"\x0fCascadeScenario\x12E\n" +
+	"\x0finitial_failure\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x0einitialFailure\x127\n" +
+	"\x05steps\x18\x02 \x03(\v2!.logistics.gateway.v1.CascadeStepR\x05steps\x12\x1d\n" +
+	"\n" +
+	"final_flow\x18\x03 \x01(\x01R\tfinalFlow\x12%\n" +
+	"\x0eflow_reduction\x18\x04 \x01(\x01R\rflowReduction\x12!\n" +
+	"\ffailed_edges\x18\x05 \x01(\x05R\vfailedEdges\"\x83\x01\n" +
+	"\vCascadeStep\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12?\n" +
+	"\ffailed_edges\x18\x02 \x03(\v2\x1c.logistics.common.v1.EdgeKeyR\vfailedEdges\x12\x1d\n" +
+	"\n" +
+	"flow_after\x18\x03 \x01(\x01R\tflowAfter\"\xe7\x01\n" +
 	"\x11ResilienceMetrics\x12#\n" +
 	"\roverall_score\x18\x01 \x01(\x01R\foverallScore\x127\n" +
 	"\x17connectivity_robustness\x18\x02 \x01(\x01R\x16connectivityRobustness\x12'\n" +
@@ -10927,7 +12100,11 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x0ealgorithm_used\x18\b \x01(\tR\ralgorithmUsed\x12=\n" +
 	"\fprocessed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\x12\x19\n" +
 	"\btrace_id\x18\n" +
-	" \x01(\tR\atraceId*\xa6\x01\n" +
+	" \x01(\tR\atraceId*_\n" +
+	"\tSolveMode\x12\x1a\n" +
+	"\x16SOLVE_MODE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SOLVE_MODE_MAX_FLOW\x10\x01\x12\x1d\n" +
+	"\x19SOLVE_MODE_TRANSPORTATION\x10\x02*\xa6\x01\n" +
 	"\x0fValidationLevel\x12 \n" +
 	"\x1cVALIDATION_LEVEL_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16VALIDATION_LEVEL_BASIC\x10\x01\x12\x1d\n" +
@@ -10958,12 +12135,34 @@ const file_logistics_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x10IMPACT_LEVEL_LOW\x10\x02\x12\x17\n" +
 	"\x13IMPACT_LEVEL_MEDIUM\x10\x03\x12\x15\n" +
 	"\x11IMPACT_LEVEL_HIGH\x10\x04\x12\x19\n" +
-	"\x15IMPACT_LEVEL_CRITICAL\x10\x05*\x94\x01\n" +
+	"\x15IMPACT_LEVEL_CRITICAL\x10\x05*\x9e\x01\n" +
+	"\x12CorrelationMeasure\x12#\n" +
+	"\x1fCORRELATION_MEASURE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCORRELATION_MEASURE_GAUSSIAN\x10\x01\x12 \n" +
+	"\x1cCORRELATION_MEASURE_SPEARMAN\x10\x02\x12\x1f\n" +
+	"\x1bCORRELATION_MEASURE_KENDALL\x10\x03*\xdc\x01\n" +
+	"\x14MonteCarloStopReason\x12'\n" +
+	"#MONTE_CARLO_STOP_REASON_UNSPECIFIED\x10\x00\x12%\n" +
+	"!MONTE_CARLO_STOP_REASON_COMPLETED\x10\x01\x12%\n" +
+	"!MONTE_CARLO_STOP_REASON_CONVERGED\x10\x02\x12'\n" +
+	"#MONTE_CARLO_STOP_REASON_TIME_BUDGET\x10\x03\x12$\n" +
+	" MONTE_CARLO_STOP_REASON_CANCELED\x10\x04*\xad\x01\n" +
+	"\x0eSamplingMethod\x12\x1f\n" +
+	"\x1bSAMPLING_METHOD_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SAMPLING_METHOD_RANDOM\x10\x01\x12#\n" +
+	"\x1fSAMPLING_METHOD_LATIN_HYPERCUBE\x10\x02\x12\x19\n" +
+	"\x15SAMPLING_METHOD_SOBOL\x10\x03\x12\x1e\n" +
+	"\x1aSAMPLING_METHOD_ANTITHETIC\x10\x04*\x94\x01\n" +
 	"\x10DistributionType\x12!\n" +
 	"\x1dDISTRIBUTION_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18DISTRIBUTION_TYPE_NORMAL\x10\x01\x12\x1d\n" +
 	"\x19DISTRIBUTION_TYPE_UNIFORM\x10\x02\x12 \n" +
-	"\x1cDISTRIBUTION_TYPE_TRIANGULAR\x10\x03*\xc0\x01\n" +
+	"\x1cDISTRIBUTION_TYPE_TRIANGULAR\x10\x03*\x9a\x01\n" +
+	"\x11SensitivityMethod\x12\"\n" +
+	"\x1eSENSITIVITY_METHOD_UNSPECIFIED\x10\x00\x12$\n" +
+	" SENSITIVITY_METHOD_ONE_AT_A_TIME\x10\x01\x12\x1d\n" +
+	"\x19SENSITIVITY_METHOD_MORRIS\x10\x02\x12\x1c\n" +
+	"\x18SENSITIVITY_METHOD_SOBOL\x10\x03*\xc0\x01\n" +
 	"\fReportFormat\x12\x1d\n" +
 	"\x19REPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16REPORT_FORMAT_MARKDOWN\x10\x01\x12\x15\n" +
@@ -11043,486 +12242,520 @@ func file_logistics_gateway_v1_gateway_proto_rawDescGZIP() []byte {
 	return file_logistics_gateway_v1_gateway_proto_rawDescData
 }
 
-var file_logistics_gateway_v1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_logistics_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 146)
+var file_logistics_gateway_v1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_logistics_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 155)
 var file_logistics_gateway_v1_gateway_proto_goTypes = []any{
-	(ValidationLevel)(0),                 // 0: logistics.gateway.v1.ValidationLevel
-	(BottleneckSeverity)(0),              // 1: logistics.gateway.v1.BottleneckSeverity
-	(ModificationType)(0),                // 2: logistics.gateway.v1.ModificationType
-	(ModificationTarget)(0),              // 3: logistics.gateway.v1.ModificationTarget
-	(ImpactLevel)(0),                     // 4: logistics.gateway.v1.ImpactLevel
-	(DistributionType)(0),                // 5: logistics.gateway.v1.DistributionType
-	(ReportFormat)(0),                    // 6: logistics.gateway.v1.ReportFormat
-	(ReportType)(0),                      // 7: logistics.gateway.v1.ReportType
-	(*HealthResponse)(nil),               // 8: logistics.gateway.v1.HealthResponse
-	(*ServiceHealth)(nil),                // 9: logistics.gateway.v1.ServiceHealth
-	(*ReadinessResponse)(nil),            // 10: logistics.gateway.v1.ReadinessResponse
-	(*InfoResponse)(nil),                 // 11: logistics.gateway.v1.InfoResponse
-	(*RateLimitInfo)(nil),                // 12: logistics.gateway.v1.RateLimitInfo
-	(*AlgorithmsResponse)(nil),           // 13: logistics.gateway.v1.AlgorithmsResponse
-	(*AlgorithmInfo)(nil),                // 14: logistics.gateway.v1.AlgorithmInfo
-	(*RegisterRequest)(nil),              // 15: logistics.gateway.v1.RegisterRequest
-	(*LoginRequest)(nil),                 // 16: logistics.gateway.v1.LoginRequest
-	(*RefreshTokenRequest)(nil),          // 17: logistics.gateway.v1.RefreshTokenRequest
-	(*ValidateTokenRequest)(nil),         // 18: logistics.gateway.v1.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),        // 19: logistics.gateway.v1.ValidateTokenResponse
-	(*AuthResponse)(nil),                 // 20: logistics.gateway.v1.AuthResponse
-	(*UserProfile)(nil),                  // 21: logistics.gateway.v1.UserProfile
-	(*CalculateLogisticsRequest)(nil),    // 22: logistics.gateway.v1.CalculateLogisticsRequest
-	(*CalculateLogisticsResponse)(nil),   // 23: logistics.gateway.v1.CalculateLogisticsResponse
-	(*SolveGraphRequest)(nil),            // 24: logistics.gateway.v1.SolveGraphRequest
-	(*SolveGraphResponse)(nil),           // 25: logistics.gateway.v1.SolveGraphResponse
-	(*SolveProgressEvent)(nil),           // 26: logistics.gateway.v1.SolveProgressEvent
-	(*BatchSolveRequest)(nil),            // 27: logistics.gateway.v1.BatchSolveRequest
-	(*BatchSolveItem)(nil),               // 28: logistics.gateway.v1.BatchSolveItem
-	(*BatchSolveResponse)(nil),           // 29: logistics.gateway.v1.BatchSolveResponse
-	(*BatchSolveResult)(nil),             // 30: logistics.gateway.v1.BatchSolveResult
-	(*SolveOptions)(nil),                 // 31: logistics.gateway.v1.SolveOptions
-	(*SolveMetrics)(nil),                 // 32: logistics.gateway.v1.SolveMetrics
-	(*ValidateGraphRequest)(nil),         // 33: logistics.gateway.v1.ValidateGraphRequest
-	(*ValidateGraphResponse)(nil),        // 34: logistics.gateway.v1.ValidateGraphResponse
-	(*ValidateForAlgorithmRequest)(nil),  // 35: logistics.gateway.v1.ValidateForAlgorithmRequest
-	(*ValidateForAlgorithmResponse)(nil), // 36: logistics.gateway.v1.ValidateForAlgorithmResponse
-	(*AlgorithmComplexityEstimate)(nil),  // 37: logistics.gateway.v1.AlgorithmComplexityEstimate
-	(*ValidationResult)(nil),             // 38: logistics.gateway.v1.ValidationResult
-	(*ValidationMetrics)(nil),            // 39: logistics.gateway.v1.ValidationMetrics
-	(*AnalyzeGraphRequest)(nil),          // 40: logistics.gateway.v1.AnalyzeGraphRequest
-	(*AnalyzeGraphResponse)(nil),         // 41: logistics.gateway.v1.AnalyzeGraphResponse
-	(*AnalysisOptions)(nil),              // 42: logistics.gateway.v1.AnalysisOptions
-	(*CalculateCostRequest)(nil),         // 43: logistics.gateway.v1.CalculateCostRequest
-	(*CalculateCostResponse)(nil),        // 44: logistics.gateway.v1.CalculateCostResponse
-	(*CostOptions)(nil),                  // 45: logistics.gateway.v1.CostOptions
-	(*CostBreakdown)(nil),                // 46: logistics.gateway.v1.CostBreakdown
-	(*CostAnalysis)(nil),                 // 47: logistics.gateway.v1.CostAnalysis
-	(*BottlenecksRequest)(nil),           // 48: logistics.gateway.v1.BottlenecksRequest
-	(*BottlenecksResponse)(nil),          // 49: logistics.gateway.v1.BottlenecksResponse
-	(*Bottleneck)(nil),                   // 50: logistics.gateway.v1.Bottleneck
-	(*Recommendation)(nil),               // 51: logistics.gateway.v1.Recommendation
-	(*BottleneckAnalysis)(nil),           // 52: logistics.gateway.v1.BottleneckAnalysis
-	(*EfficiencyReport)(nil),             // 53: logistics.gateway.v1.EfficiencyReport
-	(*CompareScenariosRequest)(nil),      // 54: logistics.gateway.v1.CompareScenariosRequest
-	(*ScenarioInput)(nil),                // 55: logistics.gateway.v1.ScenarioInput
-	(*CompareScenariosResponse)(nil),     // 56: logistics.gateway.v1.CompareScenariosResponse
-	(*ScenarioResult)(nil),               // 57: logistics.gateway.v1.ScenarioResult
-	(*AnalyticsResult)(nil),              // 58: logistics.gateway.v1.AnalyticsResult
-	(*SolveResult)(nil),                  // 59: logistics.gateway.v1.SolveResult
-	(*WhatIfRequest)(nil),                // 60: logistics.gateway.v1.WhatIfRequest
-	(*Modification)(nil),                 // 61: logistics.gateway.v1.Modification
-	(*WhatIfOptions)(nil),                // 62: logistics.gateway.v1.WhatIfOptions
-	(*WhatIfResponse)(nil),               // 63: logistics.gateway.v1.WhatIfResponse
-	(*ScenarioComparison)(nil),           // 64: logistics.gateway.v1.ScenarioComparison
-	(*MonteCarloRequest)(nil),            // 65: logistics.gateway.v1.MonteCarloRequest
-	(*MonteCarloConfig)(nil),             // 66: logistics.gateway.v1.MonteCarloConfig
-	(*UncertaintySpec)(nil),              // 67: logistics.gateway.v1.UncertaintySpec
-	(*Distribution)(nil),                 // 68: logistics.gateway.v1.Distribution
-	(*MonteCarloResponse)(nil),           // 69: logistics.gateway.v1.MonteCarloResponse
-	(*MonteCarloStats)(nil),              // 70: logistics.gateway.v1.MonteCarloStats
-	(*RiskAnalysis)(nil),                 // 71: logistics.gateway.v1.RiskAnalysis
-	(*MonteCarloProgressEvent)(nil),      // 72: logistics.gateway.v1.MonteCarloProgressEvent
-	(*SensitivityRequest)(nil),           // 73: logistics.gateway.v1.SensitivityRequest
-	(*SensitivityParameter)(nil),         // 74: logistics.gateway.v1.SensitivityParameter
-	(*SensitivityResponse)(nil),          // 75: logistics.gateway.v1.SensitivityResponse
-	(*SensitivityResult)(nil),            // 76: logistics.gateway.v1.SensitivityResult
-	(*SensitivityPoint)(nil),             // 77: logistics.gateway.v1.SensitivityPoint
-	(*ParameterRanking)(nil),             // 78: logistics.gateway.v1.ParameterRanking
-	(*ResilienceRequest)(nil),            // 79: logistics.gateway.v1.ResilienceRequest
-	(*ResilienceConfig)(nil),             // 80: logistics.gateway.v1.ResilienceConfig
-	(*ResilienceResponse)(nil),           // 81: logistics.gateway.v1.ResilienceResponse
-	(*ResilienceMetrics)(nil),            // 82: logistics.gateway.v1.ResilienceMetrics
-	(*ResilienceWeakness)(nil),           // 83: logistics.gateway.v1.ResilienceWeakness
-	(*FailureSimulationRequest)(nil),     // 84: logistics.gateway.v1.FailureSimulationRequest
-	(*FailureScenario)(nil),              // 85: logistics.gateway.v1.FailureScenario
-	(*FailureSimulationResponse)(nil),    // 86: logistics.gateway.v1.FailureSimulationResponse
-	(*FailureScenarioResult)(nil),        // 87: logistics.gateway.v1.FailureScenarioResult
-	(*FailureStats)(nil),                 // 88: logistics.gateway.v1.FailureStats
-	(*CriticalElementsRequest)(nil),      // 89: logistics.gateway.v1.CriticalElementsRequest
-	(*CriticalElementsConfig)(nil),       // 90: logistics.gateway.v1.CriticalElementsConfig
-	(*CriticalElementsResponse)(nil),     // 91: logistics.gateway.v1.CriticalElementsResponse
-	(*CriticalEdge)(nil),                 // 92: logistics.gateway.v1.CriticalEdge
-	(*CriticalNode)(nil),                 // 93: logistics.gateway.v1.CriticalNode
-	(*SimulationMetadata)(nil),           // 94: logistics.gateway.v1.SimulationMetadata
-	(*GetSimulationRequest)(nil),         // 95: logistics.gateway.v1.GetSimulationRequest
-	(*ListSimulationsRequest)(nil),       // 96: logistics.gateway.v1.ListSimulationsRequest
-	(*ListSimulationsResponse)(nil),      // 97: logistics.gateway.v1.ListSimulationsResponse
-	(*SimulationRecord)(nil),             // 98: logistics.gateway.v1.SimulationRecord
-	(*DeleteSimulationRequest)(nil),      // 99: logistics.gateway.v1.DeleteSimulationRequest
-	(*SaveCalculationRequest)(nil),       // 100: logistics.gateway.v1.SaveCalculationRequest
-	(*SaveCalculationResponse)(nil),      // 101: logistics.gateway.v1.SaveCalculationResponse
-	(*GetCalculationRequest)(nil),        // 102: logistics.gateway.v1.GetCalculationRequest
-	(*ListCalculationsRequest)(nil),      // 103: logistics.gateway.v1.ListCalculationsRequest
-	(*ListCalculationsResponse)(nil),     // 104: logistics.gateway.v1.ListCalculationsResponse
-	(*CalculationRecord)(nil),            // 105: logistics.gateway.v1.CalculationRecord
-	(*CalculationSummary)(nil),           // 106: logistics.gateway.v1.CalculationSummary
-	(*DeleteCalculationRequest)(nil),     // 107: logistics.gateway.v1.DeleteCalculationRequest
-	(*GetStatisticsRequest)(nil),         // 108: logistics.gateway.v1.GetStatisticsRequest
-	(*StatisticsResponse)(nil),           // 109: logistics.gateway.v1.StatisticsResponse
-	(*DailyStats)(nil),                   // 110: logistics.gateway.v1.DailyStats
-	(*GenerateReportRequest)(nil),        // 111: logistics.gateway.v1.GenerateReportRequest
-	(*ReportOptions)(nil),                // 112: logistics.gateway.v1.ReportOptions
-	(*FlowReportSource)(nil),             // 113: logistics.gateway.v1.FlowReportSource
-	(*AnalyticsReportSource)(nil),        // 114: logistics.gateway.v1.AnalyticsReportSource
-	(*SimulationReportSource)(nil),       // 115: logistics.gateway.v1.SimulationReportSource
-	(*HistoryReportSource)(nil),          // 116: logistics.gateway.v1.HistoryReportSource
-	(*GenerateReportResponse)(nil),       // 117: logistics.gateway.v1.GenerateReportResponse
-	(*ReportInfo)(nil),                   // 118: logistics.gateway.v1.ReportInfo
-	(*GetReportRequest)(nil),             // 119: logistics.gateway.v1.GetReportRequest
-	(*DownloadReportRequest)(nil),        // 120: logistics.gateway.v1.DownloadReportRequest
-	(*ReportChunk)(nil),                  // 121: logistics.gateway.v1.ReportChunk
-	(*ReportRecord)(nil),                 // 122: logistics.gateway.v1.ReportRecord
-	(*ListReportsRequest)(nil),           // 123: logistics.gateway.v1.ListReportsRequest
-	(*ListReportsResponse)(nil),          // 124: logistics.gateway.v1.ListReportsResponse
-	(*DeleteReportRequest)(nil),          // 125: logistics.gateway.v1.DeleteReportRequest
-	(*ReportFormatsResponse)(nil),        // 126: logistics.gateway.v1.ReportFormatsResponse
-	(*ReportFormatInfo)(nil),             // 127: logistics.gateway.v1.ReportFormatInfo
-	(*GetAuditLogsRequest)(nil),          // 128: logistics.gateway.v1.GetAuditLogsRequest
-	(*AuditLogsResponse)(nil),            // 129: logistics.gateway.v1.AuditLogsResponse
-	(*AuditEntry)(nil),                   // 130: logistics.gateway.v1.AuditEntry
-	(*GetUserActivityRequest)(nil),       // 131: logistics.gateway.v1.GetUserActivityRequest
-	(*UserActivityResponse)(nil),         // 132: logistics.gateway.v1.UserActivityResponse
-	(*UserActivitySummary)(nil),          // 133: logistics.gateway.v1.UserActivitySummary
-	(*GetAuditStatsRequest)(nil),         // 134: logistics.gateway.v1.GetAuditStatsRequest
-	(*AuditStatsResponse)(nil),           // 135: logistics.gateway.v1.AuditStatsResponse
-	(*AuditStatsPoint)(nil),              // 136: logistics.gateway.v1.AuditStatsPoint
-	(*RequestMetadata)(nil),              // 137: logistics.gateway.v1.RequestMetadata
-	nil,                                  // 138: logistics.gateway.v1.HealthResponse.ServicesEntry
-	nil,                                  // 139: logistics.gateway.v1.ReadinessResponse.DependenciesEntry
-	nil,                                  // 140: logistics.gateway.v1.InfoResponse.BuildInfoEntry
-	nil,                                  // 141: logistics.gateway.v1.CalculateLogisticsRequest.TagsEntry
-	nil,                                  // 142: logistics.gateway.v1.CostOptions.CostMultipliersEntry
-	nil,                                  // 143: logistics.gateway.v1.CostBreakdown.CostByRoadTypeEntry
-	nil,                                  // 144: logistics.gateway.v1.CostBreakdown.CostByNodeTypeEntry
-	nil,                                  // 145: logistics.gateway.v1.SimulationRecord.TagsEntry
-	nil,                                  // 146: logistics.gateway.v1.SaveCalculationRequest.TagsEntry
-	nil,                                  // 147: logistics.gateway.v1.CalculationRecord.TagsEntry
-	nil,                                  // 148: logistics.gateway.v1.StatisticsResponse.CalculationsByAlgorithmEntry
-	nil,                                  // 149: logistics.gateway.v1.AuditEntry.MetadataEntry
-	nil,                                  // 150: logistics.gateway.v1.UserActivitySummary.ActionsByTypeEntry
-	nil,                                  // 151: logistics.gateway.v1.UserActivitySummary.ActionsByServiceEntry
-	nil,                                  // 152: logistics.gateway.v1.AuditStatsResponse.ByServiceEntry
-	nil,                                  // 153: logistics.gateway.v1.AuditStatsResponse.ByActionEntry
-	(*timestamppb.Timestamp)(nil),        // 154: google.protobuf.Timestamp
-	(v1.Algorithm)(0),                    // 155: logistics.common.v1.Algorithm
-	(*v1.Graph)(nil),                     // 156: logistics.common.v1.Graph
-	(*v1.ErrorDetail)(nil),               // 157: logistics.common.v1.ErrorDetail
-	(*v1.FlowResult)(nil),                // 158: logistics.common.v1.FlowResult
-	(*v1.Path)(nil),                      // 159: logistics.common.v1.Path
-	(*v1.ValidationError)(nil),           // 160: logistics.common.v1.ValidationError
-	(*v1.GraphStatistics)(nil),           // 161: logistics.common.v1.GraphStatistics
-	(*v1.FlowStatistics)(nil),            // 162: logistics.common.v1.FlowStatistics
-	(*v1.EdgeKey)(nil),                   // 163: logistics.common.v1.EdgeKey
-	(v1.FlowStatus)(0),                   // 164: logistics.common.v1.FlowStatus
-	(*emptypb.Empty)(nil),                // 165: google.protobuf.Empty
+	(SolveMode)(0),                       // 0: logistics.gateway.v1.SolveMode
+	(ValidationLevel)(0),                 // 1: logistics.gateway.v1.ValidationLevel
+	(BottleneckSeverity)(0),              // 2: logistics.gateway.v1.BottleneckSeverity
+	(ModificationType)(0),                // 3: logistics.gateway.v1.ModificationType
+	(ModificationTarget)(0),              // 4: logistics.gateway.v1.ModificationTarget
+	(ImpactLevel)(0),                     // 5: logistics.gateway.v1.ImpactLevel
+	(CorrelationMeasure)(0),              // 6: logistics.gateway.v1.CorrelationMeasure
+	(MonteCarloStopReason)(0),            // 7: logistics.gateway.v1.MonteCarloStopReason
+	(SamplingMethod)(0),                  // 8: logistics.gateway.v1.SamplingMethod
+	(DistributionType)(0),                // 9: logistics.gateway.v1.DistributionType
+	(SensitivityMethod)(0),               // 10: logistics.gateway.v1.SensitivityMethod
+	(ReportFormat)(0),                    // 11: logistics.gateway.v1.ReportFormat
+	(ReportType)(0),                      // 12: logistics.gateway.v1.ReportType
+	(*HealthResponse)(nil),               // 13: logistics.gateway.v1.HealthResponse
+	(*ServiceHealth)(nil),                // 14: logistics.gateway.v1.ServiceHealth
+	(*ReadinessResponse)(nil),            // 15: logistics.gateway.v1.ReadinessResponse
+	(*InfoResponse)(nil),                 // 16: logistics.gateway.v1.InfoResponse
+	(*RateLimitInfo)(nil),                // 17: logistics.gateway.v1.RateLimitInfo
+	(*AlgorithmsResponse)(nil),           // 18: logistics.gateway.v1.AlgorithmsResponse
+	(*AlgorithmInfo)(nil),                // 19: logistics.gateway.v1.AlgorithmInfo
+	(*RegisterRequest)(nil),              // 20: logistics.gateway.v1.RegisterRequest
+	(*LoginRequest)(nil),                 // 21: logistics.gateway.v1.LoginRequest
+	(*RefreshTokenRequest)(nil),          // 22: logistics.gateway.v1.RefreshTokenRequest
+	(*ValidateTokenRequest)(nil),         // 23: logistics.gateway.v1.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),        // 24: logistics.gateway.v1.ValidateTokenResponse
+	(*AuthResponse)(nil),                 // 25: logistics.gateway.v1.AuthResponse
+	(*UserProfile)(nil),                  // 26: logistics.gateway.v1.UserProfile
+	(*CalculateLogisticsRequest)(nil),    // 27: logistics.gateway.v1.CalculateLogisticsRequest
+	(*CalculateLogisticsResponse)(nil),   // 28: logistics.gateway.v1.CalculateLogisticsResponse
+	(*SolveGraphRequest)(nil),            // 29: logistics.gateway.v1.SolveGraphRequest
+	(*SolveGraphResponse)(nil),           // 30: logistics.gateway.v1.SolveGraphResponse
+	(*SolveProgressEvent)(nil),           // 31: logistics.gateway.v1.SolveProgressEvent
+	(*BatchSolveRequest)(nil),            // 32: logistics.gateway.v1.BatchSolveRequest
+	(*BatchSolveItem)(nil),               // 33: logistics.gateway.v1.BatchSolveItem
+	(*BatchSolveResponse)(nil),           // 34: logistics.gateway.v1.BatchSolveResponse
+	(*BatchSolveResult)(nil),             // 35: logistics.gateway.v1.BatchSolveResult
+	(*SolveOptions)(nil),                 // 36: logistics.gateway.v1.SolveOptions
+	(*SolveMetrics)(nil),                 // 37: logistics.gateway.v1.SolveMetrics
+	(*ValidateGraphRequest)(nil),         // 38: logistics.gateway.v1.ValidateGraphRequest
+	(*ValidateGraphResponse)(nil),        // 39: logistics.gateway.v1.ValidateGraphResponse
+	(*ValidateForAlgorithmRequest)(nil),  // 40: logistics.gateway.v1.ValidateForAlgorithmRequest
+	(*ValidateForAlgorithmResponse)(nil), // 41: logistics.gateway.v1.ValidateForAlgorithmResponse
+	(*AlgorithmComplexityEstimate)(nil),  // 42: logistics.gateway.v1.AlgorithmComplexityEstimate
+	(*ValidationResult)(nil),             // 43: logistics.gateway.v1.ValidationResult
+	(*ValidationMetrics)(nil),            // 44: logistics.gateway.v1.ValidationMetrics
+	(*AnalyzeGraphRequest)(nil),          // 45: logistics.gateway.v1.AnalyzeGraphRequest
+	(*AnalyzeGraphResponse)(nil),         // 46: logistics.gateway.v1.AnalyzeGraphResponse
+	(*AnalysisOptions)(nil),              // 47: logistics.gateway.v1.AnalysisOptions
+	(*CalculateCostRequest)(nil),         // 48: logistics.gateway.v1.CalculateCostRequest
+	(*CalculateCostResponse)(nil),        // 49: logistics.gateway.v1.CalculateCostResponse
+	(*CostOptions)(nil),                  // 50: logistics.gateway.v1.CostOptions
+	(*CostBreakdown)(nil),                // 51: logistics.gateway.v1.CostBreakdown
+	(*CostAnalysis)(nil),                 // 52: logistics.gateway.v1.CostAnalysis
+	(*BottlenecksRequest)(nil),           // 53: logistics.gateway.v1.BottlenecksRequest
+	(*BottlenecksResponse)(nil),          // 54: logistics.gateway.v1.BottlenecksResponse
+	(*Bottleneck)(nil),                   // 55: logistics.gateway.v1.Bottleneck
+	(*Recommendation)(nil),               // 56: logistics.gateway.v1.Recommendation
+	(*BottleneckAnalysis)(nil),           // 57: logistics.gateway.v1.BottleneckAnalysis
+	(*EfficiencyReport)(nil),             // 58: logistics.gateway.v1.EfficiencyReport
+	(*CompareScenariosRequest)(nil),      // 59: logistics.gateway.v1.CompareScenariosRequest
+	(*ScenarioInput)(nil),                // 60: logistics.gateway.v1.ScenarioInput
+	(*CompareScenariosResponse)(nil),     // 61: logistics.gateway.v1.CompareScenariosResponse
+	(*ScenarioResult)(nil),               // 62: logistics.gateway.v1.ScenarioResult
+	(*AnalyticsResult)(nil),              // 63: logistics.gateway.v1.AnalyticsResult
+	(*SolveResult)(nil),                  // 64: logistics.gateway.v1.SolveResult
+	(*WhatIfRequest)(nil),                // 65: logistics.gateway.v1.WhatIfRequest
+	(*Modification)(nil),                 // 66: logistics.gateway.v1.Modification
+	(*WhatIfOptions)(nil),                // 67: logistics.gateway.v1.WhatIfOptions
+	(*WhatIfResponse)(nil),               // 68: logistics.gateway.v1.WhatIfResponse
+	(*ScenarioComparison)(nil),           // 69: logistics.gateway.v1.ScenarioComparison
+	(*MonteCarloRequest)(nil),            // 70: logistics.gateway.v1.MonteCarloRequest
+	(*UncertaintyCorrelation)(nil),       // 71: logistics.gateway.v1.UncertaintyCorrelation
+	(*CorrelationGroup)(nil),             // 72: logistics.gateway.v1.CorrelationGroup
+	(*MonteCarloConfig)(nil),             // 73: logistics.gateway.v1.MonteCarloConfig
+	(*UncertaintySpec)(nil),              // 74: logistics.gateway.v1.UncertaintySpec
+	(*Distribution)(nil),                 // 75: logistics.gateway.v1.Distribution
+	(*MonteCarloResponse)(nil),           // 76: logistics.gateway.v1.MonteCarloResponse
+	(*MonteCarloSample)(nil),             // 77: logistics.gateway.v1.MonteCarloSample
+	(*MonteCarloStats)(nil),              // 78: logistics.gateway.v1.MonteCarloStats
+	(*RiskAnalysis)(nil),                 // 79: logistics.gateway.v1.RiskAnalysis
+	(*MonteCarloProgressEvent)(nil),      // 80: logistics.gateway.v1.MonteCarloProgressEvent
+	(*SensitivityRequest)(nil),           // 81: logistics.gateway.v1.SensitivityRequest
+	(*SensitivityConfig)(nil),            // 82: logistics.gateway.v1.SensitivityConfig
+	(*SensitivityParameter)(nil),         // 83: logistics.gateway.v1.SensitivityParameter
+	(*SensitivityResponse)(nil),          // 84: logistics.gateway.v1.SensitivityResponse
+	(*SensitivityResult)(nil),            // 85: logistics.gateway.v1.SensitivityResult
+	(*MorrisIndices)(nil),                // 86: logistics.gateway.v1.MorrisIndices
+	(*SobolIndices)(nil),                 // 87: logistics.gateway.v1.SobolIndices
+	(*SensitivityPoint)(nil),             // 88: logistics.gateway.v1.SensitivityPoint
+	(*ParameterRanking)(nil),             // 89: logistics.gateway.v1.ParameterRanking
+	(*ResilienceRequest)(nil),            // 90: logistics.gateway.v1.ResilienceRequest
+	(*ResilienceConfig)(nil),             // 91: logistics.gateway.v1.ResilienceConfig
+	(*ResilienceResponse)(nil),           // 92: logistics.gateway.v1.ResilienceResponse
+	(*EdgePair)(nil),                     // 93: logistics.gateway.v1.EdgePair
+	(*CascadeScenario)(nil),              // 94: logistics.gateway.v1.CascadeScenario
+	(*CascadeStep)(nil),                  // 95: logistics.gateway.v1.CascadeStep
+	(*ResilienceMetrics)(nil),            // 96: logistics.gateway.v1.ResilienceMetrics
+	(*ResilienceWeakness)(nil),           // 97: logistics.gateway.v1.ResilienceWeakness
+	(*FailureSimulationRequest)(nil),     // 98: logistics.gateway.v1.FailureSimulationRequest
+	(*FailureScenario)(nil),              // 99: logistics.gateway.v1.FailureScenario
+	(*FailureSimulationResponse)(nil),    // 100: logistics.gateway.v1.FailureSimulationResponse
+	(*FailureScenarioResult)(nil),        // 101: logistics.gateway.v1.FailureScenarioResult
+	(*FailureStats)(nil),                 // 102: logistics.gateway.v1.FailureStats
+	(*CriticalElementsRequest)(nil),      // 103: logistics.gateway.v1.CriticalElementsRequest
+	(*CriticalElementsConfig)(nil),       // 104: logistics.gateway.v1.CriticalElementsConfig
+	(*CriticalElementsResponse)(nil),     // 105: logistics.gateway.v1.CriticalElementsResponse
+	(*CriticalEdge)(nil),                 // 106: logistics.gateway.v1.CriticalEdge
+	(*CriticalNode)(nil),                 // 107: logistics.gateway.v1.CriticalNode
+	(*SimulationMetadata)(nil),           // 108: logistics.gateway.v1.SimulationMetadata
+	(*GetSimulationRequest)(nil),         // 109: logistics.gateway.v1.GetSimulationRequest
+	(*ListSimulationsRequest)(nil),       // 110: logistics.gateway.v1.ListSimulationsRequest
+	(*ListSimulationsResponse)(nil),      // 111: logistics.gateway.v1.ListSimulationsResponse
+	(*SimulationRecord)(nil),             // 112: logistics.gateway.v1.SimulationRecord
+	(*DeleteSimulationRequest)(nil),      // 113: logistics.gateway.v1.DeleteSimulationRequest
+	(*SaveCalculationRequest)(nil),       // 114: logistics.gateway.v1.SaveCalculationRequest
+	(*SaveCalculationResponse)(nil),      // 115: logistics.gateway.v1.SaveCalculationResponse
+	(*GetCalculationRequest)(nil),        // 116: logistics.gateway.v1.GetCalculationRequest
+	(*ListCalculationsRequest)(nil),      // 117: logistics.gateway.v1.ListCalculationsRequest
+	(*ListCalculationsResponse)(nil),     // 118: logistics.gateway.v1.ListCalculationsResponse
+	(*CalculationRecord)(nil),            // 119: logistics.gateway.v1.CalculationRecord
+	(*CalculationSummary)(nil),           // 120: logistics.gateway.v1.CalculationSummary
+	(*DeleteCalculationRequest)(nil),     // 121: logistics.gateway.v1.DeleteCalculationRequest
+	(*GetStatisticsRequest)(nil),         // 122: logistics.gateway.v1.GetStatisticsRequest
+	(*StatisticsResponse)(nil),           // 123: logistics.gateway.v1.StatisticsResponse
+	(*DailyStats)(nil),                   // 124: logistics.gateway.v1.DailyStats
+	(*GenerateReportRequest)(nil),        // 125: logistics.gateway.v1.GenerateReportRequest
+	(*ReportOptions)(nil),                // 126: logistics.gateway.v1.ReportOptions
+	(*FlowReportSource)(nil),             // 127: logistics.gateway.v1.FlowReportSource
+	(*AnalyticsReportSource)(nil),        // 128: logistics.gateway.v1.AnalyticsReportSource
+	(*SimulationReportSource)(nil),       // 129: logistics.gateway.v1.SimulationReportSource
+	(*HistoryReportSource)(nil),          // 130: logistics.gateway.v1.HistoryReportSource
+	(*GenerateReportResponse)(nil),       // 131: logistics.gateway.v1.GenerateReportResponse
+	(*ReportInfo)(nil),                   // 132: logistics.gateway.v1.ReportInfo
+	(*GetReportRequest)(nil),             // 133: logistics.gateway.v1.GetReportRequest
+	(*DownloadReportRequest)(nil),        // 134: logistics.gateway.v1.DownloadReportRequest
+	(*ReportChunk)(nil),                  // 135: logistics.gateway.v1.ReportChunk
+	(*ReportRecord)(nil),                 // 136: logistics.gateway.v1.ReportRecord
+	(*ListReportsRequest)(nil),           // 137: logistics.gateway.v1.ListReportsRequest
+	(*ListReportsResponse)(nil),          // 138: logistics.gateway.v1.ListReportsResponse
+	(*DeleteReportRequest)(nil),          // 139: logistics.gateway.v1.DeleteReportRequest
+	(*ReportFormatsResponse)(nil),        // 140: logistics.gateway.v1.ReportFormatsResponse
+	(*ReportFormatInfo)(nil),             // 141: logistics.gateway.v1.ReportFormatInfo
+	(*GetAuditLogsRequest)(nil),          // 142: logistics.gateway.v1.GetAuditLogsRequest
+	(*AuditLogsResponse)(nil),            // 143: logistics.gateway.v1.AuditLogsResponse
+	(*AuditEntry)(nil),                   // 144: logistics.gateway.v1.AuditEntry
+	(*GetUserActivityRequest)(nil),       // 145: logistics.gateway.v1.GetUserActivityRequest
+	(*UserActivityResponse)(nil),         // 146: logistics.gateway.v1.UserActivityResponse
+	(*UserActivitySummary)(nil),          // 147: logistics.gateway.v1.UserActivitySummary
+	(*GetAuditStatsRequest)(nil),         // 148: logistics.gateway.v1.GetAuditStatsRequest
+	(*AuditStatsResponse)(nil),           // 149: logistics.gateway.v1.AuditStatsResponse
+	(*AuditStatsPoint)(nil),              // 150: logistics.gateway.v1.AuditStatsPoint
+	(*RequestMetadata)(nil),              // 151: logistics.gateway.v1.RequestMetadata
+	nil,                                  // 152: logistics.gateway.v1.HealthResponse.ServicesEntry
+	nil,                                  // 153: logistics.gateway.v1.ReadinessResponse.DependenciesEntry
+	nil,                                  // 154: logistics.gateway.v1.InfoResponse.BuildInfoEntry
+	nil,                                  // 155: logistics.gateway.v1.CalculateLogisticsRequest.TagsEntry
+	nil,                                  // 156: logistics.gateway.v1.CostOptions.CostMultipliersEntry
+	nil,                                  // 157: logistics.gateway.v1.CostBreakdown.CostByRoadTypeEntry
+	nil,                                  // 158: logistics.gateway.v1.CostBreakdown.CostByNodeTypeEntry
+	nil,                                  // 159: logistics.gateway.v1.SimulationRecord.TagsEntry
+	nil,                                  // 160: logistics.gateway.v1.SaveCalculationRequest.TagsEntry
+	nil,                                  // 161: logistics.gateway.v1.CalculationRecord.TagsEntry
+	nil,                                  // 162: logistics.gateway.v1.StatisticsResponse.CalculationsByAlgorithmEntry
+	nil,                                  // 163: logistics.gateway.v1.AuditEntry.MetadataEntry
+	nil,                                  // 164: logistics.gateway.v1.UserActivitySummary.ActionsByTypeEntry
+	nil,                                  // 165: logistics.gateway.v1.UserActivitySummary.ActionsByServiceEntry
+	nil,                                  // 166: logistics.gateway.v1.AuditStatsResponse.ByServiceEntry
+	nil,                                  // 167: logistics.gateway.v1.AuditStatsResponse.ByActionEntry
+	(*timestamppb.Timestamp)(nil),        // 168: google.protobuf.Timestamp
+	(v1.Algorithm)(0),                    // 169: logistics.common.v1.Algorithm
+	(*v1.Graph)(nil),                     // 170: logistics.common.v1.Graph
+	(*v1.ErrorDetail)(nil),               // 171: logistics.common.v1.ErrorDetail
+	(*v1.FlowResult)(nil),                // 172: logistics.common.v1.FlowResult
+	(*v1.Path)(nil),                      // 173: logistics.common.v1.Path
+	(*v1.ValidationError)(nil),           // 174: logistics.common.v1.ValidationError
+	(*v1.GraphStatistics)(nil),           // 175: logistics.common.v1.GraphStatistics
+	(*v1.FlowStatistics)(nil),            // 176: logistics.common.v1.FlowStatistics
+	(*v1.EdgeKey)(nil),                   // 177: logistics.common.v1.EdgeKey
+	(v1.FlowStatus)(0),                   // 178: logistics.common.v1.FlowStatus
+	(*v1.FlowDecomposition)(nil),         // 179: logistics.common.v1.FlowDecomposition
+	(*emptypb.Empty)(nil),                // 180: google.protobuf.Empty
 }
 var file_logistics_gateway_v1_gateway_proto_depIdxs = []int32{
-	154, // 0: logistics.gateway.v1.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
-	138, // 1: logistics.gateway.v1.HealthResponse.services:type_name -> logistics.gateway.v1.HealthResponse.ServicesEntry
-	139, // 2: logistics.gateway.v1.ReadinessResponse.dependencies:type_name -> logistics.gateway.v1.ReadinessResponse.DependenciesEntry
-	154, // 3: logistics.gateway.v1.InfoResponse.started_at:type_name -> google.protobuf.Timestamp
-	12,  // 4: logistics.gateway.v1.InfoResponse.rate_limit:type_name -> logistics.gateway.v1.RateLimitInfo
-	140, // 5: logistics.gateway.v1.InfoResponse.build_info:type_name -> logistics.gateway.v1.InfoResponse.BuildInfoEntry
-	14,  // 6: logistics.gateway.v1.AlgorithmsResponse.algorithms:type_name -> logistics.gateway.v1.AlgorithmInfo
-	155, // 7: logistics.gateway.v1.AlgorithmInfo.algorithm:type_name -> logistics.common.v1.Algorithm
-	21,  // 8: logistics.gateway.v1.AuthResponse.user:type_name -> logistics.gateway.v1.UserProfile
-	154, // 9: logistics.gateway.v1.UserProfile.created_at:type_name -> google.protobuf.Timestamp
-	154, // 10: logistics.gateway.v1.UserProfile.last_login:type_name -> google.protobuf.Timestamp
-	156, // 11: logistics.gateway.v1.CalculateLogisticsRequest.graph:type_name -> logistics.common.v1.Graph
-	155, // 12: logistics.gateway.v1.CalculateLogisticsRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	0,   // 13: logistics.gateway.v1.CalculateLogisticsRequest.validation_level:type_name -> logistics.gateway.v1.ValidationLevel
-	31,  // 14: logistics.gateway.v1.CalculateLogisticsRequest.solve_options:type_name -> logistics.gateway.v1.SolveOptions
-	45,  // 15: logistics.gateway.v1.CalculateLogisticsRequest.cost_options:type_name -> logistics.gateway.v1.CostOptions
-	141, // 16: logistics.gateway.v1.CalculateLogisticsRequest.tags:type_name -> logistics.gateway.v1.CalculateLogisticsRequest.TagsEntry
-	6,   // 17: logistics.gateway.v1.CalculateLogisticsRequest.report_format:type_name -> logistics.gateway.v1.ReportFormat
-	112, // 18: logistics.gateway.v1.CalculateLogisticsRequest.report_options:type_name -> logistics.gateway.v1.ReportOptions
-	38,  // 19: logistics.gateway.v1.CalculateLogisticsResponse.validation:type_name -> logistics.gateway.v1.ValidationResult
-	59,  // 20: logistics.gateway.v1.CalculateLogisticsResponse.optimization:type_name -> logistics.gateway.v1.SolveResult
-	58,  // 21: logistics.gateway.v1.CalculateLogisticsResponse.analytics:type_name -> logistics.gateway.v1.AnalyticsResult
-	118, // 22: logistics.gateway.v1.CalculateLogisticsResponse.report:type_name -> logistics.gateway.v1.ReportInfo
-	137, // 23: logistics.gateway.v1.CalculateLogisticsResponse.metadata:type_name -> logistics.gateway.v1.RequestMetadata
-	157, // 24: logistics.gateway.v1.CalculateLogisticsResponse.errors:type_name -> logistics.common.v1.ErrorDetail
-	156, // 25: logistics.gateway.v1.SolveGraphRequest.graph:type_name -> logistics.common.v1.Graph
-	155, // 26: logistics.gateway.v1.SolveGraphRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	31,  // 27: logistics.gateway.v1.SolveGraphRequest.options:type_name -> logistics.gateway.v1.SolveOptions
-	158, // 28: logistics.gateway.v1.SolveGraphResponse.result:type_name -> logistics.common.v1.FlowResult
-	156, // 29: logistics.gateway.v1.SolveGraphResponse.solved_graph:type_name -> logistics.common.v1.Graph
-	32,  // 30: logistics.gateway.v1.SolveGraphResponse.metrics:type_name -> logistics.gateway.v1.SolveMetrics
-	159, // 31: logistics.gateway.v1.SolveProgressEvent.last_path:type_name -> logistics.common.v1.Path
-	25,  // 32: logistics.gateway.v1.SolveProgressEvent.final_result:type_name -> logistics.gateway.v1.SolveGraphResponse
-	28,  // 33: logistics.gateway.v1.BatchSolveRequest.items:type_name -> logistics.gateway.v1.BatchSolveItem
-	155, // 34: logistics.gateway.v1.BatchSolveRequest.default_algorithm:type_name -> logistics.common.v1.Algorithm
-	156, // 35: logistics.gateway.v1.BatchSolveItem.graph:type_name -> logistics.common.v1.Graph
-	155, // 36: logistics.gateway.v1.BatchSolveItem.algorithm:type_name -> logistics.common.v1.Algorithm
-	30,  // 37: logistics.gateway.v1.BatchSolveResponse.results:type_name -> logistics.gateway.v1.BatchSolveResult
-	156, // 38: logistics.gateway.v1.ValidateGraphRequest.graph:type_name -> logistics.common.v1.Graph
-	0,   // 39: logistics.gateway.v1.ValidateGraphRequest.level:type_name -> logistics.gateway.v1.ValidationLevel
-	160, // 40: logistics.gateway.v1.ValidateGraphResponse.errors:type_name -> logistics.common.v1.ValidationError
-	161, // 41: logistics.gateway.v1.ValidateGraphResponse.statistics:type_name -> logistics.common.v1.GraphStatistics
-	39,  // 42: logistics.gateway.v1.ValidateGraphResponse.metrics:type_name -> logistics.gateway.v1.ValidationMetrics
-	156, // 43: logistics.gateway.v1.ValidateForAlgorithmRequest.graph:type_name -> logistics.common.v1.Graph
-	155, // 44: logistics.gateway.v1.ValidateForAlgorithmRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	37,  // 45: logistics.gateway.v1.ValidateForAlgorithmResponse.complexity:type_name -> logistics.gateway.v1.AlgorithmComplexityEstimate
-	160, // 46: logistics.gateway.v1.ValidationResult.errors:type_name -> logistics.common.v1.ValidationError
-	161, // 47: logistics.gateway.v1.ValidationResult.graph_stats:type_name -> logistics.common.v1.GraphStatistics
-	156, // 48: logistics.gateway.v1.AnalyzeGraphRequest.graph:type_name -> logistics.common.v1.Graph
-	42,  // 49: logistics.gateway.v1.AnalyzeGraphRequest.options:type_name -> logistics.gateway.v1.AnalysisOptions
-	162, // 50: logistics.gateway.v1.AnalyzeGraphResponse.flow_stats:type_name -> logistics.common.v1.FlowStatistics
-	161, // 51: logistics.gateway.v1.AnalyzeGraphResponse.graph_stats:type_name -> logistics.common.v1.GraphStatistics
-	47,  // 52: logistics.gateway.v1.AnalyzeGraphResponse.cost:type_name -> logistics.gateway.v1.CostAnalysis
-	52,  // 53: logistics.gateway.v1.AnalyzeGraphResponse.bottlenecks:type_name -> logistics.gateway.v1.BottleneckAnalysis
-	53,  // 54: logistics.gateway.v1.AnalyzeGraphResponse.efficiency:type_name -> logistics.gateway.v1.EfficiencyReport
-	45,  // 55: logistics.gateway.v1.AnalysisOptions.cost_options:type_name -> logistics.gateway.v1.CostOptions
-	156, // 56: logistics.gateway.v1.CalculateCostRequest.graph:type_name -> logistics.common.v1.Graph
-	45,  // 57: logistics.gateway.v1.CalculateCostRequest.options:type_name -> logistics.gateway.v1.CostOptions
-	46,  // 58: logistics.gateway.v1.CalculateCostResponse.breakdown:type_name -> logistics.gateway.v1.CostBreakdown
-	142, // 59: logistics.gateway.v1.CostOptions.cost_multipliers:type_name -> logistics.gateway.v1.CostOptions.CostMultipliersEntry
-	143, // 60: logistics.gateway.v1.CostBreakdown.cost_by_road_type:type_name -> logistics.gateway.v1.CostBreakdown.CostByRoadTypeEntry
-	144, // 61: logistics.gateway.v1.CostBreakdown.cost_by_node_type:type_name -> logistics.gateway.v1.CostBreakdown.CostByNodeTypeEntry
-	46,  // 62: logistics.gateway.v1.CostAnalysis.breakdown:type_name -> logistics.gateway.v1.CostBreakdown
-	156, // 63: logistics.gateway.v1.BottlenecksRequest.graph:type_name -> logistics.common.v1.Graph
-	50,  // 64: logistics.gateway.v1.BottlenecksResponse.bottlenecks:type_name -> logistics.gateway.v1.Bottleneck
-	51,  // 65: logistics.gateway.v1.BottlenecksResponse.recommendations:type_name -> logistics.gateway.v1.Recommendation
-	163, // 66: logistics.gateway.v1.Bottleneck.edge:type_name -> logistics.common.v1.EdgeKey
-	1,   // 67: logistics.gateway.v1.Bottleneck.severity:type_name -> logistics.gateway.v1.BottleneckSeverity
-	163, // 68: logistics.gateway.v1.Recommendation.affected_edge:type_name -> logistics.common.v1.EdgeKey
-	50,  // 69: logistics.gateway.v1.BottleneckAnalysis.bottlenecks:type_name -> logistics.gateway.v1.Bottleneck
-	51,  // 70: logistics.gateway.v1.BottleneckAnalysis.recommendations:type_name -> logistics.gateway.v1.Recommendation
-	156, // 71: logistics.gateway.v1.CompareScenariosRequest.baseline:type_name -> logistics.common.v1.Graph
-	55,  // 72: logistics.gateway.v1.CompareScenariosRequest.scenarios:type_name -> logistics.gateway.v1.ScenarioInput
-	156, // 73: logistics.gateway.v1.ScenarioInput.graph:type_name -> logistics.common.v1.Graph
-	57,  // 74: logistics.gateway.v1.CompareScenariosResponse.baseline:type_name -> logistics.gateway.v1.ScenarioResult
-	57,  // 75: logistics.gateway.v1.CompareScenariosResponse.scenarios:type_name -> logistics.gateway.v1.ScenarioResult
-	46,  // 76: logistics.gateway.v1.AnalyticsResult.cost_breakdown:type_name -> logistics.gateway.v1.CostBreakdown
-	50,  // 77: logistics.gateway.v1.AnalyticsResult.bottlenecks:type_name -> logistics.gateway.v1.Bottleneck
-	51,  // 78: logistics.gateway.v1.AnalyticsResult.recommendations:type_name -> logistics.gateway.v1.Recommendation
-	53,  // 79: logistics.gateway.v1.AnalyticsResult.efficiency:type_name -> logistics.gateway.v1.EfficiencyReport
-	162, // 80: logistics.gateway.v1.AnalyticsResult.flow_stats:type_name -> logistics.common.v1.FlowStatistics
-	156, // 81: logistics.gateway.v1.SolveResult.solved_graph:type_name -> logistics.common.v1.Graph
-	164, // 82: logistics.gateway.v1.SolveResult.status:type_name -> logistics.common.v1.FlowStatus
-	159, // 83: logistics.gateway.v1.SolveResult.paths:type_name -> logistics.common.v1.Path
-	156, // 84: logistics.gateway.v1.WhatIfRequest.baseline_graph:type_name -> logistics.common.v1.Graph
-	61,  // 85: logistics.gateway.v1.WhatIfRequest.modifications:type_name -> logistics.gateway.v1.Modification
-	155, // 86: logistics.gateway.v1.WhatIfRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	62,  // 87: logistics.gateway.v1.WhatIfRequest.options:type_name -> logistics.gateway.v1.WhatIfOptions
-	2,   // 88: logistics.gateway.v1.Modification.type:type_name -> logistics.gateway.v1.ModificationType
-	163, // 89: logistics.gateway.v1.Modification.edge_key:type_name -> logistics.common.v1.EdgeKey
-	3,   // 90: logistics.gateway.v1.Modification.target:type_name -> logistics.gateway.v1.ModificationTarget
-	57,  // 91: logistics.gateway.v1.WhatIfResponse.baseline:type_name -> logistics.gateway.v1.ScenarioResult
-	57,  // 92: logistics.gateway.v1.WhatIfResponse.modified:type_name -> logistics.gateway.v1.ScenarioResult
-	64,  // 93: logistics.gateway.v1.WhatIfResponse.comparison:type_name -> logistics.gateway.v1.ScenarioComparison
-	156, // 94: logistics.gateway.v1.WhatIfResponse.modified_graph:type_name -> logistics.common.v1.Graph
-	94,  // 95: logistics.gateway.v1.WhatIfResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
-	4,   // 96: logistics.gateway.v1.ScenarioComparison.impact_level:type_name -> logistics.gateway.v1.ImpactLevel
-	156, // 97: logistics.gateway.v1.MonteCarloRequest.graph:type_name -> logistics.common.v1.Graph
-	66,  // 98: logistics.gateway.v1.MonteCarloRequest.config:type_name -> logistics.gateway.v1.MonteCarloConfig
-	67,  // 99: logistics.gateway.v1.MonteCarloRequest.uncertainties:type_name -> logistics.gateway.v1.UncertaintySpec
-	155, // 100: logistics.gateway.v1.MonteCarloRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	163, // 101: logistics.gateway.v1.UncertaintySpec.edge:type_name -> logistics.common.v1.EdgeKey
-	3,   // 102: logistics.gateway.v1.UncertaintySpec.target:type_name -> logistics.gateway.v1.ModificationTarget
-	68,  // 103: logistics.gateway.v1.UncertaintySpec.distribution:type_name -> logistics.gateway.v1.Distribution
-	5,   // 104: logistics.gateway.v1.Distribution.type:type_name -> logistics.gateway.v1.DistributionType
-	70,  // 105: logistics.gateway.v1.MonteCarloResponse.flow_stats:type_name -> logistics.gateway.v1.MonteCarloStats
-	70,  // 106: logistics.gateway.v1.MonteCarloResponse.cost_stats:type_name -> logistics.gateway.v1.MonteCarloStats
-	71,  // 107: logistics.gateway.v1.MonteCarloResponse.risk_analysis:type_name -> logistics.gateway.v1.RiskAnalysis
-	94,  // 108: logistics.gateway.v1.MonteCarloResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
-	69,  // 109: logistics.gateway.v1.MonteCarloProgressEvent.final_result:type_name -> logistics.gateway.v1.MonteCarloResponse
-	156, // 110: logistics.gateway.v1.SensitivityRequest.graph:type_name -> logistics.common.v1.Graph
-	74,  // 111: logistics.gateway.v1.SensitivityRequest.parameters:type_name -> logistics.gateway.v1.SensitivityParameter
-	155, // 112: logistics.gateway.v1.SensitivityRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	163, // 113: logistics.gateway.v1.SensitivityParameter.edge:type_name -> logistics.common.v1.EdgeKey
-	3,   // 114: logistics.gateway.v1.SensitivityParameter.target:type_name -> logistics.gateway.v1.ModificationTarget
-	76,  // 115: logistics.gateway.v1.SensitivityResponse.results:type_name -> logistics.gateway.v1.SensitivityResult
-	78,  // 116: logistics.gateway.v1.SensitivityResponse.rankings:type_name -> logistics.gateway.v1.ParameterRanking
-	94,  // 117: logistics.gateway.v1.SensitivityResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
-	77,  // 118: logistics.gateway.v1.SensitivityResult.curve:type_name -> logistics.gateway.v1.SensitivityPoint
-	156, // 119: logistics.gateway.v1.ResilienceRequest.graph:type_name -> logistics.common.v1.Graph
-	80,  // 120: logistics.gateway.v1.ResilienceRequest.config:type_name -> logistics.gateway.v1.ResilienceConfig
-	155, // 121: logistics.gateway.v1.ResilienceRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	82,  // 122: logistics.gateway.v1.ResilienceResponse.metrics:type_name -> logistics.gateway.v1.ResilienceMetrics
-	83,  // 123: logistics.gateway.v1.ResilienceResponse.weaknesses:type_name -> logistics.gateway.v1.ResilienceWeakness
-	94,  // 124: logistics.gateway.v1.ResilienceResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
-	163, // 125: logistics.gateway.v1.ResilienceWeakness.affected_edges:type_name -> logistics.common.v1.EdgeKey
-	156, // 126: logistics.gateway.v1.FailureSimulationRequest.graph:type_name -> logistics.common.v1.Graph
-	85,  // 127: logistics.gateway.v1.FailureSimulationRequest.scenarios:type_name -> logistics.gateway.v1.FailureScenario
-	155, // 128: logistics.gateway.v1.FailureSimulationRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	163, // 129: logistics.gateway.v1.FailureScenario.failed_edges:type_name -> logistics.common.v1.EdgeKey
-	57,  // 130: logistics.gateway.v1.FailureSimulationResponse.baseline:type_name -> logistics.gateway.v1.ScenarioResult
-	87,  // 131: logistics.gateway.v1.FailureSimulationResponse.scenario_results:type_name -> logistics.gateway.v1.FailureScenarioResult
-	88,  // 132: logistics.gateway.v1.FailureSimulationResponse.stats:type_name -> logistics.gateway.v1.FailureStats
-	94,  // 133: logistics.gateway.v1.FailureSimulationResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
-	57,  // 134: logistics.gateway.v1.FailureScenarioResult.result:type_name -> logistics.gateway.v1.ScenarioResult
-	64,  // 135: logistics.gateway.v1.FailureScenarioResult.vs_baseline:type_name -> logistics.gateway.v1.ScenarioComparison
-	156, // 136: logistics.gateway.v1.CriticalElementsRequest.graph:type_name -> logistics.common.v1.Graph
-	90,  // 137: logistics.gateway.v1.CriticalElementsRequest.config:type_name -> logistics.gateway.v1.CriticalElementsConfig
-	155, // 138: logistics.gateway.v1.CriticalElementsRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	92,  // 139: logistics.gateway.v1.CriticalElementsResponse.critical_edges:type_name -> logistics.gateway.v1.CriticalEdge
-	93,  // 140: logistics.gateway.v1.CriticalElementsResponse.critical_nodes:type_name -> logistics.gateway.v1.CriticalNode
-	163, // 141: logistics.gateway.v1.CriticalElementsResponse.single_points_of_failure:type_name -> logistics.common.v1.EdgeKey
-	94,  // 142: logistics.gateway.v1.CriticalElementsResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
-	163, // 143: logistics.gateway.v1.CriticalEdge.edge:type_name -> logistics.common.v1.EdgeKey
-	154, // 144: logistics.gateway.v1.SimulationMetadata.completed_at:type_name -> google.protobuf.Timestamp
-	98,  // 145: logistics.gateway.v1.ListSimulationsResponse.simulations:type_name -> logistics.gateway.v1.SimulationRecord
-	154, // 146: logistics.gateway.v1.SimulationRecord.created_at:type_name -> google.protobuf.Timestamp
-	145, // 147: logistics.gateway.v1.SimulationRecord.tags:type_name -> logistics.gateway.v1.SimulationRecord.TagsEntry
-	156, // 148: logistics.gateway.v1.SaveCalculationRequest.graph:type_name -> logistics.common.v1.Graph
-	25,  // 149: logistics.gateway.v1.SaveCalculationRequest.result:type_name -> logistics.gateway.v1.SolveGraphResponse
-	146, // 150: logistics.gateway.v1.SaveCalculationRequest.tags:type_name -> logistics.gateway.v1.SaveCalculationRequest.TagsEntry
-	154, // 151: logistics.gateway.v1.SaveCalculationResponse.created_at:type_name -> google.protobuf.Timestamp
-	155, // 152: logistics.gateway.v1.ListCalculationsRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	154, // 153: logistics.gateway.v1.ListCalculationsRequest.created_after:type_name -> google.protobuf.Timestamp
-	154, // 154: logistics.gateway.v1.ListCalculationsRequest.created_before:type_name -> google.protobuf.Timestamp
-	106, // 155: logistics.gateway.v1.ListCalculationsResponse.calculations:type_name -> logistics.gateway.v1.CalculationSummary
-	154, // 156: logistics.gateway.v1.CalculationRecord.created_at:type_name -> google.protobuf.Timestamp
-	156, // 157: logistics.gateway.v1.CalculationRecord.graph:type_name -> logistics.common.v1.Graph
-	25,  // 158: logistics.gateway.v1.CalculationRecord.result:type_name -> logistics.gateway.v1.SolveGraphResponse
-	147, // 159: logistics.gateway.v1.CalculationRecord.tags:type_name -> logistics.gateway.v1.CalculationRecord.TagsEntry
-	154, // 160: logistics.gateway.v1.CalculationSummary.created_at:type_name -> google.protobuf.Timestamp
-	155, // 161: logistics.gateway.v1.CalculationSummary.algorithm:type_name -> logistics.common.v1.Algorithm
-	154, // 162: logistics.gateway.v1.GetStatisticsRequest.start_time:type_name -> google.protobuf.Timestamp
-	154, // 163: logistics.gateway.v1.GetStatisticsRequest.end_time:type_name -> google.protobuf.Timestamp
-	148, // 164: logistics.gateway.v1.StatisticsResponse.calculations_by_algorithm:type_name -> logistics.gateway.v1.StatisticsResponse.CalculationsByAlgorithmEntry
-	110, // 165: logistics.gateway.v1.StatisticsResponse.daily_stats:type_name -> logistics.gateway.v1.DailyStats
-	7,   // 166: logistics.gateway.v1.GenerateReportRequest.type:type_name -> logistics.gateway.v1.ReportType
-	6,   // 167: logistics.gateway.v1.GenerateReportRequest.format:type_name -> logistics.gateway.v1.ReportFormat
-	112, // 168: logistics.gateway.v1.GenerateReportRequest.options:type_name -> logistics.gateway.v1.ReportOptions
-	113, // 169: logistics.gateway.v1.GenerateReportRequest.flow_source:type_name -> logistics.gateway.v1.FlowReportSource
-	114, // 170: logistics.gateway.v1.GenerateReportRequest.analytics_source:type_name -> logistics.gateway.v1.AnalyticsReportSource
-	115, // 171: logistics.gateway.v1.GenerateReportRequest.simulation_source:type_name -> logistics.gateway.v1.SimulationReportSource
-	116, // 172: logistics.gateway.v1.GenerateReportRequest.history_source:type_name -> logistics.gateway.v1.HistoryReportSource
-	156, // 173: logistics.gateway.v1.FlowReportSource.graph:type_name -> logistics.common.v1.Graph
-	158, // 174: logistics.gateway.v1.FlowReportSource.result:type_name -> logistics.common.v1.FlowResult
-	32,  // 175: logistics.gateway.v1.FlowReportSource.metrics:type_name -> logistics.gateway.v1.SolveMetrics
-	156, // 176: logistics.gateway.v1.AnalyticsReportSource.graph:type_name -> logistics.common.v1.Graph
-	41,  // 177: logistics.gateway.v1.AnalyticsReportSource.analytics:type_name -> logistics.gateway.v1.AnalyzeGraphResponse
-	156, // 178: logistics.gateway.v1.SimulationReportSource.baseline_graph:type_name -> logistics.common.v1.Graph
-	154, // 179: logistics.gateway.v1.HistoryReportSource.start_time:type_name -> google.protobuf.Timestamp
-	154, // 180: logistics.gateway.v1.HistoryReportSource.end_time:type_name -> google.protobuf.Timestamp
-	118, // 181: logistics.gateway.v1.GenerateReportResponse.report:type_name -> logistics.gateway.v1.ReportInfo
-	7,   // 182: logistics.gateway.v1.ReportInfo.type:type_name -> logistics.gateway.v1.ReportType
-	6,   // 183: logistics.gateway.v1.ReportInfo.format:type_name -> logistics.gateway.v1.ReportFormat
-	154, // 184: logistics.gateway.v1.ReportInfo.generated_at:type_name -> google.protobuf.Timestamp
-	154, // 185: logistics.gateway.v1.ReportInfo.expires_at:type_name -> google.protobuf.Timestamp
-	118, // 186: logistics.gateway.v1.ReportRecord.info:type_name -> logistics.gateway.v1.ReportInfo
-	7,   // 187: logistics.gateway.v1.ListReportsRequest.type:type_name -> logistics.gateway.v1.ReportType
-	6,   // 188: logistics.gateway.v1.ListReportsRequest.format:type_name -> logistics.gateway.v1.ReportFormat
-	154, // 189: logistics.gateway.v1.ListReportsRequest.created_after:type_name -> google.protobuf.Timestamp
-	154, // 190: logistics.gateway.v1.ListReportsRequest.created_before:type_name -> google.protobuf.Timestamp
-	118, // 191: logistics.gateway.v1.ListReportsResponse.reports:type_name -> logistics.gateway.v1.ReportInfo
-	127, // 192: logistics.gateway.v1.ReportFormatsResponse.formats:type_name -> logistics.gateway.v1.ReportFormatInfo
-	6,   // 193: logistics.gateway.v1.ReportFormatInfo.format:type_name -> logistics.gateway.v1.ReportFormat
-	7,   // 194: logistics.gateway.v1.ReportFormatInfo.supported_report_types:type_name -> logistics.gateway.v1.ReportType
-	154, // 195: logistics.gateway.v1.GetAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
-	154, // 196: logistics.gateway.v1.GetAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
-	130, // 197: logistics.gateway.v1.AuditLogsResponse.entries:type_name -> logistics.gateway.v1.AuditEntry
-	154, // 198: logistics.gateway.v1.AuditEntry.timestamp:type_name -> google.protobuf.Timestamp
-	149, // 199: logistics.gateway.v1.AuditEntry.metadata:type_name -> logistics.gateway.v1.AuditEntry.MetadataEntry
-	154, // 200: logistics.gateway.v1.GetUserActivityRequest.start_time:type_name -> google.protobuf.Timestamp
-	154, // 201: logistics.gateway.v1.GetUserActivityRequest.end_time:type_name -> google.protobuf.Timestamp
-	130, // 202: logistics.gateway.v1.UserActivityResponse.entries:type_name -> logistics.gateway.v1.AuditEntry
-	133, // 203: logistics.gateway.v1.UserActivityResponse.summary:type_name -> logistics.gateway.v1.UserActivitySummary
-	150, // 204: logistics.gateway.v1.UserActivitySummary.actions_by_type:type_name -> logistics.gateway.v1.UserActivitySummary.ActionsByTypeEntry
-	151, // 205: logistics.gateway.v1.UserActivitySummary.actions_by_service:type_name -> logistics.gateway.v1.UserActivitySummary.ActionsByServiceEntry
-	154, // 206: logistics.gateway.v1.UserActivitySummary.first_activity:type_name -> google.protobuf.Timestamp
-	154, // 207: logistics.gateway.v1.UserActivitySummary.last_activity:type_name -> google.protobuf.Timestamp
-	154, // 208: logistics.gateway.v1.GetAuditStatsRequest.start_time:type_name -> google.protobuf.Timestamp
-	154, // 209: logistics.gateway.v1.GetAuditStatsRequest.end_time:type_name -> google.protobuf.Timestamp
-	152, // 210: logistics.gateway.v1.AuditStatsResponse.by_service:type_name -> logistics.gateway.v1.AuditStatsResponse.ByServiceEntry
-	153, // 211: logistics.gateway.v1.AuditStatsResponse.by_action:type_name -> logistics.gateway.v1.AuditStatsResponse.ByActionEntry
-	136, // 212: logistics.gateway.v1.AuditStatsResponse.timeline:type_name -> logistics.gateway.v1.AuditStatsPoint
-	154, // 213: logistics.gateway.v1.AuditStatsPoint.timestamp:type_name -> google.protobuf.Timestamp
-	154, // 214: logistics.gateway.v1.RequestMetadata.processed_at:type_name -> google.protobuf.Timestamp
-	9,   // 215: logistics.gateway.v1.HealthResponse.ServicesEntry.value:type_name -> logistics.gateway.v1.ServiceHealth
-	165, // 216: logistics.gateway.v1.GatewayService.Health:input_type -> google.protobuf.Empty
-	165, // 217: logistics.gateway.v1.GatewayService.ReadinessCheck:input_type -> google.protobuf.Empty
-	165, // 218: logistics.gateway.v1.GatewayService.Info:input_type -> google.protobuf.Empty
-	165, // 219: logistics.gateway.v1.GatewayService.GetAlgorithms:input_type -> google.protobuf.Empty
-	15,  // 220: logistics.gateway.v1.GatewayService.Register:input_type -> logistics.gateway.v1.RegisterRequest
-	16,  // 221: logistics.gateway.v1.GatewayService.Login:input_type -> logistics.gateway.v1.LoginRequest
-	17,  // 222: logistics.gateway.v1.GatewayService.RefreshToken:input_type -> logistics.gateway.v1.RefreshTokenRequest
-	165, // 223: logistics.gateway.v1.GatewayService.Logout:input_type -> google.protobuf.Empty
-	165, // 224: logistics.gateway.v1.GatewayService.GetProfile:input_type -> google.protobuf.Empty
-	18,  // 225: logistics.gateway.v1.GatewayService.ValidateToken:input_type -> logistics.gateway.v1.ValidateTokenRequest
-	22,  // 226: logistics.gateway.v1.GatewayService.CalculateLogistics:input_type -> logistics.gateway.v1.CalculateLogisticsRequest
-	24,  // 227: logistics.gateway.v1.GatewayService.SolveGraph:input_type -> logistics.gateway.v1.SolveGraphRequest
-	24,  // 228: logistics.gateway.v1.GatewayService.SolveGraphStream:input_type -> logistics.gateway.v1.SolveGraphRequest
-	27,  // 229: logistics.gateway.v1.GatewayService.BatchSolve:input_type -> logistics.gateway.v1.BatchSolveRequest
-	33,  // 230: logistics.gateway.v1.GatewayService.ValidateGraph:input_type -> logistics.gateway.v1.ValidateGraphRequest
-	35,  // 231: logistics.gateway.v1.GatewayService.ValidateForAlgorithm:input_type -> logistics.gateway.v1.ValidateForAlgorithmRequest
-	40,  // 232: logistics.gateway.v1.GatewayService.AnalyzeGraph:input_type -> logistics.gateway.v1.AnalyzeGraphRequest
-	43,  // 233: logistics.gateway.v1.GatewayService.CalculateCost:input_type -> logistics.gateway.v1.CalculateCostRequest
-	48,  // 234: logistics.gateway.v1.GatewayService.GetBottlenecks:input_type -> logistics.gateway.v1.BottlenecksRequest
-	54,  // 235: logistics.gateway.v1.GatewayService.CompareScenarios:input_type -> logistics.gateway.v1.CompareScenariosRequest
-	60,  // 236: logistics.gateway.v1.GatewayService.RunWhatIf:input_type -> logistics.gateway.v1.WhatIfRequest
-	65,  // 237: logistics.gateway.v1.GatewayService.RunMonteCarlo:input_type -> logistics.gateway.v1.MonteCarloRequest
-	65,  // 238: logistics.gateway.v1.GatewayService.RunMonteCarloStream:input_type -> logistics.gateway.v1.MonteCarloRequest
-	73,  // 239: logistics.gateway.v1.GatewayService.AnalyzeSensitivity:input_type -> logistics.gateway.v1.SensitivityRequest
-	79,  // 240: logistics.gateway.v1.GatewayService.AnalyzeResilience:input_type -> logistics.gateway.v1.ResilienceRequest
-	84,  // 241: logistics.gateway.v1.GatewayService.SimulateFailures:input_type -> logistics.gateway.v1.FailureSimulationRequest
-	89,  // 242: logistics.gateway.v1.GatewayService.FindCriticalElements:input_type -> logistics.gateway.v1.CriticalElementsRequest
-	95,  // 243: logistics.gateway.v1.GatewayService.GetSimulation:input_type -> logistics.gateway.v1.GetSimulationRequest
-	96,  // 244: logistics.gateway.v1.GatewayService.ListSimulations:input_type -> logistics.gateway.v1.ListSimulationsRequest
-	99,  // 245: logistics.gateway.v1.GatewayService.DeleteSimulation:input_type -> logistics.gateway.v1.DeleteSimulationRequest
-	100, // 246: logistics.gateway.v1.GatewayService.SaveCalculation:input_type -> logistics.gateway.v1.SaveCalculationRequest
-	102, // 247: logistics.gateway.v1.GatewayService.GetCalculation:input_type -> logistics.gateway.v1.GetCalculationRequest
-	103, // 248: logistics.gateway.v1.GatewayService.ListCalculations:input_type -> logistics.gateway.v1.ListCalculationsRequest
-	107, // 249: logistics.gateway.v1.GatewayService.DeleteCalculation:input_type -> logistics.gateway.v1.DeleteCalculationRequest
-	108, // 250: logistics.gateway.v1.GatewayService.GetStatistics:input_type -> logistics.gateway.v1.GetStatisticsRequest
-	111, // 251: logistics.gateway.v1.GatewayService.GenerateReport:input_type -> logistics.gateway.v1.GenerateReportRequest
-	119, // 252: logistics.gateway.v1.GatewayService.GetReport:input_type -> logistics.gateway.v1.GetReportRequest
-	120, // 253: logistics.gateway.v1.GatewayService.DownloadReport:input_type -> logistics.gateway.v1.DownloadReportRequest
-	123, // 254: logistics.gateway.v1.GatewayService.ListReports:input_type -> logistics.gateway.v1.ListReportsRequest
-	125, // 255: logistics.gateway.v1.GatewayService.DeleteReport:input_type -> logistics.gateway.v1.DeleteReportRequest
-	165, // 256: logistics.gateway.v1.GatewayService.GetReportFormats:input_type -> google.protobuf.Empty
-	128, // 257: logistics.gateway.v1.GatewayService.GetAuditLogs:input_type -> logistics.gateway.v1.GetAuditLogsRequest
-	131, // 258: logistics.gateway.v1.GatewayService.GetUserActivity:input_type -> logistics.gateway.v1.GetUserActivityRequest
-	134, // 259: logistics.gateway.v1.GatewayService.GetAuditStats:input_type -> logistics.gateway.v1.GetAuditStatsRequest
-	8,   // 260: logistics.gateway.v1.GatewayService.Health:output_type -> logistics.gateway.v1.HealthResponse
-	10,  // 261: logistics.gateway.v1.GatewayService.ReadinessCheck:output_type -> logistics.gateway.v1.ReadinessResponse
-	11,  // 262: logistics.gateway.v1.GatewayService.Info:output_type -> logistics.gateway.v1.InfoResponse
-	13,  // 263: logistics.gateway.v1.GatewayService.GetAlgorithms:output_type -> logistics.gateway.v1.AlgorithmsResponse
-	20,  // 264: logistics.gateway.v1.GatewayService.Register:output_type -> logistics.gateway.v1.AuthResponse
-	20,  // 265: logistics.gateway.v1.GatewayService.Login:output_type -> logistics.gateway.v1.AuthResponse
-	20,  // 266: logistics.gateway.v1.GatewayService.RefreshToken:output_type -> logistics.gateway.v1.AuthResponse
-	165, // 267: logistics.gateway.v1.GatewayService.Logout:output_type -> google.protobuf.Empty
-	21,  // 268: logistics.gateway.v1.GatewayService.GetProfile:output_type -> logistics.gateway.v1.UserProfile
-	19,  // 269: logistics.gateway.v1.GatewayService.ValidateToken:output_type -> logistics.gateway.v1.ValidateTokenResponse
-	23,  // 270: logistics.gateway.v1.GatewayService.CalculateLogistics:output_type -> logistics.gateway.v1.CalculateLogisticsResponse
-	25,  // 271: logistics.gateway.v1.GatewayService.SolveGraph:output_type -> logistics.gateway.v1.SolveGraphResponse
-	26,  // 272: logistics.gateway.v1.GatewayService.SolveGraphStream:output_type -> logistics.gateway.v1.SolveProgressEvent
-	29,  // 273: logistics.gateway.v1.GatewayService.BatchSolve:output_type -> logistics.gateway.v1.BatchSolveResponse
-	34,  // 274: logistics.gateway.v1.GatewayService.ValidateGraph:output_type -> logistics.gateway.v1.ValidateGraphResponse
-	36,  // 275: logistics.gateway.v1.GatewayService.ValidateForAlgorithm:output_type -> logistics.gateway.v1.ValidateForAlgorithmResponse
-	41,  // 276: logistics.gateway.v1.GatewayService.AnalyzeGraph:output_type -> logistics.gateway.v1.AnalyzeGraphResponse
-	44,  // 277: logistics.gateway.v1.GatewayService.CalculateCost:output_type -> logistics.gateway.v1.CalculateCostResponse
-	49,  // 278: logistics.gateway.v1.GatewayService.GetBottlenecks:output_type -> logistics.gateway.v1.BottlenecksResponse
-	56,  // 279: logistics.gateway.v1.GatewayService.CompareScenarios:output_type -> logistics.gateway.v1.CompareScenariosResponse
-	63,  // 280: logistics.gateway.v1.GatewayService.RunWhatIf:output_type -> logistics.gateway.v1.WhatIfResponse
-	69,  // 281: logistics.gateway.v1.GatewayService.RunMonteCarlo:output_type -> logistics.gateway.v1.MonteCarloResponse
-	72,  // 282: logistics.gateway.v1.GatewayService.RunMonteCarloStream:output_type -> logistics.gateway.v1.MonteCarloProgressEvent
-	75,  // 283: logistics.gateway.v1.GatewayService.AnalyzeSensitivity:output_type -> logistics.gateway.v1.SensitivityResponse
-	81,  // 284: logistics.gateway.v1.GatewayService.AnalyzeResilience:output_type -> logistics.gateway.v1.ResilienceResponse
-	86,  // 285: logistics.gateway.v1.GatewayService.SimulateFailures:output_type -> logistics.gateway.v1.FailureSimulationResponse
-	91,  // 286: logistics.gateway.v1.GatewayService.FindCriticalElements:output_type -> logistics.gateway.v1.CriticalElementsResponse
-	98,  // 287: logistics.gateway.v1.GatewayService.GetSimulation:output_type -> logistics.gateway.v1.SimulationRecord
-	97,  // 288: logistics.gateway.v1.GatewayService.ListSimulations:output_type -> logistics.gateway.v1.ListSimulationsResponse
-	165, // 289: logistics.gateway.v1.GatewayService.DeleteSimulation:output_type -> google.protobuf.Empty
-	101, // 290: logistics.gateway.v1.GatewayService.SaveCalculation:output_type -> logistics.gateway.v1.SaveCalculationResponse
-	105, // 291: logistics.gateway.v1.GatewayService.GetCalculation:output_type -> logistics.gateway.v1.CalculationRecord
-	104, // 292: logistics.gateway.v1.GatewayService.ListCalculations:output_type -> logistics.gateway.v1.ListCalculationsResponse
-	165, // 293: logistics.gateway.v1.GatewayService.DeleteCalculation:output_type -> google.protobuf.Empty
-	109, // 294: logistics.gateway.v1.GatewayService.GetStatistics:output_type -> logistics.gateway.v1.StatisticsResponse
-	117, // 295: logistics.gateway.v1.GatewayService.GenerateReport:output_type -> logistics.gateway.v1.GenerateReportResponse
-	122, // 296: logistics.gateway.v1.GatewayService.GetReport:output_type -> logistics.gateway.v1.ReportRecord
-	121, // 297: logistics.gateway.v1.GatewayService.DownloadReport:output_type -> logistics.gateway.v1.ReportChunk
-	124, // 298: logistics.gateway.v1.GatewayService.ListReports:output_type -> logistics.gateway.v1.ListReportsResponse
-	165, // 299: logistics.gateway.v1.GatewayService.DeleteReport:output_type -> google.protobuf.Empty
-	126, // 300: logistics.gateway.v1.GatewayService.GetReportFormats:output_type -> logistics.gateway.v1.ReportFormatsResponse
-	129, // 301: logistics.gateway.v1.GatewayService.GetAuditLogs:output_type -> logistics.gateway.v1.AuditLogsResponse
-	132, // 302: logistics.gateway.v1.GatewayService.GetUserActivity:output_type -> logistics.gateway.v1.UserActivityResponse
-	135, // 303: logistics.gateway.v1.GatewayService.GetAuditStats:output_type -> logistics.gateway.v1.AuditStatsResponse
-	260, // [260:304] is the sub-list for method output_type
-	216, // [216:260] is the sub-list for method input_type
-	216, // [216:216] is the sub-list for extension type_name
-	216, // [216:216] is the sub-list for extension extendee
-	0,   // [0:216] is the sub-list for field type_name
+	168, // 0: logistics.gateway.v1.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
+	152, // 1: logistics.gateway.v1.HealthResponse.services:type_name -> logistics.gateway.v1.HealthResponse.ServicesEntry
+	153, // 2: logistics.gateway.v1.ReadinessResponse.dependencies:type_name -> logistics.gateway.v1.ReadinessResponse.DependenciesEntry
+	168, // 3: logistics.gateway.v1.InfoResponse.started_at:type_name -> google.protobuf.Timestamp
+	17,  // 4: logistics.gateway.v1.InfoResponse.rate_limit:type_name -> logistics.gateway.v1.RateLimitInfo
+	154, // 5: logistics.gateway.v1.InfoResponse.build_info:type_name -> logistics.gateway.v1.InfoResponse.BuildInfoEntry
+	19,  // 6: logistics.gateway.v1.AlgorithmsResponse.algorithms:type_name -> logistics.gateway.v1.AlgorithmInfo
+	169, // 7: logistics.gateway.v1.AlgorithmInfo.algorithm:type_name -> logistics.common.v1.Algorithm
+	26,  // 8: logistics.gateway.v1.AuthResponse.user:type_name -> logistics.gateway.v1.UserProfile
+	168, // 9: logistics.gateway.v1.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	168, // 10: logistics.gateway.v1.UserProfile.last_login:type_name -> google.protobuf.Timestamp
+	170, // 11: logistics.gateway.v1.CalculateLogisticsRequest.graph:type_name -> logistics.common.v1.Graph
+	169, // 12: logistics.gateway.v1.CalculateLogisticsRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	1,   // 13: logistics.gateway.v1.CalculateLogisticsRequest.validation_level:type_name -> logistics.gateway.v1.ValidationLevel
+	36,  // 14: logistics.gateway.v1.CalculateLogisticsRequest.solve_options:type_name -> logistics.gateway.v1.SolveOptions
+	50,  // 15: logistics.gateway.v1.CalculateLogisticsRequest.cost_options:type_name -> logistics.gateway.v1.CostOptions
+	155, // 16: logistics.gateway.v1.CalculateLogisticsRequest.tags:type_name -> logistics.gateway.v1.CalculateLogisticsRequest.TagsEntry
+	11,  // 17: logistics.gateway.v1.CalculateLogisticsRequest.report_format:type_name -> logistics.gateway.v1.ReportFormat
+	126, // 18: logistics.gateway.v1.CalculateLogisticsRequest.report_options:type_name -> logistics.gateway.v1.ReportOptions
+	43,  // 19: logistics.gateway.v1.CalculateLogisticsResponse.validation:type_name -> logistics.gateway.v1.ValidationResult
+	64,  // 20: logistics.gateway.v1.CalculateLogisticsResponse.optimization:type_name -> logistics.gateway.v1.SolveResult
+	63,  // 21: logistics.gateway.v1.CalculateLogisticsResponse.analytics:type_name -> logistics.gateway.v1.AnalyticsResult
+	132, // 22: logistics.gateway.v1.CalculateLogisticsResponse.report:type_name -> logistics.gateway.v1.ReportInfo
+	151, // 23: logistics.gateway.v1.CalculateLogisticsResponse.metadata:type_name -> logistics.gateway.v1.RequestMetadata
+	171, // 24: logistics.gateway.v1.CalculateLogisticsResponse.errors:type_name -> logistics.common.v1.ErrorDetail
+	170, // 25: logistics.gateway.v1.SolveGraphRequest.graph:type_name -> logistics.common.v1.Graph
+	169, // 26: logistics.gateway.v1.SolveGraphRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	36,  // 27: logistics.gateway.v1.SolveGraphRequest.options:type_name -> logistics.gateway.v1.SolveOptions
+	172, // 28: logistics.gateway.v1.SolveGraphResponse.result:type_name -> logistics.common.v1.FlowResult
+	170, // 29: logistics.gateway.v1.SolveGraphResponse.solved_graph:type_name -> logistics.common.v1.Graph
+	37,  // 30: logistics.gateway.v1.SolveGraphResponse.metrics:type_name -> logistics.gateway.v1.SolveMetrics
+	173, // 31: logistics.gateway.v1.SolveProgressEvent.last_path:type_name -> logistics.common.v1.Path
+	30,  // 32: logistics.gateway.v1.SolveProgressEvent.final_result:type_name -> logistics.gateway.v1.SolveGraphResponse
+	33,  // 33: logistics.gateway.v1.BatchSolveRequest.items:type_name -> logistics.gateway.v1.BatchSolveItem
+	169, // 34: logistics.gateway.v1.BatchSolveRequest.default_algorithm:type_name -> logistics.common.v1.Algorithm
+	170, // 35: logistics.gateway.v1.BatchSolveItem.graph:type_name -> logistics.common.v1.Graph
+	169, // 36: logistics.gateway.v1.BatchSolveItem.algorithm:type_name -> logistics.common.v1.Algorithm
+	35,  // 37: logistics.gateway.v1.BatchSolveResponse.results:type_name -> logistics.gateway.v1.BatchSolveResult
+	0,   // 38: logistics.gateway.v1.SolveOptions.mode:type_name -> logistics.gateway.v1.SolveMode
+	170, // 39: logistics.gateway.v1.ValidateGraphRequest.graph:type_name -> logistics.common.v1.Graph
+	1,   // 40: logistics.gateway.v1.ValidateGraphRequest.level:type_name -> logistics.gateway.v1.ValidationLevel
+	174, // 41: logistics.gateway.v1.ValidateGraphResponse.errors:type_name -> logistics.common.v1.ValidationError
+	175, // 42: logistics.gateway.v1.ValidateGraphResponse.statistics:type_name -> logistics.common.v1.GraphStatistics
+	44,  // 43: logistics.gateway.v1.ValidateGraphResponse.metrics:type_name -> logistics.gateway.v1.ValidationMetrics
+	170, // 44: logistics.gateway.v1.ValidateForAlgorithmRequest.graph:type_name -> logistics.common.v1.Graph
+	169, // 45: logistics.gateway.v1.ValidateForAlgorithmRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	42,  // 46: logistics.gateway.v1.ValidateForAlgorithmResponse.complexity:type_name -> logistics.gateway.v1.AlgorithmComplexityEstimate
+	174, // 47: logistics.gateway.v1.ValidationResult.errors:type_name -> logistics.common.v1.ValidationError
+	175, // 48: logistics.gateway.v1.ValidationResult.graph_stats:type_name -> logistics.common.v1.GraphStatistics
+	170, // 49: logistics.gateway.v1.AnalyzeGraphRequest.graph:type_name -> logistics.common.v1.Graph
+	47,  // 50: logistics.gateway.v1.AnalyzeGraphRequest.options:type_name -> logistics.gateway.v1.AnalysisOptions
+	176, // 51: logistics.gateway.v1.AnalyzeGraphResponse.flow_stats:type_name -> logistics.common.v1.FlowStatistics
+	175, // 52: logistics.gateway.v1.AnalyzeGraphResponse.graph_stats:type_name -> logistics.common.v1.GraphStatistics
+	52,  // 53: logistics.gateway.v1.AnalyzeGraphResponse.cost:type_name -> logistics.gateway.v1.CostAnalysis
+	57,  // 54: logistics.gateway.v1.AnalyzeGraphResponse.bottlenecks:type_name -> logistics.gateway.v1.BottleneckAnalysis
+	58,  // 55: logistics.gateway.v1.AnalyzeGraphResponse.efficiency:type_name -> logistics.gateway.v1.EfficiencyReport
+	50,  // 56: logistics.gateway.v1.AnalysisOptions.cost_options:type_name -> logistics.gateway.v1.CostOptions
+	170, // 57: logistics.gateway.v1.CalculateCostRequest.graph:type_name -> logistics.common.v1.Graph
+	50,  // 58: logistics.gateway.v1.CalculateCostRequest.options:type_name -> logistics.gateway.v1.CostOptions
+	51,  // 59: logistics.gateway.v1.CalculateCostResponse.breakdown:type_name -> logistics.gateway.v1.CostBreakdown
+	156, // 60: logistics.gateway.v1.CostOptions.cost_multipliers:type_name -> logistics.gateway.v1.CostOptions.CostMultipliersEntry
+	157, // 61: logistics.gateway.v1.CostBreakdown.cost_by_road_type:type_name -> logistics.gateway.v1.CostBreakdown.CostByRoadTypeEntry
+	158, // 62: logistics.gateway.v1.CostBreakdown.cost_by_node_type:type_name -> logistics.gateway.v1.CostBreakdown.CostByNodeTypeEntry
+	51,  // 63: logistics.gateway.v1.CostAnalysis.breakdown:type_name -> logistics.gateway.v1.CostBreakdown
+	170, // 64: logistics.gateway.v1.BottlenecksRequest.graph:type_name -> logistics.common.v1.Graph
+	55,  // 65: logistics.gateway.v1.BottlenecksResponse.bottlenecks:type_name -> logistics.gateway.v1.Bottleneck
+	56,  // 66: logistics.gateway.v1.BottlenecksResponse.recommendations:type_name -> logistics.gateway.v1.Recommendation
+	177, // 67: logistics.gateway.v1.Bottleneck.edge:type_name -> logistics.common.v1.EdgeKey
+	2,   // 68: logistics.gateway.v1.Bottleneck.severity:type_name -> logistics.gateway.v1.BottleneckSeverity
+	177, // 69: logistics.gateway.v1.Recommendation.affected_edge:type_name -> logistics.common.v1.EdgeKey
+	55,  // 70: logistics.gateway.v1.BottleneckAnalysis.bottlenecks:type_name -> logistics.gateway.v1.Bottleneck
+	56,  // 71: logistics.gateway.v1.BottleneckAnalysis.recommendations:type_name -> logistics.gateway.v1.Recommendation
+	170, // 72: logistics.gateway.v1.CompareScenariosRequest.baseline:type_name -> logistics.common.v1.Graph
+	60,  // 73: logistics.gateway.v1.CompareScenariosRequest.scenarios:type_name -> logistics.gateway.v1.ScenarioInput
+	170, // 74: logistics.gateway.v1.ScenarioInput.graph:type_name -> logistics.common.v1.Graph
+	62,  // 75: logistics.gateway.v1.CompareScenariosResponse.baseline:type_name -> logistics.gateway.v1.ScenarioResult
+	62,  // 76: logistics.gateway.v1.CompareScenariosResponse.scenarios:type_name -> logistics.gateway.v1.ScenarioResult
+	51,  // 77: logistics.gateway.v1.AnalyticsResult.cost_breakdown:type_name -> logistics.gateway.v1.CostBreakdown
+	55,  // 78: logistics.gateway.v1.AnalyticsResult.bottlenecks:type_name -> logistics.gateway.v1.Bottleneck
+	56,  // 79: logistics.gateway.v1.AnalyticsResult.recommendations:type_name -> logistics.gateway.v1.Recommendation
+	58,  // 80: logistics.gateway.v1.AnalyticsResult.efficiency:type_name -> logistics.gateway.v1.EfficiencyReport
+	176, // 81: logistics.gateway.v1.AnalyticsResult.flow_stats:type_name -> logistics.common.v1.FlowStatistics
+	170, // 82: logistics.gateway.v1.SolveResult.solved_graph:type_name -> logistics.common.v1.Graph
+	178, // 83: logistics.gateway.v1.SolveResult.status:type_name -> logistics.common.v1.FlowStatus
+	173, // 84: logistics.gateway.v1.SolveResult.paths:type_name -> logistics.common.v1.Path
+	179, // 85: logistics.gateway.v1.SolveResult.decomposition:type_name -> logistics.common.v1.FlowDecomposition
+	170, // 86: logistics.gateway.v1.WhatIfRequest.baseline_graph:type_name -> logistics.common.v1.Graph
+	66,  // 87: logistics.gateway.v1.WhatIfRequest.modifications:type_name -> logistics.gateway.v1.Modification
+	169, // 88: logistics.gateway.v1.WhatIfRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	67,  // 89: logistics.gateway.v1.WhatIfRequest.options:type_name -> logistics.gateway.v1.WhatIfOptions
+	3,   // 90: logistics.gateway.v1.Modification.type:type_name -> logistics.gateway.v1.ModificationType
+	177, // 91: logistics.gateway.v1.Modification.edge_key:type_name -> logistics.common.v1.EdgeKey
+	4,   // 92: logistics.gateway.v1.Modification.target:type_name -> logistics.gateway.v1.ModificationTarget
+	62,  // 93: logistics.gateway.v1.WhatIfResponse.baseline:type_name -> logistics.gateway.v1.ScenarioResult
+	62,  // 94: logistics.gateway.v1.WhatIfResponse.modified:type_name -> logistics.gateway.v1.ScenarioResult
+	69,  // 95: logistics.gateway.v1.WhatIfResponse.comparison:type_name -> logistics.gateway.v1.ScenarioComparison
+	170, // 96: logistics.gateway.v1.WhatIfResponse.modified_graph:type_name -> logistics.common.v1.Graph
+	108, // 97: logistics.gateway.v1.WhatIfResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
+	5,   // 98: logistics.gateway.v1.ScenarioComparison.impact_level:type_name -> logistics.gateway.v1.ImpactLevel
+	170, // 99: logistics.gateway.v1.MonteCarloRequest.graph:type_name -> logistics.common.v1.Graph
+	73,  // 100: logistics.gateway.v1.MonteCarloRequest.config:type_name -> logistics.gateway.v1.MonteCarloConfig
+	74,  // 101: logistics.gateway.v1.MonteCarloRequest.uncertainties:type_name -> logistics.gateway.v1.UncertaintySpec
+	169, // 102: logistics.gateway.v1.MonteCarloRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	71,  // 103: logistics.gateway.v1.MonteCarloRequest.correlation:type_name -> logistics.gateway.v1.UncertaintyCorrelation
+	6,   // 104: logistics.gateway.v1.UncertaintyCorrelation.measure:type_name -> logistics.gateway.v1.CorrelationMeasure
+	72,  // 105: logistics.gateway.v1.UncertaintyCorrelation.groups:type_name -> logistics.gateway.v1.CorrelationGroup
+	8,   // 106: logistics.gateway.v1.MonteCarloConfig.sampling_method:type_name -> logistics.gateway.v1.SamplingMethod
+	177, // 107: logistics.gateway.v1.UncertaintySpec.edge:type_name -> logistics.common.v1.EdgeKey
+	4,   // 108: logistics.gateway.v1.UncertaintySpec.target:type_name -> logistics.gateway.v1.ModificationTarget
+	75,  // 109: logistics.gateway.v1.UncertaintySpec.distribution:type_name -> logistics.gateway.v1.Distribution
+	9,   // 110: logistics.gateway.v1.Distribution.type:type_name -> logistics.gateway.v1.DistributionType
+	78,  // 111: logistics.gateway.v1.MonteCarloResponse.flow_stats:type_name -> logistics.gateway.v1.MonteCarloStats
+	78,  // 112: logistics.gateway.v1.MonteCarloResponse.cost_stats:type_name -> logistics.gateway.v1.MonteCarloStats
+	79,  // 113: logistics.gateway.v1.MonteCarloResponse.risk_analysis:type_name -> logistics.gateway.v1.RiskAnalysis
+	108, // 114: logistics.gateway.v1.MonteCarloResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
+	77,  // 115: logistics.gateway.v1.MonteCarloResponse.samples:type_name -> logistics.gateway.v1.MonteCarloSample
+	7,   // 116: logistics.gateway.v1.MonteCarloResponse.stop_reason:type_name -> logistics.gateway.v1.MonteCarloStopReason
+	76,  // 117: logistics.gateway.v1.MonteCarloProgressEvent.final_result:type_name -> logistics.gateway.v1.MonteCarloResponse
+	170, // 118: logistics.gateway.v1.SensitivityRequest.graph:type_name -> logistics.common.v1.Graph
+	83,  // 119: logistics.gateway.v1.SensitivityRequest.parameters:type_name -> logistics.gateway.v1.SensitivityParameter
+	169, // 120: logistics.gateway.v1.SensitivityRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	82,  // 121: logistics.gateway.v1.SensitivityRequest.config:type_name -> logistics.gateway.v1.SensitivityConfig
+	10,  // 122: logistics.gateway.v1.SensitivityConfig.method:type_name -> logistics.gateway.v1.SensitivityMethod
+	177, // 123: logistics.gateway.v1.SensitivityParameter.edge:type_name -> logistics.common.v1.EdgeKey
+	4,   // 124: logistics.gateway.v1.SensitivityParameter.target:type_name -> logistics.gateway.v1.ModificationTarget
+	85,  // 125: logistics.gateway.v1.SensitivityResponse.results:type_name -> logistics.gateway.v1.SensitivityResult
+	89,  // 126: logistics.gateway.v1.SensitivityResponse.rankings:type_name -> logistics.gateway.v1.ParameterRanking
+	108, // 127: logistics.gateway.v1.SensitivityResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
+	88,  // 128: logistics.gateway.v1.SensitivityResult.curve:type_name -> logistics.gateway.v1.SensitivityPoint
+	86,  // 129: logistics.gateway.v1.SensitivityResult.morris:type_name -> logistics.gateway.v1.MorrisIndices
+	87,  // 130: logistics.gateway.v1.SensitivityResult.sobol:type_name -> logistics.gateway.v1.SobolIndices
+	170, // 131: logistics.gateway.v1.ResilienceRequest.graph:type_name -> logistics.common.v1.Graph
+	91,  // 132: logistics.gateway.v1.ResilienceRequest.config:type_name -> logistics.gateway.v1.ResilienceConfig
+	169, // 133: logistics.gateway.v1.ResilienceRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	96,  // 134: logistics.gateway.v1.ResilienceResponse.metrics:type_name -> logistics.gateway.v1.ResilienceMetrics
+	97,  // 135: logistics.gateway.v1.ResilienceResponse.weaknesses:type_name -> logistics.gateway.v1.ResilienceWeakness
+	108, // 136: logistics.gateway.v1.ResilienceResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
+	93,  // 137: logistics.gateway.v1.ResilienceResponse.critical_edge_pairs:type_name -> logistics.gateway.v1.EdgePair
+	94,  // 138: logistics.gateway.v1.ResilienceResponse.cascades:type_name -> logistics.gateway.v1.CascadeScenario
+	177, // 139: logistics.gateway.v1.EdgePair.edge1:type_name -> logistics.common.v1.EdgeKey
+	177, // 140: logistics.gateway.v1.EdgePair.edge2:type_name -> logistics.common.v1.EdgeKey
+	177, // 141: logistics.gateway.v1.CascadeScenario.initial_failure:type_name -> logistics.common.v1.EdgeKey
+	95,  // 142: logistics.gateway.v1.CascadeScenario.steps:type_name -> logistics.gateway.v1.CascadeStep
+	177, // 143: logistics.gateway.v1.CascadeStep.failed_edges:type_name -> logistics.common.v1.EdgeKey
+	177, // 144: logistics.gateway.v1.ResilienceWeakness.affected_edges:type_name -> logistics.common.v1.EdgeKey
+	170, // 145: logistics.gateway.v1.FailureSimulationRequest.graph:type_name -> logistics.common.v1.Graph
+	99,  // 146: logistics.gateway.v1.FailureSimulationRequest.scenarios:type_name -> logistics.gateway.v1.FailureScenario
+	169, // 147: logistics.gateway.v1.FailureSimulationRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	177, // 148: logistics.gateway.v1.FailureScenario.failed_edges:type_name -> logistics.common.v1.EdgeKey
+	62,  // 149: logistics.gateway.v1.FailureSimulationResponse.baseline:type_name -> logistics.gateway.v1.ScenarioResult
+	101, // 150: logistics.gateway.v1.FailureSimulationResponse.scenario_results:type_name -> logistics.gateway.v1.FailureScenarioResult
+	102, // 151: logistics.gateway.v1.FailureSimulationResponse.stats:type_name -> logistics.gateway.v1.FailureStats
+	108, // 152: logistics.gateway.v1.FailureSimulationResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
+	62,  // 153: logistics.gateway.v1.FailureScenarioResult.result:type_name -> logistics.gateway.v1.ScenarioResult
+	69,  // 154: logistics.gateway.v1.FailureScenarioResult.vs_baseline:type_name -> logistics.gateway.v1.ScenarioComparison
+	170, // 155: logistics.gateway.v1.CriticalElementsRequest.graph:type_name -> logistics.common.v1.Graph
+	104, // 156: logistics.gateway.v1.CriticalElementsRequest.config:type_name -> logistics.gateway.v1.CriticalElementsConfig
+	169, // 157: logistics.gateway.v1.CriticalElementsRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	106, // 158: logistics.gateway.v1.CriticalElementsResponse.critical_edges:type_name -> logistics.gateway.v1.CriticalEdge
+	107, // 159: logistics.gateway.v1.CriticalElementsResponse.critical_nodes:type_name -> logistics.gateway.v1.CriticalNode
+	177, // 160: logistics.gateway.v1.CriticalElementsResponse.single_points_of_failure:type_name -> logistics.common.v1.EdgeKey
+	108, // 161: logistics.gateway.v1.CriticalElementsResponse.metadata:type_name -> logistics.gateway.v1.SimulationMetadata
+	177, // 162: logistics.gateway.v1.CriticalEdge.edge:type_name -> logistics.common.v1.EdgeKey
+	168, // 163: logistics.gateway.v1.SimulationMetadata.completed_at:type_name -> google.protobuf.Timestamp
+	112, // 164: logistics.gateway.v1.ListSimulationsResponse.simulations:type_name -> logistics.gateway.v1.SimulationRecord
+	168, // 165: logistics.gateway.v1.SimulationRecord.created_at:type_name -> google.protobuf.Timestamp
+	159, // 166: logistics.gateway.v1.SimulationRecord.tags:type_name -> logistics.gateway.v1.SimulationRecord.TagsEntry
+	170, // 167: logistics.gateway.v1.SaveCalculationRequest.graph:type_name -> logistics.common.v1.Graph
+	30,  // 168: logistics.gateway.v1.SaveCalculationRequest.result:type_name -> logistics.gateway.v1.SolveGraphResponse
+	160, // 169: logistics.gateway.v1.SaveCalculationRequest.tags:type_name -> logistics.gateway.v1.SaveCalculationRequest.TagsEntry
+	168, // 170: logistics.gateway.v1.SaveCalculationResponse.created_at:type_name -> google.protobuf.Timestamp
+	169, // 171: logistics.gateway.v1.ListCalculationsRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	168, // 172: logistics.gateway.v1.ListCalculationsRequest.created_after:type_name -> google.protobuf.Timestamp
+	168, // 173: logistics.gateway.v1.ListCalculationsRequest.created_before:type_name -> google.protobuf.Timestamp
+	120, // 174: logistics.gateway.v1.ListCalculationsResponse.calculations:type_name -> logistics.gateway.v1.CalculationSummary
+	168, // 175: logistics.gateway.v1.CalculationRecord.created_at:type_name -> google.protobuf.Timestamp
+	170, // 176: logistics.gateway.v1.CalculationRecord.graph:type_name -> logistics.common.v1.Graph
+	30,  // 177: logistics.gateway.v1.CalculationRecord.result:type_name -> logistics.gateway.v1.SolveGraphResponse
+	161, // 178: logistics.gateway.v1.CalculationRecord.tags:type_name -> logistics.gateway.v1.CalculationRecord.TagsEntry
+	168, // 179: logistics.gateway.v1.CalculationSummary.created_at:type_name -> google.protobuf.Timestamp
+	169, // 180: logistics.gateway.v1.CalculationSummary.algorithm:type_name -> logistics.common.v1.Algorithm
+	168, // 181: logistics.gateway.v1.GetStatisticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	168, // 182: logistics.gateway.v1.GetStatisticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	162, // 183: logistics.gateway.v1.StatisticsResponse.calculations_by_algorithm:type_name -> logistics.gateway.v1.StatisticsResponse.CalculationsByAlgorithmEntry
+	124, // 184: logistics.gateway.v1.StatisticsResponse.daily_stats:type_name -> logistics.gateway.v1.DailyStats
+	12,  // 185: logistics.gateway.v1.GenerateReportRequest.type:type_name -> logistics.gateway.v1.ReportType
+	11,  // 186: logistics.gateway.v1.GenerateReportRequest.format:type_name -> logistics.gateway.v1.ReportFormat
+	126, // 187: logistics.gateway.v1.GenerateReportRequest.options:type_name -> logistics.gateway.v1.ReportOptions
+	127, // 188: logistics.gateway.v1.GenerateReportRequest.flow_source:type_name -> logistics.gateway.v1.FlowReportSource
+	128, // 189: logistics.gateway.v1.GenerateReportRequest.analytics_source:type_name -> logistics.gateway.v1.AnalyticsReportSource
+	129, // 190: logistics.gateway.v1.GenerateReportRequest.simulation_source:type_name -> logistics.gateway.v1.SimulationReportSource
+	130, // 191: logistics.gateway.v1.GenerateReportRequest.history_source:type_name -> logistics.gateway.v1.HistoryReportSource
+	170, // 192: logistics.gateway.v1.FlowReportSource.graph:type_name -> logistics.common.v1.Graph
+	172, // 193: logistics.gateway.v1.FlowReportSource.result:type_name -> logistics.common.v1.FlowResult
+	37,  // 194: logistics.gateway.v1.FlowReportSource.metrics:type_name -> logistics.gateway.v1.SolveMetrics
+	170, // 195: logistics.gateway.v1.AnalyticsReportSource.graph:type_name -> logistics.common.v1.Graph
+	46,  // 196: logistics.gateway.v1.AnalyticsReportSource.analytics:type_name -> logistics.gateway.v1.AnalyzeGraphResponse
+	170, // 197: logistics.gateway.v1.SimulationReportSource.baseline_graph:type_name -> logistics.common.v1.Graph
+	168, // 198: logistics.gateway.v1.HistoryReportSource.start_time:type_name -> google.protobuf.Timestamp
+	168, // 199: logistics.gateway.v1.HistoryReportSource.end_time:type_name -> google.protobuf.Timestamp
+	132, // 200: logistics.gateway.v1.GenerateReportResponse.report:type_name -> logistics.gateway.v1.ReportInfo
+	12,  // 201: logistics.gateway.v1.ReportInfo.type:type_name -> logistics.gateway.v1.ReportType
+	11,  // 202: logistics.gateway.v1.ReportInfo.format:type_name -> logistics.gateway.v1.ReportFormat
+	168, // 203: logistics.gateway.v1.ReportInfo.generated_at:type_name -> google.protobuf.Timestamp
+	168, // 204: logistics.gateway.v1.ReportInfo.expires_at:type_name -> google.protobuf.Timestamp
+	132, // 205: logistics.gateway.v1.ReportRecord.info:type_name -> logistics.gateway.v1.ReportInfo
+	12,  // 206: logistics.gateway.v1.ListReportsRequest.type:type_name -> logistics.gateway.v1.ReportType
+	11,  // 207: logistics.gateway.v1.ListReportsRequest.format:type_name -> logistics.gateway.v1.ReportFormat
+	168, // 208: logistics.gateway.v1.ListReportsRequest.created_after:type_name -> google.protobuf.Timestamp
+	168, // 209: logistics.gateway.v1.ListReportsRequest.created_before:type_name -> google.protobuf.Timestamp
+	132, // 210: logistics.gateway.v1.ListReportsResponse.reports:type_name -> logistics.gateway.v1.ReportInfo
+	141, // 211: logistics.gateway.v1.ReportFormatsResponse.formats:type_name -> logistics.gateway.v1.ReportFormatInfo
+	11,  // 212: logistics.gateway.v1.ReportFormatInfo.format:type_name -> logistics.gateway.v1.ReportFormat
+	12,  // 213: logistics.gateway.v1.ReportFormatInfo.supported_report_types:type_name -> logistics.gateway.v1.ReportType
+	168, // 214: logistics.gateway.v1.GetAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	168, // 215: logistics.gateway.v1.GetAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	144, // 216: logistics.gateway.v1.AuditLogsResponse.entries:type_name -> logistics.gateway.v1.AuditEntry
+	168, // 217: logistics.gateway.v1.AuditEntry.timestamp:type_name -> google.protobuf.Timestamp
+	163, // 218: logistics.gateway.v1.AuditEntry.metadata:type_name -> logistics.gateway.v1.AuditEntry.MetadataEntry
+	168, // 219: logistics.gateway.v1.GetUserActivityRequest.start_time:type_name -> google.protobuf.Timestamp
+	168, // 220: logistics.gateway.v1.GetUserActivityRequest.end_time:type_name -> google.protobuf.Timestamp
+	144, // 221: logistics.gateway.v1.UserActivityResponse.entries:type_name -> logistics.gateway.v1.AuditEntry
+	147, // 222: logistics.gateway.v1.UserActivityResponse.summary:type_name -> logistics.gateway.v1.UserActivitySummary
+	164, // 223: logistics.gateway.v1.UserActivitySummary.actions_by_type:type_name -> logistics.gateway.v1.UserActivitySummary.ActionsByTypeEntry
+	165, // 224: logistics.gateway.v1.UserActivitySummary.actions_by_service:type_name -> logistics.gateway.v1.UserActivitySummary.ActionsByServiceEntry
+	168, // 225: logistics.gateway.v1.UserActivitySummary.first_activity:type_name -> google.protobuf.Timestamp
+	168, // 226: logistics.gateway.v1.UserActivitySummary.last_activity:type_name -> google.protobuf.Timestamp
+	168, // 227: logistics.gateway.v1.GetAuditStatsRequest.start_time:type_name -> google.protobuf.Timestamp
+	168, // 228: logistics.gateway.v1.GetAuditStatsRequest.end_time:type_name -> google.protobuf.Timestamp
+	166, // 229: logistics.gateway.v1.AuditStatsResponse.by_service:type_name -> logistics.gateway.v1.AuditStatsResponse.ByServiceEntry
+	167, // 230: logistics.gateway.v1.AuditStatsResponse.by_action:type_name -> logistics.gateway.v1.AuditStatsResponse.ByActionEntry
+	150, // 231: logistics.gateway.v1.AuditStatsResponse.timeline:type_name -> logistics.gateway.v1.AuditStatsPoint
+	168, // 232: logistics.gateway.v1.AuditStatsPoint.timestamp:type_name -> google.protobuf.Timestamp
+	168, // 233: logistics.gateway.v1.RequestMetadata.processed_at:type_name -> google.protobuf.Timestamp
+	14,  // 234: logistics.gateway.v1.HealthResponse.ServicesEntry.value:type_name -> logistics.gateway.v1.ServiceHealth
+	180, // 235: logistics.gateway.v1.GatewayService.Health:input_type -> google.protobuf.Empty
+	180, // 236: logistics.gateway.v1.GatewayService.ReadinessCheck:input_type -> google.protobuf.Empty
+	180, // 237: logistics.gateway.v1.GatewayService.Info:input_type -> google.protobuf.Empty
+	180, // 238: logistics.gateway.v1.GatewayService.GetAlgorithms:input_type -> google.protobuf.Empty
+	20,  // 239: logistics.gateway.v1.GatewayService.Register:input_type -> logistics.gateway.v1.RegisterRequest
+	21,  // 240: logistics.gateway.v1.GatewayService.Login:input_type -> logistics.gateway.v1.LoginRequest
+	22,  // 241: logistics.gateway.v1.GatewayService.RefreshToken:input_type -> logistics.gateway.v1.RefreshTokenRequest
+	180, // 242: logistics.gateway.v1.GatewayService.Logout:input_type -> google.protobuf.Empty
+	180, // 243: logistics.gateway.v1.GatewayService.GetProfile:input_type -> google.protobuf.Empty
+	23,  // 244: logistics.gateway.v1.GatewayService.ValidateToken:input_type -> logistics.gateway.v1.ValidateTokenRequest
+	27,  // 245: logistics.gateway.v1.GatewayService.CalculateLogistics:input_type -> logistics.gateway.v1.CalculateLogisticsRequest
+	29,  // 246: logistics.gateway.v1.GatewayService.SolveGraph:input_type -> logistics.gateway.v1.SolveGraphRequest
+	29,  // 247: logistics.gateway.v1.GatewayService.SolveGraphStream:input_type -> logistics.gateway.v1.SolveGraphRequest
+	32,  // 248: logistics.gateway.v1.GatewayService.BatchSolve:input_type -> logistics.gateway.v1.BatchSolveRequest
+	38,  // 249: logistics.gateway.v1.GatewayService.ValidateGraph:input_type -> logistics.gateway.v1.ValidateGraphRequest
+	40,  // 250: logistics.gateway.v1.GatewayService.ValidateForAlgorithm:input_type -> logistics.gateway.v1.ValidateForAlgorithmRequest
+	45,  // 251: logistics.gateway.v1.GatewayService.AnalyzeGraph:input_type -> logistics.gateway.v1.AnalyzeGraphRequest
+	48,  // 252: logistics.gateway.v1.GatewayService.CalculateCost:input_type -> logistics.gateway.v1.CalculateCostRequest
+	53,  // 253: logistics.gateway.v1.GatewayService.GetBottlenecks:input_type -> logistics.gateway.v1.BottlenecksRequest
+	59,  // 254: logistics.gateway.v1.GatewayService.CompareScenarios:input_type -> logistics.gateway.v1.CompareScenariosRequest
+	65,  // 255: logistics.gateway.v1.GatewayService.RunWhatIf:input_type -> logistics.gateway.v1.WhatIfRequest
+	70,  // 256: logistics.gateway.v1.GatewayService.RunMonteCarlo:input_type -> logistics.gateway.v1.MonteCarloRequest
+	70,  // 257: logistics.gateway.v1.GatewayService.RunMonteCarloStream:input_type -> logistics.gateway.v1.MonteCarloRequest
+	81,  // 258: logistics.gateway.v1.GatewayService.AnalyzeSensitivity:input_type -> logistics.gateway.v1.SensitivityRequest
+	90,  // 259: logistics.gateway.v1.GatewayService.AnalyzeResilience:input_type -> logistics.gateway.v1.ResilienceRequest
+	98,  // 260: logistics.gateway.v1.GatewayService.SimulateFailures:input_type -> logistics.gateway.v1.FailureSimulationRequest
+	103, // 261: logistics.gateway.v1.GatewayService.FindCriticalElements:input_type -> logistics.gateway.v1.CriticalElementsRequest
+	109, // 262: logistics.gateway.v1.GatewayService.GetSimulation:input_type -> logistics.gateway.v1.GetSimulationRequest
+	110, // 263: logistics.gateway.v1.GatewayService.ListSimulations:input_type -> logistics.gateway.v1.ListSimulationsRequest
+	113, // 264: logistics.gateway.v1.GatewayService.DeleteSimulation:input_type -> logistics.gateway.v1.DeleteSimulationRequest
+	114, // 265: logistics.gateway.v1.GatewayService.SaveCalculation:input_type -> logistics.gateway.v1.SaveCalculationRequest
+	116, // 266: logistics.gateway.v1.GatewayService.GetCalculation:input_type -> logistics.gateway.v1.GetCalculationRequest
+	117, // 267: logistics.gateway.v1.GatewayService.ListCalculations:input_type -> logistics.gateway.v1.ListCalculationsRequest
+	121, // 268: logistics.gateway.v1.GatewayService.DeleteCalculation:input_type -> logistics.gateway.v1.DeleteCalculationRequest
+	122, // 269: logistics.gateway.v1.GatewayService.GetStatistics:input_type -> logistics.gateway.v1.GetStatisticsRequest
+	125, // 270: logistics.gateway.v1.GatewayService.GenerateReport:input_type -> logistics.gateway.v1.GenerateReportRequest
+	133, // 271: logistics.gateway.v1.GatewayService.GetReport:input_type -> logistics.gateway.v1.GetReportRequest
+	134, // 272: logistics.gateway.v1.GatewayService.DownloadReport:input_type -> logistics.gateway.v1.DownloadReportRequest
+	137, // 273: logistics.gateway.v1.GatewayService.ListReports:input_type -> logistics.gateway.v1.ListReportsRequest
+	139, // 274: logistics.gateway.v1.GatewayService.DeleteReport:input_type -> logistics.gateway.v1.DeleteReportRequest
+	180, // 275: logistics.gateway.v1.GatewayService.GetReportFormats:input_type -> google.protobuf.Empty
+	142, // 276: logistics.gateway.v1.GatewayService.GetAuditLogs:input_type -> logistics.gateway.v1.GetAuditLogsRequest
+	145, // 277: logistics.gateway.v1.GatewayService.GetUserActivity:input_type -> logistics.gateway.v1.GetUserActivityRequest
+	148, // 278: logistics.gateway.v1.GatewayService.GetAuditStats:input_type -> logistics.gateway.v1.GetAuditStatsRequest
+	13,  // 279: logistics.gateway.v1.GatewayService.Health:output_type -> logistics.gateway.v1.HealthResponse
+	15,  // 280: logistics.gateway.v1.GatewayService.ReadinessCheck:output_type -> logistics.gateway.v1.ReadinessResponse
+	16,  // 281: logistics.gateway.v1.GatewayService.Info:output_type -> logistics.gateway.v1.InfoResponse
+	18,  // 282: logistics.gateway.v1.GatewayService.GetAlgorithms:output_type -> logistics.gateway.v1.AlgorithmsResponse
+	25,  // 283: logistics.gateway.v1.GatewayService.Register:output_type -> logistics.gateway.v1.AuthResponse
+	25,  // 284: logistics.gateway.v1.GatewayService.Login:output_type -> logistics.gateway.v1.AuthResponse
+	25,  // 285: logistics.gateway.v1.GatewayService.RefreshToken:output_type -> logistics.gateway.v1.AuthResponse
+	180, // 286: logistics.gateway.v1.GatewayService.Logout:output_type -> google.protobuf.Empty
+	26,  // 287: logistics.gateway.v1.GatewayService.GetProfile:output_type -> logistics.gateway.v1.UserProfile
+	24,  // 288: logistics.gateway.v1.GatewayService.ValidateToken:output_type -> logistics.gateway.v1.ValidateTokenResponse
+	28,  // 289: logistics.gateway.v1.GatewayService.CalculateLogistics:output_type -> logistics.gateway.v1.CalculateLogisticsResponse
+	30,  // 290: logistics.gateway.v1.GatewayService.SolveGraph:output_type -> logistics.gateway.v1.SolveGraphResponse
+	31,  // 291: logistics.gateway.v1.GatewayService.SolveGraphStream:output_type -> logistics.gateway.v1.SolveProgressEvent
+	34,  // 292: logistics.gateway.v1.GatewayService.BatchSolve:output_type -> logistics.gateway.v1.BatchSolveResponse
+	39,  // 293: logistics.gateway.v1.GatewayService.ValidateGraph:output_type -> logistics.gateway.v1.ValidateGraphResponse
+	41,  // 294: logistics.gateway.v1.GatewayService.ValidateForAlgorithm:output_type -> logistics.gateway.v1.ValidateForAlgorithmResponse
+	46,  // 295: logistics.gateway.v1.GatewayService.AnalyzeGraph:output_type -> logistics.gateway.v1.AnalyzeGraphResponse
+	49,  // 296: logistics.gateway.v1.GatewayService.CalculateCost:output_type -> logistics.gateway.v1.CalculateCostResponse
+	54,  // 297: logistics.gateway.v1.GatewayService.GetBottlenecks:output_type -> logistics.gateway.v1.BottlenecksResponse
+	61,  // 298: logistics.gateway.v1.GatewayService.CompareScenarios:output_type -> logistics.gateway.v1.CompareScenariosResponse
+	68,  // 299: logistics.gateway.v1.GatewayService.RunWhatIf:output_type -> logistics.gateway.v1.WhatIfResponse
+	76,  // 300: logistics.gateway.v1.GatewayService.RunMonteCarlo:output_type -> logistics.gateway.v1.MonteCarloResponse
+	80,  // 301: logistics.gateway.v1.GatewayService.RunMonteCarloStream:output_type -> logistics.gateway.v1.MonteCarloProgressEvent
+	84,  // 302: logistics.gateway.v1.GatewayService.AnalyzeSensitivity:output_type -> logistics.gateway.v1.SensitivityResponse
+	92,  // 303: logistics.gateway.v1.GatewayService.AnalyzeResilience:output_type -> logistics.gateway.v1.ResilienceResponse
+	100, // 304: logistics.gateway.v1.GatewayService.SimulateFailures:output_type -> logistics.gateway.v1.FailureSimulationResponse
+	105, // 305: logistics.gateway.v1.GatewayService.FindCriticalElements:output_type -> logistics.gateway.v1.CriticalElementsResponse
+	112, // 306: logistics.gateway.v1.GatewayService.GetSimulation:output_type -> logistics.gateway.v1.SimulationRecord
+	111, // 307: logistics.gateway.v1.GatewayService.ListSimulations:output_type -> logistics.gateway.v1.ListSimulationsResponse
+	180, // 308: logistics.gateway.v1.GatewayService.DeleteSimulation:output_type -> google.protobuf.Empty
+	115, // 309: logistics.gateway.v1.GatewayService.SaveCalculation:output_type -> logistics.gateway.v1.SaveCalculationResponse
+	119, // 310: logistics.gateway.v1.GatewayService.GetCalculation:output_type -> logistics.gateway.v1.CalculationRecord
+	118, // 311: logistics.gateway.v1.GatewayService.ListCalculations:output_type -> logistics.gateway.v1.ListCalculationsResponse
+	180, // 312: logistics.gateway.v1.GatewayService.DeleteCalculation:output_type -> google.protobuf.Empty
+	123, // 313: logistics.gateway.v1.GatewayService.GetStatistics:output_type -> logistics.gateway.v1.StatisticsResponse
+	131, // 314: logistics.gateway.v1.GatewayService.GenerateReport:output_type -> logistics.gateway.v1.GenerateReportResponse
+	136, // 315: logistics.gateway.v1.GatewayService.GetReport:output_type -> logistics.gateway.v1.ReportRecord
+	135, // 316: logistics.gateway.v1.GatewayService.DownloadReport:output_type -> logistics.gateway.v1.ReportChunk
+	138, // 317: logistics.gateway.v1.GatewayService.ListReports:output_type -> logistics.gateway.v1.ListReportsResponse
+	180, // 318: logistics.gateway.v1.GatewayService.DeleteReport:output_type -> google.protobuf.Empty
+	140, // 319: logistics.gateway.v1.GatewayService.GetReportFormats:output_type -> logistics.gateway.v1.ReportFormatsResponse
+	143, // 320: logistics.gateway.v1.GatewayService.GetAuditLogs:output_type -> logistics.gateway.v1.AuditLogsResponse
+	146, // 321: logistics.gateway.v1.GatewayService.GetUserActivity:output_type -> logistics.gateway.v1.UserActivityResponse
+	149, // 322: logistics.gateway.v1.GatewayService.GetAuditStats:output_type -> logistics.gateway.v1.AuditStatsResponse
+	279, // [279:323] is the sub-list for method output_type
+	235, // [235:279] is the sub-list for method input_type
+	235, // [235:235] is the sub-list for extension type_name
+	235, // [235:235] is the sub-list for extension extendee
+	0,   // [0:235] is the sub-list for field type_name
 }
 
 func init() { file_logistics_gateway_v1_gateway_proto_init() }
@@ -11530,7 +12763,7 @@ func file_logistics_gateway_v1_gateway_proto_init() {
 	if File_logistics_gateway_v1_gateway_proto != nil {
 		return
 	}
-	file_logistics_gateway_v1_gateway_proto_msgTypes[103].OneofWrappers = []any{
+	file_logistics_gateway_v1_gateway_proto_msgTypes[112].OneofWrappers = []any{
 		(*GenerateReportRequest_FlowSource)(nil),
 		(*GenerateReportRequest_AnalyticsSource)(nil),
 		(*GenerateReportRequest_SimulationSource)(nil),
@@ -11541,8 +12774,8 @@ func file_logistics_gateway_v1_gateway_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_logistics_gateway_v1_gateway_proto_rawDesc), len(file_logistics_gateway_v1_gateway_proto_rawDesc)),
-			NumEnums:      8,
-			NumMessages:   146,
+			NumEnums:      13,
+			NumMessages:   155,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -42,6 +42,11 @@ const (
 	// SolverServiceGetAlgorithmsProcedure is the fully-qualified name of the SolverService's
 	// GetAlgorithms RPC.
 	SolverServiceGetAlgorithmsProcedure = "/logistics.optimization.v1.SolverService/GetAlgorithms"
+	// SolverServiceGetMinCutProcedure is the fully-qualified name of the SolverService's GetMinCut RPC.
+	SolverServiceGetMinCutProcedure = "/logistics.optimization.v1.SolverService/GetMinCut"
+	// SolverServiceSolveMultiCommodityProcedure is the fully-qualified name of the SolverService's
+	// SolveMultiCommodity RPC.
+	SolverServiceSolveMultiCommodityProcedure = "/logistics.optimization.v1.SolverService/SolveMultiCommodity"
 )
 
 // SolverServiceClient is a client for the logistics.optimization.v1.SolverService service.
@@ -52,6 +57,10 @@ type SolverServiceClient interface {
 	SolveStream(context.Context, *connect.Request[v1.SolveRequestForBigGraphs]) (*connect.ServerStreamForClient[v1.SolveProgress], error)
 	// Получить поддерживаемые алгоритмы
 	GetAlgorithms(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAlgorithmsResponse], error)
+	// Минимальный разрез после максимального потока
+	GetMinCut(context.Context, *connect.Request[v1.GetMinCutRequest]) (*connect.Response[v1.GetMinCutResponse], error)
+	// Многопродуктовый поток: несколько классов товаров на общих пропускных способностях
+	SolveMultiCommodity(context.Context, *connect.Request[v1.SolveMultiCommodityRequest]) (*connect.Response[v1.SolveMultiCommodityResponse], error)
 }
 
 // NewSolverServiceClient constructs a client for the logistics.optimization.v1.SolverService
@@ -83,14 +92,28 @@ func NewSolverServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(solverServiceMethods.ByName("GetAlgorithms")),
 			connect.WithClientOptions(opts...),
 		),
+		getMinCut: connect.NewClient[v1.GetMinCutRequest, v1.GetMinCutResponse](
+			httpClient,
+			baseURL+SolverServiceGetMinCutProcedure,
+			connect.WithSchema(solverServiceMethods.ByName("GetMinCut")),
+			connect.WithClientOptions(opts...),
+		),
+		solveMultiCommodity: connect.NewClient[v1.SolveMultiCommodityRequest, v1.SolveMultiCommodityResponse](
+			httpClient,
+			baseURL+SolverServiceSolveMultiCommodityProcedure,
+			connect.WithSchema(solverServiceMethods.ByName("SolveMultiCommodity")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // solverServiceClient implements SolverServiceClient.
 type solverServiceClient struct {
-	solve         *connect.Client[v1.SolveRequest, v1.SolveResponse]
-	solveStream   *connect.Client[v1.SolveRequestForBigGraphs, v1.SolveProgress]
-	getAlgorithms *connect.Client[emptypb.Empty, v1.GetAlgorithmsResponse]
+	solve               *connect.Client[v1.SolveRequest, v1.SolveResponse]
+	solveStream         *connect.Client[v1.SolveRequestForBigGraphs, v1.SolveProgress]
+	getAlgorithms       *connect.Client[emptypb.Empty, v1.GetAlgorithmsResponse]
+	getMinCut           *connect.Client[v1.GetMinCutRequest, v1.GetMinCutResponse]
+	solveMultiCommodity *connect.Client[v1.SolveMultiCommodityRequest, v1.SolveMultiCommodityResponse]
 }
 
 // Solve calls logistics.optimization.v1.SolverService.Solve.
@@ -108,6 +131,16 @@ func (c *solverServiceClient) GetAlgorithms(ctx context.Context, req *connect.Re
 	return c.getAlgorithms.CallUnary(ctx, req)
 }
 
+// GetMinCut calls logistics.optimization.v1.SolverService.GetMinCut.
+func (c *solverServiceClient) GetMinCut(ctx context.Context, req *connect.Request[v1.GetMinCutRequest]) (*connect.Response[v1.GetMinCutResponse], error) {
+	return c.getMinCut.CallUnary(ctx, req)
+}
+
+// SolveMultiCommodity calls logistics.optimization.v1.SolverService.SolveMultiCommodity.
+func (c *solverServiceClient) SolveMultiCommodity(ctx context.Context, req *connect.Request[v1.SolveMultiCommodityRequest]) (*connect.Response[v1.SolveMultiCommodityResponse], error) {
+	return c.solveMultiCommodity.CallUnary(ctx, req)
+}
+
 // SolverServiceHandler is an implementation of the logistics.optimization.v1.SolverService service.
 type SolverServiceHandler interface {
 	// Основной метод решения (Unary)
@@ -116,6 +149,10 @@ type SolverServiceHandler interface {
 	SolveStream(context.Context, *connect.Request[v1.SolveRequestForBigGraphs], *connect.ServerStream[v1.SolveProgress]) error
 	// Получить поддерживаемые алгоритмы
 	GetAlgorithms(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAlgorithmsResponse], error)
+	// Минимальный разрез после максимального потока
+	GetMinCut(context.Context, *connect.Request[v1.GetMinCutRequest]) (*connect.Response[v1.GetMinCutResponse], error)
+	// Многопродуктовый поток: несколько классов товаров на общих пропускных способностях
+	SolveMultiCommodity(context.Context, *connect.Request[v1.SolveMultiCommodityRequest]) (*connect.Response[v1.SolveMultiCommodityResponse], error)
 }
 
 // NewSolverServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +180,18 @@ func NewSolverServiceHandler(svc SolverServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(solverServiceMethods.ByName("GetAlgorithms")),
 		connect.WithHandlerOptions(opts...),
 	)
+	solverServiceGetMinCutHandler := connect.NewUnaryHandler(
+		SolverServiceGetMinCutProcedure,
+		svc.GetMinCut,
+		connect.WithSchema(solverServiceMethods.ByName("GetMinCut")),
+		connect.WithHandlerOptions(opts...),
+	)
+	solverServiceSolveMultiCommodityHandler := connect.NewUnaryHandler(
+		SolverServiceSolveMultiCommodityProcedure,
+		svc.SolveMultiCommodity,
+		connect.WithSchema(solverServiceMethods.ByName("SolveMultiCommodity")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/logistics.optimization.v1.SolverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SolverServiceSolveProcedure:
@@ -151,6 +200,10 @@ func NewSolverServiceHandler(svc SolverServiceHandler, opts ...connect.HandlerOp
 			solverServiceSolveStreamHandler.ServeHTTP(w, r)
 		case SolverServiceGetAlgorithmsProcedure:
 			solverServiceGetAlgorithmsHandler.ServeHTTP(w, r)
+		case SolverServiceGetMinCutProcedure:
+			solverServiceGetMinCutHandler.ServeHTTP(w, r)
+		case SolverServiceSolveMultiCommodityProcedure:
+			solverServiceSolveMultiCommodityHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +224,11 @@ func (UnimplementedSolverServiceHandler) SolveStream(context.Context, *connect.R
 func (UnimplementedSolverServiceHandler) GetAlgorithms(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAlgorithmsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.optimization.v1.SolverService.GetAlgorithms is not implemented"))
 }
+
+func (UnimplementedSolverServiceHandler) GetMinCut(context.Context, *connect.Request[v1.GetMinCutRequest]) (*connect.Response[v1.GetMinCutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.optimization.v1.SolverService.GetMinCut is not implemented"))
+}
+
+func (UnimplementedSolverServiceHandler) SolveMultiCommodity(context.Context, *connect.Request[v1.SolveMultiCommodityRequest]) (*connect.Response[v1.SolveMultiCommodityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.optimization.v1.SolverService.SolveMultiCommodity is not implemented"))
+}
@@ -23,6 +23,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SolveMode int32
+
+const (
+	SolveMode_SOLVE_MODE_UNSPECIFIED SolveMode = 0 // То же, что SOLVE_MODE_MAX_FLOW
+	SolveMode_SOLVE_MODE_MAX_FLOW    SolveMode = 1 // Максимальный поток между source_id и sink_id (или супер-терминалами)
+	// Транспортная задача: supply/demand узлов — жёсткие ограничения,
+	// весь спрос удовлетворяется с минимальной стоимостью, иначе FLOW_STATUS_INFEASIBLE
+	SolveMode_SOLVE_MODE_TRANSPORTATION SolveMode = 2
+)
+
+// Enum value maps for SolveMode.
+var (
+	SolveMode_name = map[int32]string{
+		0: "SOLVE_MODE_UNSPECIFIED",
+		1: "SOLVE_MODE_MAX_FLOW",
+		2: "SOLVE_MODE_TRANSPORTATION",
+	}
+	SolveMode_value = map[string]int32{
+		"SOLVE_MODE_UNSPECIFIED":    0,
+		"SOLVE_MODE_MAX_FLOW":       1,
+		"SOLVE_MODE_TRANSPORTATION": 2,
+	}
+)
+
+func (x SolveMode) Enum() *SolveMode {
+	p := new(SolveMode)
+	*p = x
+	return p
+}
+
+func (x SolveMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SolveMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_optimization_v1_solver_proto_enumTypes[0].Descriptor()
+}
+
+func (SolveMode) Type() protoreflect.EnumType {
+	return &file_logistics_optimization_v1_solver_proto_enumTypes[0]
+}
+
+func (x SolveMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SolveMode.Descriptor instead.
+func (SolveMode) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{0}
+}
+
 type SolveRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Graph         *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
@@ -149,8 +200,18 @@ type SolveOptions struct {
 	ReturnPaths    bool                   `protobuf:"varint,2,opt,name=return_paths,json=returnPaths,proto3" json:"return_paths,omitempty"`           // Возвращать увеличивающие пути
 	MaxIterations  int32                  `protobuf:"varint,3,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`     // Лимит итераций (0 = без лимита)
 	Epsilon        float64                `protobuf:"fixed64,4,opt,name=epsilon,proto3" json:"epsilon,omitempty"`                                     // Точность сравнения (default: 1e-9)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Mode           SolveMode              `protobuf:"varint,5,opt,name=mode,proto3,enum=logistics.optimization.v1.SolveMode" json:"mode,omitempty"`   // Постановка задачи (default: максимальный поток)
+	ReturnMinCut   bool                   `protobuf:"varint,6,opt,name=return_min_cut,json=returnMinCut,proto3" json:"return_min_cut,omitempty"`      // Возвращать минимальный разрез в FlowResult.min_cut (только SOLVE_MODE_MAX_FLOW)
+	// Тёплый старт: solved_graph предыдущего решения. current_flow переносится по id рёбер
+	// (ограничивается новыми пропускными способностями) и достраивается инкрементально.
+	// Игнорируется в SOLVE_MODE_TRANSPORTATION, для алгоритмов минимальной стоимости,
+	// при min_flow рёбер и в SolveStream
+	WarmStart *v1.Graph `protobuf:"bytes,7,opt,name=warm_start,json=warmStart,proto3" json:"warm_start,omitempty"`
+	// Разложение итогового потока на пути и циклы в FlowResult.decomposition
+	// (для любого алгоритма, в отличие от return_paths)
+	ReturnDecomposition bool `protobuf:"varint,8,opt,name=return_decomposition,json=returnDecomposition,proto3" json:"return_decomposition,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *SolveOptions) Reset() {
@@ -211,6 +272,34 @@ func (x *SolveOptions) GetEpsilon() float64 {
 	return 0
 }
 
+func (x *SolveOptions) GetMode() SolveMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SolveMode_SOLVE_MODE_UNSPECIFIED
+}
+
+func (x *SolveOptions) GetReturnMinCut() bool {
+	if x != nil {
+		return x.ReturnMinCut
+	}
+	return false
+}
+
+func (x *SolveOptions) GetWarmStart() *v1.Graph {
+	if x != nil {
+		return x.WarmStart
+	}
+	return nil
+}
+
+func (x *SolveOptions) GetReturnDecomposition() bool {
+	if x != nil {
+		return x.ReturnDecomposition
+	}
+	return false
+}
+
 type SolveResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -293,6 +382,7 @@ type SolveMetrics struct {
 	Iterations           int32                  `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
 	AugmentingPathsFound int32                  `protobuf:"varint,3,opt,name=augmenting_paths_found,json=augmentingPathsFound,proto3" json:"augmenting_paths_found,omitempty"`
 	MemoryUsedBytes      int64                  `protobuf:"varint,4,opt,name=memory_used_bytes,json=memoryUsedBytes,proto3" json:"memory_used_bytes,omitempty"`
+	WarmStarted          bool                   `protobuf:"varint,5,opt,name=warm_started,json=warmStarted,proto3" json:"warm_started,omitempty"` // Решение достроено из SolveOptions.warm_start
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -355,6 +445,587 @@ func (x *SolveMetrics) GetMemoryUsedBytes() int64 {
 	return 0
 }
 
+func (x *SolveMetrics) GetWarmStarted() bool {
+	if x != nil {
+		return x.WarmStarted
+	}
+	return false
+}
+
+type GetMinCutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Graph         *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Algorithm     v1.Algorithm           `protobuf:"varint,2,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"` // Алгоритм максимального потока
+	Options       *SolveOptions          `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`                                         // mode и return_min_cut игнорируются
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMinCutRequest) Reset() {
+	*x = GetMinCutRequest{}
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMinCutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMinCutRequest) ProtoMessage() {}
+
+func (x *GetMinCutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMinCutRequest.ProtoReflect.Descriptor instead.
+func (*GetMinCutRequest) Descriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMinCutRequest) GetGraph() *v1.Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *GetMinCutRequest) GetAlgorithm() v1.Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return v1.Algorithm(0)
+}
+
+func (x *GetMinCutRequest) GetOptions() *SolveOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GetMinCutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	MinCut        *v1.MinCut             `protobuf:"bytes,2,opt,name=min_cut,json=minCut,proto3" json:"min_cut,omitempty"`
+	MaxFlow       float64                `protobuf:"fixed64,3,opt,name=max_flow,json=maxFlow,proto3" json:"max_flow,omitempty"`
+	Metrics       *SolveMetrics          `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMinCutResponse) Reset() {
+	*x = GetMinCutResponse{}
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMinCutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMinCutResponse) ProtoMessage() {}
+
+func (x *GetMinCutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMinCutResponse.ProtoReflect.Descriptor instead.
+func (*GetMinCutResponse) Descriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMinCutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetMinCutResponse) GetMinCut() *v1.MinCut {
+	if x != nil {
+		return x.MinCut
+	}
+	return nil
+}
+
+func (x *GetMinCutResponse) GetMaxFlow() float64 {
+	if x != nil {
+		return x.MaxFlow
+	}
+	return 0
+}
+
+func (x *GetMinCutResponse) GetMetrics() *SolveMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *GetMinCutResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// Класс товаров (заморозка, сухие, опасные грузы), перевозимый по общей сети
+type Commodity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Идентификатор (0 = номер в списке, начиная с 1)
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SourceId      int64                  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SinkId        int64                  `protobuf:"varint,4,opt,name=sink_id,json=sinkId,proto3" json:"sink_id,omitempty"`
+	Demand        float64                `protobuf:"fixed64,5,opt,name=demand,proto3" json:"demand,omitempty"`                                                                                                               // Требуемый объём (> 0)
+	CostOverrides map[int64]float64      `protobuf:"bytes,6,rep,name=cost_overrides,json=costOverrides,proto3" json:"cost_overrides,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // Стоимость рёбер для этого товара: Edge.id → cost
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Commodity) Reset() {
+	*x = Commodity{}
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Commodity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Commodity) ProtoMessage() {}
+
+func (x *Commodity) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Commodity.ProtoReflect.Descriptor instead.
+func (*Commodity) Descriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Commodity) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Commodity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Commodity) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *Commodity) GetSinkId() int64 {
+	if x != nil {
+		return x.SinkId
+	}
+	return 0
+}
+
+func (x *Commodity) GetDemand() float64 {
+	if x != nil {
+		return x.Demand
+	}
+	return 0
+}
+
+func (x *Commodity) GetCostOverrides() map[int64]float64 {
+	if x != nil {
+		return x.CostOverrides
+	}
+	return nil
+}
+
+type MultiCommodityOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TimeoutSeconds float64                `protobuf:"fixed64,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // Таймаут (0 = без лимита)
+	Approximation  float64                `protobuf:"fixed64,2,opt,name=approximation,proto3" json:"approximation,omitempty"`                         // Точность приближения ε (default: 0.1, допустимо 0.05–0.5)
+	MaxPhases      int32                  `protobuf:"varint,3,opt,name=max_phases,json=maxPhases,proto3" json:"max_phases,omitempty"`                 // Лимит фаз алгоритма (0 = без лимита)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MultiCommodityOptions) Reset() {
+	*x = MultiCommodityOptions{}
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiCommodityOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCommodityOptions) ProtoMessage() {}
+
+func (x *MultiCommodityOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCommodityOptions.ProtoReflect.Descriptor instead.
+func (*MultiCommodityOptions) Descriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MultiCommodityOptions) GetTimeoutSeconds() float64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *MultiCommodityOptions) GetApproximation() float64 {
+	if x != nil {
+		return x.Approximation
+	}
+	return 0
+}
+
+func (x *MultiCommodityOptions) GetMaxPhases() int32 {
+	if x != nil {
+		return x.MaxPhases
+	}
+	return 0
+}
+
+// Граф задаёт общие пропускные способности; source_id, sink_id, supply и demand узлов
+// не используются, min_flow рёбер не поддерживается
+type SolveMultiCommodityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Graph         *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Commodities   []*Commodity           `protobuf:"bytes,2,rep,name=commodities,proto3" json:"commodities,omitempty"`
+	Options       *MultiCommodityOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveMultiCommodityRequest) Reset() {
+	*x = SolveMultiCommodityRequest{}
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveMultiCommodityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveMultiCommodityRequest) ProtoMessage() {}
+
+func (x *SolveMultiCommodityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveMultiCommodityRequest.ProtoReflect.Descriptor instead.
+func (*SolveMultiCommodityRequest) Descriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SolveMultiCommodityRequest) GetGraph() *v1.Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *SolveMultiCommodityRequest) GetCommodities() []*Commodity {
+	if x != nil {
+		return x.Commodities
+	}
+	return nil
+}
+
+func (x *SolveMultiCommodityRequest) GetOptions() *MultiCommodityOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SolveMultiCommodityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Result        *MultiCommodityResult  `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Metrics       *SolveMetrics          `protobuf:"bytes,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveMultiCommodityResponse) Reset() {
+	*x = SolveMultiCommodityResponse{}
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveMultiCommodityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveMultiCommodityResponse) ProtoMessage() {}
+
+func (x *SolveMultiCommodityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveMultiCommodityResponse.ProtoReflect.Descriptor instead.
+func (*SolveMultiCommodityResponse) Descriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SolveMultiCommodityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SolveMultiCommodityResponse) GetResult() *MultiCommodityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SolveMultiCommodityResponse) GetMetrics() *SolveMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *SolveMultiCommodityResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type MultiCommodityResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Commodities []*CommodityFlow       `protobuf:"bytes,1,rep,name=commodities,proto3" json:"commodities,omitempty"` // В порядке запроса
+	// Доля спроса, которую можно провезти одновременно для всех товаров
+	// (>= 1 — весь спрос удовлетворён)
+	ConcurrentRatio float64        `protobuf:"fixed64,2,opt,name=concurrent_ratio,json=concurrentRatio,proto3" json:"concurrent_ratio,omitempty"`
+	TotalCost       float64        `protobuf:"fixed64,3,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	Edges           []*v1.FlowEdge `protobuf:"bytes,4,rep,name=edges,proto3" json:"edges,omitempty"` // Суммарный поток всех товаров
+	// FEASIBLE — весь спрос удовлетворён, INFEASIBLE — каждый товар получил долю concurrent_ratio
+	Status        v1.FlowStatus `protobuf:"varint,5,opt,name=status,proto3,enum=logistics.common.v1.FlowStatus" json:"status,omitempty"`
+	Iterations    int32         `protobuf:"varint,6,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiCommodityResult) Reset() {
+	*x = MultiCommodityResult{}
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiCommodityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCommodityResult) ProtoMessage() {}
+
+func (x *MultiCommodityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCommodityResult.ProtoReflect.Descriptor instead.
+func (*MultiCommodityResult) Descriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MultiCommodityResult) GetCommodities() []*CommodityFlow {
+	if x != nil {
+		return x.Commodities
+	}
+	return nil
+}
+
+func (x *MultiCommodityResult) GetConcurrentRatio() float64 {
+	if x != nil {
+		return x.ConcurrentRatio
+	}
+	return 0
+}
+
+func (x *MultiCommodityResult) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *MultiCommodityResult) GetEdges() []*v1.FlowEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *MultiCommodityResult) GetStatus() v1.FlowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v1.FlowStatus(0)
+}
+
+func (x *MultiCommodityResult) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+type CommodityFlow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommodityId   int64                  `protobuf:"varint,1,opt,name=commodity_id,json=commodityId,proto3" json:"commodity_id,omitempty"`
+	Demand        float64                `protobuf:"fixed64,2,opt,name=demand,proto3" json:"demand,omitempty"`
+	Flow          float64                `protobuf:"fixed64,3,opt,name=flow,proto3" json:"flow,omitempty"` // Провезённый объём
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"` // Стоимость с учётом cost_overrides
+	Edges         []*v1.FlowEdge         `protobuf:"bytes,5,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommodityFlow) Reset() {
+	*x = CommodityFlow{}
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommodityFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommodityFlow) ProtoMessage() {}
+
+func (x *CommodityFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommodityFlow.ProtoReflect.Descriptor instead.
+func (*CommodityFlow) Descriptor() ([]byte, []int) {
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CommodityFlow) GetCommodityId() int64 {
+	if x != nil {
+		return x.CommodityId
+	}
+	return 0
+}
+
+func (x *CommodityFlow) GetDemand() float64 {
+	if x != nil {
+		return x.Demand
+	}
+	return 0
+}
+
+func (x *CommodityFlow) GetFlow() float64 {
+	if x != nil {
+		return x.Flow
+	}
+	return 0
+}
+
+func (x *CommodityFlow) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *CommodityFlow) GetEdges() []*v1.FlowEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
 type SolveProgress struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Iteration       int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
@@ -365,13 +1036,16 @@ type SolveProgress struct {
 	// Новые поля для мониторинга
 	ComputationTimeMs float64 `protobuf:"fixed64,6,opt,name=computation_time_ms,json=computationTimeMs,proto3" json:"computation_time_ms,omitempty"` // Время с начала вычисления
 	MemoryUsedBytes   int64   `protobuf:"varint,7,opt,name=memory_used_bytes,json=memoryUsedBytes,proto3" json:"memory_used_bytes,omitempty"`        // Текущее потребление памяти (аллокации)
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Балансы узлов при множественных источниках/стоках (только в финальном сообщении)
+	SourceBalances []*v1.NodeBalance `protobuf:"bytes,8,rep,name=source_balances,json=sourceBalances,proto3" json:"source_balances,omitempty"` // Отгружено каждым складом
+	SinkBalances   []*v1.NodeBalance `protobuf:"bytes,9,rep,name=sink_balances,json=sinkBalances,proto3" json:"sink_balances,omitempty"`       // Получено каждой точкой доставки
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SolveProgress) Reset() {
 	*x = SolveProgress{}
-	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[5]
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -383,7 +1057,7 @@ func (x *SolveProgress) String() string {
 func (*SolveProgress) ProtoMessage() {}
 
 func (x *SolveProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[5]
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +1070,7 @@ func (x *SolveProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SolveProgress.ProtoReflect.Descriptor instead.
 func (*SolveProgress) Descriptor() ([]byte, []int) {
-	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{5}
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SolveProgress) GetIteration() int32 {
@@ -448,6 +1122,20 @@ func (x *SolveProgress) GetMemoryUsedBytes() int64 {
 	return 0
 }
 
+func (x *SolveProgress) GetSourceBalances() []*v1.NodeBalance {
+	if x != nil {
+		return x.SourceBalances
+	}
+	return nil
+}
+
+func (x *SolveProgress) GetSinkBalances() []*v1.NodeBalance {
+	if x != nil {
+		return x.SinkBalances
+	}
+	return nil
+}
+
 type GetAlgorithmsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Algorithms    []*AlgorithmInfo       `protobuf:"bytes,1,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
@@ -457,7 +1145,7 @@ type GetAlgorithmsResponse struct {
 
 func (x *GetAlgorithmsResponse) Reset() {
 	*x = GetAlgorithmsResponse{}
-	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[6]
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +1157,7 @@ func (x *GetAlgorithmsResponse) String() string {
 func (*GetAlgorithmsResponse) ProtoMessage() {}
 
 func (x *GetAlgorithmsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[6]
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +1170,7 @@ func (x *GetAlgorithmsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAlgorithmsResponse.ProtoReflect.Descriptor instead.
 func (*GetAlgorithmsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{6}
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAlgorithmsResponse) GetAlgorithms() []*AlgorithmInfo {
@@ -508,7 +1196,7 @@ type AlgorithmInfo struct {
 
 func (x *AlgorithmInfo) Reset() {
 	*x = AlgorithmInfo{}
-	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[7]
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +1208,7 @@ func (x *AlgorithmInfo) String() string {
 func (*AlgorithmInfo) ProtoMessage() {}
 
 func (x *AlgorithmInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[7]
+	mi := &file_logistics_optimization_v1_solver_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +1221,7 @@ func (x *AlgorithmInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlgorithmInfo.ProtoReflect.Descriptor instead.
 func (*AlgorithmInfo) Descriptor() ([]byte, []int) {
-	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{7}
+	return file_logistics_optimization_v1_solver_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AlgorithmInfo) GetAlgorithm() v1.Algorithm {
@@ -604,25 +1292,81 @@ const file_logistics_optimization_v1_solver_proto_rawDesc = "" +
 	"\x18SolveRequestForBigGraphs\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12<\n" +
 	"\talgorithm\x18\x02 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\x12A\n" +
-	"\aoptions\x18\x03 \x01(\v2'.logistics.optimization.v1.SolveOptionsR\aoptions\"\x9b\x01\n" +
+	"\aoptions\x18\x03 \x01(\v2'.logistics.optimization.v1.SolveOptionsR\aoptions\"\xe9\x02\n" +
 	"\fSolveOptions\x12'\n" +
 	"\x0ftimeout_seconds\x18\x01 \x01(\x01R\x0etimeoutSeconds\x12!\n" +
 	"\freturn_paths\x18\x02 \x01(\bR\vreturnPaths\x12%\n" +
 	"\x0emax_iterations\x18\x03 \x01(\x05R\rmaxIterations\x12\x18\n" +
-	"\aepsilon\x18\x04 \x01(\x01R\aepsilon\"\x89\x02\n" +
+	"\aepsilon\x18\x04 \x01(\x01R\aepsilon\x128\n" +
+	"\x04mode\x18\x05 \x01(\x0e2$.logistics.optimization.v1.SolveModeR\x04mode\x12$\n" +
+	"\x0ereturn_min_cut\x18\x06 \x01(\bR\freturnMinCut\x129\n" +
+	"\n" +
+	"warm_start\x18\a \x01(\v2\x1a.logistics.common.v1.GraphR\twarmStart\x121\n" +
+	"\x14return_decomposition\x18\b \x01(\bR\x13returnDecomposition\"\x89\x02\n" +
 	"\rSolveResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x127\n" +
 	"\x06result\x18\x02 \x01(\v2\x1f.logistics.common.v1.FlowResultR\x06result\x12=\n" +
 	"\fsolved_graph\x18\x03 \x01(\v2\x1a.logistics.common.v1.GraphR\vsolvedGraph\x12A\n" +
 	"\ametrics\x18\x04 \x01(\v2'.logistics.optimization.v1.SolveMetricsR\ametrics\x12#\n" +
-	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\xc0\x01\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\xe3\x01\n" +
 	"\fSolveMetrics\x12.\n" +
 	"\x13computation_time_ms\x18\x01 \x01(\x01R\x11computationTimeMs\x12\x1e\n" +
 	"\n" +
 	"iterations\x18\x02 \x01(\x05R\n" +
 	"iterations\x124\n" +
 	"\x16augmenting_paths_found\x18\x03 \x01(\x05R\x14augmentingPathsFound\x12*\n" +
-	"\x11memory_used_bytes\x18\x04 \x01(\x03R\x0fmemoryUsedBytes\"\xa7\x02\n" +
+	"\x11memory_used_bytes\x18\x04 \x01(\x03R\x0fmemoryUsedBytes\x12!\n" +
+	"\fwarm_started\x18\x05 \x01(\bR\vwarmStarted\"\xc5\x01\n" +
+	"\x10GetMinCutRequest\x120\n" +
+	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12<\n" +
+	"\talgorithm\x18\x02 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\x12A\n" +
+	"\aoptions\x18\x03 \x01(\v2'.logistics.optimization.v1.SolveOptionsR\aoptions\"\xe6\x01\n" +
+	"\x11GetMinCutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x124\n" +
+	"\amin_cut\x18\x02 \x01(\v2\x1b.logistics.common.v1.MinCutR\x06minCut\x12\x19\n" +
+	"\bmax_flow\x18\x03 \x01(\x01R\amaxFlow\x12A\n" +
+	"\ametrics\x18\x04 \x01(\v2'.logistics.optimization.v1.SolveMetricsR\ametrics\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\x9f\x02\n" +
+	"\tCommodity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\x03R\bsourceId\x12\x17\n" +
+	"\asink_id\x18\x04 \x01(\x03R\x06sinkId\x12\x16\n" +
+	"\x06demand\x18\x05 \x01(\x01R\x06demand\x12^\n" +
+	"\x0ecost_overrides\x18\x06 \x03(\v27.logistics.optimization.v1.Commodity.CostOverridesEntryR\rcostOverrides\x1a@\n" +
+	"\x12CostOverridesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x85\x01\n" +
+	"\x15MultiCommodityOptions\x12'\n" +
+	"\x0ftimeout_seconds\x18\x01 \x01(\x01R\x0etimeoutSeconds\x12$\n" +
+	"\rapproximation\x18\x02 \x01(\x01R\rapproximation\x12\x1d\n" +
+	"\n" +
+	"max_phases\x18\x03 \x01(\x05R\tmaxPhases\"\xe2\x01\n" +
+	"\x1aSolveMultiCommodityRequest\x120\n" +
+	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12F\n" +
+	"\vcommodities\x18\x02 \x03(\v2$.logistics.optimization.v1.CommodityR\vcommodities\x12J\n" +
+	"\aoptions\x18\x03 \x01(\v20.logistics.optimization.v1.MultiCommodityOptionsR\aoptions\"\xe8\x01\n" +
+	"\x1bSolveMultiCommodityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12G\n" +
+	"\x06result\x18\x02 \x01(\v2/.logistics.optimization.v1.MultiCommodityResultR\x06result\x12A\n" +
+	"\ametrics\x18\x03 \x01(\v2'.logistics.optimization.v1.SolveMetricsR\ametrics\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xba\x02\n" +
+	"\x14MultiCommodityResult\x12J\n" +
+	"\vcommodities\x18\x01 \x03(\v2(.logistics.optimization.v1.CommodityFlowR\vcommodities\x12)\n" +
+	"\x10concurrent_ratio\x18\x02 \x01(\x01R\x0fconcurrentRatio\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x03 \x01(\x01R\ttotalCost\x123\n" +
+	"\x05edges\x18\x04 \x03(\v2\x1d.logistics.common.v1.FlowEdgeR\x05edges\x127\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1f.logistics.common.v1.FlowStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x06 \x01(\x05R\n" +
+	"iterations\"\xa7\x01\n" +
+	"\rCommodityFlow\x12!\n" +
+	"\fcommodity_id\x18\x01 \x01(\x03R\vcommodityId\x12\x16\n" +
+	"\x06demand\x18\x02 \x01(\x01R\x06demand\x12\x12\n" +
+	"\x04flow\x18\x03 \x01(\x01R\x04flow\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x123\n" +
+	"\x05edges\x18\x05 \x03(\v2\x1d.logistics.common.v1.FlowEdgeR\x05edges\"\xb9\x03\n" +
 	"\rSolveProgress\x12\x1c\n" +
 	"\titeration\x18\x01 \x01(\x05R\titeration\x12!\n" +
 	"\fcurrent_flow\x18\x02 \x01(\x01R\vcurrentFlow\x12)\n" +
@@ -630,7 +1374,9 @@ const file_logistics_optimization_v1_solver_proto_rawDesc = "" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x126\n" +
 	"\tlast_path\x18\x05 \x01(\v2\x19.logistics.common.v1.PathR\blastPath\x12.\n" +
 	"\x13computation_time_ms\x18\x06 \x01(\x01R\x11computationTimeMs\x12*\n" +
-	"\x11memory_used_bytes\x18\a \x01(\x03R\x0fmemoryUsedBytes\"a\n" +
+	"\x11memory_used_bytes\x18\a \x01(\x03R\x0fmemoryUsedBytes\x12I\n" +
+	"\x0fsource_balances\x18\b \x03(\v2 .logistics.common.v1.NodeBalanceR\x0esourceBalances\x12E\n" +
+	"\rsink_balances\x18\t \x03(\v2 .logistics.common.v1.NodeBalanceR\fsinkBalances\"a\n" +
 	"\x15GetAlgorithmsResponse\x12H\n" +
 	"\n" +
 	"algorithms\x18\x01 \x03(\v2(.logistics.optimization.v1.AlgorithmInfoR\n" +
@@ -643,11 +1389,17 @@ const file_logistics_optimization_v1_solver_proto_rawDesc = "" +
 	"\x10space_complexity\x18\x05 \x01(\tR\x0fspaceComplexity\x12*\n" +
 	"\x11supports_min_cost\x18\x06 \x01(\bR\x0fsupportsMinCost\x126\n" +
 	"\x17supports_negative_costs\x18\a \x01(\bR\x15supportsNegativeCosts\x12\x19\n" +
-	"\bbest_for\x18\b \x03(\tR\abestFor2\xb6\x02\n" +
+	"\bbest_for\x18\b \x03(\tR\abestFor*_\n" +
+	"\tSolveMode\x12\x1a\n" +
+	"\x16SOLVE_MODE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SOLVE_MODE_MAX_FLOW\x10\x01\x12\x1d\n" +
+	"\x19SOLVE_MODE_TRANSPORTATION\x10\x022\xa5\x04\n" +
 	"\rSolverService\x12Z\n" +
 	"\x05Solve\x12'.logistics.optimization.v1.SolveRequest\x1a(.logistics.optimization.v1.SolveResponse\x12n\n" +
 	"\vSolveStream\x123.logistics.optimization.v1.SolveRequestForBigGraphs\x1a(.logistics.optimization.v1.SolveProgress0\x01\x12Y\n" +
-	"\rGetAlgorithms\x12\x16.google.protobuf.Empty\x1a0.logistics.optimization.v1.GetAlgorithmsResponseB\xed\x01\n" +
+	"\rGetAlgorithms\x12\x16.google.protobuf.Empty\x1a0.logistics.optimization.v1.GetAlgorithmsResponse\x12f\n" +
+	"\tGetMinCut\x12+.logistics.optimization.v1.GetMinCutRequest\x1a,.logistics.optimization.v1.GetMinCutResponse\x12\x84\x01\n" +
+	"\x13SolveMultiCommodity\x125.logistics.optimization.v1.SolveMultiCommodityRequest\x1a6.logistics.optimization.v1.SolveMultiCommodityResponseB\xed\x01\n" +
 	"\x1dcom.logistics.optimization.v1B\vSolverProtoP\x01Z9logistics/gen/go/logistics/optimization/v1;optimizationv1\xa2\x02\x03LOX\xaa\x02\x19Logistics.Optimization.V1\xca\x02\x19Logistics\\Optimization\\V1\xe2\x02%Logistics\\Optimization\\V1\\GPBMetadata\xea\x02\x1bLogistics::Optimization::V1b\x06proto3"
 
 var (
@@ -662,46 +1414,84 @@ func file_logistics_optimization_v1_solver_proto_rawDescGZIP() []byte {
 	return file_logistics_optimization_v1_solver_proto_rawDescData
 }
 
-var file_logistics_optimization_v1_solver_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_logistics_optimization_v1_solver_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_logistics_optimization_v1_solver_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_logistics_optimization_v1_solver_proto_goTypes = []any{
-	(*SolveRequest)(nil),             // 0: logistics.optimization.v1.SolveRequest
-	(*SolveRequestForBigGraphs)(nil), // 1: logistics.optimization.v1.SolveRequestForBigGraphs
-	(*SolveOptions)(nil),             // 2: logistics.optimization.v1.SolveOptions
-	(*SolveResponse)(nil),            // 3: logistics.optimization.v1.SolveResponse
-	(*SolveMetrics)(nil),             // 4: logistics.optimization.v1.SolveMetrics
-	(*SolveProgress)(nil),            // 5: logistics.optimization.v1.SolveProgress
-	(*GetAlgorithmsResponse)(nil),    // 6: logistics.optimization.v1.GetAlgorithmsResponse
-	(*AlgorithmInfo)(nil),            // 7: logistics.optimization.v1.AlgorithmInfo
-	(*v1.Graph)(nil),                 // 8: logistics.common.v1.Graph
-	(v1.Algorithm)(0),                // 9: logistics.common.v1.Algorithm
-	(*v1.FlowResult)(nil),            // 10: logistics.common.v1.FlowResult
-	(*v1.Path)(nil),                  // 11: logistics.common.v1.Path
-	(*emptypb.Empty)(nil),            // 12: google.protobuf.Empty
+	(SolveMode)(0),                      // 0: logistics.optimization.v1.SolveMode
+	(*SolveRequest)(nil),                // 1: logistics.optimization.v1.SolveRequest
+	(*SolveRequestForBigGraphs)(nil),    // 2: logistics.optimization.v1.SolveRequestForBigGraphs
+	(*SolveOptions)(nil),                // 3: logistics.optimization.v1.SolveOptions
+	(*SolveResponse)(nil),               // 4: logistics.optimization.v1.SolveResponse
+	(*SolveMetrics)(nil),                // 5: logistics.optimization.v1.SolveMetrics
+	(*GetMinCutRequest)(nil),            // 6: logistics.optimization.v1.GetMinCutRequest
+	(*GetMinCutResponse)(nil),           // 7: logistics.optimization.v1.GetMinCutResponse
+	(*Commodity)(nil),                   // 8: logistics.optimization.v1.Commodity
+	(*MultiCommodityOptions)(nil),       // 9: logistics.optimization.v1.MultiCommodityOptions
+	(*SolveMultiCommodityRequest)(nil),  // 10: logistics.optimization.v1.SolveMultiCommodityRequest
+	(*SolveMultiCommodityResponse)(nil), // 11: logistics.optimization.v1.SolveMultiCommodityResponse
+	(*MultiCommodityResult)(nil),        // 12: logistics.optimization.v1.MultiCommodityResult
+	(*CommodityFlow)(nil),               // 13: logistics.optimization.v1.CommodityFlow
+	(*SolveProgress)(nil),               // 14: logistics.optimization.v1.SolveProgress
+	(*GetAlgorithmsResponse)(nil),       // 15: logistics.optimization.v1.GetAlgorithmsResponse
+	(*AlgorithmInfo)(nil),               // 16: logistics.optimization.v1.AlgorithmInfo
+	nil,                                 // 17: logistics.optimization.v1.Commodity.CostOverridesEntry
+	(*v1.Graph)(nil),                    // 18: logistics.common.v1.Graph
+	(v1.Algorithm)(0),                   // 19: logistics.common.v1.Algorithm
+	(*v1.FlowResult)(nil),               // 20: logistics.common.v1.FlowResult
+	(*v1.MinCut)(nil),                   // 21: logistics.common.v1.MinCut
+	(*v1.FlowEdge)(nil),                 // 22: logistics.common.v1.FlowEdge
+	(v1.FlowStatus)(0),                  // 23: logistics.common.v1.FlowStatus
+	(*v1.Path)(nil),                     // 24: logistics.common.v1.Path
+	(*v1.NodeBalance)(nil),              // 25: logistics.common.v1.NodeBalance
+	(*emptypb.Empty)(nil),               // 26: google.protobuf.Empty
 }
 var file_logistics_optimization_v1_solver_proto_depIdxs = []int32{
-	8,  // 0: logistics.optimization.v1.SolveRequest.graph:type_name -> logistics.common.v1.Graph
-	9,  // 1: logistics.optimization.v1.SolveRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	2,  // 2: logistics.optimization.v1.SolveRequest.options:type_name -> logistics.optimization.v1.SolveOptions
-	8,  // 3: logistics.optimization.v1.SolveRequestForBigGraphs.graph:type_name -> logistics.common.v1.Graph
-	9,  // 4: logistics.optimization.v1.SolveRequestForBigGraphs.algorithm:type_name -> logistics.common.v1.Algorithm
-	2,  // 5: logistics.optimization.v1.SolveRequestForBigGraphs.options:type_name -> logistics.optimization.v1.SolveOptions
-	10, // 6: logistics.optimization.v1.SolveResponse.result:type_name -> logistics.common.v1.FlowResult
-	8,  // 7: logistics.optimization.v1.SolveResponse.solved_graph:type_name -> logistics.common.v1.Graph
-	4,  // 8: logistics.optimization.v1.SolveResponse.metrics:type_name -> logistics.optimization.v1.SolveMetrics
-	11, // 9: logistics.optimization.v1.SolveProgress.last_path:type_name -> logistics.common.v1.Path
-	7,  // 10: logistics.optimization.v1.GetAlgorithmsResponse.algorithms:type_name -> logistics.optimization.v1.AlgorithmInfo
-	9,  // 11: logistics.optimization.v1.AlgorithmInfo.algorithm:type_name -> logistics.common.v1.Algorithm
-	0,  // 12: logistics.optimization.v1.SolverService.Solve:input_type -> logistics.optimization.v1.SolveRequest
-	1,  // 13: logistics.optimization.v1.SolverService.SolveStream:input_type -> logistics.optimization.v1.SolveRequestForBigGraphs
-	12, // 14: logistics.optimization.v1.SolverService.GetAlgorithms:input_type -> google.protobuf.Empty
-	3,  // 15: logistics.optimization.v1.SolverService.Solve:output_type -> logistics.optimization.v1.SolveResponse
-	5,  // 16: logistics.optimization.v1.SolverService.SolveStream:output_type -> logistics.optimization.v1.SolveProgress
-	6,  // 17: logistics.optimization.v1.SolverService.GetAlgorithms:output_type -> logistics.optimization.v1.GetAlgorithmsResponse
-	15, // [15:18] is the sub-list for method output_type
-	12, // [12:15] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	18, // 0: logistics.optimization.v1.SolveRequest.graph:type_name -> logistics.common.v1.Graph
+	19, // 1: logistics.optimization.v1.SolveRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	3,  // 2: logistics.optimization.v1.SolveRequest.options:type_name -> logistics.optimization.v1.SolveOptions
+	18, // 3: logistics.optimization.v1.SolveRequestForBigGraphs.graph:type_name -> logistics.common.v1.Graph
+	19, // 4: logistics.optimization.v1.SolveRequestForBigGraphs.algorithm:type_name -> logistics.common.v1.Algorithm
+	3,  // 5: logistics.optimization.v1.SolveRequestForBigGraphs.options:type_name -> logistics.optimization.v1.SolveOptions
+	0,  // 6: logistics.optimization.v1.SolveOptions.mode:type_name -> logistics.optimization.v1.SolveMode
+	18, // 7: logistics.optimization.v1.SolveOptions.warm_start:type_name -> logistics.common.v1.Graph
+	20, // 8: logistics.optimization.v1.SolveResponse.result:type_name -> logistics.common.v1.FlowResult
+	18, // 9: logistics.optimization.v1.SolveResponse.solved_graph:type_name -> logistics.common.v1.Graph
+	5,  // 10: logistics.optimization.v1.SolveResponse.metrics:type_name -> logistics.optimization.v1.SolveMetrics
+	18, // 11: logistics.optimization.v1.GetMinCutRequest.graph:type_name -> logistics.common.v1.Graph
+	19, // 12: logistics.optimization.v1.GetMinCutRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	3,  // 13: logistics.optimization.v1.GetMinCutRequest.options:type_name -> logistics.optimization.v1.SolveOptions
+	21, // 14: logistics.optimization.v1.GetMinCutResponse.min_cut:type_name -> logistics.common.v1.MinCut
+	5,  // 15: logistics.optimization.v1.GetMinCutResponse.metrics:type_name -> logistics.optimization.v1.SolveMetrics
+	17, // 16: logistics.optimization.v1.Commodity.cost_overrides:type_name -> logistics.optimization.v1.Commodity.CostOverridesEntry
+	18, // 17: logistics.optimization.v1.SolveMultiCommodityRequest.graph:type_name -> logistics.common.v1.Graph
+	8,  // 18: logistics.optimization.v1.SolveMultiCommodityRequest.commodities:type_name -> logistics.optimization.v1.Commodity
+	9,  // 19: logistics.optimization.v1.SolveMultiCommodityRequest.options:type_name -> logistics.optimization.v1.MultiCommodityOptions
+	12, // 20: logistics.optimization.v1.SolveMultiCommodityResponse.result:type_name -> logistics.optimization.v1.MultiCommodityResult
+	5,  // 21: logistics.optimization.v1.SolveMultiCommodityResponse.metrics:type_name -> logistics.optimization.v1.SolveMetrics
+	13, // 22: logistics.optimization.v1.MultiCommodityResult.commodities:type_name -> logistics.optimization.v1.CommodityFlow
+	22, // 23: logistics.optimization.v1.MultiCommodityResult.edges:type_name -> logistics.common.v1.FlowEdge
+	23, // 24: logistics.optimization.v1.MultiCommodityResult.status:type_name -> logistics.common.v1.FlowStatus
+	22, // 25: logistics.optimization.v1.CommodityFlow.edges:type_name -> logistics.common.v1.FlowEdge
+	24, // 26: logistics.optimization.v1.SolveProgress.last_path:type_name -> logistics.common.v1.Path
+	25, // 27: logistics.optimization.v1.SolveProgress.source_balances:type_name -> logistics.common.v1.NodeBalance
+	25, // 28: logistics.optimization.v1.SolveProgress.sink_balances:type_name -> logistics.common.v1.NodeBalance
+	16, // 29: logistics.optimization.v1.GetAlgorithmsResponse.algorithms:type_name -> logistics.optimization.v1.AlgorithmInfo
+	19, // 30: logistics.optimization.v1.AlgorithmInfo.algorithm:type_name -> logistics.common.v1.Algorithm
+	1,  // 31: logistics.optimization.v1.SolverService.Solve:input_type -> logistics.optimization.v1.SolveRequest
+	2,  // 32: logistics.optimization.v1.SolverService.SolveStream:input_type -> logistics.optimization.v1.SolveRequestForBigGraphs
+	26, // 33: logistics.optimization.v1.SolverService.GetAlgorithms:input_type -> google.protobuf.Empty
+	6,  // 34: logistics.optimization.v1.SolverService.GetMinCut:input_type -> logistics.optimization.v1.GetMinCutRequest
+	10, // 35: logistics.optimization.v1.SolverService.SolveMultiCommodity:input_type -> logistics.optimization.v1.SolveMultiCommodityRequest
+	4,  // 36: logistics.optimization.v1.SolverService.Solve:output_type -> logistics.optimization.v1.SolveResponse
+	14, // 37: logistics.optimization.v1.SolverService.SolveStream:output_type -> logistics.optimization.v1.SolveProgress
+	15, // 38: logistics.optimization.v1.SolverService.GetAlgorithms:output_type -> logistics.optimization.v1.GetAlgorithmsResponse
+	7,  // 39: logistics.optimization.v1.SolverService.GetMinCut:output_type -> logistics.optimization.v1.GetMinCutResponse
+	11, // 40: logistics.optimization.v1.SolverService.SolveMultiCommodity:output_type -> logistics.optimization.v1.SolveMultiCommodityResponse
+	36, // [36:41] is the sub-list for method output_type
+	31, // [31:36] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_logistics_optimization_v1_solver_proto_init() }
@@ -714,13 +1504,14 @@ func file_logistics_optimization_v1_solver_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_logistics_optimization_v1_solver_proto_rawDesc), len(file_logistics_optimization_v1_solver_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_logistics_optimization_v1_solver_proto_goTypes,
 		DependencyIndexes: file_logistics_optimization_v1_solver_proto_depIdxs,
+		EnumInfos:         file_logistics_optimization_v1_solver_proto_enumTypes,
 		MessageInfos:      file_logistics_optimization_v1_solver_proto_msgTypes,
 	}.Build()
 	File_logistics_optimization_v1_solver_proto = out.File
@@ -20,9 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SolverService_Solve_FullMethodName         = "/logistics.optimization.v1.SolverService/Solve"
-	SolverService_SolveStream_FullMethodName   = "/logistics.optimization.v1.SolverService/SolveStream"
-	SolverService_GetAlgorithms_FullMethodName = "/logistics.optimization.v1.SolverService/GetAlgorithms"
+	SolverService_Solve_FullMethodName               = "/logistics.optimization.v1.SolverService/Solve"
+	SolverService_SolveStream_FullMethodName         = "/logistics.optimization.v1.SolverService/SolveStream"
+	SolverService_GetAlgorithms_FullMethodName       = "/logistics.optimization.v1.SolverService/GetAlgorithms"
+	SolverService_GetMinCut_FullMethodName           = "/logistics.optimization.v1.SolverService/GetMinCut"
+	SolverService_SolveMultiCommodity_FullMethodName = "/logistics.optimization.v1.SolverService/SolveMultiCommodity"
 )
 
 // SolverServiceClient is the client API for SolverService service.
@@ -35,6 +37,10 @@ type SolverServiceClient interface {
 	SolveStream(ctx context.Context, in *SolveRequestForBigGraphs, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SolveProgress], error)
 	// Получить поддерживаемые алгоритмы
 	GetAlgorithms(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAlgorithmsResponse, error)
+	// Минимальный разрез после максимального потока
+	GetMinCut(ctx context.Context, in *GetMinCutRequest, opts ...grpc.CallOption) (*GetMinCutResponse, error)
+	// Многопродуктовый поток: несколько классов товаров на общих пропускных способностях
+	SolveMultiCommodity(ctx context.Context, in *SolveMultiCommodityRequest, opts ...grpc.CallOption) (*SolveMultiCommodityResponse, error)
 }
 
 type solverServiceClient struct {
@@ -84,6 +90,26 @@ func (c *solverServiceClient) GetAlgorithms(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *solverServiceClient) GetMinCut(ctx context.Context, in *GetMinCutRequest, opts ...grpc.CallOption) (*GetMinCutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMinCutResponse)
+	err := c.cc.Invoke(ctx, SolverService_GetMinCut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverServiceClient) SolveMultiCommodity(ctx context.Context, in *SolveMultiCommodityRequest, opts ...grpc.CallOption) (*SolveMultiCommodityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveMultiCommodityResponse)
+	err := c.cc.Invoke(ctx, SolverService_SolveMultiCommodity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SolverServiceServer is the server API for SolverService service.
 // All implementations must embed UnimplementedSolverServiceServer
 // for forward compatibility.
@@ -94,6 +120,10 @@ type SolverServiceServer interface {
 	SolveStream(*SolveRequestForBigGraphs, grpc.ServerStreamingServer[SolveProgress]) error
 	// Получить поддерживаемые алгоритмы
 	GetAlgorithms(context.Context, *emptypb.Empty) (*GetAlgorithmsResponse, error)
+	// Минимальный разрез после максимального потока
+	GetMinCut(context.Context, *GetMinCutRequest) (*GetMinCutResponse, error)
+	// Многопродуктовый поток: несколько классов товаров на общих пропускных способностях
+	SolveMultiCommodity(context.Context, *SolveMultiCommodityRequest) (*SolveMultiCommodityResponse, error)
 	mustEmbedUnimplementedSolverServiceServer()
 }
 
@@ -113,6 +143,12 @@ func (UnimplementedSolverServiceServer) SolveStream(*SolveRequestForBigGraphs, g
 func (UnimplementedSolverServiceServer) GetAlgorithms(context.Context, *emptypb.Empty) (*GetAlgorithmsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAlgorithms not implemented")
 }
+func (UnimplementedSolverServiceServer) GetMinCut(context.Context, *GetMinCutRequest) (*GetMinCutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMinCut not implemented")
+}
+func (UnimplementedSolverServiceServer) SolveMultiCommodity(context.Context, *SolveMultiCommodityRequest) (*SolveMultiCommodityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SolveMultiCommodity not implemented")
+}
 func (UnimplementedSolverServiceServer) mustEmbedUnimplementedSolverServiceServer() {}
 func (UnimplementedSolverServiceServer) testEmbeddedByValue()                       {}
 
@@ -181,6 +217,42 @@ func _SolverService_GetAlgorithms_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SolverService_GetMinCut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinCutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).GetMinCut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_GetMinCut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).GetMinCut(ctx, req.(*GetMinCutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolverService_SolveMultiCommodity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveMultiCommodityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServiceServer).SolveMultiCommodity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SolverService_SolveMultiCommodity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServiceServer).SolveMultiCommodity(ctx, req.(*SolveMultiCommodityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SolverService_ServiceDesc is the grpc.ServiceDesc for SolverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -196,6 +268,14 @@ var SolverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAlgorithms",
 			Handler:    _SolverService_GetAlgorithms_Handler,
 		},
+		{
+			MethodName: "GetMinCut",
+			Handler:    _SolverService_GetMinCut_Handler,
+		},
+		{
+			MethodName: "SolveMultiCommodity",
+			Handler:    _SolverService_SolveMultiCommodity_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -786,17 +786,18 @@ type GenerateAnalyticsReportRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
 	// Данные аналитики
-	Cost          *v12.CalculateCostResponse   `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	Bottlenecks   *v12.FindBottlenecksResponse `protobuf:"bytes,3,opt,name=bottlenecks,proto3" json:"bottlenecks,omitempty"`
-	Efficiency    *v12.EfficiencyReport        `protobuf:"bytes,4,opt,name=efficiency,proto3" json:"efficiency,omitempty"`
-	FlowStats     *v1.FlowStatistics           `protobuf:"bytes,5,opt,name=flow_stats,json=flowStats,proto3" json:"flow_stats,omitempty"`
-	GraphStats    *v1.GraphStatistics          `protobuf:"bytes,6,opt,name=graph_stats,json=graphStats,proto3" json:"graph_stats,omitempty"`
-	Format        ReportFormat                 `protobuf:"varint,7,opt,name=format,proto3,enum=logistics.report.v1.ReportFormat" json:"format,omitempty"`
-	Options       *ReportOptions               `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
-	CalculationId string                       `protobuf:"bytes,9,opt,name=calculation_id,json=calculationId,proto3" json:"calculation_id,omitempty"`
-	GraphId       string                       `protobuf:"bytes,10,opt,name=graph_id,json=graphId,proto3" json:"graph_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Cost              *v12.CalculateCostResponse                `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	Bottlenecks       *v12.FindBottlenecksResponse              `protobuf:"bytes,3,opt,name=bottlenecks,proto3" json:"bottlenecks,omitempty"`
+	Efficiency        *v12.EfficiencyReport                     `protobuf:"bytes,4,opt,name=efficiency,proto3" json:"efficiency,omitempty"`
+	FlowStats         *v1.FlowStatistics                        `protobuf:"bytes,5,opt,name=flow_stats,json=flowStats,proto3" json:"flow_stats,omitempty"`
+	GraphStats        *v1.GraphStatistics                       `protobuf:"bytes,6,opt,name=graph_stats,json=graphStats,proto3" json:"graph_stats,omitempty"`
+	OriginDestination *v12.BuildOriginDestinationMatrixResponse `protobuf:"bytes,11,opt,name=origin_destination,json=originDestination,proto3" json:"origin_destination,omitempty"`
+	Format            ReportFormat                              `protobuf:"varint,7,opt,name=format,proto3,enum=logistics.report.v1.ReportFormat" json:"format,omitempty"`
+	Options           *ReportOptions                            `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	CalculationId     string                                    `protobuf:"bytes,9,opt,name=calculation_id,json=calculationId,proto3" json:"calculation_id,omitempty"`
+	GraphId           string                                    `protobuf:"bytes,10,opt,name=graph_id,json=graphId,proto3" json:"graph_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GenerateAnalyticsReportRequest) Reset() {
@@ -871,6 +872,13 @@ func (x *GenerateAnalyticsReportRequest) GetGraphStats() *v1.GraphStatistics {
 	return nil
 }
 
+func (x *GenerateAnalyticsReportRequest) GetOriginDestination() *v12.BuildOriginDestinationMatrixResponse {
+	if x != nil {
+		return x.OriginDestination
+	}
+	return nil
+}
+
 func (x *GenerateAnalyticsReportRequest) GetFormat() ReportFormat {
 	if x != nil {
 		return x.Format
@@ -3420,7 +3428,7 @@ const file_logistics_report_v1_report_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12?\n" +
 	"\bmetadata\x18\x02 \x01(\v2#.logistics.report.v1.ReportMetadataR\bmetadata\x12<\n" +
 	"\acontent\x18\x03 \x01(\v2\".logistics.report.v1.ReportContentR\acontent\x12#\n" +
-	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xf8\x04\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xe5\x05\n" +
 	"\x1eGenerateAnalyticsReportRequest\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12A\n" +
 	"\x04cost\x18\x02 \x01(\v2-.logistics.analytics.v1.CalculateCostResponseR\x04cost\x12Q\n" +
@@ -3431,7 +3439,8 @@ const file_logistics_report_v1_report_proto_rawDesc = "" +
 	"\n" +
 	"flow_stats\x18\x05 \x01(\v2#.logistics.common.v1.FlowStatisticsR\tflowStats\x12E\n" +
 	"\vgraph_stats\x18\x06 \x01(\v2$.logistics.common.v1.GraphStatisticsR\n" +
-	"graphStats\x129\n" +
+	"graphStats\x12k\n" +
+	"\x12origin_destination\x18\v \x01(\v2<.logistics.analytics.v1.BuildOriginDestinationMatrixResponseR\x11originDestination\x129\n" +
 	"\x06format\x18\a \x01(\x0e2!.logistics.report.v1.ReportFormatR\x06format\x12<\n" +
 	"\aoptions\x18\b \x01(\v2\".logistics.report.v1.ReportOptionsR\aoptions\x12%\n" +
 	"\x0ecalculation_id\x18\t \x01(\tR\rcalculationId\x12\x19\n" +
@@ -3707,73 +3716,74 @@ func file_logistics_report_v1_report_proto_rawDescGZIP() []byte {
 var file_logistics_report_v1_report_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_logistics_report_v1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_logistics_report_v1_report_proto_goTypes = []any{
-	(ReportFormat)(0),                        // 0: logistics.report.v1.ReportFormat
-	(ReportType)(0),                          // 1: logistics.report.v1.ReportType
-	(*ReportMetadata)(nil),                   // 2: logistics.report.v1.ReportMetadata
-	(*ReportOptions)(nil),                    // 3: logistics.report.v1.ReportOptions
-	(*ReportContent)(nil),                    // 4: logistics.report.v1.ReportContent
-	(*GenerateFlowReportRequest)(nil),        // 5: logistics.report.v1.GenerateFlowReportRequest
-	(*GenerateFlowReportResponse)(nil),       // 6: logistics.report.v1.GenerateFlowReportResponse
-	(*GenerateAnalyticsReportRequest)(nil),   // 7: logistics.report.v1.GenerateAnalyticsReportRequest
-	(*GenerateAnalyticsReportResponse)(nil),  // 8: logistics.report.v1.GenerateAnalyticsReportResponse
-	(*GenerateSimulationReportRequest)(nil),  // 9: logistics.report.v1.GenerateSimulationReportRequest
-	(*GenerateSimulationReportResponse)(nil), // 10: logistics.report.v1.GenerateSimulationReportResponse
-	(*GenerateSummaryReportRequest)(nil),     // 11: logistics.report.v1.GenerateSummaryReportRequest
-	(*SimulationSummaryData)(nil),            // 12: logistics.report.v1.SimulationSummaryData
-	(*GenerateSummaryReportResponse)(nil),    // 13: logistics.report.v1.GenerateSummaryReportResponse
-	(*GenerateComparisonReportRequest)(nil),  // 14: logistics.report.v1.GenerateComparisonReportRequest
-	(*ComparisonItem)(nil),                   // 15: logistics.report.v1.ComparisonItem
-	(*GenerateComparisonReportResponse)(nil), // 16: logistics.report.v1.GenerateComparisonReportResponse
-	(*GenerateHistoryReportRequest)(nil),     // 17: logistics.report.v1.GenerateHistoryReportRequest
-	(*HistoryEntry)(nil),                     // 18: logistics.report.v1.HistoryEntry
-	(*HistoryStatistics)(nil),                // 19: logistics.report.v1.HistoryStatistics
-	(*GenerateHistoryReportResponse)(nil),    // 20: logistics.report.v1.GenerateHistoryReportResponse
-	(*GenerateReportStreamRequest)(nil),      // 21: logistics.report.v1.GenerateReportStreamRequest
-	(*ReportChunk)(nil),                      // 22: logistics.report.v1.ReportChunk
-	(*GetReportRequest)(nil),                 // 23: logistics.report.v1.GetReportRequest
-	(*GetReportResponse)(nil),                // 24: logistics.report.v1.GetReportResponse
-	(*GetReportInfoRequest)(nil),             // 25: logistics.report.v1.GetReportInfoRequest
-	(*GetReportInfoResponse)(nil),            // 26: logistics.report.v1.GetReportInfoResponse
-	(*ListReportsRequest)(nil),               // 27: logistics.report.v1.ListReportsRequest
-	(*ListReportsResponse)(nil),              // 28: logistics.report.v1.ListReportsResponse
-	(*DeleteReportRequest)(nil),              // 29: logistics.report.v1.DeleteReportRequest
-	(*DeleteReportResponse)(nil),             // 30: logistics.report.v1.DeleteReportResponse
-	(*UpdateReportTagsRequest)(nil),          // 31: logistics.report.v1.UpdateReportTagsRequest
-	(*UpdateReportTagsResponse)(nil),         // 32: logistics.report.v1.UpdateReportTagsResponse
-	(*GetRepositoryStatsRequest)(nil),        // 33: logistics.report.v1.GetRepositoryStatsRequest
-	(*GetRepositoryStatsResponse)(nil),       // 34: logistics.report.v1.GetRepositoryStatsResponse
-	(*GetSupportedFormatsRequest)(nil),       // 35: logistics.report.v1.GetSupportedFormatsRequest
-	(*GetSupportedFormatsResponse)(nil),      // 36: logistics.report.v1.GetSupportedFormatsResponse
-	(*FormatInfo)(nil),                       // 37: logistics.report.v1.FormatInfo
-	(*HealthRequest)(nil),                    // 38: logistics.report.v1.HealthRequest
-	(*HealthResponse)(nil),                   // 39: logistics.report.v1.HealthResponse
-	(*StorageHealth)(nil),                    // 40: logistics.report.v1.StorageHealth
-	nil,                                      // 41: logistics.report.v1.ReportMetadata.CustomFieldsEntry
-	nil,                                      // 42: logistics.report.v1.ReportOptions.CustomFieldsEntry
-	nil,                                      // 43: logistics.report.v1.SimulationSummaryData.KeyMetricsEntry
-	nil,                                      // 44: logistics.report.v1.ComparisonItem.MetricsEntry
-	nil,                                      // 45: logistics.report.v1.HistoryStatistics.ByAlgorithmEntry
-	nil,                                      // 46: logistics.report.v1.GetRepositoryStatsResponse.ReportsByTypeEntry
-	nil,                                      // 47: logistics.report.v1.GetRepositoryStatsResponse.ReportsByFormatEntry
-	nil,                                      // 48: logistics.report.v1.GetRepositoryStatsResponse.SizeByTypeEntry
-	(*timestamppb.Timestamp)(nil),            // 49: google.protobuf.Timestamp
-	(*v1.Graph)(nil),                         // 50: logistics.common.v1.Graph
-	(*v1.FlowResult)(nil),                    // 51: logistics.common.v1.FlowResult
-	(*v11.SolveMetrics)(nil),                 // 52: logistics.optimization.v1.SolveMetrics
-	(*v12.CalculateCostResponse)(nil),        // 53: logistics.analytics.v1.CalculateCostResponse
-	(*v12.FindBottlenecksResponse)(nil),      // 54: logistics.analytics.v1.FindBottlenecksResponse
-	(*v12.EfficiencyReport)(nil),             // 55: logistics.analytics.v1.EfficiencyReport
-	(*v1.FlowStatistics)(nil),                // 56: logistics.common.v1.FlowStatistics
-	(*v1.GraphStatistics)(nil),               // 57: logistics.common.v1.GraphStatistics
-	(*v13.RunWhatIfResponse)(nil),            // 58: logistics.simulation.v1.RunWhatIfResponse
-	(*v13.CompareScenariosResponse)(nil),     // 59: logistics.simulation.v1.CompareScenariosResponse
-	(*v13.RunMonteCarloResponse)(nil),        // 60: logistics.simulation.v1.RunMonteCarloResponse
-	(*v13.AnalyzeSensitivityResponse)(nil),   // 61: logistics.simulation.v1.AnalyzeSensitivityResponse
-	(*v13.AnalyzeResilienceResponse)(nil),    // 62: logistics.simulation.v1.AnalyzeResilienceResponse
-	(*v13.RunTimeSimulationResponse)(nil),    // 63: logistics.simulation.v1.RunTimeSimulationResponse
-	(*v12.AnalyzeFlowResponse)(nil),          // 64: logistics.analytics.v1.AnalyzeFlowResponse
-	(*v1.TimeRange)(nil),                     // 65: logistics.common.v1.TimeRange
-	(v1.Algorithm)(0),                        // 66: logistics.common.v1.Algorithm
+	(ReportFormat)(0),                                // 0: logistics.report.v1.ReportFormat
+	(ReportType)(0),                                  // 1: logistics.report.v1.ReportType
+	(*ReportMetadata)(nil),                           // 2: logistics.report.v1.ReportMetadata
+	(*ReportOptions)(nil),                            // 3: logistics.report.v1.ReportOptions
+	(*ReportContent)(nil),                            // 4: logistics.report.v1.ReportContent
+	(*GenerateFlowReportRequest)(nil),                // 5: logistics.report.v1.GenerateFlowReportRequest
+	(*GenerateFlowReportResponse)(nil),               // 6: logistics.report.v1.GenerateFlowReportResponse
+	(*GenerateAnalyticsReportRequest)(nil),           // 7: logistics.report.v1.GenerateAnalyticsReportRequest
+	(*GenerateAnalyticsReportResponse)(nil),          // 8: logistics.report.v1.GenerateAnalyticsReportResponse
+	(*GenerateSimulationReportRequest)(nil),          // 9: logistics.report.v1.GenerateSimulationReportRequest
+	(*GenerateSimulationReportResponse)(nil),         // 10: logistics.report.v1.GenerateSimulationReportResponse
+	(*GenerateSummaryReportRequest)(nil),             // 11: logistics.report.v1.GenerateSummaryReportRequest
+	(*SimulationSummaryData)(nil),                    // 12: logistics.report.v1.SimulationSummaryData
+	(*GenerateSummaryReportResponse)(nil),            // 13: logistics.report.v1.GenerateSummaryReportResponse
+	(*GenerateComparisonReportRequest)(nil),          // 14: logistics.report.v1.GenerateComparisonReportRequest
+	(*ComparisonItem)(nil),                           // 15: logistics.report.v1.ComparisonItem
+	(*GenerateComparisonReportResponse)(nil),         // 16: logistics.report.v1.GenerateComparisonReportResponse
+	(*GenerateHistoryReportRequest)(nil),             // 17: logistics.report.v1.GenerateHistoryReportRequest
+	(*HistoryEntry)(nil),                             // 18: logistics.report.v1.HistoryEntry
+	(*HistoryStatistics)(nil),                        // 19: logistics.report.v1.HistoryStatistics
+	(*GenerateHistoryReportResponse)(nil),            // 20: logistics.report.v1.GenerateHistoryReportResponse
+	(*GenerateReportStreamRequest)(nil),              // 21: logistics.report.v1.GenerateReportStreamRequest
+	(*ReportChunk)(nil),                              // 22: logistics.report.v1.ReportChunk
+	(*GetReportRequest)(nil),                         // 23: logistics.report.v1.GetReportRequest
+	(*GetReportResponse)(nil),                        // 24: logistics.report.v1.GetReportResponse
+	(*GetReportInfoRequest)(nil),                     // 25: logistics.report.v1.GetReportInfoRequest
+	(*GetReportInfoResponse)(nil),                    // 26: logistics.report.v1.GetReportInfoResponse
+	(*ListReportsRequest)(nil),                       // 27: logistics.report.v1.ListReportsRequest
+	(*ListReportsResponse)(nil),                      // 28: logistics.report.v1.ListReportsResponse
+	(*DeleteReportRequest)(nil),                      // 29: logistics.report.v1.DeleteReportRequest
+	(*DeleteReportResponse)(nil),                     // 30: logistics.report.v1.DeleteReportResponse
+	(*UpdateReportTagsRequest)(nil),                  // 31: logistics.report.v1.UpdateReportTagsRequest
+	(*UpdateReportTagsResponse)(nil),                 // 32: logistics.report.v1.UpdateReportTagsResponse
+	(*GetRepositoryStatsRequest)(nil),                // 33: logistics.report.v1.GetRepositoryStatsRequest
+	(*GetRepositoryStatsResponse)(nil),               // 34: logistics.report.v1.GetRepositoryStatsResponse
+	(*GetSupportedFormatsRequest)(nil),               // 35: logistics.report.v1.GetSupportedFormatsRequest
+	(*GetSupportedFormatsResponse)(nil),              // 36: logistics.report.v1.GetSupportedFormatsResponse
+	(*FormatInfo)(nil),                               // 37: logistics.report.v1.FormatInfo
+	(*HealthRequest)(nil),                            // 38: logistics.report.v1.HealthRequest
+	(*HealthResponse)(nil),                           // 39: logistics.report.v1.HealthResponse
+	(*StorageHealth)(nil),                            // 40: logistics.report.v1.StorageHealth
+	nil,                                              // 41: logistics.report.v1.ReportMetadata.CustomFieldsEntry
+	nil,                                              // 42: logistics.report.v1.ReportOptions.CustomFieldsEntry
+	nil,                                              // 43: logistics.report.v1.SimulationSummaryData.KeyMetricsEntry
+	nil,                                              // 44: logistics.report.v1.ComparisonItem.MetricsEntry
+	nil,                                              // 45: logistics.report.v1.HistoryStatistics.ByAlgorithmEntry
+	nil,                                              // 46: logistics.report.v1.GetRepositoryStatsResponse.ReportsByTypeEntry
+	nil,                                              // 47: logistics.report.v1.GetRepositoryStatsResponse.ReportsByFormatEntry
+	nil,                                              // 48: logistics.report.v1.GetRepositoryStatsResponse.SizeByTypeEntry
+	(*timestamppb.Timestamp)(nil),                    // 49: google.protobuf.Timestamp
+	(*v1.Graph)(nil),                                 // 50: logistics.common.v1.Graph
+	(*v1.FlowResult)(nil),                            // 51: logistics.common.v1.FlowResult
+	(*v11.SolveMetrics)(nil),                         // 52: logistics.optimization.v1.SolveMetrics
+	(*v12.CalculateCostResponse)(nil),                // 53: logistics.analytics.v1.CalculateCostResponse
+	(*v12.FindBottlenecksResponse)(nil),              // 54: logistics.analytics.v1.FindBottlenecksResponse
+	(*v12.EfficiencyReport)(nil),                     // 55: logistics.analytics.v1.EfficiencyReport
+	(*v1.FlowStatistics)(nil),                        // 56: logistics.common.v1.FlowStatistics
+	(*v1.GraphStatistics)(nil),                       // 57: logistics.common.v1.GraphStatistics
+	(*v12.BuildOriginDestinationMatrixResponse)(nil), // 58: logistics.analytics.v1.BuildOriginDestinationMatrixResponse
+	(*v13.RunWhatIfResponse)(nil),                    // 59: logistics.simulation.v1.RunWhatIfResponse
+	(*v13.CompareScenariosResponse)(nil),             // 60: logistics.simulation.v1.CompareScenariosResponse
+	(*v13.RunMonteCarloResponse)(nil),                // 61: logistics.simulation.v1.RunMonteCarloResponse
+	(*v13.AnalyzeSensitivityResponse)(nil),           // 62: logistics.simulation.v1.AnalyzeSensitivityResponse
+	(*v13.AnalyzeResilienceResponse)(nil),            // 63: logistics.simulation.v1.AnalyzeResilienceResponse
+	(*v13.RunTimeSimulationResponse)(nil),            // 64: logistics.simulation.v1.RunTimeSimulationResponse
+	(*v12.AnalyzeFlowResponse)(nil),                  // 65: logistics.analytics.v1.AnalyzeFlowResponse
+	(*v1.TimeRange)(nil),                             // 66: logistics.common.v1.TimeRange
+	(v1.Algorithm)(0),                                // 67: logistics.common.v1.Algorithm
 }
 var file_logistics_report_v1_report_proto_depIdxs = []int32{
 	1,  // 0: logistics.report.v1.ReportMetadata.type:type_name -> logistics.report.v1.ReportType
@@ -3795,105 +3805,106 @@ var file_logistics_report_v1_report_proto_depIdxs = []int32{
 	55, // 16: logistics.report.v1.GenerateAnalyticsReportRequest.efficiency:type_name -> logistics.analytics.v1.EfficiencyReport
 	56, // 17: logistics.report.v1.GenerateAnalyticsReportRequest.flow_stats:type_name -> logistics.common.v1.FlowStatistics
 	57, // 18: logistics.report.v1.GenerateAnalyticsReportRequest.graph_stats:type_name -> logistics.common.v1.GraphStatistics
-	0,  // 19: logistics.report.v1.GenerateAnalyticsReportRequest.format:type_name -> logistics.report.v1.ReportFormat
-	3,  // 20: logistics.report.v1.GenerateAnalyticsReportRequest.options:type_name -> logistics.report.v1.ReportOptions
-	2,  // 21: logistics.report.v1.GenerateAnalyticsReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
-	4,  // 22: logistics.report.v1.GenerateAnalyticsReportResponse.content:type_name -> logistics.report.v1.ReportContent
-	50, // 23: logistics.report.v1.GenerateSimulationReportRequest.baseline_graph:type_name -> logistics.common.v1.Graph
-	58, // 24: logistics.report.v1.GenerateSimulationReportRequest.what_if:type_name -> logistics.simulation.v1.RunWhatIfResponse
-	59, // 25: logistics.report.v1.GenerateSimulationReportRequest.comparison:type_name -> logistics.simulation.v1.CompareScenariosResponse
-	60, // 26: logistics.report.v1.GenerateSimulationReportRequest.monte_carlo:type_name -> logistics.simulation.v1.RunMonteCarloResponse
-	61, // 27: logistics.report.v1.GenerateSimulationReportRequest.sensitivity:type_name -> logistics.simulation.v1.AnalyzeSensitivityResponse
-	62, // 28: logistics.report.v1.GenerateSimulationReportRequest.resilience:type_name -> logistics.simulation.v1.AnalyzeResilienceResponse
-	63, // 29: logistics.report.v1.GenerateSimulationReportRequest.time_simulation:type_name -> logistics.simulation.v1.RunTimeSimulationResponse
-	0,  // 30: logistics.report.v1.GenerateSimulationReportRequest.format:type_name -> logistics.report.v1.ReportFormat
-	3,  // 31: logistics.report.v1.GenerateSimulationReportRequest.options:type_name -> logistics.report.v1.ReportOptions
-	2,  // 32: logistics.report.v1.GenerateSimulationReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
-	4,  // 33: logistics.report.v1.GenerateSimulationReportResponse.content:type_name -> logistics.report.v1.ReportContent
-	50, // 34: logistics.report.v1.GenerateSummaryReportRequest.graph:type_name -> logistics.common.v1.Graph
-	51, // 35: logistics.report.v1.GenerateSummaryReportRequest.flow_result:type_name -> logistics.common.v1.FlowResult
-	64, // 36: logistics.report.v1.GenerateSummaryReportRequest.analytics:type_name -> logistics.analytics.v1.AnalyzeFlowResponse
-	12, // 37: logistics.report.v1.GenerateSummaryReportRequest.simulations:type_name -> logistics.report.v1.SimulationSummaryData
-	0,  // 38: logistics.report.v1.GenerateSummaryReportRequest.format:type_name -> logistics.report.v1.ReportFormat
-	3,  // 39: logistics.report.v1.GenerateSummaryReportRequest.options:type_name -> logistics.report.v1.ReportOptions
-	43, // 40: logistics.report.v1.SimulationSummaryData.key_metrics:type_name -> logistics.report.v1.SimulationSummaryData.KeyMetricsEntry
-	2,  // 41: logistics.report.v1.GenerateSummaryReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
-	4,  // 42: logistics.report.v1.GenerateSummaryReportResponse.content:type_name -> logistics.report.v1.ReportContent
-	15, // 43: logistics.report.v1.GenerateComparisonReportRequest.items:type_name -> logistics.report.v1.ComparisonItem
-	0,  // 44: logistics.report.v1.GenerateComparisonReportRequest.format:type_name -> logistics.report.v1.ReportFormat
-	3,  // 45: logistics.report.v1.GenerateComparisonReportRequest.options:type_name -> logistics.report.v1.ReportOptions
-	50, // 46: logistics.report.v1.ComparisonItem.graph:type_name -> logistics.common.v1.Graph
-	51, // 47: logistics.report.v1.ComparisonItem.result:type_name -> logistics.common.v1.FlowResult
-	44, // 48: logistics.report.v1.ComparisonItem.metrics:type_name -> logistics.report.v1.ComparisonItem.MetricsEntry
-	2,  // 49: logistics.report.v1.GenerateComparisonReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
-	4,  // 50: logistics.report.v1.GenerateComparisonReportResponse.content:type_name -> logistics.report.v1.ReportContent
-	65, // 51: logistics.report.v1.GenerateHistoryReportRequest.time_range:type_name -> logistics.common.v1.TimeRange
-	18, // 52: logistics.report.v1.GenerateHistoryReportRequest.entries:type_name -> logistics.report.v1.HistoryEntry
-	19, // 53: logistics.report.v1.GenerateHistoryReportRequest.statistics:type_name -> logistics.report.v1.HistoryStatistics
-	0,  // 54: logistics.report.v1.GenerateHistoryReportRequest.format:type_name -> logistics.report.v1.ReportFormat
-	3,  // 55: logistics.report.v1.GenerateHistoryReportRequest.options:type_name -> logistics.report.v1.ReportOptions
-	49, // 56: logistics.report.v1.HistoryEntry.created_at:type_name -> google.protobuf.Timestamp
-	66, // 57: logistics.report.v1.HistoryEntry.algorithm:type_name -> logistics.common.v1.Algorithm
-	45, // 58: logistics.report.v1.HistoryStatistics.by_algorithm:type_name -> logistics.report.v1.HistoryStatistics.ByAlgorithmEntry
-	2,  // 59: logistics.report.v1.GenerateHistoryReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
-	4,  // 60: logistics.report.v1.GenerateHistoryReportResponse.content:type_name -> logistics.report.v1.ReportContent
-	5,  // 61: logistics.report.v1.GenerateReportStreamRequest.flow:type_name -> logistics.report.v1.GenerateFlowReportRequest
-	7,  // 62: logistics.report.v1.GenerateReportStreamRequest.analytics:type_name -> logistics.report.v1.GenerateAnalyticsReportRequest
-	9,  // 63: logistics.report.v1.GenerateReportStreamRequest.simulation:type_name -> logistics.report.v1.GenerateSimulationReportRequest
-	11, // 64: logistics.report.v1.GenerateReportStreamRequest.summary:type_name -> logistics.report.v1.GenerateSummaryReportRequest
-	2,  // 65: logistics.report.v1.ReportChunk.metadata:type_name -> logistics.report.v1.ReportMetadata
-	2,  // 66: logistics.report.v1.GetReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
-	4,  // 67: logistics.report.v1.GetReportResponse.content:type_name -> logistics.report.v1.ReportContent
-	2,  // 68: logistics.report.v1.GetReportInfoResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
-	1,  // 69: logistics.report.v1.ListReportsRequest.report_type:type_name -> logistics.report.v1.ReportType
-	0,  // 70: logistics.report.v1.ListReportsRequest.format:type_name -> logistics.report.v1.ReportFormat
-	49, // 71: logistics.report.v1.ListReportsRequest.created_after:type_name -> google.protobuf.Timestamp
-	49, // 72: logistics.report.v1.ListReportsRequest.created_before:type_name -> google.protobuf.Timestamp
-	2,  // 73: logistics.report.v1.ListReportsResponse.reports:type_name -> logistics.report.v1.ReportMetadata
-	46, // 74: logistics.report.v1.GetRepositoryStatsResponse.reports_by_type:type_name -> logistics.report.v1.GetRepositoryStatsResponse.ReportsByTypeEntry
-	47, // 75: logistics.report.v1.GetRepositoryStatsResponse.reports_by_format:type_name -> logistics.report.v1.GetRepositoryStatsResponse.ReportsByFormatEntry
-	48, // 76: logistics.report.v1.GetRepositoryStatsResponse.size_by_type:type_name -> logistics.report.v1.GetRepositoryStatsResponse.SizeByTypeEntry
-	49, // 77: logistics.report.v1.GetRepositoryStatsResponse.oldest_report_at:type_name -> google.protobuf.Timestamp
-	49, // 78: logistics.report.v1.GetRepositoryStatsResponse.newest_report_at:type_name -> google.protobuf.Timestamp
-	37, // 79: logistics.report.v1.GetSupportedFormatsResponse.formats:type_name -> logistics.report.v1.FormatInfo
-	0,  // 80: logistics.report.v1.FormatInfo.format:type_name -> logistics.report.v1.ReportFormat
-	1,  // 81: logistics.report.v1.FormatInfo.supported_report_types:type_name -> logistics.report.v1.ReportType
-	40, // 82: logistics.report.v1.HealthResponse.storage:type_name -> logistics.report.v1.StorageHealth
-	5,  // 83: logistics.report.v1.ReportService.GenerateFlowReport:input_type -> logistics.report.v1.GenerateFlowReportRequest
-	7,  // 84: logistics.report.v1.ReportService.GenerateAnalyticsReport:input_type -> logistics.report.v1.GenerateAnalyticsReportRequest
-	9,  // 85: logistics.report.v1.ReportService.GenerateSimulationReport:input_type -> logistics.report.v1.GenerateSimulationReportRequest
-	11, // 86: logistics.report.v1.ReportService.GenerateSummaryReport:input_type -> logistics.report.v1.GenerateSummaryReportRequest
-	14, // 87: logistics.report.v1.ReportService.GenerateComparisonReport:input_type -> logistics.report.v1.GenerateComparisonReportRequest
-	17, // 88: logistics.report.v1.ReportService.GenerateHistoryReport:input_type -> logistics.report.v1.GenerateHistoryReportRequest
-	21, // 89: logistics.report.v1.ReportService.GenerateReportStream:input_type -> logistics.report.v1.GenerateReportStreamRequest
-	23, // 90: logistics.report.v1.ReportService.GetReport:input_type -> logistics.report.v1.GetReportRequest
-	25, // 91: logistics.report.v1.ReportService.GetReportInfo:input_type -> logistics.report.v1.GetReportInfoRequest
-	27, // 92: logistics.report.v1.ReportService.ListReports:input_type -> logistics.report.v1.ListReportsRequest
-	29, // 93: logistics.report.v1.ReportService.DeleteReport:input_type -> logistics.report.v1.DeleteReportRequest
-	31, // 94: logistics.report.v1.ReportService.UpdateReportTags:input_type -> logistics.report.v1.UpdateReportTagsRequest
-	33, // 95: logistics.report.v1.ReportService.GetRepositoryStats:input_type -> logistics.report.v1.GetRepositoryStatsRequest
-	35, // 96: logistics.report.v1.ReportService.GetSupportedFormats:input_type -> logistics.report.v1.GetSupportedFormatsRequest
-	38, // 97: logistics.report.v1.ReportService.Health:input_type -> logistics.report.v1.HealthRequest
-	6,  // 98: logistics.report.v1.ReportService.GenerateFlowReport:output_type -> logistics.report.v1.GenerateFlowReportResponse
-	8,  // 99: logistics.report.v1.ReportService.GenerateAnalyticsReport:output_type -> logistics.report.v1.GenerateAnalyticsReportResponse
-	10, // 100: logistics.report.v1.ReportService.GenerateSimulationReport:output_type -> logistics.report.v1.GenerateSimulationReportResponse
-	13, // 101: logistics.report.v1.ReportService.GenerateSummaryReport:output_type -> logistics.report.v1.GenerateSummaryReportResponse
-	16, // 102: logistics.report.v1.ReportService.GenerateComparisonReport:output_type -> logistics.report.v1.GenerateComparisonReportResponse
-	20, // 103: logistics.report.v1.ReportService.GenerateHistoryReport:output_type -> logistics.report.v1.GenerateHistoryReportResponse
-	22, // 104: logistics.report.v1.ReportService.GenerateReportStream:output_type -> logistics.report.v1.ReportChunk
-	24, // 105: logistics.report.v1.ReportService.GetReport:output_type -> logistics.report.v1.GetReportResponse
-	26, // 106: logistics.report.v1.ReportService.GetReportInfo:output_type -> logistics.report.v1.GetReportInfoResponse
-	28, // 107: logistics.report.v1.ReportService.ListReports:output_type -> logistics.report.v1.ListReportsResponse
-	30, // 108: logistics.report.v1.ReportService.DeleteReport:output_type -> logistics.report.v1.DeleteReportResponse
-	32, // 109: logistics.report.v1.ReportService.UpdateReportTags:output_type -> logistics.report.v1.UpdateReportTagsResponse
-	34, // 110: logistics.report.v1.ReportService.GetRepositoryStats:output_type -> logistics.report.v1.GetRepositoryStatsResponse
-	36, // 111: logistics.report.v1.ReportService.GetSupportedFormats:output_type -> logistics.report.v1.GetSupportedFormatsResponse
-	39, // 112: logistics.report.v1.ReportService.Health:output_type -> logistics.report.v1.HealthResponse
-	98, // [98:113] is the sub-list for method output_type
-	83, // [83:98] is the sub-list for method input_type
-	83, // [83:83] is the sub-list for extension type_name
-	83, // [83:83] is the sub-list for extension extendee
-	0,  // [0:83] is the sub-list for field type_name
+	58, // 19: logistics.report.v1.GenerateAnalyticsReportRequest.origin_destination:type_name -> logistics.analytics.v1.BuildOriginDestinationMatrixResponse
+	0,  // 20: logistics.report.v1.GenerateAnalyticsReportRequest.format:type_name -> logistics.report.v1.ReportFormat
+	3,  // 21: logistics.report.v1.GenerateAnalyticsReportRequest.options:type_name -> logistics.report.v1.ReportOptions
+	2,  // 22: logistics.report.v1.GenerateAnalyticsReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
+	4,  // 23: logistics.report.v1.GenerateAnalyticsReportResponse.content:type_name -> logistics.report.v1.ReportContent
+	50, // 24: logistics.report.v1.GenerateSimulationReportRequest.baseline_graph:type_name -> logistics.common.v1.Graph
+	59, // 25: logistics.report.v1.GenerateSimulationReportRequest.what_if:type_name -> logistics.simulation.v1.RunWhatIfResponse
+	60, // 26: logistics.report.v1.GenerateSimulationReportRequest.comparison:type_name -> logistics.simulation.v1.CompareScenariosResponse
+	61, // 27: logistics.report.v1.GenerateSimulationReportRequest.monte_carlo:type_name -> logistics.simulation.v1.RunMonteCarloResponse
+	62, // 28: logistics.report.v1.GenerateSimulationReportRequest.sensitivity:type_name -> logistics.simulation.v1.AnalyzeSensitivityResponse
+	63, // 29: logistics.report.v1.GenerateSimulationReportRequest.resilience:type_name -> logistics.simulation.v1.AnalyzeResilienceResponse
+	64, // 30: logistics.report.v1.GenerateSimulationReportRequest.time_simulation:type_name -> logistics.simulation.v1.RunTimeSimulationResponse
+	0,  // 31: logistics.report.v1.GenerateSimulationReportRequest.format:type_name -> logistics.report.v1.ReportFormat
+	3,  // 32: logistics.report.v1.GenerateSimulationReportRequest.options:type_name -> logistics.report.v1.ReportOptions
+	2,  // 33: logistics.report.v1.GenerateSimulationReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
+	4,  // 34: logistics.report.v1.GenerateSimulationReportResponse.content:type_name -> logistics.report.v1.ReportContent
+	50, // 35: logistics.report.v1.GenerateSummaryReportRequest.graph:type_name -> logistics.common.v1.Graph
+	51, // 36: logistics.report.v1.GenerateSummaryReportRequest.flow_result:type_name -> logistics.common.v1.FlowResult
+	65, // 37: logistics.report.v1.GenerateSummaryReportRequest.analytics:type_name -> logistics.analytics.v1.AnalyzeFlowResponse
+	12, // 38: logistics.report.v1.GenerateSummaryReportRequest.simulations:type_name -> logistics.report.v1.SimulationSummaryData
+	0,  // 39: logistics.report.v1.GenerateSummaryReportRequest.format:type_name -> logistics.report.v1.ReportFormat
+	3,  // 40: logistics.report.v1.GenerateSummaryReportRequest.options:type_name -> logistics.report.v1.ReportOptions
+	43, // 41: logistics.report.v1.SimulationSummaryData.key_metrics:type_name -> logistics.report.v1.SimulationSummaryData.KeyMetricsEntry
+	2,  // 42: logistics.report.v1.GenerateSummaryReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
+	4,  // 43: logistics.report.v1.GenerateSummaryReportResponse.content:type_name -> logistics.report.v1.ReportContent
+	15, // 44: logistics.report.v1.GenerateComparisonReportRequest.items:type_name -> logistics.report.v1.ComparisonItem
+	0,  // 45: logistics.report.v1.GenerateComparisonReportRequest.format:type_name -> logistics.report.v1.ReportFormat
+	3,  // 46: logistics.report.v1.GenerateComparisonReportRequest.options:type_name -> logistics.report.v1.ReportOptions
+	50, // 47: logistics.report.v1.ComparisonItem.graph:type_name -> logistics.common.v1.Graph
+	51, // 48: logistics.report.v1.ComparisonItem.result:type_name -> logistics.common.v1.FlowResult
+	44, // 49: logistics.report.v1.ComparisonItem.metrics:type_name -> logistics.report.v1.ComparisonItem.MetricsEntry
+	2,  // 50: logistics.report.v1.GenerateComparisonReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
+	4,  // 51: logistics.report.v1.GenerateComparisonReportResponse.content:type_name -> logistics.report.v1.ReportContent
+	66, // 52: logistics.report.v1.GenerateHistoryReportRequest.time_range:type_name -> logistics.common.v1.TimeRange
+	18, // 53: logistics.report.v1.GenerateHistoryReportRequest.entries:type_name -> logistics.report.v1.HistoryEntry
+	19, // 54: logistics.report.v1.GenerateHistoryReportRequest.statistics:type_name -> logistics.report.v1.HistoryStatistics
+	0,  // 55: logistics.report.v1.GenerateHistoryReportRequest.format:type_name -> logistics.report.v1.ReportFormat
+	3,  // 56: logistics.report.v1.GenerateHistoryReportRequest.options:type_name -> logistics.report.v1.ReportOptions
+	49, // 57: logistics.report.v1.HistoryEntry.created_at:type_name -> google.protobuf.Timestamp
+	67, // 58: logistics.report.v1.HistoryEntry.algorithm:type_name -> logistics.common.v1.Algorithm
+	45, // 59: logistics.report.v1.HistoryStatistics.by_algorithm:type_name -> logistics.report.v1.HistoryStatistics.ByAlgorithmEntry
+	2,  // 60: logistics.report.v1.GenerateHistoryReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
+	4,  // 61: logistics.report.v1.GenerateHistoryReportResponse.content:type_name -> logistics.report.v1.ReportContent
+	5,  // 62: logistics.report.v1.GenerateReportStreamRequest.flow:type_name -> logistics.report.v1.GenerateFlowReportRequest
+	7,  // 63: logistics.report.v1.GenerateReportStreamRequest.analytics:type_name -> logistics.report.v1.GenerateAnalyticsReportRequest
+	9,  // 64: logistics.report.v1.GenerateReportStreamRequest.simulation:type_name -> logistics.report.v1.GenerateSimulationReportRequest
+	11, // 65: logistics.report.v1.GenerateReportStreamRequest.summary:type_name -> logistics.report.v1.GenerateSummaryReportRequest
+	2,  // 66: logistics.report.v1.ReportChunk.metadata:type_name -> logistics.report.v1.ReportMetadata
+	2,  // 67: logistics.report.v1.GetReportResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
+	4,  // 68: logistics.report.v1.GetReportResponse.content:type_name -> logistics.report.v1.ReportContent
+	2,  // 69: logistics.report.v1.GetReportInfoResponse.metadata:type_name -> logistics.report.v1.ReportMetadata
+	1,  // 70: logistics.report.v1.ListReportsRequest.report_type:type_name -> logistics.report.v1.ReportType
+	0,  // 71: logistics.report.v1.ListReportsRequest.format:type_name -> logistics.report.v1.ReportFormat
+	49, // 72: logistics.report.v1.ListReportsRequest.created_after:type_name -> google.protobuf.Timestamp
+	49, // 73: logistics.report.v1.ListReportsRequest.created_before:type_name -> google.protobuf.Timestamp
+	2,  // 74: logistics.report.v1.ListReportsResponse.reports:type_name -> logistics.report.v1.ReportMetadata
+	46, // 75: logistics.report.v1.GetRepositoryStatsResponse.reports_by_type:type_name -> logistics.report.v1.GetRepositoryStatsResponse.ReportsByTypeEntry
+	47, // 76: logistics.report.v1.GetRepositoryStatsResponse.reports_by_format:type_name -> logistics.report.v1.GetRepositoryStatsResponse.ReportsByFormatEntry
+	48, // 77: logistics.report.v1.GetRepositoryStatsResponse.size_by_type:type_name -> logistics.report.v1.GetRepositoryStatsResponse.SizeByTypeEntry
+	49, // 78: logistics.report.v1.GetRepositoryStatsResponse.oldest_report_at:type_name -> google.protobuf.Timestamp
+	49, // 79: logistics.report.v1.GetRepositoryStatsResponse.newest_report_at:type_name -> google.protobuf.Timestamp
+	37, // 80: logistics.report.v1.GetSupportedFormatsResponse.formats:type_name -> logistics.report.v1.FormatInfo
+	0,  // 81: logistics.report.v1.FormatInfo.format:type_name -> logistics.report.v1.ReportFormat
+	1,  // 82: logistics.report.v1.FormatInfo.supported_report_types:type_name -> logistics.report.v1.ReportType
+	40, // 83: logistics.report.v1.HealthResponse.storage:type_name -> logistics.report.v1.StorageHealth
+	5,  // 84: logistics.report.v1.ReportService.GenerateFlowReport:input_type -> logistics.report.v1.GenerateFlowReportRequest
+	7,  // 85: logistics.report.v1.ReportService.GenerateAnalyticsReport:input_type -> logistics.report.v1.GenerateAnalyticsReportRequest
+	9,  // 86: logistics.report.v1.ReportService.GenerateSimulationReport:input_type -> logistics.report.v1.GenerateSimulationReportRequest
+	11, // 87: logistics.report.v1.ReportService.GenerateSummaryReport:input_type -> logistics.report.v1.GenerateSummaryReportRequest
+	14, // 88: logistics.report.v1.ReportService.GenerateComparisonReport:input_type -> logistics.report.v1.GenerateComparisonReportRequest
+	17, // 89: logistics.report.v1.ReportService.GenerateHistoryReport:input_type -> logistics.report.v1.GenerateHistoryReportRequest
+	21, // 90: logistics.report.v1.ReportService.GenerateReportStream:input_type -> logistics.report.v1.GenerateReportStreamRequest
+	23, // 91: logistics.report.v1.ReportService.GetReport:input_type -> logistics.report.v1.GetReportRequest
+	25, // 92: logistics.report.v1.ReportService.GetReportInfo:input_type -> logistics.report.v1.GetReportInfoRequest
+	27, // 93: logistics.report.v1.ReportService.ListReports:input_type -> logistics.report.v1.ListReportsRequest
+	29, // 94: logistics.report.v1.ReportService.DeleteReport:input_type -> logistics.report.v1.DeleteReportRequest
+	31, // 95: logistics.report.v1.ReportService.UpdateReportTags:input_type -> logistics.report.v1.UpdateReportTagsRequest
+	33, // 96: logistics.report.v1.ReportService.GetRepositoryStats:input_type -> logistics.report.v1.GetRepositoryStatsRequest
+	35, // 97: logistics.report.v1.ReportService.GetSupportedFormats:input_type -> logistics.report.v1.GetSupportedFormatsRequest
+	38, // 98: logistics.report.v1.ReportService.Health:input_type -> logistics.report.v1.HealthRequest
+	6,  // 99: logistics.report.v1.ReportService.GenerateFlowReport:output_type -> logistics.report.v1.GenerateFlowReportResponse
+	8,  // 100: logistics.report.v1.ReportService.GenerateAnalyticsReport:output_type -> logistics.report.v1.GenerateAnalyticsReportResponse
+	10, // 101: logistics.report.v1.ReportService.GenerateSimulationReport:output_type -> logistics.report.v1.GenerateSimulationReportResponse
+	13, // 102: logistics.report.v1.ReportService.GenerateSummaryReport:output_type -> logistics.report.v1.GenerateSummaryReportResponse
+	16, // 103: logistics.report.v1.ReportService.GenerateComparisonReport:output_type -> logistics.report.v1.GenerateComparisonReportResponse
+	20, // 104: logistics.report.v1.ReportService.GenerateHistoryReport:output_type -> logistics.report.v1.GenerateHistoryReportResponse
+	22, // 105: logistics.report.v1.ReportService.GenerateReportStream:output_type -> logistics.report.v1.ReportChunk
+	24, // 106: logistics.report.v1.ReportService.GetReport:output_type -> logistics.report.v1.GetReportResponse
+	26, // 107: logistics.report.v1.ReportService.GetReportInfo:output_type -> logistics.report.v1.GetReportInfoResponse
+	28, // 108: logistics.report.v1.ReportService.ListReports:output_type -> logistics.report.v1.ListReportsResponse
+	30, // 109: logistics.report.v1.ReportService.DeleteReport:output_type -> logistics.report.v1.DeleteReportResponse
+	32, // 110: logistics.report.v1.ReportService.UpdateReportTags:output_type -> logistics.report.v1.UpdateReportTagsResponse
+	34, // 111: logistics.report.v1.ReportService.GetRepositoryStats:output_type -> logistics.report.v1.GetRepositoryStatsResponse
+	36, // 112: logistics.report.v1.ReportService.GetSupportedFormats:output_type -> logistics.report.v1.GetSupportedFormatsResponse
+	39, // 113: logistics.report.v1.ReportService.Health:output_type -> logistics.report.v1.HealthResponse
+	99, // [99:114] is the sub-list for method output_type
+	84, // [84:99] is the sub-list for method input_type
+	84, // [84:84] is the sub-list for extension type_name
+	84, // [84:84] is the sub-list for extension extendee
+	0,  // [0:84] is the sub-list for field type_name
 }
 
 func init() { file_logistics_report_v1_report_proto_init() }
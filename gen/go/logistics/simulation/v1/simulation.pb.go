@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	v11 "logistics/gen/go/logistics/analytics/v1"
 	v1 "logistics/gen/go/logistics/common/v1"
 	reflect "reflect"
 	sync "sync"
@@ -255,6 +256,163 @@ func (BottleneckChangeType) EnumDescriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{3}
 }
 
+type ExpansionStopReason int32
+
+const (
+	ExpansionStopReason_EXPANSION_STOP_REASON_UNSPECIFIED    ExpansionStopReason = 0
+	ExpansionStopReason_EXPANSION_STOP_REASON_BUDGET         ExpansionStopReason = 1 // Ни один кандидат не укладывается в бюджет
+	ExpansionStopReason_EXPANSION_STOP_REASON_NO_GAIN        ExpansionStopReason = 2 // Разрез нельзя расширить или расширение не даёт потока
+	ExpansionStopReason_EXPANSION_STOP_REASON_MAX_ITERATIONS ExpansionStopReason = 3
+)
+
+// Enum value maps for ExpansionStopReason.
+var (
+	ExpansionStopReason_name = map[int32]string{
+		0: "EXPANSION_STOP_REASON_UNSPECIFIED",
+		1: "EXPANSION_STOP_REASON_BUDGET",
+		2: "EXPANSION_STOP_REASON_NO_GAIN",
+		3: "EXPANSION_STOP_REASON_MAX_ITERATIONS",
+	}
+	ExpansionStopReason_value = map[string]int32{
+		"EXPANSION_STOP_REASON_UNSPECIFIED":    0,
+		"EXPANSION_STOP_REASON_BUDGET":         1,
+		"EXPANSION_STOP_REASON_NO_GAIN":        2,
+		"EXPANSION_STOP_REASON_MAX_ITERATIONS": 3,
+	}
+)
+
+func (x ExpansionStopReason) Enum() *ExpansionStopReason {
+	p := new(ExpansionStopReason)
+	*p = x
+	return p
+}
+
+func (x ExpansionStopReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpansionStopReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[4].Descriptor()
+}
+
+func (ExpansionStopReason) Type() protoreflect.EnumType {
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[4]
+}
+
+func (x ExpansionStopReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpansionStopReason.Descriptor instead.
+func (ExpansionStopReason) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{4}
+}
+
+type FacilityMoveType int32
+
+const (
+	FacilityMoveType_FACILITY_MOVE_TYPE_UNSPECIFIED FacilityMoveType = 0
+	FacilityMoveType_FACILITY_MOVE_TYPE_OPEN        FacilityMoveType = 1
+	FacilityMoveType_FACILITY_MOVE_TYPE_CLOSE       FacilityMoveType = 2
+	FacilityMoveType_FACILITY_MOVE_TYPE_SWAP        FacilityMoveType = 3
+)
+
+// Enum value maps for FacilityMoveType.
+var (
+	FacilityMoveType_name = map[int32]string{
+		0: "FACILITY_MOVE_TYPE_UNSPECIFIED",
+		1: "FACILITY_MOVE_TYPE_OPEN",
+		2: "FACILITY_MOVE_TYPE_CLOSE",
+		3: "FACILITY_MOVE_TYPE_SWAP",
+	}
+	FacilityMoveType_value = map[string]int32{
+		"FACILITY_MOVE_TYPE_UNSPECIFIED": 0,
+		"FACILITY_MOVE_TYPE_OPEN":        1,
+		"FACILITY_MOVE_TYPE_CLOSE":       2,
+		"FACILITY_MOVE_TYPE_SWAP":        3,
+	}
+)
+
+func (x FacilityMoveType) Enum() *FacilityMoveType {
+	p := new(FacilityMoveType)
+	*p = x
+	return p
+}
+
+func (x FacilityMoveType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FacilityMoveType) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[5].Descriptor()
+}
+
+func (FacilityMoveType) Type() protoreflect.EnumType {
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[5]
+}
+
+func (x FacilityMoveType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FacilityMoveType.Descriptor instead.
+func (FacilityMoveType) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{5}
+}
+
+type TimeSimulationMode int32
+
+const (
+	TimeSimulationMode_TIME_SIMULATION_MODE_UNSPECIFIED TimeSimulationMode = 0 // = STATIC
+	// Каждый шаг — независимый максимальный поток
+	TimeSimulationMode_TIME_SIMULATION_MODE_STATIC TimeSimulationMode = 1
+	// Один поток в сети, развёрнутой во времени: ребро ведёт из узла на шаге t
+	// в узел на шаге t + время в пути, груз может ждать в узле в пределах
+	// Node.storage_capacity
+	TimeSimulationMode_TIME_SIMULATION_MODE_DYNAMIC TimeSimulationMode = 2
+)
+
+// Enum value maps for TimeSimulationMode.
+var (
+	TimeSimulationMode_name = map[int32]string{
+		0: "TIME_SIMULATION_MODE_UNSPECIFIED",
+		1: "TIME_SIMULATION_MODE_STATIC",
+		2: "TIME_SIMULATION_MODE_DYNAMIC",
+	}
+	TimeSimulationMode_value = map[string]int32{
+		"TIME_SIMULATION_MODE_UNSPECIFIED": 0,
+		"TIME_SIMULATION_MODE_STATIC":      1,
+		"TIME_SIMULATION_MODE_DYNAMIC":     2,
+	}
+)
+
+func (x TimeSimulationMode) Enum() *TimeSimulationMode {
+	p := new(TimeSimulationMode)
+	*p = x
+	return p
+}
+
+func (x TimeSimulationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeSimulationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[6].Descriptor()
+}
+
+func (TimeSimulationMode) Type() protoreflect.EnumType {
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[6]
+}
+
+func (x TimeSimulationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeSimulationMode.Descriptor instead.
+func (TimeSimulationMode) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{6}
+}
+
 type TimeStep int32
 
 const (
@@ -294,11 +452,11 @@ func (x TimeStep) String() string {
 }
 
 func (TimeStep) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[4].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[7].Descriptor()
 }
 
 func (TimeStep) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[4]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[7]
 }
 
 func (x TimeStep) Number() protoreflect.EnumNumber {
@@ -307,7 +465,7 @@ func (x TimeStep) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TimeStep.Descriptor instead.
 func (TimeStep) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{4}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{7}
 }
 
 type PatternTarget int32
@@ -349,11 +507,11 @@ func (x PatternTarget) String() string {
 }
 
 func (PatternTarget) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[5].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[8].Descriptor()
 }
 
 func (PatternTarget) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[5]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[8]
 }
 
 func (x PatternTarget) Number() protoreflect.EnumNumber {
@@ -362,7 +520,7 @@ func (x PatternTarget) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PatternTarget.Descriptor instead.
 func (PatternTarget) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{5}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{8}
 }
 
 type PatternType int32
@@ -410,11 +568,11 @@ func (x PatternType) String() string {
 }
 
 func (PatternType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[6].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[9].Descriptor()
 }
 
 func (PatternType) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[6]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[9]
 }
 
 func (x PatternType) Number() protoreflect.EnumNumber {
@@ -423,7 +581,7 @@ func (x PatternType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PatternType.Descriptor instead.
 func (PatternType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{6}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{9}
 }
 
 type CriticalPeriodType int32
@@ -465,11 +623,11 @@ func (x CriticalPeriodType) String() string {
 }
 
 func (CriticalPeriodType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[7].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[10].Descriptor()
 }
 
 func (CriticalPeriodType) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[7]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[10]
 }
 
 func (x CriticalPeriodType) Number() protoreflect.EnumNumber {
@@ -478,7 +636,169 @@ func (x CriticalPeriodType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CriticalPeriodType.Descriptor instead.
 func (CriticalPeriodType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{7}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{10}
+}
+
+type CorrelationMeasure int32
+
+const (
+	CorrelationMeasure_CORRELATION_MEASURE_UNSPECIFIED CorrelationMeasure = 0 // То же, что CORRELATION_MEASURE_GAUSSIAN
+	CorrelationMeasure_CORRELATION_MEASURE_GAUSSIAN    CorrelationMeasure = 1 // Корреляции нормальных величин копулы
+	CorrelationMeasure_CORRELATION_MEASURE_SPEARMAN    CorrelationMeasure = 2 // Ранговая корреляция Спирмена
+	CorrelationMeasure_CORRELATION_MEASURE_KENDALL     CorrelationMeasure = 3 // Ранговая корреляция тау Кендалла
+)
+
+// Enum value maps for CorrelationMeasure.
+var (
+	CorrelationMeasure_name = map[int32]string{
+		0: "CORRELATION_MEASURE_UNSPECIFIED",
+		1: "CORRELATION_MEASURE_GAUSSIAN",
+		2: "CORRELATION_MEASURE_SPEARMAN",
+		3: "CORRELATION_MEASURE_KENDALL",
+	}
+	CorrelationMeasure_value = map[string]int32{
+		"CORRELATION_MEASURE_UNSPECIFIED": 0,
+		"CORRELATION_MEASURE_GAUSSIAN":    1,
+		"CORRELATION_MEASURE_SPEARMAN":    2,
+		"CORRELATION_MEASURE_KENDALL":     3,
+	}
+)
+
+func (x CorrelationMeasure) Enum() *CorrelationMeasure {
+	p := new(CorrelationMeasure)
+	*p = x
+	return p
+}
+
+func (x CorrelationMeasure) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CorrelationMeasure) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[11].Descriptor()
+}
+
+func (CorrelationMeasure) Type() protoreflect.EnumType {
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[11]
+}
+
+func (x CorrelationMeasure) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CorrelationMeasure.Descriptor instead.
+func (CorrelationMeasure) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{11}
+}
+
+type MonteCarloStopReason int32
+
+const (
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_UNSPECIFIED MonteCarloStopReason = 0
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_COMPLETED   MonteCarloStopReason = 1 // Выполнены все итерации (адаптивный прогон не сошёлся)
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CONVERGED   MonteCarloStopReason = 2 // Достигнута target_relative_ci_half_width
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_TIME_BUDGET MonteCarloStopReason = 3 // Исчерпан time_budget_seconds
+	MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CANCELED    MonteCarloStopReason = 4 // Запрос отменён
+)
+
+// Enum value maps for MonteCarloStopReason.
+var (
+	MonteCarloStopReason_name = map[int32]string{
+		0: "MONTE_CARLO_STOP_REASON_UNSPECIFIED",
+		1: "MONTE_CARLO_STOP_REASON_COMPLETED",
+		2: "MONTE_CARLO_STOP_REASON_CONVERGED",
+		3: "MONTE_CARLO_STOP_REASON_TIME_BUDGET",
+		4: "MONTE_CARLO_STOP_REASON_CANCELED",
+	}
+	MonteCarloStopReason_value = map[string]int32{
+		"MONTE_CARLO_STOP_REASON_UNSPECIFIED": 0,
+		"MONTE_CARLO_STOP_REASON_COMPLETED":   1,
+		"MONTE_CARLO_STOP_REASON_CONVERGED":   2,
+		"MONTE_CARLO_STOP_REASON_TIME_BUDGET": 3,
+		"MONTE_CARLO_STOP_REASON_CANCELED":    4,
+	}
+)
+
+func (x MonteCarloStopReason) Enum() *MonteCarloStopReason {
+	p := new(MonteCarloStopReason)
+	*p = x
+	return p
+}
+
+func (x MonteCarloStopReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MonteCarloStopReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[12].Descriptor()
+}
+
+func (MonteCarloStopReason) Type() protoreflect.EnumType {
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[12]
+}
+
+func (x MonteCarloStopReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MonteCarloStopReason.Descriptor instead.
+func (MonteCarloStopReason) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{12}
+}
+
+type SamplingMethod int32
+
+const (
+	SamplingMethod_SAMPLING_METHOD_UNSPECIFIED     SamplingMethod = 0 // То же, что SAMPLING_METHOD_RANDOM
+	SamplingMethod_SAMPLING_METHOD_RANDOM          SamplingMethod = 1 // Независимые случайные сэмплы
+	SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE SamplingMethod = 2 // Латинский гиперкуб: каждое измерение стратифицировано
+	SamplingMethod_SAMPLING_METHOD_SOBOL           SamplingMethod = 3 // Квазислучайная последовательность Соболя со случайным цифровым сдвигом
+	SamplingMethod_SAMPLING_METHOD_ANTITHETIC      SamplingMethod = 4 // Антитетические пары: итерации 2k и 2k+1 используют u и 1-u
+)
+
+// Enum value maps for SamplingMethod.
+var (
+	SamplingMethod_name = map[int32]string{
+		0: "SAMPLING_METHOD_UNSPECIFIED",
+		1: "SAMPLING_METHOD_RANDOM",
+		2: "SAMPLING_METHOD_LATIN_HYPERCUBE",
+		3: "SAMPLING_METHOD_SOBOL",
+		4: "SAMPLING_METHOD_ANTITHETIC",
+	}
+	SamplingMethod_value = map[string]int32{
+		"SAMPLING_METHOD_UNSPECIFIED":     0,
+		"SAMPLING_METHOD_RANDOM":          1,
+		"SAMPLING_METHOD_LATIN_HYPERCUBE": 2,
+		"SAMPLING_METHOD_SOBOL":           3,
+		"SAMPLING_METHOD_ANTITHETIC":      4,
+	}
+)
+
+func (x SamplingMethod) Enum() *SamplingMethod {
+	p := new(SamplingMethod)
+	*p = x
+	return p
+}
+
+func (x SamplingMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SamplingMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[13].Descriptor()
+}
+
+func (SamplingMethod) Type() protoreflect.EnumType {
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[13]
+}
+
+func (x SamplingMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SamplingMethod.Descriptor instead.
+func (SamplingMethod) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{13}
 }
 
 type UncertaintyType int32
@@ -517,11 +837,11 @@ func (x UncertaintyType) String() string {
 }
 
 func (UncertaintyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[8].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[14].Descriptor()
 }
 
 func (UncertaintyType) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[8]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[14]
 }
 
 func (x UncertaintyType) Number() protoreflect.EnumNumber {
@@ -530,7 +850,7 @@ func (x UncertaintyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UncertaintyType.Descriptor instead.
 func (UncertaintyType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{8}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{14}
 }
 
 type DistributionType int32
@@ -575,11 +895,11 @@ func (x DistributionType) String() string {
 }
 
 func (DistributionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[9].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[15].Descriptor()
 }
 
 func (DistributionType) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[9]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[15]
 }
 
 func (x DistributionType) Number() protoreflect.EnumNumber {
@@ -588,7 +908,7 @@ func (x DistributionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DistributionType.Descriptor instead.
 func (DistributionType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{9}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{15}
 }
 
 type SensitivityMethod int32
@@ -627,11 +947,11 @@ func (x SensitivityMethod) String() string {
 }
 
 func (SensitivityMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[10].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[16].Descriptor()
 }
 
 func (SensitivityMethod) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[10]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[16]
 }
 
 func (x SensitivityMethod) Number() protoreflect.EnumNumber {
@@ -640,7 +960,7 @@ func (x SensitivityMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SensitivityMethod.Descriptor instead.
 func (SensitivityMethod) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{10}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{16}
 }
 
 type SensitivityLevel int32
@@ -685,11 +1005,11 @@ func (x SensitivityLevel) String() string {
 }
 
 func (SensitivityLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[11].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[17].Descriptor()
 }
 
 func (SensitivityLevel) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[11]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[17]
 }
 
 func (x SensitivityLevel) Number() protoreflect.EnumNumber {
@@ -698,7 +1018,7 @@ func (x SensitivityLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SensitivityLevel.Descriptor instead.
 func (SensitivityLevel) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{11}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{17}
 }
 
 type ThresholdType int32
@@ -740,11 +1060,11 @@ func (x ThresholdType) String() string {
 }
 
 func (ThresholdType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[12].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[18].Descriptor()
 }
 
 func (ThresholdType) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[12]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[18]
 }
 
 func (x ThresholdType) Number() protoreflect.EnumNumber {
@@ -753,7 +1073,59 @@ func (x ThresholdType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ThresholdType.Descriptor instead.
 func (ThresholdType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{12}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{18}
+}
+
+type FailureCorrelation int32
+
+const (
+	FailureCorrelation_FAILURE_CORRELATION_UNSPECIFIED FailureCorrelation = 0 // Как FAILURE_CORRELATION_REGIONAL
+	// Авария в радиусе region_radius от случайного узла: задеты узлы в радиусе
+	// и рёбра, хотя бы один конец которых в радиусе
+	FailureCorrelation_FAILURE_CORRELATION_REGIONAL FailureCorrelation = 1
+	// Общий отказ дорог одного случайного типа (road_type рёбер)
+	FailureCorrelation_FAILURE_CORRELATION_ROAD_TYPE FailureCorrelation = 2
+)
+
+// Enum value maps for FailureCorrelation.
+var (
+	FailureCorrelation_name = map[int32]string{
+		0: "FAILURE_CORRELATION_UNSPECIFIED",
+		1: "FAILURE_CORRELATION_REGIONAL",
+		2: "FAILURE_CORRELATION_ROAD_TYPE",
+	}
+	FailureCorrelation_value = map[string]int32{
+		"FAILURE_CORRELATION_UNSPECIFIED": 0,
+		"FAILURE_CORRELATION_REGIONAL":    1,
+		"FAILURE_CORRELATION_ROAD_TYPE":   2,
+	}
+)
+
+func (x FailureCorrelation) Enum() *FailureCorrelation {
+	p := new(FailureCorrelation)
+	*p = x
+	return p
+}
+
+func (x FailureCorrelation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FailureCorrelation) Descriptor() protoreflect.EnumDescriptor {
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[19].Descriptor()
+}
+
+func (FailureCorrelation) Type() protoreflect.EnumType {
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[19]
+}
+
+func (x FailureCorrelation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FailureCorrelation.Descriptor instead.
+func (FailureCorrelation) EnumDescriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{19}
 }
 
 type RecommendationType int32
@@ -795,11 +1167,11 @@ func (x RecommendationType) String() string {
 }
 
 func (RecommendationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[13].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[20].Descriptor()
 }
 
 func (RecommendationType) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[13]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[20]
 }
 
 func (x RecommendationType) Number() protoreflect.EnumNumber {
@@ -808,7 +1180,7 @@ func (x RecommendationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RecommendationType.Descriptor instead.
 func (RecommendationType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{13}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{20}
 }
 
 type WeaknessType int32
@@ -819,6 +1191,7 @@ const (
 	WeaknessType_WEAKNESS_TYPE_CAPACITY_BOTTLENECK      WeaknessType = 2
 	WeaknessType_WEAKNESS_TYPE_NO_REDUNDANCY            WeaknessType = 3
 	WeaknessType_WEAKNESS_TYPE_GEOGRAPHIC_CONCENTRATION WeaknessType = 4
+	WeaknessType_WEAKNESS_TYPE_CASCADE_RISK             WeaknessType = 5 // Отказ запускает каскад перегрузок
 )
 
 // Enum value maps for WeaknessType.
@@ -829,6 +1202,7 @@ var (
 		2: "WEAKNESS_TYPE_CAPACITY_BOTTLENECK",
 		3: "WEAKNESS_TYPE_NO_REDUNDANCY",
 		4: "WEAKNESS_TYPE_GEOGRAPHIC_CONCENTRATION",
+		5: "WEAKNESS_TYPE_CASCADE_RISK",
 	}
 	WeaknessType_value = map[string]int32{
 		"WEAKNESS_TYPE_UNSPECIFIED":              0,
@@ -836,6 +1210,7 @@ var (
 		"WEAKNESS_TYPE_CAPACITY_BOTTLENECK":      2,
 		"WEAKNESS_TYPE_NO_REDUNDANCY":            3,
 		"WEAKNESS_TYPE_GEOGRAPHIC_CONCENTRATION": 4,
+		"WEAKNESS_TYPE_CASCADE_RISK":             5,
 	}
 )
 
@@ -850,11 +1225,11 @@ func (x WeaknessType) String() string {
 }
 
 func (WeaknessType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[14].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[21].Descriptor()
 }
 
 func (WeaknessType) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[14]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[21]
 }
 
 func (x WeaknessType) Number() protoreflect.EnumNumber {
@@ -863,7 +1238,7 @@ func (x WeaknessType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WeaknessType.Descriptor instead.
 func (WeaknessType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{14}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{21}
 }
 
 type SimulationType int32
@@ -911,11 +1286,11 @@ func (x SimulationType) String() string {
 }
 
 func (SimulationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_logistics_simulation_v1_simulation_proto_enumTypes[15].Descriptor()
+	return file_logistics_simulation_v1_simulation_proto_enumTypes[22].Descriptor()
 }
 
 func (SimulationType) Type() protoreflect.EnumType {
-	return &file_logistics_simulation_v1_simulation_proto_enumTypes[15]
+	return &file_logistics_simulation_v1_simulation_proto_enumTypes[22]
 }
 
 func (x SimulationType) Number() protoreflect.EnumNumber {
@@ -924,7 +1299,7 @@ func (x SimulationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SimulationType.Descriptor instead.
 func (SimulationType) EnumDescriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{15}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{22}
 }
 
 type RunWhatIfRequest struct {
@@ -1909,34 +2284,42 @@ func (x *ScenarioResultWithRank) GetVsBaseline() *ScenarioComparison {
 	return nil
 }
 
-type RunTimeSimulationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
-	// Временные параметры
-	TimeConfig *TimeSimulationConfig `protobuf:"bytes,2,opt,name=time_config,json=timeConfig,proto3" json:"time_config,omitempty"`
-	// Временные паттерны для рёбер
-	EdgePatterns []*EdgeTimePattern `protobuf:"bytes,3,rep,name=edge_patterns,json=edgePatterns,proto3" json:"edge_patterns,omitempty"`
-	// Временные паттерны для узлов (demand)
-	NodePatterns  []*NodeTimePattern `protobuf:"bytes,4,rep,name=node_patterns,json=nodePatterns,proto3" json:"node_patterns,omitempty"`
-	Algorithm     v1.Algorithm       `protobuf:"varint,5,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Жадное расширение по минимальному разрезу: на каждой итерации кандидаты —
+// приращения рёбер текущего минимального разреза по отдельности, всего
+// разреза сразу и всех насыщенных рёбер самого дешёвого по стоимости
+// расширения увеличивающего пути (последовательные узкие места расширяются
+// вместе); выбирается кандидат с наибольшим приростом потока на единицу
+// стоимости, который укладывается в остаток бюджета. Прирост каждого
+// кандидата проверяется решением solver'а
+type OptimizeCapacityExpansionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Graph     *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Algorithm v1.Algorithm           `protobuf:"varint,2,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	Budget    float64                `protobuf:"fixed64,3,opt,name=budget,proto3" json:"budget,omitempty"`
+	// Варианты расширения отдельных рёбер
+	Upgrades []*EdgeUpgradeOption `protobuf:"bytes,4,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	// Вариант для рёбер без своего (edge не используется); без него
+	// расширяются только рёбра из upgrades
+	DefaultUpgrade *EdgeUpgradeOption `protobuf:"bytes,5,opt,name=default_upgrade,json=defaultUpgrade,proto3" json:"default_upgrade,omitempty"`
+	MaxIterations  int32              `protobuf:"varint,6,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"` // По умолчанию 50
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RunTimeSimulationRequest) Reset() {
-	*x = RunTimeSimulationRequest{}
+func (x *OptimizeCapacityExpansionRequest) Reset() {
+	*x = OptimizeCapacityExpansionRequest{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunTimeSimulationRequest) String() string {
+func (x *OptimizeCapacityExpansionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunTimeSimulationRequest) ProtoMessage() {}
+func (*OptimizeCapacityExpansionRequest) ProtoMessage() {}
 
-func (x *RunTimeSimulationRequest) ProtoReflect() protoreflect.Message {
+func (x *OptimizeCapacityExpansionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1948,73 +2331,83 @@ func (x *RunTimeSimulationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunTimeSimulationRequest.ProtoReflect.Descriptor instead.
-func (*RunTimeSimulationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OptimizeCapacityExpansionRequest.ProtoReflect.Descriptor instead.
+func (*OptimizeCapacityExpansionRequest) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RunTimeSimulationRequest) GetGraph() *v1.Graph {
+func (x *OptimizeCapacityExpansionRequest) GetGraph() *v1.Graph {
 	if x != nil {
 		return x.Graph
 	}
 	return nil
 }
 
-func (x *RunTimeSimulationRequest) GetTimeConfig() *TimeSimulationConfig {
+func (x *OptimizeCapacityExpansionRequest) GetAlgorithm() v1.Algorithm {
 	if x != nil {
-		return x.TimeConfig
+		return x.Algorithm
 	}
-	return nil
+	return v1.Algorithm(0)
 }
 
-func (x *RunTimeSimulationRequest) GetEdgePatterns() []*EdgeTimePattern {
+func (x *OptimizeCapacityExpansionRequest) GetBudget() float64 {
 	if x != nil {
-		return x.EdgePatterns
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *OptimizeCapacityExpansionRequest) GetUpgrades() []*EdgeUpgradeOption {
+	if x != nil {
+		return x.Upgrades
 	}
 	return nil
 }
 
-func (x *RunTimeSimulationRequest) GetNodePatterns() []*NodeTimePattern {
+func (x *OptimizeCapacityExpansionRequest) GetDefaultUpgrade() *EdgeUpgradeOption {
 	if x != nil {
-		return x.NodePatterns
+		return x.DefaultUpgrade
 	}
 	return nil
 }
 
-func (x *RunTimeSimulationRequest) GetAlgorithm() v1.Algorithm {
+func (x *OptimizeCapacityExpansionRequest) GetMaxIterations() int32 {
 	if x != nil {
-		return x.Algorithm
+		return x.MaxIterations
 	}
-	return v1.Algorithm(0)
+	return 0
 }
 
-type TimeSimulationConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Период симуляции
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// Шаг симуляции
-	TimeStep TimeStep `protobuf:"varint,3,opt,name=time_step,json=timeStep,proto3,enum=logistics.simulation.v1.TimeStep" json:"time_step,omitempty"`
-	// Количество шагов (альтернатива end_time)
-	NumSteps      int32 `protobuf:"varint,4,opt,name=num_steps,json=numSteps,proto3" json:"num_steps,omitempty"`
+// Стоимость расширения ребра: fixed_cost при первом приращении плюс
+// cost_per_unit за каждую единицу пропускной способности
+type EdgeUpgradeOption struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Edge        *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	FixedCost   float64                `protobuf:"fixed64,2,opt,name=fixed_cost,json=fixedCost,proto3" json:"fixed_cost,omitempty"`
+	CostPerUnit float64                `protobuf:"fixed64,3,opt,name=cost_per_unit,json=costPerUnit,proto3" json:"cost_per_unit,omitempty"`
+	// Шаг приращения; 0 — исходная пропускная способность ребра (первый шаг
+	// удваивает ребро)
+	Step float64 `protobuf:"fixed64,4,opt,name=step,proto3" json:"step,omitempty"`
+	// Предельное суммарное приращение; 0 — без ограничения
+	MaxIncrease   float64 `protobuf:"fixed64,5,opt,name=max_increase,json=maxIncrease,proto3" json:"max_increase,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeSimulationConfig) Reset() {
-	*x = TimeSimulationConfig{}
+func (x *EdgeUpgradeOption) Reset() {
+	*x = EdgeUpgradeOption{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeSimulationConfig) String() string {
+func (x *EdgeUpgradeOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSimulationConfig) ProtoMessage() {}
+func (*EdgeUpgradeOption) ProtoMessage() {}
 
-func (x *TimeSimulationConfig) ProtoReflect() protoreflect.Message {
+func (x *EdgeUpgradeOption) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,61 +2419,79 @@ func (x *TimeSimulationConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSimulationConfig.ProtoReflect.Descriptor instead.
-func (*TimeSimulationConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use EdgeUpgradeOption.ProtoReflect.Descriptor instead.
+func (*EdgeUpgradeOption) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TimeSimulationConfig) GetStartTime() *timestamppb.Timestamp {
+func (x *EdgeUpgradeOption) GetEdge() *v1.EdgeKey {
 	if x != nil {
-		return x.StartTime
+		return x.Edge
 	}
 	return nil
 }
 
-func (x *TimeSimulationConfig) GetEndTime() *timestamppb.Timestamp {
+func (x *EdgeUpgradeOption) GetFixedCost() float64 {
 	if x != nil {
-		return x.EndTime
+		return x.FixedCost
 	}
-	return nil
+	return 0
 }
 
-func (x *TimeSimulationConfig) GetTimeStep() TimeStep {
+func (x *EdgeUpgradeOption) GetCostPerUnit() float64 {
 	if x != nil {
-		return x.TimeStep
+		return x.CostPerUnit
 	}
-	return TimeStep_TIME_STEP_UNSPECIFIED
+	return 0
 }
 
-func (x *TimeSimulationConfig) GetNumSteps() int32 {
+func (x *EdgeUpgradeOption) GetStep() float64 {
 	if x != nil {
-		return x.NumSteps
+		return x.Step
 	}
 	return 0
 }
 
-type EdgeTimePattern struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Edge          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
-	Pattern       *TimePattern           `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+func (x *EdgeUpgradeOption) GetMaxIncrease() float64 {
+	if x != nil {
+		return x.MaxIncrease
+	}
+	return 0
+}
+
+type OptimizeCapacityExpansionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BaseFlow        float64                `protobuf:"fixed64,2,opt,name=base_flow,json=baseFlow,proto3" json:"base_flow,omitempty"`
+	FinalFlow       float64                `protobuf:"fixed64,3,opt,name=final_flow,json=finalFlow,proto3" json:"final_flow,omitempty"`
+	TotalCost       float64                `protobuf:"fixed64,4,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"` // Потрачено из бюджета
+	RemainingBudget float64                `protobuf:"fixed64,5,opt,name=remaining_budget,json=remainingBudget,proto3" json:"remaining_budget,omitempty"`
+	// Итоговые приращения по рёбрам
+	Increments []*CapacityIncrement `protobuf:"bytes,6,rep,name=increments,proto3" json:"increments,omitempty"`
+	// Выбранные кандидаты по итерациям
+	Steps []*ExpansionStep `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Решённый граф с расширенными рёбрами
+	ExpandedGraph *v1.Graph           `protobuf:"bytes,8,opt,name=expanded_graph,json=expandedGraph,proto3" json:"expanded_graph,omitempty"`
+	StopReason    ExpansionStopReason `protobuf:"varint,9,opt,name=stop_reason,json=stopReason,proto3,enum=logistics.simulation.v1.ExpansionStopReason" json:"stop_reason,omitempty"`
+	Metadata      *SimulationMetadata `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EdgeTimePattern) Reset() {
-	*x = EdgeTimePattern{}
+func (x *OptimizeCapacityExpansionResponse) Reset() {
+	*x = OptimizeCapacityExpansionResponse{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EdgeTimePattern) String() string {
+func (x *OptimizeCapacityExpansionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EdgeTimePattern) ProtoMessage() {}
+func (*OptimizeCapacityExpansionResponse) ProtoMessage() {}
 
-func (x *EdgeTimePattern) ProtoReflect() protoreflect.Message {
+func (x *OptimizeCapacityExpansionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2092,48 +2503,105 @@ func (x *EdgeTimePattern) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EdgeTimePattern.ProtoReflect.Descriptor instead.
-func (*EdgeTimePattern) Descriptor() ([]byte, []int) {
+// Deprecated: Use OptimizeCapacityExpansionResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeCapacityExpansionResponse) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *EdgeTimePattern) GetEdge() *v1.EdgeKey {
+func (x *OptimizeCapacityExpansionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Edge
+		return x.Success
+	}
+	return false
+}
+
+func (x *OptimizeCapacityExpansionResponse) GetBaseFlow() float64 {
+	if x != nil {
+		return x.BaseFlow
+	}
+	return 0
+}
+
+func (x *OptimizeCapacityExpansionResponse) GetFinalFlow() float64 {
+	if x != nil {
+		return x.FinalFlow
+	}
+	return 0
+}
+
+func (x *OptimizeCapacityExpansionResponse) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *OptimizeCapacityExpansionResponse) GetRemainingBudget() float64 {
+	if x != nil {
+		return x.RemainingBudget
+	}
+	return 0
+}
+
+func (x *OptimizeCapacityExpansionResponse) GetIncrements() []*CapacityIncrement {
+	if x != nil {
+		return x.Increments
 	}
 	return nil
 }
 
-func (x *EdgeTimePattern) GetPattern() *TimePattern {
+func (x *OptimizeCapacityExpansionResponse) GetSteps() []*ExpansionStep {
 	if x != nil {
-		return x.Pattern
+		return x.Steps
 	}
 	return nil
 }
 
-type NodeTimePattern struct {
+func (x *OptimizeCapacityExpansionResponse) GetExpandedGraph() *v1.Graph {
+	if x != nil {
+		return x.ExpandedGraph
+	}
+	return nil
+}
+
+func (x *OptimizeCapacityExpansionResponse) GetStopReason() ExpansionStopReason {
+	if x != nil {
+		return x.StopReason
+	}
+	return ExpansionStopReason_EXPANSION_STOP_REASON_UNSPECIFIED
+}
+
+func (x *OptimizeCapacityExpansionResponse) GetMetadata() *SimulationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CapacityIncrement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Pattern       *TimePattern           `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Target        PatternTarget          `protobuf:"varint,3,opt,name=target,proto3,enum=logistics.simulation.v1.PatternTarget" json:"target,omitempty"` // supply или demand
+	Edge          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	AddedCapacity float64                `protobuf:"fixed64,2,opt,name=added_capacity,json=addedCapacity,proto3" json:"added_capacity,omitempty"`
+	NewCapacity   float64                `protobuf:"fixed64,3,opt,name=new_capacity,json=newCapacity,proto3" json:"new_capacity,omitempty"`
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeTimePattern) Reset() {
-	*x = NodeTimePattern{}
+func (x *CapacityIncrement) Reset() {
+	*x = CapacityIncrement{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeTimePattern) String() string {
+func (x *CapacityIncrement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeTimePattern) ProtoMessage() {}
+func (*CapacityIncrement) ProtoMessage() {}
 
-func (x *NodeTimePattern) ProtoReflect() protoreflect.Message {
+func (x *CapacityIncrement) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2145,63 +2613,65 @@ func (x *NodeTimePattern) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeTimePattern.ProtoReflect.Descriptor instead.
-func (*NodeTimePattern) Descriptor() ([]byte, []int) {
+// Deprecated: Use CapacityIncrement.ProtoReflect.Descriptor instead.
+func (*CapacityIncrement) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *NodeTimePattern) GetNodeId() int64 {
+func (x *CapacityIncrement) GetEdge() *v1.EdgeKey {
 	if x != nil {
-		return x.NodeId
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *CapacityIncrement) GetAddedCapacity() float64 {
+	if x != nil {
+		return x.AddedCapacity
 	}
 	return 0
 }
 
-func (x *NodeTimePattern) GetPattern() *TimePattern {
+func (x *CapacityIncrement) GetNewCapacity() float64 {
 	if x != nil {
-		return x.Pattern
+		return x.NewCapacity
 	}
-	return nil
+	return 0
 }
 
-func (x *NodeTimePattern) GetTarget() PatternTarget {
+func (x *CapacityIncrement) GetCost() float64 {
 	if x != nil {
-		return x.Target
+		return x.Cost
 	}
-	return PatternTarget_PATTERN_TARGET_UNSPECIFIED
+	return 0
 }
 
-type TimePattern struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Type  PatternType            `protobuf:"varint,1,opt,name=type,proto3,enum=logistics.simulation.v1.PatternType" json:"type,omitempty"`
-	// Для периодических паттернов
-	HourlyMultipliers []float64 `protobuf:"fixed64,2,rep,packed,name=hourly_multipliers,json=hourlyMultipliers,proto3" json:"hourly_multipliers,omitempty"` // 24 значения для часов
-	DailyMultipliers  []float64 `protobuf:"fixed64,3,rep,packed,name=daily_multipliers,json=dailyMultipliers,proto3" json:"daily_multipliers,omitempty"`    // 7 значений для дней недели
-	// Для кастомных паттернов
-	CustomPoints []*TimePoint `protobuf:"bytes,4,rep,name=custom_points,json=customPoints,proto3" json:"custom_points,omitempty"`
-	// Для стохастических паттернов
-	Mean          float64 `protobuf:"fixed64,5,opt,name=mean,proto3" json:"mean,omitempty"`
-	StdDev        float64 `protobuf:"fixed64,6,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
-	MinValue      float64 `protobuf:"fixed64,7,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
-	MaxValue      float64 `protobuf:"fixed64,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+type ExpansionStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iteration     int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Increments    []*CapacityIncrement   `protobuf:"bytes,2,rep,name=increments,proto3" json:"increments,omitempty"` // Приращения шага
+	Cost          float64                `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Flow          float64                `protobuf:"fixed64,4,opt,name=flow,proto3" json:"flow,omitempty"` // Поток после шага
+	FlowGain      float64                `protobuf:"fixed64,5,opt,name=flow_gain,json=flowGain,proto3" json:"flow_gain,omitempty"`
+	WholeCut      bool                   `protobuf:"varint,6,opt,name=whole_cut,json=wholeCut,proto3" json:"whole_cut,omitempty"` // Расширен весь минимальный разрез
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimePattern) Reset() {
-	*x = TimePattern{}
+func (x *ExpansionStep) Reset() {
+	*x = ExpansionStep{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimePattern) String() string {
+func (x *ExpansionStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimePattern) ProtoMessage() {}
+func (*ExpansionStep) ProtoMessage() {}
 
-func (x *TimePattern) ProtoReflect() protoreflect.Message {
+func (x *ExpansionStep) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2213,89 +2683,90 @@ func (x *TimePattern) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimePattern.ProtoReflect.Descriptor instead.
-func (*TimePattern) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpansionStep.ProtoReflect.Descriptor instead.
+func (*ExpansionStep) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *TimePattern) GetType() PatternType {
-	if x != nil {
-		return x.Type
-	}
-	return PatternType_PATTERN_TYPE_UNSPECIFIED
-}
-
-func (x *TimePattern) GetHourlyMultipliers() []float64 {
-	if x != nil {
-		return x.HourlyMultipliers
-	}
-	return nil
-}
-
-func (x *TimePattern) GetDailyMultipliers() []float64 {
+func (x *ExpansionStep) GetIteration() int32 {
 	if x != nil {
-		return x.DailyMultipliers
+		return x.Iteration
 	}
-	return nil
+	return 0
 }
 
-func (x *TimePattern) GetCustomPoints() []*TimePoint {
+func (x *ExpansionStep) GetIncrements() []*CapacityIncrement {
 	if x != nil {
-		return x.CustomPoints
+		return x.Increments
 	}
 	return nil
 }
 
-func (x *TimePattern) GetMean() float64 {
+func (x *ExpansionStep) GetCost() float64 {
 	if x != nil {
-		return x.Mean
+		return x.Cost
 	}
 	return 0
 }
 
-func (x *TimePattern) GetStdDev() float64 {
+func (x *ExpansionStep) GetFlow() float64 {
 	if x != nil {
-		return x.StdDev
+		return x.Flow
 	}
 	return 0
 }
 
-func (x *TimePattern) GetMinValue() float64 {
+func (x *ExpansionStep) GetFlowGain() float64 {
 	if x != nil {
-		return x.MinValue
+		return x.FlowGain
 	}
 	return 0
 }
 
-func (x *TimePattern) GetMaxValue() float64 {
+func (x *ExpansionStep) GetWholeCut() bool {
 	if x != nil {
-		return x.MaxValue
+		return x.WholeCut
 	}
-	return 0
+	return false
 }
 
-type TimePoint struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Step          int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`              // Номер шага
-	Multiplier    float64                `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // Множитель к базовому значению
+// Ёмкостная задача размещения складов. Кандидаты — узлы NODE_TYPE_WAREHOUSE,
+// их supply — мощность склада (0 — без ограничения), demand узлов
+// NODE_TYPE_DELIVERY_POINT — спрос. Для набора открытых складов транспортная
+// стоимость — стоимость потока минимальной стоимости (ALGORITHM_MIN_COST), в
+// котором supply закрытых складов обнулён. Локальный поиск начинает с
+// initial_open и на каждой итерации выполняет лучший из ходов «открыть»,
+// «закрыть» и «заменить», пока ход уменьшает суммарную стоимость
+type OptimizeFacilityLocationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	// Стоимость открытия склада: warehouse_costs по ID узла, иначе
+	// warehouse_cost; остальные поля не используются
+	FixedCosts *v11.FixedCostConfig `protobuf:"bytes,2,opt,name=fixed_costs,json=fixedCosts,proto3" json:"fixed_costs,omitempty"`
+	// Штраф за единицу неудовлетворённого спроса; 0 — решения сравниваются
+	// сначала по обслуженному спросу, затем по стоимости
+	UnmetDemandPenalty float64 `protobuf:"fixed64,3,opt,name=unmet_demand_penalty,json=unmetDemandPenalty,proto3" json:"unmet_demand_penalty,omitempty"`
+	// Склады, открытые в начале поиска; пусто — все кандидаты
+	InitialOpen   []int64 `protobuf:"varint,4,rep,packed,name=initial_open,json=initialOpen,proto3" json:"initial_open,omitempty"`
+	MaxIterations int32   `protobuf:"varint,5,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"` // По умолчанию 100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimePoint) Reset() {
-	*x = TimePoint{}
+func (x *OptimizeFacilityLocationRequest) Reset() {
+	*x = OptimizeFacilityLocationRequest{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimePoint) String() string {
+func (x *OptimizeFacilityLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimePoint) ProtoMessage() {}
+func (*OptimizeFacilityLocationRequest) ProtoMessage() {}
 
-func (x *TimePoint) ProtoReflect() protoreflect.Message {
+func (x *OptimizeFacilityLocationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2307,53 +2778,87 @@ func (x *TimePoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimePoint.ProtoReflect.Descriptor instead.
-func (*TimePoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use OptimizeFacilityLocationRequest.ProtoReflect.Descriptor instead.
+func (*OptimizeFacilityLocationRequest) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TimePoint) GetStep() int32 {
+func (x *OptimizeFacilityLocationRequest) GetGraph() *v1.Graph {
 	if x != nil {
-		return x.Step
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *OptimizeFacilityLocationRequest) GetFixedCosts() *v11.FixedCostConfig {
+	if x != nil {
+		return x.FixedCosts
+	}
+	return nil
+}
+
+func (x *OptimizeFacilityLocationRequest) GetUnmetDemandPenalty() float64 {
+	if x != nil {
+		return x.UnmetDemandPenalty
 	}
 	return 0
 }
 
-func (x *TimePoint) GetMultiplier() float64 {
+func (x *OptimizeFacilityLocationRequest) GetInitialOpen() []int64 {
 	if x != nil {
-		return x.Multiplier
+		return x.InitialOpen
+	}
+	return nil
+}
+
+func (x *OptimizeFacilityLocationRequest) GetMaxIterations() int32 {
+	if x != nil {
+		return x.MaxIterations
 	}
 	return 0
 }
 
-type RunTimeSimulationResponse struct {
+type OptimizeFacilityLocationResponse struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Результаты по временным шагам
-	StepResults []*TimeStepResult `protobuf:"bytes,2,rep,name=step_results,json=stepResults,proto3" json:"step_results,omitempty"`
-	// Агрегированная статистика
-	Stats *TimeSimulationStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
-	// Критические периоды
-	CriticalPeriods []*CriticalPeriod   `protobuf:"bytes,4,rep,name=critical_periods,json=criticalPeriods,proto3" json:"critical_periods,omitempty"`
-	Metadata        *SimulationMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Решение по каждому кандидату в порядке узлов графа
+	Facilities       []*FacilityDecision `protobuf:"bytes,2,rep,name=facilities,proto3" json:"facilities,omitempty"`
+	OpenWarehouses   []int64             `protobuf:"varint,3,rep,packed,name=open_warehouses,json=openWarehouses,proto3" json:"open_warehouses,omitempty"`
+	ClosedWarehouses []int64             `protobuf:"varint,4,rep,packed,name=closed_warehouses,json=closedWarehouses,proto3" json:"closed_warehouses,omitempty"`
+	TotalCost        float64             `protobuf:"fixed64,5,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"` // fixed_cost + transport_cost + штраф
+	FixedCost        float64             `protobuf:"fixed64,6,opt,name=fixed_cost,json=fixedCost,proto3" json:"fixed_cost,omitempty"`
+	TransportCost    float64             `protobuf:"fixed64,7,opt,name=transport_cost,json=transportCost,proto3" json:"transport_cost,omitempty"`
+	UnmetDemandCost  float64             `protobuf:"fixed64,8,opt,name=unmet_demand_cost,json=unmetDemandCost,proto3" json:"unmet_demand_cost,omitempty"` // Штраф за неудовлетворённый спрос
+	TotalDemand      float64             `protobuf:"fixed64,9,opt,name=total_demand,json=totalDemand,proto3" json:"total_demand,omitempty"`
+	ServedDemand     float64             `protobuf:"fixed64,10,opt,name=served_demand,json=servedDemand,proto3" json:"served_demand,omitempty"`
+	InitialTotalCost float64             `protobuf:"fixed64,11,opt,name=initial_total_cost,json=initialTotalCost,proto3" json:"initial_total_cost,omitempty"` // Стоимость набора initial_open
+	// Выполненные ходы локального поиска
+	Moves []*FacilityMove `protobuf:"bytes,12,rep,name=moves,proto3" json:"moves,omitempty"`
+	// По рекомендации на ход: замена склада — перенос (affected_node —
+	// открываемый склад); estimated_improvement — доля стоимости до хода,
+	// которую он экономит
+	Recommendations []*ResilienceRecommendation `protobuf:"bytes,13,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	// Решённый граф для выбранного набора складов
+	SolvedGraph   *v1.Graph           `protobuf:"bytes,14,opt,name=solved_graph,json=solvedGraph,proto3" json:"solved_graph,omitempty"`
+	Metadata      *SimulationMetadata `protobuf:"bytes,15,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RunTimeSimulationResponse) Reset() {
-	*x = RunTimeSimulationResponse{}
+func (x *OptimizeFacilityLocationResponse) Reset() {
+	*x = OptimizeFacilityLocationResponse{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunTimeSimulationResponse) String() string {
+func (x *OptimizeFacilityLocationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunTimeSimulationResponse) ProtoMessage() {}
+func (*OptimizeFacilityLocationResponse) ProtoMessage() {}
 
-func (x *RunTimeSimulationResponse) ProtoReflect() protoreflect.Message {
+func (x *OptimizeFacilityLocationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2365,168 +2870,145 @@ func (x *RunTimeSimulationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunTimeSimulationResponse.ProtoReflect.Descriptor instead.
-func (*RunTimeSimulationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OptimizeFacilityLocationResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeFacilityLocationResponse) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RunTimeSimulationResponse) GetSuccess() bool {
+func (x *OptimizeFacilityLocationResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RunTimeSimulationResponse) GetStepResults() []*TimeStepResult {
+func (x *OptimizeFacilityLocationResponse) GetFacilities() []*FacilityDecision {
 	if x != nil {
-		return x.StepResults
+		return x.Facilities
 	}
 	return nil
 }
 
-func (x *RunTimeSimulationResponse) GetStats() *TimeSimulationStats {
+func (x *OptimizeFacilityLocationResponse) GetOpenWarehouses() []int64 {
 	if x != nil {
-		return x.Stats
+		return x.OpenWarehouses
 	}
 	return nil
 }
 
-func (x *RunTimeSimulationResponse) GetCriticalPeriods() []*CriticalPeriod {
+func (x *OptimizeFacilityLocationResponse) GetClosedWarehouses() []int64 {
 	if x != nil {
-		return x.CriticalPeriods
+		return x.ClosedWarehouses
 	}
 	return nil
 }
 
-func (x *RunTimeSimulationResponse) GetMetadata() *SimulationMetadata {
+func (x *OptimizeFacilityLocationResponse) GetTotalCost() float64 {
 	if x != nil {
-		return x.Metadata
+		return x.TotalCost
 	}
-	return nil
-}
-
-type TimeStepResult struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Step               int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
-	Timestamp          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	MaxFlow            float64                `protobuf:"fixed64,3,opt,name=max_flow,json=maxFlow,proto3" json:"max_flow,omitempty"`
-	TotalCost          float64                `protobuf:"fixed64,4,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
-	AverageUtilization float64                `protobuf:"fixed64,5,opt,name=average_utilization,json=averageUtilization,proto3" json:"average_utilization,omitempty"`
-	SaturatedEdges     int32                  `protobuf:"varint,6,opt,name=saturated_edges,json=saturatedEdges,proto3" json:"saturated_edges,omitempty"`
-	Bottlenecks        []*v1.EdgeKey          `protobuf:"bytes,7,rep,name=bottlenecks,proto3" json:"bottlenecks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *TimeStepResult) Reset() {
-	*x = TimeStepResult{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *TimeStepResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OptimizeFacilityLocationResponse) GetFixedCost() float64 {
+	if x != nil {
+		return x.FixedCost
+	}
+	return 0
 }
 
-func (*TimeStepResult) ProtoMessage() {}
-
-func (x *TimeStepResult) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[19]
+func (x *OptimizeFacilityLocationResponse) GetTransportCost() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TransportCost
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TimeStepResult.ProtoReflect.Descriptor instead.
-func (*TimeStepResult) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{19}
+func (x *OptimizeFacilityLocationResponse) GetUnmetDemandCost() float64 {
+	if x != nil {
+		return x.UnmetDemandCost
+	}
+	return 0
 }
 
-func (x *TimeStepResult) GetStep() int32 {
+func (x *OptimizeFacilityLocationResponse) GetTotalDemand() float64 {
 	if x != nil {
-		return x.Step
+		return x.TotalDemand
 	}
 	return 0
 }
 
-func (x *TimeStepResult) GetTimestamp() *timestamppb.Timestamp {
+func (x *OptimizeFacilityLocationResponse) GetServedDemand() float64 {
 	if x != nil {
-		return x.Timestamp
+		return x.ServedDemand
 	}
-	return nil
+	return 0
 }
 
-func (x *TimeStepResult) GetMaxFlow() float64 {
+func (x *OptimizeFacilityLocationResponse) GetInitialTotalCost() float64 {
 	if x != nil {
-		return x.MaxFlow
+		return x.InitialTotalCost
 	}
 	return 0
 }
 
-func (x *TimeStepResult) GetTotalCost() float64 {
+func (x *OptimizeFacilityLocationResponse) GetMoves() []*FacilityMove {
 	if x != nil {
-		return x.TotalCost
+		return x.Moves
 	}
-	return 0
+	return nil
 }
 
-func (x *TimeStepResult) GetAverageUtilization() float64 {
+func (x *OptimizeFacilityLocationResponse) GetRecommendations() []*ResilienceRecommendation {
 	if x != nil {
-		return x.AverageUtilization
+		return x.Recommendations
 	}
-	return 0
+	return nil
 }
 
-func (x *TimeStepResult) GetSaturatedEdges() int32 {
+func (x *OptimizeFacilityLocationResponse) GetSolvedGraph() *v1.Graph {
 	if x != nil {
-		return x.SaturatedEdges
+		return x.SolvedGraph
 	}
-	return 0
+	return nil
 }
 
-func (x *TimeStepResult) GetBottlenecks() []*v1.EdgeKey {
+func (x *OptimizeFacilityLocationResponse) GetMetadata() *SimulationMetadata {
 	if x != nil {
-		return x.Bottlenecks
+		return x.Metadata
 	}
 	return nil
 }
 
-type TimeSimulationStats struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	MinFlow              float64                `protobuf:"fixed64,1,opt,name=min_flow,json=minFlow,proto3" json:"min_flow,omitempty"`
-	MaxFlow              float64                `protobuf:"fixed64,2,opt,name=max_flow,json=maxFlow,proto3" json:"max_flow,omitempty"`
-	AvgFlow              float64                `protobuf:"fixed64,3,opt,name=avg_flow,json=avgFlow,proto3" json:"avg_flow,omitempty"`
-	StdDevFlow           float64                `protobuf:"fixed64,4,opt,name=std_dev_flow,json=stdDevFlow,proto3" json:"std_dev_flow,omitempty"`
-	MinCost              float64                `protobuf:"fixed64,5,opt,name=min_cost,json=minCost,proto3" json:"min_cost,omitempty"`
-	MaxCost              float64                `protobuf:"fixed64,6,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
-	AvgCost              float64                `protobuf:"fixed64,7,opt,name=avg_cost,json=avgCost,proto3" json:"avg_cost,omitempty"`
-	TotalSteps           int32                  `protobuf:"varint,8,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
-	StepsWithBottlenecks int32                  `protobuf:"varint,9,opt,name=steps_with_bottlenecks,json=stepsWithBottlenecks,proto3" json:"steps_with_bottlenecks,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type FacilityDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Open          bool                   `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	InitiallyOpen bool                   `protobuf:"varint,4,opt,name=initially_open,json=initiallyOpen,proto3" json:"initially_open,omitempty"`
+	OpeningCost   float64                `protobuf:"fixed64,5,opt,name=opening_cost,json=openingCost,proto3" json:"opening_cost,omitempty"`
+	Capacity      float64                `protobuf:"fixed64,6,opt,name=capacity,proto3" json:"capacity,omitempty"`       // 0 — без ограничения
+	Shipped       float64                `protobuf:"fixed64,7,opt,name=shipped,proto3" json:"shipped,omitempty"`         // Отгружено со склада
+	Utilization   float64                `protobuf:"fixed64,8,opt,name=utilization,proto3" json:"utilization,omitempty"` // shipped / capacity; 0 без ограничения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeSimulationStats) Reset() {
-	*x = TimeSimulationStats{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[20]
+func (x *FacilityDecision) Reset() {
+	*x = FacilityDecision{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeSimulationStats) String() string {
+func (x *FacilityDecision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSimulationStats) ProtoMessage() {}
+func (*FacilityDecision) ProtoMessage() {}
 
-func (x *TimeSimulationStats) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[20]
+func (x *FacilityDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,102 +3019,94 @@ func (x *TimeSimulationStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSimulationStats.ProtoReflect.Descriptor instead.
-func (*TimeSimulationStats) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *TimeSimulationStats) GetMinFlow() float64 {
-	if x != nil {
-		return x.MinFlow
-	}
-	return 0
+// Deprecated: Use FacilityDecision.ProtoReflect.Descriptor instead.
+func (*FacilityDecision) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TimeSimulationStats) GetMaxFlow() float64 {
+func (x *FacilityDecision) GetNodeId() int64 {
 	if x != nil {
-		return x.MaxFlow
+		return x.NodeId
 	}
 	return 0
 }
 
-func (x *TimeSimulationStats) GetAvgFlow() float64 {
+func (x *FacilityDecision) GetName() string {
 	if x != nil {
-		return x.AvgFlow
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *TimeSimulationStats) GetStdDevFlow() float64 {
+func (x *FacilityDecision) GetOpen() bool {
 	if x != nil {
-		return x.StdDevFlow
+		return x.Open
 	}
-	return 0
+	return false
 }
 
-func (x *TimeSimulationStats) GetMinCost() float64 {
+func (x *FacilityDecision) GetInitiallyOpen() bool {
 	if x != nil {
-		return x.MinCost
+		return x.InitiallyOpen
 	}
-	return 0
+	return false
 }
 
-func (x *TimeSimulationStats) GetMaxCost() float64 {
+func (x *FacilityDecision) GetOpeningCost() float64 {
 	if x != nil {
-		return x.MaxCost
+		return x.OpeningCost
 	}
 	return 0
 }
 
-func (x *TimeSimulationStats) GetAvgCost() float64 {
+func (x *FacilityDecision) GetCapacity() float64 {
 	if x != nil {
-		return x.AvgCost
+		return x.Capacity
 	}
 	return 0
 }
 
-func (x *TimeSimulationStats) GetTotalSteps() int32 {
+func (x *FacilityDecision) GetShipped() float64 {
 	if x != nil {
-		return x.TotalSteps
+		return x.Shipped
 	}
 	return 0
 }
 
-func (x *TimeSimulationStats) GetStepsWithBottlenecks() int32 {
+func (x *FacilityDecision) GetUtilization() float64 {
 	if x != nil {
-		return x.StepsWithBottlenecks
+		return x.Utilization
 	}
 	return 0
 }
 
-type CriticalPeriod struct {
+type FacilityMove struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartStep     int32                  `protobuf:"varint,1,opt,name=start_step,json=startStep,proto3" json:"start_step,omitempty"`
-	EndStep       int32                  `protobuf:"varint,2,opt,name=end_step,json=endStep,proto3" json:"end_step,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Type          CriticalPeriodType     `protobuf:"varint,5,opt,name=type,proto3,enum=logistics.simulation.v1.CriticalPeriodType" json:"type,omitempty"`
-	Severity      float64                `protobuf:"fixed64,6,opt,name=severity,proto3" json:"severity,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Iteration     int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Type          FacilityMoveType       `protobuf:"varint,2,opt,name=type,proto3,enum=logistics.simulation.v1.FacilityMoveType" json:"type,omitempty"`
+	OpenedNode    int64                  `protobuf:"varint,3,opt,name=opened_node,json=openedNode,proto3" json:"opened_node,omitempty"` // 0 для CLOSE
+	ClosedNode    int64                  `protobuf:"varint,4,opt,name=closed_node,json=closedNode,proto3" json:"closed_node,omitempty"` // 0 для OPEN
+	TotalCost     float64                `protobuf:"fixed64,5,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`   // Стоимость после хода
+	Saving        float64                `protobuf:"fixed64,6,opt,name=saving,proto3" json:"saving,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CriticalPeriod) Reset() {
-	*x = CriticalPeriod{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[21]
+func (x *FacilityMove) Reset() {
+	*x = FacilityMove{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CriticalPeriod) String() string {
+func (x *FacilityMove) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CriticalPeriod) ProtoMessage() {}
+func (*FacilityMove) ProtoMessage() {}
 
-func (x *CriticalPeriod) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[21]
+func (x *FacilityMove) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2643,90 +3117,82 @@ func (x *CriticalPeriod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CriticalPeriod.ProtoReflect.Descriptor instead.
-func (*CriticalPeriod) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *CriticalPeriod) GetStartStep() int32 {
-	if x != nil {
-		return x.StartStep
-	}
-	return 0
+// Deprecated: Use FacilityMove.ProtoReflect.Descriptor instead.
+func (*FacilityMove) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CriticalPeriod) GetEndStep() int32 {
+func (x *FacilityMove) GetIteration() int32 {
 	if x != nil {
-		return x.EndStep
+		return x.Iteration
 	}
 	return 0
 }
 
-func (x *CriticalPeriod) GetStartTime() *timestamppb.Timestamp {
+func (x *FacilityMove) GetType() FacilityMoveType {
 	if x != nil {
-		return x.StartTime
+		return x.Type
 	}
-	return nil
+	return FacilityMoveType_FACILITY_MOVE_TYPE_UNSPECIFIED
 }
 
-func (x *CriticalPeriod) GetEndTime() *timestamppb.Timestamp {
+func (x *FacilityMove) GetOpenedNode() int64 {
 	if x != nil {
-		return x.EndTime
+		return x.OpenedNode
 	}
-	return nil
+	return 0
 }
 
-func (x *CriticalPeriod) GetType() CriticalPeriodType {
+func (x *FacilityMove) GetClosedNode() int64 {
 	if x != nil {
-		return x.Type
+		return x.ClosedNode
 	}
-	return CriticalPeriodType_CRITICAL_PERIOD_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *CriticalPeriod) GetSeverity() float64 {
+func (x *FacilityMove) GetTotalCost() float64 {
 	if x != nil {
-		return x.Severity
+		return x.TotalCost
 	}
 	return 0
 }
 
-func (x *CriticalPeriod) GetDescription() string {
+func (x *FacilityMove) GetSaving() float64 {
 	if x != nil {
-		return x.Description
+		return x.Saving
 	}
-	return ""
+	return 0
 }
 
-type SimulatePeakLoadRequest struct {
+type RunTimeSimulationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
-	// Множители для пиковой нагрузки
-	DemandMultiplier  float64 `protobuf:"fixed64,2,opt,name=demand_multiplier,json=demandMultiplier,proto3" json:"demand_multiplier,omitempty"`    // 1.5 = +50% demand
-	CapacityReduction float64 `protobuf:"fixed64,3,opt,name=capacity_reduction,json=capacityReduction,proto3" json:"capacity_reduction,omitempty"` // 0.8 = -20% capacity
-	// Какие узлы затрагивает пик
-	AffectedNodes []int64 `protobuf:"varint,4,rep,packed,name=affected_nodes,json=affectedNodes,proto3" json:"affected_nodes,omitempty"` // Пустой = все
-	// Какие рёбра затрагивает
-	AffectedEdges []*v1.EdgeKey `protobuf:"bytes,5,rep,name=affected_edges,json=affectedEdges,proto3" json:"affected_edges,omitempty"`
-	Algorithm     v1.Algorithm  `protobuf:"varint,6,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	// Временные параметры
+	TimeConfig *TimeSimulationConfig `protobuf:"bytes,2,opt,name=time_config,json=timeConfig,proto3" json:"time_config,omitempty"`
+	// Временные паттерны для рёбер
+	EdgePatterns []*EdgeTimePattern `protobuf:"bytes,3,rep,name=edge_patterns,json=edgePatterns,proto3" json:"edge_patterns,omitempty"`
+	// Временные паттерны для узлов (demand)
+	NodePatterns  []*NodeTimePattern `protobuf:"bytes,4,rep,name=node_patterns,json=nodePatterns,proto3" json:"node_patterns,omitempty"`
+	Algorithm     v1.Algorithm       `protobuf:"varint,5,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulatePeakLoadRequest) Reset() {
-	*x = SimulatePeakLoadRequest{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[22]
+func (x *RunTimeSimulationRequest) Reset() {
+	*x = RunTimeSimulationRequest{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulatePeakLoadRequest) String() string {
+func (x *RunTimeSimulationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulatePeakLoadRequest) ProtoMessage() {}
+func (*RunTimeSimulationRequest) ProtoMessage() {}
 
-func (x *SimulatePeakLoadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[22]
+func (x *RunTimeSimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2737,83 +3203,86 @@ func (x *SimulatePeakLoadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulatePeakLoadRequest.ProtoReflect.Descriptor instead.
-func (*SimulatePeakLoadRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use RunTimeSimulationRequest.ProtoReflect.Descriptor instead.
+func (*RunTimeSimulationRequest) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SimulatePeakLoadRequest) GetGraph() *v1.Graph {
+func (x *RunTimeSimulationRequest) GetGraph() *v1.Graph {
 	if x != nil {
 		return x.Graph
 	}
 	return nil
 }
 
-func (x *SimulatePeakLoadRequest) GetDemandMultiplier() float64 {
-	if x != nil {
-		return x.DemandMultiplier
-	}
-	return 0
-}
-
-func (x *SimulatePeakLoadRequest) GetCapacityReduction() float64 {
+func (x *RunTimeSimulationRequest) GetTimeConfig() *TimeSimulationConfig {
 	if x != nil {
-		return x.CapacityReduction
+		return x.TimeConfig
 	}
-	return 0
+	return nil
 }
 
-func (x *SimulatePeakLoadRequest) GetAffectedNodes() []int64 {
+func (x *RunTimeSimulationRequest) GetEdgePatterns() []*EdgeTimePattern {
 	if x != nil {
-		return x.AffectedNodes
+		return x.EdgePatterns
 	}
 	return nil
 }
 
-func (x *SimulatePeakLoadRequest) GetAffectedEdges() []*v1.EdgeKey {
+func (x *RunTimeSimulationRequest) GetNodePatterns() []*NodeTimePattern {
 	if x != nil {
-		return x.AffectedEdges
+		return x.NodePatterns
 	}
 	return nil
 }
 
-func (x *SimulatePeakLoadRequest) GetAlgorithm() v1.Algorithm {
+func (x *RunTimeSimulationRequest) GetAlgorithm() v1.Algorithm {
 	if x != nil {
 		return x.Algorithm
 	}
 	return v1.Algorithm(0)
 }
 
-type SimulatePeakLoadResponse struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	Success      bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	NormalResult *ScenarioResult        `protobuf:"bytes,2,opt,name=normal_result,json=normalResult,proto3" json:"normal_result,omitempty"`
-	PeakResult   *ScenarioResult        `protobuf:"bytes,3,opt,name=peak_result,json=peakResult,proto3" json:"peak_result,omitempty"`
-	Comparison   *ScenarioComparison    `protobuf:"bytes,4,opt,name=comparison,proto3" json:"comparison,omitempty"`
-	// Рёбра, которые не справились
-	OverloadedEdges []*OverloadedEdge `protobuf:"bytes,5,rep,name=overloaded_edges,json=overloadedEdges,proto3" json:"overloaded_edges,omitempty"`
-	// Рекомендации
-	Recommendations []string            `protobuf:"bytes,6,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
-	Metadata        *SimulationMetadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type TimeSimulationConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Период симуляции
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Шаг симуляции
+	TimeStep TimeStep `protobuf:"varint,3,opt,name=time_step,json=timeStep,proto3,enum=logistics.simulation.v1.TimeStep" json:"time_step,omitempty"`
+	// Количество шагов (альтернатива end_time)
+	NumSteps int32 `protobuf:"varint,4,opt,name=num_steps,json=numSteps,proto3" json:"num_steps,omitempty"`
+	// Режим: независимый статический поток на каждом шаге или динамический
+	// поток в сети, развёрнутой во времени
+	Mode TimeSimulationMode `protobuf:"varint,5,opt,name=mode,proto3,enum=logistics.simulation.v1.TimeSimulationMode" json:"mode,omitempty"`
+	// Скорости движения по типам дорог (единиц Edge.length в час) для расчёта
+	// времени в пути в динамическом режиме. Не заданные типы — скорости по
+	// умолчанию: HIGHWAY 90, PRIMARY 60, SECONDARY 50, LOCAL 40, URBAN 30,
+	// UNSPECIFIED 50
+	RoadSpeeds []*RoadTypeSpeed `protobuf:"bytes,6,rep,name=road_speeds,json=roadSpeeds,proto3" json:"road_speeds,omitempty"`
+	// Перенос состояния между шагами (режим STATIC): невыполненный спрос узла
+	// (demand > 0) переходит в backlog следующего шага, неотгруженное
+	// предложение (supply > 0) — в запас узла
+	CarryOver     bool `protobuf:"varint,7,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulatePeakLoadResponse) Reset() {
-	*x = SimulatePeakLoadResponse{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[23]
+func (x *TimeSimulationConfig) Reset() {
+	*x = TimeSimulationConfig{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulatePeakLoadResponse) String() string {
+func (x *TimeSimulationConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulatePeakLoadResponse) ProtoMessage() {}
+func (*TimeSimulationConfig) ProtoMessage() {}
 
-func (x *SimulatePeakLoadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[23]
+func (x *TimeSimulationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2824,86 +3293,83 @@ func (x *SimulatePeakLoadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulatePeakLoadResponse.ProtoReflect.Descriptor instead.
-func (*SimulatePeakLoadResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use TimeSimulationConfig.ProtoReflect.Descriptor instead.
+func (*TimeSimulationConfig) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SimulatePeakLoadResponse) GetSuccess() bool {
+func (x *TimeSimulationConfig) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.StartTime
 	}
-	return false
+	return nil
 }
 
-func (x *SimulatePeakLoadResponse) GetNormalResult() *ScenarioResult {
+func (x *TimeSimulationConfig) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NormalResult
+		return x.EndTime
 	}
 	return nil
 }
 
-func (x *SimulatePeakLoadResponse) GetPeakResult() *ScenarioResult {
+func (x *TimeSimulationConfig) GetTimeStep() TimeStep {
 	if x != nil {
-		return x.PeakResult
+		return x.TimeStep
 	}
-	return nil
+	return TimeStep_TIME_STEP_UNSPECIFIED
 }
 
-func (x *SimulatePeakLoadResponse) GetComparison() *ScenarioComparison {
+func (x *TimeSimulationConfig) GetNumSteps() int32 {
 	if x != nil {
-		return x.Comparison
+		return x.NumSteps
 	}
-	return nil
+	return 0
 }
 
-func (x *SimulatePeakLoadResponse) GetOverloadedEdges() []*OverloadedEdge {
+func (x *TimeSimulationConfig) GetMode() TimeSimulationMode {
 	if x != nil {
-		return x.OverloadedEdges
+		return x.Mode
 	}
-	return nil
+	return TimeSimulationMode_TIME_SIMULATION_MODE_UNSPECIFIED
 }
 
-func (x *SimulatePeakLoadResponse) GetRecommendations() []string {
+func (x *TimeSimulationConfig) GetRoadSpeeds() []*RoadTypeSpeed {
 	if x != nil {
-		return x.Recommendations
+		return x.RoadSpeeds
 	}
 	return nil
 }
 
-func (x *SimulatePeakLoadResponse) GetMetadata() *SimulationMetadata {
+func (x *TimeSimulationConfig) GetCarryOver() bool {
 	if x != nil {
-		return x.Metadata
+		return x.CarryOver
 	}
-	return nil
+	return false
 }
 
-type OverloadedEdge struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Edge              *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
-	RequiredCapacity  float64                `protobuf:"fixed64,2,opt,name=required_capacity,json=requiredCapacity,proto3" json:"required_capacity,omitempty"`
-	AvailableCapacity float64                `protobuf:"fixed64,3,opt,name=available_capacity,json=availableCapacity,proto3" json:"available_capacity,omitempty"`
-	Shortage          float64                `protobuf:"fixed64,4,opt,name=shortage,proto3" json:"shortage,omitempty"`
-	ShortagePercent   float64                `protobuf:"fixed64,5,opt,name=shortage_percent,json=shortagePercent,proto3" json:"shortage_percent,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type RoadTypeSpeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoadType      v1.RoadType            `protobuf:"varint,1,opt,name=road_type,json=roadType,proto3,enum=logistics.common.v1.RoadType" json:"road_type,omitempty"`
+	Speed         float64                `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"` // Единиц длины в час, > 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OverloadedEdge) Reset() {
-	*x = OverloadedEdge{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[24]
+func (x *RoadTypeSpeed) Reset() {
+	*x = RoadTypeSpeed{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OverloadedEdge) String() string {
+func (x *RoadTypeSpeed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OverloadedEdge) ProtoMessage() {}
+func (*RoadTypeSpeed) ProtoMessage() {}
 
-func (x *OverloadedEdge) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[24]
+func (x *RoadTypeSpeed) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2914,72 +3380,100 @@ func (x *OverloadedEdge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OverloadedEdge.ProtoReflect.Descriptor instead.
-func (*OverloadedEdge) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RoadTypeSpeed.ProtoReflect.Descriptor instead.
+func (*RoadTypeSpeed) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *OverloadedEdge) GetEdge() *v1.EdgeKey {
+func (x *RoadTypeSpeed) GetRoadType() v1.RoadType {
 	if x != nil {
-		return x.Edge
+		return x.RoadType
 	}
-	return nil
+	return v1.RoadType(0)
 }
 
-func (x *OverloadedEdge) GetRequiredCapacity() float64 {
+func (x *RoadTypeSpeed) GetSpeed() float64 {
 	if x != nil {
-		return x.RequiredCapacity
+		return x.Speed
 	}
 	return 0
 }
 
-func (x *OverloadedEdge) GetAvailableCapacity() float64 {
+type EdgeTimePattern struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edge          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	Pattern       *TimePattern           `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EdgeTimePattern) Reset() {
+	*x = EdgeTimePattern{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeTimePattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeTimePattern) ProtoMessage() {}
+
+func (x *EdgeTimePattern) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[24]
 	if x != nil {
-		return x.AvailableCapacity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OverloadedEdge) GetShortage() float64 {
+// Deprecated: Use EdgeTimePattern.ProtoReflect.Descriptor instead.
+func (*EdgeTimePattern) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EdgeTimePattern) GetEdge() *v1.EdgeKey {
 	if x != nil {
-		return x.Shortage
+		return x.Edge
 	}
-	return 0
+	return nil
 }
 
-func (x *OverloadedEdge) GetShortagePercent() float64 {
+func (x *EdgeTimePattern) GetPattern() *TimePattern {
 	if x != nil {
-		return x.ShortagePercent
+		return x.Pattern
 	}
-	return 0
+	return nil
 }
 
-type RunMonteCarloRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
-	// Конфигурация Monte Carlo
-	Config *MonteCarloConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	// Неопределённости
-	Uncertainties []*UncertaintySpec `protobuf:"bytes,3,rep,name=uncertainties,proto3" json:"uncertainties,omitempty"`
-	Algorithm     v1.Algorithm       `protobuf:"varint,4,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+type NodeTimePattern struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Pattern       *TimePattern           `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Target        PatternTarget          `protobuf:"varint,3,opt,name=target,proto3,enum=logistics.simulation.v1.PatternTarget" json:"target,omitempty"` // supply или demand
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RunMonteCarloRequest) Reset() {
-	*x = RunMonteCarloRequest{}
+func (x *NodeTimePattern) Reset() {
+	*x = NodeTimePattern{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunMonteCarloRequest) String() string {
+func (x *NodeTimePattern) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunMonteCarloRequest) ProtoMessage() {}
+func (*NodeTimePattern) ProtoMessage() {}
 
-func (x *RunMonteCarloRequest) ProtoReflect() protoreflect.Message {
+func (x *NodeTimePattern) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2991,64 +3485,63 @@ func (x *RunMonteCarloRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunMonteCarloRequest.ProtoReflect.Descriptor instead.
-func (*RunMonteCarloRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeTimePattern.ProtoReflect.Descriptor instead.
+func (*NodeTimePattern) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RunMonteCarloRequest) GetGraph() *v1.Graph {
-	if x != nil {
-		return x.Graph
-	}
-	return nil
-}
-
-func (x *RunMonteCarloRequest) GetConfig() *MonteCarloConfig {
+func (x *NodeTimePattern) GetNodeId() int64 {
 	if x != nil {
-		return x.Config
+		return x.NodeId
 	}
-	return nil
+	return 0
 }
 
-func (x *RunMonteCarloRequest) GetUncertainties() []*UncertaintySpec {
+func (x *NodeTimePattern) GetPattern() *TimePattern {
 	if x != nil {
-		return x.Uncertainties
+		return x.Pattern
 	}
 	return nil
 }
 
-func (x *RunMonteCarloRequest) GetAlgorithm() v1.Algorithm {
+func (x *NodeTimePattern) GetTarget() PatternTarget {
 	if x != nil {
-		return x.Algorithm
+		return x.Target
 	}
-	return v1.Algorithm(0)
+	return PatternTarget_PATTERN_TARGET_UNSPECIFIED
 }
 
-type MonteCarloConfig struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	NumIterations   int32                  `protobuf:"varint,1,opt,name=num_iterations,json=numIterations,proto3" json:"num_iterations,omitempty"`        // Количество итераций
-	RandomSeed      int64                  `protobuf:"varint,2,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`                 // Seed для воспроизводимости (0 = random)
-	ConfidenceLevel float64                `protobuf:"fixed64,3,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"` // 0.95 для 95% CI
-	Parallel        bool                   `protobuf:"varint,4,opt,name=parallel,proto3" json:"parallel,omitempty"`                                       // Параллельное выполнение
-	MaxWorkers      int32                  `protobuf:"varint,5,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`                 // Макс. воркеров
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type TimePattern struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  PatternType            `protobuf:"varint,1,opt,name=type,proto3,enum=logistics.simulation.v1.PatternType" json:"type,omitempty"`
+	// Для периодических паттернов
+	HourlyMultipliers []float64 `protobuf:"fixed64,2,rep,packed,name=hourly_multipliers,json=hourlyMultipliers,proto3" json:"hourly_multipliers,omitempty"` // 24 значения для часов
+	DailyMultipliers  []float64 `protobuf:"fixed64,3,rep,packed,name=daily_multipliers,json=dailyMultipliers,proto3" json:"daily_multipliers,omitempty"`    // 7 значений для дней недели
+	// Для кастомных паттернов
+	CustomPoints []*TimePoint `protobuf:"bytes,4,rep,name=custom_points,json=customPoints,proto3" json:"custom_points,omitempty"`
+	// Для стохастических паттернов
+	Mean          float64 `protobuf:"fixed64,5,opt,name=mean,proto3" json:"mean,omitempty"`
+	StdDev        float64 `protobuf:"fixed64,6,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	MinValue      float64 `protobuf:"fixed64,7,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue      float64 `protobuf:"fixed64,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonteCarloConfig) Reset() {
-	*x = MonteCarloConfig{}
+func (x *TimePattern) Reset() {
+	*x = TimePattern{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonteCarloConfig) String() string {
+func (x *TimePattern) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonteCarloConfig) ProtoMessage() {}
+func (*TimePattern) ProtoMessage() {}
 
-func (x *MonteCarloConfig) ProtoReflect() protoreflect.Message {
+func (x *TimePattern) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3060,75 +3553,89 @@ func (x *MonteCarloConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonteCarloConfig.ProtoReflect.Descriptor instead.
-func (*MonteCarloConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimePattern.ProtoReflect.Descriptor instead.
+func (*TimePattern) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MonteCarloConfig) GetNumIterations() int32 {
+func (x *TimePattern) GetType() PatternType {
 	if x != nil {
-		return x.NumIterations
+		return x.Type
 	}
-	return 0
+	return PatternType_PATTERN_TYPE_UNSPECIFIED
 }
 
-func (x *MonteCarloConfig) GetRandomSeed() int64 {
+func (x *TimePattern) GetHourlyMultipliers() []float64 {
 	if x != nil {
-		return x.RandomSeed
+		return x.HourlyMultipliers
 	}
-	return 0
+	return nil
 }
 
-func (x *MonteCarloConfig) GetConfidenceLevel() float64 {
+func (x *TimePattern) GetDailyMultipliers() []float64 {
 	if x != nil {
-		return x.ConfidenceLevel
+		return x.DailyMultipliers
 	}
-	return 0
+	return nil
 }
 
-func (x *MonteCarloConfig) GetParallel() bool {
+func (x *TimePattern) GetCustomPoints() []*TimePoint {
 	if x != nil {
-		return x.Parallel
+		return x.CustomPoints
 	}
-	return false
+	return nil
 }
 
-func (x *MonteCarloConfig) GetMaxWorkers() int32 {
+func (x *TimePattern) GetMean() float64 {
 	if x != nil {
-		return x.MaxWorkers
+		return x.Mean
 	}
 	return 0
 }
 
-type UncertaintySpec struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Type  UncertaintyType        `protobuf:"varint,1,opt,name=type,proto3,enum=logistics.simulation.v1.UncertaintyType" json:"type,omitempty"`
-	// Для edge uncertainties
-	Edge *v1.EdgeKey `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
-	// Для node uncertainties
-	NodeId int64 `protobuf:"varint,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	// Какой параметр
-	Target ModificationTarget `protobuf:"varint,4,opt,name=target,proto3,enum=logistics.simulation.v1.ModificationTarget" json:"target,omitempty"`
-	// Распределение
-	Distribution  *Distribution `protobuf:"bytes,5,opt,name=distribution,proto3" json:"distribution,omitempty"`
+func (x *TimePattern) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *TimePattern) GetMinValue() float64 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
+}
+
+func (x *TimePattern) GetMaxValue() float64 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
+}
+
+type TimePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`              // Номер шага
+	Multiplier    float64                `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // Множитель к базовому значению
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UncertaintySpec) Reset() {
-	*x = UncertaintySpec{}
+func (x *TimePoint) Reset() {
+	*x = TimePoint{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UncertaintySpec) String() string {
+func (x *TimePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UncertaintySpec) ProtoMessage() {}
+func (*TimePoint) ProtoMessage() {}
 
-func (x *UncertaintySpec) ProtoReflect() protoreflect.Message {
+func (x *TimePoint) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3140,71 +3647,57 @@ func (x *UncertaintySpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UncertaintySpec.ProtoReflect.Descriptor instead.
-func (*UncertaintySpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimePoint.ProtoReflect.Descriptor instead.
+func (*TimePoint) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UncertaintySpec) GetType() UncertaintyType {
-	if x != nil {
-		return x.Type
-	}
-	return UncertaintyType_UNCERTAINTY_TYPE_UNSPECIFIED
-}
-
-func (x *UncertaintySpec) GetEdge() *v1.EdgeKey {
-	if x != nil {
-		return x.Edge
-	}
-	return nil
-}
-
-func (x *UncertaintySpec) GetNodeId() int64 {
+func (x *TimePoint) GetStep() int32 {
 	if x != nil {
-		return x.NodeId
+		return x.Step
 	}
 	return 0
 }
 
-func (x *UncertaintySpec) GetTarget() ModificationTarget {
-	if x != nil {
-		return x.Target
-	}
-	return ModificationTarget_MODIFICATION_TARGET_UNSPECIFIED
-}
-
-func (x *UncertaintySpec) GetDistribution() *Distribution {
+func (x *TimePoint) GetMultiplier() float64 {
 	if x != nil {
-		return x.Distribution
+		return x.Multiplier
 	}
-	return nil
+	return 0
 }
 
-type Distribution struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Type  DistributionType       `protobuf:"varint,1,opt,name=type,proto3,enum=logistics.simulation.v1.DistributionType" json:"type,omitempty"`
-	// Параметры распределения
-	Param1        float64 `protobuf:"fixed64,2,opt,name=param1,proto3" json:"param1,omitempty"` // mean для Normal, min для Uniform
-	Param2        float64 `protobuf:"fixed64,3,opt,name=param2,proto3" json:"param2,omitempty"` // std_dev для Normal, max для Uniform
-	Param3        float64 `protobuf:"fixed64,4,opt,name=param3,proto3" json:"param3,omitempty"` // Дополнительный параметр
+type RunTimeSimulationResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Результаты по временным шагам
+	StepResults []*TimeStepResult `protobuf:"bytes,2,rep,name=step_results,json=stepResults,proto3" json:"step_results,omitempty"`
+	// Агрегированная статистика
+	Stats *TimeSimulationStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Критические периоды
+	CriticalPeriods []*CriticalPeriod   `protobuf:"bytes,4,rep,name=critical_periods,json=criticalPeriods,proto3" json:"critical_periods,omitempty"`
+	Metadata        *SimulationMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Сводка динамического потока (только в режиме DYNAMIC)
+	DynamicFlow *DynamicFlowSummary `protobuf:"bytes,6,opt,name=dynamic_flow,json=dynamicFlow,proto3" json:"dynamic_flow,omitempty"`
+	// Уровни обслуживания точек доставки (только при carry_over)
+	ServiceLevels []*ServiceLevel `protobuf:"bytes,7,rep,name=service_levels,json=serviceLevels,proto3" json:"service_levels,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Distribution) Reset() {
-	*x = Distribution{}
+func (x *RunTimeSimulationResponse) Reset() {
+	*x = RunTimeSimulationResponse{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Distribution) String() string {
+func (x *RunTimeSimulationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Distribution) ProtoMessage() {}
+func (*RunTimeSimulationResponse) ProtoMessage() {}
 
-func (x *Distribution) ProtoReflect() protoreflect.Message {
+func (x *RunTimeSimulationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3216,74 +3709,99 @@ func (x *Distribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Distribution.ProtoReflect.Descriptor instead.
-func (*Distribution) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunTimeSimulationResponse.ProtoReflect.Descriptor instead.
+func (*RunTimeSimulationResponse) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Distribution) GetType() DistributionType {
+func (x *RunTimeSimulationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Type
+		return x.Success
 	}
-	return DistributionType_DISTRIBUTION_TYPE_UNSPECIFIED
+	return false
 }
 
-func (x *Distribution) GetParam1() float64 {
+func (x *RunTimeSimulationResponse) GetStepResults() []*TimeStepResult {
 	if x != nil {
-		return x.Param1
+		return x.StepResults
 	}
-	return 0
+	return nil
 }
 
-func (x *Distribution) GetParam2() float64 {
+func (x *RunTimeSimulationResponse) GetStats() *TimeSimulationStats {
 	if x != nil {
-		return x.Param2
+		return x.Stats
 	}
-	return 0
+	return nil
 }
 
-func (x *Distribution) GetParam3() float64 {
+func (x *RunTimeSimulationResponse) GetCriticalPeriods() []*CriticalPeriod {
 	if x != nil {
-		return x.Param3
+		return x.CriticalPeriods
 	}
-	return 0
+	return nil
 }
 
-type RunMonteCarloResponse struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Статистика результатов
-	FlowStats *MonteCarloStats `protobuf:"bytes,2,opt,name=flow_stats,json=flowStats,proto3" json:"flow_stats,omitempty"`
-	CostStats *MonteCarloStats `protobuf:"bytes,3,opt,name=cost_stats,json=costStats,proto3" json:"cost_stats,omitempty"`
-	// Распределение результатов
-	FlowHistogram []*HistogramBucket `protobuf:"bytes,4,rep,name=flow_histogram,json=flowHistogram,proto3" json:"flow_histogram,omitempty"`
-	CostHistogram []*HistogramBucket `protobuf:"bytes,5,rep,name=cost_histogram,json=costHistogram,proto3" json:"cost_histogram,omitempty"`
-	// Percentiles
-	FlowPercentiles map[string]float64 `protobuf:"bytes,6,rep,name=flow_percentiles,json=flowPercentiles,proto3" json:"flow_percentiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // "p5", "p25", "p50", "p75", "p95"
-	CostPercentiles map[string]float64 `protobuf:"bytes,7,rep,name=cost_percentiles,json=costPercentiles,proto3" json:"cost_percentiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
-	// Анализ рисков
-	RiskAnalysis *RiskAnalysis `protobuf:"bytes,8,opt,name=risk_analysis,json=riskAnalysis,proto3" json:"risk_analysis,omitempty"`
-	// Корреляции
-	Correlations  []*ParameterCorrelation `protobuf:"bytes,9,rep,name=correlations,proto3" json:"correlations,omitempty"`
-	Metadata      *SimulationMetadata     `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RunTimeSimulationResponse) GetMetadata() *SimulationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-func (x *RunMonteCarloResponse) Reset() {
-	*x = RunMonteCarloResponse{}
+func (x *RunTimeSimulationResponse) GetDynamicFlow() *DynamicFlowSummary {
+	if x != nil {
+		return x.DynamicFlow
+	}
+	return nil
+}
+
+func (x *RunTimeSimulationResponse) GetServiceLevels() []*ServiceLevel {
+	if x != nil {
+		return x.ServiceLevels
+	}
+	return nil
+}
+
+type TimeStepResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Step      int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// В динамическом режиме — поток, прибывший в сток на шаге
+	MaxFlow            float64       `protobuf:"fixed64,3,opt,name=max_flow,json=maxFlow,proto3" json:"max_flow,omitempty"`
+	TotalCost          float64       `protobuf:"fixed64,4,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	AverageUtilization float64       `protobuf:"fixed64,5,opt,name=average_utilization,json=averageUtilization,proto3" json:"average_utilization,omitempty"`
+	SaturatedEdges     int32         `protobuf:"varint,6,opt,name=saturated_edges,json=saturatedEdges,proto3" json:"saturated_edges,omitempty"`
+	Bottlenecks        []*v1.EdgeKey `protobuf:"bytes,7,rep,name=bottlenecks,proto3" json:"bottlenecks,omitempty"`
+	// Динамический режим
+	Arrivals   float64          `protobuf:"fixed64,8,opt,name=arrivals,proto3" json:"arrivals,omitempty"`                     // Прибыло в сток на шаге
+	Departures float64          `protobuf:"fixed64,9,opt,name=departures,proto3" json:"departures,omitempty"`                 // Выпущено из истока на шаге
+	InTransit  float64          `protobuf:"fixed64,10,opt,name=in_transit,json=inTransit,proto3" json:"in_transit,omitempty"` // В пути в конце шага
+	Inventory  []*NodeInventory `protobuf:"bytes,11,rep,name=inventory,proto3" json:"inventory,omitempty"`                    // Запасы в узлах в конце шага
+	// Перенос состояния (carry_over): supply и demand — предложение и спрос
+	// шага с учётом запаса и backlog, unused_supply — запас в конце шага,
+	// unmet_demand — backlog в конце шага
+	NodeBalances   []*v1.NodeBalance `protobuf:"bytes,12,rep,name=node_balances,json=nodeBalances,proto3" json:"node_balances,omitempty"`
+	TotalInventory float64           `protobuf:"fixed64,13,opt,name=total_inventory,json=totalInventory,proto3" json:"total_inventory,omitempty"` // Неотгруженное предложение в конце шага
+	TotalBacklog   float64           `protobuf:"fixed64,14,opt,name=total_backlog,json=totalBacklog,proto3" json:"total_backlog,omitempty"`       // Невыполненный спрос в конце шага
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TimeStepResult) Reset() {
+	*x = TimeStepResult{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunMonteCarloResponse) String() string {
+func (x *TimeStepResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunMonteCarloResponse) ProtoMessage() {}
+func (*TimeStepResult) ProtoMessage() {}
 
-func (x *RunMonteCarloResponse) ProtoReflect() protoreflect.Message {
+func (x *TimeStepResult) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3295,111 +3813,136 @@ func (x *RunMonteCarloResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunMonteCarloResponse.ProtoReflect.Descriptor instead.
-func (*RunMonteCarloResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeStepResult.ProtoReflect.Descriptor instead.
+func (*TimeStepResult) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *RunMonteCarloResponse) GetSuccess() bool {
+func (x *TimeStepResult) GetStep() int32 {
 	if x != nil {
-		return x.Success
+		return x.Step
 	}
-	return false
+	return 0
 }
 
-func (x *RunMonteCarloResponse) GetFlowStats() *MonteCarloStats {
+func (x *TimeStepResult) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FlowStats
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *RunMonteCarloResponse) GetCostStats() *MonteCarloStats {
+func (x *TimeStepResult) GetMaxFlow() float64 {
 	if x != nil {
-		return x.CostStats
+		return x.MaxFlow
 	}
-	return nil
+	return 0
 }
 
-func (x *RunMonteCarloResponse) GetFlowHistogram() []*HistogramBucket {
+func (x *TimeStepResult) GetTotalCost() float64 {
 	if x != nil {
-		return x.FlowHistogram
+		return x.TotalCost
 	}
-	return nil
+	return 0
 }
 
-func (x *RunMonteCarloResponse) GetCostHistogram() []*HistogramBucket {
+func (x *TimeStepResult) GetAverageUtilization() float64 {
 	if x != nil {
-		return x.CostHistogram
+		return x.AverageUtilization
 	}
-	return nil
+	return 0
 }
 
-func (x *RunMonteCarloResponse) GetFlowPercentiles() map[string]float64 {
+func (x *TimeStepResult) GetSaturatedEdges() int32 {
 	if x != nil {
-		return x.FlowPercentiles
+		return x.SaturatedEdges
 	}
-	return nil
+	return 0
 }
 
-func (x *RunMonteCarloResponse) GetCostPercentiles() map[string]float64 {
+func (x *TimeStepResult) GetBottlenecks() []*v1.EdgeKey {
 	if x != nil {
-		return x.CostPercentiles
+		return x.Bottlenecks
 	}
 	return nil
 }
 
-func (x *RunMonteCarloResponse) GetRiskAnalysis() *RiskAnalysis {
+func (x *TimeStepResult) GetArrivals() float64 {
 	if x != nil {
-		return x.RiskAnalysis
+		return x.Arrivals
 	}
-	return nil
+	return 0
 }
 
-func (x *RunMonteCarloResponse) GetCorrelations() []*ParameterCorrelation {
+func (x *TimeStepResult) GetDepartures() float64 {
 	if x != nil {
-		return x.Correlations
+		return x.Departures
+	}
+	return 0
+}
+
+func (x *TimeStepResult) GetInTransit() float64 {
+	if x != nil {
+		return x.InTransit
+	}
+	return 0
+}
+
+func (x *TimeStepResult) GetInventory() []*NodeInventory {
+	if x != nil {
+		return x.Inventory
 	}
 	return nil
 }
 
-func (x *RunMonteCarloResponse) GetMetadata() *SimulationMetadata {
+func (x *TimeStepResult) GetNodeBalances() []*v1.NodeBalance {
 	if x != nil {
-		return x.Metadata
+		return x.NodeBalances
 	}
 	return nil
 }
 
-type MonteCarloStats struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Mean                   float64                `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
-	StdDev                 float64                `protobuf:"fixed64,2,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
-	Min                    float64                `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
-	Max                    float64                `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
-	Median                 float64                `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
-	Variance               float64                `protobuf:"fixed64,6,opt,name=variance,proto3" json:"variance,omitempty"`
-	Skewness               float64                `protobuf:"fixed64,7,opt,name=skewness,proto3" json:"skewness,omitempty"`
-	Kurtosis               float64                `protobuf:"fixed64,8,opt,name=kurtosis,proto3" json:"kurtosis,omitempty"`
-	ConfidenceIntervalLow  float64                `protobuf:"fixed64,9,opt,name=confidence_interval_low,json=confidenceIntervalLow,proto3" json:"confidence_interval_low,omitempty"`
-	ConfidenceIntervalHigh float64                `protobuf:"fixed64,10,opt,name=confidence_interval_high,json=confidenceIntervalHigh,proto3" json:"confidence_interval_high,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *TimeStepResult) GetTotalInventory() float64 {
+	if x != nil {
+		return x.TotalInventory
+	}
+	return 0
 }
 
-func (x *MonteCarloStats) Reset() {
-	*x = MonteCarloStats{}
+func (x *TimeStepResult) GetTotalBacklog() float64 {
+	if x != nil {
+		return x.TotalBacklog
+	}
+	return 0
+}
+
+// ServiceLevel уровень обслуживания точки доставки за горизонт
+type ServiceLevel struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NodeId           int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	TotalDemand      float64                `protobuf:"fixed64,2,opt,name=total_demand,json=totalDemand,proto3" json:"total_demand,omitempty"` // Спрос за горизонт без учёта переноса
+	Delivered        float64                `protobuf:"fixed64,3,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	FillRate         float64                `protobuf:"fixed64,4,opt,name=fill_rate,json=fillRate,proto3" json:"fill_rate,omitempty"` // delivered / total_demand
+	FinalBacklog     float64                `protobuf:"fixed64,5,opt,name=final_backlog,json=finalBacklog,proto3" json:"final_backlog,omitempty"`
+	StepsWithBacklog int32                  `protobuf:"varint,6,opt,name=steps_with_backlog,json=stepsWithBacklog,proto3" json:"steps_with_backlog,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ServiceLevel) Reset() {
+	*x = ServiceLevel{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonteCarloStats) String() string {
+func (x *ServiceLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonteCarloStats) ProtoMessage() {}
+func (*ServiceLevel) ProtoMessage() {}
 
-func (x *MonteCarloStats) ProtoReflect() protoreflect.Message {
+func (x *ServiceLevel) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3411,105 +3954,76 @@ func (x *MonteCarloStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonteCarloStats.ProtoReflect.Descriptor instead.
-func (*MonteCarloStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceLevel.ProtoReflect.Descriptor instead.
+func (*ServiceLevel) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MonteCarloStats) GetMean() float64 {
-	if x != nil {
-		return x.Mean
-	}
-	return 0
-}
-
-func (x *MonteCarloStats) GetStdDev() float64 {
-	if x != nil {
-		return x.StdDev
-	}
-	return 0
-}
-
-func (x *MonteCarloStats) GetMin() float64 {
-	if x != nil {
-		return x.Min
-	}
-	return 0
-}
-
-func (x *MonteCarloStats) GetMax() float64 {
-	if x != nil {
-		return x.Max
-	}
-	return 0
-}
-
-func (x *MonteCarloStats) GetMedian() float64 {
+func (x *ServiceLevel) GetNodeId() int64 {
 	if x != nil {
-		return x.Median
+		return x.NodeId
 	}
 	return 0
 }
 
-func (x *MonteCarloStats) GetVariance() float64 {
+func (x *ServiceLevel) GetTotalDemand() float64 {
 	if x != nil {
-		return x.Variance
+		return x.TotalDemand
 	}
 	return 0
 }
 
-func (x *MonteCarloStats) GetSkewness() float64 {
+func (x *ServiceLevel) GetDelivered() float64 {
 	if x != nil {
-		return x.Skewness
+		return x.Delivered
 	}
 	return 0
 }
 
-func (x *MonteCarloStats) GetKurtosis() float64 {
+func (x *ServiceLevel) GetFillRate() float64 {
 	if x != nil {
-		return x.Kurtosis
+		return x.FillRate
 	}
 	return 0
 }
 
-func (x *MonteCarloStats) GetConfidenceIntervalLow() float64 {
+func (x *ServiceLevel) GetFinalBacklog() float64 {
 	if x != nil {
-		return x.ConfidenceIntervalLow
+		return x.FinalBacklog
 	}
 	return 0
 }
 
-func (x *MonteCarloStats) GetConfidenceIntervalHigh() float64 {
+func (x *ServiceLevel) GetStepsWithBacklog() int32 {
 	if x != nil {
-		return x.ConfidenceIntervalHigh
+		return x.StepsWithBacklog
 	}
 	return 0
 }
 
-type HistogramBucket struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LowerBound    float64                `protobuf:"fixed64,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
-	UpperBound    float64                `protobuf:"fixed64,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Frequency     float64                `protobuf:"fixed64,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type NodeInventory struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NodeId          int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Level           float64                `protobuf:"fixed64,2,opt,name=level,proto3" json:"level,omitempty"`
+	StorageCapacity float64                `protobuf:"fixed64,3,opt,name=storage_capacity,json=storageCapacity,proto3" json:"storage_capacity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *HistogramBucket) Reset() {
-	*x = HistogramBucket{}
+func (x *NodeInventory) Reset() {
+	*x = NodeInventory{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HistogramBucket) String() string {
+func (x *NodeInventory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistogramBucket) ProtoMessage() {}
+func (*NodeInventory) ProtoMessage() {}
 
-func (x *HistogramBucket) ProtoReflect() protoreflect.Message {
+func (x *NodeInventory) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3521,65 +4035,62 @@ func (x *HistogramBucket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistogramBucket.ProtoReflect.Descriptor instead.
-func (*HistogramBucket) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeInventory.ProtoReflect.Descriptor instead.
+func (*NodeInventory) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *HistogramBucket) GetLowerBound() float64 {
-	if x != nil {
-		return x.LowerBound
-	}
-	return 0
-}
-
-func (x *HistogramBucket) GetUpperBound() float64 {
+func (x *NodeInventory) GetNodeId() int64 {
 	if x != nil {
-		return x.UpperBound
+		return x.NodeId
 	}
 	return 0
 }
 
-func (x *HistogramBucket) GetCount() int32 {
+func (x *NodeInventory) GetLevel() float64 {
 	if x != nil {
-		return x.Count
+		return x.Level
 	}
 	return 0
 }
 
-func (x *HistogramBucket) GetFrequency() float64 {
+func (x *NodeInventory) GetStorageCapacity() float64 {
 	if x != nil {
-		return x.Frequency
+		return x.StorageCapacity
 	}
 	return 0
 }
 
-type RiskAnalysis struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	ProbabilityBelowThreshold float64                `protobuf:"fixed64,1,opt,name=probability_below_threshold,json=probabilityBelowThreshold,proto3" json:"probability_below_threshold,omitempty"` // P(flow < threshold)
-	ValueAtRisk               float64                `protobuf:"fixed64,2,opt,name=value_at_risk,json=valueAtRisk,proto3" json:"value_at_risk,omitempty"`                                           // VaR
-	ExpectedShortfall         float64                `protobuf:"fixed64,3,opt,name=expected_shortfall,json=expectedShortfall,proto3" json:"expected_shortfall,omitempty"`                           // CVaR
-	WorstCaseFlow             float64                `protobuf:"fixed64,4,opt,name=worst_case_flow,json=worstCaseFlow,proto3" json:"worst_case_flow,omitempty"`
-	BestCaseFlow              float64                `protobuf:"fixed64,5,opt,name=best_case_flow,json=bestCaseFlow,proto3" json:"best_case_flow,omitempty"`
-	RiskScenarios             []*RiskScenario        `protobuf:"bytes,6,rep,name=risk_scenarios,json=riskScenarios,proto3" json:"risk_scenarios,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type DynamicFlowSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalFlow     float64                `protobuf:"fixed64,1,opt,name=total_flow,json=totalFlow,proto3" json:"total_flow,omitempty"` // Суммарно доставлено за горизонт
+	HorizonSteps  int32                  `protobuf:"varint,2,opt,name=horizon_steps,json=horizonSteps,proto3" json:"horizon_steps,omitempty"`
+	ExpandedNodes int32                  `protobuf:"varint,3,opt,name=expanded_nodes,json=expandedNodes,proto3" json:"expanded_nodes,omitempty"` // Размер развёрнутой во времени сети
+	ExpandedEdges int32                  `protobuf:"varint,4,opt,name=expanded_edges,json=expandedEdges,proto3" json:"expanded_edges,omitempty"`
+	PeakInTransit float64                `protobuf:"fixed64,5,opt,name=peak_in_transit,json=peakInTransit,proto3" json:"peak_in_transit,omitempty"`
+	PeakInventory float64                `protobuf:"fixed64,6,opt,name=peak_inventory,json=peakInventory,proto3" json:"peak_inventory,omitempty"` // Максимальный суммарный запас за шаг
+	// Среднее время в пути единицы доставленного груза, шагов
+	AverageTransitSteps float64 `protobuf:"fixed64,7,opt,name=average_transit_steps,json=averageTransitSteps,proto3" json:"average_transit_steps,omitempty"`
+	// Время в пути по рёбрам, шагов (в порядке рёбер графа)
+	TransitTimes  []*EdgeTransitTime `protobuf:"bytes,8,rep,name=transit_times,json=transitTimes,proto3" json:"transit_times,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RiskAnalysis) Reset() {
-	*x = RiskAnalysis{}
+func (x *DynamicFlowSummary) Reset() {
+	*x = DynamicFlowSummary{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RiskAnalysis) String() string {
+func (x *DynamicFlowSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RiskAnalysis) ProtoMessage() {}
+func (*DynamicFlowSummary) ProtoMessage() {}
 
-func (x *RiskAnalysis) ProtoReflect() protoreflect.Message {
+func (x *DynamicFlowSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3591,77 +4102,89 @@ func (x *RiskAnalysis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RiskAnalysis.ProtoReflect.Descriptor instead.
-func (*RiskAnalysis) Descriptor() ([]byte, []int) {
+// Deprecated: Use DynamicFlowSummary.ProtoReflect.Descriptor instead.
+func (*DynamicFlowSummary) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RiskAnalysis) GetProbabilityBelowThreshold() float64 {
+func (x *DynamicFlowSummary) GetTotalFlow() float64 {
 	if x != nil {
-		return x.ProbabilityBelowThreshold
+		return x.TotalFlow
 	}
 	return 0
 }
 
-func (x *RiskAnalysis) GetValueAtRisk() float64 {
+func (x *DynamicFlowSummary) GetHorizonSteps() int32 {
 	if x != nil {
-		return x.ValueAtRisk
+		return x.HorizonSteps
 	}
 	return 0
 }
 
-func (x *RiskAnalysis) GetExpectedShortfall() float64 {
+func (x *DynamicFlowSummary) GetExpandedNodes() int32 {
 	if x != nil {
-		return x.ExpectedShortfall
+		return x.ExpandedNodes
 	}
 	return 0
 }
 
-func (x *RiskAnalysis) GetWorstCaseFlow() float64 {
+func (x *DynamicFlowSummary) GetExpandedEdges() int32 {
 	if x != nil {
-		return x.WorstCaseFlow
+		return x.ExpandedEdges
 	}
 	return 0
 }
 
-func (x *RiskAnalysis) GetBestCaseFlow() float64 {
+func (x *DynamicFlowSummary) GetPeakInTransit() float64 {
 	if x != nil {
-		return x.BestCaseFlow
+		return x.PeakInTransit
 	}
 	return 0
 }
 
-func (x *RiskAnalysis) GetRiskScenarios() []*RiskScenario {
+func (x *DynamicFlowSummary) GetPeakInventory() float64 {
 	if x != nil {
-		return x.RiskScenarios
+		return x.PeakInventory
+	}
+	return 0
+}
+
+func (x *DynamicFlowSummary) GetAverageTransitSteps() float64 {
+	if x != nil {
+		return x.AverageTransitSteps
+	}
+	return 0
+}
+
+func (x *DynamicFlowSummary) GetTransitTimes() []*EdgeTransitTime {
+	if x != nil {
+		return x.TransitTimes
 	}
 	return nil
 }
 
-type RiskScenario struct {
+type EdgeTransitTime struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	Probability   float64                `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"`
-	FlowImpact    float64                `protobuf:"fixed64,3,opt,name=flow_impact,json=flowImpact,proto3" json:"flow_impact,omitempty"`
-	CostImpact    float64                `protobuf:"fixed64,4,opt,name=cost_impact,json=costImpact,proto3" json:"cost_impact,omitempty"`
+	Edge          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	Steps         int32                  `protobuf:"varint,2,opt,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RiskScenario) Reset() {
-	*x = RiskScenario{}
+func (x *EdgeTransitTime) Reset() {
+	*x = EdgeTransitTime{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RiskScenario) String() string {
+func (x *EdgeTransitTime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RiskScenario) ProtoMessage() {}
+func (*EdgeTransitTime) ProtoMessage() {}
 
-func (x *RiskScenario) ProtoReflect() protoreflect.Message {
+func (x *EdgeTransitTime) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3673,63 +4196,58 @@ func (x *RiskScenario) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RiskScenario.ProtoReflect.Descriptor instead.
-func (*RiskScenario) Descriptor() ([]byte, []int) {
+// Deprecated: Use EdgeTransitTime.ProtoReflect.Descriptor instead.
+func (*EdgeTransitTime) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RiskScenario) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *RiskScenario) GetProbability() float64 {
-	if x != nil {
-		return x.Probability
-	}
-	return 0
-}
-
-func (x *RiskScenario) GetFlowImpact() float64 {
+func (x *EdgeTransitTime) GetEdge() *v1.EdgeKey {
 	if x != nil {
-		return x.FlowImpact
+		return x.Edge
 	}
-	return 0
+	return nil
 }
 
-func (x *RiskScenario) GetCostImpact() float64 {
+func (x *EdgeTransitTime) GetSteps() int32 {
 	if x != nil {
-		return x.CostImpact
+		return x.Steps
 	}
 	return 0
 }
 
-type ParameterCorrelation struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ParameterName       string                 `protobuf:"bytes,1,opt,name=parameter_name,json=parameterName,proto3" json:"parameter_name,omitempty"`
-	CorrelationWithFlow float64                `protobuf:"fixed64,2,opt,name=correlation_with_flow,json=correlationWithFlow,proto3" json:"correlation_with_flow,omitempty"`
-	CorrelationWithCost float64                `protobuf:"fixed64,3,opt,name=correlation_with_cost,json=correlationWithCost,proto3" json:"correlation_with_cost,omitempty"`
-	ImportanceScore     float64                `protobuf:"fixed64,4,opt,name=importance_score,json=importanceScore,proto3" json:"importance_score,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type TimeSimulationStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MinFlow              float64                `protobuf:"fixed64,1,opt,name=min_flow,json=minFlow,proto3" json:"min_flow,omitempty"`
+	MaxFlow              float64                `protobuf:"fixed64,2,opt,name=max_flow,json=maxFlow,proto3" json:"max_flow,omitempty"`
+	AvgFlow              float64                `protobuf:"fixed64,3,opt,name=avg_flow,json=avgFlow,proto3" json:"avg_flow,omitempty"`
+	StdDevFlow           float64                `protobuf:"fixed64,4,opt,name=std_dev_flow,json=stdDevFlow,proto3" json:"std_dev_flow,omitempty"`
+	MinCost              float64                `protobuf:"fixed64,5,opt,name=min_cost,json=minCost,proto3" json:"min_cost,omitempty"`
+	MaxCost              float64                `protobuf:"fixed64,6,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	AvgCost              float64                `protobuf:"fixed64,7,opt,name=avg_cost,json=avgCost,proto3" json:"avg_cost,omitempty"`
+	TotalSteps           int32                  `protobuf:"varint,8,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
+	StepsWithBottlenecks int32                  `protobuf:"varint,9,opt,name=steps_with_bottlenecks,json=stepsWithBottlenecks,proto3" json:"steps_with_bottlenecks,omitempty"`
+	// При carry_over: доля спроса за горизонт, доставленная к его концу, и
+	// невыполненный спрос в конце горизонта
+	FillRate      float64 `protobuf:"fixed64,10,opt,name=fill_rate,json=fillRate,proto3" json:"fill_rate,omitempty"`
+	FinalBacklog  float64 `protobuf:"fixed64,11,opt,name=final_backlog,json=finalBacklog,proto3" json:"final_backlog,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ParameterCorrelation) Reset() {
-	*x = ParameterCorrelation{}
+func (x *TimeSimulationStats) Reset() {
+	*x = TimeSimulationStats{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParameterCorrelation) String() string {
+func (x *TimeSimulationStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParameterCorrelation) ProtoMessage() {}
+func (*TimeSimulationStats) ProtoMessage() {}
 
-func (x *ParameterCorrelation) ProtoReflect() protoreflect.Message {
+func (x *TimeSimulationStats) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3741,65 +4259,115 @@ func (x *ParameterCorrelation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParameterCorrelation.ProtoReflect.Descriptor instead.
-func (*ParameterCorrelation) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeSimulationStats.ProtoReflect.Descriptor instead.
+func (*TimeSimulationStats) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ParameterCorrelation) GetParameterName() string {
+func (x *TimeSimulationStats) GetMinFlow() float64 {
 	if x != nil {
-		return x.ParameterName
+		return x.MinFlow
 	}
-	return ""
+	return 0
 }
 
-func (x *ParameterCorrelation) GetCorrelationWithFlow() float64 {
+func (x *TimeSimulationStats) GetMaxFlow() float64 {
 	if x != nil {
-		return x.CorrelationWithFlow
+		return x.MaxFlow
 	}
 	return 0
 }
 
-func (x *ParameterCorrelation) GetCorrelationWithCost() float64 {
+func (x *TimeSimulationStats) GetAvgFlow() float64 {
 	if x != nil {
-		return x.CorrelationWithCost
+		return x.AvgFlow
 	}
 	return 0
 }
 
-func (x *ParameterCorrelation) GetImportanceScore() float64 {
+func (x *TimeSimulationStats) GetStdDevFlow() float64 {
 	if x != nil {
-		return x.ImportanceScore
+		return x.StdDevFlow
 	}
 	return 0
 }
 
-type MonteCarloProgress struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Iteration       int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
-	TotalIterations int32                  `protobuf:"varint,2,opt,name=total_iterations,json=totalIterations,proto3" json:"total_iterations,omitempty"`
-	ProgressPercent float64                `protobuf:"fixed64,3,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
-	CurrentMeanFlow float64                `protobuf:"fixed64,4,opt,name=current_mean_flow,json=currentMeanFlow,proto3" json:"current_mean_flow,omitempty"`
-	CurrentStdDev   float64                `protobuf:"fixed64,5,opt,name=current_std_dev,json=currentStdDev,proto3" json:"current_std_dev,omitempty"`
-	Status          string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *TimeSimulationStats) GetMinCost() float64 {
+	if x != nil {
+		return x.MinCost
+	}
+	return 0
 }
 
-func (x *MonteCarloProgress) Reset() {
-	*x = MonteCarloProgress{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[35]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TimeSimulationStats) GetMaxCost() float64 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
 }
 
-func (x *MonteCarloProgress) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TimeSimulationStats) GetAvgCost() float64 {
+	if x != nil {
+		return x.AvgCost
+	}
+	return 0
 }
 
-func (*MonteCarloProgress) ProtoMessage() {}
-
-func (x *MonteCarloProgress) ProtoReflect() protoreflect.Message {
+func (x *TimeSimulationStats) GetTotalSteps() int32 {
+	if x != nil {
+		return x.TotalSteps
+	}
+	return 0
+}
+
+func (x *TimeSimulationStats) GetStepsWithBottlenecks() int32 {
+	if x != nil {
+		return x.StepsWithBottlenecks
+	}
+	return 0
+}
+
+func (x *TimeSimulationStats) GetFillRate() float64 {
+	if x != nil {
+		return x.FillRate
+	}
+	return 0
+}
+
+func (x *TimeSimulationStats) GetFinalBacklog() float64 {
+	if x != nil {
+		return x.FinalBacklog
+	}
+	return 0
+}
+
+type CriticalPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartStep     int32                  `protobuf:"varint,1,opt,name=start_step,json=startStep,proto3" json:"start_step,omitempty"`
+	EndStep       int32                  `protobuf:"varint,2,opt,name=end_step,json=endStep,proto3" json:"end_step,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Type          CriticalPeriodType     `protobuf:"varint,5,opt,name=type,proto3,enum=logistics.simulation.v1.CriticalPeriodType" json:"type,omitempty"`
+	Severity      float64                `protobuf:"fixed64,6,opt,name=severity,proto3" json:"severity,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriticalPeriod) Reset() {
+	*x = CriticalPeriod{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriticalPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalPeriod) ProtoMessage() {}
+
+func (x *CriticalPeriod) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3811,79 +4379,89 @@ func (x *MonteCarloProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonteCarloProgress.ProtoReflect.Descriptor instead.
-func (*MonteCarloProgress) Descriptor() ([]byte, []int) {
+// Deprecated: Use CriticalPeriod.ProtoReflect.Descriptor instead.
+func (*CriticalPeriod) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *MonteCarloProgress) GetIteration() int32 {
+func (x *CriticalPeriod) GetStartStep() int32 {
 	if x != nil {
-		return x.Iteration
+		return x.StartStep
 	}
 	return 0
 }
 
-func (x *MonteCarloProgress) GetTotalIterations() int32 {
+func (x *CriticalPeriod) GetEndStep() int32 {
 	if x != nil {
-		return x.TotalIterations
+		return x.EndStep
 	}
 	return 0
 }
 
-func (x *MonteCarloProgress) GetProgressPercent() float64 {
+func (x *CriticalPeriod) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ProgressPercent
+		return x.StartTime
 	}
-	return 0
+	return nil
 }
 
-func (x *MonteCarloProgress) GetCurrentMeanFlow() float64 {
+func (x *CriticalPeriod) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CurrentMeanFlow
+		return x.EndTime
 	}
-	return 0
+	return nil
 }
 
-func (x *MonteCarloProgress) GetCurrentStdDev() float64 {
+func (x *CriticalPeriod) GetType() CriticalPeriodType {
 	if x != nil {
-		return x.CurrentStdDev
+		return x.Type
+	}
+	return CriticalPeriodType_CRITICAL_PERIOD_TYPE_UNSPECIFIED
+}
+
+func (x *CriticalPeriod) GetSeverity() float64 {
+	if x != nil {
+		return x.Severity
 	}
 	return 0
 }
 
-func (x *MonteCarloProgress) GetStatus() string {
+func (x *CriticalPeriod) GetDescription() string {
 	if x != nil {
-		return x.Status
+		return x.Description
 	}
 	return ""
 }
 
-type AnalyzeSensitivityRequest struct {
+type SimulatePeakLoadRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
-	// Параметры для анализа
-	Parameters []*SensitivityParameter `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty"`
-	// Конфигурация
-	Config        *SensitivityConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
-	Algorithm     v1.Algorithm       `protobuf:"varint,4,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	// Множители для пиковой нагрузки
+	DemandMultiplier  float64 `protobuf:"fixed64,2,opt,name=demand_multiplier,json=demandMultiplier,proto3" json:"demand_multiplier,omitempty"`    // 1.5 = +50% demand
+	CapacityReduction float64 `protobuf:"fixed64,3,opt,name=capacity_reduction,json=capacityReduction,proto3" json:"capacity_reduction,omitempty"` // 0.8 = -20% capacity
+	// Какие узлы затрагивает пик
+	AffectedNodes []int64 `protobuf:"varint,4,rep,packed,name=affected_nodes,json=affectedNodes,proto3" json:"affected_nodes,omitempty"` // Пустой = все
+	// Какие рёбра затрагивает
+	AffectedEdges []*v1.EdgeKey `protobuf:"bytes,5,rep,name=affected_edges,json=affectedEdges,proto3" json:"affected_edges,omitempty"`
+	Algorithm     v1.Algorithm  `protobuf:"varint,6,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnalyzeSensitivityRequest) Reset() {
-	*x = AnalyzeSensitivityRequest{}
+func (x *SimulatePeakLoadRequest) Reset() {
+	*x = SimulatePeakLoadRequest{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalyzeSensitivityRequest) String() string {
+func (x *SimulatePeakLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeSensitivityRequest) ProtoMessage() {}
+func (*SimulatePeakLoadRequest) ProtoMessage() {}
 
-func (x *AnalyzeSensitivityRequest) ProtoReflect() protoreflect.Message {
+func (x *SimulatePeakLoadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3895,67 +4473,82 @@ func (x *AnalyzeSensitivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeSensitivityRequest.ProtoReflect.Descriptor instead.
-func (*AnalyzeSensitivityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulatePeakLoadRequest.ProtoReflect.Descriptor instead.
+func (*SimulatePeakLoadRequest) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *AnalyzeSensitivityRequest) GetGraph() *v1.Graph {
+func (x *SimulatePeakLoadRequest) GetGraph() *v1.Graph {
 	if x != nil {
 		return x.Graph
 	}
 	return nil
 }
 
-func (x *AnalyzeSensitivityRequest) GetParameters() []*SensitivityParameter {
+func (x *SimulatePeakLoadRequest) GetDemandMultiplier() float64 {
 	if x != nil {
-		return x.Parameters
+		return x.DemandMultiplier
+	}
+	return 0
+}
+
+func (x *SimulatePeakLoadRequest) GetCapacityReduction() float64 {
+	if x != nil {
+		return x.CapacityReduction
+	}
+	return 0
+}
+
+func (x *SimulatePeakLoadRequest) GetAffectedNodes() []int64 {
+	if x != nil {
+		return x.AffectedNodes
 	}
 	return nil
 }
 
-func (x *AnalyzeSensitivityRequest) GetConfig() *SensitivityConfig {
+func (x *SimulatePeakLoadRequest) GetAffectedEdges() []*v1.EdgeKey {
 	if x != nil {
-		return x.Config
+		return x.AffectedEdges
 	}
 	return nil
 }
 
-func (x *AnalyzeSensitivityRequest) GetAlgorithm() v1.Algorithm {
+func (x *SimulatePeakLoadRequest) GetAlgorithm() v1.Algorithm {
 	if x != nil {
 		return x.Algorithm
 	}
 	return v1.Algorithm(0)
 }
 
-type SensitivityParameter struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Идентификация параметра
-	Edge   *v1.EdgeKey        `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
-	NodeId int64              `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Target ModificationTarget `protobuf:"varint,3,opt,name=target,proto3,enum=logistics.simulation.v1.ModificationTarget" json:"target,omitempty"`
-	// Диапазон изменения
-	MinMultiplier float64 `protobuf:"fixed64,4,opt,name=min_multiplier,json=minMultiplier,proto3" json:"min_multiplier,omitempty"` // 0.5 = -50%
-	MaxMultiplier float64 `protobuf:"fixed64,5,opt,name=max_multiplier,json=maxMultiplier,proto3" json:"max_multiplier,omitempty"` // 1.5 = +50%
-	NumSteps      int32   `protobuf:"varint,6,opt,name=num_steps,json=numSteps,proto3" json:"num_steps,omitempty"`                 // Количество точек
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SimulatePeakLoadResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Success      bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	NormalResult *ScenarioResult        `protobuf:"bytes,2,opt,name=normal_result,json=normalResult,proto3" json:"normal_result,omitempty"`
+	PeakResult   *ScenarioResult        `protobuf:"bytes,3,opt,name=peak_result,json=peakResult,proto3" json:"peak_result,omitempty"`
+	Comparison   *ScenarioComparison    `protobuf:"bytes,4,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	// Рёбра, которые не справились
+	OverloadedEdges []*OverloadedEdge `protobuf:"bytes,5,rep,name=overloaded_edges,json=overloadedEdges,proto3" json:"overloaded_edges,omitempty"`
+	// Рекомендации
+	Recommendations []string            `protobuf:"bytes,6,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	Metadata        *SimulationMetadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SensitivityParameter) Reset() {
-	*x = SensitivityParameter{}
+func (x *SimulatePeakLoadResponse) Reset() {
+	*x = SimulatePeakLoadResponse{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SensitivityParameter) String() string {
+func (x *SimulatePeakLoadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SensitivityParameter) ProtoMessage() {}
+func (*SimulatePeakLoadResponse) ProtoMessage() {}
 
-func (x *SensitivityParameter) ProtoReflect() protoreflect.Message {
+func (x *SimulatePeakLoadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3967,76 +4560,85 @@ func (x *SensitivityParameter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SensitivityParameter.ProtoReflect.Descriptor instead.
-func (*SensitivityParameter) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulatePeakLoadResponse.ProtoReflect.Descriptor instead.
+func (*SimulatePeakLoadResponse) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SensitivityParameter) GetEdge() *v1.EdgeKey {
+func (x *SimulatePeakLoadResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Edge
+		return x.Success
+	}
+	return false
+}
+
+func (x *SimulatePeakLoadResponse) GetNormalResult() *ScenarioResult {
+	if x != nil {
+		return x.NormalResult
 	}
 	return nil
 }
 
-func (x *SensitivityParameter) GetNodeId() int64 {
+func (x *SimulatePeakLoadResponse) GetPeakResult() *ScenarioResult {
 	if x != nil {
-		return x.NodeId
+		return x.PeakResult
 	}
-	return 0
+	return nil
 }
 
-func (x *SensitivityParameter) GetTarget() ModificationTarget {
+func (x *SimulatePeakLoadResponse) GetComparison() *ScenarioComparison {
 	if x != nil {
-		return x.Target
+		return x.Comparison
 	}
-	return ModificationTarget_MODIFICATION_TARGET_UNSPECIFIED
+	return nil
 }
 
-func (x *SensitivityParameter) GetMinMultiplier() float64 {
+func (x *SimulatePeakLoadResponse) GetOverloadedEdges() []*OverloadedEdge {
 	if x != nil {
-		return x.MinMultiplier
+		return x.OverloadedEdges
 	}
-	return 0
+	return nil
 }
 
-func (x *SensitivityParameter) GetMaxMultiplier() float64 {
+func (x *SimulatePeakLoadResponse) GetRecommendations() []string {
 	if x != nil {
-		return x.MaxMultiplier
+		return x.Recommendations
 	}
-	return 0
+	return nil
 }
 
-func (x *SensitivityParameter) GetNumSteps() int32 {
+func (x *SimulatePeakLoadResponse) GetMetadata() *SimulationMetadata {
 	if x != nil {
-		return x.NumSteps
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type SensitivityConfig struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Method              SensitivityMethod      `protobuf:"varint,1,opt,name=method,proto3,enum=logistics.simulation.v1.SensitivityMethod" json:"method,omitempty"`
-	CalculateElasticity bool                   `protobuf:"varint,2,opt,name=calculate_elasticity,json=calculateElasticity,proto3" json:"calculate_elasticity,omitempty"` // Эластичность
-	FindThresholds      bool                   `protobuf:"varint,3,opt,name=find_thresholds,json=findThresholds,proto3" json:"find_thresholds,omitempty"`                // Пороговые значения
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type OverloadedEdge struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Edge              *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	RequiredCapacity  float64                `protobuf:"fixed64,2,opt,name=required_capacity,json=requiredCapacity,proto3" json:"required_capacity,omitempty"`
+	AvailableCapacity float64                `protobuf:"fixed64,3,opt,name=available_capacity,json=availableCapacity,proto3" json:"available_capacity,omitempty"`
+	Shortage          float64                `protobuf:"fixed64,4,opt,name=shortage,proto3" json:"shortage,omitempty"`
+	ShortagePercent   float64                `protobuf:"fixed64,5,opt,name=shortage_percent,json=shortagePercent,proto3" json:"shortage_percent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SensitivityConfig) Reset() {
-	*x = SensitivityConfig{}
+func (x *OverloadedEdge) Reset() {
+	*x = OverloadedEdge{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SensitivityConfig) String() string {
+func (x *OverloadedEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SensitivityConfig) ProtoMessage() {}
+func (*OverloadedEdge) ProtoMessage() {}
 
-func (x *SensitivityConfig) ProtoReflect() protoreflect.Message {
+func (x *OverloadedEdge) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4048,60 +4650,71 @@ func (x *SensitivityConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SensitivityConfig.ProtoReflect.Descriptor instead.
-func (*SensitivityConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use OverloadedEdge.ProtoReflect.Descriptor instead.
+func (*OverloadedEdge) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SensitivityConfig) GetMethod() SensitivityMethod {
+func (x *OverloadedEdge) GetEdge() *v1.EdgeKey {
 	if x != nil {
-		return x.Method
+		return x.Edge
 	}
-	return SensitivityMethod_SENSITIVITY_METHOD_UNSPECIFIED
+	return nil
 }
 
-func (x *SensitivityConfig) GetCalculateElasticity() bool {
+func (x *OverloadedEdge) GetRequiredCapacity() float64 {
 	if x != nil {
-		return x.CalculateElasticity
+		return x.RequiredCapacity
 	}
-	return false
+	return 0
 }
 
-func (x *SensitivityConfig) GetFindThresholds() bool {
+func (x *OverloadedEdge) GetAvailableCapacity() float64 {
 	if x != nil {
-		return x.FindThresholds
+		return x.AvailableCapacity
 	}
-	return false
+	return 0
 }
 
-type AnalyzeSensitivityResponse struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Результаты по параметрам
-	ParameterResults []*SensitivityResult `protobuf:"bytes,2,rep,name=parameter_results,json=parameterResults,proto3" json:"parameter_results,omitempty"`
-	// Рейтинг влияния
-	Rankings []*ParameterRanking `protobuf:"bytes,3,rep,name=rankings,proto3" json:"rankings,omitempty"`
-	// Найденные пороги
-	Thresholds    []*ThresholdPoint   `protobuf:"bytes,4,rep,name=thresholds,proto3" json:"thresholds,omitempty"`
-	Metadata      *SimulationMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+func (x *OverloadedEdge) GetShortage() float64 {
+	if x != nil {
+		return x.Shortage
+	}
+	return 0
+}
+
+func (x *OverloadedEdge) GetShortagePercent() float64 {
+	if x != nil {
+		return x.ShortagePercent
+	}
+	return 0
+}
+
+type RunDiscreteEventSimulationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Решённый граф (SolveResponse.solved_graph): current_flow рёбер — объём
+	// перевозок в единицах в час. Поток раскладывается на маршруты от узлов с
+	// избытком (исток, поставщики) к узлам с недостатком (сток, точки доставки)
+	SolvedGraph   *v1.Graph            `protobuf:"bytes,1,opt,name=solved_graph,json=solvedGraph,proto3" json:"solved_graph,omitempty"`
+	Config        *DiscreteEventConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnalyzeSensitivityResponse) Reset() {
-	*x = AnalyzeSensitivityResponse{}
+func (x *RunDiscreteEventSimulationRequest) Reset() {
+	*x = RunDiscreteEventSimulationRequest{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalyzeSensitivityResponse) String() string {
+func (x *RunDiscreteEventSimulationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeSensitivityResponse) ProtoMessage() {}
+func (*RunDiscreteEventSimulationRequest) ProtoMessage() {}
 
-func (x *AnalyzeSensitivityResponse) ProtoReflect() protoreflect.Message {
+func (x *RunDiscreteEventSimulationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4113,74 +4726,68 @@ func (x *AnalyzeSensitivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeSensitivityResponse.ProtoReflect.Descriptor instead.
-func (*AnalyzeSensitivityResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunDiscreteEventSimulationRequest.ProtoReflect.Descriptor instead.
+func (*RunDiscreteEventSimulationRequest) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AnalyzeSensitivityResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *AnalyzeSensitivityResponse) GetParameterResults() []*SensitivityResult {
-	if x != nil {
-		return x.ParameterResults
-	}
-	return nil
-}
-
-func (x *AnalyzeSensitivityResponse) GetRankings() []*ParameterRanking {
-	if x != nil {
-		return x.Rankings
-	}
-	return nil
-}
-
-func (x *AnalyzeSensitivityResponse) GetThresholds() []*ThresholdPoint {
+func (x *RunDiscreteEventSimulationRequest) GetSolvedGraph() *v1.Graph {
 	if x != nil {
-		return x.Thresholds
+		return x.SolvedGraph
 	}
 	return nil
 }
 
-func (x *AnalyzeSensitivityResponse) GetMetadata() *SimulationMetadata {
+func (x *RunDiscreteEventSimulationRequest) GetConfig() *DiscreteEventConfig {
 	if x != nil {
-		return x.Metadata
+		return x.Config
 	}
 	return nil
 }
 
-type SensitivityResult struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	ParameterId string                 `protobuf:"bytes,1,opt,name=parameter_id,json=parameterId,proto3" json:"parameter_id,omitempty"`
-	// Кривая чувствительности
-	Curve []*SensitivityPoint `protobuf:"bytes,2,rep,name=curve,proto3" json:"curve,omitempty"`
-	// Метрики
-	Elasticity       float64          `protobuf:"fixed64,3,opt,name=elasticity,proto3" json:"elasticity,omitempty"`                                     // % изменения output / % изменения input
-	SensitivityIndex float64          `protobuf:"fixed64,4,opt,name=sensitivity_index,json=sensitivityIndex,proto3" json:"sensitivity_index,omitempty"` // Нормализованный индекс 0-1
-	ImpactRange      float64          `protobuf:"fixed64,5,opt,name=impact_range,json=impactRange,proto3" json:"impact_range,omitempty"`                // max_flow - min_flow
-	Level            SensitivityLevel `protobuf:"varint,6,opt,name=level,proto3,enum=logistics.simulation.v1.SensitivityLevel" json:"level,omitempty"`
+type DiscreteEventConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Окно отправлений, часов (по умолчанию 24). Симуляция продолжается, пока
+	// не завершатся все отправленные рейсы
+	HorizonHours float64 `protobuf:"fixed64,1,opt,name=horizon_hours,json=horizonHours,proto3" json:"horizon_hours,omitempty"`
+	// Вместимость транспортного средства, единиц потока (по умолчанию 10)
+	VehicleCapacity float64 `protobuf:"fixed64,2,opt,name=vehicle_capacity,json=vehicleCapacity,proto3" json:"vehicle_capacity,omitempty"`
+	// Интервал отправлений по маршруту, часов. 0 — рейс уходит, как только
+	// накопится полная загрузка: vehicle_capacity / поток маршрута
+	DepartureIntervalHours float64 `protobuf:"fixed64,3,opt,name=departure_interval_hours,json=departureIntervalHours,proto3" json:"departure_interval_hours,omitempty"`
+	// Время погрузки и разгрузки одного рейса, часов (по умолчанию 0.5).
+	// Погрузка — в начале маршрута, разгрузка — в конце, на промежуточных
+	// складах (NODE_TYPE_WAREHOUSE) — перегрузка: разгрузка и погрузка
+	LoadingTimeHours   float64 `protobuf:"fixed64,4,opt,name=loading_time_hours,json=loadingTimeHours,proto3" json:"loading_time_hours,omitempty"`
+	UnloadingTimeHours float64 `protobuf:"fixed64,5,opt,name=unloading_time_hours,json=unloadingTimeHours,proto3" json:"unloading_time_hours,omitempty"`
+	// Число параллельных постов погрузки-разгрузки в узле (по умолчанию 1)
+	DocksPerNode int32 `protobuf:"varint,6,opt,name=docks_per_node,json=docksPerNode,proto3" json:"docks_per_node,omitempty"`
+	// Скорости по типам дорог; по умолчанию — как в TimeSimulationConfig
+	RoadSpeeds []*RoadTypeSpeed `protobuf:"bytes,7,rep,name=road_speeds,json=roadSpeeds,proto3" json:"road_speeds,omitempty"`
+	// Случайная задержка на каждом ребре, часов (отрицательные значения — 0)
+	TravelDelay *Distribution `protobuf:"bytes,8,opt,name=travel_delay,json=travelDelay,proto3" json:"travel_delay,omitempty"`
+	// 0 — случайный seed
+	RandomSeed int64 `protobuf:"varint,9,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`
+	// Число интервалов гистограммы времени доставки (по умолчанию 20)
+	HistogramBuckets int32 `protobuf:"varint,10,opt,name=histogram_buckets,json=histogramBuckets,proto3" json:"histogram_buckets,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SensitivityResult) Reset() {
-	*x = SensitivityResult{}
+func (x *DiscreteEventConfig) Reset() {
+	*x = DiscreteEventConfig{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SensitivityResult) String() string {
+func (x *DiscreteEventConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SensitivityResult) ProtoMessage() {}
+func (*DiscreteEventConfig) ProtoMessage() {}
 
-func (x *SensitivityResult) ProtoReflect() protoreflect.Message {
+func (x *DiscreteEventConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4192,76 +4799,116 @@ func (x *SensitivityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SensitivityResult.ProtoReflect.Descriptor instead.
-func (*SensitivityResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscreteEventConfig.ProtoReflect.Descriptor instead.
+func (*DiscreteEventConfig) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SensitivityResult) GetParameterId() string {
+func (x *DiscreteEventConfig) GetHorizonHours() float64 {
 	if x != nil {
-		return x.ParameterId
+		return x.HorizonHours
 	}
-	return ""
+	return 0
 }
 
-func (x *SensitivityResult) GetCurve() []*SensitivityPoint {
+func (x *DiscreteEventConfig) GetVehicleCapacity() float64 {
 	if x != nil {
-		return x.Curve
+		return x.VehicleCapacity
 	}
-	return nil
+	return 0
 }
 
-func (x *SensitivityResult) GetElasticity() float64 {
+func (x *DiscreteEventConfig) GetDepartureIntervalHours() float64 {
 	if x != nil {
-		return x.Elasticity
+		return x.DepartureIntervalHours
 	}
 	return 0
 }
 
-func (x *SensitivityResult) GetSensitivityIndex() float64 {
+func (x *DiscreteEventConfig) GetLoadingTimeHours() float64 {
 	if x != nil {
-		return x.SensitivityIndex
+		return x.LoadingTimeHours
 	}
 	return 0
 }
 
-func (x *SensitivityResult) GetImpactRange() float64 {
+func (x *DiscreteEventConfig) GetUnloadingTimeHours() float64 {
 	if x != nil {
-		return x.ImpactRange
+		return x.UnloadingTimeHours
 	}
 	return 0
 }
 
-func (x *SensitivityResult) GetLevel() SensitivityLevel {
+func (x *DiscreteEventConfig) GetDocksPerNode() int32 {
 	if x != nil {
-		return x.Level
+		return x.DocksPerNode
 	}
-	return SensitivityLevel_SENSITIVITY_LEVEL_UNSPECIFIED
+	return 0
 }
 
-type SensitivityPoint struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ParameterValue float64                `protobuf:"fixed64,1,opt,name=parameter_value,json=parameterValue,proto3" json:"parameter_value,omitempty"` // Множитель
-	FlowValue      float64                `protobuf:"fixed64,2,opt,name=flow_value,json=flowValue,proto3" json:"flow_value,omitempty"`
-	CostValue      float64                `protobuf:"fixed64,3,opt,name=cost_value,json=costValue,proto3" json:"cost_value,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *DiscreteEventConfig) GetRoadSpeeds() []*RoadTypeSpeed {
+	if x != nil {
+		return x.RoadSpeeds
+	}
+	return nil
 }
 
-func (x *SensitivityPoint) Reset() {
-	*x = SensitivityPoint{}
+func (x *DiscreteEventConfig) GetTravelDelay() *Distribution {
+	if x != nil {
+		return x.TravelDelay
+	}
+	return nil
+}
+
+func (x *DiscreteEventConfig) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *DiscreteEventConfig) GetHistogramBuckets() int32 {
+	if x != nil {
+		return x.HistogramBuckets
+	}
+	return 0
+}
+
+type RunDiscreteEventSimulationResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Stats   *DiscreteEventStats    `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Время доставки рейса (от готовности груза к отправке до окончания
+	// разгрузки), часов
+	LeadTimeStats       *MonteCarloStats   `protobuf:"bytes,3,opt,name=lead_time_stats,json=leadTimeStats,proto3" json:"lead_time_stats,omitempty"`
+	LeadTimeHistogram   []*HistogramBucket `protobuf:"bytes,4,rep,name=lead_time_histogram,json=leadTimeHistogram,proto3" json:"lead_time_histogram,omitempty"`
+	LeadTimePercentiles map[string]float64 `protobuf:"bytes,5,rep,name=lead_time_percentiles,json=leadTimePercentiles,proto3" json:"lead_time_percentiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // "p5" … "p99"
+	// Очереди на погрузку-разгрузку по узлам (только узлы с обслуживанием)
+	NodeQueues []*NodeQueueStats `protobuf:"bytes,6,rep,name=node_queues,json=nodeQueues,proto3" json:"node_queues,omitempty"`
+	// Доставлено по часам окна отправлений и после него
+	Throughput []*ThroughputPoint `protobuf:"bytes,7,rep,name=throughput,proto3" json:"throughput,omitempty"`
+	// Маршруты, на которые разложен поток
+	Routes        []*ShipmentRoute    `protobuf:"bytes,8,rep,name=routes,proto3" json:"routes,omitempty"`
+	RandomSeed    int64               `protobuf:"varint,9,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"` // Использованный seed
+	Metadata      *SimulationMetadata `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunDiscreteEventSimulationResponse) Reset() {
+	*x = RunDiscreteEventSimulationResponse{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SensitivityPoint) String() string {
+func (x *RunDiscreteEventSimulationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SensitivityPoint) ProtoMessage() {}
+func (*RunDiscreteEventSimulationResponse) ProtoMessage() {}
 
-func (x *SensitivityPoint) ProtoReflect() protoreflect.Message {
+func (x *RunDiscreteEventSimulationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4273,125 +4920,111 @@ func (x *SensitivityPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SensitivityPoint.ProtoReflect.Descriptor instead.
-func (*SensitivityPoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunDiscreteEventSimulationResponse.ProtoReflect.Descriptor instead.
+func (*RunDiscreteEventSimulationResponse) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SensitivityPoint) GetParameterValue() float64 {
+func (x *RunDiscreteEventSimulationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ParameterValue
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *SensitivityPoint) GetFlowValue() float64 {
+func (x *RunDiscreteEventSimulationResponse) GetStats() *DiscreteEventStats {
 	if x != nil {
-		return x.FlowValue
+		return x.Stats
 	}
-	return 0
+	return nil
 }
 
-func (x *SensitivityPoint) GetCostValue() float64 {
+func (x *RunDiscreteEventSimulationResponse) GetLeadTimeStats() *MonteCarloStats {
 	if x != nil {
-		return x.CostValue
+		return x.LeadTimeStats
 	}
-	return 0
-}
-
-type ParameterRanking struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ParameterId      string                 `protobuf:"bytes,1,opt,name=parameter_id,json=parameterId,proto3" json:"parameter_id,omitempty"`
-	Rank             int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
-	SensitivityIndex float64                `protobuf:"fixed64,3,opt,name=sensitivity_index,json=sensitivityIndex,proto3" json:"sensitivity_index,omitempty"`
-	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *ParameterRanking) Reset() {
-	*x = ParameterRanking{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *ParameterRanking) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RunDiscreteEventSimulationResponse) GetLeadTimeHistogram() []*HistogramBucket {
+	if x != nil {
+		return x.LeadTimeHistogram
+	}
+	return nil
 }
 
-func (*ParameterRanking) ProtoMessage() {}
-
-func (x *ParameterRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[42]
+func (x *RunDiscreteEventSimulationResponse) GetLeadTimePercentiles() map[string]float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LeadTimePercentiles
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ParameterRanking.ProtoReflect.Descriptor instead.
-func (*ParameterRanking) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{42}
+func (x *RunDiscreteEventSimulationResponse) GetNodeQueues() []*NodeQueueStats {
+	if x != nil {
+		return x.NodeQueues
+	}
+	return nil
 }
 
-func (x *ParameterRanking) GetParameterId() string {
+func (x *RunDiscreteEventSimulationResponse) GetThroughput() []*ThroughputPoint {
 	if x != nil {
-		return x.ParameterId
+		return x.Throughput
 	}
-	return ""
+	return nil
 }
 
-func (x *ParameterRanking) GetRank() int32 {
+func (x *RunDiscreteEventSimulationResponse) GetRoutes() []*ShipmentRoute {
 	if x != nil {
-		return x.Rank
+		return x.Routes
 	}
-	return 0
+	return nil
 }
 
-func (x *ParameterRanking) GetSensitivityIndex() float64 {
+func (x *RunDiscreteEventSimulationResponse) GetRandomSeed() int64 {
 	if x != nil {
-		return x.SensitivityIndex
+		return x.RandomSeed
 	}
 	return 0
 }
 
-func (x *ParameterRanking) GetDescription() string {
+func (x *RunDiscreteEventSimulationResponse) GetMetadata() *SimulationMetadata {
 	if x != nil {
-		return x.Description
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-type ThresholdPoint struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ParameterId    string                 `protobuf:"bytes,1,opt,name=parameter_id,json=parameterId,proto3" json:"parameter_id,omitempty"`
-	ThresholdValue float64                `protobuf:"fixed64,2,opt,name=threshold_value,json=thresholdValue,proto3" json:"threshold_value,omitempty"`
-	Type           ThresholdType          `protobuf:"varint,3,opt,name=type,proto3,enum=logistics.simulation.v1.ThresholdType" json:"type,omitempty"`
-	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DiscreteEventStats struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	VehiclesDispatched      int32                  `protobuf:"varint,1,opt,name=vehicles_dispatched,json=vehiclesDispatched,proto3" json:"vehicles_dispatched,omitempty"`
+	VehiclesDelivered       int32                  `protobuf:"varint,2,opt,name=vehicles_delivered,json=vehiclesDelivered,proto3" json:"vehicles_delivered,omitempty"`
+	UnitsDispatched         float64                `protobuf:"fixed64,3,opt,name=units_dispatched,json=unitsDispatched,proto3" json:"units_dispatched,omitempty"`
+	UnitsDeliveredInHorizon float64                `protobuf:"fixed64,4,opt,name=units_delivered_in_horizon,json=unitsDeliveredInHorizon,proto3" json:"units_delivered_in_horizon,omitempty"` // Доставлено до конца окна отправлений
+	Throughput              float64                `protobuf:"fixed64,5,opt,name=throughput,proto3" json:"throughput,omitempty"`                                                              // units_delivered_in_horizon / horizon_hours
+	PlannedThroughput       float64                `protobuf:"fixed64,6,opt,name=planned_throughput,json=plannedThroughput,proto3" json:"planned_throughput,omitempty"`                       // Поток решения, единиц в час
+	MakespanHours           float64                `protobuf:"fixed64,7,opt,name=makespan_hours,json=makespanHours,proto3" json:"makespan_hours,omitempty"`                                   // Окончание последней разгрузки
+	EventsProcessed         int64                  `protobuf:"varint,8,opt,name=events_processed,json=eventsProcessed,proto3" json:"events_processed,omitempty"`
+	AverageWaitHours        float64                `protobuf:"fixed64,9,opt,name=average_wait_hours,json=averageWaitHours,proto3" json:"average_wait_hours,omitempty"` // Среднее ожидание поста за рейс
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *ThresholdPoint) Reset() {
-	*x = ThresholdPoint{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[43]
+func (x *DiscreteEventStats) Reset() {
+	*x = DiscreteEventStats{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ThresholdPoint) String() string {
+func (x *DiscreteEventStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThresholdPoint) ProtoMessage() {}
+func (*DiscreteEventStats) ProtoMessage() {}
 
-func (x *ThresholdPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[43]
+func (x *DiscreteEventStats) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4402,62 +5035,189 @@ func (x *ThresholdPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThresholdPoint.ProtoReflect.Descriptor instead.
-func (*ThresholdPoint) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use DiscreteEventStats.ProtoReflect.Descriptor instead.
+func (*DiscreteEventStats) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ThresholdPoint) GetParameterId() string {
+func (x *DiscreteEventStats) GetVehiclesDispatched() int32 {
 	if x != nil {
-		return x.ParameterId
+		return x.VehiclesDispatched
 	}
-	return ""
+	return 0
 }
 
-func (x *ThresholdPoint) GetThresholdValue() float64 {
+func (x *DiscreteEventStats) GetVehiclesDelivered() int32 {
 	if x != nil {
-		return x.ThresholdValue
+		return x.VehiclesDelivered
 	}
 	return 0
 }
 
-func (x *ThresholdPoint) GetType() ThresholdType {
+func (x *DiscreteEventStats) GetUnitsDispatched() float64 {
 	if x != nil {
-		return x.Type
+		return x.UnitsDispatched
 	}
-	return ThresholdType_THRESHOLD_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *ThresholdPoint) GetDescription() string {
+func (x *DiscreteEventStats) GetUnitsDeliveredInHorizon() float64 {
 	if x != nil {
-		return x.Description
+		return x.UnitsDeliveredInHorizon
 	}
-	return ""
+	return 0
 }
 
-type FindCriticalElementsRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Graph         *v1.Graph               `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
-	Config        *CriticalElementsConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	Algorithm     v1.Algorithm            `protobuf:"varint,3,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DiscreteEventStats) GetThroughput() float64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
 }
 
-func (x *FindCriticalElementsRequest) Reset() {
-	*x = FindCriticalElementsRequest{}
+func (x *DiscreteEventStats) GetPlannedThroughput() float64 {
+	if x != nil {
+		return x.PlannedThroughput
+	}
+	return 0
+}
+
+func (x *DiscreteEventStats) GetMakespanHours() float64 {
+	if x != nil {
+		return x.MakespanHours
+	}
+	return 0
+}
+
+func (x *DiscreteEventStats) GetEventsProcessed() int64 {
+	if x != nil {
+		return x.EventsProcessed
+	}
+	return 0
+}
+
+func (x *DiscreteEventStats) GetAverageWaitHours() float64 {
+	if x != nil {
+		return x.AverageWaitHours
+	}
+	return 0
+}
+
+type NodeQueueStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	NodeId             int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	VehiclesServed     int32                  `protobuf:"varint,2,opt,name=vehicles_served,json=vehiclesServed,proto3" json:"vehicles_served,omitempty"`
+	AverageQueueLength float64                `protobuf:"fixed64,3,opt,name=average_queue_length,json=averageQueueLength,proto3" json:"average_queue_length,omitempty"` // Средняя по времени длина очереди
+	MaxQueueLength     int32                  `protobuf:"varint,4,opt,name=max_queue_length,json=maxQueueLength,proto3" json:"max_queue_length,omitempty"`
+	AverageWaitHours   float64                `protobuf:"fixed64,5,opt,name=average_wait_hours,json=averageWaitHours,proto3" json:"average_wait_hours,omitempty"`
+	MaxWaitHours       float64                `protobuf:"fixed64,6,opt,name=max_wait_hours,json=maxWaitHours,proto3" json:"max_wait_hours,omitempty"`
+	DockUtilization    float64                `protobuf:"fixed64,7,opt,name=dock_utilization,json=dockUtilization,proto3" json:"dock_utilization,omitempty"` // Доля времени занятости постов
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NodeQueueStats) Reset() {
+	*x = NodeQueueStats{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeQueueStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeQueueStats) ProtoMessage() {}
+
+func (x *NodeQueueStats) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeQueueStats.ProtoReflect.Descriptor instead.
+func (*NodeQueueStats) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NodeQueueStats) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *NodeQueueStats) GetVehiclesServed() int32 {
+	if x != nil {
+		return x.VehiclesServed
+	}
+	return 0
+}
+
+func (x *NodeQueueStats) GetAverageQueueLength() float64 {
+	if x != nil {
+		return x.AverageQueueLength
+	}
+	return 0
+}
+
+func (x *NodeQueueStats) GetMaxQueueLength() int32 {
+	if x != nil {
+		return x.MaxQueueLength
+	}
+	return 0
+}
+
+func (x *NodeQueueStats) GetAverageWaitHours() float64 {
+	if x != nil {
+		return x.AverageWaitHours
+	}
+	return 0
+}
+
+func (x *NodeQueueStats) GetMaxWaitHours() float64 {
+	if x != nil {
+		return x.MaxWaitHours
+	}
+	return 0
+}
+
+func (x *NodeQueueStats) GetDockUtilization() float64 {
+	if x != nil {
+		return x.DockUtilization
+	}
+	return 0
+}
+
+type ThroughputPoint struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Hour              int32                  `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"` // Начало часа от старта симуляции
+	UnitsDelivered    float64                `protobuf:"fixed64,2,opt,name=units_delivered,json=unitsDelivered,proto3" json:"units_delivered,omitempty"`
+	VehiclesDelivered int32                  `protobuf:"varint,3,opt,name=vehicles_delivered,json=vehiclesDelivered,proto3" json:"vehicles_delivered,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ThroughputPoint) Reset() {
+	*x = ThroughputPoint{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindCriticalElementsRequest) String() string {
+func (x *ThroughputPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindCriticalElementsRequest) ProtoMessage() {}
+func (*ThroughputPoint) ProtoMessage() {}
 
-func (x *FindCriticalElementsRequest) ProtoReflect() protoreflect.Message {
+func (x *ThroughputPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4469,56 +5229,56 @@ func (x *FindCriticalElementsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindCriticalElementsRequest.ProtoReflect.Descriptor instead.
-func (*FindCriticalElementsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ThroughputPoint.ProtoReflect.Descriptor instead.
+func (*ThroughputPoint) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *FindCriticalElementsRequest) GetGraph() *v1.Graph {
+func (x *ThroughputPoint) GetHour() int32 {
 	if x != nil {
-		return x.Graph
+		return x.Hour
 	}
-	return nil
+	return 0
 }
 
-func (x *FindCriticalElementsRequest) GetConfig() *CriticalElementsConfig {
+func (x *ThroughputPoint) GetUnitsDelivered() float64 {
 	if x != nil {
-		return x.Config
+		return x.UnitsDelivered
 	}
-	return nil
+	return 0
 }
 
-func (x *FindCriticalElementsRequest) GetAlgorithm() v1.Algorithm {
+func (x *ThroughputPoint) GetVehiclesDelivered() int32 {
 	if x != nil {
-		return x.Algorithm
+		return x.VehiclesDelivered
 	}
-	return v1.Algorithm(0)
+	return 0
 }
 
-type CriticalElementsConfig struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AnalyzeEdges     bool                   `protobuf:"varint,1,opt,name=analyze_edges,json=analyzeEdges,proto3" json:"analyze_edges,omitempty"`
-	AnalyzeNodes     bool                   `protobuf:"varint,2,opt,name=analyze_nodes,json=analyzeNodes,proto3" json:"analyze_nodes,omitempty"`
-	TopN             int32                  `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`                                      // Топ N критических
-	FailureThreshold float64                `protobuf:"fixed64,4,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"` // Порог снижения потока для "критичности"
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ShipmentRoute struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	NodeIds              []int64                `protobuf:"varint,1,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Flow                 float64                `protobuf:"fixed64,2,opt,name=flow,proto3" json:"flow,omitempty"` // Единиц в час
+	Vehicles             int32                  `protobuf:"varint,3,opt,name=vehicles,proto3" json:"vehicles,omitempty"`
+	AverageLeadTimeHours float64                `protobuf:"fixed64,4,opt,name=average_lead_time_hours,json=averageLeadTimeHours,proto3" json:"average_lead_time_hours,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *CriticalElementsConfig) Reset() {
-	*x = CriticalElementsConfig{}
+func (x *ShipmentRoute) Reset() {
+	*x = ShipmentRoute{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CriticalElementsConfig) String() string {
+func (x *ShipmentRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CriticalElementsConfig) ProtoMessage() {}
+func (*ShipmentRoute) ProtoMessage() {}
 
-func (x *CriticalElementsConfig) ProtoReflect() protoreflect.Message {
+func (x *ShipmentRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4530,67 +5290,67 @@ func (x *CriticalElementsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CriticalElementsConfig.ProtoReflect.Descriptor instead.
-func (*CriticalElementsConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShipmentRoute.ProtoReflect.Descriptor instead.
+func (*ShipmentRoute) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CriticalElementsConfig) GetAnalyzeEdges() bool {
+func (x *ShipmentRoute) GetNodeIds() []int64 {
 	if x != nil {
-		return x.AnalyzeEdges
+		return x.NodeIds
 	}
-	return false
+	return nil
 }
 
-func (x *CriticalElementsConfig) GetAnalyzeNodes() bool {
+func (x *ShipmentRoute) GetFlow() float64 {
 	if x != nil {
-		return x.AnalyzeNodes
+		return x.Flow
 	}
-	return false
+	return 0
 }
 
-func (x *CriticalElementsConfig) GetTopN() int32 {
+func (x *ShipmentRoute) GetVehicles() int32 {
 	if x != nil {
-		return x.TopN
+		return x.Vehicles
 	}
 	return 0
 }
 
-func (x *CriticalElementsConfig) GetFailureThreshold() float64 {
+func (x *ShipmentRoute) GetAverageLeadTimeHours() float64 {
 	if x != nil {
-		return x.FailureThreshold
+		return x.AverageLeadTimeHours
 	}
 	return 0
 }
 
-type FindCriticalElementsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	CriticalEdges []*CriticalEdge        `protobuf:"bytes,2,rep,name=critical_edges,json=criticalEdges,proto3" json:"critical_edges,omitempty"`
-	CriticalNodes []*CriticalNode        `protobuf:"bytes,3,rep,name=critical_nodes,json=criticalNodes,proto3" json:"critical_nodes,omitempty"`
-	// Single Point of Failure
-	SinglePointsOfFailure []*v1.EdgeKey `protobuf:"bytes,4,rep,name=single_points_of_failure,json=singlePointsOfFailure,proto3" json:"single_points_of_failure,omitempty"`
-	// Общая оценка устойчивости
-	ResilienceScore float64             `protobuf:"fixed64,5,opt,name=resilience_score,json=resilienceScore,proto3" json:"resilience_score,omitempty"` // 0-1
-	Metadata        *SimulationMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type RunMonteCarloRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	// Конфигурация Monte Carlo
+	Config *MonteCarloConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// Неопределённости
+	Uncertainties []*UncertaintySpec `protobuf:"bytes,3,rep,name=uncertainties,proto3" json:"uncertainties,omitempty"`
+	Algorithm     v1.Algorithm       `protobuf:"varint,4,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	// Корреляции между неопределённостями (без него неопределённости независимы)
+	Correlation   *UncertaintyCorrelation `protobuf:"bytes,5,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindCriticalElementsResponse) Reset() {
-	*x = FindCriticalElementsResponse{}
+func (x *RunMonteCarloRequest) Reset() {
+	*x = RunMonteCarloRequest{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindCriticalElementsResponse) String() string {
+func (x *RunMonteCarloRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindCriticalElementsResponse) ProtoMessage() {}
+func (*RunMonteCarloRequest) ProtoMessage() {}
 
-func (x *FindCriticalElementsResponse) ProtoReflect() protoreflect.Message {
+func (x *RunMonteCarloRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4602,79 +5362,76 @@ func (x *FindCriticalElementsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindCriticalElementsResponse.ProtoReflect.Descriptor instead.
-func (*FindCriticalElementsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunMonteCarloRequest.ProtoReflect.Descriptor instead.
+func (*RunMonteCarloRequest) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *FindCriticalElementsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *FindCriticalElementsResponse) GetCriticalEdges() []*CriticalEdge {
+func (x *RunMonteCarloRequest) GetGraph() *v1.Graph {
 	if x != nil {
-		return x.CriticalEdges
+		return x.Graph
 	}
 	return nil
 }
 
-func (x *FindCriticalElementsResponse) GetCriticalNodes() []*CriticalNode {
+func (x *RunMonteCarloRequest) GetConfig() *MonteCarloConfig {
 	if x != nil {
-		return x.CriticalNodes
+		return x.Config
 	}
 	return nil
 }
 
-func (x *FindCriticalElementsResponse) GetSinglePointsOfFailure() []*v1.EdgeKey {
+func (x *RunMonteCarloRequest) GetUncertainties() []*UncertaintySpec {
 	if x != nil {
-		return x.SinglePointsOfFailure
+		return x.Uncertainties
 	}
 	return nil
 }
 
-func (x *FindCriticalElementsResponse) GetResilienceScore() float64 {
+func (x *RunMonteCarloRequest) GetAlgorithm() v1.Algorithm {
 	if x != nil {
-		return x.ResilienceScore
+		return x.Algorithm
 	}
-	return 0
+	return v1.Algorithm(0)
 }
 
-func (x *FindCriticalElementsResponse) GetMetadata() *SimulationMetadata {
+func (x *RunMonteCarloRequest) GetCorrelation() *UncertaintyCorrelation {
 	if x != nil {
-		return x.Metadata
+		return x.Correlation
 	}
 	return nil
 }
 
-type CriticalEdge struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Edge                   *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
-	CriticalityScore       float64                `protobuf:"fixed64,2,opt,name=criticality_score,json=criticalityScore,proto3" json:"criticality_score,omitempty"`              // 0-1
-	FlowImpactIfRemoved    float64                `protobuf:"fixed64,3,opt,name=flow_impact_if_removed,json=flowImpactIfRemoved,proto3" json:"flow_impact_if_removed,omitempty"` // Снижение потока
-	CostImpactIfRemoved    float64                `protobuf:"fixed64,4,opt,name=cost_impact_if_removed,json=costImpactIfRemoved,proto3" json:"cost_impact_if_removed,omitempty"` // Увеличение стоимости
-	Rank                   int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
-	IsSinglePointOfFailure bool                   `protobuf:"varint,6,opt,name=is_single_point_of_failure,json=isSinglePointOfFailure,proto3" json:"is_single_point_of_failure,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+// Зависимость неопределённостей задаётся гауссовой копулой: маргинальные
+// распределения Distribution сохраняются, а совместные отклонения (в том числе
+// хвосты, определяющие VaR и CVaR) коррелируют. Задаётся полной матрицей или группами
+type UncertaintyCorrelation struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Measure CorrelationMeasure     `protobuf:"varint,1,opt,name=measure,proto3,enum=logistics.simulation.v1.CorrelationMeasure" json:"measure,omitempty"`
+	// Полная матрица n×n по строкам, n = len(uncertainties): симметричная,
+	// с единицами на диагонали, положительно определённая
+	Matrix []float64 `protobuf:"fixed64,2,rep,packed,name=matrix,proto3" json:"matrix,omitempty"`
+	// Группы: неопределённости группы попарно коррелируют с одним коэффициентом,
+	// между группами корреляции нет. Неопределённость входит не более чем в одну группу
+	Groups        []*CorrelationGroup `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CriticalEdge) Reset() {
-	*x = CriticalEdge{}
+func (x *UncertaintyCorrelation) Reset() {
+	*x = UncertaintyCorrelation{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CriticalEdge) String() string {
+func (x *UncertaintyCorrelation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CriticalEdge) ProtoMessage() {}
+func (*UncertaintyCorrelation) ProtoMessage() {}
 
-func (x *CriticalEdge) ProtoReflect() protoreflect.Message {
+func (x *UncertaintyCorrelation) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4686,79 +5443,54 @@ func (x *CriticalEdge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CriticalEdge.ProtoReflect.Descriptor instead.
-func (*CriticalEdge) Descriptor() ([]byte, []int) {
+// Deprecated: Use UncertaintyCorrelation.ProtoReflect.Descriptor instead.
+func (*UncertaintyCorrelation) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CriticalEdge) GetEdge() *v1.EdgeKey {
+func (x *UncertaintyCorrelation) GetMeasure() CorrelationMeasure {
 	if x != nil {
-		return x.Edge
+		return x.Measure
 	}
-	return nil
+	return CorrelationMeasure_CORRELATION_MEASURE_UNSPECIFIED
 }
 
-func (x *CriticalEdge) GetCriticalityScore() float64 {
+func (x *UncertaintyCorrelation) GetMatrix() []float64 {
 	if x != nil {
-		return x.CriticalityScore
+		return x.Matrix
 	}
-	return 0
+	return nil
 }
 
-func (x *CriticalEdge) GetFlowImpactIfRemoved() float64 {
+func (x *UncertaintyCorrelation) GetGroups() []*CorrelationGroup {
 	if x != nil {
-		return x.FlowImpactIfRemoved
+		return x.Groups
 	}
-	return 0
+	return nil
 }
 
-func (x *CriticalEdge) GetCostImpactIfRemoved() float64 {
-	if x != nil {
-		return x.CostImpactIfRemoved
-	}
-	return 0
+type CorrelationGroup struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UncertaintyIndices []int32                `protobuf:"varint,1,rep,packed,name=uncertainty_indices,json=uncertaintyIndices,proto3" json:"uncertainty_indices,omitempty"` // Индексы в RunMonteCarloRequest.uncertainties
+	Correlation        float64                `protobuf:"fixed64,2,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CriticalEdge) GetRank() int32 {
-	if x != nil {
-		return x.Rank
-	}
-	return 0
-}
-
-func (x *CriticalEdge) GetIsSinglePointOfFailure() bool {
-	if x != nil {
-		return x.IsSinglePointOfFailure
-	}
-	return false
-}
-
-type CriticalNode struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	NodeId                 int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	CriticalityScore       float64                `protobuf:"fixed64,2,opt,name=criticality_score,json=criticalityScore,proto3" json:"criticality_score,omitempty"`
-	FlowImpactIfRemoved    float64                `protobuf:"fixed64,3,opt,name=flow_impact_if_removed,json=flowImpactIfRemoved,proto3" json:"flow_impact_if_removed,omitempty"`
-	AffectedEdges          int32                  `protobuf:"varint,4,opt,name=affected_edges,json=affectedEdges,proto3" json:"affected_edges,omitempty"`
-	Rank                   int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
-	IsSinglePointOfFailure bool                   `protobuf:"varint,6,opt,name=is_single_point_of_failure,json=isSinglePointOfFailure,proto3" json:"is_single_point_of_failure,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *CriticalNode) Reset() {
-	*x = CriticalNode{}
+func (x *CorrelationGroup) Reset() {
+	*x = CorrelationGroup{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CriticalNode) String() string {
+func (x *CorrelationGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CriticalNode) ProtoMessage() {}
+func (*CorrelationGroup) ProtoMessage() {}
 
-func (x *CriticalNode) ProtoReflect() protoreflect.Message {
+func (x *CorrelationGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4770,147 +5502,182 @@ func (x *CriticalNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CriticalNode.ProtoReflect.Descriptor instead.
-func (*CriticalNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use CorrelationGroup.ProtoReflect.Descriptor instead.
+func (*CorrelationGroup) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CriticalNode) GetNodeId() int64 {
+func (x *CorrelationGroup) GetUncertaintyIndices() []int32 {
 	if x != nil {
-		return x.NodeId
+		return x.UncertaintyIndices
 	}
-	return 0
+	return nil
 }
 
-func (x *CriticalNode) GetCriticalityScore() float64 {
+func (x *CorrelationGroup) GetCorrelation() float64 {
 	if x != nil {
-		return x.CriticalityScore
+		return x.Correlation
 	}
 	return 0
 }
 
-func (x *CriticalNode) GetFlowImpactIfRemoved() float64 {
+type MonteCarloConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NumIterations   int32                  `protobuf:"varint,1,opt,name=num_iterations,json=numIterations,proto3" json:"num_iterations,omitempty"`        // Количество итераций
+	RandomSeed      int64                  `protobuf:"varint,2,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`                 // Seed для воспроизводимости (0 = random)
+	ConfidenceLevel float64                `protobuf:"fixed64,3,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"` // 0.95 для 95% CI
+	Parallel        bool                   `protobuf:"varint,4,opt,name=parallel,proto3" json:"parallel,omitempty"`                                       // Параллельное выполнение
+	MaxWorkers      int32                  `protobuf:"varint,5,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`                 // Макс. воркеров
+	// Возвращать сэмплы каждой итерации в RunMonteCarloResponse.samples
+	ReturnSamples bool `protobuf:"varint,6,opt,name=return_samples,json=returnSamples,proto3" json:"return_samples,omitempty"`
+	// Выполнить только указанные итерации (воспроизведение прогона с тем же random_seed,
+	// random_seed обязателен). Каждая итерация использует собственный поток случайных
+	// чисел из seed и своего номера, поэтому результат не зависит от parallel и max_workers
+	ReplayIterations []int32 `protobuf:"varint,7,rep,packed,name=replay_iterations,json=replayIterations,proto3" json:"replay_iterations,omitempty"`
+	// Метод сэмплирования неопределённостей. Для LATIN_HYPERCUBE и SOBOL итерации делятся
+	// на независимые реплики, по разбросу которых считаются доверительный интервал и
+	// effective_sample_size; воспроизведение итераций требует того же num_iterations
+	SamplingMethod SamplingMethod `protobuf:"varint,8,opt,name=sampling_method,json=samplingMethod,proto3,enum=logistics.simulation.v1.SamplingMethod" json:"sampling_method,omitempty"`
+	// Адаптивная остановка: прогон завершается, как только относительная полуширина
+	// доверительного интервала (MonteCarloStats.relative_ci_half_width) и потока, и
+	// стоимости не превышает этого значения (0.01 = ±1% от среднего). num_iterations
+	// задаёт верхнюю границу. 0 — выполнить все итерации. Не применяется к replay_iterations
+	TargetRelativeCiHalfWidth float64 `protobuf:"fixed64,9,opt,name=target_relative_ci_half_width,json=targetRelativeCiHalfWidth,proto3" json:"target_relative_ci_half_width,omitempty"`
+	// Ограничение времени прогона в секундах (0 — без ограничения)
+	TimeBudgetSeconds float64 `protobuf:"fixed64,10,opt,name=time_budget_seconds,json=timeBudgetSeconds,proto3" json:"time_budget_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MonteCarloConfig) Reset() {
+	*x = MonteCarloConfig{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonteCarloConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonteCarloConfig) ProtoMessage() {}
+
+func (x *MonteCarloConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[49]
 	if x != nil {
-		return x.FlowImpactIfRemoved
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CriticalNode) GetAffectedEdges() int32 {
+// Deprecated: Use MonteCarloConfig.ProtoReflect.Descriptor instead.
+func (*MonteCarloConfig) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MonteCarloConfig) GetNumIterations() int32 {
 	if x != nil {
-		return x.AffectedEdges
+		return x.NumIterations
 	}
 	return 0
 }
 
-func (x *CriticalNode) GetRank() int32 {
+func (x *MonteCarloConfig) GetRandomSeed() int64 {
 	if x != nil {
-		return x.Rank
+		return x.RandomSeed
 	}
 	return 0
 }
 
-func (x *CriticalNode) GetIsSinglePointOfFailure() bool {
+func (x *MonteCarloConfig) GetConfidenceLevel() float64 {
 	if x != nil {
-		return x.IsSinglePointOfFailure
+		return x.ConfidenceLevel
 	}
-	return false
-}
-
-type SimulateFailuresRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
-	// Сценарии отказов
-	FailureScenarios []*FailureScenario `protobuf:"bytes,2,rep,name=failure_scenarios,json=failureScenarios,proto3" json:"failure_scenarios,omitempty"`
-	// Или случайные отказы
-	RandomConfig  *RandomFailureConfig `protobuf:"bytes,3,opt,name=random_config,json=randomConfig,proto3" json:"random_config,omitempty"`
-	Algorithm     v1.Algorithm         `protobuf:"varint,4,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SimulateFailuresRequest) Reset() {
-	*x = SimulateFailuresRequest{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[49]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *SimulateFailuresRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MonteCarloConfig) GetParallel() bool {
+	if x != nil {
+		return x.Parallel
+	}
+	return false
 }
 
-func (*SimulateFailuresRequest) ProtoMessage() {}
-
-func (x *SimulateFailuresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[49]
+func (x *MonteCarloConfig) GetMaxWorkers() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaxWorkers
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SimulateFailuresRequest.ProtoReflect.Descriptor instead.
-func (*SimulateFailuresRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{49}
+func (x *MonteCarloConfig) GetReturnSamples() bool {
+	if x != nil {
+		return x.ReturnSamples
+	}
+	return false
 }
 
-func (x *SimulateFailuresRequest) GetGraph() *v1.Graph {
+func (x *MonteCarloConfig) GetReplayIterations() []int32 {
 	if x != nil {
-		return x.Graph
+		return x.ReplayIterations
 	}
 	return nil
 }
 
-func (x *SimulateFailuresRequest) GetFailureScenarios() []*FailureScenario {
+func (x *MonteCarloConfig) GetSamplingMethod() SamplingMethod {
 	if x != nil {
-		return x.FailureScenarios
+		return x.SamplingMethod
 	}
-	return nil
+	return SamplingMethod_SAMPLING_METHOD_UNSPECIFIED
 }
 
-func (x *SimulateFailuresRequest) GetRandomConfig() *RandomFailureConfig {
+func (x *MonteCarloConfig) GetTargetRelativeCiHalfWidth() float64 {
 	if x != nil {
-		return x.RandomConfig
+		return x.TargetRelativeCiHalfWidth
 	}
-	return nil
+	return 0
 }
 
-func (x *SimulateFailuresRequest) GetAlgorithm() v1.Algorithm {
+func (x *MonteCarloConfig) GetTimeBudgetSeconds() float64 {
 	if x != nil {
-		return x.Algorithm
+		return x.TimeBudgetSeconds
 	}
-	return v1.Algorithm(0)
+	return 0
 }
 
-type FailureScenario struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	FailedEdges   []*v1.EdgeKey          `protobuf:"bytes,2,rep,name=failed_edges,json=failedEdges,proto3" json:"failed_edges,omitempty"`
-	FailedNodes   []int64                `protobuf:"varint,3,rep,packed,name=failed_nodes,json=failedNodes,proto3" json:"failed_nodes,omitempty"`
-	Probability   float64                `protobuf:"fixed64,4,opt,name=probability,proto3" json:"probability,omitempty"` // Вероятность сценария
+type UncertaintySpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  UncertaintyType        `protobuf:"varint,1,opt,name=type,proto3,enum=logistics.simulation.v1.UncertaintyType" json:"type,omitempty"`
+	// Для edge uncertainties
+	Edge *v1.EdgeKey `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
+	// Для node uncertainties
+	NodeId int64 `protobuf:"varint,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Какой параметр
+	Target ModificationTarget `protobuf:"varint,4,opt,name=target,proto3,enum=logistics.simulation.v1.ModificationTarget" json:"target,omitempty"`
+	// Распределение
+	Distribution  *Distribution `protobuf:"bytes,5,opt,name=distribution,proto3" json:"distribution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FailureScenario) Reset() {
-	*x = FailureScenario{}
+func (x *UncertaintySpec) Reset() {
+	*x = UncertaintySpec{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FailureScenario) String() string {
+func (x *UncertaintySpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FailureScenario) ProtoMessage() {}
+func (*UncertaintySpec) ProtoMessage() {}
 
-func (x *FailureScenario) ProtoReflect() protoreflect.Message {
+func (x *UncertaintySpec) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4922,64 +5689,71 @@ func (x *FailureScenario) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FailureScenario.ProtoReflect.Descriptor instead.
-func (*FailureScenario) Descriptor() ([]byte, []int) {
+// Deprecated: Use UncertaintySpec.ProtoReflect.Descriptor instead.
+func (*UncertaintySpec) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *FailureScenario) GetName() string {
+func (x *UncertaintySpec) GetType() UncertaintyType {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
-	return ""
+	return UncertaintyType_UNCERTAINTY_TYPE_UNSPECIFIED
 }
 
-func (x *FailureScenario) GetFailedEdges() []*v1.EdgeKey {
+func (x *UncertaintySpec) GetEdge() *v1.EdgeKey {
 	if x != nil {
-		return x.FailedEdges
+		return x.Edge
 	}
 	return nil
 }
 
-func (x *FailureScenario) GetFailedNodes() []int64 {
+func (x *UncertaintySpec) GetNodeId() int64 {
 	if x != nil {
-		return x.FailedNodes
+		return x.NodeId
 	}
-	return nil
+	return 0
 }
 
-func (x *FailureScenario) GetProbability() float64 {
+func (x *UncertaintySpec) GetTarget() ModificationTarget {
 	if x != nil {
-		return x.Probability
+		return x.Target
 	}
-	return 0
+	return ModificationTarget_MODIFICATION_TARGET_UNSPECIFIED
 }
 
-type RandomFailureConfig struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	NumScenarios            int32                  `protobuf:"varint,1,opt,name=num_scenarios,json=numScenarios,proto3" json:"num_scenarios,omitempty"`
-	EdgeFailureProbability  float64                `protobuf:"fixed64,2,opt,name=edge_failure_probability,json=edgeFailureProbability,proto3" json:"edge_failure_probability,omitempty"` // P(edge fails)
-	NodeFailureProbability  float64                `protobuf:"fixed64,3,opt,name=node_failure_probability,json=nodeFailureProbability,proto3" json:"node_failure_probability,omitempty"`
-	MaxSimultaneousFailures int32                  `protobuf:"varint,4,opt,name=max_simultaneous_failures,json=maxSimultaneousFailures,proto3" json:"max_simultaneous_failures,omitempty"`
-	CorrelatedFailures      bool                   `protobuf:"varint,5,opt,name=correlated_failures,json=correlatedFailures,proto3" json:"correlated_failures,omitempty"` // Связанные отказы
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *UncertaintySpec) GetDistribution() *Distribution {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
 }
 
-func (x *RandomFailureConfig) Reset() {
-	*x = RandomFailureConfig{}
+type Distribution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  DistributionType       `protobuf:"varint,1,opt,name=type,proto3,enum=logistics.simulation.v1.DistributionType" json:"type,omitempty"`
+	// Параметры распределения
+	Param1        float64 `protobuf:"fixed64,2,opt,name=param1,proto3" json:"param1,omitempty"` // mean для Normal, min для Uniform
+	Param2        float64 `protobuf:"fixed64,3,opt,name=param2,proto3" json:"param2,omitempty"` // std_dev для Normal, max для Uniform
+	Param3        float64 `protobuf:"fixed64,4,opt,name=param3,proto3" json:"param3,omitempty"` // Дополнительный параметр
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Distribution) Reset() {
+	*x = Distribution{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RandomFailureConfig) String() string {
+func (x *Distribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandomFailureConfig) ProtoMessage() {}
+func (*Distribution) ProtoMessage() {}
 
-func (x *RandomFailureConfig) ProtoReflect() protoreflect.Message {
+func (x *Distribution) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4991,74 +5765,78 @@ func (x *RandomFailureConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandomFailureConfig.ProtoReflect.Descriptor instead.
-func (*RandomFailureConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use Distribution.ProtoReflect.Descriptor instead.
+func (*Distribution) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RandomFailureConfig) GetNumScenarios() int32 {
+func (x *Distribution) GetType() DistributionType {
 	if x != nil {
-		return x.NumScenarios
+		return x.Type
 	}
-	return 0
+	return DistributionType_DISTRIBUTION_TYPE_UNSPECIFIED
 }
 
-func (x *RandomFailureConfig) GetEdgeFailureProbability() float64 {
+func (x *Distribution) GetParam1() float64 {
 	if x != nil {
-		return x.EdgeFailureProbability
+		return x.Param1
 	}
 	return 0
 }
 
-func (x *RandomFailureConfig) GetNodeFailureProbability() float64 {
+func (x *Distribution) GetParam2() float64 {
 	if x != nil {
-		return x.NodeFailureProbability
+		return x.Param2
 	}
 	return 0
 }
 
-func (x *RandomFailureConfig) GetMaxSimultaneousFailures() int32 {
+func (x *Distribution) GetParam3() float64 {
 	if x != nil {
-		return x.MaxSimultaneousFailures
+		return x.Param3
 	}
 	return 0
 }
 
-func (x *RandomFailureConfig) GetCorrelatedFailures() bool {
-	if x != nil {
-		return x.CorrelatedFailures
-	}
-	return false
-}
-
-type SimulateFailuresResponse struct {
-	state           protoimpl.MessageState   `protogen:"open.v1"`
-	Success         bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Baseline        *ScenarioResult          `protobuf:"bytes,2,opt,name=baseline,proto3" json:"baseline,omitempty"`
-	ScenarioResults []*FailureScenarioResult `protobuf:"bytes,3,rep,name=scenario_results,json=scenarioResults,proto3" json:"scenario_results,omitempty"`
-	// Агрегированная статистика
-	Stats *FailureStats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
-	// Рекомендации по повышению устойчивости
-	Recommendations []*ResilienceRecommendation `protobuf:"bytes,5,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
-	Metadata        *SimulationMetadata         `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type RunMonteCarloResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Статистика результатов
+	FlowStats *MonteCarloStats `protobuf:"bytes,2,opt,name=flow_stats,json=flowStats,proto3" json:"flow_stats,omitempty"`
+	CostStats *MonteCarloStats `protobuf:"bytes,3,opt,name=cost_stats,json=costStats,proto3" json:"cost_stats,omitempty"`
+	// Распределение результатов
+	FlowHistogram []*HistogramBucket `protobuf:"bytes,4,rep,name=flow_histogram,json=flowHistogram,proto3" json:"flow_histogram,omitempty"`
+	CostHistogram []*HistogramBucket `protobuf:"bytes,5,rep,name=cost_histogram,json=costHistogram,proto3" json:"cost_histogram,omitempty"`
+	// Percentiles
+	FlowPercentiles map[string]float64 `protobuf:"bytes,6,rep,name=flow_percentiles,json=flowPercentiles,proto3" json:"flow_percentiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // "p5", "p25", "p50", "p75", "p95"
+	CostPercentiles map[string]float64 `protobuf:"bytes,7,rep,name=cost_percentiles,json=costPercentiles,proto3" json:"cost_percentiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Анализ рисков
+	RiskAnalysis *RiskAnalysis `protobuf:"bytes,8,opt,name=risk_analysis,json=riskAnalysis,proto3" json:"risk_analysis,omitempty"`
+	// Корреляции
+	Correlations        []*ParameterCorrelation `protobuf:"bytes,9,rep,name=correlations,proto3" json:"correlations,omitempty"`
+	Metadata            *SimulationMetadata     `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	RandomSeed          int64                   `protobuf:"varint,11,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"` // Использованный seed (сгенерированный, если в запросе 0)
+	Samples             []*MonteCarloSample     `protobuf:"bytes,12,rep,name=samples,proto3" json:"samples,omitempty"`                          // Только при return_samples, по возрастанию iteration
+	StopReason          MonteCarloStopReason    `protobuf:"varint,13,opt,name=stop_reason,json=stopReason,proto3,enum=logistics.simulation.v1.MonteCarloStopReason" json:"stop_reason,omitempty"`
+	CompletedIterations int32                   `protobuf:"varint,14,opt,name=completed_iterations,json=completedIterations,proto3" json:"completed_iterations,omitempty"` // Число итераций, вошедших в статистику
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *SimulateFailuresResponse) Reset() {
-	*x = SimulateFailuresResponse{}
+func (x *RunMonteCarloResponse) Reset() {
+	*x = RunMonteCarloResponse{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateFailuresResponse) String() string {
+func (x *RunMonteCarloResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateFailuresResponse) ProtoMessage() {}
+func (*RunMonteCarloResponse) ProtoMessage() {}
 
-func (x *SimulateFailuresResponse) ProtoReflect() protoreflect.Message {
+func (x *RunMonteCarloResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5070,154 +5848,136 @@ func (x *SimulateFailuresResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateFailuresResponse.ProtoReflect.Descriptor instead.
-func (*SimulateFailuresResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunMonteCarloResponse.ProtoReflect.Descriptor instead.
+func (*RunMonteCarloResponse) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SimulateFailuresResponse) GetSuccess() bool {
+func (x *RunMonteCarloResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *SimulateFailuresResponse) GetBaseline() *ScenarioResult {
+func (x *RunMonteCarloResponse) GetFlowStats() *MonteCarloStats {
 	if x != nil {
-		return x.Baseline
+		return x.FlowStats
 	}
 	return nil
 }
 
-func (x *SimulateFailuresResponse) GetScenarioResults() []*FailureScenarioResult {
+func (x *RunMonteCarloResponse) GetCostStats() *MonteCarloStats {
 	if x != nil {
-		return x.ScenarioResults
+		return x.CostStats
 	}
 	return nil
 }
 
-func (x *SimulateFailuresResponse) GetStats() *FailureStats {
+func (x *RunMonteCarloResponse) GetFlowHistogram() []*HistogramBucket {
 	if x != nil {
-		return x.Stats
+		return x.FlowHistogram
 	}
 	return nil
 }
 
-func (x *SimulateFailuresResponse) GetRecommendations() []*ResilienceRecommendation {
+func (x *RunMonteCarloResponse) GetCostHistogram() []*HistogramBucket {
 	if x != nil {
-		return x.Recommendations
+		return x.CostHistogram
 	}
 	return nil
 }
 
-func (x *SimulateFailuresResponse) GetMetadata() *SimulationMetadata {
+func (x *RunMonteCarloResponse) GetFlowPercentiles() map[string]float64 {
 	if x != nil {
-		return x.Metadata
+		return x.FlowPercentiles
 	}
 	return nil
 }
 
-type FailureScenarioResult struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ScenarioName        string                 `protobuf:"bytes,1,opt,name=scenario_name,json=scenarioName,proto3" json:"scenario_name,omitempty"`
-	Probability         float64                `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"`
-	Result              *ScenarioResult        `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
-	VsBaseline          *ScenarioComparison    `protobuf:"bytes,4,opt,name=vs_baseline,json=vsBaseline,proto3" json:"vs_baseline,omitempty"`
-	NetworkDisconnected bool                   `protobuf:"varint,5,opt,name=network_disconnected,json=networkDisconnected,proto3" json:"network_disconnected,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
-}
-
-func (x *FailureScenarioResult) Reset() {
-	*x = FailureScenarioResult{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[53]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *FailureScenarioResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RunMonteCarloResponse) GetCostPercentiles() map[string]float64 {
+	if x != nil {
+		return x.CostPercentiles
+	}
+	return nil
 }
 
-func (*FailureScenarioResult) ProtoMessage() {}
-
-func (x *FailureScenarioResult) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[53]
+func (x *RunMonteCarloResponse) GetRiskAnalysis() *RiskAnalysis {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RiskAnalysis
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use FailureScenarioResult.ProtoReflect.Descriptor instead.
-func (*FailureScenarioResult) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{53}
+func (x *RunMonteCarloResponse) GetCorrelations() []*ParameterCorrelation {
+	if x != nil {
+		return x.Correlations
+	}
+	return nil
 }
 
-func (x *FailureScenarioResult) GetScenarioName() string {
+func (x *RunMonteCarloResponse) GetMetadata() *SimulationMetadata {
 	if x != nil {
-		return x.ScenarioName
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *FailureScenarioResult) GetProbability() float64 {
+func (x *RunMonteCarloResponse) GetRandomSeed() int64 {
 	if x != nil {
-		return x.Probability
+		return x.RandomSeed
 	}
 	return 0
 }
 
-func (x *FailureScenarioResult) GetResult() *ScenarioResult {
+func (x *RunMonteCarloResponse) GetSamples() []*MonteCarloSample {
 	if x != nil {
-		return x.Result
+		return x.Samples
 	}
 	return nil
 }
 
-func (x *FailureScenarioResult) GetVsBaseline() *ScenarioComparison {
+func (x *RunMonteCarloResponse) GetStopReason() MonteCarloStopReason {
 	if x != nil {
-		return x.VsBaseline
+		return x.StopReason
 	}
-	return nil
+	return MonteCarloStopReason_MONTE_CARLO_STOP_REASON_UNSPECIFIED
 }
 
-func (x *FailureScenarioResult) GetNetworkDisconnected() bool {
+func (x *RunMonteCarloResponse) GetCompletedIterations() int32 {
 	if x != nil {
-		return x.NetworkDisconnected
+		return x.CompletedIterations
 	}
-	return false
+	return 0
 }
 
-type FailureStats struct {
-	state                      protoimpl.MessageState `protogen:"open.v1"`
-	ExpectedFlowLoss           float64                `protobuf:"fixed64,1,opt,name=expected_flow_loss,json=expectedFlowLoss,proto3" json:"expected_flow_loss,omitempty"` // E[baseline_flow - scenario_flow]
-	MaxFlowLoss                float64                `protobuf:"fixed64,2,opt,name=max_flow_loss,json=maxFlowLoss,proto3" json:"max_flow_loss,omitempty"`
-	ProbabilityOfDisconnection float64                `protobuf:"fixed64,3,opt,name=probability_of_disconnection,json=probabilityOfDisconnection,proto3" json:"probability_of_disconnection,omitempty"`
-	AverageRecoveryPotential   float64                `protobuf:"fixed64,4,opt,name=average_recovery_potential,json=averageRecoveryPotential,proto3" json:"average_recovery_potential,omitempty"`
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+// Сэмпл одной итерации Monte Carlo
+type MonteCarloSample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iteration     int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Multipliers   []float64              `protobuf:"fixed64,2,rep,packed,name=multipliers,proto3" json:"multipliers,omitempty"` // Множители в порядке RunMonteCarloRequest.uncertainties
+	Flow          float64                `protobuf:"fixed64,3,opt,name=flow,proto3" json:"flow,omitempty"`
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"` // Ошибка solver (flow и cost тогда 0)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FailureStats) Reset() {
-	*x = FailureStats{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[54]
+func (x *MonteCarloSample) Reset() {
+	*x = MonteCarloSample{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FailureStats) String() string {
+func (x *MonteCarloSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FailureStats) ProtoMessage() {}
+func (*MonteCarloSample) ProtoMessage() {}
 
-func (x *FailureStats) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[54]
+func (x *MonteCarloSample) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5228,66 +5988,82 @@ func (x *FailureStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FailureStats.ProtoReflect.Descriptor instead.
-func (*FailureStats) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use MonteCarloSample.ProtoReflect.Descriptor instead.
+func (*MonteCarloSample) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *FailureStats) GetExpectedFlowLoss() float64 {
+func (x *MonteCarloSample) GetIteration() int32 {
 	if x != nil {
-		return x.ExpectedFlowLoss
+		return x.Iteration
 	}
 	return 0
 }
 
-func (x *FailureStats) GetMaxFlowLoss() float64 {
+func (x *MonteCarloSample) GetMultipliers() []float64 {
 	if x != nil {
-		return x.MaxFlowLoss
+		return x.Multipliers
 	}
-	return 0
+	return nil
 }
 
-func (x *FailureStats) GetProbabilityOfDisconnection() float64 {
+func (x *MonteCarloSample) GetFlow() float64 {
 	if x != nil {
-		return x.ProbabilityOfDisconnection
+		return x.Flow
 	}
 	return 0
 }
 
-func (x *FailureStats) GetAverageRecoveryPotential() float64 {
+func (x *MonteCarloSample) GetCost() float64 {
 	if x != nil {
-		return x.AverageRecoveryPotential
+		return x.Cost
 	}
 	return 0
 }
 
-type ResilienceRecommendation struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Type                 RecommendationType     `protobuf:"varint,1,opt,name=type,proto3,enum=logistics.simulation.v1.RecommendationType" json:"type,omitempty"`
-	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AffectedEdge         *v1.EdgeKey            `protobuf:"bytes,3,opt,name=affected_edge,json=affectedEdge,proto3" json:"affected_edge,omitempty"`
-	AffectedNode         int64                  `protobuf:"varint,4,opt,name=affected_node,json=affectedNode,proto3" json:"affected_node,omitempty"`
-	EstimatedImprovement float64                `protobuf:"fixed64,5,opt,name=estimated_improvement,json=estimatedImprovement,proto3" json:"estimated_improvement,omitempty"`
-	EstimatedCost        float64                `protobuf:"fixed64,6,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *MonteCarloSample) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *ResilienceRecommendation) Reset() {
-	*x = ResilienceRecommendation{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[55]
+type MonteCarloStats struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Mean                   float64                `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
+	StdDev                 float64                `protobuf:"fixed64,2,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	Min                    float64                `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max                    float64                `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Median                 float64                `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
+	Variance               float64                `protobuf:"fixed64,6,opt,name=variance,proto3" json:"variance,omitempty"`
+	Skewness               float64                `protobuf:"fixed64,7,opt,name=skewness,proto3" json:"skewness,omitempty"`
+	Kurtosis               float64                `protobuf:"fixed64,8,opt,name=kurtosis,proto3" json:"kurtosis,omitempty"`
+	ConfidenceIntervalLow  float64                `protobuf:"fixed64,9,opt,name=confidence_interval_low,json=confidenceIntervalLow,proto3" json:"confidence_interval_low,omitempty"` // Доверительный интервал среднего с учётом метода сэмплирования
+	ConfidenceIntervalHigh float64                `protobuf:"fixed64,10,opt,name=confidence_interval_high,json=confidenceIntervalHigh,proto3" json:"confidence_interval_high,omitempty"`
+	// Эффективный размер выборки: число независимых случайных сэмплов,
+	// дающих ту же точность среднего
+	EffectiveSampleSize float64 `protobuf:"fixed64,11,opt,name=effective_sample_size,json=effectiveSampleSize,proto3" json:"effective_sample_size,omitempty"`
+	// Полуширина доверительного интервала, делённая на |mean|
+	RelativeCiHalfWidth float64 `protobuf:"fixed64,12,opt,name=relative_ci_half_width,json=relativeCiHalfWidth,proto3" json:"relative_ci_half_width,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MonteCarloStats) Reset() {
+	*x = MonteCarloStats{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResilienceRecommendation) String() string {
+func (x *MonteCarloStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResilienceRecommendation) ProtoMessage() {}
+func (*MonteCarloStats) ProtoMessage() {}
 
-func (x *ResilienceRecommendation) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[55]
+func (x *MonteCarloStats) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5298,76 +6074,189 @@ func (x *ResilienceRecommendation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResilienceRecommendation.ProtoReflect.Descriptor instead.
-func (*ResilienceRecommendation) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use MonteCarloStats.ProtoReflect.Descriptor instead.
+func (*MonteCarloStats) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ResilienceRecommendation) GetType() RecommendationType {
+func (x *MonteCarloStats) GetMean() float64 {
 	if x != nil {
-		return x.Type
+		return x.Mean
 	}
-	return RecommendationType_RECOMMENDATION_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *ResilienceRecommendation) GetDescription() string {
+func (x *MonteCarloStats) GetStdDev() float64 {
 	if x != nil {
-		return x.Description
+		return x.StdDev
 	}
-	return ""
+	return 0
 }
 
-func (x *ResilienceRecommendation) GetAffectedEdge() *v1.EdgeKey {
+func (x *MonteCarloStats) GetMin() float64 {
 	if x != nil {
-		return x.AffectedEdge
+		return x.Min
 	}
-	return nil
+	return 0
 }
 
-func (x *ResilienceRecommendation) GetAffectedNode() int64 {
+func (x *MonteCarloStats) GetMax() float64 {
 	if x != nil {
-		return x.AffectedNode
+		return x.Max
 	}
 	return 0
 }
 
-func (x *ResilienceRecommendation) GetEstimatedImprovement() float64 {
+func (x *MonteCarloStats) GetMedian() float64 {
 	if x != nil {
-		return x.EstimatedImprovement
+		return x.Median
 	}
 	return 0
 }
 
-func (x *ResilienceRecommendation) GetEstimatedCost() float64 {
+func (x *MonteCarloStats) GetVariance() float64 {
 	if x != nil {
-		return x.EstimatedCost
+		return x.Variance
 	}
 	return 0
 }
 
-type AnalyzeResilienceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Graph         *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
-	Config        *ResilienceConfig      `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	Algorithm     v1.Algorithm           `protobuf:"varint,3,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MonteCarloStats) GetSkewness() float64 {
+	if x != nil {
+		return x.Skewness
+	}
+	return 0
 }
 
-func (x *AnalyzeResilienceRequest) Reset() {
-	*x = AnalyzeResilienceRequest{}
+func (x *MonteCarloStats) GetKurtosis() float64 {
+	if x != nil {
+		return x.Kurtosis
+	}
+	return 0
+}
+
+func (x *MonteCarloStats) GetConfidenceIntervalLow() float64 {
+	if x != nil {
+		return x.ConfidenceIntervalLow
+	}
+	return 0
+}
+
+func (x *MonteCarloStats) GetConfidenceIntervalHigh() float64 {
+	if x != nil {
+		return x.ConfidenceIntervalHigh
+	}
+	return 0
+}
+
+func (x *MonteCarloStats) GetEffectiveSampleSize() float64 {
+	if x != nil {
+		return x.EffectiveSampleSize
+	}
+	return 0
+}
+
+func (x *MonteCarloStats) GetRelativeCiHalfWidth() float64 {
+	if x != nil {
+		return x.RelativeCiHalfWidth
+	}
+	return 0
+}
+
+type HistogramBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LowerBound    float64                `protobuf:"fixed64,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound    float64                `protobuf:"fixed64,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Frequency     float64                `protobuf:"fixed64,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistogramBucket) Reset() {
+	*x = HistogramBucket{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistogramBucket) ProtoMessage() {}
+
+func (x *HistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistogramBucket.ProtoReflect.Descriptor instead.
+func (*HistogramBucket) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *HistogramBucket) GetLowerBound() float64 {
+	if x != nil {
+		return x.LowerBound
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetUpperBound() float64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetFrequency() float64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+type RiskAnalysis struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	ProbabilityBelowThreshold float64                `protobuf:"fixed64,1,opt,name=probability_below_threshold,json=probabilityBelowThreshold,proto3" json:"probability_below_threshold,omitempty"` // P(flow < threshold)
+	ValueAtRisk               float64                `protobuf:"fixed64,2,opt,name=value_at_risk,json=valueAtRisk,proto3" json:"value_at_risk,omitempty"`                                           // VaR
+	ExpectedShortfall         float64                `protobuf:"fixed64,3,opt,name=expected_shortfall,json=expectedShortfall,proto3" json:"expected_shortfall,omitempty"`                           // CVaR
+	WorstCaseFlow             float64                `protobuf:"fixed64,4,opt,name=worst_case_flow,json=worstCaseFlow,proto3" json:"worst_case_flow,omitempty"`
+	BestCaseFlow              float64                `protobuf:"fixed64,5,opt,name=best_case_flow,json=bestCaseFlow,proto3" json:"best_case_flow,omitempty"`
+	RiskScenarios             []*RiskScenario        `protobuf:"bytes,6,rep,name=risk_scenarios,json=riskScenarios,proto3" json:"risk_scenarios,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RiskAnalysis) Reset() {
+	*x = RiskAnalysis{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalyzeResilienceRequest) String() string {
+func (x *RiskAnalysis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeResilienceRequest) ProtoMessage() {}
+func (*RiskAnalysis) ProtoMessage() {}
 
-func (x *AnalyzeResilienceRequest) ProtoReflect() protoreflect.Message {
+func (x *RiskAnalysis) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5379,55 +6268,77 @@ func (x *AnalyzeResilienceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeResilienceRequest.ProtoReflect.Descriptor instead.
-func (*AnalyzeResilienceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RiskAnalysis.ProtoReflect.Descriptor instead.
+func (*RiskAnalysis) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AnalyzeResilienceRequest) GetGraph() *v1.Graph {
+func (x *RiskAnalysis) GetProbabilityBelowThreshold() float64 {
 	if x != nil {
-		return x.Graph
+		return x.ProbabilityBelowThreshold
 	}
-	return nil
+	return 0
 }
 
-func (x *AnalyzeResilienceRequest) GetConfig() *ResilienceConfig {
+func (x *RiskAnalysis) GetValueAtRisk() float64 {
 	if x != nil {
-		return x.Config
+		return x.ValueAtRisk
 	}
-	return nil
+	return 0
 }
 
-func (x *AnalyzeResilienceRequest) GetAlgorithm() v1.Algorithm {
+func (x *RiskAnalysis) GetExpectedShortfall() float64 {
 	if x != nil {
-		return x.Algorithm
+		return x.ExpectedShortfall
 	}
-	return v1.Algorithm(0)
+	return 0
 }
 
-type ResilienceConfig struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	MaxFailuresToTest     int32                  `protobuf:"varint,1,opt,name=max_failures_to_test,json=maxFailuresToTest,proto3" json:"max_failures_to_test,omitempty"`           // Тестировать до N отказов
-	TestCascadingFailures bool                   `protobuf:"varint,2,opt,name=test_cascading_failures,json=testCascadingFailures,proto3" json:"test_cascading_failures,omitempty"` // Каскадные отказы
-	LoadFactor            float64                `protobuf:"fixed64,3,opt,name=load_factor,json=loadFactor,proto3" json:"load_factor,omitempty"`                                   // Коэффициент загрузки
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *RiskAnalysis) GetWorstCaseFlow() float64 {
+	if x != nil {
+		return x.WorstCaseFlow
+	}
+	return 0
 }
 
-func (x *ResilienceConfig) Reset() {
-	*x = ResilienceConfig{}
+func (x *RiskAnalysis) GetBestCaseFlow() float64 {
+	if x != nil {
+		return x.BestCaseFlow
+	}
+	return 0
+}
+
+func (x *RiskAnalysis) GetRiskScenarios() []*RiskScenario {
+	if x != nil {
+		return x.RiskScenarios
+	}
+	return nil
+}
+
+type RiskScenario struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Probability   float64                `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"`
+	FlowImpact    float64                `protobuf:"fixed64,3,opt,name=flow_impact,json=flowImpact,proto3" json:"flow_impact,omitempty"`
+	CostImpact    float64                `protobuf:"fixed64,4,opt,name=cost_impact,json=costImpact,proto3" json:"cost_impact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskScenario) Reset() {
+	*x = RiskScenario{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResilienceConfig) String() string {
+func (x *RiskScenario) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResilienceConfig) ProtoMessage() {}
+func (*RiskScenario) ProtoMessage() {}
 
-func (x *ResilienceConfig) ProtoReflect() protoreflect.Message {
+func (x *RiskScenario) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5439,62 +6350,63 @@ func (x *ResilienceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResilienceConfig.ProtoReflect.Descriptor instead.
-func (*ResilienceConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use RiskScenario.ProtoReflect.Descriptor instead.
+func (*RiskScenario) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ResilienceConfig) GetMaxFailuresToTest() int32 {
+func (x *RiskScenario) GetDescription() string {
 	if x != nil {
-		return x.MaxFailuresToTest
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RiskScenario) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
 	}
 	return 0
 }
 
-func (x *ResilienceConfig) GetTestCascadingFailures() bool {
+func (x *RiskScenario) GetFlowImpact() float64 {
 	if x != nil {
-		return x.TestCascadingFailures
+		return x.FlowImpact
 	}
-	return false
+	return 0
 }
 
-func (x *ResilienceConfig) GetLoadFactor() float64 {
+func (x *RiskScenario) GetCostImpact() float64 {
 	if x != nil {
-		return x.LoadFactor
+		return x.CostImpact
 	}
 	return 0
 }
 
-type AnalyzeResilienceResponse struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Общие метрики
-	Metrics *ResilienceMetrics `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	// N-1 анализ (устойчивость к одиночным отказам)
-	NMinusOne *NMinusOneAnalysis `protobuf:"bytes,3,opt,name=n_minus_one,json=nMinusOne,proto3" json:"n_minus_one,omitempty"`
-	// N-2 анализ (опционально)
-	NMinusTwo *NMinusTwoAnalysis `protobuf:"bytes,4,opt,name=n_minus_two,json=nMinusTwo,proto3" json:"n_minus_two,omitempty"`
-	// Узкие места устойчивости
-	Weaknesses    []*ResilienceWeakness `protobuf:"bytes,5,rep,name=weaknesses,proto3" json:"weaknesses,omitempty"`
-	Metadata      *SimulationMetadata   `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ParameterCorrelation struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ParameterName       string                 `protobuf:"bytes,1,opt,name=parameter_name,json=parameterName,proto3" json:"parameter_name,omitempty"`
+	CorrelationWithFlow float64                `protobuf:"fixed64,2,opt,name=correlation_with_flow,json=correlationWithFlow,proto3" json:"correlation_with_flow,omitempty"`
+	CorrelationWithCost float64                `protobuf:"fixed64,3,opt,name=correlation_with_cost,json=correlationWithCost,proto3" json:"correlation_with_cost,omitempty"`
+	ImportanceScore     float64                `protobuf:"fixed64,4,opt,name=importance_score,json=importanceScore,proto3" json:"importance_score,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *AnalyzeResilienceResponse) Reset() {
-	*x = AnalyzeResilienceResponse{}
+func (x *ParameterCorrelation) Reset() {
+	*x = ParameterCorrelation{}
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnalyzeResilienceResponse) String() string {
+func (x *ParameterCorrelation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeResilienceResponse) ProtoMessage() {}
+func (*ParameterCorrelation) ProtoMessage() {}
 
-func (x *AnalyzeResilienceResponse) ProtoReflect() protoreflect.Message {
+func (x *ParameterCorrelation) ProtoReflect() protoreflect.Message {
 	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5506,79 +6418,2455 @@ func (x *AnalyzeResilienceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeResilienceResponse.ProtoReflect.Descriptor instead.
-func (*AnalyzeResilienceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ParameterCorrelation.ProtoReflect.Descriptor instead.
+func (*ParameterCorrelation) Descriptor() ([]byte, []int) {
 	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *AnalyzeResilienceResponse) GetSuccess() bool {
+func (x *ParameterCorrelation) GetParameterName() string {
 	if x != nil {
-		return x.Success
+		return x.ParameterName
 	}
-	return false
+	return ""
 }
 
-func (x *AnalyzeResilienceResponse) GetMetrics() *ResilienceMetrics {
+func (x *ParameterCorrelation) GetCorrelationWithFlow() float64 {
 	if x != nil {
-		return x.Metrics
+		return x.CorrelationWithFlow
 	}
-	return nil
+	return 0
 }
 
-func (x *AnalyzeResilienceResponse) GetNMinusOne() *NMinusOneAnalysis {
+func (x *ParameterCorrelation) GetCorrelationWithCost() float64 {
+	if x != nil {
+		return x.CorrelationWithCost
+	}
+	return 0
+}
+
+func (x *ParameterCorrelation) GetImportanceScore() float64 {
+	if x != nil {
+		return x.ImportanceScore
+	}
+	return 0
+}
+
+type MonteCarloProgress struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Iteration           int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	TotalIterations     int32                  `protobuf:"varint,2,opt,name=total_iterations,json=totalIterations,proto3" json:"total_iterations,omitempty"`
+	ProgressPercent     float64                `protobuf:"fixed64,3,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	CurrentMeanFlow     float64                `protobuf:"fixed64,4,opt,name=current_mean_flow,json=currentMeanFlow,proto3" json:"current_mean_flow,omitempty"`
+	CurrentStdDev       float64                `protobuf:"fixed64,5,opt,name=current_std_dev,json=currentStdDev,proto3" json:"current_std_dev,omitempty"`
+	Status              string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                                            // "running" или "completed" (финальное сообщение)
+	CiHalfWidth         float64                `protobuf:"fixed64,7,opt,name=ci_half_width,json=ciHalfWidth,proto3" json:"ci_half_width,omitempty"`                           // Текущая полуширина доверительного интервала среднего потока
+	RelativeCiHalfWidth float64                `protobuf:"fixed64,8,opt,name=relative_ci_half_width,json=relativeCiHalfWidth,proto3" json:"relative_ci_half_width,omitempty"` // Наибольшая относительная полуширина по потоку и стоимости
+	// Оценка оставшихся итераций: до target_relative_ci_half_width при адаптивной
+	// остановке (не больше num_iterations), иначе до num_iterations
+	EstimatedIterationsRemaining int32                  `protobuf:"varint,9,opt,name=estimated_iterations_remaining,json=estimatedIterationsRemaining,proto3" json:"estimated_iterations_remaining,omitempty"`
+	Result                       *RunMonteCarloResponse `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"` // Только в финальном сообщении
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *MonteCarloProgress) Reset() {
+	*x = MonteCarloProgress{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonteCarloProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonteCarloProgress) ProtoMessage() {}
+
+func (x *MonteCarloProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonteCarloProgress.ProtoReflect.Descriptor instead.
+func (*MonteCarloProgress) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MonteCarloProgress) GetIteration() int32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetTotalIterations() int32 {
+	if x != nil {
+		return x.TotalIterations
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetProgressPercent() float64 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetCurrentMeanFlow() float64 {
+	if x != nil {
+		return x.CurrentMeanFlow
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetCurrentStdDev() float64 {
+	if x != nil {
+		return x.CurrentStdDev
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MonteCarloProgress) GetCiHalfWidth() float64 {
+	if x != nil {
+		return x.CiHalfWidth
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetRelativeCiHalfWidth() float64 {
+	if x != nil {
+		return x.RelativeCiHalfWidth
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetEstimatedIterationsRemaining() int32 {
+	if x != nil {
+		return x.EstimatedIterationsRemaining
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetResult() *RunMonteCarloResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type AnalyzeSensitivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	// Параметры для анализа
+	Parameters []*SensitivityParameter `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// Конфигурация
+	Config        *SensitivityConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Algorithm     v1.Algorithm       `protobuf:"varint,4,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeSensitivityRequest) Reset() {
+	*x = AnalyzeSensitivityRequest{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeSensitivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeSensitivityRequest) ProtoMessage() {}
+
+func (x *AnalyzeSensitivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeSensitivityRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeSensitivityRequest) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AnalyzeSensitivityRequest) GetGraph() *v1.Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *AnalyzeSensitivityRequest) GetParameters() []*SensitivityParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *AnalyzeSensitivityRequest) GetConfig() *SensitivityConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AnalyzeSensitivityRequest) GetAlgorithm() v1.Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return v1.Algorithm(0)
+}
+
+type SensitivityParameter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Идентификация параметра
+	Edge   *v1.EdgeKey        `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	NodeId int64              `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Target ModificationTarget `protobuf:"varint,3,opt,name=target,proto3,enum=logistics.simulation.v1.ModificationTarget" json:"target,omitempty"`
+	// Диапазон изменения
+	MinMultiplier float64 `protobuf:"fixed64,4,opt,name=min_multiplier,json=minMultiplier,proto3" json:"min_multiplier,omitempty"` // 0.5 = -50%
+	MaxMultiplier float64 `protobuf:"fixed64,5,opt,name=max_multiplier,json=maxMultiplier,proto3" json:"max_multiplier,omitempty"` // 1.5 = +50%
+	NumSteps      int32   `protobuf:"varint,6,opt,name=num_steps,json=numSteps,proto3" json:"num_steps,omitempty"`                 // Количество точек
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensitivityParameter) Reset() {
+	*x = SensitivityParameter{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensitivityParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitivityParameter) ProtoMessage() {}
+
+func (x *SensitivityParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitivityParameter.ProtoReflect.Descriptor instead.
+func (*SensitivityParameter) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SensitivityParameter) GetEdge() *v1.EdgeKey {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *SensitivityParameter) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *SensitivityParameter) GetTarget() ModificationTarget {
+	if x != nil {
+		return x.Target
+	}
+	return ModificationTarget_MODIFICATION_TARGET_UNSPECIFIED
+}
+
+func (x *SensitivityParameter) GetMinMultiplier() float64 {
+	if x != nil {
+		return x.MinMultiplier
+	}
+	return 0
+}
+
+func (x *SensitivityParameter) GetMaxMultiplier() float64 {
+	if x != nil {
+		return x.MaxMultiplier
+	}
+	return 0
+}
+
+func (x *SensitivityParameter) GetNumSteps() int32 {
+	if x != nil {
+		return x.NumSteps
+	}
+	return 0
+}
+
+type SensitivityConfig struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Method              SensitivityMethod      `protobuf:"varint,1,opt,name=method,proto3,enum=logistics.simulation.v1.SensitivityMethod" json:"method,omitempty"`
+	CalculateElasticity bool                   `protobuf:"varint,2,opt,name=calculate_elasticity,json=calculateElasticity,proto3" json:"calculate_elasticity,omitempty"` // Эластичность
+	FindThresholds      bool                   `protobuf:"varint,3,opt,name=find_thresholds,json=findThresholds,proto3" json:"find_thresholds,omitempty"`                // Пороговые значения
+	// Morris: число траекторий r (по умолчанию 10) и уровней сетки p — чётное,
+	// по умолчанию 4. Требует r·(k+1) решений для k параметров
+	NumTrajectories int32 `protobuf:"varint,4,opt,name=num_trajectories,json=numTrajectories,proto3" json:"num_trajectories,omitempty"`
+	NumLevels       int32 `protobuf:"varint,5,opt,name=num_levels,json=numLevels,proto3" json:"num_levels,omitempty"`
+	// Sobol: базовый размер выборки N (по умолчанию 256, лучше степень двойки).
+	// Требует N·(k+2) решений
+	NumSamples    int32 `protobuf:"varint,6,opt,name=num_samples,json=numSamples,proto3" json:"num_samples,omitempty"`
+	RandomSeed    int64 `protobuf:"varint,7,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"` // 0 = случайный seed
+	MaxWorkers    int32 `protobuf:"varint,8,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"` // Параллельных решений (по умолчанию 4)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensitivityConfig) Reset() {
+	*x = SensitivityConfig{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensitivityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitivityConfig) ProtoMessage() {}
+
+func (x *SensitivityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitivityConfig.ProtoReflect.Descriptor instead.
+func (*SensitivityConfig) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SensitivityConfig) GetMethod() SensitivityMethod {
+	if x != nil {
+		return x.Method
+	}
+	return SensitivityMethod_SENSITIVITY_METHOD_UNSPECIFIED
+}
+
+func (x *SensitivityConfig) GetCalculateElasticity() bool {
+	if x != nil {
+		return x.CalculateElasticity
+	}
+	return false
+}
+
+func (x *SensitivityConfig) GetFindThresholds() bool {
+	if x != nil {
+		return x.FindThresholds
+	}
+	return false
+}
+
+func (x *SensitivityConfig) GetNumTrajectories() int32 {
+	if x != nil {
+		return x.NumTrajectories
+	}
+	return 0
+}
+
+func (x *SensitivityConfig) GetNumLevels() int32 {
+	if x != nil {
+		return x.NumLevels
+	}
+	return 0
+}
+
+func (x *SensitivityConfig) GetNumSamples() int32 {
+	if x != nil {
+		return x.NumSamples
+	}
+	return 0
+}
+
+func (x *SensitivityConfig) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *SensitivityConfig) GetMaxWorkers() int32 {
+	if x != nil {
+		return x.MaxWorkers
+	}
+	return 0
+}
+
+type AnalyzeSensitivityResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Результаты по параметрам
+	ParameterResults []*SensitivityResult `protobuf:"bytes,2,rep,name=parameter_results,json=parameterResults,proto3" json:"parameter_results,omitempty"`
+	// Рейтинг влияния
+	Rankings []*ParameterRanking `protobuf:"bytes,3,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	// Найденные пороги
+	Thresholds    []*ThresholdPoint   `protobuf:"bytes,4,rep,name=thresholds,proto3" json:"thresholds,omitempty"`
+	Metadata      *SimulationMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeSensitivityResponse) Reset() {
+	*x = AnalyzeSensitivityResponse{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeSensitivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeSensitivityResponse) ProtoMessage() {}
+
+func (x *AnalyzeSensitivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeSensitivityResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeSensitivityResponse) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AnalyzeSensitivityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AnalyzeSensitivityResponse) GetParameterResults() []*SensitivityResult {
+	if x != nil {
+		return x.ParameterResults
+	}
+	return nil
+}
+
+func (x *AnalyzeSensitivityResponse) GetRankings() []*ParameterRanking {
+	if x != nil {
+		return x.Rankings
+	}
+	return nil
+}
+
+func (x *AnalyzeSensitivityResponse) GetThresholds() []*ThresholdPoint {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *AnalyzeSensitivityResponse) GetMetadata() *SimulationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type SensitivityResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ParameterId string                 `protobuf:"bytes,1,opt,name=parameter_id,json=parameterId,proto3" json:"parameter_id,omitempty"`
+	// Кривая чувствительности
+	Curve []*SensitivityPoint `protobuf:"bytes,2,rep,name=curve,proto3" json:"curve,omitempty"`
+	// Метрики
+	Elasticity       float64          `protobuf:"fixed64,3,opt,name=elasticity,proto3" json:"elasticity,omitempty"`                                     // % изменения output / % изменения input
+	SensitivityIndex float64          `protobuf:"fixed64,4,opt,name=sensitivity_index,json=sensitivityIndex,proto3" json:"sensitivity_index,omitempty"` // Нормализованный индекс 0-1
+	ImpactRange      float64          `protobuf:"fixed64,5,opt,name=impact_range,json=impactRange,proto3" json:"impact_range,omitempty"`                // max_flow - min_flow
+	Level            SensitivityLevel `protobuf:"varint,6,opt,name=level,proto3,enum=logistics.simulation.v1.SensitivityLevel" json:"level,omitempty"`
+	// Индексы глобальных методов (Morris / Sobol)
+	Morris        *MorrisIndices `protobuf:"bytes,7,opt,name=morris,proto3" json:"morris,omitempty"`
+	Sobol         *SobolIndices  `protobuf:"bytes,8,opt,name=sobol,proto3" json:"sobol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensitivityResult) Reset() {
+	*x = SensitivityResult{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensitivityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitivityResult) ProtoMessage() {}
+
+func (x *SensitivityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitivityResult.ProtoReflect.Descriptor instead.
+func (*SensitivityResult) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SensitivityResult) GetParameterId() string {
+	if x != nil {
+		return x.ParameterId
+	}
+	return ""
+}
+
+func (x *SensitivityResult) GetCurve() []*SensitivityPoint {
+	if x != nil {
+		return x.Curve
+	}
+	return nil
+}
+
+func (x *SensitivityResult) GetElasticity() float64 {
+	if x != nil {
+		return x.Elasticity
+	}
+	return 0
+}
+
+func (x *SensitivityResult) GetSensitivityIndex() float64 {
+	if x != nil {
+		return x.SensitivityIndex
+	}
+	return 0
+}
+
+func (x *SensitivityResult) GetImpactRange() float64 {
+	if x != nil {
+		return x.ImpactRange
+	}
+	return 0
+}
+
+func (x *SensitivityResult) GetLevel() SensitivityLevel {
+	if x != nil {
+		return x.Level
+	}
+	return SensitivityLevel_SENSITIVITY_LEVEL_UNSPECIFIED
+}
+
+func (x *SensitivityResult) GetMorris() *MorrisIndices {
+	if x != nil {
+		return x.Morris
+	}
+	return nil
+}
+
+func (x *SensitivityResult) GetSobol() *SobolIndices {
+	if x != nil {
+		return x.Sobol
+	}
+	return nil
+}
+
+// MorrisIndices статистики элементарных эффектов параметра. Эффект —
+// изменение потока при проходе всего диапазона [min_multiplier, max_multiplier]
+type MorrisIndices struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mu            float64                `protobuf:"fixed64,1,opt,name=mu,proto3" json:"mu,omitempty"`                       // Среднее эффектов (знак — направление влияния)
+	MuStar        float64                `protobuf:"fixed64,2,opt,name=mu_star,json=muStar,proto3" json:"mu_star,omitempty"` // Среднее модулей эффектов — общая важность
+	Sigma         float64                `protobuf:"fixed64,3,opt,name=sigma,proto3" json:"sigma,omitempty"`                 // СКО эффектов — нелинейность и взаимодействия
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MorrisIndices) Reset() {
+	*x = MorrisIndices{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MorrisIndices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MorrisIndices) ProtoMessage() {}
+
+func (x *MorrisIndices) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MorrisIndices.ProtoReflect.Descriptor instead.
+func (*MorrisIndices) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MorrisIndices) GetMu() float64 {
+	if x != nil {
+		return x.Mu
+	}
+	return 0
+}
+
+func (x *MorrisIndices) GetMuStar() float64 {
+	if x != nil {
+		return x.MuStar
+	}
+	return 0
+}
+
+func (x *MorrisIndices) GetSigma() float64 {
+	if x != nil {
+		return x.Sigma
+	}
+	return 0
+}
+
+// SobolIndices доли дисперсии потока, объясняемые параметром
+type SobolIndices struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FirstOrder     float64                `protobuf:"fixed64,1,opt,name=first_order,json=firstOrder,proto3" json:"first_order,omitempty"`               // S_i: вклад параметра в одиночку
+	TotalOrder     float64                `protobuf:"fixed64,2,opt,name=total_order,json=totalOrder,proto3" json:"total_order,omitempty"`               // S_Ti: вклад вместе со взаимодействиями
+	FirstOrderConf float64                `protobuf:"fixed64,3,opt,name=first_order_conf,json=firstOrderConf,proto3" json:"first_order_conf,omitempty"` // Полуширина 95% бутстрэп-интервала S_i
+	TotalOrderConf float64                `protobuf:"fixed64,4,opt,name=total_order_conf,json=totalOrderConf,proto3" json:"total_order_conf,omitempty"` // Полуширина 95% бутстрэп-интервала S_Ti
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SobolIndices) Reset() {
+	*x = SobolIndices{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SobolIndices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SobolIndices) ProtoMessage() {}
+
+func (x *SobolIndices) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SobolIndices.ProtoReflect.Descriptor instead.
+func (*SobolIndices) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SobolIndices) GetFirstOrder() float64 {
+	if x != nil {
+		return x.FirstOrder
+	}
+	return 0
+}
+
+func (x *SobolIndices) GetTotalOrder() float64 {
+	if x != nil {
+		return x.TotalOrder
+	}
+	return 0
+}
+
+func (x *SobolIndices) GetFirstOrderConf() float64 {
+	if x != nil {
+		return x.FirstOrderConf
+	}
+	return 0
+}
+
+func (x *SobolIndices) GetTotalOrderConf() float64 {
+	if x != nil {
+		return x.TotalOrderConf
+	}
+	return 0
+}
+
+type SensitivityPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ParameterValue float64                `protobuf:"fixed64,1,opt,name=parameter_value,json=parameterValue,proto3" json:"parameter_value,omitempty"` // Множитель
+	FlowValue      float64                `protobuf:"fixed64,2,opt,name=flow_value,json=flowValue,proto3" json:"flow_value,omitempty"`
+	CostValue      float64                `protobuf:"fixed64,3,opt,name=cost_value,json=costValue,proto3" json:"cost_value,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SensitivityPoint) Reset() {
+	*x = SensitivityPoint{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensitivityPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitivityPoint) ProtoMessage() {}
+
+func (x *SensitivityPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitivityPoint.ProtoReflect.Descriptor instead.
+func (*SensitivityPoint) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SensitivityPoint) GetParameterValue() float64 {
+	if x != nil {
+		return x.ParameterValue
+	}
+	return 0
+}
+
+func (x *SensitivityPoint) GetFlowValue() float64 {
+	if x != nil {
+		return x.FlowValue
+	}
+	return 0
+}
+
+func (x *SensitivityPoint) GetCostValue() float64 {
+	if x != nil {
+		return x.CostValue
+	}
+	return 0
+}
+
+type ParameterRanking struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ParameterId      string                 `protobuf:"bytes,1,opt,name=parameter_id,json=parameterId,proto3" json:"parameter_id,omitempty"`
+	Rank             int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	SensitivityIndex float64                `protobuf:"fixed64,3,opt,name=sensitivity_index,json=sensitivityIndex,proto3" json:"sensitivity_index,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ParameterRanking) Reset() {
+	*x = ParameterRanking{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterRanking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterRanking) ProtoMessage() {}
+
+func (x *ParameterRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterRanking.ProtoReflect.Descriptor instead.
+func (*ParameterRanking) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ParameterRanking) GetParameterId() string {
+	if x != nil {
+		return x.ParameterId
+	}
+	return ""
+}
+
+func (x *ParameterRanking) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ParameterRanking) GetSensitivityIndex() float64 {
+	if x != nil {
+		return x.SensitivityIndex
+	}
+	return 0
+}
+
+func (x *ParameterRanking) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ThresholdPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ParameterId    string                 `protobuf:"bytes,1,opt,name=parameter_id,json=parameterId,proto3" json:"parameter_id,omitempty"`
+	ThresholdValue float64                `protobuf:"fixed64,2,opt,name=threshold_value,json=thresholdValue,proto3" json:"threshold_value,omitempty"`
+	Type           ThresholdType          `protobuf:"varint,3,opt,name=type,proto3,enum=logistics.simulation.v1.ThresholdType" json:"type,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ThresholdPoint) Reset() {
+	*x = ThresholdPoint{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThresholdPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdPoint) ProtoMessage() {}
+
+func (x *ThresholdPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdPoint.ProtoReflect.Descriptor instead.
+func (*ThresholdPoint) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ThresholdPoint) GetParameterId() string {
+	if x != nil {
+		return x.ParameterId
+	}
+	return ""
+}
+
+func (x *ThresholdPoint) GetThresholdValue() float64 {
+	if x != nil {
+		return x.ThresholdValue
+	}
+	return 0
+}
+
+func (x *ThresholdPoint) GetType() ThresholdType {
+	if x != nil {
+		return x.Type
+	}
+	return ThresholdType_THRESHOLD_TYPE_UNSPECIFIED
+}
+
+func (x *ThresholdPoint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type FindCriticalElementsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Graph         *v1.Graph               `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Config        *CriticalElementsConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Algorithm     v1.Algorithm            `protobuf:"varint,3,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindCriticalElementsRequest) Reset() {
+	*x = FindCriticalElementsRequest{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindCriticalElementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCriticalElementsRequest) ProtoMessage() {}
+
+func (x *FindCriticalElementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCriticalElementsRequest.ProtoReflect.Descriptor instead.
+func (*FindCriticalElementsRequest) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FindCriticalElementsRequest) GetGraph() *v1.Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *FindCriticalElementsRequest) GetConfig() *CriticalElementsConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *FindCriticalElementsRequest) GetAlgorithm() v1.Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return v1.Algorithm(0)
+}
+
+type CriticalElementsConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AnalyzeEdges     bool                   `protobuf:"varint,1,opt,name=analyze_edges,json=analyzeEdges,proto3" json:"analyze_edges,omitempty"`
+	AnalyzeNodes     bool                   `protobuf:"varint,2,opt,name=analyze_nodes,json=analyzeNodes,proto3" json:"analyze_nodes,omitempty"`
+	TopN             int32                  `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`                                      // Топ N критических
+	FailureThreshold float64                `protobuf:"fixed64,4,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"` // Порог снижения потока для "критичности"
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CriticalElementsConfig) Reset() {
+	*x = CriticalElementsConfig{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriticalElementsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalElementsConfig) ProtoMessage() {}
+
+func (x *CriticalElementsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriticalElementsConfig.ProtoReflect.Descriptor instead.
+func (*CriticalElementsConfig) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CriticalElementsConfig) GetAnalyzeEdges() bool {
+	if x != nil {
+		return x.AnalyzeEdges
+	}
+	return false
+}
+
+func (x *CriticalElementsConfig) GetAnalyzeNodes() bool {
+	if x != nil {
+		return x.AnalyzeNodes
+	}
+	return false
+}
+
+func (x *CriticalElementsConfig) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *CriticalElementsConfig) GetFailureThreshold() float64 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+type FindCriticalElementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	CriticalEdges []*CriticalEdge        `protobuf:"bytes,2,rep,name=critical_edges,json=criticalEdges,proto3" json:"critical_edges,omitempty"`
+	CriticalNodes []*CriticalNode        `protobuf:"bytes,3,rep,name=critical_nodes,json=criticalNodes,proto3" json:"critical_nodes,omitempty"`
+	// Single Point of Failure
+	SinglePointsOfFailure []*v1.EdgeKey `protobuf:"bytes,4,rep,name=single_points_of_failure,json=singlePointsOfFailure,proto3" json:"single_points_of_failure,omitempty"`
+	// Общая оценка устойчивости
+	ResilienceScore float64             `protobuf:"fixed64,5,opt,name=resilience_score,json=resilienceScore,proto3" json:"resilience_score,omitempty"` // 0-1
+	Metadata        *SimulationMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindCriticalElementsResponse) Reset() {
+	*x = FindCriticalElementsResponse{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindCriticalElementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCriticalElementsResponse) ProtoMessage() {}
+
+func (x *FindCriticalElementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCriticalElementsResponse.ProtoReflect.Descriptor instead.
+func (*FindCriticalElementsResponse) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FindCriticalElementsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FindCriticalElementsResponse) GetCriticalEdges() []*CriticalEdge {
+	if x != nil {
+		return x.CriticalEdges
+	}
+	return nil
+}
+
+func (x *FindCriticalElementsResponse) GetCriticalNodes() []*CriticalNode {
+	if x != nil {
+		return x.CriticalNodes
+	}
+	return nil
+}
+
+func (x *FindCriticalElementsResponse) GetSinglePointsOfFailure() []*v1.EdgeKey {
+	if x != nil {
+		return x.SinglePointsOfFailure
+	}
+	return nil
+}
+
+func (x *FindCriticalElementsResponse) GetResilienceScore() float64 {
+	if x != nil {
+		return x.ResilienceScore
+	}
+	return 0
+}
+
+func (x *FindCriticalElementsResponse) GetMetadata() *SimulationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CriticalEdge struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Edge                   *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	CriticalityScore       float64                `protobuf:"fixed64,2,opt,name=criticality_score,json=criticalityScore,proto3" json:"criticality_score,omitempty"`              // 0-1
+	FlowImpactIfRemoved    float64                `protobuf:"fixed64,3,opt,name=flow_impact_if_removed,json=flowImpactIfRemoved,proto3" json:"flow_impact_if_removed,omitempty"` // Снижение потока
+	CostImpactIfRemoved    float64                `protobuf:"fixed64,4,opt,name=cost_impact_if_removed,json=costImpactIfRemoved,proto3" json:"cost_impact_if_removed,omitempty"` // Увеличение стоимости
+	Rank                   int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	IsSinglePointOfFailure bool                   `protobuf:"varint,6,opt,name=is_single_point_of_failure,json=isSinglePointOfFailure,proto3" json:"is_single_point_of_failure,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CriticalEdge) Reset() {
+	*x = CriticalEdge{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriticalEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalEdge) ProtoMessage() {}
+
+func (x *CriticalEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriticalEdge.ProtoReflect.Descriptor instead.
+func (*CriticalEdge) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CriticalEdge) GetEdge() *v1.EdgeKey {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *CriticalEdge) GetCriticalityScore() float64 {
+	if x != nil {
+		return x.CriticalityScore
+	}
+	return 0
+}
+
+func (x *CriticalEdge) GetFlowImpactIfRemoved() float64 {
+	if x != nil {
+		return x.FlowImpactIfRemoved
+	}
+	return 0
+}
+
+func (x *CriticalEdge) GetCostImpactIfRemoved() float64 {
+	if x != nil {
+		return x.CostImpactIfRemoved
+	}
+	return 0
+}
+
+func (x *CriticalEdge) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *CriticalEdge) GetIsSinglePointOfFailure() bool {
+	if x != nil {
+		return x.IsSinglePointOfFailure
+	}
+	return false
+}
+
+type CriticalNode struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId                 int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	CriticalityScore       float64                `protobuf:"fixed64,2,opt,name=criticality_score,json=criticalityScore,proto3" json:"criticality_score,omitempty"`
+	FlowImpactIfRemoved    float64                `protobuf:"fixed64,3,opt,name=flow_impact_if_removed,json=flowImpactIfRemoved,proto3" json:"flow_impact_if_removed,omitempty"`
+	AffectedEdges          int32                  `protobuf:"varint,4,opt,name=affected_edges,json=affectedEdges,proto3" json:"affected_edges,omitempty"`
+	Rank                   int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	IsSinglePointOfFailure bool                   `protobuf:"varint,6,opt,name=is_single_point_of_failure,json=isSinglePointOfFailure,proto3" json:"is_single_point_of_failure,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CriticalNode) Reset() {
+	*x = CriticalNode{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriticalNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalNode) ProtoMessage() {}
+
+func (x *CriticalNode) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriticalNode.ProtoReflect.Descriptor instead.
+func (*CriticalNode) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CriticalNode) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *CriticalNode) GetCriticalityScore() float64 {
+	if x != nil {
+		return x.CriticalityScore
+	}
+	return 0
+}
+
+func (x *CriticalNode) GetFlowImpactIfRemoved() float64 {
+	if x != nil {
+		return x.FlowImpactIfRemoved
+	}
+	return 0
+}
+
+func (x *CriticalNode) GetAffectedEdges() int32 {
+	if x != nil {
+		return x.AffectedEdges
+	}
+	return 0
+}
+
+func (x *CriticalNode) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *CriticalNode) GetIsSinglePointOfFailure() bool {
+	if x != nil {
+		return x.IsSinglePointOfFailure
+	}
+	return false
+}
+
+type SimulateFailuresRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Graph *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	// Сценарии отказов
+	FailureScenarios []*FailureScenario `protobuf:"bytes,2,rep,name=failure_scenarios,json=failureScenarios,proto3" json:"failure_scenarios,omitempty"`
+	// Или случайные отказы
+	RandomConfig  *RandomFailureConfig `protobuf:"bytes,3,opt,name=random_config,json=randomConfig,proto3" json:"random_config,omitempty"`
+	Algorithm     v1.Algorithm         `protobuf:"varint,4,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateFailuresRequest) Reset() {
+	*x = SimulateFailuresRequest{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateFailuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateFailuresRequest) ProtoMessage() {}
+
+func (x *SimulateFailuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateFailuresRequest.ProtoReflect.Descriptor instead.
+func (*SimulateFailuresRequest) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SimulateFailuresRequest) GetGraph() *v1.Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *SimulateFailuresRequest) GetFailureScenarios() []*FailureScenario {
+	if x != nil {
+		return x.FailureScenarios
+	}
+	return nil
+}
+
+func (x *SimulateFailuresRequest) GetRandomConfig() *RandomFailureConfig {
+	if x != nil {
+		return x.RandomConfig
+	}
+	return nil
+}
+
+func (x *SimulateFailuresRequest) GetAlgorithm() v1.Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return v1.Algorithm(0)
+}
+
+type FailureScenario struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FailedEdges []*v1.EdgeKey          `protobuf:"bytes,2,rep,name=failed_edges,json=failedEdges,proto3" json:"failed_edges,omitempty"`
+	FailedNodes []int64                `protobuf:"varint,3,rep,packed,name=failed_nodes,json=failedNodes,proto3" json:"failed_nodes,omitempty"`
+	Probability float64                `protobuf:"fixed64,4,opt,name=probability,proto3" json:"probability,omitempty"` // Вероятность сценария
+	// Сколько раз сценарий выпал при случайной генерации (0 — задан вручную)
+	Occurrences   int32 `protobuf:"varint,5,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailureScenario) Reset() {
+	*x = FailureScenario{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailureScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureScenario) ProtoMessage() {}
+
+func (x *FailureScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureScenario.ProtoReflect.Descriptor instead.
+func (*FailureScenario) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *FailureScenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FailureScenario) GetFailedEdges() []*v1.EdgeKey {
+	if x != nil {
+		return x.FailedEdges
+	}
+	return nil
+}
+
+func (x *FailureScenario) GetFailedNodes() []int64 {
+	if x != nil {
+		return x.FailedNodes
+	}
+	return nil
+}
+
+func (x *FailureScenario) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *FailureScenario) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+// RandomFailureConfig модель случайных отказов. Элементы отказывают
+// независимо; при correlated_failures с вероятностью common_cause_probability
+// в сценарии действует общая причина, повышающая вероятность отказа задетых
+// элементов до common_cause_failure_probability. Исток и сток не отказывают.
+type RandomFailureConfig struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	NumScenarios                  int32                  `protobuf:"varint,1,opt,name=num_scenarios,json=numScenarios,proto3" json:"num_scenarios,omitempty"`                                  // Число сэмплов модели (по умолчанию 10)
+	EdgeFailureProbability        float64                `protobuf:"fixed64,2,opt,name=edge_failure_probability,json=edgeFailureProbability,proto3" json:"edge_failure_probability,omitempty"` // P(edge fails), по умолчанию 0.1
+	NodeFailureProbability        float64                `protobuf:"fixed64,3,opt,name=node_failure_probability,json=nodeFailureProbability,proto3" json:"node_failure_probability,omitempty"`
+	MaxSimultaneousFailures       int32                  `protobuf:"varint,4,opt,name=max_simultaneous_failures,json=maxSimultaneousFailures,proto3" json:"max_simultaneous_failures,omitempty"` // Сэмплы обусловлены числом отказов ≤ N (по умолчанию 3)
+	CorrelatedFailures            bool                   `protobuf:"varint,5,opt,name=correlated_failures,json=correlatedFailures,proto3" json:"correlated_failures,omitempty"`                  // Связанные отказы
+	RandomSeed                    int64                  `protobuf:"varint,6,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`                                          // 0 = случайный seed
+	Correlation                   FailureCorrelation     `protobuf:"varint,7,opt,name=correlation,proto3,enum=logistics.simulation.v1.FailureCorrelation" json:"correlation,omitempty"`
+	CommonCauseProbability        float64                `protobuf:"fixed64,8,opt,name=common_cause_probability,json=commonCauseProbability,proto3" json:"common_cause_probability,omitempty"`                         // По умолчанию 0.1
+	RegionRadius                  float64                `protobuf:"fixed64,9,opt,name=region_radius,json=regionRadius,proto3" json:"region_radius,omitempty"`                                                         // В единицах координат; 0 = 10% диагонали охвата узлов
+	CommonCauseFailureProbability float64                `protobuf:"fixed64,10,opt,name=common_cause_failure_probability,json=commonCauseFailureProbability,proto3" json:"common_cause_failure_probability,omitempty"` // По умолчанию 0.5
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *RandomFailureConfig) Reset() {
+	*x = RandomFailureConfig{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomFailureConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomFailureConfig) ProtoMessage() {}
+
+func (x *RandomFailureConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomFailureConfig.ProtoReflect.Descriptor instead.
+func (*RandomFailureConfig) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RandomFailureConfig) GetNumScenarios() int32 {
+	if x != nil {
+		return x.NumScenarios
+	}
+	return 0
+}
+
+func (x *RandomFailureConfig) GetEdgeFailureProbability() float64 {
+	if x != nil {
+		return x.EdgeFailureProbability
+	}
+	return 0
+}
+
+func (x *RandomFailureConfig) GetNodeFailureProbability() float64 {
+	if x != nil {
+		return x.NodeFailureProbability
+	}
+	return 0
+}
+
+func (x *RandomFailureConfig) GetMaxSimultaneousFailures() int32 {
+	if x != nil {
+		return x.MaxSimultaneousFailures
+	}
+	return 0
+}
+
+func (x *RandomFailureConfig) GetCorrelatedFailures() bool {
+	if x != nil {
+		return x.CorrelatedFailures
+	}
+	return false
+}
+
+func (x *RandomFailureConfig) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *RandomFailureConfig) GetCorrelation() FailureCorrelation {
+	if x != nil {
+		return x.Correlation
+	}
+	return FailureCorrelation_FAILURE_CORRELATION_UNSPECIFIED
+}
+
+func (x *RandomFailureConfig) GetCommonCauseProbability() float64 {
+	if x != nil {
+		return x.CommonCauseProbability
+	}
+	return 0
+}
+
+func (x *RandomFailureConfig) GetRegionRadius() float64 {
+	if x != nil {
+		return x.RegionRadius
+	}
+	return 0
+}
+
+func (x *RandomFailureConfig) GetCommonCauseFailureProbability() float64 {
+	if x != nil {
+		return x.CommonCauseFailureProbability
+	}
+	return 0
+}
+
+type SimulateFailuresResponse struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Success         bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Baseline        *ScenarioResult          `protobuf:"bytes,2,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	ScenarioResults []*FailureScenarioResult `protobuf:"bytes,3,rep,name=scenario_results,json=scenarioResults,proto3" json:"scenario_results,omitempty"`
+	// Агрегированная статистика
+	Stats *FailureStats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Рекомендации по повышению устойчивости
+	Recommendations []*ResilienceRecommendation `protobuf:"bytes,5,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	Metadata        *SimulationMetadata         `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	RandomSeed      int64                       `protobuf:"varint,7,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"` // Seed случайной генерации сценариев
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SimulateFailuresResponse) Reset() {
+	*x = SimulateFailuresResponse{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateFailuresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateFailuresResponse) ProtoMessage() {}
+
+func (x *SimulateFailuresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateFailuresResponse.ProtoReflect.Descriptor instead.
+func (*SimulateFailuresResponse) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SimulateFailuresResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SimulateFailuresResponse) GetBaseline() *ScenarioResult {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *SimulateFailuresResponse) GetScenarioResults() []*FailureScenarioResult {
+	if x != nil {
+		return x.ScenarioResults
+	}
+	return nil
+}
+
+func (x *SimulateFailuresResponse) GetStats() *FailureStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *SimulateFailuresResponse) GetRecommendations() []*ResilienceRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *SimulateFailuresResponse) GetMetadata() *SimulationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SimulateFailuresResponse) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+type FailureScenarioResult struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ScenarioName        string                 `protobuf:"bytes,1,opt,name=scenario_name,json=scenarioName,proto3" json:"scenario_name,omitempty"`
+	Probability         float64                `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"`
+	Result              *ScenarioResult        `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	VsBaseline          *ScenarioComparison    `protobuf:"bytes,4,opt,name=vs_baseline,json=vsBaseline,proto3" json:"vs_baseline,omitempty"`
+	NetworkDisconnected bool                   `protobuf:"varint,5,opt,name=network_disconnected,json=networkDisconnected,proto3" json:"network_disconnected,omitempty"`
+	Occurrences         int32                  `protobuf:"varint,6,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FailureScenarioResult) Reset() {
+	*x = FailureScenarioResult{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailureScenarioResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureScenarioResult) ProtoMessage() {}
+
+func (x *FailureScenarioResult) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureScenarioResult.ProtoReflect.Descriptor instead.
+func (*FailureScenarioResult) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *FailureScenarioResult) GetScenarioName() string {
+	if x != nil {
+		return x.ScenarioName
+	}
+	return ""
+}
+
+func (x *FailureScenarioResult) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *FailureScenarioResult) GetResult() *ScenarioResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *FailureScenarioResult) GetVsBaseline() *ScenarioComparison {
+	if x != nil {
+		return x.VsBaseline
+	}
+	return nil
+}
+
+func (x *FailureScenarioResult) GetNetworkDisconnected() bool {
+	if x != nil {
+		return x.NetworkDisconnected
+	}
+	return false
+}
+
+func (x *FailureScenarioResult) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+// FailureStats для случайных сценариев — оценки Монте-Карло по сэмплам модели
+// (веса — доли выпадений), для заданных вручную — взвешенные по probability
+type FailureStats struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	ExpectedFlowLoss           float64                `protobuf:"fixed64,1,opt,name=expected_flow_loss,json=expectedFlowLoss,proto3" json:"expected_flow_loss,omitempty"` // E[baseline_flow - scenario_flow]
+	MaxFlowLoss                float64                `protobuf:"fixed64,2,opt,name=max_flow_loss,json=maxFlowLoss,proto3" json:"max_flow_loss,omitempty"`
+	ProbabilityOfDisconnection float64                `protobuf:"fixed64,3,opt,name=probability_of_disconnection,json=probabilityOfDisconnection,proto3" json:"probability_of_disconnection,omitempty"`
+	AverageRecoveryPotential   float64                `protobuf:"fixed64,4,opt,name=average_recovery_potential,json=averageRecoveryPotential,proto3" json:"average_recovery_potential,omitempty"`
+	ExpectedFlowLossStdError   float64                `protobuf:"fixed64,5,opt,name=expected_flow_loss_std_error,json=expectedFlowLossStdError,proto3" json:"expected_flow_loss_std_error,omitempty"` // Только для случайных сценариев
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *FailureStats) Reset() {
+	*x = FailureStats{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailureStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureStats) ProtoMessage() {}
+
+func (x *FailureStats) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureStats.ProtoReflect.Descriptor instead.
+func (*FailureStats) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *FailureStats) GetExpectedFlowLoss() float64 {
+	if x != nil {
+		return x.ExpectedFlowLoss
+	}
+	return 0
+}
+
+func (x *FailureStats) GetMaxFlowLoss() float64 {
+	if x != nil {
+		return x.MaxFlowLoss
+	}
+	return 0
+}
+
+func (x *FailureStats) GetProbabilityOfDisconnection() float64 {
+	if x != nil {
+		return x.ProbabilityOfDisconnection
+	}
+	return 0
+}
+
+func (x *FailureStats) GetAverageRecoveryPotential() float64 {
+	if x != nil {
+		return x.AverageRecoveryPotential
+	}
+	return 0
+}
+
+func (x *FailureStats) GetExpectedFlowLossStdError() float64 {
+	if x != nil {
+		return x.ExpectedFlowLossStdError
+	}
+	return 0
+}
+
+type ResilienceRecommendation struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Type                 RecommendationType     `protobuf:"varint,1,opt,name=type,proto3,enum=logistics.simulation.v1.RecommendationType" json:"type,omitempty"`
+	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AffectedEdge         *v1.EdgeKey            `protobuf:"bytes,3,opt,name=affected_edge,json=affectedEdge,proto3" json:"affected_edge,omitempty"`
+	AffectedNode         int64                  `protobuf:"varint,4,opt,name=affected_node,json=affectedNode,proto3" json:"affected_node,omitempty"`
+	EstimatedImprovement float64                `protobuf:"fixed64,5,opt,name=estimated_improvement,json=estimatedImprovement,proto3" json:"estimated_improvement,omitempty"`
+	EstimatedCost        float64                `protobuf:"fixed64,6,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ResilienceRecommendation) Reset() {
+	*x = ResilienceRecommendation{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResilienceRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResilienceRecommendation) ProtoMessage() {}
+
+func (x *ResilienceRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResilienceRecommendation.ProtoReflect.Descriptor instead.
+func (*ResilienceRecommendation) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ResilienceRecommendation) GetType() RecommendationType {
+	if x != nil {
+		return x.Type
+	}
+	return RecommendationType_RECOMMENDATION_TYPE_UNSPECIFIED
+}
+
+func (x *ResilienceRecommendation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ResilienceRecommendation) GetAffectedEdge() *v1.EdgeKey {
+	if x != nil {
+		return x.AffectedEdge
+	}
+	return nil
+}
+
+func (x *ResilienceRecommendation) GetAffectedNode() int64 {
+	if x != nil {
+		return x.AffectedNode
+	}
+	return 0
+}
+
+func (x *ResilienceRecommendation) GetEstimatedImprovement() float64 {
+	if x != nil {
+		return x.EstimatedImprovement
+	}
+	return 0
+}
+
+func (x *ResilienceRecommendation) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+type AnalyzeResilienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Graph         *v1.Graph              `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Config        *ResilienceConfig      `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Algorithm     v1.Algorithm           `protobuf:"varint,3,opt,name=algorithm,proto3,enum=logistics.common.v1.Algorithm" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeResilienceRequest) Reset() {
+	*x = AnalyzeResilienceRequest{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeResilienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeResilienceRequest) ProtoMessage() {}
+
+func (x *AnalyzeResilienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeResilienceRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeResilienceRequest) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AnalyzeResilienceRequest) GetGraph() *v1.Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *AnalyzeResilienceRequest) GetConfig() *ResilienceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AnalyzeResilienceRequest) GetAlgorithm() v1.Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return v1.Algorithm(0)
+}
+
+type ResilienceConfig struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	MaxFailuresToTest     int32                  `protobuf:"varint,1,opt,name=max_failures_to_test,json=maxFailuresToTest,proto3" json:"max_failures_to_test,omitempty"`           // Тестировать до N отказов
+	TestCascadingFailures bool                   `protobuf:"varint,2,opt,name=test_cascading_failures,json=testCascadingFailures,proto3" json:"test_cascading_failures,omitempty"` // Каскадные отказы
+	// Коэффициент загрузки: ребро, загрузка которого после отказа выросла и
+	// превысила этот порог, отказывает в следующем раунде каскада (по умолчанию 0.9)
+	LoadFactor float64 `protobuf:"fixed64,3,opt,name=load_factor,json=loadFactor,proto3" json:"load_factor,omitempty"`
+	// Рёбер-кандидатов для перебора N-k и запуска каскадов (по умолчанию 20)
+	MaxCandidateEdges int32 `protobuf:"varint,4,opt,name=max_candidate_edges,json=maxCandidateEdges,proto3" json:"max_candidate_edges,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResilienceConfig) Reset() {
+	*x = ResilienceConfig{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResilienceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResilienceConfig) ProtoMessage() {}
+
+func (x *ResilienceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResilienceConfig.ProtoReflect.Descriptor instead.
+func (*ResilienceConfig) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ResilienceConfig) GetMaxFailuresToTest() int32 {
+	if x != nil {
+		return x.MaxFailuresToTest
+	}
+	return 0
+}
+
+func (x *ResilienceConfig) GetTestCascadingFailures() bool {
+	if x != nil {
+		return x.TestCascadingFailures
+	}
+	return false
+}
+
+func (x *ResilienceConfig) GetLoadFactor() float64 {
+	if x != nil {
+		return x.LoadFactor
+	}
+	return 0
+}
+
+func (x *ResilienceConfig) GetMaxCandidateEdges() int32 {
+	if x != nil {
+		return x.MaxCandidateEdges
+	}
+	return 0
+}
+
+type AnalyzeResilienceResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Общие метрики
+	Metrics *ResilienceMetrics `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// N-1 анализ (устойчивость к одиночным отказам)
+	NMinusOne *NMinusOneAnalysis `protobuf:"bytes,3,opt,name=n_minus_one,json=nMinusOne,proto3" json:"n_minus_one,omitempty"`
+	// N-2 анализ (опционально)
+	NMinusTwo *NMinusTwoAnalysis `protobuf:"bytes,4,opt,name=n_minus_two,json=nMinusTwo,proto3" json:"n_minus_two,omitempty"`
+	// Узкие места устойчивости
+	Weaknesses []*ResilienceWeakness `protobuf:"bytes,5,rep,name=weaknesses,proto3" json:"weaknesses,omitempty"`
+	Metadata   *SimulationMetadata   `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Каскадные отказы (при test_cascading_failures)
+	Cascade       *CascadeAnalysis `protobuf:"bytes,7,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeResilienceResponse) Reset() {
+	*x = AnalyzeResilienceResponse{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeResilienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeResilienceResponse) ProtoMessage() {}
+
+func (x *AnalyzeResilienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeResilienceResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeResilienceResponse) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AnalyzeResilienceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AnalyzeResilienceResponse) GetMetrics() *ResilienceMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *AnalyzeResilienceResponse) GetNMinusOne() *NMinusOneAnalysis {
+	if x != nil {
+		return x.NMinusOne
+	}
+	return nil
+}
+
+func (x *AnalyzeResilienceResponse) GetNMinusTwo() *NMinusTwoAnalysis {
+	if x != nil {
+		return x.NMinusTwo
+	}
+	return nil
+}
+
+func (x *AnalyzeResilienceResponse) GetWeaknesses() []*ResilienceWeakness {
 	if x != nil {
-		return x.NMinusOne
+		return x.Weaknesses
 	}
 	return nil
 }
 
-func (x *AnalyzeResilienceResponse) GetNMinusTwo() *NMinusTwoAnalysis {
+func (x *AnalyzeResilienceResponse) GetMetadata() *SimulationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AnalyzeResilienceResponse) GetCascade() *CascadeAnalysis {
+	if x != nil {
+		return x.Cascade
+	}
+	return nil
+}
+
+type ResilienceMetrics struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OverallScore           float64                `protobuf:"fixed64,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`                               // 0-1
+	ConnectivityRobustness float64                `protobuf:"fixed64,2,opt,name=connectivity_robustness,json=connectivityRobustness,proto3" json:"connectivity_robustness,omitempty"` // Устойчивость связности
+	FlowRobustness         float64                `protobuf:"fixed64,3,opt,name=flow_robustness,json=flowRobustness,proto3" json:"flow_robustness,omitempty"`                         // Устойчивость потока
+	RedundancyLevel        float64                `protobuf:"fixed64,4,opt,name=redundancy_level,json=redundancyLevel,proto3" json:"redundancy_level,omitempty"`                      // Уровень резервирования
+	MinCutSize             int32                  `protobuf:"varint,5,opt,name=min_cut_size,json=minCutSize,proto3" json:"min_cut_size,omitempty"`                                    // Минимальный разрез
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ResilienceMetrics) Reset() {
+	*x = ResilienceMetrics{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResilienceMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResilienceMetrics) ProtoMessage() {}
+
+func (x *ResilienceMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResilienceMetrics.ProtoReflect.Descriptor instead.
+func (*ResilienceMetrics) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ResilienceMetrics) GetOverallScore() float64 {
+	if x != nil {
+		return x.OverallScore
+	}
+	return 0
+}
+
+func (x *ResilienceMetrics) GetConnectivityRobustness() float64 {
+	if x != nil {
+		return x.ConnectivityRobustness
+	}
+	return 0
+}
+
+func (x *ResilienceMetrics) GetFlowRobustness() float64 {
+	if x != nil {
+		return x.FlowRobustness
+	}
+	return 0
+}
+
+func (x *ResilienceMetrics) GetRedundancyLevel() float64 {
+	if x != nil {
+		return x.RedundancyLevel
+	}
+	return 0
+}
+
+func (x *ResilienceMetrics) GetMinCutSize() int32 {
+	if x != nil {
+		return x.MinCutSize
+	}
+	return 0
+}
+
+type NMinusOneAnalysis struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	AllScenariosFeasible   bool                   `protobuf:"varint,1,opt,name=all_scenarios_feasible,json=allScenariosFeasible,proto3" json:"all_scenarios_feasible,omitempty"`
+	WorstCaseFlowReduction float64                `protobuf:"fixed64,2,opt,name=worst_case_flow_reduction,json=worstCaseFlowReduction,proto3" json:"worst_case_flow_reduction,omitempty"`
+	MostCriticalEdge       *v1.EdgeKey            `protobuf:"bytes,3,opt,name=most_critical_edge,json=mostCriticalEdge,proto3" json:"most_critical_edge,omitempty"`
+	MostCriticalNode       int64                  `protobuf:"varint,4,opt,name=most_critical_node,json=mostCriticalNode,proto3" json:"most_critical_node,omitempty"`
+	ScenariosTested        int32                  `protobuf:"varint,5,opt,name=scenarios_tested,json=scenariosTested,proto3" json:"scenarios_tested,omitempty"`
+	ScenariosFailed        int32                  `protobuf:"varint,6,opt,name=scenarios_failed,json=scenariosFailed,proto3" json:"scenarios_failed,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NMinusOneAnalysis) Reset() {
+	*x = NMinusOneAnalysis{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NMinusOneAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NMinusOneAnalysis) ProtoMessage() {}
+
+func (x *NMinusOneAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NMinusOneAnalysis.ProtoReflect.Descriptor instead.
+func (*NMinusOneAnalysis) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *NMinusOneAnalysis) GetAllScenariosFeasible() bool {
+	if x != nil {
+		return x.AllScenariosFeasible
+	}
+	return false
+}
+
+func (x *NMinusOneAnalysis) GetWorstCaseFlowReduction() float64 {
+	if x != nil {
+		return x.WorstCaseFlowReduction
+	}
+	return 0
+}
+
+func (x *NMinusOneAnalysis) GetMostCriticalEdge() *v1.EdgeKey {
+	if x != nil {
+		return x.MostCriticalEdge
+	}
+	return nil
+}
+
+func (x *NMinusOneAnalysis) GetMostCriticalNode() int64 {
+	if x != nil {
+		return x.MostCriticalNode
+	}
+	return 0
+}
+
+func (x *NMinusOneAnalysis) GetScenariosTested() int32 {
+	if x != nil {
+		return x.ScenariosTested
+	}
+	return 0
+}
+
+func (x *NMinusOneAnalysis) GetScenariosFailed() int32 {
+	if x != nil {
+		return x.ScenariosFailed
+	}
+	return 0
+}
+
+// NMinusTwoAnalysis перебор одновременных отказов от 2 до max_failures_to_test
+// рёбер-кандидатов. Набор критичен, если его отказ обрывает поток или снижает
+// его сильнее суммы одиночных отказов его рёбер (рёбра резервируют друг
+// друга); в отчёт попадают только минимальные критические наборы.
+type NMinusTwoAnalysis struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Enabled                bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ProbabilityOfFailure   float64                `protobuf:"fixed64,2,opt,name=probability_of_failure,json=probabilityOfFailure,proto3" json:"probability_of_failure,omitempty"` // Доля сценариев с полной потерей потока
+	CriticalPairs          int32                  `protobuf:"varint,3,opt,name=critical_pairs,json=criticalPairs,proto3" json:"critical_pairs,omitempty"`
+	CriticalEdgePairs      []*EdgePair            `protobuf:"bytes,4,rep,name=critical_edge_pairs,json=criticalEdgePairs,proto3" json:"critical_edge_pairs,omitempty"`
+	MaxFailures            int32                  `protobuf:"varint,5,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`          // Наибольший размер проверенных наборов
+	CandidateEdges         int32                  `protobuf:"varint,6,opt,name=candidate_edges,json=candidateEdges,proto3" json:"candidate_edges,omitempty"` // Рёбер-кандидатов после отсечения
+	ScenariosTested        int32                  `protobuf:"varint,7,opt,name=scenarios_tested,json=scenariosTested,proto3" json:"scenarios_tested,omitempty"`
+	ScenariosFailed        int32                  `protobuf:"varint,8,opt,name=scenarios_failed,json=scenariosFailed,proto3" json:"scenarios_failed,omitempty"`
+	WorstCaseFlowReduction float64                `protobuf:"fixed64,9,opt,name=worst_case_flow_reduction,json=worstCaseFlowReduction,proto3" json:"worst_case_flow_reduction,omitempty"`
+	CriticalEdgeSets       []*EdgeSet             `protobuf:"bytes,10,rep,name=critical_edge_sets,json=criticalEdgeSets,proto3" json:"critical_edge_sets,omitempty"` // Критические наборы из 3+ рёбер
+	Truncated              bool                   `protobuf:"varint,11,opt,name=truncated,proto3" json:"truncated,omitempty"`                                        // Перебор остановлен на лимите сценариев
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NMinusTwoAnalysis) Reset() {
+	*x = NMinusTwoAnalysis{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NMinusTwoAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NMinusTwoAnalysis) ProtoMessage() {}
+
+func (x *NMinusTwoAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NMinusTwoAnalysis.ProtoReflect.Descriptor instead.
+func (*NMinusTwoAnalysis) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *NMinusTwoAnalysis) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NMinusTwoAnalysis) GetProbabilityOfFailure() float64 {
+	if x != nil {
+		return x.ProbabilityOfFailure
+	}
+	return 0
+}
+
+func (x *NMinusTwoAnalysis) GetCriticalPairs() int32 {
+	if x != nil {
+		return x.CriticalPairs
+	}
+	return 0
+}
+
+func (x *NMinusTwoAnalysis) GetCriticalEdgePairs() []*EdgePair {
+	if x != nil {
+		return x.CriticalEdgePairs
+	}
+	return nil
+}
+
+func (x *NMinusTwoAnalysis) GetMaxFailures() int32 {
+	if x != nil {
+		return x.MaxFailures
+	}
+	return 0
+}
+
+func (x *NMinusTwoAnalysis) GetCandidateEdges() int32 {
+	if x != nil {
+		return x.CandidateEdges
+	}
+	return 0
+}
+
+func (x *NMinusTwoAnalysis) GetScenariosTested() int32 {
+	if x != nil {
+		return x.ScenariosTested
+	}
+	return 0
+}
+
+func (x *NMinusTwoAnalysis) GetScenariosFailed() int32 {
+	if x != nil {
+		return x.ScenariosFailed
+	}
+	return 0
+}
+
+func (x *NMinusTwoAnalysis) GetWorstCaseFlowReduction() float64 {
 	if x != nil {
-		return x.NMinusTwo
+		return x.WorstCaseFlowReduction
 	}
-	return nil
+	return 0
 }
 
-func (x *AnalyzeResilienceResponse) GetWeaknesses() []*ResilienceWeakness {
+func (x *NMinusTwoAnalysis) GetCriticalEdgeSets() []*EdgeSet {
 	if x != nil {
-		return x.Weaknesses
+		return x.CriticalEdgeSets
 	}
 	return nil
 }
 
-func (x *AnalyzeResilienceResponse) GetMetadata() *SimulationMetadata {
+func (x *NMinusTwoAnalysis) GetTruncated() bool {
 	if x != nil {
-		return x.Metadata
+		return x.Truncated
 	}
-	return nil
+	return false
 }
 
-type ResilienceMetrics struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	OverallScore           float64                `protobuf:"fixed64,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`                               // 0-1
-	ConnectivityRobustness float64                `protobuf:"fixed64,2,opt,name=connectivity_robustness,json=connectivityRobustness,proto3" json:"connectivity_robustness,omitempty"` // Устойчивость связности
-	FlowRobustness         float64                `protobuf:"fixed64,3,opt,name=flow_robustness,json=flowRobustness,proto3" json:"flow_robustness,omitempty"`                         // Устойчивость потока
-	RedundancyLevel        float64                `protobuf:"fixed64,4,opt,name=redundancy_level,json=redundancyLevel,proto3" json:"redundancy_level,omitempty"`                      // Уровень резервирования
-	MinCutSize             int32                  `protobuf:"varint,5,opt,name=min_cut_size,json=minCutSize,proto3" json:"min_cut_size,omitempty"`                                    // Минимальный разрез
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type EdgePair struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Edge1          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge1,proto3" json:"edge1,omitempty"`
+	Edge2          *v1.EdgeKey            `protobuf:"bytes,2,opt,name=edge2,proto3" json:"edge2,omitempty"`
+	CombinedImpact float64                `protobuf:"fixed64,3,opt,name=combined_impact,json=combinedImpact,proto3" json:"combined_impact,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ResilienceMetrics) Reset() {
-	*x = ResilienceMetrics{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[59]
+func (x *EdgePair) Reset() {
+	*x = EdgePair{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResilienceMetrics) String() string {
+func (x *EdgePair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResilienceMetrics) ProtoMessage() {}
+func (*EdgePair) ProtoMessage() {}
 
-func (x *ResilienceMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[59]
+func (x *EdgePair) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5589,73 +8877,114 @@ func (x *ResilienceMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResilienceMetrics.ProtoReflect.Descriptor instead.
-func (*ResilienceMetrics) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use EdgePair.ProtoReflect.Descriptor instead.
+func (*EdgePair) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *ResilienceMetrics) GetOverallScore() float64 {
+func (x *EdgePair) GetEdge1() *v1.EdgeKey {
 	if x != nil {
-		return x.OverallScore
+		return x.Edge1
 	}
-	return 0
+	return nil
 }
 
-func (x *ResilienceMetrics) GetConnectivityRobustness() float64 {
+func (x *EdgePair) GetEdge2() *v1.EdgeKey {
 	if x != nil {
-		return x.ConnectivityRobustness
+		return x.Edge2
 	}
-	return 0
+	return nil
 }
 
-func (x *ResilienceMetrics) GetFlowRobustness() float64 {
+func (x *EdgePair) GetCombinedImpact() float64 {
 	if x != nil {
-		return x.FlowRobustness
+		return x.CombinedImpact
 	}
 	return 0
 }
 
-func (x *ResilienceMetrics) GetRedundancyLevel() float64 {
+type EdgeSet struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Edges          []*v1.EdgeKey          `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	CombinedImpact float64                `protobuf:"fixed64,2,opt,name=combined_impact,json=combinedImpact,proto3" json:"combined_impact,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EdgeSet) Reset() {
+	*x = EdgeSet{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeSet) ProtoMessage() {}
+
+func (x *EdgeSet) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[89]
 	if x != nil {
-		return x.RedundancyLevel
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ResilienceMetrics) GetMinCutSize() int32 {
+// Deprecated: Use EdgeSet.ProtoReflect.Descriptor instead.
+func (*EdgeSet) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *EdgeSet) GetEdges() []*v1.EdgeKey {
 	if x != nil {
-		return x.MinCutSize
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *EdgeSet) GetCombinedImpact() float64 {
+	if x != nil {
+		return x.CombinedImpact
 	}
 	return 0
 }
 
-type NMinusOneAnalysis struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	AllScenariosFeasible   bool                   `protobuf:"varint,1,opt,name=all_scenarios_feasible,json=allScenariosFeasible,proto3" json:"all_scenarios_feasible,omitempty"`
-	WorstCaseFlowReduction float64                `protobuf:"fixed64,2,opt,name=worst_case_flow_reduction,json=worstCaseFlowReduction,proto3" json:"worst_case_flow_reduction,omitempty"`
-	MostCriticalEdge       *v1.EdgeKey            `protobuf:"bytes,3,opt,name=most_critical_edge,json=mostCriticalEdge,proto3" json:"most_critical_edge,omitempty"`
-	MostCriticalNode       int64                  `protobuf:"varint,4,opt,name=most_critical_node,json=mostCriticalNode,proto3" json:"most_critical_node,omitempty"`
-	ScenariosTested        int32                  `protobuf:"varint,5,opt,name=scenarios_tested,json=scenariosTested,proto3" json:"scenarios_tested,omitempty"`
-	ScenariosFailed        int32                  `protobuf:"varint,6,opt,name=scenarios_failed,json=scenariosFailed,proto3" json:"scenarios_failed,omitempty"`
+// CascadeAnalysis моделирует каскады перегрузок: после исходного отказа
+// поток перераспределяется, и рёбра, загрузка которых выросла выше
+// load_factor, отказывают в следующем раунде — до устойчивого состояния
+type CascadeAnalysis struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LoadFactor      float64                `protobuf:"fixed64,2,opt,name=load_factor,json=loadFactor,proto3" json:"load_factor,omitempty"` // Применённый порог загрузки
+	ScenariosTested int32                  `protobuf:"varint,3,opt,name=scenarios_tested,json=scenariosTested,proto3" json:"scenarios_tested,omitempty"`
+	// Каскады, вышедшие за исходный отказ, по убыванию потери потока
+	Scenarios              []*CascadeScenario `protobuf:"bytes,4,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	WorstCaseFlowReduction float64            `protobuf:"fixed64,5,opt,name=worst_case_flow_reduction,json=worstCaseFlowReduction,proto3" json:"worst_case_flow_reduction,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *NMinusOneAnalysis) Reset() {
-	*x = NMinusOneAnalysis{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[60]
+func (x *CascadeAnalysis) Reset() {
+	*x = CascadeAnalysis{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NMinusOneAnalysis) String() string {
+func (x *CascadeAnalysis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NMinusOneAnalysis) ProtoMessage() {}
+func (*CascadeAnalysis) ProtoMessage() {}
 
-func (x *NMinusOneAnalysis) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[60]
+func (x *CascadeAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5666,78 +8995,72 @@ func (x *NMinusOneAnalysis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NMinusOneAnalysis.ProtoReflect.Descriptor instead.
-func (*NMinusOneAnalysis) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use CascadeAnalysis.ProtoReflect.Descriptor instead.
+func (*CascadeAnalysis) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *NMinusOneAnalysis) GetAllScenariosFeasible() bool {
+func (x *CascadeAnalysis) GetEnabled() bool {
 	if x != nil {
-		return x.AllScenariosFeasible
+		return x.Enabled
 	}
 	return false
 }
 
-func (x *NMinusOneAnalysis) GetWorstCaseFlowReduction() float64 {
+func (x *CascadeAnalysis) GetLoadFactor() float64 {
 	if x != nil {
-		return x.WorstCaseFlowReduction
+		return x.LoadFactor
 	}
 	return 0
 }
 
-func (x *NMinusOneAnalysis) GetMostCriticalEdge() *v1.EdgeKey {
-	if x != nil {
-		return x.MostCriticalEdge
-	}
-	return nil
-}
-
-func (x *NMinusOneAnalysis) GetMostCriticalNode() int64 {
+func (x *CascadeAnalysis) GetScenariosTested() int32 {
 	if x != nil {
-		return x.MostCriticalNode
+		return x.ScenariosTested
 	}
 	return 0
 }
 
-func (x *NMinusOneAnalysis) GetScenariosTested() int32 {
+func (x *CascadeAnalysis) GetScenarios() []*CascadeScenario {
 	if x != nil {
-		return x.ScenariosTested
+		return x.Scenarios
 	}
-	return 0
+	return nil
 }
 
-func (x *NMinusOneAnalysis) GetScenariosFailed() int32 {
+func (x *CascadeAnalysis) GetWorstCaseFlowReduction() float64 {
 	if x != nil {
-		return x.ScenariosFailed
+		return x.WorstCaseFlowReduction
 	}
 	return 0
 }
 
-type NMinusTwoAnalysis struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Enabled              bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ProbabilityOfFailure float64                `protobuf:"fixed64,2,opt,name=probability_of_failure,json=probabilityOfFailure,proto3" json:"probability_of_failure,omitempty"`
-	CriticalPairs        int32                  `protobuf:"varint,3,opt,name=critical_pairs,json=criticalPairs,proto3" json:"critical_pairs,omitempty"`
-	CriticalEdgePairs    []*EdgePair            `protobuf:"bytes,4,rep,name=critical_edge_pairs,json=criticalEdgePairs,proto3" json:"critical_edge_pairs,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type CascadeScenario struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitialFailure *v1.EdgeKey            `protobuf:"bytes,1,opt,name=initial_failure,json=initialFailure,proto3" json:"initial_failure,omitempty"`
+	Steps          []*CascadeStep         `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`                            // Раунды, начиная с исходного отказа
+	FinalFlow      float64                `protobuf:"fixed64,3,opt,name=final_flow,json=finalFlow,proto3" json:"final_flow,omitempty"` // Поток по уцелевшим рёбрам
+	FlowReduction  float64                `protobuf:"fixed64,4,opt,name=flow_reduction,json=flowReduction,proto3" json:"flow_reduction,omitempty"`
+	FailedEdges    int32                  `protobuf:"varint,5,opt,name=failed_edges,json=failedEdges,proto3" json:"failed_edges,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *NMinusTwoAnalysis) Reset() {
-	*x = NMinusTwoAnalysis{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[61]
+func (x *CascadeScenario) Reset() {
+	*x = CascadeScenario{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NMinusTwoAnalysis) String() string {
+func (x *CascadeScenario) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NMinusTwoAnalysis) ProtoMessage() {}
+func (*CascadeScenario) ProtoMessage() {}
 
-func (x *NMinusTwoAnalysis) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[61]
+func (x *CascadeScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5748,63 +9071,70 @@ func (x *NMinusTwoAnalysis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NMinusTwoAnalysis.ProtoReflect.Descriptor instead.
-func (*NMinusTwoAnalysis) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use CascadeScenario.ProtoReflect.Descriptor instead.
+func (*CascadeScenario) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *NMinusTwoAnalysis) GetEnabled() bool {
+func (x *CascadeScenario) GetInitialFailure() *v1.EdgeKey {
 	if x != nil {
-		return x.Enabled
+		return x.InitialFailure
 	}
-	return false
+	return nil
 }
 
-func (x *NMinusTwoAnalysis) GetProbabilityOfFailure() float64 {
+func (x *CascadeScenario) GetSteps() []*CascadeStep {
 	if x != nil {
-		return x.ProbabilityOfFailure
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CascadeScenario) GetFinalFlow() float64 {
+	if x != nil {
+		return x.FinalFlow
 	}
 	return 0
 }
 
-func (x *NMinusTwoAnalysis) GetCriticalPairs() int32 {
+func (x *CascadeScenario) GetFlowReduction() float64 {
 	if x != nil {
-		return x.CriticalPairs
+		return x.FlowReduction
 	}
 	return 0
 }
 
-func (x *NMinusTwoAnalysis) GetCriticalEdgePairs() []*EdgePair {
+func (x *CascadeScenario) GetFailedEdges() int32 {
 	if x != nil {
-		return x.CriticalEdgePairs
+		return x.FailedEdges
 	}
-	return nil
+	return 0
 }
 
-type EdgePair struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Edge1          *v1.EdgeKey            `protobuf:"bytes,1,opt,name=edge1,proto3" json:"edge1,omitempty"`
-	Edge2          *v1.EdgeKey            `protobuf:"bytes,2,opt,name=edge2,proto3" json:"edge2,omitempty"`
-	CombinedImpact float64                `protobuf:"fixed64,3,opt,name=combined_impact,json=combinedImpact,proto3" json:"combined_impact,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CascadeStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	FailedEdges   []*v1.EdgeKey          `protobuf:"bytes,2,rep,name=failed_edges,json=failedEdges,proto3" json:"failed_edges,omitempty"`
+	FlowAfter     float64                `protobuf:"fixed64,3,opt,name=flow_after,json=flowAfter,proto3" json:"flow_after,omitempty"` // Поток после отказа рёбер раунда
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EdgePair) Reset() {
-	*x = EdgePair{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[62]
+func (x *CascadeStep) Reset() {
+	*x = CascadeStep{}
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EdgePair) String() string {
+func (x *CascadeStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EdgePair) ProtoMessage() {}
+func (*CascadeStep) ProtoMessage() {}
 
-func (x *EdgePair) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[62]
+func (x *CascadeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5815,28 +9145,28 @@ func (x *EdgePair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EdgePair.ProtoReflect.Descriptor instead.
-func (*EdgePair) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use CascadeStep.ProtoReflect.Descriptor instead.
+func (*CascadeStep) Descriptor() ([]byte, []int) {
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *EdgePair) GetEdge1() *v1.EdgeKey {
+func (x *CascadeStep) GetRound() int32 {
 	if x != nil {
-		return x.Edge1
+		return x.Round
 	}
-	return nil
+	return 0
 }
 
-func (x *EdgePair) GetEdge2() *v1.EdgeKey {
+func (x *CascadeStep) GetFailedEdges() []*v1.EdgeKey {
 	if x != nil {
-		return x.Edge2
+		return x.FailedEdges
 	}
 	return nil
 }
 
-func (x *EdgePair) GetCombinedImpact() float64 {
+func (x *CascadeStep) GetFlowAfter() float64 {
 	if x != nil {
-		return x.CombinedImpact
+		return x.FlowAfter
 	}
 	return 0
 }
@@ -5855,7 +9185,7 @@ type ResilienceWeakness struct {
 
 func (x *ResilienceWeakness) Reset() {
 	*x = ResilienceWeakness{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[63]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5867,7 +9197,7 @@ func (x *ResilienceWeakness) String() string {
 func (*ResilienceWeakness) ProtoMessage() {}
 
 func (x *ResilienceWeakness) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[63]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5880,7 +9210,7 @@ func (x *ResilienceWeakness) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResilienceWeakness.ProtoReflect.Descriptor instead.
 func (*ResilienceWeakness) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{63}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *ResilienceWeakness) GetDescription() string {
@@ -5943,7 +9273,7 @@ type SaveSimulationRequest struct {
 
 func (x *SaveSimulationRequest) Reset() {
 	*x = SaveSimulationRequest{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[64]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5955,7 +9285,7 @@ func (x *SaveSimulationRequest) String() string {
 func (*SaveSimulationRequest) ProtoMessage() {}
 
 func (x *SaveSimulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[64]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5968,7 +9298,7 @@ func (x *SaveSimulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveSimulationRequest.ProtoReflect.Descriptor instead.
 func (*SaveSimulationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{64}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *SaveSimulationRequest) GetUserId() string {
@@ -6037,7 +9367,7 @@ type SaveSimulationResponse struct {
 
 func (x *SaveSimulationResponse) Reset() {
 	*x = SaveSimulationResponse{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[65]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6049,7 +9379,7 @@ func (x *SaveSimulationResponse) String() string {
 func (*SaveSimulationResponse) ProtoMessage() {}
 
 func (x *SaveSimulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[65]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6062,7 +9392,7 @@ func (x *SaveSimulationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveSimulationResponse.ProtoReflect.Descriptor instead.
 func (*SaveSimulationResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{65}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *SaveSimulationResponse) GetSimulationId() string {
@@ -6089,7 +9419,7 @@ type GetSimulationRequest struct {
 
 func (x *GetSimulationRequest) Reset() {
 	*x = GetSimulationRequest{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[66]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6101,7 +9431,7 @@ func (x *GetSimulationRequest) String() string {
 func (*GetSimulationRequest) ProtoMessage() {}
 
 func (x *GetSimulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[66]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6114,7 +9444,7 @@ func (x *GetSimulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSimulationRequest.ProtoReflect.Descriptor instead.
 func (*GetSimulationRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{66}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetSimulationRequest) GetSimulationId() string {
@@ -6140,7 +9470,7 @@ type GetSimulationResponse struct {
 
 func (x *GetSimulationResponse) Reset() {
 	*x = GetSimulationResponse{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[67]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6152,7 +9482,7 @@ func (x *GetSimulationResponse) String() string {
 func (*GetSimulationResponse) ProtoMessage() {}
 
 func (x *GetSimulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[67]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6165,7 +9495,7 @@ func (x *GetSimulationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSimulationResponse.ProtoReflect.Descriptor instead.
 func (*GetSimulationResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{67}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *GetSimulationResponse) GetRecord() *SimulationRecord {
@@ -6186,7 +9516,7 @@ type ListSimulationsRequest struct {
 
 func (x *ListSimulationsRequest) Reset() {
 	*x = ListSimulationsRequest{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[68]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6198,7 +9528,7 @@ func (x *ListSimulationsRequest) String() string {
 func (*ListSimulationsRequest) ProtoMessage() {}
 
 func (x *ListSimulationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[68]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6211,7 +9541,7 @@ func (x *ListSimulationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSimulationsRequest.ProtoReflect.Descriptor instead.
 func (*ListSimulationsRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{68}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *ListSimulationsRequest) GetUserId() string {
@@ -6245,7 +9575,7 @@ type ListSimulationsResponse struct {
 
 func (x *ListSimulationsResponse) Reset() {
 	*x = ListSimulationsResponse{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[69]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6257,7 +9587,7 @@ func (x *ListSimulationsResponse) String() string {
 func (*ListSimulationsResponse) ProtoMessage() {}
 
 func (x *ListSimulationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[69]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6270,7 +9600,7 @@ func (x *ListSimulationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSimulationsResponse.ProtoReflect.Descriptor instead.
 func (*ListSimulationsResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{69}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *ListSimulationsResponse) GetSimulations() []*SimulationSummary {
@@ -6304,7 +9634,7 @@ type SimulationRecord struct {
 
 func (x *SimulationRecord) Reset() {
 	*x = SimulationRecord{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[70]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6316,7 +9646,7 @@ func (x *SimulationRecord) String() string {
 func (*SimulationRecord) ProtoMessage() {}
 
 func (x *SimulationRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[70]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6329,7 +9659,7 @@ func (x *SimulationRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationRecord.ProtoReflect.Descriptor instead.
 func (*SimulationRecord) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{70}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *SimulationRecord) GetId() string {
@@ -6408,7 +9738,7 @@ type SimulationSummary struct {
 
 func (x *SimulationSummary) Reset() {
 	*x = SimulationSummary{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[71]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6420,7 +9750,7 @@ func (x *SimulationSummary) String() string {
 func (*SimulationSummary) ProtoMessage() {}
 
 func (x *SimulationSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[71]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6433,7 +9763,7 @@ func (x *SimulationSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationSummary.ProtoReflect.Descriptor instead.
 func (*SimulationSummary) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{71}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *SimulationSummary) GetId() string {
@@ -6485,7 +9815,7 @@ type SimulationMetadata struct {
 
 func (x *SimulationMetadata) Reset() {
 	*x = SimulationMetadata{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[72]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6497,7 +9827,7 @@ func (x *SimulationMetadata) String() string {
 func (*SimulationMetadata) ProtoMessage() {}
 
 func (x *SimulationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[72]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6510,7 +9840,7 @@ func (x *SimulationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationMetadata.ProtoReflect.Descriptor instead.
 func (*SimulationMetadata) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{72}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *SimulationMetadata) GetSimulationId() string {
@@ -6563,7 +9893,7 @@ type HealthRequest struct {
 
 func (x *HealthRequest) Reset() {
 	*x = HealthRequest{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[73]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6575,7 +9905,7 @@ func (x *HealthRequest) String() string {
 func (*HealthRequest) ProtoMessage() {}
 
 func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[73]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6588,7 +9918,7 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
 func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{73}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{103}
 }
 
 type HealthResponse struct {
@@ -6602,7 +9932,7 @@ type HealthResponse struct {
 
 func (x *HealthResponse) Reset() {
 	*x = HealthResponse{}
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[74]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6614,7 +9944,7 @@ func (x *HealthResponse) String() string {
 func (*HealthResponse) ProtoMessage() {}
 
 func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[74]
+	mi := &file_logistics_simulation_v1_simulation_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6627,7 +9957,7 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{74}
+	return file_logistics_simulation_v1_simulation_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *HealthResponse) GetStatus() string {
@@ -6655,7 +9985,7 @@ var File_logistics_simulation_v1_simulation_proto protoreflect.FileDescriptor
 
 const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\n" +
-	"(logistics/simulation/v1/simulation.proto\x12\x17logistics.simulation.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a logistics/common/v1/common.proto\"\xa2\x02\n" +
+	"(logistics/simulation/v1/simulation.proto\x12\x17logistics.simulation.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&logistics/analytics/v1/analytics.proto\x1a logistics/common/v1/common.proto\"\xa2\x02\n" +
 	"\x10RunWhatIfRequest\x12A\n" +
 	"\x0ebaseline_graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\rbaselineGraph\x12K\n" +
 	"\rmodifications\x18\x02 \x03(\v2%.logistics.simulation.v1.ModificationR\rmodifications\x12<\n" +
@@ -6740,20 +10070,120 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x10\n" +
 	"\x03roi\x18\x04 \x01(\x01R\x03roi\x12L\n" +
 	"\vvs_baseline\x18\x05 \x01(\v2+.logistics.simulation.v1.ScenarioComparisonR\n" +
-	"vsBaseline\"\xf8\x02\n" +
+	"vsBaseline\"\xee\x02\n" +
+	" OptimizeCapacityExpansionRequest\x120\n" +
+	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12<\n" +
+	"\talgorithm\x18\x02 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\x12\x16\n" +
+	"\x06budget\x18\x03 \x01(\x01R\x06budget\x12F\n" +
+	"\bupgrades\x18\x04 \x03(\v2*.logistics.simulation.v1.EdgeUpgradeOptionR\bupgrades\x12S\n" +
+	"\x0fdefault_upgrade\x18\x05 \x01(\v2*.logistics.simulation.v1.EdgeUpgradeOptionR\x0edefaultUpgrade\x12%\n" +
+	"\x0emax_iterations\x18\x06 \x01(\x05R\rmaxIterations\"\xbf\x01\n" +
+	"\x11EdgeUpgradeOption\x120\n" +
+	"\x04edge\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x04edge\x12\x1d\n" +
+	"\n" +
+	"fixed_cost\x18\x02 \x01(\x01R\tfixedCost\x12\"\n" +
+	"\rcost_per_unit\x18\x03 \x01(\x01R\vcostPerUnit\x12\x12\n" +
+	"\x04step\x18\x04 \x01(\x01R\x04step\x12!\n" +
+	"\fmax_increase\x18\x05 \x01(\x01R\vmaxIncrease\"\xa8\x04\n" +
+	"!OptimizeCapacityExpansionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\tbase_flow\x18\x02 \x01(\x01R\bbaseFlow\x12\x1d\n" +
+	"\n" +
+	"final_flow\x18\x03 \x01(\x01R\tfinalFlow\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x04 \x01(\x01R\ttotalCost\x12)\n" +
+	"\x10remaining_budget\x18\x05 \x01(\x01R\x0fremainingBudget\x12J\n" +
+	"\n" +
+	"increments\x18\x06 \x03(\v2*.logistics.simulation.v1.CapacityIncrementR\n" +
+	"increments\x12<\n" +
+	"\x05steps\x18\a \x03(\v2&.logistics.simulation.v1.ExpansionStepR\x05steps\x12A\n" +
+	"\x0eexpanded_graph\x18\b \x01(\v2\x1a.logistics.common.v1.GraphR\rexpandedGraph\x12M\n" +
+	"\vstop_reason\x18\t \x01(\x0e2,.logistics.simulation.v1.ExpansionStopReasonR\n" +
+	"stopReason\x12G\n" +
+	"\bmetadata\x18\n" +
+	" \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\"\xa3\x01\n" +
+	"\x11CapacityIncrement\x120\n" +
+	"\x04edge\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x04edge\x12%\n" +
+	"\x0eadded_capacity\x18\x02 \x01(\x01R\raddedCapacity\x12!\n" +
+	"\fnew_capacity\x18\x03 \x01(\x01R\vnewCapacity\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\"\xdb\x01\n" +
+	"\rExpansionStep\x12\x1c\n" +
+	"\titeration\x18\x01 \x01(\x05R\titeration\x12J\n" +
+	"\n" +
+	"increments\x18\x02 \x03(\v2*.logistics.simulation.v1.CapacityIncrementR\n" +
+	"increments\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\x12\x12\n" +
+	"\x04flow\x18\x04 \x01(\x01R\x04flow\x12\x1b\n" +
+	"\tflow_gain\x18\x05 \x01(\x01R\bflowGain\x12\x1b\n" +
+	"\twhole_cut\x18\x06 \x01(\bR\bwholeCut\"\x99\x02\n" +
+	"\x1fOptimizeFacilityLocationRequest\x120\n" +
+	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12H\n" +
+	"\vfixed_costs\x18\x02 \x01(\v2'.logistics.analytics.v1.FixedCostConfigR\n" +
+	"fixedCosts\x120\n" +
+	"\x14unmet_demand_penalty\x18\x03 \x01(\x01R\x12unmetDemandPenalty\x12!\n" +
+	"\finitial_open\x18\x04 \x03(\x03R\vinitialOpen\x12%\n" +
+	"\x0emax_iterations\x18\x05 \x01(\x05R\rmaxIterations\"\x86\x06\n" +
+	" OptimizeFacilityLocationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12I\n" +
+	"\n" +
+	"facilities\x18\x02 \x03(\v2).logistics.simulation.v1.FacilityDecisionR\n" +
+	"facilities\x12'\n" +
+	"\x0fopen_warehouses\x18\x03 \x03(\x03R\x0eopenWarehouses\x12+\n" +
+	"\x11closed_warehouses\x18\x04 \x03(\x03R\x10closedWarehouses\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x05 \x01(\x01R\ttotalCost\x12\x1d\n" +
+	"\n" +
+	"fixed_cost\x18\x06 \x01(\x01R\tfixedCost\x12%\n" +
+	"\x0etransport_cost\x18\a \x01(\x01R\rtransportCost\x12*\n" +
+	"\x11unmet_demand_cost\x18\b \x01(\x01R\x0funmetDemandCost\x12!\n" +
+	"\ftotal_demand\x18\t \x01(\x01R\vtotalDemand\x12#\n" +
+	"\rserved_demand\x18\n" +
+	" \x01(\x01R\fservedDemand\x12,\n" +
+	"\x12initial_total_cost\x18\v \x01(\x01R\x10initialTotalCost\x12;\n" +
+	"\x05moves\x18\f \x03(\v2%.logistics.simulation.v1.FacilityMoveR\x05moves\x12[\n" +
+	"\x0frecommendations\x18\r \x03(\v21.logistics.simulation.v1.ResilienceRecommendationR\x0frecommendations\x12=\n" +
+	"\fsolved_graph\x18\x0e \x01(\v2\x1a.logistics.common.v1.GraphR\vsolvedGraph\x12G\n" +
+	"\bmetadata\x18\x0f \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\"\xf5\x01\n" +
+	"\x10FacilityDecision\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04open\x18\x03 \x01(\bR\x04open\x12%\n" +
+	"\x0einitially_open\x18\x04 \x01(\bR\rinitiallyOpen\x12!\n" +
+	"\fopening_cost\x18\x05 \x01(\x01R\vopeningCost\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x01R\bcapacity\x12\x18\n" +
+	"\ashipped\x18\a \x01(\x01R\ashipped\x12 \n" +
+	"\vutilization\x18\b \x01(\x01R\vutilization\"\xe4\x01\n" +
+	"\fFacilityMove\x12\x1c\n" +
+	"\titeration\x18\x01 \x01(\x05R\titeration\x12=\n" +
+	"\x04type\x18\x02 \x01(\x0e2).logistics.simulation.v1.FacilityMoveTypeR\x04type\x12\x1f\n" +
+	"\vopened_node\x18\x03 \x01(\x03R\n" +
+	"openedNode\x12\x1f\n" +
+	"\vclosed_node\x18\x04 \x01(\x03R\n" +
+	"closedNode\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x05 \x01(\x01R\ttotalCost\x12\x16\n" +
+	"\x06saving\x18\x06 \x01(\x01R\x06saving\"\xf8\x02\n" +
 	"\x18RunTimeSimulationRequest\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12N\n" +
 	"\vtime_config\x18\x02 \x01(\v2-.logistics.simulation.v1.TimeSimulationConfigR\n" +
 	"timeConfig\x12M\n" +
 	"\redge_patterns\x18\x03 \x03(\v2(.logistics.simulation.v1.EdgeTimePatternR\fedgePatterns\x12M\n" +
 	"\rnode_patterns\x18\x04 \x03(\v2(.logistics.simulation.v1.NodeTimePatternR\fnodePatterns\x12<\n" +
-	"\talgorithm\x18\x05 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\xe5\x01\n" +
+	"\talgorithm\x18\x05 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\x8e\x03\n" +
 	"\x14TimeSimulationConfig\x129\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12>\n" +
 	"\ttime_step\x18\x03 \x01(\x0e2!.logistics.simulation.v1.TimeStepR\btimeStep\x12\x1b\n" +
-	"\tnum_steps\x18\x04 \x01(\x05R\bnumSteps\"\x83\x01\n" +
+	"\tnum_steps\x18\x04 \x01(\x05R\bnumSteps\x12?\n" +
+	"\x04mode\x18\x05 \x01(\x0e2+.logistics.simulation.v1.TimeSimulationModeR\x04mode\x12G\n" +
+	"\vroad_speeds\x18\x06 \x03(\v2&.logistics.simulation.v1.RoadTypeSpeedR\n" +
+	"roadSpeeds\x12\x1d\n" +
+	"\n" +
+	"carry_over\x18\a \x01(\bR\tcarryOver\"a\n" +
+	"\rRoadTypeSpeed\x12:\n" +
+	"\troad_type\x18\x01 \x01(\x0e2\x1d.logistics.common.v1.RoadTypeR\broadType\x12\x14\n" +
+	"\x05speed\x18\x02 \x01(\x01R\x05speed\"\x83\x01\n" +
 	"\x0fEdgeTimePattern\x120\n" +
 	"\x04edge\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x04edge\x12>\n" +
 	"\apattern\x18\x02 \x01(\v2$.logistics.simulation.v1.TimePatternR\apattern\"\xaa\x01\n" +
@@ -6774,13 +10204,15 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x04step\x18\x01 \x01(\x05R\x04step\x12\x1e\n" +
 	"\n" +
 	"multiplier\x18\x02 \x01(\x01R\n" +
-	"multiplier\"\xe2\x02\n" +
+	"multiplier\"\x80\x04\n" +
 	"\x19RunTimeSimulationResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12J\n" +
 	"\fstep_results\x18\x02 \x03(\v2'.logistics.simulation.v1.TimeStepResultR\vstepResults\x12B\n" +
 	"\x05stats\x18\x03 \x01(\v2,.logistics.simulation.v1.TimeSimulationStatsR\x05stats\x12R\n" +
 	"\x10critical_periods\x18\x04 \x03(\v2'.logistics.simulation.v1.CriticalPeriodR\x0fcriticalPeriods\x12G\n" +
-	"\bmetadata\x18\x05 \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\"\xb2\x02\n" +
+	"\bmetadata\x18\x05 \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\x12N\n" +
+	"\fdynamic_flow\x18\x06 \x01(\v2+.logistics.simulation.v1.DynamicFlowSummaryR\vdynamicFlow\x12L\n" +
+	"\x0eservice_levels\x18\a \x03(\v2%.logistics.simulation.v1.ServiceLevelR\rserviceLevels\"\xe8\x04\n" +
 	"\x0eTimeStepResult\x12\x12\n" +
 	"\x04step\x18\x01 \x01(\x05R\x04step\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x19\n" +
@@ -6789,7 +10221,42 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"total_cost\x18\x04 \x01(\x01R\ttotalCost\x12/\n" +
 	"\x13average_utilization\x18\x05 \x01(\x01R\x12averageUtilization\x12'\n" +
 	"\x0fsaturated_edges\x18\x06 \x01(\x05R\x0esaturatedEdges\x12>\n" +
-	"\vbottlenecks\x18\a \x03(\v2\x1c.logistics.common.v1.EdgeKeyR\vbottlenecks\"\xb0\x02\n" +
+	"\vbottlenecks\x18\a \x03(\v2\x1c.logistics.common.v1.EdgeKeyR\vbottlenecks\x12\x1a\n" +
+	"\barrivals\x18\b \x01(\x01R\barrivals\x12\x1e\n" +
+	"\n" +
+	"departures\x18\t \x01(\x01R\n" +
+	"departures\x12\x1d\n" +
+	"\n" +
+	"in_transit\x18\n" +
+	" \x01(\x01R\tinTransit\x12D\n" +
+	"\tinventory\x18\v \x03(\v2&.logistics.simulation.v1.NodeInventoryR\tinventory\x12E\n" +
+	"\rnode_balances\x18\f \x03(\v2 .logistics.common.v1.NodeBalanceR\fnodeBalances\x12'\n" +
+	"\x0ftotal_inventory\x18\r \x01(\x01R\x0etotalInventory\x12#\n" +
+	"\rtotal_backlog\x18\x0e \x01(\x01R\ftotalBacklog\"\xd8\x01\n" +
+	"\fServiceLevel\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12!\n" +
+	"\ftotal_demand\x18\x02 \x01(\x01R\vtotalDemand\x12\x1c\n" +
+	"\tdelivered\x18\x03 \x01(\x01R\tdelivered\x12\x1b\n" +
+	"\tfill_rate\x18\x04 \x01(\x01R\bfillRate\x12#\n" +
+	"\rfinal_backlog\x18\x05 \x01(\x01R\ffinalBacklog\x12,\n" +
+	"\x12steps_with_backlog\x18\x06 \x01(\x05R\x10stepsWithBacklog\"i\n" +
+	"\rNodeInventory\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x01R\x05level\x12)\n" +
+	"\x10storage_capacity\x18\x03 \x01(\x01R\x0fstorageCapacity\"\xf8\x02\n" +
+	"\x12DynamicFlowSummary\x12\x1d\n" +
+	"\n" +
+	"total_flow\x18\x01 \x01(\x01R\ttotalFlow\x12#\n" +
+	"\rhorizon_steps\x18\x02 \x01(\x05R\fhorizonSteps\x12%\n" +
+	"\x0eexpanded_nodes\x18\x03 \x01(\x05R\rexpandedNodes\x12%\n" +
+	"\x0eexpanded_edges\x18\x04 \x01(\x05R\rexpandedEdges\x12&\n" +
+	"\x0fpeak_in_transit\x18\x05 \x01(\x01R\rpeakInTransit\x12%\n" +
+	"\x0epeak_inventory\x18\x06 \x01(\x01R\rpeakInventory\x122\n" +
+	"\x15average_transit_steps\x18\a \x01(\x01R\x13averageTransitSteps\x12M\n" +
+	"\rtransit_times\x18\b \x03(\v2(.logistics.simulation.v1.EdgeTransitTimeR\ftransitTimes\"Y\n" +
+	"\x0fEdgeTransitTime\x120\n" +
+	"\x04edge\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x04edge\x12\x14\n" +
+	"\x05steps\x18\x02 \x01(\x05R\x05steps\"\xf2\x02\n" +
 	"\x13TimeSimulationStats\x12\x19\n" +
 	"\bmin_flow\x18\x01 \x01(\x01R\aminFlow\x12\x19\n" +
 	"\bmax_flow\x18\x02 \x01(\x01R\amaxFlow\x12\x19\n" +
@@ -6801,7 +10268,10 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\bavg_cost\x18\a \x01(\x01R\aavgCost\x12\x1f\n" +
 	"\vtotal_steps\x18\b \x01(\x05R\n" +
 	"totalSteps\x124\n" +
-	"\x16steps_with_bottlenecks\x18\t \x01(\x05R\x14stepsWithBottlenecks\"\xbb\x02\n" +
+	"\x16steps_with_bottlenecks\x18\t \x01(\x05R\x14stepsWithBottlenecks\x12\x1b\n" +
+	"\tfill_rate\x18\n" +
+	" \x01(\x01R\bfillRate\x12#\n" +
+	"\rfinal_backlog\x18\v \x01(\x01R\ffinalBacklog\"\xbb\x02\n" +
 	"\x0eCriticalPeriod\x12\x1d\n" +
 	"\n" +
 	"start_step\x18\x01 \x01(\x05R\tstartStep\x12\x19\n" +
@@ -6835,12 +10305,85 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x11required_capacity\x18\x02 \x01(\x01R\x10requiredCapacity\x12-\n" +
 	"\x12available_capacity\x18\x03 \x01(\x01R\x11availableCapacity\x12\x1a\n" +
 	"\bshortage\x18\x04 \x01(\x01R\bshortage\x12)\n" +
-	"\x10shortage_percent\x18\x05 \x01(\x01R\x0fshortagePercent\"\x99\x02\n" +
+	"\x10shortage_percent\x18\x05 \x01(\x01R\x0fshortagePercent\"\xa8\x01\n" +
+	"!RunDiscreteEventSimulationRequest\x12=\n" +
+	"\fsolved_graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\vsolvedGraph\x12D\n" +
+	"\x06config\x18\x02 \x01(\v2,.logistics.simulation.v1.DiscreteEventConfigR\x06config\"\x86\x04\n" +
+	"\x13DiscreteEventConfig\x12#\n" +
+	"\rhorizon_hours\x18\x01 \x01(\x01R\fhorizonHours\x12)\n" +
+	"\x10vehicle_capacity\x18\x02 \x01(\x01R\x0fvehicleCapacity\x128\n" +
+	"\x18departure_interval_hours\x18\x03 \x01(\x01R\x16departureIntervalHours\x12,\n" +
+	"\x12loading_time_hours\x18\x04 \x01(\x01R\x10loadingTimeHours\x120\n" +
+	"\x14unloading_time_hours\x18\x05 \x01(\x01R\x12unloadingTimeHours\x12$\n" +
+	"\x0edocks_per_node\x18\x06 \x01(\x05R\fdocksPerNode\x12G\n" +
+	"\vroad_speeds\x18\a \x03(\v2&.logistics.simulation.v1.RoadTypeSpeedR\n" +
+	"roadSpeeds\x12H\n" +
+	"\ftravel_delay\x18\b \x01(\v2%.logistics.simulation.v1.DistributionR\vtravelDelay\x12\x1f\n" +
+	"\vrandom_seed\x18\t \x01(\x03R\n" +
+	"randomSeed\x12+\n" +
+	"\x11histogram_buckets\x18\n" +
+	" \x01(\x05R\x10histogramBuckets\"\xbe\x06\n" +
+	"\"RunDiscreteEventSimulationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12A\n" +
+	"\x05stats\x18\x02 \x01(\v2+.logistics.simulation.v1.DiscreteEventStatsR\x05stats\x12P\n" +
+	"\x0flead_time_stats\x18\x03 \x01(\v2(.logistics.simulation.v1.MonteCarloStatsR\rleadTimeStats\x12X\n" +
+	"\x13lead_time_histogram\x18\x04 \x03(\v2(.logistics.simulation.v1.HistogramBucketR\x11leadTimeHistogram\x12\x88\x01\n" +
+	"\x15lead_time_percentiles\x18\x05 \x03(\v2T.logistics.simulation.v1.RunDiscreteEventSimulationResponse.LeadTimePercentilesEntryR\x13leadTimePercentiles\x12H\n" +
+	"\vnode_queues\x18\x06 \x03(\v2'.logistics.simulation.v1.NodeQueueStatsR\n" +
+	"nodeQueues\x12H\n" +
+	"\n" +
+	"throughput\x18\a \x03(\v2(.logistics.simulation.v1.ThroughputPointR\n" +
+	"throughput\x12>\n" +
+	"\x06routes\x18\b \x03(\v2&.logistics.simulation.v1.ShipmentRouteR\x06routes\x12\x1f\n" +
+	"\vrandom_seed\x18\t \x01(\x03R\n" +
+	"randomSeed\x12G\n" +
+	"\bmetadata\x18\n" +
+	" \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\x1aF\n" +
+	"\x18LeadTimePercentilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xab\x03\n" +
+	"\x12DiscreteEventStats\x12/\n" +
+	"\x13vehicles_dispatched\x18\x01 \x01(\x05R\x12vehiclesDispatched\x12-\n" +
+	"\x12vehicles_delivered\x18\x02 \x01(\x05R\x11vehiclesDelivered\x12)\n" +
+	"\x10units_dispatched\x18\x03 \x01(\x01R\x0funitsDispatched\x12;\n" +
+	"\x1aunits_delivered_in_horizon\x18\x04 \x01(\x01R\x17unitsDeliveredInHorizon\x12\x1e\n" +
+	"\n" +
+	"throughput\x18\x05 \x01(\x01R\n" +
+	"throughput\x12-\n" +
+	"\x12planned_throughput\x18\x06 \x01(\x01R\x11plannedThroughput\x12%\n" +
+	"\x0emakespan_hours\x18\a \x01(\x01R\rmakespanHours\x12)\n" +
+	"\x10events_processed\x18\b \x01(\x03R\x0feventsProcessed\x12,\n" +
+	"\x12average_wait_hours\x18\t \x01(\x01R\x10averageWaitHours\"\xad\x02\n" +
+	"\x0eNodeQueueStats\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12'\n" +
+	"\x0fvehicles_served\x18\x02 \x01(\x05R\x0evehiclesServed\x120\n" +
+	"\x14average_queue_length\x18\x03 \x01(\x01R\x12averageQueueLength\x12(\n" +
+	"\x10max_queue_length\x18\x04 \x01(\x05R\x0emaxQueueLength\x12,\n" +
+	"\x12average_wait_hours\x18\x05 \x01(\x01R\x10averageWaitHours\x12$\n" +
+	"\x0emax_wait_hours\x18\x06 \x01(\x01R\fmaxWaitHours\x12)\n" +
+	"\x10dock_utilization\x18\a \x01(\x01R\x0fdockUtilization\"}\n" +
+	"\x0fThroughputPoint\x12\x12\n" +
+	"\x04hour\x18\x01 \x01(\x05R\x04hour\x12'\n" +
+	"\x0funits_delivered\x18\x02 \x01(\x01R\x0eunitsDelivered\x12-\n" +
+	"\x12vehicles_delivered\x18\x03 \x01(\x05R\x11vehiclesDelivered\"\x91\x01\n" +
+	"\rShipmentRoute\x12\x19\n" +
+	"\bnode_ids\x18\x01 \x03(\x03R\anodeIds\x12\x12\n" +
+	"\x04flow\x18\x02 \x01(\x01R\x04flow\x12\x1a\n" +
+	"\bvehicles\x18\x03 \x01(\x05R\bvehicles\x125\n" +
+	"\x17average_lead_time_hours\x18\x04 \x01(\x01R\x14averageLeadTimeHours\"\xec\x02\n" +
 	"\x14RunMonteCarloRequest\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12A\n" +
 	"\x06config\x18\x02 \x01(\v2).logistics.simulation.v1.MonteCarloConfigR\x06config\x12N\n" +
 	"\runcertainties\x18\x03 \x03(\v2(.logistics.simulation.v1.UncertaintySpecR\runcertainties\x12<\n" +
-	"\talgorithm\x18\x04 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\xc2\x01\n" +
+	"\talgorithm\x18\x04 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\x12Q\n" +
+	"\vcorrelation\x18\x05 \x01(\v2/.logistics.simulation.v1.UncertaintyCorrelationR\vcorrelation\"\xba\x01\n" +
+	"\x16UncertaintyCorrelation\x12E\n" +
+	"\ameasure\x18\x01 \x01(\x0e2+.logistics.simulation.v1.CorrelationMeasureR\ameasure\x12\x16\n" +
+	"\x06matrix\x18\x02 \x03(\x01R\x06matrix\x12A\n" +
+	"\x06groups\x18\x03 \x03(\v2).logistics.simulation.v1.CorrelationGroupR\x06groups\"e\n" +
+	"\x10CorrelationGroup\x12/\n" +
+	"\x13uncertainty_indices\x18\x01 \x03(\x05R\x12uncertaintyIndices\x12 \n" +
+	"\vcorrelation\x18\x02 \x01(\x01R\vcorrelation\"\xda\x03\n" +
 	"\x10MonteCarloConfig\x12%\n" +
 	"\x0enum_iterations\x18\x01 \x01(\x05R\rnumIterations\x12\x1f\n" +
 	"\vrandom_seed\x18\x02 \x01(\x03R\n" +
@@ -6848,7 +10391,13 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x10confidence_level\x18\x03 \x01(\x01R\x0fconfidenceLevel\x12\x1a\n" +
 	"\bparallel\x18\x04 \x01(\bR\bparallel\x12\x1f\n" +
 	"\vmax_workers\x18\x05 \x01(\x05R\n" +
-	"maxWorkers\"\xaa\x02\n" +
+	"maxWorkers\x12%\n" +
+	"\x0ereturn_samples\x18\x06 \x01(\bR\rreturnSamples\x12+\n" +
+	"\x11replay_iterations\x18\a \x03(\x05R\x10replayIterations\x12P\n" +
+	"\x0fsampling_method\x18\b \x01(\x0e2'.logistics.simulation.v1.SamplingMethodR\x0esamplingMethod\x12@\n" +
+	"\x1dtarget_relative_ci_half_width\x18\t \x01(\x01R\x19targetRelativeCiHalfWidth\x12.\n" +
+	"\x13time_budget_seconds\x18\n" +
+	" \x01(\x01R\x11timeBudgetSeconds\"\xaa\x02\n" +
 	"\x0fUncertaintySpec\x12<\n" +
 	"\x04type\x18\x01 \x01(\x0e2(.logistics.simulation.v1.UncertaintyTypeR\x04type\x120\n" +
 	"\x04edge\x18\x02 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x04edge\x12\x17\n" +
@@ -6859,7 +10408,7 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x04type\x18\x01 \x01(\x0e2).logistics.simulation.v1.DistributionTypeR\x04type\x12\x16\n" +
 	"\x06param1\x18\x02 \x01(\x01R\x06param1\x12\x16\n" +
 	"\x06param2\x18\x03 \x01(\x01R\x06param2\x12\x16\n" +
-	"\x06param3\x18\x04 \x01(\x01R\x06param3\"\xb5\a\n" +
+	"\x06param3\x18\x04 \x01(\x01R\x06param3\"\x9e\t\n" +
 	"\x15RunMonteCarloResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12G\n" +
 	"\n" +
@@ -6873,13 +10422,25 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\rrisk_analysis\x18\b \x01(\v2%.logistics.simulation.v1.RiskAnalysisR\friskAnalysis\x12Q\n" +
 	"\fcorrelations\x18\t \x03(\v2-.logistics.simulation.v1.ParameterCorrelationR\fcorrelations\x12G\n" +
 	"\bmetadata\x18\n" +
-	" \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\x1aB\n" +
+	" \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\x12\x1f\n" +
+	"\vrandom_seed\x18\v \x01(\x03R\n" +
+	"randomSeed\x12C\n" +
+	"\asamples\x18\f \x03(\v2).logistics.simulation.v1.MonteCarloSampleR\asamples\x12N\n" +
+	"\vstop_reason\x18\r \x01(\x0e2-.logistics.simulation.v1.MonteCarloStopReasonR\n" +
+	"stopReason\x121\n" +
+	"\x14completed_iterations\x18\x0e \x01(\x05R\x13completedIterations\x1aB\n" +
 	"\x14FlowPercentilesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1aB\n" +
 	"\x14CostPercentilesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xc0\x02\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x90\x01\n" +
+	"\x10MonteCarloSample\x12\x1c\n" +
+	"\titeration\x18\x01 \x01(\x05R\titeration\x12 \n" +
+	"\vmultipliers\x18\x02 \x03(\x01R\vmultipliers\x12\x12\n" +
+	"\x04flow\x18\x03 \x01(\x01R\x04flow\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xa9\x03\n" +
 	"\x0fMonteCarloStats\x12\x12\n" +
 	"\x04mean\x18\x01 \x01(\x01R\x04mean\x12\x17\n" +
 	"\astd_dev\x18\x02 \x01(\x01R\x06stdDev\x12\x10\n" +
@@ -6891,7 +10452,9 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\bkurtosis\x18\b \x01(\x01R\bkurtosis\x126\n" +
 	"\x17confidence_interval_low\x18\t \x01(\x01R\x15confidenceIntervalLow\x128\n" +
 	"\x18confidence_interval_high\x18\n" +
-	" \x01(\x01R\x16confidenceIntervalHigh\"\x87\x01\n" +
+	" \x01(\x01R\x16confidenceIntervalHigh\x122\n" +
+	"\x15effective_sample_size\x18\v \x01(\x01R\x13effectiveSampleSize\x123\n" +
+	"\x16relative_ci_half_width\x18\f \x01(\x01R\x13relativeCiHalfWidth\"\x87\x01\n" +
 	"\x0fHistogramBucket\x12\x1f\n" +
 	"\vlower_bound\x18\x01 \x01(\x01R\n" +
 	"lowerBound\x12\x1f\n" +
@@ -6917,14 +10480,19 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x0eparameter_name\x18\x01 \x01(\tR\rparameterName\x122\n" +
 	"\x15correlation_with_flow\x18\x02 \x01(\x01R\x13correlationWithFlow\x122\n" +
 	"\x15correlation_with_cost\x18\x03 \x01(\x01R\x13correlationWithCost\x12)\n" +
-	"\x10importance_score\x18\x04 \x01(\x01R\x0fimportanceScore\"\xf4\x01\n" +
+	"\x10importance_score\x18\x04 \x01(\x01R\x0fimportanceScore\"\xdb\x03\n" +
 	"\x12MonteCarloProgress\x12\x1c\n" +
 	"\titeration\x18\x01 \x01(\x05R\titeration\x12)\n" +
 	"\x10total_iterations\x18\x02 \x01(\x05R\x0ftotalIterations\x12)\n" +
 	"\x10progress_percent\x18\x03 \x01(\x01R\x0fprogressPercent\x12*\n" +
 	"\x11current_mean_flow\x18\x04 \x01(\x01R\x0fcurrentMeanFlow\x12&\n" +
 	"\x0fcurrent_std_dev\x18\x05 \x01(\x01R\rcurrentStdDev\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\"\x9e\x02\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\"\n" +
+	"\rci_half_width\x18\a \x01(\x01R\vciHalfWidth\x123\n" +
+	"\x16relative_ci_half_width\x18\b \x01(\x01R\x13relativeCiHalfWidth\x12D\n" +
+	"\x1eestimated_iterations_remaining\x18\t \x01(\x05R\x1cestimatedIterationsRemaining\x12F\n" +
+	"\x06result\x18\n" +
+	" \x01(\v2..logistics.simulation.v1.RunMonteCarloResponseR\x06result\"\x9e\x02\n" +
 	"\x19AnalyzeSensitivityRequest\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12M\n" +
 	"\n" +
@@ -6938,11 +10506,20 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x06target\x18\x03 \x01(\x0e2+.logistics.simulation.v1.ModificationTargetR\x06target\x12%\n" +
 	"\x0emin_multiplier\x18\x04 \x01(\x01R\rminMultiplier\x12%\n" +
 	"\x0emax_multiplier\x18\x05 \x01(\x01R\rmaxMultiplier\x12\x1b\n" +
-	"\tnum_steps\x18\x06 \x01(\x05R\bnumSteps\"\xb3\x01\n" +
+	"\tnum_steps\x18\x06 \x01(\x05R\bnumSteps\"\xe0\x02\n" +
 	"\x11SensitivityConfig\x12B\n" +
 	"\x06method\x18\x01 \x01(\x0e2*.logistics.simulation.v1.SensitivityMethodR\x06method\x121\n" +
 	"\x14calculate_elasticity\x18\x02 \x01(\bR\x13calculateElasticity\x12'\n" +
-	"\x0ffind_thresholds\x18\x03 \x01(\bR\x0efindThresholds\"\xe8\x02\n" +
+	"\x0ffind_thresholds\x18\x03 \x01(\bR\x0efindThresholds\x12)\n" +
+	"\x10num_trajectories\x18\x04 \x01(\x05R\x0fnumTrajectories\x12\x1d\n" +
+	"\n" +
+	"num_levels\x18\x05 \x01(\x05R\tnumLevels\x12\x1f\n" +
+	"\vnum_samples\x18\x06 \x01(\x05R\n" +
+	"numSamples\x12\x1f\n" +
+	"\vrandom_seed\x18\a \x01(\x03R\n" +
+	"randomSeed\x12\x1f\n" +
+	"\vmax_workers\x18\b \x01(\x05R\n" +
+	"maxWorkers\"\xe8\x02\n" +
 	"\x1aAnalyzeSensitivityResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12W\n" +
 	"\x11parameter_results\x18\x02 \x03(\v2*.logistics.simulation.v1.SensitivityResultR\x10parameterResults\x12E\n" +
@@ -6950,7 +10527,7 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\n" +
 	"thresholds\x18\x04 \x03(\v2'.logistics.simulation.v1.ThresholdPointR\n" +
 	"thresholds\x12G\n" +
-	"\bmetadata\x18\x05 \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\"\xa8\x02\n" +
+	"\bmetadata\x18\x05 \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\"\xa5\x03\n" +
 	"\x11SensitivityResult\x12!\n" +
 	"\fparameter_id\x18\x01 \x01(\tR\vparameterId\x12?\n" +
 	"\x05curve\x18\x02 \x03(\v2).logistics.simulation.v1.SensitivityPointR\x05curve\x12\x1e\n" +
@@ -6959,7 +10536,20 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"elasticity\x12+\n" +
 	"\x11sensitivity_index\x18\x04 \x01(\x01R\x10sensitivityIndex\x12!\n" +
 	"\fimpact_range\x18\x05 \x01(\x01R\vimpactRange\x12?\n" +
-	"\x05level\x18\x06 \x01(\x0e2).logistics.simulation.v1.SensitivityLevelR\x05level\"y\n" +
+	"\x05level\x18\x06 \x01(\x0e2).logistics.simulation.v1.SensitivityLevelR\x05level\x12>\n" +
+	"\x06morris\x18\a \x01(\v2&.logistics.simulation.v1.MorrisIndicesR\x06morris\x12;\n" +
+	"\x05sobol\x18\b \x01(\v2%.logistics.simulation.v1.SobolIndicesR\x05sobol\"N\n" +
+	"\rMorrisIndices\x12\x0e\n" +
+	"\x02mu\x18\x01 \x01(\x01R\x02mu\x12\x17\n" +
+	"\amu_star\x18\x02 \x01(\x01R\x06muStar\x12\x14\n" +
+	"\x05sigma\x18\x03 \x01(\x01R\x05sigma\"\xa4\x01\n" +
+	"\fSobolIndices\x12\x1f\n" +
+	"\vfirst_order\x18\x01 \x01(\x01R\n" +
+	"firstOrder\x12\x1f\n" +
+	"\vtotal_order\x18\x02 \x01(\x01R\n" +
+	"totalOrder\x12(\n" +
+	"\x10first_order_conf\x18\x03 \x01(\x01R\x0efirstOrderConf\x12(\n" +
+	"\x10total_order_conf\x18\x04 \x01(\x01R\x0etotalOrderConf\"y\n" +
 	"\x10SensitivityPoint\x12'\n" +
 	"\x0fparameter_value\x18\x01 \x01(\x01R\x0eparameterValue\x12\x1d\n" +
 	"\n" +
@@ -7010,37 +10600,49 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12U\n" +
 	"\x11failure_scenarios\x18\x02 \x03(\v2(.logistics.simulation.v1.FailureScenarioR\x10failureScenarios\x12Q\n" +
 	"\rrandom_config\x18\x03 \x01(\v2,.logistics.simulation.v1.RandomFailureConfigR\frandomConfig\x12<\n" +
-	"\talgorithm\x18\x04 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\xab\x01\n" +
+	"\talgorithm\x18\x04 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\xcd\x01\n" +
 	"\x0fFailureScenario\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12?\n" +
 	"\ffailed_edges\x18\x02 \x03(\v2\x1c.logistics.common.v1.EdgeKeyR\vfailedEdges\x12!\n" +
 	"\ffailed_nodes\x18\x03 \x03(\x03R\vfailedNodes\x12 \n" +
-	"\vprobability\x18\x04 \x01(\x01R\vprobability\"\x9b\x02\n" +
+	"\vprobability\x18\x04 \x01(\x01R\vprobability\x12 \n" +
+	"\voccurrences\x18\x05 \x01(\x05R\voccurrences\"\xb3\x04\n" +
 	"\x13RandomFailureConfig\x12#\n" +
 	"\rnum_scenarios\x18\x01 \x01(\x05R\fnumScenarios\x128\n" +
 	"\x18edge_failure_probability\x18\x02 \x01(\x01R\x16edgeFailureProbability\x128\n" +
 	"\x18node_failure_probability\x18\x03 \x01(\x01R\x16nodeFailureProbability\x12:\n" +
 	"\x19max_simultaneous_failures\x18\x04 \x01(\x05R\x17maxSimultaneousFailures\x12/\n" +
-	"\x13correlated_failures\x18\x05 \x01(\bR\x12correlatedFailures\"\xb7\x03\n" +
+	"\x13correlated_failures\x18\x05 \x01(\bR\x12correlatedFailures\x12\x1f\n" +
+	"\vrandom_seed\x18\x06 \x01(\x03R\n" +
+	"randomSeed\x12M\n" +
+	"\vcorrelation\x18\a \x01(\x0e2+.logistics.simulation.v1.FailureCorrelationR\vcorrelation\x128\n" +
+	"\x18common_cause_probability\x18\b \x01(\x01R\x16commonCauseProbability\x12#\n" +
+	"\rregion_radius\x18\t \x01(\x01R\fregionRadius\x12G\n" +
+	" common_cause_failure_probability\x18\n" +
+	" \x01(\x01R\x1dcommonCauseFailureProbability\"\xd8\x03\n" +
 	"\x18SimulateFailuresResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12C\n" +
 	"\bbaseline\x18\x02 \x01(\v2'.logistics.simulation.v1.ScenarioResultR\bbaseline\x12Y\n" +
 	"\x10scenario_results\x18\x03 \x03(\v2..logistics.simulation.v1.FailureScenarioResultR\x0fscenarioResults\x12;\n" +
 	"\x05stats\x18\x04 \x01(\v2%.logistics.simulation.v1.FailureStatsR\x05stats\x12[\n" +
 	"\x0frecommendations\x18\x05 \x03(\v21.logistics.simulation.v1.ResilienceRecommendationR\x0frecommendations\x12G\n" +
-	"\bmetadata\x18\x06 \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\"\xa0\x02\n" +
+	"\bmetadata\x18\x06 \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\x12\x1f\n" +
+	"\vrandom_seed\x18\a \x01(\x03R\n" +
+	"randomSeed\"\xc2\x02\n" +
 	"\x15FailureScenarioResult\x12#\n" +
 	"\rscenario_name\x18\x01 \x01(\tR\fscenarioName\x12 \n" +
 	"\vprobability\x18\x02 \x01(\x01R\vprobability\x12?\n" +
 	"\x06result\x18\x03 \x01(\v2'.logistics.simulation.v1.ScenarioResultR\x06result\x12L\n" +
 	"\vvs_baseline\x18\x04 \x01(\v2+.logistics.simulation.v1.ScenarioComparisonR\n" +
 	"vsBaseline\x121\n" +
-	"\x14network_disconnected\x18\x05 \x01(\bR\x13networkDisconnected\"\xe0\x01\n" +
+	"\x14network_disconnected\x18\x05 \x01(\bR\x13networkDisconnected\x12 \n" +
+	"\voccurrences\x18\x06 \x01(\x05R\voccurrences\"\xa0\x02\n" +
 	"\fFailureStats\x12,\n" +
 	"\x12expected_flow_loss\x18\x01 \x01(\x01R\x10expectedFlowLoss\x12\"\n" +
 	"\rmax_flow_loss\x18\x02 \x01(\x01R\vmaxFlowLoss\x12@\n" +
 	"\x1cprobability_of_disconnection\x18\x03 \x01(\x01R\x1aprobabilityOfDisconnection\x12<\n" +
-	"\x1aaverage_recovery_potential\x18\x04 \x01(\x01R\x18averageRecoveryPotential\"\xc1\x02\n" +
+	"\x1aaverage_recovery_potential\x18\x04 \x01(\x01R\x18averageRecoveryPotential\x12>\n" +
+	"\x1cexpected_flow_loss_std_error\x18\x05 \x01(\x01R\x18expectedFlowLossStdError\"\xc1\x02\n" +
 	"\x18ResilienceRecommendation\x12?\n" +
 	"\x04type\x18\x01 \x01(\x0e2+.logistics.simulation.v1.RecommendationTypeR\x04type\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12A\n" +
@@ -7051,12 +10653,13 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x18AnalyzeResilienceRequest\x120\n" +
 	"\x05graph\x18\x01 \x01(\v2\x1a.logistics.common.v1.GraphR\x05graph\x12A\n" +
 	"\x06config\x18\x02 \x01(\v2).logistics.simulation.v1.ResilienceConfigR\x06config\x12<\n" +
-	"\talgorithm\x18\x03 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\x9c\x01\n" +
+	"\talgorithm\x18\x03 \x01(\x0e2\x1e.logistics.common.v1.AlgorithmR\talgorithm\"\xcc\x01\n" +
 	"\x10ResilienceConfig\x12/\n" +
 	"\x14max_failures_to_test\x18\x01 \x01(\x05R\x11maxFailuresToTest\x126\n" +
 	"\x17test_cascading_failures\x18\x02 \x01(\bR\x15testCascadingFailures\x12\x1f\n" +
 	"\vload_factor\x18\x03 \x01(\x01R\n" +
-	"loadFactor\"\xa9\x03\n" +
+	"loadFactor\x12.\n" +
+	"\x13max_candidate_edges\x18\x04 \x01(\x05R\x11maxCandidateEdges\"\xed\x03\n" +
 	"\x19AnalyzeResilienceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12D\n" +
 	"\ametrics\x18\x02 \x01(\v2*.logistics.simulation.v1.ResilienceMetricsR\ametrics\x12J\n" +
@@ -7065,7 +10668,8 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\n" +
 	"weaknesses\x18\x05 \x03(\v2+.logistics.simulation.v1.ResilienceWeaknessR\n" +
 	"weaknesses\x12G\n" +
-	"\bmetadata\x18\x06 \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\"\xe7\x01\n" +
+	"\bmetadata\x18\x06 \x01(\v2+.logistics.simulation.v1.SimulationMetadataR\bmetadata\x12B\n" +
+	"\acascade\x18\a \x01(\v2(.logistics.simulation.v1.CascadeAnalysisR\acascade\"\xe7\x01\n" +
 	"\x11ResilienceMetrics\x12#\n" +
 	"\roverall_score\x18\x01 \x01(\x01R\foverallScore\x127\n" +
 	"\x17connectivity_robustness\x18\x02 \x01(\x01R\x16connectivityRobustness\x12'\n" +
@@ -7079,16 +10683,46 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x12most_critical_edge\x18\x03 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x10mostCriticalEdge\x12,\n" +
 	"\x12most_critical_node\x18\x04 \x01(\x03R\x10mostCriticalNode\x12)\n" +
 	"\x10scenarios_tested\x18\x05 \x01(\x05R\x0fscenariosTested\x12)\n" +
-	"\x10scenarios_failed\x18\x06 \x01(\x05R\x0fscenariosFailed\"\xdd\x01\n" +
+	"\x10scenarios_failed\x18\x06 \x01(\x05R\x0fscenariosFailed\"\xa8\x04\n" +
 	"\x11NMinusTwoAnalysis\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x124\n" +
 	"\x16probability_of_failure\x18\x02 \x01(\x01R\x14probabilityOfFailure\x12%\n" +
 	"\x0ecritical_pairs\x18\x03 \x01(\x05R\rcriticalPairs\x12Q\n" +
-	"\x13critical_edge_pairs\x18\x04 \x03(\v2!.logistics.simulation.v1.EdgePairR\x11criticalEdgePairs\"\x9b\x01\n" +
+	"\x13critical_edge_pairs\x18\x04 \x03(\v2!.logistics.simulation.v1.EdgePairR\x11criticalEdgePairs\x12!\n" +
+	"\fmax_failures\x18\x05 \x01(\x05R\vmaxFailures\x12'\n" +
+	"\x0fcandidate_edges\x18\x06 \x01(\x05R\x0ecandidateEdges\x12)\n" +
+	"\x10scenarios_tested\x18\a \x01(\x05R\x0fscenariosTested\x12)\n" +
+	"\x10scenarios_failed\x18\b \x01(\x05R\x0fscenariosFailed\x129\n" +
+	"\x19worst_case_flow_reduction\x18\t \x01(\x01R\x16worstCaseFlowReduction\x12N\n" +
+	"\x12critical_edge_sets\x18\n" +
+	" \x03(\v2 .logistics.simulation.v1.EdgeSetR\x10criticalEdgeSets\x12\x1c\n" +
+	"\ttruncated\x18\v \x01(\bR\ttruncated\"\x9b\x01\n" +
 	"\bEdgePair\x122\n" +
 	"\x05edge1\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x05edge1\x122\n" +
 	"\x05edge2\x18\x02 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x05edge2\x12'\n" +
-	"\x0fcombined_impact\x18\x03 \x01(\x01R\x0ecombinedImpact\"\xae\x02\n" +
+	"\x0fcombined_impact\x18\x03 \x01(\x01R\x0ecombinedImpact\"f\n" +
+	"\aEdgeSet\x122\n" +
+	"\x05edges\x18\x01 \x03(\v2\x1c.logistics.common.v1.EdgeKeyR\x05edges\x12'\n" +
+	"\x0fcombined_impact\x18\x02 \x01(\x01R\x0ecombinedImpact\"\xfa\x01\n" +
+	"\x0fCascadeAnalysis\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vload_factor\x18\x02 \x01(\x01R\n" +
+	"loadFactor\x12)\n" +
+	"\x10scenarios_tested\x18\x03 \x01(\x05R\x0fscenariosTested\x12F\n" +
+	"\tscenarios\x18\x04 \x03(\v2(.logistics.simulation.v1.CascadeScenarioR\tscenarios\x129\n" +
+	"\x19worst_case_flow_reduction\x18\x05 \x01(\x01R\x16worstCaseFlowReduction\"\xfd\x01\n" +
+	"\x0fCascadeScenario\x12E\n" +
+	"\x0finitial_failure\x18\x01 \x01(\v2\x1c.logistics.common.v1.EdgeKeyR\x0einitialFailure\x12:\n" +
+	"\x05steps\x18\x02 \x03(\v2$.logistics.simulation.v1.CascadeStepR\x05steps\x12\x1d\n" +
+	"\n" +
+	"final_flow\x18\x03 \x01(\x01R\tfinalFlow\x12%\n" +
+	"\x0eflow_reduction\x18\x04 \x01(\x01R\rflowReduction\x12!\n" +
+	"\ffailed_edges\x18\x05 \x01(\x05R\vfailedEdges\"\x83\x01\n" +
+	"\vCascadeStep\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12?\n" +
+	"\ffailed_edges\x18\x02 \x03(\v2\x1c.logistics.common.v1.EdgeKeyR\vfailedEdges\x12\x1d\n" +
+	"\n" +
+	"flow_after\x18\x03 \x01(\x01R\tflowAfter\"\xae\x02\n" +
 	"\x12ResilienceWeakness\x12 \n" +
 	"\vdescription\x18\x01 \x01(\tR\vdescription\x129\n" +
 	"\x04type\x18\x02 \x01(\x0e2%.logistics.simulation.v1.WeaknessTypeR\x04type\x12\x1a\n" +
@@ -7193,7 +10827,21 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x1aBOTTLENECK_CHANGE_TYPE_NEW\x10\x01\x12#\n" +
 	"\x1fBOTTLENECK_CHANGE_TYPE_RESOLVED\x10\x02\x12#\n" +
 	"\x1fBOTTLENECK_CHANGE_TYPE_WORSENED\x10\x03\x12#\n" +
-	"\x1fBOTTLENECK_CHANGE_TYPE_IMPROVED\x10\x04*v\n" +
+	"\x1fBOTTLENECK_CHANGE_TYPE_IMPROVED\x10\x04*\xab\x01\n" +
+	"\x13ExpansionStopReason\x12%\n" +
+	"!EXPANSION_STOP_REASON_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cEXPANSION_STOP_REASON_BUDGET\x10\x01\x12!\n" +
+	"\x1dEXPANSION_STOP_REASON_NO_GAIN\x10\x02\x12(\n" +
+	"$EXPANSION_STOP_REASON_MAX_ITERATIONS\x10\x03*\x8e\x01\n" +
+	"\x10FacilityMoveType\x12\"\n" +
+	"\x1eFACILITY_MOVE_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17FACILITY_MOVE_TYPE_OPEN\x10\x01\x12\x1c\n" +
+	"\x18FACILITY_MOVE_TYPE_CLOSE\x10\x02\x12\x1b\n" +
+	"\x17FACILITY_MOVE_TYPE_SWAP\x10\x03*}\n" +
+	"\x12TimeSimulationMode\x12$\n" +
+	" TIME_SIMULATION_MODE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTIME_SIMULATION_MODE_STATIC\x10\x01\x12 \n" +
+	"\x1cTIME_SIMULATION_MODE_DYNAMIC\x10\x02*v\n" +
 	"\bTimeStep\x12\x19\n" +
 	"\x15TIME_STEP_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10TIME_STEP_MINUTE\x10\x01\x12\x12\n" +
@@ -7219,7 +10867,24 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"!CRITICAL_PERIOD_TYPE_LOW_CAPACITY\x10\x01\x12$\n" +
 	" CRITICAL_PERIOD_TYPE_HIGH_DEMAND\x10\x02\x12#\n" +
 	"\x1fCRITICAL_PERIOD_TYPE_CONGESTION\x10\x03\x12 \n" +
-	"\x1cCRITICAL_PERIOD_TYPE_FAILURE\x10\x04*\x86\x01\n" +
+	"\x1cCRITICAL_PERIOD_TYPE_FAILURE\x10\x04*\x9e\x01\n" +
+	"\x12CorrelationMeasure\x12#\n" +
+	"\x1fCORRELATION_MEASURE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCORRELATION_MEASURE_GAUSSIAN\x10\x01\x12 \n" +
+	"\x1cCORRELATION_MEASURE_SPEARMAN\x10\x02\x12\x1f\n" +
+	"\x1bCORRELATION_MEASURE_KENDALL\x10\x03*\xdc\x01\n" +
+	"\x14MonteCarloStopReason\x12'\n" +
+	"#MONTE_CARLO_STOP_REASON_UNSPECIFIED\x10\x00\x12%\n" +
+	"!MONTE_CARLO_STOP_REASON_COMPLETED\x10\x01\x12%\n" +
+	"!MONTE_CARLO_STOP_REASON_CONVERGED\x10\x02\x12'\n" +
+	"#MONTE_CARLO_STOP_REASON_TIME_BUDGET\x10\x03\x12$\n" +
+	" MONTE_CARLO_STOP_REASON_CANCELED\x10\x04*\xad\x01\n" +
+	"\x0eSamplingMethod\x12\x1f\n" +
+	"\x1bSAMPLING_METHOD_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SAMPLING_METHOD_RANDOM\x10\x01\x12#\n" +
+	"\x1fSAMPLING_METHOD_LATIN_HYPERCUBE\x10\x02\x12\x19\n" +
+	"\x15SAMPLING_METHOD_SOBOL\x10\x03\x12\x1e\n" +
+	"\x1aSAMPLING_METHOD_ANTITHETIC\x10\x04*\x86\x01\n" +
 	"\x0fUncertaintyType\x12 \n" +
 	"\x1cUNCERTAINTY_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15UNCERTAINTY_TYPE_EDGE\x10\x01\x12\x19\n" +
@@ -7249,19 +10914,24 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x19THRESHOLD_TYPE_FLOW_DROPS\x10\x01\x12\x1e\n" +
 	"\x1aTHRESHOLD_TYPE_COST_SPIKES\x10\x02\x12%\n" +
 	"!THRESHOLD_TYPE_BOTTLENECK_APPEARS\x10\x03\x12\x1d\n" +
-	"\x19THRESHOLD_TYPE_INFEASIBLE\x10\x04*\xe2\x01\n" +
+	"\x19THRESHOLD_TYPE_INFEASIBLE\x10\x04*~\n" +
+	"\x12FailureCorrelation\x12#\n" +
+	"\x1fFAILURE_CORRELATION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cFAILURE_CORRELATION_REGIONAL\x10\x01\x12!\n" +
+	"\x1dFAILURE_CORRELATION_ROAD_TYPE\x10\x02*\xe2\x01\n" +
 	"\x12RecommendationType\x12#\n" +
 	"\x1fRECOMMENDATION_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
 	"\"RECOMMENDATION_TYPE_ADD_REDUNDANCY\x10\x01\x12)\n" +
 	"%RECOMMENDATION_TYPE_INCREASE_CAPACITY\x10\x02\x12(\n" +
 	"$RECOMMENDATION_TYPE_ADD_BACKUP_ROUTE\x10\x03\x12*\n" +
-	"&RECOMMENDATION_TYPE_RELOCATE_WAREHOUSE\x10\x04*\xcc\x01\n" +
+	"&RECOMMENDATION_TYPE_RELOCATE_WAREHOUSE\x10\x04*\xec\x01\n" +
 	"\fWeaknessType\x12\x1d\n" +
 	"\x19WEAKNESS_TYPE_UNSPECIFIED\x10\x00\x12)\n" +
 	"%WEAKNESS_TYPE_SINGLE_POINT_OF_FAILURE\x10\x01\x12%\n" +
 	"!WEAKNESS_TYPE_CAPACITY_BOTTLENECK\x10\x02\x12\x1f\n" +
 	"\x1bWEAKNESS_TYPE_NO_REDUNDANCY\x10\x03\x12*\n" +
-	"&WEAKNESS_TYPE_GEOGRAPHIC_CONCENTRATION\x10\x04*\xe7\x01\n" +
+	"&WEAKNESS_TYPE_GEOGRAPHIC_CONCENTRATION\x10\x04\x12\x1e\n" +
+	"\x1aWEAKNESS_TYPE_CASCADE_RISK\x10\x05*\xe7\x01\n" +
 	"\x0eSimulationType\x12\x1f\n" +
 	"\x1bSIMULATION_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17SIMULATION_TYPE_WHAT_IF\x10\x01\x12\x18\n" +
@@ -7269,12 +10939,15 @@ const file_logistics_simulation_v1_simulation_proto_rawDesc = "" +
 	"\x1bSIMULATION_TYPE_MONTE_CARLO\x10\x03\x12\x1f\n" +
 	"\x1bSIMULATION_TYPE_SENSITIVITY\x10\x04\x12\x1b\n" +
 	"\x17SIMULATION_TYPE_FAILURE\x10\x05\x12\x1e\n" +
-	"\x1aSIMULATION_TYPE_RESILIENCE\x10\x062\xf8\f\n" +
+	"\x1aSIMULATION_TYPE_RESILIENCE\x10\x062\xb7\x10\n" +
 	"\x11SimulationService\x12b\n" +
 	"\tRunWhatIf\x12).logistics.simulation.v1.RunWhatIfRequest\x1a*.logistics.simulation.v1.RunWhatIfResponse\x12w\n" +
-	"\x10CompareScenarios\x120.logistics.simulation.v1.CompareScenariosRequest\x1a1.logistics.simulation.v1.CompareScenariosResponse\x12z\n" +
+	"\x10CompareScenarios\x120.logistics.simulation.v1.CompareScenariosRequest\x1a1.logistics.simulation.v1.CompareScenariosResponse\x12\x92\x01\n" +
+	"\x19OptimizeCapacityExpansion\x129.logistics.simulation.v1.OptimizeCapacityExpansionRequest\x1a:.logistics.simulation.v1.OptimizeCapacityExpansionResponse\x12\x8f\x01\n" +
+	"\x18OptimizeFacilityLocation\x128.logistics.simulation.v1.OptimizeFacilityLocationRequest\x1a9.logistics.simulation.v1.OptimizeFacilityLocationResponse\x12z\n" +
 	"\x11RunTimeSimulation\x121.logistics.simulation.v1.RunTimeSimulationRequest\x1a2.logistics.simulation.v1.RunTimeSimulationResponse\x12w\n" +
-	"\x10SimulatePeakLoad\x120.logistics.simulation.v1.SimulatePeakLoadRequest\x1a1.logistics.simulation.v1.SimulatePeakLoadResponse\x12n\n" +
+	"\x10SimulatePeakLoad\x120.logistics.simulation.v1.SimulatePeakLoadRequest\x1a1.logistics.simulation.v1.SimulatePeakLoadResponse\x12\x95\x01\n" +
+	"\x1aRunDiscreteEventSimulation\x12:.logistics.simulation.v1.RunDiscreteEventSimulationRequest\x1a;.logistics.simulation.v1.RunDiscreteEventSimulationResponse\x12n\n" +
 	"\rRunMonteCarlo\x12-.logistics.simulation.v1.RunMonteCarloRequest\x1a..logistics.simulation.v1.RunMonteCarloResponse\x12s\n" +
 	"\x13RunMonteCarloStream\x12-.logistics.simulation.v1.RunMonteCarloRequest\x1a+.logistics.simulation.v1.MonteCarloProgress0\x01\x12}\n" +
 	"\x12AnalyzeSensitivity\x122.logistics.simulation.v1.AnalyzeSensitivityRequest\x1a3.logistics.simulation.v1.AnalyzeSensitivityResponse\x12\x83\x01\n" +
@@ -7299,291 +10972,396 @@ func file_logistics_simulation_v1_simulation_proto_rawDescGZIP() []byte {
 	return file_logistics_simulation_v1_simulation_proto_rawDescData
 }
 
-var file_logistics_simulation_v1_simulation_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
-var file_logistics_simulation_v1_simulation_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_logistics_simulation_v1_simulation_proto_enumTypes = make([]protoimpl.EnumInfo, 23)
+var file_logistics_simulation_v1_simulation_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
 var file_logistics_simulation_v1_simulation_proto_goTypes = []any{
-	(ModificationType)(0),                // 0: logistics.simulation.v1.ModificationType
-	(ModificationTarget)(0),              // 1: logistics.simulation.v1.ModificationTarget
-	(ImpactLevel)(0),                     // 2: logistics.simulation.v1.ImpactLevel
-	(BottleneckChangeType)(0),            // 3: logistics.simulation.v1.BottleneckChangeType
-	(TimeStep)(0),                        // 4: logistics.simulation.v1.TimeStep
-	(PatternTarget)(0),                   // 5: logistics.simulation.v1.PatternTarget
-	(PatternType)(0),                     // 6: logistics.simulation.v1.PatternType
-	(CriticalPeriodType)(0),              // 7: logistics.simulation.v1.CriticalPeriodType
-	(UncertaintyType)(0),                 // 8: logistics.simulation.v1.UncertaintyType
-	(DistributionType)(0),                // 9: logistics.simulation.v1.DistributionType
-	(SensitivityMethod)(0),               // 10: logistics.simulation.v1.SensitivityMethod
-	(SensitivityLevel)(0),                // 11: logistics.simulation.v1.SensitivityLevel
-	(ThresholdType)(0),                   // 12: logistics.simulation.v1.ThresholdType
-	(RecommendationType)(0),              // 13: logistics.simulation.v1.RecommendationType
-	(WeaknessType)(0),                    // 14: logistics.simulation.v1.WeaknessType
-	(SimulationType)(0),                  // 15: logistics.simulation.v1.SimulationType
-	(*RunWhatIfRequest)(nil),             // 16: logistics.simulation.v1.RunWhatIfRequest
-	(*Modification)(nil),                 // 17: logistics.simulation.v1.Modification
-	(*WhatIfOptions)(nil),                // 18: logistics.simulation.v1.WhatIfOptions
-	(*RunWhatIfResponse)(nil),            // 19: logistics.simulation.v1.RunWhatIfResponse
-	(*ScenarioResult)(nil),               // 20: logistics.simulation.v1.ScenarioResult
-	(*ScenarioComparison)(nil),           // 21: logistics.simulation.v1.ScenarioComparison
-	(*BottleneckChange)(nil),             // 22: logistics.simulation.v1.BottleneckChange
-	(*CompareScenariosRequest)(nil),      // 23: logistics.simulation.v1.CompareScenariosRequest
-	(*Scenario)(nil),                     // 24: logistics.simulation.v1.Scenario
-	(*CompareOptions)(nil),               // 25: logistics.simulation.v1.CompareOptions
-	(*CompareScenariosResponse)(nil),     // 26: logistics.simulation.v1.CompareScenariosResponse
-	(*ScenarioResultWithRank)(nil),       // 27: logistics.simulation.v1.ScenarioResultWithRank
-	(*RunTimeSimulationRequest)(nil),     // 28: logistics.simulation.v1.RunTimeSimulationRequest
-	(*TimeSimulationConfig)(nil),         // 29: logistics.simulation.v1.TimeSimulationConfig
-	(*EdgeTimePattern)(nil),              // 30: logistics.simulation.v1.EdgeTimePattern
-	(*NodeTimePattern)(nil),              // 31: logistics.simulation.v1.NodeTimePattern
-	(*TimePattern)(nil),                  // 32: logistics.simulation.v1.TimePattern
-	(*TimePoint)(nil),                    // 33: logistics.simulation.v1.TimePoint
-	(*RunTimeSimulationResponse)(nil),    // 34: logistics.simulation.v1.RunTimeSimulationResponse
-	(*TimeStepResult)(nil),               // 35: logistics.simulation.v1.TimeStepResult
-	(*TimeSimulationStats)(nil),          // 36: logistics.simulation.v1.TimeSimulationStats
-	(*CriticalPeriod)(nil),               // 37: logistics.simulation.v1.CriticalPeriod
-	(*SimulatePeakLoadRequest)(nil),      // 38: logistics.simulation.v1.SimulatePeakLoadRequest
-	(*SimulatePeakLoadResponse)(nil),     // 39: logistics.simulation.v1.SimulatePeakLoadResponse
-	(*OverloadedEdge)(nil),               // 40: logistics.simulation.v1.OverloadedEdge
-	(*RunMonteCarloRequest)(nil),         // 41: logistics.simulation.v1.RunMonteCarloRequest
-	(*MonteCarloConfig)(nil),             // 42: logistics.simulation.v1.MonteCarloConfig
-	(*UncertaintySpec)(nil),              // 43: logistics.simulation.v1.UncertaintySpec
-	(*Distribution)(nil),                 // 44: logistics.simulation.v1.Distribution
-	(*RunMonteCarloResponse)(nil),        // 45: logistics.simulation.v1.RunMonteCarloResponse
-	(*MonteCarloStats)(nil),              // 46: logistics.simulation.v1.MonteCarloStats
-	(*HistogramBucket)(nil),              // 47: logistics.simulation.v1.HistogramBucket
-	(*RiskAnalysis)(nil),                 // 48: logistics.simulation.v1.RiskAnalysis
-	(*RiskScenario)(nil),                 // 49: logistics.simulation.v1.RiskScenario
-	(*ParameterCorrelation)(nil),         // 50: logistics.simulation.v1.ParameterCorrelation
-	(*MonteCarloProgress)(nil),           // 51: logistics.simulation.v1.MonteCarloProgress
-	(*AnalyzeSensitivityRequest)(nil),    // 52: logistics.simulation.v1.AnalyzeSensitivityRequest
-	(*SensitivityParameter)(nil),         // 53: logistics.simulation.v1.SensitivityParameter
-	(*SensitivityConfig)(nil),            // 54: logistics.simulation.v1.SensitivityConfig
-	(*AnalyzeSensitivityResponse)(nil),   // 55: logistics.simulation.v1.AnalyzeSensitivityResponse
-	(*SensitivityResult)(nil),            // 56: logistics.simulation.v1.SensitivityResult
-	(*SensitivityPoint)(nil),             // 57: logistics.simulation.v1.SensitivityPoint
-	(*ParameterRanking)(nil),             // 58: logistics.simulation.v1.ParameterRanking
-	(*ThresholdPoint)(nil),               // 59: logistics.simulation.v1.ThresholdPoint
-	(*FindCriticalElementsRequest)(nil),  // 60: logistics.simulation.v1.FindCriticalElementsRequest
-	(*CriticalElementsConfig)(nil),       // 61: logistics.simulation.v1.CriticalElementsConfig
-	(*FindCriticalElementsResponse)(nil), // 62: logistics.simulation.v1.FindCriticalElementsResponse
-	(*CriticalEdge)(nil),                 // 63: logistics.simulation.v1.CriticalEdge
-	(*CriticalNode)(nil),                 // 64: logistics.simulation.v1.CriticalNode
-	(*SimulateFailuresRequest)(nil),      // 65: logistics.simulation.v1.SimulateFailuresRequest
-	(*FailureScenario)(nil),              // 66: logistics.simulation.v1.FailureScenario
-	(*RandomFailureConfig)(nil),          // 67: logistics.simulation.v1.RandomFailureConfig
-	(*SimulateFailuresResponse)(nil),     // 68: logistics.simulation.v1.SimulateFailuresResponse
-	(*FailureScenarioResult)(nil),        // 69: logistics.simulation.v1.FailureScenarioResult
-	(*FailureStats)(nil),                 // 70: logistics.simulation.v1.FailureStats
-	(*ResilienceRecommendation)(nil),     // 71: logistics.simulation.v1.ResilienceRecommendation
-	(*AnalyzeResilienceRequest)(nil),     // 72: logistics.simulation.v1.AnalyzeResilienceRequest
-	(*ResilienceConfig)(nil),             // 73: logistics.simulation.v1.ResilienceConfig
-	(*AnalyzeResilienceResponse)(nil),    // 74: logistics.simulation.v1.AnalyzeResilienceResponse
-	(*ResilienceMetrics)(nil),            // 75: logistics.simulation.v1.ResilienceMetrics
-	(*NMinusOneAnalysis)(nil),            // 76: logistics.simulation.v1.NMinusOneAnalysis
-	(*NMinusTwoAnalysis)(nil),            // 77: logistics.simulation.v1.NMinusTwoAnalysis
-	(*EdgePair)(nil),                     // 78: logistics.simulation.v1.EdgePair
-	(*ResilienceWeakness)(nil),           // 79: logistics.simulation.v1.ResilienceWeakness
-	(*SaveSimulationRequest)(nil),        // 80: logistics.simulation.v1.SaveSimulationRequest
-	(*SaveSimulationResponse)(nil),       // 81: logistics.simulation.v1.SaveSimulationResponse
-	(*GetSimulationRequest)(nil),         // 82: logistics.simulation.v1.GetSimulationRequest
-	(*GetSimulationResponse)(nil),        // 83: logistics.simulation.v1.GetSimulationResponse
-	(*ListSimulationsRequest)(nil),       // 84: logistics.simulation.v1.ListSimulationsRequest
-	(*ListSimulationsResponse)(nil),      // 85: logistics.simulation.v1.ListSimulationsResponse
-	(*SimulationRecord)(nil),             // 86: logistics.simulation.v1.SimulationRecord
-	(*SimulationSummary)(nil),            // 87: logistics.simulation.v1.SimulationSummary
-	(*SimulationMetadata)(nil),           // 88: logistics.simulation.v1.SimulationMetadata
-	(*HealthRequest)(nil),                // 89: logistics.simulation.v1.HealthRequest
-	(*HealthResponse)(nil),               // 90: logistics.simulation.v1.HealthResponse
-	nil,                                  // 91: logistics.simulation.v1.RunMonteCarloResponse.FlowPercentilesEntry
-	nil,                                  // 92: logistics.simulation.v1.RunMonteCarloResponse.CostPercentilesEntry
-	nil,                                  // 93: logistics.simulation.v1.SaveSimulationRequest.TagsEntry
-	nil,                                  // 94: logistics.simulation.v1.SimulationRecord.TagsEntry
-	nil,                                  // 95: logistics.simulation.v1.SimulationSummary.TagsEntry
-	(*v1.Graph)(nil),                     // 96: logistics.common.v1.Graph
-	(v1.Algorithm)(0),                    // 97: logistics.common.v1.Algorithm
-	(*v1.EdgeKey)(nil),                   // 98: logistics.common.v1.EdgeKey
-	(v1.FlowStatus)(0),                   // 99: logistics.common.v1.FlowStatus
-	(*timestamppb.Timestamp)(nil),        // 100: google.protobuf.Timestamp
-	(*v1.PaginationRequest)(nil),         // 101: logistics.common.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil),        // 102: logistics.common.v1.PaginationResponse
+	(ModificationType)(0),                      // 0: logistics.simulation.v1.ModificationType
+	(ModificationTarget)(0),                    // 1: logistics.simulation.v1.ModificationTarget
+	(ImpactLevel)(0),                           // 2: logistics.simulation.v1.ImpactLevel
+	(BottleneckChangeType)(0),                  // 3: logistics.simulation.v1.BottleneckChangeType
+	(ExpansionStopReason)(0),                   // 4: logistics.simulation.v1.ExpansionStopReason
+	(FacilityMoveType)(0),                      // 5: logistics.simulation.v1.FacilityMoveType
+	(TimeSimulationMode)(0),                    // 6: logistics.simulation.v1.TimeSimulationMode
+	(TimeStep)(0),                              // 7: logistics.simulation.v1.TimeStep
+	(PatternTarget)(0),                         // 8: logistics.simulation.v1.PatternTarget
+	(PatternType)(0),                           // 9: logistics.simulation.v1.PatternType
+	(CriticalPeriodType)(0),                    // 10: logistics.simulation.v1.CriticalPeriodType
+	(CorrelationMeasure)(0),                    // 11: logistics.simulation.v1.CorrelationMeasure
+	(MonteCarloStopReason)(0),                  // 12: logistics.simulation.v1.MonteCarloStopReason
+	(SamplingMethod)(0),                        // 13: logistics.simulation.v1.SamplingMethod
+	(UncertaintyType)(0),                       // 14: logistics.simulation.v1.UncertaintyType
+	(DistributionType)(0),                      // 15: logistics.simulation.v1.DistributionType
+	(SensitivityMethod)(0),                     // 16: logistics.simulation.v1.SensitivityMethod
+	(SensitivityLevel)(0),                      // 17: logistics.simulation.v1.SensitivityLevel
+	(ThresholdType)(0),                         // 18: logistics.simulation.v1.ThresholdType
+	(FailureCorrelation)(0),                    // 19: logistics.simulation.v1.FailureCorrelation
+	(RecommendationType)(0),                    // 20: logistics.simulation.v1.RecommendationType
+	(WeaknessType)(0),                          // 21: logistics.simulation.v1.WeaknessType
+	(SimulationType)(0),                        // 22: logistics.simulation.v1.SimulationType
+	(*RunWhatIfRequest)(nil),                   // 23: logistics.simulation.v1.RunWhatIfRequest
+	(*Modification)(nil),                       // 24: logistics.simulation.v1.Modification
+	(*WhatIfOptions)(nil),                      // 25: logistics.simulation.v1.WhatIfOptions
+	(*RunWhatIfResponse)(nil),                  // 26: logistics.simulation.v1.RunWhatIfResponse
+	(*ScenarioResult)(nil),                     // 27: logistics.simulation.v1.ScenarioResult
+	(*ScenarioComparison)(nil),                 // 28: logistics.simulation.v1.ScenarioComparison
+	(*BottleneckChange)(nil),                   // 29: logistics.simulation.v1.BottleneckChange
+	(*CompareScenariosRequest)(nil),            // 30: logistics.simulation.v1.CompareScenariosRequest
+	(*Scenario)(nil),                           // 31: logistics.simulation.v1.Scenario
+	(*CompareOptions)(nil),                     // 32: logistics.simulation.v1.CompareOptions
+	(*CompareScenariosResponse)(nil),           // 33: logistics.simulation.v1.CompareScenariosResponse
+	(*ScenarioResultWithRank)(nil),             // 34: logistics.simulation.v1.ScenarioResultWithRank
+	(*OptimizeCapacityExpansionRequest)(nil),   // 35: logistics.simulation.v1.OptimizeCapacityExpansionRequest
+	(*EdgeUpgradeOption)(nil),                  // 36: logistics.simulation.v1.EdgeUpgradeOption
+	(*OptimizeCapacityExpansionResponse)(nil),  // 37: logistics.simulation.v1.OptimizeCapacityExpansionResponse
+	(*CapacityIncrement)(nil),                  // 38: logistics.simulation.v1.CapacityIncrement
+	(*ExpansionStep)(nil),                      // 39: logistics.simulation.v1.ExpansionStep
+	(*OptimizeFacilityLocationRequest)(nil),    // 40: logistics.simulation.v1.OptimizeFacilityLocationRequest
+	(*OptimizeFacilityLocationResponse)(nil),   // 41: logistics.simulation.v1.OptimizeFacilityLocationResponse
+	(*FacilityDecision)(nil),                   // 42: logistics.simulation.v1.FacilityDecision
+	(*FacilityMove)(nil),                       // 43: logistics.simulation.v1.FacilityMove
+	(*RunTimeSimulationRequest)(nil),           // 44: logistics.simulation.v1.RunTimeSimulationRequest
+	(*TimeSimulationConfig)(nil),               // 45: logistics.simulation.v1.TimeSimulationConfig
+	(*RoadTypeSpeed)(nil),                      // 46: logistics.simulation.v1.RoadTypeSpeed
+	(*EdgeTimePattern)(nil),                    // 47: logistics.simulation.v1.EdgeTimePattern
+	(*NodeTimePattern)(nil),                    // 48: logistics.simulation.v1.NodeTimePattern
+	(*TimePattern)(nil),                        // 49: logistics.simulation.v1.TimePattern
+	(*TimePoint)(nil),                          // 50: logistics.simulation.v1.TimePoint
+	(*RunTimeSimulationResponse)(nil),          // 51: logistics.simulation.v1.RunTimeSimulationResponse
+	(*TimeStepResult)(nil),                     // 52: logistics.simulation.v1.TimeStepResult
+	(*ServiceLevel)(nil),                       // 53: logistics.simulation.v1.ServiceLevel
+	(*NodeInventory)(nil),                      // 54: logistics.simulation.v1.NodeInventory
+	(*DynamicFlowSummary)(nil),                 // 55: logistics.simulation.v1.DynamicFlowSummary
+	(*EdgeTransitTime)(nil),                    // 56: logistics.simulation.v1.EdgeTransitTime
+	(*TimeSimulationStats)(nil),                // 57: logistics.simulation.v1.TimeSimulationStats
+	(*CriticalPeriod)(nil),                     // 58: logistics.simulation.v1.CriticalPeriod
+	(*SimulatePeakLoadRequest)(nil),            // 59: logistics.simulation.v1.SimulatePeakLoadRequest
+	(*SimulatePeakLoadResponse)(nil),           // 60: logistics.simulation.v1.SimulatePeakLoadResponse
+	(*OverloadedEdge)(nil),                     // 61: logistics.simulation.v1.OverloadedEdge
+	(*RunDiscreteEventSimulationRequest)(nil),  // 62: logistics.simulation.v1.RunDiscreteEventSimulationRequest
+	(*DiscreteEventConfig)(nil),                // 63: logistics.simulation.v1.DiscreteEventConfig
+	(*RunDiscreteEventSimulationResponse)(nil), // 64: logistics.simulation.v1.RunDiscreteEventSimulationResponse
+	(*DiscreteEventStats)(nil),                 // 65: logistics.simulation.v1.DiscreteEventStats
+	(*NodeQueueStats)(nil),                     // 66: logistics.simulation.v1.NodeQueueStats
+	(*ThroughputPoint)(nil),                    // 67: logistics.simulation.v1.ThroughputPoint
+	(*ShipmentRoute)(nil),                      // 68: logistics.simulation.v1.ShipmentRoute
+	(*RunMonteCarloRequest)(nil),               // 69: logistics.simulation.v1.RunMonteCarloRequest
+	(*UncertaintyCorrelation)(nil),             // 70: logistics.simulation.v1.UncertaintyCorrelation
+	(*CorrelationGroup)(nil),                   // 71: logistics.simulation.v1.CorrelationGroup
+	(*MonteCarloConfig)(nil),                   // 72: logistics.simulation.v1.MonteCarloConfig
+	(*UncertaintySpec)(nil),                    // 73: logistics.simulation.v1.UncertaintySpec
+	(*Distribution)(nil),                       // 74: logistics.simulation.v1.Distribution
+	(*RunMonteCarloResponse)(nil),              // 75: logistics.simulation.v1.RunMonteCarloResponse
+	(*MonteCarloSample)(nil),                   // 76: logistics.simulation.v1.MonteCarloSample
+	(*MonteCarloStats)(nil),                    // 77: logistics.simulation.v1.MonteCarloStats
+	(*HistogramBucket)(nil),                    // 78: logistics.simulation.v1.HistogramBucket
+	(*RiskAnalysis)(nil),                       // 79: logistics.simulation.v1.RiskAnalysis
+	(*RiskScenario)(nil),                       // 80: logistics.simulation.v1.RiskScenario
+	(*ParameterCorrelation)(nil),               // 81: logistics.simulation.v1.ParameterCorrelation
+	(*MonteCarloProgress)(nil),                 // 82: logistics.simulation.v1.MonteCarloProgress
+	(*AnalyzeSensitivityRequest)(nil),          // 83: logistics.simulation.v1.AnalyzeSensitivityRequest
+	(*SensitivityParameter)(nil),               // 84: logistics.simulation.v1.SensitivityParameter
+	(*SensitivityConfig)(nil),                  // 85: logistics.simulation.v1.SensitivityConfig
+	(*AnalyzeSensitivityResponse)(nil),         // 86: logistics.simulation.v1.AnalyzeSensitivityResponse
+	(*SensitivityResult)(nil),                  // 87: logistics.simulation.v1.SensitivityResult
+	(*MorrisIndices)(nil),                      // 88: logistics.simulation.v1.MorrisIndices
+	(*SobolIndices)(nil),                       // 89: logistics.simulation.v1.SobolIndices
+	(*SensitivityPoint)(nil),                   // 90: logistics.simulation.v1.SensitivityPoint
+	(*ParameterRanking)(nil),                   // 91: logistics.simulation.v1.ParameterRanking
+	(*ThresholdPoint)(nil),                     // 92: logistics.simulation.v1.ThresholdPoint
+	(*FindCriticalElementsRequest)(nil),        // 93: logistics.simulation.v1.FindCriticalElementsRequest
+	(*CriticalElementsConfig)(nil),             // 94: logistics.simulation.v1.CriticalElementsConfig
+	(*FindCriticalElementsResponse)(nil),       // 95: logistics.simulation.v1.FindCriticalElementsResponse
+	(*CriticalEdge)(nil),                       // 96: logistics.simulation.v1.CriticalEdge
+	(*CriticalNode)(nil),                       // 97: logistics.simulation.v1.CriticalNode
+	(*SimulateFailuresRequest)(nil),            // 98: logistics.simulation.v1.SimulateFailuresRequest
+	(*FailureScenario)(nil),                    // 99: logistics.simulation.v1.FailureScenario
+	(*RandomFailureConfig)(nil),                // 100: logistics.simulation.v1.RandomFailureConfig
+	(*SimulateFailuresResponse)(nil),           // 101: logistics.simulation.v1.SimulateFailuresResponse
+	(*FailureScenarioResult)(nil),              // 102: logistics.simulation.v1.FailureScenarioResult
+	(*FailureStats)(nil),                       // 103: logistics.simulation.v1.FailureStats
+	(*ResilienceRecommendation)(nil),           // 104: logistics.simulation.v1.ResilienceRecommendation
+	(*AnalyzeResilienceRequest)(nil),           // 105: logistics.simulation.v1.AnalyzeResilienceRequest
+	(*ResilienceConfig)(nil),                   // 106: logistics.simulation.v1.ResilienceConfig
+	(*AnalyzeResilienceResponse)(nil),          // 107: logistics.simulation.v1.AnalyzeResilienceResponse
+	(*ResilienceMetrics)(nil),                  // 108: logistics.simulation.v1.ResilienceMetrics
+	(*NMinusOneAnalysis)(nil),                  // 109: logistics.simulation.v1.NMinusOneAnalysis
+	(*NMinusTwoAnalysis)(nil),                  // 110: logistics.simulation.v1.NMinusTwoAnalysis
+	(*EdgePair)(nil),                           // 111: logistics.simulation.v1.EdgePair
+	(*EdgeSet)(nil),                            // 112: logistics.simulation.v1.EdgeSet
+	(*CascadeAnalysis)(nil),                    // 113: logistics.simulation.v1.CascadeAnalysis
+	(*CascadeScenario)(nil),                    // 114: logistics.simulation.v1.CascadeScenario
+	(*CascadeStep)(nil),                        // 115: logistics.simulation.v1.CascadeStep
+	(*ResilienceWeakness)(nil),                 // 116: logistics.simulation.v1.ResilienceWeakness
+	(*SaveSimulationRequest)(nil),              // 117: logistics.simulation.v1.SaveSimulationRequest
+	(*SaveSimulationResponse)(nil),             // 118: logistics.simulation.v1.SaveSimulationResponse
+	(*GetSimulationRequest)(nil),               // 119: logistics.simulation.v1.GetSimulationRequest
+	(*GetSimulationResponse)(nil),              // 120: logistics.simulation.v1.GetSimulationResponse
+	(*ListSimulationsRequest)(nil),             // 121: logistics.simulation.v1.ListSimulationsRequest
+	(*ListSimulationsResponse)(nil),            // 122: logistics.simulation.v1.ListSimulationsResponse
+	(*SimulationRecord)(nil),                   // 123: logistics.simulation.v1.SimulationRecord
+	(*SimulationSummary)(nil),                  // 124: logistics.simulation.v1.SimulationSummary
+	(*SimulationMetadata)(nil),                 // 125: logistics.simulation.v1.SimulationMetadata
+	(*HealthRequest)(nil),                      // 126: logistics.simulation.v1.HealthRequest
+	(*HealthResponse)(nil),                     // 127: logistics.simulation.v1.HealthResponse
+	nil,                                        // 128: logistics.simulation.v1.RunDiscreteEventSimulationResponse.LeadTimePercentilesEntry
+	nil,                                        // 129: logistics.simulation.v1.RunMonteCarloResponse.FlowPercentilesEntry
+	nil,                                        // 130: logistics.simulation.v1.RunMonteCarloResponse.CostPercentilesEntry
+	nil,                                        // 131: logistics.simulation.v1.SaveSimulationRequest.TagsEntry
+	nil,                                        // 132: logistics.simulation.v1.SimulationRecord.TagsEntry
+	nil,                                        // 133: logistics.simulation.v1.SimulationSummary.TagsEntry
+	(*v1.Graph)(nil),                           // 134: logistics.common.v1.Graph
+	(v1.Algorithm)(0),                          // 135: logistics.common.v1.Algorithm
+	(*v1.EdgeKey)(nil),                         // 136: logistics.common.v1.EdgeKey
+	(v1.FlowStatus)(0),                         // 137: logistics.common.v1.FlowStatus
+	(*v11.FixedCostConfig)(nil),                // 138: logistics.analytics.v1.FixedCostConfig
+	(*timestamppb.Timestamp)(nil),              // 139: google.protobuf.Timestamp
+	(v1.RoadType)(0),                           // 140: logistics.common.v1.RoadType
+	(*v1.NodeBalance)(nil),                     // 141: logistics.common.v1.NodeBalance
+	(*v1.PaginationRequest)(nil),               // 142: logistics.common.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),              // 143: logistics.common.v1.PaginationResponse
 }
 var file_logistics_simulation_v1_simulation_proto_depIdxs = []int32{
-	96,  // 0: logistics.simulation.v1.RunWhatIfRequest.baseline_graph:type_name -> logistics.common.v1.Graph
-	17,  // 1: logistics.simulation.v1.RunWhatIfRequest.modifications:type_name -> logistics.simulation.v1.Modification
-	97,  // 2: logistics.simulation.v1.RunWhatIfRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	18,  // 3: logistics.simulation.v1.RunWhatIfRequest.options:type_name -> logistics.simulation.v1.WhatIfOptions
+	134, // 0: logistics.simulation.v1.RunWhatIfRequest.baseline_graph:type_name -> logistics.common.v1.Graph
+	24,  // 1: logistics.simulation.v1.RunWhatIfRequest.modifications:type_name -> logistics.simulation.v1.Modification
+	135, // 2: logistics.simulation.v1.RunWhatIfRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	25,  // 3: logistics.simulation.v1.RunWhatIfRequest.options:type_name -> logistics.simulation.v1.WhatIfOptions
 	0,   // 4: logistics.simulation.v1.Modification.type:type_name -> logistics.simulation.v1.ModificationType
-	98,  // 5: logistics.simulation.v1.Modification.edge_key:type_name -> logistics.common.v1.EdgeKey
+	136, // 5: logistics.simulation.v1.Modification.edge_key:type_name -> logistics.common.v1.EdgeKey
 	1,   // 6: logistics.simulation.v1.Modification.target:type_name -> logistics.simulation.v1.ModificationTarget
-	20,  // 7: logistics.simulation.v1.RunWhatIfResponse.baseline:type_name -> logistics.simulation.v1.ScenarioResult
-	20,  // 8: logistics.simulation.v1.RunWhatIfResponse.modified:type_name -> logistics.simulation.v1.ScenarioResult
-	21,  // 9: logistics.simulation.v1.RunWhatIfResponse.comparison:type_name -> logistics.simulation.v1.ScenarioComparison
-	96,  // 10: logistics.simulation.v1.RunWhatIfResponse.modified_graph:type_name -> logistics.common.v1.Graph
-	22,  // 11: logistics.simulation.v1.RunWhatIfResponse.bottleneck_changes:type_name -> logistics.simulation.v1.BottleneckChange
-	88,  // 12: logistics.simulation.v1.RunWhatIfResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	99,  // 13: logistics.simulation.v1.ScenarioResult.status:type_name -> logistics.common.v1.FlowStatus
+	27,  // 7: logistics.simulation.v1.RunWhatIfResponse.baseline:type_name -> logistics.simulation.v1.ScenarioResult
+	27,  // 8: logistics.simulation.v1.RunWhatIfResponse.modified:type_name -> logistics.simulation.v1.ScenarioResult
+	28,  // 9: logistics.simulation.v1.RunWhatIfResponse.comparison:type_name -> logistics.simulation.v1.ScenarioComparison
+	134, // 10: logistics.simulation.v1.RunWhatIfResponse.modified_graph:type_name -> logistics.common.v1.Graph
+	29,  // 11: logistics.simulation.v1.RunWhatIfResponse.bottleneck_changes:type_name -> logistics.simulation.v1.BottleneckChange
+	125, // 12: logistics.simulation.v1.RunWhatIfResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	137, // 13: logistics.simulation.v1.ScenarioResult.status:type_name -> logistics.common.v1.FlowStatus
 	2,   // 14: logistics.simulation.v1.ScenarioComparison.impact_level:type_name -> logistics.simulation.v1.ImpactLevel
-	98,  // 15: logistics.simulation.v1.BottleneckChange.edge:type_name -> logistics.common.v1.EdgeKey
+	136, // 15: logistics.simulation.v1.BottleneckChange.edge:type_name -> logistics.common.v1.EdgeKey
 	3,   // 16: logistics.simulation.v1.BottleneckChange.change_type:type_name -> logistics.simulation.v1.BottleneckChangeType
-	96,  // 17: logistics.simulation.v1.CompareScenariosRequest.baseline_graph:type_name -> logistics.common.v1.Graph
-	24,  // 18: logistics.simulation.v1.CompareScenariosRequest.scenarios:type_name -> logistics.simulation.v1.Scenario
-	97,  // 19: logistics.simulation.v1.CompareScenariosRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	25,  // 20: logistics.simulation.v1.CompareScenariosRequest.options:type_name -> logistics.simulation.v1.CompareOptions
-	17,  // 21: logistics.simulation.v1.Scenario.modifications:type_name -> logistics.simulation.v1.Modification
-	20,  // 22: logistics.simulation.v1.CompareScenariosResponse.baseline:type_name -> logistics.simulation.v1.ScenarioResult
-	27,  // 23: logistics.simulation.v1.CompareScenariosResponse.ranked_scenarios:type_name -> logistics.simulation.v1.ScenarioResultWithRank
-	88,  // 24: logistics.simulation.v1.CompareScenariosResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	20,  // 25: logistics.simulation.v1.ScenarioResultWithRank.result:type_name -> logistics.simulation.v1.ScenarioResult
-	21,  // 26: logistics.simulation.v1.ScenarioResultWithRank.vs_baseline:type_name -> logistics.simulation.v1.ScenarioComparison
-	96,  // 27: logistics.simulation.v1.RunTimeSimulationRequest.graph:type_name -> logistics.common.v1.Graph
-	29,  // 28: logistics.simulation.v1.RunTimeSimulationRequest.time_config:type_name -> logistics.simulation.v1.TimeSimulationConfig
-	30,  // 29: logistics.simulation.v1.RunTimeSimulationRequest.edge_patterns:type_name -> logistics.simulation.v1.EdgeTimePattern
-	31,  // 30: logistics.simulation.v1.RunTimeSimulationRequest.node_patterns:type_name -> logistics.simulation.v1.NodeTimePattern
-	97,  // 31: logistics.simulation.v1.RunTimeSimulationRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	100, // 32: logistics.simulation.v1.TimeSimulationConfig.start_time:type_name -> google.protobuf.Timestamp
-	100, // 33: logistics.simulation.v1.TimeSimulationConfig.end_time:type_name -> google.protobuf.Timestamp
-	4,   // 34: logistics.simulation.v1.TimeSimulationConfig.time_step:type_name -> logistics.simulation.v1.TimeStep
-	98,  // 35: logistics.simulation.v1.EdgeTimePattern.edge:type_name -> logistics.common.v1.EdgeKey
-	32,  // 36: logistics.simulation.v1.EdgeTimePattern.pattern:type_name -> logistics.simulation.v1.TimePattern
-	32,  // 37: logistics.simulation.v1.NodeTimePattern.pattern:type_name -> logistics.simulation.v1.TimePattern
-	5,   // 38: logistics.simulation.v1.NodeTimePattern.target:type_name -> logistics.simulation.v1.PatternTarget
-	6,   // 39: logistics.simulation.v1.TimePattern.type:type_name -> logistics.simulation.v1.PatternType
-	33,  // 40: logistics.simulation.v1.TimePattern.custom_points:type_name -> logistics.simulation.v1.TimePoint
-	35,  // 41: logistics.simulation.v1.RunTimeSimulationResponse.step_results:type_name -> logistics.simulation.v1.TimeStepResult
-	36,  // 42: logistics.simulation.v1.RunTimeSimulationResponse.stats:type_name -> logistics.simulation.v1.TimeSimulationStats
-	37,  // 43: logistics.simulation.v1.RunTimeSimulationResponse.critical_periods:type_name -> logistics.simulation.v1.CriticalPeriod
-	88,  // 44: logistics.simulation.v1.RunTimeSimulationResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	100, // 45: logistics.simulation.v1.TimeStepResult.timestamp:type_name -> google.protobuf.Timestamp
-	98,  // 46: logistics.simulation.v1.TimeStepResult.bottlenecks:type_name -> logistics.common.v1.EdgeKey
-	100, // 47: logistics.simulation.v1.CriticalPeriod.start_time:type_name -> google.protobuf.Timestamp
-	100, // 48: logistics.simulation.v1.CriticalPeriod.end_time:type_name -> google.protobuf.Timestamp
-	7,   // 49: logistics.simulation.v1.CriticalPeriod.type:type_name -> logistics.simulation.v1.CriticalPeriodType
-	96,  // 50: logistics.simulation.v1.SimulatePeakLoadRequest.graph:type_name -> logistics.common.v1.Graph
-	98,  // 51: logistics.simulation.v1.SimulatePeakLoadRequest.affected_edges:type_name -> logistics.common.v1.EdgeKey
-	97,  // 52: logistics.simulation.v1.SimulatePeakLoadRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	20,  // 53: logistics.simulation.v1.SimulatePeakLoadResponse.normal_result:type_name -> logistics.simulation.v1.ScenarioResult
-	20,  // 54: logistics.simulation.v1.SimulatePeakLoadResponse.peak_result:type_name -> logistics.simulation.v1.ScenarioResult
-	21,  // 55: logistics.simulation.v1.SimulatePeakLoadResponse.comparison:type_name -> logistics.simulation.v1.ScenarioComparison
-	40,  // 56: logistics.simulation.v1.SimulatePeakLoadResponse.overloaded_edges:type_name -> logistics.simulation.v1.OverloadedEdge
-	88,  // 57: logistics.simulation.v1.SimulatePeakLoadResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	98,  // 58: logistics.simulation.v1.OverloadedEdge.edge:type_name -> logistics.common.v1.EdgeKey
-	96,  // 59: logistics.simulation.v1.RunMonteCarloRequest.graph:type_name -> logistics.common.v1.Graph
-	42,  // 60: logistics.simulation.v1.RunMonteCarloRequest.config:type_name -> logistics.simulation.v1.MonteCarloConfig
-	43,  // 61: logistics.simulation.v1.RunMonteCarloRequest.uncertainties:type_name -> logistics.simulation.v1.UncertaintySpec
-	97,  // 62: logistics.simulation.v1.RunMonteCarloRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	8,   // 63: logistics.simulation.v1.UncertaintySpec.type:type_name -> logistics.simulation.v1.UncertaintyType
-	98,  // 64: logistics.simulation.v1.UncertaintySpec.edge:type_name -> logistics.common.v1.EdgeKey
-	1,   // 65: logistics.simulation.v1.UncertaintySpec.target:type_name -> logistics.simulation.v1.ModificationTarget
-	44,  // 66: logistics.simulation.v1.UncertaintySpec.distribution:type_name -> logistics.simulation.v1.Distribution
-	9,   // 67: logistics.simulation.v1.Distribution.type:type_name -> logistics.simulation.v1.DistributionType
-	46,  // 68: logistics.simulation.v1.RunMonteCarloResponse.flow_stats:type_name -> logistics.simulation.v1.MonteCarloStats
-	46,  // 69: logistics.simulation.v1.RunMonteCarloResponse.cost_stats:type_name -> logistics.simulation.v1.MonteCarloStats
-	47,  // 70: logistics.simulation.v1.RunMonteCarloResponse.flow_histogram:type_name -> logistics.simulation.v1.HistogramBucket
-	47,  // 71: logistics.simulation.v1.RunMonteCarloResponse.cost_histogram:type_name -> logistics.simulation.v1.HistogramBucket
-	91,  // 72: logistics.simulation.v1.RunMonteCarloResponse.flow_percentiles:type_name -> logistics.simulation.v1.RunMonteCarloResponse.FlowPercentilesEntry
-	92,  // 73: logistics.simulation.v1.RunMonteCarloResponse.cost_percentiles:type_name -> logistics.simulation.v1.RunMonteCarloResponse.CostPercentilesEntry
-	48,  // 74: logistics.simulation.v1.RunMonteCarloResponse.risk_analysis:type_name -> logistics.simulation.v1.RiskAnalysis
-	50,  // 75: logistics.simulation.v1.RunMonteCarloResponse.correlations:type_name -> logistics.simulation.v1.ParameterCorrelation
-	88,  // 76: logistics.simulation.v1.RunMonteCarloResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	49,  // 77: logistics.simulation.v1.RiskAnalysis.risk_scenarios:type_name -> logistics.simulation.v1.RiskScenario
-	96,  // 78: logistics.simulation.v1.AnalyzeSensitivityRequest.graph:type_name -> logistics.common.v1.Graph
-	53,  // 79: logistics.simulation.v1.AnalyzeSensitivityRequest.parameters:type_name -> logistics.simulation.v1.SensitivityParameter
-	54,  // 80: logistics.simulation.v1.AnalyzeSensitivityRequest.config:type_name -> logistics.simulation.v1.SensitivityConfig
-	97,  // 81: logistics.simulation.v1.AnalyzeSensitivityRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	98,  // 82: logistics.simulation.v1.SensitivityParameter.edge:type_name -> logistics.common.v1.EdgeKey
-	1,   // 83: logistics.simulation.v1.SensitivityParameter.target:type_name -> logistics.simulation.v1.ModificationTarget
-	10,  // 84: logistics.simulation.v1.SensitivityConfig.method:type_name -> logistics.simulation.v1.SensitivityMethod
-	56,  // 85: logistics.simulation.v1.AnalyzeSensitivityResponse.parameter_results:type_name -> logistics.simulation.v1.SensitivityResult
-	58,  // 86: logistics.simulation.v1.AnalyzeSensitivityResponse.rankings:type_name -> logistics.simulation.v1.ParameterRanking
-	59,  // 87: logistics.simulation.v1.AnalyzeSensitivityResponse.thresholds:type_name -> logistics.simulation.v1.ThresholdPoint
-	88,  // 88: logistics.simulation.v1.AnalyzeSensitivityResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	57,  // 89: logistics.simulation.v1.SensitivityResult.curve:type_name -> logistics.simulation.v1.SensitivityPoint
-	11,  // 90: logistics.simulation.v1.SensitivityResult.level:type_name -> logistics.simulation.v1.SensitivityLevel
-	12,  // 91: logistics.simulation.v1.ThresholdPoint.type:type_name -> logistics.simulation.v1.ThresholdType
-	96,  // 92: logistics.simulation.v1.FindCriticalElementsRequest.graph:type_name -> logistics.common.v1.Graph
-	61,  // 93: logistics.simulation.v1.FindCriticalElementsRequest.config:type_name -> logistics.simulation.v1.CriticalElementsConfig
-	97,  // 94: logistics.simulation.v1.FindCriticalElementsRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	63,  // 95: logistics.simulation.v1.FindCriticalElementsResponse.critical_edges:type_name -> logistics.simulation.v1.CriticalEdge
-	64,  // 96: logistics.simulation.v1.FindCriticalElementsResponse.critical_nodes:type_name -> logistics.simulation.v1.CriticalNode
-	98,  // 97: logistics.simulation.v1.FindCriticalElementsResponse.single_points_of_failure:type_name -> logistics.common.v1.EdgeKey
-	88,  // 98: logistics.simulation.v1.FindCriticalElementsResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	98,  // 99: logistics.simulation.v1.CriticalEdge.edge:type_name -> logistics.common.v1.EdgeKey
-	96,  // 100: logistics.simulation.v1.SimulateFailuresRequest.graph:type_name -> logistics.common.v1.Graph
-	66,  // 101: logistics.simulation.v1.SimulateFailuresRequest.failure_scenarios:type_name -> logistics.simulation.v1.FailureScenario
-	67,  // 102: logistics.simulation.v1.SimulateFailuresRequest.random_config:type_name -> logistics.simulation.v1.RandomFailureConfig
-	97,  // 103: logistics.simulation.v1.SimulateFailuresRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	98,  // 104: logistics.simulation.v1.FailureScenario.failed_edges:type_name -> logistics.common.v1.EdgeKey
-	20,  // 105: logistics.simulation.v1.SimulateFailuresResponse.baseline:type_name -> logistics.simulation.v1.ScenarioResult
-	69,  // 106: logistics.simulation.v1.SimulateFailuresResponse.scenario_results:type_name -> logistics.simulation.v1.FailureScenarioResult
-	70,  // 107: logistics.simulation.v1.SimulateFailuresResponse.stats:type_name -> logistics.simulation.v1.FailureStats
-	71,  // 108: logistics.simulation.v1.SimulateFailuresResponse.recommendations:type_name -> logistics.simulation.v1.ResilienceRecommendation
-	88,  // 109: logistics.simulation.v1.SimulateFailuresResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	20,  // 110: logistics.simulation.v1.FailureScenarioResult.result:type_name -> logistics.simulation.v1.ScenarioResult
-	21,  // 111: logistics.simulation.v1.FailureScenarioResult.vs_baseline:type_name -> logistics.simulation.v1.ScenarioComparison
-	13,  // 112: logistics.simulation.v1.ResilienceRecommendation.type:type_name -> logistics.simulation.v1.RecommendationType
-	98,  // 113: logistics.simulation.v1.ResilienceRecommendation.affected_edge:type_name -> logistics.common.v1.EdgeKey
-	96,  // 114: logistics.simulation.v1.AnalyzeResilienceRequest.graph:type_name -> logistics.common.v1.Graph
-	73,  // 115: logistics.simulation.v1.AnalyzeResilienceRequest.config:type_name -> logistics.simulation.v1.ResilienceConfig
-	97,  // 116: logistics.simulation.v1.AnalyzeResilienceRequest.algorithm:type_name -> logistics.common.v1.Algorithm
-	75,  // 117: logistics.simulation.v1.AnalyzeResilienceResponse.metrics:type_name -> logistics.simulation.v1.ResilienceMetrics
-	76,  // 118: logistics.simulation.v1.AnalyzeResilienceResponse.n_minus_one:type_name -> logistics.simulation.v1.NMinusOneAnalysis
-	77,  // 119: logistics.simulation.v1.AnalyzeResilienceResponse.n_minus_two:type_name -> logistics.simulation.v1.NMinusTwoAnalysis
-	79,  // 120: logistics.simulation.v1.AnalyzeResilienceResponse.weaknesses:type_name -> logistics.simulation.v1.ResilienceWeakness
-	88,  // 121: logistics.simulation.v1.AnalyzeResilienceResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
-	98,  // 122: logistics.simulation.v1.NMinusOneAnalysis.most_critical_edge:type_name -> logistics.common.v1.EdgeKey
-	78,  // 123: logistics.simulation.v1.NMinusTwoAnalysis.critical_edge_pairs:type_name -> logistics.simulation.v1.EdgePair
-	98,  // 124: logistics.simulation.v1.EdgePair.edge1:type_name -> logistics.common.v1.EdgeKey
-	98,  // 125: logistics.simulation.v1.EdgePair.edge2:type_name -> logistics.common.v1.EdgeKey
-	14,  // 126: logistics.simulation.v1.ResilienceWeakness.type:type_name -> logistics.simulation.v1.WeaknessType
-	98,  // 127: logistics.simulation.v1.ResilienceWeakness.affected_edges:type_name -> logistics.common.v1.EdgeKey
-	15,  // 128: logistics.simulation.v1.SaveSimulationRequest.type:type_name -> logistics.simulation.v1.SimulationType
-	96,  // 129: logistics.simulation.v1.SaveSimulationRequest.graph:type_name -> logistics.common.v1.Graph
-	93,  // 130: logistics.simulation.v1.SaveSimulationRequest.tags:type_name -> logistics.simulation.v1.SaveSimulationRequest.TagsEntry
-	100, // 131: logistics.simulation.v1.SaveSimulationResponse.created_at:type_name -> google.protobuf.Timestamp
-	86,  // 132: logistics.simulation.v1.GetSimulationResponse.record:type_name -> logistics.simulation.v1.SimulationRecord
-	15,  // 133: logistics.simulation.v1.ListSimulationsRequest.type:type_name -> logistics.simulation.v1.SimulationType
-	101, // 134: logistics.simulation.v1.ListSimulationsRequest.pagination:type_name -> logistics.common.v1.PaginationRequest
-	87,  // 135: logistics.simulation.v1.ListSimulationsResponse.simulations:type_name -> logistics.simulation.v1.SimulationSummary
-	102, // 136: logistics.simulation.v1.ListSimulationsResponse.pagination:type_name -> logistics.common.v1.PaginationResponse
-	15,  // 137: logistics.simulation.v1.SimulationRecord.type:type_name -> logistics.simulation.v1.SimulationType
-	100, // 138: logistics.simulation.v1.SimulationRecord.created_at:type_name -> google.protobuf.Timestamp
-	94,  // 139: logistics.simulation.v1.SimulationRecord.tags:type_name -> logistics.simulation.v1.SimulationRecord.TagsEntry
-	15,  // 140: logistics.simulation.v1.SimulationSummary.type:type_name -> logistics.simulation.v1.SimulationType
-	100, // 141: logistics.simulation.v1.SimulationSummary.created_at:type_name -> google.protobuf.Timestamp
-	95,  // 142: logistics.simulation.v1.SimulationSummary.tags:type_name -> logistics.simulation.v1.SimulationSummary.TagsEntry
-	100, // 143: logistics.simulation.v1.SimulationMetadata.completed_at:type_name -> google.protobuf.Timestamp
-	16,  // 144: logistics.simulation.v1.SimulationService.RunWhatIf:input_type -> logistics.simulation.v1.RunWhatIfRequest
-	23,  // 145: logistics.simulation.v1.SimulationService.CompareScenarios:input_type -> logistics.simulation.v1.CompareScenariosRequest
-	28,  // 146: logistics.simulation.v1.SimulationService.RunTimeSimulation:input_type -> logistics.simulation.v1.RunTimeSimulationRequest
-	38,  // 147: logistics.simulation.v1.SimulationService.SimulatePeakLoad:input_type -> logistics.simulation.v1.SimulatePeakLoadRequest
-	41,  // 148: logistics.simulation.v1.SimulationService.RunMonteCarlo:input_type -> logistics.simulation.v1.RunMonteCarloRequest
-	41,  // 149: logistics.simulation.v1.SimulationService.RunMonteCarloStream:input_type -> logistics.simulation.v1.RunMonteCarloRequest
-	52,  // 150: logistics.simulation.v1.SimulationService.AnalyzeSensitivity:input_type -> logistics.simulation.v1.AnalyzeSensitivityRequest
-	60,  // 151: logistics.simulation.v1.SimulationService.FindCriticalElements:input_type -> logistics.simulation.v1.FindCriticalElementsRequest
-	65,  // 152: logistics.simulation.v1.SimulationService.SimulateFailures:input_type -> logistics.simulation.v1.SimulateFailuresRequest
-	72,  // 153: logistics.simulation.v1.SimulationService.AnalyzeResilience:input_type -> logistics.simulation.v1.AnalyzeResilienceRequest
-	80,  // 154: logistics.simulation.v1.SimulationService.SaveSimulation:input_type -> logistics.simulation.v1.SaveSimulationRequest
-	82,  // 155: logistics.simulation.v1.SimulationService.GetSimulation:input_type -> logistics.simulation.v1.GetSimulationRequest
-	84,  // 156: logistics.simulation.v1.SimulationService.ListSimulations:input_type -> logistics.simulation.v1.ListSimulationsRequest
-	89,  // 157: logistics.simulation.v1.SimulationService.Health:input_type -> logistics.simulation.v1.HealthRequest
-	19,  // 158: logistics.simulation.v1.SimulationService.RunWhatIf:output_type -> logistics.simulation.v1.RunWhatIfResponse
-	26,  // 159: logistics.simulation.v1.SimulationService.CompareScenarios:output_type -> logistics.simulation.v1.CompareScenariosResponse
-	34,  // 160: logistics.simulation.v1.SimulationService.RunTimeSimulation:output_type -> logistics.simulation.v1.RunTimeSimulationResponse
-	39,  // 161: logistics.simulation.v1.SimulationService.SimulatePeakLoad:output_type -> logistics.simulation.v1.SimulatePeakLoadResponse
-	45,  // 162: logistics.simulation.v1.SimulationService.RunMonteCarlo:output_type -> logistics.simulation.v1.RunMonteCarloResponse
-	51,  // 163: logistics.simulation.v1.SimulationService.RunMonteCarloStream:output_type -> logistics.simulation.v1.MonteCarloProgress
-	55,  // 164: logistics.simulation.v1.SimulationService.AnalyzeSensitivity:output_type -> logistics.simulation.v1.AnalyzeSensitivityResponse
-	62,  // 165: logistics.simulation.v1.SimulationService.FindCriticalElements:output_type -> logistics.simulation.v1.FindCriticalElementsResponse
-	68,  // 166: logistics.simulation.v1.SimulationService.SimulateFailures:output_type -> logistics.simulation.v1.SimulateFailuresResponse
-	74,  // 167: logistics.simulation.v1.SimulationService.AnalyzeResilience:output_type -> logistics.simulation.v1.AnalyzeResilienceResponse
-	81,  // 168: logistics.simulation.v1.SimulationService.SaveSimulation:output_type -> logistics.simulation.v1.SaveSimulationResponse
-	83,  // 169: logistics.simulation.v1.SimulationService.GetSimulation:output_type -> logistics.simulation.v1.GetSimulationResponse
-	85,  // 170: logistics.simulation.v1.SimulationService.ListSimulations:output_type -> logistics.simulation.v1.ListSimulationsResponse
-	90,  // 171: logistics.simulation.v1.SimulationService.Health:output_type -> logistics.simulation.v1.HealthResponse
-	158, // [158:172] is the sub-list for method output_type
-	144, // [144:158] is the sub-list for method input_type
-	144, // [144:144] is the sub-list for extension type_name
-	144, // [144:144] is the sub-list for extension extendee
-	0,   // [0:144] is the sub-list for field type_name
+	134, // 17: logistics.simulation.v1.CompareScenariosRequest.baseline_graph:type_name -> logistics.common.v1.Graph
+	31,  // 18: logistics.simulation.v1.CompareScenariosRequest.scenarios:type_name -> logistics.simulation.v1.Scenario
+	135, // 19: logistics.simulation.v1.CompareScenariosRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	32,  // 20: logistics.simulation.v1.CompareScenariosRequest.options:type_name -> logistics.simulation.v1.CompareOptions
+	24,  // 21: logistics.simulation.v1.Scenario.modifications:type_name -> logistics.simulation.v1.Modification
+	27,  // 22: logistics.simulation.v1.CompareScenariosResponse.baseline:type_name -> logistics.simulation.v1.ScenarioResult
+	34,  // 23: logistics.simulation.v1.CompareScenariosResponse.ranked_scenarios:type_name -> logistics.simulation.v1.ScenarioResultWithRank
+	125, // 24: logistics.simulation.v1.CompareScenariosResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	27,  // 25: logistics.simulation.v1.ScenarioResultWithRank.result:type_name -> logistics.simulation.v1.ScenarioResult
+	28,  // 26: logistics.simulation.v1.ScenarioResultWithRank.vs_baseline:type_name -> logistics.simulation.v1.ScenarioComparison
+	134, // 27: logistics.simulation.v1.OptimizeCapacityExpansionRequest.graph:type_name -> logistics.common.v1.Graph
+	135, // 28: logistics.simulation.v1.OptimizeCapacityExpansionRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	36,  // 29: logistics.simulation.v1.OptimizeCapacityExpansionRequest.upgrades:type_name -> logistics.simulation.v1.EdgeUpgradeOption
+	36,  // 30: logistics.simulation.v1.OptimizeCapacityExpansionRequest.default_upgrade:type_name -> logistics.simulation.v1.EdgeUpgradeOption
+	136, // 31: logistics.simulation.v1.EdgeUpgradeOption.edge:type_name -> logistics.common.v1.EdgeKey
+	38,  // 32: logistics.simulation.v1.OptimizeCapacityExpansionResponse.increments:type_name -> logistics.simulation.v1.CapacityIncrement
+	39,  // 33: logistics.simulation.v1.OptimizeCapacityExpansionResponse.steps:type_name -> logistics.simulation.v1.ExpansionStep
+	134, // 34: logistics.simulation.v1.OptimizeCapacityExpansionResponse.expanded_graph:type_name -> logistics.common.v1.Graph
+	4,   // 35: logistics.simulation.v1.OptimizeCapacityExpansionResponse.stop_reason:type_name -> logistics.simulation.v1.ExpansionStopReason
+	125, // 36: logistics.simulation.v1.OptimizeCapacityExpansionResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	136, // 37: logistics.simulation.v1.CapacityIncrement.edge:type_name -> logistics.common.v1.EdgeKey
+	38,  // 38: logistics.simulation.v1.ExpansionStep.increments:type_name -> logistics.simulation.v1.CapacityIncrement
+	134, // 39: logistics.simulation.v1.OptimizeFacilityLocationRequest.graph:type_name -> logistics.common.v1.Graph
+	138, // 40: logistics.simulation.v1.OptimizeFacilityLocationRequest.fixed_costs:type_name -> logistics.analytics.v1.FixedCostConfig
+	42,  // 41: logistics.simulation.v1.OptimizeFacilityLocationResponse.facilities:type_name -> logistics.simulation.v1.FacilityDecision
+	43,  // 42: logistics.simulation.v1.OptimizeFacilityLocationResponse.moves:type_name -> logistics.simulation.v1.FacilityMove
+	104, // 43: logistics.simulation.v1.OptimizeFacilityLocationResponse.recommendations:type_name -> logistics.simulation.v1.ResilienceRecommendation
+	134, // 44: logistics.simulation.v1.OptimizeFacilityLocationResponse.solved_graph:type_name -> logistics.common.v1.Graph
+	125, // 45: logistics.simulation.v1.OptimizeFacilityLocationResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	5,   // 46: logistics.simulation.v1.FacilityMove.type:type_name -> logistics.simulation.v1.FacilityMoveType
+	134, // 47: logistics.simulation.v1.RunTimeSimulationRequest.graph:type_name -> logistics.common.v1.Graph
+	45,  // 48: logistics.simulation.v1.RunTimeSimulationRequest.time_config:type_name -> logistics.simulation.v1.TimeSimulationConfig
+	47,  // 49: logistics.simulation.v1.RunTimeSimulationRequest.edge_patterns:type_name -> logistics.simulation.v1.EdgeTimePattern
+	48,  // 50: logistics.simulation.v1.RunTimeSimulationRequest.node_patterns:type_name -> logistics.simulation.v1.NodeTimePattern
+	135, // 51: logistics.simulation.v1.RunTimeSimulationRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	139, // 52: logistics.simulation.v1.TimeSimulationConfig.start_time:type_name -> google.protobuf.Timestamp
+	139, // 53: logistics.simulation.v1.TimeSimulationConfig.end_time:type_name -> google.protobuf.Timestamp
+	7,   // 54: logistics.simulation.v1.TimeSimulationConfig.time_step:type_name -> logistics.simulation.v1.TimeStep
+	6,   // 55: logistics.simulation.v1.TimeSimulationConfig.mode:type_name -> logistics.simulation.v1.TimeSimulationMode
+	46,  // 56: logistics.simulation.v1.TimeSimulationConfig.road_speeds:type_name -> logistics.simulation.v1.RoadTypeSpeed
+	140, // 57: logistics.simulation.v1.RoadTypeSpeed.road_type:type_name -> logistics.common.v1.RoadType
+	136, // 58: logistics.simulation.v1.EdgeTimePattern.edge:type_name -> logistics.common.v1.EdgeKey
+	49,  // 59: logistics.simulation.v1.EdgeTimePattern.pattern:type_name -> logistics.simulation.v1.TimePattern
+	49,  // 60: logistics.simulation.v1.NodeTimePattern.pattern:type_name -> logistics.simulation.v1.TimePattern
+	8,   // 61: logistics.simulation.v1.NodeTimePattern.target:type_name -> logistics.simulation.v1.PatternTarget
+	9,   // 62: logistics.simulation.v1.TimePattern.type:type_name -> logistics.simulation.v1.PatternType
+	50,  // 63: logistics.simulation.v1.TimePattern.custom_points:type_name -> logistics.simulation.v1.TimePoint
+	52,  // 64: logistics.simulation.v1.RunTimeSimulationResponse.step_results:type_name -> logistics.simulation.v1.TimeStepResult
+	57,  // 65: logistics.simulation.v1.RunTimeSimulationResponse.stats:type_name -> logistics.simulation.v1.TimeSimulationStats
+	58,  // 66: logistics.simulation.v1.RunTimeSimulationResponse.critical_periods:type_name -> logistics.simulation.v1.CriticalPeriod
+	125, // 67: logistics.simulation.v1.RunTimeSimulationResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	55,  // 68: logistics.simulation.v1.RunTimeSimulationResponse.dynamic_flow:type_name -> logistics.simulation.v1.DynamicFlowSummary
+	53,  // 69: logistics.simulation.v1.RunTimeSimulationResponse.service_levels:type_name -> logistics.simulation.v1.ServiceLevel
+	139, // 70: logistics.simulation.v1.TimeStepResult.timestamp:type_name -> google.protobuf.Timestamp
+	136, // 71: logistics.simulation.v1.TimeStepResult.bottlenecks:type_name -> logistics.common.v1.EdgeKey
+	54,  // 72: logistics.simulation.v1.TimeStepResult.inventory:type_name -> logistics.simulation.v1.NodeInventory
+	141, // 73: logistics.simulation.v1.TimeStepResult.node_balances:type_name -> logistics.common.v1.NodeBalance
+	56,  // 74: logistics.simulation.v1.DynamicFlowSummary.transit_times:type_name -> logistics.simulation.v1.EdgeTransitTime
+	136, // 75: logistics.simulation.v1.EdgeTransitTime.edge:type_name -> logistics.common.v1.EdgeKey
+	139, // 76: logistics.simulation.v1.CriticalPeriod.start_time:type_name -> google.protobuf.Timestamp
+	139, // 77: logistics.simulation.v1.CriticalPeriod.end_time:type_name -> google.protobuf.Timestamp
+	10,  // 78: logistics.simulation.v1.CriticalPeriod.type:type_name -> logistics.simulation.v1.CriticalPeriodType
+	134, // 79: logistics.simulation.v1.SimulatePeakLoadRequest.graph:type_name -> logistics.common.v1.Graph
+	136, // 80: logistics.simulation.v1.SimulatePeakLoadRequest.affected_edges:type_name -> logistics.common.v1.EdgeKey
+	135, // 81: logistics.simulation.v1.SimulatePeakLoadRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	27,  // 82: logistics.simulation.v1.SimulatePeakLoadResponse.normal_result:type_name -> logistics.simulation.v1.ScenarioResult
+	27,  // 83: logistics.simulation.v1.SimulatePeakLoadResponse.peak_result:type_name -> logistics.simulation.v1.ScenarioResult
+	28,  // 84: logistics.simulation.v1.SimulatePeakLoadResponse.comparison:type_name -> logistics.simulation.v1.ScenarioComparison
+	61,  // 85: logistics.simulation.v1.SimulatePeakLoadResponse.overloaded_edges:type_name -> logistics.simulation.v1.OverloadedEdge
+	125, // 86: logistics.simulation.v1.SimulatePeakLoadResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	136, // 87: logistics.simulation.v1.OverloadedEdge.edge:type_name -> logistics.common.v1.EdgeKey
+	134, // 88: logistics.simulation.v1.RunDiscreteEventSimulationRequest.solved_graph:type_name -> logistics.common.v1.Graph
+	63,  // 89: logistics.simulation.v1.RunDiscreteEventSimulationRequest.config:type_name -> logistics.simulation.v1.DiscreteEventConfig
+	46,  // 90: logistics.simulation.v1.DiscreteEventConfig.road_speeds:type_name -> logistics.simulation.v1.RoadTypeSpeed
+	74,  // 91: logistics.simulation.v1.DiscreteEventConfig.travel_delay:type_name -> logistics.simulation.v1.Distribution
+	65,  // 92: logistics.simulation.v1.RunDiscreteEventSimulationResponse.stats:type_name -> logistics.simulation.v1.DiscreteEventStats
+	77,  // 93: logistics.simulation.v1.RunDiscreteEventSimulationResponse.lead_time_stats:type_name -> logistics.simulation.v1.MonteCarloStats
+	78,  // 94: logistics.simulation.v1.RunDiscreteEventSimulationResponse.lead_time_histogram:type_name -> logistics.simulation.v1.HistogramBucket
+	128, // 95: logistics.simulation.v1.RunDiscreteEventSimulationResponse.lead_time_percentiles:type_name -> logistics.simulation.v1.RunDiscreteEventSimulationResponse.LeadTimePercentilesEntry
+	66,  // 96: logistics.simulation.v1.RunDiscreteEventSimulationResponse.node_queues:type_name -> logistics.simulation.v1.NodeQueueStats
+	67,  // 97: logistics.simulation.v1.RunDiscreteEventSimulationResponse.throughput:type_name -> logistics.simulation.v1.ThroughputPoint
+	68,  // 98: logistics.simulation.v1.RunDiscreteEventSimulationResponse.routes:type_name -> logistics.simulation.v1.ShipmentRoute
+	125, // 99: logistics.simulation.v1.RunDiscreteEventSimulationResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	134, // 100: logistics.simulation.v1.RunMonteCarloRequest.graph:type_name -> logistics.common.v1.Graph
+	72,  // 101: logistics.simulation.v1.RunMonteCarloRequest.config:type_name -> logistics.simulation.v1.MonteCarloConfig
+	73,  // 102: logistics.simulation.v1.RunMonteCarloRequest.uncertainties:type_name -> logistics.simulation.v1.UncertaintySpec
+	135, // 103: logistics.simulation.v1.RunMonteCarloRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	70,  // 104: logistics.simulation.v1.RunMonteCarloRequest.correlation:type_name -> logistics.simulation.v1.UncertaintyCorrelation
+	11,  // 105: logistics.simulation.v1.UncertaintyCorrelation.measure:type_name -> logistics.simulation.v1.CorrelationMeasure
+	71,  // 106: logistics.simulation.v1.UncertaintyCorrelation.groups:type_name -> logistics.simulation.v1.CorrelationGroup
+	13,  // 107: logistics.simulation.v1.MonteCarloConfig.sampling_method:type_name -> logistics.simulation.v1.SamplingMethod
+	14,  // 108: logistics.simulation.v1.UncertaintySpec.type:type_name -> logistics.simulation.v1.UncertaintyType
+	136, // 109: logistics.simulation.v1.UncertaintySpec.edge:type_name -> logistics.common.v1.EdgeKey
+	1,   // 110: logistics.simulation.v1.UncertaintySpec.target:type_name -> logistics.simulation.v1.ModificationTarget
+	74,  // 111: logistics.simulation.v1.UncertaintySpec.distribution:type_name -> logistics.simulation.v1.Distribution
+	15,  // 112: logistics.simulation.v1.Distribution.type:type_name -> logistics.simulation.v1.DistributionType
+	77,  // 113: logistics.simulation.v1.RunMonteCarloResponse.flow_stats:type_name -> logistics.simulation.v1.MonteCarloStats
+	77,  // 114: logistics.simulation.v1.RunMonteCarloResponse.cost_stats:type_name -> logistics.simulation.v1.MonteCarloStats
+	78,  // 115: logistics.simulation.v1.RunMonteCarloResponse.flow_histogram:type_name -> logistics.simulation.v1.HistogramBucket
+	78,  // 116: logistics.simulation.v1.RunMonteCarloResponse.cost_histogram:type_name -> logistics.simulation.v1.HistogramBucket
+	129, // 117: logistics.simulation.v1.RunMonteCarloResponse.flow_percentiles:type_name -> logistics.simulation.v1.RunMonteCarloResponse.FlowPercentilesEntry
+	130, // 118: logistics.simulation.v1.RunMonteCarloResponse.cost_percentiles:type_name -> logistics.simulation.v1.RunMonteCarloResponse.CostPercentilesEntry
+	79,  // 119: logistics.simulation.v1.RunMonteCarloResponse.risk_analysis:type_name -> logistics.simulation.v1.RiskAnalysis
+	81,  // 120: logistics.simulation.v1.RunMonteCarloResponse.correlations:type_name -> logistics.simulation.v1.ParameterCorrelation
+	125, // 121: logistics.simulation.v1.RunMonteCarloResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	76,  // 122: logistics.simulation.v1.RunMonteCarloResponse.samples:type_name -> logistics.simulation.v1.MonteCarloSample
+	12,  // 123: logistics.simulation.v1.RunMonteCarloResponse.stop_reason:type_name -> logistics.simulation.v1.MonteCarloStopReason
+	80,  // 124: logistics.simulation.v1.RiskAnalysis.risk_scenarios:type_name -> logistics.simulation.v1.RiskScenario
+	75,  // 125: logistics.simulation.v1.MonteCarloProgress.result:type_name -> logistics.simulation.v1.RunMonteCarloResponse
+	134, // 126: logistics.simulation.v1.AnalyzeSensitivityRequest.graph:type_name -> logistics.common.v1.Graph
+	84,  // 127: logistics.simulation.v1.AnalyzeSensitivityRequest.parameters:type_name -> logistics.simulation.v1.SensitivityParameter
+	85,  // 128: logistics.simulation.v1.AnalyzeSensitivityRequest.config:type_name -> logistics.simulation.v1.SensitivityConfig
+	135, // 129: logistics.simulation.v1.AnalyzeSensitivityRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	136, // 130: logistics.simulation.v1.SensitivityParameter.edge:type_name -> logistics.common.v1.EdgeKey
+	1,   // 131: logistics.simulation.v1.SensitivityParameter.target:type_name -> logistics.simulation.v1.ModificationTarget
+	16,  // 132: logistics.simulation.v1.SensitivityConfig.method:type_name -> logistics.simulation.v1.SensitivityMethod
+	87,  // 133: logistics.simulation.v1.AnalyzeSensitivityResponse.parameter_results:type_name -> logistics.simulation.v1.SensitivityResult
+	91,  // 134: logistics.simulation.v1.AnalyzeSensitivityResponse.rankings:type_name -> logistics.simulation.v1.ParameterRanking
+	92,  // 135: logistics.simulation.v1.AnalyzeSensitivityResponse.thresholds:type_name -> logistics.simulation.v1.ThresholdPoint
+	125, // 136: logistics.simulation.v1.AnalyzeSensitivityResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	90,  // 137: logistics.simulation.v1.SensitivityResult.curve:type_name -> logistics.simulation.v1.SensitivityPoint
+	17,  // 138: logistics.simulation.v1.SensitivityResult.level:type_name -> logistics.simulation.v1.SensitivityLevel
+	88,  // 139: logistics.simulation.v1.SensitivityResult.morris:type_name -> logistics.simulation.v1.MorrisIndices
+	89,  // 140: logistics.simulation.v1.SensitivityResult.sobol:type_name -> logistics.simulation.v1.SobolIndices
+	18,  // 141: logistics.simulation.v1.ThresholdPoint.type:type_name -> logistics.simulation.v1.ThresholdType
+	134, // 142: logistics.simulation.v1.FindCriticalElementsRequest.graph:type_name -> logistics.common.v1.Graph
+	94,  // 143: logistics.simulation.v1.FindCriticalElementsRequest.config:type_name -> logistics.simulation.v1.CriticalElementsConfig
+	135, // 144: logistics.simulation.v1.FindCriticalElementsRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	96,  // 145: logistics.simulation.v1.FindCriticalElementsResponse.critical_edges:type_name -> logistics.simulation.v1.CriticalEdge
+	97,  // 146: logistics.simulation.v1.FindCriticalElementsResponse.critical_nodes:type_name -> logistics.simulation.v1.CriticalNode
+	136, // 147: logistics.simulation.v1.FindCriticalElementsResponse.single_points_of_failure:type_name -> logistics.common.v1.EdgeKey
+	125, // 148: logistics.simulation.v1.FindCriticalElementsResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	136, // 149: logistics.simulation.v1.CriticalEdge.edge:type_name -> logistics.common.v1.EdgeKey
+	134, // 150: logistics.simulation.v1.SimulateFailuresRequest.graph:type_name -> logistics.common.v1.Graph
+	99,  // 151: logistics.simulation.v1.SimulateFailuresRequest.failure_scenarios:type_name -> logistics.simulation.v1.FailureScenario
+	100, // 152: logistics.simulation.v1.SimulateFailuresRequest.random_config:type_name -> logistics.simulation.v1.RandomFailureConfig
+	135, // 153: logistics.simulation.v1.SimulateFailuresRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	136, // 154: logistics.simulation.v1.FailureScenario.failed_edges:type_name -> logistics.common.v1.EdgeKey
+	19,  // 155: logistics.simulation.v1.RandomFailureConfig.correlation:type_name -> logistics.simulation.v1.FailureCorrelation
+	27,  // 156: logistics.simulation.v1.SimulateFailuresResponse.baseline:type_name -> logistics.simulation.v1.ScenarioResult
+	102, // 157: logistics.simulation.v1.SimulateFailuresResponse.scenario_results:type_name -> logistics.simulation.v1.FailureScenarioResult
+	103, // 158: logistics.simulation.v1.SimulateFailuresResponse.stats:type_name -> logistics.simulation.v1.FailureStats
+	104, // 159: logistics.simulation.v1.SimulateFailuresResponse.recommendations:type_name -> logistics.simulation.v1.ResilienceRecommendation
+	125, // 160: logistics.simulation.v1.SimulateFailuresResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	27,  // 161: logistics.simulation.v1.FailureScenarioResult.result:type_name -> logistics.simulation.v1.ScenarioResult
+	28,  // 162: logistics.simulation.v1.FailureScenarioResult.vs_baseline:type_name -> logistics.simulation.v1.ScenarioComparison
+	20,  // 163: logistics.simulation.v1.ResilienceRecommendation.type:type_name -> logistics.simulation.v1.RecommendationType
+	136, // 164: logistics.simulation.v1.ResilienceRecommendation.affected_edge:type_name -> logistics.common.v1.EdgeKey
+	134, // 165: logistics.simulation.v1.AnalyzeResilienceRequest.graph:type_name -> logistics.common.v1.Graph
+	106, // 166: logistics.simulation.v1.AnalyzeResilienceRequest.config:type_name -> logistics.simulation.v1.ResilienceConfig
+	135, // 167: logistics.simulation.v1.AnalyzeResilienceRequest.algorithm:type_name -> logistics.common.v1.Algorithm
+	108, // 168: logistics.simulation.v1.AnalyzeResilienceResponse.metrics:type_name -> logistics.simulation.v1.ResilienceMetrics
+	109, // 169: logistics.simulation.v1.AnalyzeResilienceResponse.n_minus_one:type_name -> logistics.simulation.v1.NMinusOneAnalysis
+	110, // 170: logistics.simulation.v1.AnalyzeResilienceResponse.n_minus_two:type_name -> logistics.simulation.v1.NMinusTwoAnalysis
+	116, // 171: logistics.simulation.v1.AnalyzeResilienceResponse.weaknesses:type_name -> logistics.simulation.v1.ResilienceWeakness
+	125, // 172: logistics.simulation.v1.AnalyzeResilienceResponse.metadata:type_name -> logistics.simulation.v1.SimulationMetadata
+	113, // 173: logistics.simulation.v1.AnalyzeResilienceResponse.cascade:type_name -> logistics.simulation.v1.CascadeAnalysis
+	136, // 174: logistics.simulation.v1.NMinusOneAnalysis.most_critical_edge:type_name -> logistics.common.v1.EdgeKey
+	111, // 175: logistics.simulation.v1.NMinusTwoAnalysis.critical_edge_pairs:type_name -> logistics.simulation.v1.EdgePair
+	112, // 176: logistics.simulation.v1.NMinusTwoAnalysis.critical_edge_sets:type_name -> logistics.simulation.v1.EdgeSet
+	136, // 177: logistics.simulation.v1.EdgePair.edge1:type_name -> logistics.common.v1.EdgeKey
+	136, // 178: logistics.simulation.v1.EdgePair.edge2:type_name -> logistics.common.v1.EdgeKey
+	136, // 179: logistics.simulation.v1.EdgeSet.edges:type_name -> logistics.common.v1.EdgeKey
+	114, // 180: logistics.simulation.v1.CascadeAnalysis.scenarios:type_name -> logistics.simulation.v1.CascadeScenario
+	136, // 181: logistics.simulation.v1.CascadeScenario.initial_failure:type_name -> logistics.common.v1.EdgeKey
+	115, // 182: logistics.simulation.v1.CascadeScenario.steps:type_name -> logistics.simulation.v1.CascadeStep
+	136, // 183: logistics.simulation.v1.CascadeStep.failed_edges:type_name -> logistics.common.v1.EdgeKey
+	21,  // 184: logistics.simulation.v1.ResilienceWeakness.type:type_name -> logistics.simulation.v1.WeaknessType
+	136, // 185: logistics.simulation.v1.ResilienceWeakness.affected_edges:type_name -> logistics.common.v1.EdgeKey
+	22,  // 186: logistics.simulation.v1.SaveSimulationRequest.type:type_name -> logistics.simulation.v1.SimulationType
+	134, // 187: logistics.simulation.v1.SaveSimulationRequest.graph:type_name -> logistics.common.v1.Graph
+	131, // 188: logistics.simulation.v1.SaveSimulationRequest.tags:type_name -> logistics.simulation.v1.SaveSimulationRequest.TagsEntry
+	139, // 189: logistics.simulation.v1.SaveSimulationResponse.created_at:type_name -> google.protobuf.Timestamp
+	123, // 190: logistics.simulation.v1.GetSimulationResponse.record:type_name -> logistics.simulation.v1.SimulationRecord
+	22,  // 191: logistics.simulation.v1.ListSimulationsRequest.type:type_name -> logistics.simulation.v1.SimulationType
+	142, // 192: logistics.simulation.v1.ListSimulationsRequest.pagination:type_name -> logistics.common.v1.PaginationRequest
+	124, // 193: logistics.simulation.v1.ListSimulationsResponse.simulations:type_name -> logistics.simulation.v1.SimulationSummary
+	143, // 194: logistics.simulation.v1.ListSimulationsResponse.pagination:type_name -> logistics.common.v1.PaginationResponse
+	22,  // 195: logistics.simulation.v1.SimulationRecord.type:type_name -> logistics.simulation.v1.SimulationType
+	139, // 196: logistics.simulation.v1.SimulationRecord.created_at:type_name -> google.protobuf.Timestamp
+	132, // 197: logistics.simulation.v1.SimulationRecord.tags:type_name -> logistics.simulation.v1.SimulationRecord.TagsEntry
+	22,  // 198: logistics.simulation.v1.SimulationSummary.type:type_name -> logistics.simulation.v1.SimulationType
+	139, // 199: logistics.simulation.v1.SimulationSummary.created_at:type_name -> google.protobuf.Timestamp
+	133, // 200: logistics.simulation.v1.SimulationSummary.tags:type_name -> logistics.simulation.v1.SimulationSummary.TagsEntry
+	139, // 201: logistics.simulation.v1.SimulationMetadata.completed_at:type_name -> google.protobuf.Timestamp
+	23,  // 202: logistics.simulation.v1.SimulationService.RunWhatIf:input_type -> logistics.simulation.v1.RunWhatIfRequest
+	30,  // 203: logistics.simulation.v1.SimulationService.CompareScenarios:input_type -> logistics.simulation.v1.CompareScenariosRequest
+	35,  // 204: logistics.simulation.v1.SimulationService.OptimizeCapacityExpansion:input_type -> logistics.simulation.v1.OptimizeCapacityExpansionRequest
+	40,  // 205: logistics.simulation.v1.SimulationService.OptimizeFacilityLocation:input_type -> logistics.simulation.v1.OptimizeFacilityLocationRequest
+	44,  // 206: logistics.simulation.v1.SimulationService.RunTimeSimulation:input_type -> logistics.simulation.v1.RunTimeSimulationRequest
+	59,  // 207: logistics.simulation.v1.SimulationService.SimulatePeakLoad:input_type -> logistics.simulation.v1.SimulatePeakLoadRequest
+	62,  // 208: logistics.simulation.v1.SimulationService.RunDiscreteEventSimulation:input_type -> logistics.simulation.v1.RunDiscreteEventSimulationRequest
+	69,  // 209: logistics.simulation.v1.SimulationService.RunMonteCarlo:input_type -> logistics.simulation.v1.RunMonteCarloRequest
+	69,  // 210: logistics.simulation.v1.SimulationService.RunMonteCarloStream:input_type -> logistics.simulation.v1.RunMonteCarloRequest
+	83,  // 211: logistics.simulation.v1.SimulationService.AnalyzeSensitivity:input_type -> logistics.simulation.v1.AnalyzeSensitivityRequest
+	93,  // 212: logistics.simulation.v1.SimulationService.FindCriticalElements:input_type -> logistics.simulation.v1.FindCriticalElementsRequest
+	98,  // 213: logistics.simulation.v1.SimulationService.SimulateFailures:input_type -> logistics.simulation.v1.SimulateFailuresRequest
+	105, // 214: logistics.simulation.v1.SimulationService.AnalyzeResilience:input_type -> logistics.simulation.v1.AnalyzeResilienceRequest
+	117, // 215: logistics.simulation.v1.SimulationService.SaveSimulation:input_type -> logistics.simulation.v1.SaveSimulationRequest
+	119, // 216: logistics.simulation.v1.SimulationService.GetSimulation:input_type -> logistics.simulation.v1.GetSimulationRequest
+	121, // 217: logistics.simulation.v1.SimulationService.ListSimulations:input_type -> logistics.simulation.v1.ListSimulationsRequest
+	126, // 218: logistics.simulation.v1.SimulationService.Health:input_type -> logistics.simulation.v1.HealthRequest
+	26,  // 219: logistics.simulation.v1.SimulationService.RunWhatIf:output_type -> logistics.simulation.v1.RunWhatIfResponse
+	33,  // 220: logistics.simulation.v1.SimulationService.CompareScenarios:output_type -> logistics.simulation.v1.CompareScenariosResponse
+	37,  // 221: logistics.simulation.v1.SimulationService.OptimizeCapacityExpansion:output_type -> logistics.simulation.v1.OptimizeCapacityExpansionResponse
+	41,  // 222: logistics.simulation.v1.SimulationService.OptimizeFacilityLocation:output_type -> logistics.simulation.v1.OptimizeFacilityLocationResponse
+	51,  // 223: logistics.simulation.v1.SimulationService.RunTimeSimulation:output_type -> logistics.simulation.v1.RunTimeSimulationResponse
+	60,  // 224: logistics.simulation.v1.SimulationService.SimulatePeakLoad:output_type -> logistics.simulation.v1.SimulatePeakLoadResponse
+	64,  // 225: logistics.simulation.v1.SimulationService.RunDiscreteEventSimulation:output_type -> logistics.simulation.v1.RunDiscreteEventSimulationResponse
+	75,  // 226: logistics.simulation.v1.SimulationService.RunMonteCarlo:output_type -> logistics.simulation.v1.RunMonteCarloResponse
+	82,  // 227: logistics.simulation.v1.SimulationService.RunMonteCarloStream:output_type -> logistics.simulation.v1.MonteCarloProgress
+	86,  // 228: logistics.simulation.v1.SimulationService.AnalyzeSensitivity:output_type -> logistics.simulation.v1.AnalyzeSensitivityResponse
+	95,  // 229: logistics.simulation.v1.SimulationService.FindCriticalElements:output_type -> logistics.simulation.v1.FindCriticalElementsResponse
+	101, // 230: logistics.simulation.v1.SimulationService.SimulateFailures:output_type -> logistics.simulation.v1.SimulateFailuresResponse
+	107, // 231: logistics.simulation.v1.SimulationService.AnalyzeResilience:output_type -> logistics.simulation.v1.AnalyzeResilienceResponse
+	118, // 232: logistics.simulation.v1.SimulationService.SaveSimulation:output_type -> logistics.simulation.v1.SaveSimulationResponse
+	120, // 233: logistics.simulation.v1.SimulationService.GetSimulation:output_type -> logistics.simulation.v1.GetSimulationResponse
+	122, // 234: logistics.simulation.v1.SimulationService.ListSimulations:output_type -> logistics.simulation.v1.ListSimulationsResponse
+	127, // 235: logistics.simulation.v1.SimulationService.Health:output_type -> logistics.simulation.v1.HealthResponse
+	219, // [219:236] is the sub-list for method output_type
+	202, // [202:219] is the sub-list for method input_type
+	202, // [202:202] is the sub-list for extension type_name
+	202, // [202:202] is the sub-list for extension extendee
+	0,   // [0:202] is the sub-list for field type_name
 }
 
 func init() { file_logistics_simulation_v1_simulation_proto_init() }
@@ -7601,8 +11379,8 @@ func file_logistics_simulation_v1_simulation_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_logistics_simulation_v1_simulation_proto_rawDesc), len(file_logistics_simulation_v1_simulation_proto_rawDesc)),
-			NumEnums:      16,
-			NumMessages:   80,
+			NumEnums:      23,
+			NumMessages:   111,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,20 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SimulationService_RunWhatIf_FullMethodName            = "/logistics.simulation.v1.SimulationService/RunWhatIf"
-	SimulationService_CompareScenarios_FullMethodName     = "/logistics.simulation.v1.SimulationService/CompareScenarios"
-	SimulationService_RunTimeSimulation_FullMethodName    = "/logistics.simulation.v1.SimulationService/RunTimeSimulation"
-	SimulationService_SimulatePeakLoad_FullMethodName     = "/logistics.simulation.v1.SimulationService/SimulatePeakLoad"
-	SimulationService_RunMonteCarlo_FullMethodName        = "/logistics.simulation.v1.SimulationService/RunMonteCarlo"
-	SimulationService_RunMonteCarloStream_FullMethodName  = "/logistics.simulation.v1.SimulationService/RunMonteCarloStream"
-	SimulationService_AnalyzeSensitivity_FullMethodName   = "/logistics.simulation.v1.SimulationService/AnalyzeSensitivity"
-	SimulationService_FindCriticalElements_FullMethodName = "/logistics.simulation.v1.SimulationService/FindCriticalElements"
-	SimulationService_SimulateFailures_FullMethodName     = "/logistics.simulation.v1.SimulationService/SimulateFailures"
-	SimulationService_AnalyzeResilience_FullMethodName    = "/logistics.simulation.v1.SimulationService/AnalyzeResilience"
-	SimulationService_SaveSimulation_FullMethodName       = "/logistics.simulation.v1.SimulationService/SaveSimulation"
-	SimulationService_GetSimulation_FullMethodName        = "/logistics.simulation.v1.SimulationService/GetSimulation"
-	SimulationService_ListSimulations_FullMethodName      = "/logistics.simulation.v1.SimulationService/ListSimulations"
-	SimulationService_Health_FullMethodName               = "/logistics.simulation.v1.SimulationService/Health"
+	SimulationService_RunWhatIf_FullMethodName                  = "/logistics.simulation.v1.SimulationService/RunWhatIf"
+	SimulationService_CompareScenarios_FullMethodName           = "/logistics.simulation.v1.SimulationService/CompareScenarios"
+	SimulationService_OptimizeCapacityExpansion_FullMethodName  = "/logistics.simulation.v1.SimulationService/OptimizeCapacityExpansion"
+	SimulationService_OptimizeFacilityLocation_FullMethodName   = "/logistics.simulation.v1.SimulationService/OptimizeFacilityLocation"
+	SimulationService_RunTimeSimulation_FullMethodName          = "/logistics.simulation.v1.SimulationService/RunTimeSimulation"
+	SimulationService_SimulatePeakLoad_FullMethodName           = "/logistics.simulation.v1.SimulationService/SimulatePeakLoad"
+	SimulationService_RunDiscreteEventSimulation_FullMethodName = "/logistics.simulation.v1.SimulationService/RunDiscreteEventSimulation"
+	SimulationService_RunMonteCarlo_FullMethodName              = "/logistics.simulation.v1.SimulationService/RunMonteCarlo"
+	SimulationService_RunMonteCarloStream_FullMethodName        = "/logistics.simulation.v1.SimulationService/RunMonteCarloStream"
+	SimulationService_AnalyzeSensitivity_FullMethodName         = "/logistics.simulation.v1.SimulationService/AnalyzeSensitivity"
+	SimulationService_FindCriticalElements_FullMethodName       = "/logistics.simulation.v1.SimulationService/FindCriticalElements"
+	SimulationService_SimulateFailures_FullMethodName           = "/logistics.simulation.v1.SimulationService/SimulateFailures"
+	SimulationService_AnalyzeResilience_FullMethodName          = "/logistics.simulation.v1.SimulationService/AnalyzeResilience"
+	SimulationService_SaveSimulation_FullMethodName             = "/logistics.simulation.v1.SimulationService/SaveSimulation"
+	SimulationService_GetSimulation_FullMethodName              = "/logistics.simulation.v1.SimulationService/GetSimulation"
+	SimulationService_ListSimulations_FullMethodName            = "/logistics.simulation.v1.SimulationService/ListSimulations"
+	SimulationService_Health_FullMethodName                     = "/logistics.simulation.v1.SimulationService/Health"
 )
 
 // SimulationServiceClient is the client API for SimulationService service.
@@ -43,10 +46,16 @@ type SimulationServiceClient interface {
 	RunWhatIf(ctx context.Context, in *RunWhatIfRequest, opts ...grpc.CallOption) (*RunWhatIfResponse, error)
 	// Сравнение нескольких сценариев
 	CompareScenarios(ctx context.Context, in *CompareScenariosRequest, opts ...grpc.CallOption) (*CompareScenariosResponse, error)
+	// Выбор расширений пропускной способности в пределах бюджета
+	OptimizeCapacityExpansion(ctx context.Context, in *OptimizeCapacityExpansionRequest, opts ...grpc.CallOption) (*OptimizeCapacityExpansionResponse, error)
+	// Выбор складов, которые стоит открыть или закрыть
+	OptimizeFacilityLocation(ctx context.Context, in *OptimizeFacilityLocationRequest, opts ...grpc.CallOption) (*OptimizeFacilityLocationResponse, error)
 	// Симуляция с временными параметрами
 	RunTimeSimulation(ctx context.Context, in *RunTimeSimulationRequest, opts ...grpc.CallOption) (*RunTimeSimulationResponse, error)
 	// Симуляция пиковых нагрузок
 	SimulatePeakLoad(ctx context.Context, in *SimulatePeakLoadRequest, opts ...grpc.CallOption) (*SimulatePeakLoadResponse, error)
+	// Дискретно-событийная симуляция рейсов по решённому потоку
+	RunDiscreteEventSimulation(ctx context.Context, in *RunDiscreteEventSimulationRequest, opts ...grpc.CallOption) (*RunDiscreteEventSimulationResponse, error)
 	// Запуск Monte Carlo симуляции
 	RunMonteCarlo(ctx context.Context, in *RunMonteCarloRequest, opts ...grpc.CallOption) (*RunMonteCarloResponse, error)
 	// Streaming для долгих Monte Carlo симуляций
@@ -97,6 +106,26 @@ func (c *simulationServiceClient) CompareScenarios(ctx context.Context, in *Comp
 	return out, nil
 }
 
+func (c *simulationServiceClient) OptimizeCapacityExpansion(ctx context.Context, in *OptimizeCapacityExpansionRequest, opts ...grpc.CallOption) (*OptimizeCapacityExpansionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptimizeCapacityExpansionResponse)
+	err := c.cc.Invoke(ctx, SimulationService_OptimizeCapacityExpansion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) OptimizeFacilityLocation(ctx context.Context, in *OptimizeFacilityLocationRequest, opts ...grpc.CallOption) (*OptimizeFacilityLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptimizeFacilityLocationResponse)
+	err := c.cc.Invoke(ctx, SimulationService_OptimizeFacilityLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *simulationServiceClient) RunTimeSimulation(ctx context.Context, in *RunTimeSimulationRequest, opts ...grpc.CallOption) (*RunTimeSimulationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RunTimeSimulationResponse)
@@ -117,6 +146,16 @@ func (c *simulationServiceClient) SimulatePeakLoad(ctx context.Context, in *Simu
 	return out, nil
 }
 
+func (c *simulationServiceClient) RunDiscreteEventSimulation(ctx context.Context, in *RunDiscreteEventSimulationRequest, opts ...grpc.CallOption) (*RunDiscreteEventSimulationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunDiscreteEventSimulationResponse)
+	err := c.cc.Invoke(ctx, SimulationService_RunDiscreteEventSimulation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *simulationServiceClient) RunMonteCarlo(ctx context.Context, in *RunMonteCarloRequest, opts ...grpc.CallOption) (*RunMonteCarloResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RunMonteCarloResponse)
@@ -234,10 +273,16 @@ type SimulationServiceServer interface {
 	RunWhatIf(context.Context, *RunWhatIfRequest) (*RunWhatIfResponse, error)
 	// Сравнение нескольких сценариев
 	CompareScenarios(context.Context, *CompareScenariosRequest) (*CompareScenariosResponse, error)
+	// Выбор расширений пропускной способности в пределах бюджета
+	OptimizeCapacityExpansion(context.Context, *OptimizeCapacityExpansionRequest) (*OptimizeCapacityExpansionResponse, error)
+	// Выбор складов, которые стоит открыть или закрыть
+	OptimizeFacilityLocation(context.Context, *OptimizeFacilityLocationRequest) (*OptimizeFacilityLocationResponse, error)
 	// Симуляция с временными параметрами
 	RunTimeSimulation(context.Context, *RunTimeSimulationRequest) (*RunTimeSimulationResponse, error)
 	// Симуляция пиковых нагрузок
 	SimulatePeakLoad(context.Context, *SimulatePeakLoadRequest) (*SimulatePeakLoadResponse, error)
+	// Дискретно-событийная симуляция рейсов по решённому потоку
+	RunDiscreteEventSimulation(context.Context, *RunDiscreteEventSimulationRequest) (*RunDiscreteEventSimulationResponse, error)
 	// Запуск Monte Carlo симуляции
 	RunMonteCarlo(context.Context, *RunMonteCarloRequest) (*RunMonteCarloResponse, error)
 	// Streaming для долгих Monte Carlo симуляций
@@ -274,12 +319,21 @@ func (UnimplementedSimulationServiceServer) RunWhatIf(context.Context, *RunWhatI
 func (UnimplementedSimulationServiceServer) CompareScenarios(context.Context, *CompareScenariosRequest) (*CompareScenariosResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompareScenarios not implemented")
 }
+func (UnimplementedSimulationServiceServer) OptimizeCapacityExpansion(context.Context, *OptimizeCapacityExpansionRequest) (*OptimizeCapacityExpansionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OptimizeCapacityExpansion not implemented")
+}
+func (UnimplementedSimulationServiceServer) OptimizeFacilityLocation(context.Context, *OptimizeFacilityLocationRequest) (*OptimizeFacilityLocationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OptimizeFacilityLocation not implemented")
+}
 func (UnimplementedSimulationServiceServer) RunTimeSimulation(context.Context, *RunTimeSimulationRequest) (*RunTimeSimulationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RunTimeSimulation not implemented")
 }
 func (UnimplementedSimulationServiceServer) SimulatePeakLoad(context.Context, *SimulatePeakLoadRequest) (*SimulatePeakLoadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SimulatePeakLoad not implemented")
 }
+func (UnimplementedSimulationServiceServer) RunDiscreteEventSimulation(context.Context, *RunDiscreteEventSimulationRequest) (*RunDiscreteEventSimulationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunDiscreteEventSimulation not implemented")
+}
 func (UnimplementedSimulationServiceServer) RunMonteCarlo(context.Context, *RunMonteCarloRequest) (*RunMonteCarloResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RunMonteCarlo not implemented")
 }
@@ -367,6 +421,42 @@ func _SimulationService_CompareScenarios_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SimulationService_OptimizeCapacityExpansion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizeCapacityExpansionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).OptimizeCapacityExpansion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_OptimizeCapacityExpansion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).OptimizeCapacityExpansion(ctx, req.(*OptimizeCapacityExpansionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_OptimizeFacilityLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizeFacilityLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).OptimizeFacilityLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_OptimizeFacilityLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).OptimizeFacilityLocation(ctx, req.(*OptimizeFacilityLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SimulationService_RunTimeSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RunTimeSimulationRequest)
 	if err := dec(in); err != nil {
@@ -403,6 +493,24 @@ func _SimulationService_SimulatePeakLoad_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SimulationService_RunDiscreteEventSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDiscreteEventSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).RunDiscreteEventSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_RunDiscreteEventSimulation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).RunDiscreteEventSimulation(ctx, req.(*RunDiscreteEventSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SimulationService_RunMonteCarlo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RunMonteCarloRequest)
 	if err := dec(in); err != nil {
@@ -591,6 +699,14 @@ var SimulationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompareScenarios",
 			Handler:    _SimulationService_CompareScenarios_Handler,
 		},
+		{
+			MethodName: "OptimizeCapacityExpansion",
+			Handler:    _SimulationService_OptimizeCapacityExpansion_Handler,
+		},
+		{
+			MethodName: "OptimizeFacilityLocation",
+			Handler:    _SimulationService_OptimizeFacilityLocation_Handler,
+		},
 		{
 			MethodName: "RunTimeSimulation",
 			Handler:    _SimulationService_RunTimeSimulation_Handler,
@@ -599,6 +715,10 @@ var SimulationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SimulatePeakLoad",
 			Handler:    _SimulationService_SimulatePeakLoad_Handler,
 		},
+		{
+			MethodName: "RunDiscreteEventSimulation",
+			Handler:    _SimulationService_RunDiscreteEventSimulation_Handler,
+		},
 		{
 			MethodName: "RunMonteCarlo",
 			Handler:    _SimulationService_RunMonteCarlo_Handler,
@@ -39,12 +39,21 @@ const (
 	// SimulationServiceCompareScenariosProcedure is the fully-qualified name of the SimulationService's
 	// CompareScenarios RPC.
 	SimulationServiceCompareScenariosProcedure = "/logistics.simulation.v1.SimulationService/CompareScenarios"
+	// SimulationServiceOptimizeCapacityExpansionProcedure is the fully-qualified name of the
+	// SimulationService's OptimizeCapacityExpansion RPC.
+	SimulationServiceOptimizeCapacityExpansionProcedure = "/logistics.simulation.v1.SimulationService/OptimizeCapacityExpansion"
+	// SimulationServiceOptimizeFacilityLocationProcedure is the fully-qualified name of the
+	// SimulationService's OptimizeFacilityLocation RPC.
+	SimulationServiceOptimizeFacilityLocationProcedure = "/logistics.simulation.v1.SimulationService/OptimizeFacilityLocation"
 	// SimulationServiceRunTimeSimulationProcedure is the fully-qualified name of the
 	// SimulationService's RunTimeSimulation RPC.
 	SimulationServiceRunTimeSimulationProcedure = "/logistics.simulation.v1.SimulationService/RunTimeSimulation"
 	// SimulationServiceSimulatePeakLoadProcedure is the fully-qualified name of the SimulationService's
 	// SimulatePeakLoad RPC.
 	SimulationServiceSimulatePeakLoadProcedure = "/logistics.simulation.v1.SimulationService/SimulatePeakLoad"
+	// SimulationServiceRunDiscreteEventSimulationProcedure is the fully-qualified name of the
+	// SimulationService's RunDiscreteEventSimulation RPC.
+	SimulationServiceRunDiscreteEventSimulationProcedure = "/logistics.simulation.v1.SimulationService/RunDiscreteEventSimulation"
 	// SimulationServiceRunMonteCarloProcedure is the fully-qualified name of the SimulationService's
 	// RunMonteCarlo RPC.
 	SimulationServiceRunMonteCarloProcedure = "/logistics.simulation.v1.SimulationService/RunMonteCarlo"
@@ -83,10 +92,16 @@ type SimulationServiceClient interface {
 	RunWhatIf(context.Context, *connect.Request[v1.RunWhatIfRequest]) (*connect.Response[v1.RunWhatIfResponse], error)
 	// Сравнение нескольких сценариев
 	CompareScenarios(context.Context, *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error)
+	// Выбор расширений пропускной способности в пределах бюджета
+	OptimizeCapacityExpansion(context.Context, *connect.Request[v1.OptimizeCapacityExpansionRequest]) (*connect.Response[v1.OptimizeCapacityExpansionResponse], error)
+	// Выбор складов, которые стоит открыть или закрыть
+	OptimizeFacilityLocation(context.Context, *connect.Request[v1.OptimizeFacilityLocationRequest]) (*connect.Response[v1.OptimizeFacilityLocationResponse], error)
 	// Симуляция с временными параметрами
 	RunTimeSimulation(context.Context, *connect.Request[v1.RunTimeSimulationRequest]) (*connect.Response[v1.RunTimeSimulationResponse], error)
 	// Симуляция пиковых нагрузок
 	SimulatePeakLoad(context.Context, *connect.Request[v1.SimulatePeakLoadRequest]) (*connect.Response[v1.SimulatePeakLoadResponse], error)
+	// Дискретно-событийная симуляция рейсов по решённому потоку
+	RunDiscreteEventSimulation(context.Context, *connect.Request[v1.RunDiscreteEventSimulationRequest]) (*connect.Response[v1.RunDiscreteEventSimulationResponse], error)
 	// Запуск Monte Carlo симуляции
 	RunMonteCarlo(context.Context, *connect.Request[v1.RunMonteCarloRequest]) (*connect.Response[v1.RunMonteCarloResponse], error)
 	// Streaming для долгих Monte Carlo симуляций
@@ -132,6 +147,18 @@ func NewSimulationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(simulationServiceMethods.ByName("CompareScenarios")),
 			connect.WithClientOptions(opts...),
 		),
+		optimizeCapacityExpansion: connect.NewClient[v1.OptimizeCapacityExpansionRequest, v1.OptimizeCapacityExpansionResponse](
+			httpClient,
+			baseURL+SimulationServiceOptimizeCapacityExpansionProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("OptimizeCapacityExpansion")),
+			connect.WithClientOptions(opts...),
+		),
+		optimizeFacilityLocation: connect.NewClient[v1.OptimizeFacilityLocationRequest, v1.OptimizeFacilityLocationResponse](
+			httpClient,
+			baseURL+SimulationServiceOptimizeFacilityLocationProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("OptimizeFacilityLocation")),
+			connect.WithClientOptions(opts...),
+		),
 		runTimeSimulation: connect.NewClient[v1.RunTimeSimulationRequest, v1.RunTimeSimulationResponse](
 			httpClient,
 			baseURL+SimulationServiceRunTimeSimulationProcedure,
@@ -144,6 +171,12 @@ func NewSimulationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(simulationServiceMethods.ByName("SimulatePeakLoad")),
 			connect.WithClientOptions(opts...),
 		),
+		runDiscreteEventSimulation: connect.NewClient[v1.RunDiscreteEventSimulationRequest, v1.RunDiscreteEventSimulationResponse](
+			httpClient,
+			baseURL+SimulationServiceRunDiscreteEventSimulationProcedure,
+			connect.WithSchema(simulationServiceMethods.ByName("RunDiscreteEventSimulation")),
+			connect.WithClientOptions(opts...),
+		),
 		runMonteCarlo: connect.NewClient[v1.RunMonteCarloRequest, v1.RunMonteCarloResponse](
 			httpClient,
 			baseURL+SimulationServiceRunMonteCarloProcedure,
@@ -209,20 +242,23 @@ func NewSimulationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 
 // simulationServiceClient implements SimulationServiceClient.
 type simulationServiceClient struct {
-	runWhatIf            *connect.Client[v1.RunWhatIfRequest, v1.RunWhatIfResponse]
-	compareScenarios     *connect.Client[v1.CompareScenariosRequest, v1.CompareScenariosResponse]
-	runTimeSimulation    *connect.Client[v1.RunTimeSimulationRequest, v1.RunTimeSimulationResponse]
-	simulatePeakLoad     *connect.Client[v1.SimulatePeakLoadRequest, v1.SimulatePeakLoadResponse]
-	runMonteCarlo        *connect.Client[v1.RunMonteCarloRequest, v1.RunMonteCarloResponse]
-	runMonteCarloStream  *connect.Client[v1.RunMonteCarloRequest, v1.MonteCarloProgress]
-	analyzeSensitivity   *connect.Client[v1.AnalyzeSensitivityRequest, v1.AnalyzeSensitivityResponse]
-	findCriticalElements *connect.Client[v1.FindCriticalElementsRequest, v1.FindCriticalElementsResponse]
-	simulateFailures     *connect.Client[v1.SimulateFailuresRequest, v1.SimulateFailuresResponse]
-	analyzeResilience    *connect.Client[v1.AnalyzeResilienceRequest, v1.AnalyzeResilienceResponse]
-	saveSimulation       *connect.Client[v1.SaveSimulationRequest, v1.SaveSimulationResponse]
-	getSimulation        *connect.Client[v1.GetSimulationRequest, v1.GetSimulationResponse]
-	listSimulations      *connect.Client[v1.ListSimulationsRequest, v1.ListSimulationsResponse]
-	health               *connect.Client[v1.HealthRequest, v1.HealthResponse]
+	runWhatIf                  *connect.Client[v1.RunWhatIfRequest, v1.RunWhatIfResponse]
+	compareScenarios           *connect.Client[v1.CompareScenariosRequest, v1.CompareScenariosResponse]
+	optimizeCapacityExpansion  *connect.Client[v1.OptimizeCapacityExpansionRequest, v1.OptimizeCapacityExpansionResponse]
+	optimizeFacilityLocation   *connect.Client[v1.OptimizeFacilityLocationRequest, v1.OptimizeFacilityLocationResponse]
+	runTimeSimulation          *connect.Client[v1.RunTimeSimulationRequest, v1.RunTimeSimulationResponse]
+	simulatePeakLoad           *connect.Client[v1.SimulatePeakLoadRequest, v1.SimulatePeakLoadResponse]
+	runDiscreteEventSimulation *connect.Client[v1.RunDiscreteEventSimulationRequest, v1.RunDiscreteEventSimulationResponse]
+	runMonteCarlo              *connect.Client[v1.RunMonteCarloRequest, v1.RunMonteCarloResponse]
+	runMonteCarloStream        *connect.Client[v1.RunMonteCarloRequest, v1.MonteCarloProgress]
+	analyzeSensitivity         *connect.Client[v1.AnalyzeSensitivityRequest, v1.AnalyzeSensitivityResponse]
+	findCriticalElements       *connect.Client[v1.FindCriticalElementsRequest, v1.FindCriticalElementsResponse]
+	simulateFailures           *connect.Client[v1.SimulateFailuresRequest, v1.SimulateFailuresResponse]
+	analyzeResilience          *connect.Client[v1.AnalyzeResilienceRequest, v1.AnalyzeResilienceResponse]
+	saveSimulation             *connect.Client[v1.SaveSimulationRequest, v1.SaveSimulationResponse]
+	getSimulation              *connect.Client[v1.GetSimulationRequest, v1.GetSimulationResponse]
+	listSimulations            *connect.Client[v1.ListSimulationsRequest, v1.ListSimulationsResponse]
+	health                     *connect.Client[v1.HealthRequest, v1.HealthResponse]
 }
 
 // RunWhatIf calls logistics.simulation.v1.SimulationService.RunWhatIf.
@@ -235,6 +271,18 @@ func (c *simulationServiceClient) CompareScenarios(ctx context.Context, req *con
 	return c.compareScenarios.CallUnary(ctx, req)
 }
 
+// OptimizeCapacityExpansion calls
+// logistics.simulation.v1.SimulationService.OptimizeCapacityExpansion.
+func (c *simulationServiceClient) OptimizeCapacityExpansion(ctx context.Context, req *connect.Request[v1.OptimizeCapacityExpansionRequest]) (*connect.Response[v1.OptimizeCapacityExpansionResponse], error) {
+	return c.optimizeCapacityExpansion.CallUnary(ctx, req)
+}
+
+// OptimizeFacilityLocation calls
+// logistics.simulation.v1.SimulationService.OptimizeFacilityLocation.
+func (c *simulationServiceClient) OptimizeFacilityLocation(ctx context.Context, req *connect.Request[v1.OptimizeFacilityLocationRequest]) (*connect.Response[v1.OptimizeFacilityLocationResponse], error) {
+	return c.optimizeFacilityLocation.CallUnary(ctx, req)
+}
+
 // RunTimeSimulation calls logistics.simulation.v1.SimulationService.RunTimeSimulation.
 func (c *simulationServiceClient) RunTimeSimulation(ctx context.Context, req *connect.Request[v1.RunTimeSimulationRequest]) (*connect.Response[v1.RunTimeSimulationResponse], error) {
 	return c.runTimeSimulation.CallUnary(ctx, req)
@@ -245,6 +293,12 @@ func (c *simulationServiceClient) SimulatePeakLoad(ctx context.Context, req *con
 	return c.simulatePeakLoad.CallUnary(ctx, req)
 }
 
+// RunDiscreteEventSimulation calls
+// logistics.simulation.v1.SimulationService.RunDiscreteEventSimulation.
+func (c *simulationServiceClient) RunDiscreteEventSimulation(ctx context.Context, req *connect.Request[v1.RunDiscreteEventSimulationRequest]) (*connect.Response[v1.RunDiscreteEventSimulationResponse], error) {
+	return c.runDiscreteEventSimulation.CallUnary(ctx, req)
+}
+
 // RunMonteCarlo calls logistics.simulation.v1.SimulationService.RunMonteCarlo.
 func (c *simulationServiceClient) RunMonteCarlo(ctx context.Context, req *connect.Request[v1.RunMonteCarloRequest]) (*connect.Response[v1.RunMonteCarloResponse], error) {
 	return c.runMonteCarlo.CallUnary(ctx, req)
@@ -302,10 +356,16 @@ type SimulationServiceHandler interface {
 	RunWhatIf(context.Context, *connect.Request[v1.RunWhatIfRequest]) (*connect.Response[v1.RunWhatIfResponse], error)
 	// Сравнение нескольких сценариев
 	CompareScenarios(context.Context, *connect.Request[v1.CompareScenariosRequest]) (*connect.Response[v1.CompareScenariosResponse], error)
+	// Выбор расширений пропускной способности в пределах бюджета
+	OptimizeCapacityExpansion(context.Context, *connect.Request[v1.OptimizeCapacityExpansionRequest]) (*connect.Response[v1.OptimizeCapacityExpansionResponse], error)
+	// Выбор складов, которые стоит открыть или закрыть
+	OptimizeFacilityLocation(context.Context, *connect.Request[v1.OptimizeFacilityLocationRequest]) (*connect.Response[v1.OptimizeFacilityLocationResponse], error)
 	// Симуляция с временными параметрами
 	RunTimeSimulation(context.Context, *connect.Request[v1.RunTimeSimulationRequest]) (*connect.Response[v1.RunTimeSimulationResponse], error)
 	// Симуляция пиковых нагрузок
 	SimulatePeakLoad(context.Context, *connect.Request[v1.SimulatePeakLoadRequest]) (*connect.Response[v1.SimulatePeakLoadResponse], error)
+	// Дискретно-событийная симуляция рейсов по решённому потоку
+	RunDiscreteEventSimulation(context.Context, *connect.Request[v1.RunDiscreteEventSimulationRequest]) (*connect.Response[v1.RunDiscreteEventSimulationResponse], error)
 	// Запуск Monte Carlo симуляции
 	RunMonteCarlo(context.Context, *connect.Request[v1.RunMonteCarloRequest]) (*connect.Response[v1.RunMonteCarloResponse], error)
 	// Streaming для долгих Monte Carlo симуляций
@@ -347,6 +407,18 @@ func NewSimulationServiceHandler(svc SimulationServiceHandler, opts ...connect.H
 		connect.WithSchema(simulationServiceMethods.ByName("CompareScenarios")),
 		connect.WithHandlerOptions(opts...),
 	)
+	simulationServiceOptimizeCapacityExpansionHandler := connect.NewUnaryHandler(
+		SimulationServiceOptimizeCapacityExpansionProcedure,
+		svc.OptimizeCapacityExpansion,
+		connect.WithSchema(simulationServiceMethods.ByName("OptimizeCapacityExpansion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	simulationServiceOptimizeFacilityLocationHandler := connect.NewUnaryHandler(
+		SimulationServiceOptimizeFacilityLocationProcedure,
+		svc.OptimizeFacilityLocation,
+		connect.WithSchema(simulationServiceMethods.ByName("OptimizeFacilityLocation")),
+		connect.WithHandlerOptions(opts...),
+	)
 	simulationServiceRunTimeSimulationHandler := connect.NewUnaryHandler(
 		SimulationServiceRunTimeSimulationProcedure,
 		svc.RunTimeSimulation,
@@ -359,6 +431,12 @@ func NewSimulationServiceHandler(svc SimulationServiceHandler, opts ...connect.H
 		connect.WithSchema(simulationServiceMethods.ByName("SimulatePeakLoad")),
 		connect.WithHandlerOptions(opts...),
 	)
+	simulationServiceRunDiscreteEventSimulationHandler := connect.NewUnaryHandler(
+		SimulationServiceRunDiscreteEventSimulationProcedure,
+		svc.RunDiscreteEventSimulation,
+		connect.WithSchema(simulationServiceMethods.ByName("RunDiscreteEventSimulation")),
+		connect.WithHandlerOptions(opts...),
+	)
 	simulationServiceRunMonteCarloHandler := connect.NewUnaryHandler(
 		SimulationServiceRunMonteCarloProcedure,
 		svc.RunMonteCarlo,
@@ -425,10 +503,16 @@ func NewSimulationServiceHandler(svc SimulationServiceHandler, opts ...connect.H
 			simulationServiceRunWhatIfHandler.ServeHTTP(w, r)
 		case SimulationServiceCompareScenariosProcedure:
 			simulationServiceCompareScenariosHandler.ServeHTTP(w, r)
+		case SimulationServiceOptimizeCapacityExpansionProcedure:
+			simulationServiceOptimizeCapacityExpansionHandler.ServeHTTP(w, r)
+		case SimulationServiceOptimizeFacilityLocationProcedure:
+			simulationServiceOptimizeFacilityLocationHandler.ServeHTTP(w, r)
 		case SimulationServiceRunTimeSimulationProcedure:
 			simulationServiceRunTimeSimulationHandler.ServeHTTP(w, r)
 		case SimulationServiceSimulatePeakLoadProcedure:
 			simulationServiceSimulatePeakLoadHandler.ServeHTTP(w, r)
+		case SimulationServiceRunDiscreteEventSimulationProcedure:
+			simulationServiceRunDiscreteEventSimulationHandler.ServeHTTP(w, r)
 		case SimulationServiceRunMonteCarloProcedure:
 			simulationServiceRunMonteCarloHandler.ServeHTTP(w, r)
 		case SimulationServiceRunMonteCarloStreamProcedure:
@@ -466,6 +550,14 @@ func (UnimplementedSimulationServiceHandler) CompareScenarios(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.simulation.v1.SimulationService.CompareScenarios is not implemented"))
 }
 
+func (UnimplementedSimulationServiceHandler) OptimizeCapacityExpansion(context.Context, *connect.Request[v1.OptimizeCapacityExpansionRequest]) (*connect.Response[v1.OptimizeCapacityExpansionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.simulation.v1.SimulationService.OptimizeCapacityExpansion is not implemented"))
+}
+
+func (UnimplementedSimulationServiceHandler) OptimizeFacilityLocation(context.Context, *connect.Request[v1.OptimizeFacilityLocationRequest]) (*connect.Response[v1.OptimizeFacilityLocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.simulation.v1.SimulationService.OptimizeFacilityLocation is not implemented"))
+}
+
 func (UnimplementedSimulationServiceHandler) RunTimeSimulation(context.Context, *connect.Request[v1.RunTimeSimulationRequest]) (*connect.Response[v1.RunTimeSimulationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.simulation.v1.SimulationService.RunTimeSimulation is not implemented"))
 }
@@ -474,6 +566,10 @@ func (UnimplementedSimulationServiceHandler) SimulatePeakLoad(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.simulation.v1.SimulationService.SimulatePeakLoad is not implemented"))
 }
 
+func (UnimplementedSimulationServiceHandler) RunDiscreteEventSimulation(context.Context, *connect.Request[v1.RunDiscreteEventSimulationRequest]) (*connect.Response[v1.RunDiscreteEventSimulationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.simulation.v1.SimulationService.RunDiscreteEventSimulation is not implemented"))
+}
+
 func (UnimplementedSimulationServiceHandler) RunMonteCarlo(context.Context, *connect.Request[v1.RunMonteCarloRequest]) (*connect.Response[v1.RunMonteCarloResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("logistics.simulation.v1.SimulationService.RunMonteCarlo is not implemented"))
 }
@@ -28,6 +28,10 @@ const (
 	CodeSourceEqualsSink ErrorCode = "SOURCE_EQUALS_SINK"
 	CodeInvalidCapacity  ErrorCode = "INVALID_CAPACITY"
 	CodeNegativeLength   ErrorCode = "NEGATIVE_LENGTH"
+	CodeDuplicateEdgeID  ErrorCode = "DUPLICATE_EDGE_ID"
+
+	CodeNegativeLowerBound        ErrorCode = "NEGATIVE_LOWER_BOUND"
+	CodeLowerBoundExceedsCapacity ErrorCode = "LOWER_BOUND_EXCEEDS_CAPACITY"
 
 	// Connectivity
 	CodeNoPath            ErrorCode = "NO_PATH"
@@ -50,6 +54,7 @@ const (
 	CodeConservationViolation ErrorCode = "CONSERVATION_VIOLATION"
 	CodeNegativeFlow          ErrorCode = "NEGATIVE_FLOW"
 	CodeFlowImbalance         ErrorCode = "FLOW_IMBALANCE"
+	CodeLowerBoundViolation   ErrorCode = "LOWER_BOUND_VIOLATION"
 
 	// Business Logic
 	CodeIsolatedWarehouse   ErrorCode = "ISOLATED_WAREHOUSE"
@@ -133,7 +138,8 @@ func (e *Error) grpcCode() codes.Code {
 		CodeDuplicateNode, CodeDanglingEdge, CodeSelfLoop, CodeNegativeCapacity,
 		CodeNegativeCost, CodeSourceEqualsSink, CodeInvalidArgument, CodeInvalidCapacity,
 		CodeNegativeLength, CodeNilInput, CodeInvalidPagination, CodeInvalidThreshold,
-		CodeInvalidAlgorithm:
+		CodeInvalidAlgorithm, CodeNegativeLowerBound, CodeLowerBoundExceedsCapacity,
+		CodeDuplicateEdgeID:
 		return codes.InvalidArgument
 
 	case CodeNoPath, CodeDisconnectedGraph, CodeIsolatedNode, CodeUnreachableNode,
@@ -156,7 +162,7 @@ func (e *Error) grpcCode() codes.Code {
 		return codes.Aborted
 
 	case CodeFlowViolation, CodeCapacityOverflow, CodeConservationViolation,
-		CodeNegativeFlow, CodeFlowImbalance:
+		CodeNegativeFlow, CodeFlowImbalance, CodeLowerBoundViolation:
 		return codes.DataLoss
 
 	default:
@@ -25,9 +25,13 @@ func graphToCanonical(graph *commonv1.Graph) []byte {
 	// Сортируем узлы по ID
 	nodeIDs := make([]int64, 0, len(graph.Nodes))
 	nodeTypes := make(map[int64]int32)
+	nodeBalances := make(map[int64][2]float64)
 	for _, node := range graph.Nodes {
 		nodeIDs = append(nodeIDs, node.Id)
 		nodeTypes[node.Id] = int32(node.Type)
+		if node.Supply != 0 || node.Demand != 0 {
+			nodeBalances[node.Id] = [2]float64{node.Supply, node.Demand}
+		}
 	}
 	sort.Slice(nodeIDs, func(i, j int) bool {
 		return nodeIDs[i] < nodeIDs[j]
@@ -38,12 +42,15 @@ func graphToCanonical(graph *commonv1.Graph) []byte {
 		from, to int64
 		capacity float64
 		cost     float64
+		minFlow  float64
+		id       int64
 	}
 	edges := make([]edgeData, 0, len(graph.Edges))
 	for _, e := range graph.Edges {
-		edges = append(edges, edgeData{e.From, e.To, e.Capacity, e.Cost})
+		edges = append(edges, edgeData{e.From, e.To, e.Capacity, e.Cost, e.MinFlow, e.Id})
 	}
-	sort.Slice(edges, func(i, j int) bool {
+	// Стабильная сортировка: порядок параллельных рёбер задаёт их id по умолчанию
+	sort.SliceStable(edges, func(i, j int) bool {
 		if edges[i].from != edges[j].from {
 			return edges[i].from < edges[j].from
 		}
@@ -56,15 +63,28 @@ func graphToCanonical(graph *commonv1.Graph) []byte {
 	// Source и Sink
 	result = append(result, []byte(fmt.Sprintf("s:%d,t:%d;", graph.SourceId, graph.SinkId))...)
 
-	// Узлы
+	// Узлы (supply/demand добавляются только если заданы, чтобы не менять
+	// ключи кэша для графов с одним источником и стоком)
 	for _, id := range nodeIDs {
+		if b, ok := nodeBalances[id]; ok {
+			result = append(result, []byte(fmt.Sprintf("n:%d:%d:%.6f:%.6f;", id, nodeTypes[id], b[0], b[1]))...)
+			continue
+		}
 		result = append(result, []byte(fmt.Sprintf("n:%d:%d;", id, nodeTypes[id]))...)
 	}
 
-	// Рёбра
+	// Рёбра (min_flow и id добавляются только если заданы, чтобы не менять
+	// ключи кэша для остальных графов)
 	for _, e := range edges {
-		result = append(result, []byte(fmt.Sprintf("e:%d:%d:%.6f:%.6f;",
+		result = append(result, []byte(fmt.Sprintf("e:%d:%d:%.6f:%.6f",
 			e.from, e.to, e.capacity, e.cost))...)
+		if e.minFlow != 0 {
+			result = append(result, []byte(fmt.Sprintf(":%.6f", e.minFlow))...)
+		}
+		if e.id != 0 {
+			result = append(result, []byte(fmt.Sprintf(":#%d", e.id))...)
+		}
+		result = append(result, ';')
 	}
 
 	return result
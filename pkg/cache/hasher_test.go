@@ -80,6 +80,57 @@ func TestGraphHash(t *testing.T) {
 			t.Error("node order should not affect hash")
 		}
 	})
+
+	t.Run("supply and demand affect hash", func(t *testing.T) {
+		g1 := &commonv1.Graph{
+			Nodes: []*commonv1.Node{{Id: 1, Supply: 10}, {Id: 2, Demand: 10}},
+			Edges: []*commonv1.Edge{{From: 1, To: 2, Capacity: 10}},
+		}
+		g2 := &commonv1.Graph{
+			Nodes: []*commonv1.Node{{Id: 1, Supply: 10}, {Id: 2, Demand: 5}},
+			Edges: []*commonv1.Edge{{From: 1, To: 2, Capacity: 10}},
+		}
+
+		if GraphHash(g1) == GraphHash(g2) {
+			t.Error("different demand should produce different hashes")
+		}
+	})
+
+	t.Run("min flow affects hash", func(t *testing.T) {
+		g1 := &commonv1.Graph{
+			Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}},
+			Edges: []*commonv1.Edge{{From: 1, To: 2, Capacity: 10}},
+		}
+		g2 := &commonv1.Graph{
+			Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}},
+			Edges: []*commonv1.Edge{{From: 1, To: 2, Capacity: 10, MinFlow: 5}},
+		}
+
+		if GraphHash(g1) == GraphHash(g2) {
+			t.Error("different min flow should produce different hashes")
+		}
+	})
+
+	t.Run("edge ids affect hash", func(t *testing.T) {
+		g1 := &commonv1.Graph{
+			Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}},
+			Edges: []*commonv1.Edge{
+				{From: 1, To: 2, Capacity: 10, Cost: 1, Id: 7},
+				{From: 1, To: 2, Capacity: 10, Cost: 2, Id: 8},
+			},
+		}
+		g2 := &commonv1.Graph{
+			Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}},
+			Edges: []*commonv1.Edge{
+				{From: 1, To: 2, Capacity: 10, Cost: 1, Id: 8},
+				{From: 1, To: 2, Capacity: 10, Cost: 2, Id: 7},
+			},
+		}
+
+		if GraphHash(g1) == GraphHash(g2) {
+			t.Error("swapped parallel edge ids should produce different hashes")
+		}
+	})
 }
 
 func TestBuildSolveKey(t *testing.T) {
@@ -25,6 +25,10 @@ type CachedSolveResult struct {
 	ComputationTimeMs float64          `json:"computation_time_ms"`
 	FlowEdges         []*FlowEdgeCache `json:"flow_edges,omitempty"`
 	ComputedAt        time.Time        `json:"computed_at"`
+
+	// Балансы складов и точек доставки графов с несколькими полюсами
+	SourceBalances []*NodeBalanceCache `json:"source_balances,omitempty"`
+	SinkBalances   []*NodeBalanceCache `json:"sink_balances,omitempty"`
 }
 
 // FlowEdgeCache кэшированное ребро с потоком
@@ -34,6 +38,18 @@ type FlowEdgeCache struct {
 	Flow        float64 `json:"flow"`
 	Capacity    float64 `json:"capacity"`
 	Utilization float64 `json:"utilization"`
+	EdgeID      int64   `json:"edge_id,omitempty"`
+}
+
+// NodeBalanceCache кэшированный баланс узла
+type NodeBalanceCache struct {
+	NodeID       int64   `json:"node_id"`
+	Supply       float64 `json:"supply,omitempty"`
+	Demand       float64 `json:"demand,omitempty"`
+	Shipped      float64 `json:"shipped,omitempty"`
+	Received     float64 `json:"received,omitempty"`
+	UnmetDemand  float64 `json:"unmet_demand,omitempty"`
+	UnusedSupply float64 `json:"unused_supply,omitempty"`
 }
 
 // NewSolverCache создаёт кэш для solver результатов
@@ -111,9 +127,13 @@ func (sc *SolverCache) SetFromResponse(ctx context.Context, graph *commonv1.Grap
 			Flow:        edge.Flow,
 			Capacity:    edge.Capacity,
 			Utilization: edge.Utilization,
+			EdgeID:      edge.EdgeId,
 		})
 	}
 
+	result.SourceBalances = toNodeBalanceCache(resp.Result.SourceBalances)
+	result.SinkBalances = toNodeBalanceCache(resp.Result.SinkBalances)
+
 	return sc.Set(ctx, graph, algorithm, result, ttl)
 }
 
@@ -152,8 +172,44 @@ func (r *CachedSolveResult) ToFlowResult() *commonv1.FlowResult {
 			Flow:        e.Flow,
 			Capacity:    e.Capacity,
 			Utilization: e.Utilization,
+			EdgeId:      e.EdgeID,
 		})
 	}
 
+	result.SourceBalances = fromNodeBalanceCache(r.SourceBalances)
+	result.SinkBalances = fromNodeBalanceCache(r.SinkBalances)
+
+	return result
+}
+
+func toNodeBalanceCache(balances []*commonv1.NodeBalance) []*NodeBalanceCache {
+	var result []*NodeBalanceCache
+	for _, b := range balances {
+		result = append(result, &NodeBalanceCache{
+			NodeID:       b.NodeId,
+			Supply:       b.Supply,
+			Demand:       b.Demand,
+			Shipped:      b.Shipped,
+			Received:     b.Received,
+			UnmetDemand:  b.UnmetDemand,
+			UnusedSupply: b.UnusedSupply,
+		})
+	}
+	return result
+}
+
+func fromNodeBalanceCache(balances []*NodeBalanceCache) []*commonv1.NodeBalance {
+	var result []*commonv1.NodeBalance
+	for _, b := range balances {
+		result = append(result, &commonv1.NodeBalance{
+			NodeId:       b.NodeID,
+			Supply:       b.Supply,
+			Demand:       b.Demand,
+			Shipped:      b.Shipped,
+			Received:     b.Received,
+			UnmetDemand:  b.UnmetDemand,
+			UnusedSupply: b.UnusedSupply,
+		})
+	}
 	return result
 }
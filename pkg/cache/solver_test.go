@@ -136,7 +136,7 @@ func TestSolverCache_SetFromResponse(t *testing.T) {
 			Iterations:        10,
 			ComputationTimeMs: 2.5,
 			Edges: []*commonv1.FlowEdge{
-				{From: 1, To: 2, Flow: 15, Capacity: 20, Utilization: 0.75},
+				{From: 1, To: 2, Flow: 15, Capacity: 20, Utilization: 0.75, EdgeId: 3},
 			},
 		},
 	}
@@ -154,6 +154,9 @@ func TestSolverCache_SetFromResponse(t *testing.T) {
 	if got.MaxFlow != 15 {
 		t.Errorf("expected max flow 15, got %f", got.MaxFlow)
 	}
+	if edges := got.ToFlowResult().Edges; len(edges) != 1 || edges[0].EdgeId != 3 {
+		t.Errorf("expected cached edge with edge id 3, got %v", edges)
+	}
 }
 
 func TestSolverCache_SetFromResponse_NilResponse(t *testing.T) {
@@ -264,3 +267,19 @@ func TestCachedSolveResult_ToFlowResult(t *testing.T) {
 		t.Errorf("expected 2 edges, got %d", len(result.Edges))
 	}
 }
+
+func TestCachedSolveResult_ToFlowResult_Balances(t *testing.T) {
+	cached := &CachedSolveResult{
+		SourceBalances: []*NodeBalanceCache{{NodeID: 1, Supply: 10, Shipped: 8, UnusedSupply: 2}},
+		SinkBalances:   []*NodeBalanceCache{{NodeID: 4, Demand: 9, Received: 6, UnmetDemand: 3}},
+	}
+
+	result := cached.ToFlowResult()
+
+	if len(result.SourceBalances) != 1 || result.SourceBalances[0].UnusedSupply != 2 {
+		t.Errorf("expected source balance with unused supply 2, got %v", result.SourceBalances)
+	}
+	if len(result.SinkBalances) != 1 || result.SinkBalances[0].UnmetDemand != 3 {
+		t.Errorf("expected sink balance with unmet demand 3, got %v", result.SinkBalances)
+	}
+}
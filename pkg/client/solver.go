@@ -88,6 +88,8 @@ type SolveResult struct {
 	ComputationTimeMs  float64
 	Graph              *commonv1.Graph
 	Iterations         int32
+	MinCut             *commonv1.MinCut            // Только при SolveOptions.ReturnMinCut
+	Decomposition      *commonv1.FlowDecomposition // Только при SolveOptions.ReturnDecomposition
 	Error              error
 }
 
@@ -124,6 +126,8 @@ func (c *SolverClient) Solve(ctx context.Context, graph *commonv1.Graph, algorit
 		ComputationTimeMs:  resp.Metrics.ComputationTimeMs,
 		Graph:              resp.SolvedGraph,
 		Iterations:         resp.Metrics.Iterations,
+		MinCut:             resp.Result.MinCut,
+		Decomposition:      resp.Result.Decomposition,
 	}, nil
 }
 
@@ -139,6 +143,42 @@ func (c *SolverClient) SolveWithTimeout(ctx context.Context, graph *commonv1.Gra
 	return c.Solve(ctx, graph, algorithm, opts)
 }
 
+// GetMinCut вычисляет максимальный поток и возвращает минимальный разрез
+func (c *SolverClient) GetMinCut(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, opts *optimizationv1.SolveOptions) (*commonv1.MinCut, error) {
+	resp, err := c.client.GetMinCut(ctx, &optimizationv1.GetMinCutRequest{
+		Graph:     graph,
+		Algorithm: algorithm,
+		Options:   opts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("min cut request failed: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("solver returned error: %s", resp.ErrorMessage)
+	}
+
+	return resp.MinCut, nil
+}
+
+// SolveMultiCommodity распределяет несколько товаров по общим пропускным способностям графа
+func (c *SolverClient) SolveMultiCommodity(ctx context.Context, graph *commonv1.Graph, commodities []*optimizationv1.Commodity, opts *optimizationv1.MultiCommodityOptions) (*optimizationv1.MultiCommodityResult, error) {
+	resp, err := c.client.SolveMultiCommodity(ctx, &optimizationv1.SolveMultiCommodityRequest{
+		Graph:       graph,
+		Commodities: commodities,
+		Options:     opts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("multi-commodity request failed: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("solver returned error: %s", resp.ErrorMessage)
+	}
+
+	return resp.Result, nil
+}
+
 // GetAlgorithms возвращает список алгоритмов
 func (c *SolverClient) GetAlgorithms(ctx context.Context) ([]*optimizationv1.AlgorithmInfo, error) {
 	resp, err := c.client.GetAlgorithms(ctx, nil)
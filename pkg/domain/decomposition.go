@@ -0,0 +1,266 @@
+package domain
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// FlowArc дуга с потоком для разложения. Параллельные дуги между одной
+// парой узлов допустимы
+type FlowArc struct {
+	From   int64
+	To     int64
+	Flow   float64
+	Cost   float64 // За единицу потока
+	Length float64
+}
+
+// FlowDecomposition разложение потока на пути и циклы
+type FlowDecomposition struct {
+	// Пути от узлов, отгружающих поток, к узлам, принимающим его
+	Paths []*Path
+	// Циклы: первый узел совпадает с последним
+	Cycles []*Path
+}
+
+// DecomposeFlow раскладывает поток по дугам на пути и циклы.
+//
+// Сначала из потока удаляются циклы (поиск в глубину по дугам с
+// остатком потока). Ацикличный остаток раскладывается на пути от узлов с
+// избытком (исходящий поток больше входящего) к узлам с недостатком: на
+// каждом шаге выбирается путь наибольшей пропускной способности, что даёт
+// небольшое число путей (точный минимум — NP-трудная задача). Каждый шаг
+// обнуляет дугу или баланс узла, поэтому путей не больше, чем дуг и узлов.
+//
+// Cost пути — стоимость всего его потока, Length — длина пути, Arcs —
+// индексы его дуг в arcs, чтобы различать параллельные дуги.
+func DecomposeFlow(arcs []FlowArc) *FlowDecomposition {
+	d := &decomposer{
+		arcs:      arcs,
+		remaining: make([]float64, len(arcs)),
+		out:       make(map[int64][]int),
+	}
+	seen := make(map[int64]bool)
+	for i, a := range arcs {
+		if a.Flow <= Epsilon {
+			continue
+		}
+		d.remaining[i] = a.Flow
+		d.out[a.From] = append(d.out[a.From], i)
+		for _, id := range []int64{a.From, a.To} {
+			if !seen[id] {
+				seen[id] = true
+				d.nodes = append(d.nodes, id)
+			}
+		}
+	}
+	sort.Slice(d.nodes, func(i, j int) bool { return d.nodes[i] < d.nodes[j] })
+
+	result := &FlowDecomposition{}
+	result.Cycles = d.cancelCycles()
+	result.Paths = d.extractPaths()
+	return result
+}
+
+// decomposer состояние разложения: остаток потока по дугам
+type decomposer struct {
+	arcs      []FlowArc
+	remaining []float64
+	out       map[int64][]int // Индексы исходящих дуг
+	nodes     []int64         // По возрастанию ID
+}
+
+// cancelCycles находит и вычитает циклы потока
+func (d *decomposer) cancelCycles() []*Path {
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+	state := make(map[int64]int)
+	next := make(map[int64]int) // Следующая непросмотренная исходящая дуга
+
+	var cycles []*Path
+	for _, root := range d.nodes {
+		if state[root] != unvisited {
+			continue
+		}
+		stack := []int64{root}
+		var entered []int // entered[i] — дуга, по которой вошли в stack[i+1]
+		state[root] = onStack
+
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			if next[u] >= len(d.out[u]) {
+				state[u] = done
+				stack = stack[:len(stack)-1]
+				if len(entered) > 0 {
+					entered = entered[:len(entered)-1]
+				}
+				continue
+			}
+
+			a := d.out[u][next[u]]
+			if d.remaining[a] <= Epsilon {
+				next[u]++
+				continue
+			}
+			v := d.arcs[a].To
+			switch state[v] {
+			case unvisited:
+				state[v] = onStack
+				stack = append(stack, v)
+				entered = append(entered, a)
+			case done:
+				// Из просмотренного узла нет пути обратно в стек
+				next[u]++
+			case onStack:
+				k := len(stack) - 1
+				for stack[k] != v {
+					k--
+				}
+				cycle := append(append([]int(nil), entered[k:]...), a)
+				cycles = append(cycles, d.subtract(cycle))
+
+				// Узлы выше v обходятся заново: их дуги могли обнулиться
+				for len(stack)-1 > k {
+					state[stack[len(stack)-1]] = unvisited
+					stack = stack[:len(stack)-1]
+					entered = entered[:len(entered)-1]
+				}
+			}
+		}
+	}
+	return cycles
+}
+
+// extractPaths раскладывает ацикличный остаток потока на пути
+func (d *decomposer) extractPaths() []*Path {
+	excess := make(map[int64]float64)
+	for i, a := range d.arcs {
+		excess[a.From] += d.remaining[i]
+		excess[a.To] -= d.remaining[i]
+	}
+
+	var paths []*Path
+	for {
+		width, parent := d.widest(excess)
+
+		// Сток, принимающий наибольший поток
+		target, flow := int64(0), 0.0
+		for _, id := range d.nodes {
+			if excess[id] >= -Epsilon {
+				continue
+			}
+			if f := Min(width[id], -excess[id]); f > flow+Epsilon {
+				target, flow = id, f
+			}
+		}
+		if flow <= Epsilon {
+			return paths
+		}
+
+		var route []int
+		node := target
+		for {
+			a, ok := parent[node]
+			if !ok {
+				break
+			}
+			route = append(route, a)
+			node = d.arcs[a].From
+		}
+		for i, j := 0, len(route)-1; i < j; i, j = i+1, j-1 {
+			route[i], route[j] = route[j], route[i]
+		}
+
+		path := d.subtractFlow(route, flow)
+		excess[node] -= flow
+		excess[target] += flow
+		paths = append(paths, path)
+	}
+}
+
+// widest строит дерево путей наибольшей пропускной способности от узлов с
+// избытком; ширина пути ограничена избытком начального узла
+func (d *decomposer) widest(excess map[int64]float64) (map[int64]float64, map[int64]int) {
+	width := make(map[int64]float64)
+	parent := make(map[int64]int)
+	pq := &widestQueue{}
+	for _, id := range d.nodes {
+		if excess[id] > Epsilon {
+			width[id] = excess[id]
+			heap.Push(pq, widestItem{node: id, width: excess[id]})
+		}
+	}
+
+	visited := make(map[int64]bool)
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(widestItem)
+		if visited[item.node] {
+			continue
+		}
+		visited[item.node] = true
+		for _, a := range d.out[item.node] {
+			w := Min(item.width, d.remaining[a])
+			v := d.arcs[a].To
+			if w > width[v]+Epsilon && !visited[v] {
+				width[v] = w
+				parent[v] = a
+				heap.Push(pq, widestItem{node: v, width: w})
+			}
+		}
+	}
+	return width, parent
+}
+
+// subtract вычитает из дуг цикла его наименьший остаток
+func (d *decomposer) subtract(route []int) *Path {
+	flow := Infinity
+	for _, a := range route {
+		flow = Min(flow, d.remaining[a])
+	}
+	return d.subtractFlow(route, flow)
+}
+
+// subtractFlow вычитает поток из дуг маршрута и возвращает его путь
+func (d *decomposer) subtractFlow(route []int, flow float64) *Path {
+	path := &Path{Nodes: []int64{d.arcs[route[0]].From}, Flow: flow}
+	for _, a := range route {
+		arc := d.arcs[a]
+		path.Nodes = append(path.Nodes, arc.To)
+		path.Arcs = append(path.Arcs, a)
+		path.Cost += arc.Cost * flow
+		path.Length += arc.Length
+
+		d.remaining[a] -= flow
+		if d.remaining[a] <= Epsilon {
+			d.remaining[a] = 0
+		}
+	}
+	return path
+}
+
+type widestItem struct {
+	node  int64
+	width float64
+}
+
+// widestQueue max-куча по ширине пути; при равной ширине — меньший ID
+type widestQueue []widestItem
+
+func (q widestQueue) Len() int { return len(q) }
+func (q widestQueue) Less(i, j int) bool {
+	if q[i].width != q[j].width {
+		return q[i].width > q[j].width
+	}
+	return q[i].node < q[j].node
+}
+func (q widestQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *widestQueue) Push(x any)   { *q = append(*q, x.(widestItem)) }
+func (q *widestQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecomposeFlow_Paths(t *testing.T) {
+	arcs := []FlowArc{
+		{From: 1, To: 2, Flow: 5, Cost: 1, Length: 10},
+		{From: 1, To: 3, Flow: 3, Cost: 2, Length: 5},
+		{From: 2, To: 4, Flow: 5, Cost: 1, Length: 10},
+		{From: 3, To: 4, Flow: 3, Cost: 2, Length: 5},
+		{From: 2, To: 3, Flow: 0, Cost: 1}, // Без потока
+	}
+
+	d := DecomposeFlow(arcs)
+
+	if len(d.Cycles) != 0 {
+		t.Fatalf("Cycles = %d, want 0", len(d.Cycles))
+	}
+	want := []*Path{
+		{Nodes: []int64{1, 2, 4}, Flow: 5, Cost: 10, Length: 20, Arcs: []int{0, 2}},
+		{Nodes: []int64{1, 3, 4}, Flow: 3, Cost: 12, Length: 10, Arcs: []int{1, 3}},
+	}
+	if !reflect.DeepEqual(d.Paths, want) {
+		t.Errorf("Paths = %+v, want %+v", d.Paths, want)
+	}
+}
+
+func TestDecomposeFlow_Cycle(t *testing.T) {
+	arcs := []FlowArc{
+		{From: 1, To: 2, Flow: 5, Cost: 1},
+		{From: 2, To: 3, Flow: 7, Cost: 1},
+		{From: 3, To: 2, Flow: 2, Cost: 1},
+		{From: 3, To: 4, Flow: 5, Cost: 1},
+	}
+
+	d := DecomposeFlow(arcs)
+
+	if len(d.Cycles) != 1 {
+		t.Fatalf("Cycles = %d, want 1", len(d.Cycles))
+	}
+	cycle := d.Cycles[0]
+	if !reflect.DeepEqual(cycle.Nodes, []int64{2, 3, 2}) || cycle.Flow != 2 || cycle.Cost != 4 {
+		t.Errorf("Cycle = %+v, want 2→3→2 with flow 2 and cost 4", cycle)
+	}
+
+	if len(d.Paths) != 1 {
+		t.Fatalf("Paths = %d, want 1", len(d.Paths))
+	}
+	if !reflect.DeepEqual(d.Paths[0].Nodes, []int64{1, 2, 3, 4}) || d.Paths[0].Flow != 5 {
+		t.Errorf("Path = %+v, want 1→2→3→4 with flow 5", d.Paths[0])
+	}
+}
+
+func TestDecomposeFlow_MultipleTerminals(t *testing.T) {
+	// Склады 1 и 2 отгружают через общий узел 3 в точки 4 и 5
+	arcs := []FlowArc{
+		{From: 1, To: 3, Flow: 6},
+		{From: 2, To: 3, Flow: 4},
+		{From: 3, To: 4, Flow: 7},
+		{From: 3, To: 5, Flow: 3},
+	}
+
+	d := DecomposeFlow(arcs)
+
+	shipped := make(map[int64]float64)
+	received := make(map[int64]float64)
+	for _, p := range d.Paths {
+		shipped[p.Nodes[0]] += p.Flow
+		received[p.Nodes[len(p.Nodes)-1]] += p.Flow
+	}
+	if shipped[1] != 6 || shipped[2] != 4 {
+		t.Errorf("shipped = %v, want 1:6 2:4", shipped)
+	}
+	if received[4] != 7 || received[5] != 3 {
+		t.Errorf("received = %v, want 4:7 5:3", received)
+	}
+	// Наибольший путь выбирается первым
+	if d.Paths[0].Flow != 6 {
+		t.Errorf("first path flow = %v, want 6", d.Paths[0].Flow)
+	}
+	if len(d.Paths) > 4 {
+		t.Errorf("Paths = %d, want at most 4", len(d.Paths))
+	}
+}
+
+func TestDecomposeFlow_ParallelArcs(t *testing.T) {
+	arcs := []FlowArc{
+		{From: 1, To: 2, Flow: 4, Cost: 1},
+		{From: 1, To: 2, Flow: 2, Cost: 3},
+	}
+
+	d := DecomposeFlow(arcs)
+
+	if len(d.Paths) != 2 {
+		t.Fatalf("Paths = %d, want 2", len(d.Paths))
+	}
+	total := 0.0
+	for _, p := range d.Paths {
+		total += p.Cost
+	}
+	if total != 10 {
+		t.Errorf("total cost = %v, want 10", total)
+	}
+}
+
+func TestDecomposeFlow_Empty(t *testing.T) {
+	d := DecomposeFlow(nil)
+	if len(d.Paths) != 0 || len(d.Cycles) != 0 {
+		t.Errorf("DecomposeFlow(nil) = %+v, want empty", d)
+	}
+}
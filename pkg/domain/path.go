@@ -6,9 +6,12 @@ type Path struct {
 	Flow   float64
 	Cost   float64
 	Length float64
+	Arcs   []int // Индексы дуг пути во входном срезе DecomposeFlow
 }
 
-// ReconstructPath восстанавливает путь из parent map
+// ReconstructPath восстанавливает путь из parent map.
+// Значение -1 в parent означает отсутствие родителя, кроме случая,
+// когда источником является виртуальный SuperSourceID (его ID тоже -1).
 func ReconstructPath(parent map[int64]int64, source, sink int64) []int64 {
 	if _, exists := parent[sink]; !exists {
 		return nil
@@ -20,7 +23,7 @@ func ReconstructPath(parent map[int64]int64, source, sink int64) []int64 {
 	for current != source {
 		path = append([]int64{current}, path...)
 		p, exists := parent[current]
-		if !exists || p == -1 {
+		if !exists || (p == -1 && source != SuperSourceID) {
 			if current == source {
 				break
 			}
@@ -40,6 +40,17 @@ func TestReconstructPath(t *testing.T) {
 			sink:     3,
 			expected: nil,
 		},
+		{
+			name: "from super source",
+			parent: map[int64]int64{
+				1:           SuperSourceID,
+				3:           1,
+				SuperSinkID: 3,
+			},
+			source:   SuperSourceID,
+			sink:     SuperSinkID,
+			expected: []int64{SuperSourceID, 1, 3, SuperSinkID},
+		},
 		{
 			name:     "empty parent",
 			parent:   map[int64]int64{},
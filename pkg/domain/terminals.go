@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// BalanceNode узел с предложением и спросом. Ему удовлетворяют узлы proto
+// (*commonv1.Node), поэтому функции ниже принимают их без преобразования
+type BalanceNode interface {
+	GetId() int64
+	GetSupply() float64
+	GetDemand() float64
+}
+
+// Terminals источник и сток, между которыми считается поток.
+//
+// Для графа с одним истоком и стоком это source_id и sink_id. Если в графе
+// несколько узлов предложения или спроса, используются виртуальные
+// суперисток (SuperSourceID) и суперсток (SuperSinkID):
+//
+//	SuperSource ──supply──▶ склад ──▶ ... ──▶ точка доставки ──demand──▶ SuperSink
+type Terminals struct {
+	// Source узел, из которого алгоритмы выпускают поток
+	Source int64
+
+	// Sink узел, в который алгоритмы доставляют поток
+	Sink int64
+
+	// MultiTerminal true, если используются виртуальные суперисток и суперсток
+	MultiTerminal bool
+
+	// Supplies чистое предложение узлов (supply - demand > 0)
+	Supplies map[int64]float64
+
+	// Demands чистый спрос узлов (demand - supply > 0)
+	Demands map[int64]float64
+}
+
+// ErrConflictingTerminals единственные узлы предложения и спроса не совпадают
+// с существующими source_id/sink_id графа
+var ErrConflictingTerminals = errors.New("supply/demand nodes conflict with source_id/sink_id")
+
+// ErrNoSupplyDemand в графе нет узлов предложения или узлов спроса
+var ErrNoSupplyDemand = errors.New("graph has no supply or no demand nodes")
+
+// ResolveTerminals определяет источник и сток графа так же, как solver-svc.
+//
+// Режим нескольких терминалов включается, если в графе есть и предложение,
+// и спрос, и при этом узлов предложения или спроса больше одного либо
+// source_id/sink_id не ссылаются на существующие узлы. В этом режиме
+// source_id и sink_id игнорируются.
+//
+// Граф с одним узлом предложения и одним узлом спроса решается между
+// source_id и sink_id, только если это те же узлы; иначе возвращается
+// ErrConflictingTerminals, а не молча теряются заданные предложение и спрос.
+func ResolveTerminals[N BalanceNode](source, sink int64, nodes []N) (*Terminals, error) {
+	t, hasSource, hasSink := collectBalances(source, sink, nodes)
+
+	if len(t.Supplies) == 0 || len(t.Demands) == 0 {
+		return t, nil
+	}
+
+	if len(t.Supplies) == 1 && len(t.Demands) == 1 && hasSource && hasSink {
+		if t.Supplies[t.Source] == 0 || t.Demands[t.Sink] == 0 {
+			return nil, fmt.Errorf("%w: supply node %d, demand node %d, source_id %d, sink_id %d",
+				ErrConflictingTerminals, t.SupplyNodes()[0], t.DemandNodes()[0], t.Source, t.Sink)
+		}
+		return t, nil
+	}
+
+	t.Source = SuperSourceID
+	t.Sink = SuperSinkID
+	t.MultiTerminal = true
+
+	return t, nil
+}
+
+// ResolveBalancedTerminals возвращает терминалы транспортной задачи: каждый
+// узел предложения и спроса связан с виртуальными терминалами независимо от
+// source_id/sink_id.
+//
+// Возвращает ErrNoSupplyDemand, если в графе нет предложения или спроса.
+func ResolveBalancedTerminals[N BalanceNode](source, sink int64, nodes []N) (*Terminals, error) {
+	t, _, _ := collectBalances(source, sink, nodes)
+
+	if len(t.Supplies) == 0 || len(t.Demands) == 0 {
+		return nil, ErrNoSupplyDemand
+	}
+
+	t.Source = SuperSourceID
+	t.Sink = SuperSinkID
+	t.MultiTerminal = true
+
+	return t, nil
+}
+
+// collectBalances собирает чистое предложение и спрос узлов и сообщает,
+// существуют ли заданные source_id и sink_id
+func collectBalances[N BalanceNode](source, sink int64, nodes []N) (t *Terminals, hasSource, hasSink bool) {
+	t = &Terminals{
+		Source:   source,
+		Sink:     sink,
+		Supplies: make(map[int64]float64),
+		Demands:  make(map[int64]float64),
+	}
+
+	for _, node := range nodes {
+		id := node.GetId()
+		if id == source {
+			hasSource = true
+		}
+		if id == sink {
+			hasSink = true
+		}
+
+		balance := node.GetSupply() - node.GetDemand()
+		switch {
+		case balance > Epsilon:
+			t.Supplies[id] += balance
+		case balance < -Epsilon:
+			t.Demands[id] += -balance
+		}
+	}
+
+	return t, hasSource, hasSink
+}
+
+// SupplyNodes возвращает узлы предложения в порядке возрастания ID
+func (t *Terminals) SupplyNodes() []int64 {
+	return sortedKeys(t.Supplies)
+}
+
+// DemandNodes возвращает узлы спроса в порядке возрастания ID
+func (t *Terminals) DemandNodes() []int64 {
+	return sortedKeys(t.Demands)
+}
+
+// SourceNodes возвращает реальные узлы, из которых выходит поток: узлы
+// предложения в режиме нескольких терминалов, иначе source_id
+func (t *Terminals) SourceNodes() []int64 {
+	if t.MultiTerminal {
+		return t.SupplyNodes()
+	}
+	return []int64{t.Source}
+}
+
+// SinkNodes возвращает реальные узлы, в которые приходит поток: узлы спроса
+// в режиме нескольких терминалов, иначе sink_id
+func (t *Terminals) SinkNodes() []int64 {
+	if t.MultiTerminal {
+		return t.DemandNodes()
+	}
+	return []int64{t.Sink}
+}
+
+// TotalSupply возвращает суммарное чистое предложение
+func (t *Terminals) TotalSupply() float64 {
+	return sumValues(t.Supplies)
+}
+
+// TotalDemand возвращает суммарный чистый спрос
+func (t *Terminals) TotalDemand() float64 {
+	return sumValues(t.Demands)
+}
+
+// IsVirtual проверяет, является ли id виртуальным суперистоком или
+// суперстоком. Для графа с одним истоком и стоком всегда false, чтобы
+// реальные узлы с отрицательными ID не принимались за виртуальные
+func (t *Terminals) IsVirtual(id int64) bool {
+	if t == nil || !t.MultiTerminal {
+		return false
+	}
+	return id == SuperSourceID || id == SuperSinkID
+}
+
+// StripVirtualNodes удаляет виртуальные терминалы из последовательности
+// узлов. Если их нет, возвращает исходный срез
+func (t *Terminals) StripVirtualNodes(nodeIDs []int64) []int64 {
+	if t == nil || !t.MultiTerminal {
+		return nodeIDs
+	}
+
+	hasVirtual := false
+	for _, id := range nodeIDs {
+		if t.IsVirtual(id) {
+			hasVirtual = true
+			break
+		}
+	}
+	if !hasVirtual {
+		return nodeIDs
+	}
+
+	result := make([]int64, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		if !t.IsVirtual(id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// sortedKeys возвращает ключи карты узлов в порядке возрастания
+func sortedKeys(m map[int64]float64) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// sumValues суммирует значения карты узлов в детерминированном порядке
+func sumValues(m map[int64]float64) float64 {
+	total := 0.0
+	for _, k := range sortedKeys(m) {
+		total += m[k]
+	}
+	return total
+}
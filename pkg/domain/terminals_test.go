@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// balanceNode узел для тестов (как *commonv1.Node)
+type balanceNode struct {
+	id             int64
+	supply, demand float64
+}
+
+func (n balanceNode) GetId() int64       { return n.id }
+func (n balanceNode) GetSupply() float64 { return n.supply }
+func (n balanceNode) GetDemand() float64 { return n.demand }
+
+func TestResolveTerminals_SingleTerminal(t *testing.T) {
+	// Без спроса граф решается между source_id и sink_id
+	nodes := []balanceNode{{id: 1, supply: 5}, {id: 2}, {id: 3}}
+
+	terminals, err := ResolveTerminals(1, 3, nodes)
+	if err != nil {
+		t.Fatalf("ResolveTerminals() error = %v", err)
+	}
+	if terminals.MultiTerminal || terminals.Source != 1 || terminals.Sink != 3 {
+		t.Errorf("terminals = %+v, want 1 → 3", terminals)
+	}
+	if !reflect.DeepEqual(terminals.SourceNodes(), []int64{1}) || !reflect.DeepEqual(terminals.SinkNodes(), []int64{3}) {
+		t.Errorf("SourceNodes/SinkNodes = %v/%v, want [1]/[3]", terminals.SourceNodes(), terminals.SinkNodes())
+	}
+}
+
+func TestResolveTerminals_MultiTerminal(t *testing.T) {
+	// Два склада и точка доставки; узел 4 с равными предложением и спросом
+	// терминалом не является
+	nodes := []balanceNode{
+		{id: 1, supply: 5},
+		{id: 2, supply: 8, demand: 2},
+		{id: 3, demand: 9},
+		{id: 4, supply: 1, demand: 1},
+	}
+
+	terminals, err := ResolveTerminals(1, 3, nodes)
+	if err != nil {
+		t.Fatalf("ResolveTerminals() error = %v", err)
+	}
+	if !terminals.MultiTerminal || terminals.Source != SuperSourceID || terminals.Sink != SuperSinkID {
+		t.Fatalf("terminals = %+v, want super-terminals", terminals)
+	}
+	if !reflect.DeepEqual(terminals.SourceNodes(), []int64{1, 2}) || !reflect.DeepEqual(terminals.SinkNodes(), []int64{3}) {
+		t.Errorf("SourceNodes/SinkNodes = %v/%v, want [1 2]/[3]", terminals.SourceNodes(), terminals.SinkNodes())
+	}
+	if terminals.TotalSupply() != 11 || terminals.TotalDemand() != 9 {
+		t.Errorf("TotalSupply/TotalDemand = %v/%v, want 11/9", terminals.TotalSupply(), terminals.TotalDemand())
+	}
+	if !terminals.IsVirtual(SuperSinkID) || terminals.IsVirtual(3) {
+		t.Error("IsVirtual must hold only for the super-terminals")
+	}
+	if got := terminals.StripVirtualNodes([]int64{SuperSourceID, 1, 3, SuperSinkID}); !reflect.DeepEqual(got, []int64{1, 3}) {
+		t.Errorf("StripVirtualNodes() = %v, want [1 3]", got)
+	}
+}
+
+func TestResolveTerminals_Conflicting(t *testing.T) {
+	// Предложение в узле 2 и спрос в узле 3 при source_id 1 и sink_id 3
+	nodes := []balanceNode{{id: 1}, {id: 2, supply: 5}, {id: 3, demand: 5}}
+
+	_, err := ResolveTerminals(1, 3, nodes)
+	if !errors.Is(err, ErrConflictingTerminals) {
+		t.Errorf("ResolveTerminals() error = %v, want ErrConflictingTerminals", err)
+	}
+}
+
+func TestResolveBalancedTerminals(t *testing.T) {
+	nodes := []balanceNode{{id: 1, supply: 5}, {id: 2, demand: 5}}
+
+	terminals, err := ResolveBalancedTerminals(1, 2, nodes)
+	if err != nil {
+		t.Fatalf("ResolveBalancedTerminals() error = %v", err)
+	}
+	if !terminals.MultiTerminal {
+		t.Error("transportation terminals must always be virtual")
+	}
+
+	if _, err := ResolveBalancedTerminals(1, 2, nodes[:1]); !errors.Is(err, ErrNoSupplyDemand) {
+		t.Errorf("ResolveBalancedTerminals() error = %v, want ErrNoSupplyDemand", err)
+	}
+}
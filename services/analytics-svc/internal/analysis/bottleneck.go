@@ -1,17 +1,21 @@
 package analysis
 
 import (
+	"fmt"
 	"sort"
 
 	analyticsv1 "logistics/gen/go/logistics/analytics/v1"
 	commonv1 "logistics/gen/go/logistics/common/v1"
 )
 
-// FindBottlenecks находит узкие места в сети
+// FindBottlenecks находит узкие места в сети и упорядочивает их по
+// предельной ценности расширения: сначала рёбра минимального разреза
 func FindBottlenecks(graph *commonv1.Graph, threshold float64, topN int32) *analyticsv1.FindBottlenecksResponse {
 	var bottlenecks []*analyticsv1.Bottleneck
+	analyzer := newMarginalAnalyzer(graph)
+	edgeIDs := make(map[*commonv1.Edge]int64)
 
-	for _, edge := range graph.Edges {
+	for i, edge := range graph.Edges {
 		// Пропускаем виртуальные узлы
 		if IsVirtualNode(edge.From) || IsVirtualNode(edge.To) {
 			continue
@@ -26,21 +30,24 @@ func FindBottlenecks(graph *commonv1.Graph, threshold float64, topN int32) *anal
 
 		if utilization >= threshold {
 			severity := calculateSeverity(utilization)
-			impact := calculateImpactScore(edge, graph)
+			value := analyzer.evaluate(edge)
+			edgeIDs[edge] = EdgeID(edge, i)
 
 			bottlenecks = append(bottlenecks, &analyticsv1.Bottleneck{
-				Edge:        edge,
-				Utilization: utilization,
-				ImpactScore: impact,
-				Severity:    severity,
+				Edge:               edge,
+				Utilization:        utilization,
+				ImpactScore:        value.impact,
+				Severity:           severity,
+				InMinCut:           value.inMinCut,
+				MarginalFlowGain:   value.marginalFlowGain,
+				FlowGain:           value.flowGain,
+				MarginalCostSaving: value.marginalCostSaving,
+				CostSaving:         value.costSaving,
 			})
 		}
 	}
 
-	// Сортируем по utilization (убывание)
-	sort.Slice(bottlenecks, func(i, j int) bool {
-		return bottlenecks[i].Utilization > bottlenecks[j].Utilization
-	})
+	sortBottlenecks(bottlenecks)
 
 	// Ограничиваем количество
 	if topN > 0 && int(topN) < len(bottlenecks) {
@@ -48,7 +55,7 @@ func FindBottlenecks(graph *commonv1.Graph, threshold float64, topN int32) *anal
 	}
 
 	// Генерируем рекомендации
-	recommendations := generateRecommendations(bottlenecks)
+	recommendations := generateRecommendations(bottlenecks, edgeIDs)
 
 	return &analyticsv1.FindBottlenecksResponse{
 		Bottlenecks:     bottlenecks,
@@ -69,38 +76,52 @@ func calculateSeverity(utilization float64) analyticsv1.BottleneckSeverity {
 	}
 }
 
-func calculateImpactScore(edge *commonv1.Edge, graph *commonv1.Graph) float64 {
-	// Упрощённая оценка влияния: доля потока через это ребро
-	totalFlow := 0.0
-	for _, e := range graph.Edges {
-		if !IsVirtualNode(e.From) && !IsVirtualNode(e.To) {
-			totalFlow += e.CurrentFlow
+// sortBottlenecks упорядочивает узкие места: рёбра минимального разреза,
+// затем по выигрышу от расширения, затем по загрузке
+func sortBottlenecks(bottlenecks []*analyticsv1.Bottleneck) {
+	sort.SliceStable(bottlenecks, func(i, j int) bool {
+		a, b := bottlenecks[i], bottlenecks[j]
+		if a.InMinCut != b.InMinCut {
+			return a.InMinCut
 		}
-	}
-
-	if totalFlow <= Epsilon {
-		return 0.0
-	}
-
-	return edge.CurrentFlow / totalFlow
+		if a.ImpactScore != b.ImpactScore {
+			return a.ImpactScore > b.ImpactScore
+		}
+		return a.Utilization > b.Utilization
+	})
 }
 
-func generateRecommendations(bottlenecks []*analyticsv1.Bottleneck) []*analyticsv1.Recommendation {
+// generateRecommendations рекомендует расширение рёбер, удвоение которых
+// увеличивает поток или снижает стоимость; edgeIDs задаёт EdgeID рёбер
+func generateRecommendations(bottlenecks []*analyticsv1.Bottleneck, edgeIDs map[*commonv1.Edge]int64) []*analyticsv1.Recommendation {
 	var recommendations []*analyticsv1.Recommendation
 
 	for _, b := range bottlenecks {
-		if b.Severity >= analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_HIGH {
-			recommendations = append(recommendations, &analyticsv1.Recommendation{
-				Type:        "increase_capacity",
-				Description: "Увеличьте пропускную способность ребра для устранения узкого места",
-				AffectedEdge: &commonv1.EdgeKey{
-					From: b.Edge.From,
-					To:   b.Edge.To,
-				},
-				EstimatedImprovement: (1.0 - b.Utilization) * 100, // Потенциальное улучшение в %
-				EstimatedCost:        b.Edge.Capacity * 0.5,       // Примерная оценка
-			})
+		var description string
+		switch {
+		case b.FlowGain > Epsilon:
+			description = fmt.Sprintf(
+				"Ребро %d→%d входит в минимальный разрез: удвоение пропускной способности увеличит максимальный поток на %.2f",
+				b.Edge.From, b.Edge.To, b.FlowGain)
+		case b.CostSaving > Epsilon:
+			description = fmt.Sprintf(
+				"Удвоение пропускной способности ребра %d→%d снизит стоимость потока на %.2f (%.2f за единицу)",
+				b.Edge.From, b.Edge.To, b.CostSaving, b.MarginalCostSaving)
+		default:
+			continue // Расширение ребра ничего не даёт
 		}
+
+		recommendations = append(recommendations, &analyticsv1.Recommendation{
+			Type:        "increase_capacity",
+			Description: description,
+			AffectedEdge: &commonv1.EdgeKey{
+				From:   b.Edge.From,
+				To:     b.Edge.To,
+				EdgeId: edgeIDs[b.Edge],
+			},
+			EstimatedImprovement: b.ImpactScore * 100,
+			EstimatedCost:        b.Edge.Capacity * 0.5, // Примерная оценка
+		})
 	}
 
 	return recommendations
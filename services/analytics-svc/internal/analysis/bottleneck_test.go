@@ -2,6 +2,7 @@ package analysis
 
 import (
 	"testing"
+	"time"
 
 	analyticsv1 "logistics/gen/go/logistics/analytics/v1"
 	commonv1 "logistics/gen/go/logistics/common/v1"
@@ -78,8 +79,10 @@ func TestFindBottlenecks(t *testing.T) {
 			expectedCount: 2,
 		},
 		{
-			name: "sorts by utilization descending",
+			name: "ranks min-cut edges first",
 			graph: &commonv1.Graph{
+				SourceId: 1,
+				SinkId:   4,
 				Edges: []*commonv1.Edge{
 					{From: 1, To: 2, Capacity: 100, CurrentFlow: 90, Cost: 1},
 					{From: 2, To: 3, Capacity: 100, CurrentFlow: 100, Cost: 1},
@@ -110,8 +113,10 @@ func TestFindBottlenecks(t *testing.T) {
 
 			// Verify sorted order
 			for i := 1; i < len(result.Bottlenecks); i++ {
-				if result.Bottlenecks[i].Utilization > result.Bottlenecks[i-1].Utilization {
-					t.Errorf("Bottlenecks not sorted by utilization descending")
+				prev, cur := result.Bottlenecks[i-1], result.Bottlenecks[i]
+				if cur.InMinCut && !prev.InMinCut ||
+					cur.InMinCut == prev.InMinCut && cur.ImpactScore > prev.ImpactScore {
+					t.Errorf("Bottlenecks not sorted by marginal value")
 				}
 			}
 		})
@@ -162,66 +167,165 @@ func TestCalculateSeverity(t *testing.T) {
 	}
 }
 
-func TestCalculateImpactScore(t *testing.T) {
-	tests := []struct {
-		name          string
-		edge          *commonv1.Edge
-		graph         *commonv1.Graph
-		expectedScore float64
-	}{
-		{
-			name: "half of total flow",
-			edge: &commonv1.Edge{From: 1, To: 2, CurrentFlow: 50},
-			graph: &commonv1.Graph{
-				Edges: []*commonv1.Edge{
-					{From: 1, To: 2, CurrentFlow: 50},
-					{From: 2, To: 3, CurrentFlow: 50},
-				},
-			},
-			expectedScore: 0.5,
-		},
-		{
-			name: "all flow through one edge",
-			edge: &commonv1.Edge{From: 1, To: 2, CurrentFlow: 100},
-			graph: &commonv1.Graph{
-				Edges: []*commonv1.Edge{
-					{From: 1, To: 2, CurrentFlow: 100},
-				},
-			},
-			expectedScore: 1.0,
+func TestFindBottlenecks_MarginalValue(t *testing.T) {
+	// Загруженное ребро 1→2 не мешает потоку: сток ограничен ребром 3→4
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 50, CurrentFlow: 50, Cost: 1},
+			{From: 1, To: 3, Capacity: 100, CurrentFlow: 20, Cost: 1},
+			{From: 2, To: 3, Capacity: 60, CurrentFlow: 50, Cost: 0},
+			{From: 3, To: 4, Capacity: 70, CurrentFlow: 70, Cost: 1},
 		},
-		{
-			name: "zero total flow",
-			edge: &commonv1.Edge{From: 1, To: 2, CurrentFlow: 0},
-			graph: &commonv1.Graph{
-				Edges: []*commonv1.Edge{
-					{From: 1, To: 2, CurrentFlow: 0},
-				},
-			},
-			expectedScore: 0.0,
+	}
+
+	result := FindBottlenecks(graph, 0.9, 0)
+
+	if len(result.Bottlenecks) != 2 {
+		t.Fatalf("Expected 2 bottlenecks, got %d", len(result.Bottlenecks))
+	}
+	first, second := result.Bottlenecks[0], result.Bottlenecks[1]
+	if first.Edge.From != 3 || !first.InMinCut {
+		t.Errorf("Expected min-cut edge 3->4 first, got %d->%d", first.Edge.From, first.Edge.To)
+	}
+	if first.MarginalFlowGain != 1 || !floatEquals(first.FlowGain, 70, 0.0001) {
+		t.Errorf("Edge 3->4: marginal gain = %v, gain = %v", first.MarginalFlowGain, first.FlowGain)
+	}
+	if !floatEquals(first.ImpactScore, 1, 0.0001) {
+		t.Errorf("Edge 3->4: impact = %v, want 1", first.ImpactScore)
+	}
+	if second.InMinCut || second.FlowGain != 0 || second.ImpactScore != 0 {
+		t.Errorf("Edge 1->2 should have no marginal value, got %+v", second)
+	}
+
+	if len(result.Recommendations) != 1 {
+		t.Fatalf("Expected 1 recommendation, got %d", len(result.Recommendations))
+	}
+	if !floatEquals(result.Recommendations[0].EstimatedImprovement, 100, 0.0001) {
+		t.Errorf("EstimatedImprovement = %v, want 100", result.Recommendations[0].EstimatedImprovement)
+	}
+}
+
+func TestFindBottlenecks_CostSaving(t *testing.T) {
+	// Поток ограничен ребром 4→5; дешёвый путь 1→2→4 насыщен, и часть
+	// потока идёт по дорогому 1→3→4
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   5,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, CurrentFlow: 10, Cost: 1},
+			{From: 2, To: 4, Capacity: 20, CurrentFlow: 10, Cost: 1},
+			{From: 1, To: 3, Capacity: 20, CurrentFlow: 5, Cost: 4},
+			{From: 3, To: 4, Capacity: 20, CurrentFlow: 5, Cost: 1},
+			{From: 4, To: 5, Capacity: 15, CurrentFlow: 15},
 		},
-		{
-			name: "ignores virtual nodes in total",
-			edge: &commonv1.Edge{From: 1, To: 2, CurrentFlow: 50},
-			graph: &commonv1.Graph{
-				Edges: []*commonv1.Edge{
-					{From: -1, To: 1, CurrentFlow: 100},
-					{From: 1, To: 2, CurrentFlow: 50},
-					{From: 2, To: 3, CurrentFlow: 50},
-				},
-			},
-			expectedScore: 0.5,
+	}
+
+	result := FindBottlenecks(graph, 0.9, 0)
+
+	var cheap *analyticsv1.Bottleneck
+	for _, b := range result.Bottlenecks {
+		if b.Edge.From == 1 && b.Edge.To == 2 {
+			cheap = b
+		}
+	}
+	if cheap == nil {
+		t.Fatal("Edge 1->2 not reported")
+	}
+	if first := result.Bottlenecks[0]; first.Edge.From != 4 || !floatEquals(first.FlowGain, 15, 0.0001) {
+		t.Errorf("Expected min-cut edge 4->5 first with gain 15, got %+v", first)
+	}
+	if cheap.InMinCut {
+		t.Error("Edge 1->2 should not be in min cut")
+	}
+	// Перенос единицы с 1→3→4 на 1→2→4 экономит 5 - 2 = 3, переносится 5 единиц
+	if !floatEquals(cheap.MarginalCostSaving, 3, 0.0001) || !floatEquals(cheap.CostSaving, 15, 0.0001) {
+		t.Errorf("Edge 1->2: marginal saving = %v, saving = %v", cheap.MarginalCostSaving, cheap.CostSaving)
+	}
+	if !floatEquals(cheap.ImpactScore, 15.0/45, 0.0001) {
+		t.Errorf("Edge 1->2: impact = %v, want %v", cheap.ImpactScore, 15.0/45)
+	}
+}
+
+func TestFindBottlenecks_MultiTerminal(t *testing.T) {
+	// Склады 1 и 2 по 10, точка 3 ждёт 20 и получила 15: склад 2 отгрузил
+	// всё, и расширение его дороги поток не увеличит
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{{Id: 1, Supply: 10}, {Id: 2, Supply: 10}, {Id: 3, Demand: 20}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 3, Capacity: 5, CurrentFlow: 5, Cost: 1},
+			{From: 2, To: 3, Capacity: 10, CurrentFlow: 10, Cost: 1},
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := calculateImpactScore(tt.edge, tt.graph)
-			if !floatEquals(result, tt.expectedScore, 0.0001) {
-				t.Errorf("calculateImpactScore() = %v, want %v",
-					result, tt.expectedScore)
+	result := FindBottlenecks(graph, 0.9, 0)
+
+	if len(result.Bottlenecks) != 2 {
+		t.Fatalf("Expected 2 bottlenecks, got %d", len(result.Bottlenecks))
+	}
+	first, second := result.Bottlenecks[0], result.Bottlenecks[1]
+	if first.Edge.From != 1 || !first.InMinCut || !floatEquals(first.FlowGain, 5, 0.0001) {
+		t.Errorf("Expected min-cut edge 1->3 with gain 5, got %+v", first)
+	}
+	if !floatEquals(first.ImpactScore, 5.0/15, 0.0001) {
+		t.Errorf("Edge 1->3: impact = %v, want %v", first.ImpactScore, 5.0/15)
+	}
+	if second.InMinCut || second.FlowGain != 0 {
+		t.Errorf("Edge 2->3 should have no flow gain, got %+v", second)
+	}
+}
+
+func TestFindBottlenecks_LargeGrid(t *testing.T) {
+	// Решётка 40x40: все рёбра вправо и вниз насыщены, и каждое оценивается
+	// на экономию стоимости
+	const size = 40
+	id := func(r, c int) int64 { return int64(r*size + c + 1) }
+	graph := &commonv1.Graph{SourceId: id(0, 0), SinkId: id(size-1, size-1)}
+	for r := 0; r < size; r++ {
+		for c := 0; c < size; c++ {
+			graph.Nodes = append(graph.Nodes, &commonv1.Node{Id: id(r, c)})
+			if c+1 < size {
+				graph.Edges = append(graph.Edges,
+					&commonv1.Edge{From: id(r, c), To: id(r, c+1), Capacity: 1, CurrentFlow: 1, Cost: 1},
+					&commonv1.Edge{From: id(r, c+1), To: id(r, c), Capacity: 1, Cost: 1})
 			}
-		})
+			if r+1 < size {
+				graph.Edges = append(graph.Edges,
+					&commonv1.Edge{From: id(r, c), To: id(r+1, c), Capacity: 1, CurrentFlow: 1, Cost: 2},
+					&commonv1.Edge{From: id(r+1, c), To: id(r, c), Capacity: 1, Cost: 2})
+			}
+		}
+	}
+
+	start := time.Now()
+	result := FindBottlenecks(graph, 0.9, 0)
+	elapsed := time.Since(start)
+
+	if want := 2 * size * (size - 1); len(result.Bottlenecks) != want {
+		t.Errorf("Expected %d bottlenecks, got %d", want, len(result.Bottlenecks))
+	}
+	if elapsed > 2*time.Second {
+		t.Errorf("FindBottlenecks took %v on a %dx%d grid", elapsed, size, size)
+	}
+}
+
+func TestNewResidualNetwork_InferredTerminals(t *testing.T) {
+	r := newResidualNetwork(&commonv1.Graph{
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, CurrentFlow: 10, Cost: 2},
+			{From: 2, To: 3, Capacity: 10, CurrentFlow: 4, Cost: 1},
+		},
+	})
+
+	if len(r.sources) != 1 || r.sources[0] != 1 {
+		t.Errorf("sources = %v, want [1]", r.sources)
+	}
+	if len(r.sinks) != 2 {
+		t.Errorf("sinks = %v, want [2 3]", r.sinks)
+	}
+	if !floatEquals(r.totalFlow, 10, 0.0001) || !floatEquals(r.totalCost, 24, 0.0001) {
+		t.Errorf("totalFlow = %v, totalCost = %v", r.totalFlow, r.totalCost)
 	}
 }
 
@@ -232,33 +336,36 @@ func TestGenerateRecommendations(t *testing.T) {
 		expectedCount int
 	}{
 		{
-			name: "generates recommendations for high severity",
+			name: "generates recommendations for flow gain",
 			bottlenecks: []*analyticsv1.Bottleneck{
 				{
-					Severity:    analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_HIGH,
-					Utilization: 0.95,
+					Severity:    analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_CRITICAL,
+					Utilization: 1.0,
+					InMinCut:    true,
+					FlowGain:    20,
 					Edge:        &commonv1.Edge{From: 1, To: 2, Capacity: 100},
 				},
 			},
 			expectedCount: 1,
 		},
 		{
-			name: "generates recommendations for critical severity",
+			name: "generates recommendations for cost saving",
 			bottlenecks: []*analyticsv1.Bottleneck{
 				{
 					Severity:    analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_CRITICAL,
 					Utilization: 1.0,
+					CostSaving:  15,
 					Edge:        &commonv1.Edge{From: 1, To: 2, Capacity: 100},
 				},
 			},
 			expectedCount: 1,
 		},
 		{
-			name: "no recommendations for low severity",
+			name: "no recommendations without marginal value",
 			bottlenecks: []*analyticsv1.Bottleneck{
 				{
-					Severity:    analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_LOW,
-					Utilization: 0.85,
+					Severity:    analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_CRITICAL,
+					Utilization: 1.0,
 					Edge:        &commonv1.Edge{From: 1, To: 2, Capacity: 100},
 				},
 			},
@@ -273,7 +380,7 @@ func TestGenerateRecommendations(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := generateRecommendations(tt.bottlenecks)
+			result := generateRecommendations(tt.bottlenecks, nil)
 			if len(result) != tt.expectedCount {
 				t.Errorf("generateRecommendations() returned %d recommendations, want %d",
 					len(result), tt.expectedCount)
@@ -384,16 +491,19 @@ func TestFindBottlenecks_AllSaturated(t *testing.T) {
 	}
 }
 
-func TestGenerateRecommendations_MultipleSeverities(t *testing.T) {
+func TestGenerateRecommendations_MixedValues(t *testing.T) {
 	bottlenecks := []*analyticsv1.Bottleneck{
 		{
 			Severity:    analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_CRITICAL,
 			Utilization: 1.0,
+			InMinCut:    true,
+			FlowGain:    30,
 			Edge:        &commonv1.Edge{From: 1, To: 2, Capacity: 100},
 		},
 		{
-			Severity:    analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_HIGH,
-			Utilization: 0.96,
+			Severity:    analyticsv1.BottleneckSeverity_BOTTLENECK_SEVERITY_CRITICAL,
+			Utilization: 1.0,
+			CostSaving:  12,
 			Edge:        &commonv1.Edge{From: 2, To: 3, Capacity: 100},
 		},
 		{
@@ -408,10 +518,10 @@ func TestGenerateRecommendations_MultipleSeverities(t *testing.T) {
 		},
 	}
 
-	result := generateRecommendations(bottlenecks)
+	result := generateRecommendations(bottlenecks, nil)
 
-	// Рекомендации только для HIGH и CRITICAL
+	// Рекомендации только для рёбер, расширение которых что-то даёт
 	if len(result) != 2 {
-		t.Errorf("Expected 2 recommendations (CRITICAL + HIGH), got %d", len(result))
+		t.Errorf("Expected 2 recommendations (flow gain + cost saving), got %d", len(result))
 	}
 }
@@ -171,6 +171,9 @@ func calculateNodeFixedCosts(
 		switch node.Type {
 		case commonv1.NodeType_NODE_TYPE_WAREHOUSE:
 			nodeCost = cfg.WarehouseCost
+			if v, ok := cfg.WarehouseCosts[node.Id]; ok {
+				nodeCost = v
+			}
 			if activeNodes[node.Id] {
 				stats.activeWarehouses++
 			}
@@ -268,6 +271,7 @@ func CreateCostOptions() *CostOptionsBuilder {
 			CostMultipliers: make(map[string]float64),
 			FixedCosts: &analyticsv1.FixedCostConfig{
 				RoadTypeBaseCosts: make(map[string]float64),
+				WarehouseCosts:    make(map[int64]float64),
 			},
 		},
 	}
@@ -298,6 +302,11 @@ func (b *CostOptionsBuilder) WarehouseCost(v float64) *CostOptionsBuilder {
 	return b
 }
 
+func (b *CostOptionsBuilder) NodeWarehouseCost(nodeID int64, v float64) *CostOptionsBuilder {
+	b.opts.FixedCosts.WarehouseCosts[nodeID] = v
+	return b
+}
+
 func (b *CostOptionsBuilder) DeliveryPointCost(v float64) *CostOptionsBuilder {
 	b.opts.FixedCosts.DeliveryPointCost = v
 	return b
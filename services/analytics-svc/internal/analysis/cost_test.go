@@ -322,6 +322,37 @@ func TestCalculateCost_WithFullMode(t *testing.T) {
 	}
 }
 
+func TestCalculateCost_PerNodeWarehouseCost(t *testing.T) {
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE},
+			{Id: 2, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE},
+			{Id: 3, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT},
+		},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 3, CurrentFlow: 5, Cost: 1, Capacity: 10},
+			{From: 2, To: 3, CurrentFlow: 5, Cost: 1, Capacity: 10},
+		},
+	}
+
+	opts := CreateCostOptions().
+		Mode(analyticsv1.CostCalculationMode_COST_CALCULATION_MODE_WITH_FIXED).
+		WarehouseCost(1000).
+		NodeWarehouseCost(2, 300).
+		Build()
+
+	result := CalculateCost(graph, opts)
+
+	// Склад 1 стоит warehouse_cost, склад 2 — своё значение
+	if result.Breakdown.FixedCost != 1300 {
+		t.Errorf("FixedCost = %v, want 1300", result.Breakdown.FixedCost)
+	}
+	if result.Breakdown.CostByNodeType["NODE_TYPE_WAREHOUSE"] != 1300 {
+		t.Errorf("CostByNodeType[WAREHOUSE] = %v, want 1300",
+			result.Breakdown.CostByNodeType["NODE_TYPE_WAREHOUSE"])
+	}
+}
+
 func TestCalculateCost_DiscountAndMarkupCombined(t *testing.T) {
 	graph := &commonv1.Graph{
 		Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}},
@@ -0,0 +1,336 @@
+package analysis
+
+import (
+	"container/heap"
+	"math"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/pkg/domain"
+)
+
+// residualArc дуга остаточной сети; to — индекс узла
+type residualArc struct {
+	to       int
+	capacity float64
+	cost     float64
+}
+
+// residualNetwork остаточная сеть решённого графа: прямая дуга с остатком
+// пропускной способности и обратная дуга с текущим потоком. Узлы
+// пронумерованы подряд, чтобы поиск путей обходился без карт
+type residualNetwork struct {
+	ids     []int64
+	index   map[int64]int
+	out     [][]residualArc
+	in      [][]residualArc // Обратные дуги: to — начало дуги
+	sources []int64
+	sinks   []int64
+
+	totalFlow float64
+	totalCost float64
+
+	// potential потенциалы узлов (Беллман–Форд): приведённые стоимости дуг
+	// неотрицательны. nil, если в сети есть цикл отрицательной стоимости
+	potential []float64
+}
+
+func newResidualNetwork(graph *commonv1.Graph) *residualNetwork {
+	r := &residualNetwork{index: make(map[int64]int)}
+
+	balance := make(map[int64]float64) // Исходящий минус входящий поток
+	for _, edge := range graph.Edges {
+		if IsVirtualNode(edge.From) || IsVirtualNode(edge.To) {
+			continue
+		}
+		flow := math.Max(edge.CurrentFlow, 0)
+		r.addArc(edge.From, edge.To, edge.Capacity-flow, edge.Cost)
+		r.addArc(edge.To, edge.From, flow, -edge.Cost)
+		balance[edge.From] += flow
+		balance[edge.To] -= flow
+		r.totalCost += flow * edge.Cost
+	}
+
+	r.resolvePoles(graph, balance)
+	r.potential = r.bellmanFord()
+	return r
+}
+
+// resolvePoles определяет истоки и стоки так же, как solver-svc
+// (domain.ResolveTerminals). Для графа с несколькими узлами предложения
+// или спроса добавляются суперисток и суперсток с дугами на оставшиеся
+// предложение и спрос узлов; без заданных истока и стока полюса
+// определяются по балансу потока
+func (r *residualNetwork) resolvePoles(graph *commonv1.Graph, balance map[int64]float64) {
+	terminals, err := domain.ResolveTerminals(graph.SourceId, graph.SinkId, graph.Nodes)
+	if err == nil && terminals.MultiTerminal {
+		for _, id := range terminals.SupplyNodes() {
+			supply := terminals.Supplies[id]
+			shipped := math.Min(math.Max(balance[id], 0), supply)
+			r.addArc(domain.SuperSourceID, id, supply-shipped, 0)
+			r.addArc(id, domain.SuperSourceID, shipped, 0)
+			r.totalFlow += shipped
+		}
+		for _, id := range terminals.DemandNodes() {
+			demand := terminals.Demands[id]
+			received := math.Min(math.Max(-balance[id], 0), demand)
+			r.addArc(id, domain.SuperSinkID, demand-received, 0)
+			r.addArc(domain.SuperSinkID, id, received, 0)
+		}
+		r.sources = []int64{domain.SuperSourceID}
+		r.sinks = []int64{domain.SuperSinkID}
+		return
+	}
+
+	_, hasSource := r.index[graph.SourceId]
+	_, hasSink := r.index[graph.SinkId]
+	if graph.SourceId != graph.SinkId && hasSource && hasSink {
+		r.sources = []int64{graph.SourceId}
+		r.sinks = []int64{graph.SinkId}
+		r.totalFlow = balance[graph.SourceId]
+		return
+	}
+	for _, id := range r.ids {
+		switch {
+		case balance[id] > Epsilon:
+			r.sources = append(r.sources, id)
+			r.totalFlow += balance[id]
+		case balance[id] < -Epsilon:
+			r.sinks = append(r.sinks, id)
+		}
+	}
+}
+
+// node возвращает индекс узла, добавляя его при первом обращении
+func (r *residualNetwork) node(id int64) int {
+	if i, ok := r.index[id]; ok {
+		return i
+	}
+	i := len(r.ids)
+	r.index[id] = i
+	r.ids = append(r.ids, id)
+	r.out = append(r.out, nil)
+	r.in = append(r.in, nil)
+	return i
+}
+
+func (r *residualNetwork) addArc(from, to int64, capacity, cost float64) {
+	u, v := r.node(from), r.node(to)
+	if capacity <= Epsilon {
+		return
+	}
+	r.out[u] = append(r.out[u], residualArc{to: v, capacity: capacity, cost: cost})
+	r.in[v] = append(r.in[v], residualArc{to: u, capacity: capacity, cost: cost})
+}
+
+// bellmanFord считает потенциалы узлов от виртуального корня, связанного
+// со всеми узлами дугами нулевой стоимости. Возвращает nil при цикле
+// отрицательной стоимости: поток не оптимален по стоимости, и предельная
+// экономия не определена
+func (r *residualNetwork) bellmanFord() []float64 {
+	potential := make([]float64, len(r.ids))
+	for i := 0; i <= len(r.ids); i++ {
+		changed := false
+		for u, arcs := range r.out {
+			for _, arc := range arcs {
+				if d := potential[u] + arc.cost; d < potential[arc.to]-Epsilon {
+					potential[arc.to] = d
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			return potential
+		}
+	}
+	return nil
+}
+
+// widest возвращает для каждого узла наибольшую пропускную способность пути
+// от полюсов (по прямым дугам) или до полюсов (по обратным); 0 — пути нет
+func (r *residualNetwork) widest(poles []int64, adjacency [][]residualArc) []float64 {
+	width := make([]float64, len(r.ids))
+	pq := &widthQueue{}
+	for _, id := range poles {
+		i := r.index[id]
+		width[i] = math.Inf(1)
+		heap.Push(pq, widthItem{node: i, width: width[i]})
+	}
+
+	done := make([]bool, len(r.ids))
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(widthItem)
+		if done[item.node] {
+			continue
+		}
+		done[item.node] = true
+		for _, arc := range adjacency[item.node] {
+			w := math.Min(item.width, arc.capacity)
+			if w > width[arc.to] {
+				width[arc.to] = w
+				heap.Push(pq, widthItem{node: arc.to, width: w})
+			}
+		}
+	}
+	return width
+}
+
+// shortestPaths дерево путей минимальной стоимости из одного узла
+type shortestPaths struct {
+	dist    []float64 // Приведённая стоимость пути
+	width   []float64 // Наименьший остаток дуг пути
+	reached []bool
+}
+
+// cheapestPaths ищет пути минимальной стоимости из from алгоритмом
+// Дейкстры по приведённым стоимостям дуг
+func (r *residualNetwork) cheapestPaths(from int) *shortestPaths {
+	n := len(r.ids)
+	sp := &shortestPaths{
+		dist:    make([]float64, n),
+		width:   make([]float64, n),
+		reached: make([]bool, n),
+	}
+	sp.reached[from] = true
+	sp.width[from] = math.Inf(1)
+
+	done := make([]bool, n)
+	pq := &distQueue{{node: from}}
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(distItem)
+		u := item.node
+		if done[u] {
+			continue
+		}
+		done[u] = true
+		for _, arc := range r.out[u] {
+			reduced := math.Max(arc.cost+r.potential[u]-r.potential[arc.to], 0)
+			d := sp.dist[u] + reduced
+			if done[arc.to] || sp.reached[arc.to] && d >= sp.dist[arc.to]-Epsilon {
+				continue
+			}
+			sp.reached[arc.to] = true
+			sp.dist[arc.to] = d
+			sp.width[arc.to] = math.Min(sp.width[u], arc.capacity)
+			heap.Push(pq, distItem{node: arc.to, dist: d})
+		}
+	}
+	return sp
+}
+
+// edgeMarginalValue предельная ценность расширения ребра
+type edgeMarginalValue struct {
+	inMinCut           bool
+	marginalFlowGain   float64
+	flowGain           float64
+	marginalCostSaving float64
+	costSaving         float64
+	impact             float64
+}
+
+// marginalAnalyzer считает предельную ценность рёбер по одной остаточной сети
+type marginalAnalyzer struct {
+	residual *residualNetwork
+	fromSrc  []float64 // Ширина пути от истоков
+	toSink   []float64 // Ширина пути до стоков
+
+	// paths деревья кратчайших путей по начальному узлу: рёбра с общим
+	// концом оцениваются по одному дереву
+	paths map[int]*shortestPaths
+}
+
+func newMarginalAnalyzer(graph *commonv1.Graph) *marginalAnalyzer {
+	r := newResidualNetwork(graph)
+	return &marginalAnalyzer{
+		residual: r,
+		fromSrc:  r.widest(r.sources, r.out),
+		toSink:   r.widest(r.sinks, r.in),
+		paths:    make(map[int]*shortestPaths),
+	}
+}
+
+// evaluate оценивает выигрыш от удвоения пропускной способности ребра
+func (a *marginalAnalyzer) evaluate(edge *commonv1.Edge) edgeMarginalValue {
+	var v edgeMarginalValue
+	if edge.Capacity-edge.CurrentFlow > Epsilon {
+		return v // Ненасыщенное ребро не ограничивает ни поток, ни стоимость
+	}
+	r := a.residual
+	u, okU := r.index[edge.From]
+	w, okW := r.index[edge.To]
+	if !okU || !okW {
+		return v
+	}
+
+	// Ребро минимального разреза: появляется увеличивающий путь через него
+	if wu, wv := a.fromSrc[u], a.toSink[w]; wu > 0 && wv > 0 {
+		v.inMinCut = true
+		v.marginalFlowGain = 1
+		v.flowGain = math.Min(edge.Capacity, math.Min(wu, wv))
+		if r.totalFlow > Epsilon {
+			v.impact = v.flowGain / r.totalFlow
+		}
+		return v
+	}
+
+	// Иначе — цикл отрицательной стоимости через новую пропускную способность
+	if r.potential == nil {
+		return v
+	}
+	sp, ok := a.paths[w]
+	if !ok {
+		sp = r.cheapestPaths(w)
+		a.paths[w] = sp
+	}
+	if !sp.reached[u] {
+		return v
+	}
+	dist := sp.dist[u] + r.potential[u] - r.potential[w]
+	reduced := edge.Cost + dist
+	if reduced >= -Epsilon {
+		return v
+	}
+	v.marginalCostSaving = -reduced
+	v.costSaving = v.marginalCostSaving * math.Min(edge.Capacity, sp.width[u])
+	if r.totalCost > Epsilon {
+		v.impact = v.costSaving / r.totalCost
+	}
+	return v
+}
+
+type widthItem struct {
+	node  int
+	width float64
+}
+
+// widthQueue max-куча по ширине пути
+type widthQueue []widthItem
+
+func (q widthQueue) Len() int           { return len(q) }
+func (q widthQueue) Less(i, j int) bool { return q[i].width > q[j].width }
+func (q widthQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *widthQueue) Push(x any)        { *q = append(*q, x.(widthItem)) }
+func (q *widthQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+type distItem struct {
+	node int
+	dist float64
+}
+
+// distQueue min-куча по стоимости пути
+type distQueue []distItem
+
+func (q distQueue) Len() int           { return len(q) }
+func (q distQueue) Less(i, j int) bool { return q[i].dist < q[j].dist }
+func (q distQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *distQueue) Push(x any)        { *q = append(*q, x.(distItem)) }
+func (q *distQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
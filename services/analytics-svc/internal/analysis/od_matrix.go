@@ -0,0 +1,184 @@
+package analysis
+
+import (
+	"sort"
+
+	analyticsv1 "logistics/gen/go/logistics/analytics/v1"
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/pkg/domain"
+)
+
+// odKey пара «склад — точка доставки»
+type odKey struct {
+	origin      int64
+	destination int64
+}
+
+// odAccumulator накопленные объём, стоимость и длина путей
+type odAccumulator struct {
+	volume       float64
+	cost         float64
+	lengthVolume float64 // Сумма длин путей, взвешенных по объёму
+	paths        int32
+}
+
+func (a *odAccumulator) add(p *domain.Path) {
+	a.volume += p.Flow
+	a.cost += p.Cost
+	a.lengthVolume += p.Length * p.Flow
+	a.paths++
+}
+
+func (a *odAccumulator) averageCost() float64 {
+	if a.volume <= Epsilon {
+		return 0
+	}
+	return a.cost / a.volume
+}
+
+func (a *odAccumulator) averageLength() float64 {
+	if a.volume <= Epsilon {
+		return 0
+	}
+	return a.lengthVolume / a.volume
+}
+
+// BuildOriginDestinationMatrix строит матрицу корреспонденций решённого
+// графа: поток раскладывается на пути (domain.DecomposeFlow), и объём
+// каждого пути относится к паре «склад — точка доставки».
+//
+// Складом пути считается первый узел типа WAREHOUSE на нём, точкой
+// доставки — последний узел типа DELIVERY_POINT после склада. Если таких
+// узлов нет, берутся начало и конец пути. Стоимость и длина считаются по
+// всему пути, включая рёбра от истока и к стоку.
+func BuildOriginDestinationMatrix(graph *commonv1.Graph) *analyticsv1.BuildOriginDestinationMatrixResponse {
+	nodes := make(map[int64]*commonv1.Node, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		nodes[node.Id] = node
+	}
+
+	arcs := make([]domain.FlowArc, 0, len(graph.Edges))
+	for _, edge := range graph.Edges {
+		if IsVirtualNode(edge.From) || IsVirtualNode(edge.To) {
+			continue
+		}
+		arcs = append(arcs, domain.FlowArc{
+			From:   edge.From,
+			To:     edge.To,
+			Flow:   edge.CurrentFlow,
+			Cost:   edge.Cost,
+			Length: edge.Length,
+		})
+	}
+	decomposition := domain.DecomposeFlow(arcs)
+
+	cells := make(map[odKey]*odAccumulator)
+	origins := make(map[int64]*odAccumulator)
+	destinations := make(map[int64]*odAccumulator)
+	total := &odAccumulator{}
+	accumulator := func(m map[int64]*odAccumulator, id int64) *odAccumulator {
+		if m[id] == nil {
+			m[id] = &odAccumulator{}
+		}
+		return m[id]
+	}
+
+	for _, path := range decomposition.Paths {
+		key := pathEndpoints(path.Nodes, nodes)
+		if cells[key] == nil {
+			cells[key] = &odAccumulator{}
+		}
+		cells[key].add(path)
+		accumulator(origins, key.origin).add(path)
+		accumulator(destinations, key.destination).add(path)
+		total.add(path)
+	}
+
+	result := &analyticsv1.BuildOriginDestinationMatrixResponse{
+		TotalVolume:   total.volume,
+		TotalCost:     total.cost,
+		AverageCost:   total.averageCost(),
+		AverageLength: total.averageLength(),
+		PathCount:     total.paths,
+	}
+	for _, cycle := range decomposition.Cycles {
+		result.CycleVolume += cycle.Flow
+		result.CycleCost += cycle.Cost
+	}
+
+	keys := make([]odKey, 0, len(cells))
+	for key := range cells {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].origin != keys[j].origin {
+			return keys[i].origin < keys[j].origin
+		}
+		return keys[i].destination < keys[j].destination
+	})
+	for _, key := range keys {
+		acc := cells[key]
+		cell := &analyticsv1.OriginDestinationCell{
+			OriginId:        key.origin,
+			OriginName:      nodes[key.origin].GetName(),
+			DestinationId:   key.destination,
+			DestinationName: nodes[key.destination].GetName(),
+			Volume:          acc.volume,
+			TotalCost:       acc.cost,
+			AverageCost:     acc.averageCost(),
+			AverageLength:   acc.averageLength(),
+			PathCount:       acc.paths,
+		}
+		if received := destinations[key.destination].volume; received > Epsilon {
+			cell.DestinationShare = acc.volume / received
+		}
+		result.Cells = append(result.Cells, cell)
+	}
+
+	result.Origins = odTotals(origins, nodes)
+	result.Destinations = odTotals(destinations, nodes)
+	return result
+}
+
+// pathEndpoints определяет склад и точку доставки пути
+func pathEndpoints(path []int64, nodes map[int64]*commonv1.Node) odKey {
+	key := odKey{origin: path[0], destination: path[len(path)-1]}
+
+	start := 0
+	for i, id := range path {
+		if nodes[id].GetType() == commonv1.NodeType_NODE_TYPE_WAREHOUSE {
+			key.origin, start = id, i
+			break
+		}
+	}
+	for i := len(path) - 1; i > start; i-- {
+		if nodes[path[i]].GetType() == commonv1.NodeType_NODE_TYPE_DELIVERY_POINT {
+			key.destination = path[i]
+			break
+		}
+	}
+	return key
+}
+
+// odTotals итоги по узлам в порядке возрастания ID
+func odTotals(totals map[int64]*odAccumulator, nodes map[int64]*commonv1.Node) []*analyticsv1.OriginDestinationTotal {
+	ids := make([]int64, 0, len(totals))
+	for id := range totals {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	result := make([]*analyticsv1.OriginDestinationTotal, 0, len(ids))
+	for _, id := range ids {
+		acc := totals[id]
+		result = append(result, &analyticsv1.OriginDestinationTotal{
+			NodeId:        id,
+			Name:          nodes[id].GetName(),
+			Volume:        acc.volume,
+			TotalCost:     acc.cost,
+			AverageCost:   acc.averageCost(),
+			AverageLength: acc.averageLength(),
+		})
+	}
+	return result
+}
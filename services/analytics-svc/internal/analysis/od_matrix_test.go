@@ -0,0 +1,151 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+)
+
+func TestBuildOriginDestinationMatrix(t *testing.T) {
+	// Склады 1 и 2 отгружают через узел 5 в точки доставки 3 и 4
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE, Name: "Север"},
+			{Id: 2, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE, Name: "Юг"},
+			{Id: 3, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT, Name: "Магазин"},
+			{Id: 4, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT},
+			{Id: 5, Type: commonv1.NodeType_NODE_TYPE_INTERSECTION},
+		},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 5, CurrentFlow: 6, Cost: 1, Length: 10},
+			{From: 2, To: 5, CurrentFlow: 4, Cost: 2, Length: 20},
+			{From: 5, To: 3, CurrentFlow: 7, Cost: 1, Length: 5},
+			{From: 5, To: 4, CurrentFlow: 3, Cost: 3, Length: 15},
+		},
+	}
+
+	result := BuildOriginDestinationMatrix(graph)
+
+	want := []struct {
+		origin, destination          int64
+		volume, cost, avgCost, share float64
+		avgLength                    float64
+	}{
+		{1, 3, 6, 12, 2, 6.0 / 7, 15},
+		{2, 3, 1, 3, 3, 1.0 / 7, 25},
+		{2, 4, 3, 15, 5, 1, 35},
+	}
+	if len(result.Cells) != len(want) {
+		t.Fatalf("Cells = %d, want %d", len(result.Cells), len(want))
+	}
+	for i, w := range want {
+		c := result.Cells[i]
+		if c.OriginId != w.origin || c.DestinationId != w.destination {
+			t.Errorf("Cells[%d] = %d→%d, want %d→%d", i, c.OriginId, c.DestinationId, w.origin, w.destination)
+			continue
+		}
+		if c.Volume != w.volume || c.TotalCost != w.cost || c.AverageCost != w.avgCost {
+			t.Errorf("Cells[%d] volume/cost/avg = %v/%v/%v, want %v/%v/%v",
+				i, c.Volume, c.TotalCost, c.AverageCost, w.volume, w.cost, w.avgCost)
+		}
+		if math.Abs(c.DestinationShare-w.share) > Epsilon {
+			t.Errorf("Cells[%d].DestinationShare = %v, want %v", i, c.DestinationShare, w.share)
+		}
+		if c.AverageLength != w.avgLength {
+			t.Errorf("Cells[%d].AverageLength = %v, want %v", i, c.AverageLength, w.avgLength)
+		}
+	}
+	if result.Cells[0].OriginName != "Север" || result.Cells[0].DestinationName != "Магазин" {
+		t.Errorf("names = %q → %q, want Север → Магазин", result.Cells[0].OriginName, result.Cells[0].DestinationName)
+	}
+
+	if result.TotalVolume != 10 || result.TotalCost != 30 || result.AverageCost != 3 {
+		t.Errorf("totals = %v/%v/%v, want 10/30/3", result.TotalVolume, result.TotalCost, result.AverageCost)
+	}
+	if result.AverageLength != 22 {
+		t.Errorf("AverageLength = %v, want 22", result.AverageLength)
+	}
+	if result.PathCount != 3 {
+		t.Errorf("PathCount = %d, want 3", result.PathCount)
+	}
+
+	if len(result.Origins) != 2 || result.Origins[1].NodeId != 2 || result.Origins[1].Volume != 4 ||
+		result.Origins[1].AverageCost != 4.5 {
+		t.Errorf("Origins = %+v, want warehouse 2 with volume 4 and average cost 4.5", result.Origins)
+	}
+	if len(result.Destinations) != 2 || result.Destinations[0].Volume != 7 {
+		t.Errorf("Destinations = %+v, want point 3 with volume 7", result.Destinations)
+	}
+}
+
+func TestBuildOriginDestinationMatrix_Terminals(t *testing.T) {
+	// Исток 10 питает склад 1, поток проходит точку 3 и заканчивается в
+	// точке 4 перед стоком 20; рёбра виртуальных узлов не учитываются
+	graph := &commonv1.Graph{
+		SourceId: 10,
+		SinkId:   20,
+		Nodes: []*commonv1.Node{
+			{Id: 10, Type: commonv1.NodeType_NODE_TYPE_SOURCE},
+			{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE},
+			{Id: 3, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT},
+			{Id: 4, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT},
+			{Id: 20, Type: commonv1.NodeType_NODE_TYPE_SINK},
+		},
+		Edges: []*commonv1.Edge{
+			{From: -1, To: 10, CurrentFlow: 5},
+			{From: 10, To: 1, CurrentFlow: 5},
+			{From: 1, To: 3, CurrentFlow: 5, Cost: 1},
+			{From: 3, To: 4, CurrentFlow: 5, Cost: 1},
+			{From: 4, To: 20, CurrentFlow: 5},
+		},
+	}
+
+	result := BuildOriginDestinationMatrix(graph)
+
+	if len(result.Cells) != 1 {
+		t.Fatalf("Cells = %d, want 1", len(result.Cells))
+	}
+	c := result.Cells[0]
+	if c.OriginId != 1 || c.DestinationId != 4 || c.Volume != 5 || c.AverageCost != 2 {
+		t.Errorf("cell = %+v, want 1→4 with volume 5 and average cost 2", c)
+	}
+}
+
+func TestBuildOriginDestinationMatrix_Cycle(t *testing.T) {
+	// Без складов и точек доставки пары определяются концами путей
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, CurrentFlow: 5, Cost: 1},
+			{From: 2, To: 3, CurrentFlow: 7, Cost: 1},
+			{From: 3, To: 2, CurrentFlow: 2, Cost: 1},
+			{From: 3, To: 4, CurrentFlow: 5, Cost: 1},
+		},
+	}
+
+	result := BuildOriginDestinationMatrix(graph)
+
+	if len(result.Cells) != 1 || result.Cells[0].OriginId != 1 || result.Cells[0].DestinationId != 4 {
+		t.Fatalf("Cells = %+v, want single 1→4 cell", result.Cells)
+	}
+	if result.TotalVolume != 5 || result.TotalCost != 15 {
+		t.Errorf("totals = %v/%v, want 5/15", result.TotalVolume, result.TotalCost)
+	}
+	if result.CycleVolume != 2 || result.CycleCost != 4 {
+		t.Errorf("cycle = %v/%v, want 2/4", result.CycleVolume, result.CycleCost)
+	}
+}
+
+func TestBuildOriginDestinationMatrix_NoFlow(t *testing.T) {
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges: []*commonv1.Edge{{From: 1, To: 2, Capacity: 10}},
+	}
+
+	result := BuildOriginDestinationMatrix(graph)
+
+	if len(result.Cells) != 0 || result.TotalVolume != 0 || result.AverageCost != 0 {
+		t.Errorf("result = %+v, want empty matrix", result)
+	}
+}
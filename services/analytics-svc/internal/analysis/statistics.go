@@ -16,7 +16,7 @@ func CalculateFlowStatistics(graph *commonv1.Graph) *commonv1.FlowStatistics {
 		bottlenecks      []*commonv1.EdgeKey
 	)
 
-	for _, edge := range graph.Edges {
+	for i, edge := range graph.Edges {
 		if IsVirtualNode(edge.From) || IsVirtualNode(edge.To) {
 			continue
 		}
@@ -39,8 +39,9 @@ func CalculateFlowStatistics(graph *commonv1.Graph) *commonv1.FlowStatistics {
 		if utilization >= 1.0-Epsilon {
 			saturatedEdges++
 			bottlenecks = append(bottlenecks, &commonv1.EdgeKey{
-				From: edge.From,
-				To:   edge.To,
+				From:   edge.From,
+				To:     edge.To,
+				EdgeId: EdgeID(edge, i),
 			})
 		}
 	}
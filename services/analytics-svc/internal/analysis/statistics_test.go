@@ -283,3 +283,24 @@ func TestCalculateFlowStatistics_SaturatedEdgesWithBottlenecks(t *testing.T) {
 		t.Errorf("Bottlenecks count = %v, want 2", len(result.Bottlenecks))
 	}
 }
+
+func TestCalculateFlowStatistics_ParallelBottlenecks(t *testing.T) {
+	// Оба параллельных ребра 1→2 насыщены и различаются по EdgeId
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, CurrentFlow: 30, Capacity: 30},
+			{Id: 7, From: 1, To: 2, CurrentFlow: 20, Capacity: 20},
+		},
+	}
+
+	result := CalculateFlowStatistics(graph)
+
+	if len(result.Bottlenecks) != 2 {
+		t.Fatalf("Bottlenecks count = %v, want 2", len(result.Bottlenecks))
+	}
+	if result.Bottlenecks[0].EdgeId != 1 || result.Bottlenecks[1].EdgeId != 7 {
+		t.Errorf("Bottleneck edge IDs = %d, %d, want 1, 7",
+			result.Bottlenecks[0].EdgeId, result.Bottlenecks[1].EdgeId)
+	}
+}
@@ -8,26 +8,35 @@ import (
 // Используем константы из pkg/domain
 const Epsilon = domain.Epsilon
 
-// BuildEdgeMap создаёт карту рёбер для быстрого доступа
-func BuildEdgeMap(graph *commonv1.Graph) map[int64]map[int64]*commonv1.Edge {
-	edgeMap := make(map[int64]map[int64]*commonv1.Edge)
+// EdgeID возвращает идентификатор ребра: явный Id, а если он не задан —
+// порядковый номер ребра в графе (с 1), как его назначает solver-svc
+func EdgeID(edge *commonv1.Edge, index int) int64 {
+	if edge.Id != 0 {
+		return edge.Id
+	}
+	return int64(index + 1)
+}
 
-	for _, edge := range graph.Edges {
-		if edgeMap[edge.From] == nil {
-			edgeMap[edge.From] = make(map[int64]*commonv1.Edge)
-		}
-		edgeMap[edge.From][edge.To] = edge
+// BuildEdgeMap создаёт карту рёбер по идентификатору (EdgeID), чтобы
+// параллельные рёбра между одними и теми же узлами не затирали друг друга
+func BuildEdgeMap(graph *commonv1.Graph) map[int64]*commonv1.Edge {
+	edgeMap := make(map[int64]*commonv1.Edge, len(graph.Edges))
+
+	for i, edge := range graph.Edges {
+		edgeMap[EdgeID(edge, i)] = edge
 	}
 
 	return edgeMap
 }
 
-// GetEdge возвращает ребро между вершинами
-func GetEdge(edgeMap map[int64]map[int64]*commonv1.Edge, from, to int64) *commonv1.Edge {
-	if edgeMap[from] == nil {
+// GetEdge возвращает ребро по ключу или nil, если ребра с таким EdgeId
+// между From и To нет
+func GetEdge(edgeMap map[int64]*commonv1.Edge, key *commonv1.EdgeKey) *commonv1.Edge {
+	edge := edgeMap[key.GetEdgeId()]
+	if edge == nil || edge.From != key.GetFrom() || edge.To != key.GetTo() {
 		return nil
 	}
-	return edgeMap[from][to]
+	return edge
 }
 
 // IsVirtualNode проверяет, является ли узел виртуальным
@@ -106,78 +106,77 @@ func TestCalculateUtilization(t *testing.T) {
 }
 
 func TestBuildEdgeMap(t *testing.T) {
+	// Два параллельных ребра 1→2: у первого явный Id, у второго — порядковый номер
 	graph := &commonv1.Graph{
 		Edges: []*commonv1.Edge{
-			{From: 1, To: 2, Capacity: 100},
-			{From: 1, To: 3, Capacity: 50},
+			{Id: 10, From: 1, To: 2, Capacity: 100},
+			{From: 1, To: 2, Capacity: 40},
 			{From: 2, To: 3, Capacity: 75},
 		},
 	}
 
 	edgeMap := BuildEdgeMap(graph)
 
-	// Проверяем наличие рёбер
-	if edgeMap[1][2] == nil {
-		t.Error("Expected edge 1->2 to exist")
-	}
-	if edgeMap[1][3] == nil {
-		t.Error("Expected edge 1->3 to exist")
+	if len(edgeMap) != 3 {
+		t.Fatalf("len(edgeMap) = %d, want 3", len(edgeMap))
 	}
-	if edgeMap[2][3] == nil {
-		t.Error("Expected edge 2->3 to exist")
+	if edgeMap[10] == nil || edgeMap[10].Capacity != 100 {
+		t.Errorf("edgeMap[10] = %v, want edge 1->2 with capacity 100", edgeMap[10])
 	}
-
-	// Проверяем отсутствие несуществующих рёбер
-	if edgeMap[3][1] != nil {
-		t.Error("Expected edge 3->1 to not exist")
+	if edgeMap[2] == nil || edgeMap[2].Capacity != 40 {
+		t.Errorf("edgeMap[2] = %v, want parallel edge 1->2 with capacity 40", edgeMap[2])
 	}
-
-	// Проверяем значения
-	if edgeMap[1][2].Capacity != 100 {
-		t.Errorf("Edge 1->2 capacity = %v, want 100", edgeMap[1][2].Capacity)
+	if edgeMap[3] == nil || edgeMap[3].From != 2 {
+		t.Errorf("edgeMap[3] = %v, want edge 2->3", edgeMap[3])
 	}
 }
 
 func TestGetEdge(t *testing.T) {
-	edgeMap := map[int64]map[int64]*commonv1.Edge{
-		1: {
-			2: {From: 1, To: 2, Capacity: 100},
-			3: {From: 1, To: 3, Capacity: 50},
+	edgeMap := BuildEdgeMap(&commonv1.Graph{
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 100},
+			{From: 1, To: 2, Capacity: 50},
 		},
-	}
+	})
 
 	tests := []struct {
 		name     string
-		from     int64
-		to       int64
+		key      *commonv1.EdgeKey
+		capacity float64
 		expected bool
 	}{
 		{
-			name:     "existing edge",
-			from:     1,
-			to:       2,
+			name:     "first parallel edge",
+			key:      &commonv1.EdgeKey{From: 1, To: 2, EdgeId: 1},
+			capacity: 100,
+			expected: true,
+		},
+		{
+			name:     "second parallel edge",
+			key:      &commonv1.EdgeKey{From: 1, To: 2, EdgeId: 2},
+			capacity: 50,
 			expected: true,
 		},
 		{
-			name:     "non-existing edge - wrong to",
-			from:     1,
-			to:       4,
+			name:     "wrong endpoints",
+			key:      &commonv1.EdgeKey{From: 2, To: 1, EdgeId: 1},
 			expected: false,
 		},
 		{
-			name:     "non-existing edge - wrong from",
-			from:     2,
-			to:       3,
+			name:     "unknown id",
+			key:      &commonv1.EdgeKey{From: 1, To: 2, EdgeId: 3},
 			expected: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			edge := GetEdge(edgeMap, tt.from, tt.to)
+			edge := GetEdge(edgeMap, tt.key)
 			if (edge != nil) != tt.expected {
-				t.Errorf("GetEdge(%d, %d) exists = %v, want %v",
-					tt.from, tt.to, edge != nil, tt.expected)
+				t.Fatalf("GetEdge(%v) exists = %v, want %v", tt.key, edge != nil, tt.expected)
+			}
+			if edge != nil && edge.Capacity != tt.capacity {
+				t.Errorf("GetEdge(%v).Capacity = %v, want %v", tt.key, edge.Capacity, tt.capacity)
 			}
 		})
 	}
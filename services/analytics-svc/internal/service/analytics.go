@@ -258,6 +258,33 @@ func (s *AnalyticsService) CompareScenarios(
 	}, nil
 }
 
+func (s *AnalyticsService) BuildOriginDestinationMatrix(
+	ctx context.Context,
+	req *analyticsv1.BuildOriginDestinationMatrixRequest,
+) (*analyticsv1.BuildOriginDestinationMatrixResponse, error) {
+	ctx, span := telemetry.StartSpan(ctx, "AnalyticsService.BuildOriginDestinationMatrix")
+	defer span.End()
+
+	if err := s.validateGraph(ctx, req.Graph); err != nil {
+		telemetry.SetError(ctx, err)
+		return nil, pkgerrors.ToGRPC(err)
+	}
+
+	result := analysis.BuildOriginDestinationMatrix(req.Graph)
+
+	telemetry.AddEvent(ctx, "od_matrix_built",
+		attribute.Int("cells", len(result.Cells)),
+		attribute.Int("paths", int(result.PathCount)),
+	)
+
+	span.SetAttributes(
+		attribute.Float64("total_volume", result.TotalVolume),
+		attribute.Float64("average_cost", result.AverageCost),
+	)
+
+	return result, nil
+}
+
 // validateGraph валидирует граф
 func (s *AnalyticsService) validateGraph(ctx context.Context, graph *commonv1.Graph) error {
 	if graph == nil {
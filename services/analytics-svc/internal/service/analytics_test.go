@@ -319,6 +319,66 @@ func TestAnalyticsService_CompareScenarios(t *testing.T) {
 	}
 }
 
+func TestAnalyticsService_BuildOriginDestinationMatrix(t *testing.T) {
+	svc := NewAnalyticsService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		request       *analyticsv1.BuildOriginDestinationMatrixRequest
+		wantErr       bool
+		expectedCells int
+	}{
+		{
+			name: "two warehouses",
+			request: &analyticsv1.BuildOriginDestinationMatrixRequest{
+				Graph: &commonv1.Graph{
+					Nodes: []*commonv1.Node{
+						{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE},
+						{Id: 2, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE},
+						{Id: 3, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT},
+					},
+					Edges: []*commonv1.Edge{
+						{From: 1, To: 3, CurrentFlow: 6, Capacity: 10, Cost: 1},
+						{From: 2, To: 3, CurrentFlow: 4, Capacity: 10, Cost: 2},
+					},
+				},
+			},
+			wantErr:       false,
+			expectedCells: 2,
+		},
+		{
+			name: "nil graph",
+			request: &analyticsv1.BuildOriginDestinationMatrixRequest{
+				Graph: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty graph",
+			request: &analyticsv1.BuildOriginDestinationMatrixRequest{
+				Graph: &commonv1.Graph{},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := svc.BuildOriginDestinationMatrix(ctx, tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BuildOriginDestinationMatrix() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(resp.Cells) != tt.expectedCells {
+				t.Errorf("Cells count = %d, want %d", len(resp.Cells), tt.expectedCells)
+			}
+		})
+	}
+}
+
 func TestCalculateEfficiency(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -102,6 +102,9 @@ func (h *AnalyticsHandler) GetBottlenecks(
 			Utilization: b.Utilization,
 			ImpactScore: b.ImpactScore,
 			Severity:    gatewayv1.BottleneckSeverity(b.Severity),
+			InMinCut:    b.InMinCut,
+			FlowGain:    b.FlowGain,
+			CostSaving:  b.CostSaving,
 		})
 	}
 
@@ -207,6 +210,9 @@ func (h *AnalyticsHandler) convertBottleneckAnalysis(b *analyticsv1.FindBottlene
 			Utilization: bn.Utilization,
 			ImpactScore: bn.ImpactScore,
 			Severity:    gatewayv1.BottleneckSeverity(bn.Severity),
+			InMinCut:    bn.InMinCut,
+			FlowGain:    bn.FlowGain,
+			CostSaving:  bn.CostSaving,
 		})
 	}
 
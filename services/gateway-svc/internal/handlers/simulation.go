@@ -88,19 +88,13 @@ func (h *SimulationHandler) RunMonteCarlo(
 		Config:        h.convertMonteCarloConfig(msg.Config),
 		Uncertainties: h.convertUncertainties(msg.Uncertainties),
 		Algorithm:     msg.Algorithm,
+		Correlation:   h.convertCorrelation(msg.Correlation),
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	return connect.NewResponse(&gatewayv1.MonteCarloResponse{
-		Success:      resp.Success,
-		FlowStats:    h.convertMonteCarloStats(resp.FlowStats),
-		CostStats:    h.convertMonteCarloStats(resp.CostStats),
-		RiskAnalysis: h.convertRiskAnalysis(resp.RiskAnalysis),
-		Metadata:     h.convertMetadata(resp.Metadata),
-		ErrorMessage: "",
-	}), nil
+	return connect.NewResponse(h.convertMonteCarloResponse(resp)), nil
 }
 
 func (h *SimulationHandler) RunMonteCarloStream(
@@ -115,6 +109,7 @@ func (h *SimulationHandler) RunMonteCarloStream(
 		Config:        h.convertMonteCarloConfig(msg.Config),
 		Uncertainties: h.convertUncertainties(msg.Uncertainties),
 		Algorithm:     msg.Algorithm,
+		Correlation:   h.convertCorrelation(msg.Correlation),
 	})
 
 	for {
@@ -125,13 +120,19 @@ func (h *SimulationHandler) RunMonteCarloStream(
 			}
 
 			event := &gatewayv1.MonteCarloProgressEvent{
-				Iteration:       progress.Iteration,
-				TotalIterations: progress.TotalIterations,
-				ProgressPercent: progress.ProgressPercent,
-				CurrentMeanFlow: progress.CurrentMeanFlow,
-				CurrentStdDev:   progress.CurrentStdDev,
-				Status:          progress.Status,
-				IsFinal:         progress.Status == "completed",
+				Iteration:                    progress.Iteration,
+				TotalIterations:              progress.TotalIterations,
+				ProgressPercent:              progress.ProgressPercent,
+				CurrentMeanFlow:              progress.CurrentMeanFlow,
+				CurrentStdDev:                progress.CurrentStdDev,
+				Status:                       progress.Status,
+				IsFinal:                      progress.Status == "completed",
+				CiHalfWidth:                  progress.CiHalfWidth,
+				RelativeCiHalfWidth:          progress.RelativeCiHalfWidth,
+				EstimatedIterationsRemaining: progress.EstimatedIterationsRemaining,
+			}
+			if progress.Result != nil {
+				event.FinalResult = h.convertMonteCarloResponse(progress.Result)
 			}
 
 			if err := stream.Send(event); err != nil {
@@ -171,6 +172,7 @@ func (h *SimulationHandler) AnalyzeSensitivity(
 	resp, err := h.clients.Simulation().AnalyzeSensitivity(ctx, &simulationv1.AnalyzeSensitivityRequest{
 		Graph:      msg.Graph,
 		Parameters: params,
+		Config:     h.convertSensitivityConfig(msg.Config),
 		Algorithm:  msg.Algorithm,
 	})
 	if err != nil {
@@ -192,6 +194,8 @@ func (h *SimulationHandler) AnalyzeSensitivity(
 			Curve:            curve,
 			Elasticity:       r.Elasticity,
 			SensitivityIndex: r.SensitivityIndex,
+			Morris:           h.convertMorrisIndices(r.Morris),
+			Sobol:            h.convertSobolIndices(r.Sobol),
 		})
 	}
 
@@ -213,6 +217,44 @@ func (h *SimulationHandler) AnalyzeSensitivity(
 	}), nil
 }
 
+func (h *SimulationHandler) convertSensitivityConfig(c *gatewayv1.SensitivityConfig) *simulationv1.SensitivityConfig {
+	if c == nil {
+		return nil
+	}
+	return &simulationv1.SensitivityConfig{
+		Method:          simulationv1.SensitivityMethod(c.Method),
+		FindThresholds:  c.FindThresholds,
+		NumTrajectories: c.NumTrajectories,
+		NumLevels:       c.NumLevels,
+		NumSamples:      c.NumSamples,
+		RandomSeed:      c.RandomSeed,
+		MaxWorkers:      c.MaxWorkers,
+	}
+}
+
+func (h *SimulationHandler) convertMorrisIndices(m *simulationv1.MorrisIndices) *gatewayv1.MorrisIndices {
+	if m == nil {
+		return nil
+	}
+	return &gatewayv1.MorrisIndices{
+		Mu:     m.Mu,
+		MuStar: m.MuStar,
+		Sigma:  m.Sigma,
+	}
+}
+
+func (h *SimulationHandler) convertSobolIndices(s *simulationv1.SobolIndices) *gatewayv1.SobolIndices {
+	if s == nil {
+		return nil
+	}
+	return &gatewayv1.SobolIndices{
+		FirstOrder:     s.FirstOrder,
+		TotalOrder:     s.TotalOrder,
+		FirstOrderConf: s.FirstOrderConf,
+		TotalOrderConf: s.TotalOrderConf,
+	}
+}
+
 func (h *SimulationHandler) AnalyzeResilience(
 	ctx context.Context,
 	req *connect.Request[gatewayv1.ResilienceRequest],
@@ -225,6 +267,7 @@ func (h *SimulationHandler) AnalyzeResilience(
 			MaxFailuresToTest:     msg.Config.MaxFailuresToTest,
 			TestCascadingFailures: msg.Config.TestCascadingFailures,
 			LoadFactor:            msg.Config.LoadFactor,
+			MaxCandidateEdges:     msg.Config.MaxCandidateEdges,
 		}
 	}
 
@@ -257,11 +300,47 @@ func (h *SimulationHandler) AnalyzeResilience(
 			RedundancyLevel:        resp.Metrics.RedundancyLevel,
 			MinCutSize:             resp.Metrics.MinCutSize,
 		},
-		Weaknesses: weaknesses,
-		Metadata:   h.convertMetadata(resp.Metadata),
+		Weaknesses:        weaknesses,
+		Metadata:          h.convertMetadata(resp.Metadata),
+		CriticalEdgePairs: h.convertEdgePairs(resp.NMinusTwo.GetCriticalEdgePairs()),
+		Cascades:          h.convertCascades(resp.Cascade.GetScenarios()),
 	}), nil
 }
 
+func (h *SimulationHandler) convertEdgePairs(pairs []*simulationv1.EdgePair) []*gatewayv1.EdgePair {
+	result := make([]*gatewayv1.EdgePair, 0, len(pairs))
+	for _, p := range pairs {
+		result = append(result, &gatewayv1.EdgePair{
+			Edge1:          p.Edge1,
+			Edge2:          p.Edge2,
+			CombinedImpact: p.CombinedImpact,
+		})
+	}
+	return result
+}
+
+func (h *SimulationHandler) convertCascades(scenarios []*simulationv1.CascadeScenario) []*gatewayv1.CascadeScenario {
+	result := make([]*gatewayv1.CascadeScenario, 0, len(scenarios))
+	for _, s := range scenarios {
+		steps := make([]*gatewayv1.CascadeStep, 0, len(s.Steps))
+		for _, step := range s.Steps {
+			steps = append(steps, &gatewayv1.CascadeStep{
+				Round:       step.Round,
+				FailedEdges: step.FailedEdges,
+				FlowAfter:   step.FlowAfter,
+			})
+		}
+		result = append(result, &gatewayv1.CascadeScenario{
+			InitialFailure: s.InitialFailure,
+			Steps:          steps,
+			FinalFlow:      s.FinalFlow,
+			FlowReduction:  s.FlowReduction,
+			FailedEdges:    s.FailedEdges,
+		})
+	}
+	return result
+}
+
 func (h *SimulationHandler) SimulateFailures(
 	ctx context.Context,
 	req *connect.Request[gatewayv1.FailureSimulationRequest],
@@ -501,11 +580,67 @@ func (h *SimulationHandler) convertMonteCarloConfig(c *gatewayv1.MonteCarloConfi
 		return nil
 	}
 	return &simulationv1.MonteCarloConfig{
-		NumIterations:   c.NumIterations,
-		RandomSeed:      c.RandomSeed,
-		ConfidenceLevel: c.ConfidenceLevel,
-		Parallel:        c.Parallel,
+		NumIterations:    c.NumIterations,
+		RandomSeed:       c.RandomSeed,
+		ConfidenceLevel:  c.ConfidenceLevel,
+		Parallel:         c.Parallel,
+		ReturnSamples:    c.ReturnSamples,
+		ReplayIterations: c.ReplayIterations,
+		SamplingMethod:   simulationv1.SamplingMethod(c.SamplingMethod),
+
+		TargetRelativeCiHalfWidth: c.TargetRelativeCiHalfWidth,
+		TimeBudgetSeconds:         c.TimeBudgetSeconds,
+	}
+}
+
+func (h *SimulationHandler) convertMonteCarloResponse(resp *simulationv1.RunMonteCarloResponse) *gatewayv1.MonteCarloResponse {
+	return &gatewayv1.MonteCarloResponse{
+		Success:             resp.Success,
+		FlowStats:           h.convertMonteCarloStats(resp.FlowStats),
+		CostStats:           h.convertMonteCarloStats(resp.CostStats),
+		RiskAnalysis:        h.convertRiskAnalysis(resp.RiskAnalysis),
+		Metadata:            h.convertMetadata(resp.Metadata),
+		ErrorMessage:        "",
+		RandomSeed:          resp.RandomSeed,
+		Samples:             h.convertMonteCarloSamples(resp.Samples),
+		StopReason:          gatewayv1.MonteCarloStopReason(resp.StopReason),
+		CompletedIterations: resp.CompletedIterations,
+	}
+}
+
+func (h *SimulationHandler) convertCorrelation(c *gatewayv1.UncertaintyCorrelation) *simulationv1.UncertaintyCorrelation {
+	if c == nil {
+		return nil
 	}
+	groups := make([]*simulationv1.CorrelationGroup, 0, len(c.Groups))
+	for _, g := range c.Groups {
+		groups = append(groups, &simulationv1.CorrelationGroup{
+			UncertaintyIndices: g.UncertaintyIndices,
+			Correlation:        g.Correlation,
+		})
+	}
+	return &simulationv1.UncertaintyCorrelation{
+		Measure: simulationv1.CorrelationMeasure(c.Measure),
+		Matrix:  c.Matrix,
+		Groups:  groups,
+	}
+}
+
+func (h *SimulationHandler) convertMonteCarloSamples(samples []*simulationv1.MonteCarloSample) []*gatewayv1.MonteCarloSample {
+	if len(samples) == 0 {
+		return nil
+	}
+	result := make([]*gatewayv1.MonteCarloSample, 0, len(samples))
+	for _, s := range samples {
+		result = append(result, &gatewayv1.MonteCarloSample{
+			Iteration:   s.Iteration,
+			Multipliers: s.Multipliers,
+			Flow:        s.Flow,
+			Cost:        s.Cost,
+			Error:       s.Error,
+		})
+	}
+	return result
 }
 
 func (h *SimulationHandler) convertUncertainties(specs []*gatewayv1.UncertaintySpec) []*simulationv1.UncertaintySpec {
@@ -538,6 +673,8 @@ func (h *SimulationHandler) convertMonteCarloStats(s *simulationv1.MonteCarloSta
 		Median:                 s.Median,
 		ConfidenceIntervalLow:  s.ConfidenceIntervalLow,
 		ConfidenceIntervalHigh: s.ConfidenceIntervalHigh,
+		EffectiveSampleSize:    s.EffectiveSampleSize,
+		RelativeCiHalfWidth:    s.RelativeCiHalfWidth,
 	}
 }
 
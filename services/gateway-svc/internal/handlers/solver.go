@@ -77,10 +77,12 @@ func (h *SolverHandler) SolveGraph(
 	var opts *optimizationv1.SolveOptions
 	if msg.Options != nil {
 		opts = &optimizationv1.SolveOptions{
-			TimeoutSeconds: msg.Options.TimeoutSeconds,
-			ReturnPaths:    msg.Options.ReturnPaths,
-			MaxIterations:  msg.Options.MaxIterations,
-			Epsilon:        msg.Options.Epsilon,
+			TimeoutSeconds:      msg.Options.TimeoutSeconds,
+			ReturnPaths:         msg.Options.ReturnPaths,
+			MaxIterations:       msg.Options.MaxIterations,
+			Epsilon:             msg.Options.Epsilon,
+			Mode:                optimizationv1.SolveMode(msg.Options.Mode),
+			ReturnDecomposition: msg.Options.ReturnDecomposition,
 		}
 	}
 
@@ -114,10 +116,12 @@ func (h *SolverHandler) SolveGraphStream(
 	var opts *optimizationv1.SolveOptions
 	if msg.Options != nil {
 		opts = &optimizationv1.SolveOptions{
-			TimeoutSeconds: msg.Options.TimeoutSeconds,
-			ReturnPaths:    msg.Options.ReturnPaths,
-			MaxIterations:  msg.Options.MaxIterations,
-			Epsilon:        msg.Options.Epsilon,
+			TimeoutSeconds:      msg.Options.TimeoutSeconds,
+			ReturnPaths:         msg.Options.ReturnPaths,
+			MaxIterations:       msg.Options.MaxIterations,
+			Epsilon:             msg.Options.Epsilon,
+			Mode:                optimizationv1.SolveMode(msg.Options.Mode),
+			ReturnDecomposition: msg.Options.ReturnDecomposition,
 		}
 	}
 
@@ -276,10 +280,12 @@ func (h *SolverHandler) CalculateLogistics(
 	var solveOpts *optimizationv1.SolveOptions
 	if msg.SolveOptions != nil {
 		solveOpts = &optimizationv1.SolveOptions{
-			TimeoutSeconds: msg.SolveOptions.TimeoutSeconds,
-			ReturnPaths:    msg.SolveOptions.ReturnPaths,
-			MaxIterations:  msg.SolveOptions.MaxIterations,
-			Epsilon:        msg.SolveOptions.Epsilon,
+			TimeoutSeconds:      msg.SolveOptions.TimeoutSeconds,
+			ReturnPaths:         msg.SolveOptions.ReturnPaths,
+			MaxIterations:       msg.SolveOptions.MaxIterations,
+			Epsilon:             msg.SolveOptions.Epsilon,
+			Mode:                optimizationv1.SolveMode(msg.SolveOptions.Mode),
+			ReturnDecomposition: msg.SolveOptions.ReturnDecomposition,
 		}
 	}
 
@@ -313,6 +319,7 @@ func (h *SolverHandler) CalculateLogistics(
 		ComputationTimeMs: solveResult.Metrics.ComputationTimeMs,
 		PathsFound:        int32(len(solveResult.Result.Paths)),
 		Paths:             solveResult.Result.Paths,
+		Decomposition:     solveResult.Result.Decomposition,
 	}
 
 	// 3. Аналитика
@@ -433,6 +440,9 @@ func (h *SolverHandler) convertAnalytics(resp *analyticsv1.AnalyzeFlowResponse)
 				Utilization: b.Utilization,
 				ImpactScore: b.ImpactScore,
 				Severity:    gatewayv1.BottleneckSeverity(b.Severity),
+				InMinCut:    b.InMinCut,
+				FlowGain:    b.FlowGain,
+				CostSaving:  b.CostSaving,
 			})
 		}
 		for _, r := range resp.Bottlenecks.Recommendations {
@@ -196,7 +196,59 @@ func (g *CSVGenerator) writeAnalyticsCSV(w *csvWriter, data *ReportData) {
 		w.Write([]string{"Unused Edges", fmt.Sprintf("%d", ad.Efficiency.UnusedEdges)})
 		w.Write([]string{"Saturated Edges", fmt.Sprintf("%d", ad.Efficiency.SaturatedEdges)})
 		w.Write([]string{"Grade", ad.Efficiency.Grade})
+		w.Write([]string{""})
+	}
+
+	if ad.OriginDestination != nil {
+		g.writeOriginDestinationCSV(w, ad.OriginDestination)
+	}
+}
+
+func (g *CSVGenerator) writeOriginDestinationCSV(w *csvWriter, od *OriginDestinationData) {
+	w.Write([]string{"Origin-Destination Matrix"})
+	w.Write([]string{"Total Volume", g.FormatFloat(od.TotalVolume, 4)})
+	w.Write([]string{"Total Cost", g.FormatFloat(od.TotalCost, 4)})
+	w.Write([]string{"Average Cost per Unit", g.FormatFloat(od.AverageCost, 4)})
+	w.Write([]string{"Average Length", g.FormatFloat(od.AverageLength, 4)})
+	w.Write([]string{""})
+
+	if len(od.Cells) == 0 {
+		return
 	}
+
+	// Объёмы: склады по строкам, точки доставки по столбцам
+	origins, destinations, volume := od.Matrix()
+	header := []string{"Origin \\ Destination"}
+	for _, d := range destinations {
+		header = append(header, d.DestinationLabel())
+	}
+	w.Write(append(header, "Total"))
+	for _, o := range origins {
+		row := []string{o.OriginLabel()}
+		total := 0.0
+		for _, d := range destinations {
+			v := volume[[2]int64{o.Origin, d.Destination}]
+			total += v
+			row = append(row, g.FormatFloat(v, 4))
+		}
+		w.Write(append(row, g.FormatFloat(total, 4)))
+	}
+	w.Write([]string{""})
+
+	w.Write([]string{"Origin-Destination Pairs"})
+	w.Write([]string{"Origin", "Destination", "Volume", "Average Cost", "Average Length", "Paths", "Share of Destination"})
+	for _, c := range od.Cells {
+		w.Write([]string{
+			c.OriginLabel(),
+			c.DestinationLabel(),
+			g.FormatFloat(c.Volume, 4),
+			g.FormatFloat(c.AverageCost, 4),
+			g.FormatFloat(c.AverageLength, 4),
+			fmt.Sprintf("%d", c.PathCount),
+			g.FormatFloat(c.DestinationShare, 4),
+		})
+	}
+	w.Write([]string{""})
 }
 
 func (g *CSVGenerator) writeSimulationCSV(w *csvWriter, data *ReportData) {
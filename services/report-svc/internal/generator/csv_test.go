@@ -109,6 +109,46 @@ func TestCSVGenerator_Generate_Analytics(t *testing.T) {
 	}
 }
 
+func TestCSVGenerator_Generate_OriginDestination(t *testing.T) {
+	g := NewCSVGenerator()
+	ctx := context.Background()
+
+	data := &ReportData{
+		Type: reportv1.ReportType_REPORT_TYPE_ANALYTICS,
+		AnalyticsData: &AnalyticsReportData{
+			OriginDestination: &OriginDestinationData{
+				Cells: []*OriginDestinationCellData{
+					{Origin: 1, OriginName: "Север", Destination: 3, Volume: 6, AverageCost: 2, PathCount: 1, DestinationShare: 0.75},
+					{Origin: 2, Destination: 3, Volume: 2, AverageCost: 3, PathCount: 1, DestinationShare: 0.25},
+				},
+				TotalVolume: 8,
+				TotalCost:   18,
+				AverageCost: 2.25,
+			},
+		},
+	}
+
+	result, err := g.Generate(ctx, data)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	csv := string(result)
+
+	for _, want := range []string{
+		"Origin-Destination Matrix",
+		"Total Volume,8.0000",
+		"Origin \\ Destination,3,Total",
+		"Север,6.0000,6.0000",
+		"2,2.0000,2.0000",
+		"Север,3,6.0000,2.0000,0.0000,1,0.7500",
+	} {
+		if !strings.Contains(csv, want) {
+			t.Errorf("CSV should contain %q, got:\n%s", want, csv)
+		}
+	}
+}
+
 func TestCSVGenerator_Generate_Simulation(t *testing.T) {
 	g := NewCSVGenerator()
 	ctx := context.Background()
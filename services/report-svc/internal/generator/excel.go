@@ -313,6 +313,90 @@ func (g *ExcelGenerator) writeAnalyticsExcel(f *excelize.File, data *ReportData)
 	}
 
 	f.SetColWidth(sheetName, "A", "E", 18)
+
+	if ad.OriginDestination != nil {
+		g.writeOriginDestinationExcel(f, ad.OriginDestination)
+	}
+}
+
+func (g *ExcelGenerator) writeOriginDestinationExcel(f *excelize.File, od *OriginDestinationData) {
+	sheetName := "OD Matrix"
+	f.NewSheet(sheetName)
+
+	headerStyle, _ := f.NewStyle(&excelize.Style{
+		Font:      &excelize.Font{Bold: true, Color: "FFFFFF"},
+		Fill:      excelize.Fill{Type: "pattern", Color: []string{"4472C4"}, Pattern: 1},
+		Alignment: &excelize.Alignment{Horizontal: "center"},
+	})
+
+	row := 1
+
+	f.SetCellValue(sheetName, cellAddr("A", row), "Origin-Destination Matrix")
+	f.SetCellStyle(sheetName, cellAddr("A", row), cellAddr("B", row), headerStyle)
+	row++
+
+	f.SetCellValue(sheetName, cellAddr("A", row), "Total Volume")
+	f.SetCellValue(sheetName, cellAddr("B", row), od.TotalVolume)
+	row++
+
+	f.SetCellValue(sheetName, cellAddr("A", row), "Total Cost")
+	f.SetCellValue(sheetName, cellAddr("B", row), od.TotalCost)
+	row++
+
+	f.SetCellValue(sheetName, cellAddr("A", row), "Average Cost per Unit")
+	f.SetCellValue(sheetName, cellAddr("B", row), od.AverageCost)
+	row++
+
+	f.SetCellValue(sheetName, cellAddr("A", row), "Average Length")
+	f.SetCellValue(sheetName, cellAddr("B", row), od.AverageLength)
+	row += 2
+
+	if len(od.Cells) > 0 {
+		// Объёмы: склады по строкам, точки доставки по столбцам
+		origins, destinations, volume := od.Matrix()
+		lastCol := len(destinations) + 1
+
+		f.SetCellValue(sheetName, cellAddr("A", row), "Origin \\ Destination")
+		for i, d := range destinations {
+			f.SetCellValue(sheetName, CellByIndex(i+1, row), d.DestinationLabel())
+		}
+		f.SetCellValue(sheetName, CellByIndex(lastCol, row), "Total")
+		f.SetCellStyle(sheetName, cellAddr("A", row), CellByIndex(lastCol, row), headerStyle)
+		row++
+
+		for _, o := range origins {
+			f.SetCellValue(sheetName, cellAddr("A", row), o.OriginLabel())
+			total := 0.0
+			for i, d := range destinations {
+				v := volume[[2]int64{o.Origin, d.Destination}]
+				total += v
+				f.SetCellValue(sheetName, CellByIndex(i+1, row), v)
+			}
+			f.SetCellValue(sheetName, CellByIndex(lastCol, row), total)
+			row++
+		}
+		row++
+
+		headers := []string{"Origin", "Destination", "Volume", "Average Cost", "Average Length", "Paths", "Share of Destination"}
+		for i, h := range headers {
+			f.SetCellValue(sheetName, CellByIndex(i, row), h)
+		}
+		f.SetCellStyle(sheetName, cellAddr("A", row), cellAddr("G", row), headerStyle)
+		row++
+
+		for _, c := range od.Cells {
+			f.SetCellValue(sheetName, cellAddr("A", row), c.OriginLabel())
+			f.SetCellValue(sheetName, cellAddr("B", row), c.DestinationLabel())
+			f.SetCellValue(sheetName, cellAddr("C", row), c.Volume)
+			f.SetCellValue(sheetName, cellAddr("D", row), c.AverageCost)
+			f.SetCellValue(sheetName, cellAddr("E", row), c.AverageLength)
+			f.SetCellValue(sheetName, cellAddr("F", row), c.PathCount)
+			f.SetCellValue(sheetName, cellAddr("G", row), c.DestinationShare)
+			row++
+		}
+	}
+
+	f.SetColWidth(sheetName, "A", "G", 18)
 }
 
 func (g *ExcelGenerator) writeSimulationExcel(f *excelize.File, data *ReportData) {
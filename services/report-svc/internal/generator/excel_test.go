@@ -3,9 +3,12 @@
 package generator
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
+	"github.com/xuri/excelize/v2"
+
 	commonv1 "logistics/gen/go/logistics/common/v1"
 	reportv1 "logistics/gen/go/logistics/report/v1"
 )
@@ -105,6 +108,58 @@ func TestExcelGenerator_Generate_Analytics(t *testing.T) {
 	}
 }
 
+func TestExcelGenerator_Generate_OriginDestination(t *testing.T) {
+	g := NewExcelGenerator()
+	ctx := context.Background()
+
+	data := &ReportData{
+		Type: reportv1.ReportType_REPORT_TYPE_ANALYTICS,
+		AnalyticsData: &AnalyticsReportData{
+			OriginDestination: &OriginDestinationData{
+				Cells: []*OriginDestinationCellData{
+					{Origin: 1, OriginName: "Север", Destination: 3, Volume: 6, AverageCost: 2, PathCount: 1},
+					{Origin: 1, OriginName: "Север", Destination: 4, Volume: 2, AverageCost: 5, PathCount: 1},
+				},
+				TotalVolume: 8,
+			},
+		},
+	}
+
+	result, err := g.Generate(ctx, data)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	f, err := excelize.OpenReader(bytes.NewReader(result))
+	if err != nil {
+		t.Fatalf("OpenReader() error = %v", err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows("OD Matrix")
+	if err != nil {
+		t.Fatalf("GetRows() error = %v", err)
+	}
+
+	// Строка матрицы: склад, объёмы по точкам доставки, итог
+	want := []string{"Север", "6", "2", "8"}
+	found := false
+	for _, row := range rows {
+		if len(row) == len(want) && row[0] == want[0] {
+			found = true
+			for i := range want {
+				if row[i] != want[i] {
+					t.Errorf("matrix row = %v, want %v", row, want)
+					break
+				}
+			}
+		}
+	}
+	if !found {
+		t.Errorf("matrix row for Север not found in %v", rows)
+	}
+}
+
 func TestExcelGenerator_Generate_Simulation(t *testing.T) {
 	g := NewExcelGenerator()
 	ctx := context.Background()
@@ -2,6 +2,8 @@
 package generator
 
 import (
+	"fmt"
+	"sort"
 	"time"
 
 	analyticsv1 "logistics/gen/go/logistics/analytics/v1"
@@ -32,6 +34,8 @@ type AnalyticsReportData struct {
 	Efficiency      *EfficiencyData
 	FlowStats       *commonv1.FlowStatistics
 	GraphStats      *commonv1.GraphStatistics
+
+	OriginDestination *OriginDestinationData
 }
 
 // CostBreakdownData разбивка стоимости
@@ -76,6 +80,67 @@ type EfficiencyData struct {
 	Grade               string
 }
 
+// OriginDestinationData матрица корреспонденций «склад × точка доставки»
+type OriginDestinationData struct {
+	Cells         []*OriginDestinationCellData
+	TotalVolume   float64
+	TotalCost     float64
+	AverageCost   float64
+	AverageLength float64
+}
+
+// OriginDestinationCellData объём между складом и точкой доставки
+type OriginDestinationCellData struct {
+	Origin           int64
+	OriginName       string
+	Destination      int64
+	DestinationName  string
+	Volume           float64
+	AverageCost      float64
+	AverageLength    float64
+	PathCount        int32
+	DestinationShare float64
+}
+
+// Matrix возвращает склады и точки доставки по возрастанию ID и объёмы
+// между ними
+func (d *OriginDestinationData) Matrix() (origins, destinations []*OriginDestinationCellData, volume map[[2]int64]float64) {
+	volume = make(map[[2]int64]float64, len(d.Cells))
+	seenOrigin := make(map[int64]bool)
+	seenDestination := make(map[int64]bool)
+	for _, c := range d.Cells {
+		volume[[2]int64{c.Origin, c.Destination}] += c.Volume
+		if !seenOrigin[c.Origin] {
+			seenOrigin[c.Origin] = true
+			origins = append(origins, c)
+		}
+		if !seenDestination[c.Destination] {
+			seenDestination[c.Destination] = true
+			destinations = append(destinations, c)
+		}
+	}
+	sort.Slice(origins, func(i, j int) bool { return origins[i].Origin < origins[j].Origin })
+	sort.Slice(destinations, func(i, j int) bool { return destinations[i].Destination < destinations[j].Destination })
+	return origins, destinations, volume
+}
+
+// OriginLabel возвращает имя склада или его ID
+func (c *OriginDestinationCellData) OriginLabel() string {
+	return nodeLabel(c.Origin, c.OriginName)
+}
+
+// DestinationLabel возвращает имя точки доставки или её ID
+func (c *OriginDestinationCellData) DestinationLabel() string {
+	return nodeLabel(c.Destination, c.DestinationName)
+}
+
+func nodeLabel(id int64, name string) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("%d", id)
+}
+
 // SimulationReportData данные для отчёта симуляции
 type SimulationReportData struct {
 	SimulationType string
@@ -339,3 +404,34 @@ func ConvertFlowEdges(edges []*commonv1.FlowEdge) []*EdgeFlowData {
 	}
 	return result
 }
+
+// ConvertOriginDestination конвертирует матрицу корреспонденций из proto
+func ConvertOriginDestination(resp *analyticsv1.BuildOriginDestinationMatrixResponse) *OriginDestinationData {
+	if resp == nil {
+		return nil
+	}
+	data := &OriginDestinationData{
+		Cells:         make([]*OriginDestinationCellData, 0, len(resp.Cells)),
+		TotalVolume:   resp.TotalVolume,
+		TotalCost:     resp.TotalCost,
+		AverageCost:   resp.AverageCost,
+		AverageLength: resp.AverageLength,
+	}
+	for _, c := range resp.Cells {
+		if c == nil {
+			continue
+		}
+		data.Cells = append(data.Cells, &OriginDestinationCellData{
+			Origin:           c.OriginId,
+			OriginName:       c.OriginName,
+			Destination:      c.DestinationId,
+			DestinationName:  c.DestinationName,
+			Volume:           c.Volume,
+			AverageCost:      c.AverageCost,
+			AverageLength:    c.AverageLength,
+			PathCount:        c.PathCount,
+			DestinationShare: c.DestinationShare,
+		})
+	}
+	return data
+}
@@ -322,6 +322,59 @@ func TestConvertCostBreakdown(t *testing.T) {
 	}
 }
 
+func TestConvertOriginDestination(t *testing.T) {
+	assert.Nil(t, ConvertOriginDestination(nil))
+
+	result := ConvertOriginDestination(&analyticsv1.BuildOriginDestinationMatrixResponse{
+		Cells: []*analyticsv1.OriginDestinationCell{
+			{OriginId: 1, OriginName: "Север", DestinationId: 3, Volume: 6, AverageCost: 2, AverageLength: 15, PathCount: 1, DestinationShare: 1},
+			nil,
+		},
+		TotalVolume:   6,
+		TotalCost:     12,
+		AverageCost:   2,
+		AverageLength: 15,
+	})
+
+	require.NotNil(t, result)
+	assert.Equal(t, 6.0, result.TotalVolume)
+	assert.Equal(t, 12.0, result.TotalCost)
+	require.Len(t, result.Cells, 1)
+	assert.Equal(t, &OriginDestinationCellData{
+		Origin:           1,
+		OriginName:       "Север",
+		Destination:      3,
+		Volume:           6,
+		AverageCost:      2,
+		AverageLength:    15,
+		PathCount:        1,
+		DestinationShare: 1,
+	}, result.Cells[0])
+	assert.Equal(t, "Север", result.Cells[0].OriginLabel())
+	assert.Equal(t, "3", result.Cells[0].DestinationLabel())
+}
+
+func TestOriginDestinationData_Matrix(t *testing.T) {
+	data := &OriginDestinationData{
+		Cells: []*OriginDestinationCellData{
+			{Origin: 2, Destination: 4, Volume: 3},
+			{Origin: 1, Destination: 4, Volume: 6},
+			{Origin: 2, Destination: 3, Volume: 1},
+		},
+	}
+
+	origins, destinations, volume := data.Matrix()
+
+	require.Len(t, origins, 2)
+	assert.Equal(t, int64(1), origins[0].Origin)
+	assert.Equal(t, int64(2), origins[1].Origin)
+	require.Len(t, destinations, 2)
+	assert.Equal(t, int64(3), destinations[0].Destination)
+	assert.Equal(t, int64(4), destinations[1].Destination)
+	assert.Equal(t, 6.0, volume[[2]int64{1, 4}])
+	assert.Zero(t, volume[[2]int64{1, 3}])
+}
+
 func TestConvertScenarioResults(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -185,6 +185,9 @@ func (s *ReportService) GenerateAnalyticsReport(
 	// Обрабатываем efficiency
 	analyticsData.Efficiency = generator.ConvertEfficiency(req.Efficiency)
 
+	// Обрабатываем матрицу корреспонденций
+	analyticsData.OriginDestination = generator.ConvertOriginDestination(req.OriginDestination)
+
 	data := &generator.ReportData{
 		Type:          reportv1.ReportType_REPORT_TYPE_ANALYTICS,
 		Options:       req.Options,
@@ -267,6 +267,29 @@ func TestReportService_GenerateAnalyticsReport_Success(t *testing.T) {
 	assert.Equal(t, reportv1.ReportType_REPORT_TYPE_ANALYTICS, resp.Metadata.Type)
 }
 
+func TestReportService_GenerateAnalyticsReport_OriginDestination(t *testing.T) {
+	ctx := context.Background()
+	svc := NewReportService(ServiceConfig{Version: "1.0.0"}, nil)
+
+	req := &reportv1.GenerateAnalyticsReportRequest{
+		Graph: &commonv1.Graph{},
+		OriginDestination: &analyticsv1.BuildOriginDestinationMatrixResponse{
+			Cells: []*analyticsv1.OriginDestinationCell{
+				{OriginId: 1, OriginName: "Север", DestinationId: 3, Volume: 6, AverageCost: 2, PathCount: 1},
+			},
+			TotalVolume: 6,
+		},
+		Format: reportv1.ReportFormat_REPORT_FORMAT_CSV,
+	}
+
+	resp, err := svc.GenerateAnalyticsReport(ctx, req)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Contains(t, string(resp.Content.Data), "Origin-Destination Matrix")
+	assert.Contains(t, string(resp.Content.Data), "Север,3,6.0000")
+}
+
 func TestReportService_GenerateSimulationReport_WhatIf(t *testing.T) {
 	ctx := context.Background()
 	svc := NewReportService(ServiceConfig{Version: "1.0.0"}, nil)
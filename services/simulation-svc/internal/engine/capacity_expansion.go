@@ -0,0 +1,403 @@
+// services/simulation-svc/internal/engine/capacity_expansion.go
+package engine
+
+import (
+	"container/heap"
+	"context"
+	"math"
+	"sort"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+)
+
+const defaultExpansionIterations = 50
+
+// CapacityExpansionEngine подбирает расширения пропускной способности рёбер
+// в пределах бюджета
+type CapacityExpansionEngine struct {
+	solverClient SolverClientInterface
+}
+
+// NewCapacityExpansionEngine создаёт движок расширения
+func NewCapacityExpansionEngine(solverClient SolverClientInterface) *CapacityExpansionEngine {
+	return &CapacityExpansionEngine{solverClient: solverClient}
+}
+
+// edgeUpgrade состояние расширения одного ребра
+type edgeUpgrade struct {
+	option *simulationv1.EdgeUpgradeOption
+	added  float64
+	cost   float64
+}
+
+// increment возвращает очередное приращение ребра и его стоимость; 0, если
+// ребро больше нельзя расширить
+func (u *edgeUpgrade) increment(edge *commonv1.Edge) (float64, float64) {
+	if u == nil || u.option == nil {
+		return 0, 0
+	}
+	delta := u.option.Step
+	if delta <= 0 {
+		delta = edge.Capacity - u.added // Исходная пропускная способность
+	}
+	if u.option.MaxIncrease > 0 {
+		delta = math.Min(delta, u.option.MaxIncrease-u.added)
+	}
+	if delta <= flowTolerance {
+		return 0, 0
+	}
+	return delta, u.price(delta)
+}
+
+// price возвращает стоимость приращения delta; фиксированная стоимость
+// оплачивается при первом расширении ребра
+func (u *edgeUpgrade) price(delta float64) float64 {
+	cost := delta * u.option.CostPerUnit
+	if u.added == 0 {
+		cost += u.option.FixedCost
+	}
+	return cost
+}
+
+// expansionCandidate набор приращений, проверяемый решением solver'а
+type expansionCandidate struct {
+	edges    []int
+	deltas   []float64
+	costs    []float64
+	cost     float64
+	wholeCut bool
+
+	result *client.SolveResult
+	gain   float64
+}
+
+// Optimize жадно расширяет рёбра минимального разреза или насыщенные рёбра
+// самого дешёвого увеличивающего пути, пока хватает бюджета и расширение
+// увеличивает поток
+func (e *CapacityExpansionEngine) Optimize(
+	ctx context.Context,
+	req *simulationv1.OptimizeCapacityExpansionRequest,
+) (*simulationv1.OptimizeCapacityExpansionResponse, error) {
+	graph := CloneGraph(req.Graph)
+	AssignEdgeIDs(graph)
+
+	upgrades := make([]*edgeUpgrade, len(graph.Edges))
+	for i, edge := range graph.Edges {
+		option := req.DefaultUpgrade
+		for _, candidate := range req.Upgrades {
+			if MatchesEdgeKey(edge, i, candidate.Edge) {
+				option = candidate
+				break
+			}
+		}
+		if option != nil {
+			upgrades[i] = &edgeUpgrade{option: option}
+		}
+	}
+
+	current, err := e.solverClient.Solve(ctx, graph, req.Algorithm, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &simulationv1.OptimizeCapacityExpansionResponse{
+		Success:  true,
+		BaseFlow: current.MaxFlow,
+	}
+	budget := req.Budget
+
+	iterations := int(req.MaxIterations)
+	if iterations <= 0 {
+		iterations = defaultExpansionIterations
+	}
+	resp.StopReason = simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_MAX_ITERATIONS
+	for iteration := 1; iteration <= iterations; iteration++ {
+		candidates, upgradable := e.candidates(graph, upgrades, current, budget)
+		if len(candidates) == 0 {
+			resp.StopReason = simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_NO_GAIN
+			if upgradable {
+				resp.StopReason = simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_BUDGET
+			}
+			break
+		}
+
+		best, err := e.best(ctx, graph, req.Algorithm, candidates, current.MaxFlow)
+		if err != nil {
+			return nil, err
+		}
+		if best == nil {
+			resp.StopReason = simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_NO_GAIN
+			break
+		}
+
+		step := &simulationv1.ExpansionStep{
+			Iteration: int32(iteration),
+			Cost:      best.cost,
+			Flow:      best.result.MaxFlow,
+			FlowGain:  best.gain,
+			WholeCut:  best.wholeCut,
+		}
+		for k, i := range best.edges {
+			edge := graph.Edges[i]
+			cost := best.costs[k]
+			edge.Capacity += best.deltas[k]
+			upgrades[i].added += best.deltas[k]
+			upgrades[i].cost += cost
+			step.Increments = append(step.Increments, &simulationv1.CapacityIncrement{
+				Edge:          KeyOf(edge, i),
+				AddedCapacity: best.deltas[k],
+				NewCapacity:   edge.Capacity,
+				Cost:          cost,
+			})
+		}
+		resp.Steps = append(resp.Steps, step)
+		budget -= best.cost
+		current = best.result
+	}
+
+	for i, u := range upgrades {
+		if u == nil || u.added <= 0 {
+			continue
+		}
+		edge := graph.Edges[i]
+		resp.Increments = append(resp.Increments, &simulationv1.CapacityIncrement{
+			Edge:          KeyOf(edge, i),
+			AddedCapacity: u.added,
+			NewCapacity:   edge.Capacity,
+			Cost:          u.cost,
+		})
+		resp.TotalCost += u.cost
+	}
+	resp.FinalFlow = current.MaxFlow
+	resp.RemainingBudget = req.Budget - resp.TotalCost
+	resp.ExpandedGraph = current.Graph
+	return resp, nil
+}
+
+// candidates возвращает приращения, которые укладываются в бюджет: каждое
+// ребро минимального разреза отдельно, весь разрез сразу и насыщенные рёбра
+// самого дешёвого увеличивающего пути (upgradePath). Второе значение
+// сообщает, есть ли приращение, которое ещё можно оплатить без учёта бюджета.
+func (e *CapacityExpansionEngine) candidates(
+	graph *commonv1.Graph,
+	upgrades []*edgeUpgrade,
+	current *client.SolveResult,
+	budget float64,
+) ([]*expansionCandidate, bool) {
+	flows := edgeFlows(current)
+	cut := minCutIndices(graph, flows)
+
+	var candidates []*expansionCandidate
+	whole := &expansionCandidate{wholeCut: true}
+	found := false
+	for _, i := range cut {
+		delta, cost := upgrades[i].increment(graph.Edges[i])
+		if delta <= 0 {
+			whole = nil
+			continue
+		}
+		found = true
+		if cost <= budget+flowTolerance {
+			candidates = append(candidates, &expansionCandidate{
+				edges: []int{i}, deltas: []float64{delta}, costs: []float64{cost}, cost: cost,
+			})
+		}
+		if whole != nil {
+			whole.edges = append(whole.edges, i)
+			whole.deltas = append(whole.deltas, delta)
+			whole.costs = append(whole.costs, cost)
+			whole.cost += cost
+		}
+	}
+	if whole != nil && len(whole.edges) > 1 && whole.cost <= budget+flowTolerance {
+		candidates = append(candidates, whole)
+	}
+
+	// Одно ребро пути уже проверено как ребро разреза
+	if path := upgradePath(graph, upgrades, flows); path != nil {
+		found = true
+		if len(path.edges) > 1 && path.cost <= budget+flowTolerance {
+			candidates = append(candidates, path)
+		}
+	}
+	return candidates, found
+}
+
+// upgradeArc дуга остаточной сети для поиска пути расширения
+type upgradeArc struct {
+	to      int64
+	edge    int
+	cost    float64 // Стоимость приращения; 0 для дуги с остатком
+	upgrade bool    // Насыщенное ребро, проходимое только после расширения
+}
+
+// upgradePath ищет (алгоритмом Дейкстры) увеличивающий путь наименьшей
+// стоимости расширения: дуги с остатком проходятся бесплатно, насыщенное
+// ребро — за цену его приращения. Все насыщенные рёбра пути расширяются
+// вместе на наименьшее из их приращений: при последовательных узких местах
+// расширение одного ребра поток не увеличивает. nil, если пути нет или он
+// не требует расширений.
+func upgradePath(g *commonv1.Graph, upgrades []*edgeUpgrade, flows map[int64]float64) *expansionCandidate {
+	arcs := make(map[int64][]upgradeArc)
+	for i, edge := range g.Edges {
+		flow := flows[EdgeID(edge, i)]
+		if edge.Capacity-flow > flowTolerance {
+			arcs[edge.From] = append(arcs[edge.From], upgradeArc{to: edge.To, edge: i})
+		} else if delta, cost := upgrades[i].increment(edge); delta > 0 {
+			arcs[edge.From] = append(arcs[edge.From], upgradeArc{to: edge.To, edge: i, cost: cost, upgrade: true})
+		}
+		if flow > flowTolerance {
+			arcs[edge.To] = append(arcs[edge.To], upgradeArc{to: edge.From, edge: i})
+		}
+	}
+
+	dist := map[int64]float64{g.SourceId: 0}
+	parent := make(map[int64]upgradeArc)
+	from := make(map[int64]int64)
+	done := make(map[int64]bool)
+	pq := &upgradeQueue{{node: g.SourceId}}
+	for pq.Len() > 0 && !done[g.SinkId] {
+		item := heap.Pop(pq).(upgradeItem)
+		if done[item.node] {
+			continue
+		}
+		done[item.node] = true
+		for _, a := range arcs[item.node] {
+			d := item.cost + a.cost
+			if old, seen := dist[a.to]; done[a.to] || seen && d >= old {
+				continue
+			}
+			dist[a.to] = d
+			parent[a.to] = a
+			from[a.to] = item.node
+			heap.Push(pq, upgradeItem{node: a.to, cost: d})
+		}
+	}
+	if !done[g.SinkId] {
+		return nil
+	}
+
+	var edges []int
+	delta := math.Inf(1)
+	for node := g.SinkId; node != g.SourceId; node = from[node] {
+		a := parent[node]
+		if !a.upgrade {
+			continue
+		}
+		d, _ := upgrades[a.edge].increment(g.Edges[a.edge])
+		edges = append(edges, a.edge)
+		delta = math.Min(delta, d)
+	}
+	if len(edges) == 0 {
+		return nil
+	}
+	sort.Ints(edges)
+
+	c := &expansionCandidate{edges: edges}
+	for _, i := range edges {
+		cost := upgrades[i].price(delta)
+		c.deltas = append(c.deltas, delta)
+		c.costs = append(c.costs, cost)
+		c.cost += cost
+	}
+	return c
+}
+
+type upgradeItem struct {
+	node int64
+	cost float64
+}
+
+// upgradeQueue min-куча по стоимости расширения пути
+type upgradeQueue []upgradeItem
+
+func (q upgradeQueue) Len() int           { return len(q) }
+func (q upgradeQueue) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q upgradeQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *upgradeQueue) Push(x any)        { *q = append(*q, x.(upgradeItem)) }
+func (q *upgradeQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// best решает граф для каждого кандидата и возвращает кандидата с
+// наибольшим приростом потока на единицу стоимости; nil, если ни один
+// кандидат не увеличивает поток
+func (e *CapacityExpansionEngine) best(
+	ctx context.Context,
+	graph *commonv1.Graph,
+	algorithm commonv1.Algorithm,
+	candidates []*expansionCandidate,
+	flow float64,
+) (*expansionCandidate, error) {
+	var best *expansionCandidate
+	bestRatio := 0.0
+	for _, c := range candidates {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		expanded := CloneGraph(graph)
+		for k, i := range c.edges {
+			expanded.Edges[i].Capacity += c.deltas[k]
+		}
+		result, err := e.solverClient.Solve(ctx, expanded, algorithm, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.result = result
+		c.gain = result.MaxFlow - flow
+		if c.gain <= flowTolerance {
+			continue
+		}
+
+		ratio := math.Inf(1)
+		if c.cost > 0 {
+			ratio = c.gain / c.cost
+		}
+		if best == nil || ratio > bestRatio || ratio == bestRatio && c.gain > best.gain {
+			best, bestRatio = c, ratio
+		}
+	}
+	return best, nil
+}
+
+// minCutIndices возвращает индексы рёбер минимального разреза: рёбер из
+// множества узлов, достижимых из истока по остаточной сети, в остальные
+func minCutIndices(g *commonv1.Graph, flows map[int64]float64) []int {
+	type arc struct {
+		to       int64
+		residual float64
+	}
+	arcs := make(map[int64][]arc)
+	for i, edge := range g.Edges {
+		flow := flows[EdgeID(edge, i)]
+		arcs[edge.From] = append(arcs[edge.From], arc{to: edge.To, residual: edge.Capacity - flow})
+		arcs[edge.To] = append(arcs[edge.To], arc{to: edge.From, residual: flow})
+	}
+
+	reachable := map[int64]bool{g.SourceId: true}
+	queue := []int64{g.SourceId}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, a := range arcs[node] {
+			if !reachable[a.to] && a.residual > flowTolerance {
+				reachable[a.to] = true
+				queue = append(queue, a.to)
+			}
+		}
+	}
+
+	var cut []int
+	for i, edge := range g.Edges {
+		if reachable[edge.From] && !reachable[edge.To] {
+			cut = append(cut, i)
+		}
+	}
+	return cut
+}
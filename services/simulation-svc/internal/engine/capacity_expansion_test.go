@@ -0,0 +1,227 @@
+// services/simulation-svc/internal/engine/capacity_expansion_test.go
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+func optimizeExpansion(t *testing.T, req *simulationv1.OptimizeCapacityExpansionRequest) *simulationv1.OptimizeCapacityExpansionResponse {
+	t.Helper()
+	resp, err := NewCapacityExpansionEngine(maxFlowSolver{}).Optimize(context.Background(), req)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestCapacityExpansionEngine_PicksCutEdge(t *testing.T) {
+	// Из двух рёбер разреза поток увеличивает только 2→4: путь 1→3→4 упирается в 3→4
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10},
+			{From: 2, To: 4, Capacity: 5},
+			{From: 1, To: 3, Capacity: 5},
+			{From: 3, To: 4, Capacity: 5},
+		},
+	}
+
+	resp := optimizeExpansion(t, &simulationv1.OptimizeCapacityExpansionRequest{
+		Graph:          graph,
+		Budget:         100,
+		DefaultUpgrade: &simulationv1.EdgeUpgradeOption{CostPerUnit: 1, Step: 5},
+		MaxIterations:  1,
+	})
+
+	assert.Equal(t, 10.0, resp.BaseFlow)
+	assert.Equal(t, 15.0, resp.FinalFlow)
+	assert.Equal(t, simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_MAX_ITERATIONS, resp.StopReason)
+
+	require.Len(t, resp.Steps, 1)
+	assert.False(t, resp.Steps[0].WholeCut)
+	assert.Equal(t, 5.0, resp.Steps[0].FlowGain)
+
+	require.Len(t, resp.Increments, 1)
+	assert.Equal(t, &simulationv1.CapacityIncrement{
+		Edge:          &commonv1.EdgeKey{From: 2, To: 4, EdgeId: 2},
+		AddedCapacity: 5,
+		NewCapacity:   10,
+		Cost:          5,
+	}, resp.Increments[0])
+	assert.Equal(t, 5.0, resp.TotalCost)
+	assert.Equal(t, 95.0, resp.RemainingBudget)
+	assert.Equal(t, 10.0, resp.ExpandedGraph.Edges[1].Capacity)
+
+	// Исходный граф не меняется
+	assert.Equal(t, 5.0, graph.Edges[1].Capacity)
+}
+
+func TestCapacityExpansionEngine_Budget(t *testing.T) {
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 5},
+			{From: 2, To: 3, Capacity: 100},
+		},
+	}
+
+	// Фиксированная стоимость оплачивается только при первом приращении
+	resp := optimizeExpansion(t, &simulationv1.OptimizeCapacityExpansionRequest{
+		Graph:  graph,
+		Budget: 22,
+		Upgrades: []*simulationv1.EdgeUpgradeOption{{
+			Edge:        &commonv1.EdgeKey{From: 1, To: 2},
+			FixedCost:   10,
+			CostPerUnit: 1,
+			Step:        5,
+		}},
+	})
+
+	assert.Equal(t, simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_BUDGET, resp.StopReason)
+	require.Len(t, resp.Steps, 2)
+	assert.Equal(t, 15.0, resp.Steps[0].Cost)
+	assert.Equal(t, 5.0, resp.Steps[1].Cost)
+	assert.Equal(t, 15.0, resp.FinalFlow)
+	assert.Equal(t, 20.0, resp.TotalCost)
+	assert.Equal(t, 2.0, resp.RemainingBudget)
+	require.Len(t, resp.Increments, 1)
+	assert.Equal(t, 10.0, resp.Increments[0].AddedCapacity)
+	assert.Equal(t, 15.0, resp.Increments[0].NewCapacity)
+}
+
+func TestCapacityExpansionEngine_WholeCut(t *testing.T) {
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 5},
+			{From: 1, To: 3, Capacity: 5},
+			{From: 2, To: 4, Capacity: 100},
+			{From: 3, To: 4, Capacity: 100},
+		},
+	}
+
+	// При равной отдаче на единицу стоимости выбирается больший прирост
+	resp := optimizeExpansion(t, &simulationv1.OptimizeCapacityExpansionRequest{
+		Graph:          graph,
+		Budget:         100,
+		DefaultUpgrade: &simulationv1.EdgeUpgradeOption{CostPerUnit: 1},
+		MaxIterations:  1,
+	})
+
+	assert.Equal(t, simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_MAX_ITERATIONS, resp.StopReason)
+	require.Len(t, resp.Steps, 1)
+	assert.True(t, resp.Steps[0].WholeCut)
+	assert.Len(t, resp.Steps[0].Increments, 2)
+	assert.Equal(t, 20.0, resp.FinalFlow)
+}
+
+func TestCapacityExpansionEngine_SeriesBottleneck(t *testing.T) {
+	// Рёбра 1→2 и 2→3 ограничивают поток вместе: расширение одного из них
+	// ничего не даёт, поэтому путь расширяется целиком
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 5},
+			{From: 2, To: 3, Capacity: 5},
+		},
+	}
+
+	resp := optimizeExpansion(t, &simulationv1.OptimizeCapacityExpansionRequest{
+		Graph:          graph,
+		Budget:         1000,
+		DefaultUpgrade: &simulationv1.EdgeUpgradeOption{CostPerUnit: 1},
+	})
+
+	assert.Equal(t, 5.0, resp.BaseFlow)
+	require.NotEmpty(t, resp.Steps)
+	first := resp.Steps[0]
+	assert.False(t, first.WholeCut)
+	require.Len(t, first.Increments, 2)
+	assert.Equal(t, 5.0, first.FlowGain)
+	assert.Equal(t, 10.0, first.Cost)
+
+	// Каждый шаг добавляет по 5 к обоим рёбрам за 10
+	assert.Equal(t, simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_MAX_ITERATIONS, resp.StopReason)
+	assert.Len(t, resp.Steps, defaultExpansionIterations)
+	assert.Equal(t, 255.0, resp.FinalFlow)
+	assert.Equal(t, 500.0, resp.TotalCost)
+	require.Len(t, resp.Increments, 2)
+	for _, inc := range resp.Increments {
+		assert.Equal(t, 255.0, inc.NewCapacity)
+	}
+}
+
+func TestUpgradePath(t *testing.T) {
+	// Дешёвый путь 1→2→4 требует расширить оба насыщенных ребра, дорогой
+	// 1→3→4 — одно ребро стоимостью 50
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 5},
+			{From: 2, To: 4, Capacity: 5},
+			{From: 1, To: 3, Capacity: 10},
+			{From: 3, To: 4, Capacity: 5},
+		},
+	}
+	cheap := &simulationv1.EdgeUpgradeOption{CostPerUnit: 1, Step: 5}
+	upgrades := []*edgeUpgrade{
+		{option: cheap},
+		{option: &simulationv1.EdgeUpgradeOption{CostPerUnit: 1, Step: 3}},
+		nil,
+		{option: &simulationv1.EdgeUpgradeOption{CostPerUnit: 10, Step: 5}},
+	}
+
+	path := upgradePath(graph, upgrades, map[int64]float64{1: 5, 2: 5, 3: 5, 4: 5})
+	require.NotNil(t, path)
+	assert.Equal(t, []int{0, 1}, path.edges)
+	assert.Equal(t, []float64{3, 3}, path.deltas) // Наименьшее приращение на пути
+	assert.Equal(t, 6.0, path.cost)
+
+	// Без расширяемых рёбер пути нет
+	assert.Nil(t, upgradePath(graph, make([]*edgeUpgrade, 4), map[int64]float64{1: 5, 2: 5, 3: 5, 4: 5}))
+}
+
+func TestCapacityExpansionEngine_MaxIncrease(t *testing.T) {
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Edges:    []*commonv1.Edge{{From: 1, To: 2, Capacity: 10}},
+	}
+
+	resp := optimizeExpansion(t, &simulationv1.OptimizeCapacityExpansionRequest{
+		Graph:          graph,
+		Budget:         1000,
+		DefaultUpgrade: &simulationv1.EdgeUpgradeOption{CostPerUnit: 1, Step: 4, MaxIncrease: 10},
+	})
+
+	// Шаги 4, 4 и остаток 2 до предела
+	require.Len(t, resp.Steps, 3)
+	assert.Equal(t, 2.0, resp.Steps[2].Increments[0].AddedCapacity)
+	assert.Equal(t, 20.0, resp.FinalFlow)
+	assert.Equal(t, simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_NO_GAIN, resp.StopReason)
+}
+
+func TestMinCutIndices(t *testing.T) {
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10},
+			{From: 2, To: 3, Capacity: 5},
+			{From: 3, To: 2, Capacity: 5},
+		},
+	}
+
+	assert.Equal(t, []int{1}, minCutIndices(graph, map[int64]float64{1: 5, 2: 5}))
+	assert.Equal(t, []int{0}, minCutIndices(graph, map[int64]float64{1: 10, 2: 5}))
+}
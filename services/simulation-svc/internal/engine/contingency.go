@@ -0,0 +1,433 @@
+// services/simulation-svc/internal/engine/contingency.go
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+)
+
+const (
+	defaultMaxCandidateEdges = 20
+	defaultCascadeLoadFactor = 0.9
+
+	// maxContingencyScenarios лимит решений перебора N-k
+	maxContingencyScenarios = 5000
+
+	// maxReportedCascades число каскадов в ответе
+	maxReportedCascades = 20
+
+	// flowTolerance допуск сравнения потоков
+	flowTolerance = 1e-9
+)
+
+// contingency анализ многократных и каскадных отказов. Рёбра задаются
+// индексами в graph, где всем рёбрам проставлены явные идентификаторы, —
+// индексы совпадают с индексами исходного графа и n1Result.reductions.
+type contingency struct {
+	engine    *ResilienceEngine
+	graph     *commonv1.Graph
+	algorithm commonv1.Algorithm
+	baseFlow  float64
+	baseFlows map[int64]float64 // Поток базового решения по идентификаторам рёбер
+	n1        *n1Result
+}
+
+func newContingency(
+	e *ResilienceEngine,
+	graph *commonv1.Graph,
+	algorithm commonv1.Algorithm,
+	baseResult *client.SolveResult,
+	n1 *n1Result,
+) *contingency {
+	clone := CloneGraph(graph)
+	AssignEdgeIDs(clone)
+	return &contingency{
+		engine:    e,
+		graph:     clone,
+		algorithm: algorithm,
+		baseFlow:  baseResult.MaxFlow,
+		baseFlows: edgeFlows(baseResult),
+		n1:        n1,
+	}
+}
+
+// edgeFlows возвращает поток решения по идентификаторам рёбер
+func edgeFlows(result *client.SolveResult) map[int64]float64 {
+	flows := make(map[int64]float64)
+	if result == nil || result.Graph == nil {
+		return flows
+	}
+	for i, edge := range result.Graph.Edges {
+		flows[EdgeID(edge, i)] = edge.CurrentFlow
+	}
+	return flows
+}
+
+// candidates отбирает рёбра для перебора: отказы рёбер без потока не меняют
+// максимальный поток, поэтому в первую очередь берутся рёбра минимального
+// разреза, затем резервные рёбра, принимавшие поток при одиночных отказах,
+// затем остальные рёбра с потоком по его убыванию
+func (c *contingency) candidates(limit int) []int {
+	if limit <= 0 {
+		limit = defaultMaxCandidateEdges
+	}
+
+	selected := make([]int, 0, limit)
+	seen := make(map[int]bool)
+	add := func(i int) {
+		if !seen[i] && len(selected) < limit {
+			seen[i] = true
+			selected = append(selected, i)
+		}
+	}
+
+	cut := make(map[int64]bool)
+	for _, key := range c.n1.minCutEdges {
+		cut[key.EdgeId] = true
+	}
+	for i, edge := range c.graph.Edges {
+		if cut[edge.Id] {
+			add(i)
+		}
+	}
+	for i, edge := range c.graph.Edges {
+		if c.n1.backupEdges[edge.Id] {
+			add(i)
+		}
+	}
+
+	loaded := make([]int, 0, len(c.graph.Edges))
+	for i, edge := range c.graph.Edges {
+		if c.baseFlows[edge.Id] > flowTolerance {
+			loaded = append(loaded, i)
+		}
+	}
+	sort.SliceStable(loaded, func(a, b int) bool {
+		return c.baseFlows[c.graph.Edges[loaded[a]].Id] > c.baseFlows[c.graph.Edges[loaded[b]].Id]
+	})
+	for _, i := range loaded {
+		add(i)
+	}
+	return selected
+}
+
+// solveWithout решает граф без рёбер failed. ok = false, если решение
+// не получено.
+func (c *contingency) solveWithout(ctx context.Context, failed map[int]bool) (*client.SolveResult, bool) {
+	modified := CloneGraph(c.graph)
+	edges := modified.Edges[:0]
+	for i, edge := range modified.Edges {
+		if !failed[i] {
+			edges = append(edges, edge)
+		}
+	}
+	modified.Edges = edges
+
+	result, err := c.engine.solverClient.Solve(ctx, modified, c.algorithm, nil)
+	if err != nil || result == nil {
+		return nil, false
+	}
+	return result, true
+}
+
+func (c *contingency) edgeKey(i int) *commonv1.EdgeKey {
+	return KeyOf(c.graph.Edges[i], i)
+}
+
+// =============================================================================
+// N-k
+// =============================================================================
+
+// nMinusK перебирает одновременные отказы от 2 до maxFailures рёбер-кандидатов.
+// Наборы с одиночной точкой отказа и надмножества уже найденных критических
+// наборов пропускаются: их отказ заведомо критичен.
+func (c *contingency) nMinusK(ctx context.Context, maxFailures int, candidates []int) (*simulationv1.NMinusTwoAnalysis, error) {
+	analysis := &simulationv1.NMinusTwoAnalysis{
+		Enabled:        true,
+		MaxFailures:    int32(maxFailures),
+		CandidateEdges: int32(len(candidates)),
+	}
+	if c.baseFlow <= flowTolerance {
+		return analysis, nil
+	}
+
+	tolerance := flowTolerance * max(1, c.baseFlow)
+	critical := make(map[string]bool)
+	var tested, failed int
+
+	for size := 2; size <= maxFailures && size <= len(candidates); size++ {
+		var err error
+		forEachCombination(len(candidates), size, func(combo []int) bool {
+			if err = ctx.Err(); err != nil {
+				return false
+			}
+
+			set := make([]int, size)
+			for j, pos := range combo {
+				set[j] = candidates[pos]
+			}
+			sort.Ints(set)
+			if c.containsSinglePoint(set) || containsCriticalSubset(set, critical) {
+				return true
+			}
+			if tested >= maxContingencyScenarios {
+				analysis.Truncated = true
+				return false
+			}
+
+			failedSet := make(map[int]bool, size)
+			sumSingles := 0.0
+			for _, i := range set {
+				failedSet[i] = true
+				sumSingles += c.n1.reductions[i]
+			}
+
+			result, ok := c.solveWithout(ctx, failedSet)
+			tested++
+			reduction := c.baseFlow
+			if ok && result.MaxFlow > flowTolerance {
+				reduction = c.baseFlow - result.MaxFlow
+			} else {
+				failed++
+			}
+			analysis.WorstCaseFlowReduction = max(analysis.WorstCaseFlowReduction, reduction)
+
+			if reduction >= c.baseFlow-tolerance || reduction > sumSingles+tolerance {
+				critical[edgeSetKey(set)] = true
+				c.recordCritical(analysis, set, reduction)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if analysis.Truncated {
+			break
+		}
+	}
+
+	sort.SliceStable(analysis.CriticalEdgePairs, func(a, b int) bool {
+		return analysis.CriticalEdgePairs[a].CombinedImpact > analysis.CriticalEdgePairs[b].CombinedImpact
+	})
+	sort.SliceStable(analysis.CriticalEdgeSets, func(a, b int) bool {
+		return analysis.CriticalEdgeSets[a].CombinedImpact > analysis.CriticalEdgeSets[b].CombinedImpact
+	})
+
+	analysis.ScenariosTested = int32(tested)
+	analysis.ScenariosFailed = int32(failed)
+	analysis.CriticalPairs = int32(len(analysis.CriticalEdgePairs))
+	if tested > 0 {
+		analysis.ProbabilityOfFailure = float64(failed) / float64(tested)
+	}
+	return analysis, nil
+}
+
+// containsSinglePoint проверяет, есть ли в наборе ребро, отказ которого
+// в одиночку обрывает поток
+func (c *contingency) containsSinglePoint(set []int) bool {
+	for _, i := range set {
+		if c.n1.reductions[i] >= c.baseFlow-flowTolerance*max(1, c.baseFlow) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *contingency) recordCritical(analysis *simulationv1.NMinusTwoAnalysis, set []int, reduction float64) {
+	if len(set) == 2 {
+		analysis.CriticalEdgePairs = append(analysis.CriticalEdgePairs, &simulationv1.EdgePair{
+			Edge1:          c.edgeKey(set[0]),
+			Edge2:          c.edgeKey(set[1]),
+			CombinedImpact: reduction,
+		})
+		return
+	}
+
+	keys := make([]*commonv1.EdgeKey, len(set))
+	for j, i := range set {
+		keys[j] = c.edgeKey(i)
+	}
+	analysis.CriticalEdgeSets = append(analysis.CriticalEdgeSets, &simulationv1.EdgeSet{
+		Edges:          keys,
+		CombinedImpact: reduction,
+	})
+}
+
+// containsCriticalSubset проверяет, есть ли среди собственных подмножеств
+// набора (из двух и более рёбер) уже найденный критический набор
+func containsCriticalSubset(set []int, critical map[string]bool) bool {
+	if len(critical) == 0 {
+		return false
+	}
+	full := 1<<len(set) - 1
+	for mask := 1; mask < full; mask++ {
+		subset := make([]int, 0, len(set))
+		for j, i := range set {
+			if mask&(1<<j) != 0 {
+				subset = append(subset, i)
+			}
+		}
+		if len(subset) >= 2 && critical[edgeSetKey(subset)] {
+			return true
+		}
+	}
+	return false
+}
+
+// edgeSetKey ключ набора упорядоченных индексов рёбер
+func edgeSetKey(set []int) string {
+	parts := make([]string, len(set))
+	for j, i := range set {
+		parts[j] = strconv.Itoa(i)
+	}
+	return strings.Join(parts, ",")
+}
+
+// forEachCombination перебирает сочетания из n по k в лексикографическом
+// порядке, пока visit возвращает true
+func forEachCombination(n, k int, visit func(combo []int) bool) {
+	combo := make([]int, k)
+	for i := range combo {
+		combo[i] = i
+	}
+	for {
+		if !visit(combo) {
+			return
+		}
+		i := k - 1
+		for i >= 0 && combo[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		combo[i]++
+		for j := i + 1; j < k; j++ {
+			combo[j] = combo[j-1] + 1
+		}
+	}
+}
+
+// =============================================================================
+// Каскадные отказы
+// =============================================================================
+
+// cascades запускает каскад из отказа каждого ребра-кандидата
+func (c *contingency) cascades(ctx context.Context, loadFactor float64, triggers []int) (*simulationv1.CascadeAnalysis, error) {
+	if loadFactor <= 0 {
+		loadFactor = defaultCascadeLoadFactor
+	}
+	analysis := &simulationv1.CascadeAnalysis{
+		Enabled:    true,
+		LoadFactor: loadFactor,
+	}
+
+	for _, trigger := range triggers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		scenario := c.cascade(ctx, trigger, loadFactor)
+		analysis.ScenariosTested++
+		analysis.WorstCaseFlowReduction = max(analysis.WorstCaseFlowReduction, scenario.FlowReduction)
+		if len(scenario.Steps) > 1 {
+			analysis.Scenarios = append(analysis.Scenarios, scenario)
+		}
+	}
+
+	sort.SliceStable(analysis.Scenarios, func(a, b int) bool {
+		return analysis.Scenarios[a].FlowReduction > analysis.Scenarios[b].FlowReduction
+	})
+	if len(analysis.Scenarios) > maxReportedCascades {
+		analysis.Scenarios = analysis.Scenarios[:maxReportedCascades]
+	}
+	return analysis, nil
+}
+
+// cascade моделирует каскад после отказа ребра trigger. В каждом раунде
+// поток перераспределяется по уцелевшим рёбрам; ребро отказывает в
+// следующем раунде, если его загрузка превысила loadFactor и выросла
+// относительно базового решения, — рёбра, и раньше работавшие с такой
+// загрузкой, перегрузкой не считаются. Каскад заканчивается, когда новых
+// перегрузок нет или поток оборвался.
+func (c *contingency) cascade(ctx context.Context, trigger int, loadFactor float64) *simulationv1.CascadeScenario {
+	failed := map[int]bool{trigger: true}
+	roundFailed := []int{trigger}
+	scenario := &simulationv1.CascadeScenario{InitialFailure: c.edgeKey(trigger)}
+
+	flow := c.baseFlow
+	for round := 0; len(roundFailed) > 0; round++ {
+		result, ok := c.solveWithout(ctx, failed)
+		flow = 0
+		if ok {
+			flow = result.MaxFlow
+		}
+
+		keys := make([]*commonv1.EdgeKey, len(roundFailed))
+		for j, i := range roundFailed {
+			keys[j] = c.edgeKey(i)
+		}
+		scenario.Steps = append(scenario.Steps, &simulationv1.CascadeStep{
+			Round:       int32(round),
+			FailedEdges: keys,
+			FlowAfter:   flow,
+		})
+		if flow <= flowTolerance {
+			break
+		}
+
+		flows := edgeFlows(result)
+		roundFailed = roundFailed[:0:0]
+		for i, edge := range c.graph.Edges {
+			if failed[i] || edge.Capacity <= 0 {
+				continue
+			}
+			utilization := flows[edge.Id] / edge.Capacity
+			baseUtilization := c.baseFlows[edge.Id] / edge.Capacity
+			if utilization > loadFactor+flowTolerance && utilization > baseUtilization+flowTolerance {
+				roundFailed = append(roundFailed, i)
+			}
+		}
+		for _, i := range roundFailed {
+			failed[i] = true
+		}
+	}
+
+	scenario.FinalFlow = flow
+	scenario.FlowReduction = c.baseFlow - flow
+	scenario.FailedEdges = int32(len(failed))
+	return scenario
+}
+
+// cascadeWeakness описывает риск каскадов, если хотя бы один отказ
+// распространился дальше исходного ребра
+func cascadeWeakness(analysis *simulationv1.CascadeAnalysis, baseFlow float64) *simulationv1.ResilienceWeakness {
+	if len(analysis.Scenarios) == 0 {
+		return nil
+	}
+
+	worst := analysis.Scenarios[0]
+	severity := 0.0
+	if baseFlow > 0 {
+		severity = worst.FlowReduction / baseFlow
+	}
+	edges := make([]*commonv1.EdgeKey, len(analysis.Scenarios))
+	for i, s := range analysis.Scenarios {
+		edges[i] = s.InitialFailure
+	}
+
+	return &simulationv1.ResilienceWeakness{
+		Type: simulationv1.WeaknessType_WEAKNESS_TYPE_CASCADE_RISK,
+		Description: fmt.Sprintf("Отказ любого из %d рёбер запускает каскад перегрузок: в худшем случае отказывают %d рёбер, поток снижается на %.1f%%",
+			len(analysis.Scenarios), worst.FailedEdges, severity*100),
+		Severity:             severity,
+		AffectedEdges:        edges,
+		MitigationSuggestion: "Увеличьте запас пропускной способности рёбер, принимающих перенаправленный поток",
+	}
+}
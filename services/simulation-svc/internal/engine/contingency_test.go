@@ -0,0 +1,234 @@
+// services/simulation-svc/internal/engine/contingency_test.go
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+)
+
+// maxFlowSolver считает максимальный поток алгоритмом Эдмондса–Карпа и
+// возвращает граф с потоками по рёбрам
+type maxFlowSolver struct{}
+
+func (maxFlowSolver) Solve(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, opts interface{}) (*client.SolveResult, error) {
+	type arc struct {
+		to       int64
+		residual float64
+		edge     int // Индекс ребра графа; -1 для обратной дуги
+		reverse  int
+	}
+	arcs := make(map[int64][]*arc)
+	for i, e := range graph.Edges {
+		forward := &arc{to: e.To, residual: e.Capacity, edge: i, reverse: len(arcs[e.To])}
+		backward := &arc{to: e.From, edge: -1, reverse: len(arcs[e.From])}
+		arcs[e.From] = append(arcs[e.From], forward)
+		arcs[e.To] = append(arcs[e.To], backward)
+	}
+
+	result := CloneGraph(graph)
+	total := 0.0
+	for {
+		type step struct {
+			from int64
+			arc  *arc
+		}
+		parent := map[int64]step{graph.SourceId: {}}
+		queue := []int64{graph.SourceId}
+		for len(queue) > 0 && !containsKey(parent, graph.SinkId) {
+			node := queue[0]
+			queue = queue[1:]
+			for _, a := range arcs[node] {
+				if _, seen := parent[a.to]; !seen && a.residual > 1e-12 {
+					parent[a.to] = step{from: node, arc: a}
+					queue = append(queue, a.to)
+				}
+			}
+		}
+		if !containsKey(parent, graph.SinkId) {
+			break
+		}
+
+		bottleneck := -1.0
+		for node := graph.SinkId; node != graph.SourceId; node = parent[node].from {
+			if r := parent[node].arc.residual; bottleneck < 0 || r < bottleneck {
+				bottleneck = r
+			}
+		}
+		for node := graph.SinkId; node != graph.SourceId; node = parent[node].from {
+			a := parent[node].arc
+			a.residual -= bottleneck
+			arcs[a.to][a.reverse].residual += bottleneck
+		}
+		total += bottleneck
+	}
+
+	for _, list := range arcs {
+		for _, a := range list {
+			if a.edge >= 0 {
+				result.Edges[a.edge].CurrentFlow = graph.Edges[a.edge].Capacity - a.residual
+			}
+		}
+	}
+	return &client.SolveResult{MaxFlow: total, Graph: result}, nil
+}
+
+func containsKey[K comparable, V any](m map[K]V, key K) bool {
+	_, ok := m[key]
+	return ok
+}
+
+func contingencyGraph(edges ...*commonv1.Edge) *commonv1.Graph {
+	nodes := make(map[int64]bool)
+	graph := &commonv1.Graph{SourceId: 1, Edges: edges}
+	for _, e := range edges {
+		for _, id := range []int64{e.From, e.To} {
+			if !nodes[id] {
+				nodes[id] = true
+				graph.Nodes = append(graph.Nodes, &commonv1.Node{Id: id})
+			}
+			graph.SinkId = max(graph.SinkId, id)
+		}
+	}
+	return graph
+}
+
+func TestResilienceEngine_NMinusTwo_RedundantPair(t *testing.T) {
+	// Основной маршрут 2→4 и резервный 2→3→4 — каждый держит 60 из 100
+	graph := contingencyGraph(
+		&commonv1.Edge{From: 1, To: 2, Capacity: 100},
+		&commonv1.Edge{From: 2, To: 4, Capacity: 60},
+		&commonv1.Edge{From: 2, To: 3, Capacity: 60},
+		&commonv1.Edge{From: 3, To: 4, Capacity: 60},
+	)
+
+	result, err := NewResilienceEngine(maxFlowSolver{}).AnalyzeResilience(context.Background(), graph, &simulationv1.ResilienceConfig{
+		MaxFailuresToTest: 3,
+	}, commonv1.Algorithm_ALGORITHM_DINIC)
+	require.NoError(t, err)
+
+	n2 := result.NMinusTwo
+	require.NotNil(t, n2)
+	assert.True(t, n2.Enabled)
+	assert.Equal(t, int32(3), n2.MaxFailures)
+	assert.Equal(t, int32(4), n2.CandidateEdges)
+
+	// Пары без одиночной точки отказа 1→2; тройка содержит критическую пару
+	assert.Equal(t, int32(3), n2.ScenariosTested)
+	assert.Equal(t, int32(2), n2.ScenariosFailed)
+	assert.InDelta(t, 2.0/3, n2.ProbabilityOfFailure, 1e-9)
+	assert.Equal(t, 100.0, n2.WorstCaseFlowReduction)
+	assert.Empty(t, n2.CriticalEdgeSets)
+
+	// Путь 2→3→4 — одно ребро резерва: его пара не критична
+	require.Equal(t, int32(2), n2.CriticalPairs)
+	for _, pair := range n2.CriticalEdgePairs {
+		assert.Equal(t, &commonv1.EdgeKey{From: 2, To: 4, EdgeId: 2}, pair.Edge1)
+		assert.Equal(t, 100.0, pair.CombinedImpact)
+	}
+}
+
+func TestResilienceEngine_NMinusK_CriticalTriples(t *testing.T) {
+	// Три независимых маршрута: критичен только отказ всех трёх
+	graph := contingencyGraph(
+		&commonv1.Edge{From: 1, To: 2, Capacity: 40},
+		&commonv1.Edge{From: 1, To: 3, Capacity: 40},
+		&commonv1.Edge{From: 1, To: 4, Capacity: 40},
+		&commonv1.Edge{From: 2, To: 5, Capacity: 40},
+		&commonv1.Edge{From: 3, To: 5, Capacity: 40},
+		&commonv1.Edge{From: 4, To: 5, Capacity: 40},
+	)
+
+	result, err := NewResilienceEngine(maxFlowSolver{}).AnalyzeResilience(context.Background(), graph, &simulationv1.ResilienceConfig{
+		MaxFailuresToTest: 3,
+	}, commonv1.Algorithm_ALGORITHM_DINIC)
+	require.NoError(t, err)
+
+	n3 := result.NMinusTwo
+	assert.Zero(t, n3.CriticalPairs)
+	assert.Equal(t, int32(15+20), n3.ScenariosTested)
+	require.Len(t, n3.CriticalEdgeSets, 8)
+	for _, set := range n3.CriticalEdgeSets {
+		assert.Len(t, set.Edges, 3)
+		assert.Equal(t, 120.0, set.CombinedImpact)
+	}
+}
+
+func TestResilienceEngine_NMinusTwo_CandidateLimit(t *testing.T) {
+	graph := createResilienceTestGraph()
+
+	result, err := NewResilienceEngine(maxFlowSolver{}).AnalyzeResilience(context.Background(), graph, &simulationv1.ResilienceConfig{
+		MaxFailuresToTest: 2,
+		MaxCandidateEdges: 2,
+	}, commonv1.Algorithm_ALGORITHM_DINIC)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), result.NMinusTwo.CandidateEdges)
+	assert.Equal(t, int32(1), result.NMinusTwo.ScenariosTested)
+}
+
+func TestResilienceEngine_Cascade(t *testing.T) {
+	// Кратчайший путь 2→4 загружен полностью, обход 2→3→4 — наполовину.
+	// Отказ 2→4 переводит весь поток в обход и перегружает его.
+	graph := contingencyGraph(
+		&commonv1.Edge{From: 1, To: 2, Capacity: 100},
+		&commonv1.Edge{From: 2, To: 4, Capacity: 50},
+		&commonv1.Edge{From: 2, To: 3, Capacity: 100},
+		&commonv1.Edge{From: 3, To: 4, Capacity: 100},
+	)
+	engine := NewResilienceEngine(maxFlowSolver{})
+
+	result, err := engine.AnalyzeResilience(context.Background(), graph, &simulationv1.ResilienceConfig{
+		TestCascadingFailures: true,
+	}, commonv1.Algorithm_ALGORITHM_DINIC)
+	require.NoError(t, err)
+
+	cascade := result.Cascade
+	require.NotNil(t, cascade)
+	assert.Nil(t, result.NMinusTwo)
+	assert.Equal(t, 0.9, cascade.LoadFactor)
+	assert.Equal(t, int32(4), cascade.ScenariosTested)
+	assert.Equal(t, 100.0, cascade.WorstCaseFlowReduction)
+
+	require.Len(t, cascade.Scenarios, 1)
+	scenario := cascade.Scenarios[0]
+	assert.Equal(t, &commonv1.EdgeKey{From: 2, To: 4, EdgeId: 2}, scenario.InitialFailure)
+	require.Len(t, scenario.Steps, 2)
+	assert.Equal(t, 100.0, scenario.Steps[0].FlowAfter)
+	assert.Equal(t, []*commonv1.EdgeKey{{From: 2, To: 3, EdgeId: 3}, {From: 3, To: 4, EdgeId: 4}}, scenario.Steps[1].FailedEdges)
+	assert.Zero(t, scenario.FinalFlow)
+	assert.Equal(t, int32(3), scenario.FailedEdges)
+
+	var risk *simulationv1.ResilienceWeakness
+	for _, w := range result.Weaknesses {
+		if w.Type == simulationv1.WeaknessType_WEAKNESS_TYPE_CASCADE_RISK {
+			risk = w
+		}
+	}
+	require.NotNil(t, risk)
+	assert.Equal(t, 1.0, risk.Severity)
+
+	// Порог 1: загрузка не может его превысить, каскадов нет
+	result, err = engine.AnalyzeResilience(context.Background(), graph, &simulationv1.ResilienceConfig{
+		TestCascadingFailures: true,
+		LoadFactor:            1,
+	}, commonv1.Algorithm_ALGORITHM_DINIC)
+	require.NoError(t, err)
+	assert.Empty(t, result.Cascade.Scenarios)
+	assert.Equal(t, 100.0, result.Cascade.WorstCaseFlowReduction)
+}
+
+func TestForEachCombination(t *testing.T) {
+	var combos [][]int
+	forEachCombination(4, 2, func(combo []int) bool {
+		combos = append(combos, append([]int(nil), combo...))
+		return true
+	})
+	assert.Equal(t, [][]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}, combos)
+}
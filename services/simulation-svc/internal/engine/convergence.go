@@ -0,0 +1,146 @@
+// services/simulation-svc/internal/engine/convergence.go
+package engine
+
+import (
+	"math"
+
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+const (
+	// minAdaptiveIterations итераций до первой проверки сходимости
+	minAdaptiveIterations = 100
+
+	// adaptiveCheckInterval шаг контрольных точек случайного и антитетического
+	// сэмплирования; чётный, чтобы не разрывать антитетические пары
+	adaptiveCheckInterval = 50
+)
+
+// convergence отслеживает адаптивную остановку прогона. Сходимость
+// проверяется в контрольных точках на префиксе итераций 0..c-1, как только
+// все они завершены, поэтому точка остановки не зависит от порядка
+// завершения итераций воркерами.
+type convergence struct {
+	engine      *MonteCarloEngine
+	target      float64
+	checkpoints []int
+	next        int    // Индекс следующей контрольной точки
+	done        []bool // Завершённые итерации
+	prefix      int    // Длина непрерывного префикса завершённых итераций
+
+	converged bool
+	limit     int // Контрольная точка, на которой прогон сошёлся
+}
+
+// newConvergence возвращает отслеживание сходимости или nil, если адаптивная
+// остановка не запрошена (или воспроизводятся отдельные итерации)
+func (e *MonteCarloEngine) newConvergence(numIterations int) *convergence {
+	if !e.adaptive() {
+		return nil
+	}
+	return &convergence{
+		engine:      e,
+		target:      e.config.TargetRelativeCiHalfWidth,
+		checkpoints: e.checkpoints(numIterations),
+		done:        make([]bool, numIterations),
+	}
+}
+
+func (e *MonteCarloEngine) adaptive() bool {
+	return e.config.TargetRelativeCiHalfWidth > 0 && len(e.config.ReplayIterations) == 0
+}
+
+// checkpoints возвращает длины префиксов, на которых проверяется сходимость:
+// для латинского гиперкуба и Соболя — границы реплик (оценке точности нужны
+// хотя бы две целые реплики), для остальных методов — каждые
+// adaptiveCheckInterval итераций после minAdaptiveIterations
+func (e *MonteCarloEngine) checkpoints(numIterations int) []int {
+	var checkpoints []int
+	switch e.samplingMethod() {
+	case simulationv1.SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE,
+		simulationv1.SamplingMethod_SAMPLING_METHOD_SOBOL:
+		layout := newReplicates(numIterations)
+		for replicate := 2; replicate <= layout.r; replicate++ {
+			checkpoints = append(checkpoints, layout.start(replicate))
+		}
+	default:
+		for c := minAdaptiveIterations; c < numIterations; c += adaptiveCheckInterval {
+			checkpoints = append(checkpoints, c)
+		}
+		checkpoints = append(checkpoints, numIterations)
+	}
+	return checkpoints
+}
+
+// add отмечает завершение итерации и проверяет ставшие доступными контрольные
+// точки. Возвращает true, когда прогон сошёлся.
+func (c *convergence) add(iteration int, results []MonteCarloResult) bool {
+	if c.converged {
+		return false
+	}
+
+	c.done[iteration] = true
+	for c.prefix < len(c.done) && c.done[c.prefix] {
+		c.prefix++
+	}
+
+	for c.next < len(c.checkpoints) && c.checkpoints[c.next] <= c.prefix {
+		checkpoint := c.checkpoints[c.next]
+		c.next++
+		if c.check(results, checkpoint) {
+			c.converged, c.limit = true, checkpoint
+			return true
+		}
+	}
+	return false
+}
+
+// check считает статистику итераций до checkpoint так же, как итоговый анализ,
+// и проверяет относительную полуширину интервалов потока и стоимости
+func (c *convergence) check(results []MonteCarloResult, checkpoint int) bool {
+	flows := make([]float64, 0, checkpoint)
+	costs := make([]float64, 0, checkpoint)
+	groups := make([]int, 0, checkpoint)
+	for _, r := range results {
+		if r.Iteration < checkpoint {
+			flows = append(flows, r.Flow)
+			costs = append(costs, r.Cost)
+			groups = append(groups, r.Group)
+		}
+	}
+
+	flowStats := calculateStats(flows, c.engine.config.ConfidenceLevel)
+	costStats := calculateStats(costs, c.engine.config.ConfidenceLevel)
+	c.engine.applySamplingPrecision(flowStats, flows, groups)
+	c.engine.applySamplingPrecision(costStats, costs, groups)
+
+	return flowStats.RelativeCiHalfWidth <= c.target && costStats.RelativeCiHalfWidth <= c.target
+}
+
+// estimateRemaining оценивает число оставшихся итераций: при адаптивной
+// остановке — до целевой полуширины (она убывает как 1/√n), но не больше
+// оставшихся до num_iterations
+func (e *MonteCarloEngine) estimateRemaining(done, total int, relative float64) int {
+	remaining := max(total-done, 0)
+	if !e.adaptive() || done == 0 || math.IsInf(relative, 0) {
+		return remaining
+	}
+
+	target := e.config.TargetRelativeCiHalfWidth
+	if relative <= target {
+		return 0
+	}
+	ratio := relative / target
+	needed := int(math.Ceil(float64(done) * ratio * ratio))
+	return min(max(needed-done, 0), remaining)
+}
+
+// completedPrefix возвращает длину непрерывного префикса итераций 0..k-1 в
+// отсортированных результатах
+func completedPrefix(results []MonteCarloResult) int {
+	k := 0
+	for k < len(results) && results[k].Iteration == k {
+		k++
+	}
+	return k
+}
@@ -0,0 +1,150 @@
+// services/simulation-svc/internal/engine/convergence_test.go
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+)
+
+// slowSolver отвечает как capacitySolver с задержкой
+type slowSolver struct {
+	delay time.Duration
+}
+
+func (s slowSolver) Solve(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, opts interface{}) (*client.SolveResult, error) {
+	time.Sleep(s.delay)
+	return capacitySolver{}.Solve(ctx, graph, algorithm, opts)
+}
+
+func runAdaptive(t *testing.T, config *simulationv1.MonteCarloConfig, solver SolverClientInterface) *simulationv1.RunMonteCarloResponse {
+	t.Helper()
+	engine := NewMonteCarloEngine(config, solver)
+	result, err := engine.Run(context.Background(), createMonteCarloTestGraph(), monteCarloUncertainties(), commonv1.Algorithm_ALGORITHM_DINIC, nil)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	return result
+}
+
+func TestMonteCarloEngine_Run_AdaptiveStopsOnConvergence(t *testing.T) {
+	config := func(parallel bool) *simulationv1.MonteCarloConfig {
+		return &simulationv1.MonteCarloConfig{
+			NumIterations:             5000,
+			RandomSeed:                42,
+			Parallel:                  parallel,
+			MaxWorkers:                4,
+			TargetRelativeCiHalfWidth: 0.01,
+		}
+	}
+
+	result := runAdaptive(t, config(false), capacitySolver{})
+
+	assert.Equal(t, simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CONVERGED, result.StopReason)
+	assert.Less(t, result.CompletedIterations, int32(5000))
+	assert.GreaterOrEqual(t, result.CompletedIterations, int32(minAdaptiveIterations))
+	assert.Zero(t, (result.CompletedIterations-minAdaptiveIterations)%adaptiveCheckInterval)
+	assert.LessOrEqual(t, result.FlowStats.RelativeCiHalfWidth, 0.01)
+	assert.LessOrEqual(t, result.CostStats.RelativeCiHalfWidth, 0.01)
+
+	// Точка остановки не зависит от числа воркеров
+	parallel := runAdaptive(t, config(true), capacitySolver{})
+	assert.Equal(t, result.CompletedIterations, parallel.CompletedIterations)
+	assert.Equal(t, result.FlowStats, parallel.FlowStats)
+}
+
+func TestMonteCarloEngine_Run_AdaptiveStratifiedReplicates(t *testing.T) {
+	result := runAdaptive(t, &simulationv1.MonteCarloConfig{
+		NumIterations:             2000,
+		RandomSeed:                42,
+		SamplingMethod:            simulationv1.SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE,
+		TargetRelativeCiHalfWidth: 0.001,
+	}, capacitySolver{})
+
+	// Остановка на границе реплики из 200 итераций
+	assert.Equal(t, simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CONVERGED, result.StopReason)
+	assert.Zero(t, result.CompletedIterations%200)
+	assert.Less(t, result.CompletedIterations, int32(2000))
+}
+
+func TestMonteCarloEngine_Run_AdaptiveReachesMaxIterations(t *testing.T) {
+	result := runAdaptive(t, &simulationv1.MonteCarloConfig{
+		NumIterations:             200,
+		RandomSeed:                42,
+		TargetRelativeCiHalfWidth: 1e-6,
+	}, capacitySolver{})
+
+	assert.Equal(t, simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_COMPLETED, result.StopReason)
+	assert.Equal(t, int32(200), result.CompletedIterations)
+	assert.Greater(t, result.FlowStats.RelativeCiHalfWidth, 1e-6)
+}
+
+func TestMonteCarloEngine_Run_TimeBudget(t *testing.T) {
+	result := runAdaptive(t, &simulationv1.MonteCarloConfig{
+		NumIterations:     1000,
+		RandomSeed:        42,
+		Parallel:          true,
+		MaxWorkers:        4,
+		TimeBudgetSeconds: 0.05,
+		ReturnSamples:     true,
+	}, slowSolver{delay: 2 * time.Millisecond})
+
+	assert.Equal(t, simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_TIME_BUDGET, result.StopReason)
+	assert.Less(t, result.CompletedIterations, int32(1000))
+
+	// В статистику входит непрерывный префикс итераций
+	require.Len(t, result.Samples, int(result.CompletedIterations))
+	for i, s := range result.Samples {
+		assert.Equal(t, int32(i), s.Iteration)
+	}
+}
+
+func TestMonteCarloEngine_Run_AdaptiveProgress(t *testing.T) {
+	engine := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{
+		NumIterations:             400,
+		RandomSeed:                42,
+		TargetRelativeCiHalfWidth: 1e-6,
+	}, capacitySolver{})
+
+	progressChan := make(chan *simulationv1.MonteCarloProgress, 100)
+	_, err := engine.Run(context.Background(), createMonteCarloTestGraph(), monteCarloUncertainties(), commonv1.Algorithm_ALGORITHM_DINIC, progressChan)
+	require.NoError(t, err)
+	close(progressChan)
+
+	count := 0
+	for progress := range progressChan {
+		count++
+		assert.Greater(t, progress.CiHalfWidth, 0.0)
+		assert.Greater(t, progress.RelativeCiHalfWidth, 0.0)
+		assert.Equal(t, progress.TotalIterations-progress.Iteration, progress.EstimatedIterationsRemaining)
+	}
+	assert.Equal(t, 40, count)
+}
+
+func TestMonteCarloEngine_EstimateRemaining(t *testing.T) {
+	adaptive := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{TargetRelativeCiHalfWidth: 0.01}, nil)
+
+	// Полуширина вдвое больше цели — нужно вчетверо больше итераций
+	assert.Equal(t, 300, adaptive.estimateRemaining(100, 1000, 0.02))
+	assert.Equal(t, 0, adaptive.estimateRemaining(100, 1000, 0.005))
+	assert.Equal(t, 900, adaptive.estimateRemaining(100, 1000, 0.1))
+
+	fixed := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{}, nil)
+	assert.Equal(t, 900, fixed.estimateRemaining(100, 1000, 0.02))
+}
+
+func TestMonteCarloEngine_Checkpoints(t *testing.T) {
+	random := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{}, nil)
+	assert.Equal(t, []int{100, 150, 200, 230}, random.checkpoints(230))
+
+	sobol := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{
+		SamplingMethod: simulationv1.SamplingMethod_SAMPLING_METHOD_SOBOL,
+	}, nil)
+	assert.Equal(t, []int{20, 30, 40, 50, 60, 70, 80, 90, 100}, sobol.checkpoints(100))
+}
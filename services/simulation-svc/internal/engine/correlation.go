@@ -0,0 +1,256 @@
+// services/simulation-svc/internal/engine/correlation.go
+package engine
+
+import (
+	"fmt"
+	"math"
+
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+// correlationTolerance допуск проверки симметрии, диагонали и положительной
+// определённости корреляционной матрицы
+const correlationTolerance = 1e-9
+
+// gaussianCopula связывает координаты точки единичного гиперкуба через
+// многомерное нормальное распределение: u → ε = Φ⁻¹(u) → z = Lε → Φ(z), где L —
+// множитель Холецкого корреляционной матрицы. Каждая координата остаётся
+// равномерной, поэтому квантили сохраняют маргинальные распределения, а
+// совместные отклонения (и хвосты) становятся зависимыми.
+type gaussianCopula struct {
+	cholesky [][]float64 // Нижнетреугольный множитель
+}
+
+// SetCorrelation задаёт корреляции между неопределённостями прогона
+func (e *MonteCarloEngine) SetCorrelation(correlation *simulationv1.UncertaintyCorrelation) {
+	e.correlation = correlation
+}
+
+// ValidateCorrelation проверяет корреляции для n неопределённостей
+func ValidateCorrelation(correlation *simulationv1.UncertaintyCorrelation, n int) error {
+	_, err := newGaussianCopula(correlation, n)
+	return err
+}
+
+// newGaussianCopula строит копулу для n неопределённостей; nil, если
+// корреляции не заданы
+func newGaussianCopula(correlation *simulationv1.UncertaintyCorrelation, n int) (*gaussianCopula, error) {
+	if correlation == nil || (len(correlation.Matrix) == 0 && len(correlation.Groups) == 0) {
+		return nil, nil
+	}
+
+	matrix, err := correlationMatrix(correlation, n)
+	if err != nil {
+		return nil, err
+	}
+
+	// Коэффициенты ранговых мер переводятся в корреляции нормальных величин,
+	// дающие ту же ранговую корреляцию
+	for i := range matrix {
+		for j := range matrix[i] {
+			if i != j {
+				matrix[i][j] = gaussianCorrelation(correlation.Measure, matrix[i][j])
+			}
+		}
+	}
+
+	cholesky, err := choleskyDecompose(matrix)
+	if err != nil {
+		return nil, err
+	}
+	return &gaussianCopula{cholesky: cholesky}, nil
+}
+
+// correlationMatrix собирает матрицу n×n из полной матрицы или групп
+func correlationMatrix(correlation *simulationv1.UncertaintyCorrelation, n int) ([][]float64, error) {
+	if len(correlation.Matrix) > 0 && len(correlation.Groups) > 0 {
+		return nil, fmt.Errorf("correlation: set either matrix or groups")
+	}
+
+	matrix := make([][]float64, n)
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+		matrix[i][i] = 1
+	}
+
+	if len(correlation.Matrix) > 0 {
+		if len(correlation.Matrix) != n*n {
+			return nil, fmt.Errorf("correlation matrix must have %d×%d entries, got %d", n, n, len(correlation.Matrix))
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				matrix[i][j] = correlation.Matrix[i*n+j]
+			}
+		}
+		for i := 0; i < n; i++ {
+			if math.Abs(matrix[i][i]-1) > correlationTolerance {
+				return nil, fmt.Errorf("correlation matrix diagonal entry %d must be 1", i)
+			}
+			for j := 0; j < i; j++ {
+				if math.Abs(matrix[i][j]-matrix[j][i]) > correlationTolerance {
+					return nil, fmt.Errorf("correlation matrix is not symmetric at (%d, %d)", i, j)
+				}
+				if math.Abs(matrix[i][j]) > 1 {
+					return nil, fmt.Errorf("correlation (%d, %d) must be in [-1, 1]", i, j)
+				}
+			}
+		}
+		return matrix, nil
+	}
+
+	grouped := make(map[int32]int)
+	for g, group := range correlation.Groups {
+		if math.Abs(group.Correlation) > 1 {
+			return nil, fmt.Errorf("correlation of group %d must be in [-1, 1]", g)
+		}
+		for _, idx := range group.UncertaintyIndices {
+			if idx < 0 || int(idx) >= n {
+				return nil, fmt.Errorf("correlation group %d: uncertainty index %d out of range", g, idx)
+			}
+			if other, ok := grouped[idx]; ok {
+				return nil, fmt.Errorf("uncertainty %d is in correlation groups %d and %d", idx, other, g)
+			}
+			grouped[idx] = g
+		}
+		for _, i := range group.UncertaintyIndices {
+			for _, j := range group.UncertaintyIndices {
+				if i != j {
+					matrix[i][j] = group.Correlation
+				}
+			}
+		}
+	}
+	return matrix, nil
+}
+
+// gaussianCorrelation переводит коэффициент меры measure в корреляцию
+// нормальных величин копулы: для неё ρ_S = 6/π·arcsin(ρ/2) и τ = 2/π·arcsin(ρ)
+func gaussianCorrelation(measure simulationv1.CorrelationMeasure, value float64) float64 {
+	switch measure {
+	case simulationv1.CorrelationMeasure_CORRELATION_MEASURE_SPEARMAN:
+		return 2 * math.Sin(math.Pi*value/6)
+	case simulationv1.CorrelationMeasure_CORRELATION_MEASURE_KENDALL:
+		return math.Sin(math.Pi * value / 2)
+	default:
+		return value
+	}
+}
+
+// choleskyDecompose возвращает L с LLᵀ = matrix. Вырожденные (полуопределённые)
+// матрицы допускаются — например, полная корреляция ±1: столбец с нулевым
+// ведущим элементом обнуляется.
+func choleskyDecompose(matrix [][]float64) ([][]float64, error) {
+	n := len(matrix)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+
+	for j := 0; j < n; j++ {
+		d := matrix[j][j]
+		for k := 0; k < j; k++ {
+			d -= l[j][k] * l[j][k]
+		}
+		if d < -correlationTolerance {
+			return nil, fmt.Errorf("correlation matrix is not positive semi-definite")
+		}
+		if d <= correlationTolerance {
+			continue
+		}
+		l[j][j] = math.Sqrt(d)
+
+		for i := j + 1; i < n; i++ {
+			s := matrix[i][j]
+			for k := 0; k < j; k++ {
+				s -= l[i][k] * l[j][k]
+			}
+			l[i][j] = s / l[j][j]
+		}
+	}
+
+	// Пропущенные ведущие элементы допустимы, только если LLᵀ восстанавливает матрицу
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			s := 0.0
+			for k := 0; k <= j; k++ {
+				s += l[i][k] * l[j][k]
+			}
+			if math.Abs(s-matrix[i][j]) > 1e-6 {
+				return nil, fmt.Errorf("correlation matrix is not positive semi-definite")
+			}
+		}
+	}
+	return l, nil
+}
+
+// correlate преобразует независимые равномерные координаты в зависимые
+func (c *gaussianCopula) correlate(point []float64) []float64 {
+	eps := make([]float64, len(point))
+	for i, u := range point {
+		eps[i] = normalInverse(clampUnit(u))
+	}
+
+	correlated := make([]float64, len(point))
+	for i, row := range c.cholesky {
+		z := 0.0
+		for k := 0; k <= i; k++ {
+			z += row[k] * eps[k]
+		}
+		correlated[i] = normalCDF(z)
+	}
+	return correlated
+}
+
+func normalCDF(z float64) float64 {
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
+
+// newCorrelatedSampler создаёт сэмплер метода прогона с наложенными
+// корреляциями (см. newSampler)
+func (e *MonteCarloEngine) newCorrelatedSampler(dims int) (sampler, error) {
+	smp := e.newSampler(dims)
+
+	copula, err := newGaussianCopula(e.correlation, dims)
+	if err != nil || copula == nil {
+		return smp, err
+	}
+	if smp == nil {
+		smp = &randomSampler{engine: e, dims: dims}
+	}
+	return &correlatedSampler{base: smp, copula: copula}, nil
+}
+
+// correlatedSampler накладывает копулу на точки базового сэмплера; группы
+// итераций не меняются
+type correlatedSampler struct {
+	base   sampler
+	copula *gaussianCopula
+}
+
+func (s *correlatedSampler) point(iteration int) []float64 {
+	return s.copula.correlate(s.base.point(iteration))
+}
+
+func (s *correlatedSampler) group(iteration int) int {
+	return s.base.group(iteration)
+}
+
+// randomSampler выдаёт независимые случайные точки из RNG итерации; нужен
+// как база копулы при случайном сэмплировании
+type randomSampler struct {
+	engine *MonteCarloEngine
+	dims   int
+}
+
+func (s *randomSampler) point(iteration int) []float64 {
+	rng := s.engine.iterationRand(iteration)
+	point := make([]float64, s.dims)
+	for dim := range point {
+		point[dim] = rng.Float64()
+	}
+	return point
+}
+
+func (s *randomSampler) group(iteration int) int {
+	return iteration
+}
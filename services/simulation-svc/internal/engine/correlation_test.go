@@ -0,0 +1,156 @@
+// services/simulation-svc/internal/engine/correlation_test.go
+package engine
+
+import (
+	"context"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+// spearman возвращает ранговую корреляцию Спирмена выборок без совпадений
+func spearman(x, y []float64) float64 {
+	ranks := func(values []float64) []float64 {
+		idx := make([]int, len(values))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.Slice(idx, func(a, b int) bool { return values[idx[a]] < values[idx[b]] })
+		r := make([]float64, len(values))
+		for rank, i := range idx {
+			r[i] = float64(rank)
+		}
+		return r
+	}
+	rx, ry := ranks(x), ranks(y)
+	n := float64(len(x))
+	var d2 float64
+	for i := range rx {
+		d := rx[i] - ry[i]
+		d2 += d * d
+	}
+	return 1 - 6*d2/(n*(n*n-1))
+}
+
+func TestNewGaussianCopula_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		correlation *simulationv1.UncertaintyCorrelation
+		wantErr     string
+	}{
+		{"none", nil, ""},
+		{"empty", &simulationv1.UncertaintyCorrelation{}, ""},
+		{"matrix", &simulationv1.UncertaintyCorrelation{Matrix: []float64{1, 0.5, 0, 0.5, 1, 0.2, 0, 0.2, 1}}, ""},
+		{"perfect_group", &simulationv1.UncertaintyCorrelation{Groups: []*simulationv1.CorrelationGroup{{UncertaintyIndices: []int32{0, 2}, Correlation: 1}}}, ""},
+		{"matrix_and_groups", &simulationv1.UncertaintyCorrelation{
+			Matrix: []float64{1, 0, 0, 0, 1, 0, 0, 0, 1},
+			Groups: []*simulationv1.CorrelationGroup{{UncertaintyIndices: []int32{0, 1}, Correlation: 0.5}},
+		}, "either"},
+		{"wrong_size", &simulationv1.UncertaintyCorrelation{Matrix: []float64{1, 0, 0, 1}}, "entries"},
+		{"asymmetric", &simulationv1.UncertaintyCorrelation{Matrix: []float64{1, 0.5, 0, 0.4, 1, 0, 0, 0, 1}}, "symmetric"},
+		{"diagonal", &simulationv1.UncertaintyCorrelation{Matrix: []float64{2, 0, 0, 0, 1, 0, 0, 0, 1}}, "diagonal"},
+		{"not_psd", &simulationv1.UncertaintyCorrelation{Matrix: []float64{1, 0.9, -0.9, 0.9, 1, 0.9, -0.9, 0.9, 1}}, "positive semi-definite"},
+		{"group_out_of_range", &simulationv1.UncertaintyCorrelation{Groups: []*simulationv1.CorrelationGroup{{UncertaintyIndices: []int32{0, 3}, Correlation: 0.5}}}, "out of range"},
+		{"overlapping_groups", &simulationv1.UncertaintyCorrelation{Groups: []*simulationv1.CorrelationGroup{
+			{UncertaintyIndices: []int32{0, 1}, Correlation: 0.5},
+			{UncertaintyIndices: []int32{1, 2}, Correlation: 0.5},
+		}}, "groups 0 and 1"},
+		{"group_not_psd", &simulationv1.UncertaintyCorrelation{Groups: []*simulationv1.CorrelationGroup{{UncertaintyIndices: []int32{0, 1, 2}, Correlation: -0.9}}}, "positive semi-definite"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCorrelation(tt.correlation, 3)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGaussianCorrelation_RankMeasures(t *testing.T) {
+	assert.InDelta(t, 0.5, gaussianCorrelation(simulationv1.CorrelationMeasure_CORRELATION_MEASURE_GAUSSIAN, 0.5), 1e-12)
+	assert.InDelta(t, 1.0, gaussianCorrelation(simulationv1.CorrelationMeasure_CORRELATION_MEASURE_SPEARMAN, 1), 1e-12)
+	assert.InDelta(t, 1.0, gaussianCorrelation(simulationv1.CorrelationMeasure_CORRELATION_MEASURE_KENDALL, 1), 1e-12)
+	assert.InDelta(t, 0.5176, gaussianCorrelation(simulationv1.CorrelationMeasure_CORRELATION_MEASURE_SPEARMAN, 0.5), 1e-4)
+	assert.InDelta(t, math.Sqrt2/2, gaussianCorrelation(simulationv1.CorrelationMeasure_CORRELATION_MEASURE_KENDALL, 0.5), 1e-12)
+}
+
+func TestGaussianCopula_PerfectCorrelation(t *testing.T) {
+	copula, err := newGaussianCopula(&simulationv1.UncertaintyCorrelation{
+		Groups: []*simulationv1.CorrelationGroup{{UncertaintyIndices: []int32{0, 1}, Correlation: 1}},
+	}, 3)
+	require.NoError(t, err)
+
+	point := copula.correlate([]float64{0.2, 0.9, 0.7})
+	assert.InDelta(t, 0.2, point[0], 1e-6)
+	assert.InDelta(t, 0.2, point[1], 1e-6)
+	assert.InDelta(t, 0.7, point[2], 1e-6)
+}
+
+func TestMonteCarloEngine_Run_CorrelatedUncertainties(t *testing.T) {
+	uniform := func(from int64) *simulationv1.UncertaintySpec {
+		return &simulationv1.UncertaintySpec{
+			Type:   simulationv1.UncertaintyType_UNCERTAINTY_TYPE_EDGE,
+			Edge:   &commonv1.EdgeKey{From: 1, To: from},
+			Target: simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY,
+			Distribution: &simulationv1.Distribution{
+				Type:   simulationv1.DistributionType_DISTRIBUTION_TYPE_UNIFORM,
+				Param1: 0.5,
+				Param2: 1.5,
+			},
+		}
+	}
+	uncertainties := []*simulationv1.UncertaintySpec{uniform(2), uniform(3)}
+
+	run := func(method simulationv1.SamplingMethod, correlation *simulationv1.UncertaintyCorrelation) *simulationv1.RunMonteCarloResponse {
+		engine := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{
+			NumIterations:  2000,
+			RandomSeed:     42,
+			SamplingMethod: method,
+			ReturnSamples:  true,
+		}, capacitySolver{})
+		engine.SetCorrelation(correlation)
+		result, err := engine.Run(context.Background(), createMonteCarloTestGraph(), uncertainties, commonv1.Algorithm_ALGORITHM_DINIC, nil)
+		require.NoError(t, err)
+		return result
+	}
+
+	correlation := &simulationv1.UncertaintyCorrelation{
+		Measure: simulationv1.CorrelationMeasure_CORRELATION_MEASURE_SPEARMAN,
+		Groups:  []*simulationv1.CorrelationGroup{{UncertaintyIndices: []int32{0, 1}, Correlation: 0.8}},
+	}
+	independent := run(simulationv1.SamplingMethod_SAMPLING_METHOD_RANDOM, nil)
+
+	for _, method := range []simulationv1.SamplingMethod{
+		simulationv1.SamplingMethod_SAMPLING_METHOD_RANDOM,
+		simulationv1.SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE,
+	} {
+		t.Run(method.String(), func(t *testing.T) {
+			result := run(method, correlation)
+
+			first := make([]float64, len(result.Samples))
+			second := make([]float64, len(result.Samples))
+			for i, s := range result.Samples {
+				first[i], second[i] = s.Multipliers[0], s.Multipliers[1]
+				// Маргинальные распределения сохраняются
+				assert.True(t, first[i] >= 0.5 && first[i] <= 1.5)
+				assert.True(t, second[i] >= 0.5 && second[i] <= 1.5)
+			}
+			assert.InDelta(t, 0.8, spearman(first, second), 0.03)
+			assert.InDelta(t, 100, result.FlowStats.Mean, 1)
+
+			// Совместные провалы пропускной способности утяжеляют хвост
+			assert.Greater(t, result.RiskAnalysis.ValueAtRisk, 1.1*independent.RiskAnalysis.ValueAtRisk)
+			assert.Greater(t, result.RiskAnalysis.ExpectedShortfall, 1.1*independent.RiskAnalysis.ExpectedShortfall)
+		})
+	}
+}
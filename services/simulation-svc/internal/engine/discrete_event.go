@@ -0,0 +1,474 @@
+// services/simulation-svc/internal/engine/discrete_event.go
+package engine
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/domain"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultDESHorizonHours   = 24
+	defaultVehicleCapacity   = 10
+	defaultServiceTimeHours  = 0.5
+	defaultDocksPerNode      = 1
+	defaultHistogramBuckets  = 20
+	maxDiscreteEventVehicles = 200000
+)
+
+// DiscreteEventEngine симулирует отдельные рейсы по решённому потоку:
+// отправления по расписанию, время в пути со случайными задержками и очереди
+// на посты погрузки-разгрузки в узлах
+type DiscreteEventEngine struct {
+	config *simulationv1.DiscreteEventConfig
+	seed   int64
+	rng    *rand.Rand
+}
+
+// NewDiscreteEventEngine создаёт движок с конфигурацией; нулевые параметры
+// заменяются значениями по умолчанию
+func NewDiscreteEventEngine(config *simulationv1.DiscreteEventConfig) *DiscreteEventEngine {
+	cfg := &simulationv1.DiscreteEventConfig{}
+	if config != nil {
+		cfg = proto.Clone(config).(*simulationv1.DiscreteEventConfig)
+	}
+	if cfg.HorizonHours <= 0 {
+		cfg.HorizonHours = defaultDESHorizonHours
+	}
+	if cfg.VehicleCapacity <= 0 {
+		cfg.VehicleCapacity = defaultVehicleCapacity
+	}
+	if cfg.LoadingTimeHours <= 0 {
+		cfg.LoadingTimeHours = defaultServiceTimeHours
+	}
+	if cfg.UnloadingTimeHours <= 0 {
+		cfg.UnloadingTimeHours = defaultServiceTimeHours
+	}
+	if cfg.DocksPerNode <= 0 {
+		cfg.DocksPerNode = defaultDocksPerNode
+	}
+	if cfg.HistogramBuckets <= 0 {
+		cfg.HistogramBuckets = defaultHistogramBuckets
+	}
+
+	seed := cfg.RandomSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &DiscreteEventEngine{
+		config: cfg,
+		seed:   seed,
+		rng:    rand.New(rand.NewSource(seed)),
+	}
+}
+
+// shipmentRoute маршрут, по которому идёт часть потока
+type shipmentRoute struct {
+	nodes []int64
+	edges []int // Индексы рёбер графа между соседними узлами
+	flow  float64
+
+	vehicles int
+	leadTime float64 // Сумма времён доставки рейсов
+}
+
+// vehicle один рейс
+type vehicle struct {
+	route *shipmentRoute
+	load  float64
+	ready float64 // Время готовности груза к отправке
+}
+
+// desEventKind вид события
+type desEventKind int
+
+const (
+	eventArrive     desEventKind = iota // Рейс прибыл в узел маршрута
+	eventServiceEnd                     // Закончено обслуживание рейса на посту
+)
+
+type desEvent struct {
+	time     float64
+	seq      int64 // Порядок постановки: детерминирует одновременные события
+	kind     desEventKind
+	vehicle  *vehicle
+	position int // Индекс узла в маршруте
+}
+
+type desEventQueue []*desEvent
+
+func (q desEventQueue) Len() int { return len(q) }
+func (q desEventQueue) Less(i, j int) bool {
+	if q[i].time != q[j].time {
+		return q[i].time < q[j].time
+	}
+	return q[i].seq < q[j].seq
+}
+func (q desEventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *desEventQueue) Push(x any)   { *q = append(*q, x.(*desEvent)) }
+func (q *desEventQueue) Pop() any {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
+
+// dockQueue посты узла с FIFO-очередью
+type dockQueue struct {
+	docks   int
+	busy    int
+	waiting []*desEvent // Рейсы в очереди с временем постановки
+
+	lastChange float64
+	queueArea  float64 // ∫ длина очереди dt
+	busyArea   float64 // ∫ занятые посты dt
+
+	served   int
+	waitSum  float64
+	waitMax  float64
+	queueMax int
+}
+
+// advance накапливает площади до момента t
+func (d *dockQueue) advance(t float64) {
+	dt := t - d.lastChange
+	d.queueArea += float64(len(d.waiting)) * dt
+	d.busyArea += float64(d.busy) * dt
+	d.lastChange = t
+}
+
+// discreteEventRun состояние одного прогона
+type discreteEventRun struct {
+	engine  *DiscreteEventEngine
+	graph   *commonv1.Graph
+	travel  []float64
+	storage map[int64]bool // Промежуточные склады с перегрузкой
+
+	events desEventQueue
+	seq    int64
+	docks  map[int64]*dockQueue
+	order  []int64 // Узлы с постами в порядке первого обслуживания
+
+	processed int64
+	makespan  float64
+	delivered []float64 // Время доставки каждого рейса
+	completed []*vehicle
+	waitTotal float64
+}
+
+// Run раскладывает поток графа на маршруты, формирует рейсы и проигрывает
+// события до завершения всех рейсов
+func (e *DiscreteEventEngine) Run(ctx context.Context, graph *commonv1.Graph) (*simulationv1.RunDiscreteEventSimulationResponse, error) {
+	routes := shipmentRoutes(graph)
+
+	vehicles, err := e.schedule(routes)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &discreteEventRun{
+		engine:  e,
+		graph:   graph,
+		travel:  travelHours(graph, e.config.RoadSpeeds),
+		storage: make(map[int64]bool),
+		docks:   make(map[int64]*dockQueue),
+	}
+	for _, node := range graph.Nodes {
+		if node.Type == commonv1.NodeType_NODE_TYPE_WAREHOUSE {
+			run.storage[node.Id] = true
+		}
+	}
+	for _, v := range vehicles {
+		run.push(v.ready, eventArrive, v, 0)
+	}
+
+	for run.events.Len() > 0 {
+		if run.processed%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		event := heap.Pop(&run.events).(*desEvent)
+		run.processed++
+		switch event.kind {
+		case eventArrive:
+			run.arrive(event)
+		case eventServiceEnd:
+			run.serviceEnd(event)
+		}
+	}
+
+	return run.result(routes, vehicles), nil
+}
+
+// schedule формирует рейсы маршрутов: груз копится с интенсивностью потока
+// маршрута и уходит с интервалом отправлений (по умолчанию — по мере полной
+// загрузки); остаток на конец окна уходит последним рейсом. Загрузка сверх
+// вместимости делится между несколькими одновременными рейсами.
+func (e *DiscreteEventEngine) schedule(routes []*shipmentRoute) ([]*vehicle, error) {
+	horizon := e.config.HorizonHours
+	capacity := e.config.VehicleCapacity
+
+	var vehicles []*vehicle
+	dispatch := func(route *shipmentRoute, at, load float64) error {
+		for load > flowTolerance {
+			v := &vehicle{route: route, load: math.Min(load, capacity), ready: at}
+			vehicles = append(vehicles, v)
+			load -= v.load
+			if len(vehicles) > maxDiscreteEventVehicles {
+				return fmt.Errorf("discrete-event simulation exceeds %d vehicles; increase vehicle_capacity or reduce horizon_hours",
+					maxDiscreteEventVehicles)
+			}
+		}
+		return nil
+	}
+
+	for _, route := range routes {
+		interval := e.config.DepartureIntervalHours
+		if interval <= 0 {
+			interval = capacity / route.flow
+		}
+
+		last := 0.0
+		for k := 1; float64(k)*interval <= horizon+flowTolerance; k++ {
+			last = float64(k) * interval
+			if err := dispatch(route, last, route.flow*interval); err != nil {
+				return nil, err
+			}
+		}
+		if horizon-last > flowTolerance {
+			if err := dispatch(route, horizon, route.flow*(horizon-last)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return vehicles, nil
+}
+
+func (r *discreteEventRun) push(t float64, kind desEventKind, v *vehicle, position int) {
+	r.seq++
+	heap.Push(&r.events, &desEvent{time: t, seq: r.seq, kind: kind, vehicle: v, position: position})
+}
+
+// serviceTime возвращает время обслуживания рейса в узле маршрута: погрузка
+// в начале, разгрузка в конце, перегрузка на промежуточном складе
+func (r *discreteEventRun) serviceTime(v *vehicle, position int) float64 {
+	config := r.engine.config
+	switch {
+	case position == 0:
+		return config.LoadingTimeHours
+	case position == len(v.route.nodes)-1:
+		return config.UnloadingTimeHours
+	case r.storage[v.route.nodes[position]]:
+		return config.UnloadingTimeHours + config.LoadingTimeHours
+	default:
+		return 0
+	}
+}
+
+func (r *discreteEventRun) dock(node int64) *dockQueue {
+	d, ok := r.docks[node]
+	if !ok {
+		d = &dockQueue{docks: int(r.engine.config.DocksPerNode)}
+		r.docks[node] = d
+		r.order = append(r.order, node)
+	}
+	return d
+}
+
+// arrive ставит рейс на пост узла или в очередь; узлы без обслуживания
+// рейс проезжает сразу
+func (r *discreteEventRun) arrive(event *desEvent) {
+	v, position := event.vehicle, event.position
+	service := r.serviceTime(v, position)
+	if service <= 0 {
+		r.depart(event.time, v, position)
+		return
+	}
+
+	d := r.dock(v.route.nodes[position])
+	d.advance(event.time)
+	if d.busy < d.docks {
+		r.startService(d, event.time, event)
+		return
+	}
+	d.waiting = append(d.waiting, event)
+	d.queueMax = max(d.queueMax, len(d.waiting))
+}
+
+func (r *discreteEventRun) startService(d *dockQueue, t float64, arrival *desEvent) {
+	wait := t - arrival.time
+	d.busy++
+	d.served++
+	d.waitSum += wait
+	d.waitMax = math.Max(d.waitMax, wait)
+	r.waitTotal += wait
+	r.push(t+r.serviceTime(arrival.vehicle, arrival.position), eventServiceEnd, arrival.vehicle, arrival.position)
+}
+
+// serviceEnd освобождает пост, берёт следующий рейс из очереди и отправляет
+// обслуженный рейс дальше или завершает его
+func (r *discreteEventRun) serviceEnd(event *desEvent) {
+	v, position := event.vehicle, event.position
+	d := r.dock(v.route.nodes[position])
+	d.advance(event.time)
+	d.busy--
+	if len(d.waiting) > 0 {
+		next := d.waiting[0]
+		d.waiting = d.waiting[1:]
+		r.startService(d, event.time, next)
+	}
+
+	if position == len(v.route.nodes)-1 {
+		r.complete(event.time, v)
+		return
+	}
+	r.depart(event.time, v, position)
+}
+
+// depart отправляет рейс по следующему ребру маршрута
+func (r *discreteEventRun) depart(t float64, v *vehicle, position int) {
+	if position == len(v.route.nodes)-1 {
+		r.complete(t, v)
+		return
+	}
+	travel := r.travel[v.route.edges[position]]
+	if delay := r.engine.config.TravelDelay; delay != nil {
+		travel += math.Max(0, sampleDistribution(delay, r.engine.rng))
+	}
+	r.push(t+travel, eventArrive, v, position+1)
+}
+
+func (r *discreteEventRun) complete(t float64, v *vehicle) {
+	leadTime := t - v.ready
+	r.delivered = append(r.delivered, leadTime)
+	r.completed = append(r.completed, v)
+	v.route.vehicles++
+	v.route.leadTime += leadTime
+	r.makespan = math.Max(r.makespan, t)
+}
+
+// result собирает статистику прогона
+func (r *discreteEventRun) result(routes []*shipmentRoute, vehicles []*vehicle) *simulationv1.RunDiscreteEventSimulationResponse {
+	config := r.engine.config
+	stats := &simulationv1.DiscreteEventStats{
+		VehiclesDispatched: int32(len(vehicles)),
+		VehiclesDelivered:  int32(len(r.completed)),
+		MakespanHours:      r.makespan,
+		EventsProcessed:    r.processed,
+	}
+	for _, v := range vehicles {
+		stats.UnitsDispatched += v.load
+	}
+	for _, route := range routes {
+		stats.PlannedThroughput += route.flow
+	}
+	if len(vehicles) > 0 {
+		stats.AverageWaitHours = r.waitTotal / float64(len(vehicles))
+	}
+
+	hours := int(math.Ceil(r.makespan))
+	throughput := make([]*simulationv1.ThroughputPoint, hours)
+	for h := range throughput {
+		throughput[h] = &simulationv1.ThroughputPoint{Hour: int32(h)}
+	}
+	for i, v := range r.completed {
+		done := v.ready + r.delivered[i]
+		if done <= config.HorizonHours+flowTolerance {
+			stats.UnitsDeliveredInHorizon += v.load
+		}
+		h := min(int(done), hours-1)
+		throughput[h].UnitsDelivered += v.load
+		throughput[h].VehiclesDelivered++
+	}
+	stats.Throughput = stats.UnitsDeliveredInHorizon / config.HorizonHours
+
+	resp := &simulationv1.RunDiscreteEventSimulationResponse{
+		Success:    true,
+		Stats:      stats,
+		Throughput: throughput,
+		RandomSeed: r.engine.seed,
+	}
+	if len(r.delivered) > 0 {
+		resp.LeadTimeStats = calculateStats(r.delivered, 0.95)
+		resp.LeadTimeHistogram = buildHistogram(r.delivered, int(config.HistogramBuckets))
+		resp.LeadTimePercentiles = calculatePercentiles(r.delivered)
+	}
+
+	for _, node := range r.order {
+		d := r.docks[node]
+		d.advance(r.makespan)
+		queue := &simulationv1.NodeQueueStats{
+			NodeId:         node,
+			VehiclesServed: int32(d.served),
+			MaxQueueLength: int32(d.queueMax),
+			MaxWaitHours:   d.waitMax,
+		}
+		if d.served > 0 {
+			queue.AverageWaitHours = d.waitSum / float64(d.served)
+		}
+		if r.makespan > 0 {
+			queue.AverageQueueLength = d.queueArea / r.makespan
+			queue.DockUtilization = d.busyArea / (float64(d.docks) * r.makespan)
+		}
+		resp.NodeQueues = append(resp.NodeQueues, queue)
+	}
+
+	for _, route := range routes {
+		shipment := &simulationv1.ShipmentRoute{
+			NodeIds:  route.nodes,
+			Flow:     route.flow,
+			Vehicles: int32(route.vehicles),
+		}
+		if route.vehicles > 0 {
+			shipment.AverageLeadTimeHours = route.leadTime / float64(route.vehicles)
+		}
+		resp.Routes = append(resp.Routes, shipment)
+	}
+	return resp
+}
+
+// shipmentRoutes раскладывает поток решённого графа на маршруты
+// (domain.DecomposeFlow) от узлов с избытком потока к узлам с недостатком.
+// Поток по циркуляциям, не связанный с доставкой, отбрасывается.
+func shipmentRoutes(g *commonv1.Graph) []*shipmentRoute {
+	arcs := make([]domain.FlowArc, 0, len(g.Edges))
+	edges := make([]int, 0, len(g.Edges)) // Индекс ребра графа для каждой дуги
+	for i, edge := range g.Edges {
+		from, to, flow := edge.From, edge.To, edge.CurrentFlow
+		if flow < 0 {
+			from, to, flow = to, from, -flow
+		}
+		if flow <= flowTolerance {
+			continue
+		}
+		arcs = append(arcs, domain.FlowArc{From: from, To: to, Flow: flow})
+		edges = append(edges, i)
+	}
+
+	var routes []*shipmentRoute
+	index := make(map[string]*shipmentRoute)
+	for _, path := range domain.DecomposeFlow(arcs).Paths {
+		route := &shipmentRoute{nodes: path.Nodes, flow: path.Flow}
+		for _, a := range path.Arcs {
+			route.edges = append(route.edges, edges[a])
+		}
+		key := fmt.Sprint(route.edges)
+		if existing, ok := index[key]; ok {
+			existing.flow += path.Flow
+			continue
+		}
+		index[key] = route
+		routes = append(routes, route)
+	}
+	return routes
+}
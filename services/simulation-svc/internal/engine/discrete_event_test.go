@@ -0,0 +1,175 @@
+// services/simulation-svc/internal/engine/discrete_event_test.go
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+// desTestGraph: поток 10 в час 1→2→3 по дорогам 60 км со скоростью 60 км/ч
+func desTestGraph(middle commonv1.NodeType) *commonv1.Graph {
+	return &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2, Type: middle}, {Id: 3}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 20, CurrentFlow: 10, Length: 60, RoadType: commonv1.RoadType_ROAD_TYPE_PRIMARY},
+			{From: 2, To: 3, Capacity: 20, CurrentFlow: 10, Length: 60, RoadType: commonv1.RoadType_ROAD_TYPE_PRIMARY},
+		},
+	}
+}
+
+func TestShipmentRoutes(t *testing.T) {
+	// Поток 1→4 делится на два маршрута; обратное ребро 3←4 несёт поток 3→4
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, CurrentFlow: 6},
+			{From: 1, To: 3, CurrentFlow: 4},
+			{From: 2, To: 4, CurrentFlow: 6},
+			{From: 4, To: 3, CurrentFlow: -4},
+		},
+	}
+
+	routes := shipmentRoutes(graph)
+	require.Len(t, routes, 2)
+	assert.Equal(t, []int64{1, 2, 4}, routes[0].nodes)
+	assert.Equal(t, []int{0, 2}, routes[0].edges)
+	assert.Equal(t, 6.0, routes[0].flow)
+	assert.Equal(t, []int64{1, 3, 4}, routes[1].nodes)
+	assert.Equal(t, []int{1, 3}, routes[1].edges)
+	assert.Equal(t, 4.0, routes[1].flow)
+}
+
+func TestShipmentRoutes_ParallelEdges(t *testing.T) {
+	// Параллельные дороги с разным временем в пути — разные маршруты
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, CurrentFlow: 3, Length: 10},
+			{From: 1, To: 2, CurrentFlow: 5, Length: 30},
+		},
+	}
+
+	routes := shipmentRoutes(graph)
+	require.Len(t, routes, 2)
+	assert.Equal(t, []int{1}, routes[0].edges)
+	assert.Equal(t, 5.0, routes[0].flow)
+	assert.Equal(t, []int{0}, routes[1].edges)
+	assert.Equal(t, 3.0, routes[1].flow)
+}
+
+func TestDiscreteEventEngine_LeadTime(t *testing.T) {
+	// Погрузка 0.5 ч, два часа в пути и разгрузка 0.5 ч; транзитный узел без обслуживания
+	engine := NewDiscreteEventEngine(&simulationv1.DiscreteEventConfig{
+		HorizonHours:    2,
+		VehicleCapacity: 10,
+		RandomSeed:      1,
+	})
+	resp, err := engine.Run(context.Background(), desTestGraph(commonv1.NodeType_NODE_TYPE_INTERSECTION))
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), resp.Stats.VehiclesDispatched)
+	assert.Equal(t, int32(2), resp.Stats.VehiclesDelivered)
+	assert.Equal(t, 20.0, resp.Stats.UnitsDispatched)
+	assert.Equal(t, 10.0, resp.Stats.PlannedThroughput)
+	assert.InDelta(t, 5, resp.Stats.MakespanHours, 1e-9)
+	assert.InDelta(t, 3, resp.LeadTimeStats.Mean, 1e-9)
+	assert.Zero(t, resp.Stats.AverageWaitHours)
+
+	// Рейсы готовы в 1 и 2 ч и доставлены в 4 и 5 ч — после окна отправлений;
+	// доставка в момент окончания симуляции относится к последнему часу
+	assert.Zero(t, resp.Stats.UnitsDeliveredInHorizon)
+	require.Len(t, resp.Throughput, 5)
+	assert.Equal(t, 20.0, resp.Throughput[4].UnitsDelivered)
+	assert.Equal(t, int32(2), resp.Throughput[4].VehiclesDelivered)
+
+	require.Len(t, resp.NodeQueues, 2)
+	assert.Equal(t, int64(1), resp.NodeQueues[0].NodeId)
+	assert.Equal(t, int64(3), resp.NodeQueues[1].NodeId)
+	require.Len(t, resp.Routes, 1)
+	assert.Equal(t, []int64{1, 2, 3}, resp.Routes[0].NodeIds)
+	assert.Equal(t, int32(2), resp.Routes[0].Vehicles)
+	assert.InDelta(t, 3, resp.Routes[0].AverageLeadTimeHours, 1e-9)
+}
+
+func TestDiscreteEventEngine_WarehouseTransshipment(t *testing.T) {
+	engine := NewDiscreteEventEngine(&simulationv1.DiscreteEventConfig{HorizonHours: 1, RandomSeed: 1})
+	resp, err := engine.Run(context.Background(), desTestGraph(commonv1.NodeType_NODE_TYPE_WAREHOUSE))
+	require.NoError(t, err)
+
+	// На складе рейс разгружается и загружается заново
+	assert.InDelta(t, 4, resp.LeadTimeStats.Mean, 1e-9)
+	assert.Len(t, resp.NodeQueues, 3)
+}
+
+func TestDiscreteEventEngine_Queueing(t *testing.T) {
+	// Загрузка 30 за отправление делится на три одновременных рейса
+	config := &simulationv1.DiscreteEventConfig{
+		HorizonHours:           3,
+		VehicleCapacity:        10,
+		DepartureIntervalHours: 3,
+		LoadingTimeHours:       1,
+		RandomSeed:             1,
+	}
+	resp, err := NewDiscreteEventEngine(config).Run(context.Background(), desTestGraph(commonv1.NodeType_NODE_TYPE_INTERSECTION))
+	require.NoError(t, err)
+	require.Equal(t, int32(3), resp.Stats.VehiclesDispatched)
+
+	// С одним постом рейсы ждут погрузки 0, 1 и 2 часа
+	origin := resp.NodeQueues[0]
+	assert.Equal(t, int64(1), origin.NodeId)
+	assert.Equal(t, int32(3), origin.VehiclesServed)
+	assert.Equal(t, int32(2), origin.MaxQueueLength)
+	assert.InDelta(t, 1, origin.AverageWaitHours, 1e-9)
+	assert.InDelta(t, 2, origin.MaxWaitHours, 1e-9)
+	assert.InDelta(t, 1, resp.Stats.AverageWaitHours, 1e-9)
+
+	// Три поста обслуживают рейсы одновременно
+	config.DocksPerNode = 3
+	resp, err = NewDiscreteEventEngine(config).Run(context.Background(), desTestGraph(commonv1.NodeType_NODE_TYPE_INTERSECTION))
+	require.NoError(t, err)
+	assert.Zero(t, resp.NodeQueues[0].MaxQueueLength)
+	assert.Zero(t, resp.Stats.AverageWaitHours)
+}
+
+func TestDiscreteEventEngine_Reproducible(t *testing.T) {
+	config := &simulationv1.DiscreteEventConfig{
+		HorizonHours: 12,
+		TravelDelay: &simulationv1.Distribution{
+			Type:   simulationv1.DistributionType_DISTRIBUTION_TYPE_NORMAL,
+			Param1: 0.5,
+			Param2: 0.5,
+		},
+		RandomSeed: 42,
+	}
+	graph := desTestGraph(commonv1.NodeType_NODE_TYPE_INTERSECTION)
+
+	first, err := NewDiscreteEventEngine(config).Run(context.Background(), graph)
+	require.NoError(t, err)
+	second, err := NewDiscreteEventEngine(config).Run(context.Background(), graph)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(42), first.RandomSeed)
+	assert.Equal(t, first.LeadTimeStats.Mean, second.LeadTimeStats.Mean)
+	assert.Equal(t, first.Stats.MakespanHours, second.Stats.MakespanHours)
+	// Задержки не бывают отрицательными
+	assert.GreaterOrEqual(t, first.LeadTimeStats.Min, 3.0)
+	assert.Greater(t, first.LeadTimeStats.StdDev, 0.0)
+}
+
+func TestDiscreteEventEngine_VehicleLimit(t *testing.T) {
+	engine := NewDiscreteEventEngine(&simulationv1.DiscreteEventConfig{
+		HorizonHours:    1e6,
+		VehicleCapacity: 1,
+		RandomSeed:      1,
+	})
+	_, err := engine.Run(context.Background(), desTestGraph(commonv1.NodeType_NODE_TYPE_INTERSECTION))
+	assert.Error(t, err)
+}
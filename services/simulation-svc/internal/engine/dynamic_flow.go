@@ -0,0 +1,390 @@
+// services/simulation-svc/internal/engine/dynamic_flow.go
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxTimeExpandedEdges ограничивает размер развёрнутой во времени сети
+const maxTimeExpandedEdges = 500000
+
+// defaultRoadSpeeds скорости по типам дорог, единиц длины в час
+var defaultRoadSpeeds = map[commonv1.RoadType]float64{
+	commonv1.RoadType_ROAD_TYPE_UNSPECIFIED: 50,
+	commonv1.RoadType_ROAD_TYPE_HIGHWAY:     90,
+	commonv1.RoadType_ROAD_TYPE_PRIMARY:     60,
+	commonv1.RoadType_ROAD_TYPE_SECONDARY:   50,
+	commonv1.RoadType_ROAD_TYPE_LOCAL:       40,
+	commonv1.RoadType_ROAD_TYPE_URBAN:       30,
+}
+
+// expandedArcKind вид дуги развёрнутой во времени сети
+type expandedArcKind int
+
+const (
+	arcMove   expandedArcKind = iota // Движение по ребру графа
+	arcHold                          // Хранение в узле до следующего шага
+	arcSupply                        // Выпуск из суперистока в узел предложения
+	arcDemand                        // Доставка из узла спроса в суперсток
+)
+
+// expandedArc описывает дугу развёрнутой сети в терминах исходного графа
+type expandedArc struct {
+	kind    expandedArcKind
+	step    int   // Шаг начала дуги
+	arrival int   // Шаг конца дуги
+	edge    int   // Индекс ребра графа для arcMove
+	node    int64 // Узел для arcHold, arcSupply и arcDemand
+	from    int64 // Узел графа, из которого выходит arcMove
+
+	unbounded bool // Выпуск или приём без ограничения
+}
+
+// timeExpandedNetwork сеть, развёрнутая во времени: копия каждого узла на
+// каждом шаге, дуги движения (v,t)→(w,t+τ), дуги хранения (v,t)→(v,t+1) и
+// суперисток/суперсток, связанные с копиями истоков и стоков графа
+type timeExpandedNetwork struct {
+	graph   *commonv1.Graph
+	arcs    []expandedArc
+	transit []int // Время в пути по рёбрам графа, шагов
+	steps   int
+	sources map[int64]bool // Узлы графа, из которых выпускается поток
+}
+
+// runDynamicFlow решает одну задачу максимального потока в развёрнутой во
+// времени сети и раскладывает поток по шагам
+func (e *TimeSimulationEngine) runDynamicFlow(
+	ctx context.Context,
+	req *simulationv1.RunTimeSimulationRequest,
+	config *simulationv1.TimeSimulationConfig,
+	steps int,
+	startTime time.Time,
+	stepDuration time.Duration,
+) (*simulationv1.RunTimeSimulationResponse, error) {
+	network, err := e.buildTimeExpandedNetwork(req, config, steps, startTime, stepDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	solveRes, err := e.solverClient.Solve(ctx, network.graph, req.Algorithm, nil)
+	if err != nil {
+		return nil, err
+	}
+	flows := edgeFlows(solveRes)
+
+	results, summary := network.decompose(req.Graph, flows)
+
+	stats := newTimeSimulationStats()
+	criticalTracker := newCriticalPeriodTracker()
+	for i, stepResult := range results {
+		currentTime := startTime.Add(time.Duration(i) * stepDuration)
+		stepResult.Timestamp = timestamppb.New(currentTime)
+		stats.update(stepResult)
+		criticalTracker.track(i, currentTime, stepResult, stats, stepDuration)
+	}
+	criticalTracker.finalize(steps, startTime, stepDuration)
+
+	return &simulationv1.RunTimeSimulationResponse{
+		Success:         true,
+		StepResults:     results,
+		Stats:           stats.finalize(steps),
+		CriticalPeriods: criticalTracker.periods,
+		DynamicFlow:     summary,
+	}, nil
+}
+
+// buildTimeExpandedNetwork строит развёрнутую сеть на steps шагов. Паттерны
+// рёбер меняют пропускную способность по шагу отправления, паттерны узлов —
+// выпуск истоков и приём стоков на шаге.
+//
+// Истоки и стоки определяются так же, как в solver-svc
+// (domain.ResolveTerminals): при нескольких узлах предложения или спроса с
+// суперистоком и суперстоком связан каждый из них, и за шаг узел выпускает
+// или принимает свои чистые предложение или спрос. Иначе это source_id и
+// sink_id, и нулевые supply/demand не ограничивают выпуск и приём.
+func (e *TimeSimulationEngine) buildTimeExpandedNetwork(
+	req *simulationv1.RunTimeSimulationRequest,
+	config *simulationv1.TimeSimulationConfig,
+	steps int,
+	startTime time.Time,
+	stepDuration time.Duration,
+) (*timeExpandedNetwork, error) {
+	g := req.Graph
+	transit := transitSteps(g, config.RoadSpeeds, stepDuration)
+
+	n := len(g.Nodes)
+	index := make(map[int64]int, n)
+	for k, node := range g.Nodes {
+		index[node.Id] = k
+	}
+
+	terminals, err := domain.ResolveTerminals(g.SourceId, g.SinkId, g.Nodes)
+	if err != nil {
+		return nil, err
+	}
+	if !terminals.MultiTerminal {
+		if _, ok := index[g.SourceId]; !ok {
+			return nil, fmt.Errorf("source node %d not found", g.SourceId)
+		}
+		if _, ok := index[g.SinkId]; !ok {
+			return nil, fmt.Errorf("sink node %d not found", g.SinkId)
+		}
+	}
+	sources := make(map[int64]bool)
+	for _, id := range terminals.SourceNodes() {
+		sources[id] = true
+	}
+	sinks := make(map[int64]bool)
+	for _, id := range terminals.SinkNodes() {
+		sinks[id] = true
+	}
+	copyID := func(node int64, step int) int64 {
+		return int64(step*n+index[node]) + 1
+	}
+	superSource, superSink := int64(steps*n)+1, int64(steps*n)+2
+
+	network := &timeExpandedNetwork{
+		graph: &commonv1.Graph{
+			SourceId: superSource,
+			SinkId:   superSink,
+			Name:     g.Name,
+		},
+		transit: transit,
+		steps:   steps,
+		sources: sources,
+	}
+	addArc := func(from, to int64, capacity, cost float64, arc expandedArc) {
+		network.graph.Edges = append(network.graph.Edges, &commonv1.Edge{
+			Id:       int64(len(network.graph.Edges)) + 1,
+			From:     from,
+			To:       to,
+			Capacity: capacity,
+			Cost:     cost,
+		})
+		network.arcs = append(network.arcs, arc)
+	}
+
+	// Верхняя оценка потока для дуг без ограничения
+	unbounded := 0.0
+	for step := 0; step < steps; step++ {
+		currentTime := startTime.Add(time.Duration(step) * stepDuration)
+		stepGraph := e.applyTimePatterns(g, step, currentTime, req.EdgePatterns, req.NodePatterns)
+
+		for i, edge := range stepGraph.Edges {
+			arrival := step + transit[i]
+			if arrival >= steps || edge.Capacity <= 0 {
+				continue
+			}
+			addArc(copyID(edge.From, step), copyID(edge.To, arrival), edge.Capacity, edge.Cost,
+				expandedArc{kind: arcMove, step: step, arrival: arrival, edge: i, from: edge.From})
+			if edge.Bidirectional {
+				addArc(copyID(edge.To, step), copyID(edge.From, arrival), edge.Capacity, edge.Cost,
+					expandedArc{kind: arcMove, step: step, arrival: arrival, edge: i, from: edge.To})
+			}
+			unbounded += edge.Capacity
+		}
+
+		for k, node := range stepGraph.Nodes {
+			if step+1 < steps && !sinks[node.Id] && node.StorageCapacity > 0 {
+				addArc(copyID(node.Id, step), copyID(node.Id, step+1), node.StorageCapacity, 0,
+					expandedArc{kind: arcHold, step: step, arrival: step + 1, node: node.Id})
+			}
+
+			supply, demand := node.Supply, node.Demand
+			if terminals.MultiTerminal {
+				supply, demand = math.Max(node.Supply-node.Demand, 0), math.Max(node.Demand-node.Supply, 0)
+			}
+			switch {
+			case sources[node.Id]:
+				addArc(superSource, copyID(node.Id, step), supply, 0, expandedArc{
+					kind: arcSupply, step: step, arrival: step, node: node.Id,
+					unbounded: !terminals.MultiTerminal && g.Nodes[k].Supply <= 0,
+				})
+			case sinks[node.Id]:
+				addArc(copyID(node.Id, step), superSink, demand, 0, expandedArc{
+					kind: arcDemand, step: step, arrival: step, node: node.Id,
+					unbounded: !terminals.MultiTerminal && g.Nodes[k].Demand <= 0,
+				})
+			}
+		}
+
+		if len(network.graph.Edges) > maxTimeExpandedEdges {
+			return nil, fmt.Errorf("time-expanded network exceeds %d edges; reduce num_steps", maxTimeExpandedEdges)
+		}
+	}
+
+	for i, arc := range network.arcs {
+		if arc.unbounded {
+			network.graph.Edges[i].Capacity = unbounded
+		}
+	}
+
+	for step := 0; step < steps; step++ {
+		for _, node := range g.Nodes {
+			network.graph.Nodes = append(network.graph.Nodes, &commonv1.Node{
+				Id: copyID(node.Id, step), X: node.X, Y: node.Y,
+			})
+		}
+	}
+	network.graph.Nodes = append(network.graph.Nodes,
+		&commonv1.Node{Id: superSource, Type: commonv1.NodeType_NODE_TYPE_SOURCE},
+		&commonv1.Node{Id: superSink, Type: commonv1.NodeType_NODE_TYPE_SINK},
+	)
+	return network, nil
+}
+
+// transitSteps возвращает время в пути по каждому ребру в шагах:
+// length / (скорость типа дороги · длительность шага), округлённое до
+// ближайшего целого. Ребро с нулевой длиной проходится в пределах шага.
+func transitSteps(g *commonv1.Graph, speeds []*simulationv1.RoadTypeSpeed, stepDuration time.Duration) []int {
+	hours := travelHours(g, speeds)
+	transit := make([]int, len(g.Edges))
+	for i := range g.Edges {
+		transit[i] = int(math.Round(hours[i] / stepDuration.Hours()))
+	}
+	return transit
+}
+
+// travelHours возвращает время в пути по каждому ребру в часах: length /
+// скорость типа дороги (заданная или по умолчанию)
+func travelHours(g *commonv1.Graph, speeds []*simulationv1.RoadTypeSpeed) []float64 {
+	speed := make(map[commonv1.RoadType]float64, len(defaultRoadSpeeds))
+	for roadType, v := range defaultRoadSpeeds {
+		speed[roadType] = v
+	}
+	for _, s := range speeds {
+		if s.Speed > 0 {
+			speed[s.RoadType] = s.Speed
+		}
+	}
+
+	hours := make([]float64, len(g.Edges))
+	for i, edge := range g.Edges {
+		v, ok := speed[edge.RoadType]
+		if !ok {
+			v = defaultRoadSpeeds[commonv1.RoadType_ROAD_TYPE_UNSPECIFIED]
+		}
+		if edge.Length > 0 {
+			hours[i] = edge.Length / v
+		}
+	}
+	return hours
+}
+
+// decompose раскладывает поток развёрнутой сети по шагам: прибытие в сток,
+// выпуск из истока, груз в пути и запасы узлов в конце каждого шага
+func (n *timeExpandedNetwork) decompose(
+	g *commonv1.Graph,
+	flows map[int64]float64,
+) ([]*simulationv1.TimeStepResult, *simulationv1.DynamicFlowSummary) {
+	results := make([]*simulationv1.TimeStepResult, n.steps)
+	for step := range results {
+		results[step] = &simulationv1.TimeStepResult{Step: int32(step)}
+	}
+
+	storage := make(map[int64]float64, len(g.Nodes))
+	for _, node := range g.Nodes {
+		storage[node.Id] = node.StorageCapacity
+	}
+
+	// Загрузка рёбер по шагам отправления
+	type load struct {
+		utilization float64
+		arcs        int
+		saturated   map[int]bool
+	}
+	loads := make([]load, n.steps)
+	transitDelta := make([]float64, n.steps+1)
+
+	summary := &simulationv1.DynamicFlowSummary{
+		HorizonSteps:  int32(n.steps),
+		ExpandedNodes: int32(len(n.graph.Nodes)),
+		ExpandedEdges: int32(len(n.graph.Edges)),
+	}
+	var arrivalTime, departureTime float64
+
+	for i, arc := range n.arcs {
+		edge := n.graph.Edges[i]
+		flow := flows[edge.Id]
+		result := results[arc.step]
+
+		switch arc.kind {
+		case arcMove:
+			result.TotalCost += flow * edge.Cost
+			l := &loads[arc.step]
+			l.utilization += flow / edge.Capacity
+			l.arcs++
+			if flow/edge.Capacity >= 0.95 {
+				if l.saturated == nil {
+					l.saturated = make(map[int]bool)
+				}
+				l.saturated[arc.edge] = true
+			}
+			if n.sources[arc.from] {
+				result.Departures += flow
+				departureTime += float64(arc.step) * flow
+			}
+			if arc.arrival > arc.step {
+				transitDelta[arc.step] += flow
+				transitDelta[arc.arrival] -= flow
+			}
+		case arcHold:
+			if flow > flowTolerance {
+				result.Inventory = append(result.Inventory, &simulationv1.NodeInventory{
+					NodeId:          arc.node,
+					Level:           flow,
+					StorageCapacity: storage[arc.node],
+				})
+			}
+		case arcDemand:
+			result.Arrivals += flow
+			result.MaxFlow += flow
+			arrivalTime += float64(arc.step) * flow
+			summary.TotalFlow += flow
+		}
+	}
+
+	inTransit := 0.0
+	for step, result := range results {
+		inTransit += transitDelta[step]
+		result.InTransit = math.Max(0, inTransit)
+		summary.PeakInTransit = math.Max(summary.PeakInTransit, result.InTransit)
+
+		inventory := 0.0
+		for _, level := range result.Inventory {
+			inventory += level.Level
+		}
+		summary.PeakInventory = math.Max(summary.PeakInventory, inventory)
+
+		l := loads[step]
+		if l.arcs > 0 {
+			result.AverageUtilization = l.utilization / float64(l.arcs)
+		}
+		result.SaturatedEdges = int32(len(l.saturated))
+		for i, edge := range g.Edges {
+			if l.saturated[i] {
+				result.Bottlenecks = append(result.Bottlenecks, KeyOf(edge, i))
+			}
+		}
+	}
+
+	if summary.TotalFlow > flowTolerance {
+		summary.AverageTransitSteps = (arrivalTime - departureTime) / summary.TotalFlow
+	}
+	for i, edge := range g.Edges {
+		summary.TransitTimes = append(summary.TransitTimes, &simulationv1.EdgeTransitTime{
+			Edge:  KeyOf(edge, i),
+			Steps: int32(n.transit[i]),
+		})
+	}
+	return results, summary
+}
@@ -0,0 +1,169 @@
+// services/simulation-svc/internal/engine/dynamic_flow_test.go
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+func runDynamic(t *testing.T, req *simulationv1.RunTimeSimulationRequest) *simulationv1.RunTimeSimulationResponse {
+	t.Helper()
+	req.TimeConfig.Mode = simulationv1.TimeSimulationMode_TIME_SIMULATION_MODE_DYNAMIC
+	req.TimeConfig.TimeStep = simulationv1.TimeStep_TIME_STEP_HOUR
+	resp, err := NewTimeSimulationEngine(maxFlowSolver{}).RunTimeSimulation(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, resp.DynamicFlow)
+	return resp
+}
+
+func TestTimeSimulationEngine_DynamicFlow_TransitTimes(t *testing.T) {
+	// Шоссе 90 км и главная дорога 60 км — по часу в пути на каждом ребре
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, Length: 90, RoadType: commonv1.RoadType_ROAD_TYPE_HIGHWAY},
+			{From: 2, To: 3, Capacity: 10, Length: 60, RoadType: commonv1.RoadType_ROAD_TYPE_PRIMARY},
+		},
+	}
+
+	resp := runDynamic(t, &simulationv1.RunTimeSimulationRequest{
+		Graph:      graph,
+		TimeConfig: &simulationv1.TimeSimulationConfig{NumSteps: 5},
+	})
+
+	summary := resp.DynamicFlow
+	assert.Equal(t, int32(5), summary.HorizonSteps)
+	assert.Equal(t, []int32{1, 1}, []int32{summary.TransitTimes[0].Steps, summary.TransitTimes[1].Steps})
+
+	// Груз, вышедший на шагах 0–2, прибывает на шагах 2–4
+	require.Len(t, resp.StepResults, 5)
+	arrivals := make([]float64, 5)
+	inTransit := make([]float64, 5)
+	for i, step := range resp.StepResults {
+		arrivals[i] = step.Arrivals
+		inTransit[i] = step.InTransit
+		assert.Equal(t, step.Arrivals, step.MaxFlow)
+	}
+	assert.Equal(t, []float64{0, 0, 10, 10, 10}, arrivals)
+	assert.Equal(t, []float64{10, 20, 20, 10, 0}, inTransit)
+	assert.Equal(t, 30.0, summary.TotalFlow)
+	assert.InDelta(t, 2, summary.AverageTransitSteps, 1e-9)
+	assert.Equal(t, 20.0, summary.PeakInTransit)
+}
+
+func TestTimeSimulationEngine_DynamicFlow_Storage(t *testing.T) {
+	// Выход 2→3 закрыт на шагах 0–1 и втрое расширен на шаге 2
+	graph := func(storage float64) *commonv1.Graph {
+		return &commonv1.Graph{
+			SourceId: 1,
+			SinkId:   3,
+			Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2, StorageCapacity: storage}, {Id: 3}},
+			Edges: []*commonv1.Edge{
+				{From: 1, To: 2, Capacity: 10},
+				{From: 2, To: 3, Capacity: 10},
+			},
+		}
+	}
+	patterns := []*simulationv1.EdgeTimePattern{{
+		Edge: &commonv1.EdgeKey{From: 2, To: 3},
+		Pattern: &simulationv1.TimePattern{
+			Type:         simulationv1.PatternType_PATTERN_TYPE_CUSTOM,
+			CustomPoints: []*simulationv1.TimePoint{{Step: 0, Multiplier: 0}, {Step: 1, Multiplier: 0}, {Step: 2, Multiplier: 3}},
+		},
+	}}
+	run := func(storage float64) *simulationv1.RunTimeSimulationResponse {
+		return runDynamic(t, &simulationv1.RunTimeSimulationRequest{
+			Graph:        graph(storage),
+			TimeConfig:   &simulationv1.TimeSimulationConfig{NumSteps: 3},
+			EdgePatterns: patterns,
+		})
+	}
+
+	withoutStorage := run(0)
+	assert.Equal(t, 10.0, withoutStorage.DynamicFlow.TotalFlow)
+	assert.Zero(t, withoutStorage.DynamicFlow.PeakInventory)
+
+	withStorage := run(20)
+	assert.Equal(t, 30.0, withStorage.DynamicFlow.TotalFlow)
+	assert.Equal(t, 30.0, withStorage.StepResults[2].Arrivals)
+	assert.Equal(t, 20.0, withStorage.DynamicFlow.PeakInventory)
+
+	require.Len(t, withStorage.StepResults[0].Inventory, 1)
+	assert.Equal(t, &simulationv1.NodeInventory{NodeId: 2, Level: 10, StorageCapacity: 20}, withStorage.StepResults[0].Inventory[0])
+	require.Len(t, withStorage.StepResults[1].Inventory, 1)
+	assert.Equal(t, 20.0, withStorage.StepResults[1].Inventory[0].Level)
+	assert.Empty(t, withStorage.StepResults[2].Inventory)
+}
+
+func TestTimeSimulationEngine_DynamicFlow_SupplyLimit(t *testing.T) {
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1, Supply: 4}, {Id: 2}},
+		Edges:    []*commonv1.Edge{{From: 1, To: 2, Capacity: 10}},
+	}
+
+	resp := runDynamic(t, &simulationv1.RunTimeSimulationRequest{
+		Graph:      graph,
+		TimeConfig: &simulationv1.TimeSimulationConfig{NumSteps: 3},
+	})
+
+	for _, step := range resp.StepResults {
+		assert.Equal(t, 4.0, step.Departures)
+		assert.Equal(t, 4.0, step.Arrivals)
+	}
+	assert.Equal(t, 12.0, resp.DynamicFlow.TotalFlow)
+}
+
+func TestTimeSimulationEngine_DynamicFlow_MultiTerminal(t *testing.T) {
+	// Два склада (4 и 3 в час) через узел 3 снабжают две точки со спросом
+	// 5 и 1 в час; source_id и sink_id не заданы, терминалы — как в solver-svc
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1, Supply: 4},
+			{Id: 2, Supply: 3},
+			{Id: 3},
+			{Id: 4, Demand: 5},
+			{Id: 5, Demand: 1},
+		},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 3, Capacity: 10},
+			{From: 2, To: 3, Capacity: 10},
+			{From: 3, To: 4, Capacity: 10},
+			{From: 3, To: 5, Capacity: 10},
+		},
+	}
+
+	resp := runDynamic(t, &simulationv1.RunTimeSimulationRequest{
+		Graph:      graph,
+		TimeConfig: &simulationv1.TimeSimulationConfig{NumSteps: 3},
+	})
+
+	// Ограничивает суммарный спрос: 6 в час из 7 возможных
+	for _, step := range resp.StepResults {
+		assert.Equal(t, 6.0, step.Departures)
+		assert.Equal(t, 6.0, step.Arrivals)
+	}
+	assert.Equal(t, 18.0, resp.DynamicFlow.TotalFlow)
+}
+
+func TestTransitSteps(t *testing.T) {
+	graph := &commonv1.Graph{Edges: []*commonv1.Edge{
+		{Length: 100, RoadType: commonv1.RoadType_ROAD_TYPE_URBAN},
+		{Length: 100, RoadType: commonv1.RoadType_ROAD_TYPE_HIGHWAY},
+		{Length: 0},
+	}}
+	speeds := []*simulationv1.RoadTypeSpeed{{RoadType: commonv1.RoadType_ROAD_TYPE_URBAN, Speed: 20}}
+
+	assert.Equal(t, []int{5, 1, 0}, transitSteps(graph, speeds, time.Hour))
+	assert.Equal(t, []int{0, 0, 0}, transitSteps(graph, speeds, 24*time.Hour))
+}
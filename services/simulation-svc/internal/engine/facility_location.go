@@ -0,0 +1,370 @@
+// services/simulation-svc/internal/engine/facility_location.go
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	analyticsv1 "logistics/gen/go/logistics/analytics/v1"
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+)
+
+const defaultFacilityIterations = 100
+
+// FacilityLocationEngine выбирает склады для открытия локальным поиском по
+// решениям задачи потока минимальной стоимости
+type FacilityLocationEngine struct {
+	solverClient SolverClientInterface
+}
+
+// NewFacilityLocationEngine создаёт движок размещения складов
+func NewFacilityLocationEngine(solverClient SolverClientInterface) *FacilityLocationEngine {
+	return &FacilityLocationEngine{solverClient: solverClient}
+}
+
+// facilityCandidate склад-кандидат
+type facilityCandidate struct {
+	index       int // Индекс узла в графе
+	openingCost float64
+	capacity    float64 // 0 — без ограничения
+}
+
+// facilityEvaluation стоимость одного набора открытых складов
+type facilityEvaluation struct {
+	open      []bool
+	result    *client.SolveResult // nil, если ни один источник не открыт
+	fixed     float64
+	transport float64
+	served    float64
+	unmetCost float64
+	total     float64
+}
+
+// facilitySearch состояние локального поиска
+type facilitySearch struct {
+	solverClient SolverClientInterface
+	graph        *commonv1.Graph
+	candidates   []facilityCandidate
+	totalDemand  float64
+	penalty      float64
+	cache        map[string]*facilityEvaluation
+}
+
+// Optimize ищет набор открытых складов с минимальной суммой фиксированных и
+// транспортных затрат
+func (e *FacilityLocationEngine) Optimize(
+	ctx context.Context,
+	req *simulationv1.OptimizeFacilityLocationRequest,
+) (*simulationv1.OptimizeFacilityLocationResponse, error) {
+	graph := CloneGraph(req.Graph)
+	// Полюса задаются балансами узлов: solver соединяет склады и точки
+	// доставки с суперистоком и суперстоком
+	graph.SourceId, graph.SinkId = 0, 0
+
+	s := &facilitySearch{
+		solverClient: e.solverClient,
+		graph:        graph,
+		penalty:      req.UnmetDemandPenalty,
+		cache:        make(map[string]*facilityEvaluation),
+	}
+	for i, node := range graph.Nodes {
+		switch node.Type {
+		case commonv1.NodeType_NODE_TYPE_WAREHOUSE:
+			s.candidates = append(s.candidates, facilityCandidate{
+				index:       i,
+				openingCost: warehouseOpeningCost(req.FixedCosts, node.Id),
+				capacity:    math.Max(node.Supply, 0),
+			})
+		case commonv1.NodeType_NODE_TYPE_DELIVERY_POINT:
+			s.totalDemand += math.Max(node.Demand, 0)
+		}
+	}
+	if len(s.candidates) == 0 {
+		return nil, fmt.Errorf("graph has no warehouse nodes")
+	}
+
+	initial := make([]bool, len(s.candidates))
+	initialSet := make(map[int64]bool, len(req.InitialOpen))
+	for _, id := range req.InitialOpen {
+		initialSet[id] = true
+	}
+	for i, c := range s.candidates {
+		initial[i] = len(initialSet) == 0 || initialSet[graph.Nodes[c.index].Id]
+	}
+
+	current, err := s.evaluate(ctx, initial)
+	if err != nil {
+		return nil, err
+	}
+	resp := &simulationv1.OptimizeFacilityLocationResponse{
+		Success:          true,
+		InitialTotalCost: current.total,
+	}
+
+	iterations := int(req.MaxIterations)
+	if iterations <= 0 {
+		iterations = defaultFacilityIterations
+	}
+	for iteration := 1; iteration <= iterations; iteration++ {
+		var (
+			best     *facilityEvaluation
+			bestMove *simulationv1.FacilityMove
+		)
+		for _, move := range s.moves(current.open) {
+			next, err := s.evaluate(ctx, s.apply(current.open, move))
+			if err != nil {
+				return nil, err
+			}
+			if best == nil && s.better(next, current) || best != nil && s.better(next, best) {
+				best, bestMove = next, move
+			}
+		}
+		if best == nil {
+			break
+		}
+
+		bestMove.Iteration = int32(iteration)
+		bestMove.TotalCost = best.total
+		bestMove.Saving = current.total - best.total
+		resp.Moves = append(resp.Moves, bestMove)
+		current = best
+	}
+
+	s.fillResponse(resp, current, initial)
+	s.addRecommendations(resp)
+	return resp, nil
+}
+
+// warehouseOpeningCost возвращает стоимость открытия склада: значение из
+// warehouse_costs или warehouse_cost
+func warehouseOpeningCost(cfg *analyticsv1.FixedCostConfig, nodeID int64) float64 {
+	if cfg == nil {
+		return 0
+	}
+	if cost, ok := cfg.WarehouseCosts[nodeID]; ok {
+		return cost
+	}
+	return cfg.WarehouseCost
+}
+
+// evaluate решает задачу потока минимальной стоимости для набора открытых
+// складов; результаты кешируются по набору
+func (s *facilitySearch) evaluate(ctx context.Context, open []bool) (*facilityEvaluation, error) {
+	key := facilityKey(open)
+	if ev, ok := s.cache[key]; ok {
+		return ev, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	graph := CloneGraph(s.graph)
+	ev := &facilityEvaluation{open: open}
+	for i, c := range s.candidates {
+		node := graph.Nodes[c.index]
+		node.Supply = 0
+		if !open[i] {
+			continue
+		}
+		node.Supply = c.capacity
+		if node.Supply == 0 {
+			node.Supply = s.totalDemand
+		}
+		ev.fixed += c.openingCost
+	}
+
+	hasSupply := false
+	for _, node := range graph.Nodes {
+		if node.Supply > flowTolerance {
+			hasSupply = true
+			break
+		}
+	}
+	if hasSupply && s.totalDemand > flowTolerance {
+		result, err := s.solverClient.Solve(ctx, graph, commonv1.Algorithm_ALGORITHM_MIN_COST, nil)
+		if err != nil {
+			return nil, err
+		}
+		ev.result = result
+		ev.transport = result.TotalCost
+		ev.served = math.Min(result.MaxFlow, s.totalDemand)
+	}
+
+	ev.unmetCost = s.penalty * math.Max(s.totalDemand-ev.served, 0)
+	ev.total = ev.fixed + ev.transport + ev.unmetCost
+	s.cache[key] = ev
+	return ev, nil
+}
+
+// better сравнивает наборы: со штрафом — по суммарной стоимости, без
+// штрафа — сначала по обслуженному спросу, затем по стоимости
+func (s *facilitySearch) better(a, b *facilityEvaluation) bool {
+	if s.penalty <= 0 {
+		if a.served > b.served+flowTolerance {
+			return true
+		}
+		if a.served < b.served-flowTolerance {
+			return false
+		}
+	}
+	return a.total < b.total-flowTolerance
+}
+
+// moves перечисляет соседние наборы: открыть закрытый склад, закрыть
+// открытый и заменить открытый закрытым
+func (s *facilitySearch) moves(open []bool) []*simulationv1.FacilityMove {
+	var moves []*simulationv1.FacilityMove
+	for i, c := range s.candidates {
+		id := s.graph.Nodes[c.index].Id
+		if open[i] {
+			moves = append(moves, &simulationv1.FacilityMove{
+				Type:       simulationv1.FacilityMoveType_FACILITY_MOVE_TYPE_CLOSE,
+				ClosedNode: id,
+			})
+		} else {
+			moves = append(moves, &simulationv1.FacilityMove{
+				Type:       simulationv1.FacilityMoveType_FACILITY_MOVE_TYPE_OPEN,
+				OpenedNode: id,
+			})
+		}
+	}
+	for i, closed := range s.candidates {
+		if !open[i] {
+			continue
+		}
+		for j, opened := range s.candidates {
+			if open[j] {
+				continue
+			}
+			moves = append(moves, &simulationv1.FacilityMove{
+				Type:       simulationv1.FacilityMoveType_FACILITY_MOVE_TYPE_SWAP,
+				OpenedNode: s.graph.Nodes[opened.index].Id,
+				ClosedNode: s.graph.Nodes[closed.index].Id,
+			})
+		}
+	}
+	return moves
+}
+
+// apply возвращает набор после хода
+func (s *facilitySearch) apply(open []bool, move *simulationv1.FacilityMove) []bool {
+	next := make([]bool, len(open))
+	copy(next, open)
+	for i, c := range s.candidates {
+		switch s.graph.Nodes[c.index].Id {
+		case move.OpenedNode:
+			next[i] = true
+		case move.ClosedNode:
+			next[i] = false
+		}
+	}
+	return next
+}
+
+// fillResponse заполняет итоговые стоимости и решения по складам
+func (s *facilitySearch) fillResponse(
+	resp *simulationv1.OptimizeFacilityLocationResponse,
+	ev *facilityEvaluation,
+	initial []bool,
+) {
+	resp.TotalCost = ev.total
+	resp.FixedCost = ev.fixed
+	resp.TransportCost = ev.transport
+	resp.UnmetDemandCost = ev.unmetCost
+	resp.TotalDemand = s.totalDemand
+	resp.ServedDemand = ev.served
+
+	shipped := make(map[int64]float64)
+	if ev.result != nil && ev.result.Graph != nil {
+		resp.SolvedGraph = ev.result.Graph
+		for _, edge := range ev.result.Graph.Edges {
+			shipped[edge.From] += edge.CurrentFlow
+			shipped[edge.To] -= edge.CurrentFlow
+		}
+	}
+
+	for i, c := range s.candidates {
+		node := s.graph.Nodes[c.index]
+		decision := &simulationv1.FacilityDecision{
+			NodeId:        node.Id,
+			Name:          node.Name,
+			Open:          ev.open[i],
+			InitiallyOpen: initial[i],
+			OpeningCost:   c.openingCost,
+			Capacity:      c.capacity,
+		}
+		if ev.open[i] {
+			decision.Shipped = math.Max(shipped[node.Id], 0)
+			if c.capacity > 0 {
+				decision.Utilization = decision.Shipped / c.capacity
+			}
+			resp.OpenWarehouses = append(resp.OpenWarehouses, node.Id)
+		} else {
+			resp.ClosedWarehouses = append(resp.ClosedWarehouses, node.Id)
+		}
+		resp.Facilities = append(resp.Facilities, decision)
+	}
+}
+
+// addRecommendations превращает выполненные ходы в рекомендации: замена
+// склада — перенос, открытие и закрытие — отдельные рекомендации
+func (s *facilitySearch) addRecommendations(resp *simulationv1.OptimizeFacilityLocationResponse) {
+	nodes := make(map[int64]*commonv1.Node, len(s.candidates))
+	costs := make(map[int64]float64, len(s.candidates))
+	for _, c := range s.candidates {
+		node := s.graph.Nodes[c.index]
+		nodes[node.Id] = node
+		costs[node.Id] = c.openingCost
+	}
+
+	for _, move := range resp.Moves {
+		rec := &simulationv1.ResilienceRecommendation{
+			Type: simulationv1.RecommendationType_RECOMMENDATION_TYPE_RELOCATE_WAREHOUSE,
+		}
+		switch move.Type {
+		case simulationv1.FacilityMoveType_FACILITY_MOVE_TYPE_OPEN:
+			rec.AffectedNode = move.OpenedNode
+			rec.Description = fmt.Sprintf("Открыть склад %s", facilityName(nodes[move.OpenedNode]))
+		case simulationv1.FacilityMoveType_FACILITY_MOVE_TYPE_CLOSE:
+			rec.AffectedNode = move.ClosedNode
+			rec.Description = fmt.Sprintf("Закрыть склад %s", facilityName(nodes[move.ClosedNode]))
+		default:
+			rec.AffectedNode = move.OpenedNode
+			rec.Description = fmt.Sprintf("Перенести склад %s в узел %s",
+				facilityName(nodes[move.ClosedNode]), facilityName(nodes[move.OpenedNode]))
+		}
+		rec.Description += fmt.Sprintf(": стоимость %.2f → %.2f", move.TotalCost+move.Saving, move.TotalCost)
+		if move.OpenedNode != 0 {
+			rec.EstimatedCost = costs[move.OpenedNode]
+		}
+		if before := move.TotalCost + move.Saving; before > flowTolerance {
+			rec.EstimatedImprovement = math.Max(move.Saving, 0) / before
+		}
+		resp.Recommendations = append(resp.Recommendations, rec)
+	}
+}
+
+// facilityName возвращает имя узла или его ID
+func facilityName(node *commonv1.Node) string {
+	if node.Name != "" {
+		return node.Name
+	}
+	return fmt.Sprintf("%d", node.Id)
+}
+
+// facilityKey ключ набора открытых складов для кеша
+func facilityKey(open []bool) string {
+	var b strings.Builder
+	for _, o := range open {
+		if o {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,249 @@
+// services/simulation-svc/internal/engine/facility_location_test.go
+package engine
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	analyticsv1 "logistics/gen/go/logistics/analytics/v1"
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+)
+
+// minCostSolver ищет поток минимальной стоимости последовательными
+// кратчайшими путями от суперистока к суперстоку, которые соединены с
+// узлами по их supply и demand
+type minCostSolver struct{}
+
+func (minCostSolver) Solve(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, opts interface{}) (*client.SolveResult, error) {
+	const superSource, superSink = -1, -2
+	type arc struct {
+		to       int64
+		residual float64
+		cost     float64
+		edge     int // Индекс ребра графа; -1 для служебных и обратных дуг
+		reverse  int
+	}
+	arcs := make(map[int64][]*arc)
+	addArc := func(from, to int64, capacity, cost float64, edge int) {
+		arcs[from] = append(arcs[from], &arc{to: to, residual: capacity, cost: cost, edge: edge, reverse: len(arcs[to])})
+		arcs[to] = append(arcs[to], &arc{to: from, cost: -cost, edge: -1, reverse: len(arcs[from]) - 1})
+	}
+	for i, e := range graph.Edges {
+		addArc(e.From, e.To, e.Capacity, e.Cost, i)
+	}
+	for _, node := range graph.Nodes {
+		if node.Supply > 0 {
+			addArc(superSource, node.Id, node.Supply, 0, -1)
+		}
+		if node.Demand > 0 {
+			addArc(node.Id, superSink, node.Demand, 0, -1)
+		}
+	}
+
+	type step struct {
+		from int64
+		arc  *arc
+	}
+	flow, cost := 0.0, 0.0
+	for {
+		dist := map[int64]float64{superSource: 0}
+		parent := make(map[int64]step)
+		for changed := true; changed; {
+			changed = false
+			for from, list := range arcs {
+				d, ok := dist[from]
+				if !ok {
+					continue
+				}
+				for _, a := range list {
+					if a.residual <= 1e-12 {
+						continue
+					}
+					if old, seen := dist[a.to]; !seen || d+a.cost < old-1e-12 {
+						dist[a.to] = d + a.cost
+						parent[a.to] = step{from: from, arc: a}
+						changed = true
+					}
+				}
+			}
+		}
+		if _, ok := dist[superSink]; !ok {
+			break
+		}
+
+		bottleneck := math.Inf(1)
+		for node := int64(superSink); node != superSource; node = parent[node].from {
+			bottleneck = math.Min(bottleneck, parent[node].arc.residual)
+		}
+		for node := int64(superSink); node != superSource; node = parent[node].from {
+			a := parent[node].arc
+			a.residual -= bottleneck
+			arcs[a.to][a.reverse].residual += bottleneck
+		}
+		flow += bottleneck
+		cost += bottleneck * dist[superSink]
+	}
+
+	result := CloneGraph(graph)
+	for _, list := range arcs {
+		for _, a := range list {
+			if a.edge >= 0 {
+				result.Edges[a.edge].CurrentFlow = graph.Edges[a.edge].Capacity - a.residual
+			}
+		}
+	}
+	return &client.SolveResult{MaxFlow: flow, TotalCost: cost, Graph: result}, nil
+}
+
+// facilityTestGraph два склада, каждый дёшево обслуживает «свою» точку
+// доставки и дорого — чужую
+func facilityTestGraph() *commonv1.Graph {
+	return &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes: []*commonv1.Node{
+			{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE, Name: "Север"},
+			{Id: 2, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE, Name: "Юг"},
+			{Id: 3, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT, Demand: 10},
+			{Id: 4, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT, Demand: 10},
+		},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 3, Capacity: 100, Cost: 1},
+			{From: 1, To: 4, Capacity: 100, Cost: 10},
+			{From: 2, To: 3, Capacity: 100, Cost: 10},
+			{From: 2, To: 4, Capacity: 100, Cost: 1},
+		},
+	}
+}
+
+func optimizeFacilities(t *testing.T, req *simulationv1.OptimizeFacilityLocationRequest) *simulationv1.OptimizeFacilityLocationResponse {
+	t.Helper()
+	resp, err := NewFacilityLocationEngine(minCostSolver{}).Optimize(context.Background(), req)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestFacilityLocationEngine_KeepsAllOpen(t *testing.T) {
+	resp := optimizeFacilities(t, &simulationv1.OptimizeFacilityLocationRequest{
+		Graph:      facilityTestGraph(),
+		FixedCosts: &analyticsv1.FixedCostConfig{WarehouseCost: 50},
+	})
+
+	// Оба склада: 100 + 20; один склад: 50 + 110
+	assert.Empty(t, resp.Moves)
+	assert.Equal(t, []int64{1, 2}, resp.OpenWarehouses)
+	assert.Equal(t, 120.0, resp.TotalCost)
+	assert.Equal(t, 100.0, resp.FixedCost)
+	assert.Equal(t, 20.0, resp.TransportCost)
+	assert.Equal(t, 20.0, resp.ServedDemand)
+	assert.Equal(t, 120.0, resp.InitialTotalCost)
+	assert.Empty(t, resp.Recommendations)
+
+	require.Len(t, resp.Facilities, 2)
+	assert.Equal(t, 10.0, resp.Facilities[0].Shipped)
+	assert.Zero(t, resp.Facilities[0].Utilization) // Без ограничения мощности
+}
+
+func TestFacilityLocationEngine_ClosesExpensiveWarehouse(t *testing.T) {
+	graph := facilityTestGraph()
+	resp := optimizeFacilities(t, &simulationv1.OptimizeFacilityLocationRequest{
+		Graph: graph,
+		FixedCosts: &analyticsv1.FixedCostConfig{
+			WarehouseCost:  50,
+			WarehouseCosts: map[int64]float64{2: 200},
+		},
+	})
+
+	require.Len(t, resp.Moves, 1)
+	assert.Equal(t, simulationv1.FacilityMoveType_FACILITY_MOVE_TYPE_CLOSE, resp.Moves[0].Type)
+	assert.Equal(t, int64(2), resp.Moves[0].ClosedNode)
+	assert.Equal(t, 110.0, resp.Moves[0].Saving)
+
+	assert.Equal(t, []int64{1}, resp.OpenWarehouses)
+	assert.Equal(t, []int64{2}, resp.ClosedWarehouses)
+	assert.Equal(t, 270.0, resp.InitialTotalCost)
+	assert.Equal(t, 160.0, resp.TotalCost)
+	assert.Equal(t, 20.0, resp.Facilities[0].Shipped)
+	assert.True(t, resp.Facilities[1].InitiallyOpen)
+	assert.False(t, resp.Facilities[1].Open)
+
+	require.Len(t, resp.Recommendations, 1)
+	rec := resp.Recommendations[0]
+	assert.Equal(t, simulationv1.RecommendationType_RECOMMENDATION_TYPE_RELOCATE_WAREHOUSE, rec.Type)
+	assert.Equal(t, int64(2), rec.AffectedNode)
+	assert.InDelta(t, 110.0/270, rec.EstimatedImprovement, 1e-12)
+
+	// Исходный граф не меняется
+	assert.Equal(t, int64(1), graph.SourceId)
+	assert.Zero(t, graph.Nodes[0].Supply)
+}
+
+func TestFacilityLocationEngine_Swap(t *testing.T) {
+	resp := optimizeFacilities(t, &simulationv1.OptimizeFacilityLocationRequest{
+		Graph: facilityTestGraph(),
+		FixedCosts: &analyticsv1.FixedCostConfig{
+			WarehouseCost:  50,
+			WarehouseCosts: map[int64]float64{2: 200},
+		},
+		InitialOpen: []int64{2},
+	})
+
+	// Без штрафа закрыть единственный склад нельзя: спрос не обслуживается
+	require.Len(t, resp.Moves, 1)
+	move := resp.Moves[0]
+	assert.Equal(t, simulationv1.FacilityMoveType_FACILITY_MOVE_TYPE_SWAP, move.Type)
+	assert.Equal(t, int64(1), move.OpenedNode)
+	assert.Equal(t, int64(2), move.ClosedNode)
+	assert.Equal(t, 310.0, resp.InitialTotalCost)
+	assert.Equal(t, 160.0, resp.TotalCost)
+
+	require.Len(t, resp.Recommendations, 1)
+	assert.Equal(t, int64(1), resp.Recommendations[0].AffectedNode)
+	assert.Equal(t, 50.0, resp.Recommendations[0].EstimatedCost)
+}
+
+func TestFacilityLocationEngine_Capacity(t *testing.T) {
+	graph := facilityTestGraph()
+	graph.Nodes[0].Supply = 15 // Северный склад не покрывает весь спрос
+
+	resp := optimizeFacilities(t, &simulationv1.OptimizeFacilityLocationRequest{
+		Graph: graph,
+		FixedCosts: &analyticsv1.FixedCostConfig{
+			WarehouseCost:  50,
+			WarehouseCosts: map[int64]float64{2: 200},
+		},
+	})
+
+	assert.Empty(t, resp.Moves)
+	assert.Equal(t, []int64{1, 2}, resp.OpenWarehouses)
+	assert.Equal(t, 20.0, resp.ServedDemand)
+	assert.Equal(t, 10.0, resp.Facilities[0].Shipped)
+	assert.InDelta(t, 10.0/15, resp.Facilities[0].Utilization, 1e-12)
+}
+
+func TestFacilityLocationEngine_UnmetDemandPenalty(t *testing.T) {
+	graph := facilityTestGraph()
+	graph.Nodes[0].Supply = 15
+
+	// Штраф ниже затрат на второй склад: выгоднее не обслужить 5 единиц
+	resp := optimizeFacilities(t, &simulationv1.OptimizeFacilityLocationRequest{
+		Graph: graph,
+		FixedCosts: &analyticsv1.FixedCostConfig{
+			WarehouseCost:  50,
+			WarehouseCosts: map[int64]float64{2: 200},
+		},
+		UnmetDemandPenalty: 20,
+	})
+
+	assert.Equal(t, []int64{1}, resp.OpenWarehouses)
+	assert.Equal(t, 15.0, resp.ServedDemand)
+	assert.Equal(t, 100.0, resp.UnmetDemandCost)
+	// 50 + 10·1 + 5·10 + 5·20
+	assert.Equal(t, 210.0, resp.TotalCost)
+}
@@ -0,0 +1,378 @@
+// services/simulation-svc/internal/engine/failure_model.go
+package engine
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+const (
+	defaultFailureScenarios              = 10
+	defaultEdgeFailureProbability        = 0.1
+	defaultMaxSimultaneousFailures       = 3
+	defaultCommonCauseProbability        = 0.1
+	defaultCommonCauseFailureProbability = 0.5
+	defaultRegionRadiusShare             = 0.1 // Доля диагонали охвата узлов
+)
+
+// FailureModel вероятностная модель отказов рёбер и узлов графа — смесь
+// компонент: независимые отказы с базовыми вероятностями и, при связанных
+// отказах, по компоненте на каждую общую причину (регион вокруг узла или тип
+// дороги), в которой задетые элементы отказывают с повышенной вероятностью.
+// Модель обусловлена числом одновременных отказов не больше limit: сэмплы
+// берутся точно из условного распределения, без отбраковки.
+type FailureModel struct {
+	graph *commonv1.Graph
+	seed  int64
+	rng   *rand.Rand
+
+	// Элементы 0..len(edges)-1 — рёбра (индексы в graph.Edges), далее — узлы
+	edges []int
+	nodes []int64
+	base  []float64 // Базовая вероятность отказа элемента
+	shock float64   // Вероятность отказа элемента, задетого общей причиной
+	limit int
+
+	components []*failureComponent
+	mass       float64 // Σ weight·admissible — вероятность допустимого сценария
+}
+
+// failureComponent компонента смеси
+type failureComponent struct {
+	weight     float64
+	exposed    map[int]bool // Элементы, задетые общей причиной
+	admissible float64      // P(отказов ≤ limit) в компоненте
+	suffix     [][]float64  // suffix[i][b] = P(отказов среди i.. ≤ b); строится при первом сэмпле
+}
+
+// NewFailureModel строит модель отказов графа по конфигурации
+func NewFailureModel(graph *commonv1.Graph, config *simulationv1.RandomFailureConfig) (*FailureModel, error) {
+	seed := config.GetRandomSeed()
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	edgeProb := config.GetEdgeFailureProbability()
+	if edgeProb <= 0 {
+		edgeProb = defaultEdgeFailureProbability
+	}
+	limit := int(config.GetMaxSimultaneousFailures())
+	if limit <= 0 {
+		limit = defaultMaxSimultaneousFailures
+	}
+	shock := config.GetCommonCauseFailureProbability()
+	if shock <= 0 {
+		shock = defaultCommonCauseFailureProbability
+	}
+
+	m := &FailureModel{
+		graph: graph,
+		seed:  seed,
+		rng:   rand.New(rand.NewSource(seed)),
+		shock: shock,
+		limit: limit,
+	}
+
+	for i := range graph.Edges {
+		m.edges = append(m.edges, i)
+		m.base = append(m.base, edgeProb)
+	}
+	// Отказ истока или стока делает задачу неопределённой
+	for _, node := range graph.Nodes {
+		if node.Id != graph.SourceId && node.Id != graph.SinkId {
+			m.nodes = append(m.nodes, node.Id)
+			m.base = append(m.base, config.GetNodeFailureProbability())
+		}
+	}
+
+	var causes []map[int]bool
+	if config.GetCorrelatedFailures() {
+		switch config.GetCorrelation() {
+		case simulationv1.FailureCorrelation_FAILURE_CORRELATION_ROAD_TYPE:
+			causes = m.roadTypeCauses()
+		default:
+			causes = m.regionalCauses(config.GetRegionRadius())
+		}
+	}
+
+	q := 0.0
+	if len(causes) > 0 {
+		q = config.GetCommonCauseProbability()
+		if q <= 0 {
+			q = defaultCommonCauseProbability
+		}
+	}
+	m.components = append(m.components, &failureComponent{weight: 1 - q})
+	for _, exposed := range causes {
+		m.components = append(m.components, &failureComponent{
+			weight:  q / float64(len(causes)),
+			exposed: exposed,
+		})
+	}
+
+	for _, c := range m.components {
+		c.admissible = m.admissible(c)
+		m.mass += c.weight * c.admissible
+	}
+	if m.mass <= 0 {
+		return nil, fmt.Errorf("no failure scenario has at most %d simultaneous failures", limit)
+	}
+	return m, nil
+}
+
+// Seed возвращает seed генерации
+func (m *FailureModel) Seed() int64 {
+	return m.seed
+}
+
+// regionalCauses возвращает задетые элементы аварии с центром в каждом узле:
+// узлы в радиусе и рёбра, хотя бы один конец которых в радиусе. Радиус по
+// умолчанию — 10% диагонали прямоугольника, охватывающего узлы.
+func (m *FailureModel) regionalCauses(radius float64) []map[int]bool {
+	nodes := m.graph.Nodes
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	if radius <= 0 {
+		minX, maxX, minY, maxY := nodes[0].X, nodes[0].X, nodes[0].Y, nodes[0].Y
+		for _, node := range nodes {
+			minX, maxX = min(minX, node.X), max(maxX, node.X)
+			minY, maxY = min(minY, node.Y), max(maxY, node.Y)
+		}
+		radius = defaultRegionRadiusShare * math.Hypot(maxX-minX, maxY-minY)
+	}
+
+	nodeElement := make(map[int64]int, len(m.nodes))
+	for k, id := range m.nodes {
+		nodeElement[id] = len(m.edges) + k
+	}
+
+	causes := make([]map[int]bool, 0, len(nodes))
+	for _, center := range nodes {
+		inside := make(map[int64]bool)
+		exposed := make(map[int]bool)
+		for _, node := range nodes {
+			if math.Hypot(node.X-center.X, node.Y-center.Y) <= radius {
+				inside[node.Id] = true
+				if i, ok := nodeElement[node.Id]; ok {
+					exposed[i] = true
+				}
+			}
+		}
+		for i, j := range m.edges {
+			edge := m.graph.Edges[j]
+			if inside[edge.From] || inside[edge.To] {
+				exposed[i] = true
+			}
+		}
+		causes = append(causes, exposed)
+	}
+	return causes
+}
+
+// roadTypeCauses возвращает рёбра каждого заданного в графе типа дороги
+func (m *FailureModel) roadTypeCauses() []map[int]bool {
+	byType := make(map[commonv1.RoadType]map[int]bool)
+	for i, j := range m.edges {
+		roadType := m.graph.Edges[j].RoadType
+		if roadType == commonv1.RoadType_ROAD_TYPE_UNSPECIFIED {
+			continue
+		}
+		if byType[roadType] == nil {
+			byType[roadType] = make(map[int]bool)
+		}
+		byType[roadType][i] = true
+	}
+
+	types := make([]commonv1.RoadType, 0, len(byType))
+	for roadType := range byType {
+		types = append(types, roadType)
+	}
+	slices.Sort(types)
+
+	causes := make([]map[int]bool, len(types))
+	for k, roadType := range types {
+		causes[k] = byType[roadType]
+	}
+	return causes
+}
+
+// probability возвращает вероятность отказа элемента i в компоненте
+func (m *FailureModel) probability(c *failureComponent, i int) float64 {
+	if c.exposed[i] {
+		return max(m.base[i], m.shock)
+	}
+	return m.base[i]
+}
+
+// admissible возвращает P(отказов ≤ limit) в компоненте
+func (m *FailureModel) admissible(c *failureComponent) float64 {
+	dist := make([]float64, m.limit+1)
+	dist[0] = 1
+	for i := range m.base {
+		p := m.probability(c, i)
+		for b := m.limit; b >= 0; b-- {
+			dist[b] *= 1 - p
+			if b > 0 {
+				dist[b] += dist[b-1] * p
+			}
+		}
+	}
+
+	total := 0.0
+	for _, v := range dist {
+		total += v
+	}
+	return total
+}
+
+// suffixTable строит suffix[i][b] = P(среди элементов i.. отказов ≤ b)
+func (m *FailureModel) suffixTable(c *failureComponent) [][]float64 {
+	if c.suffix != nil {
+		return c.suffix
+	}
+	n := len(m.base)
+	suffix := make([][]float64, n+1)
+	suffix[n] = make([]float64, m.limit+1)
+	for b := range suffix[n] {
+		suffix[n][b] = 1
+	}
+	for i := n - 1; i >= 0; i-- {
+		p := m.probability(c, i)
+		suffix[i] = make([]float64, m.limit+1)
+		for b := 0; b <= m.limit; b++ {
+			suffix[i][b] = (1 - p) * suffix[i+1][b]
+			if b > 0 {
+				suffix[i][b] += p * suffix[i+1][b-1]
+			}
+		}
+	}
+	c.suffix = suffix
+	return suffix
+}
+
+// Sample сэмплирует n сценариев модели. Совпавшие сценарии объединяются:
+// occurrences — число выпадений, probability — точная вероятность сценария
+// в модели. Сценарий без отказов тоже возвращается, чтобы доли выпадений
+// оставались несмещённой оценкой.
+func (m *FailureModel) Sample(n int) []*simulationv1.FailureScenario {
+	if n <= 0 {
+		n = defaultFailureScenarios
+	}
+
+	var scenarios []*simulationv1.FailureScenario
+	index := make(map[string]*simulationv1.FailureScenario)
+	for s := 0; s < n; s++ {
+		failed := m.sampleFailures()
+		key := failureKey(failed)
+		if scenario, ok := index[key]; ok {
+			scenario.Occurrences++
+			continue
+		}
+
+		scenario := m.scenario(failed)
+		scenario.Name = fmt.Sprintf("Random Scenario %d", len(scenarios)+1)
+		scenario.Occurrences = 1
+		index[key] = scenario
+		scenarios = append(scenarios, scenario)
+	}
+	return scenarios
+}
+
+// sampleFailures выбирает компоненту по её апостериорному весу и
+// последовательно разыгрывает отказы элементов при остатке лимита b:
+// P(отказ i | b) = p·suffix[i+1][b-1] / suffix[i][b]
+func (m *FailureModel) sampleFailures() []int {
+	u := m.rng.Float64() * m.mass
+	component := m.components[len(m.components)-1]
+	for _, c := range m.components {
+		if u < c.weight*c.admissible {
+			component = c
+			break
+		}
+		u -= c.weight * c.admissible
+	}
+
+	suffix := m.suffixTable(component)
+	var failed []int
+	budget := m.limit
+	for i := range m.base {
+		if budget == 0 || suffix[i][budget] <= 0 {
+			break
+		}
+		p := m.probability(component, i) * suffix[i+1][budget-1] / suffix[i][budget]
+		if m.rng.Float64() < p {
+			failed = append(failed, i)
+			budget--
+		}
+	}
+	return failed
+}
+
+// scenario строит сценарий из отказавших элементов с его вероятностью
+// в модели: Σ weight·P(сценарий | компонента) / mass
+func (m *FailureModel) scenario(failed []int) *simulationv1.FailureScenario {
+	isFailed := make(map[int]bool, len(failed))
+	for _, i := range failed {
+		isFailed[i] = true
+	}
+
+	logTerms := make([]float64, len(m.components))
+	for k, c := range m.components {
+		logP := math.Log(c.weight)
+		for i := range m.base {
+			p := m.probability(c, i)
+			if isFailed[i] {
+				logP += math.Log(p)
+			} else {
+				logP += math.Log1p(-p)
+			}
+		}
+		logTerms[k] = logP
+	}
+
+	scenario := &simulationv1.FailureScenario{
+		Probability: math.Exp(logSumExp(logTerms) - math.Log(m.mass)),
+	}
+	for _, i := range failed {
+		if i < len(m.edges) {
+			j := m.edges[i]
+			scenario.FailedEdges = append(scenario.FailedEdges, KeyOf(m.graph.Edges[j], j))
+		} else {
+			scenario.FailedNodes = append(scenario.FailedNodes, m.nodes[i-len(m.edges)])
+		}
+	}
+	return scenario
+}
+
+func logSumExp(values []float64) float64 {
+	top := math.Inf(-1)
+	for _, v := range values {
+		top = max(top, v)
+	}
+	if math.IsInf(top, -1) {
+		return top
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += math.Exp(v - top)
+	}
+	return top + math.Log(sum)
+}
+
+func failureKey(failed []int) string {
+	parts := make([]string, len(failed))
+	for j, i := range failed {
+		parts[j] = strconv.Itoa(i)
+	}
+	return strings.Join(parts, ",")
+}
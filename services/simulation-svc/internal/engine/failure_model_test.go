@@ -0,0 +1,173 @@
+// services/simulation-svc/internal/engine/failure_model_test.go
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+// failureTestGraph две удалённые пары параллельных рёбер: 1→2 у истока и
+// 3→4 у стока, связанные ребром 2→3
+func failureTestGraph() *commonv1.Graph {
+	return &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Nodes: []*commonv1.Node{
+			{Id: 1, X: 0, Y: 0},
+			{Id: 2, X: 1, Y: 0},
+			{Id: 3, X: 100, Y: 0},
+			{Id: 4, X: 101, Y: 0},
+		},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, RoadType: commonv1.RoadType_ROAD_TYPE_HIGHWAY},
+			{From: 1, To: 2, Capacity: 10, RoadType: commonv1.RoadType_ROAD_TYPE_LOCAL},
+			{From: 2, To: 3, Capacity: 20, RoadType: commonv1.RoadType_ROAD_TYPE_PRIMARY},
+			{From: 3, To: 4, Capacity: 10, RoadType: commonv1.RoadType_ROAD_TYPE_HIGHWAY},
+			{From: 3, To: 4, Capacity: 10, RoadType: commonv1.RoadType_ROAD_TYPE_LOCAL},
+		},
+	}
+}
+
+// jointFrequency возвращает долю выпадений сценариев, где отказали оба ребра
+// с индексами a и b
+func jointFrequency(scenarios []*simulationv1.FailureScenario, a, b int) float64 {
+	var joint, total int32
+	for _, s := range scenarios {
+		failed := make(map[int64]bool)
+		for _, key := range s.FailedEdges {
+			failed[key.EdgeId] = true
+		}
+		if failed[int64(a+1)] && failed[int64(b+1)] {
+			joint += s.Occurrences
+		}
+		total += s.Occurrences
+	}
+	return float64(joint) / float64(total)
+}
+
+func TestFailureModel_Sample_Reproducible(t *testing.T) {
+	config := &simulationv1.RandomFailureConfig{
+		EdgeFailureProbability:  0.3,
+		NodeFailureProbability:  0.5,
+		MaxSimultaneousFailures: 2,
+		RandomSeed:              11,
+	}
+
+	sample := func() []*simulationv1.FailureScenario {
+		model, err := NewFailureModel(failureTestGraph(), config)
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), model.Seed())
+		return model.Sample(500)
+	}
+
+	first, second := sample(), sample()
+	require.Equal(t, len(first), len(second))
+
+	var occurrences int32
+	for i, s := range first {
+		assert.Equal(t, s.FailedEdges, second[i].FailedEdges)
+		assert.Equal(t, s.FailedNodes, second[i].FailedNodes)
+		assert.Equal(t, s.Occurrences, second[i].Occurrences)
+
+		assert.LessOrEqual(t, len(s.FailedEdges)+len(s.FailedNodes), 2)
+		// Исток и сток не отказывают
+		assert.NotContains(t, s.FailedNodes, int64(1))
+		assert.NotContains(t, s.FailedNodes, int64(4))
+		occurrences += s.Occurrences
+	}
+	assert.Equal(t, int32(500), occurrences)
+}
+
+func TestFailureModel_Sample_MatchesProbabilities(t *testing.T) {
+	graph := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 1},
+			{From: 1, To: 2, Capacity: 1},
+			{From: 1, To: 2, Capacity: 1},
+		},
+	}
+	model, err := NewFailureModel(graph, &simulationv1.RandomFailureConfig{
+		EdgeFailureProbability:  0.4,
+		MaxSimultaneousFailures: 2,
+		RandomSeed:              3,
+	})
+	require.NoError(t, err)
+
+	const n = 40000
+	scenarios := model.Sample(n)
+	// Все 7 сценариев с не более чем двумя отказами из трёх
+	require.Len(t, scenarios, 7)
+
+	// P(k отказов) ∝ C(3,k)·0.4^k·0.6^(3-k) при k ≤ 2
+	mass := 0.216 + 3*0.144 + 3*0.096
+	total := 0.0
+	for _, s := range scenarios {
+		want := 0.216 / mass
+		switch len(s.FailedEdges) {
+		case 1:
+			want = 0.144 / mass
+		case 2:
+			want = 0.096 / mass
+		}
+		assert.InDelta(t, want, s.Probability, 1e-12)
+		assert.InDelta(t, want, float64(s.Occurrences)/n, 0.01)
+		total += s.Probability
+	}
+	assert.InDelta(t, 1, total, 1e-12)
+}
+
+func TestFailureModel_CorrelatedFailures(t *testing.T) {
+	sample := func(config *simulationv1.RandomFailureConfig) []*simulationv1.FailureScenario {
+		config.EdgeFailureProbability = 0.05
+		config.MaxSimultaneousFailures = 5
+		config.RandomSeed = 5
+		model, err := NewFailureModel(failureTestGraph(), config)
+		require.NoError(t, err)
+		return model.Sample(20000)
+	}
+
+	independent := sample(&simulationv1.RandomFailureConfig{})
+	regional := sample(&simulationv1.RandomFailureConfig{
+		CorrelatedFailures:     true,
+		Correlation:            simulationv1.FailureCorrelation_FAILURE_CORRELATION_REGIONAL,
+		RegionRadius:           5,
+		CommonCauseProbability: 0.2,
+	})
+	roadType := sample(&simulationv1.RandomFailureConfig{
+		CorrelatedFailures:     true,
+		Correlation:            simulationv1.FailureCorrelation_FAILURE_CORRELATION_ROAD_TYPE,
+		CommonCauseProbability: 0.3,
+	})
+
+	// Независимо: 0.05² = 0.0025
+	assert.InDelta(t, 0.0025, jointFrequency(independent, 0, 1), 0.002)
+
+	// Параллельные рёбра одного региона отказывают вместе
+	assert.Greater(t, jointFrequency(regional, 0, 1), 0.02)
+	assert.Greater(t, jointFrequency(regional, 3, 4), 0.02)
+	// Разные регионы остаются почти независимыми
+	assert.Less(t, jointFrequency(regional, 0, 3), 0.01)
+
+	// Рёбра одного типа дороги отказывают вместе, хоть и далеко друг от друга
+	assert.Greater(t, jointFrequency(roadType, 0, 3), 0.02)
+	assert.Less(t, jointFrequency(roadType, 0, 1), 0.01)
+}
+
+func TestFailureModel_RegionalCauses_DefaultRadius(t *testing.T) {
+	model, err := NewFailureModel(failureTestGraph(), &simulationv1.RandomFailureConfig{RandomSeed: 1})
+	require.NoError(t, err)
+
+	// Диагональ охвата 101, радиус по умолчанию ≈ 10.1: регион узла 1 задевает
+	// узел 2 и рёбра, инцидентные узлам 1 и 2
+	causes := model.regionalCauses(0)
+	require.Len(t, causes, 4)
+	assert.Equal(t, map[int]bool{0: true, 1: true, 2: true, 5: true}, causes[0])
+}
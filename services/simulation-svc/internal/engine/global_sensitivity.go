@@ -0,0 +1,416 @@
+// services/simulation-svc/internal/engine/global_sensitivity.go
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+)
+
+const (
+	defaultMorrisTrajectories = 10
+	defaultMorrisLevels       = 4
+	defaultSobolSamples       = 256
+	defaultSensitivityWorkers = 4
+
+	// sobolBootstrapResamples число бутстрэп-выборок для интервалов индексов Соболя
+	sobolBootstrapResamples = 100
+)
+
+// globalSensitivity глобальный анализ чувствительности: параметры меняются
+// одновременно, координата i точки единичного гиперкуба [0,1]^k задаёт
+// положение i-го параметра в его диапазоне множителей
+type globalSensitivity struct {
+	engine     *SensitivityEngine
+	graph      *commonv1.Graph
+	params     []*simulationv1.SensitivityParameter
+	config     *simulationv1.SensitivityConfig
+	algorithm  commonv1.Algorithm
+	baseResult *client.SolveResult
+	seed       int64
+}
+
+func (e *SensitivityEngine) newGlobalSensitivity(
+	graph *commonv1.Graph,
+	params []*simulationv1.SensitivityParameter,
+	config *simulationv1.SensitivityConfig,
+	algorithm commonv1.Algorithm,
+	baseResult *client.SolveResult,
+) *globalSensitivity {
+	seed := config.GetRandomSeed()
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &globalSensitivity{
+		engine:     e,
+		graph:      graph,
+		params:     params,
+		config:     config,
+		algorithm:  algorithm,
+		baseResult: baseResult,
+		seed:       seed,
+	}
+}
+
+// multiplier переводит координату u ∈ [0,1] в множитель i-го параметра
+func (g *globalSensitivity) multiplier(i int, u float64) float64 {
+	minMult, maxMult := parameterRange(g.params[i])
+	return minMult + u*(maxMult-minMult)
+}
+
+// solve решает граф с параметрами в точке point и возвращает поток
+func (g *globalSensitivity) solve(ctx context.Context, point []float64) (float64, error) {
+	mods := make([]*simulationv1.Modification, len(g.params))
+	for i, param := range g.params {
+		mods[i] = parameterModification(param, g.multiplier(i, point[i]))
+	}
+
+	// Все точки решаются от базового потока: порядок решений не влияет на результат
+	result, err := g.engine.solverClient.Solve(ctx, ApplyModifications(g.graph, mods), g.algorithm, warmStartOptions(g.baseResult))
+	if err != nil {
+		return 0, err
+	}
+	if result == nil {
+		return 0, nil
+	}
+	return result.MaxFlow, nil
+}
+
+// evaluate параллельно решает граф во всех точках. В отличие от OAT, сбой
+// решения прерывает анализ: нулевой поток исказил бы оценки всех параметров.
+func (g *globalSensitivity) evaluate(ctx context.Context, points [][]float64) ([]float64, error) {
+	workers := int(g.config.GetMaxWorkers())
+	if workers <= 0 {
+		workers = defaultSensitivityWorkers
+	}
+	workers = min(workers, len(points))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	flows := make([]float64, len(points))
+	tasks := make(chan int)
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range tasks {
+				flow, err := g.solve(ctx, points[i])
+				if err != nil {
+					once.Do(func() {
+						firstErr = fmt.Errorf("solve sensitivity sample %d: %w", i, err)
+						cancel()
+					})
+					continue
+				}
+				flows[i] = flow
+			}
+		}()
+	}
+
+dispatch:
+	for i := range points {
+		select {
+		case tasks <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(tasks)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return flows, nil
+}
+
+// relativeIndex нормирует изменение потока на базовый поток
+func (g *globalSensitivity) relativeIndex(flowChange float64) float64 {
+	if g.baseResult == nil || g.baseResult.MaxFlow <= 0 {
+		return 0
+	}
+	return flowChange / g.baseResult.MaxFlow
+}
+
+// response собирает ответ с рейтингом по индексам результатов
+func (g *globalSensitivity) response(results []*simulationv1.SensitivityResult) *simulationv1.AnalyzeSensitivityResponse {
+	rankings := make([]*simulationv1.ParameterRanking, 0, len(results))
+	for _, r := range results {
+		rankings = append(rankings, &simulationv1.ParameterRanking{
+			ParameterId:      r.ParameterId,
+			SensitivityIndex: r.SensitivityIndex,
+		})
+	}
+	g.engine.rankParameters(rankings)
+
+	return &simulationv1.AnalyzeSensitivityResponse{
+		Success:          true,
+		ParameterResults: results,
+		Rankings:         rankings,
+	}
+}
+
+// =============================================================================
+// Morris
+// =============================================================================
+
+// morris выполняет скрининг элементарных эффектов Морриса. Каждая из r
+// траекторий начинается в случайном узле сетки из p уровней и меняет
+// параметры по одному в случайном порядке на шаг Δ = p/(2(p−1)); эффект
+// параметра — изменение потока на шаге, делённое на шаг. Нужно r·(k+1)
+// решений. Индекс чувствительности — μ*, отнесённое к базовому потоку.
+func (g *globalSensitivity) morris(ctx context.Context) (*simulationv1.AnalyzeSensitivityResponse, error) {
+	k := len(g.params)
+	r := int(g.config.GetNumTrajectories())
+	if r <= 0 {
+		r = defaultMorrisTrajectories
+	}
+	p := int(g.config.GetNumLevels())
+	if p == 0 {
+		p = defaultMorrisLevels
+	}
+	if p < 2 || p%2 != 0 {
+		return nil, fmt.Errorf("morris num_levels must be even and at least 2, got %d", p)
+	}
+
+	// При чётном p шаг Δ кратен шагу сетки и из любого узла возможен
+	// либо в сторону увеличения, либо в сторону уменьшения
+	delta := float64(p) / float64(2*(p-1))
+	rng := streamRand(g.seed, 0)
+
+	points := make([][]float64, 0, r*(k+1))
+	orders := make([][]int, r)
+	for t := 0; t < r; t++ {
+		x := make([]float64, k)
+		for i := range x {
+			x[i] = float64(rng.Intn(p)) / float64(p-1)
+		}
+		points = append(points, append([]float64(nil), x...))
+
+		orders[t] = rng.Perm(k)
+		for _, i := range orders[t] {
+			if x[i]+delta <= 1+1e-12 {
+				x[i] += delta
+			} else {
+				x[i] -= delta
+			}
+			points = append(points, append([]float64(nil), x...))
+		}
+	}
+
+	flows, err := g.evaluate(ctx, points)
+	if err != nil {
+		return nil, err
+	}
+
+	effects := make([][]float64, k)
+	for t, order := range orders {
+		base := t * (k + 1)
+		for step, i := range order {
+			from, to := base+step, base+step+1
+			effects[i] = append(effects[i], (flows[to]-flows[from])/(points[to][i]-points[from][i]))
+		}
+	}
+
+	results := make([]*simulationv1.SensitivityResult, 0, k)
+	for i, param := range g.params {
+		indices := morrisStatistics(effects[i])
+		index := g.relativeIndex(indices.MuStar)
+		results = append(results, &simulationv1.SensitivityResult{
+			ParameterId:      g.engine.buildParamID(param),
+			SensitivityIndex: index,
+			Level:            determineSensitivityLevel(index),
+			Morris:           indices,
+		})
+	}
+	return g.response(results), nil
+}
+
+// morrisStatistics считает μ, μ* и σ элементарных эффектов
+func morrisStatistics(effects []float64) *simulationv1.MorrisIndices {
+	n := float64(len(effects))
+	if n == 0 {
+		return &simulationv1.MorrisIndices{}
+	}
+
+	var sum, sumAbs float64
+	for _, ee := range effects {
+		sum += ee
+		sumAbs += math.Abs(ee)
+	}
+	mu := sum / n
+
+	sigma := 0.0
+	if len(effects) > 1 {
+		var ss float64
+		for _, ee := range effects {
+			ss += (ee - mu) * (ee - mu)
+		}
+		sigma = math.Sqrt(ss / (n - 1))
+	}
+
+	return &simulationv1.MorrisIndices{
+		Mu:     mu,
+		MuStar: sumAbs / n,
+		Sigma:  sigma,
+	}
+}
+
+// =============================================================================
+// Sobol
+// =============================================================================
+
+// sobol оценивает индексы Соболя по схеме Сальтелли: матрицы A и B из N
+// точек квазислучайной последовательности и k матриц AB_i (A со столбцом i
+// из B) — всего N·(k+2) решений. Индекс чувствительности — полный индекс S_Ti.
+func (g *globalSensitivity) sobol(ctx context.Context) (*simulationv1.AnalyzeSensitivityResponse, error) {
+	k := len(g.params)
+	n := int(g.config.GetNumSamples())
+	if n <= 0 {
+		n = defaultSobolSamples
+	}
+
+	a, b := g.sobolMatrices(n, k)
+	points := make([][]float64, 0, n*(k+2))
+	points = append(points, a...)
+	points = append(points, b...)
+	for i := 0; i < k; i++ {
+		for j := 0; j < n; j++ {
+			ab := append([]float64(nil), a[j]...)
+			ab[i] = b[j][i]
+			points = append(points, ab)
+		}
+	}
+
+	flows, err := g.evaluate(ctx, points)
+	if err != nil {
+		return nil, err
+	}
+	fa, fb := flows[:n], flows[n:2*n]
+
+	// Общие бутстрэп-выборки для всех параметров
+	rng := streamRand(g.seed, 1)
+	resamples := make([][]int, sobolBootstrapResamples)
+	for s := range resamples {
+		resamples[s] = make([]int, n)
+		for j := range resamples[s] {
+			resamples[s][j] = rng.Intn(n)
+		}
+	}
+
+	results := make([]*simulationv1.SensitivityResult, 0, k)
+	for i, param := range g.params {
+		fab := flows[(2+i)*n : (3+i)*n]
+		first, total := sobolEstimates(fa, fb, fab, nil)
+
+		firsts := make([]float64, len(resamples))
+		totals := make([]float64, len(resamples))
+		for s, idx := range resamples {
+			firsts[s], totals[s] = sobolEstimates(fa, fb, fab, idx)
+		}
+
+		results = append(results, &simulationv1.SensitivityResult{
+			ParameterId:      g.engine.buildParamID(param),
+			SensitivityIndex: total,
+			Level:            determineSensitivityLevel(total),
+			Sobol: &simulationv1.SobolIndices{
+				FirstOrder:     first,
+				TotalOrder:     total,
+				FirstOrderConf: zScore(0.95) * sampleStdDev(firsts),
+				TotalOrderConf: zScore(0.95) * sampleStdDev(totals),
+			},
+		})
+	}
+	return g.response(results), nil
+}
+
+// sobolMatrices возвращает матрицы A и B — первые и последние k координат
+// точек последовательности Соболя размерности 2k со случайным цифровым сдвигом
+func (g *globalSensitivity) sobolMatrices(n, k int) ([][]float64, [][]float64) {
+	directions := sobolDirections(2 * k)
+	rng := streamRand(g.seed^samplerSalt, 0)
+	shift := make([]uint32, 2*k)
+	for dim := range shift {
+		shift[dim] = rng.Uint32()
+	}
+
+	a := make([][]float64, n)
+	b := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		point := sobolPoint(directions, shift, j)
+		a[j], b[j] = point[:k], point[k:]
+	}
+	return a, b
+}
+
+// sobolEstimates возвращает оценку первого порядка Сальтелли (2010) и полную
+// оценку Янсена по выборкам с индексами idx (nil — все). Среднее и дисперсия
+// выхода оцениваются по объединению f(A) и f(B); оценка первого порядка
+// считается по центрированному f(B), иначе её разброс растёт с квадратом
+// среднего потока.
+func sobolEstimates(fa, fb, fab []float64, idx []int) (float64, float64) {
+	n := len(fa)
+	if idx != nil {
+		n = len(idx)
+	}
+	at := func(j int) int {
+		if idx != nil {
+			return idx[j]
+		}
+		return j
+	}
+
+	var sum float64
+	for j := 0; j < n; j++ {
+		m := at(j)
+		sum += fa[m] + fb[m]
+	}
+	mean := sum / float64(2*n)
+
+	var variance, first, total float64
+	for j := 0; j < n; j++ {
+		m := at(j)
+		variance += (fa[m]-mean)*(fa[m]-mean) + (fb[m]-mean)*(fb[m]-mean)
+		first += (fb[m] - mean) * (fab[m] - fa[m])
+		d := fa[m] - fab[m]
+		total += d * d
+	}
+	variance /= float64(2 * n)
+	if variance <= 0 {
+		return 0, 0
+	}
+	return first / float64(n) / variance, total / float64(2*n) / variance
+}
+
+func sampleStdDev(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	var mean float64
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+
+	var ss float64
+	for _, v := range values {
+		ss += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(ss / float64(len(values)-1))
+}
@@ -0,0 +1,173 @@
+// services/simulation-svc/internal/engine/global_sensitivity_test.go
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+)
+
+// productSolver отвечает потоком c(1→2)·c(1→3)/50 — модель со взаимодействием
+type productSolver struct{}
+
+func (productSolver) Solve(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, opts interface{}) (*client.SolveResult, error) {
+	capacity := make(map[int64]float64)
+	for _, e := range graph.Edges {
+		if e.From == graph.SourceId {
+			capacity[e.To] = e.Capacity
+		}
+	}
+	return &client.SolveResult{MaxFlow: capacity[2] * capacity[3] / 50}, nil
+}
+
+// failingSolver отвечает ошибкой на все вызовы, кроме базового
+type failingSolver struct{}
+
+func (failingSolver) Solve(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, opts interface{}) (*client.SolveResult, error) {
+	if opts == nil {
+		return capacitySolver{}.Solve(ctx, graph, algorithm, opts)
+	}
+	return nil, errors.New("solver unavailable")
+}
+
+// globalSensitivityParams — два параметра на рёбрах из истока с разными
+// диапазонами и один на ребре, не влияющем на capacitySolver
+func globalSensitivityParams() []*simulationv1.SensitivityParameter {
+	capacity := func(from, to int64, lo, hi float64) *simulationv1.SensitivityParameter {
+		return &simulationv1.SensitivityParameter{
+			Edge:          &commonv1.EdgeKey{From: from, To: to},
+			Target:        simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY,
+			MinMultiplier: lo,
+			MaxMultiplier: hi,
+		}
+	}
+	return []*simulationv1.SensitivityParameter{
+		capacity(1, 2, 0.5, 1.5),
+		capacity(1, 3, 0.8, 1.2),
+		capacity(2, 4, 0.5, 1.5),
+	}
+}
+
+func analyzeGlobal(t *testing.T, solver SolverClientInterface, config *simulationv1.SensitivityConfig) *simulationv1.AnalyzeSensitivityResponse {
+	t.Helper()
+	engine := NewSensitivityEngine(solver)
+	result, err := engine.AnalyzeSensitivity(context.Background(), createMonteCarloTestGraph(), globalSensitivityParams(), config, commonv1.Algorithm_ALGORITHM_DINIC)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Len(t, result.ParameterResults, 3)
+	return result
+}
+
+func TestSensitivityEngine_Morris_LinearModel(t *testing.T) {
+	result := analyzeGlobal(t, capacitySolver{}, &simulationv1.SensitivityConfig{
+		Method:     simulationv1.SensitivityMethod_SENSITIVITY_METHOD_MORRIS,
+		RandomSeed: 42,
+		MaxWorkers: 4,
+	})
+
+	// Поток линеен: эффект равен изменению потока на всём диапазоне
+	want := []float64{50, 20, 0}
+	for i, r := range result.ParameterResults {
+		require.NotNil(t, r.Morris)
+		assert.Nil(t, r.Sobol)
+		assert.InDelta(t, want[i], r.Morris.MuStar, 1e-9)
+		assert.InDelta(t, want[i], r.Morris.Mu, 1e-9)
+		assert.InDelta(t, 0, r.Morris.Sigma, 1e-9)
+		assert.InDelta(t, want[i]/100, r.SensitivityIndex, 1e-9)
+	}
+
+	assert.Equal(t, "edge_1_2_MODIFICATION_TARGET_CAPACITY", result.Rankings[0].ParameterId)
+	assert.Equal(t, int32(1), result.Rankings[0].Rank)
+	assert.Equal(t, simulationv1.SensitivityLevel_SENSITIVITY_LEVEL_NEGLIGIBLE, result.ParameterResults[2].Level)
+}
+
+func TestSensitivityEngine_Morris_InteractionRaisesSigma(t *testing.T) {
+	result := analyzeGlobal(t, productSolver{}, &simulationv1.SensitivityConfig{
+		Method:          simulationv1.SensitivityMethod_SENSITIVITY_METHOD_MORRIS,
+		NumTrajectories: 20,
+		RandomSeed:      7,
+	})
+
+	// Эффект 1→2 зависит от значения 1→3 и наоборот
+	assert.Greater(t, result.ParameterResults[0].Morris.Sigma, 0.0)
+	assert.Greater(t, result.ParameterResults[1].Morris.Sigma, 0.0)
+	assert.InDelta(t, 0, result.ParameterResults[2].Morris.MuStar, 1e-9)
+}
+
+func TestSensitivityEngine_Morris_OddLevels(t *testing.T) {
+	engine := NewSensitivityEngine(capacitySolver{})
+	_, err := engine.AnalyzeSensitivity(context.Background(), createMonteCarloTestGraph(), globalSensitivityParams(), &simulationv1.SensitivityConfig{
+		Method:    simulationv1.SensitivityMethod_SENSITIVITY_METHOD_MORRIS,
+		NumLevels: 5,
+	}, commonv1.Algorithm_ALGORITHM_DINIC)
+	assert.ErrorContains(t, err, "num_levels")
+}
+
+func TestSensitivityEngine_Sobol_LinearModel(t *testing.T) {
+	config := &simulationv1.SensitivityConfig{
+		Method:     simulationv1.SensitivityMethod_SENSITIVITY_METHOD_SOBOL,
+		NumSamples: 1024,
+		RandomSeed: 42,
+		MaxWorkers: 8,
+	}
+	result := analyzeGlobal(t, capacitySolver{}, config)
+
+	// Дисперсии вкладов относятся как 1² : 0.4², взаимодействий нет
+	want := []float64{1 / 1.16, 0.16 / 1.16, 0}
+	var sum float64
+	for i, r := range result.ParameterResults {
+		require.NotNil(t, r.Sobol)
+		assert.Nil(t, r.Morris)
+		assert.InDelta(t, want[i], r.Sobol.FirstOrder, 0.03, "parameter %d", i)
+		assert.InDelta(t, want[i], r.Sobol.TotalOrder, 0.03, "parameter %d", i)
+		assert.Equal(t, r.Sobol.TotalOrder, r.SensitivityIndex)
+		sum += r.Sobol.FirstOrder
+	}
+	assert.InDelta(t, 1, sum, 0.03)
+	assert.Greater(t, result.ParameterResults[0].Sobol.FirstOrderConf, 0.0)
+	assert.Less(t, result.ParameterResults[0].Sobol.FirstOrderConf, 0.1)
+	assert.Zero(t, result.ParameterResults[2].Sobol.TotalOrderConf)
+
+	// Результат не зависит от числа воркеров
+	config.MaxWorkers = 1
+	assert.Equal(t, result.ParameterResults, analyzeGlobal(t, capacitySolver{}, config).ParameterResults)
+}
+
+func TestSensitivityEngine_Sobol_Interaction(t *testing.T) {
+	result := analyzeGlobal(t, productSolver{}, &simulationv1.SensitivityConfig{
+		Method:     simulationv1.SensitivityMethod_SENSITIVITY_METHOD_SOBOL,
+		NumSamples: 1024,
+		RandomSeed: 42,
+	})
+
+	// Взаимодействие: сумма индексов первого порядка меньше единицы, полный
+	// индекс не меньше индекса первого порядка
+	var sum float64
+	for _, r := range result.ParameterResults[:2] {
+		assert.GreaterOrEqual(t, r.Sobol.TotalOrder, r.Sobol.FirstOrder-0.01)
+		sum += r.Sobol.FirstOrder
+	}
+	assert.Less(t, sum, 1.0)
+	assert.Greater(t, result.ParameterResults[1].Sobol.TotalOrder-result.ParameterResults[1].Sobol.FirstOrder, 0.0)
+}
+
+func TestSensitivityEngine_Global_SolveError(t *testing.T) {
+	engine := NewSensitivityEngine(failingSolver{})
+	for _, method := range []simulationv1.SensitivityMethod{
+		simulationv1.SensitivityMethod_SENSITIVITY_METHOD_MORRIS,
+		simulationv1.SensitivityMethod_SENSITIVITY_METHOD_SOBOL,
+	} {
+		_, err := engine.AnalyzeSensitivity(context.Background(), createMonteCarloTestGraph(), globalSensitivityParams(), &simulationv1.SensitivityConfig{
+			Method:     method,
+			NumSamples: 16,
+		}, commonv1.Algorithm_ALGORITHM_DINIC)
+		assert.ErrorContains(t, err, "solver unavailable", method.String())
+	}
+}
@@ -35,6 +35,8 @@ func CloneGraph(g *commonv1.Graph) *commonv1.Graph {
 			Supply:   node.Supply,
 			Demand:   node.Demand,
 			Metadata: make(map[string]string),
+
+			StorageCapacity: node.StorageCapacity,
 		}
 		for k, v := range node.Metadata {
 			clone.Nodes[i].Metadata[k] = v
@@ -43,6 +45,7 @@ func CloneGraph(g *commonv1.Graph) *commonv1.Graph {
 
 	for i, edge := range g.Edges {
 		clone.Edges[i] = &commonv1.Edge{
+			Id:            edge.Id,
 			From:          edge.From,
 			To:            edge.To,
 			Capacity:      edge.Capacity,
@@ -51,15 +54,49 @@ func CloneGraph(g *commonv1.Graph) *commonv1.Graph {
 			RoadType:      edge.RoadType,
 			CurrentFlow:   edge.CurrentFlow,
 			Bidirectional: edge.Bidirectional,
+			MinFlow:       edge.MinFlow,
 		}
 	}
 
 	return clone
 }
 
+// EdgeID возвращает идентификатор ребра: явный Id, а если он не задан —
+// порядковый номер ребра в графе (с 1), как его назначает solver-svc
+func EdgeID(edge *commonv1.Edge, index int) int64 {
+	if edge.Id != 0 {
+		return edge.Id
+	}
+	return int64(index + 1)
+}
+
+// KeyOf возвращает ключ ребра вместе с его идентификатором, чтобы
+// параллельные рёбра между одними и теми же узлами различались
+func KeyOf(edge *commonv1.Edge, index int) *commonv1.EdgeKey {
+	return &commonv1.EdgeKey{From: edge.From, To: edge.To, EdgeId: EdgeID(edge, index)}
+}
+
+// MatchesEdgeKey проверяет, соответствует ли ребро ключу.
+// Ключ без EdgeId соответствует всем параллельным рёбрам между From и To.
+func MatchesEdgeKey(edge *commonv1.Edge, index int, key *commonv1.EdgeKey) bool {
+	if edge.From != key.From || edge.To != key.To {
+		return false
+	}
+	return key.EdgeId == 0 || EdgeID(edge, index) == key.EdgeId
+}
+
+// AssignEdgeIDs проставляет явные идентификаторы рёбрам без Id, чтобы они
+// не зависели от позиции ребра после удаления других рёбер
+func AssignEdgeIDs(g *commonv1.Graph) {
+	for i, edge := range g.Edges {
+		edge.Id = EdgeID(edge, i)
+	}
+}
+
 // ApplyModifications применяет модификации к графу
 func ApplyModifications(g *commonv1.Graph, mods []*simulationv1.Modification) *commonv1.Graph {
 	modified := CloneGraph(g)
+	AssignEdgeIDs(modified)
 
 	// Индексы для быстрого доступа
 	nodeIndex := make(map[int64]int)
@@ -67,11 +104,7 @@ func ApplyModifications(g *commonv1.Graph, mods []*simulationv1.Modification) *c
 		nodeIndex[node.Id] = i
 	}
 
-	edgeIndex := make(map[string]int)
-	for i, edge := range modified.Edges {
-		key := edgeKey(edge.From, edge.To)
-		edgeIndex[key] = i
-	}
+	edgeIndex := buildEdgeIndex(modified)
 
 	for _, mod := range mods {
 		switch mod.Type {
@@ -81,20 +114,22 @@ func ApplyModifications(g *commonv1.Graph, mods []*simulationv1.Modification) *c
 		case simulationv1.ModificationType_MODIFICATION_TYPE_REMOVE_EDGE:
 			modified = removeEdge(modified, mod.EdgeKey)
 			// Перестраиваем индекс
-			edgeIndex = make(map[string]int)
-			for i, edge := range modified.Edges {
-				edgeIndex[edgeKey(edge.From, edge.To)] = i
-			}
+			edgeIndex = buildEdgeIndex(modified)
 
 		case simulationv1.ModificationType_MODIFICATION_TYPE_ADD_EDGE:
 			newEdge := &commonv1.Edge{
+				Id:       mod.EdgeKey.EdgeId,
 				From:     mod.EdgeKey.From,
 				To:       mod.EdgeKey.To,
 				Capacity: getModValue(mod, 0),
 				Cost:     0,
 			}
+			if newEdge.Id == 0 {
+				newEdge.Id = maxEdgeID(modified) + 1
+			}
 			modified.Edges = append(modified.Edges, newEdge)
-			edgeIndex[edgeKey(newEdge.From, newEdge.To)] = len(modified.Edges) - 1
+			key := edgeKey(newEdge.From, newEdge.To)
+			edgeIndex[key] = append(edgeIndex[key], len(modified.Edges)-1)
 
 		case simulationv1.ModificationType_MODIFICATION_TYPE_UPDATE_NODE:
 			applyNodeModification(modified, nodeIndex, mod)
@@ -106,10 +141,7 @@ func ApplyModifications(g *commonv1.Graph, mods []*simulationv1.Modification) *c
 			for i, node := range modified.Nodes {
 				nodeIndex[node.Id] = i
 			}
-			edgeIndex = make(map[string]int)
-			for i, edge := range modified.Edges {
-				edgeIndex[edgeKey(edge.From, edge.To)] = i
-			}
+			edgeIndex = buildEdgeIndex(modified)
 
 		case simulationv1.ModificationType_MODIFICATION_TYPE_DISABLE_NODE:
 			// Устанавливаем capacity всех связанных рёбер в 0
@@ -120,17 +152,38 @@ func ApplyModifications(g *commonv1.Graph, mods []*simulationv1.Modification) *c
 	return modified
 }
 
-func applyEdgeModification(g *commonv1.Graph, index map[string]int, mod *simulationv1.Modification) {
-	key := edgeKey(mod.EdgeKey.From, mod.EdgeKey.To)
-	idx, ok := index[key]
-	if !ok {
-		return
+// buildEdgeIndex индексирует рёбра по паре узлов; параллельные рёбра
+// попадают в один список
+func buildEdgeIndex(g *commonv1.Graph) map[string][]int {
+	index := make(map[string][]int)
+	for i, edge := range g.Edges {
+		key := edgeKey(edge.From, edge.To)
+		index[key] = append(index[key], i)
 	}
+	return index
+}
 
-	edge := g.Edges[idx]
-	value := getTargetValue(edge, mod.Target)
-	newValue := calculateNewValue(value, mod)
-	setTargetValue(edge, mod.Target, newValue)
+func maxEdgeID(g *commonv1.Graph) int64 {
+	var maxID int64
+	for i, edge := range g.Edges {
+		if id := EdgeID(edge, i); id > maxID {
+			maxID = id
+		}
+	}
+	return maxID
+}
+
+func applyEdgeModification(g *commonv1.Graph, index map[string][]int, mod *simulationv1.Modification) {
+	key := edgeKey(mod.EdgeKey.From, mod.EdgeKey.To)
+	for _, idx := range index[key] {
+		edge := g.Edges[idx]
+		if !MatchesEdgeKey(edge, idx, mod.EdgeKey) {
+			continue
+		}
+		value := getTargetValue(edge, mod.Target)
+		newValue := calculateNewValue(value, mod)
+		setTargetValue(edge, mod.Target, newValue)
+	}
 }
 
 func applyNodeModification(g *commonv1.Graph, index map[int64]int, mod *simulationv1.Modification) {
@@ -149,9 +202,9 @@ func applyNodeModification(g *commonv1.Graph, index map[int64]int, mod *simulati
 }
 
 func removeEdge(g *commonv1.Graph, key *commonv1.EdgeKey) *commonv1.Graph {
-	newEdges := make([]*commonv1.Edge, 0, len(g.Edges)-1)
-	for _, edge := range g.Edges {
-		if edge.From != key.From || edge.To != key.To {
+	newEdges := make([]*commonv1.Edge, 0, len(g.Edges))
+	for i, edge := range g.Edges {
+		if !MatchesEdgeKey(edge, i, key) {
 			newEdges = append(newEdges, edge)
 		}
 	}
@@ -370,6 +370,54 @@ func TestApplyModifications_NonexistentEdge(t *testing.T) {
 	assert.Equal(t, len(graph.Edges), len(result.Edges))
 }
 
+func TestApplyModifications_ParallelEdges(t *testing.T) {
+	// Два параллельных ребра 1->2: без Id (получит 1) и с явным Id 20
+	graph := &commonv1.Graph{
+		Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, Cost: 1},
+			{From: 1, To: 2, Capacity: 10, Cost: 5, Id: 20},
+		},
+	}
+
+	updated := ApplyModifications(graph, []*simulationv1.Modification{{
+		Type:    simulationv1.ModificationType_MODIFICATION_TYPE_UPDATE_EDGE,
+		EdgeKey: &commonv1.EdgeKey{From: 1, To: 2, EdgeId: 20},
+		Target:  simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY,
+		Change:  &simulationv1.Modification_AbsoluteValue{AbsoluteValue: 3},
+	}})
+	require.Len(t, updated.Edges, 2)
+	assert.Equal(t, int64(1), updated.Edges[0].Id)
+	assert.Equal(t, 10.0, updated.Edges[0].Capacity)
+	assert.Equal(t, 3.0, updated.Edges[1].Capacity)
+
+	// Удаление первого ребра не сдвигает идентификатор оставшегося
+	removed := ApplyModifications(graph, []*simulationv1.Modification{
+		{
+			Type:    simulationv1.ModificationType_MODIFICATION_TYPE_REMOVE_EDGE,
+			EdgeKey: &commonv1.EdgeKey{From: 1, To: 2, EdgeId: 1},
+		},
+		{
+			Type:    simulationv1.ModificationType_MODIFICATION_TYPE_ADD_EDGE,
+			EdgeKey: &commonv1.EdgeKey{From: 1, To: 2},
+			Change:  &simulationv1.Modification_AbsoluteValue{AbsoluteValue: 7},
+		},
+	})
+	require.Len(t, removed.Edges, 2)
+	assert.Equal(t, int64(20), removed.Edges[0].Id)
+	assert.Equal(t, int64(21), removed.Edges[1].Id)
+
+	// Ключ без EdgeId затрагивает все параллельные рёбра
+	all := ApplyModifications(graph, []*simulationv1.Modification{{
+		Type:    simulationv1.ModificationType_MODIFICATION_TYPE_REMOVE_EDGE,
+		EdgeKey: &commonv1.EdgeKey{From: 1, To: 2},
+	}})
+	assert.Empty(t, all.Edges)
+
+	// Исходный граф не изменён
+	assert.Equal(t, int64(0), graph.Edges[0].Id)
+}
+
 func TestResetFlow(t *testing.T) {
 	graph := createTestGraph()
 	// Устанавливаем flow
@@ -0,0 +1,238 @@
+// services/simulation-svc/internal/engine/inventory.go
+package engine
+
+import (
+	"math"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+	"logistics/pkg/client"
+	"logistics/pkg/domain"
+)
+
+// inventoryState переносит состояние узлов между шагами временной симуляции:
+// неотгруженное предложение копится в запасе узла, невыполненный спрос —
+// в backlog, и оба добавляются к предложению и спросу следующего шага
+type inventoryState struct {
+	supplyNodes []int64 // Узлы с supply > 0 в порядке графа
+	demandNodes []int64 // Точки доставки (demand > 0) в порядке графа
+
+	inventory map[int64]float64
+	backlog   map[int64]float64
+	levels    map[int64]*simulationv1.ServiceLevel
+
+	totalBacklog float64 // Backlog в конце последнего шага
+}
+
+func newInventoryState(g *commonv1.Graph) *inventoryState {
+	s := &inventoryState{
+		inventory: make(map[int64]float64),
+		backlog:   make(map[int64]float64),
+		levels:    make(map[int64]*simulationv1.ServiceLevel),
+	}
+	for _, node := range g.Nodes {
+		if node.Supply > 0 {
+			s.supplyNodes = append(s.supplyNodes, node.Id)
+			s.inventory[node.Id] = 0
+		}
+		if node.Demand > 0 {
+			s.demandNodes = append(s.demandNodes, node.Id)
+			s.levels[node.Id] = &simulationv1.ServiceLevel{NodeId: node.Id}
+		}
+	}
+	return s
+}
+
+// apply добавляет запасы и backlog к предложению и спросу узлов графа шага и
+// ограничивает их явными рёбрами виртуальных терминалов:
+//
+//	SuperSource ──supply + запас──▶ склад ──▶ ... ──▶ точка доставки ──demand + backlog──▶ SuperSink
+//
+// solver-svc учитывает supply/demand узлов только в графах с несколькими
+// терминалами, поэтому граф шага решается между SuperSourceID и SuperSinkID,
+// а supply/demand узлов обнуляются. Без узлов предложения (спроса) поток
+// по-прежнему выходит из source_id (приходит в sink_id) графа.
+//
+// Возвращает балансы узлов с предложением и спросом шага
+func (s *inventoryState) apply(g *commonv1.Graph) map[int64]*commonv1.NodeBalance {
+	balances := make(map[int64]*commonv1.NodeBalance)
+	var terminals []*commonv1.Edge
+	for _, node := range g.Nodes {
+		inventory, isSupply := s.inventory[node.Id]
+		level, isDemand := s.levels[node.Id]
+		if !isSupply && !isDemand {
+			continue
+		}
+
+		balance := &commonv1.NodeBalance{NodeId: node.Id}
+		if isSupply {
+			balance.Supply = node.Supply + inventory
+			terminals = append(terminals, &commonv1.Edge{
+				From: domain.SuperSourceID, To: node.Id, Capacity: balance.Supply,
+			})
+		}
+		if isDemand {
+			level.TotalDemand += node.Demand
+			balance.Demand = node.Demand + s.backlog[node.Id]
+			terminals = append(terminals, &commonv1.Edge{
+				From: node.Id, To: domain.SuperSinkID, Capacity: balance.Demand,
+			})
+		}
+		node.Supply, node.Demand = 0, 0
+		balances[node.Id] = balance
+	}
+
+	if len(s.supplyNodes) > 0 {
+		g.Nodes = append(g.Nodes, &commonv1.Node{Id: domain.SuperSourceID, Type: commonv1.NodeType_NODE_TYPE_SOURCE})
+		g.SourceId = domain.SuperSourceID
+	}
+	if len(s.demandNodes) > 0 {
+		g.Nodes = append(g.Nodes, &commonv1.Node{Id: domain.SuperSinkID, Type: commonv1.NodeType_NODE_TYPE_SINK})
+		g.SinkId = domain.SuperSinkID
+	}
+	nextID := maxEdgeID(g)
+	for _, edge := range terminals {
+		nextID++
+		edge.Id = nextID
+	}
+	g.Edges = append(g.Edges, terminals...)
+
+	return balances
+}
+
+// settle распределяет поток шага по балансам узлов: отгружено — поток по
+// ребру от суперистока, доставлено — поток по ребру в суперсток. Это один
+// поток, поэтому отгруженное и доставленное согласованы; остатки переходят
+// на следующий шаг
+func (s *inventoryState) settle(
+	balances map[int64]*commonv1.NodeBalance,
+	flowGraph *commonv1.Graph,
+	result *simulationv1.TimeStepResult,
+) {
+	if flowGraph != nil {
+		for _, edge := range flowGraph.Edges {
+			switch {
+			case edge.From == domain.SuperSourceID && balances[edge.To] != nil:
+				balances[edge.To].Shipped += edge.CurrentFlow
+			case edge.To == domain.SuperSinkID && balances[edge.From] != nil:
+				balances[edge.From].Received += edge.CurrentFlow
+			}
+		}
+	}
+
+	for _, id := range s.supplyNodes {
+		b := balances[id]
+		b.UnusedSupply = math.Max(b.Supply-b.Shipped, 0)
+		if b.UnusedSupply <= flowTolerance {
+			b.UnusedSupply = 0
+		}
+		s.inventory[id] = b.UnusedSupply
+		result.TotalInventory += b.UnusedSupply
+	}
+
+	for _, id := range s.demandNodes {
+		b := balances[id]
+		b.UnmetDemand = math.Max(b.Demand-b.Received, 0)
+		if b.UnmetDemand <= flowTolerance {
+			b.UnmetDemand = 0
+		}
+		s.backlog[id] = b.UnmetDemand
+		result.TotalBacklog += b.UnmetDemand
+
+		level := s.levels[id]
+		level.Delivered += b.Received
+		level.FinalBacklog = b.UnmetDemand
+		if b.UnmetDemand > 0 {
+			level.StepsWithBacklog++
+		}
+	}
+	s.totalBacklog = result.TotalBacklog
+
+	// Сначала узлы с предложением, затем точки доставки
+	for _, id := range s.supplyNodes {
+		result.NodeBalances = append(result.NodeBalances, balances[id])
+	}
+	for _, id := range s.demandNodes {
+		if _, isSupply := s.inventory[id]; !isSupply {
+			result.NodeBalances = append(result.NodeBalances, balances[id])
+		}
+	}
+}
+
+// withoutTerminals возвращает результат шага без виртуальных терминалов,
+// добавленных apply: узких мест и статистики по ним нет в исходном графе
+func withoutTerminals(res *client.SolveResult, source, sink int64) *client.SolveResult {
+	if res.Graph == nil {
+		return res
+	}
+
+	graph := &commonv1.Graph{
+		SourceId: source,
+		SinkId:   sink,
+		Name:     res.Graph.Name,
+		Metadata: res.Graph.Metadata,
+	}
+	for _, node := range res.Graph.Nodes {
+		if node.Id != domain.SuperSourceID && node.Id != domain.SuperSinkID {
+			graph.Nodes = append(graph.Nodes, node)
+		}
+	}
+
+	stripped := *res
+	stripped.Graph = graph
+	stripped.AverageUtilization, stripped.SaturatedEdges = 0, 0
+	active := 0
+	for _, edge := range res.Graph.Edges {
+		if edge.From == domain.SuperSourceID || edge.To == domain.SuperSinkID {
+			continue
+		}
+		graph.Edges = append(graph.Edges, edge)
+
+		// Как в client.SolverClient.Solve
+		if edge.CurrentFlow > 1e-9 {
+			active++
+			if edge.Capacity > 0 {
+				utilization := edge.CurrentFlow / edge.Capacity
+				stripped.AverageUtilization += utilization
+				if utilization >= 0.99 {
+					stripped.SaturatedEdges++
+				}
+			}
+		}
+	}
+	if active > 0 {
+		stripped.AverageUtilization /= float64(active)
+	}
+	return &stripped
+}
+
+// backlogTotal возвращает backlog в конце последнего шага; 0 без переноса
+func (s *inventoryState) backlogTotal() float64 {
+	if s == nil {
+		return 0
+	}
+	return s.totalBacklog
+}
+
+// serviceLevels возвращает уровни обслуживания точек доставки и общую долю
+// доставленного спроса
+func (s *inventoryState) serviceLevels() ([]*simulationv1.ServiceLevel, float64) {
+	levels := make([]*simulationv1.ServiceLevel, 0, len(s.demandNodes))
+	var demand, delivered float64
+	for _, id := range s.demandNodes {
+		level := s.levels[id]
+		level.FillRate = 1
+		if level.TotalDemand > 0 {
+			level.FillRate = level.Delivered / level.TotalDemand
+		}
+		demand += level.TotalDemand
+		delivered += level.Delivered
+		levels = append(levels, level)
+	}
+
+	fillRate := 1.0
+	if demand > 0 {
+		fillRate = delivered / demand
+	}
+	return levels, fillRate
+}
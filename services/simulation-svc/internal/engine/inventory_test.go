@@ -0,0 +1,170 @@
+// services/simulation-svc/internal/engine/inventory_test.go
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+func inventoryTestRequest(carryOver bool) *simulationv1.RunTimeSimulationRequest {
+	return &simulationv1.RunTimeSimulationRequest{
+		Graph: &commonv1.Graph{
+			SourceId: 1,
+			SinkId:   2,
+			Nodes:    []*commonv1.Node{{Id: 1, Supply: 10}, {Id: 2, Demand: 10}},
+			Edges:    []*commonv1.Edge{{From: 1, To: 2, Capacity: 15}},
+		},
+		TimeConfig: &simulationv1.TimeSimulationConfig{
+			NumSteps:  4,
+			TimeStep:  simulationv1.TimeStep_TIME_STEP_HOUR,
+			CarryOver: carryOver,
+		},
+		// Спроса нет два шага, затем он удваивается
+		NodePatterns: []*simulationv1.NodeTimePattern{{
+			NodeId: 2,
+			Target: simulationv1.PatternTarget_PATTERN_TARGET_DEMAND,
+			Pattern: &simulationv1.TimePattern{
+				Type: simulationv1.PatternType_PATTERN_TYPE_CUSTOM,
+				CustomPoints: []*simulationv1.TimePoint{
+					{Step: 0, Multiplier: 0}, {Step: 1, Multiplier: 0}, {Step: 2, Multiplier: 2}, {Step: 3, Multiplier: 2},
+				},
+			},
+		}},
+	}
+}
+
+func TestTimeSimulationEngine_CarryOver(t *testing.T) {
+	resp, err := NewTimeSimulationEngine(maxFlowSolver{}).RunTimeSimulation(context.Background(), inventoryTestRequest(true))
+	require.NoError(t, err)
+	require.Len(t, resp.StepResults, 4)
+
+	// Предложение копится, пока нет спроса, затем ребро 15 не успевает за спросом 20
+	wantInventory := []float64{10, 20, 15, 10}
+	wantBacklog := []float64{0, 0, 5, 10}
+	wantDelivered := []float64{0, 0, 15, 15}
+	for i, step := range resp.StepResults {
+		assert.Equal(t, wantInventory[i], step.TotalInventory, "step %d", i)
+		assert.Equal(t, wantBacklog[i], step.TotalBacklog, "step %d", i)
+
+		require.Len(t, step.NodeBalances, 2)
+		supply, demand := step.NodeBalances[0], step.NodeBalances[1]
+		assert.Equal(t, int64(1), supply.NodeId)
+		assert.Equal(t, int64(2), demand.NodeId)
+		assert.Equal(t, wantDelivered[i], demand.Received, "step %d", i)
+		assert.Equal(t, demand.Received, supply.Shipped, "step %d", i)
+		assert.Equal(t, wantDelivered[i], step.MaxFlow, "step %d", i)
+		assert.Equal(t, wantInventory[i], supply.UnusedSupply, "step %d", i)
+		assert.Equal(t, wantBacklog[i], demand.UnmetDemand, "step %d", i)
+
+		// Рёбра виртуальных терминалов не попадают в узкие места шага
+		for _, key := range step.Bottlenecks {
+			assert.Equal(t, int64(1), key.From, "step %d", i)
+			assert.Equal(t, int64(2), key.To, "step %d", i)
+		}
+	}
+	assert.Equal(t, 30.0, resp.StepResults[2].NodeBalances[0].Supply)
+	assert.Equal(t, 25.0, resp.StepResults[3].NodeBalances[1].Demand)
+
+	require.Len(t, resp.ServiceLevels, 1)
+	level := resp.ServiceLevels[0]
+	assert.Equal(t, int64(2), level.NodeId)
+	assert.Equal(t, 40.0, level.TotalDemand)
+	assert.Equal(t, 30.0, level.Delivered)
+	assert.InDelta(t, 0.75, level.FillRate, 1e-12)
+	assert.Equal(t, 10.0, level.FinalBacklog)
+	assert.Equal(t, int32(2), level.StepsWithBacklog)
+
+	assert.InDelta(t, 0.75, resp.Stats.FillRate, 1e-12)
+	assert.Equal(t, 10.0, resp.Stats.FinalBacklog)
+
+	// Backlog растёт на шагах 2–3
+	require.Len(t, resp.CriticalPeriods, 1)
+	period := resp.CriticalPeriods[0]
+	assert.Equal(t, simulationv1.CriticalPeriodType_CRITICAL_PERIOD_TYPE_HIGH_DEMAND, period.Type)
+	assert.Equal(t, int32(2), period.StartStep)
+	assert.Equal(t, int32(3), period.EndStep)
+}
+
+func TestTimeSimulationEngine_WithoutCarryOver(t *testing.T) {
+	resp, err := NewTimeSimulationEngine(maxFlowSolver{}).RunTimeSimulation(context.Background(), inventoryTestRequest(false))
+	require.NoError(t, err)
+
+	// Без переноса граф с одним истоком и стоком решается как есть:
+	// supply/demand узлов поток не ограничивают
+	for _, step := range resp.StepResults {
+		assert.Empty(t, step.NodeBalances)
+		assert.Equal(t, 15.0, step.MaxFlow)
+	}
+	assert.Empty(t, resp.ServiceLevels)
+	assert.Zero(t, resp.Stats.FillRate)
+}
+
+func TestTimeSimulationEngine_CarryOverMultipleSupplies(t *testing.T) {
+	// Два склада по 6 и точка доставки со спросом 8: отгружено ровно
+	// столько, сколько доставлено, остаток копится в запасе складов
+	req := &simulationv1.RunTimeSimulationRequest{
+		Graph: &commonv1.Graph{
+			SourceId: 1,
+			SinkId:   3,
+			Nodes:    []*commonv1.Node{{Id: 1, Supply: 6}, {Id: 2, Supply: 6}, {Id: 3, Demand: 8}},
+			Edges: []*commonv1.Edge{
+				{From: 1, To: 3, Capacity: 10},
+				{From: 2, To: 3, Capacity: 10},
+			},
+		},
+		TimeConfig: &simulationv1.TimeSimulationConfig{
+			NumSteps:  2,
+			TimeStep:  simulationv1.TimeStep_TIME_STEP_HOUR,
+			CarryOver: true,
+		},
+	}
+
+	resp, err := NewTimeSimulationEngine(maxFlowSolver{}).RunTimeSimulation(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.StepResults, 2)
+
+	wantInventory := []float64{4, 8}
+	for i, step := range resp.StepResults {
+		assert.Equal(t, 8.0, step.MaxFlow, "step %d", i)
+		assert.Equal(t, wantInventory[i], step.TotalInventory, "step %d", i)
+		assert.Zero(t, step.TotalBacklog, "step %d", i)
+
+		require.Len(t, step.NodeBalances, 3)
+		var shipped float64
+		for _, b := range step.NodeBalances[:2] {
+			assert.LessOrEqual(t, b.Shipped, b.Supply, "step %d node %d", i, b.NodeId)
+			shipped += b.Shipped
+		}
+		assert.Equal(t, 8.0, shipped, "step %d", i)
+		assert.Equal(t, 8.0, step.NodeBalances[2].Received, "step %d", i)
+	}
+	assert.InDelta(t, 1.0, resp.Stats.FillRate, 1e-12)
+}
+
+func TestCriticalPeriodTracker_TrackBacklog(t *testing.T) {
+	tracker := newCriticalPeriodTracker()
+	stats := newTimeSimulationStats()
+	stats.maxFlow = 100
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lowFlow := &simulationv1.TimeStepResult{MaxFlow: 10}
+	tracker.track(0, start, lowFlow, stats, time.Hour)
+	tracker.trackBacklog(1, start.Add(time.Hour), time.Hour)
+	tracker.trackBacklog(2, start.Add(2*time.Hour), time.Hour)
+	tracker.finalize(3, start, time.Hour)
+
+	// Период нехватки пропускной способности закрывается, когда начинает расти backlog
+	require.Len(t, tracker.periods, 2)
+	assert.Equal(t, simulationv1.CriticalPeriodType_CRITICAL_PERIOD_TYPE_LOW_CAPACITY, tracker.periods[0].Type)
+	assert.Equal(t, int32(0), tracker.periods[0].EndStep)
+	assert.Equal(t, simulationv1.CriticalPeriodType_CRITICAL_PERIOD_TYPE_HIGH_DEMAND, tracker.periods[1].Type)
+	assert.Equal(t, int32(1), tracker.periods[1].StartStep)
+	assert.Equal(t, int32(2), tracker.periods[1].EndStep)
+}
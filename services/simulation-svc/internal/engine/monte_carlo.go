@@ -17,8 +17,10 @@ import (
 // MonteCarloEngine движок Monte Carlo симуляции
 type MonteCarloEngine struct {
 	config       *simulationv1.MonteCarloConfig
+	seed         int64
 	rng          *rand.Rand
 	solverClient SolverClientInterface // Изменено на интерфейс
+	correlation  *simulationv1.UncertaintyCorrelation
 }
 
 // NewMonteCarloEngine создаёт новый движок
@@ -33,6 +35,7 @@ func NewMonteCarloEngine(config *simulationv1.MonteCarloConfig, solverClient Sol
 
 	return &MonteCarloEngine{
 		config:       config,
+		seed:         seed,
 		rng:          rand.New(rand.NewSource(seed)),
 		solverClient: solverClient,
 	}
@@ -40,13 +43,24 @@ func NewMonteCarloEngine(config *simulationv1.MonteCarloConfig, solverClient Sol
 
 // MonteCarloResult результат одной итерации
 type MonteCarloResult struct {
-	Iteration int
-	Flow      float64
-	Cost      float64
-	Error     error
+	Iteration   int
+	Multipliers []float64 // Сэмплированные множители в порядке неопределённостей
+	Group       int       // Группа итерации для оценки точности среднего (см. sampler)
+	Flow        float64
+	Cost        float64
+	Error       error
 }
 
-// Run запускает Monte Carlo симуляцию
+// Run запускает Monte Carlo симуляцию.
+//
+// Каждая итерация сэмплирует из собственного RNG, полученного из seed и номера
+// итерации, а результаты анализируются в порядке итераций, поэтому прогон с
+// тем же seed воспроизводится независимо от числа воркеров. Методы
+// сэмплирования, отличные от случайного, строят точки итераций через sampler.
+//
+// При target_relative_ci_half_width прогон останавливается на первой
+// контрольной точке, где сошлись и поток, и стоимость (см. convergence), а в
+// статистику входят только итерации до неё — результат тоже воспроизводим.
 func (e *MonteCarloEngine) Run(
 	ctx context.Context,
 	baseGraph *commonv1.Graph,
@@ -54,13 +68,13 @@ func (e *MonteCarloEngine) Run(
 	algorithm commonv1.Algorithm,
 	progressChan chan<- *simulationv1.MonteCarloProgress,
 ) (*simulationv1.RunMonteCarloResponse, error) {
-	numIterations := int(e.config.NumIterations)
-	if numIterations <= 0 {
-		numIterations = 1000
+	iterations := e.iterations()
+	numIterations := len(iterations)
+	smp, err := e.newCorrelatedSampler(len(uncertainties))
+	if err != nil {
+		return nil, err
 	}
-
-	results := make([]MonteCarloResult, 0, numIterations)
-	var mu sync.Mutex
+	conv := e.newConvergence(numIterations)
 
 	// Определяем количество воркеров
 	numWorkers := runtime.NumCPU()
@@ -71,17 +85,35 @@ func (e *MonteCarloEngine) Run(
 		numWorkers = 1
 	}
 
+	// Выдача задач прекращается при сходимости, по бюджету времени или отмене
+	dispatchCtx, stopDispatch := context.WithCancel(ctx)
+	defer stopDispatch()
+	if e.config.TimeBudgetSeconds > 0 {
+		var cancel context.CancelFunc
+		dispatchCtx, cancel = context.WithTimeout(dispatchCtx, time.Duration(e.config.TimeBudgetSeconds*float64(time.Second)))
+		defer cancel()
+	}
+
 	// Канал задач
-	tasks := make(chan int, numIterations)
-	var wg sync.WaitGroup
+	tasks := make(chan int)
+	go func() {
+		defer close(tasks)
+		for _, iteration := range iterations {
+			select {
+			case tasks <- iteration:
+			case <-dispatchCtx.Done():
+				return
+			}
+		}
+	}()
 
 	// Запускаем воркеры
+	completed := make(chan MonteCarloResult, numWorkers)
+	var wg sync.WaitGroup
 	for w := 0; w < numWorkers; w++ {
 		wg.Add(1)
-		go func(workerID int) {
+		go func() {
 			defer wg.Done()
-			// Локальный RNG для потокобезопасности
-			localRng := rand.New(rand.NewSource(time.Now().UnixNano() + int64(workerID)))
 
 			for iteration := range tasks {
 				select {
@@ -90,67 +122,176 @@ func (e *MonteCarloEngine) Run(
 				default:
 				}
 
-				// Применяем случайные модификации
-				modifiedGraph := e.applyUncertainties(baseGraph, uncertainties, localRng)
-
-				// Решаем через клиент
-				result, err := e.solverClient.Solve(ctx, modifiedGraph, algorithm, nil)
+				completed <- e.runIteration(ctx, smp, baseGraph, uncertainties, algorithm, iteration)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(completed)
+	}()
 
-				mcResult := MonteCarloResult{
-					Iteration: iteration,
-					Error:     err,
-				}
+	// Собираем результаты до завершения воркеров
+	results := make([]MonteCarloResult, 0, numIterations)
+	for mcResult := range completed {
+		results = append(results, mcResult)
 
-				if result != nil {
-					mcResult.Flow = result.MaxFlow
-					mcResult.Cost = result.TotalCost
-				}
+		if conv != nil && conv.add(mcResult.Iteration, results) {
+			stopDispatch()
+		}
 
-				mu.Lock()
-				results = append(results, mcResult)
-
-				// Отправляем прогресс
-				if progressChan != nil && len(results)%10 == 0 {
-					stats := e.calculateRunningStats(results)
-					select {
-					case progressChan <- &simulationv1.MonteCarloProgress{
-						Iteration:       int32(len(results)),
-						TotalIterations: int32(numIterations),
-						ProgressPercent: float64(len(results)) / float64(numIterations) * 100,
-						CurrentMeanFlow: stats.Mean,
-						CurrentStdDev:   stats.StdDev,
-						Status:          "running",
-					}:
-					default:
-					}
-				}
-				mu.Unlock()
+		// Отправляем прогресс
+		if progressChan != nil && len(results)%10 == 0 {
+			select {
+			case progressChan <- e.progress(results, numIterations):
+			default:
 			}
-		}(w)
+		}
 	}
 
-	// Отправляем задачи
-	for i := 0; i < numIterations; i++ {
-		tasks <- i
+	// Порядок завершения зависит от планировщика, порядок анализа — нет
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Iteration < results[j].Iteration
+	})
+
+	stopReason := simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_COMPLETED
+	switch {
+	case conv != nil && conv.converged:
+		stopReason = simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CONVERGED
+		results = results[:conv.limit]
+	case ctx.Err() != nil && len(results) < numIterations:
+		stopReason = simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CANCELED
+	case dispatchCtx.Err() != nil && len(results) < numIterations:
+		stopReason = simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_TIME_BUDGET
+		if len(e.config.ReplayIterations) == 0 {
+			results = results[:completedPrefix(results)]
+		}
 	}
-	close(tasks)
-
-	// Ждём завершения
-	wg.Wait()
 
 	// Анализируем результаты
-	return e.analyzeResults(results)
+	response, err := e.analyzeResults(results)
+	if err != nil {
+		return nil, err
+	}
+	response.RandomSeed = e.seed
+	response.StopReason = stopReason
+	response.CompletedIterations = int32(len(results))
+	if e.config.ReturnSamples {
+		response.Samples = toMonteCarloSamples(results)
+	}
+	return response, nil
+}
+
+// runIteration сэмплирует неопределённости итерации и решает изменённый граф
+func (e *MonteCarloEngine) runIteration(
+	ctx context.Context,
+	smp sampler,
+	baseGraph *commonv1.Graph,
+	uncertainties []*simulationv1.UncertaintySpec,
+	algorithm commonv1.Algorithm,
+	iteration int,
+) MonteCarloResult {
+	// Применяем случайные модификации
+	multipliers := e.sampleIteration(smp, uncertainties, iteration)
+	modifiedGraph := e.applySample(baseGraph, uncertainties, multipliers)
+
+	// Решаем через клиент
+	result, err := e.solverClient.Solve(ctx, modifiedGraph, algorithm, nil)
+
+	mcResult := MonteCarloResult{
+		Iteration:   iteration,
+		Multipliers: multipliers,
+		Group:       iteration,
+		Error:       err,
+	}
+	if smp != nil {
+		mcResult.Group = smp.group(iteration)
+	}
+
+	if result != nil {
+		mcResult.Flow = result.MaxFlow
+		mcResult.Cost = result.TotalCost
+	}
+	return mcResult
+}
+
+// progress возвращает сообщение о ходе прогона по завершённым итерациям
+func (e *MonteCarloEngine) progress(results []MonteCarloResult, numIterations int) *simulationv1.MonteCarloProgress {
+	stats := e.calculateRunningStats(results)
+	return &simulationv1.MonteCarloProgress{
+		Iteration:                    int32(len(results)),
+		TotalIterations:              int32(numIterations),
+		ProgressPercent:              float64(len(results)) / float64(numIterations) * 100,
+		CurrentMeanFlow:              stats.Mean,
+		CurrentStdDev:                stats.StdDev,
+		Status:                       "running",
+		CiHalfWidth:                  stats.CIHalfWidth,
+		RelativeCiHalfWidth:          stats.RelativeCIHalfWidth,
+		EstimatedIterationsRemaining: int32(e.estimateRemaining(len(results), numIterations, stats.RelativeCIHalfWidth)),
+	}
+}
+
+// iterations возвращает номера итераций прогона: replay_iterations или
+// 0..num_iterations-1
+func (e *MonteCarloEngine) iterations() []int {
+	if len(e.config.ReplayIterations) > 0 {
+		iterations := make([]int, len(e.config.ReplayIterations))
+		for i, it := range e.config.ReplayIterations {
+			iterations[i] = int(it)
+		}
+		return iterations
+	}
+
+	iterations := make([]int, e.numIterations())
+	for i := range iterations {
+		iterations[i] = i
+	}
+	return iterations
+}
+
+// numIterations возвращает полное число итераций прогона (num_iterations,
+// по умолчанию 1000), в том числе при воспроизведении части итераций
+func (e *MonteCarloEngine) numIterations() int {
+	if e.config.NumIterations <= 0 {
+		return 1000
+	}
+	return int(e.config.NumIterations)
+}
+
+// iterationRand возвращает RNG итерации: seed итерации выводится из seed
+// прогона и номера итерации, так что соседние итерации получают независимые
+// потоки
+func (e *MonteCarloEngine) iterationRand(iteration int) *rand.Rand {
+	return streamRand(e.seed, uint64(iteration+1))
 }
 
 func (e *MonteCarloEngine) applyUncertainties(
 	baseGraph *commonv1.Graph,
 	uncertainties []*simulationv1.UncertaintySpec,
 	rng *rand.Rand,
+) *commonv1.Graph {
+	return e.applySample(baseGraph, uncertainties, e.sampleUncertainties(uncertainties, rng))
+}
+
+// sampleUncertainties сэмплирует множитель каждой неопределённости
+func (e *MonteCarloEngine) sampleUncertainties(uncertainties []*simulationv1.UncertaintySpec, rng *rand.Rand) []float64 {
+	multipliers := make([]float64, len(uncertainties))
+	for i, u := range uncertainties {
+		multipliers[i] = sampleDistribution(u.Distribution, rng)
+	}
+	return multipliers
+}
+
+// applySample применяет сэмплированные множители к копии графа
+func (e *MonteCarloEngine) applySample(
+	baseGraph *commonv1.Graph,
+	uncertainties []*simulationv1.UncertaintySpec,
+	multipliers []float64,
 ) *commonv1.Graph {
 	graph := CloneGraph(baseGraph)
 
-	for _, u := range uncertainties {
-		multiplier := e.sampleDistribution(u.Distribution, rng)
+	for i, u := range uncertainties {
+		multiplier := multipliers[i]
 
 		switch u.Type {
 		case simulationv1.UncertaintyType_UNCERTAINTY_TYPE_EDGE:
@@ -167,7 +308,26 @@ func (e *MonteCarloEngine) applyUncertainties(
 	return graph
 }
 
-func (e *MonteCarloEngine) sampleDistribution(dist *simulationv1.Distribution, rng *rand.Rand) float64 {
+// toMonteCarloSamples конвертирует результаты итераций в proto
+func toMonteCarloSamples(results []MonteCarloResult) []*simulationv1.MonteCarloSample {
+	samples := make([]*simulationv1.MonteCarloSample, len(results))
+	for i, r := range results {
+		samples[i] = &simulationv1.MonteCarloSample{
+			Iteration:   int32(r.Iteration),
+			Multipliers: r.Multipliers,
+			Flow:        r.Flow,
+			Cost:        r.Cost,
+		}
+		if r.Error != nil {
+			samples[i].Error = r.Error.Error()
+		}
+	}
+	return samples
+}
+
+// sampleDistribution возвращает случайное значение распределения; 1 — без
+// распределения
+func sampleDistribution(dist *simulationv1.Distribution, rng *rand.Rand) float64 {
 	if dist == nil {
 		return 1.0
 	}
@@ -176,17 +336,9 @@ func (e *MonteCarloEngine) sampleDistribution(dist *simulationv1.Distribution, r
 	case simulationv1.DistributionType_DISTRIBUTION_TYPE_NORMAL:
 		return rng.NormFloat64()*dist.Param2 + dist.Param1
 
-	case simulationv1.DistributionType_DISTRIBUTION_TYPE_UNIFORM:
-		return dist.Param1 + rng.Float64()*(dist.Param2-dist.Param1)
-
-	case simulationv1.DistributionType_DISTRIBUTION_TYPE_TRIANGULAR:
-		u := rng.Float64()
-		min, max, mode := dist.Param1, dist.Param2, dist.Param3
-		fc := (mode - min) / (max - min)
-		if u < fc {
-			return min + math.Sqrt(u*(max-min)*(mode-min))
-		}
-		return max - math.Sqrt((1-u)*(max-min)*(max-mode))
+	case simulationv1.DistributionType_DISTRIBUTION_TYPE_UNIFORM,
+		simulationv1.DistributionType_DISTRIBUTION_TYPE_TRIANGULAR:
+		return distributionQuantile(dist, rng.Float64())
 
 	case simulationv1.DistributionType_DISTRIBUTION_TYPE_LOGNORMAL:
 		return math.Exp(rng.NormFloat64()*dist.Param2 + dist.Param1)
@@ -205,15 +357,15 @@ func (e *MonteCarloEngine) applyEdgeUncertainty(
 	target simulationv1.ModificationTarget,
 	multiplier float64,
 ) {
-	for _, edge := range graph.Edges {
-		if edge.From == edgeKey.From && edge.To == edgeKey.To {
-			switch target {
-			case simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY:
-				edge.Capacity *= multiplier
-			case simulationv1.ModificationTarget_MODIFICATION_TARGET_COST:
-				edge.Cost *= multiplier
-			}
-			return
+	for i, edge := range graph.Edges {
+		if !MatchesEdgeKey(edge, i, edgeKey) {
+			continue
+		}
+		switch target {
+		case simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY:
+			edge.Capacity *= multiplier
+		case simulationv1.ModificationTarget_MODIFICATION_TARGET_COST:
+			edge.Cost *= multiplier
 		}
 	}
 }
@@ -253,8 +405,10 @@ func (e *MonteCarloEngine) applyGlobalUncertainty(
 }
 
 type runningStats struct {
-	Mean   float64
-	StdDev float64
+	Mean                float64
+	StdDev              float64
+	CIHalfWidth         float64 // Полуширина доверительного интервала среднего потока
+	RelativeCIHalfWidth float64 // Наибольшая относительная полуширина по потоку и стоимости
 }
 
 func (e *MonteCarloEngine) calculateRunningStats(results []MonteCarloResult) runningStats {
@@ -262,10 +416,15 @@ func (e *MonteCarloEngine) calculateRunningStats(results []MonteCarloResult) run
 		return runningStats{}
 	}
 
-	var sum, sumSq float64
-	for _, r := range results {
+	flows := make([]float64, len(results))
+	costs := make([]float64, len(results))
+	groups := make([]int, len(results))
+	var sum, sumSq, costSum float64
+	for i, r := range results {
+		flows[i], costs[i], groups[i] = r.Flow, r.Cost, r.Group
 		sum += r.Flow
 		sumSq += r.Flow * r.Flow
+		costSum += r.Cost
 	}
 
 	n := float64(len(results))
@@ -273,7 +432,38 @@ func (e *MonteCarloEngine) calculateRunningStats(results []MonteCarloResult) run
 	variance := sumSq/n - mean*mean
 	stdDev := math.Sqrt(math.Max(0, variance))
 
-	return runningStats{Mean: mean, StdDev: stdDev}
+	flowHalfWidth := e.meanHalfWidth(flows, groups)
+	costHalfWidth := e.meanHalfWidth(costs, groups)
+
+	return runningStats{
+		Mean:        mean,
+		StdDev:      stdDev,
+		CIHalfWidth: flowHalfWidth,
+		RelativeCIHalfWidth: math.Max(
+			relativeHalfWidth(flowHalfWidth, mean),
+			relativeHalfWidth(costHalfWidth, costSum/n),
+		),
+	}
+}
+
+// meanHalfWidth возвращает полуширину доверительного интервала среднего values
+// с учётом метода сэмплирования
+func (e *MonteCarloEngine) meanHalfWidth(values []float64, groups []int) float64 {
+	z := zScore(e.config.ConfidenceLevel)
+	if e.samplingMethod() != simulationv1.SamplingMethod_SAMPLING_METHOD_RANDOM {
+		if meanVariance, ok := groupedMeanVariance(values, groups); ok {
+			return z * math.Sqrt(meanVariance)
+		}
+	}
+
+	n := float64(len(values))
+	var sum, sumSq float64
+	for _, v := range values {
+		sum += v
+		sumSq += v * v
+	}
+	mean := sum / n
+	return z * math.Sqrt(math.Max(0, sumSq/n-mean*mean)/n)
 }
 
 func (e *MonteCarloEngine) analyzeResults(results []MonteCarloResult) (*simulationv1.RunMonteCarloResponse, error) {
@@ -285,15 +475,20 @@ func (e *MonteCarloEngine) analyzeResults(results []MonteCarloResult) (*simulati
 	// Собираем значения
 	flows := make([]float64, n)
 	costs := make([]float64, n)
+	groups := make([]int, n)
 	for i, r := range results {
 		flows[i] = r.Flow
 		costs[i] = r.Cost
+		groups[i] = r.Group
 	}
 
 	// Статистика потока
 	flowStats := calculateStats(flows, e.config.ConfidenceLevel)
 	costStats := calculateStats(costs, e.config.ConfidenceLevel)
 
+	e.applySamplingPrecision(flowStats, flows, groups)
+	e.applySamplingPrecision(costStats, costs, groups)
+
 	// Гистограммы
 	flowHist := buildHistogram(flows, 20)
 	costHist := buildHistogram(costs, 20)
@@ -353,11 +548,7 @@ func calculateStats(values []float64, confidenceLevel float64) *simulationv1.Mon
 	}
 
 	// Confidence interval
-	zScore := 1.96 // 95% CI
-	if confidenceLevel > 0 && confidenceLevel < 1 {
-		zScore = normalInverse((1 + confidenceLevel) / 2)
-	}
-	marginOfError := zScore * stdDev / math.Sqrt(n)
+	marginOfError := zScore(confidenceLevel) * stdDev / math.Sqrt(n)
 
 	return &simulationv1.MonteCarloStats{
 		Mean:                   mean,
@@ -370,7 +561,30 @@ func calculateStats(values []float64, confidenceLevel float64) *simulationv1.Mon
 		Kurtosis:               kurtosis,
 		ConfidenceIntervalLow:  mean - marginOfError,
 		ConfidenceIntervalHigh: mean + marginOfError,
+		EffectiveSampleSize:    n,
+		RelativeCiHalfWidth:    relativeHalfWidth(marginOfError, mean),
+	}
+}
+
+// relativeHalfWidth возвращает полуширину доверительного интервала, делённую
+// на |mean| (+Inf при нулевом среднем и ненулевой полуширине)
+func relativeHalfWidth(halfWidth, mean float64) float64 {
+	if halfWidth == 0 {
+		return 0
+	}
+	if mean == 0 {
+		return math.Inf(1)
+	}
+	return halfWidth / math.Abs(mean)
+}
+
+// zScore возвращает квантиль нормального распределения для двустороннего
+// доверительного интервала уровня confidenceLevel (по умолчанию 95%)
+func zScore(confidenceLevel float64) float64 {
+	if confidenceLevel > 0 && confidenceLevel < 1 {
+		return normalInverse((1 + confidenceLevel) / 2)
 	}
+	return 1.96
 }
 
 func buildHistogram(values []float64, numBuckets int) []*simulationv1.HistogramBucket {
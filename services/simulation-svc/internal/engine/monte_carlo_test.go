@@ -274,6 +274,110 @@ func TestMonteCarloEngine_Run_ContextCancellation(t *testing.T) {
 	}
 }
 
+// capacitySolver отвечает потоком, равным суммарной пропускной способности
+// рёбер из истока: результат зависит только от графа, а не от порядка вызовов
+type capacitySolver struct{}
+
+func (capacitySolver) Solve(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, opts interface{}) (*client.SolveResult, error) {
+	flow := 0.0
+	for _, e := range graph.Edges {
+		if e.From == graph.SourceId {
+			flow += e.Capacity
+		}
+	}
+	return &client.SolveResult{MaxFlow: flow, TotalCost: 2 * flow}, nil
+}
+
+func monteCarloUncertainties() []*simulationv1.UncertaintySpec {
+	return []*simulationv1.UncertaintySpec{
+		{
+			Type:   simulationv1.UncertaintyType_UNCERTAINTY_TYPE_EDGE,
+			Edge:   &commonv1.EdgeKey{From: 1, To: 2},
+			Target: simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY,
+			Distribution: &simulationv1.Distribution{
+				Type:   simulationv1.DistributionType_DISTRIBUTION_TYPE_NORMAL,
+				Param1: 1.0,
+				Param2: 0.2,
+			},
+		},
+		{
+			Type:   simulationv1.UncertaintyType_UNCERTAINTY_TYPE_EDGE,
+			Edge:   &commonv1.EdgeKey{From: 1, To: 3},
+			Target: simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY,
+			Distribution: &simulationv1.Distribution{
+				Type:   simulationv1.DistributionType_DISTRIBUTION_TYPE_UNIFORM,
+				Param1: 0.5,
+				Param2: 1.5,
+			},
+		},
+	}
+}
+
+func TestMonteCarloEngine_Run_ReproducibleWithSeed(t *testing.T) {
+	run := func(seed int64, parallel bool, workers int32) *simulationv1.RunMonteCarloResponse {
+		engine := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{
+			NumIterations: 200,
+			RandomSeed:    seed,
+			Parallel:      parallel,
+			MaxWorkers:    workers,
+			ReturnSamples: true,
+		}, capacitySolver{})
+		result, err := engine.Run(context.Background(), createMonteCarloTestGraph(), monteCarloUncertainties(), commonv1.Algorithm_ALGORITHM_DINIC, nil)
+		require.NoError(t, err)
+		return result
+	}
+
+	sequential := run(42, false, 0)
+	parallel := run(42, true, 8)
+	other := run(43, true, 8)
+
+	assert.Equal(t, int64(42), sequential.RandomSeed)
+	require.Len(t, sequential.Samples, 200)
+	for i, s := range sequential.Samples {
+		assert.Equal(t, int32(i), s.Iteration)
+		assert.Len(t, s.Multipliers, 2)
+	}
+	assert.Equal(t, sequential.Samples, parallel.Samples, "samples must not depend on workers")
+	assert.Equal(t, sequential.FlowStats, parallel.FlowStats)
+	assert.Equal(t, sequential.FlowPercentiles, parallel.FlowPercentiles)
+	assert.NotEqual(t, sequential.FlowStats.Mean, other.FlowStats.Mean)
+}
+
+func TestMonteCarloEngine_Run_ReplayIterations(t *testing.T) {
+	graph := createMonteCarloTestGraph()
+
+	full := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{
+		NumIterations: 50,
+		RandomSeed:    7,
+		Parallel:      true,
+		ReturnSamples: true,
+	}, capacitySolver{})
+	all, err := full.Run(context.Background(), graph, monteCarloUncertainties(), commonv1.Algorithm_ALGORITHM_DINIC, nil)
+	require.NoError(t, err)
+
+	replay := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{
+		RandomSeed:       7,
+		ReturnSamples:    true,
+		ReplayIterations: []int32{31, 4},
+	}, capacitySolver{})
+	replayed, err := replay.Run(context.Background(), graph, monteCarloUncertainties(), commonv1.Algorithm_ALGORITHM_DINIC, nil)
+	require.NoError(t, err)
+
+	require.Len(t, replayed.Samples, 2)
+	assert.Equal(t, all.Samples[4], replayed.Samples[0])
+	assert.Equal(t, all.Samples[31], replayed.Samples[1])
+}
+
+func TestMonteCarloEngine_Run_SamplesOnlyOnRequest(t *testing.T) {
+	engine := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{NumIterations: 10, RandomSeed: 1}, capacitySolver{})
+
+	result, err := engine.Run(context.Background(), createMonteCarloTestGraph(), monteCarloUncertainties(), commonv1.Algorithm_ALGORITHM_DINIC, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Samples)
+	assert.Equal(t, int64(1), result.RandomSeed)
+}
+
 // ============================================================
 // DISTRIBUTION SAMPLING TESTS
 // ============================================================
@@ -381,7 +485,7 @@ func TestMonteCarloEngine_SampleDistribution(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Сэмплируем несколько раз для проверки
 			for i := 0; i < 10; i++ {
-				value := engine.sampleDistribution(tt.distribution, engine.rng)
+				value := sampleDistribution(tt.distribution, engine.rng)
 				tt.checkFunc(t, value)
 			}
 		})
@@ -6,6 +6,7 @@ import (
 	"math"
 
 	commonv1 "logistics/gen/go/logistics/common/v1"
+	optimizationv1 "logistics/gen/go/logistics/optimization/v1"
 	simulationv1 "logistics/gen/go/logistics/simulation/v1"
 	"logistics/pkg/client"
 )
@@ -29,8 +30,10 @@ func (e *ResilienceEngine) AnalyzeResilience(
 	config *simulationv1.ResilienceConfig,
 	algorithm commonv1.Algorithm,
 ) (*simulationv1.AnalyzeResilienceResponse, error) {
-	// Базовый результат
-	baseResult, err := e.solverClient.Solve(ctx, graph, algorithm, nil)
+	// Базовый результат вместе с минимальным разрезом
+	baseResult, err := e.solverClient.Solve(ctx, graph, algorithm, &optimizationv1.SolveOptions{
+		ReturnMinCut: true,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +58,29 @@ func (e *ResilienceEngine) AnalyzeResilience(
 	// Слабости
 	response.Weaknesses = e.identifyWeaknesses(n1, graph)
 
+	// N-k и каскадные отказы перебирают рёбра-кандидаты
+	if config.GetMaxFailuresToTest() >= 2 || config.GetTestCascadingFailures() {
+		c := newContingency(e, graph, algorithm, baseResult, n1)
+		candidates := c.candidates(int(config.GetMaxCandidateEdges()))
+
+		if config.GetMaxFailuresToTest() >= 2 {
+			response.NMinusTwo, err = c.nMinusK(ctx, int(config.MaxFailuresToTest), candidates)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if config.GetTestCascadingFailures() {
+			response.Cascade, err = c.cascades(ctx, config.LoadFactor, candidates)
+			if err != nil {
+				return nil, err
+			}
+			if weakness := cascadeWeakness(response.Cascade, baseResult.MaxFlow); weakness != nil {
+				response.Weaknesses = append(response.Weaknesses, weakness)
+			}
+		}
+	}
+
 	return response, nil
 }
 
@@ -65,8 +91,16 @@ type n1Result struct {
 	flowRobustness         float64
 	redundancyLevel        float64
 	minCutSize             int32
+	minCutEdges            []*commonv1.EdgeKey
 	spofEdges              []*commonv1.EdgeKey
 	spofNodes              []int64
+
+	// reductions[i] — потеря потока при отказе i-го ребра (весь поток, если
+	// отказ обрывает сеть)
+	reductions []float64
+	// backupEdges — идентификаторы рёбер, принимающих перенаправленный поток
+	// хотя бы при одном одиночном отказе
+	backupEdges map[int64]bool
 }
 
 func (e *ResilienceEngine) performN1Analysis(
@@ -79,46 +113,50 @@ func (e *ResilienceEngine) performN1Analysis(
 		analysis: &simulationv1.NMinusOneAnalysis{
 			AllScenariosFeasible: true,
 		},
-		spofEdges: make([]*commonv1.EdgeKey, 0),
-		spofNodes: make([]int64, 0),
+		spofEdges:   make([]*commonv1.EdgeKey, 0),
+		spofNodes:   make([]int64, 0),
+		reductions:  make([]float64, len(graph.Edges)),
+		backupEdges: make(map[int64]bool),
 	}
 
 	var worstFlowReduction float64
 	var scenariosTested, scenariosFailed int
+	baseFlows := edgeFlows(baseResult)
 
 	// Тестируем удаление каждого ребра
-	for _, edge := range graph.Edges {
-		modGraph := e.removeEdge(graph, edge.From, edge.To)
+	for i, edge := range graph.Edges {
+		key := KeyOf(edge, i)
+		modGraph := e.removeEdge(graph, key)
 		modResult, err := e.solverClient.Solve(ctx, modGraph, algorithm, nil)
 		scenariosTested++
 
 		if err != nil {
 			scenariosFailed++
 			result.analysis.AllScenariosFeasible = false
-			result.spofEdges = append(result.spofEdges, &commonv1.EdgeKey{
-				From: edge.From,
-				To:   edge.To,
-			})
+			result.spofEdges = append(result.spofEdges, key)
+			result.reductions[i] = baseResult.MaxFlow
 			continue
 		}
 
 		if modResult.MaxFlow == 0 && baseResult.MaxFlow > 0 {
 			scenariosFailed++
 			result.analysis.AllScenariosFeasible = false
-			result.spofEdges = append(result.spofEdges, &commonv1.EdgeKey{
-				From: edge.From,
-				To:   edge.To,
-			})
+			result.spofEdges = append(result.spofEdges, key)
+			result.reductions[i] = baseResult.MaxFlow
 			continue
 		}
 
+		for id, flow := range edgeFlows(modResult) {
+			if flow > baseFlows[id]+flowTolerance {
+				result.backupEdges[id] = true
+			}
+		}
+
 		reduction := baseResult.MaxFlow - modResult.MaxFlow
+		result.reductions[i] = reduction
 		if reduction > worstFlowReduction {
 			worstFlowReduction = reduction
-			result.analysis.MostCriticalEdge = &commonv1.EdgeKey{
-				From: edge.From,
-				To:   edge.To,
-			}
+			result.analysis.MostCriticalEdge = key
 		}
 	}
 
@@ -141,47 +179,27 @@ func (e *ResilienceEngine) performN1Analysis(
 		result.redundancyLevel = float64(len(graph.Edges)) / float64(len(graph.Nodes))
 	}
 
-	result.minCutSize = e.estimateMinCut(graph, scenariosFailed)
+	result.minCutEdges = minCutEdgeKeys(baseResult.MinCut)
+	result.minCutSize = int32(len(result.minCutEdges))
 	result.overallScore = (result.connectivityRobustness + result.flowRobustness) / 2
 
 	return result
 }
 
-func (e *ResilienceEngine) removeEdge(g *commonv1.Graph, from, to int64) *commonv1.Graph {
+func (e *ResilienceEngine) removeEdge(g *commonv1.Graph, key *commonv1.EdgeKey) *commonv1.Graph {
 	clone := CloneGraph(g)
-	newEdges := make([]*commonv1.Edge, 0, len(clone.Edges)-1)
-	for _, edge := range clone.Edges {
-		if edge.From != from || edge.To != to {
-			newEdges = append(newEdges, edge)
-		}
-	}
-	clone.Edges = newEdges
-	return clone
+	AssignEdgeIDs(clone)
+	return removeEdge(clone, key)
 }
 
-func (e *ResilienceEngine) estimateMinCut(graph *commonv1.Graph, failedScenarios int) int32 {
-	if failedScenarios > 0 {
-		return 1
+// minCutEdgeKeys возвращает рёбра минимального разреза, вычисленного solver'ом
+// по итоговой остаточной сети. Пусто, если разрез не был получен.
+func minCutEdgeKeys(cut *commonv1.MinCut) []*commonv1.EdgeKey {
+	keys := make([]*commonv1.EdgeKey, 0, len(cut.GetEdges()))
+	for _, edge := range cut.GetEdges() {
+		keys = append(keys, &commonv1.EdgeKey{From: edge.From, To: edge.To, EdgeId: edge.EdgeId})
 	}
-
-	minDegree := len(graph.Edges)
-	degree := make(map[int64]int)
-
-	for _, edge := range graph.Edges {
-		degree[edge.From]++
-		degree[edge.To]++
-	}
-
-	for _, node := range graph.Nodes {
-		if node.Id == graph.SourceId || node.Id == graph.SinkId {
-			continue
-		}
-		if d := degree[node.Id]; d < minDegree && d > 0 {
-			minDegree = d
-		}
-	}
-
-	return int32(minDegree)
+	return keys
 }
 
 func (e *ResilienceEngine) identifyWeaknesses(n1 *n1Result, graph *commonv1.Graph) []*simulationv1.ResilienceWeakness {
@@ -205,7 +223,8 @@ func (e *ResilienceEngine) identifyWeaknesses(n1 *n1Result, graph *commonv1.Grap
 			Type:                 simulationv1.WeaknessType_WEAKNESS_TYPE_CAPACITY_BOTTLENECK,
 			Description:          "Низкая устойчивость потока к единичным отказам",
 			Severity:             1.0 - n1.flowRobustness,
-			MitigationSuggestion: "Увеличьте пропускную способность резервных маршрутов",
+			AffectedEdges:        n1.minCutEdges,
+			MitigationSuggestion: "Увеличьте пропускную способность рёбер минимального разреза и резервных маршрутов",
 		})
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonv1 "logistics/gen/go/logistics/common/v1"
+	optimizationv1 "logistics/gen/go/logistics/optimization/v1"
 	simulationv1 "logistics/gen/go/logistics/simulation/v1"
 	"logistics/pkg/client"
 )
@@ -23,6 +24,7 @@ type ResilienceMockSolver struct {
 	baseFlow       float64
 	failOnEdge     map[string]bool    // edge key -> should fail
 	reduceFlowEdge map[string]float64 // edge key -> flow reduction
+	minCut         *commonv1.MinCut   // returned when opts request the min cut
 	returnError    bool
 }
 
@@ -68,11 +70,17 @@ func (m *ResilienceMockSolver) Solve(ctx context.Context, graph *commonv1.Graph,
 		}
 	}
 
+	var minCut *commonv1.MinCut
+	if o, ok := opts.(*optimizationv1.SolveOptions); ok && o.GetReturnMinCut() {
+		minCut = m.minCut
+	}
+
 	return &client.SolveResult{
 		MaxFlow:   flow,
 		TotalCost: flow * 0.5,
 		Status:    commonv1.FlowStatus_FLOW_STATUS_OPTIMAL,
 		Graph:     graph,
+		MinCut:    minCut,
 	}, nil
 }
 
@@ -227,7 +235,7 @@ func TestResilienceEngine_RemoveEdge(t *testing.T) {
 	engine := NewResilienceEngine(nil)
 	graph := createResilienceTestGraph()
 
-	result := engine.removeEdge(graph, 1, 2)
+	result := engine.removeEdge(graph, &commonv1.EdgeKey{From: 1, To: 2})
 
 	// Проверяем что ребро удалено
 	assert.Equal(t, 3, len(result.Edges))
@@ -243,29 +251,55 @@ func TestResilienceEngine_RemoveEdge_NonExistent(t *testing.T) {
 	engine := NewResilienceEngine(nil)
 	graph := createResilienceTestGraph()
 
-	result := engine.removeEdge(graph, 99, 100)
+	result := engine.removeEdge(graph, &commonv1.EdgeKey{From: 99, To: 100})
 
 	// Ничего не удалено
 	assert.Equal(t, 4, len(result.Edges))
 }
 
 // ============================================================
-// ESTIMATE MIN CUT TESTS
+// MIN CUT TESTS
 // ============================================================
 
-func TestResilienceEngine_EstimateMinCut(t *testing.T) {
-	engine := NewResilienceEngine(nil)
+func TestResilienceEngine_AnalyzeResilience_MinCut(t *testing.T) {
+	ctx := context.Background()
 
-	t.Run("with_failed_scenarios", func(t *testing.T) {
-		graph := createResilienceTestGraph()
-		minCut := engine.estimateMinCut(graph, 1)
-		assert.Equal(t, int32(1), minCut)
+	t.Run("from_solver", func(t *testing.T) {
+		mockSolver := NewResilienceMockSolver()
+		mockSolver.reduceFlowEdge["2->4"] = 50
+		mockSolver.minCut = &commonv1.MinCut{
+			SourceSide: []int64{1, 2, 3},
+			Edges: []*commonv1.CutEdge{
+				{From: 2, To: 4, EdgeId: 3, Capacity: 50},
+				{From: 3, To: 4, EdgeId: 4, Capacity: 50},
+			},
+			Value: 100,
+		}
+		engine := NewResilienceEngine(mockSolver)
+
+		result, err := engine.AnalyzeResilience(ctx, createResilienceTestGraph(), nil, commonv1.Algorithm_ALGORITHM_DINIC)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), result.Metrics.MinCutSize)
+
+		var bottleneck *simulationv1.ResilienceWeakness
+		for _, w := range result.Weaknesses {
+			if w.Type == simulationv1.WeaknessType_WEAKNESS_TYPE_CAPACITY_BOTTLENECK {
+				bottleneck = w
+			}
+		}
+		require.NotNil(t, bottleneck)
+		require.Len(t, bottleneck.AffectedEdges, 2)
+		assert.Equal(t, &commonv1.EdgeKey{From: 2, To: 4, EdgeId: 3}, bottleneck.AffectedEdges[0])
 	})
 
-	t.Run("no_failed_scenarios", func(t *testing.T) {
-		graph := createResilienceTestGraph()
-		minCut := engine.estimateMinCut(graph, 0)
-		assert.Greater(t, minCut, int32(0))
+	t.Run("not_returned", func(t *testing.T) {
+		engine := NewResilienceEngine(NewResilienceMockSolver())
+
+		result, err := engine.AnalyzeResilience(ctx, createResilienceTestGraph(), nil, commonv1.Algorithm_ALGORITHM_DINIC)
+
+		require.NoError(t, err)
+		assert.Zero(t, result.Metrics.MinCutSize)
 	})
 }
 
@@ -0,0 +1,482 @@
+// services/simulation-svc/internal/engine/sampling.go
+package engine
+
+import (
+	"math"
+	"math/rand"
+
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+// stratifiedReplicates число независимых реплик латинского гиперкуба и
+// последовательности Соболя: по разбросу средних реплик оценивается точность
+// среднего, которую формула для независимых сэмплов переоценивает
+const stratifiedReplicates = 10
+
+// unitEpsilon отступ от границ (0,1), где квантили нормального и
+// экспоненциального распределений бесконечны
+const unitEpsilon = 1e-12
+
+// sampler выдаёт точку единичного гиперкуба (0,1)^d для итерации прогона:
+// координата i — уровень квантиля распределения i-й неопределённости
+type sampler interface {
+	// point возвращает точку итерации; безопасен для конкурентного вызова
+	point(iteration int) []float64
+
+	// group возвращает номер группы итерации. Средние разных групп
+	// независимы, по их разбросу оценивается точность общего среднего
+	group(iteration int) int
+}
+
+// samplingMethod возвращает метод сэмплирования прогона
+func (e *MonteCarloEngine) samplingMethod() simulationv1.SamplingMethod {
+	if e.config == nil {
+		return simulationv1.SamplingMethod_SAMPLING_METHOD_RANDOM
+	}
+	switch e.config.SamplingMethod {
+	case simulationv1.SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE,
+		simulationv1.SamplingMethod_SAMPLING_METHOD_SOBOL,
+		simulationv1.SamplingMethod_SAMPLING_METHOD_ANTITHETIC:
+		return e.config.SamplingMethod
+	default:
+		return simulationv1.SamplingMethod_SAMPLING_METHOD_RANDOM
+	}
+}
+
+// newSampler создаёт сэмплер для dims неопределённостей; nil для
+// независимого случайного сэмплирования
+func (e *MonteCarloEngine) newSampler(dims int) sampler {
+	switch e.samplingMethod() {
+	case simulationv1.SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE:
+		return newLatinHypercubeSampler(e, dims)
+	case simulationv1.SamplingMethod_SAMPLING_METHOD_SOBOL:
+		return newSobolSampler(e, dims)
+	case simulationv1.SamplingMethod_SAMPLING_METHOD_ANTITHETIC:
+		return &antitheticSampler{engine: e, dims: dims}
+	default:
+		return nil
+	}
+}
+
+// sampleIteration сэмплирует множители неопределённостей итерации
+func (e *MonteCarloEngine) sampleIteration(s sampler, uncertainties []*simulationv1.UncertaintySpec, iteration int) []float64 {
+	if s == nil {
+		return e.sampleUncertainties(uncertainties, e.iterationRand(iteration))
+	}
+
+	point := s.point(iteration)
+	multipliers := make([]float64, len(uncertainties))
+	for i, u := range uncertainties {
+		multipliers[i] = distributionQuantile(u.Distribution, point[i])
+	}
+	return multipliers
+}
+
+// distributionQuantile возвращает значение распределения на уровне p ∈ (0,1)
+// (обратная функция распределения)
+func distributionQuantile(dist *simulationv1.Distribution, p float64) float64 {
+	if dist == nil {
+		return 1.0
+	}
+
+	switch dist.Type {
+	case simulationv1.DistributionType_DISTRIBUTION_TYPE_NORMAL:
+		return dist.Param1 + dist.Param2*normalInverse(clampUnit(p))
+
+	case simulationv1.DistributionType_DISTRIBUTION_TYPE_UNIFORM:
+		return dist.Param1 + p*(dist.Param2-dist.Param1)
+
+	case simulationv1.DistributionType_DISTRIBUTION_TYPE_TRIANGULAR:
+		min, max, mode := dist.Param1, dist.Param2, dist.Param3
+		fc := (mode - min) / (max - min)
+		if p < fc {
+			return min + math.Sqrt(p*(max-min)*(mode-min))
+		}
+		return max - math.Sqrt((1-p)*(max-min)*(max-mode))
+
+	case simulationv1.DistributionType_DISTRIBUTION_TYPE_LOGNORMAL:
+		return math.Exp(dist.Param1 + dist.Param2*normalInverse(clampUnit(p)))
+
+	case simulationv1.DistributionType_DISTRIBUTION_TYPE_EXPONENTIAL:
+		return -math.Log(1-clampUnit(p)) * dist.Param1
+
+	default:
+		return 1.0
+	}
+}
+
+func clampUnit(p float64) float64 {
+	return math.Min(math.Max(p, unitEpsilon), 1-unitEpsilon)
+}
+
+// streamRand возвращает RNG потока stream, выведенного из seed
+// перемешиванием SplitMix64, так что соседние потоки независимы
+func streamRand(seed int64, stream uint64) *rand.Rand {
+	z := uint64(seed) + stream*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	return rand.New(rand.NewSource(int64(z)))
+}
+
+// samplerSalt отделяет потоки сэмплеров от потоков итераций
+const samplerSalt = 0x5851f42d4c957f2d
+
+// =============================================================================
+// Реплики
+// =============================================================================
+
+// replicates делит итерации 0..n-1 на r последовательных реплик, размеры
+// которых отличаются не больше чем на единицу
+type replicates struct {
+	n, r int
+}
+
+func newReplicates(n int) replicates {
+	r := min(stratifiedReplicates, n/2)
+	return replicates{n: n, r: max(r, 1)}
+}
+
+// of возвращает реплику итерации, её номер внутри реплики и размер реплики
+func (p replicates) of(iteration int) (replicate, index, size int) {
+	replicate = iteration * p.r / p.n
+	start := p.start(replicate)
+	return replicate, iteration - start, p.start(replicate+1) - start
+}
+
+func (p replicates) start(replicate int) int {
+	return (replicate*p.n + p.r - 1) / p.r
+}
+
+// =============================================================================
+// Латинский гиперкуб
+// =============================================================================
+
+// latinHypercubeSampler делит каждое измерение реплики на size равных страт и
+// выдаёт в каждую страту ровно одну итерацию; положение внутри страты
+// случайно. Страты измерений переставлены независимо.
+type latinHypercubeSampler struct {
+	engine *MonteCarloEngine
+	layout replicates
+	dims   int
+	strata [][]int32 // strata[replicate*dims+dim][index]
+}
+
+func newLatinHypercubeSampler(e *MonteCarloEngine, dims int) *latinHypercubeSampler {
+	layout := newReplicates(e.numIterations())
+	s := &latinHypercubeSampler{
+		engine: e,
+		layout: layout,
+		dims:   dims,
+		strata: make([][]int32, layout.r*dims),
+	}
+
+	for replicate := 0; replicate < layout.r; replicate++ {
+		size := layout.start(replicate+1) - layout.start(replicate)
+		rng := streamRand(e.seed^samplerSalt, uint64(replicate))
+		for dim := 0; dim < dims; dim++ {
+			perm := make([]int32, size)
+			for i, j := range rng.Perm(size) {
+				perm[i] = int32(j)
+			}
+			s.strata[replicate*dims+dim] = perm
+		}
+	}
+	return s
+}
+
+func (s *latinHypercubeSampler) point(iteration int) []float64 {
+	replicate, index, size := s.layout.of(iteration)
+	rng := s.engine.iterationRand(iteration)
+
+	point := make([]float64, s.dims)
+	for dim := range point {
+		stratum := s.strata[replicate*s.dims+dim][index]
+		point[dim] = (float64(stratum) + rng.Float64()) / float64(size)
+	}
+	return point
+}
+
+func (s *latinHypercubeSampler) group(iteration int) int {
+	replicate, _, _ := s.layout.of(iteration)
+	return replicate
+}
+
+// =============================================================================
+// Последовательность Соболя
+// =============================================================================
+
+// sobolBits разрядность точек последовательности
+const sobolBits = 32
+
+// sobolSampler выдаёт точки последовательности Соболя, каждая реплика — со
+// своим случайным цифровым сдвигом (XOR координат со случайным словом).
+// Сдвиг сохраняет равномерность сети и делает оценку несмещённой. Лучше всего
+// работает, когда размер реплики — степень двойки.
+type sobolSampler struct {
+	layout     replicates
+	directions [][sobolBits]uint32
+	shifts     [][]uint32 // shifts[replicate][dim]
+}
+
+func newSobolSampler(e *MonteCarloEngine, dims int) *sobolSampler {
+	layout := newReplicates(e.numIterations())
+	s := &sobolSampler{
+		layout:     layout,
+		directions: sobolDirections(dims),
+		shifts:     make([][]uint32, layout.r),
+	}
+
+	for replicate := range s.shifts {
+		rng := streamRand(e.seed^samplerSalt, uint64(layout.r+replicate))
+		s.shifts[replicate] = make([]uint32, dims)
+		for dim := range s.shifts[replicate] {
+			s.shifts[replicate][dim] = rng.Uint32()
+		}
+	}
+	return s
+}
+
+func (s *sobolSampler) point(iteration int) []float64 {
+	replicate, index, _ := s.layout.of(iteration)
+	return sobolPoint(s.directions, s.shifts[replicate], index)
+}
+
+// sobolPoint возвращает точку index последовательности Соболя с цифровым
+// сдвигом shift
+func sobolPoint(directions [][sobolBits]uint32, shift []uint32, index int) []float64 {
+	point := make([]float64, len(directions))
+	for dim := range point {
+		x := shift[dim]
+		for bit, i := 0, uint32(index); i > 0; bit, i = bit+1, i>>1 {
+			if i&1 == 1 {
+				x ^= directions[dim][bit]
+			}
+		}
+		point[dim] = (float64(x) + 0.5) / (1 << sobolBits)
+	}
+	return point
+}
+
+func (s *sobolSampler) group(iteration int) int {
+	replicate, _, _ := s.layout.of(iteration)
+	return replicate
+}
+
+// sobolPolynomial примитивный многочлен над GF(2) степени degree с
+// коэффициентами средних членов coeffs и начальные направляющие числа m
+type sobolPolynomial struct {
+	degree int
+	coeffs uint32
+	m      []uint32
+}
+
+// sobolInitial начальные направляющие числа Джо–Куо (new-joe-kuo-6.21201)
+// для измерений 2..21; остальные измерения получают случайные нечётные
+// начальные числа (последовательность остаётся последовательностью Соболя,
+// но хуже по равномерности проекций)
+var sobolInitial = []sobolPolynomial{
+	{1, 0, []uint32{1}},
+	{2, 1, []uint32{1, 3}},
+	{3, 1, []uint32{1, 3, 1}},
+	{3, 2, []uint32{1, 1, 1}},
+	{4, 1, []uint32{1, 1, 3, 3}},
+	{4, 4, []uint32{1, 3, 5, 13}},
+	{5, 2, []uint32{1, 1, 5, 5, 17}},
+	{5, 4, []uint32{1, 1, 5, 5, 5}},
+	{5, 7, []uint32{1, 1, 7, 11, 19}},
+	{5, 11, []uint32{1, 1, 5, 1, 1}},
+	{5, 13, []uint32{1, 1, 1, 3, 11}},
+	{5, 14, []uint32{1, 3, 5, 5, 31}},
+	{6, 1, []uint32{1, 3, 3, 9, 7, 49}},
+	{6, 13, []uint32{1, 1, 1, 15, 21, 21}},
+	{6, 16, []uint32{1, 3, 1, 13, 27, 49}},
+	{6, 19, []uint32{1, 1, 1, 15, 7, 5}},
+	{6, 22, []uint32{1, 3, 1, 15, 13, 25}},
+	{6, 25, []uint32{1, 1, 5, 5, 19, 61}},
+	{7, 1, []uint32{1, 3, 7, 11, 23, 15, 103}},
+	{7, 4, []uint32{1, 3, 7, 13, 13, 15, 69}},
+}
+
+// sobolDirectionsSeed seed начальных чисел измерений сверх таблицы; не
+// зависит от seed прогона, чтобы последовательность была одной и той же
+const sobolDirectionsSeed = 21201
+
+// sobolDirections возвращает направляющие числа первых dims измерений
+func sobolDirections(dims int) [][sobolBits]uint32 {
+	directions := make([][sobolBits]uint32, dims)
+	if dims == 0 {
+		return directions
+	}
+
+	// Первое измерение — последовательность ван дер Корпута
+	for bit := 0; bit < sobolBits; bit++ {
+		directions[0][bit] = 1 << (sobolBits - 1 - bit)
+	}
+
+	polynomials := sobolPolynomials(dims - 1)
+	for dim := 1; dim < dims; dim++ {
+		p := polynomials[dim-1]
+		v := &directions[dim]
+		for bit := 0; bit < sobolBits; bit++ {
+			if bit < p.degree {
+				v[bit] = p.m[bit] << (sobolBits - 1 - bit)
+				continue
+			}
+			v[bit] = v[bit-p.degree] ^ (v[bit-p.degree] >> p.degree)
+			for k := 1; k < p.degree; k++ {
+				if (p.coeffs>>(p.degree-1-k))&1 == 1 {
+					v[bit] ^= v[bit-k]
+				}
+			}
+		}
+	}
+	return directions
+}
+
+// sobolPolynomials возвращает count многочленов: таблицу Джо–Куо и далее
+// следующие по порядку (степень, коэффициенты) примитивные многочлены
+func sobolPolynomials(count int) []sobolPolynomial {
+	polynomials := make([]sobolPolynomial, 0, count)
+	polynomials = append(polynomials, sobolInitial[:min(count, len(sobolInitial))]...)
+	if len(polynomials) == count {
+		return polynomials
+	}
+
+	rng := rand.New(rand.NewSource(sobolDirectionsSeed))
+	last := sobolInitial[len(sobolInitial)-1]
+	degree, coeffs := last.degree, last.coeffs+1
+	for len(polynomials) < count && degree < sobolBits {
+		if coeffs >= 1<<(degree-1) {
+			degree, coeffs = degree+1, 0
+			continue
+		}
+		if isPrimitivePolynomial(degree, coeffs) {
+			m := make([]uint32, degree)
+			for k := range m {
+				// Нечётное число меньше 2^(k+1)
+				m[k] = uint32(rng.Int63n(1<<k))<<1 | 1
+			}
+			polynomials = append(polynomials, sobolPolynomial{degree: degree, coeffs: coeffs, m: m})
+		}
+		coeffs++
+	}
+	return polynomials
+}
+
+// isPrimitivePolynomial проверяет, что x^degree + (средние члены coeffs) + 1
+// примитивен над GF(2): порядок x по его модулю равен 2^degree - 1
+func isPrimitivePolynomial(degree int, coeffs uint32) bool {
+	poly := uint64(1)<<degree | uint64(coeffs)<<1 | 1
+	period := uint64(1)<<degree - 1
+
+	x := uint64(1)
+	for k := uint64(1); k <= period; k++ {
+		x <<= 1
+		if x>>degree&1 == 1 {
+			x ^= poly
+		}
+		if x == 1 {
+			return k == period
+		}
+	}
+	return false
+}
+
+// =============================================================================
+// Антитетические пары
+// =============================================================================
+
+// antitheticSampler составляет пары итераций 2k и 2k+1: первая получает
+// случайную точку u, вторая — зеркальную 1-u. Отрицательная корреляция
+// внутри пары уменьшает дисперсию среднего для монотонных откликов.
+type antitheticSampler struct {
+	engine *MonteCarloEngine
+	dims   int
+}
+
+func (s *antitheticSampler) point(iteration int) []float64 {
+	rng := s.engine.iterationRand(iteration &^ 1)
+
+	point := make([]float64, s.dims)
+	for dim := range point {
+		u := rng.Float64()
+		if iteration&1 == 1 {
+			u = 1 - u
+		}
+		point[dim] = u
+	}
+	return point
+}
+
+func (s *antitheticSampler) group(iteration int) int {
+	return iteration / 2
+}
+
+// =============================================================================
+// Точность среднего
+// =============================================================================
+
+// groupedMeanVariance оценивает дисперсию общего среднего values по разбросу
+// средних групп (кластерная оценка, допускает группы разного размера).
+// ok=false, если групп меньше двух.
+func groupedMeanVariance(values []float64, groups []int) (variance float64, ok bool) {
+	type groupSum struct {
+		sum   float64
+		count int
+	}
+	sums := make(map[int]*groupSum)
+	total := 0.0
+	for i, v := range values {
+		g, exists := sums[groups[i]]
+		if !exists {
+			g = &groupSum{}
+			sums[groups[i]] = g
+		}
+		g.sum += v
+		g.count++
+		total += v
+	}
+	if len(sums) < 2 {
+		return 0, false
+	}
+
+	n := float64(len(values))
+	mean := total / n
+	for _, g := range sums {
+		// Вклад группы в отклонение общего среднего
+		d := (g.sum - float64(g.count)*mean) / n
+		variance += d * d
+	}
+	numGroups := float64(len(sums))
+	return variance * numGroups / (numGroups - 1), true
+}
+
+// applyGroupedPrecision заменяет доверительный интервал и эффективный размер
+// выборки stats оценками по средним групп
+func applyGroupedPrecision(stats *simulationv1.MonteCarloStats, values []float64, groups []int, confidenceLevel float64) {
+	meanVariance, ok := groupedMeanVariance(values, groups)
+	if !ok {
+		return
+	}
+
+	marginOfError := zScore(confidenceLevel) * math.Sqrt(meanVariance)
+	stats.ConfidenceIntervalLow = stats.Mean - marginOfError
+	stats.ConfidenceIntervalHigh = stats.Mean + marginOfError
+
+	stats.RelativeCiHalfWidth = relativeHalfWidth(marginOfError, stats.Mean)
+
+	// При нулевой оценке дисперсии (постоянный отклик) выигрыш не определён
+	if meanVariance > 0 && stats.Variance > 0 {
+		stats.EffectiveSampleSize = stats.Variance / meanVariance
+	}
+}
+
+// applySamplingPrecision уточняет точность среднего stats для методов с
+// зависимыми сэмплами: для стратифицированных и антитетических методов она
+// оценивается по независимым группам
+func (e *MonteCarloEngine) applySamplingPrecision(stats *simulationv1.MonteCarloStats, values []float64, groups []int) {
+	if e.samplingMethod() != simulationv1.SamplingMethod_SAMPLING_METHOD_RANDOM {
+		applyGroupedPrecision(stats, values, groups, e.config.ConfidenceLevel)
+	}
+}
@@ -0,0 +1,242 @@
+// services/simulation-svc/internal/engine/sampling_test.go
+package engine
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	simulationv1 "logistics/gen/go/logistics/simulation/v1"
+)
+
+func newSamplingEngine(method simulationv1.SamplingMethod, iterations int32) *MonteCarloEngine {
+	return NewMonteCarloEngine(&simulationv1.MonteCarloConfig{
+		NumIterations:  iterations,
+		RandomSeed:     42,
+		SamplingMethod: method,
+	}, capacitySolver{})
+}
+
+func TestDistributionQuantile(t *testing.T) {
+	tests := []struct {
+		name string
+		dist *simulationv1.Distribution
+		p    float64
+		want float64
+	}{
+		{"normal_median", &simulationv1.Distribution{Type: simulationv1.DistributionType_DISTRIBUTION_TYPE_NORMAL, Param1: 1, Param2: 0.2}, 0.5, 1},
+		{"normal_upper", &simulationv1.Distribution{Type: simulationv1.DistributionType_DISTRIBUTION_TYPE_NORMAL, Param1: 0, Param2: 1}, 0.975, 1.959964},
+		{"uniform", &simulationv1.Distribution{Type: simulationv1.DistributionType_DISTRIBUTION_TYPE_UNIFORM, Param1: 0.5, Param2: 1.5}, 0.25, 0.75},
+		{"triangular_mode", &simulationv1.Distribution{Type: simulationv1.DistributionType_DISTRIBUTION_TYPE_TRIANGULAR, Param1: 0, Param2: 2, Param3: 1}, 0.5, 1},
+		{"lognormal_median", &simulationv1.Distribution{Type: simulationv1.DistributionType_DISTRIBUTION_TYPE_LOGNORMAL, Param1: 0, Param2: 0.5}, 0.5, 1},
+		{"exponential", &simulationv1.Distribution{Type: simulationv1.DistributionType_DISTRIBUTION_TYPE_EXPONENTIAL, Param1: 2}, 1 - 1/math.E, 2},
+		{"nil", nil, 0.3, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, distributionQuantile(tt.dist, tt.p), 1e-6)
+		})
+	}
+
+	// Границы интервала не дают бесконечностей
+	normal := &simulationv1.Distribution{Type: simulationv1.DistributionType_DISTRIBUTION_TYPE_NORMAL, Param1: 1, Param2: 0.2}
+	assert.False(t, math.IsInf(distributionQuantile(normal, 0), 0))
+	assert.False(t, math.IsInf(distributionQuantile(normal, 1), 0))
+}
+
+func TestReplicates(t *testing.T) {
+	layout := newReplicates(25)
+	require.Equal(t, 10, layout.r)
+
+	sizes := make(map[int]int)
+	prev := -1
+	for it := 0; it < 25; it++ {
+		replicate, index, size := layout.of(it)
+		assert.GreaterOrEqual(t, replicate, prev)
+		assert.Less(t, index, size)
+		sizes[replicate] = size
+		prev = replicate
+	}
+	require.Len(t, sizes, 10)
+	total := 0
+	for _, size := range sizes {
+		assert.Contains(t, []int{2, 3}, size)
+		total += size
+	}
+	assert.Equal(t, 25, total)
+}
+
+func TestLatinHypercubeSampler_StratifiesEachDimension(t *testing.T) {
+	const iterations, dims = 40, 3
+	s := newLatinHypercubeSampler(newSamplingEngine(simulationv1.SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE, iterations), dims)
+
+	// 10 реплик по 4 итерации: в каждой реплике каждое измерение
+	// попадает в каждую из 4 страт ровно один раз
+	seen := make(map[[3]int]int)
+	for it := 0; it < iterations; it++ {
+		replicate, _, size := s.layout.of(it)
+		require.Equal(t, 4, size)
+		for dim, u := range s.point(it) {
+			require.True(t, u > 0 && u < 1)
+			seen[[3]int{replicate, dim, int(u * float64(size))}]++
+		}
+	}
+	assert.Len(t, seen, 10*dims*4)
+	for key, count := range seen {
+		assert.Equal(t, 1, count, "replicate %d, dim %d, stratum %d", key[0], key[1], key[2])
+	}
+}
+
+func TestSobolPolynomials_MatchPrimitiveEnumeration(t *testing.T) {
+	// Таблица Джо–Куо идёт по порядку примитивных многочленов
+	var enumerated [][2]int
+	for degree := 1; len(enumerated) < len(sobolInitial); degree++ {
+		for coeffs := 0; coeffs < 1<<(degree-1); coeffs++ {
+			if isPrimitivePolynomial(degree, uint32(coeffs)) {
+				enumerated = append(enumerated, [2]int{degree, coeffs})
+			}
+		}
+	}
+
+	polynomials := sobolPolynomials(60)
+	require.Len(t, polynomials, 60)
+	for i, p := range polynomials {
+		if i < len(sobolInitial) {
+			assert.Equal(t, enumerated[i], [2]int{p.degree, int(p.coeffs)}, "polynomial %d", i)
+		} else {
+			assert.True(t, isPrimitivePolynomial(p.degree, p.coeffs), "polynomial %d", i)
+		}
+		require.Len(t, p.m, p.degree)
+		for k, m := range p.m {
+			assert.Equal(t, uint32(1), m&1, "m must be odd")
+			assert.Less(t, m, uint32(1)<<(k+1))
+		}
+	}
+}
+
+func TestSobolDirections_BalancedProjections(t *testing.T) {
+	const dims, points = 30, 16
+	directions := sobolDirections(dims)
+
+	point := func(index, dim int) uint32 {
+		x := uint32(0)
+		for bit, i := 0, uint32(index); i > 0; bit, i = bit+1, i>>1 {
+			if i&1 == 1 {
+				x ^= directions[dim][bit]
+			}
+		}
+		return x
+	}
+
+	// Первые 16 точек каждого измерения — по одной в каждой из 16 страт
+	for dim := 0; dim < dims; dim++ {
+		strata := make(map[uint32]bool)
+		for i := 0; i < points; i++ {
+			strata[point(i, dim)>>28] = true
+		}
+		assert.Len(t, strata, points, "dimension %d", dim)
+	}
+
+	// Первые два измерения — по одной точке в каждой клетке сетки 4×4
+	cells := make(map[[2]uint32]bool)
+	for i := 0; i < points; i++ {
+		cells[[2]uint32{point(i, 0) >> 30, point(i, 1) >> 30}] = true
+	}
+	assert.Len(t, cells, points)
+}
+
+func TestSobolSampler_ReplicatesAreShifted(t *testing.T) {
+	s := newSobolSampler(newSamplingEngine(simulationv1.SamplingMethod_SAMPLING_METHOD_SOBOL, 40), 2)
+
+	// Первые точки реплик — это их сдвиги, они различаются
+	first := s.point(0)
+	second := s.point(4)
+	assert.Equal(t, 0, s.group(0))
+	assert.Equal(t, 1, s.group(4))
+	assert.NotEqual(t, first, second)
+	for _, u := range append(first, second...) {
+		assert.True(t, u > 0 && u < 1)
+	}
+}
+
+func TestAntitheticSampler_MirrorsPairs(t *testing.T) {
+	s := &antitheticSampler{engine: newSamplingEngine(simulationv1.SamplingMethod_SAMPLING_METHOD_ANTITHETIC, 10), dims: 3}
+
+	for pair := 0; pair < 5; pair++ {
+		u, v := s.point(2*pair), s.point(2*pair+1)
+		for dim := range u {
+			assert.InDelta(t, 1, u[dim]+v[dim], 1e-12)
+		}
+		assert.Equal(t, s.group(2*pair), s.group(2*pair+1))
+	}
+	assert.NotEqual(t, s.point(0), s.point(2))
+}
+
+func TestGroupedMeanVariance(t *testing.T) {
+	// Группы с одинаковым средним: среднее известно точно
+	variance, ok := groupedMeanVariance([]float64{1, 3, 2, 2}, []int{0, 0, 1, 1})
+	require.True(t, ok)
+	assert.InDelta(t, 0, variance, 1e-12)
+
+	// Одна группа — оценки нет
+	_, ok = groupedMeanVariance([]float64{1, 3}, []int{0, 0})
+	assert.False(t, ok)
+
+	// Группы по одному значению — обычная оценка s²/n
+	values := []float64{1, 2, 3, 4}
+	variance, ok = groupedMeanVariance(values, []int{0, 1, 2, 3})
+	require.True(t, ok)
+	assert.InDelta(t, (5.0/3)/4, variance, 1e-12)
+}
+
+func TestMonteCarloEngine_Run_SamplingMethods(t *testing.T) {
+	run := func(method simulationv1.SamplingMethod) *simulationv1.RunMonteCarloResponse {
+		engine := NewMonteCarloEngine(&simulationv1.MonteCarloConfig{
+			NumIterations:  320,
+			RandomSeed:     11,
+			SamplingMethod: method,
+			ReturnSamples:  true,
+		}, capacitySolver{})
+		result, err := engine.Run(context.Background(), createMonteCarloTestGraph(), monteCarloUncertainties(), commonv1.Algorithm_ALGORITHM_DINIC, nil)
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		return result
+	}
+
+	random := run(simulationv1.SamplingMethod_SAMPLING_METHOD_RANDOM)
+	assert.Equal(t, 320.0, random.FlowStats.EffectiveSampleSize)
+	randomWidth := random.FlowStats.ConfidenceIntervalHigh - random.FlowStats.ConfidenceIntervalLow
+
+	// Средний поток: 50·1.0 + 50·1.0
+	for _, method := range []simulationv1.SamplingMethod{
+		simulationv1.SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE,
+		simulationv1.SamplingMethod_SAMPLING_METHOD_SOBOL,
+		simulationv1.SamplingMethod_SAMPLING_METHOD_ANTITHETIC,
+	} {
+		t.Run(method.String(), func(t *testing.T) {
+			result := run(method)
+			stats := result.FlowStats
+
+			assert.InDelta(t, 100, stats.Mean, 0.5)
+			assert.Greater(t, stats.EffectiveSampleSize, 320.0)
+			assert.Less(t, stats.ConfidenceIntervalHigh-stats.ConfidenceIntervalLow, randomWidth)
+			assert.LessOrEqual(t, stats.ConfidenceIntervalLow, stats.Mean)
+
+			// Маргинальные распределения сохраняются
+			var sum float64
+			for _, s := range result.Samples {
+				sum += s.Multipliers[1]
+				assert.True(t, s.Multipliers[1] >= 0.5 && s.Multipliers[1] <= 1.5)
+			}
+			assert.InDelta(t, 1.0, sum/320, 0.01)
+
+			// Воспроизводимость
+			assert.Equal(t, result.Samples, run(method).Samples)
+		})
+	}
+}
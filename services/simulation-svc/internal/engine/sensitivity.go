@@ -39,6 +39,13 @@ func (e *SensitivityEngine) AnalyzeSensitivity(
 		return nil, err
 	}
 
+	switch config.GetMethod() {
+	case simulationv1.SensitivityMethod_SENSITIVITY_METHOD_MORRIS:
+		return e.newGlobalSensitivity(graph, params, config, algorithm, baseResult).morris(ctx)
+	case simulationv1.SensitivityMethod_SENSITIVITY_METHOD_SOBOL:
+		return e.newGlobalSensitivity(graph, params, config, algorithm, baseResult).sobol(ctx)
+	}
+
 	results := make([]*simulationv1.SensitivityResult, 0, len(params))
 	rankings := make([]*simulationv1.ParameterRanking, 0, len(params))
 	var thresholds []*simulationv1.ThresholdPoint
@@ -56,14 +63,7 @@ func (e *SensitivityEngine) AnalyzeSensitivity(
 		})
 	}
 
-	// Сортируем rankings
-	sort.Slice(rankings, func(i, j int) bool {
-		return rankings[i].SensitivityIndex > rankings[j].SensitivityIndex
-	})
-	for i := range rankings {
-		rankings[i].Rank = int32(i + 1)
-		rankings[i].Description = e.describeRank(rankings[i])
-	}
+	e.rankParameters(rankings)
 
 	return &simulationv1.AnalyzeSensitivityResponse{
 		Success:          true,
@@ -73,6 +73,17 @@ func (e *SensitivityEngine) AnalyzeSensitivity(
 	}, nil
 }
 
+// rankParameters сортирует рейтинг по убыванию индекса и нумерует места
+func (e *SensitivityEngine) rankParameters(rankings []*simulationv1.ParameterRanking) {
+	sort.SliceStable(rankings, func(i, j int) bool {
+		return rankings[i].SensitivityIndex > rankings[j].SensitivityIndex
+	})
+	for i := range rankings {
+		rankings[i].Rank = int32(i + 1)
+		rankings[i].Description = e.describeRank(rankings[i])
+	}
+}
+
 func (e *SensitivityEngine) buildParamID(param *simulationv1.SensitivityParameter) string {
 	if param.Edge != nil {
 		return fmt.Sprintf("edge_%d_%d_%s", param.Edge.From, param.Edge.To, param.Target.String())
@@ -96,14 +107,7 @@ func (e *SensitivityEngine) analyzeParameter(
 		numSteps = 10
 	}
 
-	minMult := param.MinMultiplier
-	maxMult := param.MaxMultiplier
-	if minMult == 0 {
-		minMult = 0.5
-	}
-	if maxMult == 0 {
-		maxMult = 1.5
-	}
+	minMult, maxMult := parameterRange(param)
 
 	step := (maxMult - minMult) / float64(numSteps-1)
 	curve := make([]*simulationv1.SensitivityPoint, 0, numSteps)
@@ -112,27 +116,15 @@ func (e *SensitivityEngine) analyzeParameter(
 	var thresholds []*simulationv1.ThresholdPoint
 	var prevFlow float64
 
+	// Соседние шаги отличаются одним параметром: каждый шаг достраивает
+	// поток предыдущего решения вместо решения с нуля
+	previous := baseResult
+
 	for i := 0; i < numSteps; i++ {
 		multiplier := minMult + float64(i)*step
 
-		// Создаём модификацию
-		mod := &simulationv1.Modification{
-			Target: param.Target,
-		}
-		mod.Change = &simulationv1.Modification_RelativeChange{
-			RelativeChange: multiplier,
-		}
-
-		if param.Edge != nil {
-			mod.Type = simulationv1.ModificationType_MODIFICATION_TYPE_UPDATE_EDGE
-			mod.EdgeKey = param.Edge
-		} else if param.NodeId > 0 {
-			mod.Type = simulationv1.ModificationType_MODIFICATION_TYPE_UPDATE_NODE
-			mod.NodeId = param.NodeId
-		}
-
-		modifiedGraph := ApplyModifications(graph, []*simulationv1.Modification{mod})
-		result, err := e.solverClient.Solve(ctx, modifiedGraph, algorithm, nil)
+		modifiedGraph := ApplyModifications(graph, []*simulationv1.Modification{parameterModification(param, multiplier)})
+		result, err := e.solverClient.Solve(ctx, modifiedGraph, algorithm, warmStartOptions(previous))
 
 		flow := 0.0
 		cost := 0.0
@@ -146,6 +138,7 @@ func (e *SensitivityEngine) analyzeParameter(
 		} else if result != nil {
 			flow = result.MaxFlow
 			cost = result.TotalCost
+			previous = result
 		}
 
 		point := &simulationv1.SensitivityPoint{
@@ -208,6 +201,38 @@ func (e *SensitivityEngine) analyzeParameter(
 	}, thresholds
 }
 
+// parameterRange возвращает диапазон множителя параметра (по умолчанию 0.5–1.5)
+func parameterRange(param *simulationv1.SensitivityParameter) (float64, float64) {
+	minMult := param.MinMultiplier
+	maxMult := param.MaxMultiplier
+	if minMult == 0 {
+		minMult = 0.5
+	}
+	if maxMult == 0 {
+		maxMult = 1.5
+	}
+	return minMult, maxMult
+}
+
+// parameterModification создаёт модификацию, умножающую параметр на multiplier
+func parameterModification(param *simulationv1.SensitivityParameter, multiplier float64) *simulationv1.Modification {
+	mod := &simulationv1.Modification{
+		Target: param.Target,
+		Change: &simulationv1.Modification_RelativeChange{
+			RelativeChange: multiplier,
+		},
+	}
+
+	if param.Edge != nil {
+		mod.Type = simulationv1.ModificationType_MODIFICATION_TYPE_UPDATE_EDGE
+		mod.EdgeKey = param.Edge
+	} else if param.NodeId > 0 {
+		mod.Type = simulationv1.ModificationType_MODIFICATION_TYPE_UPDATE_NODE
+		mod.NodeId = param.NodeId
+	}
+	return mod
+}
+
 func determineSensitivityLevel(index float64) simulationv1.SensitivityLevel {
 	switch {
 	case index < 0.01:
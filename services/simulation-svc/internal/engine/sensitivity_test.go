@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonv1 "logistics/gen/go/logistics/common/v1"
+	optimizationv1 "logistics/gen/go/logistics/optimization/v1"
 	simulationv1 "logistics/gen/go/logistics/simulation/v1"
 	"logistics/pkg/client"
 	"logistics/pkg/logger"
@@ -36,6 +37,7 @@ type MockSolverClient struct {
 	callIndex   int
 	defaultFlow float64
 	flowPerStep float64
+	opts        []interface{} // Опции каждого вызова
 }
 
 func NewMockSolverClient() *MockSolverClient {
@@ -47,6 +49,7 @@ func NewMockSolverClient() *MockSolverClient {
 
 func (m *MockSolverClient) Solve(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, opts interface{}) (*client.SolveResult, error) {
 	defer func() { m.callIndex++ }()
+	m.opts = append(m.opts, opts)
 
 	// Если есть предопределённые ошибки
 	if m.callIndex < len(m.errors) && m.errors[m.callIndex] != nil {
@@ -163,6 +166,35 @@ func TestSensitivityEngine_AnalyzeSensitivity_Success(t *testing.T) {
 	// Metadata устанавливается в service, не в engine
 }
 
+func TestSensitivityEngine_AnalyzeSensitivity_WarmStart(t *testing.T) {
+	mockClient := NewMockSolverClient().WithErrors(nil, nil, fmt.Errorf("solver unavailable"))
+	engine := NewSensitivityEngine(mockClient)
+
+	graph := createSensitivityTestGraph()
+	params := []*simulationv1.SensitivityParameter{{
+		Edge:     &commonv1.EdgeKey{From: 1, To: 2},
+		Target:   simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY,
+		NumSteps: 4,
+	}}
+
+	_, err := engine.AnalyzeSensitivity(context.Background(), graph, params, nil, commonv1.Algorithm_ALGORITHM_DINIC)
+	require.NoError(t, err)
+	require.Len(t, mockClient.opts, 5)
+
+	warmStart := func(call int) *commonv1.Graph {
+		opts, ok := mockClient.opts[call].(*optimizationv1.SolveOptions)
+		require.True(t, ok, "call %d", call)
+		return opts.GetWarmStart()
+	}
+
+	// Базовое решение — с нуля, каждый шаг достраивает предыдущий успешный
+	assert.Nil(t, mockClient.opts[0])
+	assert.Same(t, graph, warmStart(1))
+	assert.NotNil(t, warmStart(2))
+	assert.Same(t, warmStart(2), warmStart(3), "failed step is skipped")
+	assert.NotSame(t, warmStart(3), warmStart(4))
+}
+
 func TestSensitivityEngine_AnalyzeSensitivity_MultipleParameters(t *testing.T) {
 	ctx := context.Background()
 	mockClient := NewMockSolverClient()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	commonv1 "logistics/gen/go/logistics/common/v1"
+	optimizationv1 "logistics/gen/go/logistics/optimization/v1"
 	simulationv1 "logistics/gen/go/logistics/simulation/v1"
 	"logistics/pkg/client"
 )
@@ -50,6 +51,32 @@ func (e *SolverEngine) Solve(ctx context.Context, graph *commonv1.Graph, algorit
 	return e.clientIface.Solve(ctx, graph, algorithm, nil)
 }
 
+// SolveFrom решает задачу потока с тёплого старта: solver достраивает поток
+// предыдущего решения previous вместо решения с нуля. Подходит для
+// модификаций графа, затрагивающих несколько рёбер или узлов.
+// Без previous (или без его графа) работает как Solve.
+func (e *SolverEngine) SolveFrom(ctx context.Context, graph *commonv1.Graph, algorithm commonv1.Algorithm, previous *SolveResult) (*SolveResult, error) {
+	if previous == nil || previous.Graph == nil {
+		return e.Solve(ctx, graph, algorithm)
+	}
+	if e.clientIface == nil {
+		return nil, fmt.Errorf("solver client not initialized")
+	}
+
+	ResetFlow(graph)
+
+	return e.clientIface.Solve(ctx, graph, algorithm, warmStartOptions(previous))
+}
+
+// warmStartOptions возвращает опции solver для тёплого старта с previous
+// (nil, если у previous нет решённого графа)
+func warmStartOptions(previous *SolveResult) *optimizationv1.SolveOptions {
+	if previous == nil || previous.Graph == nil {
+		return nil
+	}
+	return &optimizationv1.SolveOptions{WarmStart: previous.Graph}
+}
+
 // ToScenarioResult конвертирует результат в proto
 func ToScenarioResult(r *SolveResult, name string) *simulationv1.ScenarioResult {
 	if r == nil {
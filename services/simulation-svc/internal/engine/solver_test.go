@@ -1,9 +1,11 @@
 package engine
 
 import (
+	"context"
 	"testing"
 
 	commonv1 "logistics/gen/go/logistics/common/v1"
+	optimizationv1 "logistics/gen/go/logistics/optimization/v1"
 	simulationv1 "logistics/gen/go/logistics/simulation/v1"
 	"logistics/pkg/client"
 
@@ -16,6 +18,24 @@ func TestNewSolverEngine(t *testing.T) {
 	require.NotNil(t, engine)
 }
 
+func TestSolverEngine_SolveFrom(t *testing.T) {
+	mockClient := NewMockSolverClient()
+	engine := NewSolverEngineWithInterface(mockClient)
+	graph := createSensitivityTestGraph()
+	previous := &SolveResult{MaxFlow: 100, Graph: createSensitivityTestGraph()}
+
+	_, err := engine.SolveFrom(context.Background(), graph, commonv1.Algorithm_ALGORITHM_DINIC, previous)
+	require.NoError(t, err)
+	_, err = engine.SolveFrom(context.Background(), graph, commonv1.Algorithm_ALGORITHM_DINIC, nil)
+	require.NoError(t, err)
+
+	require.Len(t, mockClient.opts, 2)
+	opts, ok := mockClient.opts[0].(*optimizationv1.SolveOptions)
+	require.True(t, ok)
+	assert.Same(t, previous.Graph, opts.WarmStart)
+	assert.Nil(t, mockClient.opts[1], "without a previous result the graph is solved from scratch")
+}
+
 func TestToScenarioResult(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -39,34 +39,53 @@ func (e *TimeSimulationEngine) RunTimeSimulation(
 	startTime := e.getStartTime(config)
 	stepDuration := e.getStepDuration(config.TimeStep)
 
+	if config.Mode == simulationv1.TimeSimulationMode_TIME_SIMULATION_MODE_DYNAMIC {
+		return e.runDynamicFlow(ctx, req, config, steps, startTime, stepDuration)
+	}
+
 	results := make([]*simulationv1.TimeStepResult, 0, steps)
 	stats := newTimeSimulationStats()
 	criticalTracker := newCriticalPeriodTracker()
 
+	var state *inventoryState
+	if config.CarryOver {
+		state = newInventoryState(req.Graph)
+	}
+
 	for i := 0; i < steps; i++ {
 		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
 
 		currentTime := startTime.Add(time.Duration(i) * stepDuration)
-		stepResult, err := e.processTimeStep(ctx, req, i, currentTime)
+		previousBacklog := state.backlogTotal()
+		stepResult, err := e.processTimeStep(ctx, req, i, currentTime, state)
 		if err != nil {
 			return nil, err
 		}
 
 		results = append(results, stepResult)
 		stats.update(stepResult)
-		criticalTracker.track(i, currentTime, stepResult, stats, stepDuration)
+		if state != nil && stepResult.TotalBacklog > previousBacklog+flowTolerance {
+			criticalTracker.trackBacklog(i, currentTime, stepDuration)
+		} else {
+			criticalTracker.track(i, currentTime, stepResult, stats, stepDuration)
+		}
 	}
 
 	criticalTracker.finalize(steps, startTime, stepDuration)
 
-	return &simulationv1.RunTimeSimulationResponse{
+	response := &simulationv1.RunTimeSimulationResponse{
 		Success:         true,
 		StepResults:     results,
 		Stats:           stats.finalize(steps),
 		CriticalPeriods: criticalTracker.periods,
-	}, nil
+	}
+	if state != nil {
+		response.ServiceLevels, response.Stats.FillRate = state.serviceLevels()
+		response.Stats.FinalBacklog = state.backlogTotal()
+	}
+	return response, nil
 }
 
 // Helper types and methods
@@ -155,17 +174,27 @@ func (e *TimeSimulationEngine) processTimeStep(
 	req *simulationv1.RunTimeSimulationRequest,
 	step int,
 	currentTime time.Time,
+	state *inventoryState,
 ) (*simulationv1.TimeStepResult, error) {
 	modGraph := e.applyTimePatterns(req.Graph, step, currentTime, req.EdgePatterns, req.NodePatterns)
 
+	var balances map[int64]*commonv1.NodeBalance
+	if state != nil {
+		balances = state.apply(modGraph)
+	}
+
 	solveRes, err := e.solverClient.Solve(ctx, modGraph, req.Algorithm, nil)
 	if err != nil {
 		return nil, err
 	}
+	flowGraph := solveRes.Graph
+	if state != nil {
+		solveRes = withoutTerminals(solveRes, req.Graph.SourceId, req.Graph.SinkId)
+	}
 
 	bottlenecks := e.findBottlenecks(solveRes.Graph)
 
-	return &simulationv1.TimeStepResult{
+	result := &simulationv1.TimeStepResult{
 		Step:               int32(step),
 		Timestamp:          timestamppb.New(currentTime),
 		MaxFlow:            solveRes.MaxFlow,
@@ -173,7 +202,11 @@ func (e *TimeSimulationEngine) processTimeStep(
 		AverageUtilization: solveRes.AverageUtilization,
 		SaturatedEdges:     solveRes.SaturatedEdges,
 		Bottlenecks:        bottlenecks,
-	}, nil
+	}
+	if state != nil {
+		state.settle(balances, flowGraph, result)
+	}
+	return result, nil
 }
 
 func (e *TimeSimulationEngine) findBottlenecks(graph *commonv1.Graph) []*commonv1.EdgeKey {
@@ -181,9 +214,9 @@ func (e *TimeSimulationEngine) findBottlenecks(graph *commonv1.Graph) []*commonv
 		return nil
 	}
 	var bottlenecks []*commonv1.EdgeKey
-	for _, edge := range graph.Edges {
+	for i, edge := range graph.Edges {
 		if edge.Capacity > 0 && edge.CurrentFlow/edge.Capacity >= 0.95 {
-			bottlenecks = append(bottlenecks, &commonv1.EdgeKey{From: edge.From, To: edge.To})
+			bottlenecks = append(bottlenecks, KeyOf(edge, i))
 		}
 	}
 	return bottlenecks
@@ -208,14 +241,27 @@ func (t *criticalPeriodTracker) track(
 	stepDuration time.Duration,
 ) {
 	isCritical := t.isCriticalStep(stepResult, stats.maxFlow)
+	t.trackState(step, currentTime, isCritical, t.determineCriticalType(stepResult),
+		"Начало критического периода", stepDuration)
+}
 
+// trackState открывает период заданного типа на критическом шаге, если он
+// ещё не открыт, и закрывает открытый период на некритическом
+func (t *criticalPeriodTracker) trackState(
+	step int,
+	currentTime time.Time,
+	isCritical bool,
+	periodType simulationv1.CriticalPeriodType,
+	description string,
+	stepDuration time.Duration,
+) {
 	if isCritical {
 		if t.current == nil {
 			t.current = &simulationv1.CriticalPeriod{
 				StartStep:   int32(step),
 				StartTime:   timestamppb.New(currentTime),
-				Type:        t.determineCriticalType(stepResult),
-				Description: "Начало критического периода",
+				Type:        periodType,
+				Description: description,
 			}
 		}
 	} else if t.current != nil {
@@ -223,6 +269,16 @@ func (t *criticalPeriodTracker) track(
 	}
 }
 
+// trackBacklog отмечает шаг с растущим backlog: спрос опережает возможности
+// сети. Открытый период другого типа закрывается на предыдущем шаге.
+func (t *criticalPeriodTracker) trackBacklog(step int, currentTime time.Time, stepDuration time.Duration) {
+	highDemand := simulationv1.CriticalPeriodType_CRITICAL_PERIOD_TYPE_HIGH_DEMAND
+	if t.current != nil && t.current.Type != highDemand {
+		t.closePeriod(step-1, currentTime.Add(-stepDuration))
+	}
+	t.trackState(step, currentTime, true, highDemand, "Растёт невыполненный спрос", stepDuration)
+}
+
 func (t *criticalPeriodTracker) isCriticalStep(step *simulationv1.TimeStepResult, maxFlow float64) bool {
 	if maxFlow == 0 {
 		return false
@@ -309,8 +365,8 @@ func (e *TimeSimulationEngine) buildPeakGraph(req *simulationv1.SimulatePeakLoad
 	}
 
 	if req.CapacityReduction > 0 && req.CapacityReduction < 1 {
-		for _, edge := range peakGraph.Edges {
-			if len(req.AffectedEdges) == 0 || containsEdge(req.AffectedEdges, edge.From, edge.To) {
+		for i, edge := range peakGraph.Edges {
+			if len(req.AffectedEdges) == 0 || containsEdge(req.AffectedEdges, edge, i) {
 				edge.Capacity *= req.CapacityReduction
 			}
 		}
@@ -325,7 +381,7 @@ func (e *TimeSimulationEngine) findOverloadedEdges(graph *commonv1.Graph, demand
 	}
 
 	overloadedEdges := make([]*simulationv1.OverloadedEdge, 0, len(graph.Edges)/10+1)
-	for _, edge := range graph.Edges {
+	for i, edge := range graph.Edges {
 		if edge.CurrentFlow < edge.Capacity*0.95 {
 			continue
 		}
@@ -342,7 +398,7 @@ func (e *TimeSimulationEngine) findOverloadedEdges(graph *commonv1.Graph, demand
 		}
 
 		overloadedEdges = append(overloadedEdges, &simulationv1.OverloadedEdge{
-			Edge:              &commonv1.EdgeKey{From: edge.From, To: edge.To},
+			Edge:              KeyOf(edge, i),
 			RequiredCapacity:  requiredCapacity,
 			AvailableCapacity: edge.Capacity,
 			Shortage:          shortage,
@@ -542,10 +598,9 @@ func (e *TimeSimulationEngine) applyTimePatterns(
 
 	for _, p := range ePatterns {
 		mult := e.getMultiplier(p.Pattern, step, currentTime)
-		for _, edge := range g.Edges {
-			if edge.From == p.Edge.From && edge.To == p.Edge.To {
+		for i, edge := range g.Edges {
+			if MatchesEdgeKey(edge, i, p.Edge) {
 				edge.Capacity *= mult
-				break
 			}
 		}
 	}
@@ -729,9 +784,9 @@ func containsNode(nodes []int64, id int64) bool {
 	return false
 }
 
-func containsEdge(edges []*commonv1.EdgeKey, from, to int64) bool {
+func containsEdge(edges []*commonv1.EdgeKey, edge *commonv1.Edge, index int) bool {
 	for _, e := range edges {
-		if e.From == from && e.To == to {
+		if MatchesEdgeKey(edge, index, e) {
 			return true
 		}
 	}
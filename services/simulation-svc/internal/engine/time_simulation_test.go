@@ -646,10 +646,15 @@ func TestContainsEdge(t *testing.T) {
 		{From: 2, To: 3},
 	}
 
-	assert.True(t, containsEdge(edges, 1, 2))
-	assert.True(t, containsEdge(edges, 2, 3))
-	assert.False(t, containsEdge(edges, 1, 3))
-	assert.False(t, containsEdge(edges, 3, 2))
+	assert.True(t, containsEdge(edges, &commonv1.Edge{From: 1, To: 2}, 0))
+	assert.True(t, containsEdge(edges, &commonv1.Edge{From: 2, To: 3}, 0))
+	assert.False(t, containsEdge(edges, &commonv1.Edge{From: 1, To: 3}, 0))
+	assert.False(t, containsEdge(edges, &commonv1.Edge{From: 3, To: 2}, 0))
+
+	// Ключ с EdgeId выбирает одно из параллельных рёбер
+	keyed := []*commonv1.EdgeKey{{From: 1, To: 2, EdgeId: 7}}
+	assert.True(t, containsEdge(keyed, &commonv1.Edge{From: 1, To: 2, Id: 7}, 0))
+	assert.False(t, containsEdge(keyed, &commonv1.Edge{From: 1, To: 2, Id: 8}, 1))
 }
 
 func TestCalculateChangePercent(t *testing.T) {
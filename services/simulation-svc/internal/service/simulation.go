@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -36,6 +37,8 @@ type SimulationService struct {
 	resilienceEngine  *engine.ResilienceEngine
 	sensitivityEngine *engine.SensitivityEngine
 	timeEngine        *engine.TimeSimulationEngine
+	expansionEngine   *engine.CapacityExpansionEngine
+	facilityEngine    *engine.FacilityLocationEngine
 }
 
 // NewSimulationService создаёт новый сервис
@@ -57,6 +60,8 @@ func NewSimulationService(
 		resilienceEngine:  engine.NewResilienceEngine(solverInterface),
 		sensitivityEngine: engine.NewSensitivityEngine(solverInterface),
 		timeEngine:        engine.NewTimeSimulationEngine(solverInterface),
+		expansionEngine:   engine.NewCapacityExpansionEngine(solverInterface),
+		facilityEngine:    engine.NewFacilityLocationEngine(solverInterface),
 	}
 }
 
@@ -74,6 +79,8 @@ func NewSimulationServiceWithInterface(
 		resilienceEngine:  engine.NewResilienceEngine(solverClient),
 		sensitivityEngine: engine.NewSensitivityEngine(solverClient),
 		timeEngine:        engine.NewTimeSimulationEngine(solverClient),
+		expansionEngine:   engine.NewCapacityExpansionEngine(solverClient),
+		facilityEngine:    engine.NewFacilityLocationEngine(solverClient),
 	}
 }
 
@@ -112,8 +119,8 @@ func (s *SimulationService) RunWhatIf(
 	// Применяем модификации
 	modifiedGraph := engine.ApplyModifications(req.BaselineGraph, req.Modifications)
 
-	// Решаем модифицированный граф
-	modifiedResult, err := s.solverEngine.Solve(ctx, modifiedGraph, req.Algorithm)
+	// Решаем модифицированный граф, достраивая поток базового решения
+	modifiedResult, err := s.solverEngine.SolveFrom(ctx, modifiedGraph, req.Algorithm, baselineResult)
 	if err != nil {
 		telemetry.SetError(ctx, err)
 		return nil, pkgerrors.ToGRPC(
@@ -190,7 +197,7 @@ func (s *SimulationService) CompareScenarios(
 
 	for i, scenario := range req.Scenarios {
 		modifiedGraph := engine.ApplyModifications(req.BaselineGraph, scenario.Modifications)
-		result, err := s.solverEngine.Solve(ctx, modifiedGraph, req.Algorithm)
+		result, err := s.solverEngine.SolveFrom(ctx, modifiedGraph, req.Algorithm, baselineResult)
 		if err != nil {
 			continue
 		}
@@ -244,6 +251,192 @@ func (s *SimulationService) CompareScenarios(
 	}, nil
 }
 
+// OptimizeCapacityExpansion подбирает расширения рёбер в пределах бюджета
+func (s *SimulationService) OptimizeCapacityExpansion(
+	ctx context.Context,
+	req *simulationv1.OptimizeCapacityExpansionRequest,
+) (*simulationv1.OptimizeCapacityExpansionResponse, error) {
+	ctx, span := telemetry.StartSpan(ctx, "SimulationService.OptimizeCapacityExpansion",
+		trace.WithAttributes(
+			attribute.Float64("budget", req.Budget),
+			attribute.Int("upgrades_count", len(req.Upgrades)),
+		),
+	)
+	defer span.End()
+
+	if req.Graph == nil {
+		return nil, pkgerrors.ToGRPC(
+			pkgerrors.New(pkgerrors.CodeInvalidArgument, "graph is required"),
+		)
+	}
+	if err := validateCapacityExpansion(req); err != nil {
+		return nil, pkgerrors.ToGRPC(err)
+	}
+
+	start := time.Now()
+
+	result, err := s.expansionEngine.Optimize(ctx, req)
+	if err != nil {
+		telemetry.SetError(ctx, err)
+		return nil, pkgerrors.ToGRPC(
+			pkgerrors.Wrap(err, pkgerrors.CodeInternal, "capacity expansion failed"),
+		)
+	}
+
+	span.SetAttributes(
+		attribute.Int("steps", len(result.Steps)),
+		attribute.Float64("flow_gain", result.FinalFlow-result.BaseFlow),
+	)
+
+	result.Metadata = &simulationv1.SimulationMetadata{
+		ComputationTimeMs: float64(time.Since(start).Milliseconds()),
+		Iterations:        int32(len(result.Steps)),
+		AlgorithmUsed:     req.Algorithm.String(),
+		CompletedAt:       timestamppb.Now(),
+	}
+
+	return result, nil
+}
+
+// validateCapacityExpansion проверяет бюджет и варианты расширения
+func validateCapacityExpansion(req *simulationv1.OptimizeCapacityExpansionRequest) error {
+	if req.Budget < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"budget must be non-negative", "budget")
+	}
+	if req.MaxIterations < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"max_iterations must be non-negative", "max_iterations")
+	}
+	if req.DefaultUpgrade == nil && len(req.Upgrades) == 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"upgrades or default_upgrade is required", "upgrades")
+	}
+
+	if req.DefaultUpgrade != nil {
+		if err := validateUpgradeOption(req.DefaultUpgrade, "default_upgrade"); err != nil {
+			return err
+		}
+	}
+	for i, option := range req.Upgrades {
+		field := fmt.Sprintf("upgrades[%d]", i)
+		if option.Edge == nil {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument, "edge is required", field+".edge")
+		}
+		if err := validateUpgradeOption(option, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateUpgradeOption(option *simulationv1.EdgeUpgradeOption, field string) error {
+	if option.FixedCost < 0 || option.CostPerUnit < 0 || option.Step < 0 || option.MaxIncrease < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"fixed_cost, cost_per_unit, step and max_increase must be non-negative", field)
+	}
+	return nil
+}
+
+// OptimizeFacilityLocation выбирает склады, которые стоит открыть или закрыть
+func (s *SimulationService) OptimizeFacilityLocation(
+	ctx context.Context,
+	req *simulationv1.OptimizeFacilityLocationRequest,
+) (*simulationv1.OptimizeFacilityLocationResponse, error) {
+	ctx, span := telemetry.StartSpan(ctx, "SimulationService.OptimizeFacilityLocation",
+		trace.WithAttributes(
+			attribute.Int("initial_open_count", len(req.InitialOpen)),
+			attribute.Float64("unmet_demand_penalty", req.UnmetDemandPenalty),
+		),
+	)
+	defer span.End()
+
+	if req.Graph == nil {
+		return nil, pkgerrors.ToGRPC(
+			pkgerrors.New(pkgerrors.CodeInvalidArgument, "graph is required"),
+		)
+	}
+	if err := validateFacilityLocation(req); err != nil {
+		return nil, pkgerrors.ToGRPC(err)
+	}
+
+	start := time.Now()
+
+	result, err := s.facilityEngine.Optimize(ctx, req)
+	if err != nil {
+		telemetry.SetError(ctx, err)
+		return nil, pkgerrors.ToGRPC(
+			pkgerrors.Wrap(err, pkgerrors.CodeInternal, "facility location failed"),
+		)
+	}
+
+	span.SetAttributes(
+		attribute.Int("moves", len(result.Moves)),
+		attribute.Int("open_warehouses", len(result.OpenWarehouses)),
+		attribute.Float64("total_cost", result.TotalCost),
+	)
+
+	result.Metadata = &simulationv1.SimulationMetadata{
+		ComputationTimeMs: float64(time.Since(start).Milliseconds()),
+		Iterations:        int32(len(result.Moves)),
+		AlgorithmUsed:     commonv1.Algorithm_ALGORITHM_MIN_COST.String(),
+		CompletedAt:       timestamppb.Now(),
+	}
+
+	return result, nil
+}
+
+// validateFacilityLocation проверяет кандидатов, спрос и затраты
+func validateFacilityLocation(req *simulationv1.OptimizeFacilityLocationRequest) error {
+	warehouses := make(map[int64]bool)
+	hasDemand := false
+	for _, node := range req.Graph.Nodes {
+		switch node.Type {
+		case commonv1.NodeType_NODE_TYPE_WAREHOUSE:
+			warehouses[node.Id] = true
+		case commonv1.NodeType_NODE_TYPE_DELIVERY_POINT:
+			hasDemand = hasDemand || node.Demand > 0
+		}
+	}
+	if len(warehouses) == 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"graph must contain NODE_TYPE_WAREHOUSE nodes", "graph.nodes")
+	}
+	if !hasDemand {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"graph must contain NODE_TYPE_DELIVERY_POINT nodes with demand", "graph.nodes")
+	}
+
+	if req.UnmetDemandPenalty < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"unmet_demand_penalty must be non-negative", "unmet_demand_penalty")
+	}
+	if req.MaxIterations < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"max_iterations must be non-negative", "max_iterations")
+	}
+	for i, id := range req.InitialOpen {
+		if !warehouses[id] {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				fmt.Sprintf("node %d is not a warehouse", id), fmt.Sprintf("initial_open[%d]", i))
+		}
+	}
+
+	if cfg := req.FixedCosts; cfg != nil {
+		if cfg.WarehouseCost < 0 {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				"warehouse_cost must be non-negative", "fixed_costs.warehouse_cost")
+		}
+		for id, cost := range cfg.WarehouseCosts {
+			if cost < 0 {
+				return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+					"warehouse_costs must be non-negative", fmt.Sprintf("fixed_costs.warehouse_costs[%d]", id))
+			}
+		}
+	}
+	return nil
+}
+
 // ============ MONTE CARLO ============
 
 // RunMonteCarlo запускает Monte Carlo симуляцию
@@ -273,9 +466,18 @@ func (s *SimulationService) RunMonteCarlo(
 			ConfidenceLevel: 0.95,
 		}
 	}
+	if err := validateMonteCarloConfig(config); err != nil {
+		return nil, pkgerrors.ToGRPC(err)
+	}
+	if err := engine.ValidateCorrelation(req.Correlation, len(req.Uncertainties)); err != nil {
+		return nil, pkgerrors.ToGRPC(
+			pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument, err.Error(), "correlation"),
+		)
+	}
 
 	// Используем интерфейс вместо конкретного клиента
 	mcEngine := engine.NewMonteCarloEngine(config, s.solverClient)
+	mcEngine.SetCorrelation(req.Correlation)
 	result, err := mcEngine.Run(ctx, req.Graph, req.Uncertainties, req.Algorithm, nil)
 	if err != nil {
 		telemetry.SetError(ctx, err)
@@ -286,7 +488,7 @@ func (s *SimulationService) RunMonteCarlo(
 
 	result.Metadata = &simulationv1.SimulationMetadata{
 		ComputationTimeMs: float64(time.Since(start).Milliseconds()),
-		Iterations:        config.NumIterations,
+		Iterations:        result.CompletedIterations,
 		AlgorithmUsed:     req.Algorithm.String(),
 		CompletedAt:       timestamppb.Now(),
 	}
@@ -316,18 +518,54 @@ func (s *SimulationService) RunMonteCarloStream(
 			ConfidenceLevel: 0.95,
 		}
 	}
+	if err := validateMonteCarloConfig(config); err != nil {
+		return pkgerrors.ToGRPC(err)
+	}
+	if err := engine.ValidateCorrelation(req.Correlation, len(req.Uncertainties)); err != nil {
+		return pkgerrors.ToGRPC(
+			pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument, err.Error(), "correlation"),
+		)
+	}
 
 	progressChan := make(chan *simulationv1.MonteCarloProgress, 100)
 	errChan := make(chan error, 1)
 
 	// Запускаем в горутине - используем интерфейс
 	go func() {
+		defer close(progressChan)
+		defer close(errChan)
+
+		start := time.Now()
 		mcEngine := engine.NewMonteCarloEngine(config, s.solverClient)
-		_, err := mcEngine.Run(ctx, req.Graph, req.Uncertainties, req.Algorithm, progressChan)
+		mcEngine.SetCorrelation(req.Correlation)
+		result, err := mcEngine.Run(ctx, req.Graph, req.Uncertainties, req.Algorithm, progressChan)
 		if err != nil {
 			errChan <- err
+			return
+		}
+
+		result.Metadata = &simulationv1.SimulationMetadata{
+			ComputationTimeMs: float64(time.Since(start).Milliseconds()),
+			Iterations:        result.CompletedIterations,
+			AlgorithmUsed:     req.Algorithm.String(),
+			CompletedAt:       timestamppb.Now(),
+		}
+
+		// Финальное сообщение с результатом
+		select {
+		case progressChan <- &simulationv1.MonteCarloProgress{
+			Iteration:           result.CompletedIterations,
+			TotalIterations:     result.CompletedIterations,
+			ProgressPercent:     100,
+			CurrentMeanFlow:     result.FlowStats.GetMean(),
+			CurrentStdDev:       result.FlowStats.GetStdDev(),
+			Status:              "completed",
+			CiHalfWidth:         (result.FlowStats.GetConfidenceIntervalHigh() - result.FlowStats.GetConfidenceIntervalLow()) / 2,
+			RelativeCiHalfWidth: max(result.FlowStats.GetRelativeCiHalfWidth(), result.CostStats.GetRelativeCiHalfWidth()),
+			Result:              result,
+		}:
+		case <-ctx.Done():
 		}
-		close(errChan)
 	}()
 
 	// Стримим прогресс
@@ -347,6 +585,44 @@ func (s *SimulationService) RunMonteCarloStream(
 	return nil
 }
 
+// validateMonteCarloConfig проверяет параметры остановки и воспроизведения итераций
+func validateMonteCarloConfig(config *simulationv1.MonteCarloConfig) error {
+	if config.TargetRelativeCiHalfWidth < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"target_relative_ci_half_width must be non-negative", "config.target_relative_ci_half_width")
+	}
+	if config.TimeBudgetSeconds < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"time_budget_seconds must be non-negative", "config.time_budget_seconds")
+	}
+	if len(config.ReplayIterations) == 0 {
+		return nil
+	}
+	if config.RandomSeed == 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"replay_iterations requires random_seed", "config.random_seed")
+	}
+	// Точки стратифицированных методов определены только внутри исходного прогона
+	stratified := config.SamplingMethod == simulationv1.SamplingMethod_SAMPLING_METHOD_LATIN_HYPERCUBE ||
+		config.SamplingMethod == simulationv1.SamplingMethod_SAMPLING_METHOD_SOBOL
+	numIterations := config.NumIterations
+	if numIterations <= 0 {
+		numIterations = 1000
+	}
+	for _, it := range config.ReplayIterations {
+		if it < 0 {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				fmt.Sprintf("replay iteration %d must be non-negative", it), "config.replay_iterations")
+		}
+		if stratified && it >= numIterations {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				fmt.Sprintf("replay iteration %d is outside num_iterations %d of the stratified run", it, numIterations),
+				"config.replay_iterations")
+		}
+	}
+	return nil
+}
+
 // ============ SENSITIVITY ANALYSIS ============
 
 // AnalyzeSensitivity выполняет анализ чувствительности
@@ -369,6 +645,12 @@ func (s *SimulationService) AnalyzeSensitivity(
 		)
 	}
 
+	if req.Config != nil {
+		if err := validateSensitivityConfig(req.Config); err != nil {
+			return nil, pkgerrors.ToGRPC(err)
+		}
+	}
+
 	result, err := s.sensitivityEngine.AnalyzeSensitivity(ctx, req.Graph, req.Parameters, req.Config, req.Algorithm)
 	if err != nil {
 		telemetry.SetError(ctx, err)
@@ -386,6 +668,28 @@ func (s *SimulationService) AnalyzeSensitivity(
 	return result, nil
 }
 
+// validateSensitivityConfig проверяет настройки глобальных методов
+func validateSensitivityConfig(config *simulationv1.SensitivityConfig) error {
+	if config.NumTrajectories < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"num_trajectories must be non-negative", "config.num_trajectories")
+	}
+	// Шаг Морриса p/(2(p−1)) попадает в узлы сетки только при чётном p
+	if config.NumLevels != 0 && (config.NumLevels < 2 || config.NumLevels%2 != 0) {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"num_levels must be even and at least 2", "config.num_levels")
+	}
+	if config.NumSamples < 0 || config.NumSamples == 1 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"num_samples must be at least 2", "config.num_samples")
+	}
+	if config.MaxWorkers < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"max_workers must be non-negative", "config.max_workers")
+	}
+	return nil
+}
+
 // ============ CRITICAL ELEMENTS ============
 
 // criticalElementsAnalyzer вспомогательная структура для анализа критических элементов
@@ -478,24 +782,24 @@ func (a *criticalElementsAnalyzer) analyzeEdges(ctx context.Context) ([]*simulat
 	criticalEdges := make([]*simulationv1.CriticalEdge, 0)
 	var spofs []*commonv1.EdgeKey
 
-	for _, edge := range a.graph.Edges {
-		result := a.analyzeEdge(ctx, edge)
+	for i, edge := range a.graph.Edges {
+		result := a.analyzeEdge(ctx, edge, engine.KeyOf(edge, i))
 		if result == nil {
 			continue
 		}
 
 		criticalEdges = append(criticalEdges, result)
 		if result.IsSinglePointOfFailure {
-			spofs = append(spofs, &commonv1.EdgeKey{From: edge.From, To: edge.To})
+			spofs = append(spofs, result.Edge)
 		}
 	}
 
 	return criticalEdges, spofs
 }
 
-func (a *criticalElementsAnalyzer) analyzeEdge(ctx context.Context, edge *commonv1.Edge) *simulationv1.CriticalEdge {
-	modGraph := a.service.removeEdgeFromGraph(a.graph, edge.From, edge.To)
-	modResult, err := a.service.solverEngine.Solve(ctx, modGraph, a.algorithm)
+func (a *criticalElementsAnalyzer) analyzeEdge(ctx context.Context, edge *commonv1.Edge, key *commonv1.EdgeKey) *simulationv1.CriticalEdge {
+	modGraph := a.service.removeEdgeFromGraph(a.graph, key)
+	modResult, err := a.service.solverEngine.SolveFrom(ctx, modGraph, a.algorithm, a.baseResult)
 	if err != nil {
 		return nil
 	}
@@ -518,7 +822,7 @@ func (a *criticalElementsAnalyzer) analyzeEdge(ctx context.Context, edge *common
 	}
 
 	return &simulationv1.CriticalEdge{
-		Edge:                   &commonv1.EdgeKey{From: edge.From, To: edge.To},
+		Edge:                   key,
 		CriticalityScore:       flowImpactPercent,
 		FlowImpactIfRemoved:    flowImpact,
 		CostImpactIfRemoved:    costImpact,
@@ -549,7 +853,7 @@ func (a *criticalElementsAnalyzer) analyzeNodes(ctx context.Context) []*simulati
 
 func (a *criticalElementsAnalyzer) analyzeNode(ctx context.Context, node *commonv1.Node) *simulationv1.CriticalNode {
 	modGraph := a.service.removeNodeFromGraph(a.graph, node.Id)
-	modResult, err := a.service.solverEngine.Solve(ctx, modGraph, a.algorithm)
+	modResult, err := a.service.solverEngine.SolveFrom(ctx, modGraph, a.algorithm, a.baseResult)
 	if err != nil {
 		return nil
 	}
@@ -614,6 +918,12 @@ func (s *SimulationService) SimulateFailures(
 		)
 	}
 
+	if req.RandomConfig != nil {
+		if err := validateRandomFailureConfig(req.RandomConfig); err != nil {
+			return nil, pkgerrors.ToGRPC(err)
+		}
+	}
+
 	start := time.Now()
 
 	// Базовый результат
@@ -625,10 +935,16 @@ func (s *SimulationService) SimulateFailures(
 	}
 
 	scenarios := req.FailureScenarios
+	var randomSeed int64
 
 	// Если заданы случайные отказы, генерируем сценарии
 	if req.RandomConfig != nil && len(scenarios) == 0 {
-		scenarios = s.generateRandomFailureScenarios(req.Graph, req.RandomConfig)
+		scenarios, randomSeed, err = s.generateRandomFailureScenarios(req.Graph, req.RandomConfig)
+		if err != nil {
+			return nil, pkgerrors.ToGRPC(
+				pkgerrors.Wrap(err, pkgerrors.CodeInvalidArgument, "invalid random failure config"),
+			)
+		}
 	}
 
 	scenarioResults := make([]*simulationv1.FailureScenarioResult, 0, len(scenarios))
@@ -638,7 +954,7 @@ func (s *SimulationService) SimulateFailures(
 
 		// Удаляем failed edges
 		for _, edgeKey := range scenario.FailedEdges {
-			modGraph = s.removeEdgeFromGraph(modGraph, edgeKey.From, edgeKey.To)
+			modGraph = s.removeEdgeFromGraph(modGraph, edgeKey)
 		}
 
 		// Удаляем failed nodes
@@ -646,7 +962,7 @@ func (s *SimulationService) SimulateFailures(
 			modGraph = s.removeNodeFromGraph(modGraph, nodeID)
 		}
 
-		modResult, err := s.solverEngine.Solve(ctx, modGraph, req.Algorithm)
+		modResult, err := s.solverEngine.SolveFrom(ctx, modGraph, req.Algorithm, baseResult)
 		if err != nil {
 			continue
 		}
@@ -660,6 +976,7 @@ func (s *SimulationService) SimulateFailures(
 			Result:              engine.ToScenarioResult(modResult, scenario.Name),
 			VsBaseline:          comparison,
 			NetworkDisconnected: isDisconnected,
+			Occurrences:         scenario.Occurrences,
 		})
 	}
 
@@ -675,6 +992,7 @@ func (s *SimulationService) SimulateFailures(
 		ScenarioResults: scenarioResults,
 		Stats:           stats,
 		Recommendations: recommendations,
+		RandomSeed:      randomSeed,
 		Metadata: &simulationv1.SimulationMetadata{
 			ComputationTimeMs: float64(time.Since(start).Milliseconds()),
 			Iterations:        int32(len(scenarioResults)),
@@ -684,6 +1002,38 @@ func (s *SimulationService) SimulateFailures(
 	}, nil
 }
 
+// validateRandomFailureConfig проверяет параметры модели случайных отказов
+func validateRandomFailureConfig(config *simulationv1.RandomFailureConfig) error {
+	probabilities := []struct {
+		value float64
+		field string
+	}{
+		{config.EdgeFailureProbability, "edge_failure_probability"},
+		{config.NodeFailureProbability, "node_failure_probability"},
+		{config.CommonCauseProbability, "common_cause_probability"},
+		{config.CommonCauseFailureProbability, "common_cause_failure_probability"},
+	}
+	for _, p := range probabilities {
+		if p.value < 0 || p.value > 1 {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				p.field+" must be in [0, 1]", "random_config."+p.field)
+		}
+	}
+	if config.NumScenarios < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"num_scenarios must be non-negative", "random_config.num_scenarios")
+	}
+	if config.MaxSimultaneousFailures < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"max_simultaneous_failures must be non-negative", "random_config.max_simultaneous_failures")
+	}
+	if config.RegionRadius < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"region_radius must be non-negative", "random_config.region_radius")
+	}
+	return nil
+}
+
 // AnalyzeResilience анализирует устойчивость сети
 func (s *SimulationService) AnalyzeResilience(
 	ctx context.Context,
@@ -698,6 +1048,12 @@ func (s *SimulationService) AnalyzeResilience(
 		)
 	}
 
+	if req.Config != nil {
+		if err := validateResilienceConfig(req.Config); err != nil {
+			return nil, pkgerrors.ToGRPC(err)
+		}
+	}
+
 	start := time.Now()
 
 	result, err := s.resilienceEngine.AnalyzeResilience(ctx, req.Graph, req.Config, req.Algorithm)
@@ -717,6 +1073,23 @@ func (s *SimulationService) AnalyzeResilience(
 	return result, nil
 }
 
+// validateResilienceConfig проверяет настройки перебора отказов
+func validateResilienceConfig(config *simulationv1.ResilienceConfig) error {
+	if config.MaxFailuresToTest < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"max_failures_to_test must be non-negative", "config.max_failures_to_test")
+	}
+	if config.MaxCandidateEdges < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"max_candidate_edges must be non-negative", "config.max_candidate_edges")
+	}
+	if config.LoadFactor < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"load_factor must be non-negative", "config.load_factor")
+	}
+	return nil
+}
+
 // ============ TIME SIMULATION ============
 
 // RunTimeSimulation запускает временную симуляцию
@@ -732,6 +1105,9 @@ func (s *SimulationService) RunTimeSimulation(
 			pkgerrors.New(pkgerrors.CodeInvalidArgument, "graph is required"),
 		)
 	}
+	if err := validateTimeSimulation(req); err != nil {
+		return nil, pkgerrors.ToGRPC(err)
+	}
 
 	start := time.Now()
 
@@ -752,6 +1128,33 @@ func (s *SimulationService) RunTimeSimulation(
 	return result, nil
 }
 
+// validateTimeSimulation проверяет настройки временной симуляции
+func validateTimeSimulation(req *simulationv1.RunTimeSimulationRequest) error {
+	config := req.TimeConfig
+	if config.GetNumSteps() < 0 {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"num_steps must be non-negative", "time_config.num_steps")
+	}
+	if config.GetCarryOver() && config.GetMode() == simulationv1.TimeSimulationMode_TIME_SIMULATION_MODE_DYNAMIC {
+		return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+			"carry_over is supported only in static mode; dynamic mode stores goods via node storage_capacity",
+			"time_config.carry_over")
+	}
+	for _, speed := range config.GetRoadSpeeds() {
+		if speed.Speed <= 0 {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				fmt.Sprintf("speed for %s must be positive", speed.RoadType), "time_config.road_speeds")
+		}
+	}
+	for _, node := range req.Graph.Nodes {
+		if node.StorageCapacity < 0 {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				fmt.Sprintf("storage_capacity of node %d must be non-negative", node.Id), "graph.nodes.storage_capacity")
+		}
+	}
+	return nil
+}
+
 // SimulatePeakLoad симулирует пиковую нагрузку
 func (s *SimulationService) SimulatePeakLoad(
 	ctx context.Context,
@@ -785,6 +1188,77 @@ func (s *SimulationService) SimulatePeakLoad(
 	return result, nil
 }
 
+// ============ DISCRETE-EVENT SIMULATION ============
+
+// RunDiscreteEventSimulation проигрывает рейсы транспорта по решённому потоку
+func (s *SimulationService) RunDiscreteEventSimulation(
+	ctx context.Context,
+	req *simulationv1.RunDiscreteEventSimulationRequest,
+) (*simulationv1.RunDiscreteEventSimulationResponse, error) {
+	ctx, span := telemetry.StartSpan(ctx, "SimulationService.RunDiscreteEventSimulation")
+	defer span.End()
+
+	if req.SolvedGraph == nil {
+		return nil, pkgerrors.ToGRPC(
+			pkgerrors.New(pkgerrors.CodeInvalidArgument, "solved_graph is required"),
+		)
+	}
+	if err := validateDiscreteEventConfig(req.Config); err != nil {
+		return nil, pkgerrors.ToGRPC(err)
+	}
+
+	start := time.Now()
+
+	desEngine := engine.NewDiscreteEventEngine(req.Config)
+	result, err := desEngine.Run(ctx, req.SolvedGraph)
+	if err != nil {
+		telemetry.SetError(ctx, err)
+		return nil, pkgerrors.ToGRPC(
+			pkgerrors.Wrap(err, pkgerrors.CodeInternal, "discrete-event simulation failed"),
+		)
+	}
+
+	result.Metadata = &simulationv1.SimulationMetadata{
+		ComputationTimeMs: float64(time.Since(start).Milliseconds()),
+		Iterations:        int32(result.Stats.VehiclesDispatched),
+		CompletedAt:       timestamppb.Now(),
+	}
+
+	return result, nil
+}
+
+// validateDiscreteEventConfig проверяет параметры рейсов и постов
+func validateDiscreteEventConfig(config *simulationv1.DiscreteEventConfig) error {
+	if config == nil {
+		return nil
+	}
+	nonNegative := []struct {
+		value float64
+		field string
+	}{
+		{config.HorizonHours, "horizon_hours"},
+		{config.VehicleCapacity, "vehicle_capacity"},
+		{config.DepartureIntervalHours, "departure_interval_hours"},
+		{config.LoadingTimeHours, "loading_time_hours"},
+		{config.UnloadingTimeHours, "unloading_time_hours"},
+		{float64(config.DocksPerNode), "docks_per_node"},
+		{float64(config.HistogramBuckets), "histogram_buckets"},
+	}
+	for _, p := range nonNegative {
+		if p.value < 0 {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				p.field+" must be non-negative", "config."+p.field)
+		}
+	}
+	for _, speed := range config.RoadSpeeds {
+		if speed.Speed <= 0 {
+			return pkgerrors.NewWithField(pkgerrors.CodeInvalidArgument,
+				fmt.Sprintf("speed for %s must be positive", speed.RoadType), "config.road_speeds")
+		}
+	}
+	return nil
+}
+
 // ============ MANAGEMENT ============
 
 // SaveSimulation сохраняет симуляцию
@@ -1002,14 +1476,14 @@ func newBottleneckChangeAnalyzer(baseResult, modResult *client.SolveResult) *bot
 	}
 
 	if baseResult.Graph != nil {
-		for _, e := range baseResult.Graph.Edges {
-			a.baseEdges[edgeKey(e.From, e.To)] = e
+		for i, e := range baseResult.Graph.Edges {
+			a.baseEdges[parallelEdgeKey(e, i)] = e
 		}
 	}
 
 	if modResult.Graph != nil {
-		for _, e := range modResult.Graph.Edges {
-			a.modEdges[edgeKey(e.From, e.To)] = e
+		for i, e := range modResult.Graph.Edges {
+			a.modEdges[parallelEdgeKey(e, i)] = e
 		}
 	}
 
@@ -1096,7 +1570,7 @@ func (a *bottleneckChangeAnalyzer) addChange(
 	oldUtil, newUtil float64,
 ) {
 	a.changes = append(a.changes, &simulationv1.BottleneckChange{
-		Edge:           &commonv1.EdgeKey{From: edge.From, To: edge.To},
+		Edge:           &commonv1.EdgeKey{From: edge.From, To: edge.To, EdgeId: edge.Id},
 		ChangeType:     changeType,
 		OldUtilization: oldUtil,
 		NewUtilization: newUtil,
@@ -1132,11 +1606,14 @@ func (s *SimulationService) calculateModificationCost(mods []*simulationv1.Modif
 	return total
 }
 
-func (s *SimulationService) removeEdgeFromGraph(g *commonv1.Graph, from, to int64) *commonv1.Graph {
+// removeEdgeFromGraph удаляет ребро по ключу; ключ без EdgeId удаляет все
+// параллельные рёбра между From и To
+func (s *SimulationService) removeEdgeFromGraph(g *commonv1.Graph, key *commonv1.EdgeKey) *commonv1.Graph {
 	clone := engine.CloneGraph(g)
-	newEdges := make([]*commonv1.Edge, 0, len(clone.Edges)-1)
-	for _, e := range clone.Edges {
-		if e.From != from || e.To != to {
+	engine.AssignEdgeIDs(clone)
+	newEdges := make([]*commonv1.Edge, 0, len(clone.Edges))
+	for i, e := range clone.Edges {
+		if !engine.MatchesEdgeKey(e, i, key) {
 			newEdges = append(newEdges, e)
 		}
 	}
@@ -1209,11 +1686,22 @@ func (s *SimulationService) calculateResilienceScore(
 	return 1.0 - criticalRatio
 }
 
+// calculateFailureStats считает статистику отказов. Для сэмплированных
+// сценариев (occurrences > 0) ожидания — выборочные средние по выпадениям,
+// с оценкой стандартной ошибки ожидаемой потери потока.
 func (s *SimulationService) calculateFailureStats(results []*simulationv1.FailureScenarioResult, baseFlow float64) *simulationv1.FailureStats {
 	if len(results) == 0 {
 		return &simulationv1.FailureStats{}
 	}
 
+	var samples int
+	for _, r := range results {
+		samples += int(r.Occurrences)
+	}
+	if samples > 0 {
+		return sampledFailureStats(results, baseFlow, samples)
+	}
+
 	var totalLoss, maxLoss, totalProbability float64
 	var disconnections int
 
@@ -1243,6 +1731,39 @@ func (s *SimulationService) calculateFailureStats(results []*simulationv1.Failur
 	}
 }
 
+// sampledFailureStats оценивает статистику по samples выпадениям сценариев
+func sampledFailureStats(results []*simulationv1.FailureScenarioResult, baseFlow float64, samples int) *simulationv1.FailureStats {
+	n := float64(samples)
+	var mean, maxLoss, disconnection float64
+	losses := make([]float64, len(results))
+	for i, r := range results {
+		w := float64(r.Occurrences) / n
+		losses[i] = max(baseFlow-r.Result.MaxFlow, 0)
+		mean += w * losses[i]
+		maxLoss = max(maxLoss, losses[i])
+		if r.NetworkDisconnected {
+			disconnection += w
+		}
+	}
+
+	var stdError float64
+	if samples > 1 {
+		var sumSq float64
+		for i, r := range results {
+			d := losses[i] - mean
+			sumSq += float64(r.Occurrences) * d * d
+		}
+		stdError = math.Sqrt(sumSq / (n - 1) / n)
+	}
+
+	return &simulationv1.FailureStats{
+		ExpectedFlowLoss:           mean,
+		MaxFlowLoss:                maxLoss,
+		ProbabilityOfDisconnection: disconnection,
+		ExpectedFlowLossStdError:   stdError,
+	}
+}
+
 func (s *SimulationService) generateResilienceRecommendations(
 	results []*simulationv1.FailureScenarioResult,
 	g *commonv1.Graph,
@@ -1271,54 +1792,17 @@ func (s *SimulationService) generateResilienceRecommendations(
 	return recs
 }
 
+// generateRandomFailureScenarios сэмплирует сценарии из модели отказов и
+// возвращает их вместе с использованным seed
 func (s *SimulationService) generateRandomFailureScenarios(
 	g *commonv1.Graph,
 	config *simulationv1.RandomFailureConfig,
-) []*simulationv1.FailureScenario {
-	var scenarios []*simulationv1.FailureScenario
-
-	numScenarios := int(config.NumScenarios)
-	if numScenarios <= 0 {
-		numScenarios = 10
-	}
-
-	edgeProb := config.EdgeFailureProbability
-	if edgeProb <= 0 {
-		edgeProb = 0.1
-	}
-
-	maxFailures := int(config.MaxSimultaneousFailures)
-	if maxFailures <= 0 {
-		maxFailures = 3
-	}
-
-	// Простая генерация: для каждого сценария случайно выбираем рёбра
-	for i := 0; i < numScenarios; i++ {
-		scenario := &simulationv1.FailureScenario{
-			Name:        fmt.Sprintf("Random Scenario %d", i+1),
-			Probability: 1.0 / float64(numScenarios),
-		}
-
-		failedCount := 0
-		for _, edge := range g.Edges {
-			if failedCount >= maxFailures {
-				break
-			}
-			// Простой детерминистический выбор на основе индекса
-			// (в реальной реализации использовать rand)
-			if (int(edge.From)+int(edge.To)+i)%10 < int(edgeProb*10) {
-				scenario.FailedEdges = append(scenario.FailedEdges, &commonv1.EdgeKey{
-					From: edge.From,
-					To:   edge.To,
-				})
-				failedCount++
-			}
-		}
-
-		scenarios = append(scenarios, scenario)
+) ([]*simulationv1.FailureScenario, int64, error) {
+	model, err := engine.NewFailureModel(g, config)
+	if err != nil {
+		return nil, 0, err
 	}
-
-	return scenarios
+	return model.Sample(int(config.NumScenarios)), model.Seed(), nil
 }
 
 func parseSimulationType(s string) simulationv1.SimulationType {
@@ -1352,3 +1836,8 @@ func splitOnce(s, sep string) []string {
 func edgeKey(from, to int64) string {
 	return fmt.Sprintf("%d->%d", from, to)
 }
+
+// parallelEdgeKey различает параллельные рёбра по их идентификатору
+func parallelEdgeKey(e *commonv1.Edge, index int) string {
+	return fmt.Sprintf("%s#%d", edgeKey(e.From, e.To), engine.EdgeID(e, index))
+}
@@ -4,14 +4,18 @@ package service
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
+	analyticsv1 "logistics/gen/go/logistics/analytics/v1"
 	commonv1 "logistics/gen/go/logistics/common/v1"
+	optimizationv1 "logistics/gen/go/logistics/optimization/v1"
 	simulationv1 "logistics/gen/go/logistics/simulation/v1"
 	"logistics/pkg/client"
 	"logistics/services/simulation-svc/internal/engine"
@@ -206,6 +210,12 @@ func TestSimulationService_RunWhatIf_Success(t *testing.T) {
 	assert.NotNil(t, resp.ModifiedGraph)
 	assert.NotNil(t, resp.Metadata)
 	mockSolver.AssertExpectations(t)
+
+	// Модифицированный граф достраивается из базового решения
+	assert.Nil(t, mockSolver.Calls[0].Arguments.Get(3))
+	opts, ok := mockSolver.Calls[1].Arguments.Get(3).(*optimizationv1.SolveOptions)
+	require.True(t, ok)
+	assert.Same(t, baselineResult.Graph, opts.GetWarmStart())
 }
 
 func TestSimulationService_RunWhatIf_NoGraph(t *testing.T) {
@@ -499,6 +509,191 @@ func TestSimulationService_CompareScenarios_ScenarioSolverError(t *testing.T) {
 	mockSolver.AssertExpectations(t)
 }
 
+// ============================================================
+// CAPACITY EXPANSION TESTS
+// ============================================================
+
+func TestSimulationService_OptimizeCapacityExpansion_Success(t *testing.T) {
+	mockSolver := new(MockSolverClientInterface)
+	mockSolver.On("Solve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(createTestSolveResult(100, 50), nil).Once()
+
+	svc := NewSimulationServiceWithInterface(new(MockSimulationRepository), mockSolver, "1.0.0")
+
+	// Поток решения не упирается в разрез: расширять нечего
+	resp, err := svc.OptimizeCapacityExpansion(context.Background(), &simulationv1.OptimizeCapacityExpansionRequest{
+		Graph:          createTestGraph(),
+		Budget:         100,
+		DefaultUpgrade: &simulationv1.EdgeUpgradeOption{CostPerUnit: 1},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, 100.0, resp.BaseFlow)
+	assert.Equal(t, 100.0, resp.FinalFlow)
+	assert.Empty(t, resp.Steps)
+	assert.Equal(t, 100.0, resp.RemainingBudget)
+	assert.Equal(t, simulationv1.ExpansionStopReason_EXPANSION_STOP_REASON_NO_GAIN, resp.StopReason)
+	require.NotNil(t, resp.Metadata)
+	mockSolver.AssertExpectations(t)
+}
+
+func TestSimulationService_OptimizeCapacityExpansion_InvalidRequest(t *testing.T) {
+	svc := NewSimulationServiceWithInterface(new(MockSimulationRepository), new(MockSolverClientInterface), "1.0.0")
+
+	tests := []struct {
+		name string
+		req  *simulationv1.OptimizeCapacityExpansionRequest
+		want string
+	}{
+		{
+			name: "no graph",
+			req:  &simulationv1.OptimizeCapacityExpansionRequest{},
+			want: "graph is required",
+		},
+		{
+			name: "negative budget",
+			req: &simulationv1.OptimizeCapacityExpansionRequest{
+				Graph:          createTestGraph(),
+				Budget:         -1,
+				DefaultUpgrade: &simulationv1.EdgeUpgradeOption{},
+			},
+			want: "budget",
+		},
+		{
+			name: "no upgrades",
+			req:  &simulationv1.OptimizeCapacityExpansionRequest{Graph: createTestGraph(), Budget: 10},
+			want: "upgrades or default_upgrade",
+		},
+		{
+			name: "upgrade without edge",
+			req: &simulationv1.OptimizeCapacityExpansionRequest{
+				Graph:    createTestGraph(),
+				Upgrades: []*simulationv1.EdgeUpgradeOption{{CostPerUnit: 1}},
+			},
+			want: "edge is required",
+		},
+		{
+			name: "negative cost",
+			req: &simulationv1.OptimizeCapacityExpansionRequest{
+				Graph:          createTestGraph(),
+				DefaultUpgrade: &simulationv1.EdgeUpgradeOption{CostPerUnit: -1},
+			},
+			want: "must be non-negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.OptimizeCapacityExpansion(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+// ============================================================
+// FACILITY LOCATION TESTS
+// ============================================================
+
+func createFacilityTestGraph() *commonv1.Graph {
+	return &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE},
+			{Id: 2, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT, Demand: 10},
+		},
+		Edges: []*commonv1.Edge{{From: 1, To: 2, Capacity: 100, Cost: 5}},
+	}
+}
+
+func TestSimulationService_OptimizeFacilityLocation_Success(t *testing.T) {
+	mockSolver := new(MockSolverClientInterface)
+	mockSolver.On("Solve", mock.Anything, mock.Anything, commonv1.Algorithm_ALGORITHM_MIN_COST, mock.Anything).
+		Return(createTestSolveResult(10, 50), nil).Once()
+
+	svc := NewSimulationServiceWithInterface(new(MockSimulationRepository), mockSolver, "1.0.0")
+
+	// Единственный склад закрыть нельзя: без него спрос не обслуживается
+	resp, err := svc.OptimizeFacilityLocation(context.Background(), &simulationv1.OptimizeFacilityLocationRequest{
+		Graph: createFacilityTestGraph(),
+		FixedCosts: &analyticsv1.FixedCostConfig{
+			WarehouseCosts: map[int64]float64{1: 300},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, []int64{1}, resp.OpenWarehouses)
+	assert.Empty(t, resp.Moves)
+	assert.Equal(t, 300.0, resp.FixedCost)
+	assert.Equal(t, 50.0, resp.TransportCost)
+	assert.Equal(t, 350.0, resp.TotalCost)
+	assert.Equal(t, 10.0, resp.ServedDemand)
+	require.NotNil(t, resp.Metadata)
+	assert.Equal(t, "ALGORITHM_MIN_COST", resp.Metadata.AlgorithmUsed)
+	mockSolver.AssertExpectations(t)
+}
+
+func TestSimulationService_OptimizeFacilityLocation_InvalidRequest(t *testing.T) {
+	svc := NewSimulationServiceWithInterface(new(MockSimulationRepository), new(MockSolverClientInterface), "1.0.0")
+
+	tests := []struct {
+		name string
+		req  *simulationv1.OptimizeFacilityLocationRequest
+		want string
+	}{
+		{
+			name: "no graph",
+			req:  &simulationv1.OptimizeFacilityLocationRequest{},
+			want: "graph is required",
+		},
+		{
+			name: "no warehouses",
+			req:  &simulationv1.OptimizeFacilityLocationRequest{Graph: createTestGraph()},
+			want: "NODE_TYPE_WAREHOUSE",
+		},
+		{
+			name: "no demand",
+			req: &simulationv1.OptimizeFacilityLocationRequest{
+				Graph: &commonv1.Graph{Nodes: []*commonv1.Node{{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE}}},
+			},
+			want: "NODE_TYPE_DELIVERY_POINT",
+		},
+		{
+			name: "initial open not a warehouse",
+			req: &simulationv1.OptimizeFacilityLocationRequest{
+				Graph:       createFacilityTestGraph(),
+				InitialOpen: []int64{2},
+			},
+			want: "not a warehouse",
+		},
+		{
+			name: "negative penalty",
+			req: &simulationv1.OptimizeFacilityLocationRequest{
+				Graph:              createFacilityTestGraph(),
+				UnmetDemandPenalty: -1,
+			},
+			want: "unmet_demand_penalty",
+		},
+		{
+			name: "negative warehouse cost",
+			req: &simulationv1.OptimizeFacilityLocationRequest{
+				Graph:      createFacilityTestGraph(),
+				FixedCosts: &analyticsv1.FixedCostConfig{WarehouseCosts: map[int64]float64{1: -5}},
+			},
+			want: "warehouse_costs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.OptimizeFacilityLocation(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
 // ============================================================
 // MONTE CARLO TESTS
 // ============================================================
@@ -562,6 +757,128 @@ func TestSimulationService_RunMonteCarlo_NoGraph(t *testing.T) {
 	assert.Contains(t, err.Error(), "graph is required")
 }
 
+func TestSimulationService_RunMonteCarlo_ReplayIterations(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockSimulationRepository)
+	mockSolver := new(MockSolverClientInterface)
+	mockSolver.On("Solve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(createTestSolveResult(100, 50), nil)
+
+	svc := NewSimulationServiceWithInterface(repo, mockSolver, "1.0.0")
+
+	resp, err := svc.RunMonteCarlo(ctx, &simulationv1.RunMonteCarloRequest{
+		Graph: createTestGraph(),
+		Config: &simulationv1.MonteCarloConfig{
+			RandomSeed:       42,
+			ReturnSamples:    true,
+			ReplayIterations: []int32{3, 8},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.Metadata.Iterations)
+	require.Len(t, resp.Samples, 2)
+	assert.Equal(t, int32(3), resp.Samples[0].Iteration)
+	mockSolver.AssertNumberOfCalls(t, "Solve", 2)
+
+	for _, config := range []*simulationv1.MonteCarloConfig{
+		{ReplayIterations: []int32{3}},                  // без seed
+		{RandomSeed: 42, ReplayIterations: []int32{-1}}, // отрицательный номер
+		{ // вне стратифицированного прогона
+			RandomSeed:       42,
+			NumIterations:    10,
+			SamplingMethod:   simulationv1.SamplingMethod_SAMPLING_METHOD_SOBOL,
+			ReplayIterations: []int32{10},
+		},
+	} {
+		_, err := svc.RunMonteCarlo(ctx, &simulationv1.RunMonteCarloRequest{Graph: createTestGraph(), Config: config})
+		assert.ErrorContains(t, err, "replay")
+	}
+}
+
+func TestSimulationService_RunMonteCarlo_Correlation(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockSimulationRepository)
+	mockSolver := new(MockSolverClientInterface)
+	mockSolver.On("Solve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(createTestSolveResult(100, 50), nil)
+
+	svc := NewSimulationServiceWithInterface(repo, mockSolver, "1.0.0")
+	uncertainties := []*simulationv1.UncertaintySpec{
+		{Type: simulationv1.UncertaintyType_UNCERTAINTY_TYPE_GLOBAL, Target: simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY},
+		{Type: simulationv1.UncertaintyType_UNCERTAINTY_TYPE_GLOBAL, Target: simulationv1.ModificationTarget_MODIFICATION_TARGET_COST},
+	}
+
+	resp, err := svc.RunMonteCarlo(ctx, &simulationv1.RunMonteCarloRequest{
+		Graph:         createTestGraph(),
+		Config:        &simulationv1.MonteCarloConfig{NumIterations: 20, RandomSeed: 42},
+		Uncertainties: uncertainties,
+		Correlation:   &simulationv1.UncertaintyCorrelation{Matrix: []float64{1, 0.5, 0.5, 1}},
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	_, err = svc.RunMonteCarlo(ctx, &simulationv1.RunMonteCarloRequest{
+		Graph:         createTestGraph(),
+		Config:        &simulationv1.MonteCarloConfig{NumIterations: 20},
+		Uncertainties: uncertainties,
+		Correlation:   &simulationv1.UncertaintyCorrelation{Matrix: []float64{1, 0.5, 0.5}},
+	})
+	assert.ErrorContains(t, err, "correlation matrix")
+}
+
+// monteCarloStream собирает сообщения RunMonteCarloStream
+type monteCarloStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*simulationv1.MonteCarloProgress
+}
+
+func (s *monteCarloStream) Context() context.Context { return s.ctx }
+
+func (s *monteCarloStream) Send(p *simulationv1.MonteCarloProgress) error {
+	s.sent = append(s.sent, p)
+	return nil
+}
+
+func TestSimulationService_RunMonteCarloStream_Adaptive(t *testing.T) {
+	repo := new(MockSimulationRepository)
+	mockSolver := new(MockSolverClientInterface)
+	mockSolver.On("Solve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(createTestSolveResult(100, 50), nil)
+
+	svc := NewSimulationServiceWithInterface(repo, mockSolver, "1.0.0")
+	stream := &monteCarloStream{ctx: context.Background()}
+
+	err := svc.RunMonteCarloStream(&simulationv1.RunMonteCarloRequest{
+		Graph: createTestGraph(),
+		Config: &simulationv1.MonteCarloConfig{
+			NumIterations:             1000,
+			RandomSeed:                42,
+			TargetRelativeCiHalfWidth: 0.01,
+		},
+	}, stream)
+	require.NoError(t, err)
+
+	// Постоянный отклик сходится на первой контрольной точке
+	require.NotEmpty(t, stream.sent)
+	final := stream.sent[len(stream.sent)-1]
+	assert.Equal(t, "completed", final.Status)
+	require.NotNil(t, final.Result)
+	assert.Equal(t, simulationv1.MonteCarloStopReason_MONTE_CARLO_STOP_REASON_CONVERGED, final.Result.StopReason)
+	assert.Equal(t, int32(100), final.Result.CompletedIterations)
+	assert.Equal(t, int32(100), final.Result.Metadata.Iterations)
+	for _, progress := range stream.sent[:len(stream.sent)-1] {
+		assert.Equal(t, "running", progress.Status)
+	}
+
+	err = svc.RunMonteCarloStream(&simulationv1.RunMonteCarloRequest{
+		Graph:  createTestGraph(),
+		Config: &simulationv1.MonteCarloConfig{TargetRelativeCiHalfWidth: -1},
+	}, &monteCarloStream{ctx: context.Background()})
+	assert.ErrorContains(t, err, "target_relative_ci_half_width")
+}
+
 func TestSimulationService_RunMonteCarlo_DefaultConfig(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockSimulationRepository)
@@ -645,6 +962,48 @@ func TestSimulationService_AnalyzeSensitivity_NoGraph(t *testing.T) {
 	assert.Contains(t, err.Error(), "graph is required")
 }
 
+func TestSimulationService_AnalyzeSensitivity_Morris(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockSimulationRepository)
+	mockSolver := new(MockSolverClientInterface)
+	mockSolver.On("Solve", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(createTestSolveResult(100, 50), nil)
+
+	svc := NewSimulationServiceWithInterface(repo, mockSolver, "1.0.0")
+	params := []*simulationv1.SensitivityParameter{
+		{
+			Edge:   &commonv1.EdgeKey{From: 1, To: 2},
+			Target: simulationv1.ModificationTarget_MODIFICATION_TARGET_CAPACITY,
+		},
+	}
+
+	resp, err := svc.AnalyzeSensitivity(ctx, &simulationv1.AnalyzeSensitivityRequest{
+		Graph:      createTestGraph(),
+		Parameters: params,
+		Config: &simulationv1.SensitivityConfig{
+			Method:          simulationv1.SensitivityMethod_SENSITIVITY_METHOD_MORRIS,
+			NumTrajectories: 3,
+			RandomSeed:      42,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.ParameterResults, 1)
+	require.NotNil(t, resp.ParameterResults[0].Morris)
+	assert.Zero(t, resp.ParameterResults[0].Morris.MuStar)
+	// Базовое решение и 3 траектории по 2 точки
+	mockSolver.AssertNumberOfCalls(t, "Solve", 7)
+
+	_, err = svc.AnalyzeSensitivity(ctx, &simulationv1.AnalyzeSensitivityRequest{
+		Graph:      createTestGraph(),
+		Parameters: params,
+		Config: &simulationv1.SensitivityConfig{
+			Method:    simulationv1.SensitivityMethod_SENSITIVITY_METHOD_MORRIS,
+			NumLevels: 3,
+		},
+	})
+	assert.ErrorContains(t, err, "num_levels")
+}
+
 func TestSimulationService_AnalyzeSensitivity_MultipleParameters(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockSimulationRepository)
@@ -983,6 +1342,19 @@ func TestSimulationService_AnalyzeResilience_NoGraph(t *testing.T) {
 	assert.Contains(t, err.Error(), "graph is required")
 }
 
+func TestSimulationService_AnalyzeResilience_InvalidConfig(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockSimulationRepository)
+	svc := NewSimulationService(repo, nil, "1.0.0")
+
+	resp, err := svc.AnalyzeResilience(ctx, &simulationv1.AnalyzeResilienceRequest{
+		Graph:  createTestGraph(),
+		Config: &simulationv1.ResilienceConfig{MaxFailuresToTest: 2, LoadFactor: -0.5},
+	})
+	assert.Nil(t, resp)
+	assert.ErrorContains(t, err, "load_factor")
+}
+
 // ============================================================
 // TIME SIMULATION TESTS
 // ============================================================
@@ -1032,6 +1404,39 @@ func TestSimulationService_RunTimeSimulation_NoGraph(t *testing.T) {
 	assert.Contains(t, err.Error(), "graph is required")
 }
 
+func TestSimulationService_RunTimeSimulation_InvalidDynamicConfig(t *testing.T) {
+	svc := NewSimulationServiceWithInterface(new(MockSimulationRepository), new(MockSolverClientInterface), "1.0.0")
+
+	graph := createTestGraph()
+	graph.Nodes[1].StorageCapacity = -1
+	_, err := svc.RunTimeSimulation(context.Background(), &simulationv1.RunTimeSimulationRequest{
+		Graph:      graph,
+		TimeConfig: &simulationv1.TimeSimulationConfig{Mode: simulationv1.TimeSimulationMode_TIME_SIMULATION_MODE_DYNAMIC},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "storage_capacity")
+
+	_, err = svc.RunTimeSimulation(context.Background(), &simulationv1.RunTimeSimulationRequest{
+		Graph: createTestGraph(),
+		TimeConfig: &simulationv1.TimeSimulationConfig{
+			Mode:       simulationv1.TimeSimulationMode_TIME_SIMULATION_MODE_DYNAMIC,
+			RoadSpeeds: []*simulationv1.RoadTypeSpeed{{RoadType: commonv1.RoadType_ROAD_TYPE_URBAN}},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ROAD_TYPE_URBAN")
+
+	_, err = svc.RunTimeSimulation(context.Background(), &simulationv1.RunTimeSimulationRequest{
+		Graph: createTestGraph(),
+		TimeConfig: &simulationv1.TimeSimulationConfig{
+			Mode:      simulationv1.TimeSimulationMode_TIME_SIMULATION_MODE_DYNAMIC,
+			CarryOver: true,
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "carry_over")
+}
+
 func TestSimulationService_RunTimeSimulation_WithPatterns(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockSimulationRepository)
@@ -1074,6 +1479,52 @@ func TestSimulationService_RunTimeSimulation_WithPatterns(t *testing.T) {
 	assert.True(t, resp.Success)
 }
 
+// ============================================================
+// DISCRETE-EVENT SIMULATION TESTS
+// ============================================================
+
+func TestSimulationService_RunDiscreteEventSimulation_Success(t *testing.T) {
+	svc := NewSimulationService(new(MockSimulationRepository), nil, "1.0.0")
+
+	resp, err := svc.RunDiscreteEventSimulation(context.Background(), &simulationv1.RunDiscreteEventSimulationRequest{
+		SolvedGraph: createTestGraph(),
+		Config:      &simulationv1.DiscreteEventConfig{HorizonHours: 4, RandomSeed: 7},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Len(t, resp.Routes, 2)
+	assert.Equal(t, 18.0, resp.Stats.PlannedThroughput)
+	assert.Equal(t, resp.Stats.VehiclesDispatched, resp.Stats.VehiclesDelivered)
+	assert.Equal(t, int64(7), resp.RandomSeed)
+	require.NotNil(t, resp.Metadata)
+	assert.Equal(t, resp.Stats.VehiclesDispatched, resp.Metadata.Iterations)
+}
+
+func TestSimulationService_RunDiscreteEventSimulation_InvalidRequest(t *testing.T) {
+	svc := NewSimulationService(new(MockSimulationRepository), nil, "1.0.0")
+
+	_, err := svc.RunDiscreteEventSimulation(context.Background(), &simulationv1.RunDiscreteEventSimulationRequest{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "solved_graph is required")
+
+	_, err = svc.RunDiscreteEventSimulation(context.Background(), &simulationv1.RunDiscreteEventSimulationRequest{
+		SolvedGraph: createTestGraph(),
+		Config:      &simulationv1.DiscreteEventConfig{DocksPerNode: -1},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "docks_per_node")
+
+	_, err = svc.RunDiscreteEventSimulation(context.Background(), &simulationv1.RunDiscreteEventSimulationRequest{
+		SolvedGraph: createTestGraph(),
+		Config: &simulationv1.DiscreteEventConfig{
+			RoadSpeeds: []*simulationv1.RoadTypeSpeed{{RoadType: commonv1.RoadType_ROAD_TYPE_HIGHWAY}},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ROAD_TYPE_HIGHWAY")
+}
+
 // ============================================================
 // PEAK LOAD TESTS
 // ============================================================
@@ -1588,7 +2039,7 @@ func TestSimulationService_RemoveEdgeFromGraph(t *testing.T) {
 	svc := NewSimulationService(nil, nil, "1.0.0")
 	graph := createTestGraph()
 
-	result := svc.removeEdgeFromGraph(graph, 1, 2)
+	result := svc.removeEdgeFromGraph(graph, &commonv1.EdgeKey{From: 1, To: 2})
 
 	assert.Equal(t, 3, len(result.Edges))
 	for _, edge := range result.Edges {
@@ -1734,18 +2185,32 @@ func TestSimulationService_GenerateRandomFailureScenarios(t *testing.T) {
 	graph := createTestGraph()
 
 	config := &simulationv1.RandomFailureConfig{
-		NumScenarios:            5,
+		NumScenarios:            50,
 		EdgeFailureProbability:  0.3,
 		MaxSimultaneousFailures: 2,
+		RandomSeed:              7,
 	}
 
-	scenarios := svc.generateRandomFailureScenarios(graph, config)
+	scenarios, seed, err := svc.generateRandomFailureScenarios(graph, config)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), seed)
 
-	assert.Len(t, scenarios, 5)
+	var occurrences int32
 	for _, s := range scenarios {
 		assert.Contains(t, s.Name, "Random Scenario")
 		assert.LessOrEqual(t, len(s.FailedEdges), 2)
-		assert.InDelta(t, 0.2, s.Probability, 0.01)
+		assert.Greater(t, s.Probability, 0.0)
+		occurrences += s.Occurrences
+	}
+	assert.Equal(t, int32(50), occurrences)
+
+	// Тот же seed — те же сценарии
+	again, _, err := svc.generateRandomFailureScenarios(graph, config)
+	require.NoError(t, err)
+	assert.Equal(t, len(scenarios), len(again))
+	for i := range scenarios {
+		assert.Equal(t, scenarios[i].FailedEdges, again[i].FailedEdges)
+		assert.Equal(t, scenarios[i].Occurrences, again[i].Occurrences)
 	}
 }
 
@@ -1759,9 +2224,27 @@ func TestSimulationService_GenerateRandomFailureScenarios_DefaultConfig(t *testi
 		MaxSimultaneousFailures: 0,
 	}
 
-	scenarios := svc.generateRandomFailureScenarios(graph, config)
+	scenarios, seed, err := svc.generateRandomFailureScenarios(graph, config)
+	require.NoError(t, err)
+	assert.NotZero(t, seed)
 
-	assert.Len(t, scenarios, 10)
+	var occurrences int32
+	for _, s := range scenarios {
+		occurrences += s.Occurrences
+	}
+	assert.Equal(t, int32(10), occurrences)
+}
+
+func TestSimulationService_SimulateFailures_InvalidRandomConfig(t *testing.T) {
+	svc := NewSimulationServiceWithInterface(new(MockSimulationRepository), new(MockSolverClientInterface), "1.0.0")
+
+	_, err := svc.SimulateFailures(context.Background(), &simulationv1.SimulateFailuresRequest{
+		Graph:        createTestGraph(),
+		RandomConfig: &simulationv1.RandomFailureConfig{NodeFailureProbability: 1.5},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "node_failure_probability")
 }
 
 func TestSimulationService_CalculateFailureStats(t *testing.T) {
@@ -1795,6 +2278,24 @@ func TestSimulationService_CalculateFailureStats(t *testing.T) {
 	assert.InDelta(t, 1.0/3.0, stats.ProbabilityOfDisconnection, 0.01)
 }
 
+func TestSimulationService_CalculateFailureStats_Sampled(t *testing.T) {
+	svc := NewSimulationService(nil, nil, "1.0.0")
+
+	results := []*simulationv1.FailureScenarioResult{
+		{Occurrences: 6, Result: &simulationv1.ScenarioResult{MaxFlow: 100}},
+		{Occurrences: 3, Result: &simulationv1.ScenarioResult{MaxFlow: 80}},
+		{Occurrences: 1, Result: &simulationv1.ScenarioResult{MaxFlow: 0}, NetworkDisconnected: true},
+	}
+
+	stats := svc.calculateFailureStats(results, 100)
+
+	// Потери 0×6, 20×3, 100×1: среднее 16, выборочная дисперсия 960
+	assert.InDelta(t, 16.0, stats.ExpectedFlowLoss, 1e-9)
+	assert.Equal(t, 100.0, stats.MaxFlowLoss)
+	assert.InDelta(t, 0.1, stats.ProbabilityOfDisconnection, 1e-9)
+	assert.InDelta(t, math.Sqrt(960.0/10), stats.ExpectedFlowLossStdError, 1e-9)
+}
+
 func TestSimulationService_CalculateFailureStats_Empty(t *testing.T) {
 	svc := NewSimulationService(nil, nil, "1.0.0")
 
@@ -8,7 +8,7 @@
 //
 // The solver service exposes the following capabilities via gRPC:
 //   - Maximum flow computation (Ford-Fulkerson, Edmonds-Karp, Dinic, Push-Relabel)
-//   - Minimum cost maximum flow (Successive Shortest Path, Capacity Scaling)
+//   - Minimum cost maximum flow (Successive Shortest Path, Capacity Scaling, Cost Scaling)
 //   - Algorithm recommendation based on graph characteristics
 //   - Batch processing for multiple flow problems
 //   - Result caching for repeated queries
@@ -31,7 +31,7 @@
 //	│  (internal/algorithms/*.go)                                 │
 //	│  - Ford-Fulkerson, Edmonds-Karp, Dinic                     │
 //	│  - Push-Relabel (FIFO, Highest Label, Lowest Label)        │
-//	│  - Min-Cost Flow (SSP, Capacity Scaling)                   │
+//	│  - Min-Cost Flow (SSP, Capacity/Cost Scaling)              │
 //	│  - Bellman-Ford, Dijkstra (shortest paths)                 │
 //	├─────────────────────────────────────────────────────────────┤
 //	│                       Graph Layer                           │
@@ -155,6 +155,7 @@
 //	Algorithm Selection:
 //	  - Automatic algorithm recommendation based on graph characteristics
 //	  - Capacity Scaling for large capacity values (>1e6)
+//	  - Cost Scaling for large cost ranges (>1e5)
 //	  - Push-Relabel for dense graphs
 //	  - Dinic for sparse graphs and bipartite matching
 //
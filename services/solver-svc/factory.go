@@ -12,3 +12,12 @@ func NewBenchmarkServer() optimizationv1.SolverServiceServer {
 	// Здесь мы вызываем внутренний конструктор
 	return service.NewSolverService("benchmark", nil)
 }
+
+// NewBenchmarkServerWithCSRThreshold создаёт экземпляр сервиса для бенчмарков
+// с заданным порогом перехода на CSR-граф: 1 — всегда CSR, -1 — всегда
+// граф на map, 0 — порог по умолчанию.
+func NewBenchmarkServerWithCSRThreshold(threshold int) optimizationv1.SolverServiceServer {
+	config := service.DefaultServiceConfig()
+	config.CSRThreshold = threshold
+	return service.NewSolverServiceWithConfig("benchmark", nil, config)
+}
@@ -774,6 +774,10 @@ const (
 	// MinCostAlgorithmCapacityScaling selects Capacity Scaling algorithm.
 	// Best for: large graphs with high capacity values (> 10^6).
 	MinCostAlgorithmCapacityScaling
+
+	// MinCostAlgorithmCostScaling selects Cost Scaling algorithm.
+	// Best for: graphs with a large cost range (> 10^5).
+	MinCostAlgorithmCostScaling
 )
 
 // String returns the algorithm name for logging/debugging.
@@ -783,6 +787,8 @@ func (t MinCostAlgorithmType) String() string {
 		return "SuccessiveShortestPath"
 	case MinCostAlgorithmCapacityScaling:
 		return "CapacityScaling"
+	case MinCostAlgorithmCostScaling:
+		return "CostScaling"
 	default:
 		return "Unknown"
 	}
@@ -800,15 +806,46 @@ func ShouldUseCapacityScaling(g *graph.ResidualGraph) bool {
 	return findMaxCapacity(g) > CapacityScalingThreshold
 }
 
+// ShouldUseCostScaling determines if Cost Scaling is recommended for the
+// given graph based on the spread of edge costs.
+//
+// Returns true if the difference between the largest and smallest edge
+// cost exceeds CostScalingRangeThreshold (10^5).
+func ShouldUseCostScaling(g *graph.ResidualGraph) bool {
+	return findCostRange(g) > CostScalingRangeThreshold
+}
+
+// findCostRange returns the difference between the largest and smallest
+// cost of the forward edges of g.
+func findCostRange(g *graph.ResidualGraph) float64 {
+	minCost, maxCost := math.Inf(1), math.Inf(-1)
+	for _, edges := range g.EdgesList {
+		for _, edge := range edges {
+			if !edge.IsReverse {
+				minCost = math.Min(minCost, edge.Cost)
+				maxCost = math.Max(maxCost, edge.Cost)
+			}
+		}
+	}
+	if maxCost < minCost {
+		return 0
+	}
+	return maxCost - minCost
+}
+
 // RecommendMinCostAlgorithm analyzes graph characteristics and recommends
 // the most suitable min-cost flow algorithm.
 //
 // Decision factors:
+//   - Cost range (a large spread of costs favors Cost Scaling)
 //   - Maximum edge capacity (high capacity favors Capacity Scaling)
 //   - Graph size (very small graphs don't benefit from scaling)
 //
 // Returns the recommended algorithm type.
 func RecommendMinCostAlgorithm(g *graph.ResidualGraph) MinCostAlgorithmType {
+	if ShouldUseCostScaling(g) {
+		return MinCostAlgorithmCostScaling
+	}
 	if ShouldUseCapacityScaling(g) {
 		return MinCostAlgorithmCapacityScaling
 	}
@@ -268,6 +268,7 @@ func TestMinCostAlgorithmType_String(t *testing.T) {
 	}{
 		{MinCostAlgorithmSSP, "SuccessiveShortestPath"},
 		{MinCostAlgorithmCapacityScaling, "CapacityScaling"},
+		{MinCostAlgorithmCostScaling, "CostScaling"},
 		{MinCostAlgorithmType(999), "Unknown"},
 	}
 
@@ -486,3 +487,13 @@ func TestRecommendMinCostAlgorithm_LargeCapacity(t *testing.T) {
 
 	assert.Equal(t, MinCostAlgorithmCapacityScaling, algo)
 }
+
+func TestRecommendMinCostAlgorithm_LargeCostRange(t *testing.T) {
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithReverse(1, 2, 1e7, 1)
+	g.AddEdgeWithReverse(2, 3, 100, 1e6)
+
+	algo := RecommendMinCostAlgorithm(g)
+
+	assert.Equal(t, MinCostAlgorithmCostScaling, algo)
+}
@@ -0,0 +1,505 @@
+package algorithms
+
+import (
+	"context"
+	"math"
+
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Cost Scaling Algorithm (Goldberg–Tarjan)
+// =============================================================================
+//
+// Cost scaling solves min-cost flow as a sequence of push-relabel phases on
+// node prices. A flow is ε-optimal if every residual arc has reduced cost
+// c(u,v) + π(u) - π(v) >= -ε. Each refine phase turns an ε·α-optimal flow
+// into an ε-optimal one: it saturates every arc with negative reduced cost
+// and then pushes the resulting excesses along admissible arcs (reduced cost
+// below zero), lowering the price of a node whenever it has none. Costs are
+// multiplied by n+1, so the flow of the phase with ε = 1 is optimal.
+//
+// As with network simplex, the problem is solved on the residual arcs of a
+// graph.CSRGraph, so flow already in the graph is respected and negative
+// cycles with residual capacity are cancelled. The source supplies the flow
+// value (at most the maximum flow, found first with DinicCSR) and the sink
+// demands it.
+//
+// Implementation notes:
+//   - Costs must be integers for the final phase to be exact. Fractional
+//     costs are multiplied by the smallest power of ten up to
+//     CostScalingMaxPrecision that makes them integral; costs with more
+//     decimals are rounded, so the result is optimal up to that rounding.
+//   - Global price update: at the start of every phase and after every n
+//     relabels, prices are recomputed from the ε-distance to the nearest
+//     deficit node with Dial's algorithm over the bucketQueue of
+//     Push-Relabel.
+//   - Active nodes are discharged in FIFO order.
+//
+// Time Complexity: O(V²E log(V·C)) where C = max |cost|
+// Space Complexity: O(V + E)
+//
+// Reference: Goldberg, A.V., Tarjan, R.E. "Finding Minimum-Cost Circulations
+// by Successive Approximation" (1990).
+
+// CostScalingRangeThreshold is the spread between the largest and smallest
+// edge cost above which Cost Scaling is preferred over Successive Shortest
+// Path: its running time grows with log(C) only.
+const CostScalingRangeThreshold = 1e5
+
+// CostScalingMaxPrecision is the largest factor fractional costs are
+// multiplied with before they are rounded to integers.
+const CostScalingMaxPrecision = 1e6
+
+// costScalingAlpha is the factor ε is divided by between refine phases.
+const costScalingAlpha = 8
+
+// CostScalingMinCostFlow finds a minimum-cost flow of up to requiredFlow
+// units using cost scaling.
+//
+// Unlike Successive Shortest Path it does not build the flow path by path,
+// so no paths are returned. Iterations counts the ε-scaling phases.
+//
+// Parameters:
+//   - g: The residual graph (will be modified)
+//   - source: Source node ID
+//   - sink: Sink node ID
+//   - requiredFlow: Maximum amount of flow to push (use math.MaxFloat64 for max flow)
+//   - options: Solver configuration options
+//
+// Returns:
+//   - MinCostFlowResult containing flow value, cost and phase count
+func CostScalingMinCostFlow(g *graph.ResidualGraph, source, sink int64, requiredFlow float64, options *SolverOptions) *MinCostFlowResult {
+	return CostScalingMinCostFlowWithContext(context.Background(), g, source, sink, requiredFlow, options)
+}
+
+// CostScalingMinCostFlowWithContext is the context-aware version of
+// CostScalingMinCostFlow. Options.Timeout is applied on top of ctx.
+//
+// If cancelled, the graph is left unchanged and Canceled=true.
+func CostScalingMinCostFlowWithContext(ctx context.Context, g *graph.ResidualGraph, source, sink int64, requiredFlow float64, options *SolverOptions) *MinCostFlowResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	// The flow value is capped by the maximum flow so that the supplies are feasible
+	probe := graph.NewCSRGraph(g)
+	s, _ := probe.Index(source)
+	t, _ := probe.Index(sink)
+	maxFlow := DinicCSR(ctx, probe, s, t, &SolverOptions{Epsilon: options.Epsilon})
+	if maxFlow.Canceled {
+		return &MinCostFlowResult{Canceled: true}
+	}
+
+	c := graph.NewCSRGraph(g)
+	result := CostScalingMinCostFlowCSR(ctx, c, s, t, math.Min(requiredFlow, maxFlow.MaxFlow), options)
+	if !result.Canceled && result.Error == nil {
+		c.WriteBack(g)
+	}
+
+	return result
+}
+
+// CostScalingMinCostFlowCSR sends requiredFlow units from source to sink at
+// minimum cost on a CSR graph. requiredFlow must be feasible (at most the
+// max flow).
+//
+// Parameters:
+//   - ctx: Context for cancellation support
+//   - c: The CSR graph (pushed to on success; call WriteBack to apply the flow)
+//   - source: The source node index
+//   - sink: The sink node index
+//   - requiredFlow: Flow to send from source to sink
+//   - options: Solver options (nil for defaults)
+//
+// Returns:
+//   - *MinCostFlowResult; on cancellation or error c is left unchanged
+func CostScalingMinCostFlowCSR(ctx context.Context, c *graph.CSRGraph, source, sink int32, requiredFlow float64, options *SolverOptions) *MinCostFlowResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	cs := newCostScaling(ctx, c, options.Epsilon)
+	cs.excess[source] += requiredFlow
+	cs.excess[sink] -= requiredFlow
+
+	phases := 0
+	for eps := cs.maxCost; ; {
+		eps = max(eps/costScalingAlpha, 1)
+		if err := cs.refine(eps); err != nil {
+			return &MinCostFlowResult{Iterations: phases, Canceled: cs.canceled, Error: err}
+		}
+		phases++
+		if eps == 1 {
+			break
+		}
+	}
+
+	return &MinCostFlowResult{
+		Flow:       requiredFlow,
+		Cost:       cs.apply(),
+		Iterations: phases,
+	}
+}
+
+// costScaling holds the cost scaling state. Arcs of the CSR graph with
+// residual capacity become variables with bounds [0, capacity]; every
+// variable v has two residual halves, 2v (tail → head, capacity - flow) and
+// 2v+1 (head → tail, flow with negated cost).
+type costScaling struct {
+	ctx      context.Context
+	canceled bool
+	c        *graph.CSRGraph
+	epsilon  float64
+	n        int
+
+	// Variables
+	csrArc   []int32
+	tail     []int32
+	head     []int32
+	capacity []float64
+	cost     []int64 // scaled by the cost precision and n+1
+	flow     []float64
+	maxCost  int64
+
+	// Residual halves grouped by their tail node
+	first   []int32
+	halves  []int32
+	current []int32
+
+	// Node state
+	excess   []float64
+	price    []int64
+	queue    []int32
+	inQueue  []bool
+	dist     []int
+	done     []bool
+	buckets  *bucketQueue
+	relabels int
+}
+
+// newCostScaling collects the arcs of c with residual capacity and scales
+// their costs to integers.
+func newCostScaling(ctx context.Context, c *graph.CSRGraph, epsilon float64) *costScaling {
+	n := c.NodeCount()
+
+	var arcs []int32
+	for a := range c.Capacity {
+		if c.Capacity[a] > epsilon {
+			arcs = append(arcs, int32(a))
+		}
+	}
+	m := len(arcs)
+
+	cs := &costScaling{
+		ctx:      ctx,
+		c:        c,
+		epsilon:  epsilon,
+		n:        n,
+		csrArc:   arcs,
+		tail:     make([]int32, m),
+		head:     make([]int32, m),
+		capacity: make([]float64, m),
+		cost:     make([]int64, m),
+		flow:     make([]float64, m),
+		first:    make([]int32, n+1),
+		halves:   make([]int32, 2*m),
+		current:  make([]int32, n),
+		excess:   make([]float64, n),
+		price:    make([]int64, n),
+		inQueue:  make([]bool, n),
+		dist:     make([]int, n),
+		done:     make([]bool, n),
+		buckets:  newBucketQueue(n, n),
+	}
+
+	// Tails come from the CSR layout: arcs are ordered by tail node
+	u := int32(0)
+	for v, a := range arcs {
+		for int(a) >= int(c.Start[u+1]) {
+			u++
+		}
+		cs.tail[v] = u
+		cs.head[v] = c.Head[a]
+		cs.capacity[v] = c.Capacity[a]
+	}
+
+	scale := costScalingFactor(c, arcs, n)
+	for v, a := range arcs {
+		cs.cost[v] = int64(math.Round(c.Cost[a]*scale)) * int64(n+1)
+		if abs := max(cs.cost[v], -cs.cost[v]); abs > cs.maxCost {
+			cs.maxCost = abs
+		}
+	}
+
+	// Bucket the halves by tail node (counting sort)
+	for v := range arcs {
+		cs.first[cs.tail[v]+1]++
+		cs.first[cs.head[v]+1]++
+	}
+	for u := 0; u < n; u++ {
+		cs.first[u+1] += cs.first[u]
+	}
+	next := make([]int32, n)
+	copy(next, cs.first[:n])
+	for v := range arcs {
+		cs.halves[next[cs.tail[v]]] = int32(2 * v)
+		next[cs.tail[v]]++
+		cs.halves[next[cs.head[v]]] = int32(2*v + 1)
+		next[cs.head[v]]++
+	}
+
+	return cs
+}
+
+// costScalingFactor returns the factor costs are multiplied with before
+// rounding: the smallest power of ten up to CostScalingMaxPrecision that
+// makes every cost integral, lowered if prices could overflow int64.
+func costScalingFactor(c *graph.CSRGraph, arcs []int32, n int) float64 {
+	maxAbs := 0.0
+	for _, a := range arcs {
+		maxAbs = math.Max(maxAbs, math.Abs(c.Cost[a]))
+	}
+
+	scale := 1.0
+	for ; scale < CostScalingMaxPrecision; scale *= 10 {
+		integral := true
+		for _, a := range arcs {
+			x := c.Cost[a] * scale
+			if math.Abs(x-math.Round(x)) > 1e-9*math.Max(1, math.Abs(x)) {
+				integral = false
+				break
+			}
+		}
+		if integral {
+			break
+		}
+	}
+
+	// Prices drop by at most about 6·n·ε₀ over all phases, ε₀ = maxAbs·scale·(n+1)
+	limit := math.Ldexp(1, 62) / (8 * float64(n+1) * float64(n+1))
+	if maxAbs*scale > limit {
+		scale = limit / maxAbs
+	}
+
+	return scale
+}
+
+// residual returns the tail, head, residual capacity and cost of half h.
+func (cs *costScaling) residual(h int32) (from, to int32, capacity float64, cost int64) {
+	v := h >> 1
+	if h&1 == 0 {
+		return cs.tail[v], cs.head[v], cs.capacity[v] - cs.flow[v], cs.cost[v]
+	}
+	return cs.head[v], cs.tail[v], cs.flow[v], -cs.cost[v]
+}
+
+// push sends amount units along half h.
+func (cs *costScaling) push(h int32, amount float64) {
+	v := h >> 1
+	from, to := cs.tail[v], cs.head[v]
+	if h&1 == 0 {
+		cs.flow[v] += amount
+	} else {
+		cs.flow[v] -= amount
+		from, to = to, from
+	}
+	cs.excess[from] -= amount
+	cs.excess[to] += amount
+}
+
+// activate queues u if it has excess.
+func (cs *costScaling) activate(u int32) {
+	if !cs.inQueue[u] && cs.excess[u] > cs.epsilon {
+		cs.inQueue[u] = true
+		cs.queue = append(cs.queue, u)
+	}
+}
+
+// refine turns the current flow into an eps-optimal one.
+func (cs *costScaling) refine(eps int64) error {
+	// Saturating every arc with negative reduced cost makes the pseudoflow 0-optimal
+	for u := int32(0); int(u) < cs.n; u++ {
+		for i := cs.first[u]; i < cs.first[u+1]; i++ {
+			h := cs.halves[i]
+			_, to, capacity, cost := cs.residual(h)
+			if capacity > cs.epsilon && cost+cs.price[u]-cs.price[to] < 0 {
+				cs.push(h, capacity)
+			}
+		}
+	}
+
+	cs.globalUpdate(eps)
+	for u := int32(0); int(u) < cs.n; u++ {
+		cs.activate(u)
+	}
+
+	const checkInterval = 100
+	discharges := 0
+
+	for len(cs.queue) > 0 {
+		if discharges%checkInterval == 0 {
+			select {
+			case <-cs.ctx.Done():
+				cs.canceled = true
+				return ErrContextCanceled
+			default:
+			}
+		}
+		discharges++
+
+		u := cs.queue[0]
+		cs.queue = cs.queue[1:]
+		cs.inQueue[u] = false
+
+		if err := cs.discharge(u, eps); err != nil {
+			return err
+		}
+
+		if cs.relabels >= cs.n {
+			cs.globalUpdate(eps)
+		}
+	}
+	cs.queue = cs.queue[:0]
+
+	return nil
+}
+
+// discharge pushes the excess of u along admissible halves, relabelling u
+// whenever it runs out of them.
+func (cs *costScaling) discharge(u int32, eps int64) error {
+	for cs.excess[u] > cs.epsilon {
+		if cs.current[u] == cs.first[u+1] {
+			if !cs.relabel(u, eps) {
+				return ErrStrandedExcess
+			}
+			continue
+		}
+
+		h := cs.halves[cs.current[u]]
+		_, to, capacity, cost := cs.residual(h)
+		if capacity > cs.epsilon && cost+cs.price[u]-cs.price[to] < 0 {
+			amount := math.Min(cs.excess[u], capacity)
+			cs.push(h, amount)
+			cs.activate(to)
+			if amount < capacity-cs.epsilon {
+				continue
+			}
+		}
+		cs.current[u]++
+	}
+	return nil
+}
+
+// relabel lowers the price of u just enough to create an admissible half.
+// Returns false if u has no residual half at all.
+func (cs *costScaling) relabel(u int32, eps int64) bool {
+	best := int64(math.MinInt64)
+	for i := cs.first[u]; i < cs.first[u+1]; i++ {
+		_, to, capacity, cost := cs.residual(cs.halves[i])
+		if capacity > cs.epsilon && cs.price[to]-cost > best {
+			best = cs.price[to] - cost
+		}
+	}
+	if best == math.MinInt64 {
+		return false
+	}
+
+	cs.price[u] = best - eps
+	cs.current[u] = cs.first[u]
+	cs.relabels++
+	return true
+}
+
+// globalUpdate recomputes prices from the distance, in units of eps, of
+// every node to the nearest deficit node over residual halves, where a
+// half of reduced cost r has length floor(r/eps)+1. Dial's algorithm runs
+// on the Push-Relabel bucket queue and stops once every node with excess
+// is reached; nodes not reached are lowered by the last distance settled,
+// which keeps the flow eps-optimal.
+func (cs *costScaling) globalUpdate(eps int64) {
+	cs.relabels = 0
+	for u := range cs.current {
+		cs.current[u] = cs.first[u]
+	}
+
+	active := 0
+	for u := 0; u < cs.n; u++ {
+		cs.done[u] = false
+		cs.dist[u] = cs.n + 1
+		switch {
+		case cs.excess[u] < -cs.epsilon:
+			cs.dist[u] = 0
+			cs.buckets.push(u, 0)
+		case cs.excess[u] > cs.epsilon:
+			active++
+		}
+	}
+	if cs.buckets.isEmpty() {
+		return
+	}
+
+	level := 0
+	for active > 0 {
+		w, ok := cs.buckets.popLowest()
+		if !ok {
+			break
+		}
+		cs.done[w] = true
+		level = cs.dist[w]
+		if cs.excess[w] > cs.epsilon {
+			active--
+		}
+
+		// Halves entering w are the partners of the halves leaving it
+		for i := cs.first[w]; i < cs.first[w+1]; i++ {
+			from, _, capacity, cost := cs.residual(cs.halves[i] ^ 1)
+			if cs.done[from] || capacity <= cs.epsilon {
+				continue
+			}
+			length := floorDiv(cost+cs.price[from]-cs.price[w], eps) + 1
+			d := level + int(max(length, 0))
+			if d < cs.dist[from] {
+				cs.buckets.updateHeight(int(from), cs.dist[from], d)
+				cs.dist[from] = d
+			}
+		}
+	}
+	cs.buckets.clear()
+
+	for u := 0; u < cs.n; u++ {
+		d := level
+		if cs.done[u] {
+			d = cs.dist[u]
+		}
+		cs.price[u] -= int64(d) * eps
+	}
+}
+
+// apply pushes the flow of every variable onto the CSR graph and returns
+// its cost.
+func (cs *costScaling) apply() float64 {
+	cost := 0.0
+	for v, a := range cs.csrArc {
+		if cs.flow[v] > cs.epsilon {
+			cs.c.Push(a, cs.flow[v])
+			cost += cs.flow[v] * cs.c.Cost[a]
+		}
+	}
+	return cost
+}
+
+// floorDiv returns ⌊a/b⌋ for b > 0.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
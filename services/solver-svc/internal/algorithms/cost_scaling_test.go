@@ -0,0 +1,195 @@
+package algorithms
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+func TestCostScalingMinCostFlow(t *testing.T) {
+	tests := []struct {
+		name         string
+		buildGraph   func() *graph.ResidualGraph
+		sink         int64
+		requiredFlow float64
+		wantFlow     float64
+		wantCost     float64
+	}{
+		{
+			name: "simple_edge",
+			buildGraph: func() *graph.ResidualGraph {
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 10, 5)
+				return g
+			},
+			sink:         2,
+			requiredFlow: math.MaxFloat64,
+			wantFlow:     10,
+			wantCost:     50,
+		},
+		{
+			name: "cheap_path_first",
+			buildGraph: func() *graph.ResidualGraph {
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 3, 1)
+				g.AddEdgeWithReverse(2, 4, 3, 1)
+				g.AddEdgeWithReverse(1, 3, 5, 5)
+				g.AddEdgeWithReverse(3, 4, 5, 5)
+				return g
+			},
+			sink:         4,
+			requiredFlow: 4,
+			wantFlow:     4,
+			wantCost:     16, // 3 * 2 + 1 * 10
+		},
+		{
+			name: "fractional_costs",
+			buildGraph: func() *graph.ResidualGraph {
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 2, 0.25)
+				g.AddEdgeWithReverse(2, 4, 2, 0.5)
+				g.AddEdgeWithReverse(1, 3, 2, 0.5)
+				g.AddEdgeWithReverse(3, 4, 2, 0.3)
+				return g
+			},
+			sink:         4,
+			requiredFlow: 3,
+			wantFlow:     3,
+			wantCost:     2.3, // 2 * 0.75 + 1 * 0.8
+		},
+		{
+			name: "negative_costs",
+			buildGraph: func() *graph.ResidualGraph {
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 5, -2)
+				g.AddEdgeWithReverse(2, 3, 5, 1)
+				g.AddEdgeWithReverse(1, 3, 5, 0)
+				return g
+			},
+			sink:         3,
+			requiredFlow: math.MaxFloat64,
+			wantFlow:     10,
+			wantCost:     -5,
+		},
+		{
+			name: "no_path",
+			buildGraph: func() *graph.ResidualGraph {
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 10, 1)
+				g.AddNode(4)
+				return g
+			},
+			sink:         4,
+			requiredFlow: math.MaxFloat64,
+			wantFlow:     0,
+			wantCost:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.buildGraph()
+
+			result := CostScalingMinCostFlow(g, 1, tt.sink, tt.requiredFlow, nil)
+
+			require.NoError(t, result.Error)
+			assert.InDelta(t, tt.wantFlow, result.Flow, 1e-9)
+			assert.InDelta(t, tt.wantCost, result.Cost, 1e-9)
+			assert.InDelta(t, tt.wantCost, g.GetTotalCost(), 1e-9)
+		})
+	}
+}
+
+func TestCostScalingMinCostFlow_MatchesNetworkSimplex(t *testing.T) {
+	for seed := int64(1); seed <= 30; seed++ {
+		want := randomFlowGraph(seed, 25, 120)
+		g := want.Clone()
+
+		ns := NetworkSimplex(want, 1, 25, nil)
+		result := CostScalingMinCostFlow(g, 1, 25, math.MaxFloat64, nil)
+
+		require.NoError(t, ns.Error)
+		require.NoError(t, result.Error, "seed %d", seed)
+		assert.InDelta(t, ns.Flow, result.Flow, 1e-6, "seed %d", seed)
+		assert.InDelta(t, ns.Cost, result.Cost, 1e-6, "seed %d", seed)
+		assertValidFlow(t, g, 1, 25)
+	}
+}
+
+func TestCostScalingMinCostFlow_LargeCostRange(t *testing.T) {
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithReverse(1, 2, 10, 1)
+	g.AddEdgeWithReverse(2, 4, 10, 1e7)
+	g.AddEdgeWithReverse(1, 3, 10, 5e6)
+	g.AddEdgeWithReverse(3, 4, 10, 1)
+	g.AddEdgeWithReverse(2, 3, 5, 3)
+
+	require.Equal(t, MinCostAlgorithmCostScaling, RecommendMinCostAlgorithm(g))
+	want := NetworkSimplex(g.Clone(), 1, 4, nil)
+
+	result := Solve(context.Background(), g, 1, 4, commonv1.Algorithm_ALGORITHM_MIN_COST, nil)
+
+	require.NoError(t, result.Error)
+	assert.InDelta(t, 20.0, result.MaxFlow, 1e-9)
+	assert.InDelta(t, want.Cost, result.TotalCost, 1e-6)
+	assert.InDelta(t, want.Cost, g.GetTotalCost(), 1e-6)
+}
+
+func TestCostScalingMinCostFlow_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	g := randomFlowGraph(3, 20, 80)
+	result := CostScalingMinCostFlowWithContext(ctx, g, 1, 20, math.MaxFloat64, nil)
+
+	assert.True(t, result.Canceled)
+	assert.Zero(t, g.GetTotalFlow(1))
+}
+
+func TestCostScalingMinCostFlow_Timeout(t *testing.T) {
+	g := randomFlowGraph(4, 20, 80)
+	opts := DefaultSolverOptions().WithTimeout(time.Nanosecond)
+
+	result := CostScalingMinCostFlowWithContext(context.Background(), g, 1, 20, math.MaxFloat64, opts)
+
+	assert.True(t, result.Canceled)
+	assert.Zero(t, g.GetTotalFlow(1))
+}
+
+func TestCostScalingFactor(t *testing.T) {
+	tests := []struct {
+		name  string
+		costs []float64
+		want  float64
+	}{
+		{"integers", []float64{1, -3, 7}, 1},
+		{"one_decimal", []float64{1.5, 2}, 10},
+		{"three_decimals", []float64{0.125, 4}, 1000},
+		{"irrational", []float64{math.Pi}, CostScalingMaxPrecision},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.NewResidualGraph()
+			for i, cost := range tt.costs {
+				g.AddEdge(int64(i), int64(i+1), 1, cost)
+			}
+			c := graph.NewCSRGraph(g)
+			arcs := make([]int32, 0, c.ArcCount())
+			for a := 0; a < c.ArcCount(); a++ {
+				if !c.IsReverse(int32(a)) {
+					arcs = append(arcs, int32(a))
+				}
+			}
+
+			assert.Equal(t, tt.want, costScalingFactor(c, arcs, c.NodeCount()))
+		})
+	}
+}
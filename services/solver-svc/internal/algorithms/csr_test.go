@@ -0,0 +1,154 @@
+package algorithms
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+var csrAlgorithms = []commonv1.Algorithm{
+	commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+	commonv1.Algorithm_ALGORITHM_DINIC,
+	commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+	commonv1.Algorithm_ALGORITHM_MIN_COST,
+}
+
+// randomFlowGraph builds a random network on nodes 1..n with integer
+// capacities and costs, including antiparallel and parallel edges.
+func randomFlowGraph(seed int64, n, m int) *graph.ResidualGraph {
+	r := rand.New(rand.NewSource(seed))
+	g := graph.NewResidualGraph()
+	for i := 1; i <= n; i++ {
+		g.AddNode(int64(i))
+	}
+	for i := 0; i < m; i++ {
+		from := int64(r.Intn(n) + 1)
+		to := int64(r.Intn(n) + 1)
+		if from == to || to == 1 || from == int64(n) {
+			continue
+		}
+		g.AddEdgeWithReverse(from, to, float64(r.Intn(20)+1), float64(r.Intn(10)))
+	}
+	return g
+}
+
+// assertValidFlow checks non-negative residual capacities and flow
+// conservation at every node except source and sink.
+func assertValidFlow(t *testing.T, g *graph.ResidualGraph, source, sink int64) {
+	t.Helper()
+
+	balance := make(map[int64]float64)
+	for _, from := range g.GetSortedNodes() {
+		for _, edge := range g.GetNeighborsList(from) {
+			assert.GreaterOrEqual(t, edge.Capacity, -1e-9, "edge %d -> %d", from, edge.To)
+			if edge.IsReverse {
+				continue
+			}
+			balance[from] -= edge.Flow
+			balance[edge.To] += edge.Flow
+		}
+	}
+	for node, b := range balance {
+		if node != source && node != sink {
+			assert.InDelta(t, 0.0, b, 1e-6, "conservation at node %d", node)
+		}
+	}
+}
+
+func TestSolve_CSRMatchesMapGraph(t *testing.T) {
+	ctx := context.Background()
+
+	for _, algo := range csrAlgorithms {
+		t.Run(algo.String(), func(t *testing.T) {
+			for seed := int64(1); seed <= 25; seed++ {
+				mapGraph := randomFlowGraph(seed, 30, 150)
+				csrGraph := mapGraph.Clone()
+
+				want := Solve(ctx, mapGraph, 1, 30, algo, DefaultSolverOptions().WithCSRThreshold(-1))
+				got := Solve(ctx, csrGraph, 1, 30, algo, DefaultSolverOptions().WithCSRThreshold(1))
+
+				require.NoError(t, want.Error)
+				require.NoError(t, got.Error)
+				assert.InDelta(t, want.MaxFlow, got.MaxFlow, 1e-6, "seed %d", seed)
+				assert.InDelta(t, got.MaxFlow, csrGraph.GetTotalFlow(1), 1e-6, "seed %d", seed)
+				if algo == commonv1.Algorithm_ALGORITHM_MIN_COST {
+					assert.InDelta(t, want.TotalCost, got.TotalCost, 1e-6, "seed %d", seed)
+					assert.InDelta(t, got.TotalCost, csrGraph.GetTotalCost(), 1e-6, "seed %d", seed)
+				}
+				assertValidFlow(t, csrGraph, 1, 30)
+			}
+		})
+	}
+}
+
+func TestSolve_CSRLowerBounds(t *testing.T) {
+	for _, algo := range csrAlgorithms {
+		t.Run(algo.String(), func(t *testing.T) {
+			g := lowerBoundGraph(6)
+
+			result := Solve(context.Background(), g, 1, 4, algo, DefaultSolverOptions().WithCSRThreshold(1))
+
+			require.NoError(t, result.Error)
+			assert.InDelta(t, 10.0, result.MaxFlow, 1e-9)
+			assert.GreaterOrEqual(t, g.GetFlowOnEdge(2, 3), 4.0-1e-9)
+			assertValidFlow(t, g, 1, 4)
+		})
+	}
+}
+
+func TestSolve_CSRReturnPaths(t *testing.T) {
+	for _, algo := range []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_MIN_COST,
+	} {
+		t.Run(algo.String(), func(t *testing.T) {
+			g := randomFlowGraph(7, 20, 80)
+			opts := DefaultSolverOptions().WithCSRThreshold(1).WithReturnPaths(true)
+
+			result := Solve(context.Background(), g, 1, 20, algo, opts)
+			require.NoError(t, result.Error)
+
+			total := 0.0
+			for _, p := range result.Paths {
+				require.NotEmpty(t, p.NodeIDs)
+				assert.Equal(t, int64(1), p.NodeIDs[0])
+				assert.Equal(t, int64(20), p.NodeIDs[len(p.NodeIDs)-1])
+				total += p.Flow
+			}
+			assert.InDelta(t, result.MaxFlow, total, 1e-6)
+		})
+	}
+}
+
+func TestSolve_CSRCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, algo := range csrAlgorithms {
+		g := randomFlowGraph(3, 30, 150)
+		result := Solve(ctx, g, 1, 30, algo, DefaultSolverOptions().WithCSRThreshold(1))
+		assert.ErrorIs(t, result.Error, ErrContextCanceled, algo.String())
+	}
+}
+
+func TestCSRFor_Threshold(t *testing.T) {
+	g := randomFlowGraph(1, 10, 20)
+
+	c, _, _ := csrFor(g, 1, 10, DefaultSolverOptions())
+	assert.Nil(t, c, "small graphs stay on the map-based graph by default")
+
+	c, _, _ = csrFor(g, 1, 10, DefaultSolverOptions().WithCSRThreshold(-1))
+	assert.Nil(t, c)
+
+	c, s, sink := csrFor(g, 1, 10, DefaultSolverOptions().WithCSRThreshold(g.EdgeCount()))
+	require.NotNil(t, c)
+	assert.Equal(t, int64(1), c.IDs[s])
+	assert.Equal(t, int64(10), c.IDs[sink])
+}
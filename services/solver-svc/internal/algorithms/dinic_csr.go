@@ -0,0 +1,179 @@
+package algorithms
+
+import (
+	"context"
+
+	"logistics/services/solver-svc/internal/converter"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Dinic's Algorithm on a CSR Graph
+// =============================================================================
+//
+// Same phases as DinicWithContext (BFS level graph, blocking flow with current
+// arcs), but levels, current arcs and the DFS stack are flat slices indexed by
+// dense node index instead of maps keyed by node ID.
+
+// DinicCSR executes Dinic's algorithm on a CSR graph.
+//
+// Parameters:
+//   - ctx: Context for cancellation support
+//   - c: The CSR graph (will be modified; call WriteBack to apply the flow)
+//   - source: The source node index
+//   - sink: The sink node index
+//   - options: Solver options (nil for defaults)
+//
+// Returns:
+//   - *DinicResult containing max flow, iteration count, paths, and cancellation status
+func DinicCSR(ctx context.Context, c *graph.CSRGraph, source, sink int32, options *SolverOptions) *DinicResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	n := c.NodeCount()
+	level := make([]int32, n)
+	currentArc := make([]int32, n)
+	queue := make([]int32, 0, n)
+	pathArcs := make([]int32, 0, 64)
+
+	maxFlow := 0.0
+	iterations := 0
+	var paths []converter.PathWithFlow
+
+	const checkInterval = 100
+
+	for options.MaxIterations <= 0 || iterations < options.MaxIterations {
+		if iterations%checkInterval == 0 {
+			select {
+			case <-ctx.Done():
+				return &DinicResult{
+					MaxFlow:    maxFlow,
+					Iterations: iterations,
+					Paths:      paths,
+					Canceled:   true,
+				}
+			default:
+			}
+		}
+
+		// Phase 1: Build level graph using BFS
+		queue = bfsLevelCSR(c, source, level, queue)
+		if level[sink] < 0 {
+			break
+		}
+
+		// Phase 2: Find blocking flow
+		copy(currentArc, c.Start[:n])
+		blockingFlow := 0.0
+
+		for {
+			var pathFlow float64
+			pathArcs, pathFlow = dfsBlockingPathCSR(c, source, sink, level, currentArc, pathArcs[:0], options.Epsilon)
+			if pathFlow <= options.Epsilon {
+				break
+			}
+
+			blockingFlow += pathFlow
+
+			if options.ReturnPaths {
+				paths = append(paths, converter.PathWithFlow{
+					NodeIDs: arcPathIDs(c, source, pathArcs),
+					Flow:    pathFlow,
+				})
+			}
+		}
+
+		if blockingFlow <= options.Epsilon {
+			break
+		}
+
+		maxFlow += blockingFlow
+		iterations++
+	}
+
+	return &DinicResult{
+		MaxFlow:    maxFlow,
+		Iterations: iterations,
+		Paths:      paths,
+		Canceled:   false,
+	}
+}
+
+// bfsLevelCSR fills level with BFS distances from source over arcs with
+// residual capacity; unreachable nodes get -1. Returns the reused queue.
+func bfsLevelCSR(c *graph.CSRGraph, source int32, level, queue []int32) []int32 {
+	for i := range level {
+		level[i] = -1
+	}
+	level[source] = 0
+
+	queue = append(queue[:0], source)
+	for head := 0; head < len(queue); head++ {
+		u := queue[head]
+		for a := c.Start[u]; a < c.Start[u+1]; a++ {
+			v := c.Head[a]
+			if level[v] < 0 && c.Capacity[a] > graph.Epsilon {
+				level[v] = level[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	return queue
+}
+
+// dfsBlockingPathCSR finds one augmenting path in the level graph using
+// iterative DFS with current arcs and augments it. Returns the arcs of the
+// path and the amount of flow pushed (0 if the sink is no longer reachable).
+func dfsBlockingPathCSR(c *graph.CSRGraph, source, sink int32, level, currentArc, pathArcs []int32, epsilon float64) ([]int32, float64) {
+	u := source
+
+	for {
+		if u == sink {
+			bottleneck := graph.Infinity
+			for _, a := range pathArcs {
+				bottleneck = min(bottleneck, c.Capacity[a])
+			}
+			for _, a := range pathArcs {
+				c.Push(a, bottleneck)
+			}
+			return pathArcs, bottleneck
+		}
+
+		advanced := false
+		for ; currentArc[u] < c.Start[u+1]; currentArc[u]++ {
+			a := currentArc[u]
+			v := c.Head[a]
+			if level[v] == level[u]+1 && c.Capacity[a] > epsilon {
+				pathArcs = append(pathArcs, a)
+				u = v
+				advanced = true
+				break
+			}
+		}
+		if advanced {
+			continue
+		}
+
+		// Dead end: remove u from the level graph and backtrack
+		level[u] = -1
+		if len(pathArcs) == 0 {
+			return pathArcs, 0
+		}
+		last := pathArcs[len(pathArcs)-1]
+		pathArcs = pathArcs[:len(pathArcs)-1]
+		u = c.Head[c.Pair[last]]
+		currentArc[u]++
+	}
+}
+
+// arcPathIDs converts a path given as arcs from source into node IDs.
+func arcPathIDs(c *graph.CSRGraph, source int32, arcs []int32) []int64 {
+	ids := make([]int64, 0, len(arcs)+1)
+	ids = append(ids, c.IDs[source])
+	for _, a := range arcs {
+		ids = append(ids, c.IDs[c.Head[a]])
+	}
+	return ids
+}
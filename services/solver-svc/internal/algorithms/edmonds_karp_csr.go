@@ -0,0 +1,125 @@
+package algorithms
+
+import (
+	"context"
+
+	"logistics/services/solver-svc/internal/converter"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Edmonds-Karp Algorithm on a CSR Graph
+// =============================================================================
+
+// EdmondsKarpCSR executes the Edmonds-Karp algorithm on a CSR graph.
+// Augmenting paths are found by BFS over arcs in CSR order, recording the
+// arc used to reach each node instead of a parent map.
+//
+// Parameters:
+//   - ctx: Context for cancellation support
+//   - c: The CSR graph (will be modified; call WriteBack to apply the flow)
+//   - source: The source node index
+//   - sink: The sink node index
+//   - options: Solver options (nil for defaults)
+//
+// Returns:
+//   - *EdmondsKarpResult containing max flow, iterations, paths, and cancellation status
+func EdmondsKarpCSR(ctx context.Context, c *graph.CSRGraph, source, sink int32, options *SolverOptions) *EdmondsKarpResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	n := c.NodeCount()
+	parentArc := make([]int32, n)
+	queue := make([]int32, 0, n)
+	pathArcs := make([]int32, 0, 64)
+
+	maxFlow := 0.0
+	iterations := 0
+	var paths []converter.PathWithFlow
+
+	const checkInterval = 100
+
+	for options.MaxIterations <= 0 || iterations < options.MaxIterations {
+		if iterations%checkInterval == 0 {
+			select {
+			case <-ctx.Done():
+				return &EdmondsKarpResult{
+					MaxFlow:    maxFlow,
+					Iterations: iterations,
+					Paths:      paths,
+					Canceled:   true,
+				}
+			default:
+			}
+		}
+
+		if !bfsParentArcsCSR(c, source, sink, parentArc, queue) {
+			break
+		}
+
+		// Collect the path arcs from sink back to source
+		pathArcs = pathArcs[:0]
+		pathFlow := graph.Infinity
+		for v := sink; v != source; {
+			a := parentArc[v]
+			pathArcs = append(pathArcs, a)
+			pathFlow = min(pathFlow, c.Capacity[a])
+			v = c.Head[c.Pair[a]]
+		}
+		if pathFlow <= options.Epsilon {
+			break
+		}
+
+		for i, j := 0, len(pathArcs)-1; i < j; i, j = i+1, j-1 {
+			pathArcs[i], pathArcs[j] = pathArcs[j], pathArcs[i]
+		}
+		for _, a := range pathArcs {
+			c.Push(a, pathFlow)
+		}
+
+		maxFlow += pathFlow
+		iterations++
+
+		if options.ReturnPaths {
+			paths = append(paths, converter.PathWithFlow{
+				NodeIDs: arcPathIDs(c, source, pathArcs),
+				Flow:    pathFlow,
+			})
+		}
+	}
+
+	return &EdmondsKarpResult{
+		MaxFlow:    maxFlow,
+		Iterations: iterations,
+		Paths:      paths,
+		Canceled:   false,
+	}
+}
+
+// bfsParentArcsCSR runs BFS from source over arcs with residual capacity and
+// records in parentArc the arc used to reach every visited node (-1 for
+// unvisited nodes). Stops as soon as the sink is reached.
+func bfsParentArcsCSR(c *graph.CSRGraph, source, sink int32, parentArc, queue []int32) bool {
+	for i := range parentArc {
+		parentArc[i] = -1
+	}
+
+	queue = append(queue[:0], source)
+	for head := 0; head < len(queue); head++ {
+		u := queue[head]
+		for a := c.Start[u]; a < c.Start[u+1]; a++ {
+			v := c.Head[a]
+			if v == source || parentArc[v] >= 0 || c.Capacity[a] <= graph.Epsilon {
+				continue
+			}
+			parentArc[v] = a
+			if v == sink {
+				return true
+			}
+			queue = append(queue, v)
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+package algorithms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Edge Lower Bounds
+// =============================================================================
+
+// LowerBoundResult describes the feasibility phase for edge lower bounds.
+type LowerBoundResult struct {
+	// BaseFlow is the source → sink flow value of the feasible flow found.
+	BaseFlow float64
+
+	// Iterations is the number of iterations of the feasibility phase.
+	Iterations int
+
+	// Deficits lists the nodes whose lower-bound imbalance could not be
+	// routed. Non-empty only when the lower bounds are infeasible.
+	Deficits []graph.LowerBoundDeficit
+}
+
+// EstablishLowerBounds finds a flow that satisfies every edge lower bound of g
+// and leaves it in g, so that any max-flow or min-cost algorithm can then
+// augment from source to sink as usual.
+//
+// The feasibility phase runs the given algorithm between the auxiliary
+// terminals of graph.ApplyLowerBounds; with ALGORITHM_MIN_COST or
+// ALGORITHM_NETWORK_SIMPLEX the feasible flow found is also the cheapest one.
+//
+// Returns ErrInfeasibleLowerBounds (with Deficits filled in) if the lower
+// bounds cannot be met, and ErrContextCanceled if ctx was cancelled.
+func EstablishLowerBounds(ctx context.Context, g *graph.ResidualGraph, source, sink int64, algorithm commonv1.Algorithm, options *SolverOptions) (*LowerBoundResult, error) {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	reduction := g.ApplyLowerBounds(source, sink)
+
+	// Feasibility paths go through auxiliary nodes and are not reported
+	phaseOptions := *options
+	phaseOptions.ReturnPaths = false
+
+	phase := solveInternal(ctx, g, reduction.AuxSource, reduction.AuxSink, algorithm, &phaseOptions)
+	if phase.Error != nil {
+		return nil, phase.Error
+	}
+
+	result := &LowerBoundResult{
+		Iterations: phase.Iterations,
+		Deficits:   reduction.Deficits(g, options.Epsilon),
+	}
+	result.BaseFlow = reduction.Remove(g)
+
+	if len(result.Deficits) > 0 {
+		return result, fmt.Errorf("%w: %s", ErrInfeasibleLowerBounds, describeDeficits(result.Deficits))
+	}
+
+	return result, nil
+}
+
+// solveWithLowerBounds establishes the lower bounds of g and then runs the
+// requested algorithm from source to sink on top of the feasible flow.
+func solveWithLowerBounds(ctx context.Context, g *graph.ResidualGraph, source, sink int64, algorithm commonv1.Algorithm, options *SolverOptions) *SolverResult {
+	lb, err := EstablishLowerBounds(ctx, g, source, sink, algorithm, options)
+	if err != nil {
+		result := &SolverResult{
+			Status: commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:  err,
+		}
+		if lb != nil {
+			result.Status = commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE
+			result.Iterations = lb.Iterations
+			result.LowerBoundDeficits = lb.Deficits
+		}
+		return result
+	}
+
+	result := solveInternal(ctx, g, source, sink, algorithm, options)
+	result.MaxFlow += lb.BaseFlow
+	result.Iterations += lb.Iterations
+	result.TotalCost = g.GetTotalCost()
+
+	return result
+}
+
+// describeDeficits formats deficits for error messages.
+func describeDeficits(deficits []graph.LowerBoundDeficit) string {
+	parts := make([]string, 0, len(deficits))
+	for _, d := range deficits {
+		parts = append(parts, fmt.Sprintf("node %d: %.4g of %.4g unrouted", d.Node, d.Unresolved, d.Imbalance))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,133 @@
+package algorithms
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// lowerBoundGraph: 1 -> 2 -> 4 and 2 -> 3 -> 4, where 2 -> 3 must carry at
+// least 4 units and 3 -> 4 has capacity capThreeFour.
+func lowerBoundGraph(capThreeFour float64) *graph.ResidualGraph {
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithReverse(1, 2, 10, 1)
+	g.AddEdgeWithReverse(2, 4, 10, 1)
+	g.AddEdgeWithReverse(2, 3, 10, 2)
+	g.AddEdgeWithReverse(3, 4, capThreeFour, 2)
+	g.SetLowerBound(2, 3, 4)
+	return g
+}
+
+var lowerBoundAlgorithms = []commonv1.Algorithm{
+	commonv1.Algorithm_ALGORITHM_FORD_FULKERSON,
+	commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+	commonv1.Algorithm_ALGORITHM_DINIC,
+	commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+	commonv1.Algorithm_ALGORITHM_MIN_COST,
+	commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+}
+
+func TestSolve_LowerBounds(t *testing.T) {
+	for _, algo := range lowerBoundAlgorithms {
+		t.Run(algo.String(), func(t *testing.T) {
+			g := lowerBoundGraph(6)
+
+			result := Solve(context.Background(), g, 1, 4, algo, nil)
+
+			require.NoError(t, result.Error)
+			assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_OPTIMAL, result.Status)
+			assert.InDelta(t, 10.0, result.MaxFlow, 1e-9)
+			assert.GreaterOrEqual(t, g.GetFlowOnEdge(2, 3), 4.0-1e-9)
+			assert.InDelta(t, result.MaxFlow, g.GetTotalFlow(1), 1e-9)
+			assert.Len(t, g.GetNodes(), 4, "auxiliary nodes must be removed")
+		})
+	}
+}
+
+func TestSolve_LowerBoundsMinCost(t *testing.T) {
+	g := lowerBoundGraph(6)
+
+	result := Solve(context.Background(), g, 1, 4, commonv1.Algorithm_ALGORITHM_MIN_COST, nil)
+
+	require.NoError(t, result.Error)
+	// Exactly the lower bound goes the expensive way: 6*2 + 4*5
+	assert.InDelta(t, 4.0, g.GetFlowOnEdge(2, 3), 1e-9)
+	assert.InDelta(t, 32.0, result.TotalCost, 1e-9)
+}
+
+func TestSolve_LowerBoundsInfeasible(t *testing.T) {
+	for _, algo := range lowerBoundAlgorithms {
+		t.Run(algo.String(), func(t *testing.T) {
+			// Node 3 receives at least 4 units but can only forward 3
+			g := lowerBoundGraph(3)
+
+			result := Solve(context.Background(), g, 1, 4, algo, nil)
+
+			require.Error(t, result.Error)
+			assert.True(t, errors.Is(result.Error, ErrInfeasibleLowerBounds))
+			assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE, result.Status)
+			require.Len(t, result.LowerBoundDeficits, 2)
+			assert.Equal(t, graph.LowerBoundDeficit{Node: 2, Imbalance: -4, Unresolved: 1}, result.LowerBoundDeficits[0])
+			assert.Equal(t, graph.LowerBoundDeficit{Node: 3, Imbalance: 4, Unresolved: 1}, result.LowerBoundDeficits[1])
+		})
+	}
+}
+
+func TestSolve_LowerBoundsPushRelabelReturnsExcess(t *testing.T) {
+	// The lower bound on 1 -> 3 is met by the circulation 0 -> 1 -> 3 -> 0
+	// through the source. Excess push-relabel leaves at node 3 must go back
+	// along flow pushed in the main phase, not along the preloaded bound.
+	build := func() *graph.ResidualGraph {
+		g := graph.NewResidualGraph()
+		g.AddEdgeWithReverse(0, 1, 5, 2)
+		g.AddEdgeWithReverse(0, 2, 1, 0)
+		g.AddEdgeWithReverse(0, 4, 10, 1)
+		g.AddEdgeWithReverse(1, 3, 2, 4)
+		g.AddEdgeWithReverse(2, 5, 10, 4)
+		g.AddEdgeWithReverse(3, 0, 5, 0)
+		g.AddEdgeWithReverse(4, 1, 7, 4)
+		g.AddEdgeWithReverse(4, 2, 5, 0)
+		g.AddEdgeWithReverse(5, 1, 1, 0)
+		g.SetLowerBound(1, 3, 1)
+		return g
+	}
+
+	for name, options := range map[string]*SolverOptions{
+		"map": DefaultSolverOptions().WithCSRThreshold(-1),
+		"csr": DefaultSolverOptions().WithCSRThreshold(1),
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := build()
+
+			result := Solve(context.Background(), g, 0, 5, commonv1.Algorithm_ALGORITHM_PUSH_RELABEL, options)
+
+			require.NoError(t, result.Error)
+			assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_OPTIMAL, result.Status)
+			assert.InDelta(t, 6.0, result.MaxFlow, 1e-9)
+			assert.GreaterOrEqual(t, g.GetFlowOnEdge(1, 3), 1.0-1e-9)
+			assert.InDelta(t, g.GetFlowOnEdge(1, 3), g.GetFlowOnEdge(3, 0), 1e-9)
+		})
+	}
+}
+
+func TestSolveTransportation_LowerBounds(t *testing.T) {
+	g := transportationGraph(10)
+	// Force warehouse 2 to ship at least 4 units to point 3
+	g.SetLowerBound(2, 3, 4)
+
+	result := SolveTransportation(context.Background(), g, 0, 5, 15, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_OPTIMAL, result.Status)
+	assert.InDelta(t, 15.0, result.MaxFlow, 1e-9)
+	assert.InDelta(t, 4.0, g.GetFlowOnEdge(2, 3), 1e-9)
+	// 1->3: 4, 1->4: 6, 2->3: 4, 2->4: 1
+	assert.InDelta(t, 39.0, result.TotalCost, 1e-9)
+	assert.InDelta(t, result.TotalCost, g.GetTotalCost(), 1e-9)
+}
@@ -53,6 +53,10 @@ type MinCostFlowResult struct {
 
 	// Canceled indicates if the computation was interrupted by context cancellation.
 	Canceled bool
+
+	// Error is set if the algorithm failed for a reason other than
+	// cancellation. The graph is left unchanged in that case.
+	Error error
 }
 
 // =============================================================================
@@ -102,6 +106,8 @@ func MinCostMaxFlowWithContext(ctx context.Context, g *graph.ResidualGraph, sour
 	switch recommendation {
 	case MinCostAlgorithmCapacityScaling:
 		return CapacityScalingMinCostFlowWithContext(ctx, g, source, sink, requiredFlow, options)
+	case MinCostAlgorithmCostScaling:
+		return CostScalingMinCostFlowWithContext(ctx, g, source, sink, requiredFlow, options)
 	default:
 		return SuccessiveShortestPathInternal(ctx, g, source, sink, requiredFlow, options)
 	}
@@ -130,6 +136,8 @@ func MinCostFlowWithAlgorithm(ctx context.Context, g *graph.ResidualGraph, sourc
 	switch algorithm {
 	case MinCostAlgorithmCapacityScaling:
 		return CapacityScalingMinCostFlowWithContext(ctx, g, source, sink, requiredFlow, options)
+	case MinCostAlgorithmCostScaling:
+		return CostScalingMinCostFlowWithContext(ctx, g, source, sink, requiredFlow, options)
 	default:
 		return SuccessiveShortestPathInternal(ctx, g, source, sink, requiredFlow, options)
 	}
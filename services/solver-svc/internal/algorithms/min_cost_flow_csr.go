@@ -0,0 +1,328 @@
+package algorithms
+
+import (
+	"context"
+
+	"logistics/services/solver-svc/internal/converter"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Successive Shortest Path on a CSR Graph
+// =============================================================================
+//
+// Same scheme as SuccessiveShortestPathInternal: Bellman-Ford for the initial
+// potentials (and periodic reinitialization), then Dijkstra on reduced costs.
+// Distances, potentials and parent arcs are flat slices, and the Dijkstra heap
+// holds (distance, node index) pairs with lazy deletion instead of
+// pointer-based items.
+
+// SuccessiveShortestPathCSR finds a minimum-cost flow of up to requiredFlow
+// units on a CSR graph.
+//
+// Parameters:
+//   - ctx: Context for cancellation support
+//   - c: The CSR graph (will be modified; call WriteBack to apply the flow)
+//   - source: The source node index
+//   - sink: The sink node index
+//   - requiredFlow: Flow to push (math.MaxFloat64 for min-cost max-flow)
+//   - options: Solver options (nil for defaults)
+//
+// Returns:
+//   - *MinCostFlowResult; an empty result if the residual graph has a
+//     negative cycle reachable from the source
+func SuccessiveShortestPathCSR(ctx context.Context, c *graph.CSRGraph, source, sink int32, requiredFlow float64, options *SolverOptions) *MinCostFlowResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	n := c.NodeCount()
+	sp := &csrShortestPaths{
+		c:         c,
+		dist:      make([]float64, n),
+		parentArc: make([]int32, n),
+		potential: make([]float64, n),
+	}
+
+	if !sp.reinitialize(source) {
+		return &MinCostFlowResult{}
+	}
+
+	totalFlow := 0.0
+	totalCost := 0.0
+	iterations := 0
+	var paths []converter.PathWithFlow
+	pathArcs := make([]int32, 0, 64)
+
+	const checkInterval = 50
+	reinitInterval := computeReinitInterval(n)
+
+	// The initial Bellman-Ford distances serve the first augmentation
+	haveDistances := true
+
+	for totalFlow < requiredFlow-options.Epsilon {
+		if options.MaxIterations > 0 && iterations >= options.MaxIterations {
+			break
+		}
+
+		if iterations%checkInterval == 0 {
+			select {
+			case <-ctx.Done():
+				return &MinCostFlowResult{
+					Flow:       totalFlow,
+					Cost:       totalCost,
+					Iterations: iterations,
+					Paths:      paths,
+					Canceled:   true,
+				}
+			default:
+			}
+		}
+
+		ok := true
+		switch {
+		case haveDistances:
+			haveDistances = false
+		case iterations%reinitInterval == 0:
+			// Periodic reinitialization for numerical stability
+			ok = sp.reinitialize(source)
+		case sp.dijkstra(source):
+			sp.addDistancesToPotentials()
+		default:
+			// Significant negative reduced cost: fall back to Bellman-Ford
+			ok = sp.reinitialize(source)
+		}
+
+		// A negative cycle shouldn't appear if the algorithm is correct
+		if !ok || sp.dist[sink] >= graph.Infinity-options.Epsilon {
+			break
+		}
+
+		// Collect the path arcs from sink back to source
+		pathArcs = pathArcs[:0]
+		pathFlow := requiredFlow - totalFlow
+		for v := sink; v != source; {
+			a := sp.parentArc[v]
+			pathArcs = append(pathArcs, a)
+			pathFlow = min(pathFlow, c.Capacity[a])
+			v = c.Head[c.Pair[a]]
+		}
+		if pathFlow <= options.Epsilon {
+			break
+		}
+
+		for i, j := 0, len(pathArcs)-1; i < j; i, j = i+1, j-1 {
+			pathArcs[i], pathArcs[j] = pathArcs[j], pathArcs[i]
+		}
+		for _, a := range pathArcs {
+			totalCost += c.Cost[a] * pathFlow
+			c.Push(a, pathFlow)
+		}
+
+		totalFlow += pathFlow
+		iterations++
+
+		if options.ReturnPaths {
+			paths = append(paths, converter.PathWithFlow{
+				NodeIDs: arcPathIDs(c, source, pathArcs),
+				Flow:    pathFlow,
+			})
+		}
+	}
+
+	return &MinCostFlowResult{
+		Flow:       totalFlow,
+		Cost:       totalCost,
+		Iterations: iterations,
+		Paths:      paths,
+		Canceled:   false,
+	}
+}
+
+// csrShortestPaths holds the shortest path state of SSP on a CSR graph.
+type csrShortestPaths struct {
+	c         *graph.CSRGraph
+	dist      []float64
+	parentArc []int32
+	potential []float64
+	heap      csrDistHeap
+	queue     []int32
+	inQueue   []bool
+	relaxed   []int32
+}
+
+// bellmanFord computes shortest distances from source on actual arc costs
+// (queue-based Bellman-Ford). Returns false if a negative cycle is reachable.
+func (sp *csrShortestPaths) bellmanFord(source int32) bool {
+	c := sp.c
+	n := c.NodeCount()
+
+	if sp.inQueue == nil {
+		sp.inQueue = make([]bool, n)
+		sp.relaxed = make([]int32, n)
+	}
+	for i := range sp.dist {
+		sp.dist[i] = graph.Infinity
+		sp.parentArc[i] = -1
+		sp.relaxed[i] = 0
+	}
+	sp.dist[source] = 0
+
+	sp.queue = append(sp.queue[:0], source)
+	sp.inQueue[source] = true
+
+	for head := 0; head < len(sp.queue); head++ {
+		u := sp.queue[head]
+		sp.inQueue[u] = false
+
+		for a := c.Start[u]; a < c.Start[u+1]; a++ {
+			if c.Capacity[a] <= graph.Epsilon {
+				continue
+			}
+			v := c.Head[a]
+			if d := sp.dist[u] + c.Cost[a]; d < sp.dist[v]-graph.Epsilon {
+				sp.dist[v] = d
+				sp.parentArc[v] = a
+				if sp.inQueue[v] {
+					continue
+				}
+				// A node relaxed n times lies on a negative cycle
+				sp.relaxed[v]++
+				if int(sp.relaxed[v]) >= n {
+					return false
+				}
+				sp.queue = append(sp.queue, v)
+				sp.inQueue[v] = true
+			}
+		}
+	}
+
+	return true
+}
+
+// reinitialize runs Bellman-Ford and sets the potentials of reachable nodes
+// to their distances. Returns false if a negative cycle is reachable.
+func (sp *csrShortestPaths) reinitialize(source int32) bool {
+	if !sp.bellmanFord(source) {
+		return false
+	}
+	for v, d := range sp.dist {
+		if d < graph.Infinity-graph.Epsilon {
+			sp.potential[v] = d
+		}
+	}
+	return true
+}
+
+// addDistancesToPotentials adds reduced-cost distances to the potentials of
+// reachable nodes after a Dijkstra run.
+func (sp *csrShortestPaths) addDistancesToPotentials() {
+	for v, d := range sp.dist {
+		if d < graph.Infinity-graph.Epsilon {
+			sp.potential[v] += d
+		}
+	}
+}
+
+// dijkstra computes shortest reduced-cost distances from source. Returns
+// false if an arc has a significantly negative reduced cost, i.e. the
+// potentials are no longer valid.
+func (sp *csrShortestPaths) dijkstra(source int32) bool {
+	c := sp.c
+
+	for i := range sp.dist {
+		sp.dist[i] = graph.Infinity
+		sp.parentArc[i] = -1
+	}
+	sp.dist[source] = 0
+
+	sp.heap = append(sp.heap[:0], csrDistItem{node: source})
+
+	for len(sp.heap) > 0 {
+		item := sp.heap.pop()
+		u := item.node
+		if item.dist > sp.dist[u]+graph.Epsilon {
+			continue
+		}
+
+		for a := c.Start[u]; a < c.Start[u+1]; a++ {
+			if c.Capacity[a] <= graph.Epsilon {
+				continue
+			}
+			v := c.Head[a]
+
+			reduced := c.Cost[a] + sp.potential[u] - sp.potential[v]
+			if reduced < -graph.Epsilon {
+				return false
+			}
+			if reduced < 0 {
+				reduced = 0
+			}
+
+			if d := sp.dist[u] + reduced; d < sp.dist[v]-graph.Epsilon {
+				sp.dist[v] = d
+				sp.parentArc[v] = a
+				sp.heap.push(csrDistItem{dist: d, node: v})
+			}
+		}
+	}
+
+	return true
+}
+
+// csrDistItem is a Dijkstra heap entry.
+type csrDistItem struct {
+	dist float64
+	node int32
+}
+
+// csrDistHeap is a binary min-heap of Dijkstra entries, ordered by distance
+// and then node index for deterministic tie-breaking. Unlike container/heap
+// it works on concrete values, so pushes do not allocate.
+type csrDistHeap []csrDistItem
+
+func (h csrDistHeap) less(i, j int) bool {
+	if h[i].dist != h[j].dist {
+		return h[i].dist < h[j].dist
+	}
+	return h[i].node < h[j].node
+}
+
+func (h *csrDistHeap) push(item csrDistItem) {
+	*h = append(*h, item)
+	q := *h
+	for i := len(q) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if !q.less(i, parent) {
+			break
+		}
+		q[i], q[parent] = q[parent], q[i]
+		i = parent
+	}
+}
+
+func (h *csrDistHeap) pop() csrDistItem {
+	q := *h
+	top := q[0]
+	last := len(q) - 1
+	q[0] = q[last]
+	q = q[:last]
+
+	for i := 0; ; {
+		smallest := i
+		if l := 2*i + 1; l < len(q) && q.less(l, smallest) {
+			smallest = l
+		}
+		if r := 2*i + 2; r < len(q) && q.less(r, smallest) {
+			smallest = r
+		}
+		if smallest == i {
+			break
+		}
+		q[i], q[smallest] = q[smallest], q[i]
+		i = smallest
+	}
+
+	*h = q
+	return top
+}
@@ -0,0 +1,675 @@
+package algorithms
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Multi-Commodity Flow (Garg–Könemann / Fleischer)
+// =============================================================================
+//
+// Several commodities (product classes) share the capacity of every edge.
+// The solver computes a maximum concurrent flow: the largest ratio λ such
+// that λ·demand of every commodity can be routed at the same time. When the
+// network can carry every demand (λ >= 1) exactly the demands are routed;
+// otherwise every commodity receives the same share λ of its demand.
+//
+// The problem is an LP, which is approximated without an LP solver by the
+// multiplicative-weights method of Garg and Könemann in Fleischer's
+// per-commodity form:
+//
+//  1. Every edge gets a length y(e) = δ/c(e). In a phase each commodity
+//     routes its demand along shortest paths under y (Dijkstra), at most the
+//     bottleneck capacity per path, and every used edge grows its length by
+//     the factor 1 + ε·u/c(e).
+//  2. Phases repeat until D = Σ c(e)·y(e) reaches 1. Heavily used edges
+//     become long, so later paths avoid them.
+//  3. The accumulated flow overloads edges; dividing it by the largest
+//     load/capacity ratio makes it feasible. This is never worse than the
+//     theoretical scaling by log_{1+ε}(1/δ) and gives λ >= (1-ε)³·λ*.
+//
+// Demands are first scaled so that λ* lies in [1, k] (k commodities), using
+// the single-commodity maximum flows as bounds, which bounds the number of
+// phases by O(k·ε⁻²·log m).
+//
+// Costs: the concurrent flow ignores costs, so a second stage looks for the
+// cheapest flow with the same throughput. A budget B becomes one more packing
+// constraint with its own length (cost(P)·φ added to every path length), and
+// a binary search over B keeps the cheapest run that still routes the target
+// share of every demand. Per-commodity costs (CostOverrides) only change the
+// path lengths of their commodity.
+//
+// Implementation notes:
+//   - Paths come from DijkstraWithContext on a copy of the graph whose edge
+//     costs are set to the current normalized lengths.
+//   - Capacities of bidirectional edges are per direction, as in
+//     ToResidualGraph. Parallel edges keep separate capacities; the second
+//     edge of a lane (see graph.AddEdgeWithID) is not a constraint.
+//   - Costs must be non-negative and edges must not have lower bounds.
+//
+// Time Complexity: O(k·ε⁻²·log m · T_sp) per stage, T_sp = O((V+E) log V)
+// Space Complexity: O(k·E)
+//
+// References:
+//   - Garg, N., Könemann, J. "Faster and Simpler Algorithms for
+//     Multicommodity Flow and Other Fractional Packing Problems" (1998)
+//   - Fleischer, L. "Approximating Fractional Multicommodity Flow
+//     Independent of the Number of Commodities" (2000)
+
+// DefaultMultiCommodityAccuracy is the default approximation parameter ε.
+const DefaultMultiCommodityAccuracy = 0.1
+
+// MinMultiCommodityAccuracy and MaxMultiCommodityAccuracy bound ε: smaller
+// values underflow the initial lengths, larger ones give no useful guarantee.
+const (
+	MinMultiCommodityAccuracy = 0.05
+	MaxMultiCommodityAccuracy = 0.5
+)
+
+// MultiCommodityBudgetSteps is the number of binary search steps over the
+// cost budget in the cost stage.
+const MultiCommodityBudgetSteps = 8
+
+// ErrNegativeCommodityCost is returned when an edge cost or cost override
+// is negative: lengths of the packing formulation must be non-negative.
+var ErrNegativeCommodityCost = errors.New("multi-commodity flow requires non-negative costs")
+
+// Commodity is one product class routed over the shared network.
+type Commodity struct {
+	// Source is the node the commodity is shipped from.
+	Source int64
+
+	// Sink is the node the commodity is shipped to.
+	Sink int64
+
+	// Demand is the amount to ship.
+	Demand float64
+
+	// CostOverrides maps edge IDs to the per-unit cost of this commodity on
+	// that edge. Edges without an override use their own cost.
+	CostOverrides map[int64]float64
+}
+
+// CommodityFlow is the routing of one commodity.
+type CommodityFlow struct {
+	// Flow is the amount shipped from the commodity's source to its sink.
+	Flow float64
+
+	// Cost is the cost of the shipped flow at the commodity's edge costs.
+	Cost float64
+
+	// Graph is a copy of the input graph carrying only this commodity's flow,
+	// with edge costs replaced by the commodity's costs. Nil when the sink is
+	// unreachable from the source.
+	Graph *graph.ResidualGraph
+}
+
+// MultiCommodityResult contains the result of MultiCommodityFlow.
+type MultiCommodityResult struct {
+	// Commodities holds the routing of every commodity, in input order.
+	Commodities []CommodityFlow
+
+	// Ratio is the approximate maximum concurrent ratio λ of the commodities
+	// that can reach their sink. Values above 1 mean spare capacity; the
+	// flows ship min(λ, 1) of every demand.
+	Ratio float64
+
+	// TotalCost is the cost of all shipped flow.
+	TotalCost float64
+
+	// Iterations is the number of shortest paths routed over all stages.
+	Iterations int
+
+	// Status is FLOW_STATUS_FEASIBLE when every demand is shipped and
+	// FLOW_STATUS_INFEASIBLE otherwise.
+	Status commonv1.FlowStatus
+
+	// Canceled indicates whether the operation was canceled via context.
+	Canceled bool
+
+	// Error is set when the input is invalid or the operation was canceled.
+	Error error
+}
+
+// MultiCommodityFlow routes several commodities over the shared capacities of
+// g (see the notes above). The aggregate flow of all commodities is
+// written to the forward edges of g; antiparallel edges keep their own flows.
+//
+// Parameters:
+//   - ctx: Context for cancellation support
+//   - g: The graph (forward edges receive the aggregate flow)
+//   - commodities: The commodities to route
+//   - accuracy: The approximation parameter ε, clamped to
+//     [MinMultiCommodityAccuracy, MaxMultiCommodityAccuracy];
+//     zero or negative uses DefaultMultiCommodityAccuracy
+//   - options: Solver options; MaxIterations limits the phases per stage
+//
+// Returns:
+//   - *MultiCommodityResult with per-commodity flows, the ratio and the cost
+func MultiCommodityFlow(ctx context.Context, g *graph.ResidualGraph, commodities []Commodity, accuracy float64, options *SolverOptions) *MultiCommodityResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	mc, err := newMultiCommodity(g, commodities, accuracy, options)
+	if err != nil {
+		return &MultiCommodityResult{Status: commonv1.FlowStatus_FLOW_STATUS_ERROR, Error: err}
+	}
+
+	result, err := mc.solve(ctx)
+	if err != nil {
+		return &MultiCommodityResult{
+			Status:   commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Canceled: errors.Is(err, ErrContextCanceled),
+			Error:    err,
+		}
+	}
+
+	return result
+}
+
+// mcArc is an edge of the working graph that can carry commodity flow.
+type mcArc struct {
+	from int64
+	edge *graph.ResidualEdge
+
+	// capacity is the shared capacity; +Inf for the unconstrained second
+	// edge of a lane.
+	capacity float64
+}
+
+// mcRun is the raw (overloaded) flow of one Garg–Könemann run.
+type mcRun struct {
+	flows  [][]float64 // commodity × arc
+	routed []float64   // per commodity, in scaled demand units
+	phases int
+}
+
+// mcSolution is a feasible flow derived from a run.
+type mcSolution struct {
+	factors []float64 // per-commodity multiplier of the run's flows
+	ratio   float64   // concurrent ratio in original demand units
+	cost    float64
+	run     *mcRun
+}
+
+// multiCommodity holds the state shared by all runs.
+type multiCommodity struct {
+	input       *graph.ResidualGraph
+	work        *graph.ResidualGraph
+	arcs        []mcArc
+	arcIndex    map[*graph.ResidualEdge]int
+	commodities []Commodity
+	costs       [][]float64 // commodity × arc
+	hasCosts    bool
+	demands     []float64 // scaled demands; 0 for unreachable commodities
+	scale       float64   // demands = scale · Commodity.Demand
+	eps         float64
+	maxPhases   int
+	iterations  int
+}
+
+func newMultiCommodity(g *graph.ResidualGraph, commodities []Commodity, accuracy float64, options *SolverOptions) (*multiCommodity, error) {
+	if g == nil {
+		return nil, ErrNilGraph
+	}
+
+	switch {
+	case accuracy <= 0:
+		accuracy = DefaultMultiCommodityAccuracy
+	case accuracy < MinMultiCommodityAccuracy:
+		accuracy = MinMultiCommodityAccuracy
+	case accuracy > MaxMultiCommodityAccuracy:
+		accuracy = MaxMultiCommodityAccuracy
+	}
+
+	mc := &multiCommodity{
+		input:       g,
+		work:        g.Clone(),
+		arcIndex:    make(map[*graph.ResidualEdge]int),
+		commodities: commodities,
+		eps:         accuracy,
+		maxPhases:   options.MaxIterations,
+	}
+
+	for _, from := range mc.work.GetSortedNodes() {
+		for _, edge := range mc.work.GetNeighborsList(from) {
+			if edge.IsReverse || edge.Capacity <= graph.Epsilon {
+				continue
+			}
+			if edge.Cost < 0 {
+				return nil, ErrNegativeCommodityCost
+			}
+			capacity := edge.Capacity
+			if mc.work.IsLaneNode(from) {
+				capacity = math.Inf(1)
+			}
+			mc.arcIndex[edge] = len(mc.arcs)
+			mc.arcs = append(mc.arcs, mcArc{from: from, edge: edge, capacity: capacity})
+		}
+	}
+
+	mc.costs = make([][]float64, len(commodities))
+	for j, c := range commodities {
+		if !g.Nodes[c.Source] {
+			return nil, ErrSourceNotFound
+		}
+		if !g.Nodes[c.Sink] {
+			return nil, ErrSinkNotFound
+		}
+		if c.Source == c.Sink {
+			return nil, ErrSourceEqualSink
+		}
+
+		mc.costs[j] = make([]float64, len(mc.arcs))
+		for i, arc := range mc.arcs {
+			cost := arc.edge.Cost
+			if override, ok := c.CostOverrides[arc.edge.ID]; ok && !mc.work.IsLaneNode(arc.from) {
+				cost = override
+			}
+			if cost < 0 {
+				return nil, ErrNegativeCommodityCost
+			}
+			mc.costs[j][i] = cost
+			if cost > 0 {
+				mc.hasCosts = true
+			}
+		}
+	}
+
+	return mc, nil
+}
+
+// solve runs the concurrent-flow stage, then the cost stage, and writes the
+// best solution to the input graph.
+func (mc *multiCommodity) solve(ctx context.Context) (*MultiCommodityResult, error) {
+	if err := mc.scaleDemands(ctx); err != nil {
+		return nil, err
+	}
+
+	best := &mcSolution{}
+	if mc.routable() > 0 {
+		run, err := mc.run(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+		best = mc.feasible(run, 0, math.Inf(1))
+
+		if mc.hasCosts && best.ratio > graph.Epsilon {
+			cheaper, err := mc.minimizeCost(ctx, best)
+			if err != nil {
+				return nil, err
+			}
+			best = cheaper
+		}
+	}
+
+	return mc.apply(best), nil
+}
+
+// routable returns the number of commodities that can reach their sink.
+func (mc *multiCommodity) routable() int {
+	count := 0
+	for _, d := range mc.demands {
+		if d > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// scaleDemands scales the demands so that the concurrent ratio lies in
+// [1, k]: λ* is at most the smallest single-commodity ratio maxflow/demand
+// and at least that ratio divided by k. Commodities whose sink is
+// unreachable are left out (demand 0).
+func (mc *multiCommodity) scaleDemands(ctx context.Context) error {
+	mc.demands = make([]float64, len(mc.commodities))
+	minRatio := math.Inf(1)
+	routable := 0
+
+	for j, c := range mc.commodities {
+		if c.Demand <= graph.Epsilon {
+			continue
+		}
+
+		probe := graph.NewCSRGraph(mc.work)
+		s, _ := probe.Index(c.Source)
+		t, _ := probe.Index(c.Sink)
+		maxFlow := DinicCSR(ctx, probe, s, t, DefaultSolverOptions())
+		if maxFlow.Canceled {
+			return ErrContextCanceled
+		}
+		if maxFlow.MaxFlow <= graph.Epsilon {
+			continue
+		}
+
+		mc.demands[j] = c.Demand
+		minRatio = math.Min(minRatio, maxFlow.MaxFlow/c.Demand)
+		routable++
+	}
+
+	if routable == 0 {
+		mc.scale = 1
+		return nil
+	}
+
+	mc.scale = minRatio / float64(routable)
+	for j := range mc.demands {
+		mc.demands[j] *= mc.scale
+	}
+	return nil
+}
+
+// run executes one Garg–Könemann run. A positive budget adds the cost
+// constraint Σ cost·flow <= budget.
+func (mc *multiCommodity) run(ctx context.Context, budget float64) (*mcRun, error) {
+	rows := 0
+	for _, arc := range mc.arcs {
+		if !math.IsInf(arc.capacity, 1) {
+			rows++
+		}
+	}
+	if budget > 0 {
+		rows++
+	}
+
+	delta := math.Pow(float64(rows)/(1-mc.eps), -1/mc.eps)
+
+	lengths := make([]float64, len(mc.arcs))
+	for i, arc := range mc.arcs {
+		if !math.IsInf(arc.capacity, 1) {
+			lengths[i] = delta / arc.capacity
+		}
+	}
+	var phi float64
+	if budget > 0 {
+		phi = delta / budget
+	}
+	dual := float64(rows) * delta
+
+	run := &mcRun{
+		flows:  make([][]float64, len(mc.commodities)),
+		routed: make([]float64, len(mc.commodities)),
+	}
+	for j := range run.flows {
+		run.flows[j] = make([]float64, len(mc.arcs))
+	}
+
+	for dual < 1 {
+		if mc.maxPhases > 0 && run.phases >= mc.maxPhases {
+			break
+		}
+
+		for j, c := range mc.commodities {
+			remaining := mc.demands[j]
+			for remaining > graph.Epsilon*mc.demands[j] && dual < 1 {
+				path, err := mc.shortestPath(ctx, c, mc.costs[j], lengths, phi)
+				if err != nil {
+					return nil, err
+				}
+				if path == nil {
+					break
+				}
+				mc.iterations++
+
+				amount, pathCost := remaining, 0.0
+				for _, i := range path {
+					amount = math.Min(amount, mc.arcs[i].capacity)
+					pathCost += mc.costs[j][i]
+				}
+				if budget > 0 && pathCost > 0 {
+					amount = math.Min(amount, budget/pathCost)
+				}
+
+				for _, i := range path {
+					run.flows[j][i] += amount
+					if capacity := mc.arcs[i].capacity; !math.IsInf(capacity, 1) {
+						grown := lengths[i] * (1 + mc.eps*amount/capacity)
+						dual += capacity * (grown - lengths[i])
+						lengths[i] = grown
+					}
+				}
+				if budget > 0 && pathCost > 0 {
+					grown := phi * (1 + mc.eps*amount*pathCost/budget)
+					dual += budget * (grown - phi)
+					phi = grown
+				}
+
+				remaining -= amount
+				run.routed[j] += amount
+			}
+		}
+
+		if dual < 1 {
+			run.phases++
+		}
+	}
+
+	return run, nil
+}
+
+// shortestPath returns the arcs of a shortest source-sink path of the
+// commodity under the lengths y(e) + φ·cost(e), or nil if there is none.
+func (mc *multiCommodity) shortestPath(ctx context.Context, c Commodity, costs, lengths []float64, phi float64) ([]int, error) {
+	// Lengths span many orders of magnitude; normalize them so that
+	// Dijkstra's epsilon comparisons stay meaningful
+	norm := 0.0
+	for i := range mc.arcs {
+		norm = math.Max(norm, lengths[i]+phi*costs[i])
+	}
+	if norm <= 0 {
+		norm = 1
+	}
+	for i, arc := range mc.arcs {
+		arc.edge.Cost = (lengths[i] + phi*costs[i]) / norm
+	}
+
+	return mc.dijkstraPath(ctx, c)
+}
+
+// cheapestPathCost returns the cost of the cheapest source-sink path of the
+// commodity, ignoring capacities.
+func (mc *multiCommodity) cheapestPathCost(ctx context.Context, c Commodity, costs []float64) (float64, error) {
+	for i, arc := range mc.arcs {
+		arc.edge.Cost = costs[i]
+	}
+
+	path, err := mc.dijkstraPath(ctx, c)
+	if err != nil || path == nil {
+		return 0, err
+	}
+
+	total := 0.0
+	for _, i := range path {
+		total += costs[i]
+	}
+	return total, nil
+}
+
+// dijkstraPath runs Dijkstra on the working graph with its current costs and
+// returns the arcs of the path to the commodity's sink.
+func (mc *multiCommodity) dijkstraPath(ctx context.Context, c Commodity) ([]int, error) {
+	sp := DijkstraWithContext(ctx, mc.work, c.Source)
+	if sp.Canceled {
+		return nil, ErrContextCanceled
+	}
+	if sp.Distances[c.Sink] >= graph.Infinity {
+		return nil, nil
+	}
+
+	var path []int
+	for v := c.Sink; v != c.Source; {
+		u := sp.Parent[v]
+		path = append(path, mc.arcIndex[mc.work.GetEdge(u, v)])
+		v = u
+	}
+	return path, nil
+}
+
+// feasible scales a run down to a feasible flow that ships at most target
+// times every demand. Loads are divided by the largest load/capacity ratio,
+// counting the budget as one more constraint.
+func (mc *multiCommodity) feasible(run *mcRun, budget, target float64) *mcSolution {
+	congestion := 0.0
+	for i, arc := range mc.arcs {
+		if math.IsInf(arc.capacity, 1) {
+			continue
+		}
+		load := 0.0
+		for j := range run.flows {
+			load += run.flows[j][i]
+		}
+		congestion = math.Max(congestion, load/arc.capacity)
+	}
+	if budget > 0 {
+		congestion = math.Max(congestion, mc.runCost(run, nil)/budget)
+	}
+
+	ratio := math.Inf(1)
+	for j, d := range mc.demands {
+		if d > 0 {
+			ratio = math.Min(ratio, run.routed[j]/d)
+		}
+	}
+	ratio = ratio / congestion * mc.scale
+
+	share := math.Min(math.Min(ratio, target), 1)
+	factors := make([]float64, len(mc.commodities))
+	for j, c := range mc.commodities {
+		if mc.demands[j] > 0 && run.routed[j] > 0 {
+			factors[j] = share * c.Demand / run.routed[j]
+		}
+	}
+
+	return &mcSolution{
+		factors: factors,
+		ratio:   ratio,
+		cost:    mc.runCost(run, factors),
+		run:     run,
+	}
+}
+
+// runCost returns the cost of a run's flows, multiplied by factors if given.
+func (mc *multiCommodity) runCost(run *mcRun, factors []float64) float64 {
+	total := 0.0
+	for j := range run.flows {
+		f := 1.0
+		if factors != nil {
+			f = factors[j]
+		}
+		for i, flow := range run.flows[j] {
+			total += f * flow * mc.costs[j][i]
+		}
+	}
+	return total
+}
+
+// minimizeCost binary-searches the smallest budget that still ships the share
+// of every demand reached by the concurrent flow. The lower bound routes every
+// commodity along its cheapest path, the upper bound is the current cost.
+func (mc *multiCommodity) minimizeCost(ctx context.Context, best *mcSolution) (*mcSolution, error) {
+	share := math.Min(best.ratio, 1)
+
+	lower := 0.0
+	for j, c := range mc.commodities {
+		if mc.demands[j] == 0 {
+			continue
+		}
+		cost, err := mc.cheapestPathCost(ctx, c, mc.costs[j])
+		if err != nil {
+			return nil, err
+		}
+		lower += share * c.Demand * cost
+	}
+	upper := best.cost
+
+	for step := 0; step < MultiCommodityBudgetSteps && upper-lower > mc.eps*upper; step++ {
+		budget := (lower + upper) / 2
+
+		run, err := mc.run(ctx, budget)
+		if err != nil {
+			return nil, err
+		}
+
+		candidate := mc.feasible(run, budget, share)
+		if candidate.ratio >= share*(1-graph.Epsilon) && candidate.cost < best.cost {
+			// Keep the concurrent ratio of the first stage for reporting
+			candidate.ratio = best.ratio
+			best = candidate
+			upper = candidate.cost
+		} else {
+			lower = budget
+		}
+	}
+
+	return best, nil
+}
+
+// apply writes a solution to the input graph and per-commodity copies.
+func (mc *multiCommodity) apply(best *mcSolution) *MultiCommodityResult {
+	result := &MultiCommodityResult{
+		Commodities: make([]CommodityFlow, len(mc.commodities)),
+		Ratio:       best.ratio,
+		Iterations:  mc.iterations,
+		Status:      commonv1.FlowStatus_FLOW_STATUS_FEASIBLE,
+	}
+
+	// Commodity graphs are cloned before the aggregate flow reaches the input
+	owns := make([]*graph.ResidualGraph, len(mc.commodities))
+	for j := range mc.commodities {
+		if mc.demands[j] > 0 && best.run != nil {
+			owns[j] = mc.input.Clone()
+		}
+	}
+
+	for j, c := range mc.commodities {
+		own := owns[j]
+		if own == nil {
+			if c.Demand > graph.Epsilon {
+				result.Status = commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE
+			}
+			continue
+		}
+
+		flow := best.factors[j] * best.run.routed[j]
+		cost := 0.0
+		for i, arc := range mc.arcs {
+			edge := own.GetEdge(arc.from, arc.edge.To)
+			edge.Cost = mc.costs[j][i]
+
+			amount := best.factors[j] * best.run.flows[j][i]
+			if amount <= graph.Epsilon {
+				continue
+			}
+			addForwardFlow(edge, amount)
+			addForwardFlow(mc.input.GetEdge(arc.from, arc.edge.To), amount)
+			cost += amount * mc.costs[j][i]
+		}
+
+		result.Commodities[j] = CommodityFlow{Flow: flow, Cost: cost, Graph: own}
+		result.TotalCost += cost
+		if flow < c.Demand*(1-graph.Epsilon) {
+			result.Status = commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE
+		}
+	}
+
+	return result
+}
+
+// addForwardFlow adds flow to a forward edge without touching its residual
+// edge: antiparallel edges of a multi-commodity flow carry independent flows.
+func addForwardFlow(edge *graph.ResidualEdge, amount float64) {
+	edge.Flow += amount
+	edge.Capacity -= amount
+}
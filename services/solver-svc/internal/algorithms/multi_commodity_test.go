@@ -0,0 +1,215 @@
+package algorithms
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// sharedLaneGraph: warehouses 1 and 2 ship to 5 and 6 through the shared
+// lane 3 -> 4 with capacity 10.
+func sharedLaneGraph() *graph.ResidualGraph {
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithReverse(1, 3, 10, 1)
+	g.AddEdgeWithReverse(2, 3, 10, 1)
+	g.AddEdgeWithReverse(3, 4, 10, 1)
+	g.AddEdgeWithReverse(4, 5, 10, 1)
+	g.AddEdgeWithReverse(4, 6, 10, 1)
+	return g
+}
+
+// twoRouteGraph: 1 -> 4 over a cheap route via 2 and an expensive one via 3,
+// both with capacity 10. Edge IDs: 1-2 = 1, 2-4 = 2, 1-3 = 3, 3-4 = 4.
+func twoRouteGraph() *graph.ResidualGraph {
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithID(1, 1, 2, 10, 1)
+	g.AddEdgeWithID(2, 2, 4, 10, 1)
+	g.AddEdgeWithID(3, 1, 3, 10, 5)
+	g.AddEdgeWithID(4, 3, 4, 10, 5)
+	return g
+}
+
+func TestMultiCommodityFlow_SharedBottleneck(t *testing.T) {
+	g := sharedLaneGraph()
+	commodities := []Commodity{
+		{Source: 1, Sink: 5, Demand: 8},
+		{Source: 2, Sink: 6, Demand: 8},
+	}
+
+	result := MultiCommodityFlow(context.Background(), g, commodities, 0.1, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE, result.Status)
+
+	// λ* = 10 / 16; the approximation guarantees (1-ε)³·λ*
+	assert.LessOrEqual(t, result.Ratio, 0.625+1e-9)
+	assert.GreaterOrEqual(t, result.Ratio, 0.625*math.Pow(0.9, 3))
+	for j, c := range commodities {
+		assert.InDelta(t, result.Ratio*c.Demand, result.Commodities[j].Flow, 1e-6)
+		assertValidFlow(t, result.Commodities[j].Graph, c.Source, c.Sink)
+	}
+	assert.LessOrEqual(t, g.GetEdge(3, 4).Flow, 10+1e-9)
+	assert.InDelta(t, 2*result.Ratio*8, g.GetEdge(3, 4).Flow, 1e-6, "aggregate flow on the shared lane")
+}
+
+func TestMultiCommodityFlow_CheapestRoute(t *testing.T) {
+	g := twoRouteGraph()
+
+	result := MultiCommodityFlow(context.Background(), g, []Commodity{
+		{Source: 1, Sink: 4, Demand: 5},
+	}, 0.1, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_FEASIBLE, result.Status)
+	assert.GreaterOrEqual(t, result.Ratio, 1.0)
+	assert.InDelta(t, 5.0, result.Commodities[0].Flow, 1e-6)
+	// Optimum 10 (everything on the cheap route), within the accuracy
+	assert.LessOrEqual(t, result.TotalCost, 10*1.1+1e-6)
+	assert.InDelta(t, result.TotalCost, g.GetTotalCost(), 1e-6)
+}
+
+func TestMultiCommodityFlow_SharedCapacitySplitsRoutes(t *testing.T) {
+	g := twoRouteGraph()
+	commodities := []Commodity{
+		{Source: 1, Sink: 4, Demand: 10},
+		{Source: 1, Sink: 4, Demand: 10},
+	}
+
+	result := MultiCommodityFlow(context.Background(), g, commodities, 0.1, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_FEASIBLE, result.Status)
+	assert.InDelta(t, 20.0, result.Commodities[0].Flow+result.Commodities[1].Flow, 1e-6)
+	// Both routes are needed: 10·2 + 10·10
+	assert.InDelta(t, 120.0, result.TotalCost, 1e-6)
+	assert.InDelta(t, 10.0, g.GetEdge(1, 2).Flow, 1e-6)
+	assert.InDelta(t, 10.0, g.GetEdge(1, 3).Flow, 1e-6)
+}
+
+func TestMultiCommodityFlow_CostOverrides(t *testing.T) {
+	g := twoRouteGraph()
+	commodities := []Commodity{
+		{Source: 1, Sink: 4, Demand: 4},
+		// Hazmat must not use the cheap route: its edges cost 100 for it
+		{Source: 1, Sink: 4, Demand: 4, CostOverrides: map[int64]float64{1: 100, 2: 100}},
+	}
+
+	result := MultiCommodityFlow(context.Background(), g, commodities, 0.1, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_FEASIBLE, result.Status)
+
+	regular, hazmat := result.Commodities[0], result.Commodities[1]
+	assert.InDelta(t, 4.0, regular.Graph.GetEdge(1, 2).Flow, 0.4)
+	assert.InDelta(t, 4.0, hazmat.Graph.GetEdge(1, 3).Flow, 0.4)
+	assert.Equal(t, 100.0, hazmat.Graph.GetEdge(1, 2).Cost, "commodity graph carries its own costs")
+	assert.InDelta(t, regular.Cost+hazmat.Cost, result.TotalCost, 1e-9)
+	// Optimum 4·2 + 4·10, within the accuracy
+	assert.LessOrEqual(t, result.TotalCost, 48*1.1+1e-6)
+}
+
+func TestMultiCommodityFlow_ParallelAndBidirectionalEdges(t *testing.T) {
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithID(1, 1, 2, 5, 1)
+	g.AddEdgeWithID(2, 1, 2, 5, 3) // parallel lane
+	g.AddEdgeWithID(3, 2, 3, 20, 1)
+	g.AddEdgeWithID(3, 3, 2, 20, 1) // bidirectional
+	g.AddEdgeWithID(4, 3, 4, 20, 1)
+
+	result := MultiCommodityFlow(context.Background(), g, []Commodity{
+		{Source: 1, Sink: 4, Demand: 8},
+		{Source: 3, Sink: 2, Demand: 5},
+	}, 0.1, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_FEASIBLE, result.Status)
+	// Both lanes are needed for the first commodity
+	assert.GreaterOrEqual(t, g.GetEdge(1, 2).Flow, 3-1e-6)
+	assert.GreaterOrEqual(t, g.GetEdge(1, graph.LaneNodeBase).Flow, 3-1e-6)
+	// Opposite directions of the bidirectional edge carry independent flows
+	assert.InDelta(t, 8.0, g.GetEdge(2, 3).Flow, 1e-6)
+	assert.InDelta(t, 5.0, g.GetEdge(3, 2).Flow, 1e-6)
+}
+
+func TestMultiCommodityFlow_UnreachableCommodity(t *testing.T) {
+	g := sharedLaneGraph()
+	g.AddNode(7)
+
+	result := MultiCommodityFlow(context.Background(), g, []Commodity{
+		{Source: 1, Sink: 5, Demand: 4},
+		{Source: 1, Sink: 7, Demand: 4},
+	}, 0.1, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE, result.Status)
+	assert.InDelta(t, 4.0, result.Commodities[0].Flow, 1e-6)
+	assert.Zero(t, result.Commodities[1].Flow)
+	assert.Nil(t, result.Commodities[1].Graph)
+}
+
+func TestMultiCommodityFlow_RandomGraphsStayFeasible(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		g := randomFlowGraph(seed, 15, 60)
+		commodities := []Commodity{
+			{Source: 1, Sink: 15, Demand: 20},
+			{Source: 2, Sink: 14, Demand: 10},
+			{Source: 3, Sink: 13, Demand: 15},
+		}
+
+		result := MultiCommodityFlow(context.Background(), g, commodities, 0.2, nil)
+
+		require.NoError(t, result.Error, "seed %d", seed)
+		for j, c := range commodities {
+			if result.Commodities[j].Graph == nil {
+				continue
+			}
+			assert.LessOrEqual(t, result.Commodities[j].Flow, c.Demand+1e-6, "seed %d", seed)
+			assertValidFlow(t, result.Commodities[j].Graph, c.Source, c.Sink)
+		}
+		for _, from := range g.GetSortedNodes() {
+			for _, edge := range g.GetNeighborsList(from) {
+				assert.GreaterOrEqual(t, edge.Capacity, -1e-6, "seed %d: edge %d -> %d", seed, from, edge.To)
+			}
+		}
+	}
+}
+
+func TestMultiCommodityFlow_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name        string
+		commodities []Commodity
+		wantErr     error
+	}{
+		{"negative_override", []Commodity{{Source: 1, Sink: 4, Demand: 1, CostOverrides: map[int64]float64{1: -1}}}, ErrNegativeCommodityCost},
+		{"unknown_source", []Commodity{{Source: 9, Sink: 4, Demand: 1}}, ErrSourceNotFound},
+		{"unknown_sink", []Commodity{{Source: 1, Sink: 9, Demand: 1}}, ErrSinkNotFound},
+		{"source_equals_sink", []Commodity{{Source: 1, Sink: 1, Demand: 1}}, ErrSourceEqualSink},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MultiCommodityFlow(context.Background(), twoRouteGraph(), tt.commodities, 0, nil)
+
+			assert.ErrorIs(t, result.Error, tt.wantErr)
+			assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_ERROR, result.Status)
+		})
+	}
+}
+
+func TestMultiCommodityFlow_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	g := sharedLaneGraph()
+	result := MultiCommodityFlow(ctx, g, []Commodity{{Source: 1, Sink: 5, Demand: 4}}, 0.1, nil)
+
+	assert.True(t, result.Canceled)
+	assert.ErrorIs(t, result.Error, ErrContextCanceled)
+	assert.Zero(t, g.GetTotalFlow(1))
+}
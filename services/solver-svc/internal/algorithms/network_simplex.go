@@ -0,0 +1,628 @@
+package algorithms
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Network Simplex Algorithm
+// =============================================================================
+//
+// Network simplex solves min-cost flow as a linear program whose bases are
+// spanning trees. Every arc not in the tree sits at one of its bounds (zero
+// flow or full capacity); tree arcs carry whatever flow balances the node
+// supplies. A pivot picks a non-tree arc with negative reduced cost, sends
+// flow around the cycle it closes with the tree, and swaps it with the arc
+// that hits its bound first.
+//
+// The problem is solved on the residual arcs of a graph.CSRGraph: every arc
+// with residual capacity becomes a variable with bounds [0, Capacity], so
+// flow already in the graph (e.g. from edge lower bounds) is respected and
+// can be rerouted if that is cheaper. The source supplies the maximum flow
+// value, found first with DinicCSR, and the sink demands it.
+//
+// Implementation notes:
+//   - Initial basis: an artificial root with one artificial arc per node.
+//     Supply nodes send their supply to the root at zero cost; demand nodes
+//     receive from the root at a cost larger than any simple path, so
+//     optimal bases route no flow over artificial arcs.
+//   - Block search pivot rule: arcs are scanned in blocks of about √E
+//     starting where the previous search stopped, and the best candidate of
+//     the first block containing one enters the basis.
+//   - Degeneracy: the leaving arc is chosen by the strongly feasible tree
+//     rule (last blocking arc on the path towards the entering arc's tail,
+//     first on the other side), which prevents cycling on degenerate pivots.
+//
+// Complexity: exponential in the worst case, in practice fast on dense,
+// cost-heavy instances where successive shortest paths need many Dijkstra
+// runs.
+
+// Arc states of the network simplex basis. Non-tree arcs have their state
+// multiplied with the reduced cost to test for a candidate.
+const (
+	nsStateUpper int8 = -1
+	nsStateTree  int8 = 0
+	nsStateLower int8 = 1
+)
+
+// Directions of a tree arc relative to the child node.
+const (
+	nsDirUp   int8 = 1  // child → parent
+	nsDirDown int8 = -1 // parent → child
+)
+
+// nsMinBlockSize is the smallest block of the block search pivot rule.
+const nsMinBlockSize = 10
+
+// NetworkSimplexResult contains the result of the network simplex algorithm.
+type NetworkSimplexResult struct {
+	// Flow is the total flow sent from source to sink.
+	Flow float64
+
+	// Cost is the cost of the flow added by the algorithm.
+	Cost float64
+
+	// Iterations is the number of pivots performed.
+	Iterations int
+
+	// Canceled indicates if the computation was interrupted by context cancellation.
+	Canceled bool
+
+	// Error is set if no optimal basis was found (ErrPivotLimit, ErrUnboundedCost).
+	// The graph is left unchanged in that case.
+	Error error
+}
+
+// PivotCallback is a function called after every network simplex pivot with
+// the number of pivots so far, the flow already routed from source to sink
+// without artificial arcs, and the current cost.
+type PivotCallback func(pivots int, flow, cost float64)
+
+// NetworkSimplex finds a minimum-cost maximum flow using network simplex.
+//
+// Parameters:
+//   - g: The residual graph (will be modified)
+//   - source: The source node ID
+//   - sink: The sink node ID
+//   - options: Solver options (nil for defaults)
+//
+// Returns:
+//   - *NetworkSimplexResult containing flow, cost and pivot count
+func NetworkSimplex(g *graph.ResidualGraph, source, sink int64, options *SolverOptions) *NetworkSimplexResult {
+	return NetworkSimplexWithContext(context.Background(), g, source, sink, options)
+}
+
+// NetworkSimplexWithContext finds a minimum-cost maximum flow using network
+// simplex with cancellation support.
+func NetworkSimplexWithContext(ctx context.Context, g *graph.ResidualGraph, source, sink int64, options *SolverOptions) *NetworkSimplexResult {
+	return NetworkSimplexWithCallback(ctx, g, source, sink, options, nil)
+}
+
+// NetworkSimplexWithCallback finds a minimum-cost maximum flow using network
+// simplex and reports every pivot to callback (which may be nil).
+//
+// Options.MaxIterations limits the number of pivots; reaching the limit
+// returns ErrPivotLimit because intermediate bases are not valid flows.
+func NetworkSimplexWithCallback(ctx context.Context, g *graph.ResidualGraph, source, sink int64, options *SolverOptions, callback PivotCallback) *NetworkSimplexResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	// Phase 1: the maximum flow value becomes the supply of the source
+	probe := graph.NewCSRGraph(g)
+	s, _ := probe.Index(source)
+	t, _ := probe.Index(sink)
+	maxFlow := DinicCSR(ctx, probe, s, t, &SolverOptions{Epsilon: options.Epsilon})
+	if maxFlow.Canceled {
+		return &NetworkSimplexResult{Canceled: true}
+	}
+
+	// Phase 2: min-cost flow of that value on a fresh snapshot
+	c := graph.NewCSRGraph(g)
+	result := NetworkSimplexCSR(ctx, c, s, t, maxFlow.MaxFlow, options, callback)
+	if !result.Canceled && result.Error == nil {
+		c.WriteBack(g)
+	}
+
+	return result
+}
+
+// NetworkSimplexCSR sends requiredFlow units from source to sink at minimum
+// cost on a CSR graph. requiredFlow must be feasible (e.g. the max flow).
+//
+// Parameters:
+//   - ctx: Context for cancellation support
+//   - c: The CSR graph (pushed to on success; call WriteBack to apply the flow)
+//   - source: The source node index
+//   - sink: The sink node index
+//   - requiredFlow: Flow to send from source to sink
+//   - options: Solver options (nil for defaults)
+//   - callback: Called after every pivot (can be nil)
+//
+// Returns:
+//   - *NetworkSimplexResult; on cancellation or error c is left unchanged
+func NetworkSimplexCSR(ctx context.Context, c *graph.CSRGraph, source, sink int32, requiredFlow float64, options *SolverOptions, callback PivotCallback) *NetworkSimplexResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	ns := newNetworkSimplex(c, options.Epsilon)
+	ns.initialize(source, sink, requiredFlow)
+
+	const checkInterval = 100
+	pivots := 0
+
+	for {
+		if pivots%checkInterval == 0 {
+			select {
+			case <-ctx.Done():
+				return &NetworkSimplexResult{Iterations: pivots, Canceled: true}
+			default:
+			}
+		}
+
+		in, ok := ns.findEnteringArc()
+		if !ok {
+			break
+		}
+		if options.MaxIterations > 0 && pivots >= options.MaxIterations {
+			return &NetworkSimplexResult{Iterations: pivots, Error: ErrPivotLimit}
+		}
+
+		first, second := ns.cycleEnds(in)
+		join := ns.findJoinNode(in)
+		delta, out, side := ns.findLeavingArc(in, first, second, join)
+		if delta >= graph.Infinity {
+			return &NetworkSimplexResult{Iterations: pivots, Error: ErrUnboundedCost}
+		}
+
+		ns.changeFlow(in, join, delta)
+		ns.updateBasis(in, first, second, out, side)
+		pivots++
+
+		if callback != nil {
+			callback(pivots, requiredFlow-ns.flow[ns.artificialArc(source)], ns.currentCost)
+		}
+	}
+
+	if err := ns.checkArtificialFlow(); err != nil {
+		return &NetworkSimplexResult{Iterations: pivots, Error: err}
+	}
+
+	return &NetworkSimplexResult{
+		Flow:       requiredFlow,
+		Cost:       ns.apply(),
+		Iterations: pivots,
+	}
+}
+
+// networkSimplex holds the network simplex state. Arcs 0..arcCount-1 are
+// the residual arcs of the CSR graph with positive capacity; arc
+// arcCount+u is the artificial arc of node u. Node nodeCount is the root.
+type networkSimplex struct {
+	c         *graph.CSRGraph
+	epsilon   float64
+	tolerance float64 // reduced cost below -tolerance makes a candidate
+	nodeCount int
+	arcCount  int
+
+	// Arc data
+	csrArc   []int32 // CSR arc of every real arc
+	tail     []int32
+	head     []int32
+	capacity []float64
+	cost     []float64
+	flow     []float64
+	state    []int8
+
+	// Spanning tree: parent links with the arc to the parent, and child
+	// lists to walk subtrees after a pivot
+	parent      []int32
+	pred        []int32
+	predDir     []int8
+	depth       []int32
+	firstChild  []int32
+	nextSibling []int32
+	prevSibling []int32
+	potential   []float64
+
+	// Block search pivot rule
+	blockSize int
+	nextArc   int
+
+	// currentCost is the cost of the flow on real arcs, kept up to date
+	// for the pivot callback.
+	currentCost float64
+
+	stack []int32
+}
+
+// newNetworkSimplex collects the arcs of c with residual capacity.
+func newNetworkSimplex(c *graph.CSRGraph, epsilon float64) *networkSimplex {
+	n := c.NodeCount()
+
+	m := 0
+	for a := range c.Capacity {
+		if c.Capacity[a] > epsilon {
+			m++
+		}
+	}
+
+	total := m + n
+	ns := &networkSimplex{
+		c:           c,
+		epsilon:     epsilon,
+		nodeCount:   n,
+		arcCount:    m,
+		csrArc:      make([]int32, 0, m),
+		tail:        make([]int32, total),
+		head:        make([]int32, total),
+		capacity:    make([]float64, total),
+		cost:        make([]float64, total),
+		flow:        make([]float64, total),
+		state:       make([]int8, total),
+		parent:      make([]int32, n+1),
+		pred:        make([]int32, n+1),
+		predDir:     make([]int8, n+1),
+		depth:       make([]int32, n+1),
+		firstChild:  make([]int32, n+1),
+		nextSibling: make([]int32, n+1),
+		prevSibling: make([]int32, n+1),
+		potential:   make([]float64, n+1),
+		blockSize:   max(int(math.Sqrt(float64(total))), nsMinBlockSize),
+	}
+
+	for u := 0; u < n; u++ {
+		for a := c.Start[u]; a < c.Start[u+1]; a++ {
+			if c.Capacity[a] <= epsilon {
+				continue
+			}
+			e := len(ns.csrArc)
+			ns.csrArc = append(ns.csrArc, a)
+			ns.tail[e] = int32(u)
+			ns.head[e] = c.Head[a]
+			ns.capacity[e] = c.Capacity[a]
+			ns.cost[e] = c.Cost[a]
+		}
+	}
+
+	return ns
+}
+
+// artificialArc returns the artificial arc of node u.
+func (ns *networkSimplex) artificialArc(u int32) int {
+	return ns.arcCount + int(u)
+}
+
+// initialize builds the artificial starting basis for the given supplies.
+func (ns *networkSimplex) initialize(source, sink int32, requiredFlow float64) {
+	n := ns.nodeCount
+	root := int32(n)
+
+	// Any simple path costs less than artCost
+	maxCost := 0.0
+	for e := 0; e < ns.arcCount; e++ {
+		ns.state[e] = nsStateLower
+		maxCost = max(maxCost, math.Abs(ns.cost[e]))
+	}
+	artCost := (maxCost + 1) * float64(n+1)
+
+	// Potentials reach artCost, so reduced costs carry rounding errors
+	// proportional to it
+	ns.tolerance = max(ns.epsilon, artCost*1e-12)
+
+	ns.parent[root] = -1
+	ns.pred[root] = -1
+	ns.firstChild[root] = -1
+	ns.nextSibling[root] = -1
+	ns.prevSibling[root] = -1
+
+	for u := int32(0); u < int32(n); u++ {
+		supply := 0.0
+		switch u {
+		case source:
+			supply = requiredFlow
+		case sink:
+			supply = -requiredFlow
+		}
+
+		e := ns.artificialArc(u)
+		ns.capacity[e] = graph.Infinity
+		ns.state[e] = nsStateTree
+		ns.parent[u] = root
+		ns.pred[u] = int32(e)
+		ns.depth[u] = 1
+		ns.firstChild[u] = -1
+		ns.addChild(root, u)
+
+		if supply >= 0 {
+			ns.tail[e], ns.head[e] = u, root
+			ns.predDir[u] = nsDirUp
+			ns.flow[e] = supply
+			ns.potential[u] = 0
+		} else {
+			ns.tail[e], ns.head[e] = root, u
+			ns.predDir[u] = nsDirDown
+			ns.flow[e] = -supply
+			ns.cost[e] = artCost
+			ns.potential[u] = artCost
+		}
+	}
+}
+
+// reducedCost returns the reduced cost of arc e under the current potentials.
+func (ns *networkSimplex) reducedCost(e int) float64 {
+	return ns.cost[e] + ns.potential[ns.tail[e]] - ns.potential[ns.head[e]]
+}
+
+// findEnteringArc applies the block search pivot rule. Returns false if no
+// arc violates the optimality conditions.
+func (ns *networkSimplex) findEnteringArc() (int, bool) {
+	total := len(ns.state)
+	best := -ns.tolerance
+	in := -1
+	count := ns.blockSize
+
+	for i := 0; i < total; i++ {
+		e := (ns.nextArc + i) % total
+		if ns.state[e] != nsStateTree {
+			if v := float64(ns.state[e]) * ns.reducedCost(e); v < best {
+				best = v
+				in = e
+			}
+		}
+
+		count--
+		if count == 0 {
+			if in >= 0 {
+				ns.nextArc = (e + 1) % total
+				return in, true
+			}
+			count = ns.blockSize
+		}
+	}
+
+	if in < 0 {
+		return 0, false
+	}
+	ns.nextArc = (in + 1) % total
+	return in, true
+}
+
+// cycleEnds orients the cycle of entering arc in: flow is sent along the
+// entering arc from first to second.
+func (ns *networkSimplex) cycleEnds(in int) (first, second int32) {
+	if ns.state[in] == nsStateLower {
+		return ns.tail[in], ns.head[in]
+	}
+	return ns.head[in], ns.tail[in]
+}
+
+// findJoinNode returns the lowest common ancestor of the ends of arc in.
+func (ns *networkSimplex) findJoinNode(in int) int32 {
+	u, v := ns.tail[in], ns.head[in]
+	for u != v {
+		if ns.depth[u] > ns.depth[v] {
+			u = ns.parent[u]
+		} else {
+			v = ns.parent[v]
+		}
+	}
+	return u
+}
+
+// treeResidual returns how much the tree arc above u can carry in the
+// given cycle direction: increasing is true if the cycle flow follows the
+// arc's orientation.
+func (ns *networkSimplex) treeResidual(u int32, increasing bool) float64 {
+	e := ns.pred[u]
+	if !increasing {
+		return ns.flow[e]
+	}
+	if ns.capacity[e] >= graph.Infinity {
+		return graph.Infinity
+	}
+	return ns.capacity[e] - ns.flow[e]
+}
+
+// findLeavingArc finds the cycle bottleneck and the leaving arc by the
+// strongly feasible tree rule. side is 0 if the entering arc itself is the
+// bottleneck, 1 if the leaving arc is on the path from join to first, and 2
+// if it is on the path from second to join. out is the child node of the
+// leaving tree arc.
+func (ns *networkSimplex) findLeavingArc(in int, first, second, join int32) (delta float64, out int32, side int) {
+	delta = ns.capacity[in]
+
+	// Flow goes down from join to first
+	for u := first; u != join; u = ns.parent[u] {
+		if d := ns.treeResidual(u, ns.predDir[u] == nsDirDown); d < delta {
+			delta, out, side = d, u, 1
+		}
+	}
+
+	// Flow goes up from second to join
+	for u := second; u != join; u = ns.parent[u] {
+		if d := ns.treeResidual(u, ns.predDir[u] == nsDirUp); d <= delta {
+			delta, out, side = d, u, 2
+		}
+	}
+
+	return delta, out, side
+}
+
+// changeFlow sends delta units around the cycle of entering arc in.
+func (ns *networkSimplex) changeFlow(in int, join int32, delta float64) {
+	if delta <= 0 {
+		return
+	}
+
+	val := float64(ns.state[in]) * delta
+	ns.addFlow(in, val)
+	for u := ns.tail[in]; u != join; u = ns.parent[u] {
+		ns.addFlow(int(ns.pred[u]), -float64(ns.predDir[u])*val)
+	}
+	for u := ns.head[in]; u != join; u = ns.parent[u] {
+		ns.addFlow(int(ns.pred[u]), float64(ns.predDir[u])*val)
+	}
+}
+
+// addFlow changes the flow on arc e, tracking the cost of real arcs.
+func (ns *networkSimplex) addFlow(e int, delta float64) {
+	ns.flow[e] += delta
+	if e < ns.arcCount {
+		ns.currentCost += ns.cost[e] * delta
+	}
+}
+
+// updateBasis swaps the entering and leaving arcs and fixes the tree.
+func (ns *networkSimplex) updateBasis(in int, first, second, out int32, side int) {
+	if side == 0 {
+		// The entering arc moves to its other bound
+		ns.state[in] = -ns.state[in]
+		ns.snapToBound(in)
+		return
+	}
+
+	leaving := int(ns.pred[out])
+	increasing := ns.predDir[out] == nsDirDown
+	if side == 2 {
+		increasing = !increasing
+	}
+	if increasing {
+		ns.state[leaving] = nsStateUpper
+	} else {
+		ns.state[leaving] = nsStateLower
+	}
+	ns.snapToBound(leaving)
+	ns.state[in] = nsStateTree
+
+	// The subtree below the leaving arc is re-hung from the entering arc
+	uIn, vIn := first, second
+	if side == 2 {
+		uIn, vIn = second, first
+	}
+	ns.rehang(in, uIn, vIn, out)
+}
+
+// snapToBound sets the flow of a non-tree arc exactly to its bound.
+func (ns *networkSimplex) snapToBound(e int) {
+	target := 0.0
+	if ns.state[e] == nsStateUpper {
+		target = ns.capacity[e]
+	}
+	ns.addFlow(e, target-ns.flow[e])
+}
+
+// rehang cuts the tree arc above out and reconnects the cut subtree through
+// the entering arc in from uIn (inside the subtree) to vIn. The path from
+// uIn up to out is reversed so that uIn becomes the subtree root.
+func (ns *networkSimplex) rehang(in int, uIn, vIn, out int32) {
+	path := ns.stack[:0]
+	for u := uIn; ; u = ns.parent[u] {
+		path = append(path, u)
+		if u == out {
+			break
+		}
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		ns.removeChild(ns.parent[path[i]], path[i])
+	}
+
+	// Reverse parent links top-down so every old pred is read before it is
+	// overwritten
+	for i := len(path) - 1; i > 0; i-- {
+		x, y := path[i], path[i-1]
+		ns.parent[x] = y
+		ns.pred[x] = ns.pred[y]
+		ns.predDir[x] = -ns.predDir[y]
+		ns.addChild(y, x)
+	}
+
+	ns.parent[uIn] = vIn
+	ns.pred[uIn] = int32(in)
+	if ns.tail[in] == uIn {
+		ns.predDir[uIn] = nsDirUp
+	} else {
+		ns.predDir[uIn] = nsDirDown
+	}
+	ns.addChild(vIn, uIn)
+
+	ns.stack = path
+	ns.updateSubtree(uIn)
+}
+
+// updateSubtree recomputes depths and potentials below (and including) root
+// so that tree arcs keep zero reduced cost.
+func (ns *networkSimplex) updateSubtree(root int32) {
+	stack := append(ns.stack[:0], root)
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		p, e := ns.parent[u], ns.pred[u]
+		ns.depth[u] = ns.depth[p] + 1
+		if ns.predDir[u] == nsDirUp {
+			ns.potential[u] = ns.potential[p] - ns.cost[e]
+		} else {
+			ns.potential[u] = ns.potential[p] + ns.cost[e]
+		}
+
+		for v := ns.firstChild[u]; v >= 0; v = ns.nextSibling[v] {
+			stack = append(stack, v)
+		}
+	}
+	ns.stack = stack
+}
+
+// addChild links u as the first child of p.
+func (ns *networkSimplex) addChild(p, u int32) {
+	next := ns.firstChild[p]
+	ns.nextSibling[u] = next
+	ns.prevSibling[u] = -1
+	if next >= 0 {
+		ns.prevSibling[next] = u
+	}
+	ns.firstChild[p] = u
+}
+
+// removeChild unlinks u from the children of p.
+func (ns *networkSimplex) removeChild(p, u int32) {
+	prev, next := ns.prevSibling[u], ns.nextSibling[u]
+	if prev >= 0 {
+		ns.nextSibling[prev] = next
+	} else {
+		ns.firstChild[p] = next
+	}
+	if next >= 0 {
+		ns.prevSibling[next] = prev
+	}
+}
+
+// checkArtificialFlow reports an error if an optimal basis still routes
+// flow over an artificial arc, i.e. the supplies were not feasible.
+func (ns *networkSimplex) checkArtificialFlow() error {
+	for u := 0; u < ns.nodeCount; u++ {
+		if f := ns.flow[ns.artificialArc(int32(u))]; f > ns.epsilon {
+			return fmt.Errorf("network simplex: %g units of flow at node %d cannot be routed", f, ns.c.IDs[u])
+		}
+	}
+	return nil
+}
+
+// apply pushes the flow of every real arc onto the CSR graph and returns
+// its cost.
+func (ns *networkSimplex) apply() float64 {
+	total := 0.0
+	for e := 0; e < ns.arcCount; e++ {
+		if f := ns.flow[e]; f > 0 {
+			ns.c.Push(ns.csrArc[e], f)
+			total += ns.cost[e] * f
+		}
+	}
+	return total
+}
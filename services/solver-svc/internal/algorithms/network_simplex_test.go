@@ -0,0 +1,226 @@
+package algorithms
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+func TestNetworkSimplex(t *testing.T) {
+	tests := []struct {
+		name       string
+		buildGraph func() *graph.ResidualGraph
+		source     int64
+		sink       int64
+		wantFlow   float64
+		wantCost   float64
+	}{
+		{
+			name: "simple_edge",
+			buildGraph: func() *graph.ResidualGraph {
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 10, 5)
+				return g
+			},
+			source:   1,
+			sink:     2,
+			wantFlow: 10,
+			wantCost: 50,
+		},
+		{
+			name: "use_both_paths",
+			buildGraph: func() *graph.ResidualGraph {
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 3, 1)
+				g.AddEdgeWithReverse(2, 4, 3, 1)
+				g.AddEdgeWithReverse(1, 3, 5, 5)
+				g.AddEdgeWithReverse(3, 4, 5, 5)
+				return g
+			},
+			source:   1,
+			sink:     4,
+			wantFlow: 8,
+			wantCost: 56, // 3 * 2 + 5 * 10
+		},
+		{
+			name: "reroute_through_cross_edge",
+			buildGraph: func() *graph.ResidualGraph {
+				// The cheapest first path 1-2-3-4 blocks both others; the
+				// optimum sends 1-2-4 and 1-3-4
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 1, 1)
+				g.AddEdgeWithReverse(1, 3, 1, 4)
+				g.AddEdgeWithReverse(2, 3, 1, 1)
+				g.AddEdgeWithReverse(2, 4, 1, 4)
+				g.AddEdgeWithReverse(3, 4, 1, 1)
+				return g
+			},
+			source:   1,
+			sink:     4,
+			wantFlow: 2,
+			wantCost: 10,
+		},
+		{
+			name: "no_path",
+			buildGraph: func() *graph.ResidualGraph {
+				g := graph.NewResidualGraph()
+				g.AddEdgeWithReverse(1, 2, 10, 1)
+				g.AddNode(3)
+				return g
+			},
+			source:   1,
+			sink:     3,
+			wantFlow: 0,
+			wantCost: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.buildGraph()
+
+			result := NetworkSimplex(g, tt.source, tt.sink, nil)
+
+			require.NoError(t, result.Error)
+			assert.InDelta(t, tt.wantFlow, result.Flow, 1e-9)
+			assert.InDelta(t, tt.wantCost, result.Cost, 1e-9)
+			assert.InDelta(t, tt.wantFlow, g.GetTotalFlow(tt.source), 1e-9)
+			assert.InDelta(t, tt.wantCost, g.GetTotalCost(), 1e-9)
+		})
+	}
+}
+
+func TestNetworkSimplex_MatchesSuccessiveShortestPath(t *testing.T) {
+	ctx := context.Background()
+
+	for seed := int64(1); seed <= 30; seed++ {
+		ssp := randomFlowGraph(seed, 25, 120)
+		simplex := ssp.Clone()
+
+		want := Solve(ctx, ssp, 1, 25, commonv1.Algorithm_ALGORITHM_MIN_COST, nil)
+		got := Solve(ctx, simplex, 1, 25, commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX, nil)
+
+		require.NoError(t, want.Error)
+		require.NoError(t, got.Error, "seed %d", seed)
+		assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_OPTIMAL, got.Status)
+		assert.InDelta(t, want.MaxFlow, got.MaxFlow, 1e-6, "seed %d", seed)
+		// SSP cancels flow on an antiparallel edge at that edge's own cost,
+		// so on random graphs it may end up above the true optimum
+		assert.LessOrEqual(t, got.TotalCost, want.TotalCost+1e-6, "seed %d", seed)
+		assert.InDelta(t, got.TotalCost, simplex.GetTotalCost(), 1e-6, "seed %d", seed)
+		assertValidFlow(t, simplex, 1, 25)
+	}
+}
+
+func TestNetworkSimplex_MatchesSuccessiveShortestPathOnDAG(t *testing.T) {
+	ctx := context.Background()
+
+	for seed := int64(1); seed <= 30; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		ssp := graph.NewResidualGraph()
+		for i := 0; i < 120; i++ {
+			from := int64(r.Intn(24) + 1)
+			to := from + int64(r.Intn(6)+1)
+			if to > 25 {
+				continue
+			}
+			ssp.AddEdgeWithReverse(from, to, float64(r.Intn(20)+1), float64(r.Intn(10)))
+		}
+		simplex := ssp.Clone()
+
+		want := Solve(ctx, ssp, 1, 25, commonv1.Algorithm_ALGORITHM_MIN_COST, nil)
+		got := Solve(ctx, simplex, 1, 25, commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX, nil)
+
+		require.NoError(t, got.Error, "seed %d", seed)
+		assert.InDelta(t, want.MaxFlow, got.MaxFlow, 1e-6, "seed %d", seed)
+		assert.InDelta(t, want.TotalCost, got.TotalCost, 1e-6, "seed %d", seed)
+		assertValidFlow(t, simplex, 1, 25)
+	}
+}
+
+func TestNetworkSimplex_CancelsExistingNegativeCycle(t *testing.T) {
+	// Flow already sits on the expensive route 1-3-4; the residual graph
+	// has a negative cycle that SSP cannot remove but network simplex does
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithReverse(1, 2, 5, 1)
+	g.AddEdgeWithReverse(2, 4, 5, 1)
+	g.AddEdgeWithReverse(1, 3, 5, 1)
+	g.AddEdgeWithReverse(3, 4, 5, 10)
+	g.UpdateFlow(1, 3, 5)
+	g.UpdateFlow(3, 4, 5)
+
+	result := NetworkSimplex(g, 1, 4, nil)
+
+	require.NoError(t, result.Error)
+	assert.InDelta(t, 5.0, result.Flow, 1e-9)
+	assert.InDelta(t, 10.0, g.GetTotalFlow(1), 1e-9)
+	// 5 units move from 3-4 (cost 10) to the second path (cost 2): 5*11 + 5*2
+	assert.InDelta(t, 65.0, g.GetTotalCost(), 1e-9)
+}
+
+func TestNetworkSimplex_DegenerateGraph(t *testing.T) {
+	// Many equal-cost, equal-capacity paths produce degenerate pivots
+	g := graph.NewResidualGraph()
+	for i := int64(2); i <= 9; i++ {
+		g.AddEdgeWithReverse(1, i, 1, 1)
+		for j := int64(2); j <= 9; j++ {
+			if i != j {
+				g.AddEdgeWithReverse(i, j, 1, 0)
+			}
+		}
+		g.AddEdgeWithReverse(i, 10, 1, 1)
+	}
+
+	result := NetworkSimplex(g, 1, 10, nil)
+
+	require.NoError(t, result.Error)
+	assert.InDelta(t, 8.0, result.Flow, 1e-9)
+	assert.InDelta(t, 16.0, result.Cost, 1e-9)
+	assertValidFlow(t, g, 1, 10)
+}
+
+func TestNetworkSimplex_Callback(t *testing.T) {
+	g := randomFlowGraph(5, 20, 80)
+	want := Solve(context.Background(), g.Clone(), 1, 20, commonv1.Algorithm_ALGORITHM_MIN_COST, nil)
+
+	var calls, lastPivot int
+	var lastFlow, lastCost float64
+	result := NetworkSimplexWithCallback(context.Background(), g, 1, 20, nil, func(pivots int, flow, cost float64) {
+		calls++
+		lastPivot, lastFlow, lastCost = pivots, flow, cost
+	})
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, result.Iterations, calls)
+	assert.Equal(t, result.Iterations, lastPivot)
+	assert.InDelta(t, want.MaxFlow, lastFlow, 1e-6)
+	assert.InDelta(t, want.TotalCost, lastCost, 1e-6)
+}
+
+func TestNetworkSimplex_PivotLimit(t *testing.T) {
+	g := randomFlowGraph(2, 20, 80)
+	before := g.Clone()
+
+	result := NetworkSimplex(g, 1, 20, DefaultSolverOptions().WithMaxIterations(1))
+
+	assert.ErrorIs(t, result.Error, ErrPivotLimit)
+	assert.Equal(t, before.GetTotalFlow(1), g.GetTotalFlow(1), "graph must be left unchanged")
+}
+
+func TestNetworkSimplex_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	g := randomFlowGraph(3, 20, 80)
+	result := Solve(ctx, g, 1, 20, commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX, nil)
+
+	assert.ErrorIs(t, result.Error, ErrContextCanceled)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_ERROR, result.Status)
+	assert.Zero(t, g.GetTotalFlow(1))
+}
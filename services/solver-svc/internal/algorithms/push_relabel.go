@@ -2,6 +2,8 @@ package algorithms
 
 import (
 	"context"
+	"fmt"
+	"math"
 
 	"logistics/services/solver-svc/internal/graph"
 )
@@ -29,6 +31,9 @@ type PushRelabelResult struct {
 	MaxFlow    float64
 	Iterations int
 	Canceled   bool
+
+	// Error is set when the final preflow could not be turned into a valid flow.
+	Error error
 }
 
 // =============================================================================
@@ -193,6 +198,11 @@ type prState struct {
 	// Precomputed edge lists for each node (index-based)
 	edgeLists [][]*graph.ResidualEdge
 
+	// Residual capacity of every arc of edgeLists before the run, so the
+	// flow pushed by this run can be told apart from flow already loaded
+	// into the graph (lower bounds, feasibility phase, warm start)
+	initialCapacity [][]float64
+
 	// Source and sink indices
 	sourceIdx int
 	sinkIdx   int
@@ -233,6 +243,7 @@ func newPRState(g *graph.ResidualGraph, source, sink int64, options *SolverOptio
 		data:                make([]nodeData, n),
 		heightCount:         make([]int, maxHeight+2),
 		edgeLists:           make([][]*graph.ResidualEdge, n),
+		initialCapacity:     make([][]float64, n),
 		sourceIdx:           nodeIndex[source],
 		sinkIdx:             nodeIndex[sink],
 		maxHeight:           maxHeight,
@@ -242,6 +253,10 @@ func newPRState(g *graph.ResidualGraph, source, sink int64, options *SolverOptio
 	// Precompute edge lists for each node
 	for i, node := range nodes {
 		s.edgeLists[i] = g.GetNeighborsList(node)
+		s.initialCapacity[i] = make([]float64, len(s.edgeLists[i]))
+		for j, edge := range s.edgeLists[i] {
+			s.initialCapacity[i][j] = edge.Capacity
+		}
 	}
 
 	// Build incoming edges cache for globalRelabel
@@ -494,6 +509,175 @@ func (s *prState) discharge(uIdx int, activateFunc func(int)) bool {
 	return s.data[uIdx].excess > s.epsilon && s.data[uIdx].height <= s.maxHeight
 }
 
+// returnExcessToSource converts the final preflow into a valid flow.
+//
+// Nodes that cannot reach the sink are deactivated by the gap heuristic and
+// global relabeling while still holding excess. A single DFS over edges that
+// carry flow cancels flow cycles, leaving an acyclic flow whose DFS finishing
+// order visits every node before its predecessors. Walking that order, each
+// node's excess is sent back along the reverse edges of its incoming flow, so
+// it is handed to predecessors that are processed later and finally reaches
+// the source. Runs in O(V·E) in the worst case (one cycle walk per cancelled
+// edge), instead of a residual search per node and per augmentation.
+//
+// Only the flow pushed by this run is walked (see arcFlow): flow loaded
+// before the run is balanced at every node, and lower-bound flow must not be
+// cancelled, so a node whose run excess came from cancelling its outgoing
+// flow returns it by pushing that flow forward again.
+//
+// Returns ErrStrandedExcess if some excess has no incoming flow to return
+// along, which would mean the preflow was inconsistent.
+func (s *prState) returnExcessToSource() error {
+	order := s.cancelFlowCycles()
+
+	for _, uIdx := range order {
+		if s.data[uIdx].excess <= s.epsilon {
+			continue
+		}
+
+		u := s.nodes[uIdx]
+		for i, back := range s.g.GetNeighborsList(u) {
+			if s.data[uIdx].excess <= s.epsilon {
+				break
+			}
+
+			// Flow this run sent from back.To into u
+			f := -s.arcFlow(uIdx, i)
+			if f <= s.epsilon {
+				continue
+			}
+
+			delta := math.Min(f, s.data[uIdx].excess)
+			s.g.UpdateFlow(u, back.To, delta)
+			s.data[uIdx].excess -= delta
+			s.data[s.nodeIndex[back.To]].excess += delta
+		}
+
+		if s.data[uIdx].excess > s.epsilon {
+			return fmt.Errorf("%w: node %d holds %g", ErrStrandedExcess, u, s.data[uIdx].excess)
+		}
+	}
+
+	return nil
+}
+
+// cancelFlowCycles removes flow cycles among inner nodes (source and sink
+// excluded) and returns the inner nodes in DFS finishing order.
+//
+// When the DFS meets a node on its own stack, the cycle is closed by the
+// current edge of each stacked node; the minimum flow on it is cancelled
+// and the search resumes from the node that closed the cycle. Flow only
+// decreases, so edges already skipped never need rescanning.
+func (s *prState) cancelFlowCycles() []int {
+	const (
+		white = iota
+		gray
+		black
+	)
+
+	color := make([]uint8, s.n)
+	parent := make([]int, s.n)
+	current := make([]int, s.n)
+	order := make([]int, 0, s.n)
+
+	inner := func(idx int) bool {
+		return idx != s.sourceIdx && idx != s.sinkIdx
+	}
+
+	for root := 0; root < s.n; root++ {
+		if !inner(root) || color[root] != white {
+			continue
+		}
+
+		color[root] = gray
+		parent[root] = -1
+		uIdx := root
+
+		for uIdx >= 0 {
+			edges := s.g.GetNeighborsList(s.nodes[uIdx])
+			descended := false
+
+			for current[uIdx] < len(edges) {
+				edge := edges[current[uIdx]]
+				vIdx := s.nodeIndex[edge.To]
+				if !inner(vIdx) || color[vIdx] == black || s.arcFlow(uIdx, current[uIdx]) <= s.epsilon {
+					current[uIdx]++
+					continue
+				}
+
+				if color[vIdx] == white {
+					color[vIdx] = gray
+					parent[vIdx] = uIdx
+					uIdx = vIdx
+					descended = true
+					break
+				}
+
+				// vIdx is on the stack: cancel the cycle vIdx → ... → uIdx → vIdx
+				s.cancelCycle(vIdx, uIdx, parent, current)
+				for w := uIdx; w != vIdx; w = parent[w] {
+					color[w] = white
+				}
+				uIdx = vIdx
+				descended = true
+				break
+			}
+
+			if descended {
+				continue
+			}
+
+			color[uIdx] = black
+			order = append(order, uIdx)
+			uIdx = parent[uIdx]
+			if uIdx >= 0 {
+				current[uIdx]++
+			}
+		}
+	}
+
+	return order
+}
+
+// cancelCycle cancels the minimum flow along the cycle formed by the stack
+// path top → ... → bottom and the current edge of bottom back to top.
+func (s *prState) cancelCycle(top, bottom int, parent, current []int) {
+	cycleEdge := func(idx int) *graph.ResidualEdge {
+		return s.g.GetNeighborsList(s.nodes[idx])[current[idx]]
+	}
+
+	delta := s.arcFlow(bottom, current[bottom])
+	for w := bottom; w != top; w = parent[w] {
+		delta = math.Min(delta, s.arcFlow(parent[w], current[parent[w]]))
+	}
+
+	// Collect edges before updating, since flows change as we go
+	cycle := make([]*graph.ResidualEdge, 0)
+	from := make([]int64, 0)
+	for w := bottom; ; w = parent[w] {
+		cycle = append(cycle, cycleEdge(w))
+		from = append(from, s.nodes[w])
+		if w == top {
+			break
+		}
+	}
+
+	for i, edge := range cycle {
+		s.g.UpdateFlow(edge.To, from[i], delta)
+	}
+}
+
+// arcFlow returns the net flow this run pushed along the i-th residual arc
+// of node uIdx: positive along the arc, negative when the run pushed flow
+// the other way. Arcs created during the run started with zero capacity.
+func (s *prState) arcFlow(uIdx, i int) float64 {
+	initial := 0.0
+	if i < len(s.initialCapacity[uIdx]) {
+		initial = s.initialCapacity[uIdx][i]
+	}
+	return initial - s.g.GetNeighborsList(s.nodes[uIdx])[i].Capacity
+}
+
 // =============================================================================
 // Push-Relabel FIFO Variant
 // =============================================================================
@@ -570,10 +754,13 @@ func PushRelabelWithContext(ctx context.Context, g *graph.ResidualGraph, source,
 		iterations++
 	}
 
+	err := state.returnExcessToSource()
+
 	return &PushRelabelResult{
 		MaxFlow:    state.data[state.sinkIdx].excess,
 		Iterations: iterations,
 		Canceled:   false,
+		Error:      err,
 	}
 }
 
@@ -663,10 +850,13 @@ func PushRelabelHighestLabelWithContext(ctx context.Context, g *graph.ResidualGr
 		iterations++
 	}
 
+	err := state.returnExcessToSource()
+
 	return &PushRelabelResult{
 		MaxFlow:    state.data[state.sinkIdx].excess,
 		Iterations: iterations,
 		Canceled:   false,
+		Error:      err,
 	}
 }
 
@@ -756,9 +946,12 @@ func PushRelabelLowestLabelWithContext(ctx context.Context, g *graph.ResidualGra
 		iterations++
 	}
 
+	err := state.returnExcessToSource()
+
 	return &PushRelabelResult{
 		MaxFlow:    state.data[state.sinkIdx].excess,
 		Iterations: iterations,
 		Canceled:   false,
+		Error:      err,
 	}
 }
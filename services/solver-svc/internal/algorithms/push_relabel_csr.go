@@ -0,0 +1,389 @@
+package algorithms
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Push-Relabel Algorithm on a CSR Graph
+// =============================================================================
+//
+// FIFO Push-Relabel with global relabeling and the gap heuristic, as in
+// PushRelabelWithContext. The CSR layout removes the node-index map and the
+// incoming-edge cache: the arcs entering u are the pairs of the arcs leaving
+// u, so the global relabel BFS from the sink walks Pair[a] directly.
+
+// csrPRState holds the Push-Relabel state for a CSR graph.
+type csrPRState struct {
+	c       *graph.CSRGraph
+	source  int32
+	sink    int32
+	epsilon float64
+	n       int
+
+	data        []nodeData
+	heightCount []int
+	maxHeight   int
+
+	relabelCount        int
+	globalRelabelPeriod int
+}
+
+// PushRelabelCSR executes FIFO Push-Relabel on a CSR graph.
+//
+// Parameters:
+//   - ctx: Context for cancellation support
+//   - c: The CSR graph (will be modified; call WriteBack to apply the flow)
+//   - source: The source node index
+//   - sink: The sink node index
+//   - options: Solver options (nil for defaults)
+//
+// Returns:
+//   - *PushRelabelResult; Error is set if the preflow could not be turned
+//     into a valid flow
+func PushRelabelCSR(ctx context.Context, c *graph.CSRGraph, source, sink int32, options *SolverOptions) *PushRelabelResult {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	n := c.NodeCount()
+	if n == 0 {
+		return &PushRelabelResult{}
+	}
+
+	s := &csrPRState{
+		c:                   c,
+		source:              source,
+		sink:                sink,
+		epsilon:             options.Epsilon,
+		n:                   n,
+		data:                make([]nodeData, n),
+		heightCount:         make([]int, 2*n+1),
+		maxHeight:           2*n - 1,
+		globalRelabelPeriod: n,
+	}
+	s.initialize()
+
+	queue := make([]int32, 0, n)
+	inQueue := make([]bool, n)
+	for i := int32(0); i < int32(n); i++ {
+		if i != source && i != sink && s.data[i].excess > s.epsilon {
+			queue = append(queue, i)
+			inQueue[i] = true
+		}
+	}
+
+	iterations := 0
+	const checkInterval = 100
+
+	for len(queue) > 0 {
+		if options.MaxIterations > 0 && iterations >= options.MaxIterations {
+			break
+		}
+
+		if iterations%checkInterval == 0 {
+			select {
+			case <-ctx.Done():
+				return &PushRelabelResult{
+					MaxFlow:    s.data[sink].excess,
+					Iterations: iterations,
+					Canceled:   true,
+				}
+			default:
+			}
+		}
+
+		u := queue[0]
+		queue = queue[1:]
+		inQueue[u] = false
+
+		stillActive := s.discharge(u, func(v int32) {
+			if !inQueue[v] && s.data[v].excess > s.epsilon {
+				queue = append(queue, v)
+				inQueue[v] = true
+			}
+		})
+
+		if stillActive && !inQueue[u] {
+			queue = append(queue, u)
+			inQueue[u] = true
+		}
+
+		iterations++
+	}
+
+	err := s.returnExcessToSource()
+
+	return &PushRelabelResult{
+		MaxFlow:    s.data[sink].excess,
+		Iterations: iterations,
+		Canceled:   false,
+		Error:      err,
+	}
+}
+
+// initialize saturates the source arcs and computes exact heights.
+func (s *csrPRState) initialize() {
+	c := s.c
+	for a := c.Start[s.source]; a < c.Start[s.source+1]; a++ {
+		if flow := c.Capacity[a]; flow > s.epsilon {
+			c.Push(a, flow)
+			s.data[c.Head[a]].excess += flow
+			s.data[s.source].excess -= flow
+		}
+	}
+
+	s.globalRelabel()
+}
+
+// globalRelabel recomputes heights by reverse BFS from the sink.
+func (s *csrPRState) globalRelabel() {
+	c := s.c
+	unreachable := s.maxHeight + 1
+
+	for i := range s.heightCount {
+		s.heightCount[i] = 0
+	}
+	for i := range s.data {
+		s.data[i].height = unreachable
+	}
+	s.data[s.sink].height = 0
+
+	queue := make([]int32, 0, s.n)
+	queue = append(queue, s.sink)
+	for head := 0; head < len(queue); head++ {
+		u := queue[head]
+		h := s.data[u].height
+		for a := c.Start[u]; a < c.Start[u+1]; a++ {
+			v := c.Head[a]
+			// v can push to u if the arc v → u has residual capacity
+			if s.data[v].height == unreachable && v != s.source && c.Capacity[c.Pair[a]] > s.epsilon {
+				s.data[v].height = h + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	s.data[s.source].height = s.n
+	for i := range s.data {
+		s.data[i].currentArc = int(c.Start[i])
+		if h := s.data[i].height; h <= s.maxHeight {
+			s.heightCount[h]++
+		}
+	}
+
+	s.relabelCount = 0
+}
+
+// relabel raises u just above its lowest residual neighbour.
+// Returns the new height, or -1 if u is deactivated.
+func (s *csrPRState) relabel(u int32) int {
+	c := s.c
+	oldHeight := s.data[u].height
+
+	minHeight := s.maxHeight + 1
+	for a := c.Start[u]; a < c.Start[u+1]; a++ {
+		if c.Capacity[a] > s.epsilon {
+			minHeight = min(minHeight, s.data[c.Head[a]].height)
+		}
+	}
+
+	s.heightCount[oldHeight]--
+	if minHeight >= s.maxHeight {
+		s.data[u].height = s.maxHeight + 1
+		return -1
+	}
+
+	if s.heightCount[oldHeight] == 0 && oldHeight < s.n && oldHeight > 0 {
+		s.applyGapHeuristic(oldHeight)
+	}
+
+	newHeight := minHeight + 1
+	s.heightCount[newHeight]++
+	s.data[u].height = newHeight
+	s.data[u].currentArc = int(c.Start[u])
+	s.relabelCount++
+
+	return newHeight
+}
+
+// applyGapHeuristic raises all nodes above the gap to maxHeight + 1.
+func (s *csrPRState) applyGapHeuristic(gapHeight int) {
+	for i := range s.data {
+		h := s.data[i].height
+		if h > gapHeight && h <= s.maxHeight && int32(i) != s.source {
+			s.heightCount[h]--
+			s.data[i].height = s.maxHeight + 1
+		}
+	}
+}
+
+// discharge pushes the excess of u along admissible arcs, relabelling u when
+// its arcs are exhausted. Returns true if u is still active afterwards.
+func (s *csrPRState) discharge(u int32, activate func(int32)) bool {
+	c := s.c
+	end := int(c.Start[u+1])
+	if int(c.Start[u]) == end {
+		return false
+	}
+
+	for s.data[u].excess > s.epsilon && s.data[u].height <= s.maxHeight {
+		arc := s.data[u].currentArc
+		if arc >= end {
+			if s.relabel(u) < 0 {
+				return false
+			}
+			if s.relabelCount >= s.globalRelabelPeriod {
+				s.globalRelabel()
+			}
+			continue
+		}
+
+		a := int32(arc)
+		v := c.Head[a]
+		if c.Capacity[a] <= s.epsilon || s.data[u].height != s.data[v].height+1 {
+			s.data[u].currentArc++
+			continue
+		}
+
+		delta := min(s.data[u].excess, c.Capacity[a])
+		if delta <= s.epsilon {
+			s.data[u].currentArc++
+			continue
+		}
+
+		c.Push(a, delta)
+		s.data[u].excess -= delta
+		s.data[v].excess += delta
+
+		if v != s.source && v != s.sink {
+			activate(v)
+		}
+	}
+
+	return s.data[u].excess > s.epsilon && s.data[u].height <= s.maxHeight
+}
+
+// returnExcessToSource converts the final preflow into a valid flow, as
+// prState.returnExcessToSource does: flow cycles are cancelled first, then
+// the excess of every node is sent back along its incoming flow in DFS
+// finishing order.
+func (s *csrPRState) returnExcessToSource() error {
+	c := s.c
+
+	for _, u := range s.cancelFlowCycles() {
+		for a := c.Start[u]; a < c.Start[u+1] && s.data[u].excess > s.epsilon; a++ {
+			f := -c.NetPushed(a)
+			if f <= s.epsilon {
+				continue
+			}
+
+			delta := math.Min(f, s.data[u].excess)
+			c.Push(a, delta)
+			s.data[u].excess -= delta
+			s.data[c.Head[a]].excess += delta
+		}
+
+		if s.data[u].excess > s.epsilon {
+			return fmt.Errorf("%w: node %d holds %g", ErrStrandedExcess, c.IDs[u], s.data[u].excess)
+		}
+	}
+
+	return nil
+}
+
+// cancelFlowCycles removes flow cycles among inner nodes and returns the
+// inner nodes in DFS finishing order.
+// See prState.cancelFlowCycles.
+func (s *csrPRState) cancelFlowCycles() []int32 {
+	const (
+		white = iota
+		gray
+		black
+	)
+
+	c := s.c
+	color := make([]uint8, s.n)
+	parent := make([]int32, s.n)
+	current := make([]int32, s.n)
+	copy(current, c.Start[:s.n])
+	order := make([]int32, 0, s.n)
+
+	inner := func(v int32) bool {
+		return v != s.source && v != s.sink
+	}
+
+	for root := int32(0); root < int32(s.n); root++ {
+		if !inner(root) || color[root] != white {
+			continue
+		}
+
+		color[root] = gray
+		parent[root] = -1
+		u := root
+
+		for u >= 0 {
+			descended := false
+
+			for ; current[u] < c.Start[u+1]; current[u]++ {
+				a := current[u]
+				v := c.Head[a]
+				if !inner(v) || color[v] == black || c.NetPushed(a) <= s.epsilon {
+					continue
+				}
+
+				if color[v] == white {
+					color[v] = gray
+					parent[v] = u
+					u = v
+					descended = true
+					break
+				}
+
+				// v is on the stack: cancel the cycle v → ... → u → v
+				s.cancelCycle(v, u, parent, current)
+				for w := u; w != v; w = parent[w] {
+					color[w] = white
+				}
+				u = v
+				descended = true
+				break
+			}
+
+			if descended {
+				continue
+			}
+
+			color[u] = black
+			order = append(order, u)
+			u = parent[u]
+			if u >= 0 {
+				current[u]++
+			}
+		}
+	}
+
+	return order
+}
+
+// cancelCycle cancels the minimum flow along the cycle formed by the stack
+// path top → ... → bottom and the current arc of bottom back to top.
+func (s *csrPRState) cancelCycle(top, bottom int32, parent, current []int32) {
+	c := s.c
+
+	delta := c.NetPushed(current[bottom])
+	for w := bottom; w != top; w = parent[w] {
+		delta = math.Min(delta, c.NetPushed(current[parent[w]]))
+	}
+
+	for w := bottom; ; w = parent[w] {
+		c.Push(c.Pair[current[w]], delta)
+		if w == top {
+			break
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"logistics/services/solver-svc/internal/graph"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPushRelabel(t *testing.T) {
@@ -789,3 +790,68 @@ func BenchmarkPushRelabelLowestLabel_Complete50(b *testing.B) {
 		PushRelabelLowestLabel(g, 0, int64(n-1), nil)
 	}
 }
+
+func TestPushRelabel_ReturnsStrandedExcess(t *testing.T) {
+	// Source saturates 1->2 (10), but only 4 units can reach the sink.
+	// The remaining 6 units must be returned to the source.
+	variants := map[string]func(*graph.ResidualGraph, int64, int64, *SolverOptions) *PushRelabelResult{
+		"fifo":    PushRelabel,
+		"highest": PushRelabelHighestLabel,
+		"lowest":  PushRelabelLowestLabel,
+	}
+
+	for name, run := range variants {
+		t.Run(name, func(t *testing.T) {
+			g := graph.NewResidualGraph()
+			g.AddEdgeWithReverse(1, 2, 10, 0)
+			g.AddEdgeWithReverse(2, 3, 4, 0)
+			g.AddEdgeWithReverse(2, 4, 10, 0)
+
+			result := run(g, 1, 3, DefaultSolverOptions())
+
+			assert.InDelta(t, 4.0, result.MaxFlow, 1e-9)
+			assert.InDelta(t, 4.0, g.GetEdge(1, 2).OriginalCapacity-g.GetEdge(1, 2).Capacity, 1e-9)
+			assert.InDelta(t, 0.0, g.GetEdge(2, 4).OriginalCapacity-g.GetEdge(2, 4).Capacity, 1e-9)
+		})
+	}
+}
+
+func TestPushRelabel_ReturnExcessCancelsFlowCycles(t *testing.T) {
+	// Preflow: 1->2 carries 5, of which 3 loops around 2->3->4->2
+	// and 5 stays stranded at node 2 (sink 5 unreachable).
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithReverse(1, 2, 5, 0)
+	g.AddEdgeWithReverse(2, 3, 5, 0)
+	g.AddEdgeWithReverse(3, 4, 5, 0)
+	g.AddEdgeWithReverse(4, 2, 5, 0)
+	g.AddNode(5)
+
+	// The preflow is pushed after the state is created, as in a real run
+	state := newPRState(g, 1, 5, DefaultSolverOptions())
+	g.UpdateFlow(1, 2, 5)
+	g.UpdateFlow(2, 3, 3)
+	g.UpdateFlow(3, 4, 3)
+	g.UpdateFlow(4, 2, 3)
+	state.data[state.nodeIndex[2]].excess = 5
+
+	require.NoError(t, state.returnExcessToSource())
+
+	for _, e := range [][2]int64{{1, 2}, {2, 3}, {3, 4}, {4, 2}} {
+		edge := g.GetEdge(e[0], e[1])
+		assert.InDelta(t, 0.0, edge.OriginalCapacity-edge.Capacity, 1e-9, "edge %d->%d", e[0], e[1])
+	}
+	assert.InDelta(t, 5.0, state.data[state.sourceIdx].excess, 1e-9)
+}
+
+func TestPushRelabel_ReturnExcessReportsInconsistentPreflow(t *testing.T) {
+	// Excess on node 2 without any incoming flow cannot be returned
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithReverse(1, 2, 5, 0)
+	g.AddEdgeWithReverse(2, 3, 5, 0)
+
+	state := newPRState(g, 1, 3, DefaultSolverOptions())
+	state.data[state.nodeIndex[2]].excess = 2
+
+	err := state.returnExcessToSource()
+	assert.ErrorIs(t, err, ErrStrandedExcess)
+}
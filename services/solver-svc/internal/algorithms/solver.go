@@ -1,6 +1,7 @@
 // Package algorithms provides implementations of various network flow algorithms
 // including max-flow algorithms (Ford-Fulkerson, Edmonds-Karp, Dinic, Push-Relabel)
-// and min-cost max-flow algorithms (Successive Shortest Path, Capacity Scaling).
+// and min-cost max-flow algorithms (Successive Shortest Path, Capacity Scaling,
+// Network Simplex).
 //
 // # Thread Safety
 //
@@ -72,6 +73,30 @@ var (
 
 	// ErrTimeout indicates that the operation exceeded the configured timeout.
 	ErrTimeout = errors.New("operation timeout")
+
+	// ErrStrandedExcess indicates that Push-Relabel left excess on a node
+	// that could not be returned to the source.
+	ErrStrandedExcess = errors.New("excess could not be returned to source")
+
+	// ErrInfeasibleLowerBounds indicates that no flow satisfies every
+	// edge lower bound (minimum flow).
+	ErrInfeasibleLowerBounds = errors.New("edge lower bounds are infeasible")
+
+	// ErrPivotLimit indicates that network simplex reached
+	// SolverOptions.MaxIterations pivots before finding an optimal basis.
+	ErrPivotLimit = errors.New("network simplex pivot limit reached")
+
+	// ErrUnboundedCost indicates a negative-cost cycle of unbounded capacity,
+	// so the cost can be decreased without limit.
+	ErrUnboundedCost = errors.New("negative-cost cycle with unbounded capacity")
+
+	// ErrWarmStartRepair indicates that a previous flow loaded for a warm
+	// start could not be repaired into a feasible flow.
+	ErrWarmStartRepair = errors.New("previous flow could not be repaired")
+
+	// ErrWarmStartLowerBounds indicates a warm start on a graph with edge
+	// lower bounds, which must be established from an empty flow.
+	ErrWarmStartLowerBounds = errors.New("warm start does not support edge lower bounds")
 )
 
 // =============================================================================
@@ -92,7 +117,8 @@ type SolverOptions struct {
 	// Default: graph.Epsilon (1e-9)
 	Epsilon float64
 
-	// MaxIterations limits the number of augmenting path iterations.
+	// MaxIterations limits the number of augmenting path iterations
+	// (pivots for network simplex).
 	// Zero or negative means unlimited.
 	// Default: 0 (unlimited)
 	MaxIterations int
@@ -112,6 +138,13 @@ type SolverOptions struct {
 	// Default: 3
 	NegativeEdgeFallbackThreshold int
 
+	// CSRThreshold is the residual edge count (forward and reverse edges) from
+	// which Edmonds-Karp, Dinic, Push-Relabel and SSP run on a compact
+	// graph.CSRGraph instead of the map-based graph.
+	// Zero uses DefaultCSRThreshold; negative disables the CSR graph.
+	// Default: 0
+	CSRThreshold int
+
 	// Pool is the graph pool for memory reuse.
 	// If nil, the global pool is used.
 	Pool *graph.GraphPool
@@ -158,6 +191,12 @@ func (o *SolverOptions) WithReturnPaths(returnPaths bool) *SolverOptions {
 	return o
 }
 
+// WithCSRThreshold sets the CSR edge threshold and returns the options for chaining.
+func (o *SolverOptions) WithCSRThreshold(threshold int) *SolverOptions {
+	o.CSRThreshold = threshold
+	return o
+}
+
 // WithMaxIterations sets the iteration limit and returns the options for chaining.
 func (o *SolverOptions) WithMaxIterations(max int) *SolverOptions {
 	o.MaxIterations = max
@@ -192,6 +231,15 @@ type SolverResult struct {
 	// Each path includes the sequence of node IDs and the flow amount.
 	Paths []converter.PathWithFlow
 
+	// Potentials holds dual node potentials (transportation mode only).
+	// See ComputeNodePotentials.
+	Potentials map[int64]float64
+
+	// LowerBoundDeficits lists the nodes whose lower-bound imbalance could
+	// not be routed. Set only when Status is FLOW_STATUS_INFEASIBLE because
+	// of edge lower bounds.
+	LowerBoundDeficits []graph.LowerBoundDeficit
+
 	// Status indicates the outcome of the computation.
 	Status commonv1.FlowStatus
 
@@ -253,6 +301,15 @@ func validateGraph(g *graph.ResidualGraph, source, sink int64) error {
 //   - ALGORITHM_DINIC: Level graph + blocking flow. O(V²E). Best for most cases.
 //   - ALGORITHM_PUSH_RELABEL: Preflow-push. O(V³) or O(V²√E). Best for dense graphs.
 //   - ALGORITHM_MIN_COST: SSP or Capacity Scaling. Finds minimum cost max flow.
+//   - ALGORITHM_NETWORK_SIMPLEX: Spanning-tree simplex. Minimum cost max flow
+//     for dense, cost-heavy instances.
+//
+// # Lower Bounds
+//
+// If any edge has a lower bound (see graph.ResidualGraph.SetLowerBound), a
+// feasible flow is established first with EstablishLowerBounds and the
+// algorithm then augments on top of it. Infeasible lower bounds yield
+// FLOW_STATUS_INFEASIBLE with LowerBoundDeficits set.
 //
 // # Thread Safety
 //
@@ -291,7 +348,12 @@ func Solve(ctx context.Context, g *graph.ResidualGraph, source, sink int64, algo
 		defer cancel()
 	}
 
-	result := solveInternal(ctx, g, source, sink, algorithm, options)
+	var result *SolverResult
+	if g.HasLowerBounds() {
+		result = solveWithLowerBounds(ctx, g, source, sink, algorithm, options)
+	} else {
+		result = solveInternal(ctx, g, source, sink, algorithm, options)
+	}
 	result.Duration = time.Since(start)
 
 	return result
@@ -315,6 +377,9 @@ func solveInternal(ctx context.Context, g *graph.ResidualGraph, source, sink int
 	case commonv1.Algorithm_ALGORITHM_FORD_FULKERSON:
 		return solveFordFulkerson(ctx, g, source, sink, options)
 
+	case commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX:
+		return solveNetworkSimplex(ctx, g, source, sink, options)
+
 	default:
 		// Default to Dinic as it has the best general performance
 		return solveDinic(ctx, g, source, sink, options)
@@ -323,7 +388,13 @@ func solveInternal(ctx context.Context, g *graph.ResidualGraph, source, sink int
 
 // solveEdmondsKarp runs the Edmonds-Karp algorithm and wraps the result.
 func solveEdmondsKarp(ctx context.Context, g *graph.ResidualGraph, source, sink int64, options *SolverOptions) *SolverResult {
-	result := EdmondsKarpWithContext(ctx, g, source, sink, options)
+	var result *EdmondsKarpResult
+	if c, s, t := csrFor(g, source, sink, options); c != nil {
+		result = EdmondsKarpCSR(ctx, c, s, t, options)
+		c.WriteBack(g)
+	} else {
+		result = EdmondsKarpWithContext(ctx, g, source, sink, options)
+	}
 	if result.Canceled {
 		return &SolverResult{
 			MaxFlow:    result.MaxFlow,
@@ -344,7 +415,13 @@ func solveEdmondsKarp(ctx context.Context, g *graph.ResidualGraph, source, sink
 
 // solveDinic runs the Dinic algorithm and wraps the result.
 func solveDinic(ctx context.Context, g *graph.ResidualGraph, source, sink int64, options *SolverOptions) *SolverResult {
-	result := DinicWithContext(ctx, g, source, sink, options)
+	var result *DinicResult
+	if c, s, t := csrFor(g, source, sink, options); c != nil {
+		result = DinicCSR(ctx, c, s, t, options)
+		c.WriteBack(g)
+	} else {
+		result = DinicWithContext(ctx, g, source, sink, options)
+	}
 	if result.Canceled {
 		return &SolverResult{
 			MaxFlow:    result.MaxFlow,
@@ -365,7 +442,13 @@ func solveDinic(ctx context.Context, g *graph.ResidualGraph, source, sink int64,
 
 // solvePushRelabel runs the Push-Relabel algorithm and wraps the result.
 func solvePushRelabel(ctx context.Context, g *graph.ResidualGraph, source, sink int64, options *SolverOptions) *SolverResult {
-	result := PushRelabelWithContext(ctx, g, source, sink, options)
+	var result *PushRelabelResult
+	if c, s, t := csrFor(g, source, sink, options); c != nil {
+		result = PushRelabelCSR(ctx, c, s, t, options)
+		c.WriteBack(g)
+	} else {
+		result = PushRelabelWithContext(ctx, g, source, sink, options)
+	}
 	if result.Canceled {
 		return &SolverResult{
 			MaxFlow:    result.MaxFlow,
@@ -374,6 +457,14 @@ func solvePushRelabel(ctx context.Context, g *graph.ResidualGraph, source, sink
 			Error:      ErrContextCanceled,
 		}
 	}
+	if result.Error != nil {
+		return &SolverResult{
+			MaxFlow:    result.MaxFlow,
+			Iterations: result.Iterations,
+			Status:     commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE,
+			Error:      result.Error,
+		}
+	}
 	return &SolverResult{
 		MaxFlow:    result.MaxFlow,
 		TotalCost:  g.GetTotalCost(),
@@ -411,7 +502,15 @@ func solveFordFulkerson(ctx context.Context, g *graph.ResidualGraph, source, sin
 func solveMinCost(ctx context.Context, g *graph.ResidualGraph, source, sink int64, options *SolverOptions) *SolverResult {
 	// Pass Infinity as required flow — the algorithm will find the maximum
 	// possible flow and stop when sink becomes unreachable
-	result := MinCostMaxFlowWithContext(ctx, g, source, sink, math.MaxFloat64, options)
+	var result *MinCostFlowResult
+	if RecommendMinCostAlgorithm(g) != MinCostAlgorithmSSP {
+		result = MinCostMaxFlowWithContext(ctx, g, source, sink, math.MaxFloat64, options)
+	} else if c, s, t := csrFor(g, source, sink, options); c != nil {
+		result = SuccessiveShortestPathCSR(ctx, c, s, t, math.MaxFloat64, options)
+		c.WriteBack(g)
+	} else {
+		result = SuccessiveShortestPathInternal(ctx, g, source, sink, math.MaxFloat64, options)
+	}
 
 	if result.Canceled {
 		return &SolverResult{
@@ -423,6 +522,13 @@ func solveMinCost(ctx context.Context, g *graph.ResidualGraph, source, sink int6
 			Error:      ErrContextCanceled,
 		}
 	}
+	if result.Error != nil {
+		return &SolverResult{
+			Iterations: result.Iterations,
+			Status:     commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:      result.Error,
+		}
+	}
 
 	return &SolverResult{
 		MaxFlow:    result.Flow,
@@ -433,6 +539,35 @@ func solveMinCost(ctx context.Context, g *graph.ResidualGraph, source, sink int6
 	}
 }
 
+// solveNetworkSimplex runs the network simplex algorithm and wraps the result.
+func solveNetworkSimplex(ctx context.Context, g *graph.ResidualGraph, source, sink int64, options *SolverOptions) *SolverResult {
+	result := NetworkSimplexWithContext(ctx, g, source, sink, options)
+	if result.Canceled {
+		return &SolverResult{
+			Iterations: result.Iterations,
+			Status:     commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:      ErrContextCanceled,
+		}
+	}
+	if result.Error != nil {
+		status := commonv1.FlowStatus_FLOW_STATUS_ERROR
+		if errors.Is(result.Error, ErrUnboundedCost) {
+			status = commonv1.FlowStatus_FLOW_STATUS_UNBOUNDED
+		}
+		return &SolverResult{
+			Iterations: result.Iterations,
+			Status:     status,
+			Error:      result.Error,
+		}
+	}
+	return &SolverResult{
+		MaxFlow:    result.Flow,
+		TotalCost:  result.Cost,
+		Iterations: result.Iterations,
+		Status:     commonv1.FlowStatus_FLOW_STATUS_OPTIMAL,
+	}
+}
+
 // =============================================================================
 // Solver Pool
 // =============================================================================
@@ -692,9 +827,9 @@ func GetAlgorithmInfo(algo commonv1.Algorithm) *AlgorithmInfo {
 		},
 		commonv1.Algorithm_ALGORITHM_MIN_COST: {
 			Algorithm:             commonv1.Algorithm_ALGORITHM_MIN_COST,
-			Name:                  "Min-Cost Max-Flow (SSP + Capacity Scaling)",
-			Description:           "Successive Shortest Paths with potentials; auto-switches to Capacity Scaling for large capacities",
-			TimeComplexity:        "O(V × E + V × E × log(V) × F) for SSP; O(E² log U) for Capacity Scaling",
+			Name:                  "Min-Cost Max-Flow (SSP + Capacity/Cost Scaling)",
+			Description:           "Successive Shortest Paths with potentials; auto-switches to Cost Scaling for large cost ranges and Capacity Scaling for large capacities",
+			TimeComplexity:        "O(V × E + V × E × log(V) × F) for SSP; O(E² log U) for Capacity Scaling; O(V² × E × log(V × C)) for Cost Scaling",
 			SpaceComplexity:       "O(V + E)",
 			SupportsMinCost:       true,
 			SupportsNegativeCosts: true,
@@ -702,6 +837,21 @@ func GetAlgorithmInfo(algo commonv1.Algorithm) *AlgorithmInfo {
 			Caveats: []string{
 				"Slower than pure max-flow algorithms",
 				"Uses Dijkstra with fallback to Bellman-Ford for negative edges",
+				"Cost Scaling does not return paths",
+			},
+		},
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX: {
+			Algorithm:             commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+			Name:                  "Network Simplex",
+			Description:           "Primal simplex on spanning-tree bases with block search pivots and strongly feasible trees",
+			TimeComplexity:        "Exponential worst case; typically O(V × E) pivots work in practice",
+			SpaceComplexity:       "O(V + E)",
+			SupportsMinCost:       true,
+			SupportsNegativeCosts: true,
+			BestFor:               []string{"cost_optimization", "dense_graphs", "transportation_problems"},
+			Caveats: []string{
+				"Does not naturally produce paths",
+				"Runs a max-flow pass first to fix the flow value",
 			},
 		},
 	}
@@ -719,6 +869,7 @@ func GetAllAlgorithms() []*AlgorithmInfo {
 		commonv1.Algorithm_ALGORITHM_DINIC,
 		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
 		commonv1.Algorithm_ALGORITHM_MIN_COST,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
 	}
 
 	var infos []*AlgorithmInfo
@@ -745,24 +896,31 @@ func GetAllAlgorithms() []*AlgorithmInfo {
 //
 // # Recommendation Logic
 //
-//   - If min-cost is needed or graph has negative costs: MIN_COST
+//   - If min-cost is needed or graph has negative costs: NETWORK_SIMPLEX for
+//     dense (>10% edges) graphs with more than 100 nodes, MIN_COST otherwise
 //   - If graph is dense (>50% edges) and large (>100 nodes): PUSH_RELABEL
 //   - If graph is large (>100 nodes): DINIC
 //   - Otherwise: EDMONDS_KARP
 func RecommendAlgorithm(nodeCount, edgeCount int, needMinCost bool, hasNegativeCosts bool) commonv1.Algorithm {
+	// Calculate graph density
+	density := 0.0
+	if maxEdges := nodeCount * (nodeCount - 1); maxEdges > 0 {
+		density = float64(edgeCount) / float64(maxEdges)
+	}
+
 	// Min-cost requirement takes priority
 	if needMinCost || hasNegativeCosts {
+		// Dense cost-heavy instances need many shortest path runs with SSP
+		if density > 0.1 && nodeCount > 100 {
+			return commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX
+		}
 		return commonv1.Algorithm_ALGORITHM_MIN_COST
 	}
 
-	// Calculate graph density
-	maxEdges := nodeCount * (nodeCount - 1)
-	if maxEdges == 0 {
+	if nodeCount < 2 {
 		return commonv1.Algorithm_ALGORITHM_EDMONDS_KARP
 	}
 
-	density := float64(edgeCount) / float64(maxEdges)
-
 	// Dense graphs with many nodes benefit from Push-Relabel
 	if density > 0.5 && nodeCount > 100 {
 		return commonv1.Algorithm_ALGORITHM_PUSH_RELABEL
@@ -32,6 +32,7 @@ func TestSolve(t *testing.T) {
 		{"push_relabel", commonv1.Algorithm_ALGORITHM_PUSH_RELABEL},
 		{"min_cost", commonv1.Algorithm_ALGORITHM_MIN_COST},
 		{"ford_fulkerson", commonv1.Algorithm_ALGORITHM_FORD_FULKERSON},
+		{"network_simplex", commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX},
 		{"unspecified_defaults_to_dinic", commonv1.Algorithm_ALGORITHM_UNSPECIFIED},
 	}
 
@@ -275,8 +276,9 @@ func TestGetAlgorithmInfo(t *testing.T) {
 		{commonv1.Algorithm_ALGORITHM_EDMONDS_KARP, "Edmonds-Karp", false, false},
 		{commonv1.Algorithm_ALGORITHM_DINIC, "Dinic", false, false},
 		{commonv1.Algorithm_ALGORITHM_PUSH_RELABEL, "Push-Relabel (FIFO with Highest Label option)", false, false},
-		{commonv1.Algorithm_ALGORITHM_MIN_COST, "Min-Cost Max-Flow (SSP + Capacity Scaling)", true, true},
+		{commonv1.Algorithm_ALGORITHM_MIN_COST, "Min-Cost Max-Flow (SSP + Capacity/Cost Scaling)", true, true},
 		{commonv1.Algorithm_ALGORITHM_FORD_FULKERSON, "Ford-Fulkerson", false, false},
+		{commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX, "Network Simplex", true, true},
 	}
 
 	for _, tt := range tests {
@@ -301,7 +303,7 @@ func TestGetAlgorithmInfo_Unknown(t *testing.T) {
 func TestGetAllAlgorithms(t *testing.T) {
 	infos := GetAllAlgorithms()
 
-	assert.Len(t, infos, 5)
+	assert.Len(t, infos, 6)
 
 	names := make(map[string]bool)
 	for _, info := range infos {
@@ -406,6 +408,47 @@ func TestSolve_AllAlgorithmsConsistent(t *testing.T) {
 	}
 }
 
+func TestSolve_ParallelEdges(t *testing.T) {
+	// Truck (cap 5, cost 1) and rail (cap 5, cost 10) lanes from 1 to 2
+	setup := func() *graph.ResidualGraph {
+		g := graph.NewResidualGraph()
+		g.AddEdgeWithID(1, 1, 2, 5, 1)
+		g.AddEdgeWithID(2, 1, 2, 5, 10)
+		g.AddEdgeWithID(3, 2, 3, 8, 0)
+		return g
+	}
+
+	algos := []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON,
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+		commonv1.Algorithm_ALGORITHM_MIN_COST,
+	}
+
+	for _, algo := range algos {
+		t.Run(algo.String(), func(t *testing.T) {
+			g := setup()
+			result := Solve(context.Background(), g, 1, 3, algo, nil)
+
+			require.NoError(t, result.Error)
+			assert.InDelta(t, 8.0, result.MaxFlow, 1e-9)
+
+			truck := g.GetEdge(1, 2).Flow
+			rail := g.GetEdge(1, graph.LaneNodeBase).Flow
+			assert.InDelta(t, 8.0, truck+rail, 1e-9)
+			assert.InDelta(t, rail, g.GetEdge(graph.LaneNodeBase, 2).Flow, 1e-9)
+		})
+	}
+
+	// Min-cost fills the cheap lane first: 5*1 + 3*10
+	g := setup()
+	result := Solve(context.Background(), g, 1, 3, commonv1.Algorithm_ALGORITHM_MIN_COST, nil)
+	require.NoError(t, result.Error)
+	assert.InDelta(t, 35.0, result.TotalCost, 1e-9)
+	assert.InDelta(t, 5.0, g.GetEdge(1, 2).Flow, 1e-9)
+}
+
 func TestRecommendAlgorithm(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -447,6 +490,13 @@ func TestRecommendAlgorithm(t *testing.T) {
 			hasNegativeCosts: true,
 			wantAlgo:         commonv1.Algorithm_ALGORITHM_MIN_COST,
 		},
+		{
+			name:        "need_min_cost_dense",
+			nodeCount:   200,
+			edgeCount:   8000, // > 10% density
+			needMinCost: true,
+			wantAlgo:    commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,161 @@
+package algorithms
+
+import (
+	"context"
+	"time"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Transportation Problem
+// =============================================================================
+//
+// The transportation problem treats node supplies and demands as hard
+// b-values: every unit of supply must be shipped and every unit of demand
+// must be received, at minimum total cost. The graph is expected to connect
+// a super-source to each supply node (capacity = supply) and each demand
+// node to a super-sink (capacity = demand), so the problem reduces to
+// pushing exactly the total supply/demand through the network with
+// MinCostFlowWithAlgorithm.
+//
+// When the required flow cannot be pushed (not enough capacity, or total
+// supply and demand differ) the result is FLOW_STATUS_INFEASIBLE and the
+// flow left in the graph is the cheapest flow of the achieved value.
+
+// SolveTransportation ships requiredFlow units from source to sink at minimum
+// cost and computes dual node potentials for the resulting flow.
+//
+// requiredFlow should be max(total supply, total demand), so that an
+// unbalanced problem is reported as infeasible rather than solved partially.
+//
+// Returns FLOW_STATUS_OPTIMAL when the full requiredFlow was shipped and
+// FLOW_STATUS_INFEASIBLE otherwise; Error is only set for invalid input or
+// cancellation.
+func SolveTransportation(ctx context.Context, g *graph.ResidualGraph, source, sink int64, requiredFlow float64, options *SolverOptions) *SolverResult {
+	start := time.Now()
+
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	if err := validateGraph(g, source, sink); err != nil {
+		return &SolverResult{
+			Status:   commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:    err,
+			Duration: time.Since(start),
+		}
+	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	// Lower bounds are met first; their flow counts towards requiredFlow
+	base := &LowerBoundResult{}
+	baseCost := 0.0
+	if g.HasLowerBounds() {
+		lb, err := EstablishLowerBounds(ctx, g, source, sink, commonv1.Algorithm_ALGORITHM_MIN_COST, options)
+		if err != nil {
+			result := &SolverResult{
+				Status:   commonv1.FlowStatus_FLOW_STATUS_ERROR,
+				Error:    err,
+				Duration: time.Since(start),
+			}
+			if lb != nil {
+				result.Status = commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE
+				result.Iterations = lb.Iterations
+				result.LowerBoundDeficits = lb.Deficits
+			}
+			return result
+		}
+		base = lb
+		baseCost = g.GetTotalCost()
+	}
+
+	// Pinned to SSP rather than RecommendMinCostAlgorithm: capacity scaling is
+	// not cost-optimal and cost scaling rounds fractional costs, while the
+	// shipping plan and its shadow prices must be exact
+	mcf := MinCostFlowWithAlgorithm(ctx, g, source, sink, requiredFlow-base.BaseFlow, MinCostAlgorithmSSP, options)
+	if mcf.Canceled {
+		return &SolverResult{
+			MaxFlow:    base.BaseFlow + mcf.Flow,
+			TotalCost:  baseCost + mcf.Cost,
+			Iterations: base.Iterations + mcf.Iterations,
+			Paths:      mcf.Paths,
+			Status:     commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:      ErrContextCanceled,
+			Duration:   time.Since(start),
+		}
+	}
+	if mcf.Error != nil {
+		return &SolverResult{
+			MaxFlow:    base.BaseFlow,
+			TotalCost:  baseCost,
+			Iterations: base.Iterations + mcf.Iterations,
+			Status:     commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:      mcf.Error,
+			Duration:   time.Since(start),
+		}
+	}
+
+	status := commonv1.FlowStatus_FLOW_STATUS_OPTIMAL
+	if base.BaseFlow+mcf.Flow < requiredFlow-options.Epsilon {
+		status = commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE
+	}
+
+	return &SolverResult{
+		MaxFlow:    base.BaseFlow + mcf.Flow,
+		TotalCost:  baseCost + mcf.Cost,
+		Iterations: base.Iterations + mcf.Iterations,
+		Paths:      mcf.Paths,
+		Status:     status,
+		Potentials: ComputeNodePotentials(g),
+		Duration:   time.Since(start),
+	}
+}
+
+// ComputeNodePotentials returns dual potentials π for the current flow in g,
+// such that every residual edge (u, v) has a non-negative reduced cost
+// c(u,v) + π(u) - π(v) (the same convention as SuccessiveShortestPath).
+//
+// Potentials are shortest distances in the residual graph from a virtual
+// root connected to every node at zero cost, so they exist for all nodes.
+// For a minimum-cost flow π(v) - π(u) is the marginal cost of moving one more
+// unit from u to v, i.e. the shadow price of v relative to u.
+//
+// Lane nodes of parallel edges are internal and get no potential.
+//
+// Returns nil if the residual graph has a negative cycle, which means the
+// flow is not cost-optimal.
+//
+// Time Complexity: O(V * E)
+func ComputeNodePotentials(g *graph.ResidualGraph) map[int64]float64 {
+	nodes := g.GetSortedNodes()
+
+	dist := make(map[int64]float64, len(nodes))
+	parent := make(map[int64]int64, len(nodes))
+	for _, node := range nodes {
+		dist[node] = 0
+		parent[node] = -1
+	}
+
+	converged := false
+	for i := 0; i < len(nodes) && !converged; i++ {
+		converged = !relaxAllEdgesDeterministic(g, nodes, dist, parent)
+	}
+
+	if !converged && checkNegativeCycleDeterministic(g, nodes, dist) {
+		return nil
+	}
+
+	for id := range dist {
+		if g.IsLaneNode(id) {
+			delete(dist, id)
+		}
+	}
+	return dist
+}
@@ -0,0 +1,120 @@
+package algorithms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// transportationGraph: warehouses 1 (supply 10) and 2 (supply 5) serve
+// delivery points 3 (demand 8) and 4 (demand 7) via super-terminals 0 and 5.
+func transportationGraph(capToFour float64) *graph.ResidualGraph {
+	g := graph.NewResidualGraph()
+	g.AddEdgeWithReverse(0, 1, 10, 0)
+	g.AddEdgeWithReverse(0, 2, 5, 0)
+	g.AddEdgeWithReverse(1, 3, 10, 1)
+	g.AddEdgeWithReverse(1, 4, capToFour, 4)
+	g.AddEdgeWithReverse(2, 3, 10, 2)
+	g.AddEdgeWithReverse(2, 4, capToFour, 3)
+	g.AddEdgeWithReverse(3, 5, 8, 0)
+	g.AddEdgeWithReverse(4, 5, 7, 0)
+	return g
+}
+
+func TestSolveTransportation_Balanced(t *testing.T) {
+	g := transportationGraph(10)
+
+	result := SolveTransportation(context.Background(), g, 0, 5, 15, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_OPTIMAL, result.Status)
+	assert.InDelta(t, 15.0, result.MaxFlow, 1e-9)
+	// 1->3: 8, 1->4: 2, 2->4: 5
+	assert.InDelta(t, 31.0, result.TotalCost, 1e-9)
+
+	// Shadow prices relative to warehouse 1
+	p := result.Potentials
+	require.NotNil(t, p)
+	assert.InDelta(t, 1.0, p[3]-p[1], 1e-9)
+	assert.InDelta(t, 4.0, p[4]-p[1], 1e-9)
+	assert.InDelta(t, 1.0, p[2]-p[1], 1e-9)
+}
+
+func TestSolveTransportation_LargeCapacities(t *testing.T) {
+	// Capacities above CapacityScalingThreshold: the plan must still be the
+	// cheapest one, not a capacity-scaling approximation
+	const unit = 7e5
+	g := graph.NewResidualGraph()
+	for _, w := range []int64{1, 2, 3} {
+		g.AddEdgeWithReverse(0, w, 1e6, 0)
+	}
+	lanes := []struct {
+		from, to int64
+		units    float64
+		cost     float64
+	}{
+		{1, 4, 3, 8}, {1, 5, 4, 5}, {1, 6, 1, 1},
+		{2, 4, 4, 7}, {2, 5, 5, 3}, {2, 6, 1, 3},
+		{3, 4, 1, 6}, {3, 5, 3, 2}, {3, 6, 4, 3},
+	}
+	for _, l := range lanes {
+		g.AddEdgeWithReverse(l.from, l.to, l.units*unit, l.cost)
+	}
+	g.AddEdgeWithReverse(4, 7, 3e6, 0)
+	g.AddEdgeWithReverse(5, 7, 2e6, 0)
+	g.AddEdgeWithReverse(6, 7, 2e6, 0)
+
+	result := SolveTransportation(context.Background(), g, 0, 7, 7e6, nil)
+
+	require.NoError(t, result.Error)
+	assert.InDelta(t, 3e6, result.MaxFlow, 1e-6)
+	// 1->6: 0.7M·1, 1->5: 0.3M·5, 2->5: 0.7M·3, 2->6: 0.3M·3, 3->5: 1M·2
+	assert.InDelta(t, 7.2e6, result.TotalCost, 1e-3)
+}
+
+func TestSolveTransportation_Infeasible(t *testing.T) {
+	// Only 2+2 units can reach delivery point 4, which needs 7
+	g := transportationGraph(2)
+
+	result := SolveTransportation(context.Background(), g, 0, 5, 15, nil)
+
+	require.NoError(t, result.Error)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE, result.Status)
+	assert.InDelta(t, 12.0, result.MaxFlow, 1e-9)
+	assert.InDelta(t, 4.0, g.GetFlowOnEdge(4, 5), 1e-9)
+}
+
+func TestSolveTransportation_InvalidSource(t *testing.T) {
+	result := SolveTransportation(context.Background(), transportationGraph(10), 42, 5, 15, nil)
+
+	assert.ErrorIs(t, result.Error, ErrSourceNotFound)
+}
+
+func TestComputeNodePotentials_ReducedCostsNonNegative(t *testing.T) {
+	g := transportationGraph(10)
+	MinCostMaxFlow(g, 0, 5, 15, nil)
+
+	p := ComputeNodePotentials(g)
+	require.NotNil(t, p)
+
+	for _, u := range g.GetSortedNodes() {
+		for _, edge := range g.GetNeighborsList(u) {
+			if edge.Capacity > graph.Epsilon {
+				assert.GreaterOrEqual(t, edge.Cost+p[u]-p[edge.To], -1e-9, "edge %d->%d", u, edge.To)
+			}
+		}
+	}
+}
+
+func TestComputeNodePotentials_NegativeCycle(t *testing.T) {
+	g := graph.NewResidualGraph()
+	g.AddEdge(1, 2, 1, -2)
+	g.AddEdge(2, 1, 1, 1)
+
+	assert.Nil(t, ComputeNodePotentials(g))
+}
@@ -0,0 +1,158 @@
+package algorithms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Warm Start
+// =============================================================================
+//
+// A warm start re-solves a slightly modified network from the flow of a
+// previous solution instead of from zero:
+//
+//  1. The caller loads the previous flow into the graph (converter.LoadFlow),
+//     clamped to the new capacities. Reduced or removed edges leave nodes
+//     with more inflow than outflow or vice versa.
+//  2. RepairFlow routes these imbalances with the reduction of
+//     graph.ApplyFlowRepair: surplus flows on towards the sink or back to the
+//     source, missing inflow is taken from the source or cancelled towards
+//     the sink. Only the changed part of the network carries repair flow.
+//  3. The requested algorithm augments from source to sink as usual.
+//
+// The result is the same maximum flow value as a cold solve; the flow on
+// individual edges may differ where several maximum flows exist.
+//
+// Min-cost algorithms are solved cold (see SupportsWarmStart): the repaired
+// flow is feasible but not necessarily the cheapest one of its value (e.g. a
+// cheaper lane got more capacity), and restoring optimality by cancelling
+// negative cycles costs far more than solving from zero.
+
+// WarmStartResult describes the repair phase of a warm start.
+type WarmStartResult struct {
+	// BaseFlow is the source → sink flow value of the repaired flow.
+	BaseFlow float64
+
+	// Iterations is the number of iterations of the repair phase.
+	Iterations int
+}
+
+// SupportsWarmStart reports whether SolveWarmStart reuses the loaded flow
+// for algorithm. Min-cost algorithms are solved from zero instead.
+func SupportsWarmStart(algorithm commonv1.Algorithm) bool {
+	info := GetAlgorithmInfo(algorithm)
+	return info == nil || !info.SupportsMinCost
+}
+
+// RepairFlow turns the (possibly unbalanced) flow loaded in g into a feasible
+// source → sink flow, so that any max-flow algorithm can augment from it.
+// The repaired flow is not necessarily the cheapest flow of its value.
+//
+// Returns ErrWarmStartRepair if imbalances are left over (numerical issues
+// only, see graph.ApplyFlowRepair), and ErrContextCanceled if ctx was cancelled.
+func RepairFlow(ctx context.Context, g *graph.ResidualGraph, source, sink int64, algorithm commonv1.Algorithm, options *SolverOptions) (*WarmStartResult, error) {
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	reduction := g.ApplyFlowRepair(source, sink)
+
+	// Repair paths go through auxiliary nodes and are not reported
+	phaseOptions := *options
+	phaseOptions.ReturnPaths = false
+
+	// Min-cost algorithms need a residual graph without negative cycles,
+	// which the loaded flow does not guarantee; the repair only has to be
+	// feasible
+	repairAlgorithm := algorithm
+	if !SupportsWarmStart(algorithm) {
+		repairAlgorithm = commonv1.Algorithm_ALGORITHM_DINIC
+	}
+
+	phase := solveInternal(ctx, g, reduction.AuxSource, reduction.AuxSink, repairAlgorithm, &phaseOptions)
+	if phase.Error != nil {
+		return nil, phase.Error
+	}
+
+	deficits := reduction.Deficits(g, options.Epsilon)
+	result := &WarmStartResult{
+		Iterations: phase.Iterations,
+		BaseFlow:   reduction.Remove(g),
+	}
+	if len(deficits) > 0 {
+		return result, fmt.Errorf("%w: %s", ErrWarmStartRepair, describeDeficits(deficits))
+	}
+
+	return result, nil
+}
+
+// SolveWarmStart repairs the flow loaded in g (see RepairFlow) and then runs
+// the requested algorithm from source to sink on top of it. For min-cost
+// algorithms the loaded flow is discarded and g is solved from zero.
+//
+// The returned MaxFlow and TotalCost cover the whole flow, not only the part
+// added after the repair; Paths only lists the augmenting paths found after
+// the repair. Graphs with edge lower bounds are rejected with
+// ErrWarmStartLowerBounds.
+func SolveWarmStart(ctx context.Context, g *graph.ResidualGraph, source, sink int64, algorithm commonv1.Algorithm, options *SolverOptions) *SolverResult {
+	start := time.Now()
+
+	if options == nil {
+		options = DefaultSolverOptions()
+	}
+
+	if err := validateGraph(g, source, sink); err != nil {
+		return &SolverResult{
+			Status:   commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:    err,
+			Duration: time.Since(start),
+		}
+	}
+
+	if g.HasLowerBounds() {
+		return &SolverResult{
+			Status:   commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:    ErrWarmStartLowerBounds,
+			Duration: time.Since(start),
+		}
+	}
+
+	if !SupportsWarmStart(algorithm) {
+		g.Reset()
+		return Solve(ctx, g, source, sink, algorithm, options)
+	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	repair, err := RepairFlow(ctx, g, source, sink, algorithm, options)
+	if err != nil {
+		result := &SolverResult{
+			Status:   commonv1.FlowStatus_FLOW_STATUS_ERROR,
+			Error:    err,
+			Duration: time.Since(start),
+		}
+		if repair != nil {
+			result.Iterations = repair.Iterations
+		}
+		return result
+	}
+
+	result := solveInternal(ctx, g, source, sink, algorithm, options)
+	result.MaxFlow += repair.BaseFlow
+	result.Iterations += repair.Iterations
+	if result.Error == nil {
+		result.TotalCost = g.GetTotalCost()
+	}
+	result.Duration = time.Since(start)
+
+	return result
+}
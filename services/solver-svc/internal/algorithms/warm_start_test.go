@@ -0,0 +1,239 @@
+package algorithms
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+var warmStartAlgorithms = append([]commonv1.Algorithm{
+	commonv1.Algorithm_ALGORITHM_FORD_FULKERSON,
+	commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+}, csrAlgorithms...)
+
+// perturbCapacities changes the capacity of about a third of the edges of
+// an unsolved graph: some lanes are cut or closed, others are widened.
+func perturbCapacities(g *graph.ResidualGraph, seed int64) {
+	r := rand.New(rand.NewSource(seed))
+	for _, from := range g.GetSortedNodes() {
+		for _, edge := range g.GetNeighborsList(from) {
+			if edge.IsReverse || r.Intn(3) != 0 {
+				continue
+			}
+			edge.OriginalCapacity = float64(r.Intn(25))
+			edge.Capacity = edge.OriginalCapacity
+		}
+	}
+}
+
+// loadPreviousFlow copies the forward-edge flows of a solved graph into an
+// unsolved one, clamped to its capacities, as converter.LoadFlow does.
+func loadPreviousFlow(previous, g *graph.ResidualGraph) {
+	for _, from := range previous.GetSortedNodes() {
+		for _, prev := range previous.GetNeighborsList(from) {
+			if prev.IsReverse || prev.Flow <= graph.Epsilon {
+				continue
+			}
+			if edge := g.GetEdge(from, prev.To); edge != nil && !edge.IsReverse {
+				if flow := min(prev.Flow, edge.Capacity); flow > graph.Epsilon {
+					g.UpdateFlow(from, prev.To, flow)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveWarmStart_MatchesColdSolve(t *testing.T) {
+	ctx := context.Background()
+
+	for _, algo := range warmStartAlgorithms {
+		t.Run(algo.String(), func(t *testing.T) {
+			for seed := int64(1); seed <= 15; seed++ {
+				previous := randomFlowGraph(seed, 25, 120)
+				require.NoError(t, Solve(ctx, previous, 1, 25, algo, nil).Error)
+
+				cold := randomFlowGraph(seed, 25, 120)
+				perturbCapacities(cold, seed)
+				warm := cold.Clone()
+				loadPreviousFlow(previous, warm)
+
+				want := Solve(ctx, cold, 1, 25, algo, nil)
+				got := SolveWarmStart(ctx, warm, 1, 25, algo, nil)
+
+				require.NoError(t, want.Error)
+				require.NoError(t, got.Error, "seed %d", seed)
+				assert.InDelta(t, want.MaxFlow, got.MaxFlow, 1e-6, "seed %d", seed)
+				assert.InDelta(t, got.MaxFlow, warm.GetTotalFlow(1), 1e-6, "seed %d", seed)
+				if GetAlgorithmInfo(algo).SupportsMinCost {
+					// Min-cost algorithms are solved cold
+					assert.InDelta(t, want.TotalCost, got.TotalCost, 1e-6, "seed %d", seed)
+				}
+				assertValidFlow(t, warm, 1, 25)
+			}
+		})
+	}
+}
+
+func TestSolveWarmStart_ReusesPreviousFlow(t *testing.T) {
+	ctx := context.Background()
+
+	previous := randomFlowGraph(11, 40, 200)
+	require.NoError(t, Solve(ctx, previous, 1, 40, commonv1.Algorithm_ALGORITHM_EDMONDS_KARP, nil).Error)
+
+	// Unchanged network: the previous flow is already maximal
+	g := randomFlowGraph(11, 40, 200)
+	loadPreviousFlow(previous, g)
+
+	result := SolveWarmStart(ctx, g, 1, 40, commonv1.Algorithm_ALGORITHM_EDMONDS_KARP, nil)
+
+	require.NoError(t, result.Error)
+	assert.InDelta(t, previous.GetTotalFlow(1), result.MaxFlow, 1e-6)
+	assert.Less(t, result.Iterations, Solve(ctx, randomFlowGraph(11, 40, 200), 1, 40, commonv1.Algorithm_ALGORITHM_EDMONDS_KARP, nil).Iterations)
+}
+
+func TestSolveWarmStart_ReducedCapacity(t *testing.T) {
+	// 1 -> 2 -> 4 and 1 -> 3 -> 4, both carrying 5 units; edge 2 -> 4 shrinks to 2
+	build := func(capTwoFour float64) *graph.ResidualGraph {
+		g := graph.NewResidualGraph()
+		g.AddEdgeWithReverse(1, 2, 5, 1)
+		g.AddEdgeWithReverse(2, 4, capTwoFour, 1)
+		g.AddEdgeWithReverse(1, 3, 5, 1)
+		g.AddEdgeWithReverse(3, 4, 5, 1)
+		g.AddEdgeWithReverse(2, 3, 10, 1)
+		return g
+	}
+
+	previous := build(5)
+	require.NoError(t, Solve(context.Background(), previous, 1, 4, commonv1.Algorithm_ALGORITHM_DINIC, nil).Error)
+	require.InDelta(t, 10.0, previous.GetTotalFlow(1), 1e-9)
+
+	g := build(2)
+	loadPreviousFlow(previous, g)
+
+	result := SolveWarmStart(context.Background(), g, 1, 4, commonv1.Algorithm_ALGORITHM_DINIC, nil)
+
+	require.NoError(t, result.Error)
+	assert.InDelta(t, 7.0, result.MaxFlow, 1e-9)
+	assertValidFlow(t, g, 1, 4)
+}
+
+func TestSolveWarmStart_MinCostSolvesCold(t *testing.T) {
+	// The cheap route 1 -> 2 -> 4 was full before and is widened now, so the
+	// previous flow on the expensive route is not optimal any more
+	build := func(capCheap float64) *graph.ResidualGraph {
+		g := graph.NewResidualGraph()
+		g.AddEdgeWithReverse(1, 2, capCheap, 1)
+		g.AddEdgeWithReverse(2, 4, capCheap, 1)
+		g.AddEdgeWithReverse(1, 3, 10, 5)
+		g.AddEdgeWithReverse(3, 4, 10, 5)
+		g.AddEdgeWithReverse(4, 5, 10, 0)
+		return g
+	}
+
+	previous := build(2)
+	require.NoError(t, Solve(context.Background(), previous, 1, 5, commonv1.Algorithm_ALGORITHM_MIN_COST, nil).Error)
+
+	g := build(10)
+	loadPreviousFlow(previous, g)
+
+	result := SolveWarmStart(context.Background(), g, 1, 5, commonv1.Algorithm_ALGORITHM_MIN_COST, nil)
+
+	require.NoError(t, result.Error)
+	assert.InDelta(t, 10.0, result.MaxFlow, 1e-9)
+	assert.InDelta(t, 20.0, result.TotalCost, 1e-9, "all flow moves to the cheap route")
+	assert.False(t, SupportsWarmStart(commonv1.Algorithm_ALGORITHM_MIN_COST))
+}
+
+// gridFlowGraph builds a size x size grid with edges in both directions
+// between neighbours, random capacities and costs. The source is node 1 in
+// one corner and the sink is node size*size in the opposite one.
+func gridFlowGraph(seed int64, size int) *graph.ResidualGraph {
+	r := rand.New(rand.NewSource(seed))
+	g := graph.NewResidualGraph()
+	id := func(i, j int) int64 { return int64(i*size + j + 1) }
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			if j < size-1 {
+				g.AddEdgeWithReverse(id(i, j), id(i, j+1), float64(r.Intn(20)+5), float64(r.Intn(9)+1))
+				g.AddEdgeWithReverse(id(i, j+1), id(i, j), float64(r.Intn(20)+5), float64(r.Intn(9)+1))
+			}
+			if i < size-1 {
+				g.AddEdgeWithReverse(id(i, j), id(i+1, j), float64(r.Intn(20)+5), float64(r.Intn(9)+1))
+				g.AddEdgeWithReverse(id(i+1, j), id(i, j), float64(r.Intn(20)+5), float64(r.Intn(9)+1))
+			}
+		}
+	}
+	return g
+}
+
+func TestSolveWarmStart_NotSlowerThanColdSolve(t *testing.T) {
+	// A warm start must never cost much more than solving from zero, for
+	// min-cost algorithms in particular (1600-node grid)
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	ctx := context.Background()
+	const size = 40
+	sink := int64(size * size)
+
+	for _, algo := range []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_MIN_COST,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+	} {
+		t.Run(algo.String(), func(t *testing.T) {
+			previous := gridFlowGraph(3, size)
+			require.NoError(t, Solve(ctx, previous, 1, sink, algo, nil).Error)
+
+			cold := gridFlowGraph(3, size)
+			perturbCapacities(cold, 3)
+			warm := cold.Clone()
+			loadPreviousFlow(previous, warm)
+
+			start := time.Now()
+			want := Solve(ctx, cold, 1, sink, algo, nil)
+			coldTime := time.Since(start)
+
+			start = time.Now()
+			got := SolveWarmStart(ctx, warm, 1, sink, algo, nil)
+			warmTime := time.Since(start)
+
+			require.NoError(t, want.Error)
+			require.NoError(t, got.Error)
+			assert.InDelta(t, want.MaxFlow, got.MaxFlow, 1e-6)
+			if GetAlgorithmInfo(algo).SupportsMinCost {
+				assert.InDelta(t, want.TotalCost, got.TotalCost, 1e-6)
+			}
+			assert.Less(t, warmTime, 3*coldTime+200*time.Millisecond,
+				"warm start %v, cold solve %v", warmTime, coldTime)
+		})
+	}
+}
+
+func TestSolveWarmStart_RejectsLowerBounds(t *testing.T) {
+	g := lowerBoundGraph(6)
+
+	result := SolveWarmStart(context.Background(), g, 1, 4, commonv1.Algorithm_ALGORITHM_DINIC, nil)
+
+	assert.ErrorIs(t, result.Error, ErrWarmStartLowerBounds)
+}
+
+func TestSolveWarmStart_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	g := randomFlowGraph(5, 20, 80)
+	g.UpdateFlow(1, g.GetNeighborsList(1)[0].To, 1)
+
+	result := SolveWarmStart(ctx, g, 1, 20, commonv1.Algorithm_ALGORITHM_DINIC, nil)
+
+	assert.ErrorIs(t, result.Error, ErrContextCanceled)
+}
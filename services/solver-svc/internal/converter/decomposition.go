@@ -0,0 +1,74 @@
+package converter
+
+import (
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/pkg/domain"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Flow Decomposition
+// =============================================================================
+
+// ToFlowDecomposition decomposes the final flow of a residual graph into
+// weighted paths and cycles (see domain.DecomposeFlow).
+//
+// Unlike ToPaths, which reports the augmenting paths an algorithm happened
+// to record, the decomposition is built from the net edge flows alone, so it
+// is available for every algorithm, including push-relabel and the scaling
+// algorithms. Its paths never cancel each other and their flows add up to
+// the flow on every edge.
+//
+// Lanes of parallel edges are folded into their original edges and, for
+// multi-terminal graphs, edges of the virtual super-terminals are dropped,
+// so paths run from supply nodes to demand nodes. Path lengths come from
+// the Length of the original proto edges, matched by their stable ID.
+//
+// Parameters:
+// - protoGraph: Original protobuf graph (used for edge lengths)
+// - rg: Residual graph with the final flow
+// - terminals: Resolved terminals of the graph (may be nil)
+//
+// Returns:
+// - FlowDecomposition with paths in extraction order (widest first) and cycles
+func ToFlowDecomposition(protoGraph *commonv1.Graph, rg *graph.ResidualGraph, terminals *Terminals) *commonv1.FlowDecomposition {
+	lengths := make(map[int64]float64, len(protoGraph.GetEdges()))
+	for i, edge := range protoGraph.GetEdges() {
+		lengths[EdgeID(edge, i)] = edge.Length
+	}
+
+	opts := DefaultFlowEdgeOptions()
+	opts.Terminals = terminals
+	flowEdges := ToFlowEdgesWithOptions(rg, opts)
+
+	arcs := make([]domain.FlowArc, 0, len(flowEdges))
+	for _, fe := range flowEdges {
+		arcs = append(arcs, domain.FlowArc{
+			From:   fe.From,
+			To:     fe.To,
+			Flow:   fe.Flow,
+			Cost:   fe.Cost,
+			Length: lengths[fe.EdgeId],
+		})
+	}
+
+	decomposition := domain.DecomposeFlow(arcs)
+	return &commonv1.FlowDecomposition{
+		Paths:  toProtoPaths(decomposition.Paths),
+		Cycles: toProtoPaths(decomposition.Cycles),
+	}
+}
+
+// toProtoPaths converts domain paths to protobuf Path messages.
+func toProtoPaths(paths []*domain.Path) []*commonv1.Path {
+	result := make([]*commonv1.Path, 0, len(paths))
+	for _, p := range paths {
+		result = append(result, &commonv1.Path{
+			NodeIds: p.Nodes,
+			Flow:    p.Flow,
+			Cost:    p.Cost,
+			Length:  p.Length,
+		})
+	}
+	return result
+}
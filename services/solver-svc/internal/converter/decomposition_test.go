@@ -0,0 +1,96 @@
+package converter
+
+import (
+	"testing"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToFlowDecomposition_ParallelLanes(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 5, Cost: 1, Length: 3, Id: 10},
+			{From: 1, To: 2, Capacity: 3, Cost: 4, Length: 7, Id: 20},
+		},
+	}
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+	rg := ToResidualGraphWithTerminals(g, terminals)
+	rg.UpdateFlow(1, 2, 5)
+	rg.UpdateFlow(1, graph.LaneNodeBase, 3)
+	rg.UpdateFlow(graph.LaneNodeBase, 2, 3)
+
+	d := ToFlowDecomposition(g, rg, terminals)
+
+	// Lanes fold into their edges and keep their own cost and length
+	assert.Empty(t, d.Cycles)
+	require.Len(t, d.Paths, 2)
+	assert.Equal(t, &commonv1.Path{NodeIds: []int64{1, 2}, Flow: 5, Cost: 5, Length: 3}, d.Paths[0])
+	assert.Equal(t, &commonv1.Path{NodeIds: []int64{1, 2}, Flow: 3, Cost: 12, Length: 7}, d.Paths[1])
+}
+
+func TestToFlowDecomposition_MultiTerminal(t *testing.T) {
+	g := twoWarehouseGraph()
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+	rg := ToResidualGraphWithTerminals(g, terminals)
+	for _, e := range []struct {
+		from, to int64
+		flow     float64
+	}{
+		{1, 3, 10}, {2, 3, 5}, {3, 4, 8}, {3, 5, 7},
+	} {
+		rg.UpdateFlow(e.from, e.to, e.flow)
+	}
+
+	d := ToFlowDecomposition(g, rg, terminals)
+
+	// Paths run from warehouses to delivery points, never via super-terminals
+	shipped := make(map[int64]float64)
+	received := make(map[int64]float64)
+	for _, p := range d.Paths {
+		require.GreaterOrEqual(t, len(p.NodeIds), 3)
+		shipped[p.NodeIds[0]] += p.Flow
+		received[p.NodeIds[len(p.NodeIds)-1]] += p.Flow
+	}
+	assert.Equal(t, map[int64]float64{1: 10, 2: 5}, shipped)
+	assert.Equal(t, map[int64]float64{4: 8, 5: 7}, received)
+}
+
+func TestToFlowDecomposition_Cycle(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}, {Id: 5}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, Cost: 1},
+			{From: 2, To: 3, Capacity: 10, Cost: 1},
+			{From: 2, To: 4, Capacity: 10, Cost: 1, Length: 2},
+			{From: 4, To: 5, Capacity: 10, Cost: 1, Length: 2},
+			{From: 5, To: 2, Capacity: 10, Cost: 1, Length: 2},
+		},
+	}
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+	rg := ToResidualGraphWithTerminals(g, terminals)
+	rg.UpdateFlow(1, 2, 6)
+	rg.UpdateFlow(2, 3, 6)
+	rg.UpdateFlow(2, 4, 2)
+	rg.UpdateFlow(4, 5, 2)
+	rg.UpdateFlow(5, 2, 2)
+
+	d := ToFlowDecomposition(g, rg, terminals)
+
+	require.Len(t, d.Cycles, 1)
+	assert.Equal(t, &commonv1.Path{NodeIds: []int64{2, 4, 5, 2}, Flow: 2, Cost: 6, Length: 6}, d.Cycles[0])
+	require.Len(t, d.Paths, 1)
+	assert.Equal(t, []int64{1, 2, 3}, d.Paths[0].NodeIds)
+	assert.InDelta(t, 6.0, d.Paths[0].Flow, 1e-9)
+}
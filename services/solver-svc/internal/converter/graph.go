@@ -47,6 +47,10 @@ type PathWithFlow struct {
 // 2. Adds all nodes from the proto graph
 // 3. Adds edges with their reverse edges for residual capacity tracking
 // 4. Handles bidirectional edges by adding both directions
+// 5. Sets edge lower bounds (min_flow) on the from → to direction
+//
+// Every edge keeps its stable ID (see EdgeID), and parallel edges between
+// the same pair of nodes stay separate lanes (see graph.ResidualGraph.AddEdgeWithID).
 //
 // Parameters:
 // - protoGraph: The protobuf Graph message to convert
@@ -67,18 +71,32 @@ func ToResidualGraph(protoGraph *commonv1.Graph) *graph.ResidualGraph {
 	}
 
 	// Add edges with reverse edges for residual graph structure
-	for _, edge := range protoGraph.Edges {
-		rg.AddEdgeWithReverse(edge.From, edge.To, edge.Capacity, edge.Cost)
+	for i, edge := range protoGraph.Edges {
+		id := EdgeID(edge, i)
+		forward := rg.AddEdgeWithID(id, edge.From, edge.To, edge.Capacity, edge.Cost)
 
 		// For bidirectional edges, add the reverse direction as well
 		if edge.Bidirectional {
-			rg.AddEdgeWithReverse(edge.To, edge.From, edge.Capacity, edge.Cost)
+			rg.AddEdgeWithID(id, edge.To, edge.From, edge.Capacity, edge.Cost)
+		}
+
+		if edge.MinFlow > 0 {
+			forward.LowerBound += edge.MinFlow
 		}
 	}
 
 	return rg
 }
 
+// EdgeID returns the stable ID of the edge at position index of a graph:
+// its id field, or its 1-based position when id is unset.
+func EdgeID(edge *commonv1.Edge, index int) int64 {
+	if edge.Id != 0 {
+		return edge.Id
+	}
+	return int64(index + 1)
+}
+
 // =============================================================================
 // Flow Calculation Helpers
 // =============================================================================
@@ -149,25 +167,30 @@ func GetUtilization(edge *graph.ResidualEdge) float64 {
 // Parameters:
 // - paths: Slice of internal path representations
 // - rg: The residual graph (used to look up edge costs)
+// - terminals: Resolved terminals of the graph (may be nil)
 //
 // Returns:
 // - Slice of protobuf Path messages
 //
-// Note: Paths with fewer than 2 nodes are filtered out as invalid.
-func ToPaths(paths []PathWithFlow, rg *graph.ResidualGraph) []*commonv1.Path {
+// Note: Lane nodes of parallel edges and, for multi-terminal graphs, the
+// virtual super-source/super-sink nodes are stripped from the paths. Paths
+// with fewer than 2 remaining nodes are filtered out as invalid.
+func ToPaths(paths []PathWithFlow, rg *graph.ResidualGraph, terminals *Terminals) []*commonv1.Path {
 	result := make([]*commonv1.Path, 0, len(paths))
 
 	for _, p := range paths {
+		// Compute total edge cost for the path, lanes included
+		unitCost := calculatePathCost(rg, p.NodeIDs)
+
+		nodeIDs := terminals.StripVirtualNodes(rg.StripLaneNodes(p.NodeIDs))
+
 		// Valid paths must have at least source and sink
-		if len(p.NodeIDs) < 2 {
+		if len(nodeIDs) < 2 {
 			continue
 		}
 
-		// Compute total edge cost for the path
-		unitCost := calculatePathCost(rg, p.NodeIDs)
-
 		result = append(result, &commonv1.Path{
-			NodeIds: p.NodeIDs,
+			NodeIds: nodeIDs,
 			Flow:    p.Flow,
 			Cost:    unitCost * p.Flow, // Total cost = unit cost * flow
 		})
@@ -295,6 +318,11 @@ type FlowEdgeOptions struct {
 	// Edges with flow < MinFlowThreshold are excluded (unless IncludeZeroFlow).
 	// Default: graph.Epsilon
 	MinFlowThreshold float64
+
+	// Terminals excludes edges to/from the virtual super-source and super-sink
+	// when the graph was solved in multi-terminal mode.
+	// Default: nil (all edges are considered)
+	Terminals *Terminals
 }
 
 // DefaultFlowEdgeOptions returns the default options for edge conversion.
@@ -353,6 +381,17 @@ func ToFlowEdgesWithOptions(rg *graph.ResidualGraph, opts *FlowEdgeOptions) []*c
 				continue
 			}
 
+			// Parallel edges are reported once, between their original nodes
+			to, ok := flowEdgeTarget(rg, from, edge)
+			if !ok {
+				continue
+			}
+
+			// Skip virtual super-source/super-sink edges of multi-terminal graphs
+			if opts.Terminals.IsVirtual(from) || opts.Terminals.IsVirtual(to) {
+				continue
+			}
+
 			// Calculate net flow for forward edges
 			// NetFlow = OriginalCapacity - RemainingCapacity
 			var netFlow float64
@@ -383,11 +422,12 @@ func ToFlowEdgesWithOptions(rg *graph.ResidualGraph, opts *FlowEdgeOptions) []*c
 
 			result = append(result, &commonv1.FlowEdge{
 				From:        from,
-				To:          edge.To,
+				To:          to,
 				Flow:        netFlow,
 				Capacity:    edge.OriginalCapacity,
 				Cost:        edge.Cost,
 				Utilization: utilization,
+				EdgeId:      edge.ID,
 			})
 		}
 	}
@@ -395,6 +435,28 @@ func ToFlowEdgesWithOptions(rg *graph.ResidualGraph, opts *FlowEdgeOptions) []*c
 	return result
 }
 
+// flowEdgeTarget returns the node an edge leads to in the original graph,
+// folding lane nodes of parallel edges into the edge they belong to.
+//
+// ok is false for edges leaving a lane node: the lane is reported through
+// its first edge, which carries the lane's cost and the same flow.
+func flowEdgeTarget(rg *graph.ResidualGraph, from int64, edge *graph.ResidualEdge) (to int64, ok bool) {
+	if rg.IsLaneNode(from) {
+		return 0, false
+	}
+
+	lane, isLane := rg.Lane(edge.To)
+	if !isLane {
+		return edge.To, true
+	}
+
+	// A reverse edge into a lane node belongs to the lane's last edge
+	if edge.IsReverse {
+		return lane.From, true
+	}
+	return lane.To, true
+}
+
 // ToAllEdges returns all forward edges regardless of flow.
 // Useful for visualizing the complete network structure.
 func ToAllEdges(rg *graph.ResidualGraph) []*commonv1.FlowEdge {
@@ -441,6 +503,11 @@ func ToFlowEdgesFiltered(rg *graph.ResidualGraph, filter EdgeFilter) []*commonv1
 	for _, from := range nodes {
 		edges := rg.GetNeighborsList(from)
 		for _, edge := range edges {
+			to, ok := flowEdgeTarget(rg, from, edge)
+			if !ok {
+				continue
+			}
+
 			if filter != nil && !filter(from, edge) {
 				continue
 			}
@@ -451,11 +518,12 @@ func ToFlowEdgesFiltered(rg *graph.ResidualGraph, filter EdgeFilter) []*commonv1
 
 			result = append(result, &commonv1.FlowEdge{
 				From:        from,
-				To:          edge.To,
+				To:          to,
 				Flow:        netFlow,
 				Capacity:    edge.OriginalCapacity,
 				Cost:        edge.Cost,
 				Utilization: utilization,
+				EdgeId:      edge.ID,
 			})
 		}
 	}
@@ -526,14 +594,16 @@ func FilterByNodes(nodes map[int64]bool) EdgeFilter {
 //
 //	NetFlow = OriginalCapacity - RemainingCapacity
 //
-// This correctly handles flow cancellation via reverse edges.
+// This correctly handles flow cancellation via reverse edges. Edges are
+// matched by their stable ID (see EdgeID), which is also set on the returned
+// edges, so parallel edges each get their own flow.
 //
 // Parameters:
 // - protoGraph: Original protobuf graph
 // - rg: Residual graph with computed flow values
 //
 // Returns:
-// - New protobuf Graph with CurrentFlow fields populated
+// - New protobuf Graph with CurrentFlow and Id fields populated
 func UpdateGraphWithFlow(protoGraph *commonv1.Graph, rg *graph.ResidualGraph) *commonv1.Graph {
 	result := &commonv1.Graph{
 		Nodes:    protoGraph.Nodes,
@@ -544,6 +614,11 @@ func UpdateGraphWithFlow(protoGraph *commonv1.Graph, rg *graph.ResidualGraph) *c
 		Metadata: protoGraph.Metadata,
 	}
 
+	flows := make(map[edgeRef]float64, len(protoGraph.Edges))
+	for _, fe := range ToAllEdges(rg) {
+		flows[edgeRef{id: fe.EdgeId, from: fe.From, to: fe.To}] = fe.Flow
+	}
+
 	for i, edge := range protoGraph.Edges {
 		newEdge := &commonv1.Edge{
 			From:          edge.From,
@@ -553,10 +628,14 @@ func UpdateGraphWithFlow(protoGraph *commonv1.Graph, rg *graph.ResidualGraph) *c
 			Length:        edge.Length,
 			RoadType:      edge.RoadType,
 			Bidirectional: edge.Bidirectional,
+			MinFlow:       edge.MinFlow,
+			Id:            EdgeID(edge, i),
 		}
 
 		// Populate net flow from residual graph
-		if re := rg.GetEdge(edge.From, edge.To); re != nil {
+		if flow, ok := flows[edgeRef{id: newEdge.Id, from: edge.From, to: edge.To}]; ok {
+			newEdge.CurrentFlow = flow
+		} else if re := rg.GetEdge(edge.From, edge.To); re != nil {
 			newEdge.CurrentFlow = GetNetFlow(re)
 		}
 
@@ -566,6 +645,12 @@ func UpdateGraphWithFlow(protoGraph *commonv1.Graph, rg *graph.ResidualGraph) *c
 	return result
 }
 
+// edgeRef identifies one direction of an original edge.
+type edgeRef struct {
+	id       int64
+	from, to int64
+}
+
 // =============================================================================
 // Statistics
 // =============================================================================
@@ -74,6 +74,91 @@ func TestToResidualGraph_BidirectionalEdge(t *testing.T) {
 	assert.Equal(t, 10.0, edge21.Capacity)
 }
 
+func TestToResidualGraph_MinFlow(t *testing.T) {
+	proto := &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1}, {Id: 2}, {Id: 3},
+		},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, MinFlow: 3, Bidirectional: true},
+			{From: 2, To: 3, Capacity: 10},
+		},
+	}
+
+	rg := ToResidualGraph(proto)
+
+	assert.True(t, rg.HasLowerBounds())
+	assert.Equal(t, 3.0, rg.GetEdge(1, 2).LowerBound)
+	assert.Equal(t, 0.0, rg.GetEdge(2, 1).LowerBound, "min_flow applies to from -> to only")
+	assert.Equal(t, 0.0, rg.GetEdge(2, 3).LowerBound)
+}
+
+// parallelEdgesGraph: a truck (cheap) and a rail (expensive) lane from 1 to 2,
+// the rail lane with an explicit id
+func parallelEdgesGraph() *commonv1.Graph {
+	return &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 5, Cost: 1},
+			{From: 1, To: 2, Capacity: 5, Cost: 10, Id: 42},
+			{From: 2, To: 3, Capacity: 8},
+		},
+	}
+}
+
+func TestEdgeID(t *testing.T) {
+	assert.Equal(t, int64(1), EdgeID(&commonv1.Edge{}, 0))
+	assert.Equal(t, int64(42), EdgeID(&commonv1.Edge{Id: 42}, 0))
+}
+
+func TestToResidualGraph_ParallelEdges(t *testing.T) {
+	rg := ToResidualGraph(parallelEdgesGraph())
+
+	truck := rg.GetEdge(1, 2)
+	require.NotNil(t, truck)
+	assert.Equal(t, 5.0, truck.Capacity, "parallel edges must not be merged")
+	assert.Equal(t, int64(1), truck.ID)
+
+	rail := rg.GetEdge(1, graph.LaneNodeBase)
+	require.NotNil(t, rail)
+	assert.Equal(t, 10.0, rail.Cost)
+	assert.Equal(t, int64(42), rail.ID)
+}
+
+func TestToFlowEdges_ParallelEdges(t *testing.T) {
+	g := parallelEdgesGraph()
+	rg := ToResidualGraph(g)
+	graph.AugmentPath(rg, []int64{1, 2, 3}, 5)
+	graph.AugmentPath(rg, []int64{1, graph.LaneNodeBase, 2, 3}, 3)
+
+	edges := ToFlowEdges(rg)
+	require.Len(t, edges, 3)
+
+	byID := make(map[int64]*commonv1.FlowEdge)
+	for _, e := range edges {
+		byID[e.EdgeId] = e
+	}
+	require.Contains(t, byID, int64(42))
+	assert.Equal(t, int64(1), byID[42].From)
+	assert.Equal(t, int64(2), byID[42].To)
+	assert.Equal(t, 3.0, byID[42].Flow)
+	assert.Equal(t, 10.0, byID[42].Cost)
+	assert.Equal(t, 5.0, byID[1].Flow)
+	assert.Equal(t, 8.0, byID[3].Flow)
+
+	solved := UpdateGraphWithFlow(g, rg)
+	assert.Equal(t, []int64{1, 42, 3}, []int64{solved.Edges[0].Id, solved.Edges[1].Id, solved.Edges[2].Id})
+	assert.Equal(t, 5.0, solved.Edges[0].CurrentFlow)
+	assert.Equal(t, 3.0, solved.Edges[1].CurrentFlow)
+
+	paths := ToPaths([]PathWithFlow{{NodeIDs: []int64{1, graph.LaneNodeBase, 2, 3}, Flow: 3}}, rg, nil)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []int64{1, 2, 3}, paths[0].NodeIds)
+	assert.Equal(t, 30.0, paths[0].Cost)
+}
+
 func TestToResidualGraph_LargeGraph(t *testing.T) {
 	n := 100
 	nodes := make([]*commonv1.Node, n)
@@ -211,7 +296,7 @@ func TestToDebugEdges(t *testing.T) {
 func TestToPaths_EmptyPaths(t *testing.T) {
 	rg := graph.NewResidualGraph()
 
-	paths := ToPaths([]PathWithFlow{}, rg)
+	paths := ToPaths([]PathWithFlow{}, rg, nil)
 
 	assert.Empty(t, paths)
 }
@@ -227,7 +312,7 @@ func TestToPaths_SimplePaths(t *testing.T) {
 		{NodeIDs: []int64{1, 2, 3}, Flow: 5.0},
 	}
 
-	paths := ToPaths(rawPaths, rg)
+	paths := ToPaths(rawPaths, rg, nil)
 
 	require.Len(t, paths, 1)
 	assert.Equal(t, []int64{1, 2, 3}, paths[0].NodeIds)
@@ -240,7 +325,7 @@ func TestToPaths_SingleNodePath(t *testing.T) {
 
 	paths := ToPaths([]PathWithFlow{
 		{NodeIDs: []int64{1}, Flow: 5.0},
-	}, rg)
+	}, rg, nil)
 
 	// Single node path should be filtered out
 	assert.Empty(t, paths)
@@ -263,7 +348,7 @@ func TestToPaths_MultiplePaths(t *testing.T) {
 		{NodeIDs: []int64{1, 3, 4}, Flow: 3.0},
 	}
 
-	paths := ToPaths(rawPaths, rg)
+	paths := ToPaths(rawPaths, rg, nil)
 
 	assert.Len(t, paths, 2)
 }
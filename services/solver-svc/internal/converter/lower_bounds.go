@@ -0,0 +1,29 @@
+package converter
+
+import (
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Edge Lower Bounds Report
+// =============================================================================
+
+// ToLowerBoundViolations converts lower-bound deficits to protobuf messages.
+// Returns nil when there are no deficits.
+func ToLowerBoundViolations(deficits []graph.LowerBoundDeficit) []*commonv1.LowerBoundViolation {
+	if len(deficits) == 0 {
+		return nil
+	}
+
+	result := make([]*commonv1.LowerBoundViolation, 0, len(deficits))
+	for _, d := range deficits {
+		result = append(result, &commonv1.LowerBoundViolation{
+			NodeId:     d.Node,
+			Imbalance:  d.Imbalance,
+			Unresolved: d.Unresolved,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,103 @@
+package converter
+
+import (
+	"errors"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Minimum Cut Extraction
+// =============================================================================
+
+// ErrFlowNotMaximal is returned by ToMinCut when the sink is still reachable
+// from the source in the residual graph, i.e. the flow is not a maximum flow
+// and no cut separates the terminals.
+var ErrFlowNotMaximal = errors.New("sink is reachable in the residual graph: flow is not maximal")
+
+// ToMinCut extracts the minimum s-t cut from the residual graph left by any
+// maximum-flow algorithm.
+//
+// The source side S is the set of nodes reachable from the source through
+// edges with positive residual capacity. Cut edges are the proto edges (and the
+// reverse direction of bidirectional edges) leading from S to the sink side;
+// parallel edges are reported individually with their stable IDs (see EdgeID).
+// Lane and virtual super-terminal nodes never appear in the result.
+//
+// The cut value follows the max-flow min-cut theorem with lower bounds:
+//
+//	value = Σ capacity(S → T) - Σ min_flow(T → S)
+//
+// In multi-terminal mode the virtual edges also count: a supply node on the
+// sink side contributes its whole supply, a demand node on the source side
+// its whole demand. Those nodes are listed in SaturatedSupplies and
+// SaturatedDemands.
+//
+// Returns ErrFlowNotMaximal if the sink is reachable from the source.
+func ToMinCut(protoGraph *commonv1.Graph, rg *graph.ResidualGraph, t *Terminals) (*commonv1.MinCut, error) {
+	reachable := graph.BFSLevel(rg, t.Source)
+	if _, ok := reachable[t.Sink]; ok {
+		return nil, ErrFlowNotMaximal
+	}
+
+	inSource := func(id int64) bool {
+		_, ok := reachable[id]
+		return ok
+	}
+
+	cut := &commonv1.MinCut{}
+
+	sourceSide := make(map[int64]bool, len(reachable))
+	for id := range reachable {
+		if !rg.IsLaneNode(id) && !t.IsVirtual(id) {
+			sourceSide[id] = true
+		}
+	}
+	cut.SourceSide = GetSortedNodeIDs(sourceSide)
+
+	for i, edge := range protoGraph.GetEdges() {
+		id := EdgeID(edge, i)
+		fromS, toS := inSource(edge.From), inSource(edge.To)
+
+		switch {
+		case fromS && !toS:
+			cut.Edges = append(cut.Edges, toCutEdge(edge.From, edge.To, id, edge.Capacity))
+			cut.Value += edge.Capacity
+		case !fromS && toS:
+			// Lower bounds apply to the from → to direction only
+			cut.Value -= edge.MinFlow
+			if edge.Bidirectional {
+				cut.Edges = append(cut.Edges, toCutEdge(edge.To, edge.From, id, edge.Capacity))
+				cut.Value += edge.Capacity
+			}
+		}
+	}
+
+	if t.MultiTerminal {
+		for _, id := range t.SupplyNodes() {
+			if !inSource(id) {
+				cut.SaturatedSupplies = append(cut.SaturatedSupplies, id)
+				cut.Value += t.Supplies[id]
+			}
+		}
+		for _, id := range t.DemandNodes() {
+			if inSource(id) {
+				cut.SaturatedDemands = append(cut.SaturatedDemands, id)
+				cut.Value += t.Demands[id]
+			}
+		}
+	}
+
+	return cut, nil
+}
+
+// toCutEdge creates a cut edge in the source-to-sink direction.
+func toCutEdge(from, to, id int64, capacity float64) *commonv1.CutEdge {
+	return &commonv1.CutEdge{
+		From:     from,
+		To:       to,
+		EdgeId:   id,
+		Capacity: capacity,
+	}
+}
@@ -0,0 +1,143 @@
+package converter
+
+import (
+	"testing"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToMinCut(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10},
+			{From: 1, To: 3, Capacity: 2},
+			{From: 2, To: 4, Capacity: 4},
+			{From: 3, To: 4, Capacity: 10},
+		},
+	}
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+	rg := ToResidualGraphWithTerminals(g, terminals)
+	rg.UpdateFlow(1, 2, 4)
+	rg.UpdateFlow(2, 4, 4)
+	rg.UpdateFlow(1, 3, 2)
+	rg.UpdateFlow(3, 4, 2)
+
+	cut, err := ToMinCut(g, rg, terminals)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, cut.SourceSide)
+	assert.InDelta(t, 6.0, cut.Value, 1e-9)
+	require.Len(t, cut.Edges, 2)
+	assert.Equal(t, &commonv1.CutEdge{From: 1, To: 3, EdgeId: 2, Capacity: 2}, cut.Edges[0])
+	assert.Equal(t, &commonv1.CutEdge{From: 2, To: 4, EdgeId: 3, Capacity: 4}, cut.Edges[1])
+}
+
+func TestToMinCut_ParallelLanes(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 5, Id: 10},
+			{From: 1, To: 2, Capacity: 3, Id: 20},
+		},
+	}
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+	rg := ToResidualGraphWithTerminals(g, terminals)
+	// Edge 20 is the second edge between 1 and 2, so it runs through a lane node
+	require.True(t, rg.IsLaneNode(graph.LaneNodeBase))
+	rg.UpdateFlow(1, 2, 5)
+	rg.UpdateFlow(1, graph.LaneNodeBase, 3)
+	rg.UpdateFlow(graph.LaneNodeBase, 2, 3)
+
+	cut, err := ToMinCut(g, rg, terminals)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, cut.SourceSide, "lane nodes must not leak into results")
+	assert.InDelta(t, 8.0, cut.Value, 1e-9)
+	require.Len(t, cut.Edges, 2)
+	assert.Equal(t, int64(10), cut.Edges[0].EdgeId)
+	assert.Equal(t, int64(20), cut.Edges[1].EdgeId)
+}
+
+func TestToMinCut_MultiTerminal(t *testing.T) {
+	t.Run("saturated_supply", func(t *testing.T) {
+		// Warehouse 1 ships its whole supply, warehouse 2 is limited by edge 2 -> 3
+		g := twoWarehouseGraph()
+		g.Edges[1].Capacity = 1  // 2 -> 3
+		g.Edges[2].Capacity = 3  // 3 -> 4
+		g.Edges[3].Capacity = 20 // 3 -> 5
+
+		terminals, err := ResolveTerminals(g)
+		require.NoError(t, err)
+		rg := ToResidualGraphWithTerminals(g, terminals)
+		rg.UpdateFlow(terminals.Source, 1, 10)
+		rg.UpdateFlow(terminals.Source, 2, 1)
+		rg.UpdateFlow(1, 3, 10)
+		rg.UpdateFlow(2, 3, 1)
+		rg.UpdateFlow(3, 4, 3)
+		rg.UpdateFlow(3, 5, 8)
+		rg.UpdateFlow(4, terminals.Sink, 3)
+		rg.UpdateFlow(5, terminals.Sink, 8)
+
+		cut, err := ToMinCut(g, rg, terminals)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, cut.SourceSide, "virtual nodes must not leak into results")
+		assert.Equal(t, []int64{1}, cut.SaturatedSupplies)
+		assert.Empty(t, cut.SaturatedDemands)
+		require.Len(t, cut.Edges, 1)
+		assert.Equal(t, &commonv1.CutEdge{From: 2, To: 3, EdgeId: 2, Capacity: 1}, cut.Edges[0])
+		assert.InDelta(t, 11.0, cut.Value, 1e-9)
+	})
+
+	t.Run("saturated_demand", func(t *testing.T) {
+		g := twoWarehouseGraph()
+		g.Nodes[3].Demand = 2
+		g.Nodes[4].Demand = 3
+
+		terminals, err := ResolveTerminals(g)
+		require.NoError(t, err)
+		rg := ToResidualGraphWithTerminals(g, terminals)
+		rg.UpdateFlow(terminals.Source, 1, 5)
+		rg.UpdateFlow(1, 3, 5)
+		rg.UpdateFlow(3, 4, 2)
+		rg.UpdateFlow(3, 5, 3)
+		rg.UpdateFlow(4, terminals.Sink, 2)
+		rg.UpdateFlow(5, terminals.Sink, 3)
+
+		cut, err := ToMinCut(g, rg, terminals)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, cut.SourceSide)
+		assert.Empty(t, cut.SaturatedSupplies)
+		assert.Equal(t, []int64{4, 5}, cut.SaturatedDemands)
+		assert.Empty(t, cut.Edges)
+		assert.InDelta(t, 5.0, cut.Value, 1e-9)
+	})
+}
+
+func TestToMinCut_FlowNotMaximal(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges:    []*commonv1.Edge{{From: 1, To: 2, Capacity: 5}},
+	}
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+	rg := ToResidualGraphWithTerminals(g, terminals)
+
+	_, err = ToMinCut(g, rg, terminals)
+
+	assert.ErrorIs(t, err, ErrFlowNotMaximal)
+}
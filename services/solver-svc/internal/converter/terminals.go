@@ -0,0 +1,122 @@
+package converter
+
+import (
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/pkg/domain"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Multi-Source / Multi-Sink Terminals
+// =============================================================================
+
+// Terminals describes the source and sink that flow algorithms should run
+// against; see domain.Terminals. The resolution rules live in pkg/domain so
+// that other services wire supply and demand nodes exactly like the solver.
+type Terminals = domain.Terminals
+
+// ErrConflictingTerminals is returned when a graph declares a single supply
+// node and a single demand node that differ from its existing source_id/sink_id.
+var ErrConflictingTerminals = domain.ErrConflictingTerminals
+
+// ErrNoSupplyDemand is returned when a transportation problem is requested
+// for a graph without both supply and demand nodes.
+var ErrNoSupplyDemand = domain.ErrNoSupplyDemand
+
+// ResolveTerminals determines the effective terminals for a proto graph
+// (see domain.ResolveTerminals).
+//
+// Multi-terminal mode is enabled when the graph has supply and demand nodes and
+// either more than one supply node, more than one demand node, or the declared
+// source_id/sink_id do not refer to existing nodes. In that mode source_id and
+// sink_id are ignored.
+func ResolveTerminals(protoGraph *commonv1.Graph) (*Terminals, error) {
+	return domain.ResolveTerminals(protoGraph.GetSourceId(), protoGraph.GetSinkId(), protoGraph.GetNodes())
+}
+
+// ResolveBalancedTerminals returns multi-terminal Terminals for a graph
+// solved as a transportation problem, where every supply and demand node is
+// connected to the virtual super-terminals regardless of source_id/sink_id.
+//
+// Returns ErrNoSupplyDemand if the graph declares no supply or no demand.
+func ResolveBalancedTerminals(protoGraph *commonv1.Graph) (*Terminals, error) {
+	return domain.ResolveBalancedTerminals(protoGraph.GetSourceId(), protoGraph.GetSinkId(), protoGraph.GetNodes())
+}
+
+// ToResidualGraphWithTerminals converts a proto graph and, in multi-terminal
+// mode, connects the virtual super-source to every supply node and every
+// demand node to the virtual super-sink. Virtual edges have zero cost and
+// capacity equal to the node's net supply/demand.
+//
+// Example:
+//
+//	terminals, err := ResolveTerminals(request.Graph)
+//	rg := ToResidualGraphWithTerminals(request.Graph, terminals)
+//	result := algorithms.Solve(ctx, rg, terminals.Source, terminals.Sink, algo, opts)
+func ToResidualGraphWithTerminals(protoGraph *commonv1.Graph, t *Terminals) *graph.ResidualGraph {
+	rg := ToResidualGraph(protoGraph)
+
+	if t == nil || !t.MultiTerminal {
+		return rg
+	}
+
+	rg.AddNode(t.Source)
+	rg.AddNode(t.Sink)
+
+	for _, id := range t.SupplyNodes() {
+		rg.AddEdgeWithReverse(t.Source, id, t.Supplies[id], 0)
+	}
+	for _, id := range t.DemandNodes() {
+		rg.AddEdgeWithReverse(id, t.Sink, t.Demands[id], 0)
+	}
+
+	return rg
+}
+
+// ToNodeBalances reports shipped volume per supply node and received volume
+// and unmet demand per demand node. Volumes are read from the virtual
+// super-terminal edges, so it returns nil slices for single-terminal graphs.
+//
+// Results are sorted by node ID.
+func ToNodeBalances(protoGraph *commonv1.Graph, rg *graph.ResidualGraph, t *Terminals) (sources, sinks []*commonv1.NodeBalance) {
+	if t == nil || !t.MultiTerminal {
+		return nil, nil
+	}
+
+	nodes := make(map[int64]*commonv1.Node, len(protoGraph.GetNodes()))
+	for _, node := range protoGraph.GetNodes() {
+		nodes[node.Id] = node
+	}
+
+	for _, id := range t.SupplyNodes() {
+		shipped := GetNetFlow(rg.GetEdge(t.Source, id))
+		sources = append(sources, &commonv1.NodeBalance{
+			NodeId:       id,
+			Supply:       nodes[id].GetSupply(),
+			Demand:       nodes[id].GetDemand(),
+			Shipped:      shipped,
+			UnusedSupply: clampNonNegative(t.Supplies[id] - shipped),
+		})
+	}
+
+	for _, id := range t.DemandNodes() {
+		received := GetNetFlow(rg.GetEdge(id, t.Sink))
+		sinks = append(sinks, &commonv1.NodeBalance{
+			NodeId:      id,
+			Supply:      nodes[id].GetSupply(),
+			Demand:      nodes[id].GetDemand(),
+			Received:    received,
+			UnmetDemand: clampNonNegative(t.Demands[id] - received),
+		})
+	}
+
+	return sources, sinks
+}
+
+// clampNonNegative rounds tiny negative values (floating point noise) to zero.
+func clampNonNegative(v float64) float64 {
+	if v < graph.Epsilon {
+		return 0
+	}
+	return v
+}
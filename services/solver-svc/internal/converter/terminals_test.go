@@ -0,0 +1,265 @@
+package converter
+
+import (
+	"testing"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/pkg/domain"
+	"logistics/services/solver-svc/internal/graph"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// twoWarehouseGraph: warehouses 1 and 2 ship through hub 3 to delivery points 4 and 5.
+func twoWarehouseGraph() *commonv1.Graph {
+	return &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE, Supply: 10},
+			{Id: 2, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE, Supply: 5},
+			{Id: 3, Type: commonv1.NodeType_NODE_TYPE_INTERSECTION},
+			{Id: 4, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT, Demand: 8},
+			{Id: 5, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT, Demand: 9},
+		},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 3, Capacity: 10, Cost: 1},
+			{From: 2, To: 3, Capacity: 5, Cost: 2},
+			{From: 3, To: 4, Capacity: 8, Cost: 1},
+			{From: 3, To: 5, Capacity: 9, Cost: 1},
+		},
+	}
+}
+
+func TestResolveTerminals_SingleTerminal(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes: []*commonv1.Node{
+			{Id: 1, Supply: 100},
+			{Id: 2, Demand: 100},
+		},
+	}
+
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+
+	assert.False(t, terminals.MultiTerminal)
+	assert.Equal(t, int64(1), terminals.Source)
+	assert.Equal(t, int64(2), terminals.Sink)
+}
+
+func TestResolveTerminals_ConflictingTerminals(t *testing.T) {
+	// Supply on 2 and demand on 3, but source_id/sink_id point at 1 and 4
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Nodes: []*commonv1.Node{
+			{Id: 1},
+			{Id: 2, Supply: 10},
+			{Id: 3, Demand: 10},
+			{Id: 4},
+		},
+	}
+
+	_, err := ResolveTerminals(g)
+
+	assert.ErrorIs(t, err, ErrConflictingTerminals)
+}
+
+func TestResolveTerminals_NoSupplyDemand(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}},
+	}
+
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+
+	assert.False(t, terminals.MultiTerminal)
+	assert.Equal(t, int64(1), terminals.Source)
+	assert.Equal(t, int64(3), terminals.Sink)
+}
+
+func TestResolveTerminals_MultiTerminal(t *testing.T) {
+	terminals, err := ResolveTerminals(twoWarehouseGraph())
+	require.NoError(t, err)
+
+	assert.True(t, terminals.MultiTerminal)
+	assert.Equal(t, domain.SuperSourceID, terminals.Source)
+	assert.Equal(t, domain.SuperSinkID, terminals.Sink)
+	assert.Equal(t, map[int64]float64{1: 10, 2: 5}, terminals.Supplies)
+	assert.Equal(t, map[int64]float64{4: 8, 5: 9}, terminals.Demands)
+}
+
+func TestResolveTerminals_MissingDeclaredTerminals(t *testing.T) {
+	// One supply and one demand node, but source_id/sink_id are not set
+	g := &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1, Supply: 10},
+			{Id: 2, Demand: 10},
+		},
+	}
+
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+
+	assert.True(t, terminals.MultiTerminal)
+}
+
+func TestResolveTerminals_NetBalance(t *testing.T) {
+	g := &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1, Supply: 10, Demand: 4},
+			{Id: 2, Supply: 3, Demand: 3},
+			{Id: 3, Demand: 6},
+		},
+	}
+
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[int64]float64{1: 6}, terminals.Supplies)
+	assert.Equal(t, map[int64]float64{3: 6}, terminals.Demands)
+}
+
+func TestToResidualGraphWithTerminals_AddsVirtualEdges(t *testing.T) {
+	rg, terminals := resolvedResidualGraph(t, twoWarehouseGraph())
+
+	require.True(t, terminals.MultiTerminal)
+	assert.Equal(t, 7, rg.NodeCount())
+
+	e := rg.GetEdge(domain.SuperSourceID, 1)
+	require.NotNil(t, e)
+	assert.Equal(t, 10.0, e.Capacity)
+	assert.Equal(t, 0.0, e.Cost)
+
+	e = rg.GetEdge(5, domain.SuperSinkID)
+	require.NotNil(t, e)
+	assert.Equal(t, 9.0, e.Capacity)
+}
+
+func TestToResidualGraphWithTerminals_SingleTerminalUnchanged(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges:    []*commonv1.Edge{{From: 1, To: 2, Capacity: 10}},
+	}
+
+	rg, terminals := resolvedResidualGraph(t, g)
+
+	assert.False(t, terminals.MultiTerminal)
+	assert.Equal(t, 2, rg.NodeCount())
+}
+
+func TestToNodeBalances(t *testing.T) {
+	g := twoWarehouseGraph()
+	rg, terminals := resolvedResidualGraph(t, g)
+
+	// Warehouse 1 ships 8 to point 4 and 2 to point 5; warehouse 2 ships 5 to point 5
+	rg.UpdateFlow(domain.SuperSourceID, 1, 10)
+	rg.UpdateFlow(domain.SuperSourceID, 2, 5)
+	rg.UpdateFlow(1, 3, 10)
+	rg.UpdateFlow(2, 3, 5)
+	rg.UpdateFlow(3, 4, 8)
+	rg.UpdateFlow(3, 5, 7)
+	rg.UpdateFlow(4, domain.SuperSinkID, 8)
+	rg.UpdateFlow(5, domain.SuperSinkID, 7)
+
+	sources, sinks := ToNodeBalances(g, rg, terminals)
+
+	require.Len(t, sources, 2)
+	assert.Equal(t, int64(1), sources[0].NodeId)
+	assert.InDelta(t, 10.0, sources[0].Shipped, 1e-9)
+	assert.InDelta(t, 0.0, sources[0].UnusedSupply, 1e-9)
+	assert.InDelta(t, 5.0, sources[1].Shipped, 1e-9)
+
+	require.Len(t, sinks, 2)
+	assert.Equal(t, int64(4), sinks[0].NodeId)
+	assert.InDelta(t, 8.0, sinks[0].Received, 1e-9)
+	assert.InDelta(t, 0.0, sinks[0].UnmetDemand, 1e-9)
+	assert.Equal(t, int64(5), sinks[1].NodeId)
+	assert.InDelta(t, 7.0, sinks[1].Received, 1e-9)
+	assert.InDelta(t, 2.0, sinks[1].UnmetDemand, 1e-9)
+	assert.Equal(t, 9.0, sinks[1].Demand)
+}
+
+func TestToNodeBalances_SingleTerminal(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}},
+	}
+	rg, terminals := resolvedResidualGraph(t, g)
+
+	sources, sinks := ToNodeBalances(g, rg, terminals)
+
+	assert.Nil(t, sources)
+	assert.Nil(t, sinks)
+}
+
+func TestToFlowEdges_StripsVirtualEdges(t *testing.T) {
+	rg, terminals := resolvedResidualGraph(t, twoWarehouseGraph())
+	rg.UpdateFlow(domain.SuperSourceID, 1, 8)
+	rg.UpdateFlow(1, 3, 8)
+	rg.UpdateFlow(3, 4, 8)
+	rg.UpdateFlow(4, domain.SuperSinkID, 8)
+
+	opts := DefaultFlowEdgeOptions()
+	opts.Terminals = terminals
+	edges := ToFlowEdgesWithOptions(rg, opts)
+
+	require.Len(t, edges, 2)
+	for _, e := range edges {
+		assert.False(t, terminals.IsVirtual(e.From))
+		assert.False(t, terminals.IsVirtual(e.To))
+	}
+
+	// Without terminals every edge is reported
+	assert.Len(t, ToFlowEdges(rg), 4)
+}
+
+func TestToFlowEdges_KeepsNegativeNodeIDsInSingleTerminalGraph(t *testing.T) {
+	// Real nodes -1 and -2 must not be mistaken for virtual super-terminals
+	g := &commonv1.Graph{
+		SourceId: -1,
+		SinkId:   -2,
+		Nodes:    []*commonv1.Node{{Id: -1}, {Id: -2}},
+		Edges:    []*commonv1.Edge{{From: -1, To: -2, Capacity: 5, Cost: 1}},
+	}
+	rg, terminals := resolvedResidualGraph(t, g)
+	rg.UpdateFlow(-1, -2, 5)
+
+	opts := DefaultFlowEdgeOptions()
+	opts.Terminals = terminals
+	edges := ToFlowEdgesWithOptions(rg, opts)
+	require.Len(t, edges, 1)
+	assert.Equal(t, int64(-1), edges[0].From)
+
+	paths := ToPaths([]PathWithFlow{{NodeIDs: []int64{-1, -2}, Flow: 5}}, rg, terminals)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []int64{-1, -2}, paths[0].NodeIds)
+}
+
+func TestToPaths_StripsVirtualNodes(t *testing.T) {
+	rg, terminals := resolvedResidualGraph(t, twoWarehouseGraph())
+
+	paths := ToPaths([]PathWithFlow{
+		{NodeIDs: []int64{domain.SuperSourceID, 1, 3, 4, domain.SuperSinkID}, Flow: 8},
+	}, rg, terminals)
+
+	require.Len(t, paths, 1)
+	assert.Equal(t, []int64{1, 3, 4}, paths[0].NodeIds)
+	assert.Equal(t, 16.0, paths[0].Cost)
+}
+
+// resolvedResidualGraph resolves terminals and builds the residual graph.
+func resolvedResidualGraph(t *testing.T, g *commonv1.Graph) (*graph.ResidualGraph, *Terminals) {
+	t.Helper()
+
+	terminals, err := ResolveTerminals(g)
+	require.NoError(t, err)
+
+	return ToResidualGraphWithTerminals(g, terminals), terminals
+}
@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"math"
+	"sort"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+)
+
+// =============================================================================
+// Transportation Problem Report
+// =============================================================================
+
+// ToDemandShortfalls lists the demand nodes whose demand was not fully met.
+// Returns nil when every demand node received its full demand.
+//
+// Results keep the order of sinks (sorted by node ID for ToNodeBalances output).
+func ToDemandShortfalls(sinks []*commonv1.NodeBalance) []*commonv1.DemandShortfall {
+	var shortfalls []*commonv1.DemandShortfall
+
+	for _, b := range sinks {
+		if b.UnmetDemand <= 0 {
+			continue
+		}
+		shortfalls = append(shortfalls, &commonv1.DemandShortfall{
+			NodeId:    b.NodeId,
+			Demand:    b.Received + b.UnmetDemand,
+			Received:  b.Received,
+			Shortfall: b.UnmetDemand,
+		})
+	}
+
+	return shortfalls
+}
+
+// ToNodePotentials converts dual potentials to protobuf messages.
+//
+// Virtual super-terminals are dropped and the remaining potentials are shifted
+// so that the smallest one is zero: potentials are only defined up to an
+// additive constant, and only their differences are meaningful.
+//
+// Results are sorted by node ID.
+func ToNodePotentials(potentials map[int64]float64, t *Terminals) []*commonv1.NodePotential {
+	if len(potentials) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(potentials))
+	minPotential := math.Inf(1)
+	for id, p := range potentials {
+		if t.IsVirtual(id) {
+			continue
+		}
+		ids = append(ids, id)
+		minPotential = math.Min(minPotential, p)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	result := make([]*commonv1.NodePotential, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, &commonv1.NodePotential{
+			NodeId:    id,
+			Potential: potentials[id] - minPotential,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,83 @@
+package converter
+
+import (
+	"testing"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToDemandShortfalls(t *testing.T) {
+	sinks := []*commonv1.NodeBalance{
+		{NodeId: 4, Demand: 8, Received: 8},
+		{NodeId: 5, Demand: 9, Received: 6, UnmetDemand: 3},
+	}
+
+	shortfalls := ToDemandShortfalls(sinks)
+
+	require.Len(t, shortfalls, 1)
+	assert.Equal(t, int64(5), shortfalls[0].NodeId)
+	assert.InDelta(t, 9.0, shortfalls[0].Demand, 1e-9)
+	assert.InDelta(t, 6.0, shortfalls[0].Received, 1e-9)
+	assert.InDelta(t, 3.0, shortfalls[0].Shortfall, 1e-9)
+
+	assert.Nil(t, ToDemandShortfalls(sinks[:1]))
+}
+
+func TestToNodePotentials(t *testing.T) {
+	terminals := &Terminals{MultiTerminal: true}
+	potentials := map[int64]float64{
+		domain.SuperSourceID: -10,
+		domain.SuperSinkID:   0,
+		3:                    -3,
+		1:                    -4,
+		2:                    -3,
+		4:                    0,
+	}
+
+	result := ToNodePotentials(potentials, terminals)
+
+	require.Len(t, result, 4)
+	want := []struct {
+		id        int64
+		potential float64
+	}{{1, 0}, {2, 1}, {3, 1}, {4, 4}}
+	for i, w := range want {
+		assert.Equal(t, w.id, result[i].NodeId)
+		assert.InDelta(t, w.potential, result[i].Potential, 1e-9)
+	}
+
+	assert.Nil(t, ToNodePotentials(nil, terminals))
+}
+
+func TestResolveBalancedTerminals(t *testing.T) {
+	// Single supply/demand pair still gets super-terminals
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1, Supply: 5}, {Id: 2, Demand: 3}},
+	}
+
+	terminals, err := ResolveBalancedTerminals(g)
+	require.NoError(t, err)
+
+	assert.True(t, terminals.MultiTerminal)
+	assert.Equal(t, domain.SuperSourceID, terminals.Source)
+	assert.Equal(t, domain.SuperSinkID, terminals.Sink)
+	assert.InDelta(t, 5.0, terminals.TotalSupply(), 1e-9)
+	assert.InDelta(t, 3.0, terminals.TotalDemand(), 1e-9)
+}
+
+func TestResolveBalancedTerminals_NoDemand(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1, Supply: 5}, {Id: 2}},
+	}
+
+	_, err := ResolveBalancedTerminals(g)
+	assert.ErrorIs(t, err, ErrNoSupplyDemand)
+}
@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"math"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+)
+
+// =============================================================================
+// Warm Start
+// =============================================================================
+
+// LoadFlow loads the flows of a previous solution into a freshly converted
+// residual graph as regular (cancellable) flow, for algorithms.SolveWarmStart.
+//
+// previous is typically the solved_graph of an earlier SolveResponse: its
+// current_flow values are matched to the edges of protoGraph by stable ID
+// and direction (see EdgeID). An edge without a match falls back to the
+// previous edge between the same nodes, if there is exactly one, so that
+// position-based IDs shifted by a removed edge still match. Flows are
+// clamped to the new capacities; edges that no longer exist are dropped.
+// Only the from → to direction of bidirectional edges is carried over.
+//
+// Returns the number of edges that received flow.
+func LoadFlow(rg *graph.ResidualGraph, protoGraph, previous *commonv1.Graph) int {
+	byRef := make(map[edgeRef]float64, len(previous.GetEdges()))
+	byPair := make(map[edgeRef][]float64)
+	for i, edge := range previous.GetEdges() {
+		if edge.CurrentFlow <= graph.Epsilon {
+			continue
+		}
+		byRef[edgeRef{id: EdgeID(edge, i), from: edge.From, to: edge.To}] = edge.CurrentFlow
+		pair := edgeRef{from: edge.From, to: edge.To}
+		byPair[pair] = append(byPair[pair], edge.CurrentFlow)
+	}
+
+	loaded := 0
+	for i, edge := range protoGraph.GetEdges() {
+		id := EdgeID(edge, i)
+		flow, ok := byRef[edgeRef{id: id, from: edge.From, to: edge.To}]
+		if !ok {
+			if flows := byPair[edgeRef{from: edge.From, to: edge.To}]; len(flows) == 1 {
+				flow = flows[0]
+			}
+		}
+
+		flow = math.Min(flow, edge.Capacity)
+		if flow <= graph.Epsilon {
+			continue
+		}
+
+		if loadEdgeFlow(rg, id, edge.From, edge.To, flow) {
+			loaded++
+		}
+	}
+
+	return loaded
+}
+
+// loadEdgeFlow pushes flow onto the residual edge (or both edges of the lane)
+// that represents the original edge id from → to.
+func loadEdgeFlow(rg *graph.ResidualGraph, id, from, to int64, flow float64) bool {
+	if edge := rg.GetEdge(from, to); edge != nil && !edge.IsReverse && edge.ID == id {
+		rg.UpdateFlow(from, to, flow)
+		return true
+	}
+
+	for _, edge := range rg.GetNeighborsList(from) {
+		if edge.IsReverse || edge.ID != id {
+			continue
+		}
+		if lane, ok := rg.Lane(edge.To); ok && lane.To == to {
+			rg.UpdateFlow(from, edge.To, flow)
+			rg.UpdateFlow(edge.To, to, flow)
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,89 @@
+package converter
+
+import (
+	"testing"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	"logistics/services/solver-svc/internal/graph"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFlow(t *testing.T) {
+	previous := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, CurrentFlow: 8, Id: 10},
+			{From: 1, To: 2, Capacity: 5, CurrentFlow: 5, Id: 20},
+			{From: 2, To: 3, Capacity: 20, CurrentFlow: 13, Id: 30},
+		},
+	}
+	// Edge 20 was cut to 2, edge 30 widened
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    previous.Nodes,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, Id: 10},
+			{From: 1, To: 2, Capacity: 2, Id: 20},
+			{From: 2, To: 3, Capacity: 30, Id: 30},
+		},
+	}
+	rg := ToResidualGraph(g)
+
+	loaded := LoadFlow(rg, g, previous)
+
+	assert.Equal(t, 3, loaded)
+	assert.InDelta(t, 8.0, rg.GetEdge(1, 2).Flow, 1e-9)
+	require.True(t, rg.IsLaneNode(graph.LaneNodeBase))
+	assert.InDelta(t, 2.0, rg.GetEdge(1, graph.LaneNodeBase).Flow, 1e-9, "clamped to the new capacity")
+	assert.InDelta(t, 2.0, rg.GetEdge(graph.LaneNodeBase, 2).Flow, 1e-9)
+	assert.InDelta(t, 13.0, rg.GetEdge(2, 3).Flow, 1e-9)
+}
+
+func TestLoadFlow_PositionalIDs(t *testing.T) {
+	previous := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 3, Capacity: 4, CurrentFlow: 4},
+			{From: 1, To: 2, Capacity: 6, CurrentFlow: 6},
+			{From: 2, To: 3, Capacity: 6, CurrentFlow: 6},
+		},
+	}
+	// The first edge was removed, shifting the positional IDs of the others
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   3,
+		Nodes:    previous.Nodes,
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 6},
+			{From: 2, To: 3, Capacity: 6},
+		},
+	}
+	rg := ToResidualGraph(g)
+
+	loaded := LoadFlow(rg, g, previous)
+
+	assert.Equal(t, 2, loaded)
+	assert.InDelta(t, 6.0, rg.GetEdge(1, 2).Flow, 1e-9)
+	assert.InDelta(t, 6.0, rg.GetEdge(2, 3).Flow, 1e-9)
+	assert.Nil(t, rg.GetEdge(1, 3))
+}
+
+func TestLoadFlow_NoPrevious(t *testing.T) {
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   2,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}},
+		Edges:    []*commonv1.Edge{{From: 1, To: 2, Capacity: 6}},
+	}
+	rg := ToResidualGraph(g)
+
+	assert.Zero(t, LoadFlow(rg, g, nil))
+	assert.Zero(t, rg.GetTotalFlow(1))
+}
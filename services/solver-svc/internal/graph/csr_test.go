@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func csrTestGraph() *ResidualGraph {
+	g := NewResidualGraph()
+	g.AddEdgeWithReverse(1, 2, 10, 1)
+	g.AddEdgeWithReverse(1, 3, 5, 2)
+	g.AddEdgeWithReverse(2, 3, 15, 1)
+	g.AddEdgeWithReverse(3, 2, 4, 3) // antiparallel to 2 → 3
+	g.AddEdgeWithReverse(2, 4, 10, 2)
+	g.AddEdgeWithReverse(3, 4, 10, 1)
+	return g
+}
+
+func TestNewCSRGraph_Layout(t *testing.T) {
+	g := csrTestGraph()
+
+	c := NewCSRGraph(g)
+
+	assert.Equal(t, []int64{1, 2, 3, 4}, c.IDs)
+	assert.Equal(t, 4, c.NodeCount())
+	assert.Equal(t, g.EdgeCount(), c.ArcCount())
+
+	for u := range c.IDs {
+		for a := c.Start[u]; a < c.Start[u+1]; a++ {
+			p := c.Pair[a]
+			assert.Equal(t, a, c.Pair[p], "pairing must be symmetric")
+			assert.Equal(t, int32(u), c.Head[p])
+
+			edge := g.GetEdge(c.IDs[u], c.IDs[c.Head[a]])
+			require.NotNil(t, edge)
+			assert.Equal(t, edge.Capacity, c.Capacity[a])
+			assert.Equal(t, edge.Cost, c.Cost[a])
+			assert.Equal(t, edge.IsReverse, c.IsReverse(a))
+		}
+	}
+
+	idx, ok := c.Index(3)
+	require.True(t, ok)
+	assert.Equal(t, int64(3), c.IDs[idx])
+	_, ok = c.Index(42)
+	assert.False(t, ok)
+}
+
+func TestNewCSRGraph_AddsMissingReverseEdges(t *testing.T) {
+	g := NewResidualGraph()
+	g.AddEdge(1, 2, 10, 3)
+
+	c := NewCSRGraph(g)
+
+	reverse := g.GetEdge(2, 1)
+	require.NotNil(t, reverse)
+	assert.True(t, reverse.IsReverse)
+	assert.Equal(t, 2, c.ArcCount())
+	assert.Equal(t, -3.0, c.Cost[c.Pair[0]])
+}
+
+func TestCSRGraph_WriteBackMatchesUpdateFlow(t *testing.T) {
+	g := csrTestGraph()
+	want := g.Clone()
+
+	// 1 → 2 → 3 → 4, then cancel part of it through 3 → 2 and 2 → 4
+	pushes := []struct {
+		from, to int64
+		flow     float64
+	}{
+		{1, 2, 8}, {2, 3, 8}, {3, 4, 8},
+		{1, 3, 5}, {3, 2, 6}, {2, 4, 6},
+	}
+
+	c := NewCSRGraph(g)
+	for _, p := range pushes {
+		want.UpdateFlow(p.from, p.to, p.flow)
+
+		u, _ := c.Index(p.from)
+		v, _ := c.Index(p.to)
+		for a := c.Start[u]; a < c.Start[u+1]; a++ {
+			if c.Head[a] == v {
+				c.Push(a, p.flow)
+			}
+		}
+	}
+	c.WriteBack(g)
+
+	for _, from := range want.GetSortedNodes() {
+		for _, expected := range want.GetNeighborsList(from) {
+			actual := g.GetEdge(from, expected.To)
+			require.NotNil(t, actual)
+			assert.InDelta(t, expected.Capacity, actual.Capacity, 1e-9, "%d -> %d", from, expected.To)
+			assert.InDelta(t, expected.Flow, actual.Flow, 1e-9, "%d -> %d", from, expected.To)
+		}
+	}
+	assert.InDelta(t, want.GetTotalFlow(1), g.GetTotalFlow(1), 1e-9)
+	assert.InDelta(t, want.GetTotalCost(), g.GetTotalCost(), 1e-9)
+}
+
+func TestCSRGraph_NetPushedTracksFlow(t *testing.T) {
+	g := csrTestGraph()
+	g.SetLowerBound(1, 2, 2)
+
+	c := NewCSRGraph(g)
+	s, _ := c.Index(1)
+	a := c.Start[s] // 1 → 2
+
+	require.False(t, c.IsReverse(a))
+	assert.Zero(t, c.NetPushed(a), "lower-bound flow is not pushed by the run")
+
+	c.Push(a, 7)
+	assert.InDelta(t, 7.0, c.NetPushed(a), 1e-9)
+
+	c.Push(c.Pair[a], 3)
+	assert.InDelta(t, 4.0, c.NetPushed(a), 1e-9)
+	assert.InDelta(t, -4.0, c.NetPushed(c.Pair[a]), 1e-9)
+}
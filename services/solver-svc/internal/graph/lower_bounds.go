@@ -0,0 +1,273 @@
+package graph
+
+import (
+	"sort"
+)
+
+// =============================================================================
+// Edge Lower Bounds
+// =============================================================================
+//
+// Lower bounds (minimum flow per edge) are handled with the standard
+// circulation-with-demands reduction:
+//
+//  1. Every edge (u, v) with lower bound l is pre-loaded with l units of flow.
+//     Its residual capacity becomes c - l, and the l units cannot be cancelled
+//     through the reverse edge. Node v gains an imbalance of +l and u of -l.
+//  2. An auxiliary source S' feeds every node with positive imbalance, and
+//     every node with negative imbalance drains into an auxiliary sink T'.
+//     A circulation edge sink → C → source lets flow return from sink to source.
+//  3. A maximum S'→T' flow that saturates every S' edge yields a flow meeting
+//     all lower bounds. Otherwise the lower bounds are infeasible.
+//  4. The auxiliary nodes are removed. The s→t flow that went through C is
+//     the base flow value, and any max-flow or min-cost algorithm can then
+//     augment further from source to sink.
+//
+// The pre-loaded flow is part of Edge.Flow and OriginalCapacity - Capacity,
+// so flow totals, costs and converted edges include it without special cases.
+
+// LowerBoundReduction records the auxiliary structure added by ApplyLowerBounds.
+type LowerBoundReduction struct {
+	// AuxSource is the auxiliary source S' feeding nodes with positive imbalance.
+	AuxSource int64
+
+	// AuxSink is the auxiliary sink T' draining nodes with negative imbalance.
+	AuxSink int64
+
+	// Required is the flow S'→T' must reach for the lower bounds to be feasible.
+	Required float64
+
+	// circulation is the node C on the sink → C → source circulation path.
+	circulation int64
+
+	// source is the original source the circulation path returns flow to.
+	source int64
+
+	// imbalance is the net pre-loaded inflow (positive) or outflow (negative) per node.
+	imbalance map[int64]float64
+}
+
+// LowerBoundDeficit describes a node whose lower-bound imbalance could not
+// be routed through the network.
+type LowerBoundDeficit struct {
+	// Node is the node ID.
+	Node int64
+
+	// Imbalance is the net lower-bound flow at the node: positive when the
+	// minimum inflow exceeds the minimum outflow (the surplus must leave the
+	// node), negative when the minimum outflow exceeds the minimum inflow.
+	Imbalance float64
+
+	// Unresolved is the part of |Imbalance| that could not be routed.
+	Unresolved float64
+}
+
+// SetLowerBound sets the minimum flow of the forward edge from → to.
+// For parallel edges merged into one, lower bounds accumulate like capacities.
+// Does nothing if the edge does not exist or is a reverse edge.
+func (rg *ResidualGraph) SetLowerBound(from, to int64, lower float64) {
+	if edge := rg.GetEdge(from, to); edge != nil && !edge.IsReverse {
+		edge.LowerBound += lower
+	}
+}
+
+// HasLowerBounds reports whether any forward edge has a positive lower bound.
+func (rg *ResidualGraph) HasLowerBounds() bool {
+	for _, edges := range rg.EdgesList {
+		for _, edge := range edges {
+			if !edge.IsReverse && edge.LowerBound > Epsilon {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ApplyLowerBounds pre-loads every lower bound and adds the auxiliary source,
+// sink and circulation path described above. The graph must not carry flow yet.
+//
+// After running a max-flow (or min-cost flow) from AuxSource to AuxSink, call
+// Deficits to check feasibility and Remove to restore the source → sink problem.
+func (rg *ResidualGraph) ApplyLowerBounds(source, sink int64) *LowerBoundReduction {
+	imbalance := make(map[int64]float64)
+	for _, from := range rg.GetSortedNodes() {
+		for _, edge := range rg.EdgesList[from] {
+			if edge.IsReverse || edge.LowerBound <= Epsilon {
+				continue
+			}
+			edge.Capacity -= edge.LowerBound
+			edge.Flow += edge.LowerBound
+			imbalance[edge.To] += edge.LowerBound
+			imbalance[from] -= edge.LowerBound
+		}
+	}
+
+	return rg.applyImbalances(source, sink, imbalance)
+}
+
+// ApplyFlowRepair turns the flow currently loaded in the graph into a
+// feasible source → sink flow problem with the same reduction as
+// ApplyLowerBounds. The loaded flow may violate conservation, e.g. a previous
+// solution clamped to reduced capacities: every node's imbalance (including
+// source and sink) is routed through the auxiliary terminals, and unlike
+// lower bounds the loaded flow stays cancellable through the reverse edges.
+//
+// A maximum AuxSource → AuxSink flow always saturates the auxiliary edges
+// (cancelling all loaded flow is one solution), so Deficits only reports
+// numerical leftovers.
+func (rg *ResidualGraph) ApplyFlowRepair(source, sink int64) *LowerBoundReduction {
+	imbalance := make(map[int64]float64)
+	for _, from := range rg.GetSortedNodes() {
+		for _, edge := range rg.EdgesList[from] {
+			if edge.IsReverse || edge.Flow <= Epsilon {
+				continue
+			}
+			imbalance[edge.To] += edge.Flow
+			imbalance[from] -= edge.Flow
+		}
+	}
+
+	return rg.applyImbalances(source, sink, imbalance)
+}
+
+// applyImbalances adds the auxiliary source and sink for the given node
+// imbalances and the sink → C → source circulation path.
+func (rg *ResidualGraph) applyImbalances(source, sink int64, imbalance map[int64]float64) *LowerBoundReduction {
+	nodes := rg.GetSortedNodes()
+	minID := int64(0)
+	if len(nodes) > 0 {
+		minID = nodes[0]
+	}
+
+	r := &LowerBoundReduction{
+		AuxSource:   minID - 1,
+		AuxSink:     minID - 2,
+		circulation: minID - 3,
+		source:      source,
+		imbalance:   imbalance,
+	}
+
+	// Circulation capacity only has to exceed any feasible source → sink flow
+	bound := 0.0
+	for _, from := range nodes {
+		for _, edge := range rg.EdgesList[from] {
+			if !edge.IsReverse {
+				bound += edge.OriginalCapacity
+			}
+		}
+	}
+
+	rg.AddEdgeWithReverse(sink, r.circulation, bound, 0)
+	rg.AddEdgeWithReverse(r.circulation, source, bound, 0)
+
+	for _, id := range sortedImbalanceKeys(r.imbalance) {
+		switch b := r.imbalance[id]; {
+		case b > Epsilon:
+			rg.AddEdgeWithReverse(r.AuxSource, id, b, 0)
+			r.Required += b
+		case b < -Epsilon:
+			rg.AddEdgeWithReverse(id, r.AuxSink, -b, 0)
+		}
+	}
+	rg.AddNode(r.AuxSource)
+	rg.AddNode(r.AuxSink)
+
+	return r
+}
+
+// Deficits returns the nodes whose imbalance was not fully routed by the
+// AuxSource → AuxSink flow, sorted by node ID. An empty result means every
+// lower bound can be met.
+func (r *LowerBoundReduction) Deficits(rg *ResidualGraph, epsilon float64) []LowerBoundDeficit {
+	var deficits []LowerBoundDeficit
+
+	for _, id := range sortedImbalanceKeys(r.imbalance) {
+		b := r.imbalance[id]
+
+		var edge *ResidualEdge
+		switch {
+		case b > epsilon:
+			edge = rg.GetEdge(r.AuxSource, id)
+		case b < -epsilon:
+			edge = rg.GetEdge(id, r.AuxSink)
+		default:
+			continue
+		}
+
+		if edge != nil && edge.Capacity > epsilon {
+			deficits = append(deficits, LowerBoundDeficit{
+				Node:       id,
+				Imbalance:  b,
+				Unresolved: edge.Capacity,
+			})
+		}
+	}
+
+	return deficits
+}
+
+// Remove deletes the auxiliary nodes and returns the source → sink flow that
+// went through the circulation path (the base flow value of the problem).
+func (r *LowerBoundReduction) Remove(rg *ResidualGraph) float64 {
+	baseFlow := rg.GetFlowOnEdge(r.circulation, r.source)
+
+	rg.RemoveNode(r.AuxSource)
+	rg.RemoveNode(r.AuxSink)
+	rg.RemoveNode(r.circulation)
+
+	return baseFlow
+}
+
+// RemoveNode deletes a node together with all edges to and from it.
+//
+// Edge indexes of the remaining edges are renumbered, so current-arc state
+// kept by algorithms must not outlive this call.
+func (rg *ResidualGraph) RemoveNode(id int64) {
+	if !rg.Nodes[id] {
+		return
+	}
+
+	for _, edge := range rg.EdgesList[id] {
+		delete(rg.ReverseEdges[edge.To], id)
+	}
+	for from := range rg.ReverseEdges[id] {
+		rg.removeEdgeFromList(from, id)
+	}
+
+	delete(rg.Nodes, id)
+	delete(rg.lanes, id)
+	delete(rg.Edges, id)
+	delete(rg.EdgesList, id)
+	delete(rg.ReverseEdges, id)
+
+	rg.invalidateIncomingCache()
+	rg.markSortedNodesDirty()
+}
+
+// removeEdgeFromList removes the edge from → to from EdgesList[from] and
+// the Edges map, renumbering the indexes of the edges after it.
+func (rg *ResidualGraph) removeEdgeFromList(from, to int64) {
+	list := rg.EdgesList[from]
+	for i, edge := range list {
+		if edge.To != to {
+			continue
+		}
+		list = append(list[:i], list[i+1:]...)
+		for j := i; j < len(list); j++ {
+			list[j].Index = j
+		}
+		rg.EdgesList[from] = list
+		delete(rg.Edges[from], to)
+		return
+	}
+}
+
+// sortedImbalanceKeys returns the keys of a node-indexed map in ascending order.
+func sortedImbalanceKeys(m map[int64]float64) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResidualGraph_SetLowerBound(t *testing.T) {
+	rg := NewResidualGraph()
+	rg.AddEdgeWithReverse(1, 2, 10, 0)
+
+	assert.False(t, rg.HasLowerBounds())
+
+	rg.SetLowerBound(1, 2, 3)
+	rg.SetLowerBound(2, 1, 5) // reverse edge: ignored
+	rg.SetLowerBound(1, 3, 5) // missing edge: ignored
+
+	assert.True(t, rg.HasLowerBounds())
+	assert.Equal(t, 3.0, rg.GetEdge(1, 2).LowerBound)
+	assert.Equal(t, 0.0, rg.GetEdge(2, 1).LowerBound)
+	assert.Equal(t, 3.0, rg.Clone().GetEdge(1, 2).LowerBound)
+}
+
+func TestResidualGraph_ApplyLowerBounds(t *testing.T) {
+	rg := NewResidualGraph()
+	rg.AddEdgeWithReverse(1, 2, 10, 0)
+	rg.AddEdgeWithReverse(2, 3, 10, 0)
+	rg.SetLowerBound(1, 2, 4)
+
+	r := rg.ApplyLowerBounds(1, 3)
+
+	assert.Equal(t, int64(0), r.AuxSource)
+	assert.Equal(t, int64(-1), r.AuxSink)
+	assert.Equal(t, 4.0, r.Required)
+
+	edge := rg.GetEdge(1, 2)
+	assert.Equal(t, 6.0, edge.Capacity)
+	assert.Equal(t, 4.0, edge.Flow)
+	assert.Equal(t, 0.0, rg.GetEdge(2, 1).Capacity, "pre-loaded flow must not be cancellable")
+
+	assert.Equal(t, 4.0, rg.GetEdge(r.AuxSource, 2).Capacity)
+	assert.Equal(t, 4.0, rg.GetEdge(1, r.AuxSink).Capacity)
+	require.NotNil(t, rg.GetEdge(3, r.circulation))
+	require.NotNil(t, rg.GetEdge(r.circulation, 1))
+
+	// Nothing routed yet: both ends of the bounded edge are in deficit
+	deficits := r.Deficits(rg, Epsilon)
+	assert.Equal(t, []LowerBoundDeficit{
+		{Node: 1, Imbalance: -4, Unresolved: 4},
+		{Node: 2, Imbalance: 4, Unresolved: 4},
+	}, deficits)
+
+	// Route S' -> 2 -> 3 -> C -> 1 -> T' by hand
+	path := []int64{r.AuxSource, 2, 3, r.circulation, 1, r.AuxSink}
+	for i := 0; i+1 < len(path); i++ {
+		rg.UpdateFlow(path[i], path[i+1], 4)
+	}
+
+	assert.Empty(t, r.Deficits(rg, Epsilon))
+	assert.Equal(t, 4.0, r.Remove(rg))
+
+	assert.ElementsMatch(t, []int64{1, 2, 3}, rg.GetNodes())
+	assert.Nil(t, rg.GetEdge(3, r.circulation))
+	assert.Nil(t, rg.GetEdge(1, r.AuxSink))
+	assert.Equal(t, 4.0, rg.GetTotalFlow(1))
+}
+
+func TestResidualGraph_RemoveNode(t *testing.T) {
+	rg := NewResidualGraph()
+	rg.AddEdgeWithReverse(1, 2, 10, 0)
+	rg.AddEdgeWithReverse(1, 3, 10, 0)
+	rg.AddEdgeWithReverse(2, 3, 10, 0)
+
+	rg.RemoveNode(2)
+	rg.RemoveNode(42) // unknown: no-op
+
+	assert.ElementsMatch(t, []int64{1, 3}, rg.GetNodes())
+	assert.Nil(t, rg.GetEdge(1, 2))
+	assert.Nil(t, rg.GetEdge(3, 2))
+	assert.Empty(t, rg.GetIncomingEdgesList(2))
+
+	list := rg.GetNeighborsList(1)
+	require.Len(t, list, 1)
+	assert.Equal(t, int64(3), list[0].To)
+	assert.Equal(t, 0, list[0].Index)
+}
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"math"
+)
+
+// =============================================================================
+// Parallel Edges
+// =============================================================================
+//
+// Edges and ReverseEdges hold one edge per (from, to) pair, which every
+// algorithm relies on for O(1) lookup and for node-sequence paths. Parallel
+// edges (e.g. a truck lane and a rail lane between the same two nodes, with
+// different costs) are therefore stored as lanes: the first edge between a
+// pair is a plain edge, and every further one is split by a lane node:
+//
+//	from ──(capacity, cost)──▶ lane ──(capacity, 0)──▶ to
+//
+// Both lane edges carry the stable ID of the original edge. Flow conservation
+// at the lane node keeps their flows equal, so every max-flow and min-cost
+// algorithm handles parallel edges exactly, without special cases. Lane nodes
+// are internal: use IsLaneNode/Lane and StripLaneNodes when reporting nodes
+// and paths.
+
+// LaneNodeBase is the ID of the first lane node. Further lane nodes count
+// down from it, far away from any realistic node ID.
+const LaneNodeBase int64 = math.MinInt64 / 2
+
+// Lane describes the parallel edge a lane node belongs to.
+type Lane struct {
+	// From is the source node of the parallel edge.
+	From int64
+
+	// To is the destination node of the parallel edge.
+	To int64
+
+	// EdgeID is the stable ID of the parallel edge.
+	EdgeID int64
+}
+
+// AddEdgeWithID adds an edge with a stable ID, together with its backward edge,
+// and returns the forward edge that carries the edge's cost (the only edge,
+// or the first edge of a lane).
+//
+// If a forward edge from → to already exists, the new edge becomes a separate
+// lane through a new lane node instead of being merged into it.
+func (rg *ResidualGraph) AddEdgeWithID(id, from, to int64, capacity, cost float64) *ResidualEdge {
+	if existing := rg.GetEdge(from, to); existing != nil && !existing.IsReverse {
+		return rg.addLane(id, from, to, capacity, cost)
+	}
+
+	rg.AddEdge(from, to, capacity, cost)
+	rg.AddReverseEdge(to, from, cost)
+
+	edge := rg.GetEdge(from, to)
+	edge.ID = id
+	return edge
+}
+
+// addLane adds a parallel edge from → to as from → lane → to.
+func (rg *ResidualGraph) addLane(id, from, to int64, capacity, cost float64) *ResidualEdge {
+	if rg.lanes == nil {
+		rg.lanes = make(map[int64]Lane)
+	}
+
+	lane := LaneNodeBase - rg.laneSeq
+	rg.laneSeq++
+	rg.lanes[lane] = Lane{From: from, To: to, EdgeID: id}
+
+	head := rg.AddEdgeWithID(id, from, lane, capacity, cost)
+	rg.AddEdgeWithID(id, lane, to, capacity, 0)
+
+	return head
+}
+
+// IsLaneNode reports whether id is an internal lane node of a parallel edge.
+func (rg *ResidualGraph) IsLaneNode(id int64) bool {
+	_, ok := rg.lanes[id]
+	return ok
+}
+
+// Lane returns the parallel edge a lane node belongs to.
+func (rg *ResidualGraph) Lane(id int64) (Lane, bool) {
+	lane, ok := rg.lanes[id]
+	return lane, ok
+}
+
+// HasLanes reports whether the graph has any parallel edges stored as lanes.
+func (rg *ResidualGraph) HasLanes() bool {
+	return len(rg.lanes) > 0
+}
+
+// StripLaneNodes removes lane nodes from a path, so that it only lists
+// original nodes. Returns the input slice unchanged if it has no lane nodes.
+func (rg *ResidualGraph) StripLaneNodes(path []int64) []int64 {
+	if len(rg.lanes) == 0 {
+		return path
+	}
+
+	result := make([]int64, 0, len(path))
+	for _, id := range path {
+		if !rg.IsLaneNode(id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// copyLanesTo copies lane bookkeeping to a clone of the graph.
+func (rg *ResidualGraph) copyLanesTo(clone *ResidualGraph) {
+	clone.laneSeq = rg.laneSeq
+	if len(rg.lanes) == 0 {
+		clear(clone.lanes)
+		return
+	}
+
+	if clone.lanes == nil {
+		clone.lanes = make(map[int64]Lane, len(rg.lanes))
+	}
+	for id, lane := range rg.lanes {
+		clone.lanes[id] = lane
+	}
+}
@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResidualGraph_AddEdgeWithID(t *testing.T) {
+	g := NewResidualGraph()
+
+	edge := g.AddEdgeWithID(7, 1, 2, 10, 3)
+
+	require.Same(t, edge, g.GetEdge(1, 2))
+	assert.Equal(t, int64(7), edge.ID)
+	assert.True(t, g.GetEdge(2, 1).IsReverse)
+	assert.False(t, g.HasLanes())
+}
+
+func TestResidualGraph_ParallelEdgesStaySeparate(t *testing.T) {
+	g := NewResidualGraph()
+
+	truck := g.AddEdgeWithID(1, 1, 2, 10, 5)
+	rail := g.AddEdgeWithID(2, 1, 2, 7, 3)
+
+	// The first edge is untouched: capacities and costs are not merged
+	assert.Equal(t, 10.0, truck.Capacity)
+	assert.Equal(t, 5.0, truck.Cost)
+
+	// The second edge is a lane 1 -> lane -> 2
+	require.True(t, g.HasLanes())
+	lane := LaneNodeBase
+	assert.True(t, g.IsLaneNode(lane))
+	assert.False(t, g.IsLaneNode(1))
+	got, ok := g.Lane(lane)
+	require.True(t, ok)
+	assert.Equal(t, Lane{From: 1, To: 2, EdgeID: 2}, got)
+
+	require.Same(t, rail, g.GetEdge(1, lane))
+	assert.Equal(t, 7.0, rail.Capacity)
+	assert.Equal(t, 3.0, rail.Cost)
+	assert.Equal(t, int64(2), rail.ID)
+
+	tail := g.GetEdge(lane, 2)
+	require.NotNil(t, tail)
+	assert.Equal(t, 7.0, tail.Capacity)
+	assert.Equal(t, 0.0, tail.Cost)
+	assert.Equal(t, int64(2), tail.ID)
+
+	// A third parallel edge gets its own lane node
+	g.AddEdgeWithReverse(1, 2, 1, 1)
+	assert.True(t, g.IsLaneNode(LaneNodeBase-1))
+}
+
+func TestResidualGraph_StripLaneNodes(t *testing.T) {
+	g := NewResidualGraph()
+	path := []int64{1, 2, 3}
+	assert.Equal(t, path, g.StripLaneNodes(path))
+
+	g.AddEdgeWithReverse(1, 2, 10, 0)
+	g.AddEdgeWithReverse(1, 2, 10, 0)
+
+	assert.Equal(t, []int64{1, 2, 3}, g.StripLaneNodes([]int64{1, LaneNodeBase, 2, 3}))
+}
+
+func TestResidualGraph_CloneKeepsLanes(t *testing.T) {
+	g := NewResidualGraph()
+	g.AddEdgeWithID(1, 1, 2, 10, 5)
+	g.AddEdgeWithID(2, 1, 2, 7, 3)
+
+	clone := g.Clone()
+	assert.True(t, clone.IsLaneNode(LaneNodeBase))
+	assert.Equal(t, int64(2), clone.GetEdge(1, LaneNodeBase).ID)
+
+	// New lanes in the clone do not reuse lane node IDs
+	clone.AddEdgeWithID(3, 1, 2, 1, 1)
+	assert.True(t, clone.IsLaneNode(LaneNodeBase-1))
+	assert.False(t, g.IsLaneNode(LaneNodeBase-1))
+
+	pool := GetPool()
+	pooled := g.CloneToPooled(pool)
+	assert.True(t, pooled.IsLaneNode(LaneNodeBase))
+	pool.ReleaseGraph(pooled)
+
+	reused := pool.AcquireGraph()
+	assert.False(t, reused.HasLanes())
+	pool.ReleaseGraph(reused)
+}
@@ -63,6 +63,15 @@ type ResidualEdge struct {
 	// when computing statistics.
 	IsReverse bool
 
+	// LowerBound is the minimum flow the edge must carry (forward edges only).
+	// See ApplyLowerBounds.
+	LowerBound float64
+
+	// ID is the stable ID of the original edge this edge belongs to
+	// (forward edges only; 0 when unset). Both edges of a parallel lane
+	// carry the ID of the lane. See AddEdgeWithID.
+	ID int64
+
 	// Index is the position of this edge in the EdgesList slice.
 	// Used for efficient edge lookup and current-arc optimization.
 	Index int
@@ -173,6 +182,11 @@ type ResidualGraph struct {
 	// Invalidated when nodes are added.
 	sortedNodes      []int64
 	sortedNodesDirty bool
+
+	// lanes maps lane nodes of parallel edges to the edge they represent.
+	// laneSeq numbers lane nodes, so IDs stay unique after removals.
+	lanes   map[int64]Lane
+	laneSeq int64
 }
 
 // NewResidualGraph creates a new empty residual graph.
@@ -217,6 +231,8 @@ func (rg *ResidualGraph) Clear() {
 		delete(rg.IncomingEdgesListCache, k)
 	}
 	rg.incomingCacheDirty = true
+	clear(rg.lanes)
+	rg.laneSeq = 0
 
 	rg.sortedNodesMu.Lock()
 	rg.sortedNodes = rg.sortedNodes[:0]
@@ -261,9 +277,10 @@ func (rg *ResidualGraph) invalidateIncomingCache() {
 //
 // If an edge already exists between the same nodes:
 //   - If the existing edge is a reverse edge, it's converted to a forward edge
-//   - Otherwise, the capacity is accumulated
+//   - Otherwise, the capacity is accumulated (the edges are merged)
 //
-// For most use cases, prefer AddEdgeWithReverse() which handles both directions.
+// For most use cases, prefer AddEdgeWithReverse() which handles both directions
+// and keeps parallel edges apart.
 //
 // Parameters:
 //   - from: Source node ID
@@ -366,6 +383,9 @@ func (rg *ResidualGraph) addReverseIndex(from, to int64, edge *ResidualEdge) {
 //   - Forward edge (from → to) with the specified capacity and cost
 //   - Backward edge (to → from) with 0 capacity and negative cost
 //
+// If a forward edge from → to already exists, the new edge is added as a
+// parallel lane with its own capacity, cost and flow (see AddEdgeWithID).
+//
 // Parameters:
 //   - from: Source node ID
 //   - to: Destination node ID
@@ -378,8 +398,7 @@ func (rg *ResidualGraph) addReverseIndex(from, to int64, edge *ResidualEdge) {
 //	// Creates edge 1→2 with capacity=10, cost=1.5
 //	// Creates edge 2→1 with capacity=0, cost=-1.5
 func (rg *ResidualGraph) AddEdgeWithReverse(from, to int64, capacity, cost float64) {
-	rg.AddEdge(from, to, capacity, cost)
-	rg.AddReverseEdge(to, from, cost)
+	rg.AddEdgeWithID(0, from, to, capacity, cost)
 }
 
 // =============================================================================
@@ -516,6 +535,14 @@ func (rg *ResidualGraph) EdgeCount() int {
 //   - Decreases the forward edge capacity by 'flow'
 //   - Increases the forward edge flow by 'flow'
 //   - Increases the backward edge capacity by 'flow'
+//   - When pushing along a reverse edge, decreases the flow of the
+//     corresponding forward edge (flow cancellation)
+//
+// Flow cancellation keeps Edge.Flow equal to the net flow on every forward
+// edge, so GetTotalFlow and GetTotalCost stay correct for algorithms that
+// augment along reverse edges. Antiparallel real edges (u→v and v→u, both
+// forward) serve as each other's residual edge and track their flow
+// independently: pushing along one never cancels the other's Flow.
 //
 // The backward edge is created if it doesn't exist.
 //
@@ -533,6 +560,10 @@ func (rg *ResidualGraph) UpdateFlow(from, to int64, flow float64) {
 	// Update or create backward edge
 	if backEdge := rg.GetEdge(to, from); backEdge != nil {
 		backEdge.Capacity += flow
+		// Pushing along a reverse edge cancels flow on its forward edge
+		if edge := rg.GetEdge(from, to); edge != nil && edge.IsReverse && !backEdge.IsReverse {
+			backEdge.Flow -= flow
+		}
 	} else {
 		// Create backward edge if it doesn't exist
 		if rg.Edges[to] == nil {
@@ -628,6 +659,8 @@ func (rg *ResidualGraph) Clone() *ResidualGraph {
 				Flow:             edge.Flow,
 				OriginalCapacity: edge.OriginalCapacity,
 				IsReverse:        edge.IsReverse,
+				LowerBound:       edge.LowerBound,
+				ID:               edge.ID,
 				Index:            edge.Index,
 			}
 			clone.Edges[from][edge.To] = clonedEdge
@@ -636,6 +669,7 @@ func (rg *ResidualGraph) Clone() *ResidualGraph {
 		}
 	}
 
+	rg.copyLanesTo(clone)
 	clone.sortedNodesDirty = true
 	return clone
 }
@@ -671,6 +705,8 @@ func (rg *ResidualGraph) CloneToPooled(pool *GraphPool) *ResidualGraph {
 				Flow:             edge.Flow,
 				OriginalCapacity: edge.OriginalCapacity,
 				IsReverse:        edge.IsReverse,
+				LowerBound:       edge.LowerBound,
+				ID:               edge.ID,
 				Index:            len(clone.EdgesList[from]),
 			}
 			clone.Edges[from][edge.To] = clonedEdge
@@ -679,6 +715,7 @@ func (rg *ResidualGraph) CloneToPooled(pool *GraphPool) *ResidualGraph {
 		}
 	}
 
+	rg.copyLanesTo(clone)
 	clone.sortedNodesDirty = true
 	return clone
 }
@@ -361,6 +361,42 @@ func TestResidualGraph_UpdateFlow(t *testing.T) {
 	}
 }
 
+func TestResidualGraph_UpdateFlowCancelsForwardFlow(t *testing.T) {
+	rg := NewResidualGraph()
+	rg.AddEdgeWithReverse(1, 2, 10.0, 1.0)
+
+	rg.UpdateFlow(1, 2, 6.0)
+	rg.UpdateFlow(2, 1, 4.0)
+
+	edge := rg.GetEdge(1, 2)
+	assert.Equal(t, 2.0, edge.Flow)
+	assert.Equal(t, 8.0, edge.Capacity)
+	assert.Equal(t, 2.0, rg.GetTotalFlow(1))
+	assert.Equal(t, 2.0, rg.GetTotalCost())
+}
+
+func TestResidualGraph_UpdateFlowAntiparallelEdges(t *testing.T) {
+	// 1->2 and 2->1 are both real edges sharing each other as residual
+	// reverse; pushing along one must not cancel flow recorded on the other.
+	rg := NewResidualGraph()
+	rg.AddEdgeWithReverse(1, 2, 10.0, 1.0)
+	rg.AddEdgeWithReverse(2, 1, 5.0, 2.0)
+
+	forward := rg.GetEdge(1, 2)
+	backward := rg.GetEdge(2, 1)
+	require.False(t, forward.IsReverse)
+	require.False(t, backward.IsReverse)
+
+	rg.UpdateFlow(1, 2, 6.0)
+	rg.UpdateFlow(2, 1, 3.0)
+
+	assert.Equal(t, 6.0, forward.Flow)
+	assert.Equal(t, 3.0, backward.Flow)
+	assert.Equal(t, 7.0, forward.Capacity)
+	assert.Equal(t, 8.0, backward.Capacity)
+	assert.Equal(t, 6.0*1.0+3.0*2.0, rg.GetTotalCost())
+}
+
 func TestResidualGraph_UpdateFlowCreatesReverseEdge(t *testing.T) {
 	rg := NewResidualGraph()
 	rg.AddEdge(1, 2, 10.0, 5.0)
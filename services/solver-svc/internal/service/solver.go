@@ -22,7 +22,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -32,6 +34,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	commonv1 "logistics/gen/go/logistics/common/v1"
@@ -58,6 +61,10 @@ const (
 	// MaxGraphEdges is the maximum number of edges allowed in a graph.
 	MaxGraphEdges = 10_000_000
 
+	// MaxCommodities is the maximum number of commodities in a
+	// multi-commodity request.
+	MaxCommodities = 1_000
+
 	// MinEpsilon is the minimum allowed epsilon value for floating-point comparisons.
 	MinEpsilon = 1e-15
 
@@ -106,6 +113,11 @@ type ServiceConfig struct {
 	// EnableMemoryTracking enables per-request memory usage tracking.
 	// This adds some overhead but provides useful metrics.
 	EnableMemoryTracking bool
+
+	// CSRThreshold is the residual edge count from which the solvers run on
+	// the array-based CSR graph. Zero uses algorithms.DefaultCSRThreshold,
+	// a negative value always uses the map-based graph.
+	CSRThreshold int
 }
 
 // DefaultServiceConfig returns a ServiceConfig with sensible defaults.
@@ -276,7 +288,8 @@ func (s *SolverService) Solve(ctx context.Context, req *optimizationv1.SolveRequ
 	}
 
 	// Validate request
-	if err := s.validateSolveRequest(req); err != nil {
+	terminals, err := s.validateSolveRequest(req)
+	if err != nil {
 		s.stats.requestsFailed.Add(1)
 		telemetry.SetError(ctx, err)
 		return nil, err
@@ -288,7 +301,7 @@ func (s *SolverService) Solve(ctx context.Context, req *optimizationv1.SolveRequ
 	defer cancel()
 
 	// Execute solve operation
-	return s.executeSolve(ctx, req, opts, span)
+	return s.executeSolve(ctx, req, terminals, opts, span)
 }
 
 // trackRequest registers a new request and checks shutdown status.
@@ -313,7 +326,10 @@ func (s *SolverService) untrackRequest() {
 
 // checkCache attempts to retrieve a cached result.
 func (s *SolverService) checkCache(ctx context.Context, req *optimizationv1.SolveRequest, span trace.Span) (*optimizationv1.SolveResponse, bool) {
-	if s.solverCache == nil {
+	// Cached results only cover the default max-flow mode and carry neither
+	// a min cut nor a flow decomposition
+	if s.solverCache == nil || isTransportation(req.Options) ||
+		req.Options.GetReturnMinCut() || req.Options.GetReturnDecomposition() {
 		return nil, false
 	}
 
@@ -349,7 +365,7 @@ func (s *SolverService) createTimeoutContext(ctx context.Context, opts *algorith
 }
 
 // executeSolve runs the actual solve operation.
-func (s *SolverService) executeSolve(ctx context.Context, req *optimizationv1.SolveRequest, opts *algorithms.SolverOptions, span trace.Span) (*optimizationv1.SolveResponse, error) {
+func (s *SolverService) executeSolve(ctx context.Context, req *optimizationv1.SolveRequest, terminals *converter.Terminals, opts *algorithms.SolverOptions, span trace.Span) (*optimizationv1.SolveResponse, error) {
 	start := time.Now()
 
 	// Track memory before
@@ -368,9 +384,22 @@ func (s *SolverService) executeSolve(ctx context.Context, req *optimizationv1.So
 	}
 	defer s.solverPool.Release()
 
-	// Convert and solve
-	rg := converter.ToResidualGraph(req.Graph)
-	result := algorithms.Solve(ctx, rg, req.Graph.SourceId, req.Graph.SinkId, req.Algorithm, opts)
+	// Convert and solve (multi-terminal graphs run against virtual super-terminals)
+	rg := converter.ToResidualGraphWithTerminals(req.Graph, terminals)
+
+	var (
+		result      *algorithms.SolverResult
+		warmStarted bool
+	)
+	switch {
+	case isTransportation(req.Options):
+		requiredFlow := math.Max(terminals.TotalSupply(), terminals.TotalDemand())
+		result = algorithms.SolveTransportation(ctx, rg, terminals.Source, terminals.Sink, requiredFlow, opts)
+	case req.Options.GetWarmStart() != nil && !rg.HasLowerBounds() && algorithms.SupportsWarmStart(req.Algorithm):
+		rg, result, warmStarted = s.solveWarmStart(ctx, req, rg, terminals, opts)
+	default:
+		result = algorithms.Solve(ctx, rg, terminals.Source, terminals.Sink, req.Algorithm, opts)
+	}
 
 	elapsed := time.Since(start)
 
@@ -389,7 +418,34 @@ func (s *SolverService) executeSolve(ctx context.Context, req *optimizationv1.So
 	}
 
 	// Build successful response
-	return s.buildSuccessResponse(ctx, req, rg, result, opts, elapsed, memUsed, span)
+	resp, err := s.buildSuccessResponse(ctx, req, rg, terminals, result, opts, elapsed, memUsed, span)
+	if resp != nil {
+		resp.Metrics.WarmStarted = warmStarted
+	}
+	return resp, err
+}
+
+// solveWarmStart re-solves from the flow of req.Options.WarmStart instead of
+// from zero. If the previous flow cannot be repaired the graph is rebuilt and
+// solved from scratch; the returned flag reports whether the warm start was
+// used.
+func (s *SolverService) solveWarmStart(
+	ctx context.Context,
+	req *optimizationv1.SolveRequest,
+	rg *graph.ResidualGraph,
+	terminals *converter.Terminals,
+	opts *algorithms.SolverOptions,
+) (*graph.ResidualGraph, *algorithms.SolverResult, bool) {
+	converter.LoadFlow(rg, req.Graph, req.Options.GetWarmStart())
+
+	result := algorithms.SolveWarmStart(ctx, rg, terminals.Source, terminals.Sink, req.Algorithm, opts)
+	if !errors.Is(result.Error, algorithms.ErrWarmStartRepair) {
+		return rg, result, true
+	}
+
+	logger.Log.Warn("Warm start failed, solving from scratch", "error", result.Error)
+	rg = converter.ToResidualGraphWithTerminals(req.Graph, terminals)
+	return rg, algorithms.Solve(ctx, rg, terminals.Source, terminals.Sink, req.Algorithm, opts), false
 }
 
 // handleSolveError processes a failed solve result.
@@ -401,13 +457,26 @@ func (s *SolverService) handleSolveError(ctx context.Context, result *algorithms
 		return nil, status.Error(codes.DeadlineExceeded, "computation timeout")
 	}
 
-	return &optimizationv1.SolveResponse{
+	response := &optimizationv1.SolveResponse{
 		Success:      false,
 		ErrorMessage: result.Error.Error(),
 		Metrics: &optimizationv1.SolveMetrics{
 			ComputationTimeMs: float64(elapsed.Milliseconds()),
 		},
-	}, nil
+	}
+
+	// Infeasible lower bounds: report which nodes could not be balanced
+	if errors.Is(result.Error, algorithms.ErrInfeasibleLowerBounds) {
+		response.Result = &commonv1.FlowResult{
+			Status:               result.Status,
+			Iterations:           int32(result.Iterations),
+			ComputationTimeMs:    float64(elapsed.Milliseconds()),
+			ErrorMessage:         result.Error.Error(),
+			LowerBoundViolations: converter.ToLowerBoundViolations(result.LowerBoundDeficits),
+		}
+	}
+
+	return response, nil
 }
 
 // buildSuccessResponse constructs the response for a successful solve.
@@ -415,6 +484,7 @@ func (s *SolverService) buildSuccessResponse(
 	ctx context.Context,
 	req *optimizationv1.SolveRequest,
 	rg *graph.ResidualGraph,
+	terminals *converter.Terminals,
 	result *algorithms.SolverResult,
 	opts *algorithms.SolverOptions,
 	elapsed time.Duration,
@@ -423,21 +493,48 @@ func (s *SolverService) buildSuccessResponse(
 ) (*optimizationv1.SolveResponse, error) {
 	s.stats.requestsSuccess.Add(1)
 
+	// Edges to/from virtual super-terminals are internal to the solve
+	edgeOpts := converter.DefaultFlowEdgeOptions()
+	edgeOpts.Terminals = terminals
+
 	flowResult := &commonv1.FlowResult{
 		MaxFlow:           result.MaxFlow,
 		TotalCost:         result.TotalCost,
-		Edges:             converter.ToFlowEdges(rg),
+		Edges:             converter.ToFlowEdgesWithOptions(rg, edgeOpts),
 		Status:            result.Status,
 		Iterations:        int32(result.Iterations),
 		ComputationTimeMs: float64(elapsed.Milliseconds()),
 	}
 
 	if opts.ReturnPaths && len(result.Paths) > 0 {
-		flowResult.Paths = converter.ToPaths(result.Paths, rg)
+		flowResult.Paths = converter.ToPaths(result.Paths, rg, terminals)
+	}
+
+	// Which shipments go which way, whatever the algorithm recorded
+	if req.Options.GetReturnDecomposition() {
+		flowResult.Decomposition = converter.ToFlowDecomposition(req.Graph, rg, terminals)
 	}
 
-	// Cache result asynchronously
-	s.cacheResultAsync(req.Graph, req.Algorithm, flowResult)
+	// Per-warehouse shipped volume and per-delivery-point unmet demand
+	flowResult.SourceBalances, flowResult.SinkBalances = converter.ToNodeBalances(req.Graph, rg, terminals)
+
+	// Bottleneck lanes read from the final residual graph
+	if req.Options.GetReturnMinCut() {
+		minCut, err := converter.ToMinCut(req.Graph, rg, terminals)
+		if err != nil {
+			logger.Log.Warn("Failed to extract min cut", "error", err)
+		}
+		flowResult.MinCut = minCut
+	}
+
+	if isTransportation(req.Options) {
+		// Feasibility report and shadow prices
+		flowResult.UnmetDemands = converter.ToDemandShortfalls(flowResult.SinkBalances)
+		flowResult.NodePotentials = converter.ToNodePotentials(result.Potentials, terminals)
+	} else {
+		// Cache result asynchronously
+		s.cacheResultAsync(req.Graph, req.Algorithm, flowResult)
+	}
 
 	// Record metrics
 	if s.metrics != nil {
@@ -503,6 +600,222 @@ func (s *SolverService) cacheResultAsync(g *commonv1.Graph, algorithm commonv1.A
 	}()
 }
 
+// =============================================================================
+// Minimum Cut
+// =============================================================================
+
+// GetMinCut computes a maximum flow and returns the minimum cut separating
+// the source from the sink: the source-side nodes, the saturated bottleneck
+// edges and the cut value.
+//
+// The request runs through the same pipeline as Solve in max-flow mode, so any
+// max-flow or min-cost algorithm can be used; the cut is read from the final
+// residual graph of that algorithm.
+func (s *SolverService) GetMinCut(ctx context.Context, req *optimizationv1.GetMinCutRequest) (*optimizationv1.GetMinCutResponse, error) {
+	ctx, span := telemetry.StartSpan(ctx, "SolverService.GetMinCut",
+		trace.WithAttributes(
+			attribute.String("algorithm", req.Algorithm.String()),
+		),
+	)
+	defer span.End()
+
+	options := &optimizationv1.SolveOptions{}
+	if req.Options != nil {
+		options = proto.Clone(req.Options).(*optimizationv1.SolveOptions)
+	}
+	options.Mode = optimizationv1.SolveMode_SOLVE_MODE_MAX_FLOW
+	options.ReturnMinCut = true
+
+	resp, err := s.Solve(ctx, &optimizationv1.SolveRequest{
+		Graph:     req.Graph,
+		Algorithm: req.Algorithm,
+		Options:   options,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		return &optimizationv1.GetMinCutResponse{
+			Success:      false,
+			Metrics:      resp.Metrics,
+			ErrorMessage: resp.ErrorMessage,
+		}, nil
+	}
+
+	if resp.Result.GetMinCut() == nil {
+		return &optimizationv1.GetMinCutResponse{
+			Success:      false,
+			MaxFlow:      resp.Result.MaxFlow,
+			Metrics:      resp.Metrics,
+			ErrorMessage: converter.ErrFlowNotMaximal.Error(),
+		}, nil
+	}
+
+	span.SetAttributes(attribute.Int("cut_edges", len(resp.Result.MinCut.Edges)))
+
+	return &optimizationv1.GetMinCutResponse{
+		Success: true,
+		MinCut:  resp.Result.MinCut,
+		MaxFlow: resp.Result.MaxFlow,
+		Metrics: resp.Metrics,
+	}, nil
+}
+
+// =============================================================================
+// Multi-Commodity Flow
+// =============================================================================
+
+// SolveMultiCommodity routes several commodities (product classes) over the
+// shared edge capacities of the graph and returns per-commodity edge flows.
+//
+// The flow is a maximum concurrent flow: when the network cannot carry every
+// demand, each commodity ships the same share concurrent_ratio of its demand.
+// Among such flows the solver looks for the cheapest one, with per-commodity
+// edge costs taken from cost_overrides. Both are (1-ε)-approximations, see
+// algorithms.MultiCommodityFlow.
+func (s *SolverService) SolveMultiCommodity(ctx context.Context, req *optimizationv1.SolveMultiCommodityRequest) (*optimizationv1.SolveMultiCommodityResponse, error) {
+	if err := s.trackRequest(); err != nil {
+		return nil, err
+	}
+	defer s.untrackRequest()
+
+	ctx, span := telemetry.StartSpan(ctx, "SolverService.SolveMultiCommodity",
+		trace.WithAttributes(
+			attribute.Int("commodities", len(req.Commodities)),
+		),
+	)
+	defer span.End()
+
+	if err := s.validateMultiCommodityRequest(req); err != nil {
+		s.stats.requestsFailed.Add(1)
+		telemetry.SetError(ctx, err)
+		return nil, err
+	}
+
+	// Timeout and phase limit share the bounds of SolveOptions
+	opts := s.buildSolverOptions(&optimizationv1.SolveOptions{
+		TimeoutSeconds: req.Options.GetTimeoutSeconds(),
+		MaxIterations:  req.Options.GetMaxPhases(),
+	})
+	ctx, cancel := s.createTimeoutContext(ctx, opts)
+	defer cancel()
+
+	start := time.Now()
+
+	var memBefore uint64
+	if s.config.EnableMemoryTracking {
+		memBefore = s.memStatsCache.refresh()
+	}
+
+	if err := s.solverPool.Acquire(ctx); err != nil {
+		s.stats.requestsFailed.Add(1)
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, status.Error(codes.DeadlineExceeded, "timeout waiting for solver slot")
+		}
+		return nil, status.Error(codes.ResourceExhausted, "too many concurrent requests")
+	}
+	defer s.solverPool.Release()
+
+	rg := converter.ToResidualGraph(req.Graph)
+	result := algorithms.MultiCommodityFlow(ctx, rg, toCommodities(req.Commodities),
+		req.Options.GetApproximation(), opts)
+
+	elapsed := time.Since(start)
+
+	var memUsed int64
+	if s.config.EnableMemoryTracking {
+		memAfter := s.memStatsCache.refresh()
+		if memAfter > memBefore {
+			memUsed = int64(memAfter - memBefore)
+		}
+	}
+
+	metrics := &optimizationv1.SolveMetrics{
+		ComputationTimeMs: float64(elapsed.Milliseconds()),
+		Iterations:        int32(result.Iterations),
+		MemoryUsedBytes:   memUsed,
+	}
+
+	if result.Error != nil {
+		s.stats.requestsFailed.Add(1)
+		telemetry.SetError(ctx, result.Error)
+		if result.Canceled {
+			return nil, status.Error(codes.DeadlineExceeded, "computation timeout")
+		}
+		return &optimizationv1.SolveMultiCommodityResponse{
+			Success:      false,
+			Metrics:      metrics,
+			ErrorMessage: result.Error.Error(),
+		}, nil
+	}
+
+	s.stats.requestsSuccess.Add(1)
+
+	mcResult := &optimizationv1.MultiCommodityResult{
+		Commodities:     make([]*optimizationv1.CommodityFlow, len(req.Commodities)),
+		ConcurrentRatio: result.Ratio,
+		TotalCost:       result.TotalCost,
+		Edges:           converter.ToFlowEdges(rg),
+		Status:          result.Status,
+		Iterations:      int32(result.Iterations),
+	}
+
+	totalFlow := 0.0
+	for j, c := range req.Commodities {
+		flow := result.Commodities[j]
+		commodityFlow := &optimizationv1.CommodityFlow{
+			CommodityId: commodityID(c, j),
+			Demand:      c.Demand,
+			Flow:        flow.Flow,
+			Cost:        flow.Cost,
+		}
+		if flow.Graph != nil {
+			commodityFlow.Edges = converter.ToFlowEdges(flow.Graph)
+		}
+		mcResult.Commodities[j] = commodityFlow
+		totalFlow += flow.Flow
+	}
+
+	if s.metrics != nil {
+		s.metrics.RecordSolveOperation("MULTI_COMMODITY", true, elapsed, totalFlow)
+	}
+
+	span.SetAttributes(
+		attribute.Float64("concurrent_ratio", result.Ratio),
+		attribute.Int("iterations", result.Iterations),
+	)
+
+	return &optimizationv1.SolveMultiCommodityResponse{
+		Success: true,
+		Result:  mcResult,
+		Metrics: metrics,
+	}, nil
+}
+
+// toCommodities converts request commodities to the algorithm input.
+func toCommodities(commodities []*optimizationv1.Commodity) []algorithms.Commodity {
+	result := make([]algorithms.Commodity, len(commodities))
+	for j, c := range commodities {
+		result[j] = algorithms.Commodity{
+			Source:        c.SourceId,
+			Sink:          c.SinkId,
+			Demand:        c.Demand,
+			CostOverrides: c.CostOverrides,
+		}
+	}
+	return result
+}
+
+// commodityID returns the ID of the commodity at position index of a
+// request: its id field, or its 1-based position when id is unset.
+func commodityID(c *optimizationv1.Commodity, index int) int64 {
+	if c.Id != 0 {
+		return c.Id
+	}
+	return int64(index + 1)
+}
+
 // =============================================================================
 // Streaming Solve
 // =============================================================================
@@ -529,7 +842,8 @@ func (s *SolverService) SolveStream(req *optimizationv1.SolveRequestForBigGraphs
 	defer span.End()
 
 	// Validate request
-	if err := s.validateStreamRequest(req); err != nil {
+	terminals, err := s.validateStreamRequest(req)
+	if err != nil {
 		s.stats.requestsFailed.Add(1)
 		telemetry.SetError(ctx, err)
 		return err
@@ -551,18 +865,19 @@ func (s *SolverService) SolveStream(req *optimizationv1.SolveRequestForBigGraphs
 	defer s.solverPool.Release()
 
 	// Execute streaming solve
-	return s.executeStreamSolve(ctx, req, opts, stream)
+	return s.executeStreamSolve(ctx, req, terminals, opts, stream)
 }
 
 // executeStreamSolve runs the streaming solve operation.
 func (s *SolverService) executeStreamSolve(
 	ctx context.Context,
 	req *optimizationv1.SolveRequestForBigGraphs,
+	terminals *converter.Terminals,
 	opts *algorithms.SolverOptions,
 	stream optimizationv1.SolverService_SolveStreamServer,
 ) error {
 	start := time.Now()
-	progress := newProgressTracker(stream, start, s.memStatsCache)
+	progress := newProgressTracker(stream, start, s.memStatsCache, terminals)
 
 	// Check context before starting
 	if ctx.Err() != nil {
@@ -576,9 +891,24 @@ func (s *SolverService) executeStreamSolve(
 		return err
 	}
 
-	rg := converter.ToResidualGraph(req.Graph)
-	source := req.Graph.SourceId
-	sink := req.Graph.SinkId
+	rg := converter.ToResidualGraphWithTerminals(req.Graph, terminals)
+	source := terminals.Source
+	sink := terminals.Sink
+	progress.graph = rg
+
+	// Edge lower bounds are met before streaming augmentations start
+	if rg.HasLowerBounds() {
+		if _, err := algorithms.EstablishLowerBounds(ctx, rg, source, sink, req.Algorithm, opts); err != nil {
+			s.stats.requestsFailed.Add(1)
+			if errors.Is(err, algorithms.ErrInfeasibleLowerBounds) {
+				return status.Error(codes.FailedPrecondition, err.Error())
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
 
 	// Run algorithm with progress callback
 	var err error
@@ -589,6 +919,8 @@ func (s *SolverService) executeStreamSolve(
 		err = s.streamPushRelabel(ctx, rg, source, sink, opts, progress)
 	case commonv1.Algorithm_ALGORITHM_MIN_COST:
 		err = s.streamMinCostFlow(ctx, rg, source, sink, opts, progress)
+	case commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX:
+		err = s.streamNetworkSimplex(ctx, rg, source, sink, opts, progress)
 	default:
 		err = s.streamEdmondsKarp(ctx, rg, source, sink, opts, progress)
 	}
@@ -606,7 +938,8 @@ func (s *SolverService) executeStreamSolve(
 	maxFlow := rg.GetTotalFlow(source)
 	telemetry.AddEvent(ctx, "stream_completed", attribute.Float64("max_flow", maxFlow))
 
-	return progress.sendCompleted(maxFlow)
+	sourceBalances, sinkBalances := converter.ToNodeBalances(req.Graph, rg, terminals)
+	return progress.sendCompleted(maxFlow, sourceBalances, sinkBalances)
 }
 
 // =============================================================================
@@ -619,15 +952,21 @@ type progressTracker struct {
 	start         time.Time
 	lastSendTime  time.Time
 	memStatsCache *memStatsCache
+	terminals     *converter.Terminals
+
+	// graph is the graph being solved; its lane nodes are stripped from paths.
+	graph *graph.ResidualGraph
 }
 
 // newProgressTracker creates a new progress tracker.
-func newProgressTracker(stream optimizationv1.SolverService_SolveStreamServer, start time.Time, memCache *memStatsCache) *progressTracker {
+// Virtual super-terminals are stripped from reported paths.
+func newProgressTracker(stream optimizationv1.SolverService_SolveStreamServer, start time.Time, memCache *memStatsCache, terminals *converter.Terminals) *progressTracker {
 	return &progressTracker{
 		stream:        stream,
 		start:         start,
 		lastSendTime:  start,
 		memStatsCache: memCache,
+		terminals:     terminals,
 	}
 }
 
@@ -660,7 +999,10 @@ func (p *progressTracker) sendProgressForced(iteration int, currentFlow float64,
 		MemoryUsedBytes:   int64(p.memStatsCache.get()),
 	}
 
-	if len(path) > 0 {
+	if p.graph != nil {
+		path = p.graph.StripLaneNodes(path)
+	}
+	if path = p.terminals.StripVirtualNodes(path); len(path) > 0 {
 		progress.LastPath = &commonv1.Path{
 			NodeIds: path,
 			Flow:    pathFlow,
@@ -688,13 +1030,16 @@ func (p *progressTracker) sendStatus(statusMsg string, iteration int, currentFlo
 }
 
 // sendCompleted sends the final completion progress.
-func (p *progressTracker) sendCompleted(maxFlow float64) error {
+// Balances are only set for multi-terminal graphs.
+func (p *progressTracker) sendCompleted(maxFlow float64, sourceBalances, sinkBalances []*commonv1.NodeBalance) error {
 	return p.stream.Send(&optimizationv1.SolveProgress{
 		CurrentFlow:       maxFlow,
 		ProgressPercent:   100.0,
 		Status:            "completed",
 		ComputationTimeMs: float64(time.Since(p.start).Milliseconds()),
 		MemoryUsedBytes:   int64(p.memStatsCache.get()),
+		SourceBalances:    sourceBalances,
+		SinkBalances:      sinkBalances,
 	})
 }
 
@@ -920,6 +1265,9 @@ func (s *SolverService) streamPushRelabel(
 			if result.Canceled {
 				return ctx.Err()
 			}
+			if result.Error != nil {
+				return status.Error(codes.Internal, result.Error.Error())
+			}
 			return nil
 
 		case <-progressTicker.C:
@@ -1055,6 +1403,41 @@ func (s *SolverService) streamMinCostFlow(
 	return nil
 }
 
+// streamNetworkSimplex runs Network Simplex, reporting flow and cost after
+// each pivot. A failed send cancels the solver.
+func (s *SolverService) streamNetworkSimplex(
+	ctx context.Context,
+	rg *graph.ResidualGraph,
+	source, sink int64,
+	opts *algorithms.SolverOptions,
+	progress *progressTracker,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var sendErr error
+	result := algorithms.NetworkSimplexWithCallback(ctx, rg, source, sink, opts, func(pivots int, flow, cost float64) {
+		if sendErr != nil {
+			return
+		}
+		if err := progress.sendProgressWithCost(pivots, flow, cost, nil, 0); err != nil {
+			sendErr = err
+			cancel()
+		}
+	})
+
+	if sendErr != nil {
+		return sendErr
+	}
+	if result.Canceled {
+		return ctx.Err()
+	}
+	if result.Error != nil {
+		return status.Error(codes.Internal, result.Error.Error())
+	}
+	return nil
+}
+
 // =============================================================================
 // Algorithm Information
 // =============================================================================
@@ -1169,17 +1552,90 @@ func (s *SolverService) Shutdown(ctx context.Context) error {
 // =============================================================================
 
 // validateSolveRequest validates a synchronous solve request.
-func (s *SolverService) validateSolveRequest(req *optimizationv1.SolveRequest) error {
-	return s.validateGraph(req.Graph)
+func (s *SolverService) validateSolveRequest(req *optimizationv1.SolveRequest) (*converter.Terminals, error) {
+	if isTransportation(req.Options) && req.Options.GetReturnMinCut() {
+		return nil, status.Error(codes.InvalidArgument,
+			"min cut is only available in max-flow mode")
+	}
+	if warm := req.Options.GetWarmStart(); warm != nil && len(warm.Edges) > MaxGraphEdges {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"warm start graph has too many edges: %d > %d", len(warm.Edges), MaxGraphEdges)
+	}
+	return s.validateGraph(req.Graph, isTransportation(req.Options))
 }
 
 // validateStreamRequest validates a streaming solve request.
-func (s *SolverService) validateStreamRequest(req *optimizationv1.SolveRequestForBigGraphs) error {
-	return s.validateGraph(req.Graph)
+func (s *SolverService) validateStreamRequest(req *optimizationv1.SolveRequestForBigGraphs) (*converter.Terminals, error) {
+	if isTransportation(req.Options) {
+		return nil, status.Error(codes.InvalidArgument,
+			"transportation mode is not supported for streaming, use Solve")
+	}
+	return s.validateGraph(req.Graph, false)
+}
+
+// isTransportation reports whether the request asks for the transportation
+// problem (hard supply/demand) instead of max-flow.
+func isTransportation(opts *optimizationv1.SolveOptions) bool {
+	return opts.GetMode() == optimizationv1.SolveMode_SOLVE_MODE_TRANSPORTATION
 }
 
-// validateGraph performs comprehensive graph validation.
-func (s *SolverService) validateGraph(g *commonv1.Graph) error {
+// validateGraph performs comprehensive graph validation and resolves
+// the terminals the solve should run against. In transportation mode every
+// supply and demand node is attached to the virtual super-terminals.
+func (s *SolverService) validateGraph(g *commonv1.Graph, transportation bool) (*converter.Terminals, error) {
+	if err := s.validateGraphStructure(g); err != nil {
+		return nil, err
+	}
+
+	var (
+		terminals *converter.Terminals
+		err       error
+	)
+	if transportation {
+		terminals, err = converter.ResolveBalancedTerminals(g)
+	} else {
+		terminals, err = converter.ResolveTerminals(g)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Build node set
+	nodeExists := make(map[int64]bool, len(g.Nodes))
+	for _, node := range g.Nodes {
+		nodeExists[node.Id] = true
+	}
+
+	// Multi-terminal graphs are solved via virtual super-terminals,
+	// so source_id/sink_id are ignored but virtual IDs must stay free.
+	if terminals.MultiTerminal {
+		for _, id := range []int64{domain.SuperSourceID, domain.SuperSinkID} {
+			if nodeExists[id] {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"node id %d is reserved for virtual super-source/super-sink", id)
+			}
+		}
+		return terminals, nil
+	}
+
+	if !nodeExists[g.SourceId] {
+		return nil, pkgerrors.ErrInvalidSource
+	}
+
+	if !nodeExists[g.SinkId] {
+		return nil, pkgerrors.ErrInvalidSink
+	}
+
+	if g.SourceId == g.SinkId {
+		return nil, pkgerrors.ErrSourceEqualsSink
+	}
+
+	return terminals, nil
+}
+
+// validateGraphStructure checks graph size limits, edge lower bounds and
+// edge ID uniqueness, independent of the problem solved on the graph.
+func (s *SolverService) validateGraphStructure(g *commonv1.Graph) error {
 	if g == nil {
 		return pkgerrors.ErrNilGraph
 	}
@@ -1198,22 +1654,94 @@ func (s *SolverService) validateGraph(g *commonv1.Graph) error {
 			"graph has too many edges: %d > %d", len(g.Edges), MaxGraphEdges)
 	}
 
-	// Build node set
+	edgeIDs := make(map[int64]bool, len(g.Edges))
+	for i, e := range g.Edges {
+		if e.MinFlow < 0 || e.MinFlow > e.Capacity {
+			return status.Errorf(codes.InvalidArgument,
+				"edge %d->%d: min_flow %g must be within [0, capacity %g]", e.From, e.To, e.MinFlow, e.Capacity)
+		}
+
+		// Edge IDs identify parallel edges in results, so they must be unique
+		id := converter.EdgeID(e, i)
+		if edgeIDs[id] {
+			return status.Errorf(codes.InvalidArgument, "duplicate edge id %d", id)
+		}
+		edgeIDs[id] = true
+	}
+
+	return nil
+}
+
+// validateMultiCommodityRequest validates a multi-commodity request: the
+// graph structure, non-negative costs without lower bounds, and every
+// commodity's terminals, demand and cost overrides.
+func (s *SolverService) validateMultiCommodityRequest(req *optimizationv1.SolveMultiCommodityRequest) error {
+	g := req.Graph
+	if err := s.validateGraphStructure(g); err != nil {
+		return err
+	}
+
+	if len(req.Commodities) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one commodity is required")
+	}
+
+	if len(req.Commodities) > MaxCommodities {
+		return status.Errorf(codes.InvalidArgument,
+			"too many commodities: %d > %d", len(req.Commodities), MaxCommodities)
+	}
+
 	nodeExists := make(map[int64]bool, len(g.Nodes))
 	for _, node := range g.Nodes {
 		nodeExists[node.Id] = true
 	}
 
-	if !nodeExists[g.SourceId] {
-		return pkgerrors.ErrInvalidSource
+	edgeIDs := make(map[int64]bool, len(g.Edges))
+	for i, e := range g.Edges {
+		if e.MinFlow > 0 {
+			return status.Errorf(codes.InvalidArgument,
+				"edge %d->%d: min_flow is not supported for multi-commodity flow", e.From, e.To)
+		}
+		if e.Cost < 0 {
+			return status.Errorf(codes.InvalidArgument,
+				"edge %d->%d: cost %g must be non-negative for multi-commodity flow", e.From, e.To, e.Cost)
+		}
+		edgeIDs[converter.EdgeID(e, i)] = true
 	}
 
-	if !nodeExists[g.SinkId] {
-		return pkgerrors.ErrInvalidSink
-	}
+	commodityIDs := make(map[int64]bool, len(req.Commodities))
+	for j, c := range req.Commodities {
+		id := commodityID(c, j)
+		if commodityIDs[id] {
+			return status.Errorf(codes.InvalidArgument, "duplicate commodity id %d", id)
+		}
+		commodityIDs[id] = true
 
-	if g.SourceId == g.SinkId {
-		return pkgerrors.ErrSourceEqualsSink
+		if !nodeExists[c.SourceId] {
+			return status.Errorf(codes.InvalidArgument,
+				"commodity %d: source node %d not found", id, c.SourceId)
+		}
+		if !nodeExists[c.SinkId] {
+			return status.Errorf(codes.InvalidArgument,
+				"commodity %d: sink node %d not found", id, c.SinkId)
+		}
+		if c.SourceId == c.SinkId {
+			return status.Errorf(codes.InvalidArgument,
+				"commodity %d: source and sink must be different", id)
+		}
+		if !(c.Demand > 0) || math.IsInf(c.Demand, 0) {
+			return status.Errorf(codes.InvalidArgument,
+				"commodity %d: demand %g must be positive and finite", id, c.Demand)
+		}
+		for edgeID, cost := range c.CostOverrides {
+			if !edgeIDs[edgeID] {
+				return status.Errorf(codes.InvalidArgument,
+					"commodity %d: cost override for unknown edge id %d", id, edgeID)
+			}
+			if !(cost >= 0) {
+				return status.Errorf(codes.InvalidArgument,
+					"commodity %d: cost override %g for edge %d must be non-negative", id, cost, edgeID)
+			}
+		}
 	}
 
 	return nil
@@ -1226,6 +1754,9 @@ func (s *SolverService) validateGraph(g *commonv1.Graph) error {
 // buildSolverOptions creates algorithm options from request options.
 func (s *SolverService) buildSolverOptions(opts *optimizationv1.SolveOptions) *algorithms.SolverOptions {
 	result := algorithms.DefaultSolverOptions()
+	if s.config != nil {
+		result.CSRThreshold = s.config.CSRThreshold
+	}
 
 	if opts == nil {
 		return result
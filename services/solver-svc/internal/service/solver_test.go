@@ -11,6 +11,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	commonv1 "logistics/gen/go/logistics/common/v1"
@@ -282,6 +284,7 @@ func TestSolverService_Solve_AllAlgorithms(t *testing.T) {
 		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
 		commonv1.Algorithm_ALGORITHM_MIN_COST,
 		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
 	}
 
 	graph := &commonv1.Graph{
@@ -550,11 +553,12 @@ func TestSolverService_GetAlgorithms(t *testing.T) {
 
 	// Check required algorithms present
 	expectedAlgorithms := map[commonv1.Algorithm]bool{
-		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP:   false,
-		commonv1.Algorithm_ALGORITHM_DINIC:          false,
-		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL:   false,
-		commonv1.Algorithm_ALGORITHM_MIN_COST:       false,
-		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON: false,
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP:    false,
+		commonv1.Algorithm_ALGORITHM_DINIC:           false,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL:    false,
+		commonv1.Algorithm_ALGORITHM_MIN_COST:        false,
+		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON:  false,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX: false,
 	}
 
 	for _, algo := range resp.Algorithms {
@@ -973,6 +977,849 @@ func TestSolverService_Solve_ParallelEdges(t *testing.T) {
 	}
 }
 
+// parallelLanesGraph: два параллельных ребра 1->2 (авто дешевле, ж/д дороже)
+func parallelLanesGraph() *commonv1.Graph {
+	return &commonv1.Graph{
+		Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 5, Cost: 1, Id: 10},
+			{From: 1, To: 2, Capacity: 5, Cost: 10, Id: 20},
+			{From: 2, To: 3, Capacity: 8, Id: 30},
+		},
+		SourceId: 1,
+		SinkId:   3,
+	}
+}
+
+func TestSolverService_Solve_ParallelEdgesWithIDs(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:     parallelLanesGraph(),
+		Algorithm: commonv1.Algorithm_ALGORITHM_MIN_COST,
+		Options:   &optimizationv1.SolveOptions{ReturnPaths: true},
+	})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 8.0, resp.Result.MaxFlow, 1e-9)
+	assert.InDelta(t, 35.0, resp.Result.TotalCost, 1e-9)
+
+	flows := make(map[int64]float64)
+	for _, e := range resp.Result.Edges {
+		assert.Positive(t, e.From, "lane nodes must not leak into results")
+		assert.Positive(t, e.To, "lane nodes must not leak into results")
+		flows[e.EdgeId] = e.Flow
+	}
+	assert.InDelta(t, 5.0, flows[10], 1e-9)
+	assert.InDelta(t, 3.0, flows[20], 1e-9)
+	assert.InDelta(t, 8.0, flows[30], 1e-9)
+
+	for _, p := range resp.Result.Paths {
+		assert.Equal(t, []int64{1, 2, 3}, p.NodeIds)
+	}
+}
+
+func TestSolverService_Solve_DuplicateEdgeID(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	g := parallelLanesGraph()
+	g.Edges[1].Id = 10
+
+	_, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{Graph: g})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// multiTerminalGraph: склады 1 и 2 (supply 10 и 5), точки доставки 4 и 5
+// (demand 8 и 9) через хаб 3. Ребро 3->5 ограничивает доставку в точку 5.
+func multiTerminalGraph() *commonv1.Graph {
+	return &commonv1.Graph{
+		Nodes: []*commonv1.Node{
+			{Id: 1, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE, Supply: 10},
+			{Id: 2, Type: commonv1.NodeType_NODE_TYPE_WAREHOUSE, Supply: 5},
+			{Id: 3, Type: commonv1.NodeType_NODE_TYPE_INTERSECTION},
+			{Id: 4, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT, Demand: 8},
+			{Id: 5, Type: commonv1.NodeType_NODE_TYPE_DELIVERY_POINT, Demand: 9},
+		},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 3, Capacity: 10, Cost: 1},
+			{From: 2, To: 3, Capacity: 5, Cost: 2},
+			{From: 3, To: 4, Capacity: 8, Cost: 1},
+			{From: 3, To: 5, Capacity: 6, Cost: 1},
+		},
+	}
+}
+
+func TestSolverService_Solve_MultiTerminal_AllAlgorithms(t *testing.T) {
+	algos := []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+		commonv1.Algorithm_ALGORITHM_MIN_COST,
+		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+	}
+
+	svc := NewSolverService("1.0.0", nil)
+	ctx := context.Background()
+
+	for _, algo := range algos {
+		t.Run(algo.String(), func(t *testing.T) {
+			resp, err := svc.Solve(ctx, &optimizationv1.SolveRequest{
+				Graph:     multiTerminalGraph(),
+				Algorithm: algo,
+				Options:   &optimizationv1.SolveOptions{ReturnPaths: true},
+			})
+			require.NoError(t, err)
+			require.True(t, resp.Success, resp.ErrorMessage)
+
+			assert.InDelta(t, 14.0, resp.Result.MaxFlow, 1e-9)
+
+			// Virtual super-source/super-sink are not exposed
+			for _, e := range resp.Result.Edges {
+				assert.Positive(t, e.From)
+				assert.Positive(t, e.To)
+			}
+			for _, p := range resp.Result.Paths {
+				for _, id := range p.NodeIds {
+					assert.Positive(t, id)
+				}
+			}
+
+			require.Len(t, resp.Result.SourceBalances, 2)
+			var shipped float64
+			for _, b := range resp.Result.SourceBalances {
+				shipped += b.Shipped
+			}
+			assert.InDelta(t, 14.0, shipped, 1e-9)
+
+			require.Len(t, resp.Result.SinkBalances, 2)
+			assert.Equal(t, int64(4), resp.Result.SinkBalances[0].NodeId)
+			assert.InDelta(t, 8.0, resp.Result.SinkBalances[0].Received, 1e-9)
+			assert.InDelta(t, 0.0, resp.Result.SinkBalances[0].UnmetDemand, 1e-9)
+			assert.Equal(t, int64(5), resp.Result.SinkBalances[1].NodeId)
+			assert.InDelta(t, 6.0, resp.Result.SinkBalances[1].Received, 1e-9)
+			assert.InDelta(t, 3.0, resp.Result.SinkBalances[1].UnmetDemand, 1e-9)
+		})
+	}
+}
+
+func TestSolverService_Solve_MultiTerminal_ReservedNodeID(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	g := multiTerminalGraph()
+	g.Nodes = append(g.Nodes, &commonv1.Node{Id: -1})
+
+	_, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:     g,
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	})
+	assert.Error(t, err)
+}
+
+func TestSolverService_Solve_MultiTerminal_NegativeNodeIDAllowed(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	// Only -1 and -2 are reserved for virtual terminals
+	g := multiTerminalGraph()
+	g.Nodes = append(g.Nodes, &commonv1.Node{Id: -3})
+
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:     g,
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 14.0, resp.Result.MaxFlow, 1e-9)
+}
+
+func TestSolverService_Solve_NegativeNodeIDsSingleTerminal(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	// Real nodes -1/-2 in a single-terminal graph are kept in the result
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph: &commonv1.Graph{
+			SourceId: -1,
+			SinkId:   -2,
+			Nodes:    []*commonv1.Node{{Id: -1}, {Id: -2}},
+			Edges:    []*commonv1.Edge{{From: -1, To: -2, Capacity: 7, Cost: 1}},
+		},
+		Algorithm: commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+		Options:   &optimizationv1.SolveOptions{ReturnPaths: true},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	assert.InDelta(t, 7.0, resp.Result.MaxFlow, 1e-9)
+	require.Len(t, resp.Result.Edges, 1)
+	assert.Equal(t, int64(-1), resp.Result.Edges[0].From)
+	require.Len(t, resp.Result.Paths, 1)
+	assert.Equal(t, []int64{-1, -2}, resp.Result.Paths[0].NodeIds)
+}
+
+func TestSolverService_Solve_ConflictingTerminals(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	// Supply/demand on 2/3 while source_id/sink_id point at 1/4
+	_, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph: &commonv1.Graph{
+			SourceId: 1,
+			SinkId:   4,
+			Nodes: []*commonv1.Node{
+				{Id: 1},
+				{Id: 2, Supply: 5},
+				{Id: 3, Demand: 5},
+				{Id: 4},
+			},
+			Edges: []*commonv1.Edge{
+				{From: 1, To: 2, Capacity: 5},
+				{From: 2, To: 3, Capacity: 5},
+				{From: 3, To: 4, Capacity: 5},
+			},
+		},
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSolverService_Solve_Transportation(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	// Same network as multiTerminalGraph, but 3->5 can carry all of node 5's demand
+	// and warehouse 1 has enough supply for a balanced problem
+	g := multiTerminalGraph()
+	g.Nodes[0].Supply = 12
+	g.Edges[0].Capacity = 12
+	g.Edges[3].Capacity = 9
+
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:   g,
+		Options: &optimizationv1.SolveOptions{Mode: optimizationv1.SolveMode_SOLVE_MODE_TRANSPORTATION},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_OPTIMAL, resp.Result.Status)
+	assert.InDelta(t, 17.0, resp.Result.MaxFlow, 1e-9)
+	// 12 units from warehouse 1 (cost 1+1) and 5 from warehouse 2 (cost 2+1)
+	assert.InDelta(t, 39.0, resp.Result.TotalCost, 1e-9)
+	assert.Empty(t, resp.Result.UnmetDemands)
+
+	require.Len(t, resp.Result.NodePotentials, 5)
+	for _, p := range resp.Result.NodePotentials {
+		assert.Positive(t, p.NodeId)
+		assert.GreaterOrEqual(t, p.Potential, 0.0)
+	}
+}
+
+func TestSolverService_Solve_TransportationInfeasible(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	// 3->5 carries only 6 of node 5's demand of 9
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:   multiTerminalGraph(),
+		Options: &optimizationv1.SolveOptions{Mode: optimizationv1.SolveMode_SOLVE_MODE_TRANSPORTATION},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE, resp.Result.Status)
+	require.Len(t, resp.Result.UnmetDemands, 1)
+	assert.Equal(t, int64(5), resp.Result.UnmetDemands[0].NodeId)
+	assert.InDelta(t, 9.0, resp.Result.UnmetDemands[0].Demand, 1e-9)
+	assert.InDelta(t, 6.0, resp.Result.UnmetDemands[0].Received, 1e-9)
+	assert.InDelta(t, 3.0, resp.Result.UnmetDemands[0].Shortfall, 1e-9)
+}
+
+func TestSolverService_Solve_TransportationRequiresSupplyDemand(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	_, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph: &commonv1.Graph{
+			SourceId: 1,
+			SinkId:   2,
+			Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}},
+			Edges:    []*commonv1.Edge{{From: 1, To: 2, Capacity: 5}},
+		},
+		Options: &optimizationv1.SolveOptions{Mode: optimizationv1.SolveMode_SOLVE_MODE_TRANSPORTATION},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSolverService_SolveStream_RejectsTransportation(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	err := svc.SolveStream(&optimizationv1.SolveRequestForBigGraphs{
+		Graph:   multiTerminalGraph(),
+		Options: &optimizationv1.SolveOptions{Mode: optimizationv1.SolveMode_SOLVE_MODE_TRANSPORTATION},
+	}, &mockSolveStream{ctx: context.Background()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSolverService_SolveStream_MultiTerminal(t *testing.T) {
+	algos := []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+		commonv1.Algorithm_ALGORITHM_MIN_COST,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+	}
+
+	svc := NewSolverService("1.0.0", nil)
+
+	for _, algo := range algos {
+		t.Run(algo.String(), func(t *testing.T) {
+			stream := &mockSolveStream{
+				ctx:      context.Background(),
+				messages: make([]*optimizationv1.SolveProgress, 0),
+			}
+
+			err := svc.SolveStream(&optimizationv1.SolveRequestForBigGraphs{
+				Graph:     multiTerminalGraph(),
+				Algorithm: algo,
+			}, stream)
+			require.NoError(t, err)
+			require.NotEmpty(t, stream.messages)
+
+			// Virtual super-source/super-sink never leak into streamed paths
+			for i, msg := range stream.messages {
+				for _, id := range msg.GetLastPath().GetNodeIds() {
+					assert.Positive(t, id, "message %d", i)
+				}
+			}
+
+			lastMsg := stream.messages[len(stream.messages)-1]
+			assert.Equal(t, "completed", lastMsg.Status)
+			assert.InDelta(t, 14.0, lastMsg.CurrentFlow, 1e-9)
+
+			require.Len(t, lastMsg.SourceBalances, 2)
+			require.Len(t, lastMsg.SinkBalances, 2)
+			assert.InDelta(t, 8.0, lastMsg.SinkBalances[0].Received, 1e-9)
+			assert.InDelta(t, 3.0, lastMsg.SinkBalances[1].UnmetDemand, 1e-9)
+		})
+	}
+}
+
+// minFlowGraph: 1 -> 2 -> 4 and 2 -> 3 -> 4, where 2 -> 3 must carry at least
+// 4 units and 3 -> 4 has capacity capThreeFour.
+func minFlowGraph(capThreeFour float64) *commonv1.Graph {
+	return &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, Cost: 1},
+			{From: 2, To: 4, Capacity: 10, Cost: 1},
+			{From: 2, To: 3, Capacity: 10, Cost: 2, MinFlow: 4},
+			{From: 3, To: 4, Capacity: capThreeFour, Cost: 2},
+		},
+	}
+}
+
+func TestSolverService_Solve_MinFlow(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:     minFlowGraph(6),
+		Algorithm: commonv1.Algorithm_ALGORITHM_MIN_COST,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	assert.InDelta(t, 10.0, resp.Result.MaxFlow, 1e-9)
+	assert.InDelta(t, 32.0, resp.Result.TotalCost, 1e-9)
+	for _, e := range resp.Result.Edges {
+		if e.From == 2 && e.To == 3 {
+			assert.InDelta(t, 4.0, e.Flow, 1e-9)
+		}
+	}
+}
+
+func TestSolverService_Solve_MinFlowInfeasible(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:     minFlowGraph(3),
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.NotEmpty(t, resp.ErrorMessage)
+
+	require.NotNil(t, resp.Result)
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE, resp.Result.Status)
+	require.Len(t, resp.Result.LowerBoundViolations, 2)
+	assert.Equal(t, int64(2), resp.Result.LowerBoundViolations[0].NodeId)
+	assert.InDelta(t, -4.0, resp.Result.LowerBoundViolations[0].Imbalance, 1e-9)
+	assert.InDelta(t, 1.0, resp.Result.LowerBoundViolations[0].Unresolved, 1e-9)
+	assert.Equal(t, int64(3), resp.Result.LowerBoundViolations[1].NodeId)
+}
+
+func TestSolverService_Solve_MinFlowExceedsCapacity(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	for _, minFlow := range []float64{-1, 11} {
+		g := minFlowGraph(6)
+		g.Edges[2].MinFlow = minFlow
+
+		_, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{Graph: g})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestSolverService_SolveStream_MinFlow(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	stream := &mockSolveStream{ctx: context.Background()}
+	err := svc.SolveStream(&optimizationv1.SolveRequestForBigGraphs{
+		Graph:     minFlowGraph(6),
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	}, stream)
+	require.NoError(t, err)
+	require.NotEmpty(t, stream.messages)
+	assert.InDelta(t, 10.0, stream.messages[len(stream.messages)-1].CurrentFlow, 1e-9)
+
+	err = svc.SolveStream(&optimizationv1.SolveRequestForBigGraphs{
+		Graph:     minFlowGraph(3),
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	}, &mockSolveStream{ctx: context.Background()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSolverService_GetMinCut_AllAlgorithms(t *testing.T) {
+	algos := []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+		commonv1.Algorithm_ALGORITHM_MIN_COST,
+		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+	}
+
+	// Узкие места: 1->3 (2), 2->4 (4) и обратное направление
+	// двунаправленного ребра 3<->2 (1)
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10, Cost: 1},
+			{From: 1, To: 3, Capacity: 2, Cost: 1},
+			{From: 2, To: 4, Capacity: 4, Cost: 1},
+			{From: 3, To: 4, Capacity: 10, Cost: 1},
+			{From: 3, To: 2, Capacity: 1, Cost: 1, Bidirectional: true},
+		},
+	}
+
+	svc := NewSolverService("1.0.0", nil)
+	for _, algo := range algos {
+		t.Run(algo.String(), func(t *testing.T) {
+			resp, err := svc.GetMinCut(context.Background(), &optimizationv1.GetMinCutRequest{
+				Graph:     g,
+				Algorithm: algo,
+			})
+			require.NoError(t, err)
+			require.True(t, resp.Success, resp.ErrorMessage)
+
+			assert.InDelta(t, 7.0, resp.MaxFlow, 1e-9)
+			assert.InDelta(t, resp.MaxFlow, resp.MinCut.Value, 1e-9)
+			assert.Equal(t, []int64{1, 2}, resp.MinCut.SourceSide)
+			require.Len(t, resp.MinCut.Edges, 3)
+			assert.Equal(t, &commonv1.CutEdge{From: 1, To: 3, EdgeId: 2, Capacity: 2}, resp.MinCut.Edges[0])
+			assert.Equal(t, &commonv1.CutEdge{From: 2, To: 4, EdgeId: 3, Capacity: 4}, resp.MinCut.Edges[1])
+			assert.Equal(t, &commonv1.CutEdge{From: 2, To: 3, EdgeId: 5, Capacity: 1}, resp.MinCut.Edges[2])
+		})
+	}
+}
+
+func TestSolverService_GetMinCut_ParallelEdges(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	g := parallelLanesGraph()
+	g.Edges[2].Capacity = 20 // 2->3 больше не узкое место
+
+	resp, err := svc.GetMinCut(context.Background(), &optimizationv1.GetMinCutRequest{
+		Graph:     g,
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	assert.Equal(t, []int64{1}, resp.MinCut.SourceSide)
+	require.Len(t, resp.MinCut.Edges, 2)
+	assert.Equal(t, int64(10), resp.MinCut.Edges[0].EdgeId)
+	assert.Equal(t, int64(20), resp.MinCut.Edges[1].EdgeId)
+	assert.InDelta(t, 10.0, resp.MinCut.Value, 1e-9)
+}
+
+func TestSolverService_GetMinCut_MultiTerminal(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	resp, err := svc.GetMinCut(context.Background(), &optimizationv1.GetMinCutRequest{
+		Graph:     multiTerminalGraph(),
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	// Разрез с минимальной стороной источника: рёбра 3->4 (8) и 3->5 (6)
+	assert.InDelta(t, 14.0, resp.MaxFlow, 1e-9)
+	assert.InDelta(t, 14.0, resp.MinCut.Value, 1e-9)
+	assert.Equal(t, []int64{1, 2, 3}, resp.MinCut.SourceSide)
+	assert.Empty(t, resp.MinCut.SaturatedSupplies)
+	assert.Empty(t, resp.MinCut.SaturatedDemands)
+	require.Len(t, resp.MinCut.Edges, 2)
+	assert.Equal(t, int64(4), resp.MinCut.Edges[0].To)
+	assert.Equal(t, int64(5), resp.MinCut.Edges[1].To)
+}
+
+func TestSolverService_GetMinCut_MinFlow(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	// 3->2 обязано нести 2 единицы, которые возвращаются по 2->3:
+	// разрез {1, 2} = 3 (2->3) - 2 (минимальный поток 3->2)
+	g := &commonv1.Graph{
+		SourceId: 1,
+		SinkId:   4,
+		Nodes:    []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}},
+		Edges: []*commonv1.Edge{
+			{From: 1, To: 2, Capacity: 10},
+			{From: 2, To: 3, Capacity: 3},
+			{From: 3, To: 2, Capacity: 10, MinFlow: 2},
+			{From: 3, To: 4, Capacity: 10},
+		},
+	}
+
+	resp, err := svc.GetMinCut(context.Background(), &optimizationv1.GetMinCutRequest{
+		Graph:     g,
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	assert.InDelta(t, 1.0, resp.MaxFlow, 1e-9)
+	assert.InDelta(t, 1.0, resp.MinCut.Value, 1e-9)
+	assert.Equal(t, []int64{1, 2}, resp.MinCut.SourceSide)
+}
+
+func TestSolverService_Solve_ReturnMinCut(t *testing.T) {
+	mockC := newMockCache()
+	mockC.shouldHit = true
+	mockC.hitData, _ = json.Marshal(&cache.CachedSolveResult{MaxFlow: 42, Status: "FLOW_STATUS_OPTIMAL"})
+	svc := NewSolverService("1.0.0", cache.NewSolverCache(mockC, 10*time.Minute))
+
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:     minFlowGraph(6),
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+		Options:   &optimizationv1.SolveOptions{ReturnMinCut: true},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	// Кэш не хранит разрез, поэтому запрос решается заново
+	assert.InDelta(t, 10.0, resp.Result.MaxFlow, 1e-9)
+	require.NotNil(t, resp.Result.MinCut)
+	assert.InDelta(t, 10.0, resp.Result.MinCut.Value, 1e-9)
+}
+
+func TestSolverService_Solve_ReturnDecomposition_AllAlgorithms(t *testing.T) {
+	algos := []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+		commonv1.Algorithm_ALGORITHM_MIN_COST,
+		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+	}
+
+	svc := NewSolverService("1.0.0", nil)
+
+	for _, algo := range algos {
+		t.Run(algo.String(), func(t *testing.T) {
+			resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+				Graph:     multiTerminalGraph(),
+				Algorithm: algo,
+				Options:   &optimizationv1.SolveOptions{ReturnDecomposition: true},
+			})
+			require.NoError(t, err)
+			require.True(t, resp.Success, resp.ErrorMessage)
+			require.NotNil(t, resp.Result.Decomposition)
+
+			// Пути объясняют весь поток и его стоимость по рёбрам
+			var flow, cost, edgeCost float64
+			for _, p := range resp.Result.Decomposition.Paths {
+				flow += p.Flow
+				cost += p.Cost
+				assert.Contains(t, []int64{1, 2}, p.NodeIds[0])
+				assert.Contains(t, []int64{4, 5}, p.NodeIds[len(p.NodeIds)-1])
+			}
+			for _, c := range resp.Result.Decomposition.Cycles {
+				cost += c.Cost
+			}
+			for _, e := range resp.Result.Edges {
+				edgeCost += e.Flow * e.Cost
+			}
+			assert.InDelta(t, resp.Result.MaxFlow, flow, 1e-9)
+			assert.InDelta(t, edgeCost, cost, 1e-9)
+		})
+	}
+}
+
+func TestSolverService_Solve_ReturnDecompositionBypassesCache(t *testing.T) {
+	mockC := newMockCache()
+	mockC.shouldHit = true
+	mockC.hitData, _ = json.Marshal(&cache.CachedSolveResult{MaxFlow: 42, Status: "FLOW_STATUS_OPTIMAL"})
+	svc := NewSolverService("1.0.0", cache.NewSolverCache(mockC, 10*time.Minute))
+
+	resp, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph:     minFlowGraph(6),
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+		Options:   &optimizationv1.SolveOptions{ReturnDecomposition: true},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	// Кэш не хранит разложение, поэтому запрос решается заново
+	assert.InDelta(t, 10.0, resp.Result.MaxFlow, 1e-9)
+	require.NotNil(t, resp.Result.Decomposition)
+	assert.NotEmpty(t, resp.Result.Decomposition.Paths)
+}
+
+func TestSolverService_Solve_ReturnMinCutTransportation(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	_, err := svc.Solve(context.Background(), &optimizationv1.SolveRequest{
+		Graph: multiTerminalGraph(),
+		Options: &optimizationv1.SolveOptions{
+			Mode:         optimizationv1.SolveMode_SOLVE_MODE_TRANSPORTATION,
+			ReturnMinCut: true,
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// sharedLanesGraph: два маршрута 1->4 — дешёвый через 2 и дорогой через 3,
+// по 10 единиц каждый
+func sharedLanesGraph() *commonv1.Graph {
+	return &commonv1.Graph{
+		Nodes: []*commonv1.Node{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}},
+		Edges: []*commonv1.Edge{
+			{Id: 1, From: 1, To: 2, Capacity: 10, Cost: 1},
+			{Id: 2, From: 2, To: 4, Capacity: 10, Cost: 1},
+			{Id: 3, From: 1, To: 3, Capacity: 10, Cost: 5},
+			{Id: 4, From: 3, To: 4, Capacity: 10, Cost: 5},
+		},
+	}
+}
+
+func TestSolverService_SolveMultiCommodity(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	resp, err := svc.SolveMultiCommodity(context.Background(), &optimizationv1.SolveMultiCommodityRequest{
+		Graph: sharedLanesGraph(),
+		Commodities: []*optimizationv1.Commodity{
+			{Name: "frozen", SourceId: 1, SinkId: 4, Demand: 4},
+			// Опасные грузы не должны идти через узел 2
+			{Id: 7, Name: "hazmat", SourceId: 1, SinkId: 4, Demand: 4, CostOverrides: map[int64]float64{1: 100}},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	result := resp.Result
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_FEASIBLE, result.Status)
+	assert.GreaterOrEqual(t, result.ConcurrentRatio, 1.0)
+	require.Len(t, result.Commodities, 2)
+
+	frozen, hazmat := result.Commodities[0], result.Commodities[1]
+	assert.Equal(t, int64(1), frozen.CommodityId)
+	assert.Equal(t, int64(7), hazmat.CommodityId)
+	assert.InDelta(t, 4.0, frozen.Flow, 1e-6)
+	assert.InDelta(t, 4.0, hazmat.Flow, 1e-6)
+	assert.InDelta(t, frozen.Cost+hazmat.Cost, result.TotalCost, 1e-9)
+
+	// Основная часть опасных грузов идёт через узел 3
+	hazmatVia3 := 0.0
+	for _, e := range hazmat.Edges {
+		if e.EdgeId == 3 {
+			hazmatVia3 = e.Flow
+		}
+	}
+	assert.InDelta(t, 4.0, hazmatVia3, 0.4)
+
+	// Суммарный поток не превышает общую пропускную способность
+	for _, e := range result.Edges {
+		assert.LessOrEqual(t, e.Flow, e.Capacity+1e-9, "edge %d", e.EdgeId)
+	}
+	assert.NotNil(t, resp.Metrics)
+}
+
+func TestSolverService_SolveMultiCommodity_SharedCapacity(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+
+	resp, err := svc.SolveMultiCommodity(context.Background(), &optimizationv1.SolveMultiCommodityRequest{
+		Graph: sharedLanesGraph(),
+		Commodities: []*optimizationv1.Commodity{
+			{SourceId: 1, SinkId: 4, Demand: 15},
+			{SourceId: 1, SinkId: 4, Demand: 15},
+		},
+		Options: &optimizationv1.MultiCommodityOptions{Approximation: 0.05},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.ErrorMessage)
+
+	// Общая пропускная способность 20 из требуемых 30: каждый товар получает 2/3 спроса
+	result := resp.Result
+	assert.Equal(t, commonv1.FlowStatus_FLOW_STATUS_INFEASIBLE, result.Status)
+	assert.LessOrEqual(t, result.ConcurrentRatio, 2.0/3+1e-9)
+	assert.GreaterOrEqual(t, result.ConcurrentRatio, 2.0/3*0.95*0.95*0.95)
+	for _, c := range result.Commodities {
+		assert.InDelta(t, result.ConcurrentRatio*15, c.Flow, 1e-6)
+	}
+}
+
+func TestSolverService_SolveMultiCommodity_Validation(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+	commodity := func(mutate func(c *optimizationv1.Commodity)) []*optimizationv1.Commodity {
+		c := &optimizationv1.Commodity{SourceId: 1, SinkId: 4, Demand: 1}
+		mutate(c)
+		return []*optimizationv1.Commodity{c}
+	}
+
+	withMinFlow := sharedLanesGraph()
+	withMinFlow.Edges[0].MinFlow = 1
+	withNegativeCost := sharedLanesGraph()
+	withNegativeCost.Edges[0].Cost = -1
+
+	tests := []struct {
+		name        string
+		graph       *commonv1.Graph
+		commodities []*optimizationv1.Commodity
+	}{
+		{"NilGraph", nil, commodity(func(*optimizationv1.Commodity) {})},
+		{"NoCommodities", sharedLanesGraph(), nil},
+		{"UnknownSource", sharedLanesGraph(), commodity(func(c *optimizationv1.Commodity) { c.SourceId = 99 })},
+		{"UnknownSink", sharedLanesGraph(), commodity(func(c *optimizationv1.Commodity) { c.SinkId = 99 })},
+		{"SourceEqualsSink", sharedLanesGraph(), commodity(func(c *optimizationv1.Commodity) { c.SinkId = 1 })},
+		{"ZeroDemand", sharedLanesGraph(), commodity(func(c *optimizationv1.Commodity) { c.Demand = 0 })},
+		{"UnknownOverrideEdge", sharedLanesGraph(), commodity(func(c *optimizationv1.Commodity) {
+			c.CostOverrides = map[int64]float64{99: 1}
+		})},
+		{"NegativeOverride", sharedLanesGraph(), commodity(func(c *optimizationv1.Commodity) {
+			c.CostOverrides = map[int64]float64{1: -1}
+		})},
+		{"MinFlow", withMinFlow, commodity(func(*optimizationv1.Commodity) {})},
+		{"NegativeCost", withNegativeCost, commodity(func(*optimizationv1.Commodity) {})},
+		{"DuplicateID", sharedLanesGraph(), []*optimizationv1.Commodity{
+			{Id: 2, SourceId: 1, SinkId: 4, Demand: 1},
+			{SourceId: 1, SinkId: 4, Demand: 1}, // id по позиции — тоже 2
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SolveMultiCommodity(context.Background(), &optimizationv1.SolveMultiCommodityRequest{
+				Graph:       tt.graph,
+				Commodities: tt.commodities,
+			})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSolverService_Solve_WarmStart(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+	ctx := context.Background()
+
+	for _, algo := range []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_MIN_COST,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+	} {
+		t.Run(algo.String(), func(t *testing.T) {
+			previous, err := svc.Solve(ctx, &optimizationv1.SolveRequest{Graph: parallelLanesGraph(), Algorithm: algo})
+			require.NoError(t, err)
+
+			// Дешёвая полоса сокращена, выход в сток расширен
+			modified := parallelLanesGraph()
+			modified.Edges[0].Capacity = 2
+			modified.Edges[2].Capacity = 10
+
+			cold, err := svc.Solve(ctx, &optimizationv1.SolveRequest{Graph: modified, Algorithm: algo})
+			require.NoError(t, err)
+			warm, err := svc.Solve(ctx, &optimizationv1.SolveRequest{
+				Graph:     modified,
+				Algorithm: algo,
+				Options:   &optimizationv1.SolveOptions{WarmStart: previous.SolvedGraph},
+			})
+			require.NoError(t, err)
+
+			// Алгоритмы минимальной стоимости решают с нуля
+			assert.Equal(t, algo == commonv1.Algorithm_ALGORITHM_DINIC, warm.Metrics.WarmStarted)
+			assert.False(t, cold.Metrics.WarmStarted)
+			assert.InDelta(t, 7.0, warm.Result.MaxFlow, 1e-9)
+			assert.InDelta(t, cold.Result.MaxFlow, warm.Result.MaxFlow, 1e-9)
+			if algo != commonv1.Algorithm_ALGORITHM_DINIC {
+				assert.InDelta(t, 52.0, warm.Result.TotalCost, 1e-9)
+			}
+
+			flows := make(map[int64]float64)
+			for _, e := range warm.SolvedGraph.Edges {
+				flows[e.Id] = e.CurrentFlow
+			}
+			assert.InDelta(t, 2.0, flows[10], 1e-9)
+			assert.InDelta(t, 5.0, flows[20], 1e-9)
+			assert.InDelta(t, 7.0, flows[30], 1e-9)
+		})
+	}
+}
+
+func TestSolverService_Solve_WarmStart_MultiTerminal(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+	ctx := context.Background()
+
+	previous, err := svc.Solve(ctx, &optimizationv1.SolveRequest{Graph: multiTerminalGraph()})
+	require.NoError(t, err)
+
+	modified := multiTerminalGraph()
+	modified.Edges[2].Capacity = 4 // 3->4
+
+	resp, err := svc.Solve(ctx, &optimizationv1.SolveRequest{
+		Graph:   modified,
+		Options: &optimizationv1.SolveOptions{WarmStart: previous.SolvedGraph},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, resp.Metrics.WarmStarted)
+	assert.InDelta(t, 10.0, resp.Result.MaxFlow, 1e-9)
+	shipped := 0.0
+	for _, b := range resp.Result.SourceBalances {
+		shipped += b.Shipped
+	}
+	assert.InDelta(t, 10.0, shipped, 1e-9)
+}
+
+func TestSolverService_Solve_WarmStart_IgnoredWithMinFlow(t *testing.T) {
+	svc := NewSolverService("1.0.0", nil)
+	ctx := context.Background()
+
+	previous, err := svc.Solve(ctx, &optimizationv1.SolveRequest{Graph: parallelLanesGraph()})
+	require.NoError(t, err)
+
+	modified := parallelLanesGraph()
+	modified.Edges[1].MinFlow = 1
+
+	resp, err := svc.Solve(ctx, &optimizationv1.SolveRequest{
+		Graph:   modified,
+		Options: &optimizationv1.SolveOptions{WarmStart: previous.SolvedGraph},
+	})
+	require.NoError(t, err)
+
+	assert.False(t, resp.Metrics.WarmStarted)
+	assert.InDelta(t, 8.0, resp.Result.MaxFlow, 1e-9)
+}
+
 // =============================================================================
 // Thread-safe mock cache
 // =============================================================================
@@ -1180,6 +2027,38 @@ func TestSolverService_Solve_WithCache_Hit(t *testing.T) {
 	assert.Equal(t, float64(0), resp.Metrics.ComputationTimeMs, "Cache hit should have 0 computation time")
 }
 
+func TestSolverService_Solve_WithCache_MultiTerminalBalances(t *testing.T) {
+	mockC := newMockCache()
+	svc := NewSolverService("1.0.0", cache.NewSolverCache(mockC, 10*time.Minute))
+	ctx := context.Background()
+
+	req := &optimizationv1.SolveRequest{
+		Graph:     multiTerminalGraph(),
+		Algorithm: commonv1.Algorithm_ALGORITHM_DINIC,
+	}
+
+	// Первый запрос решается и попадает в кэш
+	first, err := svc.Solve(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, first.Result.SinkBalances, 2)
+	require.Eventually(t, func() bool {
+		return mockC.Len() > 0
+	}, time.Second, 10*time.Millisecond)
+
+	// Второй запрос берётся из кэша вместе с балансами узлов
+	hits := svc.stats.cacheHits.Load()
+	resp, err := svc.Solve(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, hits+1, svc.stats.cacheHits.Load())
+
+	require.Len(t, resp.Result.SourceBalances, 2)
+	require.Len(t, resp.Result.SinkBalances, 2)
+	assert.Equal(t, int64(5), resp.Result.SinkBalances[1].NodeId)
+	assert.InDelta(t, 6.0, resp.Result.SinkBalances[1].Received, 1e-9)
+	assert.InDelta(t, 3.0, resp.Result.SinkBalances[1].UnmetDemand, 1e-9)
+	assert.InDelta(t, 9.0, resp.Result.SinkBalances[1].Demand, 1e-9)
+}
+
 func TestSolverService_Solve_WithCache_Miss(t *testing.T) {
 	mockC := newMockCache()
 	solverCache := cache.NewSolverCache(mockC, 10*time.Minute)
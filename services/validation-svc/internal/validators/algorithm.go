@@ -52,6 +52,16 @@ func ValidateForAlgorithm(graph *commonv1.Graph, algo commonv1.Algorithm) *valid
 		checkCostsExist(graph, response)
 		checkNoNegativeCycles(graph, response)
 
+	case commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX:
+		response.Complexity = &validationv1.AlgorithmComplexity{
+			TimeComplexity:      fmt.Sprintf("O(V·E) на практике ≈ O(%d)", n*m),
+			SpaceComplexity:     fmt.Sprintf("O(V+E) ≈ O(%d)", n+m),
+			EstimatedIterations: int64(m),
+			Recommendation:      "Лучший выбор для плотных задач минимальной стоимости",
+		}
+		checkNonNegativeCapacity(graph, response)
+		checkCostsExist(graph, response)
+
 	case commonv1.Algorithm_ALGORITHM_PUSH_RELABEL:
 		response.Complexity = &validationv1.AlgorithmComplexity{
 			TimeComplexity:      fmt.Sprintf("O(V²E) или O(V³) ≈ O(%d)", n*n*m),
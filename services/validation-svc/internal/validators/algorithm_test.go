@@ -35,6 +35,13 @@ func TestValidateForAlgorithm(t *testing.T) {
 			wantCompatible: true,
 			wantIssues:     0,
 		},
+		{
+			name:           "network_simplex_valid",
+			graph:          createValidGraphWithCosts(),
+			algorithm:      commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+			wantCompatible: true,
+			wantIssues:     0,
+		},
 		{
 			name:           "push_relabel_valid",
 			graph:          createValidGraph(),
@@ -92,6 +99,7 @@ func TestValidateForAlgorithm_Complexity(t *testing.T) {
 		{"min_cost", commonv1.Algorithm_ALGORITHM_MIN_COST},
 		{"push_relabel", commonv1.Algorithm_ALGORITHM_PUSH_RELABEL},
 		{"ford_fulkerson", commonv1.Algorithm_ALGORITHM_FORD_FULKERSON},
+		{"network_simplex", commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX},
 	}
 
 	for _, tt := range tests {
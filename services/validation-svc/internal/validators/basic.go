@@ -57,7 +57,23 @@ func ValidateStructure(graph *commonv1.Graph) []*commonv1.ValidationError {
 	}
 
 	// 4. Проверка рёбер
+	edgeIDs := make(map[int64]int, len(graph.Edges))
 	for i, edge := range graph.Edges {
+		// Уникальность ID (без явного Id используется порядковый номер с 1)
+		edgeID := edge.Id
+		if edgeID == 0 {
+			edgeID = int64(i + 1)
+		}
+		if first, exists := edgeIDs[edgeID]; exists {
+			errors = append(errors, &commonv1.ValidationError{
+				Field:   fmt.Sprintf("edges[%d].id", i),
+				Message: fmt.Sprintf("Дубликат ID ребра %d (совпадает с edges[%d])", edgeID, first),
+				Code:    string(pkgerrors.CodeDuplicateEdgeID),
+			})
+		} else {
+			edgeIDs[edgeID] = i
+		}
+
 		// Существование концов
 		if _, ok := nodeMap[edge.From]; !ok {
 			errors = append(errors, &commonv1.ValidationError{
@@ -107,5 +123,8 @@ func ValidateStructure(graph *commonv1.Graph) []*commonv1.ValidationError {
 		}
 	}
 
+	// 5. Минимальные потоки рёбер
+	errors = append(errors, ValidateLowerBounds(graph)...)
+
 	return errors
 }
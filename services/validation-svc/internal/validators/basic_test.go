@@ -45,6 +45,40 @@ func TestValidateStructure(t *testing.T) {
 			wantErrors: 1,
 			wantCodes:  []string{string(pkgerrors.CodeDuplicateNode)},
 		},
+		{
+			name: "duplicate_edge_id",
+			graph: &commonv1.Graph{
+				Nodes: []*commonv1.Node{
+					{Id: 1, Type: commonv1.NodeType_NODE_TYPE_SOURCE},
+					{Id: 2, Type: commonv1.NodeType_NODE_TYPE_SINK},
+				},
+				// Второе ребро явно получает ID 1, уже занятый первым
+				Edges: []*commonv1.Edge{
+					{From: 1, To: 2, Capacity: 10},
+					{From: 1, To: 2, Capacity: 5, Id: 1},
+				},
+				SourceId: 1,
+				SinkId:   2,
+			},
+			wantErrors: 1,
+			wantCodes:  []string{string(pkgerrors.CodeDuplicateEdgeID)},
+		},
+		{
+			name: "parallel_edges",
+			graph: &commonv1.Graph{
+				Nodes: []*commonv1.Node{
+					{Id: 1, Type: commonv1.NodeType_NODE_TYPE_SOURCE},
+					{Id: 2, Type: commonv1.NodeType_NODE_TYPE_SINK},
+				},
+				Edges: []*commonv1.Edge{
+					{From: 1, To: 2, Capacity: 10, Cost: 1},
+					{From: 1, To: 2, Capacity: 5, Cost: 10},
+				},
+				SourceId: 1,
+				SinkId:   2,
+			},
+			wantErrors: 0,
+		},
 		{
 			name: "invalid_source",
 			graph: &commonv1.Graph{
@@ -11,7 +11,7 @@ import (
 
 // ValidateFlowLogic проверяет корректность рассчитанного потока
 func ValidateFlowLogic(graph *commonv1.Graph) []*commonv1.ValidationError {
-	var errors []*commonv1.ValidationError
+	errors := ValidateLowerBounds(graph)
 
 	// Карта баланса (Входящий - Исходящий)
 	balance := make(map[int64]float64)
@@ -22,7 +22,7 @@ func ValidateFlowLogic(graph *commonv1.Graph) []*commonv1.ValidationError {
 			continue
 		}
 
-		// 1. Ограничение пропускной способности (0 <= Flow <= Capacity)
+		// 1. Ограничение пропускной способности (max(0, MinFlow) <= Flow <= Capacity)
 		if edge.CurrentFlow < -domain.Epsilon {
 			errors = append(errors, &commonv1.ValidationError{
 				Field:   fmt.Sprintf("edges[%d]", i),
@@ -37,6 +37,13 @@ func ValidateFlowLogic(graph *commonv1.Graph) []*commonv1.ValidationError {
 				Message: fmt.Sprintf("Поток %.2f превышает capacity %.2f", edge.CurrentFlow, edge.Capacity),
 			})
 		}
+		if edge.MinFlow > 0 && edge.CurrentFlow < edge.MinFlow-domain.Epsilon {
+			errors = append(errors, &commonv1.ValidationError{
+				Field:   fmt.Sprintf("edges[%d]", i),
+				Code:    string(pkgerrors.CodeLowerBoundViolation),
+				Message: fmt.Sprintf("Поток %.2f меньше минимального %.2f", edge.CurrentFlow, edge.MinFlow),
+			})
+		}
 
 		balance[edge.From] -= edge.CurrentFlow
 		balance[edge.To] += edge.CurrentFlow
@@ -80,3 +87,28 @@ func ValidateFlowLogic(graph *commonv1.Graph) []*commonv1.ValidationError {
 
 	return errors
 }
+
+// ValidateLowerBounds проверяет минимальные потоки рёбер (0 <= MinFlow <= Capacity)
+func ValidateLowerBounds(graph *commonv1.Graph) []*commonv1.ValidationError {
+	var errors []*commonv1.ValidationError
+
+	for i, edge := range graph.Edges {
+		if edge.MinFlow < 0 {
+			errors = append(errors, &commonv1.ValidationError{
+				Field:   fmt.Sprintf("edges[%d].min_flow", i),
+				Code:    string(pkgerrors.CodeNegativeLowerBound),
+				Message: "Минимальный поток не может быть отрицательным",
+			})
+			continue
+		}
+		if edge.MinFlow > 0 && edge.MinFlow > edge.Capacity+domain.Epsilon {
+			errors = append(errors, &commonv1.ValidationError{
+				Field:   fmt.Sprintf("edges[%d].min_flow", i),
+				Code:    string(pkgerrors.CodeLowerBoundExceedsCapacity),
+				Message: fmt.Sprintf("Минимальный поток %.2f превышает capacity %.2f", edge.MinFlow, edge.Capacity),
+			})
+		}
+	}
+
+	return errors
+}
@@ -51,6 +51,26 @@ func TestValidateFlowLogic(t *testing.T) {
 			graph:      createZeroFlowGraph(),
 			wantErrors: 0,
 		},
+		{
+			name:       "lower_bound_met",
+			graph:      createLowerBoundFlowGraph(5, 5),
+			wantErrors: 0,
+		},
+		{
+			name:       "lower_bound_violation",
+			graph:      createLowerBoundFlowGraph(7, 5),
+			wantErrors: 1,
+			wantCodes:  []string{string(pkgerrors.CodeLowerBoundViolation)},
+		},
+		{
+			name:       "lower_bound_exceeds_capacity",
+			graph:      createLowerBoundFlowGraph(12, 10),
+			wantErrors: 2,
+			wantCodes: []string{
+				string(pkgerrors.CodeLowerBoundExceedsCapacity),
+				string(pkgerrors.CodeLowerBoundViolation),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -99,6 +119,15 @@ func createFlowImbalanceOnlyGraph() *commonv1.Graph {
 	}
 }
 
+// createLowerBoundFlowGraph creates a valid 1 -> 2 -> 3 flow where 1 -> 2 has a minimum flow.
+func createLowerBoundFlowGraph(minFlow, flow float64) *commonv1.Graph {
+	g := createValidFlowGraph()
+	g.Edges[0].MinFlow = minFlow
+	g.Edges[0].CurrentFlow = flow
+	g.Edges[1].CurrentFlow = flow
+	return g
+}
+
 func createValidFlowGraph() *commonv1.Graph {
 	return &commonv1.Graph{
 		Nodes: []*commonv1.Node{
@@ -200,3 +229,35 @@ func TestValidateFlowLogic_ComplexGraph(t *testing.T) {
 		t.Errorf("expected no errors for valid complex flow, got: %+v", errors)
 	}
 }
+
+func TestValidateLowerBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		minFlow  float64
+		wantCode string
+	}{
+		{name: "no_lower_bound", minFlow: 0},
+		{name: "within_capacity", minFlow: 10},
+		{name: "negative", minFlow: -1, wantCode: string(pkgerrors.CodeNegativeLowerBound)},
+		{name: "exceeds_capacity", minFlow: 11, wantCode: string(pkgerrors.CodeLowerBoundExceedsCapacity)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := createValidFlowGraph()
+			graph.Edges[1].MinFlow = tt.minFlow
+
+			errors := ValidateLowerBounds(graph)
+
+			if tt.wantCode == "" {
+				if len(errors) != 0 {
+					t.Errorf("expected no errors, got: %+v", errors)
+				}
+				return
+			}
+			if len(errors) != 1 || errors[0].Code != tt.wantCode || errors[0].Field != "edges[1].min_flow" {
+				t.Errorf("expected single %s error on edges[1].min_flow, got: %+v", tt.wantCode, errors)
+			}
+		})
+	}
+}
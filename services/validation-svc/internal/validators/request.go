@@ -27,11 +27,12 @@ func ValidateAlgorithmChoice(algo commonv1.Algorithm) []*commonv1.ValidationErro
 	var errors []*commonv1.ValidationError
 
 	validAlgorithms := map[commonv1.Algorithm]bool{
-		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP:   true,
-		commonv1.Algorithm_ALGORITHM_DINIC:          true,
-		commonv1.Algorithm_ALGORITHM_MIN_COST:       true,
-		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL:   true,
-		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON: true,
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP:    true,
+		commonv1.Algorithm_ALGORITHM_DINIC:           true,
+		commonv1.Algorithm_ALGORITHM_MIN_COST:        true,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL:    true,
+		commonv1.Algorithm_ALGORITHM_FORD_FULKERSON:  true,
+		commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX: true,
 	}
 
 	if algo != commonv1.Algorithm_ALGORITHM_UNSPECIFIED && !validAlgorithms[algo] {
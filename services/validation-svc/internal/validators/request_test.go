@@ -66,6 +66,11 @@ func TestValidateAlgorithmChoice(t *testing.T) {
 			algorithm:  commonv1.Algorithm_ALGORITHM_FORD_FULKERSON,
 			wantErrors: 0,
 		},
+		{
+			name:       "network_simplex",
+			algorithm:  commonv1.Algorithm_ALGORITHM_NETWORK_SIMPLEX,
+			wantErrors: 0,
+		},
 		{
 			name:       "unspecified",
 			algorithm:  commonv1.Algorithm_ALGORITHM_UNSPECIFIED,
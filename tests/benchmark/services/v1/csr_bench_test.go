@@ -0,0 +1,138 @@
+package services_benchmark
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	commonv1 "logistics/gen/go/logistics/common/v1"
+	optimizationv1 "logistics/gen/go/logistics/optimization/v1"
+
+	solversvc "logistics/services/solver-svc"
+)
+
+// =============================================================================
+// CSR vs MAP RESIDUAL GRAPH BENCHMARKS
+// Compares the array-based CSR residual graph with the map-based one on
+// road-like networks. Both servers are called directly (no gRPC) so that the
+// difference comes from the solver alone.
+// =============================================================================
+
+var (
+	csrServer = solversvc.NewBenchmarkServerWithCSRThreshold(1)
+	mapServer = solversvc.NewBenchmarkServerWithCSRThreshold(-1)
+)
+
+var (
+	csrMaxFlowAlgorithms = []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_EDMONDS_KARP,
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+	}
+	csrAllAlgorithms = append(csrMaxFlowAlgorithms, commonv1.Algorithm_ALGORITHM_MIN_COST)
+)
+
+// generateRoadProtoGraph creates an NxN grid of two-way roads with random
+// capacities and costs, fed from the west edge and drained on the east edge.
+// Each road is two antiparallel edges, as in real road graphs; a 224x224
+// grid has about 200k edges.
+func generateRoadProtoGraph(n int) *commonv1.Graph {
+	r := rand.New(rand.NewSource(42))
+
+	source := int64(n * n)
+	sink := source + 1
+	nodes := make([]*commonv1.Node, n*n, n*n+2)
+	edges := make([]*commonv1.Edge, 0, 4*n*n+2*n)
+
+	addRoad := func(a, b int64) {
+		capacity := float64(r.Intn(50) + 10)
+		cost := float64(r.Intn(9) + 1)
+		edges = append(edges,
+			&commonv1.Edge{From: a, To: b, Capacity: capacity, Cost: cost},
+			&commonv1.Edge{From: b, To: a, Capacity: capacity, Cost: cost},
+		)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			id := int64(i*n + j)
+			nodes[id] = &commonv1.Node{Id: id}
+			if j < n-1 {
+				addRoad(id, id+1)
+			}
+			if i < n-1 {
+				addRoad(id, id+int64(n))
+			}
+		}
+
+		edges = append(edges,
+			&commonv1.Edge{From: source, To: int64(i * n), Capacity: 1000, Cost: 1},
+			&commonv1.Edge{From: int64(i*n + n - 1), To: sink, Capacity: 1000, Cost: 1},
+		)
+	}
+	nodes = append(nodes, &commonv1.Node{Id: source}, &commonv1.Node{Id: sink})
+
+	return &commonv1.Graph{
+		Nodes:    nodes,
+		Edges:    edges,
+		SourceId: source,
+		SinkId:   sink,
+	}
+}
+
+// solveDirect executes benchmark by calling the server without gRPC
+func solveDirect(b *testing.B, server optimizationv1.SolverServiceServer, graph *commonv1.Graph, algorithm commonv1.Algorithm) {
+	ctx := context.Background()
+	req := &optimizationv1.SolveRequest{
+		Graph:     graph,
+		Algorithm: algorithm,
+		// The map-based runs on large graphs exceed the default timeout
+		Options: &optimizationv1.SolveOptions{TimeoutSeconds: 600},
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp, err := server.Solve(ctx, req)
+		if err != nil {
+			b.Fatalf("Solve failed: %v", err)
+		}
+		if !resp.Success {
+			b.Fatalf("Solve returned unsuccessful: %s", resp.ErrorMessage)
+		}
+	}
+}
+
+func benchmarkCSRvsMap(b *testing.B, graph *commonv1.Graph, algorithms []commonv1.Algorithm) {
+	for _, algo := range algorithms {
+		b.Run(fmt.Sprintf("%s/Map", algo), func(b *testing.B) {
+			solveDirect(b, mapServer, graph, algo)
+		})
+		b.Run(fmt.Sprintf("%s/CSR", algo), func(b *testing.B) {
+			solveDirect(b, csrServer, graph, algo)
+		})
+	}
+}
+
+func BenchmarkCSR_Road_50x50(b *testing.B) {
+	benchmarkCSRvsMap(b, generateRoadProtoGraph(50), csrAllAlgorithms)
+}
+
+func BenchmarkCSR_Road_100x100(b *testing.B) {
+	benchmarkCSRvsMap(b, generateRoadProtoGraph(100), csrMaxFlowAlgorithms)
+}
+
+func BenchmarkCSR_Road_224x224(b *testing.B) {
+	if testing.Short() {
+		b.Skip("Skipping 200k-edge road graph in short mode")
+	}
+	benchmarkCSRvsMap(b, generateRoadProtoGraph(224), []commonv1.Algorithm{
+		commonv1.Algorithm_ALGORITHM_DINIC,
+		commonv1.Algorithm_ALGORITHM_PUSH_RELABEL,
+	})
+}
+
+func BenchmarkCSR_Layered_20x50(b *testing.B) {
+	benchmarkCSRvsMap(b, generateLayeredProtoGraph(20, 50, 5), csrAllAlgorithms)
+}
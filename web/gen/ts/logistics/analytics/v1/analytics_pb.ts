@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file logistics/analytics/v1/analytics.proto.
  */
 export const file_logistics_analytics_v1_analytics: GenFile = /*@__PURE__*/
-  fileDesc("CiZsb2dpc3RpY3MvYW5hbHl0aWNzL3YxL2FuYWx5dGljcy5wcm90bxIWbG9naXN0aWNzLmFuYWx5dGljcy52MSJ3ChRDYWxjdWxhdGVDb3N0UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSNAoHb3B0aW9ucxgCIAEoCzIjLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ29zdE9wdGlvbnMi8wIKC0Nvc3RPcHRpb25zEhAKCGN1cnJlbmN5GAEgASgJEhsKE2luY2x1ZGVfZml4ZWRfY29zdHMYAiABKAgSUgoQY29zdF9tdWx0aXBsaWVycxgDIAMoCzI4LmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ29zdE9wdGlvbnMuQ29zdE11bHRpcGxpZXJzRW50cnkSPAoLZml4ZWRfY29zdHMYBCABKAsyJy5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkZpeGVkQ29zdENvbmZpZxI5CgRtb2RlGAUgASgOMisubG9naXN0aWNzLmFuYWx5dGljcy52MS5Db3N0Q2FsY3VsYXRpb25Nb2RlEhgKEGRpc2NvdW50X3BlcmNlbnQYBiABKAESFgoObWFya3VwX3BlcmNlbnQYByABKAEaNgoUQ29zdE11bHRpcGxpZXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASLNAgoPRml4ZWRDb3N0Q29uZmlnEhYKDndhcmVob3VzZV9jb3N0GAEgASgBEhsKE2RlbGl2ZXJ5X3BvaW50X2Nvc3QYAiABKAESGQoRaW50ZXJzZWN0aW9uX2Nvc3QYAyABKAESXAoUcm9hZF90eXBlX2Jhc2VfY29zdHMYBCADKAsyPi5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkZpeGVkQ29zdENvbmZpZy5Sb2FkVHlwZUJhc2VDb3N0c0VudHJ5EhsKE2Jhc2Vfb3BlcmF0aW9uX2Nvc3QYBSABKAESFQoNcGVyX2VkZ2VfY29zdBgGIAEoARIeChZwZXJfdW5pdF9oYW5kbGluZ19jb3N0GAcgASgBGjgKFlJvYWRUeXBlQmFzZUNvc3RzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASJ3ChVDYWxjdWxhdGVDb3N0UmVzcG9uc2USEgoKdG90YWxfY29zdBgBIAEoARIQCghjdXJyZW5jeRgCIAEoCRI4CglicmVha2Rvd24YAyABKAsyJS5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkNvc3RCcmVha2Rvd24imQQKDUNvc3RCcmVha2Rvd24SFgoOdHJhbnNwb3J0X2Nvc3QYASABKAESEgoKZml4ZWRfY29zdBgCIAEoARIVCg1oYW5kbGluZ19jb3N0GAMgASgBEhYKDnJvYWRfYmFzZV9jb3N0GAQgASgBEhcKD2Rpc2NvdW50X2Ftb3VudBgFIAEoARIVCg1tYXJrdXBfYW1vdW50GAYgASgBElQKEWNvc3RfYnlfcm9hZF90eXBlGAcgAygLMjkubG9naXN0aWNzLmFuYWx5dGljcy52MS5Db3N0QnJlYWtkb3duLkNvc3RCeVJvYWRUeXBlRW50cnkSVAoRY29zdF9ieV9ub2RlX3R5cGUYCCADKAsyOS5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkNvc3RCcmVha2Rvd24uQ29zdEJ5Tm9kZVR5cGVFbnRyeRIZChFhY3RpdmVfd2FyZWhvdXNlcxgJIAEoBRIeChZhY3RpdmVfZGVsaXZlcnlfcG9pbnRzGAogASgFEhQKDGFjdGl2ZV9lZGdlcxgLIAEoBRISCgp0b3RhbF9mbG93GAwgASgBGjUKE0Nvc3RCeVJvYWRUeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ARo1ChNDb3N0QnlOb2RlVHlwZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEicQoWRmluZEJvdHRsZW5lY2tzUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSHQoVdXRpbGl6YXRpb25fdGhyZXNob2xkGAIgASgBEg0KBXRvcF9uGAMgASgFIpMBChdGaW5kQm90dGxlbmVja3NSZXNwb25zZRI3Cgtib3R0bGVuZWNrcxgBIAMoCzIiLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQm90dGxlbmVjaxI/Cg9yZWNvbW1lbmRhdGlvbnMYAiADKAsyJi5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLlJlY29tbWVuZGF0aW9uIp4BCgpCb3R0bGVuZWNrEicKBGVkZ2UYASABKAsyGS5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2USEwoLdXRpbGl6YXRpb24YAiABKAESFAoMaW1wYWN0X3Njb3JlGAMgASgBEjwKCHNldmVyaXR5GAQgASgOMioubG9naXN0aWNzLmFuYWx5dGljcy52MS5Cb3R0bGVuZWNrU2V2ZXJpdHkinwEKDlJlY29tbWVuZGF0aW9uEgwKBHR5cGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSMwoNYWZmZWN0ZWRfZWRnZRgDIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIdChVlc3RpbWF0ZWRfaW1wcm92ZW1lbnQYBCABKAESFgoOZXN0aW1hdGVkX2Nvc3QYBSABKAEieQoSQW5hbHl6ZUZsb3dSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI4CgdvcHRpb25zGAIgASgLMicubG9naXN0aWNzLmFuYWx5dGljcy52MS5BbmFseXNpc09wdGlvbnMinAEKD0FuYWx5c2lzT3B0aW9ucxIVCg1hbmFseXplX2Nvc3RzGAEgASgIEhgKEGZpbmRfYm90dGxlbmVja3MYAiABKAgSHAoUY2FsY3VsYXRlX3N0YXRpc3RpY3MYAyABKAgSHAoUc3VnZ2VzdF9pbXByb3ZlbWVudHMYBCABKAgSHAoUYm90dGxlbmVja190aHJlc2hvbGQYBSABKAEiygIKE0FuYWx5emVGbG93UmVzcG9uc2USNwoKZmxvd19zdGF0cxgBIAEoCzIjLmxvZ2lzdGljcy5jb21tb24udjEuRmxvd1N0YXRpc3RpY3MSOQoLZ3JhcGhfc3RhdHMYAiABKAsyJC5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoU3RhdGlzdGljcxI7CgRjb3N0GAMgASgLMi0ubG9naXN0aWNzLmFuYWx5dGljcy52MS5DYWxjdWxhdGVDb3N0UmVzcG9uc2USRAoLYm90dGxlbmVja3MYBCABKAsyLy5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkZpbmRCb3R0bGVuZWNrc1Jlc3BvbnNlEjwKCmVmZmljaWVuY3kYBSABKAsyKC5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkVmZmljaWVuY3lSZXBvcnQilgEKEEVmZmljaWVuY3lSZXBvcnQSGgoSb3ZlcmFsbF9lZmZpY2llbmN5GAEgASgBEhwKFGNhcGFjaXR5X3V0aWxpemF0aW9uGAIgASgBEhoKEnVudXNlZF9lZGdlc19jb3VudBgDIAEoBRIdChVzYXR1cmF0ZWRfZWRnZXNfY291bnQYBCABKAUSDQoFZ3JhZGUYBSABKAkijgEKF0NvbXBhcmVTY2VuYXJpb3NSZXF1ZXN0EiwKCGJhc2VsaW5lGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBItCglzY2VuYXJpb3MYAiADKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEhYKDnNjZW5hcmlvX25hbWVzGAMgAygJIoYBChhDb21wYXJlU2NlbmFyaW9zUmVzcG9uc2USNwoHcmVzdWx0cxgBIAMoCzImLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuU2NlbmFyaW9SZXN1bHQSFQoNYmVzdF9zY2VuYXJpbxgCIAEoCRIaChJjb21wYXJpc29uX3N1bW1hcnkYAyABKAkieQoOU2NlbmFyaW9SZXN1bHQSDAoEbmFtZRgBIAEoCRIQCghtYXhfZmxvdxgCIAEoARISCgp0b3RhbF9jb3N0GAMgASgBEhIKCmVmZmljaWVuY3kYBCABKAESHwoXaW1wcm92ZW1lbnRfdnNfYmFzZWxpbmUYBSABKAEqpAEKE0Nvc3RDYWxjdWxhdGlvbk1vZGUSJQohQ09TVF9DQUxDVUxBVElPTl9NT0RFX1VOU1BFQ0lGSUVEEAASIAocQ09TVF9DQUxDVUxBVElPTl9NT0RFX1NJTVBMRRABEiQKIENPU1RfQ0FMQ1VMQVRJT05fTU9ERV9XSVRIX0ZJWEVEEAISHgoaQ09TVF9DQUxDVUxBVElPTl9NT0RFX0ZVTEwQAyq2AQoSQm90dGxlbmVja1NldmVyaXR5EiMKH0JPVFRMRU5FQ0tfU0VWRVJJVFlfVU5TUEVDSUZJRUQQABIbChdCT1RUTEVORUNLX1NFVkVSSVRZX0xPVxABEh4KGkJPVFRMRU5FQ0tfU0VWRVJJVFlfTUVESVVNEAISHAoYQk9UVExFTkVDS19TRVZFUklUWV9ISUdIEAMSIAocQk9UVExFTkVDS19TRVZFUklUWV9DUklUSUNBTBAEMtMDChBBbmFseXRpY3NTZXJ2aWNlEmwKDUNhbGN1bGF0ZUNvc3QSLC5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkNhbGN1bGF0ZUNvc3RSZXF1ZXN0Gi0ubG9naXN0aWNzLmFuYWx5dGljcy52MS5DYWxjdWxhdGVDb3N0UmVzcG9uc2UScgoPRmluZEJvdHRsZW5lY2tzEi4ubG9naXN0aWNzLmFuYWx5dGljcy52MS5GaW5kQm90dGxlbmVja3NSZXF1ZXN0Gi8ubG9naXN0aWNzLmFuYWx5dGljcy52MS5GaW5kQm90dGxlbmVja3NSZXNwb25zZRJmCgtBbmFseXplRmxvdxIqLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQW5hbHl6ZUZsb3dSZXF1ZXN0GisubG9naXN0aWNzLmFuYWx5dGljcy52MS5BbmFseXplRmxvd1Jlc3BvbnNlEnUKEENvbXBhcmVTY2VuYXJpb3MSLy5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkNvbXBhcmVTY2VuYXJpb3NSZXF1ZXN0GjAubG9naXN0aWNzLmFuYWx5dGljcy52MS5Db21wYXJlU2NlbmFyaW9zUmVzcG9uc2VC2wEKGmNvbS5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxQg5BbmFseXRpY3NQcm90b1ABWjNsb2dpc3RpY3MvZ2VuL2dvL2xvZ2lzdGljcy9hbmFseXRpY3MvdjE7YW5hbHl0aWNzdjGiAgNMQViqAhZMb2dpc3RpY3MuQW5hbHl0aWNzLlYxygIWTG9naXN0aWNzXEFuYWx5dGljc1xWMeICIkxvZ2lzdGljc1xBbmFseXRpY3NcVjFcR1BCTWV0YWRhdGHqAhhMb2dpc3RpY3M6OkFuYWx5dGljczo6VjFiBnByb3RvMw", [file_logistics_common_v1_common]);
+  fileDesc("CiZsb2dpc3RpY3MvYW5hbHl0aWNzL3YxL2FuYWx5dGljcy5wcm90bxIWbG9naXN0aWNzLmFuYWx5dGljcy52MSJ3ChRDYWxjdWxhdGVDb3N0UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSNAoHb3B0aW9ucxgCIAEoCzIjLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ29zdE9wdGlvbnMi8wIKC0Nvc3RPcHRpb25zEhAKCGN1cnJlbmN5GAEgASgJEhsKE2luY2x1ZGVfZml4ZWRfY29zdHMYAiABKAgSUgoQY29zdF9tdWx0aXBsaWVycxgDIAMoCzI4LmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ29zdE9wdGlvbnMuQ29zdE11bHRpcGxpZXJzRW50cnkSPAoLZml4ZWRfY29zdHMYBCABKAsyJy5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkZpeGVkQ29zdENvbmZpZxI5CgRtb2RlGAUgASgOMisubG9naXN0aWNzLmFuYWx5dGljcy52MS5Db3N0Q2FsY3VsYXRpb25Nb2RlEhgKEGRpc2NvdW50X3BlcmNlbnQYBiABKAESFgoObWFya3VwX3BlcmNlbnQYByABKAEaNgoUQ29zdE11bHRpcGxpZXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASLaAwoPRml4ZWRDb3N0Q29uZmlnEhYKDndhcmVob3VzZV9jb3N0GAEgASgBEhsKE2RlbGl2ZXJ5X3BvaW50X2Nvc3QYAiABKAESGQoRaW50ZXJzZWN0aW9uX2Nvc3QYAyABKAESXAoUcm9hZF90eXBlX2Jhc2VfY29zdHMYBCADKAsyPi5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkZpeGVkQ29zdENvbmZpZy5Sb2FkVHlwZUJhc2VDb3N0c0VudHJ5EhsKE2Jhc2Vfb3BlcmF0aW9uX2Nvc3QYBSABKAESFQoNcGVyX2VkZ2VfY29zdBgGIAEoARIeChZwZXJfdW5pdF9oYW5kbGluZ19jb3N0GAcgASgBElQKD3dhcmVob3VzZV9jb3N0cxgIIAMoCzI7LmxvZ2lzdGljcy5hbmFseXRpY3MudjEuRml4ZWRDb3N0Q29uZmlnLldhcmVob3VzZUNvc3RzRW50cnkaOAoWUm9hZFR5cGVCYXNlQ29zdHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBGjUKE1dhcmVob3VzZUNvc3RzRW50cnkSCwoDa2V5GAEgASgDEg0KBXZhbHVlGAIgASgBOgI4ASJ3ChVDYWxjdWxhdGVDb3N0UmVzcG9uc2USEgoKdG90YWxfY29zdBgBIAEoARIQCghjdXJyZW5jeRgCIAEoCRI4CglicmVha2Rvd24YAyABKAsyJS5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkNvc3RCcmVha2Rvd24imQQKDUNvc3RCcmVha2Rvd24SFgoOdHJhbnNwb3J0X2Nvc3QYASABKAESEgoKZml4ZWRfY29zdBgCIAEoARIVCg1oYW5kbGluZ19jb3N0GAMgASgBEhYKDnJvYWRfYmFzZV9jb3N0GAQgASgBEhcKD2Rpc2NvdW50X2Ftb3VudBgFIAEoARIVCg1tYXJrdXBfYW1vdW50GAYgASgBElQKEWNvc3RfYnlfcm9hZF90eXBlGAcgAygLMjkubG9naXN0aWNzLmFuYWx5dGljcy52MS5Db3N0QnJlYWtkb3duLkNvc3RCeVJvYWRUeXBlRW50cnkSVAoRY29zdF9ieV9ub2RlX3R5cGUYCCADKAsyOS5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkNvc3RCcmVha2Rvd24uQ29zdEJ5Tm9kZVR5cGVFbnRyeRIZChFhY3RpdmVfd2FyZWhvdXNlcxgJIAEoBRIeChZhY3RpdmVfZGVsaXZlcnlfcG9pbnRzGAogASgFEhQKDGFjdGl2ZV9lZGdlcxgLIAEoBRISCgp0b3RhbF9mbG93GAwgASgBGjUKE0Nvc3RCeVJvYWRUeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ARo1ChNDb3N0QnlOb2RlVHlwZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEicQoWRmluZEJvdHRsZW5lY2tzUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSHQoVdXRpbGl6YXRpb25fdGhyZXNob2xkGAIgASgBEg0KBXRvcF9uGAMgASgFIpMBChdGaW5kQm90dGxlbmVja3NSZXNwb25zZRI3Cgtib3R0bGVuZWNrcxgBIAMoCzIiLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQm90dGxlbmVjaxI/Cg9yZWNvbW1lbmRhdGlvbnMYAiADKAsyJi5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLlJlY29tbWVuZGF0aW9uIpQCCgpCb3R0bGVuZWNrEicKBGVkZ2UYASABKAsyGS5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2USEwoLdXRpbGl6YXRpb24YAiABKAESFAoMaW1wYWN0X3Njb3JlGAMgASgBEjwKCHNldmVyaXR5GAQgASgOMioubG9naXN0aWNzLmFuYWx5dGljcy52MS5Cb3R0bGVuZWNrU2V2ZXJpdHkSEgoKaW5fbWluX2N1dBgFIAEoCBIaChJtYXJnaW5hbF9mbG93X2dhaW4YBiABKAESEQoJZmxvd19nYWluGAcgASgBEhwKFG1hcmdpbmFsX2Nvc3Rfc2F2aW5nGAggASgBEhMKC2Nvc3Rfc2F2aW5nGAkgASgBIp8BCg5SZWNvbW1lbmRhdGlvbhIMCgR0eXBlGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEjMKDWFmZmVjdGVkX2VkZ2UYAyABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSHQoVZXN0aW1hdGVkX2ltcHJvdmVtZW50GAQgASgBEhYKDmVzdGltYXRlZF9jb3N0GAUgASgBInkKEkFuYWx5emVGbG93UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOAoHb3B0aW9ucxgCIAEoCzInLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQW5hbHlzaXNPcHRpb25zIpwBCg9BbmFseXNpc09wdGlvbnMSFQoNYW5hbHl6ZV9jb3N0cxgBIAEoCBIYChBmaW5kX2JvdHRsZW5lY2tzGAIgASgIEhwKFGNhbGN1bGF0ZV9zdGF0aXN0aWNzGAMgASgIEhwKFHN1Z2dlc3RfaW1wcm92ZW1lbnRzGAQgASgIEhwKFGJvdHRsZW5lY2tfdGhyZXNob2xkGAUgASgBIsoCChNBbmFseXplRmxvd1Jlc3BvbnNlEjcKCmZsb3dfc3RhdHMYASABKAsyIy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dTdGF0aXN0aWNzEjkKC2dyYXBoX3N0YXRzGAIgASgLMiQubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaFN0YXRpc3RpY3MSOwoEY29zdBgDIAEoCzItLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ2FsY3VsYXRlQ29zdFJlc3BvbnNlEkQKC2JvdHRsZW5lY2tzGAQgASgLMi8ubG9naXN0aWNzLmFuYWx5dGljcy52MS5GaW5kQm90dGxlbmVja3NSZXNwb25zZRI8CgplZmZpY2llbmN5GAUgASgLMigubG9naXN0aWNzLmFuYWx5dGljcy52MS5FZmZpY2llbmN5UmVwb3J0IpYBChBFZmZpY2llbmN5UmVwb3J0EhoKEm92ZXJhbGxfZWZmaWNpZW5jeRgBIAEoARIcChRjYXBhY2l0eV91dGlsaXphdGlvbhgCIAEoARIaChJ1bnVzZWRfZWRnZXNfY291bnQYAyABKAUSHQoVc2F0dXJhdGVkX2VkZ2VzX2NvdW50GAQgASgFEg0KBWdyYWRlGAUgASgJIo4BChdDb21wYXJlU2NlbmFyaW9zUmVxdWVzdBIsCghiYXNlbGluZRgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSLQoJc2NlbmFyaW9zGAIgAygLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIWCg5zY2VuYXJpb19uYW1lcxgDIAMoCSKGAQoYQ29tcGFyZVNjZW5hcmlvc1Jlc3BvbnNlEjcKB3Jlc3VsdHMYASADKAsyJi5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLlNjZW5hcmlvUmVzdWx0EhUKDWJlc3Rfc2NlbmFyaW8YAiABKAkSGgoSY29tcGFyaXNvbl9zdW1tYXJ5GAMgASgJInkKDlNjZW5hcmlvUmVzdWx0EgwKBG5hbWUYASABKAkSEAoIbWF4X2Zsb3cYAiABKAESEgoKdG90YWxfY29zdBgDIAEoARISCgplZmZpY2llbmN5GAQgASgBEh8KF2ltcHJvdmVtZW50X3ZzX2Jhc2VsaW5lGAUgASgBIlAKI0J1aWxkT3JpZ2luRGVzdGluYXRpb25NYXRyaXhSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaCKBAwokQnVpbGRPcmlnaW5EZXN0aW5hdGlvbk1hdHJpeFJlc3BvbnNlEjwKBWNlbGxzGAEgAygLMi0ubG9naXN0aWNzLmFuYWx5dGljcy52MS5PcmlnaW5EZXN0aW5hdGlvbkNlbGwSPwoHb3JpZ2lucxgCIAMoCzIuLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuT3JpZ2luRGVzdGluYXRpb25Ub3RhbBJECgxkZXN0aW5hdGlvbnMYAyADKAsyLi5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLk9yaWdpbkRlc3RpbmF0aW9uVG90YWwSFAoMdG90YWxfdm9sdW1lGAQgASgBEhIKCnRvdGFsX2Nvc3QYBSABKAESFAoMYXZlcmFnZV9jb3N0GAYgASgBEhYKDmF2ZXJhZ2VfbGVuZ3RoGAcgASgBEhIKCnBhdGhfY291bnQYCCABKAUSFAoMY3ljbGVfdm9sdW1lGAkgASgBEhIKCmN5Y2xlX2Nvc3QYCiABKAEi8gEKFU9yaWdpbkRlc3RpbmF0aW9uQ2VsbBIRCglvcmlnaW5faWQYASABKAMSEwoLb3JpZ2luX25hbWUYAiABKAkSFgoOZGVzdGluYXRpb25faWQYAyABKAMSGAoQZGVzdGluYXRpb25fbmFtZRgEIAEoCRIOCgZ2b2x1bWUYBSABKAESEgoKdG90YWxfY29zdBgGIAEoARIUCgxhdmVyYWdlX2Nvc3QYByABKAESFgoOYXZlcmFnZV9sZW5ndGgYCCABKAESEgoKcGF0aF9jb3VudBgJIAEoBRIZChFkZXN0aW5hdGlvbl9zaGFyZRgKIAEoASKJAQoWT3JpZ2luRGVzdGluYXRpb25Ub3RhbBIPCgdub2RlX2lkGAEgASgDEgwKBG5hbWUYAiABKAkSDgoGdm9sdW1lGAMgASgBEhIKCnRvdGFsX2Nvc3QYBCABKAESFAoMYXZlcmFnZV9jb3N0GAUgASgBEhYKDmF2ZXJhZ2VfbGVuZ3RoGAYgASgBKqQBChNDb3N0Q2FsY3VsYXRpb25Nb2RlEiUKIUNPU1RfQ0FMQ1VMQVRJT05fTU9ERV9VTlNQRUNJRklFRBAAEiAKHENPU1RfQ0FMQ1VMQVRJT05fTU9ERV9TSU1QTEUQARIkCiBDT1NUX0NBTENVTEFUSU9OX01PREVfV0lUSF9GSVhFRBACEh4KGkNPU1RfQ0FMQ1VMQVRJT05fTU9ERV9GVUxMEAMqtgEKEkJvdHRsZW5lY2tTZXZlcml0eRIjCh9CT1RUTEVORUNLX1NFVkVSSVRZX1VOU1BFQ0lGSUVEEAASGwoXQk9UVExFTkVDS19TRVZFUklUWV9MT1cQARIeChpCT1RUTEVORUNLX1NFVkVSSVRZX01FRElVTRACEhwKGEJPVFRMRU5FQ0tfU0VWRVJJVFlfSElHSBADEiAKHEJPVFRMRU5FQ0tfU0VWRVJJVFlfQ1JJVElDQUwQBDLvBAoQQW5hbHl0aWNzU2VydmljZRJsCg1DYWxjdWxhdGVDb3N0EiwubG9naXN0aWNzLmFuYWx5dGljcy52MS5DYWxjdWxhdGVDb3N0UmVxdWVzdBotLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ2FsY3VsYXRlQ29zdFJlc3BvbnNlEnIKD0ZpbmRCb3R0bGVuZWNrcxIuLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuRmluZEJvdHRsZW5lY2tzUmVxdWVzdBovLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuRmluZEJvdHRsZW5lY2tzUmVzcG9uc2USZgoLQW5hbHl6ZUZsb3cSKi5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkFuYWx5emVGbG93UmVxdWVzdBorLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQW5hbHl6ZUZsb3dSZXNwb25zZRJ1ChBDb21wYXJlU2NlbmFyaW9zEi8ubG9naXN0aWNzLmFuYWx5dGljcy52MS5Db21wYXJlU2NlbmFyaW9zUmVxdWVzdBowLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ29tcGFyZVNjZW5hcmlvc1Jlc3BvbnNlEpkBChxCdWlsZE9yaWdpbkRlc3RpbmF0aW9uTWF0cml4EjsubG9naXN0aWNzLmFuYWx5dGljcy52MS5CdWlsZE9yaWdpbkRlc3RpbmF0aW9uTWF0cml4UmVxdWVzdBo8LmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQnVpbGRPcmlnaW5EZXN0aW5hdGlvbk1hdHJpeFJlc3BvbnNlQtsBChpjb20ubG9naXN0aWNzLmFuYWx5dGljcy52MUIOQW5hbHl0aWNzUHJvdG9QAVozbG9naXN0aWNzL2dlbi9nby9sb2dpc3RpY3MvYW5hbHl0aWNzL3YxO2FuYWx5dGljc3YxogIDTEFYqgIWTG9naXN0aWNzLkFuYWx5dGljcy5WMcoCFkxvZ2lzdGljc1xBbmFseXRpY3NcVjHiAiJMb2dpc3RpY3NcQW5hbHl0aWNzXFYxXEdQQk1ldGFkYXRh6gIYTG9naXN0aWNzOjpBbmFseXRpY3M6OlYxYgZwcm90bzM", [file_logistics_common_v1_common]);
 
 /**
  * @generated from message logistics.analytics.v1.CalculateCostRequest
@@ -161,6 +161,14 @@ export type FixedCostConfig = Message<"logistics.analytics.v1.FixedCostConfig">
    * @generated from field: double per_unit_handling_cost = 7;
    */
   perUnitHandlingCost: number;
+
+  /**
+   * Стоимость открытия отдельных складов по ID узла; склады без записи
+   * стоят warehouse_cost
+   *
+   * @generated from field: map<int64, double> warehouse_costs = 8;
+   */
+  warehouseCosts: { [key: string]: number };
 };
 
 /**
@@ -349,6 +357,12 @@ export const FindBottlenecksResponseSchema: GenMessage<FindBottlenecksResponse>
   messageDesc(file_logistics_analytics_v1_analytics, 6);
 
 /**
+ * Предельная ценность ребра считается по остаточной сети решения: насыщенное
+ * ребро (u,v) ограничивает максимальный поток, если u достижим из истока, а
+ * из v достижим сток (ребро входит в минимальный разрез). Иначе расширение
+ * может только удешевить поток — за счёт перенаправления по циклу
+ * отрицательной стоимости через ребро
+ *
  * @generated from message logistics.analytics.v1.Bottleneck
  */
 export type Bottleneck = Message<"logistics.analytics.v1.Bottleneck"> & {
@@ -365,7 +379,9 @@ export type Bottleneck = Message<"logistics.analytics.v1.Bottleneck"> & {
   utilization: number;
 
   /**
-   * Влияние на общий поток (0-1)
+   * Относительный выигрыш от удвоения пропускной способности: flow_gain от
+   * текущего потока или cost_saving от текущей стоимости. Узкие места
+   * упорядочены по in_min_cut, затем по impact_score
    *
    * @generated from field: double impact_score = 3;
    */
@@ -375,6 +391,40 @@ export type Bottleneck = Message<"logistics.analytics.v1.Bottleneck"> & {
    * @generated from field: logistics.analytics.v1.BottleneckSeverity severity = 4;
    */
   severity: BottleneckSeverity;
+
+  /**
+   * @generated from field: bool in_min_cut = 5;
+   */
+  inMinCut: boolean;
+
+  /**
+   * Прирост максимального потока на единицу пропускной способности (0 или 1)
+   *
+   * @generated from field: double marginal_flow_gain = 6;
+   */
+  marginalFlowGain: number;
+
+  /**
+   * Прирост максимального потока при удвоении пропускной способности: оценка
+   * по самым широким остаточным путям исток → u и v → сток
+   *
+   * @generated from field: double flow_gain = 7;
+   */
+  flowGain: number;
+
+  /**
+   * Снижение стоимости на единицу пропускной способности при том же потоке
+   *
+   * @generated from field: double marginal_cost_saving = 8;
+   */
+  marginalCostSaving: number;
+
+  /**
+   * Снижение стоимости при удвоении пропускной способности
+   *
+   * @generated from field: double cost_saving = 9;
+   */
+  costSaving: number;
 };
 
 /**
@@ -406,7 +456,8 @@ export type Recommendation = Message<"logistics.analytics.v1.Recommendation"> &
   affectedEdge?: EdgeKey;
 
   /**
-   * Ожидаемое улучшение потока в %
+   * Ожидаемое улучшение в %: прирост потока или снижение стоимости
+   * (Bottleneck.impact_score × 100)
    *
    * @generated from field: double estimated_improvement = 4;
    */
@@ -663,6 +714,213 @@ export type ScenarioResult = Message<"logistics.analytics.v1.ScenarioResult"> &
 export const ScenarioResultSchema: GenMessage<ScenarioResult> = /*@__PURE__*/
   messageDesc(file_logistics_analytics_v1_analytics, 15);
 
+/**
+ * @generated from message logistics.analytics.v1.BuildOriginDestinationMatrixRequest
+ */
+export type BuildOriginDestinationMatrixRequest = Message<"logistics.analytics.v1.BuildOriginDestinationMatrixRequest"> & {
+  /**
+   * Решённый граф с current_flow
+   *
+   * @generated from field: logistics.common.v1.Graph graph = 1;
+   */
+  graph?: Graph;
+};
+
+/**
+ * Describes the message logistics.analytics.v1.BuildOriginDestinationMatrixRequest.
+ * Use `create(BuildOriginDestinationMatrixRequestSchema)` to create a new message.
+ */
+export const BuildOriginDestinationMatrixRequestSchema: GenMessage<BuildOriginDestinationMatrixRequest> = /*@__PURE__*/
+  messageDesc(file_logistics_analytics_v1_analytics, 16);
+
+/**
+ * Поток раскладывается на пути; путь относится к первому складу на нём
+ * (или к началу пути) и к последней точке доставки (или к концу пути)
+ *
+ * @generated from message logistics.analytics.v1.BuildOriginDestinationMatrixResponse
+ */
+export type BuildOriginDestinationMatrixResponse = Message<"logistics.analytics.v1.BuildOriginDestinationMatrixResponse"> & {
+  /**
+   * По складу, затем по точке доставки
+   *
+   * @generated from field: repeated logistics.analytics.v1.OriginDestinationCell cells = 1;
+   */
+  cells: OriginDestinationCell[];
+
+  /**
+   * Итоги по складам
+   *
+   * @generated from field: repeated logistics.analytics.v1.OriginDestinationTotal origins = 2;
+   */
+  origins: OriginDestinationTotal[];
+
+  /**
+   * Итоги по точкам доставки
+   *
+   * @generated from field: repeated logistics.analytics.v1.OriginDestinationTotal destinations = 3;
+   */
+  destinations: OriginDestinationTotal[];
+
+  /**
+   * @generated from field: double total_volume = 4;
+   */
+  totalVolume: number;
+
+  /**
+   * @generated from field: double total_cost = 5;
+   */
+  totalCost: number;
+
+  /**
+   * Стоимость единицы потока
+   *
+   * @generated from field: double average_cost = 6;
+   */
+  averageCost: number;
+
+  /**
+   * Средняя длина пути, взвешенная по объёму
+   *
+   * @generated from field: double average_length = 7;
+   */
+  averageLength: number;
+
+  /**
+   * @generated from field: int32 path_count = 8;
+   */
+  pathCount: number;
+
+  /**
+   * Поток в циклах никуда не доставляется, но его стоимость входит
+   * в стоимость решения
+   *
+   * @generated from field: double cycle_volume = 9;
+   */
+  cycleVolume: number;
+
+  /**
+   * @generated from field: double cycle_cost = 10;
+   */
+  cycleCost: number;
+};
+
+/**
+ * Describes the message logistics.analytics.v1.BuildOriginDestinationMatrixResponse.
+ * Use `create(BuildOriginDestinationMatrixResponseSchema)` to create a new message.
+ */
+export const BuildOriginDestinationMatrixResponseSchema: GenMessage<BuildOriginDestinationMatrixResponse> = /*@__PURE__*/
+  messageDesc(file_logistics_analytics_v1_analytics, 17);
+
+/**
+ * @generated from message logistics.analytics.v1.OriginDestinationCell
+ */
+export type OriginDestinationCell = Message<"logistics.analytics.v1.OriginDestinationCell"> & {
+  /**
+   * @generated from field: int64 origin_id = 1;
+   */
+  originId: bigint;
+
+  /**
+   * @generated from field: string origin_name = 2;
+   */
+  originName: string;
+
+  /**
+   * @generated from field: int64 destination_id = 3;
+   */
+  destinationId: bigint;
+
+  /**
+   * @generated from field: string destination_name = 4;
+   */
+  destinationName: string;
+
+  /**
+   * @generated from field: double volume = 5;
+   */
+  volume: number;
+
+  /**
+   * @generated from field: double total_cost = 6;
+   */
+  totalCost: number;
+
+  /**
+   * Стоимость единицы потока
+   *
+   * @generated from field: double average_cost = 7;
+   */
+  averageCost: number;
+
+  /**
+   * Средняя длина пути, взвешенная по объёму
+   *
+   * @generated from field: double average_length = 8;
+   */
+  averageLength: number;
+
+  /**
+   * @generated from field: int32 path_count = 9;
+   */
+  pathCount: number;
+
+  /**
+   * Доля в объёме точки доставки, 0-1
+   *
+   * @generated from field: double destination_share = 10;
+   */
+  destinationShare: number;
+};
+
+/**
+ * Describes the message logistics.analytics.v1.OriginDestinationCell.
+ * Use `create(OriginDestinationCellSchema)` to create a new message.
+ */
+export const OriginDestinationCellSchema: GenMessage<OriginDestinationCell> = /*@__PURE__*/
+  messageDesc(file_logistics_analytics_v1_analytics, 18);
+
+/**
+ * @generated from message logistics.analytics.v1.OriginDestinationTotal
+ */
+export type OriginDestinationTotal = Message<"logistics.analytics.v1.OriginDestinationTotal"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: double volume = 3;
+   */
+  volume: number;
+
+  /**
+   * @generated from field: double total_cost = 4;
+   */
+  totalCost: number;
+
+  /**
+   * @generated from field: double average_cost = 5;
+   */
+  averageCost: number;
+
+  /**
+   * @generated from field: double average_length = 6;
+   */
+  averageLength: number;
+};
+
+/**
+ * Describes the message logistics.analytics.v1.OriginDestinationTotal.
+ * Use `create(OriginDestinationTotalSchema)` to create a new message.
+ */
+export const OriginDestinationTotalSchema: GenMessage<OriginDestinationTotal> = /*@__PURE__*/
+  messageDesc(file_logistics_analytics_v1_analytics, 19);
+
 /**
  * Режим расчёта стоимости
  *
@@ -790,6 +1048,16 @@ export const AnalyticsService: GenService<{
     input: typeof CompareScenariosRequestSchema;
     output: typeof CompareScenariosResponseSchema;
   },
+  /**
+   * Матрица корреспонденций «склад × точка доставки»
+   *
+   * @generated from rpc logistics.analytics.v1.AnalyticsService.BuildOriginDestinationMatrix
+   */
+  buildOriginDestinationMatrix: {
+    methodKind: "unary";
+    input: typeof BuildOriginDestinationMatrixRequestSchema;
+    output: typeof BuildOriginDestinationMatrixResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_logistics_analytics_v1_analytics, 0);
 
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file logistics/common/v1/common.proto.
  */
 export const file_logistics_common_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("CiBsb2dpc3RpY3MvY29tbW9uL3YxL2NvbW1vbi5wcm90bxITbG9naXN0aWNzLmNvbW1vbi52MSIjCgdFZGdlS2V5EgwKBGZyb20YASABKAMSCgoCdG8YAiABKAMi7wEKBE5vZGUSCgoCaWQYASABKAMSCQoBeBgCIAEoARIJCgF5GAMgASgBEisKBHR5cGUYBCABKA4yHS5sb2dpc3RpY3MuY29tbW9uLnYxLk5vZGVUeXBlEgwKBG5hbWUYBSABKAkSOQoIbWV0YWRhdGEYBiADKAsyJy5sb2dpc3RpY3MuY29tbW9uLnYxLk5vZGUuTWV0YWRhdGFFbnRyeRIOCgZzdXBwbHkYByABKAESDgoGZGVtYW5kGAggASgBGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKvAQoERWRnZRIMCgRmcm9tGAEgASgDEgoKAnRvGAIgASgDEhAKCGNhcGFjaXR5GAMgASgBEgwKBGNvc3QYBCABKAESDgoGbGVuZ3RoGAUgASgBEjAKCXJvYWRfdHlwZRgGIAEoDjIdLmxvZ2lzdGljcy5jb21tb24udjEuUm9hZFR5cGUSFAoMY3VycmVudF9mbG93GAcgASgBEhUKDWJpZGlyZWN0aW9uYWwYCCABKAgi+gEKBUdyYXBoEigKBW5vZGVzGAEgAygLMhkubG9naXN0aWNzLmNvbW1vbi52MS5Ob2RlEigKBWVkZ2VzGAIgAygLMhkubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlEhEKCXNvdXJjZV9pZBgDIAEoAxIPCgdzaW5rX2lkGAQgASgDEgwKBG5hbWUYBSABKAkSOgoIbWV0YWRhdGEYBiADKAsyKC5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoLk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkQKBFBhdGgSEAoIbm9kZV9pZHMYASADKAMSDAoEZmxvdxgCIAEoARIMCgRjb3N0GAMgASgBEg4KBmxlbmd0aBgEIAEoASJnCghGbG93RWRnZRIMCgRmcm9tGAEgASgDEgoKAnRvGAIgASgDEgwKBGZsb3cYAyABKAESEAoIY2FwYWNpdHkYBCABKAESDAoEY29zdBgFIAEoARITCgt1dGlsaXphdGlvbhgGIAEoASKDAgoKRmxvd1Jlc3VsdBIQCghtYXhfZmxvdxgBIAEoARISCgp0b3RhbF9jb3N0GAIgASgBEiwKBWVkZ2VzGAMgAygLMh0ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93RWRnZRIoCgVwYXRocxgEIAMoCzIZLmxvZ2lzdGljcy5jb21tb24udjEuUGF0aBIvCgZzdGF0dXMYBSABKA4yHy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dTdGF0dXMSEgoKaXRlcmF0aW9ucxgGIAEoBRIbChNjb21wdXRhdGlvbl90aW1lX21zGAcgASgBEhUKDWVycm9yX21lc3NhZ2UYCCABKAkizAEKD0dyYXBoU3RhdGlzdGljcxISCgpub2RlX2NvdW50GAEgASgDEhIKCmVkZ2VfY291bnQYAiABKAMSFwoPd2FyZWhvdXNlX2NvdW50GAMgASgDEhwKFGRlbGl2ZXJ5X3BvaW50X2NvdW50GAQgASgDEhYKDnRvdGFsX2NhcGFjaXR5GAUgASgBEhsKE2F2ZXJhZ2VfZWRnZV9sZW5ndGgYBiABKAESFAoMaXNfY29ubmVjdGVkGAcgASgIEg8KB2RlbnNpdHkYCCABKAEiugEKDkZsb3dTdGF0aXN0aWNzEhIKCnRvdGFsX2Zsb3cYASABKAESEgoKdG90YWxfY29zdBgCIAEoARIbChNhdmVyYWdlX3V0aWxpemF0aW9uGAMgASgBEhcKD3NhdHVyYXRlZF9lZGdlcxgEIAEoAxIXCg96ZXJvX2Zsb3dfZWRnZXMYBSABKAMSMQoLYm90dGxlbmVja3MYBiADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkiPwoPVmFsaWRhdGlvbkVycm9yEg0KBWZpZWxkGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSDAoEY29kZRgDIAEoCSJaChBWYWxpZGF0aW9uUmVzdWx0EhAKCGlzX3ZhbGlkGAEgASgIEjQKBmVycm9ycxgCIAMoCzIkLmxvZ2lzdGljcy5jb21tb24udjEuVmFsaWRhdGlvbkVycm9yIq4BCgtFcnJvckRldGFpbBIMCgRjb2RlGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSDQoFZmllbGQYAyABKAkSQAoIbWV0YWRhdGEYBCADKAsyLi5sb2dpc3RpY3MuY29tbW9uLnYxLkVycm9yRGV0YWlsLk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjQKEVBhZ2luYXRpb25SZXF1ZXN0EgwKBHBhZ2UYASABKAUSEQoJcGFnZV9zaXplGAIgASgFIo8BChJQYWdpbmF0aW9uUmVzcG9uc2USFAoMY3VycmVudF9wYWdlGAEgASgFEhEKCXBhZ2Vfc2l6ZRgCIAEoBRITCgt0b3RhbF9wYWdlcxgDIAEoBRITCgt0b3RhbF9pdGVtcxgEIAEoAxIQCghoYXNfbmV4dBgFIAEoCBIUCgxoYXNfcHJldmlvdXMYBiABKAgiOwoJVGltZVJhbmdlEhcKD3N0YXJ0X3RpbWVzdGFtcBgBIAEoAxIVCg1lbmRfdGltZXN0YW1wGAIgASgDKqkBCglBbGdvcml0aG0SGQoVQUxHT1JJVEhNX1VOU1BFQ0lGSUVEEAASGgoWQUxHT1JJVEhNX0VETU9ORFNfS0FSUBABEhMKD0FMR09SSVRITV9ESU5JQxACEhYKEkFMR09SSVRITV9NSU5fQ09TVBADEhoKFkFMR09SSVRITV9QVVNIX1JFTEFCRUwQBBIcChhBTEdPUklUSE1fRk9SRF9GVUxLRVJTT04QBSqiAQoITm9kZVR5cGUSGQoVTk9ERV9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTTk9ERV9UWVBFX1dBUkVIT1VTRRABEhwKGE5PREVfVFlQRV9ERUxJVkVSWV9QT0lOVBACEhoKFk5PREVfVFlQRV9JTlRFUlNFQ1RJT04QAxIUChBOT0RFX1RZUEVfU09VUkNFEAQSEgoOTk9ERV9UWVBFX1NJTksQBSqWAQoIUm9hZFR5cGUSGQoVUk9BRF9UWVBFX1VOU1BFQ0lGSUVEEAASFQoRUk9BRF9UWVBFX0hJR0hXQVkQARIVChFST0FEX1RZUEVfUFJJTUFSWRACEhcKE1JPQURfVFlQRV9TRUNPTkRBUlkQAxITCg9ST0FEX1RZUEVfTE9DQUwQBBITCg9ST0FEX1RZUEVfVVJCQU4QBSqqAQoKRmxvd1N0YXR1cxIbChdGTE9XX1NUQVRVU19VTlNQRUNJRklFRBAAEhcKE0ZMT1dfU1RBVFVTX09QVElNQUwQARIYChRGTE9XX1NUQVRVU19GRUFTSUJMRRACEhoKFkZMT1dfU1RBVFVTX0lORkVBU0lCTEUQAxIZChVGTE9XX1NUQVRVU19VTkJPVU5ERUQQBBIVChFGTE9XX1NUQVRVU19FUlJPUhAFQsMBChdjb20ubG9naXN0aWNzLmNvbW1vbi52MUILQ29tbW9uUHJvdG9QAVotbG9naXN0aWNzL2dlbi9nby9sb2dpc3RpY3MvY29tbW9uL3YxO2NvbW1vbnYxogIDTENYqgITTG9naXN0aWNzLkNvbW1vbi5WMcoCE0xvZ2lzdGljc1xDb21tb25cVjHiAh9Mb2dpc3RpY3NcQ29tbW9uXFYxXEdQQk1ldGFkYXRh6gIVTG9naXN0aWNzOjpDb21tb246OlYxYgZwcm90bzM");
+  fileDesc("CiBsb2dpc3RpY3MvY29tbW9uL3YxL2NvbW1vbi5wcm90bxITbG9naXN0aWNzLmNvbW1vbi52MSI0CgdFZGdlS2V5EgwKBGZyb20YASABKAMSCgoCdG8YAiABKAMSDwoHZWRnZV9pZBgDIAEoAyKJAgoETm9kZRIKCgJpZBgBIAEoAxIJCgF4GAIgASgBEgkKAXkYAyABKAESKwoEdHlwZRgEIAEoDjIdLmxvZ2lzdGljcy5jb21tb24udjEuTm9kZVR5cGUSDAoEbmFtZRgFIAEoCRI5CghtZXRhZGF0YRgGIAMoCzInLmxvZ2lzdGljcy5jb21tb24udjEuTm9kZS5NZXRhZGF0YUVudHJ5Eg4KBnN1cHBseRgHIAEoARIOCgZkZW1hbmQYCCABKAESGAoQc3RvcmFnZV9jYXBhY2l0eRgJIAEoARovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEizQEKBEVkZ2USDAoEZnJvbRgBIAEoAxIKCgJ0bxgCIAEoAxIQCghjYXBhY2l0eRgDIAEoARIMCgRjb3N0GAQgASgBEg4KBmxlbmd0aBgFIAEoARIwCglyb2FkX3R5cGUYBiABKA4yHS5sb2dpc3RpY3MuY29tbW9uLnYxLlJvYWRUeXBlEhQKDGN1cnJlbnRfZmxvdxgHIAEoARIVCg1iaWRpcmVjdGlvbmFsGAggASgIEhAKCG1pbl9mbG93GAkgASgBEgoKAmlkGAogASgDIvoBCgVHcmFwaBIoCgVub2RlcxgBIAMoCzIZLmxvZ2lzdGljcy5jb21tb24udjEuTm9kZRIoCgVlZGdlcxgCIAMoCzIZLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZRIRCglzb3VyY2VfaWQYAyABKAMSDwoHc2lua19pZBgEIAEoAxIMCgRuYW1lGAUgASgJEjoKCG1ldGFkYXRhGAYgAygLMigubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaC5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJECgRQYXRoEhAKCG5vZGVfaWRzGAEgAygDEgwKBGZsb3cYAiABKAESDAoEY29zdBgDIAEoARIOCgZsZW5ndGgYBCABKAEieAoIRmxvd0VkZ2USDAoEZnJvbRgBIAEoAxIKCgJ0bxgCIAEoAxIMCgRmbG93GAMgASgBEhAKCGNhcGFjaXR5GAQgASgBEgwKBGNvc3QYBSABKAESEwoLdXRpbGl6YXRpb24YBiABKAESDwoHZWRnZV9pZBgHIAEoAyKoBQoKRmxvd1Jlc3VsdBIQCghtYXhfZmxvdxgBIAEoARISCgp0b3RhbF9jb3N0GAIgASgBEiwKBWVkZ2VzGAMgAygLMh0ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93RWRnZRIoCgVwYXRocxgEIAMoCzIZLmxvZ2lzdGljcy5jb21tb24udjEuUGF0aBIvCgZzdGF0dXMYBSABKA4yHy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dTdGF0dXMSEgoKaXRlcmF0aW9ucxgGIAEoBRIbChNjb21wdXRhdGlvbl90aW1lX21zGAcgASgBEhUKDWVycm9yX21lc3NhZ2UYCCABKAkSOQoPc291cmNlX2JhbGFuY2VzGAkgAygLMiAubG9naXN0aWNzLmNvbW1vbi52MS5Ob2RlQmFsYW5jZRI3Cg1zaW5rX2JhbGFuY2VzGAogAygLMiAubG9naXN0aWNzLmNvbW1vbi52MS5Ob2RlQmFsYW5jZRI7Cg11bm1ldF9kZW1hbmRzGAsgAygLMiQubG9naXN0aWNzLmNvbW1vbi52MS5EZW1hbmRTaG9ydGZhbGwSOwoPbm9kZV9wb3RlbnRpYWxzGAwgAygLMiIubG9naXN0aWNzLmNvbW1vbi52MS5Ob2RlUG90ZW50aWFsEkgKFmxvd2VyX2JvdW5kX3Zpb2xhdGlvbnMYDSADKAsyKC5sb2dpc3RpY3MuY29tbW9uLnYxLkxvd2VyQm91bmRWaW9sYXRpb24SLAoHbWluX2N1dBgOIAEoCzIbLmxvZ2lzdGljcy5jb21tb24udjEuTWluQ3V0Ej0KDWRlY29tcG9zaXRpb24YDyABKAsyJi5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dEZWNvbXBvc2l0aW9uImgKEUZsb3dEZWNvbXBvc2l0aW9uEigKBXBhdGhzGAEgAygLMhkubG9naXN0aWNzLmNvbW1vbi52MS5QYXRoEikKBmN5Y2xlcxgCIAMoCzIZLmxvZ2lzdGljcy5jb21tb24udjEuUGF0aCKOAQoLTm9kZUJhbGFuY2USDwoHbm9kZV9pZBgBIAEoAxIOCgZzdXBwbHkYAiABKAESDgoGZGVtYW5kGAMgASgBEg8KB3NoaXBwZWQYBCABKAESEAoIcmVjZWl2ZWQYBSABKAESFAoMdW5tZXRfZGVtYW5kGAYgASgBEhUKDXVudXNlZF9zdXBwbHkYByABKAEiVwoPRGVtYW5kU2hvcnRmYWxsEg8KB25vZGVfaWQYASABKAMSDgoGZGVtYW5kGAIgASgBEhAKCHJlY2VpdmVkGAMgASgBEhEKCXNob3J0ZmFsbBgEIAEoASJNChNMb3dlckJvdW5kVmlvbGF0aW9uEg8KB25vZGVfaWQYASABKAMSEQoJaW1iYWxhbmNlGAIgASgBEhIKCnVucmVzb2x2ZWQYAyABKAEikAEKBk1pbkN1dBITCgtzb3VyY2Vfc2lkZRgBIAMoAxIrCgVlZGdlcxgCIAMoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuQ3V0RWRnZRINCgV2YWx1ZRgDIAEoARIaChJzYXR1cmF0ZWRfc3VwcGxpZXMYBCADKAMSGQoRc2F0dXJhdGVkX2RlbWFuZHMYBSADKAMiRgoHQ3V0RWRnZRIMCgRmcm9tGAEgASgDEgoKAnRvGAIgASgDEg8KB2VkZ2VfaWQYAyABKAMSEAoIY2FwYWNpdHkYBCABKAEiMwoNTm9kZVBvdGVudGlhbBIPCgdub2RlX2lkGAEgASgDEhEKCXBvdGVudGlhbBgCIAEoASLMAQoPR3JhcGhTdGF0aXN0aWNzEhIKCm5vZGVfY291bnQYASABKAMSEgoKZWRnZV9jb3VudBgCIAEoAxIXCg93YXJlaG91c2VfY291bnQYAyABKAMSHAoUZGVsaXZlcnlfcG9pbnRfY291bnQYBCABKAMSFgoOdG90YWxfY2FwYWNpdHkYBSABKAESGwoTYXZlcmFnZV9lZGdlX2xlbmd0aBgGIAEoARIUCgxpc19jb25uZWN0ZWQYByABKAgSDwoHZGVuc2l0eRgIIAEoASK6AQoORmxvd1N0YXRpc3RpY3MSEgoKdG90YWxfZmxvdxgBIAEoARISCgp0b3RhbF9jb3N0GAIgASgBEhsKE2F2ZXJhZ2VfdXRpbGl6YXRpb24YAyABKAESFwoPc2F0dXJhdGVkX2VkZ2VzGAQgASgDEhcKD3plcm9fZmxvd19lZGdlcxgFIAEoAxIxCgtib3R0bGVuZWNrcxgGIAMoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleSI/Cg9WYWxpZGF0aW9uRXJyb3ISDQoFZmllbGQYASABKAkSDwoHbWVzc2FnZRgCIAEoCRIMCgRjb2RlGAMgASgJIloKEFZhbGlkYXRpb25SZXN1bHQSEAoIaXNfdmFsaWQYASABKAgSNAoGZXJyb3JzGAIgAygLMiQubG9naXN0aWNzLmNvbW1vbi52MS5WYWxpZGF0aW9uRXJyb3IirgEKC0Vycm9yRGV0YWlsEgwKBGNvZGUYASABKAkSDwoHbWVzc2FnZRgCIAEoCRINCgVmaWVsZBgDIAEoCRJACghtZXRhZGF0YRgEIAMoCzIuLmxvZ2lzdGljcy5jb21tb24udjEuRXJyb3JEZXRhaWwuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiNAoRUGFnaW5hdGlvblJlcXVlc3QSDAoEcGFnZRgBIAEoBRIRCglwYWdlX3NpemUYAiABKAUijwEKElBhZ2luYXRpb25SZXNwb25zZRIUCgxjdXJyZW50X3BhZ2UYASABKAUSEQoJcGFnZV9zaXplGAIgASgFEhMKC3RvdGFsX3BhZ2VzGAMgASgFEhMKC3RvdGFsX2l0ZW1zGAQgASgDEhAKCGhhc19uZXh0GAUgASgIEhQKDGhhc19wcmV2aW91cxgGIAEoCCI7CglUaW1lUmFuZ2USFwoPc3RhcnRfdGltZXN0YW1wGAEgASgDEhUKDWVuZF90aW1lc3RhbXAYAiABKAMqyAEKCUFsZ29yaXRobRIZChVBTEdPUklUSE1fVU5TUEVDSUZJRUQQABIaChZBTEdPUklUSE1fRURNT05EU19LQVJQEAESEwoPQUxHT1JJVEhNX0RJTklDEAISFgoSQUxHT1JJVEhNX01JTl9DT1NUEAMSGgoWQUxHT1JJVEhNX1BVU0hfUkVMQUJFTBAEEhwKGEFMR09SSVRITV9GT1JEX0ZVTEtFUlNPThAFEh0KGUFMR09SSVRITV9ORVRXT1JLX1NJTVBMRVgQBiqiAQoITm9kZVR5cGUSGQoVTk9ERV9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTTk9ERV9UWVBFX1dBUkVIT1VTRRABEhwKGE5PREVfVFlQRV9ERUxJVkVSWV9QT0lOVBACEhoKFk5PREVfVFlQRV9JTlRFUlNFQ1RJT04QAxIUChBOT0RFX1RZUEVfU09VUkNFEAQSEgoOTk9ERV9UWVBFX1NJTksQBSqWAQoIUm9hZFR5cGUSGQoVUk9BRF9UWVBFX1VOU1BFQ0lGSUVEEAASFQoRUk9BRF9UWVBFX0hJR0hXQVkQARIVChFST0FEX1RZUEVfUFJJTUFSWRACEhcKE1JPQURfVFlQRV9TRUNPTkRBUlkQAxITCg9ST0FEX1RZUEVfTE9DQUwQBBITCg9ST0FEX1RZUEVfVVJCQU4QBSqqAQoKRmxvd1N0YXR1cxIbChdGTE9XX1NUQVRVU19VTlNQRUNJRklFRBAAEhcKE0ZMT1dfU1RBVFVTX09QVElNQUwQARIYChRGTE9XX1NUQVRVU19GRUFTSUJMRRACEhoKFkZMT1dfU1RBVFVTX0lORkVBU0lCTEUQAxIZChVGTE9XX1NUQVRVU19VTkJPVU5ERUQQBBIVChFGTE9XX1NUQVRVU19FUlJPUhAFQsMBChdjb20ubG9naXN0aWNzLmNvbW1vbi52MUILQ29tbW9uUHJvdG9QAVotbG9naXN0aWNzL2dlbi9nby9sb2dpc3RpY3MvY29tbW9uL3YxO2NvbW1vbnYxogIDTENYqgITTG9naXN0aWNzLkNvbW1vbi5WMcoCE0xvZ2lzdGljc1xDb21tb25cVjHiAh9Mb2dpc3RpY3NcQ29tbW9uXFYxXEdQQk1ldGFkYXRh6gIVTG9naXN0aWNzOjpDb21tb246OlYxYgZwcm90bzM");
 
 /**
  * @generated from message logistics.common.v1.EdgeKey
@@ -25,6 +25,14 @@ export type EdgeKey = Message<"logistics.common.v1.EdgeKey"> & {
    * @generated from field: int64 to = 2;
    */
   to: bigint;
+
+  /**
+   * Идентификатор ребра (Edge.id) для выбора одного из параллельных рёбер;
+   * 0 — все рёбра между from и to
+   *
+   * @generated from field: int64 edge_id = 3;
+   */
+  edgeId: bigint;
 };
 
 /**
@@ -83,6 +91,14 @@ export type Node = Message<"logistics.common.v1.Node"> & {
    * @generated from field: double demand = 8;
    */
   demand: number;
+
+  /**
+   * Вместимость склада узла: сколько груза может ждать в узле между шагами
+   * динамической симуляции. 0 — хранение в узле запрещено
+   *
+   * @generated from field: double storage_capacity = 9;
+   */
+  storageCapacity: number;
 };
 
 /**
@@ -135,6 +151,22 @@ export type Edge = Message<"logistics.common.v1.Edge"> & {
    * @generated from field: bool bidirectional = 8;
    */
   bidirectional: boolean;
+
+  /**
+   * Минимальный поток по ребру (нижняя граница, 0 ≤ min_flow ≤ capacity).
+   * Для двунаправленных рёбер действует только в направлении from → to
+   *
+   * @generated from field: double min_flow = 9;
+   */
+  minFlow: number;
+
+  /**
+   * Стабильный идентификатор ребра, различающий параллельные рёбра между одной
+   * парой узлов. Если 0 — используется порядковый номер ребра в графе (с 1)
+   *
+   * @generated from field: int64 id = 10;
+   */
+  id: bigint;
 };
 
 /**
@@ -251,6 +283,13 @@ export type FlowEdge = Message<"logistics.common.v1.FlowEdge"> & {
    * @generated from field: double utilization = 6;
    */
   utilization: number;
+
+  /**
+   * Идентификатор исходного ребра (Edge.id)
+   *
+   * @generated from field: int64 edge_id = 7;
+   */
+  edgeId: bigint;
 };
 
 /**
@@ -303,6 +342,59 @@ export type FlowResult = Message<"logistics.common.v1.FlowResult"> & {
    * @generated from field: string error_message = 8;
    */
   errorMessage: string;
+
+  /**
+   * Балансы узлов при множественных источниках/стоках
+   *
+   * Отгружено каждым складом
+   *
+   * @generated from field: repeated logistics.common.v1.NodeBalance source_balances = 9;
+   */
+  sourceBalances: NodeBalance[];
+
+  /**
+   * Получено каждой точкой доставки
+   *
+   * @generated from field: repeated logistics.common.v1.NodeBalance sink_balances = 10;
+   */
+  sinkBalances: NodeBalance[];
+
+  /**
+   * Транспортная задача (SOLVE_MODE_TRANSPORTATION)
+   *
+   * Точки доставки с неудовлетворённым спросом
+   *
+   * @generated from field: repeated logistics.common.v1.DemandShortfall unmet_demands = 11;
+   */
+  unmetDemands: DemandShortfall[];
+
+  /**
+   * Двойственные потенциалы (теневые цены) узлов
+   *
+   * @generated from field: repeated logistics.common.v1.NodePotential node_potentials = 12;
+   */
+  nodePotentials: NodePotential[];
+
+  /**
+   * Узлы, для которых нельзя выполнить минимальные потоки рёбер (статус INFEASIBLE)
+   *
+   * @generated from field: repeated logistics.common.v1.LowerBoundViolation lower_bound_violations = 13;
+   */
+  lowerBoundViolations: LowerBoundViolation[];
+
+  /**
+   * Минимальный разрез (только при SolveOptions.return_min_cut)
+   *
+   * @generated from field: logistics.common.v1.MinCut min_cut = 14;
+   */
+  minCut?: MinCut;
+
+  /**
+   * Разложение потока (только при SolveOptions.return_decomposition)
+   *
+   * @generated from field: logistics.common.v1.FlowDecomposition decomposition = 15;
+   */
+  decomposition?: FlowDecomposition;
 };
 
 /**
@@ -312,6 +404,277 @@ export type FlowResult = Message<"logistics.common.v1.FlowResult"> & {
 export const FlowResultSchema: GenMessage<FlowResult> = /*@__PURE__*/
   messageDesc(file_logistics_common_v1_common, 6);
 
+/**
+ * Разложение итогового потока на пути и циклы, доступное для любого
+ * алгоритма: строится по потокам рёбер, а не по найденным увеличивающим путям
+ *
+ * @generated from message logistics.common.v1.FlowDecomposition
+ */
+export type FlowDecomposition = Message<"logistics.common.v1.FlowDecomposition"> & {
+  /**
+   * Пути от узлов, отгружающих поток, к узлам, принимающим его; cost —
+   * стоимость всего потока пути, length — длина пути
+   *
+   * @generated from field: repeated logistics.common.v1.Path paths = 1;
+   */
+  paths: Path[];
+
+  /**
+   * Циклы потока: первый узел совпадает с последним
+   *
+   * @generated from field: repeated logistics.common.v1.Path cycles = 2;
+   */
+  cycles: Path[];
+};
+
+/**
+ * Describes the message logistics.common.v1.FlowDecomposition.
+ * Use `create(FlowDecompositionSchema)` to create a new message.
+ */
+export const FlowDecompositionSchema: GenMessage<FlowDecomposition> = /*@__PURE__*/
+  messageDesc(file_logistics_common_v1_common, 7);
+
+/**
+ * @generated from message logistics.common.v1.NodeBalance
+ */
+export type NodeBalance = Message<"logistics.common.v1.NodeBalance"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * Заявленное предложение узла
+   *
+   * @generated from field: double supply = 2;
+   */
+  supply: number;
+
+  /**
+   * Заявленный спрос узла
+   *
+   * @generated from field: double demand = 3;
+   */
+  demand: number;
+
+  /**
+   * Фактически отгружено
+   *
+   * @generated from field: double shipped = 4;
+   */
+  shipped: number;
+
+  /**
+   * Фактически получено
+   *
+   * @generated from field: double received = 5;
+   */
+  received: number;
+
+  /**
+   * Неудовлетворённый спрос
+   *
+   * @generated from field: double unmet_demand = 6;
+   */
+  unmetDemand: number;
+
+  /**
+   * Неиспользованное предложение
+   *
+   * @generated from field: double unused_supply = 7;
+   */
+  unusedSupply: number;
+};
+
+/**
+ * Describes the message logistics.common.v1.NodeBalance.
+ * Use `create(NodeBalanceSchema)` to create a new message.
+ */
+export const NodeBalanceSchema: GenMessage<NodeBalance> = /*@__PURE__*/
+  messageDesc(file_logistics_common_v1_common, 8);
+
+/**
+ * @generated from message logistics.common.v1.DemandShortfall
+ */
+export type DemandShortfall = Message<"logistics.common.v1.DemandShortfall"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * Требуемый объём
+   *
+   * @generated from field: double demand = 2;
+   */
+  demand: number;
+
+  /**
+   * Фактически получено
+   *
+   * @generated from field: double received = 3;
+   */
+  received: number;
+
+  /**
+   * Недопоставка (demand - received)
+   *
+   * @generated from field: double shortfall = 4;
+   */
+  shortfall: number;
+};
+
+/**
+ * Describes the message logistics.common.v1.DemandShortfall.
+ * Use `create(DemandShortfallSchema)` to create a new message.
+ */
+export const DemandShortfallSchema: GenMessage<DemandShortfall> = /*@__PURE__*/
+  messageDesc(file_logistics_common_v1_common, 9);
+
+/**
+ * Дисбаланс минимальных потоков в узле: imbalance > 0 — минимальный входящий
+ * поток превышает минимальный исходящий, imbalance < 0 — наоборот
+ *
+ * @generated from message logistics.common.v1.LowerBoundViolation
+ */
+export type LowerBoundViolation = Message<"logistics.common.v1.LowerBoundViolation"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * Дисбаланс минимальных потоков
+   *
+   * @generated from field: double imbalance = 2;
+   */
+  imbalance: number;
+
+  /**
+   * Часть |imbalance|, которую не удалось провести через сеть
+   *
+   * @generated from field: double unresolved = 3;
+   */
+  unresolved: number;
+};
+
+/**
+ * Describes the message logistics.common.v1.LowerBoundViolation.
+ * Use `create(LowerBoundViolationSchema)` to create a new message.
+ */
+export const LowerBoundViolationSchema: GenMessage<LowerBoundViolation> = /*@__PURE__*/
+  messageDesc(file_logistics_common_v1_common, 10);
+
+/**
+ * Минимальный разрез по итоговой остаточной сети: узлы, достижимые из
+ * источника, и рёбра, ведущие из этого множества наружу
+ *
+ * @generated from message logistics.common.v1.MinCut
+ */
+export type MinCut = Message<"logistics.common.v1.MinCut"> & {
+  /**
+   * Узлы на стороне источника (по возрастанию ID)
+   *
+   * @generated from field: repeated int64 source_side = 1;
+   */
+  sourceSide: bigint[];
+
+  /**
+   * Рёбра разреза (узкие места)
+   *
+   * @generated from field: repeated logistics.common.v1.CutEdge edges = 2;
+   */
+  edges: CutEdge[];
+
+  /**
+   * Пропускная способность разреза (= максимальный поток)
+   *
+   * @generated from field: double value = 3;
+   */
+  value: number;
+
+  /**
+   * Мульти-терминальный режим: склады, чьё предложение целиком вошло в разрез,
+   * и точки доставки, чей спрос целиком вошёл в разрез
+   *
+   * @generated from field: repeated int64 saturated_supplies = 4;
+   */
+  saturatedSupplies: bigint[];
+
+  /**
+   * @generated from field: repeated int64 saturated_demands = 5;
+   */
+  saturatedDemands: bigint[];
+};
+
+/**
+ * Describes the message logistics.common.v1.MinCut.
+ * Use `create(MinCutSchema)` to create a new message.
+ */
+export const MinCutSchema: GenMessage<MinCut> = /*@__PURE__*/
+  messageDesc(file_logistics_common_v1_common, 11);
+
+/**
+ * Ребро разреза в направлении от стороны источника к стороне стока
+ *
+ * @generated from message logistics.common.v1.CutEdge
+ */
+export type CutEdge = Message<"logistics.common.v1.CutEdge"> & {
+  /**
+   * @generated from field: int64 from = 1;
+   */
+  from: bigint;
+
+  /**
+   * @generated from field: int64 to = 2;
+   */
+  to: bigint;
+
+  /**
+   * Edge.id (или 1-based позиция ребра, если id не задан)
+   *
+   * @generated from field: int64 edge_id = 3;
+   */
+  edgeId: bigint;
+
+  /**
+   * @generated from field: double capacity = 4;
+   */
+  capacity: number;
+};
+
+/**
+ * Describes the message logistics.common.v1.CutEdge.
+ * Use `create(CutEdgeSchema)` to create a new message.
+ */
+export const CutEdgeSchema: GenMessage<CutEdge> = /*@__PURE__*/
+  messageDesc(file_logistics_common_v1_common, 12);
+
+/**
+ * Потенциалы определены с точностью до константы (минимальный = 0):
+ * разность потенциалов двух узлов — предельная стоимость доставки единицы между ними
+ *
+ * @generated from message logistics.common.v1.NodePotential
+ */
+export type NodePotential = Message<"logistics.common.v1.NodePotential"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * @generated from field: double potential = 2;
+   */
+  potential: number;
+};
+
+/**
+ * Describes the message logistics.common.v1.NodePotential.
+ * Use `create(NodePotentialSchema)` to create a new message.
+ */
+export const NodePotentialSchema: GenMessage<NodePotential> = /*@__PURE__*/
+  messageDesc(file_logistics_common_v1_common, 13);
+
 /**
  * @generated from message logistics.common.v1.GraphStatistics
  */
@@ -362,7 +725,7 @@ export type GraphStatistics = Message<"logistics.common.v1.GraphStatistics"> & {
  * Use `create(GraphStatisticsSchema)` to create a new message.
  */
 export const GraphStatisticsSchema: GenMessage<GraphStatistics> = /*@__PURE__*/
-  messageDesc(file_logistics_common_v1_common, 7);
+  messageDesc(file_logistics_common_v1_common, 14);
 
 /**
  * @generated from message logistics.common.v1.FlowStatistics
@@ -404,7 +767,7 @@ export type FlowStatistics = Message<"logistics.common.v1.FlowStatistics"> & {
  * Use `create(FlowStatisticsSchema)` to create a new message.
  */
 export const FlowStatisticsSchema: GenMessage<FlowStatistics> = /*@__PURE__*/
-  messageDesc(file_logistics_common_v1_common, 8);
+  messageDesc(file_logistics_common_v1_common, 15);
 
 /**
  * @generated from message logistics.common.v1.ValidationError
@@ -431,7 +794,7 @@ export type ValidationError = Message<"logistics.common.v1.ValidationError"> & {
  * Use `create(ValidationErrorSchema)` to create a new message.
  */
 export const ValidationErrorSchema: GenMessage<ValidationError> = /*@__PURE__*/
-  messageDesc(file_logistics_common_v1_common, 9);
+  messageDesc(file_logistics_common_v1_common, 16);
 
 /**
  * @generated from message logistics.common.v1.ValidationResult
@@ -453,7 +816,7 @@ export type ValidationResult = Message<"logistics.common.v1.ValidationResult"> &
  * Use `create(ValidationResultSchema)` to create a new message.
  */
 export const ValidationResultSchema: GenMessage<ValidationResult> = /*@__PURE__*/
-  messageDesc(file_logistics_common_v1_common, 10);
+  messageDesc(file_logistics_common_v1_common, 17);
 
 /**
  * ErrorDetail для передачи ошибок в ответах
@@ -495,7 +858,7 @@ export type ErrorDetail = Message<"logistics.common.v1.ErrorDetail"> & {
  * Use `create(ErrorDetailSchema)` to create a new message.
  */
 export const ErrorDetailSchema: GenMessage<ErrorDetail> = /*@__PURE__*/
-  messageDesc(file_logistics_common_v1_common, 11);
+  messageDesc(file_logistics_common_v1_common, 18);
 
 /**
  * @generated from message logistics.common.v1.PaginationRequest
@@ -517,7 +880,7 @@ export type PaginationRequest = Message<"logistics.common.v1.PaginationRequest">
  * Use `create(PaginationRequestSchema)` to create a new message.
  */
 export const PaginationRequestSchema: GenMessage<PaginationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_common_v1_common, 12);
+  messageDesc(file_logistics_common_v1_common, 19);
 
 /**
  * @generated from message logistics.common.v1.PaginationResponse
@@ -559,7 +922,7 @@ export type PaginationResponse = Message<"logistics.common.v1.PaginationResponse
  * Use `create(PaginationResponseSchema)` to create a new message.
  */
 export const PaginationResponseSchema: GenMessage<PaginationResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_common_v1_common, 13);
+  messageDesc(file_logistics_common_v1_common, 20);
 
 /**
  * @generated from message logistics.common.v1.TimeRange
@@ -581,7 +944,7 @@ export type TimeRange = Message<"logistics.common.v1.TimeRange"> & {
  * Use `create(TimeRangeSchema)` to create a new message.
  */
 export const TimeRangeSchema: GenMessage<TimeRange> = /*@__PURE__*/
-  messageDesc(file_logistics_common_v1_common, 14);
+  messageDesc(file_logistics_common_v1_common, 21);
 
 /**
  * @generated from enum logistics.common.v1.Algorithm
@@ -616,6 +979,11 @@ export enum Algorithm {
    * @generated from enum value: ALGORITHM_FORD_FULKERSON = 5;
    */
   FORD_FULKERSON = 5,
+
+  /**
+   * @generated from enum value: ALGORITHM_NETWORK_SIMPLEX = 6;
+   */
+  NETWORK_SIMPLEX = 6,
 }
 
 /**
@@ -6,7 +6,7 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { Algorithm, EdgeKey, ErrorDetail, FlowResult, FlowStatistics, FlowStatus, Graph, GraphStatistics, Path, ValidationError } from "../../common/v1/common_pb";
+import type { Algorithm, EdgeKey, ErrorDetail, FlowDecomposition, FlowResult, FlowStatistics, FlowStatus, Graph, GraphStatistics, Path, ValidationError } from "../../common/v1/common_pb";
 import { file_logistics_common_v1_common } from "../../common/v1/common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file logistics/gateway/v1/gateway.proto.
  */
 export const file_logistics_gateway_v1_gateway: GenFile = /*@__PURE__*/
-  fileDesc("CiJsb2dpc3RpY3MvZ2F0ZXdheS92MS9nYXRld2F5LnByb3RvEhRsb2dpc3RpY3MuZ2F0ZXdheS52MSLrAQoOSGVhbHRoUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASRAoIc2VydmljZXMYAyADKAsyMi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5IZWFsdGhSZXNwb25zZS5TZXJ2aWNlc0VudHJ5GlQKDVNlcnZpY2VzRW50cnkSCwoDa2V5GAEgASgJEjIKBXZhbHVlGAIgASgLMiMubG9naXN0aWNzLmdhdGV3YXkudjEuU2VydmljZUhlYWx0aDoCOAEicgoNU2VydmljZUhlYWx0aBIMCgRuYW1lGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIPCgdhZGRyZXNzGAMgASgJEhIKCmxhdGVuY3lfbXMYBCABKAMSDQoFZXJyb3IYBSABKAkSDwoHdmVyc2lvbhgGIAEoCSKoAQoRUmVhZGluZXNzUmVzcG9uc2USDQoFcmVhZHkYASABKAgSTwoMZGVwZW5kZW5jaWVzGAIgAygLMjkubG9naXN0aWNzLmdhdGV3YXkudjEuUmVhZGluZXNzUmVzcG9uc2UuRGVwZW5kZW5jaWVzRW50cnkaMwoRRGVwZW5kZW5jaWVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgIOgI4ASLOAgoMSW5mb1Jlc3BvbnNlEgwKBG5hbWUYASABKAkSDwoHdmVyc2lvbhgCIAEoCRITCgtlbnZpcm9ubWVudBgDIAEoCRIuCgpzdGFydGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCg51cHRpbWVfc2Vjb25kcxgFIAEoAxIQCghmZWF0dXJlcxgGIAMoCRI3CgpyYXRlX2xpbWl0GAcgASgLMiMubG9naXN0aWNzLmdhdGV3YXkudjEuUmF0ZUxpbWl0SW5mbxJFCgpidWlsZF9pbmZvGAggAygLMjEubG9naXN0aWNzLmdhdGV3YXkudjEuSW5mb1Jlc3BvbnNlLkJ1aWxkSW5mb0VudHJ5GjAKDkJ1aWxkSW5mb0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiUQoNUmF0ZUxpbWl0SW5mbxIPCgdlbmFibGVkGAEgASgIEhsKE3JlcXVlc3RzX3Blcl9taW51dGUYAiABKAUSEgoKYnVyc3Rfc2l6ZRgDIAEoBSJNChJBbGdvcml0aG1zUmVzcG9uc2USNwoKYWxnb3JpdGhtcxgBIAMoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLkFsZ29yaXRobUluZm8i5gEKDUFsZ29yaXRobUluZm8SMQoJYWxnb3JpdGhtGAEgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIXCg90aW1lX2NvbXBsZXhpdHkYBCABKAkSGAoQc3BhY2VfY29tcGxleGl0eRgFIAEoCRIZChFzdXBwb3J0c19taW5fY29zdBgGIAEoCBIfChdzdXBwb3J0c19uZWdhdGl2ZV9jb3N0cxgHIAEoCBIQCghiZXN0X2ZvchgIIAMoCSJXCg9SZWdpc3RlclJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkSDQoFZW1haWwYAiABKAkSEAoIcGFzc3dvcmQYAyABKAkSEQoJZnVsbF9uYW1lGAQgASgJIjIKDExvZ2luUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSIsChNSZWZyZXNoVG9rZW5SZXF1ZXN0EhUKDXJlZnJlc2hfdG9rZW4YASABKAkiJQoUVmFsaWRhdGVUb2tlblJlcXVlc3QSDQoFdG9rZW4YASABKAkiSwoVVmFsaWRhdGVUb2tlblJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEg8KB3VzZXJfaWQYAiABKAkSEgoKZXhwaXJlc19hdBgDIAEoAyKoAQoMQXV0aFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSFAoMYWNjZXNzX3Rva2VuGAIgASgJEhUKDXJlZnJlc2hfdG9rZW4YAyABKAkSEgoKZXhwaXJlc19pbhgEIAEoAxIvCgR1c2VyGAUgASgLMiEubG9naXN0aWNzLmdhdGV3YXkudjEuVXNlclByb2ZpbGUSFQoNZXJyb3JfbWVzc2FnZRgGIAEoCSLAAQoLVXNlclByb2ZpbGUSDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRIRCglmdWxsX25hbWUYBCABKAkSDAoEcm9sZRgFIAEoCRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpsYXN0X2xvZ2luGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKNBgoZQ2FsY3VsYXRlTG9naXN0aWNzUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSMQoJYWxnb3JpdGhtGAIgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SFwoPc2tpcF92YWxpZGF0aW9uGAMgASgIEj8KEHZhbGlkYXRpb25fbGV2ZWwYBCABKA4yJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0aW9uTGV2ZWwSOQoNc29sdmVfb3B0aW9ucxgFIAEoCzIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNvbHZlT3B0aW9ucxIWCg5jYWxjdWxhdGVfY29zdBgGIAEoCBIYChBmaW5kX2JvdHRsZW5lY2tzGAcgASgIEhwKFGNhbGN1bGF0ZV9zdGF0aXN0aWNzGAggASgIEhwKFHN1Z2dlc3RfaW1wcm92ZW1lbnRzGAkgASgIEjcKDGNvc3Rfb3B0aW9ucxgKIAEoCzIhLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNvc3RPcHRpb25zEhwKFGJvdHRsZW5lY2tfdGhyZXNob2xkGAsgASgBEhcKD3NhdmVfdG9faGlzdG9yeRgMIAEoCBIYChBjYWxjdWxhdGlvbl9uYW1lGA0gASgJEkcKBHRhZ3MYDiADKAsyOS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5DYWxjdWxhdGVMb2dpc3RpY3NSZXF1ZXN0LlRhZ3NFbnRyeRIXCg9nZW5lcmF0ZV9yZXBvcnQYDyABKAgSOQoNcmVwb3J0X2Zvcm1hdBgQIAEoDjIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEZvcm1hdBI7Cg5yZXBvcnRfb3B0aW9ucxgRIAEoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydE9wdGlvbnMaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiowMKGkNhbGN1bGF0ZUxvZ2lzdGljc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSOgoKdmFsaWRhdGlvbhgCIAEoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLlZhbGlkYXRpb25SZXN1bHQSNwoMb3B0aW1pemF0aW9uGAMgASgLMiEubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVSZXN1bHQSOAoJYW5hbHl0aWNzGAQgASgLMiUubG9naXN0aWNzLmdhdGV3YXkudjEuQW5hbHl0aWNzUmVzdWx0EjAKBnJlcG9ydBgFIAEoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEluZm8SFgoOY2FsY3VsYXRpb25faWQYBiABKAkSNwoIbWV0YWRhdGEYByABKAsyJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXF1ZXN0TWV0YWRhdGESMAoGZXJyb3JzGAggAygLMiAubG9naXN0aWNzLmNvbW1vbi52MS5FcnJvckRldGFpbBIQCgh3YXJuaW5ncxgJIAMoCSKmAQoRU29sdmVHcmFwaFJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjEKCWFsZ29yaXRobRgCIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtEjMKB29wdGlvbnMYAyABKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2x2ZU9wdGlvbnMi1AEKElNvbHZlR3JhcGhSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEi8KBnJlc3VsdBgCIAEoCzIfLmxvZ2lzdGljcy5jb21tb24udjEuRmxvd1Jlc3VsdBIwCgxzb2x2ZWRfZ3JhcGgYAyABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjMKB21ldHJpY3MYBCABKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2x2ZU1ldHJpY3MSFQoNZXJyb3JfbWVzc2FnZRgFIAEoCSKfAgoSU29sdmVQcm9ncmVzc0V2ZW50EhEKCWl0ZXJhdGlvbhgBIAEoBRIUCgxjdXJyZW50X2Zsb3cYAiABKAESGAoQcHJvZ3Jlc3NfcGVyY2VudBgDIAEoARIOCgZzdGF0dXMYBCABKAkSLAoJbGFzdF9wYXRoGAUgASgLMhkubG9naXN0aWNzLmNvbW1vbi52MS5QYXRoEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYBiABKAESGQoRbWVtb3J5X3VzZWRfYnl0ZXMYByABKAMSEAoIaXNfZmluYWwYCCABKAgSPgoMZmluYWxfcmVzdWx0GAkgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVHcmFwaFJlc3BvbnNlIq0BChFCYXRjaFNvbHZlUmVxdWVzdBIzCgVpdGVtcxgBIAMoCzIkLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJhdGNoU29sdmVJdGVtEjkKEWRlZmF1bHRfYWxnb3JpdGhtGAIgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SEAoIcGFyYWxsZWwYAyABKAgSFgoObWF4X2NvbmN1cnJlbnQYBCABKAUiegoOQmF0Y2hTb2x2ZUl0ZW0SCgoCaWQYASABKAkSKQoFZ3JhcGgYAiABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtIogBChJCYXRjaFNvbHZlUmVzcG9uc2USNwoHcmVzdWx0cxgBIAMoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJhdGNoU29sdmVSZXN1bHQSFQoNdG90YWxfdGltZV9tcxgCIAEoARISCgpzdWNjZXNzZnVsGAMgASgFEg4KBmZhaWxlZBgEIAEoBSKJAQoQQmF0Y2hTb2x2ZVJlc3VsdBIKCgJpZBgBIAEoCRIPCgdzdWNjZXNzGAIgASgIEhAKCG1heF9mbG93GAMgASgBEhIKCnRvdGFsX2Nvc3QYBCABKAESGwoTY29tcHV0YXRpb25fdGltZV9tcxgFIAEoARIVCg1lcnJvcl9tZXNzYWdlGAYgASgJImYKDFNvbHZlT3B0aW9ucxIXCg90aW1lb3V0X3NlY29uZHMYASABKAESFAoMcmV0dXJuX3BhdGhzGAIgASgIEhYKDm1heF9pdGVyYXRpb25zGAMgASgFEg8KB2Vwc2lsb24YBCABKAEiegoMU29sdmVNZXRyaWNzEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYASABKAESEgoKaXRlcmF0aW9ucxgCIAEoBRIeChZhdWdtZW50aW5nX3BhdGhzX2ZvdW5kGAMgASgFEhkKEW1lbW9yeV91c2VkX2J5dGVzGAQgASgDIskBChRWYWxpZGF0ZUdyYXBoUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSNAoFbGV2ZWwYAiABKA4yJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0aW9uTGV2ZWwSGgoSY2hlY2tfY29ubmVjdGl2aXR5GAMgASgIEhwKFGNoZWNrX2J1c2luZXNzX3J1bGVzGAQgASgIEhYKDmNoZWNrX3RvcG9sb2d5GAUgASgIIuUBChVWYWxpZGF0ZUdyYXBoUmVzcG9uc2USEAoIaXNfdmFsaWQYASABKAgSNAoGZXJyb3JzGAIgAygLMiQubG9naXN0aWNzLmNvbW1vbi52MS5WYWxpZGF0aW9uRXJyb3ISEAoId2FybmluZ3MYAyADKAkSOAoKc3RhdGlzdGljcxgEIAEoCzIkLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGhTdGF0aXN0aWNzEjgKB21ldHJpY3MYBSABKAsyJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0aW9uTWV0cmljcyJ7ChtWYWxpZGF0ZUZvckFsZ29yaXRobVJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjEKCWFsZ29yaXRobRgCIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtIqUBChxWYWxpZGF0ZUZvckFsZ29yaXRobVJlc3BvbnNlEhUKDWlzX2NvbXBhdGlibGUYASABKAgSDgoGaXNzdWVzGAIgAygJEhcKD3JlY29tbWVuZGF0aW9ucxgDIAMoCRJFCgpjb21wbGV4aXR5GAQgASgLMjEubG9naXN0aWNzLmdhdGV3YXkudjEuQWxnb3JpdGhtQ29tcGxleGl0eUVzdGltYXRlIqEBChtBbGdvcml0aG1Db21wbGV4aXR5RXN0aW1hdGUSFwoPdGltZV9jb21wbGV4aXR5GAEgASgJEhgKEHNwYWNlX2NvbXBsZXhpdHkYAiABKAkSHAoUZXN0aW1hdGVkX2l0ZXJhdGlvbnMYAyABKAMSGQoRZXN0aW1hdGVkX3RpbWVfbXMYBCABKAESFgoOcmVjb21tZW5kYXRpb24YBSABKAki1QEKEFZhbGlkYXRpb25SZXN1bHQSEAoIaXNfdmFsaWQYASABKAgSFAoMZXJyb3JzX2NvdW50GAIgASgFEhYKDndhcm5pbmdzX2NvdW50GAMgASgFEjQKBmVycm9ycxgEIAMoCzIkLmxvZ2lzdGljcy5jb21tb24udjEuVmFsaWRhdGlvbkVycm9yEhAKCHdhcm5pbmdzGAUgAygJEjkKC2dyYXBoX3N0YXRzGAYgASgLMiQubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaFN0YXRpc3RpY3MibAoRVmFsaWRhdGlvbk1ldHJpY3MSFAoMdG90YWxfY2hlY2tzGAEgASgFEhUKDXBhc3NlZF9jaGVja3MYAiABKAUSFQoNZmFpbGVkX2NoZWNrcxgDIAEoBRITCgtkdXJhdGlvbl9tcxgEIAEoASJ4ChNBbmFseXplR3JhcGhSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI2CgdvcHRpb25zGAIgASgLMiUubG9naXN0aWNzLmdhdGV3YXkudjEuQW5hbHlzaXNPcHRpb25zIrcCChRBbmFseXplR3JhcGhSZXNwb25zZRI3CgpmbG93X3N0YXRzGAEgASgLMiMubG9naXN0aWNzLmNvbW1vbi52MS5GbG93U3RhdGlzdGljcxI5CgtncmFwaF9zdGF0cxgCIAEoCzIkLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGhTdGF0aXN0aWNzEjAKBGNvc3QYAyABKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0QW5hbHlzaXMSPQoLYm90dGxlbmVja3MYBCABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Cb3R0bGVuZWNrQW5hbHlzaXMSOgoKZWZmaWNpZW5jeRgFIAEoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLkVmZmljaWVuY3lSZXBvcnQi1QEKD0FuYWx5c2lzT3B0aW9ucxIVCg1hbmFseXplX2Nvc3RzGAEgASgIEhgKEGZpbmRfYm90dGxlbmVja3MYAiABKAgSHAoUY2FsY3VsYXRlX3N0YXRpc3RpY3MYAyABKAgSHAoUc3VnZ2VzdF9pbXByb3ZlbWVudHMYBCABKAgSHAoUYm90dGxlbmVja190aHJlc2hvbGQYBSABKAESNwoMY29zdF9vcHRpb25zGAYgASgLMiEubG9naXN0aWNzLmdhdGV3YXkudjEuQ29zdE9wdGlvbnMidQoUQ2FsY3VsYXRlQ29zdFJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjIKB29wdGlvbnMYAiABKAsyIS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0T3B0aW9ucyJ1ChVDYWxjdWxhdGVDb3N0UmVzcG9uc2USEgoKdG90YWxfY29zdBgBIAEoARIQCghjdXJyZW5jeRgCIAEoCRI2CglicmVha2Rvd24YAyABKAsyIy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0QnJlYWtkb3duIvgBCgtDb3N0T3B0aW9ucxIQCghjdXJyZW5jeRgBIAEoCRIbChNpbmNsdWRlX2ZpeGVkX2Nvc3RzGAIgASgIElAKEGNvc3RfbXVsdGlwbGllcnMYAyADKAsyNi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0T3B0aW9ucy5Db3N0TXVsdGlwbGllcnNFbnRyeRIYChBkaXNjb3VudF9wZXJjZW50GAQgASgBEhYKDm1hcmt1cF9wZXJjZW50GAUgASgBGjYKFENvc3RNdWx0aXBsaWVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEimAMKDUNvc3RCcmVha2Rvd24SFgoOdHJhbnNwb3J0X2Nvc3QYASABKAESEgoKZml4ZWRfY29zdBgCIAEoARIVCg1oYW5kbGluZ19jb3N0GAMgASgBEhcKD2Rpc2NvdW50X2Ftb3VudBgEIAEoARIVCg1tYXJrdXBfYW1vdW50GAUgASgBElIKEWNvc3RfYnlfcm9hZF90eXBlGAYgAygLMjcubG9naXN0aWNzLmdhdGV3YXkudjEuQ29zdEJyZWFrZG93bi5Db3N0QnlSb2FkVHlwZUVudHJ5ElIKEWNvc3RfYnlfbm9kZV90eXBlGAcgAygLMjcubG9naXN0aWNzLmdhdGV3YXkudjEuQ29zdEJyZWFrZG93bi5Db3N0QnlOb2RlVHlwZUVudHJ5GjUKE0Nvc3RCeVJvYWRUeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ARo1ChNDb3N0QnlOb2RlVHlwZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEibAoMQ29zdEFuYWx5c2lzEhIKCnRvdGFsX2Nvc3QYASABKAESEAoIY3VycmVuY3kYAiABKAkSNgoJYnJlYWtkb3duGAMgASgLMiMubG9naXN0aWNzLmdhdGV3YXkudjEuQ29zdEJyZWFrZG93biJtChJCb3R0bGVuZWNrc1JlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEh0KFXV0aWxpemF0aW9uX3RocmVzaG9sZBgCIAEoARINCgV0b3BfbhgDIAEoBSKLAQoTQm90dGxlbmVja3NSZXNwb25zZRI1Cgtib3R0bGVuZWNrcxgBIAMoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJvdHRsZW5lY2sSPQoPcmVjb21tZW5kYXRpb25zGAIgAygLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuUmVjb21tZW5kYXRpb24inwEKCkJvdHRsZW5lY2sSKgoEZWRnZRgBIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRITCgt1dGlsaXphdGlvbhgCIAEoARIUCgxpbXBhY3Rfc2NvcmUYAyABKAESOgoIc2V2ZXJpdHkYBCABKA4yKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Cb3R0bGVuZWNrU2V2ZXJpdHkinwEKDlJlY29tbWVuZGF0aW9uEgwKBHR5cGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSMwoNYWZmZWN0ZWRfZWRnZRgDIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIdChVlc3RpbWF0ZWRfaW1wcm92ZW1lbnQYBCABKAESFgoOZXN0aW1hdGVkX2Nvc3QYBSABKAEipQEKEkJvdHRsZW5lY2tBbmFseXNpcxI1Cgtib3R0bGVuZWNrcxgBIAMoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJvdHRsZW5lY2sSPQoPcmVjb21tZW5kYXRpb25zGAIgAygLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuUmVjb21tZW5kYXRpb24SGQoRdG90YWxfYm90dGxlbmVja3MYAyABKAUilgEKEEVmZmljaWVuY3lSZXBvcnQSGgoSb3ZlcmFsbF9lZmZpY2llbmN5GAEgASgBEhwKFGNhcGFjaXR5X3V0aWxpemF0aW9uGAIgASgBEhoKEnVudXNlZF9lZGdlc19jb3VudBgDIAEoBRIdChVzYXR1cmF0ZWRfZWRnZXNfY291bnQYBCABKAUSDQoFZ3JhZGUYBSABKAkifwoXQ29tcGFyZVNjZW5hcmlvc1JlcXVlc3QSLAoIYmFzZWxpbmUYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjYKCXNjZW5hcmlvcxgCIAMoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNjZW5hcmlvSW5wdXQiSAoNU2NlbmFyaW9JbnB1dBIMCgRuYW1lGAEgASgJEikKBWdyYXBoGAIgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaCK6AQoYQ29tcGFyZVNjZW5hcmlvc1Jlc3BvbnNlEjYKCGJhc2VsaW5lGAEgASgLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuU2NlbmFyaW9SZXN1bHQSNwoJc2NlbmFyaW9zGAIgAygLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuU2NlbmFyaW9SZXN1bHQSFQoNYmVzdF9zY2VuYXJpbxgDIAEoCRIWCg5yZWNvbW1lbmRhdGlvbhgEIAEoCSJ5Cg5TY2VuYXJpb1Jlc3VsdBIMCgRuYW1lGAEgASgJEhAKCG1heF9mbG93GAIgASgBEhIKCnRvdGFsX2Nvc3QYAyABKAESEgoKZWZmaWNpZW5jeRgEIAEoARIfChdpbXByb3ZlbWVudF92c19iYXNlbGluZRgFIAEoASL6AgoPQW5hbHl0aWNzUmVzdWx0EhIKCnRvdGFsX2Nvc3QYASABKAESEAoIY3VycmVuY3kYAiABKAkSOwoOY29zdF9icmVha2Rvd24YAyABKAsyIy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0QnJlYWtkb3duEjUKC2JvdHRsZW5lY2tzGAQgAygLMiAubG9naXN0aWNzLmdhdGV3YXkudjEuQm90dGxlbmVjaxIZChFib3R0bGVuZWNrc19jb3VudBgFIAEoBRI9Cg9yZWNvbW1lbmRhdGlvbnMYBiADKAsyJC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZWNvbW1lbmRhdGlvbhI6CgplZmZpY2llbmN5GAcgASgLMiYubG9naXN0aWNzLmdhdGV3YXkudjEuRWZmaWNpZW5jeVJlcG9ydBI3CgpmbG93X3N0YXRzGAggASgLMiMubG9naXN0aWNzLmNvbW1vbi52MS5GbG93U3RhdGlzdGljcyKGAgoLU29sdmVSZXN1bHQSMAoMc29sdmVkX2dyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIQCghtYXhfZmxvdxgCIAEoARISCgp0b3RhbF9jb3N0GAMgASgBEi8KBnN0YXR1cxgEIAEoDjIfLmxvZ2lzdGljcy5jb21tb24udjEuRmxvd1N0YXR1cxISCgppdGVyYXRpb25zGAUgASgFEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYBiABKAESEwoLcGF0aHNfZm91bmQYByABKAUSKAoFcGF0aHMYCCADKAsyGS5sb2dpc3RpY3MuY29tbW9uLnYxLlBhdGgi5wEKDVdoYXRJZlJlcXVlc3QSMgoOYmFzZWxpbmVfZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjkKDW1vZGlmaWNhdGlvbnMYAiADKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb2RpZmljYXRpb24SMQoJYWxnb3JpdGhtGAMgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SNAoHb3B0aW9ucxgEIAEoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLldoYXRJZk9wdGlvbnMi+AEKDE1vZGlmaWNhdGlvbhI0CgR0eXBlGAEgASgOMiYubG9naXN0aWNzLmdhdGV3YXkudjEuTW9kaWZpY2F0aW9uVHlwZRIuCghlZGdlX2tleRgCIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIPCgdub2RlX2lkGAMgASgDEjgKBnRhcmdldBgEIAEoDjIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vZGlmaWNhdGlvblRhcmdldBINCgV2YWx1ZRgFIAEoARITCgtpc19yZWxhdGl2ZRgGIAEoCBITCgtkZXNjcmlwdGlvbhgHIAEoCSKKAQoNV2hhdElmT3B0aW9ucxIdChVjb21wYXJlX3dpdGhfYmFzZWxpbmUYASABKAgSHQoVY2FsY3VsYXRlX2Nvc3RfaW1wYWN0GAIgASgIEhwKFGZpbmRfbmV3X2JvdHRsZW5lY2tzGAMgASgIEh0KFXJldHVybl9tb2RpZmllZF9ncmFwaBgEIAEoCCLWAgoOV2hhdElmUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI2CghiYXNlbGluZRgCIAEoCzIkLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNjZW5hcmlvUmVzdWx0EjYKCG1vZGlmaWVkGAMgASgLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuU2NlbmFyaW9SZXN1bHQSPAoKY29tcGFyaXNvbhgEIAEoCzIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNjZW5hcmlvQ29tcGFyaXNvbhIyCg5tb2RpZmllZF9ncmFwaBgFIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOgoIbWV0YWRhdGEYBiABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TaW11bGF0aW9uTWV0YWRhdGESFQoNZXJyb3JfbWVzc2FnZRgHIAEoCSLJAQoSU2NlbmFyaW9Db21wYXJpc29uEhMKC2Zsb3dfY2hhbmdlGAEgASgBEhsKE2Zsb3dfY2hhbmdlX3BlcmNlbnQYAiABKAESEwoLY29zdF9jaGFuZ2UYAyABKAESGwoTY29zdF9jaGFuZ2VfcGVyY2VudBgEIAEoARIWCg5pbXBhY3Rfc3VtbWFyeRgFIAEoCRI3CgxpbXBhY3RfbGV2ZWwYBiABKA4yIS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5JbXBhY3RMZXZlbCLnAQoRTW9udGVDYXJsb1JlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjYKBmNvbmZpZxgCIAEoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vbnRlQ2FybG9Db25maWcSPAoNdW5jZXJ0YWludGllcxgDIAMoCzIlLmxvZ2lzdGljcy5nYXRld2F5LnYxLlVuY2VydGFpbnR5U3BlYxIxCglhbGdvcml0aG0YBCABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSJrChBNb250ZUNhcmxvQ29uZmlnEhYKDm51bV9pdGVyYXRpb25zGAEgASgFEhMKC3JhbmRvbV9zZWVkGAIgASgDEhgKEGNvbmZpZGVuY2VfbGV2ZWwYAyABKAESEAoIcGFyYWxsZWwYBCABKAgiwgEKD1VuY2VydGFpbnR5U3BlYxIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5Eg8KB25vZGVfaWQYAiABKAMSOAoGdGFyZ2V0GAMgASgOMigubG9naXN0aWNzLmdhdGV3YXkudjEuTW9kaWZpY2F0aW9uVGFyZ2V0EjgKDGRpc3RyaWJ1dGlvbhgEIAEoCzIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLkRpc3RyaWJ1dGlvbiJ0CgxEaXN0cmlidXRpb24SNAoEdHlwZRgBIAEoDjImLmxvZ2lzdGljcy5nYXRld2F5LnYxLkRpc3RyaWJ1dGlvblR5cGUSDgoGcGFyYW0xGAIgASgBEg4KBnBhcmFtMhgDIAEoARIOCgZwYXJhbTMYBCABKAEiqQIKEk1vbnRlQ2FybG9SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjkKCmZsb3dfc3RhdHMYAiABKAsyJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb250ZUNhcmxvU3RhdHMSOQoKY29zdF9zdGF0cxgDIAEoCzIlLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vbnRlQ2FybG9TdGF0cxI5Cg1yaXNrX2FuYWx5c2lzGAQgASgLMiIubG9naXN0aWNzLmdhdGV3YXkudjEuUmlza0FuYWx5c2lzEjoKCG1ldGFkYXRhGAUgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU2ltdWxhdGlvbk1ldGFkYXRhEhUKDWVycm9yX21lc3NhZ2UYBiABKAkinQEKD01vbnRlQ2FybG9TdGF0cxIMCgRtZWFuGAEgASgBEg8KB3N0ZF9kZXYYAiABKAESCwoDbWluGAMgASgBEgsKA21heBgEIAEoARIOCgZtZWRpYW4YBSABKAESHwoXY29uZmlkZW5jZV9pbnRlcnZhbF9sb3cYBiABKAESIAoYY29uZmlkZW5jZV9pbnRlcnZhbF9oaWdoGAcgASgBInsKDFJpc2tBbmFseXNpcxIjChtwcm9iYWJpbGl0eV9iZWxvd190aHJlc2hvbGQYASABKAESFQoNdmFsdWVfYXRfcmlzaxgCIAEoARIXCg93b3JzdF9jYXNlX2Zsb3cYAyABKAESFgoOYmVzdF9jYXNlX2Zsb3cYBCABKAEi9gEKF01vbnRlQ2FybG9Qcm9ncmVzc0V2ZW50EhEKCWl0ZXJhdGlvbhgBIAEoBRIYChB0b3RhbF9pdGVyYXRpb25zGAIgASgFEhgKEHByb2dyZXNzX3BlcmNlbnQYAyABKAESGQoRY3VycmVudF9tZWFuX2Zsb3cYBCABKAESFwoPY3VycmVudF9zdGRfZGV2GAUgASgBEg4KBnN0YXR1cxgGIAEoCRIQCghpc19maW5hbBgHIAEoCBI+CgxmaW5hbF9yZXN1bHQYCCABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb250ZUNhcmxvUmVzcG9uc2UisgEKElNlbnNpdGl2aXR5UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPgoKcGFyYW1ldGVycxgCIAMoCzIqLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNlbnNpdGl2aXR5UGFyYW1ldGVyEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtItABChRTZW5zaXRpdml0eVBhcmFtZXRlchIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5Eg8KB25vZGVfaWQYAiABKAMSOAoGdGFyZ2V0GAMgASgOMigubG9naXN0aWNzLmdhdGV3YXkudjEuTW9kaWZpY2F0aW9uVGFyZ2V0EhYKDm1pbl9tdWx0aXBsaWVyGAQgASgBEhYKDm1heF9tdWx0aXBsaWVyGAUgASgBEhEKCW51bV9zdGVwcxgGIAEoBSLtAQoTU2Vuc2l0aXZpdHlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjgKB3Jlc3VsdHMYAiADKAsyJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TZW5zaXRpdml0eVJlc3VsdBI4CghyYW5raW5ncxgDIAMoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLlBhcmFtZXRlclJhbmtpbmcSOgoIbWV0YWRhdGEYBCABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TaW11bGF0aW9uTWV0YWRhdGESFQoNZXJyb3JfbWVzc2FnZRgFIAEoCSKPAQoRU2Vuc2l0aXZpdHlSZXN1bHQSFAoMcGFyYW1ldGVyX2lkGAEgASgJEjUKBWN1cnZlGAIgAygLMiYubG9naXN0aWNzLmdhdGV3YXkudjEuU2Vuc2l0aXZpdHlQb2ludBISCgplbGFzdGljaXR5GAMgASgBEhkKEXNlbnNpdGl2aXR5X2luZGV4GAQgASgBIlMKEFNlbnNpdGl2aXR5UG9pbnQSFwoPcGFyYW1ldGVyX3ZhbHVlGAEgASgBEhIKCmZsb3dfdmFsdWUYAiABKAESEgoKY29zdF92YWx1ZRgDIAEoASJmChBQYXJhbWV0ZXJSYW5raW5nEhQKDHBhcmFtZXRlcl9pZBgBIAEoCRIMCgRyYW5rGAIgASgFEhkKEXNlbnNpdGl2aXR5X2luZGV4GAMgASgBEhMKC2Rlc2NyaXB0aW9uGAQgASgJIqkBChFSZXNpbGllbmNlUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSNgoGY29uZmlnGAIgASgLMiYubG9naXN0aWNzLmdhdGV3YXkudjEuUmVzaWxpZW5jZUNvbmZpZxIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSJmChBSZXNpbGllbmNlQ29uZmlnEhwKFG1heF9mYWlsdXJlc190b190ZXN0GAEgASgFEh8KF3Rlc3RfY2FzY2FkaW5nX2ZhaWx1cmVzGAIgASgIEhMKC2xvYWRfZmFjdG9yGAMgASgBIvABChJSZXNpbGllbmNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI4CgdtZXRyaWNzGAIgASgLMicubG9naXN0aWNzLmdhdGV3YXkudjEuUmVzaWxpZW5jZU1ldHJpY3MSPAoKd2Vha25lc3NlcxgDIAMoCzIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlc2lsaWVuY2VXZWFrbmVzcxI6CghtZXRhZGF0YRgEIAEoCzIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNpbXVsYXRpb25NZXRhZGF0YRIVCg1lcnJvcl9tZXNzYWdlGAUgASgJIpQBChFSZXNpbGllbmNlTWV0cmljcxIVCg1vdmVyYWxsX3Njb3JlGAEgASgBEh8KF2Nvbm5lY3Rpdml0eV9yb2J1c3RuZXNzGAIgASgBEhcKD2Zsb3dfcm9idXN0bmVzcxgDIAEoARIYChByZWR1bmRhbmN5X2xldmVsGAQgASgBEhQKDG1pbl9jdXRfc2l6ZRgFIAEoBSKeAQoSUmVzaWxpZW5jZVdlYWtuZXNzEhMKC2Rlc2NyaXB0aW9uGAEgASgJEgwKBHR5cGUYAiABKAkSEAoIc2V2ZXJpdHkYAyABKAESNAoOYWZmZWN0ZWRfZWRnZXMYBCADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSHQoVbWl0aWdhdGlvbl9zdWdnZXN0aW9uGAUgASgJIrIBChhGYWlsdXJlU2ltdWxhdGlvblJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjgKCXNjZW5hcmlvcxgCIAMoCzIlLmxvZ2lzdGljcy5nYXRld2F5LnYxLkZhaWx1cmVTY2VuYXJpbxIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSJ+Cg9GYWlsdXJlU2NlbmFyaW8SDAoEbmFtZRgBIAEoCRIyCgxmYWlsZWRfZWRnZXMYAiADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSFAoMZmFpbGVkX25vZGVzGAMgAygDEhMKC3Byb2JhYmlsaXR5GAQgASgBIrECChlGYWlsdXJlU2ltdWxhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSNgoIYmFzZWxpbmUYAiABKAsyJC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TY2VuYXJpb1Jlc3VsdBJFChBzY2VuYXJpb19yZXN1bHRzGAMgAygLMisubG9naXN0aWNzLmdhdGV3YXkudjEuRmFpbHVyZVNjZW5hcmlvUmVzdWx0EjEKBXN0YXRzGAQgASgLMiIubG9naXN0aWNzLmdhdGV3YXkudjEuRmFpbHVyZVN0YXRzEjoKCG1ldGFkYXRhGAUgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU2ltdWxhdGlvbk1ldGFkYXRhEhUKDWVycm9yX21lc3NhZ2UYBiABKAki1gEKFUZhaWx1cmVTY2VuYXJpb1Jlc3VsdBIVCg1zY2VuYXJpb19uYW1lGAEgASgJEhMKC3Byb2JhYmlsaXR5GAIgASgBEjQKBnJlc3VsdBgDIAEoCzIkLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNjZW5hcmlvUmVzdWx0Ej0KC3ZzX2Jhc2VsaW5lGAQgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU2NlbmFyaW9Db21wYXJpc29uEhwKFG5ldHdvcmtfZGlzY29ubmVjdGVkGAUgASgIImcKDEZhaWx1cmVTdGF0cxIaChJleHBlY3RlZF9mbG93X2xvc3MYASABKAESFQoNbWF4X2Zsb3dfbG9zcxgCIAEoARIkChxwcm9iYWJpbGl0eV9vZl9kaXNjb25uZWN0aW9uGAMgASgBIrUBChdDcml0aWNhbEVsZW1lbnRzUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPAoGY29uZmlnGAIgASgLMiwubG9naXN0aWNzLmdhdGV3YXkudjEuQ3JpdGljYWxFbGVtZW50c0NvbmZpZxIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSJwChZDcml0aWNhbEVsZW1lbnRzQ29uZmlnEhUKDWFuYWx5emVfZWRnZXMYASABKAgSFQoNYW5hbHl6ZV9ub2RlcxgCIAEoCBINCgV0b3BfbhgDIAEoBRIZChFmYWlsdXJlX3RocmVzaG9sZBgEIAEoASLQAgoYQ3JpdGljYWxFbGVtZW50c1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSOgoOY3JpdGljYWxfZWRnZXMYAiADKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Dcml0aWNhbEVkZ2USOgoOY3JpdGljYWxfbm9kZXMYAyADKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Dcml0aWNhbE5vZGUSPgoYc2luZ2xlX3BvaW50c19vZl9mYWlsdXJlGAQgAygLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EhgKEHJlc2lsaWVuY2Vfc2NvcmUYBSABKAESOgoIbWV0YWRhdGEYBiABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TaW11bGF0aW9uTWV0YWRhdGESFQoNZXJyb3JfbWVzc2FnZRgHIAEoCSKnAQoMQ3JpdGljYWxFZGdlEioKBGVkZ2UYASABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSGQoRY3JpdGljYWxpdHlfc2NvcmUYAiABKAESHgoWZmxvd19pbXBhY3RfaWZfcmVtb3ZlZBgDIAEoARIMCgRyYW5rGAQgASgFEiIKGmlzX3NpbmdsZV9wb2ludF9vZl9mYWlsdXJlGAUgASgIIoABCgxDcml0aWNhbE5vZGUSDwoHbm9kZV9pZBgBIAEoAxIZChFjcml0aWNhbGl0eV9zY29yZRgCIAEoARIeChZmbG93X2ltcGFjdF9pZl9yZW1vdmVkGAMgASgBEhYKDmFmZmVjdGVkX2VkZ2VzGAQgASgFEgwKBHJhbmsYBSABKAUiwQEKElNpbXVsYXRpb25NZXRhZGF0YRIVCg1zaW11bGF0aW9uX2lkGAEgASgJEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYAiABKAESEgoKaXRlcmF0aW9ucxgDIAEoBRIZChFtZW1vcnlfdXNlZF9ieXRlcxgEIAEoAxIWCg5hbGdvcml0aG1fdXNlZBgFIAEoCRIwCgxjb21wbGV0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIi0KFEdldFNpbXVsYXRpb25SZXF1ZXN0EhUKDXNpbXVsYXRpb25faWQYASABKAkiUAoWTGlzdFNpbXVsYXRpb25zUmVxdWVzdBINCgVsaW1pdBgBIAEoBRIOCgZvZmZzZXQYAiABKAUSFwoPc2ltdWxhdGlvbl90eXBlGAMgASgJIn0KF0xpc3RTaW11bGF0aW9uc1Jlc3BvbnNlEjsKC3NpbXVsYXRpb25zGAEgAygLMiYubG9naXN0aWNzLmdhdGV3YXkudjEuU2ltdWxhdGlvblJlY29yZBITCgt0b3RhbF9jb3VudBgCIAEoAxIQCghoYXNfbW9yZRgDIAEoCCLsAQoQU2ltdWxhdGlvblJlY29yZBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHR5cGUYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPgoEdGFncxgFIAMoCzIwLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNpbXVsYXRpb25SZWNvcmQuVGFnc0VudHJ5EhMKC3Jlc3VsdF9kYXRhGAYgASgMGisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjAKF0RlbGV0ZVNpbXVsYXRpb25SZXF1ZXN0EhUKDXNpbXVsYXRpb25faWQYASABKAki/gEKFlNhdmVDYWxjdWxhdGlvblJlcXVlc3QSDAoEbmFtZRgBIAEoCRIpCgVncmFwaBgCIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOAoGcmVzdWx0GAMgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVHcmFwaFJlc3BvbnNlEkQKBHRhZ3MYBCADKAsyNi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TYXZlQ2FsY3VsYXRpb25SZXF1ZXN0LlRhZ3NFbnRyeRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJhChdTYXZlQ2FsY3VsYXRpb25SZXNwb25zZRIWCg5jYWxjdWxhdGlvbl9pZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIvChVHZXRDYWxjdWxhdGlvblJlcXVlc3QSFgoOY2FsY3VsYXRpb25faWQYASABKAkihAIKF0xpc3RDYWxjdWxhdGlvbnNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBRIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRIMCgR0YWdzGAQgAygJEjEKDWNyZWF0ZWRfYWZ0ZXIYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjIKDmNyZWF0ZWRfYmVmb3JlGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdzb3J0X2J5GAcgASgJEhEKCXNvcnRfZGVzYxgIIAEoCCKBAQoYTGlzdENhbGN1bGF0aW9uc1Jlc3BvbnNlEj4KDGNhbGN1bGF0aW9ucxgBIAMoCzIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNhbGN1bGF0aW9uU3VtbWFyeRITCgt0b3RhbF9jb3VudBgCIAEoAxIQCghoYXNfbW9yZRgDIAEoCCK8AgoRQ2FsY3VsYXRpb25SZWNvcmQSFgoOY2FsY3VsYXRpb25faWQYASABKAkSDAoEbmFtZRgCIAEoCRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIpCgVncmFwaBgEIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOAoGcmVzdWx0GAUgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVHcmFwaFJlc3BvbnNlEj8KBHRhZ3MYBiADKAsyMS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5DYWxjdWxhdGlvblJlY29yZC5UYWdzRW50cnkaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEilgIKEkNhbGN1bGF0aW9uU3VtbWFyeRIWCg5jYWxjdWxhdGlvbl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEi4KCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCG1heF9mbG93GAQgASgBEhIKCnRvdGFsX2Nvc3QYBSABKAESMQoJYWxnb3JpdGhtGAYgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SGwoTY29tcHV0YXRpb25fdGltZV9tcxgHIAEoARISCgpub2RlX2NvdW50GAggASgFEhIKCmVkZ2VfY291bnQYCSABKAUSDAoEdGFncxgKIAMoCSIyChhEZWxldGVDYWxjdWxhdGlvblJlcXVlc3QSFgoOY2FsY3VsYXRpb25faWQYASABKAkidAoUR2V0U3RhdGlzdGljc1JlcXVlc3QSLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIuYCChJTdGF0aXN0aWNzUmVzcG9uc2USGgoSdG90YWxfY2FsY3VsYXRpb25zGAEgASgFEhgKEGF2ZXJhZ2VfbWF4X2Zsb3cYAiABKAESFAoMYXZlcmFnZV9jb3N0GAMgASgBEiMKG2F2ZXJhZ2VfY29tcHV0YXRpb25fdGltZV9tcxgEIAEoARJoChljYWxjdWxhdGlvbnNfYnlfYWxnb3JpdGhtGAUgAygLMkUubG9naXN0aWNzLmdhdGV3YXkudjEuU3RhdGlzdGljc1Jlc3BvbnNlLkNhbGN1bGF0aW9uc0J5QWxnb3JpdGhtRW50cnkSNQoLZGFpbHlfc3RhdHMYBiADKAsyIC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5EYWlseVN0YXRzGj4KHENhbGN1bGF0aW9uc0J5QWxnb3JpdGhtRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASI9CgpEYWlseVN0YXRzEgwKBGRhdGUYASABKAkSDQoFY291bnQYAiABKAUSEgoKdG90YWxfZmxvdxgDIAEoASLTAwoVR2VuZXJhdGVSZXBvcnRSZXF1ZXN0Ei4KBHR5cGUYASABKA4yIC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRUeXBlEjIKBmZvcm1hdBgCIAEoDjIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEZvcm1hdBI0CgdvcHRpb25zGAMgASgLMiMubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0T3B0aW9ucxI9CgtmbG93X3NvdXJjZRgKIAEoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLkZsb3dSZXBvcnRTb3VyY2VIABJHChBhbmFseXRpY3Nfc291cmNlGAsgASgLMisubG9naXN0aWNzLmdhdGV3YXkudjEuQW5hbHl0aWNzUmVwb3J0U291cmNlSAASSQoRc2ltdWxhdGlvbl9zb3VyY2UYDCABKAsyLC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TaW11bGF0aW9uUmVwb3J0U291cmNlSAASQwoOaGlzdG9yeV9zb3VyY2UYDSABKAsyKS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5IaXN0b3J5UmVwb3J0U291cmNlSABCCAoGc291cmNlIv0CCg1SZXBvcnRPcHRpb25zEg0KBXRpdGxlGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg4KBmF1dGhvchgDIAEoCRIQCghsYW5ndWFnZRgEIAEoCRIQCgh0aW1lem9uZRgFIAEoCRIdChVpbmNsdWRlX2dyYXBoX2RldGFpbHMYBiABKAgSGQoRaW5jbHVkZV9lZGdlX2xpc3QYByABKAgSHAoUaW5jbHVkZV9wYXRoX2RldGFpbHMYCCABKAgSHwoXaW5jbHVkZV9yZWNvbW1lbmRhdGlvbnMYCSABKAgSFgoOaW5jbHVkZV9jaGFydHMYCiABKAgSFAoMY29tcGFueV9uYW1lGAsgASgJEhAKCGxvZ29fdXJsGAwgASgJEg0KBXRoZW1lGA0gASgJEhAKCGN1cnJlbmN5GA4gASgJEgwKBHRhZ3MYDyADKAkSEwoLdHRsX3NlY29uZHMYECABKAMSFwoPc2F2ZV90b19zdG9yYWdlGBEgASgIIqMBChBGbG93UmVwb3J0U291cmNlEikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIvCgZyZXN1bHQYAiABKAsyHy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dSZXN1bHQSMwoHbWV0cmljcxgDIAEoCzIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNvbHZlTWV0cmljcyKBAQoVQW5hbHl0aWNzUmVwb3J0U291cmNlEikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI9CglhbmFseXRpY3MYAiABKAsyKi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BbmFseXplR3JhcGhSZXNwb25zZSKAAQoWU2ltdWxhdGlvblJlcG9ydFNvdXJjZRIyCg5iYXNlbGluZV9ncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSFwoPc2ltdWxhdGlvbl90eXBlGAIgASgJEhkKEXNpbXVsYXRpb25fcmVzdWx0GAMgASgMIowBChNIaXN0b3J5UmVwb3J0U291cmNlEi4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCg9jYWxjdWxhdGlvbl9pZHMYAyADKAkigwEKFkdlbmVyYXRlUmVwb3J0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIwCgZyZXBvcnQYAiABKAsyIC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRJbmZvEg8KB2NvbnRlbnQYAyABKAwSFQoNZXJyb3JfbWVzc2FnZRgEIAEoCSK2AgoKUmVwb3J0SW5mbxIRCglyZXBvcnRfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSLgoEdHlwZRgDIAEoDjIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydFR5cGUSMgoGZm9ybWF0GAQgASgOMiIubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0Rm9ybWF0EjAKDGdlbmVyYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKc2l6ZV9ieXRlcxgGIAEoAxIaChJnZW5lcmF0aW9uX3RpbWVfbXMYByABKAESEAoIZmlsZW5hbWUYCCABKAkSLgoKZXhwaXJlc19hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiJQoQR2V0UmVwb3J0UmVxdWVzdBIRCglyZXBvcnRfaWQYASABKAkiKgoVRG93bmxvYWRSZXBvcnRSZXF1ZXN0EhEKCXJlcG9ydF9pZBgBIAEoCSJXCgtSZXBvcnRDaHVuaxITCgtjaHVua19pbmRleBgBIAEoBRIUCgx0b3RhbF9jaHVua3MYAiABKAUSDAoEZGF0YRgDIAEoDBIPCgdpc19sYXN0GAQgASgIImIKDFJlcG9ydFJlY29yZBIRCglyZXBvcnRfaWQYASABKAkSLgoEaW5mbxgCIAEoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEluZm8SDwoHY29udGVudBgDIAEoDCL+AQoSTGlzdFJlcG9ydHNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBRIuCgR0eXBlGAMgASgOMiAubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0VHlwZRIyCgZmb3JtYXQYBCABKA4yIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRGb3JtYXQSMQoNY3JlYXRlZF9hZnRlchgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoOY3JlYXRlZF9iZWZvcmUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIm8KE0xpc3RSZXBvcnRzUmVzcG9uc2USMQoHcmVwb3J0cxgBIAMoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEluZm8SEwoLdG90YWxfY291bnQYAiABKAMSEAoIaGFzX21vcmUYAyABKAgiKAoTRGVsZXRlUmVwb3J0UmVxdWVzdBIRCglyZXBvcnRfaWQYASABKAkiUAoVUmVwb3J0Rm9ybWF0c1Jlc3BvbnNlEjcKB2Zvcm1hdHMYASADKAsyJi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRGb3JtYXRJbmZvIu8BChBSZXBvcnRGb3JtYXRJbmZvEjIKBmZvcm1hdBgBIAEoDjIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEZvcm1hdBIMCgRuYW1lGAIgASgJEhEKCWV4dGVuc2lvbhgDIAEoCRIRCgltaW1lX3R5cGUYBCABKAkSFwoPc3VwcG9ydHNfY2hhcnRzGAUgASgIEhgKEHN1cHBvcnRzX3N0eWxpbmcYBiABKAgSQAoWc3VwcG9ydGVkX3JlcG9ydF90eXBlcxgHIAMoDjIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydFR5cGUi3QEKE0dldEF1ZGl0TG9nc1JlcXVlc3QSLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCHNlcnZpY2VzGAMgAygJEg8KB2FjdGlvbnMYBCADKAkSDwoHdXNlcl9pZBgFIAEoCRIVCg1yZXNvdXJjZV90eXBlGAYgASgJEg0KBWxpbWl0GAcgASgFEg4KBm9mZnNldBgIIAEoBSJtChFBdWRpdExvZ3NSZXNwb25zZRIxCgdlbnRyaWVzGAEgAygLMiAubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRFbnRyeRITCgt0b3RhbF9jb3VudBgCIAEoAxIQCghoYXNfbW9yZRgDIAEoCCKKAwoKQXVkaXRFbnRyeRIKCgJpZBgBIAEoCRItCgl0aW1lc3RhbXAYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB3NlcnZpY2UYAyABKAkSDgoGbWV0aG9kGAQgASgJEg4KBmFjdGlvbhgFIAEoCRIPCgdvdXRjb21lGAYgASgJEg8KB3VzZXJfaWQYByABKAkSEAoIdXNlcm5hbWUYCCABKAkSEQoJY2xpZW50X2lwGAkgASgJEhUKDXJlc291cmNlX3R5cGUYCiABKAkSEwoLcmVzb3VyY2VfaWQYCyABKAkSEwoLZHVyYXRpb25fbXMYDCABKAMSFQoNZXJyb3JfbWVzc2FnZRgNIAEoCRJACghtZXRhZGF0YRgOIAMoCzIuLmxvZ2lzdGljcy5nYXRld2F5LnYxLkF1ZGl0RW50cnkuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEipgEKFkdldFVzZXJBY3Rpdml0eVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIuCgpzdGFydF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGltaXQYBCABKAUSDgoGb2Zmc2V0GAUgASgFIpoBChRVc2VyQWN0aXZpdHlSZXNwb25zZRIxCgdlbnRyaWVzGAEgAygLMiAubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRFbnRyeRI6CgdzdW1tYXJ5GAIgASgLMikubG9naXN0aWNzLmdhdGV3YXkudjEuVXNlckFjdGl2aXR5U3VtbWFyeRITCgt0b3RhbF9jb3VudBgDIAEoAyLqAwoTVXNlckFjdGl2aXR5U3VtbWFyeRIVCg10b3RhbF9hY3Rpb25zGAEgASgFEhoKEnN1Y2Nlc3NmdWxfYWN0aW9ucxgCIAEoBRIWCg5mYWlsZWRfYWN0aW9ucxgDIAEoBRJVCg9hY3Rpb25zX2J5X3R5cGUYBCADKAsyPC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Vc2VyQWN0aXZpdHlTdW1tYXJ5LkFjdGlvbnNCeVR5cGVFbnRyeRJbChJhY3Rpb25zX2J5X3NlcnZpY2UYBSADKAsyPy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Vc2VyQWN0aXZpdHlTdW1tYXJ5LkFjdGlvbnNCeVNlcnZpY2VFbnRyeRIyCg5maXJzdF9hY3Rpdml0eRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoNbGFzdF9hY3Rpdml0eRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaNAoSQWN0aW9uc0J5VHlwZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaNwoVQWN0aW9uc0J5U2VydmljZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEihgEKFEdldEF1ZGl0U3RhdHNSZXF1ZXN0Ei4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghncm91cF9ieRgDIAEoCSKmAwoSQXVkaXRTdGF0c1Jlc3BvbnNlEhQKDHRvdGFsX2V2ZW50cxgBIAEoAxIZChFzdWNjZXNzZnVsX2V2ZW50cxgCIAEoAxIVCg1mYWlsZWRfZXZlbnRzGAMgASgDEhQKDHVuaXF1ZV91c2VycxgEIAEoAxJLCgpieV9zZXJ2aWNlGAUgAygLMjcubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRTdGF0c1Jlc3BvbnNlLkJ5U2VydmljZUVudHJ5EkkKCWJ5X2FjdGlvbhgGIAMoCzI2LmxvZ2lzdGljcy5nYXRld2F5LnYxLkF1ZGl0U3RhdHNSZXNwb25zZS5CeUFjdGlvbkVudHJ5EjcKCHRpbWVsaW5lGAcgAygLMiUubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRTdGF0c1BvaW50GjAKDkJ5U2VydmljZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAzoCOAEaLwoNQnlBY3Rpb25FbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6AjgBIn0KD0F1ZGl0U3RhdHNQb2ludBItCgl0aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWNvdW50GAIgASgDEhUKDXN1Y2Nlc3NfY291bnQYAyABKAMSFQoNZmFpbHVyZV9jb3VudBgEIAEoAyKXAgoPUmVxdWVzdE1ldGFkYXRhEhIKCnJlcXVlc3RfaWQYASABKAkSFQoNdG90YWxfdGltZV9tcxgCIAEoARIaChJ2YWxpZGF0aW9uX3RpbWVfbXMYAyABKAESFQoNc29sdmVfdGltZV9tcxgEIAEoARIZChFhbmFseXRpY3NfdGltZV9tcxgFIAEoARIWCg5yZXBvcnRfdGltZV9tcxgGIAEoARIXCg9zZXJ2aWNlX3ZlcnNpb24YByABKAkSFgoOYWxnb3JpdGhtX3VzZWQYCCABKAkSMAoMcHJvY2Vzc2VkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCgh0cmFjZV9pZBgKIAEoCSqmAQoPVmFsaWRhdGlvbkxldmVsEiAKHFZBTElEQVRJT05fTEVWRUxfVU5TUEVDSUZJRUQQABIaChZWQUxJREFUSU9OX0xFVkVMX0JBU0lDEAESHQoZVkFMSURBVElPTl9MRVZFTF9TVEFOREFSRBACEhsKF1ZBTElEQVRJT05fTEVWRUxfU1RSSUNUEAMSGQoVVkFMSURBVElPTl9MRVZFTF9GVUxMEAQqtgEKEkJvdHRsZW5lY2tTZXZlcml0eRIjCh9CT1RUTEVORUNLX1NFVkVSSVRZX1VOU1BFQ0lGSUVEEAASGwoXQk9UVExFTkVDS19TRVZFUklUWV9MT1cQARIeChpCT1RUTEVORUNLX1NFVkVSSVRZX01FRElVTRACEhwKGEJPVFRMRU5FQ0tfU0VWRVJJVFlfSElHSBADEiAKHEJPVFRMRU5FQ0tfU0VWRVJJVFlfQ1JJVElDQUwQBCrhAQoQTW9kaWZpY2F0aW9uVHlwZRIhCh1NT0RJRklDQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEiEKHU1PRElGSUNBVElPTl9UWVBFX1VQREFURV9FREdFEAESIQodTU9ESUZJQ0FUSU9OX1RZUEVfUkVNT1ZFX0VER0UQAhIeChpNT0RJRklDQVRJT05fVFlQRV9BRERfRURHRRADEiEKHU1PRElGSUNBVElPTl9UWVBFX1VQREFURV9OT0RFEAQSIQodTU9ESUZJQ0FUSU9OX1RZUEVfUkVNT1ZFX05PREUQBSqZAQoSTW9kaWZpY2F0aW9uVGFyZ2V0EiMKH01PRElGSUNBVElPTl9UQVJHRVRfVU5TUEVDSUZJRUQQABIgChxNT0RJRklDQVRJT05fVEFSR0VUX0NBUEFDSVRZEAESHAoYTU9ESUZJQ0FUSU9OX1RBUkdFVF9DT1NUEAISHgoaTU9ESUZJQ0FUSU9OX1RBUkdFVF9MRU5HVEgQAyqjAQoLSW1wYWN0TGV2ZWwSHAoYSU1QQUNUX0xFVkVMX1VOU1BFQ0lGSUVEEAASFQoRSU1QQUNUX0xFVkVMX05PTkUQARIUChBJTVBBQ1RfTEVWRUxfTE9XEAISFwoTSU1QQUNUX0xFVkVMX01FRElVTRADEhUKEUlNUEFDVF9MRVZFTF9ISUdIEAQSGQoVSU1QQUNUX0xFVkVMX0NSSVRJQ0FMEAUqlAEKEERpc3RyaWJ1dGlvblR5cGUSIQodRElTVFJJQlVUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIcChhESVNUUklCVVRJT05fVFlQRV9OT1JNQUwQARIdChlESVNUUklCVVRJT05fVFlQRV9VTklGT1JNEAISIAocRElTVFJJQlVUSU9OX1RZUEVfVFJJQU5HVUxBUhADKsABCgxSZXBvcnRGb3JtYXQSHQoZUkVQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhoKFlJFUE9SVF9GT1JNQVRfTUFSS0RPV04QARIVChFSRVBPUlRfRk9STUFUX0NTVhACEhcKE1JFUE9SVF9GT1JNQVRfRVhDRUwQAxIVChFSRVBPUlRfRk9STUFUX1BERhAEEhYKElJFUE9SVF9GT1JNQVRfSFRNTBAFEhYKElJFUE9SVF9GT1JNQVRfSlNPThAGKsQBCgpSZXBvcnRUeXBlEhsKF1JFUE9SVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQUkVQT1JUX1RZUEVfRkxPVxABEhkKFVJFUE9SVF9UWVBFX0FOQUxZVElDUxACEhoKFlJFUE9SVF9UWVBFX1NJTVVMQVRJT04QAxIXChNSRVBPUlRfVFlQRV9TVU1NQVJZEAQSFwoTUkVQT1JUX1RZUEVfSElTVE9SWRAFEhoKFlJFUE9SVF9UWVBFX0NPTVBBUklTT04QBjKLIgoOR2F0ZXdheVNlcnZpY2USRgoGSGVhbHRoEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiQubG9naXN0aWNzLmdhdGV3YXkudjEuSGVhbHRoUmVzcG9uc2USUQoOUmVhZGluZXNzQ2hlY2sSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZWFkaW5lc3NSZXNwb25zZRJCCgRJbmZvEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiIubG9naXN0aWNzLmdhdGV3YXkudjEuSW5mb1Jlc3BvbnNlElEKDUdldEFsZ29yaXRobXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BbGdvcml0aG1zUmVzcG9uc2USVQoIUmVnaXN0ZXISJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZWdpc3RlclJlcXVlc3QaIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BdXRoUmVzcG9uc2USTwoFTG9naW4SIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Mb2dpblJlcXVlc3QaIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BdXRoUmVzcG9uc2USXQoMUmVmcmVzaFRva2VuEikubG9naXN0aWNzLmdhdGV3YXkudjEuUmVmcmVzaFRva2VuUmVxdWVzdBoiLmxvZ2lzdGljcy5nYXRld2F5LnYxLkF1dGhSZXNwb25zZRI4CgZMb2dvdXQSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSRwoKR2V0UHJvZmlsZRIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRohLmxvZ2lzdGljcy5nYXRld2F5LnYxLlVzZXJQcm9maWxlEmgKDVZhbGlkYXRlVG9rZW4SKi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0ZVRva2VuUmVxdWVzdBorLmxvZ2lzdGljcy5nYXRld2F5LnYxLlZhbGlkYXRlVG9rZW5SZXNwb25zZRJ3ChJDYWxjdWxhdGVMb2dpc3RpY3MSLy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5DYWxjdWxhdGVMb2dpc3RpY3NSZXF1ZXN0GjAubG9naXN0aWNzLmdhdGV3YXkudjEuQ2FsY3VsYXRlTG9naXN0aWNzUmVzcG9uc2USXwoKU29sdmVHcmFwaBInLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNvbHZlR3JhcGhSZXF1ZXN0GigubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVHcmFwaFJlc3BvbnNlEmcKEFNvbHZlR3JhcGhTdHJlYW0SJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2x2ZUdyYXBoUmVxdWVzdBooLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNvbHZlUHJvZ3Jlc3NFdmVudDABEl8KCkJhdGNoU29sdmUSJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5CYXRjaFNvbHZlUmVxdWVzdBooLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJhdGNoU29sdmVSZXNwb25zZRJoCg1WYWxpZGF0ZUdyYXBoEioubG9naXN0aWNzLmdhdGV3YXkudjEuVmFsaWRhdGVHcmFwaFJlcXVlc3QaKy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0ZUdyYXBoUmVzcG9uc2USfQoUVmFsaWRhdGVGb3JBbGdvcml0aG0SMS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0ZUZvckFsZ29yaXRobVJlcXVlc3QaMi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0ZUZvckFsZ29yaXRobVJlc3BvbnNlEmUKDEFuYWx5emVHcmFwaBIpLmxvZ2lzdGljcy5nYXRld2F5LnYxLkFuYWx5emVHcmFwaFJlcXVlc3QaKi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BbmFseXplR3JhcGhSZXNwb25zZRJoCg1DYWxjdWxhdGVDb3N0EioubG9naXN0aWNzLmdhdGV3YXkudjEuQ2FsY3VsYXRlQ29zdFJlcXVlc3QaKy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5DYWxjdWxhdGVDb3N0UmVzcG9uc2USZQoOR2V0Qm90dGxlbmVja3MSKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Cb3R0bGVuZWNrc1JlcXVlc3QaKS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Cb3R0bGVuZWNrc1Jlc3BvbnNlEnEKEENvbXBhcmVTY2VuYXJpb3MSLS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db21wYXJlU2NlbmFyaW9zUmVxdWVzdBouLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNvbXBhcmVTY2VuYXJpb3NSZXNwb25zZRJWCglSdW5XaGF0SWYSIy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5XaGF0SWZSZXF1ZXN0GiQubG9naXN0aWNzLmdhdGV3YXkudjEuV2hhdElmUmVzcG9uc2USYgoNUnVuTW9udGVDYXJsbxInLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vbnRlQ2FybG9SZXF1ZXN0GigubG9naXN0aWNzLmdhdGV3YXkudjEuTW9udGVDYXJsb1Jlc3BvbnNlEm8KE1J1bk1vbnRlQ2FybG9TdHJlYW0SJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb250ZUNhcmxvUmVxdWVzdBotLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vbnRlQ2FybG9Qcm9ncmVzc0V2ZW50MAESaQoSQW5hbHl6ZVNlbnNpdGl2aXR5EigubG9naXN0aWNzLmdhdGV3YXkudjEuU2Vuc2l0aXZpdHlSZXF1ZXN0GikubG9naXN0aWNzLmdhdGV3YXkudjEuU2Vuc2l0aXZpdHlSZXNwb25zZRJmChFBbmFseXplUmVzaWxpZW5jZRInLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlc2lsaWVuY2VSZXF1ZXN0GigubG9naXN0aWNzLmdhdGV3YXkudjEuUmVzaWxpZW5jZVJlc3BvbnNlEnMKEFNpbXVsYXRlRmFpbHVyZXMSLi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5GYWlsdXJlU2ltdWxhdGlvblJlcXVlc3QaLy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5GYWlsdXJlU2ltdWxhdGlvblJlc3BvbnNlEnUKFEZpbmRDcml0aWNhbEVsZW1lbnRzEi0ubG9naXN0aWNzLmdhdGV3YXkudjEuQ3JpdGljYWxFbGVtZW50c1JlcXVlc3QaLi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Dcml0aWNhbEVsZW1lbnRzUmVzcG9uc2USYwoNR2V0U2ltdWxhdGlvbhIqLmxvZ2lzdGljcy5nYXRld2F5LnYxLkdldFNpbXVsYXRpb25SZXF1ZXN0GiYubG9naXN0aWNzLmdhdGV3YXkudjEuU2ltdWxhdGlvblJlY29yZBJuCg9MaXN0U2ltdWxhdGlvbnMSLC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5MaXN0U2ltdWxhdGlvbnNSZXF1ZXN0Gi0ubG9naXN0aWNzLmdhdGV3YXkudjEuTGlzdFNpbXVsYXRpb25zUmVzcG9uc2USWQoQRGVsZXRlU2ltdWxhdGlvbhItLmxvZ2lzdGljcy5nYXRld2F5LnYxLkRlbGV0ZVNpbXVsYXRpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Em4KD1NhdmVDYWxjdWxhdGlvbhIsLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNhdmVDYWxjdWxhdGlvblJlcXVlc3QaLS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TYXZlQ2FsY3VsYXRpb25SZXNwb25zZRJmCg5HZXRDYWxjdWxhdGlvbhIrLmxvZ2lzdGljcy5nYXRld2F5LnYxLkdldENhbGN1bGF0aW9uUmVxdWVzdBonLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNhbGN1bGF0aW9uUmVjb3JkEnEKEExpc3RDYWxjdWxhdGlvbnMSLS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5MaXN0Q2FsY3VsYXRpb25zUmVxdWVzdBouLmxvZ2lzdGljcy5nYXRld2F5LnYxLkxpc3RDYWxjdWxhdGlvbnNSZXNwb25zZRJbChFEZWxldGVDYWxjdWxhdGlvbhIuLmxvZ2lzdGljcy5nYXRld2F5LnYxLkRlbGV0ZUNhbGN1bGF0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJlCg1HZXRTdGF0aXN0aWNzEioubG9naXN0aWNzLmdhdGV3YXkudjEuR2V0U3RhdGlzdGljc1JlcXVlc3QaKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TdGF0aXN0aWNzUmVzcG9uc2USawoOR2VuZXJhdGVSZXBvcnQSKy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5HZW5lcmF0ZVJlcG9ydFJlcXVlc3QaLC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5HZW5lcmF0ZVJlcG9ydFJlc3BvbnNlElcKCUdldFJlcG9ydBImLmxvZ2lzdGljcy5nYXRld2F5LnYxLkdldFJlcG9ydFJlcXVlc3QaIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRSZWNvcmQSYgoORG93bmxvYWRSZXBvcnQSKy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Eb3dubG9hZFJlcG9ydFJlcXVlc3QaIS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRDaHVuazABEmIKC0xpc3RSZXBvcnRzEigubG9naXN0aWNzLmdhdGV3YXkudjEuTGlzdFJlcG9ydHNSZXF1ZXN0GikubG9naXN0aWNzLmdhdGV3YXkudjEuTGlzdFJlcG9ydHNSZXNwb25zZRJRCgxEZWxldGVSZXBvcnQSKS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5EZWxldGVSZXBvcnRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElcKEEdldFJlcG9ydEZvcm1hdHMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaKy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRGb3JtYXRzUmVzcG9uc2USYgoMR2V0QXVkaXRMb2dzEikubG9naXN0aWNzLmdhdGV3YXkudjEuR2V0QXVkaXRMb2dzUmVxdWVzdBonLmxvZ2lzdGljcy5nYXRld2F5LnYxLkF1ZGl0TG9nc1Jlc3BvbnNlEmsKD0dldFVzZXJBY3Rpdml0eRIsLmxvZ2lzdGljcy5nYXRld2F5LnYxLkdldFVzZXJBY3Rpdml0eVJlcXVlc3QaKi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Vc2VyQWN0aXZpdHlSZXNwb25zZRJlCg1HZXRBdWRpdFN0YXRzEioubG9naXN0aWNzLmdhdGV3YXkudjEuR2V0QXVkaXRTdGF0c1JlcXVlc3QaKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BdWRpdFN0YXRzUmVzcG9uc2VCywEKGGNvbS5sb2dpc3RpY3MuZ2F0ZXdheS52MUIMR2F0ZXdheVByb3RvUAFaL2xvZ2lzdGljcy9nZW4vZ28vbG9naXN0aWNzL2dhdGV3YXkvdjE7Z2F0ZXdheXYxogIDTEdYqgIUTG9naXN0aWNzLkdhdGV3YXkuVjHKAhRMb2dpc3RpY3NcR2F0ZXdheVxWMeICIExvZ2lzdGljc1xHYXRld2F5XFYxXEdQQk1ldGFkYXRh6gIWTG9naXN0aWNzOjpHYXRld2F5OjpWMWIGcHJvdG8z", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_logistics_common_v1_common]);
+  fileDesc("CiJsb2dpc3RpY3MvZ2F0ZXdheS92MS9nYXRld2F5LnByb3RvEhRsb2dpc3RpY3MuZ2F0ZXdheS52MSLrAQoOSGVhbHRoUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASRAoIc2VydmljZXMYAyADKAsyMi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5IZWFsdGhSZXNwb25zZS5TZXJ2aWNlc0VudHJ5GlQKDVNlcnZpY2VzRW50cnkSCwoDa2V5GAEgASgJEjIKBXZhbHVlGAIgASgLMiMubG9naXN0aWNzLmdhdGV3YXkudjEuU2VydmljZUhlYWx0aDoCOAEicgoNU2VydmljZUhlYWx0aBIMCgRuYW1lGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIPCgdhZGRyZXNzGAMgASgJEhIKCmxhdGVuY3lfbXMYBCABKAMSDQoFZXJyb3IYBSABKAkSDwoHdmVyc2lvbhgGIAEoCSKoAQoRUmVhZGluZXNzUmVzcG9uc2USDQoFcmVhZHkYASABKAgSTwoMZGVwZW5kZW5jaWVzGAIgAygLMjkubG9naXN0aWNzLmdhdGV3YXkudjEuUmVhZGluZXNzUmVzcG9uc2UuRGVwZW5kZW5jaWVzRW50cnkaMwoRRGVwZW5kZW5jaWVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgIOgI4ASLOAgoMSW5mb1Jlc3BvbnNlEgwKBG5hbWUYASABKAkSDwoHdmVyc2lvbhgCIAEoCRITCgtlbnZpcm9ubWVudBgDIAEoCRIuCgpzdGFydGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCg51cHRpbWVfc2Vjb25kcxgFIAEoAxIQCghmZWF0dXJlcxgGIAMoCRI3CgpyYXRlX2xpbWl0GAcgASgLMiMubG9naXN0aWNzLmdhdGV3YXkudjEuUmF0ZUxpbWl0SW5mbxJFCgpidWlsZF9pbmZvGAggAygLMjEubG9naXN0aWNzLmdhdGV3YXkudjEuSW5mb1Jlc3BvbnNlLkJ1aWxkSW5mb0VudHJ5GjAKDkJ1aWxkSW5mb0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiUQoNUmF0ZUxpbWl0SW5mbxIPCgdlbmFibGVkGAEgASgIEhsKE3JlcXVlc3RzX3Blcl9taW51dGUYAiABKAUSEgoKYnVyc3Rfc2l6ZRgDIAEoBSJNChJBbGdvcml0aG1zUmVzcG9uc2USNwoKYWxnb3JpdGhtcxgBIAMoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLkFsZ29yaXRobUluZm8i5gEKDUFsZ29yaXRobUluZm8SMQoJYWxnb3JpdGhtGAEgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIXCg90aW1lX2NvbXBsZXhpdHkYBCABKAkSGAoQc3BhY2VfY29tcGxleGl0eRgFIAEoCRIZChFzdXBwb3J0c19taW5fY29zdBgGIAEoCBIfChdzdXBwb3J0c19uZWdhdGl2ZV9jb3N0cxgHIAEoCBIQCghiZXN0X2ZvchgIIAMoCSJXCg9SZWdpc3RlclJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkSDQoFZW1haWwYAiABKAkSEAoIcGFzc3dvcmQYAyABKAkSEQoJZnVsbF9uYW1lGAQgASgJIjIKDExvZ2luUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSIsChNSZWZyZXNoVG9rZW5SZXF1ZXN0EhUKDXJlZnJlc2hfdG9rZW4YASABKAkiJQoUVmFsaWRhdGVUb2tlblJlcXVlc3QSDQoFdG9rZW4YASABKAkiSwoVVmFsaWRhdGVUb2tlblJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEg8KB3VzZXJfaWQYAiABKAkSEgoKZXhwaXJlc19hdBgDIAEoAyKoAQoMQXV0aFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSFAoMYWNjZXNzX3Rva2VuGAIgASgJEhUKDXJlZnJlc2hfdG9rZW4YAyABKAkSEgoKZXhwaXJlc19pbhgEIAEoAxIvCgR1c2VyGAUgASgLMiEubG9naXN0aWNzLmdhdGV3YXkudjEuVXNlclByb2ZpbGUSFQoNZXJyb3JfbWVzc2FnZRgGIAEoCSLAAQoLVXNlclByb2ZpbGUSDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRIRCglmdWxsX25hbWUYBCABKAkSDAoEcm9sZRgFIAEoCRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpsYXN0X2xvZ2luGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKNBgoZQ2FsY3VsYXRlTG9naXN0aWNzUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSMQoJYWxnb3JpdGhtGAIgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SFwoPc2tpcF92YWxpZGF0aW9uGAMgASgIEj8KEHZhbGlkYXRpb25fbGV2ZWwYBCABKA4yJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0aW9uTGV2ZWwSOQoNc29sdmVfb3B0aW9ucxgFIAEoCzIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNvbHZlT3B0aW9ucxIWCg5jYWxjdWxhdGVfY29zdBgGIAEoCBIYChBmaW5kX2JvdHRsZW5lY2tzGAcgASgIEhwKFGNhbGN1bGF0ZV9zdGF0aXN0aWNzGAggASgIEhwKFHN1Z2dlc3RfaW1wcm92ZW1lbnRzGAkgASgIEjcKDGNvc3Rfb3B0aW9ucxgKIAEoCzIhLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNvc3RPcHRpb25zEhwKFGJvdHRsZW5lY2tfdGhyZXNob2xkGAsgASgBEhcKD3NhdmVfdG9faGlzdG9yeRgMIAEoCBIYChBjYWxjdWxhdGlvbl9uYW1lGA0gASgJEkcKBHRhZ3MYDiADKAsyOS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5DYWxjdWxhdGVMb2dpc3RpY3NSZXF1ZXN0LlRhZ3NFbnRyeRIXCg9nZW5lcmF0ZV9yZXBvcnQYDyABKAgSOQoNcmVwb3J0X2Zvcm1hdBgQIAEoDjIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEZvcm1hdBI7Cg5yZXBvcnRfb3B0aW9ucxgRIAEoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydE9wdGlvbnMaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiowMKGkNhbGN1bGF0ZUxvZ2lzdGljc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSOgoKdmFsaWRhdGlvbhgCIAEoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLlZhbGlkYXRpb25SZXN1bHQSNwoMb3B0aW1pemF0aW9uGAMgASgLMiEubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVSZXN1bHQSOAoJYW5hbHl0aWNzGAQgASgLMiUubG9naXN0aWNzLmdhdGV3YXkudjEuQW5hbHl0aWNzUmVzdWx0EjAKBnJlcG9ydBgFIAEoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEluZm8SFgoOY2FsY3VsYXRpb25faWQYBiABKAkSNwoIbWV0YWRhdGEYByABKAsyJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXF1ZXN0TWV0YWRhdGESMAoGZXJyb3JzGAggAygLMiAubG9naXN0aWNzLmNvbW1vbi52MS5FcnJvckRldGFpbBIQCgh3YXJuaW5ncxgJIAMoCSKmAQoRU29sdmVHcmFwaFJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjEKCWFsZ29yaXRobRgCIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtEjMKB29wdGlvbnMYAyABKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2x2ZU9wdGlvbnMi1AEKElNvbHZlR3JhcGhSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEi8KBnJlc3VsdBgCIAEoCzIfLmxvZ2lzdGljcy5jb21tb24udjEuRmxvd1Jlc3VsdBIwCgxzb2x2ZWRfZ3JhcGgYAyABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjMKB21ldHJpY3MYBCABKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2x2ZU1ldHJpY3MSFQoNZXJyb3JfbWVzc2FnZRgFIAEoCSKfAgoSU29sdmVQcm9ncmVzc0V2ZW50EhEKCWl0ZXJhdGlvbhgBIAEoBRIUCgxjdXJyZW50X2Zsb3cYAiABKAESGAoQcHJvZ3Jlc3NfcGVyY2VudBgDIAEoARIOCgZzdGF0dXMYBCABKAkSLAoJbGFzdF9wYXRoGAUgASgLMhkubG9naXN0aWNzLmNvbW1vbi52MS5QYXRoEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYBiABKAESGQoRbWVtb3J5X3VzZWRfYnl0ZXMYByABKAMSEAoIaXNfZmluYWwYCCABKAgSPgoMZmluYWxfcmVzdWx0GAkgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVHcmFwaFJlc3BvbnNlIq0BChFCYXRjaFNvbHZlUmVxdWVzdBIzCgVpdGVtcxgBIAMoCzIkLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJhdGNoU29sdmVJdGVtEjkKEWRlZmF1bHRfYWxnb3JpdGhtGAIgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SEAoIcGFyYWxsZWwYAyABKAgSFgoObWF4X2NvbmN1cnJlbnQYBCABKAUiegoOQmF0Y2hTb2x2ZUl0ZW0SCgoCaWQYASABKAkSKQoFZ3JhcGgYAiABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtIogBChJCYXRjaFNvbHZlUmVzcG9uc2USNwoHcmVzdWx0cxgBIAMoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJhdGNoU29sdmVSZXN1bHQSFQoNdG90YWxfdGltZV9tcxgCIAEoARISCgpzdWNjZXNzZnVsGAMgASgFEg4KBmZhaWxlZBgEIAEoBSKJAQoQQmF0Y2hTb2x2ZVJlc3VsdBIKCgJpZBgBIAEoCRIPCgdzdWNjZXNzGAIgASgIEhAKCG1heF9mbG93GAMgASgBEhIKCnRvdGFsX2Nvc3QYBCABKAESGwoTY29tcHV0YXRpb25fdGltZV9tcxgFIAEoARIVCg1lcnJvcl9tZXNzYWdlGAYgASgJIrMBCgxTb2x2ZU9wdGlvbnMSFwoPdGltZW91dF9zZWNvbmRzGAEgASgBEhQKDHJldHVybl9wYXRocxgCIAEoCBIWCg5tYXhfaXRlcmF0aW9ucxgDIAEoBRIPCgdlcHNpbG9uGAQgASgBEi0KBG1vZGUYBSABKA4yHy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2x2ZU1vZGUSHAoUcmV0dXJuX2RlY29tcG9zaXRpb24YBiABKAgiegoMU29sdmVNZXRyaWNzEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYASABKAESEgoKaXRlcmF0aW9ucxgCIAEoBRIeChZhdWdtZW50aW5nX3BhdGhzX2ZvdW5kGAMgASgFEhkKEW1lbW9yeV91c2VkX2J5dGVzGAQgASgDIskBChRWYWxpZGF0ZUdyYXBoUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSNAoFbGV2ZWwYAiABKA4yJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0aW9uTGV2ZWwSGgoSY2hlY2tfY29ubmVjdGl2aXR5GAMgASgIEhwKFGNoZWNrX2J1c2luZXNzX3J1bGVzGAQgASgIEhYKDmNoZWNrX3RvcG9sb2d5GAUgASgIIuUBChVWYWxpZGF0ZUdyYXBoUmVzcG9uc2USEAoIaXNfdmFsaWQYASABKAgSNAoGZXJyb3JzGAIgAygLMiQubG9naXN0aWNzLmNvbW1vbi52MS5WYWxpZGF0aW9uRXJyb3ISEAoId2FybmluZ3MYAyADKAkSOAoKc3RhdGlzdGljcxgEIAEoCzIkLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGhTdGF0aXN0aWNzEjgKB21ldHJpY3MYBSABKAsyJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0aW9uTWV0cmljcyJ7ChtWYWxpZGF0ZUZvckFsZ29yaXRobVJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjEKCWFsZ29yaXRobRgCIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtIqUBChxWYWxpZGF0ZUZvckFsZ29yaXRobVJlc3BvbnNlEhUKDWlzX2NvbXBhdGlibGUYASABKAgSDgoGaXNzdWVzGAIgAygJEhcKD3JlY29tbWVuZGF0aW9ucxgDIAMoCRJFCgpjb21wbGV4aXR5GAQgASgLMjEubG9naXN0aWNzLmdhdGV3YXkudjEuQWxnb3JpdGhtQ29tcGxleGl0eUVzdGltYXRlIqEBChtBbGdvcml0aG1Db21wbGV4aXR5RXN0aW1hdGUSFwoPdGltZV9jb21wbGV4aXR5GAEgASgJEhgKEHNwYWNlX2NvbXBsZXhpdHkYAiABKAkSHAoUZXN0aW1hdGVkX2l0ZXJhdGlvbnMYAyABKAMSGQoRZXN0aW1hdGVkX3RpbWVfbXMYBCABKAESFgoOcmVjb21tZW5kYXRpb24YBSABKAki1QEKEFZhbGlkYXRpb25SZXN1bHQSEAoIaXNfdmFsaWQYASABKAgSFAoMZXJyb3JzX2NvdW50GAIgASgFEhYKDndhcm5pbmdzX2NvdW50GAMgASgFEjQKBmVycm9ycxgEIAMoCzIkLmxvZ2lzdGljcy5jb21tb24udjEuVmFsaWRhdGlvbkVycm9yEhAKCHdhcm5pbmdzGAUgAygJEjkKC2dyYXBoX3N0YXRzGAYgASgLMiQubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaFN0YXRpc3RpY3MibAoRVmFsaWRhdGlvbk1ldHJpY3MSFAoMdG90YWxfY2hlY2tzGAEgASgFEhUKDXBhc3NlZF9jaGVja3MYAiABKAUSFQoNZmFpbGVkX2NoZWNrcxgDIAEoBRITCgtkdXJhdGlvbl9tcxgEIAEoASJ4ChNBbmFseXplR3JhcGhSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI2CgdvcHRpb25zGAIgASgLMiUubG9naXN0aWNzLmdhdGV3YXkudjEuQW5hbHlzaXNPcHRpb25zIrcCChRBbmFseXplR3JhcGhSZXNwb25zZRI3CgpmbG93X3N0YXRzGAEgASgLMiMubG9naXN0aWNzLmNvbW1vbi52MS5GbG93U3RhdGlzdGljcxI5CgtncmFwaF9zdGF0cxgCIAEoCzIkLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGhTdGF0aXN0aWNzEjAKBGNvc3QYAyABKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0QW5hbHlzaXMSPQoLYm90dGxlbmVja3MYBCABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Cb3R0bGVuZWNrQW5hbHlzaXMSOgoKZWZmaWNpZW5jeRgFIAEoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLkVmZmljaWVuY3lSZXBvcnQi1QEKD0FuYWx5c2lzT3B0aW9ucxIVCg1hbmFseXplX2Nvc3RzGAEgASgIEhgKEGZpbmRfYm90dGxlbmVja3MYAiABKAgSHAoUY2FsY3VsYXRlX3N0YXRpc3RpY3MYAyABKAgSHAoUc3VnZ2VzdF9pbXByb3ZlbWVudHMYBCABKAgSHAoUYm90dGxlbmVja190aHJlc2hvbGQYBSABKAESNwoMY29zdF9vcHRpb25zGAYgASgLMiEubG9naXN0aWNzLmdhdGV3YXkudjEuQ29zdE9wdGlvbnMidQoUQ2FsY3VsYXRlQ29zdFJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjIKB29wdGlvbnMYAiABKAsyIS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0T3B0aW9ucyJ1ChVDYWxjdWxhdGVDb3N0UmVzcG9uc2USEgoKdG90YWxfY29zdBgBIAEoARIQCghjdXJyZW5jeRgCIAEoCRI2CglicmVha2Rvd24YAyABKAsyIy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0QnJlYWtkb3duIvgBCgtDb3N0T3B0aW9ucxIQCghjdXJyZW5jeRgBIAEoCRIbChNpbmNsdWRlX2ZpeGVkX2Nvc3RzGAIgASgIElAKEGNvc3RfbXVsdGlwbGllcnMYAyADKAsyNi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0T3B0aW9ucy5Db3N0TXVsdGlwbGllcnNFbnRyeRIYChBkaXNjb3VudF9wZXJjZW50GAQgASgBEhYKDm1hcmt1cF9wZXJjZW50GAUgASgBGjYKFENvc3RNdWx0aXBsaWVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEimAMKDUNvc3RCcmVha2Rvd24SFgoOdHJhbnNwb3J0X2Nvc3QYASABKAESEgoKZml4ZWRfY29zdBgCIAEoARIVCg1oYW5kbGluZ19jb3N0GAMgASgBEhcKD2Rpc2NvdW50X2Ftb3VudBgEIAEoARIVCg1tYXJrdXBfYW1vdW50GAUgASgBElIKEWNvc3RfYnlfcm9hZF90eXBlGAYgAygLMjcubG9naXN0aWNzLmdhdGV3YXkudjEuQ29zdEJyZWFrZG93bi5Db3N0QnlSb2FkVHlwZUVudHJ5ElIKEWNvc3RfYnlfbm9kZV90eXBlGAcgAygLMjcubG9naXN0aWNzLmdhdGV3YXkudjEuQ29zdEJyZWFrZG93bi5Db3N0QnlOb2RlVHlwZUVudHJ5GjUKE0Nvc3RCeVJvYWRUeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ARo1ChNDb3N0QnlOb2RlVHlwZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEibAoMQ29zdEFuYWx5c2lzEhIKCnRvdGFsX2Nvc3QYASABKAESEAoIY3VycmVuY3kYAiABKAkSNgoJYnJlYWtkb3duGAMgASgLMiMubG9naXN0aWNzLmdhdGV3YXkudjEuQ29zdEJyZWFrZG93biJtChJCb3R0bGVuZWNrc1JlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEh0KFXV0aWxpemF0aW9uX3RocmVzaG9sZBgCIAEoARINCgV0b3BfbhgDIAEoBSKLAQoTQm90dGxlbmVja3NSZXNwb25zZRI1Cgtib3R0bGVuZWNrcxgBIAMoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJvdHRsZW5lY2sSPQoPcmVjb21tZW5kYXRpb25zGAIgAygLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuUmVjb21tZW5kYXRpb24i2wEKCkJvdHRsZW5lY2sSKgoEZWRnZRgBIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRITCgt1dGlsaXphdGlvbhgCIAEoARIUCgxpbXBhY3Rfc2NvcmUYAyABKAESOgoIc2V2ZXJpdHkYBCABKA4yKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Cb3R0bGVuZWNrU2V2ZXJpdHkSEgoKaW5fbWluX2N1dBgFIAEoCBIRCglmbG93X2dhaW4YBiABKAESEwoLY29zdF9zYXZpbmcYByABKAEinwEKDlJlY29tbWVuZGF0aW9uEgwKBHR5cGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSMwoNYWZmZWN0ZWRfZWRnZRgDIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIdChVlc3RpbWF0ZWRfaW1wcm92ZW1lbnQYBCABKAESFgoOZXN0aW1hdGVkX2Nvc3QYBSABKAEipQEKEkJvdHRsZW5lY2tBbmFseXNpcxI1Cgtib3R0bGVuZWNrcxgBIAMoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLkJvdHRsZW5lY2sSPQoPcmVjb21tZW5kYXRpb25zGAIgAygLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuUmVjb21tZW5kYXRpb24SGQoRdG90YWxfYm90dGxlbmVja3MYAyABKAUilgEKEEVmZmljaWVuY3lSZXBvcnQSGgoSb3ZlcmFsbF9lZmZpY2llbmN5GAEgASgBEhwKFGNhcGFjaXR5X3V0aWxpemF0aW9uGAIgASgBEhoKEnVudXNlZF9lZGdlc19jb3VudBgDIAEoBRIdChVzYXR1cmF0ZWRfZWRnZXNfY291bnQYBCABKAUSDQoFZ3JhZGUYBSABKAkifwoXQ29tcGFyZVNjZW5hcmlvc1JlcXVlc3QSLAoIYmFzZWxpbmUYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjYKCXNjZW5hcmlvcxgCIAMoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNjZW5hcmlvSW5wdXQiSAoNU2NlbmFyaW9JbnB1dBIMCgRuYW1lGAEgASgJEikKBWdyYXBoGAIgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaCK6AQoYQ29tcGFyZVNjZW5hcmlvc1Jlc3BvbnNlEjYKCGJhc2VsaW5lGAEgASgLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuU2NlbmFyaW9SZXN1bHQSNwoJc2NlbmFyaW9zGAIgAygLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuU2NlbmFyaW9SZXN1bHQSFQoNYmVzdF9zY2VuYXJpbxgDIAEoCRIWCg5yZWNvbW1lbmRhdGlvbhgEIAEoCSJ5Cg5TY2VuYXJpb1Jlc3VsdBIMCgRuYW1lGAEgASgJEhAKCG1heF9mbG93GAIgASgBEhIKCnRvdGFsX2Nvc3QYAyABKAESEgoKZWZmaWNpZW5jeRgEIAEoARIfChdpbXByb3ZlbWVudF92c19iYXNlbGluZRgFIAEoASL6AgoPQW5hbHl0aWNzUmVzdWx0EhIKCnRvdGFsX2Nvc3QYASABKAESEAoIY3VycmVuY3kYAiABKAkSOwoOY29zdF9icmVha2Rvd24YAyABKAsyIy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db3N0QnJlYWtkb3duEjUKC2JvdHRsZW5lY2tzGAQgAygLMiAubG9naXN0aWNzLmdhdGV3YXkudjEuQm90dGxlbmVjaxIZChFib3R0bGVuZWNrc19jb3VudBgFIAEoBRI9Cg9yZWNvbW1lbmRhdGlvbnMYBiADKAsyJC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZWNvbW1lbmRhdGlvbhI6CgplZmZpY2llbmN5GAcgASgLMiYubG9naXN0aWNzLmdhdGV3YXkudjEuRWZmaWNpZW5jeVJlcG9ydBI3CgpmbG93X3N0YXRzGAggASgLMiMubG9naXN0aWNzLmNvbW1vbi52MS5GbG93U3RhdGlzdGljcyLFAgoLU29sdmVSZXN1bHQSMAoMc29sdmVkX2dyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIQCghtYXhfZmxvdxgCIAEoARISCgp0b3RhbF9jb3N0GAMgASgBEi8KBnN0YXR1cxgEIAEoDjIfLmxvZ2lzdGljcy5jb21tb24udjEuRmxvd1N0YXR1cxISCgppdGVyYXRpb25zGAUgASgFEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYBiABKAESEwoLcGF0aHNfZm91bmQYByABKAUSKAoFcGF0aHMYCCADKAsyGS5sb2dpc3RpY3MuY29tbW9uLnYxLlBhdGgSPQoNZGVjb21wb3NpdGlvbhgJIAEoCzImLmxvZ2lzdGljcy5jb21tb24udjEuRmxvd0RlY29tcG9zaXRpb24i5wEKDVdoYXRJZlJlcXVlc3QSMgoOYmFzZWxpbmVfZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjkKDW1vZGlmaWNhdGlvbnMYAiADKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb2RpZmljYXRpb24SMQoJYWxnb3JpdGhtGAMgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SNAoHb3B0aW9ucxgEIAEoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLldoYXRJZk9wdGlvbnMi+AEKDE1vZGlmaWNhdGlvbhI0CgR0eXBlGAEgASgOMiYubG9naXN0aWNzLmdhdGV3YXkudjEuTW9kaWZpY2F0aW9uVHlwZRIuCghlZGdlX2tleRgCIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIPCgdub2RlX2lkGAMgASgDEjgKBnRhcmdldBgEIAEoDjIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vZGlmaWNhdGlvblRhcmdldBINCgV2YWx1ZRgFIAEoARITCgtpc19yZWxhdGl2ZRgGIAEoCBITCgtkZXNjcmlwdGlvbhgHIAEoCSKKAQoNV2hhdElmT3B0aW9ucxIdChVjb21wYXJlX3dpdGhfYmFzZWxpbmUYASABKAgSHQoVY2FsY3VsYXRlX2Nvc3RfaW1wYWN0GAIgASgIEhwKFGZpbmRfbmV3X2JvdHRsZW5lY2tzGAMgASgIEh0KFXJldHVybl9tb2RpZmllZF9ncmFwaBgEIAEoCCLWAgoOV2hhdElmUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI2CghiYXNlbGluZRgCIAEoCzIkLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNjZW5hcmlvUmVzdWx0EjYKCG1vZGlmaWVkGAMgASgLMiQubG9naXN0aWNzLmdhdGV3YXkudjEuU2NlbmFyaW9SZXN1bHQSPAoKY29tcGFyaXNvbhgEIAEoCzIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNjZW5hcmlvQ29tcGFyaXNvbhIyCg5tb2RpZmllZF9ncmFwaBgFIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOgoIbWV0YWRhdGEYBiABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TaW11bGF0aW9uTWV0YWRhdGESFQoNZXJyb3JfbWVzc2FnZRgHIAEoCSLJAQoSU2NlbmFyaW9Db21wYXJpc29uEhMKC2Zsb3dfY2hhbmdlGAEgASgBEhsKE2Zsb3dfY2hhbmdlX3BlcmNlbnQYAiABKAESEwoLY29zdF9jaGFuZ2UYAyABKAESGwoTY29zdF9jaGFuZ2VfcGVyY2VudBgEIAEoARIWCg5pbXBhY3Rfc3VtbWFyeRgFIAEoCRI3CgxpbXBhY3RfbGV2ZWwYBiABKA4yIS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5JbXBhY3RMZXZlbCKqAgoRTW9udGVDYXJsb1JlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjYKBmNvbmZpZxgCIAEoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vbnRlQ2FybG9Db25maWcSPAoNdW5jZXJ0YWludGllcxgDIAMoCzIlLmxvZ2lzdGljcy5nYXRld2F5LnYxLlVuY2VydGFpbnR5U3BlYxIxCglhbGdvcml0aG0YBCABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRJBCgtjb3JyZWxhdGlvbhgFIAEoCzIsLmxvZ2lzdGljcy5nYXRld2F5LnYxLlVuY2VydGFpbnR5Q29ycmVsYXRpb24imwEKFlVuY2VydGFpbnR5Q29ycmVsYXRpb24SOQoHbWVhc3VyZRgBIAEoDjIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNvcnJlbGF0aW9uTWVhc3VyZRIOCgZtYXRyaXgYAiADKAESNgoGZ3JvdXBzGAMgAygLMiYubG9naXN0aWNzLmdhdGV3YXkudjEuQ29ycmVsYXRpb25Hcm91cCJEChBDb3JyZWxhdGlvbkdyb3VwEhsKE3VuY2VydGFpbnR5X2luZGljZXMYASADKAUSEwoLY29ycmVsYXRpb24YAiABKAEioQIKEE1vbnRlQ2FybG9Db25maWcSFgoObnVtX2l0ZXJhdGlvbnMYASABKAUSEwoLcmFuZG9tX3NlZWQYAiABKAMSGAoQY29uZmlkZW5jZV9sZXZlbBgDIAEoARIQCghwYXJhbGxlbBgEIAEoCBIWCg5yZXR1cm5fc2FtcGxlcxgFIAEoCBIZChFyZXBsYXlfaXRlcmF0aW9ucxgGIAMoBRI9Cg9zYW1wbGluZ19tZXRob2QYByABKA4yJC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TYW1wbGluZ01ldGhvZBIlCh10YXJnZXRfcmVsYXRpdmVfY2lfaGFsZl93aWR0aBgIIAEoARIbChN0aW1lX2J1ZGdldF9zZWNvbmRzGAkgASgBIsIBCg9VbmNlcnRhaW50eVNwZWMSKgoEZWRnZRgBIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIPCgdub2RlX2lkGAIgASgDEjgKBnRhcmdldBgDIAEoDjIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vZGlmaWNhdGlvblRhcmdldBI4CgxkaXN0cmlidXRpb24YBCABKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5EaXN0cmlidXRpb24idAoMRGlzdHJpYnV0aW9uEjQKBHR5cGUYASABKA4yJi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5EaXN0cmlidXRpb25UeXBlEg4KBnBhcmFtMRgCIAEoARIOCgZwYXJhbTIYAyABKAESDgoGcGFyYW0zGAQgASgBItYDChJNb250ZUNhcmxvUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI5CgpmbG93X3N0YXRzGAIgASgLMiUubG9naXN0aWNzLmdhdGV3YXkudjEuTW9udGVDYXJsb1N0YXRzEjkKCmNvc3Rfc3RhdHMYAyABKAsyJS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb250ZUNhcmxvU3RhdHMSOQoNcmlza19hbmFseXNpcxgEIAEoCzIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJpc2tBbmFseXNpcxI6CghtZXRhZGF0YRgFIAEoCzIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNpbXVsYXRpb25NZXRhZGF0YRIVCg1lcnJvcl9tZXNzYWdlGAYgASgJEhMKC3JhbmRvbV9zZWVkGAcgASgDEjcKB3NhbXBsZXMYCCADKAsyJi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb250ZUNhcmxvU2FtcGxlEj8KC3N0b3BfcmVhc29uGAkgASgOMioubG9naXN0aWNzLmdhdGV3YXkudjEuTW9udGVDYXJsb1N0b3BSZWFzb24SHAoUY29tcGxldGVkX2l0ZXJhdGlvbnMYCiABKAUiZQoQTW9udGVDYXJsb1NhbXBsZRIRCglpdGVyYXRpb24YASABKAUSEwoLbXVsdGlwbGllcnMYAiADKAESDAoEZmxvdxgDIAEoARIMCgRjb3N0GAQgASgBEg0KBWVycm9yGAUgASgJItwBCg9Nb250ZUNhcmxvU3RhdHMSDAoEbWVhbhgBIAEoARIPCgdzdGRfZGV2GAIgASgBEgsKA21pbhgDIAEoARILCgNtYXgYBCABKAESDgoGbWVkaWFuGAUgASgBEh8KF2NvbmZpZGVuY2VfaW50ZXJ2YWxfbG93GAYgASgBEiAKGGNvbmZpZGVuY2VfaW50ZXJ2YWxfaGlnaBgHIAEoARIdChVlZmZlY3RpdmVfc2FtcGxlX3NpemUYCCABKAESHgoWcmVsYXRpdmVfY2lfaGFsZl93aWR0aBgJIAEoASJ7CgxSaXNrQW5hbHlzaXMSIwobcHJvYmFiaWxpdHlfYmVsb3dfdGhyZXNob2xkGAEgASgBEhUKDXZhbHVlX2F0X3Jpc2sYAiABKAESFwoPd29yc3RfY2FzZV9mbG93GAMgASgBEhYKDmJlc3RfY2FzZV9mbG93GAQgASgBItUCChdNb250ZUNhcmxvUHJvZ3Jlc3NFdmVudBIRCglpdGVyYXRpb24YASABKAUSGAoQdG90YWxfaXRlcmF0aW9ucxgCIAEoBRIYChBwcm9ncmVzc19wZXJjZW50GAMgASgBEhkKEWN1cnJlbnRfbWVhbl9mbG93GAQgASgBEhcKD2N1cnJlbnRfc3RkX2RldhgFIAEoARIOCgZzdGF0dXMYBiABKAkSEAoIaXNfZmluYWwYByABKAgSPgoMZmluYWxfcmVzdWx0GAggASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuTW9udGVDYXJsb1Jlc3BvbnNlEhUKDWNpX2hhbGZfd2lkdGgYCSABKAESHgoWcmVsYXRpdmVfY2lfaGFsZl93aWR0aBgKIAEoARImCh5lc3RpbWF0ZWRfaXRlcmF0aW9uc19yZW1haW5pbmcYCyABKAUi6wEKElNlbnNpdGl2aXR5UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPgoKcGFyYW1ldGVycxgCIAMoCzIqLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNlbnNpdGl2aXR5UGFyYW1ldGVyEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtEjcKBmNvbmZpZxgEIAEoCzInLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNlbnNpdGl2aXR5Q29uZmlnItIBChFTZW5zaXRpdml0eUNvbmZpZxI3CgZtZXRob2QYASABKA4yJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TZW5zaXRpdml0eU1ldGhvZBIXCg9maW5kX3RocmVzaG9sZHMYAiABKAgSGAoQbnVtX3RyYWplY3RvcmllcxgDIAEoBRISCgpudW1fbGV2ZWxzGAQgASgFEhMKC251bV9zYW1wbGVzGAUgASgFEhMKC3JhbmRvbV9zZWVkGAYgASgDEhMKC21heF93b3JrZXJzGAcgASgFItABChRTZW5zaXRpdml0eVBhcmFtZXRlchIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5Eg8KB25vZGVfaWQYAiABKAMSOAoGdGFyZ2V0GAMgASgOMigubG9naXN0aWNzLmdhdGV3YXkudjEuTW9kaWZpY2F0aW9uVGFyZ2V0EhYKDm1pbl9tdWx0aXBsaWVyGAQgASgBEhYKDm1heF9tdWx0aXBsaWVyGAUgASgBEhEKCW51bV9zdGVwcxgGIAEoBSLtAQoTU2Vuc2l0aXZpdHlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjgKB3Jlc3VsdHMYAiADKAsyJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TZW5zaXRpdml0eVJlc3VsdBI4CghyYW5raW5ncxgDIAMoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLlBhcmFtZXRlclJhbmtpbmcSOgoIbWV0YWRhdGEYBCABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TaW11bGF0aW9uTWV0YWRhdGESFQoNZXJyb3JfbWVzc2FnZRgFIAEoCSL3AQoRU2Vuc2l0aXZpdHlSZXN1bHQSFAoMcGFyYW1ldGVyX2lkGAEgASgJEjUKBWN1cnZlGAIgAygLMiYubG9naXN0aWNzLmdhdGV3YXkudjEuU2Vuc2l0aXZpdHlQb2ludBISCgplbGFzdGljaXR5GAMgASgBEhkKEXNlbnNpdGl2aXR5X2luZGV4GAQgASgBEjMKBm1vcnJpcxgFIAEoCzIjLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vcnJpc0luZGljZXMSMQoFc29ib2wYBiABKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2JvbEluZGljZXMiOwoNTW9ycmlzSW5kaWNlcxIKCgJtdRgBIAEoARIPCgdtdV9zdGFyGAIgASgBEg0KBXNpZ21hGAMgASgBImwKDFNvYm9sSW5kaWNlcxITCgtmaXJzdF9vcmRlchgBIAEoARITCgt0b3RhbF9vcmRlchgCIAEoARIYChBmaXJzdF9vcmRlcl9jb25mGAMgASgBEhgKEHRvdGFsX29yZGVyX2NvbmYYBCABKAEiUwoQU2Vuc2l0aXZpdHlQb2ludBIXCg9wYXJhbWV0ZXJfdmFsdWUYASABKAESEgoKZmxvd192YWx1ZRgCIAEoARISCgpjb3N0X3ZhbHVlGAMgASgBImYKEFBhcmFtZXRlclJhbmtpbmcSFAoMcGFyYW1ldGVyX2lkGAEgASgJEgwKBHJhbmsYAiABKAUSGQoRc2Vuc2l0aXZpdHlfaW5kZXgYAyABKAESEwoLZGVzY3JpcHRpb24YBCABKAkiqQEKEVJlc2lsaWVuY2VSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI2CgZjb25maWcYAiABKAsyJi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXNpbGllbmNlQ29uZmlnEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtIoMBChBSZXNpbGllbmNlQ29uZmlnEhwKFG1heF9mYWlsdXJlc190b190ZXN0GAEgASgFEh8KF3Rlc3RfY2FzY2FkaW5nX2ZhaWx1cmVzGAIgASgIEhMKC2xvYWRfZmFjdG9yGAMgASgBEhsKE21heF9jYW5kaWRhdGVfZWRnZXMYBCABKAUi5gIKElJlc2lsaWVuY2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjgKB21ldHJpY3MYAiABKAsyJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXNpbGllbmNlTWV0cmljcxI8Cgp3ZWFrbmVzc2VzGAMgAygLMigubG9naXN0aWNzLmdhdGV3YXkudjEuUmVzaWxpZW5jZVdlYWtuZXNzEjoKCG1ldGFkYXRhGAQgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU2ltdWxhdGlvbk1ldGFkYXRhEhUKDWVycm9yX21lc3NhZ2UYBSABKAkSOwoTY3JpdGljYWxfZWRnZV9wYWlycxgGIAMoCzIeLmxvZ2lzdGljcy5nYXRld2F5LnYxLkVkZ2VQYWlyEjcKCGNhc2NhZGVzGAcgAygLMiUubG9naXN0aWNzLmdhdGV3YXkudjEuQ2FzY2FkZVNjZW5hcmlvIn0KCEVkZ2VQYWlyEisKBWVkZ2UxGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EisKBWVkZ2UyGAIgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EhcKD2NvbWJpbmVkX2ltcGFjdBgDIAEoASK8AQoPQ2FzY2FkZVNjZW5hcmlvEjUKD2luaXRpYWxfZmFpbHVyZRgBIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIwCgVzdGVwcxgCIAMoCzIhLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNhc2NhZGVTdGVwEhIKCmZpbmFsX2Zsb3cYAyABKAESFgoOZmxvd19yZWR1Y3Rpb24YBCABKAESFAoMZmFpbGVkX2VkZ2VzGAUgASgFImQKC0Nhc2NhZGVTdGVwEg0KBXJvdW5kGAEgASgFEjIKDGZhaWxlZF9lZGdlcxgCIAMoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRISCgpmbG93X2FmdGVyGAMgASgBIpQBChFSZXNpbGllbmNlTWV0cmljcxIVCg1vdmVyYWxsX3Njb3JlGAEgASgBEh8KF2Nvbm5lY3Rpdml0eV9yb2J1c3RuZXNzGAIgASgBEhcKD2Zsb3dfcm9idXN0bmVzcxgDIAEoARIYChByZWR1bmRhbmN5X2xldmVsGAQgASgBEhQKDG1pbl9jdXRfc2l6ZRgFIAEoBSKeAQoSUmVzaWxpZW5jZVdlYWtuZXNzEhMKC2Rlc2NyaXB0aW9uGAEgASgJEgwKBHR5cGUYAiABKAkSEAoIc2V2ZXJpdHkYAyABKAESNAoOYWZmZWN0ZWRfZWRnZXMYBCADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSHQoVbWl0aWdhdGlvbl9zdWdnZXN0aW9uGAUgASgJIrIBChhGYWlsdXJlU2ltdWxhdGlvblJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjgKCXNjZW5hcmlvcxgCIAMoCzIlLmxvZ2lzdGljcy5nYXRld2F5LnYxLkZhaWx1cmVTY2VuYXJpbxIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSJ+Cg9GYWlsdXJlU2NlbmFyaW8SDAoEbmFtZRgBIAEoCRIyCgxmYWlsZWRfZWRnZXMYAiADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSFAoMZmFpbGVkX25vZGVzGAMgAygDEhMKC3Byb2JhYmlsaXR5GAQgASgBIrECChlGYWlsdXJlU2ltdWxhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSNgoIYmFzZWxpbmUYAiABKAsyJC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TY2VuYXJpb1Jlc3VsdBJFChBzY2VuYXJpb19yZXN1bHRzGAMgAygLMisubG9naXN0aWNzLmdhdGV3YXkudjEuRmFpbHVyZVNjZW5hcmlvUmVzdWx0EjEKBXN0YXRzGAQgASgLMiIubG9naXN0aWNzLmdhdGV3YXkudjEuRmFpbHVyZVN0YXRzEjoKCG1ldGFkYXRhGAUgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU2ltdWxhdGlvbk1ldGFkYXRhEhUKDWVycm9yX21lc3NhZ2UYBiABKAki1gEKFUZhaWx1cmVTY2VuYXJpb1Jlc3VsdBIVCg1zY2VuYXJpb19uYW1lGAEgASgJEhMKC3Byb2JhYmlsaXR5GAIgASgBEjQKBnJlc3VsdBgDIAEoCzIkLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNjZW5hcmlvUmVzdWx0Ej0KC3ZzX2Jhc2VsaW5lGAQgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU2NlbmFyaW9Db21wYXJpc29uEhwKFG5ldHdvcmtfZGlzY29ubmVjdGVkGAUgASgIImcKDEZhaWx1cmVTdGF0cxIaChJleHBlY3RlZF9mbG93X2xvc3MYASABKAESFQoNbWF4X2Zsb3dfbG9zcxgCIAEoARIkChxwcm9iYWJpbGl0eV9vZl9kaXNjb25uZWN0aW9uGAMgASgBIrUBChdDcml0aWNhbEVsZW1lbnRzUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPAoGY29uZmlnGAIgASgLMiwubG9naXN0aWNzLmdhdGV3YXkudjEuQ3JpdGljYWxFbGVtZW50c0NvbmZpZxIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSJwChZDcml0aWNhbEVsZW1lbnRzQ29uZmlnEhUKDWFuYWx5emVfZWRnZXMYASABKAgSFQoNYW5hbHl6ZV9ub2RlcxgCIAEoCBINCgV0b3BfbhgDIAEoBRIZChFmYWlsdXJlX3RocmVzaG9sZBgEIAEoASLQAgoYQ3JpdGljYWxFbGVtZW50c1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSOgoOY3JpdGljYWxfZWRnZXMYAiADKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Dcml0aWNhbEVkZ2USOgoOY3JpdGljYWxfbm9kZXMYAyADKAsyIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Dcml0aWNhbE5vZGUSPgoYc2luZ2xlX3BvaW50c19vZl9mYWlsdXJlGAQgAygLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EhgKEHJlc2lsaWVuY2Vfc2NvcmUYBSABKAESOgoIbWV0YWRhdGEYBiABKAsyKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TaW11bGF0aW9uTWV0YWRhdGESFQoNZXJyb3JfbWVzc2FnZRgHIAEoCSKnAQoMQ3JpdGljYWxFZGdlEioKBGVkZ2UYASABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSGQoRY3JpdGljYWxpdHlfc2NvcmUYAiABKAESHgoWZmxvd19pbXBhY3RfaWZfcmVtb3ZlZBgDIAEoARIMCgRyYW5rGAQgASgFEiIKGmlzX3NpbmdsZV9wb2ludF9vZl9mYWlsdXJlGAUgASgIIoABCgxDcml0aWNhbE5vZGUSDwoHbm9kZV9pZBgBIAEoAxIZChFjcml0aWNhbGl0eV9zY29yZRgCIAEoARIeChZmbG93X2ltcGFjdF9pZl9yZW1vdmVkGAMgASgBEhYKDmFmZmVjdGVkX2VkZ2VzGAQgASgFEgwKBHJhbmsYBSABKAUiwQEKElNpbXVsYXRpb25NZXRhZGF0YRIVCg1zaW11bGF0aW9uX2lkGAEgASgJEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYAiABKAESEgoKaXRlcmF0aW9ucxgDIAEoBRIZChFtZW1vcnlfdXNlZF9ieXRlcxgEIAEoAxIWCg5hbGdvcml0aG1fdXNlZBgFIAEoCRIwCgxjb21wbGV0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIi0KFEdldFNpbXVsYXRpb25SZXF1ZXN0EhUKDXNpbXVsYXRpb25faWQYASABKAkiUAoWTGlzdFNpbXVsYXRpb25zUmVxdWVzdBINCgVsaW1pdBgBIAEoBRIOCgZvZmZzZXQYAiABKAUSFwoPc2ltdWxhdGlvbl90eXBlGAMgASgJIn0KF0xpc3RTaW11bGF0aW9uc1Jlc3BvbnNlEjsKC3NpbXVsYXRpb25zGAEgAygLMiYubG9naXN0aWNzLmdhdGV3YXkudjEuU2ltdWxhdGlvblJlY29yZBITCgt0b3RhbF9jb3VudBgCIAEoAxIQCghoYXNfbW9yZRgDIAEoCCLsAQoQU2ltdWxhdGlvblJlY29yZBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHR5cGUYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPgoEdGFncxgFIAMoCzIwLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNpbXVsYXRpb25SZWNvcmQuVGFnc0VudHJ5EhMKC3Jlc3VsdF9kYXRhGAYgASgMGisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjAKF0RlbGV0ZVNpbXVsYXRpb25SZXF1ZXN0EhUKDXNpbXVsYXRpb25faWQYASABKAki/gEKFlNhdmVDYWxjdWxhdGlvblJlcXVlc3QSDAoEbmFtZRgBIAEoCRIpCgVncmFwaBgCIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOAoGcmVzdWx0GAMgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVHcmFwaFJlc3BvbnNlEkQKBHRhZ3MYBCADKAsyNi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TYXZlQ2FsY3VsYXRpb25SZXF1ZXN0LlRhZ3NFbnRyeRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJhChdTYXZlQ2FsY3VsYXRpb25SZXNwb25zZRIWCg5jYWxjdWxhdGlvbl9pZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIvChVHZXRDYWxjdWxhdGlvblJlcXVlc3QSFgoOY2FsY3VsYXRpb25faWQYASABKAkihAIKF0xpc3RDYWxjdWxhdGlvbnNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBRIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRIMCgR0YWdzGAQgAygJEjEKDWNyZWF0ZWRfYWZ0ZXIYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjIKDmNyZWF0ZWRfYmVmb3JlGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdzb3J0X2J5GAcgASgJEhEKCXNvcnRfZGVzYxgIIAEoCCKBAQoYTGlzdENhbGN1bGF0aW9uc1Jlc3BvbnNlEj4KDGNhbGN1bGF0aW9ucxgBIAMoCzIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNhbGN1bGF0aW9uU3VtbWFyeRITCgt0b3RhbF9jb3VudBgCIAEoAxIQCghoYXNfbW9yZRgDIAEoCCK8AgoRQ2FsY3VsYXRpb25SZWNvcmQSFgoOY2FsY3VsYXRpb25faWQYASABKAkSDAoEbmFtZRgCIAEoCRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIpCgVncmFwaBgEIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOAoGcmVzdWx0GAUgASgLMigubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVHcmFwaFJlc3BvbnNlEj8KBHRhZ3MYBiADKAsyMS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5DYWxjdWxhdGlvblJlY29yZC5UYWdzRW50cnkaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEilgIKEkNhbGN1bGF0aW9uU3VtbWFyeRIWCg5jYWxjdWxhdGlvbl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEi4KCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCG1heF9mbG93GAQgASgBEhIKCnRvdGFsX2Nvc3QYBSABKAESMQoJYWxnb3JpdGhtGAYgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SGwoTY29tcHV0YXRpb25fdGltZV9tcxgHIAEoARISCgpub2RlX2NvdW50GAggASgFEhIKCmVkZ2VfY291bnQYCSABKAUSDAoEdGFncxgKIAMoCSIyChhEZWxldGVDYWxjdWxhdGlvblJlcXVlc3QSFgoOY2FsY3VsYXRpb25faWQYASABKAkidAoUR2V0U3RhdGlzdGljc1JlcXVlc3QSLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIuYCChJTdGF0aXN0aWNzUmVzcG9uc2USGgoSdG90YWxfY2FsY3VsYXRpb25zGAEgASgFEhgKEGF2ZXJhZ2VfbWF4X2Zsb3cYAiABKAESFAoMYXZlcmFnZV9jb3N0GAMgASgBEiMKG2F2ZXJhZ2VfY29tcHV0YXRpb25fdGltZV9tcxgEIAEoARJoChljYWxjdWxhdGlvbnNfYnlfYWxnb3JpdGhtGAUgAygLMkUubG9naXN0aWNzLmdhdGV3YXkudjEuU3RhdGlzdGljc1Jlc3BvbnNlLkNhbGN1bGF0aW9uc0J5QWxnb3JpdGhtRW50cnkSNQoLZGFpbHlfc3RhdHMYBiADKAsyIC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5EYWlseVN0YXRzGj4KHENhbGN1bGF0aW9uc0J5QWxnb3JpdGhtRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASI9CgpEYWlseVN0YXRzEgwKBGRhdGUYASABKAkSDQoFY291bnQYAiABKAUSEgoKdG90YWxfZmxvdxgDIAEoASLTAwoVR2VuZXJhdGVSZXBvcnRSZXF1ZXN0Ei4KBHR5cGUYASABKA4yIC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRUeXBlEjIKBmZvcm1hdBgCIAEoDjIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEZvcm1hdBI0CgdvcHRpb25zGAMgASgLMiMubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0T3B0aW9ucxI9CgtmbG93X3NvdXJjZRgKIAEoCzImLmxvZ2lzdGljcy5nYXRld2F5LnYxLkZsb3dSZXBvcnRTb3VyY2VIABJHChBhbmFseXRpY3Nfc291cmNlGAsgASgLMisubG9naXN0aWNzLmdhdGV3YXkudjEuQW5hbHl0aWNzUmVwb3J0U291cmNlSAASSQoRc2ltdWxhdGlvbl9zb3VyY2UYDCABKAsyLC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TaW11bGF0aW9uUmVwb3J0U291cmNlSAASQwoOaGlzdG9yeV9zb3VyY2UYDSABKAsyKS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5IaXN0b3J5UmVwb3J0U291cmNlSABCCAoGc291cmNlIv0CCg1SZXBvcnRPcHRpb25zEg0KBXRpdGxlGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg4KBmF1dGhvchgDIAEoCRIQCghsYW5ndWFnZRgEIAEoCRIQCgh0aW1lem9uZRgFIAEoCRIdChVpbmNsdWRlX2dyYXBoX2RldGFpbHMYBiABKAgSGQoRaW5jbHVkZV9lZGdlX2xpc3QYByABKAgSHAoUaW5jbHVkZV9wYXRoX2RldGFpbHMYCCABKAgSHwoXaW5jbHVkZV9yZWNvbW1lbmRhdGlvbnMYCSABKAgSFgoOaW5jbHVkZV9jaGFydHMYCiABKAgSFAoMY29tcGFueV9uYW1lGAsgASgJEhAKCGxvZ29fdXJsGAwgASgJEg0KBXRoZW1lGA0gASgJEhAKCGN1cnJlbmN5GA4gASgJEgwKBHRhZ3MYDyADKAkSEwoLdHRsX3NlY29uZHMYECABKAMSFwoPc2F2ZV90b19zdG9yYWdlGBEgASgIIqMBChBGbG93UmVwb3J0U291cmNlEikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIvCgZyZXN1bHQYAiABKAsyHy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dSZXN1bHQSMwoHbWV0cmljcxgDIAEoCzIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNvbHZlTWV0cmljcyKBAQoVQW5hbHl0aWNzUmVwb3J0U291cmNlEikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI9CglhbmFseXRpY3MYAiABKAsyKi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BbmFseXplR3JhcGhSZXNwb25zZSKAAQoWU2ltdWxhdGlvblJlcG9ydFNvdXJjZRIyCg5iYXNlbGluZV9ncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSFwoPc2ltdWxhdGlvbl90eXBlGAIgASgJEhkKEXNpbXVsYXRpb25fcmVzdWx0GAMgASgMIowBChNIaXN0b3J5UmVwb3J0U291cmNlEi4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCg9jYWxjdWxhdGlvbl9pZHMYAyADKAkigwEKFkdlbmVyYXRlUmVwb3J0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIwCgZyZXBvcnQYAiABKAsyIC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRJbmZvEg8KB2NvbnRlbnQYAyABKAwSFQoNZXJyb3JfbWVzc2FnZRgEIAEoCSK2AgoKUmVwb3J0SW5mbxIRCglyZXBvcnRfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSLgoEdHlwZRgDIAEoDjIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydFR5cGUSMgoGZm9ybWF0GAQgASgOMiIubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0Rm9ybWF0EjAKDGdlbmVyYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKc2l6ZV9ieXRlcxgGIAEoAxIaChJnZW5lcmF0aW9uX3RpbWVfbXMYByABKAESEAoIZmlsZW5hbWUYCCABKAkSLgoKZXhwaXJlc19hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiJQoQR2V0UmVwb3J0UmVxdWVzdBIRCglyZXBvcnRfaWQYASABKAkiKgoVRG93bmxvYWRSZXBvcnRSZXF1ZXN0EhEKCXJlcG9ydF9pZBgBIAEoCSJXCgtSZXBvcnRDaHVuaxITCgtjaHVua19pbmRleBgBIAEoBRIUCgx0b3RhbF9jaHVua3MYAiABKAUSDAoEZGF0YRgDIAEoDBIPCgdpc19sYXN0GAQgASgIImIKDFJlcG9ydFJlY29yZBIRCglyZXBvcnRfaWQYASABKAkSLgoEaW5mbxgCIAEoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEluZm8SDwoHY29udGVudBgDIAEoDCL+AQoSTGlzdFJlcG9ydHNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBRIuCgR0eXBlGAMgASgOMiAubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0VHlwZRIyCgZmb3JtYXQYBCABKA4yIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRGb3JtYXQSMQoNY3JlYXRlZF9hZnRlchgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoOY3JlYXRlZF9iZWZvcmUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIm8KE0xpc3RSZXBvcnRzUmVzcG9uc2USMQoHcmVwb3J0cxgBIAMoCzIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEluZm8SEwoLdG90YWxfY291bnQYAiABKAMSEAoIaGFzX21vcmUYAyABKAgiKAoTRGVsZXRlUmVwb3J0UmVxdWVzdBIRCglyZXBvcnRfaWQYASABKAkiUAoVUmVwb3J0Rm9ybWF0c1Jlc3BvbnNlEjcKB2Zvcm1hdHMYASADKAsyJi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXBvcnRGb3JtYXRJbmZvIu8BChBSZXBvcnRGb3JtYXRJbmZvEjIKBmZvcm1hdBgBIAEoDjIiLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydEZvcm1hdBIMCgRuYW1lGAIgASgJEhEKCWV4dGVuc2lvbhgDIAEoCRIRCgltaW1lX3R5cGUYBCABKAkSFwoPc3VwcG9ydHNfY2hhcnRzGAUgASgIEhgKEHN1cHBvcnRzX3N0eWxpbmcYBiABKAgSQAoWc3VwcG9ydGVkX3JlcG9ydF90eXBlcxgHIAMoDjIgLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlcG9ydFR5cGUi3QEKE0dldEF1ZGl0TG9nc1JlcXVlc3QSLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCHNlcnZpY2VzGAMgAygJEg8KB2FjdGlvbnMYBCADKAkSDwoHdXNlcl9pZBgFIAEoCRIVCg1yZXNvdXJjZV90eXBlGAYgASgJEg0KBWxpbWl0GAcgASgFEg4KBm9mZnNldBgIIAEoBSJtChFBdWRpdExvZ3NSZXNwb25zZRIxCgdlbnRyaWVzGAEgAygLMiAubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRFbnRyeRITCgt0b3RhbF9jb3VudBgCIAEoAxIQCghoYXNfbW9yZRgDIAEoCCKKAwoKQXVkaXRFbnRyeRIKCgJpZBgBIAEoCRItCgl0aW1lc3RhbXAYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB3NlcnZpY2UYAyABKAkSDgoGbWV0aG9kGAQgASgJEg4KBmFjdGlvbhgFIAEoCRIPCgdvdXRjb21lGAYgASgJEg8KB3VzZXJfaWQYByABKAkSEAoIdXNlcm5hbWUYCCABKAkSEQoJY2xpZW50X2lwGAkgASgJEhUKDXJlc291cmNlX3R5cGUYCiABKAkSEwoLcmVzb3VyY2VfaWQYCyABKAkSEwoLZHVyYXRpb25fbXMYDCABKAMSFQoNZXJyb3JfbWVzc2FnZRgNIAEoCRJACghtZXRhZGF0YRgOIAMoCzIuLmxvZ2lzdGljcy5nYXRld2F5LnYxLkF1ZGl0RW50cnkuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEipgEKFkdldFVzZXJBY3Rpdml0eVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIuCgpzdGFydF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGltaXQYBCABKAUSDgoGb2Zmc2V0GAUgASgFIpoBChRVc2VyQWN0aXZpdHlSZXNwb25zZRIxCgdlbnRyaWVzGAEgAygLMiAubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRFbnRyeRI6CgdzdW1tYXJ5GAIgASgLMikubG9naXN0aWNzLmdhdGV3YXkudjEuVXNlckFjdGl2aXR5U3VtbWFyeRITCgt0b3RhbF9jb3VudBgDIAEoAyLqAwoTVXNlckFjdGl2aXR5U3VtbWFyeRIVCg10b3RhbF9hY3Rpb25zGAEgASgFEhoKEnN1Y2Nlc3NmdWxfYWN0aW9ucxgCIAEoBRIWCg5mYWlsZWRfYWN0aW9ucxgDIAEoBRJVCg9hY3Rpb25zX2J5X3R5cGUYBCADKAsyPC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Vc2VyQWN0aXZpdHlTdW1tYXJ5LkFjdGlvbnNCeVR5cGVFbnRyeRJbChJhY3Rpb25zX2J5X3NlcnZpY2UYBSADKAsyPy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Vc2VyQWN0aXZpdHlTdW1tYXJ5LkFjdGlvbnNCeVNlcnZpY2VFbnRyeRIyCg5maXJzdF9hY3Rpdml0eRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoNbGFzdF9hY3Rpdml0eRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaNAoSQWN0aW9uc0J5VHlwZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaNwoVQWN0aW9uc0J5U2VydmljZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEihgEKFEdldEF1ZGl0U3RhdHNSZXF1ZXN0Ei4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghncm91cF9ieRgDIAEoCSKmAwoSQXVkaXRTdGF0c1Jlc3BvbnNlEhQKDHRvdGFsX2V2ZW50cxgBIAEoAxIZChFzdWNjZXNzZnVsX2V2ZW50cxgCIAEoAxIVCg1mYWlsZWRfZXZlbnRzGAMgASgDEhQKDHVuaXF1ZV91c2VycxgEIAEoAxJLCgpieV9zZXJ2aWNlGAUgAygLMjcubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRTdGF0c1Jlc3BvbnNlLkJ5U2VydmljZUVudHJ5EkkKCWJ5X2FjdGlvbhgGIAMoCzI2LmxvZ2lzdGljcy5nYXRld2F5LnYxLkF1ZGl0U3RhdHNSZXNwb25zZS5CeUFjdGlvbkVudHJ5EjcKCHRpbWVsaW5lGAcgAygLMiUubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRTdGF0c1BvaW50GjAKDkJ5U2VydmljZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAzoCOAEaLwoNQnlBY3Rpb25FbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6AjgBIn0KD0F1ZGl0U3RhdHNQb2ludBItCgl0aW1lc3RhbXAYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWNvdW50GAIgASgDEhUKDXN1Y2Nlc3NfY291bnQYAyABKAMSFQoNZmFpbHVyZV9jb3VudBgEIAEoAyKXAgoPUmVxdWVzdE1ldGFkYXRhEhIKCnJlcXVlc3RfaWQYASABKAkSFQoNdG90YWxfdGltZV9tcxgCIAEoARIaChJ2YWxpZGF0aW9uX3RpbWVfbXMYAyABKAESFQoNc29sdmVfdGltZV9tcxgEIAEoARIZChFhbmFseXRpY3NfdGltZV9tcxgFIAEoARIWCg5yZXBvcnRfdGltZV9tcxgGIAEoARIXCg9zZXJ2aWNlX3ZlcnNpb24YByABKAkSFgoOYWxnb3JpdGhtX3VzZWQYCCABKAkSMAoMcHJvY2Vzc2VkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCgh0cmFjZV9pZBgKIAEoCSpfCglTb2x2ZU1vZGUSGgoWU09MVkVfTU9ERV9VTlNQRUNJRklFRBAAEhcKE1NPTFZFX01PREVfTUFYX0ZMT1cQARIdChlTT0xWRV9NT0RFX1RSQU5TUE9SVEFUSU9OEAIqpgEKD1ZhbGlkYXRpb25MZXZlbBIgChxWQUxJREFUSU9OX0xFVkVMX1VOU1BFQ0lGSUVEEAASGgoWVkFMSURBVElPTl9MRVZFTF9CQVNJQxABEh0KGVZBTElEQVRJT05fTEVWRUxfU1RBTkRBUkQQAhIbChdWQUxJREFUSU9OX0xFVkVMX1NUUklDVBADEhkKFVZBTElEQVRJT05fTEVWRUxfRlVMTBAEKrYBChJCb3R0bGVuZWNrU2V2ZXJpdHkSIwofQk9UVExFTkVDS19TRVZFUklUWV9VTlNQRUNJRklFRBAAEhsKF0JPVFRMRU5FQ0tfU0VWRVJJVFlfTE9XEAESHgoaQk9UVExFTkVDS19TRVZFUklUWV9NRURJVU0QAhIcChhCT1RUTEVORUNLX1NFVkVSSVRZX0hJR0gQAxIgChxCT1RUTEVORUNLX1NFVkVSSVRZX0NSSVRJQ0FMEAQq4QEKEE1vZGlmaWNhdGlvblR5cGUSIQodTU9ESUZJQ0FUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIhCh1NT0RJRklDQVRJT05fVFlQRV9VUERBVEVfRURHRRABEiEKHU1PRElGSUNBVElPTl9UWVBFX1JFTU9WRV9FREdFEAISHgoaTU9ESUZJQ0FUSU9OX1RZUEVfQUREX0VER0UQAxIhCh1NT0RJRklDQVRJT05fVFlQRV9VUERBVEVfTk9ERRAEEiEKHU1PRElGSUNBVElPTl9UWVBFX1JFTU9WRV9OT0RFEAUqmQEKEk1vZGlmaWNhdGlvblRhcmdldBIjCh9NT0RJRklDQVRJT05fVEFSR0VUX1VOU1BFQ0lGSUVEEAASIAocTU9ESUZJQ0FUSU9OX1RBUkdFVF9DQVBBQ0lUWRABEhwKGE1PRElGSUNBVElPTl9UQVJHRVRfQ09TVBACEh4KGk1PRElGSUNBVElPTl9UQVJHRVRfTEVOR1RIEAMqowEKC0ltcGFjdExldmVsEhwKGElNUEFDVF9MRVZFTF9VTlNQRUNJRklFRBAAEhUKEUlNUEFDVF9MRVZFTF9OT05FEAESFAoQSU1QQUNUX0xFVkVMX0xPVxACEhcKE0lNUEFDVF9MRVZFTF9NRURJVU0QAxIVChFJTVBBQ1RfTEVWRUxfSElHSBAEEhkKFUlNUEFDVF9MRVZFTF9DUklUSUNBTBAFKp4BChJDb3JyZWxhdGlvbk1lYXN1cmUSIwofQ09SUkVMQVRJT05fTUVBU1VSRV9VTlNQRUNJRklFRBAAEiAKHENPUlJFTEFUSU9OX01FQVNVUkVfR0FVU1NJQU4QARIgChxDT1JSRUxBVElPTl9NRUFTVVJFX1NQRUFSTUFOEAISHwobQ09SUkVMQVRJT05fTUVBU1VSRV9LRU5EQUxMEAMq3AEKFE1vbnRlQ2FybG9TdG9wUmVhc29uEicKI01PTlRFX0NBUkxPX1NUT1BfUkVBU09OX1VOU1BFQ0lGSUVEEAASJQohTU9OVEVfQ0FSTE9fU1RPUF9SRUFTT05fQ09NUExFVEVEEAESJQohTU9OVEVfQ0FSTE9fU1RPUF9SRUFTT05fQ09OVkVSR0VEEAISJwojTU9OVEVfQ0FSTE9fU1RPUF9SRUFTT05fVElNRV9CVURHRVQQAxIkCiBNT05URV9DQVJMT19TVE9QX1JFQVNPTl9DQU5DRUxFRBAEKq0BCg5TYW1wbGluZ01ldGhvZBIfChtTQU1QTElOR19NRVRIT0RfVU5TUEVDSUZJRUQQABIaChZTQU1QTElOR19NRVRIT0RfUkFORE9NEAESIwofU0FNUExJTkdfTUVUSE9EX0xBVElOX0hZUEVSQ1VCRRACEhkKFVNBTVBMSU5HX01FVEhPRF9TT0JPTBADEh4KGlNBTVBMSU5HX01FVEhPRF9BTlRJVEhFVElDEAQqlAEKEERpc3RyaWJ1dGlvblR5cGUSIQodRElTVFJJQlVUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIcChhESVNUUklCVVRJT05fVFlQRV9OT1JNQUwQARIdChlESVNUUklCVVRJT05fVFlQRV9VTklGT1JNEAISIAocRElTVFJJQlVUSU9OX1RZUEVfVFJJQU5HVUxBUhADKpoBChFTZW5zaXRpdml0eU1ldGhvZBIiCh5TRU5TSVRJVklUWV9NRVRIT0RfVU5TUEVDSUZJRUQQABIkCiBTRU5TSVRJVklUWV9NRVRIT0RfT05FX0FUX0FfVElNRRABEh0KGVNFTlNJVElWSVRZX01FVEhPRF9NT1JSSVMQAhIcChhTRU5TSVRJVklUWV9NRVRIT0RfU09CT0wQAyrAAQoMUmVwb3J0Rm9ybWF0Eh0KGVJFUE9SVF9GT1JNQVRfVU5TUEVDSUZJRUQQABIaChZSRVBPUlRfRk9STUFUX01BUktET1dOEAESFQoRUkVQT1JUX0ZPUk1BVF9DU1YQAhIXChNSRVBPUlRfRk9STUFUX0VYQ0VMEAMSFQoRUkVQT1JUX0ZPUk1BVF9QREYQBBIWChJSRVBPUlRfRk9STUFUX0hUTUwQBRIWChJSRVBPUlRfRk9STUFUX0pTT04QBirEAQoKUmVwb3J0VHlwZRIbChdSRVBPUlRfVFlQRV9VTlNQRUNJRklFRBAAEhQKEFJFUE9SVF9UWVBFX0ZMT1cQARIZChVSRVBPUlRfVFlQRV9BTkFMWVRJQ1MQAhIaChZSRVBPUlRfVFlQRV9TSU1VTEFUSU9OEAMSFwoTUkVQT1JUX1RZUEVfU1VNTUFSWRAEEhcKE1JFUE9SVF9UWVBFX0hJU1RPUlkQBRIaChZSRVBPUlRfVFlQRV9DT01QQVJJU09OEAYyiyIKDkdhdGV3YXlTZXJ2aWNlEkYKBkhlYWx0aBIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRokLmxvZ2lzdGljcy5nYXRld2F5LnYxLkhlYWx0aFJlc3BvbnNlElEKDlJlYWRpbmVzc0NoZWNrEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GicubG9naXN0aWNzLmdhdGV3YXkudjEuUmVhZGluZXNzUmVzcG9uc2USQgoESW5mbxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoiLmxvZ2lzdGljcy5nYXRld2F5LnYxLkluZm9SZXNwb25zZRJRCg1HZXRBbGdvcml0aG1zEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GigubG9naXN0aWNzLmdhdGV3YXkudjEuQWxnb3JpdGhtc1Jlc3BvbnNlElUKCFJlZ2lzdGVyEiUubG9naXN0aWNzLmdhdGV3YXkudjEuUmVnaXN0ZXJSZXF1ZXN0GiIubG9naXN0aWNzLmdhdGV3YXkudjEuQXV0aFJlc3BvbnNlEk8KBUxvZ2luEiIubG9naXN0aWNzLmdhdGV3YXkudjEuTG9naW5SZXF1ZXN0GiIubG9naXN0aWNzLmdhdGV3YXkudjEuQXV0aFJlc3BvbnNlEl0KDFJlZnJlc2hUb2tlbhIpLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlZnJlc2hUb2tlblJlcXVlc3QaIi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BdXRoUmVzcG9uc2USOAoGTG9nb3V0EhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EkcKCkdldFByb2ZpbGUSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaIS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Vc2VyUHJvZmlsZRJoCg1WYWxpZGF0ZVRva2VuEioubG9naXN0aWNzLmdhdGV3YXkudjEuVmFsaWRhdGVUb2tlblJlcXVlc3QaKy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5WYWxpZGF0ZVRva2VuUmVzcG9uc2USdwoSQ2FsY3VsYXRlTG9naXN0aWNzEi8ubG9naXN0aWNzLmdhdGV3YXkudjEuQ2FsY3VsYXRlTG9naXN0aWNzUmVxdWVzdBowLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNhbGN1bGF0ZUxvZ2lzdGljc1Jlc3BvbnNlEl8KClNvbHZlR3JhcGgSJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2x2ZUdyYXBoUmVxdWVzdBooLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNvbHZlR3JhcGhSZXNwb25zZRJnChBTb2x2ZUdyYXBoU3RyZWFtEicubG9naXN0aWNzLmdhdGV3YXkudjEuU29sdmVHcmFwaFJlcXVlc3QaKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Tb2x2ZVByb2dyZXNzRXZlbnQwARJfCgpCYXRjaFNvbHZlEicubG9naXN0aWNzLmdhdGV3YXkudjEuQmF0Y2hTb2x2ZVJlcXVlc3QaKC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5CYXRjaFNvbHZlUmVzcG9uc2USaAoNVmFsaWRhdGVHcmFwaBIqLmxvZ2lzdGljcy5nYXRld2F5LnYxLlZhbGlkYXRlR3JhcGhSZXF1ZXN0GisubG9naXN0aWNzLmdhdGV3YXkudjEuVmFsaWRhdGVHcmFwaFJlc3BvbnNlEn0KFFZhbGlkYXRlRm9yQWxnb3JpdGhtEjEubG9naXN0aWNzLmdhdGV3YXkudjEuVmFsaWRhdGVGb3JBbGdvcml0aG1SZXF1ZXN0GjIubG9naXN0aWNzLmdhdGV3YXkudjEuVmFsaWRhdGVGb3JBbGdvcml0aG1SZXNwb25zZRJlCgxBbmFseXplR3JhcGgSKS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BbmFseXplR3JhcGhSZXF1ZXN0GioubG9naXN0aWNzLmdhdGV3YXkudjEuQW5hbHl6ZUdyYXBoUmVzcG9uc2USaAoNQ2FsY3VsYXRlQ29zdBIqLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNhbGN1bGF0ZUNvc3RSZXF1ZXN0GisubG9naXN0aWNzLmdhdGV3YXkudjEuQ2FsY3VsYXRlQ29zdFJlc3BvbnNlEmUKDkdldEJvdHRsZW5lY2tzEigubG9naXN0aWNzLmdhdGV3YXkudjEuQm90dGxlbmVja3NSZXF1ZXN0GikubG9naXN0aWNzLmdhdGV3YXkudjEuQm90dGxlbmVja3NSZXNwb25zZRJxChBDb21wYXJlU2NlbmFyaW9zEi0ubG9naXN0aWNzLmdhdGV3YXkudjEuQ29tcGFyZVNjZW5hcmlvc1JlcXVlc3QaLi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Db21wYXJlU2NlbmFyaW9zUmVzcG9uc2USVgoJUnVuV2hhdElmEiMubG9naXN0aWNzLmdhdGV3YXkudjEuV2hhdElmUmVxdWVzdBokLmxvZ2lzdGljcy5nYXRld2F5LnYxLldoYXRJZlJlc3BvbnNlEmIKDVJ1bk1vbnRlQ2FybG8SJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb250ZUNhcmxvUmVxdWVzdBooLmxvZ2lzdGljcy5nYXRld2F5LnYxLk1vbnRlQ2FybG9SZXNwb25zZRJvChNSdW5Nb250ZUNhcmxvU3RyZWFtEicubG9naXN0aWNzLmdhdGV3YXkudjEuTW9udGVDYXJsb1JlcXVlc3QaLS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5Nb250ZUNhcmxvUHJvZ3Jlc3NFdmVudDABEmkKEkFuYWx5emVTZW5zaXRpdml0eRIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNlbnNpdGl2aXR5UmVxdWVzdBopLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNlbnNpdGl2aXR5UmVzcG9uc2USZgoRQW5hbHl6ZVJlc2lsaWVuY2USJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5SZXNpbGllbmNlUmVxdWVzdBooLmxvZ2lzdGljcy5nYXRld2F5LnYxLlJlc2lsaWVuY2VSZXNwb25zZRJzChBTaW11bGF0ZUZhaWx1cmVzEi4ubG9naXN0aWNzLmdhdGV3YXkudjEuRmFpbHVyZVNpbXVsYXRpb25SZXF1ZXN0Gi8ubG9naXN0aWNzLmdhdGV3YXkudjEuRmFpbHVyZVNpbXVsYXRpb25SZXNwb25zZRJ1ChRGaW5kQ3JpdGljYWxFbGVtZW50cxItLmxvZ2lzdGljcy5nYXRld2F5LnYxLkNyaXRpY2FsRWxlbWVudHNSZXF1ZXN0Gi4ubG9naXN0aWNzLmdhdGV3YXkudjEuQ3JpdGljYWxFbGVtZW50c1Jlc3BvbnNlEmMKDUdldFNpbXVsYXRpb24SKi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5HZXRTaW11bGF0aW9uUmVxdWVzdBomLmxvZ2lzdGljcy5nYXRld2F5LnYxLlNpbXVsYXRpb25SZWNvcmQSbgoPTGlzdFNpbXVsYXRpb25zEiwubG9naXN0aWNzLmdhdGV3YXkudjEuTGlzdFNpbXVsYXRpb25zUmVxdWVzdBotLmxvZ2lzdGljcy5nYXRld2F5LnYxLkxpc3RTaW11bGF0aW9uc1Jlc3BvbnNlElkKEERlbGV0ZVNpbXVsYXRpb24SLS5sb2dpc3RpY3MuZ2F0ZXdheS52MS5EZWxldGVTaW11bGF0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJuCg9TYXZlQ2FsY3VsYXRpb24SLC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5TYXZlQ2FsY3VsYXRpb25SZXF1ZXN0Gi0ubG9naXN0aWNzLmdhdGV3YXkudjEuU2F2ZUNhbGN1bGF0aW9uUmVzcG9uc2USZgoOR2V0Q2FsY3VsYXRpb24SKy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5HZXRDYWxjdWxhdGlvblJlcXVlc3QaJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5DYWxjdWxhdGlvblJlY29yZBJxChBMaXN0Q2FsY3VsYXRpb25zEi0ubG9naXN0aWNzLmdhdGV3YXkudjEuTGlzdENhbGN1bGF0aW9uc1JlcXVlc3QaLi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5MaXN0Q2FsY3VsYXRpb25zUmVzcG9uc2USWwoRRGVsZXRlQ2FsY3VsYXRpb24SLi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5EZWxldGVDYWxjdWxhdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSZQoNR2V0U3RhdGlzdGljcxIqLmxvZ2lzdGljcy5nYXRld2F5LnYxLkdldFN0YXRpc3RpY3NSZXF1ZXN0GigubG9naXN0aWNzLmdhdGV3YXkudjEuU3RhdGlzdGljc1Jlc3BvbnNlEmsKDkdlbmVyYXRlUmVwb3J0EisubG9naXN0aWNzLmdhdGV3YXkudjEuR2VuZXJhdGVSZXBvcnRSZXF1ZXN0GiwubG9naXN0aWNzLmdhdGV3YXkudjEuR2VuZXJhdGVSZXBvcnRSZXNwb25zZRJXCglHZXRSZXBvcnQSJi5sb2dpc3RpY3MuZ2F0ZXdheS52MS5HZXRSZXBvcnRSZXF1ZXN0GiIubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0UmVjb3JkEmIKDkRvd25sb2FkUmVwb3J0EisubG9naXN0aWNzLmdhdGV3YXkudjEuRG93bmxvYWRSZXBvcnRSZXF1ZXN0GiEubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0Q2h1bmswARJiCgtMaXN0UmVwb3J0cxIoLmxvZ2lzdGljcy5nYXRld2F5LnYxLkxpc3RSZXBvcnRzUmVxdWVzdBopLmxvZ2lzdGljcy5nYXRld2F5LnYxLkxpc3RSZXBvcnRzUmVzcG9uc2USUQoMRGVsZXRlUmVwb3J0EikubG9naXN0aWNzLmdhdGV3YXkudjEuRGVsZXRlUmVwb3J0UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJXChBHZXRSZXBvcnRGb3JtYXRzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GisubG9naXN0aWNzLmdhdGV3YXkudjEuUmVwb3J0Rm9ybWF0c1Jlc3BvbnNlEmIKDEdldEF1ZGl0TG9ncxIpLmxvZ2lzdGljcy5nYXRld2F5LnYxLkdldEF1ZGl0TG9nc1JlcXVlc3QaJy5sb2dpc3RpY3MuZ2F0ZXdheS52MS5BdWRpdExvZ3NSZXNwb25zZRJrCg9HZXRVc2VyQWN0aXZpdHkSLC5sb2dpc3RpY3MuZ2F0ZXdheS52MS5HZXRVc2VyQWN0aXZpdHlSZXF1ZXN0GioubG9naXN0aWNzLmdhdGV3YXkudjEuVXNlckFjdGl2aXR5UmVzcG9uc2USZQoNR2V0QXVkaXRTdGF0cxIqLmxvZ2lzdGljcy5nYXRld2F5LnYxLkdldEF1ZGl0U3RhdHNSZXF1ZXN0GigubG9naXN0aWNzLmdhdGV3YXkudjEuQXVkaXRTdGF0c1Jlc3BvbnNlQssBChhjb20ubG9naXN0aWNzLmdhdGV3YXkudjFCDEdhdGV3YXlQcm90b1ABWi9sb2dpc3RpY3MvZ2VuL2dvL2xvZ2lzdGljcy9nYXRld2F5L3YxO2dhdGV3YXl2MaICA0xHWKoCFExvZ2lzdGljcy5HYXRld2F5LlYxygIUTG9naXN0aWNzXEdhdGV3YXlcVjHiAiBMb2dpc3RpY3NcR2F0ZXdheVxWMVxHUEJNZXRhZGF0YeoCFkxvZ2lzdGljczo6R2F0ZXdheTo6VjFiBnByb3RvMw", [file_google_protobuf_empty, file_google_protobuf_timestamp, file_logistics_common_v1_common]);
 
 /**
  * @generated from message logistics.gateway.v1.HealthResponse
@@ -916,6 +916,18 @@ export type SolveOptions = Message<"logistics.gateway.v1.SolveOptions"> & {
    * @generated from field: double epsilon = 4;
    */
   epsilon: number;
+
+  /**
+   * @generated from field: logistics.gateway.v1.SolveMode mode = 5;
+   */
+  mode: SolveMode;
+
+  /**
+   * Flow decomposition into paths and cycles
+   *
+   * @generated from field: bool return_decomposition = 6;
+   */
+  returnDecomposition: boolean;
 };
 
 /**
@@ -1521,6 +1533,8 @@ export type Bottleneck = Message<"logistics.gateway.v1.Bottleneck"> & {
   utilization: number;
 
   /**
+   * Relative gain from doubling the edge capacity
+   *
    * @generated from field: double impact_score = 3;
    */
   impactScore: number;
@@ -1529,6 +1543,25 @@ export type Bottleneck = Message<"logistics.gateway.v1.Bottleneck"> & {
    * @generated from field: logistics.gateway.v1.BottleneckSeverity severity = 4;
    */
   severity: BottleneckSeverity;
+
+  /**
+   * @generated from field: bool in_min_cut = 5;
+   */
+  inMinCut: boolean;
+
+  /**
+   * Max flow increase from doubling the edge capacity
+   *
+   * @generated from field: double flow_gain = 6;
+   */
+  flowGain: number;
+
+  /**
+   * Cost reduction from doubling the edge capacity
+   *
+   * @generated from field: double cost_saving = 7;
+   */
+  costSaving: number;
 };
 
 /**
@@ -1847,6 +1880,11 @@ export type SolveResult = Message<"logistics.gateway.v1.SolveResult"> & {
    * @generated from field: repeated logistics.common.v1.Path paths = 8;
    */
   paths: Path[];
+
+  /**
+   * @generated from field: logistics.common.v1.FlowDecomposition decomposition = 9;
+   */
+  decomposition?: FlowDecomposition;
 };
 
 /**
@@ -2081,6 +2119,13 @@ export type MonteCarloRequest = Message<"logistics.gateway.v1.MonteCarloRequest"
    * @generated from field: logistics.common.v1.Algorithm algorithm = 4;
    */
   algorithm: Algorithm;
+
+  /**
+   * Independent uncertainties when unset
+   *
+   * @generated from field: logistics.gateway.v1.UncertaintyCorrelation correlation = 5;
+   */
+  correlation?: UncertaintyCorrelation;
 };
 
 /**
@@ -2090,6 +2135,61 @@ export type MonteCarloRequest = Message<"logistics.gateway.v1.MonteCarloRequest"
 export const MonteCarloRequestSchema: GenMessage<MonteCarloRequest> = /*@__PURE__*/
   messageDesc(file_logistics_gateway_v1_gateway, 57);
 
+/**
+ * Gaussian copula between uncertainties; each marginal distribution is preserved
+ *
+ * @generated from message logistics.gateway.v1.UncertaintyCorrelation
+ */
+export type UncertaintyCorrelation = Message<"logistics.gateway.v1.UncertaintyCorrelation"> & {
+  /**
+   * @generated from field: logistics.gateway.v1.CorrelationMeasure measure = 1;
+   */
+  measure: CorrelationMeasure;
+
+  /**
+   * Full n×n matrix, row-major, n = len(uncertainties)
+   *
+   * @generated from field: repeated double matrix = 2;
+   */
+  matrix: number[];
+
+  /**
+   * Or groups with one pairwise correlation each
+   *
+   * @generated from field: repeated logistics.gateway.v1.CorrelationGroup groups = 3;
+   */
+  groups: CorrelationGroup[];
+};
+
+/**
+ * Describes the message logistics.gateway.v1.UncertaintyCorrelation.
+ * Use `create(UncertaintyCorrelationSchema)` to create a new message.
+ */
+export const UncertaintyCorrelationSchema: GenMessage<UncertaintyCorrelation> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 58);
+
+/**
+ * @generated from message logistics.gateway.v1.CorrelationGroup
+ */
+export type CorrelationGroup = Message<"logistics.gateway.v1.CorrelationGroup"> & {
+  /**
+   * @generated from field: repeated int32 uncertainty_indices = 1;
+   */
+  uncertaintyIndices: number[];
+
+  /**
+   * @generated from field: double correlation = 2;
+   */
+  correlation: number;
+};
+
+/**
+ * Describes the message logistics.gateway.v1.CorrelationGroup.
+ * Use `create(CorrelationGroupSchema)` to create a new message.
+ */
+export const CorrelationGroupSchema: GenMessage<CorrelationGroup> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 59);
+
 /**
  * @generated from message logistics.gateway.v1.MonteCarloConfig
  */
@@ -2113,6 +2213,40 @@ export type MonteCarloConfig = Message<"logistics.gateway.v1.MonteCarloConfig">
    * @generated from field: bool parallel = 4;
    */
   parallel: boolean;
+
+  /**
+   * Return per-iteration samples
+   *
+   * @generated from field: bool return_samples = 5;
+   */
+  returnSamples: boolean;
+
+  /**
+   * Re-run only these iterations (requires random_seed)
+   *
+   * @generated from field: repeated int32 replay_iterations = 6;
+   */
+  replayIterations: number[];
+
+  /**
+   * @generated from field: logistics.gateway.v1.SamplingMethod sampling_method = 7;
+   */
+  samplingMethod: SamplingMethod;
+
+  /**
+   * Stop once the relative CI half-width of both mean flow and mean cost is at most
+   * this value; num_iterations is the upper bound (0 = run all iterations)
+   *
+   * @generated from field: double target_relative_ci_half_width = 8;
+   */
+  targetRelativeCiHalfWidth: number;
+
+  /**
+   * 0 = unlimited
+   *
+   * @generated from field: double time_budget_seconds = 9;
+   */
+  timeBudgetSeconds: number;
 };
 
 /**
@@ -2120,7 +2254,7 @@ export type MonteCarloConfig = Message<"logistics.gateway.v1.MonteCarloConfig">
  * Use `create(MonteCarloConfigSchema)` to create a new message.
  */
 export const MonteCarloConfigSchema: GenMessage<MonteCarloConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 58);
+  messageDesc(file_logistics_gateway_v1_gateway, 60);
 
 /**
  * @generated from message logistics.gateway.v1.UncertaintySpec
@@ -2152,7 +2286,7 @@ export type UncertaintySpec = Message<"logistics.gateway.v1.UncertaintySpec"> &
  * Use `create(UncertaintySpecSchema)` to create a new message.
  */
 export const UncertaintySpecSchema: GenMessage<UncertaintySpec> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 59);
+  messageDesc(file_logistics_gateway_v1_gateway, 61);
 
 /**
  * @generated from message logistics.gateway.v1.Distribution
@@ -2190,7 +2324,7 @@ export type Distribution = Message<"logistics.gateway.v1.Distribution"> & {
  * Use `create(DistributionSchema)` to create a new message.
  */
 export const DistributionSchema: GenMessage<Distribution> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 60);
+  messageDesc(file_logistics_gateway_v1_gateway, 62);
 
 /**
  * @generated from message logistics.gateway.v1.MonteCarloResponse
@@ -2225,6 +2359,30 @@ export type MonteCarloResponse = Message<"logistics.gateway.v1.MonteCarloRespons
    * @generated from field: string error_message = 6;
    */
   errorMessage: string;
+
+  /**
+   * Seed used (generated when the request had 0)
+   *
+   * @generated from field: int64 random_seed = 7;
+   */
+  randomSeed: bigint;
+
+  /**
+   * Only with return_samples
+   *
+   * @generated from field: repeated logistics.gateway.v1.MonteCarloSample samples = 8;
+   */
+  samples: MonteCarloSample[];
+
+  /**
+   * @generated from field: logistics.gateway.v1.MonteCarloStopReason stop_reason = 9;
+   */
+  stopReason: MonteCarloStopReason;
+
+  /**
+   * @generated from field: int32 completed_iterations = 10;
+   */
+  completedIterations: number;
 };
 
 /**
@@ -2232,7 +2390,46 @@ export type MonteCarloResponse = Message<"logistics.gateway.v1.MonteCarloRespons
  * Use `create(MonteCarloResponseSchema)` to create a new message.
  */
 export const MonteCarloResponseSchema: GenMessage<MonteCarloResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 61);
+  messageDesc(file_logistics_gateway_v1_gateway, 63);
+
+/**
+ * @generated from message logistics.gateway.v1.MonteCarloSample
+ */
+export type MonteCarloSample = Message<"logistics.gateway.v1.MonteCarloSample"> & {
+  /**
+   * @generated from field: int32 iteration = 1;
+   */
+  iteration: number;
+
+  /**
+   * In the order of the request uncertainties
+   *
+   * @generated from field: repeated double multipliers = 2;
+   */
+  multipliers: number[];
+
+  /**
+   * @generated from field: double flow = 3;
+   */
+  flow: number;
+
+  /**
+   * @generated from field: double cost = 4;
+   */
+  cost: number;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message logistics.gateway.v1.MonteCarloSample.
+ * Use `create(MonteCarloSampleSchema)` to create a new message.
+ */
+export const MonteCarloSampleSchema: GenMessage<MonteCarloSample> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 64);
 
 /**
  * @generated from message logistics.gateway.v1.MonteCarloStats
@@ -2272,6 +2469,20 @@ export type MonteCarloStats = Message<"logistics.gateway.v1.MonteCarloStats"> &
    * @generated from field: double confidence_interval_high = 7;
    */
   confidenceIntervalHigh: number;
+
+  /**
+   * Independent random samples giving the same precision of the mean
+   *
+   * @generated from field: double effective_sample_size = 8;
+   */
+  effectiveSampleSize: number;
+
+  /**
+   * CI half-width divided by |mean|
+   *
+   * @generated from field: double relative_ci_half_width = 9;
+   */
+  relativeCiHalfWidth: number;
 };
 
 /**
@@ -2279,7 +2490,7 @@ export type MonteCarloStats = Message<"logistics.gateway.v1.MonteCarloStats"> &
  * Use `create(MonteCarloStatsSchema)` to create a new message.
  */
 export const MonteCarloStatsSchema: GenMessage<MonteCarloStats> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 62);
+  messageDesc(file_logistics_gateway_v1_gateway, 65);
 
 /**
  * @generated from message logistics.gateway.v1.RiskAnalysis
@@ -2311,7 +2522,7 @@ export type RiskAnalysis = Message<"logistics.gateway.v1.RiskAnalysis"> & {
  * Use `create(RiskAnalysisSchema)` to create a new message.
  */
 export const RiskAnalysisSchema: GenMessage<RiskAnalysis> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 63);
+  messageDesc(file_logistics_gateway_v1_gateway, 66);
 
 /**
  * @generated from message logistics.gateway.v1.MonteCarloProgressEvent
@@ -2356,6 +2567,25 @@ export type MonteCarloProgressEvent = Message<"logistics.gateway.v1.MonteCarloPr
    * @generated from field: logistics.gateway.v1.MonteCarloResponse final_result = 8;
    */
   finalResult?: MonteCarloResponse;
+
+  /**
+   * CI half-width of the mean flow
+   *
+   * @generated from field: double ci_half_width = 9;
+   */
+  ciHalfWidth: number;
+
+  /**
+   * Largest relative CI half-width of flow and cost
+   *
+   * @generated from field: double relative_ci_half_width = 10;
+   */
+  relativeCiHalfWidth: number;
+
+  /**
+   * @generated from field: int32 estimated_iterations_remaining = 11;
+   */
+  estimatedIterationsRemaining: number;
 };
 
 /**
@@ -2363,7 +2593,7 @@ export type MonteCarloProgressEvent = Message<"logistics.gateway.v1.MonteCarloPr
  * Use `create(MonteCarloProgressEventSchema)` to create a new message.
  */
 export const MonteCarloProgressEventSchema: GenMessage<MonteCarloProgressEvent> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 64);
+  messageDesc(file_logistics_gateway_v1_gateway, 67);
 
 /**
  * @generated from message logistics.gateway.v1.SensitivityRequest
@@ -2383,6 +2613,11 @@ export type SensitivityRequest = Message<"logistics.gateway.v1.SensitivityReques
    * @generated from field: logistics.common.v1.Algorithm algorithm = 3;
    */
   algorithm: Algorithm;
+
+  /**
+   * @generated from field: logistics.gateway.v1.SensitivityConfig config = 4;
+   */
+  config?: SensitivityConfig;
 };
 
 /**
@@ -2390,7 +2625,64 @@ export type SensitivityRequest = Message<"logistics.gateway.v1.SensitivityReques
  * Use `create(SensitivityRequestSchema)` to create a new message.
  */
 export const SensitivityRequestSchema: GenMessage<SensitivityRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 65);
+  messageDesc(file_logistics_gateway_v1_gateway, 68);
+
+/**
+ * @generated from message logistics.gateway.v1.SensitivityConfig
+ */
+export type SensitivityConfig = Message<"logistics.gateway.v1.SensitivityConfig"> & {
+  /**
+   * @generated from field: logistics.gateway.v1.SensitivityMethod method = 1;
+   */
+  method: SensitivityMethod;
+
+  /**
+   * @generated from field: bool find_thresholds = 2;
+   */
+  findThresholds: boolean;
+
+  /**
+   * Morris trajectories (default 10)
+   *
+   * @generated from field: int32 num_trajectories = 3;
+   */
+  numTrajectories: number;
+
+  /**
+   * Morris grid levels, even (default 4)
+   *
+   * @generated from field: int32 num_levels = 4;
+   */
+  numLevels: number;
+
+  /**
+   * Sobol base sample size (default 256)
+   *
+   * @generated from field: int32 num_samples = 5;
+   */
+  numSamples: number;
+
+  /**
+   * 0 = random seed
+   *
+   * @generated from field: int64 random_seed = 6;
+   */
+  randomSeed: bigint;
+
+  /**
+   * Parallel solves (default 4)
+   *
+   * @generated from field: int32 max_workers = 7;
+   */
+  maxWorkers: number;
+};
+
+/**
+ * Describes the message logistics.gateway.v1.SensitivityConfig.
+ * Use `create(SensitivityConfigSchema)` to create a new message.
+ */
+export const SensitivityConfigSchema: GenMessage<SensitivityConfig> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 69);
 
 /**
  * @generated from message logistics.gateway.v1.SensitivityParameter
@@ -2432,7 +2724,7 @@ export type SensitivityParameter = Message<"logistics.gateway.v1.SensitivityPara
  * Use `create(SensitivityParameterSchema)` to create a new message.
  */
 export const SensitivityParameterSchema: GenMessage<SensitivityParameter> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 66);
+  messageDesc(file_logistics_gateway_v1_gateway, 70);
 
 /**
  * @generated from message logistics.gateway.v1.SensitivityResponse
@@ -2469,7 +2761,7 @@ export type SensitivityResponse = Message<"logistics.gateway.v1.SensitivityRespo
  * Use `create(SensitivityResponseSchema)` to create a new message.
  */
 export const SensitivityResponseSchema: GenMessage<SensitivityResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 67);
+  messageDesc(file_logistics_gateway_v1_gateway, 71);
 
 /**
  * @generated from message logistics.gateway.v1.SensitivityResult
@@ -2494,6 +2786,16 @@ export type SensitivityResult = Message<"logistics.gateway.v1.SensitivityResult"
    * @generated from field: double sensitivity_index = 4;
    */
   sensitivityIndex: number;
+
+  /**
+   * @generated from field: logistics.gateway.v1.MorrisIndices morris = 5;
+   */
+  morris?: MorrisIndices;
+
+  /**
+   * @generated from field: logistics.gateway.v1.SobolIndices sobol = 6;
+   */
+  sobol?: SobolIndices;
 };
 
 /**
@@ -2501,7 +2803,70 @@ export type SensitivityResult = Message<"logistics.gateway.v1.SensitivityResult"
  * Use `create(SensitivityResultSchema)` to create a new message.
  */
 export const SensitivityResultSchema: GenMessage<SensitivityResult> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 68);
+  messageDesc(file_logistics_gateway_v1_gateway, 72);
+
+/**
+ * Elementary effects measure the flow change over the whole multiplier range
+ *
+ * @generated from message logistics.gateway.v1.MorrisIndices
+ */
+export type MorrisIndices = Message<"logistics.gateway.v1.MorrisIndices"> & {
+  /**
+   * @generated from field: double mu = 1;
+   */
+  mu: number;
+
+  /**
+   * @generated from field: double mu_star = 2;
+   */
+  muStar: number;
+
+  /**
+   * @generated from field: double sigma = 3;
+   */
+  sigma: number;
+};
+
+/**
+ * Describes the message logistics.gateway.v1.MorrisIndices.
+ * Use `create(MorrisIndicesSchema)` to create a new message.
+ */
+export const MorrisIndicesSchema: GenMessage<MorrisIndices> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 73);
+
+/**
+ * @generated from message logistics.gateway.v1.SobolIndices
+ */
+export type SobolIndices = Message<"logistics.gateway.v1.SobolIndices"> & {
+  /**
+   * @generated from field: double first_order = 1;
+   */
+  firstOrder: number;
+
+  /**
+   * @generated from field: double total_order = 2;
+   */
+  totalOrder: number;
+
+  /**
+   * 95% bootstrap CI half-width
+   *
+   * @generated from field: double first_order_conf = 3;
+   */
+  firstOrderConf: number;
+
+  /**
+   * @generated from field: double total_order_conf = 4;
+   */
+  totalOrderConf: number;
+};
+
+/**
+ * Describes the message logistics.gateway.v1.SobolIndices.
+ * Use `create(SobolIndicesSchema)` to create a new message.
+ */
+export const SobolIndicesSchema: GenMessage<SobolIndices> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 74);
 
 /**
  * @generated from message logistics.gateway.v1.SensitivityPoint
@@ -2528,7 +2893,7 @@ export type SensitivityPoint = Message<"logistics.gateway.v1.SensitivityPoint">
  * Use `create(SensitivityPointSchema)` to create a new message.
  */
 export const SensitivityPointSchema: GenMessage<SensitivityPoint> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 69);
+  messageDesc(file_logistics_gateway_v1_gateway, 75);
 
 /**
  * @generated from message logistics.gateway.v1.ParameterRanking
@@ -2560,7 +2925,7 @@ export type ParameterRanking = Message<"logistics.gateway.v1.ParameterRanking">
  * Use `create(ParameterRankingSchema)` to create a new message.
  */
 export const ParameterRankingSchema: GenMessage<ParameterRanking> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 70);
+  messageDesc(file_logistics_gateway_v1_gateway, 76);
 
 /**
  * @generated from message logistics.gateway.v1.ResilienceRequest
@@ -2587,7 +2952,7 @@ export type ResilienceRequest = Message<"logistics.gateway.v1.ResilienceRequest"
  * Use `create(ResilienceRequestSchema)` to create a new message.
  */
 export const ResilienceRequestSchema: GenMessage<ResilienceRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 71);
+  messageDesc(file_logistics_gateway_v1_gateway, 77);
 
 /**
  * @generated from message logistics.gateway.v1.ResilienceConfig
@@ -2601,57 +2966,169 @@ export type ResilienceConfig = Message<"logistics.gateway.v1.ResilienceConfig">
   /**
    * @generated from field: bool test_cascading_failures = 2;
    */
-  testCascadingFailures: boolean;
+  testCascadingFailures: boolean;
+
+  /**
+   * Utilization that fails an overloaded edge (default 0.9)
+   *
+   * @generated from field: double load_factor = 3;
+   */
+  loadFactor: number;
+
+  /**
+   * Edges enumerated for N-k and cascades (default 20)
+   *
+   * @generated from field: int32 max_candidate_edges = 4;
+   */
+  maxCandidateEdges: number;
+};
+
+/**
+ * Describes the message logistics.gateway.v1.ResilienceConfig.
+ * Use `create(ResilienceConfigSchema)` to create a new message.
+ */
+export const ResilienceConfigSchema: GenMessage<ResilienceConfig> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 78);
+
+/**
+ * @generated from message logistics.gateway.v1.ResilienceResponse
+ */
+export type ResilienceResponse = Message<"logistics.gateway.v1.ResilienceResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: logistics.gateway.v1.ResilienceMetrics metrics = 2;
+   */
+  metrics?: ResilienceMetrics;
+
+  /**
+   * @generated from field: repeated logistics.gateway.v1.ResilienceWeakness weaknesses = 3;
+   */
+  weaknesses: ResilienceWeakness[];
+
+  /**
+   * @generated from field: logistics.gateway.v1.SimulationMetadata metadata = 4;
+   */
+  metadata?: SimulationMetadata;
+
+  /**
+   * @generated from field: string error_message = 5;
+   */
+  errorMessage: string;
+
+  /**
+   * Minimal edge pairs whose joint failure is worse than their separate failures
+   *
+   * @generated from field: repeated logistics.gateway.v1.EdgePair critical_edge_pairs = 6;
+   */
+  criticalEdgePairs: EdgePair[];
+
+  /**
+   * @generated from field: repeated logistics.gateway.v1.CascadeScenario cascades = 7;
+   */
+  cascades: CascadeScenario[];
+};
+
+/**
+ * Describes the message logistics.gateway.v1.ResilienceResponse.
+ * Use `create(ResilienceResponseSchema)` to create a new message.
+ */
+export const ResilienceResponseSchema: GenMessage<ResilienceResponse> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 79);
+
+/**
+ * @generated from message logistics.gateway.v1.EdgePair
+ */
+export type EdgePair = Message<"logistics.gateway.v1.EdgePair"> & {
+  /**
+   * @generated from field: logistics.common.v1.EdgeKey edge1 = 1;
+   */
+  edge1?: EdgeKey;
+
+  /**
+   * @generated from field: logistics.common.v1.EdgeKey edge2 = 2;
+   */
+  edge2?: EdgeKey;
+
+  /**
+   * @generated from field: double combined_impact = 3;
+   */
+  combinedImpact: number;
+};
+
+/**
+ * Describes the message logistics.gateway.v1.EdgePair.
+ * Use `create(EdgePairSchema)` to create a new message.
+ */
+export const EdgePairSchema: GenMessage<EdgePair> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 80);
+
+/**
+ * @generated from message logistics.gateway.v1.CascadeScenario
+ */
+export type CascadeScenario = Message<"logistics.gateway.v1.CascadeScenario"> & {
+  /**
+   * @generated from field: logistics.common.v1.EdgeKey initial_failure = 1;
+   */
+  initialFailure?: EdgeKey;
+
+  /**
+   * @generated from field: repeated logistics.gateway.v1.CascadeStep steps = 2;
+   */
+  steps: CascadeStep[];
+
+  /**
+   * @generated from field: double final_flow = 3;
+   */
+  finalFlow: number;
+
+  /**
+   * @generated from field: double flow_reduction = 4;
+   */
+  flowReduction: number;
 
   /**
-   * @generated from field: double load_factor = 3;
+   * @generated from field: int32 failed_edges = 5;
    */
-  loadFactor: number;
+  failedEdges: number;
 };
 
 /**
- * Describes the message logistics.gateway.v1.ResilienceConfig.
- * Use `create(ResilienceConfigSchema)` to create a new message.
+ * Describes the message logistics.gateway.v1.CascadeScenario.
+ * Use `create(CascadeScenarioSchema)` to create a new message.
  */
-export const ResilienceConfigSchema: GenMessage<ResilienceConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 72);
+export const CascadeScenarioSchema: GenMessage<CascadeScenario> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 81);
 
 /**
- * @generated from message logistics.gateway.v1.ResilienceResponse
+ * @generated from message logistics.gateway.v1.CascadeStep
  */
-export type ResilienceResponse = Message<"logistics.gateway.v1.ResilienceResponse"> & {
-  /**
-   * @generated from field: bool success = 1;
-   */
-  success: boolean;
-
-  /**
-   * @generated from field: logistics.gateway.v1.ResilienceMetrics metrics = 2;
-   */
-  metrics?: ResilienceMetrics;
-
+export type CascadeStep = Message<"logistics.gateway.v1.CascadeStep"> & {
   /**
-   * @generated from field: repeated logistics.gateway.v1.ResilienceWeakness weaknesses = 3;
+   * @generated from field: int32 round = 1;
    */
-  weaknesses: ResilienceWeakness[];
+  round: number;
 
   /**
-   * @generated from field: logistics.gateway.v1.SimulationMetadata metadata = 4;
+   * @generated from field: repeated logistics.common.v1.EdgeKey failed_edges = 2;
    */
-  metadata?: SimulationMetadata;
+  failedEdges: EdgeKey[];
 
   /**
-   * @generated from field: string error_message = 5;
+   * @generated from field: double flow_after = 3;
    */
-  errorMessage: string;
+  flowAfter: number;
 };
 
 /**
- * Describes the message logistics.gateway.v1.ResilienceResponse.
- * Use `create(ResilienceResponseSchema)` to create a new message.
+ * Describes the message logistics.gateway.v1.CascadeStep.
+ * Use `create(CascadeStepSchema)` to create a new message.
  */
-export const ResilienceResponseSchema: GenMessage<ResilienceResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 73);
+export const CascadeStepSchema: GenMessage<CascadeStep> = /*@__PURE__*/
+  messageDesc(file_logistics_gateway_v1_gateway, 82);
 
 /**
  * @generated from message logistics.gateway.v1.ResilienceMetrics
@@ -2688,7 +3165,7 @@ export type ResilienceMetrics = Message<"logistics.gateway.v1.ResilienceMetrics"
  * Use `create(ResilienceMetricsSchema)` to create a new message.
  */
 export const ResilienceMetricsSchema: GenMessage<ResilienceMetrics> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 74);
+  messageDesc(file_logistics_gateway_v1_gateway, 83);
 
 /**
  * @generated from message logistics.gateway.v1.ResilienceWeakness
@@ -2725,7 +3202,7 @@ export type ResilienceWeakness = Message<"logistics.gateway.v1.ResilienceWeaknes
  * Use `create(ResilienceWeaknessSchema)` to create a new message.
  */
 export const ResilienceWeaknessSchema: GenMessage<ResilienceWeakness> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 75);
+  messageDesc(file_logistics_gateway_v1_gateway, 84);
 
 /**
  * @generated from message logistics.gateway.v1.FailureSimulationRequest
@@ -2752,7 +3229,7 @@ export type FailureSimulationRequest = Message<"logistics.gateway.v1.FailureSimu
  * Use `create(FailureSimulationRequestSchema)` to create a new message.
  */
 export const FailureSimulationRequestSchema: GenMessage<FailureSimulationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 76);
+  messageDesc(file_logistics_gateway_v1_gateway, 85);
 
 /**
  * @generated from message logistics.gateway.v1.FailureScenario
@@ -2784,7 +3261,7 @@ export type FailureScenario = Message<"logistics.gateway.v1.FailureScenario"> &
  * Use `create(FailureScenarioSchema)` to create a new message.
  */
 export const FailureScenarioSchema: GenMessage<FailureScenario> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 77);
+  messageDesc(file_logistics_gateway_v1_gateway, 86);
 
 /**
  * @generated from message logistics.gateway.v1.FailureSimulationResponse
@@ -2826,7 +3303,7 @@ export type FailureSimulationResponse = Message<"logistics.gateway.v1.FailureSim
  * Use `create(FailureSimulationResponseSchema)` to create a new message.
  */
 export const FailureSimulationResponseSchema: GenMessage<FailureSimulationResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 78);
+  messageDesc(file_logistics_gateway_v1_gateway, 87);
 
 /**
  * @generated from message logistics.gateway.v1.FailureScenarioResult
@@ -2863,7 +3340,7 @@ export type FailureScenarioResult = Message<"logistics.gateway.v1.FailureScenari
  * Use `create(FailureScenarioResultSchema)` to create a new message.
  */
 export const FailureScenarioResultSchema: GenMessage<FailureScenarioResult> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 79);
+  messageDesc(file_logistics_gateway_v1_gateway, 88);
 
 /**
  * @generated from message logistics.gateway.v1.FailureStats
@@ -2890,7 +3367,7 @@ export type FailureStats = Message<"logistics.gateway.v1.FailureStats"> & {
  * Use `create(FailureStatsSchema)` to create a new message.
  */
 export const FailureStatsSchema: GenMessage<FailureStats> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 80);
+  messageDesc(file_logistics_gateway_v1_gateway, 89);
 
 /**
  * @generated from message logistics.gateway.v1.CriticalElementsRequest
@@ -2917,7 +3394,7 @@ export type CriticalElementsRequest = Message<"logistics.gateway.v1.CriticalElem
  * Use `create(CriticalElementsRequestSchema)` to create a new message.
  */
 export const CriticalElementsRequestSchema: GenMessage<CriticalElementsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 81);
+  messageDesc(file_logistics_gateway_v1_gateway, 90);
 
 /**
  * @generated from message logistics.gateway.v1.CriticalElementsConfig
@@ -2949,7 +3426,7 @@ export type CriticalElementsConfig = Message<"logistics.gateway.v1.CriticalEleme
  * Use `create(CriticalElementsConfigSchema)` to create a new message.
  */
 export const CriticalElementsConfigSchema: GenMessage<CriticalElementsConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 82);
+  messageDesc(file_logistics_gateway_v1_gateway, 91);
 
 /**
  * @generated from message logistics.gateway.v1.CriticalElementsResponse
@@ -2996,7 +3473,7 @@ export type CriticalElementsResponse = Message<"logistics.gateway.v1.CriticalEle
  * Use `create(CriticalElementsResponseSchema)` to create a new message.
  */
 export const CriticalElementsResponseSchema: GenMessage<CriticalElementsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 83);
+  messageDesc(file_logistics_gateway_v1_gateway, 92);
 
 /**
  * @generated from message logistics.gateway.v1.CriticalEdge
@@ -3033,7 +3510,7 @@ export type CriticalEdge = Message<"logistics.gateway.v1.CriticalEdge"> & {
  * Use `create(CriticalEdgeSchema)` to create a new message.
  */
 export const CriticalEdgeSchema: GenMessage<CriticalEdge> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 84);
+  messageDesc(file_logistics_gateway_v1_gateway, 93);
 
 /**
  * @generated from message logistics.gateway.v1.CriticalNode
@@ -3070,7 +3547,7 @@ export type CriticalNode = Message<"logistics.gateway.v1.CriticalNode"> & {
  * Use `create(CriticalNodeSchema)` to create a new message.
  */
 export const CriticalNodeSchema: GenMessage<CriticalNode> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 85);
+  messageDesc(file_logistics_gateway_v1_gateway, 94);
 
 /**
  * @generated from message logistics.gateway.v1.SimulationMetadata
@@ -3112,7 +3589,7 @@ export type SimulationMetadata = Message<"logistics.gateway.v1.SimulationMetadat
  * Use `create(SimulationMetadataSchema)` to create a new message.
  */
 export const SimulationMetadataSchema: GenMessage<SimulationMetadata> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 86);
+  messageDesc(file_logistics_gateway_v1_gateway, 95);
 
 /**
  * @generated from message logistics.gateway.v1.GetSimulationRequest
@@ -3129,7 +3606,7 @@ export type GetSimulationRequest = Message<"logistics.gateway.v1.GetSimulationRe
  * Use `create(GetSimulationRequestSchema)` to create a new message.
  */
 export const GetSimulationRequestSchema: GenMessage<GetSimulationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 87);
+  messageDesc(file_logistics_gateway_v1_gateway, 96);
 
 /**
  * @generated from message logistics.gateway.v1.ListSimulationsRequest
@@ -3156,7 +3633,7 @@ export type ListSimulationsRequest = Message<"logistics.gateway.v1.ListSimulatio
  * Use `create(ListSimulationsRequestSchema)` to create a new message.
  */
 export const ListSimulationsRequestSchema: GenMessage<ListSimulationsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 88);
+  messageDesc(file_logistics_gateway_v1_gateway, 97);
 
 /**
  * @generated from message logistics.gateway.v1.ListSimulationsResponse
@@ -3183,7 +3660,7 @@ export type ListSimulationsResponse = Message<"logistics.gateway.v1.ListSimulati
  * Use `create(ListSimulationsResponseSchema)` to create a new message.
  */
 export const ListSimulationsResponseSchema: GenMessage<ListSimulationsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 89);
+  messageDesc(file_logistics_gateway_v1_gateway, 98);
 
 /**
  * @generated from message logistics.gateway.v1.SimulationRecord
@@ -3225,7 +3702,7 @@ export type SimulationRecord = Message<"logistics.gateway.v1.SimulationRecord">
  * Use `create(SimulationRecordSchema)` to create a new message.
  */
 export const SimulationRecordSchema: GenMessage<SimulationRecord> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 90);
+  messageDesc(file_logistics_gateway_v1_gateway, 99);
 
 /**
  * @generated from message logistics.gateway.v1.DeleteSimulationRequest
@@ -3242,7 +3719,7 @@ export type DeleteSimulationRequest = Message<"logistics.gateway.v1.DeleteSimula
  * Use `create(DeleteSimulationRequestSchema)` to create a new message.
  */
 export const DeleteSimulationRequestSchema: GenMessage<DeleteSimulationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 91);
+  messageDesc(file_logistics_gateway_v1_gateway, 100);
 
 /**
  * @generated from message logistics.gateway.v1.SaveCalculationRequest
@@ -3274,7 +3751,7 @@ export type SaveCalculationRequest = Message<"logistics.gateway.v1.SaveCalculati
  * Use `create(SaveCalculationRequestSchema)` to create a new message.
  */
 export const SaveCalculationRequestSchema: GenMessage<SaveCalculationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 92);
+  messageDesc(file_logistics_gateway_v1_gateway, 101);
 
 /**
  * @generated from message logistics.gateway.v1.SaveCalculationResponse
@@ -3296,7 +3773,7 @@ export type SaveCalculationResponse = Message<"logistics.gateway.v1.SaveCalculat
  * Use `create(SaveCalculationResponseSchema)` to create a new message.
  */
 export const SaveCalculationResponseSchema: GenMessage<SaveCalculationResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 93);
+  messageDesc(file_logistics_gateway_v1_gateway, 102);
 
 /**
  * @generated from message logistics.gateway.v1.GetCalculationRequest
@@ -3313,7 +3790,7 @@ export type GetCalculationRequest = Message<"logistics.gateway.v1.GetCalculation
  * Use `create(GetCalculationRequestSchema)` to create a new message.
  */
 export const GetCalculationRequestSchema: GenMessage<GetCalculationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 94);
+  messageDesc(file_logistics_gateway_v1_gateway, 103);
 
 /**
  * @generated from message logistics.gateway.v1.ListCalculationsRequest
@@ -3365,7 +3842,7 @@ export type ListCalculationsRequest = Message<"logistics.gateway.v1.ListCalculat
  * Use `create(ListCalculationsRequestSchema)` to create a new message.
  */
 export const ListCalculationsRequestSchema: GenMessage<ListCalculationsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 95);
+  messageDesc(file_logistics_gateway_v1_gateway, 104);
 
 /**
  * @generated from message logistics.gateway.v1.ListCalculationsResponse
@@ -3392,7 +3869,7 @@ export type ListCalculationsResponse = Message<"logistics.gateway.v1.ListCalcula
  * Use `create(ListCalculationsResponseSchema)` to create a new message.
  */
 export const ListCalculationsResponseSchema: GenMessage<ListCalculationsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 96);
+  messageDesc(file_logistics_gateway_v1_gateway, 105);
 
 /**
  * @generated from message logistics.gateway.v1.CalculationRecord
@@ -3434,7 +3911,7 @@ export type CalculationRecord = Message<"logistics.gateway.v1.CalculationRecord"
  * Use `create(CalculationRecordSchema)` to create a new message.
  */
 export const CalculationRecordSchema: GenMessage<CalculationRecord> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 97);
+  messageDesc(file_logistics_gateway_v1_gateway, 106);
 
 /**
  * @generated from message logistics.gateway.v1.CalculationSummary
@@ -3496,7 +3973,7 @@ export type CalculationSummary = Message<"logistics.gateway.v1.CalculationSummar
  * Use `create(CalculationSummarySchema)` to create a new message.
  */
 export const CalculationSummarySchema: GenMessage<CalculationSummary> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 98);
+  messageDesc(file_logistics_gateway_v1_gateway, 107);
 
 /**
  * @generated from message logistics.gateway.v1.DeleteCalculationRequest
@@ -3513,7 +3990,7 @@ export type DeleteCalculationRequest = Message<"logistics.gateway.v1.DeleteCalcu
  * Use `create(DeleteCalculationRequestSchema)` to create a new message.
  */
 export const DeleteCalculationRequestSchema: GenMessage<DeleteCalculationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 99);
+  messageDesc(file_logistics_gateway_v1_gateway, 108);
 
 /**
  * @generated from message logistics.gateway.v1.GetStatisticsRequest
@@ -3535,7 +4012,7 @@ export type GetStatisticsRequest = Message<"logistics.gateway.v1.GetStatisticsRe
  * Use `create(GetStatisticsRequestSchema)` to create a new message.
  */
 export const GetStatisticsRequestSchema: GenMessage<GetStatisticsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 100);
+  messageDesc(file_logistics_gateway_v1_gateway, 109);
 
 /**
  * @generated from message logistics.gateway.v1.StatisticsResponse
@@ -3577,7 +4054,7 @@ export type StatisticsResponse = Message<"logistics.gateway.v1.StatisticsRespons
  * Use `create(StatisticsResponseSchema)` to create a new message.
  */
 export const StatisticsResponseSchema: GenMessage<StatisticsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 101);
+  messageDesc(file_logistics_gateway_v1_gateway, 110);
 
 /**
  * @generated from message logistics.gateway.v1.DailyStats
@@ -3604,7 +4081,7 @@ export type DailyStats = Message<"logistics.gateway.v1.DailyStats"> & {
  * Use `create(DailyStatsSchema)` to create a new message.
  */
 export const DailyStatsSchema: GenMessage<DailyStats> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 102);
+  messageDesc(file_logistics_gateway_v1_gateway, 111);
 
 /**
  * @generated from message logistics.gateway.v1.GenerateReportRequest
@@ -3662,7 +4139,7 @@ export type GenerateReportRequest = Message<"logistics.gateway.v1.GenerateReport
  * Use `create(GenerateReportRequestSchema)` to create a new message.
  */
 export const GenerateReportRequestSchema: GenMessage<GenerateReportRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 103);
+  messageDesc(file_logistics_gateway_v1_gateway, 112);
 
 /**
  * @generated from message logistics.gateway.v1.ReportOptions
@@ -3759,7 +4236,7 @@ export type ReportOptions = Message<"logistics.gateway.v1.ReportOptions"> & {
  * Use `create(ReportOptionsSchema)` to create a new message.
  */
 export const ReportOptionsSchema: GenMessage<ReportOptions> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 104);
+  messageDesc(file_logistics_gateway_v1_gateway, 113);
 
 /**
  * @generated from message logistics.gateway.v1.FlowReportSource
@@ -3786,7 +4263,7 @@ export type FlowReportSource = Message<"logistics.gateway.v1.FlowReportSource">
  * Use `create(FlowReportSourceSchema)` to create a new message.
  */
 export const FlowReportSourceSchema: GenMessage<FlowReportSource> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 105);
+  messageDesc(file_logistics_gateway_v1_gateway, 114);
 
 /**
  * @generated from message logistics.gateway.v1.AnalyticsReportSource
@@ -3808,7 +4285,7 @@ export type AnalyticsReportSource = Message<"logistics.gateway.v1.AnalyticsRepor
  * Use `create(AnalyticsReportSourceSchema)` to create a new message.
  */
 export const AnalyticsReportSourceSchema: GenMessage<AnalyticsReportSource> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 106);
+  messageDesc(file_logistics_gateway_v1_gateway, 115);
 
 /**
  * @generated from message logistics.gateway.v1.SimulationReportSource
@@ -3835,7 +4312,7 @@ export type SimulationReportSource = Message<"logistics.gateway.v1.SimulationRep
  * Use `create(SimulationReportSourceSchema)` to create a new message.
  */
 export const SimulationReportSourceSchema: GenMessage<SimulationReportSource> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 107);
+  messageDesc(file_logistics_gateway_v1_gateway, 116);
 
 /**
  * @generated from message logistics.gateway.v1.HistoryReportSource
@@ -3862,7 +4339,7 @@ export type HistoryReportSource = Message<"logistics.gateway.v1.HistoryReportSou
  * Use `create(HistoryReportSourceSchema)` to create a new message.
  */
 export const HistoryReportSourceSchema: GenMessage<HistoryReportSource> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 108);
+  messageDesc(file_logistics_gateway_v1_gateway, 117);
 
 /**
  * @generated from message logistics.gateway.v1.GenerateReportResponse
@@ -3894,7 +4371,7 @@ export type GenerateReportResponse = Message<"logistics.gateway.v1.GenerateRepor
  * Use `create(GenerateReportResponseSchema)` to create a new message.
  */
 export const GenerateReportResponseSchema: GenMessage<GenerateReportResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 109);
+  messageDesc(file_logistics_gateway_v1_gateway, 118);
 
 /**
  * @generated from message logistics.gateway.v1.ReportInfo
@@ -3951,7 +4428,7 @@ export type ReportInfo = Message<"logistics.gateway.v1.ReportInfo"> & {
  * Use `create(ReportInfoSchema)` to create a new message.
  */
 export const ReportInfoSchema: GenMessage<ReportInfo> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 110);
+  messageDesc(file_logistics_gateway_v1_gateway, 119);
 
 /**
  * @generated from message logistics.gateway.v1.GetReportRequest
@@ -3968,7 +4445,7 @@ export type GetReportRequest = Message<"logistics.gateway.v1.GetReportRequest">
  * Use `create(GetReportRequestSchema)` to create a new message.
  */
 export const GetReportRequestSchema: GenMessage<GetReportRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 111);
+  messageDesc(file_logistics_gateway_v1_gateway, 120);
 
 /**
  * @generated from message logistics.gateway.v1.DownloadReportRequest
@@ -3985,7 +4462,7 @@ export type DownloadReportRequest = Message<"logistics.gateway.v1.DownloadReport
  * Use `create(DownloadReportRequestSchema)` to create a new message.
  */
 export const DownloadReportRequestSchema: GenMessage<DownloadReportRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 112);
+  messageDesc(file_logistics_gateway_v1_gateway, 121);
 
 /**
  * @generated from message logistics.gateway.v1.ReportChunk
@@ -4017,7 +4494,7 @@ export type ReportChunk = Message<"logistics.gateway.v1.ReportChunk"> & {
  * Use `create(ReportChunkSchema)` to create a new message.
  */
 export const ReportChunkSchema: GenMessage<ReportChunk> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 113);
+  messageDesc(file_logistics_gateway_v1_gateway, 122);
 
 /**
  * @generated from message logistics.gateway.v1.ReportRecord
@@ -4044,7 +4521,7 @@ export type ReportRecord = Message<"logistics.gateway.v1.ReportRecord"> & {
  * Use `create(ReportRecordSchema)` to create a new message.
  */
 export const ReportRecordSchema: GenMessage<ReportRecord> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 114);
+  messageDesc(file_logistics_gateway_v1_gateway, 123);
 
 /**
  * @generated from message logistics.gateway.v1.ListReportsRequest
@@ -4086,7 +4563,7 @@ export type ListReportsRequest = Message<"logistics.gateway.v1.ListReportsReques
  * Use `create(ListReportsRequestSchema)` to create a new message.
  */
 export const ListReportsRequestSchema: GenMessage<ListReportsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 115);
+  messageDesc(file_logistics_gateway_v1_gateway, 124);
 
 /**
  * @generated from message logistics.gateway.v1.ListReportsResponse
@@ -4113,7 +4590,7 @@ export type ListReportsResponse = Message<"logistics.gateway.v1.ListReportsRespo
  * Use `create(ListReportsResponseSchema)` to create a new message.
  */
 export const ListReportsResponseSchema: GenMessage<ListReportsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 116);
+  messageDesc(file_logistics_gateway_v1_gateway, 125);
 
 /**
  * @generated from message logistics.gateway.v1.DeleteReportRequest
@@ -4130,7 +4607,7 @@ export type DeleteReportRequest = Message<"logistics.gateway.v1.DeleteReportRequ
  * Use `create(DeleteReportRequestSchema)` to create a new message.
  */
 export const DeleteReportRequestSchema: GenMessage<DeleteReportRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 117);
+  messageDesc(file_logistics_gateway_v1_gateway, 126);
 
 /**
  * @generated from message logistics.gateway.v1.ReportFormatsResponse
@@ -4147,7 +4624,7 @@ export type ReportFormatsResponse = Message<"logistics.gateway.v1.ReportFormatsR
  * Use `create(ReportFormatsResponseSchema)` to create a new message.
  */
 export const ReportFormatsResponseSchema: GenMessage<ReportFormatsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 118);
+  messageDesc(file_logistics_gateway_v1_gateway, 127);
 
 /**
  * @generated from message logistics.gateway.v1.ReportFormatInfo
@@ -4194,7 +4671,7 @@ export type ReportFormatInfo = Message<"logistics.gateway.v1.ReportFormatInfo">
  * Use `create(ReportFormatInfoSchema)` to create a new message.
  */
 export const ReportFormatInfoSchema: GenMessage<ReportFormatInfo> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 119);
+  messageDesc(file_logistics_gateway_v1_gateway, 128);
 
 /**
  * @generated from message logistics.gateway.v1.GetAuditLogsRequest
@@ -4246,7 +4723,7 @@ export type GetAuditLogsRequest = Message<"logistics.gateway.v1.GetAuditLogsRequ
  * Use `create(GetAuditLogsRequestSchema)` to create a new message.
  */
 export const GetAuditLogsRequestSchema: GenMessage<GetAuditLogsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 120);
+  messageDesc(file_logistics_gateway_v1_gateway, 129);
 
 /**
  * @generated from message logistics.gateway.v1.AuditLogsResponse
@@ -4273,7 +4750,7 @@ export type AuditLogsResponse = Message<"logistics.gateway.v1.AuditLogsResponse"
  * Use `create(AuditLogsResponseSchema)` to create a new message.
  */
 export const AuditLogsResponseSchema: GenMessage<AuditLogsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 121);
+  messageDesc(file_logistics_gateway_v1_gateway, 130);
 
 /**
  * @generated from message logistics.gateway.v1.AuditEntry
@@ -4355,7 +4832,7 @@ export type AuditEntry = Message<"logistics.gateway.v1.AuditEntry"> & {
  * Use `create(AuditEntrySchema)` to create a new message.
  */
 export const AuditEntrySchema: GenMessage<AuditEntry> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 122);
+  messageDesc(file_logistics_gateway_v1_gateway, 131);
 
 /**
  * @generated from message logistics.gateway.v1.GetUserActivityRequest
@@ -4392,7 +4869,7 @@ export type GetUserActivityRequest = Message<"logistics.gateway.v1.GetUserActivi
  * Use `create(GetUserActivityRequestSchema)` to create a new message.
  */
 export const GetUserActivityRequestSchema: GenMessage<GetUserActivityRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 123);
+  messageDesc(file_logistics_gateway_v1_gateway, 132);
 
 /**
  * @generated from message logistics.gateway.v1.UserActivityResponse
@@ -4419,7 +4896,7 @@ export type UserActivityResponse = Message<"logistics.gateway.v1.UserActivityRes
  * Use `create(UserActivityResponseSchema)` to create a new message.
  */
 export const UserActivityResponseSchema: GenMessage<UserActivityResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 124);
+  messageDesc(file_logistics_gateway_v1_gateway, 133);
 
 /**
  * @generated from message logistics.gateway.v1.UserActivitySummary
@@ -4466,7 +4943,7 @@ export type UserActivitySummary = Message<"logistics.gateway.v1.UserActivitySumm
  * Use `create(UserActivitySummarySchema)` to create a new message.
  */
 export const UserActivitySummarySchema: GenMessage<UserActivitySummary> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 125);
+  messageDesc(file_logistics_gateway_v1_gateway, 134);
 
 /**
  * @generated from message logistics.gateway.v1.GetAuditStatsRequest
@@ -4493,7 +4970,7 @@ export type GetAuditStatsRequest = Message<"logistics.gateway.v1.GetAuditStatsRe
  * Use `create(GetAuditStatsRequestSchema)` to create a new message.
  */
 export const GetAuditStatsRequestSchema: GenMessage<GetAuditStatsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 126);
+  messageDesc(file_logistics_gateway_v1_gateway, 135);
 
 /**
  * @generated from message logistics.gateway.v1.AuditStatsResponse
@@ -4540,7 +5017,7 @@ export type AuditStatsResponse = Message<"logistics.gateway.v1.AuditStatsRespons
  * Use `create(AuditStatsResponseSchema)` to create a new message.
  */
 export const AuditStatsResponseSchema: GenMessage<AuditStatsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 127);
+  messageDesc(file_logistics_gateway_v1_gateway, 136);
 
 /**
  * @generated from message logistics.gateway.v1.AuditStatsPoint
@@ -4572,7 +5049,7 @@ export type AuditStatsPoint = Message<"logistics.gateway.v1.AuditStatsPoint"> &
  * Use `create(AuditStatsPointSchema)` to create a new message.
  */
 export const AuditStatsPointSchema: GenMessage<AuditStatsPoint> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 128);
+  messageDesc(file_logistics_gateway_v1_gateway, 137);
 
 /**
  * @generated from message logistics.gateway.v1.RequestMetadata
@@ -4634,7 +5111,35 @@ export type RequestMetadata = Message<"logistics.gateway.v1.RequestMetadata"> &
  * Use `create(RequestMetadataSchema)` to create a new message.
  */
 export const RequestMetadataSchema: GenMessage<RequestMetadata> = /*@__PURE__*/
-  messageDesc(file_logistics_gateway_v1_gateway, 129);
+  messageDesc(file_logistics_gateway_v1_gateway, 138);
+
+/**
+ * @generated from enum logistics.gateway.v1.SolveMode
+ */
+export enum SolveMode {
+  /**
+   * @generated from enum value: SOLVE_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SOLVE_MODE_MAX_FLOW = 1;
+   */
+  MAX_FLOW = 1,
+
+  /**
+   * supply/demand как жёсткие ограничения, минимальная стоимость
+   *
+   * @generated from enum value: SOLVE_MODE_TRANSPORTATION = 2;
+   */
+  TRANSPORTATION = 2,
+}
+
+/**
+ * Describes the enum logistics.gateway.v1.SolveMode.
+ */
+export const SolveModeSchema: GenEnum<SolveMode> = /*@__PURE__*/
+  enumDesc(file_logistics_gateway_v1_gateway, 0);
 
 /**
  * @generated from enum logistics.gateway.v1.ValidationLevel
@@ -4670,7 +5175,7 @@ export enum ValidationLevel {
  * Describes the enum logistics.gateway.v1.ValidationLevel.
  */
 export const ValidationLevelSchema: GenEnum<ValidationLevel> = /*@__PURE__*/
-  enumDesc(file_logistics_gateway_v1_gateway, 0);
+  enumDesc(file_logistics_gateway_v1_gateway, 1);
 
 /**
  * @generated from enum logistics.gateway.v1.BottleneckSeverity
@@ -4706,7 +5211,7 @@ export enum BottleneckSeverity {
  * Describes the enum logistics.gateway.v1.BottleneckSeverity.
  */
 export const BottleneckSeveritySchema: GenEnum<BottleneckSeverity> = /*@__PURE__*/
-  enumDesc(file_logistics_gateway_v1_gateway, 1);
+  enumDesc(file_logistics_gateway_v1_gateway, 2);
 
 /**
  * @generated from enum logistics.gateway.v1.ModificationType
@@ -4747,7 +5252,7 @@ export enum ModificationType {
  * Describes the enum logistics.gateway.v1.ModificationType.
  */
 export const ModificationTypeSchema: GenEnum<ModificationType> = /*@__PURE__*/
-  enumDesc(file_logistics_gateway_v1_gateway, 2);
+  enumDesc(file_logistics_gateway_v1_gateway, 3);
 
 /**
  * @generated from enum logistics.gateway.v1.ModificationTarget
@@ -4778,7 +5283,7 @@ export enum ModificationTarget {
  * Describes the enum logistics.gateway.v1.ModificationTarget.
  */
 export const ModificationTargetSchema: GenEnum<ModificationTarget> = /*@__PURE__*/
-  enumDesc(file_logistics_gateway_v1_gateway, 3);
+  enumDesc(file_logistics_gateway_v1_gateway, 4);
 
 /**
  * @generated from enum logistics.gateway.v1.ImpactLevel
@@ -4819,7 +5324,114 @@ export enum ImpactLevel {
  * Describes the enum logistics.gateway.v1.ImpactLevel.
  */
 export const ImpactLevelSchema: GenEnum<ImpactLevel> = /*@__PURE__*/
-  enumDesc(file_logistics_gateway_v1_gateway, 4);
+  enumDesc(file_logistics_gateway_v1_gateway, 5);
+
+/**
+ * @generated from enum logistics.gateway.v1.CorrelationMeasure
+ */
+export enum CorrelationMeasure {
+  /**
+   * Same as CORRELATION_MEASURE_GAUSSIAN
+   *
+   * @generated from enum value: CORRELATION_MEASURE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CORRELATION_MEASURE_GAUSSIAN = 1;
+   */
+  GAUSSIAN = 1,
+
+  /**
+   * @generated from enum value: CORRELATION_MEASURE_SPEARMAN = 2;
+   */
+  SPEARMAN = 2,
+
+  /**
+   * @generated from enum value: CORRELATION_MEASURE_KENDALL = 3;
+   */
+  KENDALL = 3,
+}
+
+/**
+ * Describes the enum logistics.gateway.v1.CorrelationMeasure.
+ */
+export const CorrelationMeasureSchema: GenEnum<CorrelationMeasure> = /*@__PURE__*/
+  enumDesc(file_logistics_gateway_v1_gateway, 6);
+
+/**
+ * @generated from enum logistics.gateway.v1.MonteCarloStopReason
+ */
+export enum MonteCarloStopReason {
+  /**
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_COMPLETED = 1;
+   */
+  COMPLETED = 1,
+
+  /**
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_CONVERGED = 2;
+   */
+  CONVERGED = 2,
+
+  /**
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_TIME_BUDGET = 3;
+   */
+  TIME_BUDGET = 3,
+
+  /**
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_CANCELED = 4;
+   */
+  CANCELED = 4,
+}
+
+/**
+ * Describes the enum logistics.gateway.v1.MonteCarloStopReason.
+ */
+export const MonteCarloStopReasonSchema: GenEnum<MonteCarloStopReason> = /*@__PURE__*/
+  enumDesc(file_logistics_gateway_v1_gateway, 7);
+
+/**
+ * @generated from enum logistics.gateway.v1.SamplingMethod
+ */
+export enum SamplingMethod {
+  /**
+   * Same as SAMPLING_METHOD_RANDOM
+   *
+   * @generated from enum value: SAMPLING_METHOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SAMPLING_METHOD_RANDOM = 1;
+   */
+  RANDOM = 1,
+
+  /**
+   * @generated from enum value: SAMPLING_METHOD_LATIN_HYPERCUBE = 2;
+   */
+  LATIN_HYPERCUBE = 2,
+
+  /**
+   * @generated from enum value: SAMPLING_METHOD_SOBOL = 3;
+   */
+  SOBOL = 3,
+
+  /**
+   * @generated from enum value: SAMPLING_METHOD_ANTITHETIC = 4;
+   */
+  ANTITHETIC = 4,
+}
+
+/**
+ * Describes the enum logistics.gateway.v1.SamplingMethod.
+ */
+export const SamplingMethodSchema: GenEnum<SamplingMethod> = /*@__PURE__*/
+  enumDesc(file_logistics_gateway_v1_gateway, 8);
 
 /**
  * @generated from enum logistics.gateway.v1.DistributionType
@@ -4850,7 +5462,44 @@ export enum DistributionType {
  * Describes the enum logistics.gateway.v1.DistributionType.
  */
 export const DistributionTypeSchema: GenEnum<DistributionType> = /*@__PURE__*/
-  enumDesc(file_logistics_gateway_v1_gateway, 5);
+  enumDesc(file_logistics_gateway_v1_gateway, 9);
+
+/**
+ * @generated from enum logistics.gateway.v1.SensitivityMethod
+ */
+export enum SensitivityMethod {
+  /**
+   * Same as SENSITIVITY_METHOD_ONE_AT_A_TIME
+   *
+   * @generated from enum value: SENSITIVITY_METHOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SENSITIVITY_METHOD_ONE_AT_A_TIME = 1;
+   */
+  ONE_AT_A_TIME = 1,
+
+  /**
+   * Elementary effects screening
+   *
+   * @generated from enum value: SENSITIVITY_METHOD_MORRIS = 2;
+   */
+  MORRIS = 2,
+
+  /**
+   * Variance-based first- and total-order indices
+   *
+   * @generated from enum value: SENSITIVITY_METHOD_SOBOL = 3;
+   */
+  SOBOL = 3,
+}
+
+/**
+ * Describes the enum logistics.gateway.v1.SensitivityMethod.
+ */
+export const SensitivityMethodSchema: GenEnum<SensitivityMethod> = /*@__PURE__*/
+  enumDesc(file_logistics_gateway_v1_gateway, 10);
 
 /**
  * @generated from enum logistics.gateway.v1.ReportFormat
@@ -4896,7 +5545,7 @@ export enum ReportFormat {
  * Describes the enum logistics.gateway.v1.ReportFormat.
  */
 export const ReportFormatSchema: GenEnum<ReportFormat> = /*@__PURE__*/
-  enumDesc(file_logistics_gateway_v1_gateway, 6);
+  enumDesc(file_logistics_gateway_v1_gateway, 11);
 
 /**
  * @generated from enum logistics.gateway.v1.ReportType
@@ -4942,7 +5591,7 @@ export enum ReportType {
  * Describes the enum logistics.gateway.v1.ReportType.
  */
 export const ReportTypeSchema: GenEnum<ReportType> = /*@__PURE__*/
-  enumDesc(file_logistics_gateway_v1_gateway, 7);
+  enumDesc(file_logistics_gateway_v1_gateway, 12);
 
 /**
  * @generated from service logistics.gateway.v1.GatewayService
@@ -2,11 +2,11 @@
 // @generated from file logistics/optimization/v1/solver.proto (package logistics.optimization.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { EmptySchema } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_empty } from "@bufbuild/protobuf/wkt";
-import type { Algorithm, FlowResult, Graph, Path } from "../../common/v1/common_pb";
+import type { Algorithm, FlowEdge, FlowResult, FlowStatus, Graph, MinCut, NodeBalance, Path } from "../../common/v1/common_pb";
 import { file_logistics_common_v1_common } from "../../common/v1/common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file logistics/optimization/v1/solver.proto.
  */
 export const file_logistics_optimization_v1_solver: GenFile = /*@__PURE__*/
-  fileDesc("CiZsb2dpc3RpY3Mvb3B0aW1pemF0aW9uL3YxL3NvbHZlci5wcm90bxIZbG9naXN0aWNzLm9wdGltaXphdGlvbi52MSKmAQoMU29sdmVSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIxCglhbGdvcml0aG0YAiABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRI4CgdvcHRpb25zGAMgASgLMicubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5Tb2x2ZU9wdGlvbnMisgEKGFNvbHZlUmVxdWVzdEZvckJpZ0dyYXBocxIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSMQoJYWxnb3JpdGhtGAIgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SOAoHb3B0aW9ucxgDIAEoCzInLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuU29sdmVPcHRpb25zImYKDFNvbHZlT3B0aW9ucxIXCg90aW1lb3V0X3NlY29uZHMYASABKAESFAoMcmV0dXJuX3BhdGhzGAIgASgIEhYKDm1heF9pdGVyYXRpb25zGAMgASgFEg8KB2Vwc2lsb24YBCABKAEi1AEKDVNvbHZlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIvCgZyZXN1bHQYAiABKAsyHy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dSZXN1bHQSMAoMc29sdmVkX2dyYXBoGAMgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI4CgdtZXRyaWNzGAQgASgLMicubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5Tb2x2ZU1ldHJpY3MSFQoNZXJyb3JfbWVzc2FnZRgFIAEoCSJ6CgxTb2x2ZU1ldHJpY3MSGwoTY29tcHV0YXRpb25fdGltZV9tcxgBIAEoARISCgppdGVyYXRpb25zGAIgASgFEh4KFmF1Z21lbnRpbmdfcGF0aHNfZm91bmQYAyABKAUSGQoRbWVtb3J5X3VzZWRfYnl0ZXMYBCABKAMiyAEKDVNvbHZlUHJvZ3Jlc3MSEQoJaXRlcmF0aW9uGAEgASgFEhQKDGN1cnJlbnRfZmxvdxgCIAEoARIYChBwcm9ncmVzc19wZXJjZW50GAMgASgBEg4KBnN0YXR1cxgEIAEoCRIsCglsYXN0X3BhdGgYBSABKAsyGS5sb2dpc3RpY3MuY29tbW9uLnYxLlBhdGgSGwoTY29tcHV0YXRpb25fdGltZV9tcxgGIAEoARIZChFtZW1vcnlfdXNlZF9ieXRlcxgHIAEoAyJVChVHZXRBbGdvcml0aG1zUmVzcG9uc2USPAoKYWxnb3JpdGhtcxgBIAMoCzIoLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuQWxnb3JpdGhtSW5mbyLmAQoNQWxnb3JpdGhtSW5mbxIxCglhbGdvcml0aG0YASABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhcKD3RpbWVfY29tcGxleGl0eRgEIAEoCRIYChBzcGFjZV9jb21wbGV4aXR5GAUgASgJEhkKEXN1cHBvcnRzX21pbl9jb3N0GAYgASgIEh8KF3N1cHBvcnRzX25lZ2F0aXZlX2Nvc3RzGAcgASgIEhAKCGJlc3RfZm9yGAggAygJMrYCCg1Tb2x2ZXJTZXJ2aWNlEloKBVNvbHZlEicubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5Tb2x2ZVJlcXVlc3QaKC5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlUmVzcG9uc2USbgoLU29sdmVTdHJlYW0SMy5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlUmVxdWVzdEZvckJpZ0dyYXBocxooLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuU29sdmVQcm9ncmVzczABElkKDUdldEFsZ29yaXRobXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaMC5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLkdldEFsZ29yaXRobXNSZXNwb25zZULtAQodY29tLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjFCC1NvbHZlclByb3RvUAFaOWxvZ2lzdGljcy9nZW4vZ28vbG9naXN0aWNzL29wdGltaXphdGlvbi92MTtvcHRpbWl6YXRpb252MaICA0xPWKoCGUxvZ2lzdGljcy5PcHRpbWl6YXRpb24uVjHKAhlMb2dpc3RpY3NcT3B0aW1pemF0aW9uXFYx4gIlTG9naXN0aWNzXE9wdGltaXphdGlvblxWMVxHUEJNZXRhZGF0YeoCG0xvZ2lzdGljczo6T3B0aW1pemF0aW9uOjpWMWIGcHJvdG8z", [file_google_protobuf_empty, file_logistics_common_v1_common]);
+  fileDesc("CiZsb2dpc3RpY3Mvb3B0aW1pemF0aW9uL3YxL3NvbHZlci5wcm90bxIZbG9naXN0aWNzLm9wdGltaXphdGlvbi52MSKmAQoMU29sdmVSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIxCglhbGdvcml0aG0YAiABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRI4CgdvcHRpb25zGAMgASgLMicubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5Tb2x2ZU9wdGlvbnMisgEKGFNvbHZlUmVxdWVzdEZvckJpZ0dyYXBocxIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSMQoJYWxnb3JpdGhtGAIgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SOAoHb3B0aW9ucxgDIAEoCzInLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuU29sdmVPcHRpb25zIoACCgxTb2x2ZU9wdGlvbnMSFwoPdGltZW91dF9zZWNvbmRzGAEgASgBEhQKDHJldHVybl9wYXRocxgCIAEoCBIWCg5tYXhfaXRlcmF0aW9ucxgDIAEoBRIPCgdlcHNpbG9uGAQgASgBEjIKBG1vZGUYBSABKA4yJC5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlTW9kZRIWCg5yZXR1cm5fbWluX2N1dBgGIAEoCBIuCgp3YXJtX3N0YXJ0GAcgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIcChRyZXR1cm5fZGVjb21wb3NpdGlvbhgIIAEoCCLUAQoNU29sdmVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEi8KBnJlc3VsdBgCIAEoCzIfLmxvZ2lzdGljcy5jb21tb24udjEuRmxvd1Jlc3VsdBIwCgxzb2x2ZWRfZ3JhcGgYAyABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjgKB21ldHJpY3MYBCABKAsyJy5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlTWV0cmljcxIVCg1lcnJvcl9tZXNzYWdlGAUgASgJIpABCgxTb2x2ZU1ldHJpY3MSGwoTY29tcHV0YXRpb25fdGltZV9tcxgBIAEoARISCgppdGVyYXRpb25zGAIgASgFEh4KFmF1Z21lbnRpbmdfcGF0aHNfZm91bmQYAyABKAUSGQoRbWVtb3J5X3VzZWRfYnl0ZXMYBCABKAMSFAoMd2FybV9zdGFydGVkGAUgASgIIqoBChBHZXRNaW5DdXRSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIxCglhbGdvcml0aG0YAiABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRI4CgdvcHRpb25zGAMgASgLMicubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5Tb2x2ZU9wdGlvbnMitQEKEUdldE1pbkN1dFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSLAoHbWluX2N1dBgCIAEoCzIbLmxvZ2lzdGljcy5jb21tb24udjEuTWluQ3V0EhAKCG1heF9mbG93GAMgASgBEjgKB21ldHJpY3MYBCABKAsyJy5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlTWV0cmljcxIVCg1lcnJvcl9tZXNzYWdlGAUgASgJIuABCglDb21tb2RpdHkSCgoCaWQYASABKAMSDAoEbmFtZRgCIAEoCRIRCglzb3VyY2VfaWQYAyABKAMSDwoHc2lua19pZBgEIAEoAxIOCgZkZW1hbmQYBSABKAESTwoOY29zdF9vdmVycmlkZXMYBiADKAsyNy5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLkNvbW1vZGl0eS5Db3N0T3ZlcnJpZGVzRW50cnkaNAoSQ29zdE92ZXJyaWRlc0VudHJ5EgsKA2tleRgBIAEoAxINCgV2YWx1ZRgCIAEoAToCOAEiWwoVTXVsdGlDb21tb2RpdHlPcHRpb25zEhcKD3RpbWVvdXRfc2Vjb25kcxgBIAEoARIVCg1hcHByb3hpbWF0aW9uGAIgASgBEhIKCm1heF9waGFzZXMYAyABKAUixQEKGlNvbHZlTXVsdGlDb21tb2RpdHlSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI5Cgtjb21tb2RpdGllcxgCIAMoCzIkLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuQ29tbW9kaXR5EkEKB29wdGlvbnMYAyABKAsyMC5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLk11bHRpQ29tbW9kaXR5T3B0aW9ucyLAAQobU29sdmVNdWx0aUNvbW1vZGl0eVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSPwoGcmVzdWx0GAIgASgLMi8ubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5NdWx0aUNvbW1vZGl0eVJlc3VsdBI4CgdtZXRyaWNzGAMgASgLMicubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5Tb2x2ZU1ldHJpY3MSFQoNZXJyb3JfbWVzc2FnZRgEIAEoCSL2AQoUTXVsdGlDb21tb2RpdHlSZXN1bHQSPQoLY29tbW9kaXRpZXMYASADKAsyKC5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLkNvbW1vZGl0eUZsb3cSGAoQY29uY3VycmVudF9yYXRpbxgCIAEoARISCgp0b3RhbF9jb3N0GAMgASgBEiwKBWVkZ2VzGAQgAygLMh0ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93RWRnZRIvCgZzdGF0dXMYBSABKA4yHy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dTdGF0dXMSEgoKaXRlcmF0aW9ucxgGIAEoBSJ/Cg1Db21tb2RpdHlGbG93EhQKDGNvbW1vZGl0eV9pZBgBIAEoAxIOCgZkZW1hbmQYAiABKAESDAoEZmxvdxgDIAEoARIMCgRjb3N0GAQgASgBEiwKBWVkZ2VzGAUgAygLMh0ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93RWRnZSK8AgoNU29sdmVQcm9ncmVzcxIRCglpdGVyYXRpb24YASABKAUSFAoMY3VycmVudF9mbG93GAIgASgBEhgKEHByb2dyZXNzX3BlcmNlbnQYAyABKAESDgoGc3RhdHVzGAQgASgJEiwKCWxhc3RfcGF0aBgFIAEoCzIZLmxvZ2lzdGljcy5jb21tb24udjEuUGF0aBIbChNjb21wdXRhdGlvbl90aW1lX21zGAYgASgBEhkKEW1lbW9yeV91c2VkX2J5dGVzGAcgASgDEjkKD3NvdXJjZV9iYWxhbmNlcxgIIAMoCzIgLmxvZ2lzdGljcy5jb21tb24udjEuTm9kZUJhbGFuY2USNwoNc2lua19iYWxhbmNlcxgJIAMoCzIgLmxvZ2lzdGljcy5jb21tb24udjEuTm9kZUJhbGFuY2UiVQoVR2V0QWxnb3JpdGhtc1Jlc3BvbnNlEjwKCmFsZ29yaXRobXMYASADKAsyKC5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLkFsZ29yaXRobUluZm8i5gEKDUFsZ29yaXRobUluZm8SMQoJYWxnb3JpdGhtGAEgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIXCg90aW1lX2NvbXBsZXhpdHkYBCABKAkSGAoQc3BhY2VfY29tcGxleGl0eRgFIAEoCRIZChFzdXBwb3J0c19taW5fY29zdBgGIAEoCBIfChdzdXBwb3J0c19uZWdhdGl2ZV9jb3N0cxgHIAEoCBIQCghiZXN0X2ZvchgIIAMoCSpfCglTb2x2ZU1vZGUSGgoWU09MVkVfTU9ERV9VTlNQRUNJRklFRBAAEhcKE1NPTFZFX01PREVfTUFYX0ZMT1cQARIdChlTT0xWRV9NT0RFX1RSQU5TUE9SVEFUSU9OEAIypQQKDVNvbHZlclNlcnZpY2USWgoFU29sdmUSJy5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlUmVxdWVzdBooLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuU29sdmVSZXNwb25zZRJuCgtTb2x2ZVN0cmVhbRIzLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuU29sdmVSZXF1ZXN0Rm9yQmlnR3JhcGhzGigubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5Tb2x2ZVByb2dyZXNzMAESWQoNR2V0QWxnb3JpdGhtcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRowLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuR2V0QWxnb3JpdGhtc1Jlc3BvbnNlEmYKCUdldE1pbkN1dBIrLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuR2V0TWluQ3V0UmVxdWVzdBosLmxvZ2lzdGljcy5vcHRpbWl6YXRpb24udjEuR2V0TWluQ3V0UmVzcG9uc2UShAEKE1NvbHZlTXVsdGlDb21tb2RpdHkSNS5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlTXVsdGlDb21tb2RpdHlSZXF1ZXN0GjYubG9naXN0aWNzLm9wdGltaXphdGlvbi52MS5Tb2x2ZU11bHRpQ29tbW9kaXR5UmVzcG9uc2VC7QEKHWNvbS5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxQgtTb2x2ZXJQcm90b1ABWjlsb2dpc3RpY3MvZ2VuL2dvL2xvZ2lzdGljcy9vcHRpbWl6YXRpb24vdjE7b3B0aW1pemF0aW9udjGiAgNMT1iqAhlMb2dpc3RpY3MuT3B0aW1pemF0aW9uLlYxygIZTG9naXN0aWNzXE9wdGltaXphdGlvblxWMeICJUxvZ2lzdGljc1xPcHRpbWl6YXRpb25cVjFcR1BCTWV0YWRhdGHqAhtMb2dpc3RpY3M6Ok9wdGltaXphdGlvbjo6VjFiBnByb3RvMw", [file_google_protobuf_empty, file_logistics_common_v1_common]);
 
 /**
  * @generated from message logistics.optimization.v1.SolveRequest
@@ -101,6 +101,38 @@ export type SolveOptions = Message<"logistics.optimization.v1.SolveOptions"> & {
    * @generated from field: double epsilon = 4;
    */
   epsilon: number;
+
+  /**
+   * Постановка задачи (default: максимальный поток)
+   *
+   * @generated from field: logistics.optimization.v1.SolveMode mode = 5;
+   */
+  mode: SolveMode;
+
+  /**
+   * Возвращать минимальный разрез в FlowResult.min_cut (только SOLVE_MODE_MAX_FLOW)
+   *
+   * @generated from field: bool return_min_cut = 6;
+   */
+  returnMinCut: boolean;
+
+  /**
+   * Тёплый старт: solved_graph предыдущего решения. current_flow переносится по id рёбер
+   * (ограничивается новыми пропускными способностями) и достраивается инкрементально.
+   * Игнорируется в SOLVE_MODE_TRANSPORTATION, для алгоритмов минимальной стоимости,
+   * при min_flow рёбер и в SolveStream
+   *
+   * @generated from field: logistics.common.v1.Graph warm_start = 7;
+   */
+  warmStart?: Graph;
+
+  /**
+   * Разложение итогового потока на пути и циклы в FlowResult.decomposition
+   * (для любого алгоритма, в отличие от return_paths)
+   *
+   * @generated from field: bool return_decomposition = 8;
+   */
+  returnDecomposition: boolean;
 };
 
 /**
@@ -172,6 +204,13 @@ export type SolveMetrics = Message<"logistics.optimization.v1.SolveMetrics"> & {
    * @generated from field: int64 memory_used_bytes = 4;
    */
   memoryUsedBytes: bigint;
+
+  /**
+   * Решение достроено из SolveOptions.warm_start
+   *
+   * @generated from field: bool warm_started = 5;
+   */
+  warmStarted: boolean;
 };
 
 /**
@@ -181,6 +220,311 @@ export type SolveMetrics = Message<"logistics.optimization.v1.SolveMetrics"> & {
 export const SolveMetricsSchema: GenMessage<SolveMetrics> = /*@__PURE__*/
   messageDesc(file_logistics_optimization_v1_solver, 4);
 
+/**
+ * @generated from message logistics.optimization.v1.GetMinCutRequest
+ */
+export type GetMinCutRequest = Message<"logistics.optimization.v1.GetMinCutRequest"> & {
+  /**
+   * @generated from field: logistics.common.v1.Graph graph = 1;
+   */
+  graph?: Graph;
+
+  /**
+   * Алгоритм максимального потока
+   *
+   * @generated from field: logistics.common.v1.Algorithm algorithm = 2;
+   */
+  algorithm: Algorithm;
+
+  /**
+   * mode и return_min_cut игнорируются
+   *
+   * @generated from field: logistics.optimization.v1.SolveOptions options = 3;
+   */
+  options?: SolveOptions;
+};
+
+/**
+ * Describes the message logistics.optimization.v1.GetMinCutRequest.
+ * Use `create(GetMinCutRequestSchema)` to create a new message.
+ */
+export const GetMinCutRequestSchema: GenMessage<GetMinCutRequest> = /*@__PURE__*/
+  messageDesc(file_logistics_optimization_v1_solver, 5);
+
+/**
+ * @generated from message logistics.optimization.v1.GetMinCutResponse
+ */
+export type GetMinCutResponse = Message<"logistics.optimization.v1.GetMinCutResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: logistics.common.v1.MinCut min_cut = 2;
+   */
+  minCut?: MinCut;
+
+  /**
+   * @generated from field: double max_flow = 3;
+   */
+  maxFlow: number;
+
+  /**
+   * @generated from field: logistics.optimization.v1.SolveMetrics metrics = 4;
+   */
+  metrics?: SolveMetrics;
+
+  /**
+   * @generated from field: string error_message = 5;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message logistics.optimization.v1.GetMinCutResponse.
+ * Use `create(GetMinCutResponseSchema)` to create a new message.
+ */
+export const GetMinCutResponseSchema: GenMessage<GetMinCutResponse> = /*@__PURE__*/
+  messageDesc(file_logistics_optimization_v1_solver, 6);
+
+/**
+ * Класс товаров (заморозка, сухие, опасные грузы), перевозимый по общей сети
+ *
+ * @generated from message logistics.optimization.v1.Commodity
+ */
+export type Commodity = Message<"logistics.optimization.v1.Commodity"> & {
+  /**
+   * Идентификатор (0 = номер в списке, начиная с 1)
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 source_id = 3;
+   */
+  sourceId: bigint;
+
+  /**
+   * @generated from field: int64 sink_id = 4;
+   */
+  sinkId: bigint;
+
+  /**
+   * Требуемый объём (> 0)
+   *
+   * @generated from field: double demand = 5;
+   */
+  demand: number;
+
+  /**
+   * Стоимость рёбер для этого товара: Edge.id → cost
+   *
+   * @generated from field: map<int64, double> cost_overrides = 6;
+   */
+  costOverrides: { [key: string]: number };
+};
+
+/**
+ * Describes the message logistics.optimization.v1.Commodity.
+ * Use `create(CommoditySchema)` to create a new message.
+ */
+export const CommoditySchema: GenMessage<Commodity> = /*@__PURE__*/
+  messageDesc(file_logistics_optimization_v1_solver, 7);
+
+/**
+ * @generated from message logistics.optimization.v1.MultiCommodityOptions
+ */
+export type MultiCommodityOptions = Message<"logistics.optimization.v1.MultiCommodityOptions"> & {
+  /**
+   * Таймаут (0 = без лимита)
+   *
+   * @generated from field: double timeout_seconds = 1;
+   */
+  timeoutSeconds: number;
+
+  /**
+   * Точность приближения ε (default: 0.1, допустимо 0.05–0.5)
+   *
+   * @generated from field: double approximation = 2;
+   */
+  approximation: number;
+
+  /**
+   * Лимит фаз алгоритма (0 = без лимита)
+   *
+   * @generated from field: int32 max_phases = 3;
+   */
+  maxPhases: number;
+};
+
+/**
+ * Describes the message logistics.optimization.v1.MultiCommodityOptions.
+ * Use `create(MultiCommodityOptionsSchema)` to create a new message.
+ */
+export const MultiCommodityOptionsSchema: GenMessage<MultiCommodityOptions> = /*@__PURE__*/
+  messageDesc(file_logistics_optimization_v1_solver, 8);
+
+/**
+ * Граф задаёт общие пропускные способности; source_id, sink_id, supply и demand узлов
+ * не используются, min_flow рёбер не поддерживается
+ *
+ * @generated from message logistics.optimization.v1.SolveMultiCommodityRequest
+ */
+export type SolveMultiCommodityRequest = Message<"logistics.optimization.v1.SolveMultiCommodityRequest"> & {
+  /**
+   * @generated from field: logistics.common.v1.Graph graph = 1;
+   */
+  graph?: Graph;
+
+  /**
+   * @generated from field: repeated logistics.optimization.v1.Commodity commodities = 2;
+   */
+  commodities: Commodity[];
+
+  /**
+   * @generated from field: logistics.optimization.v1.MultiCommodityOptions options = 3;
+   */
+  options?: MultiCommodityOptions;
+};
+
+/**
+ * Describes the message logistics.optimization.v1.SolveMultiCommodityRequest.
+ * Use `create(SolveMultiCommodityRequestSchema)` to create a new message.
+ */
+export const SolveMultiCommodityRequestSchema: GenMessage<SolveMultiCommodityRequest> = /*@__PURE__*/
+  messageDesc(file_logistics_optimization_v1_solver, 9);
+
+/**
+ * @generated from message logistics.optimization.v1.SolveMultiCommodityResponse
+ */
+export type SolveMultiCommodityResponse = Message<"logistics.optimization.v1.SolveMultiCommodityResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: logistics.optimization.v1.MultiCommodityResult result = 2;
+   */
+  result?: MultiCommodityResult;
+
+  /**
+   * @generated from field: logistics.optimization.v1.SolveMetrics metrics = 3;
+   */
+  metrics?: SolveMetrics;
+
+  /**
+   * @generated from field: string error_message = 4;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message logistics.optimization.v1.SolveMultiCommodityResponse.
+ * Use `create(SolveMultiCommodityResponseSchema)` to create a new message.
+ */
+export const SolveMultiCommodityResponseSchema: GenMessage<SolveMultiCommodityResponse> = /*@__PURE__*/
+  messageDesc(file_logistics_optimization_v1_solver, 10);
+
+/**
+ * @generated from message logistics.optimization.v1.MultiCommodityResult
+ */
+export type MultiCommodityResult = Message<"logistics.optimization.v1.MultiCommodityResult"> & {
+  /**
+   * В порядке запроса
+   *
+   * @generated from field: repeated logistics.optimization.v1.CommodityFlow commodities = 1;
+   */
+  commodities: CommodityFlow[];
+
+  /**
+   * Доля спроса, которую можно провезти одновременно для всех товаров
+   * (>= 1 — весь спрос удовлетворён)
+   *
+   * @generated from field: double concurrent_ratio = 2;
+   */
+  concurrentRatio: number;
+
+  /**
+   * @generated from field: double total_cost = 3;
+   */
+  totalCost: number;
+
+  /**
+   * Суммарный поток всех товаров
+   *
+   * @generated from field: repeated logistics.common.v1.FlowEdge edges = 4;
+   */
+  edges: FlowEdge[];
+
+  /**
+   * FEASIBLE — весь спрос удовлетворён, INFEASIBLE — каждый товар получил долю concurrent_ratio
+   *
+   * @generated from field: logistics.common.v1.FlowStatus status = 5;
+   */
+  status: FlowStatus;
+
+  /**
+   * @generated from field: int32 iterations = 6;
+   */
+  iterations: number;
+};
+
+/**
+ * Describes the message logistics.optimization.v1.MultiCommodityResult.
+ * Use `create(MultiCommodityResultSchema)` to create a new message.
+ */
+export const MultiCommodityResultSchema: GenMessage<MultiCommodityResult> = /*@__PURE__*/
+  messageDesc(file_logistics_optimization_v1_solver, 11);
+
+/**
+ * @generated from message logistics.optimization.v1.CommodityFlow
+ */
+export type CommodityFlow = Message<"logistics.optimization.v1.CommodityFlow"> & {
+  /**
+   * @generated from field: int64 commodity_id = 1;
+   */
+  commodityId: bigint;
+
+  /**
+   * @generated from field: double demand = 2;
+   */
+  demand: number;
+
+  /**
+   * Провезённый объём
+   *
+   * @generated from field: double flow = 3;
+   */
+  flow: number;
+
+  /**
+   * Стоимость с учётом cost_overrides
+   *
+   * @generated from field: double cost = 4;
+   */
+  cost: number;
+
+  /**
+   * @generated from field: repeated logistics.common.v1.FlowEdge edges = 5;
+   */
+  edges: FlowEdge[];
+};
+
+/**
+ * Describes the message logistics.optimization.v1.CommodityFlow.
+ * Use `create(CommodityFlowSchema)` to create a new message.
+ */
+export const CommodityFlowSchema: GenMessage<CommodityFlow> = /*@__PURE__*/
+  messageDesc(file_logistics_optimization_v1_solver, 12);
+
 /**
  * @generated from message logistics.optimization.v1.SolveProgress
  */
@@ -231,6 +575,22 @@ export type SolveProgress = Message<"logistics.optimization.v1.SolveProgress"> &
    * @generated from field: int64 memory_used_bytes = 7;
    */
   memoryUsedBytes: bigint;
+
+  /**
+   * Балансы узлов при множественных источниках/стоках (только в финальном сообщении)
+   *
+   * Отгружено каждым складом
+   *
+   * @generated from field: repeated logistics.common.v1.NodeBalance source_balances = 8;
+   */
+  sourceBalances: NodeBalance[];
+
+  /**
+   * Получено каждой точкой доставки
+   *
+   * @generated from field: repeated logistics.common.v1.NodeBalance sink_balances = 9;
+   */
+  sinkBalances: NodeBalance[];
 };
 
 /**
@@ -238,7 +598,7 @@ export type SolveProgress = Message<"logistics.optimization.v1.SolveProgress"> &
  * Use `create(SolveProgressSchema)` to create a new message.
  */
 export const SolveProgressSchema: GenMessage<SolveProgress> = /*@__PURE__*/
-  messageDesc(file_logistics_optimization_v1_solver, 5);
+  messageDesc(file_logistics_optimization_v1_solver, 13);
 
 /**
  * @generated from message logistics.optimization.v1.GetAlgorithmsResponse
@@ -255,7 +615,7 @@ export type GetAlgorithmsResponse = Message<"logistics.optimization.v1.GetAlgori
  * Use `create(GetAlgorithmsResponseSchema)` to create a new message.
  */
 export const GetAlgorithmsResponseSchema: GenMessage<GetAlgorithmsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_optimization_v1_solver, 6);
+  messageDesc(file_logistics_optimization_v1_solver, 14);
 
 /**
  * @generated from message logistics.optimization.v1.AlgorithmInfo
@@ -307,7 +667,40 @@ export type AlgorithmInfo = Message<"logistics.optimization.v1.AlgorithmInfo"> &
  * Use `create(AlgorithmInfoSchema)` to create a new message.
  */
 export const AlgorithmInfoSchema: GenMessage<AlgorithmInfo> = /*@__PURE__*/
-  messageDesc(file_logistics_optimization_v1_solver, 7);
+  messageDesc(file_logistics_optimization_v1_solver, 15);
+
+/**
+ * @generated from enum logistics.optimization.v1.SolveMode
+ */
+export enum SolveMode {
+  /**
+   * То же, что SOLVE_MODE_MAX_FLOW
+   *
+   * @generated from enum value: SOLVE_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Максимальный поток между source_id и sink_id (или супер-терминалами)
+   *
+   * @generated from enum value: SOLVE_MODE_MAX_FLOW = 1;
+   */
+  MAX_FLOW = 1,
+
+  /**
+   * Транспортная задача: supply/demand узлов — жёсткие ограничения,
+   * весь спрос удовлетворяется с минимальной стоимостью, иначе FLOW_STATUS_INFEASIBLE
+   *
+   * @generated from enum value: SOLVE_MODE_TRANSPORTATION = 2;
+   */
+  TRANSPORTATION = 2,
+}
+
+/**
+ * Describes the enum logistics.optimization.v1.SolveMode.
+ */
+export const SolveModeSchema: GenEnum<SolveMode> = /*@__PURE__*/
+  enumDesc(file_logistics_optimization_v1_solver, 0);
 
 /**
  * @generated from service logistics.optimization.v1.SolverService
@@ -343,6 +736,26 @@ export const SolverService: GenService<{
     input: typeof EmptySchema;
     output: typeof GetAlgorithmsResponseSchema;
   },
+  /**
+   * Минимальный разрез после максимального потока
+   *
+   * @generated from rpc logistics.optimization.v1.SolverService.GetMinCut
+   */
+  getMinCut: {
+    methodKind: "unary";
+    input: typeof GetMinCutRequestSchema;
+    output: typeof GetMinCutResponseSchema;
+  },
+  /**
+   * Многопродуктовый поток: несколько классов товаров на общих пропускных способностях
+   *
+   * @generated from rpc logistics.optimization.v1.SolverService.SolveMultiCommodity
+   */
+  solveMultiCommodity: {
+    methodKind: "unary";
+    input: typeof SolveMultiCommodityRequestSchema;
+    output: typeof SolveMultiCommodityResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_logistics_optimization_v1_solver, 0);
 
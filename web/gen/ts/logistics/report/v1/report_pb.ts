@@ -8,7 +8,7 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { AnalyzeFlowResponse, CalculateCostResponse, EfficiencyReport, FindBottlenecksResponse } from "../../analytics/v1/analytics_pb";
+import type { AnalyzeFlowResponse, BuildOriginDestinationMatrixResponse, CalculateCostResponse, EfficiencyReport, FindBottlenecksResponse } from "../../analytics/v1/analytics_pb";
 import { file_logistics_analytics_v1_analytics } from "../../analytics/v1/analytics_pb";
 import type { Algorithm, FlowResult, FlowStatistics, Graph, GraphStatistics, TimeRange } from "../../common/v1/common_pb";
 import { file_logistics_common_v1_common } from "../../common/v1/common_pb";
@@ -22,7 +22,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file logistics/report/v1/report.proto.
  */
 export const file_logistics_report_v1_report: GenFile = /*@__PURE__*/
-  fileDesc("CiBsb2dpc3RpY3MvcmVwb3J0L3YxL3JlcG9ydC5wcm90bxITbG9naXN0aWNzLnJlcG9ydC52MSKvBAoOUmVwb3J0TWV0YWRhdGESEQoJcmVwb3J0X2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEi0KBHR5cGUYBCABKA4yHy5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydFR5cGUSMQoGZm9ybWF0GAUgASgOMiEubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRGb3JtYXQSMAoMZ2VuZXJhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxnZW5lcmF0ZWRfYnkYByABKAkSDwoHdmVyc2lvbhgIIAEoCRISCgpzaXplX2J5dGVzGAkgASgDEhoKEmdlbmVyYXRpb25fdGltZV9tcxgKIAEoARJMCg1jdXN0b21fZmllbGRzGAsgAygLMjUubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YS5DdXN0b21GaWVsZHNFbnRyeRIWCg5jYWxjdWxhdGlvbl9pZBgMIAEoCRIQCghncmFwaF9pZBgNIAEoCRIMCgR0YWdzGA4gAygJEi4KCmV4cGlyZXNfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGZpbGVuYW1lGBAgASgJGjMKEUN1c3RvbUZpZWxkc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiiQUKDVJlcG9ydE9wdGlvbnMSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDgoGYXV0aG9yGAMgASgJEhAKCGxhbmd1YWdlGAQgASgJEhAKCHRpbWV6b25lGAUgASgJEh0KFWluY2x1ZGVfZ3JhcGhfZGV0YWlscxgGIAEoCBIZChFpbmNsdWRlX2VkZ2VfbGlzdBgHIAEoCBIZChFpbmNsdWRlX25vZGVfbGlzdBgIIAEoCBIcChRpbmNsdWRlX3BhdGhfZGV0YWlscxgJIAEoCBIfChdpbmNsdWRlX3JlY29tbWVuZGF0aW9ucxgKIAEoCBIWCg5pbmNsdWRlX2NoYXJ0cxgLIAEoCBIYChBpbmNsdWRlX3Jhd19kYXRhGAwgASgIEhQKDGNvbXBhbnlfbmFtZRgNIAEoCRIQCghsb2dvX3VybBgOIAEoCRINCgV0aGVtZRgPIAEoCRIaChJtYXhfZWRnZXNfaW5fdGFibGUYECABKAUSGgoSbWF4X3BhdGhzX2luX3RhYmxlGBEgASgFEhAKCGN1cnJlbmN5GBIgASgJEgwKBHRhZ3MYEyADKAkSEwoLdHRsX3NlY29uZHMYFCABKAMSFwoPc2F2ZV90b19zdG9yYWdlGBUgASgIEhsKE2FkZGl0aW9uYWxfc2VjdGlvbnMYFiADKAkSSwoNY3VzdG9tX2ZpZWxkcxgXIAMoCzI0LmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0T3B0aW9ucy5DdXN0b21GaWVsZHNFbnRyeRozChFDdXN0b21GaWVsZHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIlkKDVJlcG9ydENvbnRlbnQSDAoEZGF0YRgBIAEoDBIUCgxjb250ZW50X3R5cGUYAiABKAkSEAoIZmlsZW5hbWUYAyABKAkSEgoKc2l6ZV9ieXRlcxgEIAEoAyLDAgoZR2VuZXJhdGVGbG93UmVwb3J0UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSLwoGcmVzdWx0GAIgASgLMh8ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93UmVzdWx0EjgKB21ldHJpY3MYAyABKAsyJy5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlTWV0cmljcxIxCgZmb3JtYXQYBCABKA4yIS5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydEZvcm1hdBIzCgdvcHRpb25zGAUgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRPcHRpb25zEhYKDmNhbGN1bGF0aW9uX2lkGAYgASgJEhAKCGdyYXBoX2lkGAcgASgJIrABChpHZW5lcmF0ZUZsb3dSZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjUKCG1ldGFkYXRhGAIgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRIzCgdjb250ZW50GAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDb250ZW50EhUKDWVycm9yX21lc3NhZ2UYBCABKAkikgQKHkdlbmVyYXRlQW5hbHl0aWNzUmVwb3J0UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOwoEY29zdBgCIAEoCzItLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ2FsY3VsYXRlQ29zdFJlc3BvbnNlEkQKC2JvdHRsZW5lY2tzGAMgASgLMi8ubG9naXN0aWNzLmFuYWx5dGljcy52MS5GaW5kQm90dGxlbmVja3NSZXNwb25zZRI8CgplZmZpY2llbmN5GAQgASgLMigubG9naXN0aWNzLmFuYWx5dGljcy52MS5FZmZpY2llbmN5UmVwb3J0EjcKCmZsb3dfc3RhdHMYBSABKAsyIy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dTdGF0aXN0aWNzEjkKC2dyYXBoX3N0YXRzGAYgASgLMiQubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaFN0YXRpc3RpY3MSMQoGZm9ybWF0GAcgASgOMiEubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRGb3JtYXQSMwoHb3B0aW9ucxgIIAEoCzIiLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0T3B0aW9ucxIWCg5jYWxjdWxhdGlvbl9pZBgJIAEoCRIQCghncmFwaF9pZBgKIAEoCSK1AQofR2VuZXJhdGVBbmFseXRpY3NSZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjUKCG1ldGFkYXRhGAIgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRIzCgdjb250ZW50GAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDb250ZW50EhUKDWVycm9yX21lc3NhZ2UYBCABKAkisAUKH0dlbmVyYXRlU2ltdWxhdGlvblJlcG9ydFJlcXVlc3QSMgoOYmFzZWxpbmVfZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEj0KB3doYXRfaWYYAiABKAsyKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5XaGF0SWZSZXNwb25zZUgAEkcKCmNvbXBhcmlzb24YAyABKAsyMS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Db21wYXJlU2NlbmFyaW9zUmVzcG9uc2VIABJFCgttb250ZV9jYXJsbxgEIAEoCzIuLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1bk1vbnRlQ2FybG9SZXNwb25zZUgAEkoKC3NlbnNpdGl2aXR5GAUgASgLMjMubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVNlbnNpdGl2aXR5UmVzcG9uc2VIABJICgpyZXNpbGllbmNlGAYgASgLMjIubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVJlc2lsaWVuY2VSZXNwb25zZUgAEk0KD3RpbWVfc2ltdWxhdGlvbhgHIAEoCzIyLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1blRpbWVTaW11bGF0aW9uUmVzcG9uc2VIABIxCgZmb3JtYXQYCiABKA4yIS5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydEZvcm1hdBIzCgdvcHRpb25zGAsgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRPcHRpb25zEhYKDmNhbGN1bGF0aW9uX2lkGAwgASgJEhAKCGdyYXBoX2lkGA0gASgJQhMKEXNpbXVsYXRpb25fcmVzdWx0IrYBCiBHZW5lcmF0ZVNpbXVsYXRpb25SZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjUKCG1ldGFkYXRhGAIgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRIzCgdjb250ZW50GAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDb250ZW50EhUKDWVycm9yX21lc3NhZ2UYBCABKAkikgMKHEdlbmVyYXRlU3VtbWFyeVJlcG9ydFJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjQKC2Zsb3dfcmVzdWx0GAIgASgLMh8ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93UmVzdWx0Ej4KCWFuYWx5dGljcxgDIAEoCzIrLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQW5hbHl6ZUZsb3dSZXNwb25zZRI/CgtzaW11bGF0aW9ucxgEIAMoCzIqLmxvZ2lzdGljcy5yZXBvcnQudjEuU2ltdWxhdGlvblN1bW1hcnlEYXRhEjEKBmZvcm1hdBgFIAEoDjIhLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Rm9ybWF0EjMKB29wdGlvbnMYBiABKAsyIi5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydE9wdGlvbnMSFgoOY2FsY3VsYXRpb25faWQYByABKAkSEAoIZ3JhcGhfaWQYCCABKAkiyAEKFVNpbXVsYXRpb25TdW1tYXJ5RGF0YRIMCgRuYW1lGAEgASgJEgwKBHR5cGUYAiABKAkSDwoHc3VtbWFyeRgDIAEoCRJPCgtrZXlfbWV0cmljcxgEIAMoCzI6LmxvZ2lzdGljcy5yZXBvcnQudjEuU2ltdWxhdGlvblN1bW1hcnlEYXRhLktleU1ldHJpY3NFbnRyeRoxCg9LZXlNZXRyaWNzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASKzAQodR2VuZXJhdGVTdW1tYXJ5UmVwb3J0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI1CghtZXRhZGF0YRgCIAEoCzIjLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0TWV0YWRhdGESMwoHY29udGVudBgDIAEoCzIiLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Q29udGVudBIVCg1lcnJvcl9tZXNzYWdlGAQgASgJItUBCh9HZW5lcmF0ZUNvbXBhcmlzb25SZXBvcnRSZXF1ZXN0EjIKBWl0ZW1zGAEgAygLMiMubG9naXN0aWNzLnJlcG9ydC52MS5Db21wYXJpc29uSXRlbRIxCgZmb3JtYXQYAiABKA4yIS5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydEZvcm1hdBIzCgdvcHRpb25zGAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRPcHRpb25zEhYKDmNhbGN1bGF0aW9uX2lkGAQgASgJIu0BCg5Db21wYXJpc29uSXRlbRIMCgRuYW1lGAEgASgJEikKBWdyYXBoGAIgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIvCgZyZXN1bHQYAyABKAsyHy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dSZXN1bHQSQQoHbWV0cmljcxgEIAMoCzIwLmxvZ2lzdGljcy5yZXBvcnQudjEuQ29tcGFyaXNvbkl0ZW0uTWV0cmljc0VudHJ5Gi4KDE1ldHJpY3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBIrYBCiBHZW5lcmF0ZUNvbXBhcmlzb25SZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjUKCG1ldGFkYXRhGAIgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRIzCgdjb250ZW50GAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDb250ZW50EhUKDWVycm9yX21lc3NhZ2UYBCABKAkiuwIKHEdlbmVyYXRlSGlzdG9yeVJlcG9ydFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIyCgp0aW1lX3JhbmdlGAIgASgLMh4ubG9naXN0aWNzLmNvbW1vbi52MS5UaW1lUmFuZ2USMgoHZW50cmllcxgDIAMoCzIhLmxvZ2lzdGljcy5yZXBvcnQudjEuSGlzdG9yeUVudHJ5EjoKCnN0YXRpc3RpY3MYBCABKAsyJi5sb2dpc3RpY3MucmVwb3J0LnYxLkhpc3RvcnlTdGF0aXN0aWNzEjEKBmZvcm1hdBgFIAEoDjIhLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Rm9ybWF0EjMKB29wdGlvbnMYBiABKAsyIi5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydE9wdGlvbnMiggIKDEhpc3RvcnlFbnRyeRIWCg5jYWxjdWxhdGlvbl9pZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRuYW1lGAMgASgJEjEKCWFsZ29yaXRobRgEIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtEhAKCG1heF9mbG93GAUgASgBEhIKCnRvdGFsX2Nvc3QYBiABKAESGwoTY29tcHV0YXRpb25fdGltZV9tcxgHIAEoARISCgpub2RlX2NvdW50GAggASgFEhIKCmVkZ2VfY291bnQYCSABKAUihwIKEUhpc3RvcnlTdGF0aXN0aWNzEhoKEnRvdGFsX2NhbGN1bGF0aW9ucxgBIAEoBRIYChBhdmVyYWdlX21heF9mbG93GAIgASgBEhQKDGF2ZXJhZ2VfY29zdBgDIAEoARIjChthdmVyYWdlX2NvbXB1dGF0aW9uX3RpbWVfbXMYBCABKAESTQoMYnlfYWxnb3JpdGhtGAUgAygLMjcubG9naXN0aWNzLnJlcG9ydC52MS5IaXN0b3J5U3RhdGlzdGljcy5CeUFsZ29yaXRobUVudHJ5GjIKEEJ5QWxnb3JpdGhtRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASKzAQodR2VuZXJhdGVIaXN0b3J5UmVwb3J0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI1CghtZXRhZGF0YRgCIAEoCzIjLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0TWV0YWRhdGESMwoHY29udGVudBgDIAEoCzIiLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Q29udGVudBIVCg1lcnJvcl9tZXNzYWdlGAQgASgJIsQCChtHZW5lcmF0ZVJlcG9ydFN0cmVhbVJlcXVlc3QSPgoEZmxvdxgBIAEoCzIuLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVGbG93UmVwb3J0UmVxdWVzdEgAEkgKCWFuYWx5dGljcxgCIAEoCzIzLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVBbmFseXRpY3NSZXBvcnRSZXF1ZXN0SAASSgoKc2ltdWxhdGlvbhgDIAEoCzI0LmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVTaW11bGF0aW9uUmVwb3J0UmVxdWVzdEgAEkQKB3N1bW1hcnkYBCABKAsyMS5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlU3VtbWFyeVJlcG9ydFJlcXVlc3RIAEIJCgdyZXF1ZXN0Io4BCgtSZXBvcnRDaHVuaxITCgtjaHVua19pbmRleBgBIAEoBRIUCgx0b3RhbF9jaHVua3MYAiABKAUSDAoEZGF0YRgDIAEoDBIPCgdpc19sYXN0GAQgASgIEjUKCG1ldGFkYXRhGAUgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YSIlChBHZXRSZXBvcnRSZXF1ZXN0EhEKCXJlcG9ydF9pZBgBIAEoCSKnAQoRR2V0UmVwb3J0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI1CghtZXRhZGF0YRgCIAEoCzIjLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0TWV0YWRhdGESMwoHY29udGVudBgDIAEoCzIiLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Q29udGVudBIVCg1lcnJvcl9tZXNzYWdlGAQgASgJIikKFEdldFJlcG9ydEluZm9SZXF1ZXN0EhEKCXJlcG9ydF9pZBgBIAEoCSJ2ChVHZXRSZXBvcnRJbmZvUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI1CghtZXRhZGF0YRgCIAEoCzIjLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0TWV0YWRhdGESFQoNZXJyb3JfbWVzc2FnZRgDIAEoCSLyAgoSTGlzdFJlcG9ydHNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBRI0CgtyZXBvcnRfdHlwZRgDIAEoDjIfLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0VHlwZRIxCgZmb3JtYXQYBCABKA4yIS5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydEZvcm1hdBIWCg5jYWxjdWxhdGlvbl9pZBgFIAEoCRIQCghncmFwaF9pZBgGIAEoCRIPCgd1c2VyX2lkGAcgASgJEgwKBHRhZ3MYCCADKAkSMQoNY3JlYXRlZF9hZnRlchgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoOY3JlYXRlZF9iZWZvcmUYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCG9yZGVyX2J5GAsgASgJEhIKCm9yZGVyX2Rlc2MYDCABKAgicgoTTGlzdFJlcG9ydHNSZXNwb25zZRI0CgdyZXBvcnRzGAEgAygLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRITCgt0b3RhbF9jb3VudBgCIAEoAxIQCghoYXNfbW9yZRgDIAEoCCI9ChNEZWxldGVSZXBvcnRSZXF1ZXN0EhEKCXJlcG9ydF9pZBgBIAEoCRITCgtoYXJkX2RlbGV0ZRgCIAEoCCI+ChREZWxldGVSZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiSwoXVXBkYXRlUmVwb3J0VGFnc1JlcXVlc3QSEQoJcmVwb3J0X2lkGAEgASgJEgwKBHRhZ3MYAiADKAkSDwoHcmVwbGFjZRgDIAEoCCJQChhVcGRhdGVSZXBvcnRUYWdzUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIMCgR0YWdzGAIgAygJEhUKDWVycm9yX21lc3NhZ2UYAyABKAkiLAoZR2V0UmVwb3NpdG9yeVN0YXRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIqAFChpHZXRSZXBvc2l0b3J5U3RhdHNSZXNwb25zZRIVCg10b3RhbF9yZXBvcnRzGAEgASgDEhgKEHRvdGFsX3NpemVfYnl0ZXMYAiABKAMSFgoOYXZnX3NpemVfYnl0ZXMYAyABKAESWwoPcmVwb3J0c19ieV90eXBlGAQgAygLMkIubG9naXN0aWNzLnJlcG9ydC52MS5HZXRSZXBvc2l0b3J5U3RhdHNSZXNwb25zZS5SZXBvcnRzQnlUeXBlRW50cnkSXwoRcmVwb3J0c19ieV9mb3JtYXQYBSADKAsyRC5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFJlcG9zaXRvcnlTdGF0c1Jlc3BvbnNlLlJlcG9ydHNCeUZvcm1hdEVudHJ5ElUKDHNpemVfYnlfdHlwZRgGIAMoCzI/LmxvZ2lzdGljcy5yZXBvcnQudjEuR2V0UmVwb3NpdG9yeVN0YXRzUmVzcG9uc2UuU2l6ZUJ5VHlwZUVudHJ5EjQKEG9sZGVzdF9yZXBvcnRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjQKEG5ld2VzdF9yZXBvcnRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhcKD2V4cGlyZWRfcmVwb3J0cxgJIAEoAxo0ChJSZXBvcnRzQnlUeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgDOgI4ARo2ChRSZXBvcnRzQnlGb3JtYXRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6AjgBGjEKD1NpemVCeVR5cGVFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6AjgBIhwKGkdldFN1cHBvcnRlZEZvcm1hdHNSZXF1ZXN0Ik8KG0dldFN1cHBvcnRlZEZvcm1hdHNSZXNwb25zZRIwCgdmb3JtYXRzGAEgAygLMh8ubG9naXN0aWNzLnJlcG9ydC52MS5Gb3JtYXRJbmZvIv8BCgpGb3JtYXRJbmZvEjEKBmZvcm1hdBgBIAEoDjIhLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Rm9ybWF0EgwKBG5hbWUYAiABKAkSEQoJZXh0ZW5zaW9uGAMgASgJEhEKCW1pbWVfdHlwZRgEIAEoCRIXCg9zdXBwb3J0c19jaGFydHMYBSABKAgSGAoQc3VwcG9ydHNfc3R5bGluZxgGIAEoCBI/ChZzdXBwb3J0ZWRfcmVwb3J0X3R5cGVzGAcgAygOMh8ubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRUeXBlEhYKDm1heF9zaXplX2J5dGVzGAggASgDIg8KDUhlYWx0aFJlcXVlc3QimQEKDkhlYWx0aFJlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEhYKDnVwdGltZV9zZWNvbmRzGAMgASgDEhkKEXJlcG9ydHNfZ2VuZXJhdGVkGAQgASgDEjMKB3N0b3JhZ2UYBSABKAsyIi5sb2dpc3RpY3MucmVwb3J0LnYxLlN0b3JhZ2VIZWFsdGgiaAoNU3RvcmFnZUhlYWx0aBIOCgZzdGF0dXMYASABKAkSFgoOc3RvcmVkX3JlcG9ydHMYAiABKAMSGAoQdG90YWxfc2l6ZV9ieXRlcxgDIAEoAxIVCg1lcnJvcl9tZXNzYWdlGAQgASgJKsABCgxSZXBvcnRGb3JtYXQSHQoZUkVQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhoKFlJFUE9SVF9GT1JNQVRfTUFSS0RPV04QARIVChFSRVBPUlRfRk9STUFUX0NTVhACEhcKE1JFUE9SVF9GT1JNQVRfRVhDRUwQAxIVChFSRVBPUlRfRk9STUFUX1BERhAEEhYKElJFUE9SVF9GT1JNQVRfSFRNTBAFEhYKElJFUE9SVF9GT1JNQVRfSlNPThAGKsQBCgpSZXBvcnRUeXBlEhsKF1JFUE9SVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQUkVQT1JUX1RZUEVfRkxPVxABEhkKFVJFUE9SVF9UWVBFX0FOQUxZVElDUxACEhoKFlJFUE9SVF9UWVBFX1NJTVVMQVRJT04QAxIXChNSRVBPUlRfVFlQRV9TVU1NQVJZEAQSFwoTUkVQT1JUX1RZUEVfSElTVE9SWRAFEhoKFlJFUE9SVF9UWVBFX0NPTVBBUklTT04QBjLPDQoNUmVwb3J0U2VydmljZRJ1ChJHZW5lcmF0ZUZsb3dSZXBvcnQSLi5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlRmxvd1JlcG9ydFJlcXVlc3QaLy5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlRmxvd1JlcG9ydFJlc3BvbnNlEoQBChdHZW5lcmF0ZUFuYWx5dGljc1JlcG9ydBIzLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVBbmFseXRpY3NSZXBvcnRSZXF1ZXN0GjQubG9naXN0aWNzLnJlcG9ydC52MS5HZW5lcmF0ZUFuYWx5dGljc1JlcG9ydFJlc3BvbnNlEocBChhHZW5lcmF0ZVNpbXVsYXRpb25SZXBvcnQSNC5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlU2ltdWxhdGlvblJlcG9ydFJlcXVlc3QaNS5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlU2ltdWxhdGlvblJlcG9ydFJlc3BvbnNlEn4KFUdlbmVyYXRlU3VtbWFyeVJlcG9ydBIxLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVTdW1tYXJ5UmVwb3J0UmVxdWVzdBoyLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVTdW1tYXJ5UmVwb3J0UmVzcG9uc2UShwEKGEdlbmVyYXRlQ29tcGFyaXNvblJlcG9ydBI0LmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVDb21wYXJpc29uUmVwb3J0UmVxdWVzdBo1LmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVDb21wYXJpc29uUmVwb3J0UmVzcG9uc2USfgoVR2VuZXJhdGVIaXN0b3J5UmVwb3J0EjEubG9naXN0aWNzLnJlcG9ydC52MS5HZW5lcmF0ZUhpc3RvcnlSZXBvcnRSZXF1ZXN0GjIubG9naXN0aWNzLnJlcG9ydC52MS5HZW5lcmF0ZUhpc3RvcnlSZXBvcnRSZXNwb25zZRJsChRHZW5lcmF0ZVJlcG9ydFN0cmVhbRIwLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVSZXBvcnRTdHJlYW1SZXF1ZXN0GiAubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDaHVuazABEloKCUdldFJlcG9ydBIlLmxvZ2lzdGljcy5yZXBvcnQudjEuR2V0UmVwb3J0UmVxdWVzdBomLmxvZ2lzdGljcy5yZXBvcnQudjEuR2V0UmVwb3J0UmVzcG9uc2USZgoNR2V0UmVwb3J0SW5mbxIpLmxvZ2lzdGljcy5yZXBvcnQudjEuR2V0UmVwb3J0SW5mb1JlcXVlc3QaKi5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFJlcG9ydEluZm9SZXNwb25zZRJgCgtMaXN0UmVwb3J0cxInLmxvZ2lzdGljcy5yZXBvcnQudjEuTGlzdFJlcG9ydHNSZXF1ZXN0GigubG9naXN0aWNzLnJlcG9ydC52MS5MaXN0UmVwb3J0c1Jlc3BvbnNlEmMKDERlbGV0ZVJlcG9ydBIoLmxvZ2lzdGljcy5yZXBvcnQudjEuRGVsZXRlUmVwb3J0UmVxdWVzdBopLmxvZ2lzdGljcy5yZXBvcnQudjEuRGVsZXRlUmVwb3J0UmVzcG9uc2USbwoQVXBkYXRlUmVwb3J0VGFncxIsLmxvZ2lzdGljcy5yZXBvcnQudjEuVXBkYXRlUmVwb3J0VGFnc1JlcXVlc3QaLS5sb2dpc3RpY3MucmVwb3J0LnYxLlVwZGF0ZVJlcG9ydFRhZ3NSZXNwb25zZRJ1ChJHZXRSZXBvc2l0b3J5U3RhdHMSLi5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFJlcG9zaXRvcnlTdGF0c1JlcXVlc3QaLy5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFJlcG9zaXRvcnlTdGF0c1Jlc3BvbnNlEngKE0dldFN1cHBvcnRlZEZvcm1hdHMSLy5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFN1cHBvcnRlZEZvcm1hdHNSZXF1ZXN0GjAubG9naXN0aWNzLnJlcG9ydC52MS5HZXRTdXBwb3J0ZWRGb3JtYXRzUmVzcG9uc2USUQoGSGVhbHRoEiIubG9naXN0aWNzLnJlcG9ydC52MS5IZWFsdGhSZXF1ZXN0GiMubG9naXN0aWNzLnJlcG9ydC52MS5IZWFsdGhSZXNwb25zZULDAQoXY29tLmxvZ2lzdGljcy5yZXBvcnQudjFCC1JlcG9ydFByb3RvUAFaLWxvZ2lzdGljcy9nZW4vZ28vbG9naXN0aWNzL3JlcG9ydC92MTtyZXBvcnR2MaICA0xSWKoCE0xvZ2lzdGljcy5SZXBvcnQuVjHKAhNMb2dpc3RpY3NcUmVwb3J0XFYx4gIfTG9naXN0aWNzXFJlcG9ydFxWMVxHUEJNZXRhZGF0YeoCFUxvZ2lzdGljczo6UmVwb3J0OjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_logistics_analytics_v1_analytics, file_logistics_common_v1_common, file_logistics_optimization_v1_solver, file_logistics_simulation_v1_simulation]);
+  fileDesc("CiBsb2dpc3RpY3MvcmVwb3J0L3YxL3JlcG9ydC5wcm90bxITbG9naXN0aWNzLnJlcG9ydC52MSKvBAoOUmVwb3J0TWV0YWRhdGESEQoJcmVwb3J0X2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEi0KBHR5cGUYBCABKA4yHy5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydFR5cGUSMQoGZm9ybWF0GAUgASgOMiEubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRGb3JtYXQSMAoMZ2VuZXJhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxnZW5lcmF0ZWRfYnkYByABKAkSDwoHdmVyc2lvbhgIIAEoCRISCgpzaXplX2J5dGVzGAkgASgDEhoKEmdlbmVyYXRpb25fdGltZV9tcxgKIAEoARJMCg1jdXN0b21fZmllbGRzGAsgAygLMjUubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YS5DdXN0b21GaWVsZHNFbnRyeRIWCg5jYWxjdWxhdGlvbl9pZBgMIAEoCRIQCghncmFwaF9pZBgNIAEoCRIMCgR0YWdzGA4gAygJEi4KCmV4cGlyZXNfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGZpbGVuYW1lGBAgASgJGjMKEUN1c3RvbUZpZWxkc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiiQUKDVJlcG9ydE9wdGlvbnMSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDgoGYXV0aG9yGAMgASgJEhAKCGxhbmd1YWdlGAQgASgJEhAKCHRpbWV6b25lGAUgASgJEh0KFWluY2x1ZGVfZ3JhcGhfZGV0YWlscxgGIAEoCBIZChFpbmNsdWRlX2VkZ2VfbGlzdBgHIAEoCBIZChFpbmNsdWRlX25vZGVfbGlzdBgIIAEoCBIcChRpbmNsdWRlX3BhdGhfZGV0YWlscxgJIAEoCBIfChdpbmNsdWRlX3JlY29tbWVuZGF0aW9ucxgKIAEoCBIWCg5pbmNsdWRlX2NoYXJ0cxgLIAEoCBIYChBpbmNsdWRlX3Jhd19kYXRhGAwgASgIEhQKDGNvbXBhbnlfbmFtZRgNIAEoCRIQCghsb2dvX3VybBgOIAEoCRINCgV0aGVtZRgPIAEoCRIaChJtYXhfZWRnZXNfaW5fdGFibGUYECABKAUSGgoSbWF4X3BhdGhzX2luX3RhYmxlGBEgASgFEhAKCGN1cnJlbmN5GBIgASgJEgwKBHRhZ3MYEyADKAkSEwoLdHRsX3NlY29uZHMYFCABKAMSFwoPc2F2ZV90b19zdG9yYWdlGBUgASgIEhsKE2FkZGl0aW9uYWxfc2VjdGlvbnMYFiADKAkSSwoNY3VzdG9tX2ZpZWxkcxgXIAMoCzI0LmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0T3B0aW9ucy5DdXN0b21GaWVsZHNFbnRyeRozChFDdXN0b21GaWVsZHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIlkKDVJlcG9ydENvbnRlbnQSDAoEZGF0YRgBIAEoDBIUCgxjb250ZW50X3R5cGUYAiABKAkSEAoIZmlsZW5hbWUYAyABKAkSEgoKc2l6ZV9ieXRlcxgEIAEoAyLDAgoZR2VuZXJhdGVGbG93UmVwb3J0UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSLwoGcmVzdWx0GAIgASgLMh8ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93UmVzdWx0EjgKB21ldHJpY3MYAyABKAsyJy5sb2dpc3RpY3Mub3B0aW1pemF0aW9uLnYxLlNvbHZlTWV0cmljcxIxCgZmb3JtYXQYBCABKA4yIS5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydEZvcm1hdBIzCgdvcHRpb25zGAUgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRPcHRpb25zEhYKDmNhbGN1bGF0aW9uX2lkGAYgASgJEhAKCGdyYXBoX2lkGAcgASgJIrABChpHZW5lcmF0ZUZsb3dSZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjUKCG1ldGFkYXRhGAIgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRIzCgdjb250ZW50GAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDb250ZW50EhUKDWVycm9yX21lc3NhZ2UYBCABKAki7AQKHkdlbmVyYXRlQW5hbHl0aWNzUmVwb3J0UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOwoEY29zdBgCIAEoCzItLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQ2FsY3VsYXRlQ29zdFJlc3BvbnNlEkQKC2JvdHRsZW5lY2tzGAMgASgLMi8ubG9naXN0aWNzLmFuYWx5dGljcy52MS5GaW5kQm90dGxlbmVja3NSZXNwb25zZRI8CgplZmZpY2llbmN5GAQgASgLMigubG9naXN0aWNzLmFuYWx5dGljcy52MS5FZmZpY2llbmN5UmVwb3J0EjcKCmZsb3dfc3RhdHMYBSABKAsyIy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dTdGF0aXN0aWNzEjkKC2dyYXBoX3N0YXRzGAYgASgLMiQubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaFN0YXRpc3RpY3MSWAoSb3JpZ2luX2Rlc3RpbmF0aW9uGAsgASgLMjwubG9naXN0aWNzLmFuYWx5dGljcy52MS5CdWlsZE9yaWdpbkRlc3RpbmF0aW9uTWF0cml4UmVzcG9uc2USMQoGZm9ybWF0GAcgASgOMiEubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRGb3JtYXQSMwoHb3B0aW9ucxgIIAEoCzIiLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0T3B0aW9ucxIWCg5jYWxjdWxhdGlvbl9pZBgJIAEoCRIQCghncmFwaF9pZBgKIAEoCSK1AQofR2VuZXJhdGVBbmFseXRpY3NSZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjUKCG1ldGFkYXRhGAIgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRIzCgdjb250ZW50GAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDb250ZW50EhUKDWVycm9yX21lc3NhZ2UYBCABKAkisAUKH0dlbmVyYXRlU2ltdWxhdGlvblJlcG9ydFJlcXVlc3QSMgoOYmFzZWxpbmVfZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEj0KB3doYXRfaWYYAiABKAsyKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5XaGF0SWZSZXNwb25zZUgAEkcKCmNvbXBhcmlzb24YAyABKAsyMS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Db21wYXJlU2NlbmFyaW9zUmVzcG9uc2VIABJFCgttb250ZV9jYXJsbxgEIAEoCzIuLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1bk1vbnRlQ2FybG9SZXNwb25zZUgAEkoKC3NlbnNpdGl2aXR5GAUgASgLMjMubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVNlbnNpdGl2aXR5UmVzcG9uc2VIABJICgpyZXNpbGllbmNlGAYgASgLMjIubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVJlc2lsaWVuY2VSZXNwb25zZUgAEk0KD3RpbWVfc2ltdWxhdGlvbhgHIAEoCzIyLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1blRpbWVTaW11bGF0aW9uUmVzcG9uc2VIABIxCgZmb3JtYXQYCiABKA4yIS5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydEZvcm1hdBIzCgdvcHRpb25zGAsgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRPcHRpb25zEhYKDmNhbGN1bGF0aW9uX2lkGAwgASgJEhAKCGdyYXBoX2lkGA0gASgJQhMKEXNpbXVsYXRpb25fcmVzdWx0IrYBCiBHZW5lcmF0ZVNpbXVsYXRpb25SZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjUKCG1ldGFkYXRhGAIgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRIzCgdjb250ZW50GAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDb250ZW50EhUKDWVycm9yX21lc3NhZ2UYBCABKAkikgMKHEdlbmVyYXRlU3VtbWFyeVJlcG9ydFJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjQKC2Zsb3dfcmVzdWx0GAIgASgLMh8ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93UmVzdWx0Ej4KCWFuYWx5dGljcxgDIAEoCzIrLmxvZ2lzdGljcy5hbmFseXRpY3MudjEuQW5hbHl6ZUZsb3dSZXNwb25zZRI/CgtzaW11bGF0aW9ucxgEIAMoCzIqLmxvZ2lzdGljcy5yZXBvcnQudjEuU2ltdWxhdGlvblN1bW1hcnlEYXRhEjEKBmZvcm1hdBgFIAEoDjIhLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Rm9ybWF0EjMKB29wdGlvbnMYBiABKAsyIi5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydE9wdGlvbnMSFgoOY2FsY3VsYXRpb25faWQYByABKAkSEAoIZ3JhcGhfaWQYCCABKAkiyAEKFVNpbXVsYXRpb25TdW1tYXJ5RGF0YRIMCgRuYW1lGAEgASgJEgwKBHR5cGUYAiABKAkSDwoHc3VtbWFyeRgDIAEoCRJPCgtrZXlfbWV0cmljcxgEIAMoCzI6LmxvZ2lzdGljcy5yZXBvcnQudjEuU2ltdWxhdGlvblN1bW1hcnlEYXRhLktleU1ldHJpY3NFbnRyeRoxCg9LZXlNZXRyaWNzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASKzAQodR2VuZXJhdGVTdW1tYXJ5UmVwb3J0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI1CghtZXRhZGF0YRgCIAEoCzIjLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0TWV0YWRhdGESMwoHY29udGVudBgDIAEoCzIiLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Q29udGVudBIVCg1lcnJvcl9tZXNzYWdlGAQgASgJItUBCh9HZW5lcmF0ZUNvbXBhcmlzb25SZXBvcnRSZXF1ZXN0EjIKBWl0ZW1zGAEgAygLMiMubG9naXN0aWNzLnJlcG9ydC52MS5Db21wYXJpc29uSXRlbRIxCgZmb3JtYXQYAiABKA4yIS5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydEZvcm1hdBIzCgdvcHRpb25zGAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRPcHRpb25zEhYKDmNhbGN1bGF0aW9uX2lkGAQgASgJIu0BCg5Db21wYXJpc29uSXRlbRIMCgRuYW1lGAEgASgJEikKBWdyYXBoGAIgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIvCgZyZXN1bHQYAyABKAsyHy5sb2dpc3RpY3MuY29tbW9uLnYxLkZsb3dSZXN1bHQSQQoHbWV0cmljcxgEIAMoCzIwLmxvZ2lzdGljcy5yZXBvcnQudjEuQ29tcGFyaXNvbkl0ZW0uTWV0cmljc0VudHJ5Gi4KDE1ldHJpY3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBIrYBCiBHZW5lcmF0ZUNvbXBhcmlzb25SZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjUKCG1ldGFkYXRhGAIgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRIzCgdjb250ZW50GAMgASgLMiIubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDb250ZW50EhUKDWVycm9yX21lc3NhZ2UYBCABKAkiuwIKHEdlbmVyYXRlSGlzdG9yeVJlcG9ydFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIyCgp0aW1lX3JhbmdlGAIgASgLMh4ubG9naXN0aWNzLmNvbW1vbi52MS5UaW1lUmFuZ2USMgoHZW50cmllcxgDIAMoCzIhLmxvZ2lzdGljcy5yZXBvcnQudjEuSGlzdG9yeUVudHJ5EjoKCnN0YXRpc3RpY3MYBCABKAsyJi5sb2dpc3RpY3MucmVwb3J0LnYxLkhpc3RvcnlTdGF0aXN0aWNzEjEKBmZvcm1hdBgFIAEoDjIhLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Rm9ybWF0EjMKB29wdGlvbnMYBiABKAsyIi5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydE9wdGlvbnMiggIKDEhpc3RvcnlFbnRyeRIWCg5jYWxjdWxhdGlvbl9pZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRuYW1lGAMgASgJEjEKCWFsZ29yaXRobRgEIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtEhAKCG1heF9mbG93GAUgASgBEhIKCnRvdGFsX2Nvc3QYBiABKAESGwoTY29tcHV0YXRpb25fdGltZV9tcxgHIAEoARISCgpub2RlX2NvdW50GAggASgFEhIKCmVkZ2VfY291bnQYCSABKAUihwIKEUhpc3RvcnlTdGF0aXN0aWNzEhoKEnRvdGFsX2NhbGN1bGF0aW9ucxgBIAEoBRIYChBhdmVyYWdlX21heF9mbG93GAIgASgBEhQKDGF2ZXJhZ2VfY29zdBgDIAEoARIjChthdmVyYWdlX2NvbXB1dGF0aW9uX3RpbWVfbXMYBCABKAESTQoMYnlfYWxnb3JpdGhtGAUgAygLMjcubG9naXN0aWNzLnJlcG9ydC52MS5IaXN0b3J5U3RhdGlzdGljcy5CeUFsZ29yaXRobUVudHJ5GjIKEEJ5QWxnb3JpdGhtRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASKzAQodR2VuZXJhdGVIaXN0b3J5UmVwb3J0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI1CghtZXRhZGF0YRgCIAEoCzIjLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0TWV0YWRhdGESMwoHY29udGVudBgDIAEoCzIiLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Q29udGVudBIVCg1lcnJvcl9tZXNzYWdlGAQgASgJIsQCChtHZW5lcmF0ZVJlcG9ydFN0cmVhbVJlcXVlc3QSPgoEZmxvdxgBIAEoCzIuLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVGbG93UmVwb3J0UmVxdWVzdEgAEkgKCWFuYWx5dGljcxgCIAEoCzIzLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVBbmFseXRpY3NSZXBvcnRSZXF1ZXN0SAASSgoKc2ltdWxhdGlvbhgDIAEoCzI0LmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVTaW11bGF0aW9uUmVwb3J0UmVxdWVzdEgAEkQKB3N1bW1hcnkYBCABKAsyMS5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlU3VtbWFyeVJlcG9ydFJlcXVlc3RIAEIJCgdyZXF1ZXN0Io4BCgtSZXBvcnRDaHVuaxITCgtjaHVua19pbmRleBgBIAEoBRIUCgx0b3RhbF9jaHVua3MYAiABKAUSDAoEZGF0YRgDIAEoDBIPCgdpc19sYXN0GAQgASgIEjUKCG1ldGFkYXRhGAUgASgLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YSIlChBHZXRSZXBvcnRSZXF1ZXN0EhEKCXJlcG9ydF9pZBgBIAEoCSKnAQoRR2V0UmVwb3J0UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI1CghtZXRhZGF0YRgCIAEoCzIjLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0TWV0YWRhdGESMwoHY29udGVudBgDIAEoCzIiLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Q29udGVudBIVCg1lcnJvcl9tZXNzYWdlGAQgASgJIikKFEdldFJlcG9ydEluZm9SZXF1ZXN0EhEKCXJlcG9ydF9pZBgBIAEoCSJ2ChVHZXRSZXBvcnRJbmZvUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI1CghtZXRhZGF0YRgCIAEoCzIjLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0TWV0YWRhdGESFQoNZXJyb3JfbWVzc2FnZRgDIAEoCSLyAgoSTGlzdFJlcG9ydHNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBRI0CgtyZXBvcnRfdHlwZRgDIAEoDjIfLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0VHlwZRIxCgZmb3JtYXQYBCABKA4yIS5sb2dpc3RpY3MucmVwb3J0LnYxLlJlcG9ydEZvcm1hdBIWCg5jYWxjdWxhdGlvbl9pZBgFIAEoCRIQCghncmFwaF9pZBgGIAEoCRIPCgd1c2VyX2lkGAcgASgJEgwKBHRhZ3MYCCADKAkSMQoNY3JlYXRlZF9hZnRlchgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMgoOY3JlYXRlZF9iZWZvcmUYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCG9yZGVyX2J5GAsgASgJEhIKCm9yZGVyX2Rlc2MYDCABKAgicgoTTGlzdFJlcG9ydHNSZXNwb25zZRI0CgdyZXBvcnRzGAEgAygLMiMubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRNZXRhZGF0YRITCgt0b3RhbF9jb3VudBgCIAEoAxIQCghoYXNfbW9yZRgDIAEoCCI9ChNEZWxldGVSZXBvcnRSZXF1ZXN0EhEKCXJlcG9ydF9pZBgBIAEoCRITCgtoYXJkX2RlbGV0ZRgCIAEoCCI+ChREZWxldGVSZXBvcnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiSwoXVXBkYXRlUmVwb3J0VGFnc1JlcXVlc3QSEQoJcmVwb3J0X2lkGAEgASgJEgwKBHRhZ3MYAiADKAkSDwoHcmVwbGFjZRgDIAEoCCJQChhVcGRhdGVSZXBvcnRUYWdzUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIMCgR0YWdzGAIgAygJEhUKDWVycm9yX21lc3NhZ2UYAyABKAkiLAoZR2V0UmVwb3NpdG9yeVN0YXRzUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIqAFChpHZXRSZXBvc2l0b3J5U3RhdHNSZXNwb25zZRIVCg10b3RhbF9yZXBvcnRzGAEgASgDEhgKEHRvdGFsX3NpemVfYnl0ZXMYAiABKAMSFgoOYXZnX3NpemVfYnl0ZXMYAyABKAESWwoPcmVwb3J0c19ieV90eXBlGAQgAygLMkIubG9naXN0aWNzLnJlcG9ydC52MS5HZXRSZXBvc2l0b3J5U3RhdHNSZXNwb25zZS5SZXBvcnRzQnlUeXBlRW50cnkSXwoRcmVwb3J0c19ieV9mb3JtYXQYBSADKAsyRC5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFJlcG9zaXRvcnlTdGF0c1Jlc3BvbnNlLlJlcG9ydHNCeUZvcm1hdEVudHJ5ElUKDHNpemVfYnlfdHlwZRgGIAMoCzI/LmxvZ2lzdGljcy5yZXBvcnQudjEuR2V0UmVwb3NpdG9yeVN0YXRzUmVzcG9uc2UuU2l6ZUJ5VHlwZUVudHJ5EjQKEG9sZGVzdF9yZXBvcnRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjQKEG5ld2VzdF9yZXBvcnRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhcKD2V4cGlyZWRfcmVwb3J0cxgJIAEoAxo0ChJSZXBvcnRzQnlUeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgDOgI4ARo2ChRSZXBvcnRzQnlGb3JtYXRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6AjgBGjEKD1NpemVCeVR5cGVFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6AjgBIhwKGkdldFN1cHBvcnRlZEZvcm1hdHNSZXF1ZXN0Ik8KG0dldFN1cHBvcnRlZEZvcm1hdHNSZXNwb25zZRIwCgdmb3JtYXRzGAEgAygLMh8ubG9naXN0aWNzLnJlcG9ydC52MS5Gb3JtYXRJbmZvIv8BCgpGb3JtYXRJbmZvEjEKBmZvcm1hdBgBIAEoDjIhLmxvZ2lzdGljcy5yZXBvcnQudjEuUmVwb3J0Rm9ybWF0EgwKBG5hbWUYAiABKAkSEQoJZXh0ZW5zaW9uGAMgASgJEhEKCW1pbWVfdHlwZRgEIAEoCRIXCg9zdXBwb3J0c19jaGFydHMYBSABKAgSGAoQc3VwcG9ydHNfc3R5bGluZxgGIAEoCBI/ChZzdXBwb3J0ZWRfcmVwb3J0X3R5cGVzGAcgAygOMh8ubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRUeXBlEhYKDm1heF9zaXplX2J5dGVzGAggASgDIg8KDUhlYWx0aFJlcXVlc3QimQEKDkhlYWx0aFJlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEhYKDnVwdGltZV9zZWNvbmRzGAMgASgDEhkKEXJlcG9ydHNfZ2VuZXJhdGVkGAQgASgDEjMKB3N0b3JhZ2UYBSABKAsyIi5sb2dpc3RpY3MucmVwb3J0LnYxLlN0b3JhZ2VIZWFsdGgiaAoNU3RvcmFnZUhlYWx0aBIOCgZzdGF0dXMYASABKAkSFgoOc3RvcmVkX3JlcG9ydHMYAiABKAMSGAoQdG90YWxfc2l6ZV9ieXRlcxgDIAEoAxIVCg1lcnJvcl9tZXNzYWdlGAQgASgJKsABCgxSZXBvcnRGb3JtYXQSHQoZUkVQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhoKFlJFUE9SVF9GT1JNQVRfTUFSS0RPV04QARIVChFSRVBPUlRfRk9STUFUX0NTVhACEhcKE1JFUE9SVF9GT1JNQVRfRVhDRUwQAxIVChFSRVBPUlRfRk9STUFUX1BERhAEEhYKElJFUE9SVF9GT1JNQVRfSFRNTBAFEhYKElJFUE9SVF9GT1JNQVRfSlNPThAGKsQBCgpSZXBvcnRUeXBlEhsKF1JFUE9SVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQUkVQT1JUX1RZUEVfRkxPVxABEhkKFVJFUE9SVF9UWVBFX0FOQUxZVElDUxACEhoKFlJFUE9SVF9UWVBFX1NJTVVMQVRJT04QAxIXChNSRVBPUlRfVFlQRV9TVU1NQVJZEAQSFwoTUkVQT1JUX1RZUEVfSElTVE9SWRAFEhoKFlJFUE9SVF9UWVBFX0NPTVBBUklTT04QBjLPDQoNUmVwb3J0U2VydmljZRJ1ChJHZW5lcmF0ZUZsb3dSZXBvcnQSLi5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlRmxvd1JlcG9ydFJlcXVlc3QaLy5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlRmxvd1JlcG9ydFJlc3BvbnNlEoQBChdHZW5lcmF0ZUFuYWx5dGljc1JlcG9ydBIzLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVBbmFseXRpY3NSZXBvcnRSZXF1ZXN0GjQubG9naXN0aWNzLnJlcG9ydC52MS5HZW5lcmF0ZUFuYWx5dGljc1JlcG9ydFJlc3BvbnNlEocBChhHZW5lcmF0ZVNpbXVsYXRpb25SZXBvcnQSNC5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlU2ltdWxhdGlvblJlcG9ydFJlcXVlc3QaNS5sb2dpc3RpY3MucmVwb3J0LnYxLkdlbmVyYXRlU2ltdWxhdGlvblJlcG9ydFJlc3BvbnNlEn4KFUdlbmVyYXRlU3VtbWFyeVJlcG9ydBIxLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVTdW1tYXJ5UmVwb3J0UmVxdWVzdBoyLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVTdW1tYXJ5UmVwb3J0UmVzcG9uc2UShwEKGEdlbmVyYXRlQ29tcGFyaXNvblJlcG9ydBI0LmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVDb21wYXJpc29uUmVwb3J0UmVxdWVzdBo1LmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVDb21wYXJpc29uUmVwb3J0UmVzcG9uc2USfgoVR2VuZXJhdGVIaXN0b3J5UmVwb3J0EjEubG9naXN0aWNzLnJlcG9ydC52MS5HZW5lcmF0ZUhpc3RvcnlSZXBvcnRSZXF1ZXN0GjIubG9naXN0aWNzLnJlcG9ydC52MS5HZW5lcmF0ZUhpc3RvcnlSZXBvcnRSZXNwb25zZRJsChRHZW5lcmF0ZVJlcG9ydFN0cmVhbRIwLmxvZ2lzdGljcy5yZXBvcnQudjEuR2VuZXJhdGVSZXBvcnRTdHJlYW1SZXF1ZXN0GiAubG9naXN0aWNzLnJlcG9ydC52MS5SZXBvcnRDaHVuazABEloKCUdldFJlcG9ydBIlLmxvZ2lzdGljcy5yZXBvcnQudjEuR2V0UmVwb3J0UmVxdWVzdBomLmxvZ2lzdGljcy5yZXBvcnQudjEuR2V0UmVwb3J0UmVzcG9uc2USZgoNR2V0UmVwb3J0SW5mbxIpLmxvZ2lzdGljcy5yZXBvcnQudjEuR2V0UmVwb3J0SW5mb1JlcXVlc3QaKi5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFJlcG9ydEluZm9SZXNwb25zZRJgCgtMaXN0UmVwb3J0cxInLmxvZ2lzdGljcy5yZXBvcnQudjEuTGlzdFJlcG9ydHNSZXF1ZXN0GigubG9naXN0aWNzLnJlcG9ydC52MS5MaXN0UmVwb3J0c1Jlc3BvbnNlEmMKDERlbGV0ZVJlcG9ydBIoLmxvZ2lzdGljcy5yZXBvcnQudjEuRGVsZXRlUmVwb3J0UmVxdWVzdBopLmxvZ2lzdGljcy5yZXBvcnQudjEuRGVsZXRlUmVwb3J0UmVzcG9uc2USbwoQVXBkYXRlUmVwb3J0VGFncxIsLmxvZ2lzdGljcy5yZXBvcnQudjEuVXBkYXRlUmVwb3J0VGFnc1JlcXVlc3QaLS5sb2dpc3RpY3MucmVwb3J0LnYxLlVwZGF0ZVJlcG9ydFRhZ3NSZXNwb25zZRJ1ChJHZXRSZXBvc2l0b3J5U3RhdHMSLi5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFJlcG9zaXRvcnlTdGF0c1JlcXVlc3QaLy5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFJlcG9zaXRvcnlTdGF0c1Jlc3BvbnNlEngKE0dldFN1cHBvcnRlZEZvcm1hdHMSLy5sb2dpc3RpY3MucmVwb3J0LnYxLkdldFN1cHBvcnRlZEZvcm1hdHNSZXF1ZXN0GjAubG9naXN0aWNzLnJlcG9ydC52MS5HZXRTdXBwb3J0ZWRGb3JtYXRzUmVzcG9uc2USUQoGSGVhbHRoEiIubG9naXN0aWNzLnJlcG9ydC52MS5IZWFsdGhSZXF1ZXN0GiMubG9naXN0aWNzLnJlcG9ydC52MS5IZWFsdGhSZXNwb25zZULDAQoXY29tLmxvZ2lzdGljcy5yZXBvcnQudjFCC1JlcG9ydFByb3RvUAFaLWxvZ2lzdGljcy9nZW4vZ28vbG9naXN0aWNzL3JlcG9ydC92MTtyZXBvcnR2MaICA0xSWKoCE0xvZ2lzdGljcy5SZXBvcnQuVjHKAhNMb2dpc3RpY3NcUmVwb3J0XFYx4gIfTG9naXN0aWNzXFJlcG9ydFxWMVxHUEJNZXRhZGF0YeoCFUxvZ2lzdGljczo6UmVwb3J0OjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_logistics_analytics_v1_analytics, file_logistics_common_v1_common, file_logistics_optimization_v1_solver, file_logistics_simulation_v1_simulation]);
 
 /**
  * @generated from message logistics.report.v1.ReportMetadata
@@ -438,6 +438,11 @@ export type GenerateAnalyticsReportRequest = Message<"logistics.report.v1.Genera
    */
   graphStats?: GraphStatistics;
 
+  /**
+   * @generated from field: logistics.analytics.v1.BuildOriginDestinationMatrixResponse origin_destination = 11;
+   */
+  originDestination?: BuildOriginDestinationMatrixResponse;
+
   /**
    * @generated from field: logistics.report.v1.ReportFormat format = 7;
    */
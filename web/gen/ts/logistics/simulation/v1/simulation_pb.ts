@@ -6,7 +6,9 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { Algorithm, EdgeKey, FlowStatus, Graph, PaginationRequest, PaginationResponse } from "../../common/v1/common_pb";
+import type { FixedCostConfig } from "../../analytics/v1/analytics_pb";
+import { file_logistics_analytics_v1_analytics } from "../../analytics/v1/analytics_pb";
+import type { Algorithm, EdgeKey, FlowStatus, Graph, NodeBalance, PaginationRequest, PaginationResponse, RoadType } from "../../common/v1/common_pb";
 import { file_logistics_common_v1_common } from "../../common/v1/common_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -14,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file logistics/simulation/v1/simulation.proto.
  */
 export const file_logistics_simulation_v1_simulation: GenFile = /*@__PURE__*/
-  fileDesc("Cihsb2dpc3RpY3Mvc2ltdWxhdGlvbi92MS9zaW11bGF0aW9uLnByb3RvEhdsb2dpc3RpY3Muc2ltdWxhdGlvbi52MSLwAQoQUnVuV2hhdElmUmVxdWVzdBIyCg5iYXNlbGluZV9ncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPAoNbW9kaWZpY2F0aW9ucxgCIAMoCzIlLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vZGlmaWNhdGlvbhIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRI3CgdvcHRpb25zGAQgASgLMiYubG9naXN0aWNzLnNpbXVsYXRpb24udjEuV2hhdElmT3B0aW9ucyKqAgoMTW9kaWZpY2F0aW9uEjcKBHR5cGUYASABKA4yKS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb2RpZmljYXRpb25UeXBlEi4KCGVkZ2Vfa2V5GAIgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5Eg8KB25vZGVfaWQYAyABKAMSGAoOYWJzb2x1dGVfdmFsdWUYCiABKAFIABIZCg9yZWxhdGl2ZV9jaGFuZ2UYCyABKAFIABIPCgVkZWx0YRgMIAEoAUgAEjsKBnRhcmdldBgNIAEoDjIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vZGlmaWNhdGlvblRhcmdldBITCgtkZXNjcmlwdGlvbhgOIAEoCUIICgZjaGFuZ2UiigEKDVdoYXRJZk9wdGlvbnMSHQoVY29tcGFyZV93aXRoX2Jhc2VsaW5lGAEgASgIEh0KFWNhbGN1bGF0ZV9jb3N0X2ltcGFjdBgCIAEoCBIcChRmaW5kX25ld19ib3R0bGVuZWNrcxgDIAEoCBIdChVyZXR1cm5fbW9kaWZpZWRfZ3JhcGgYBCABKAgilQMKEVJ1bldoYXRJZlJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSOQoIYmFzZWxpbmUYAiABKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb1Jlc3VsdBI5Cghtb2RpZmllZBgDIAEoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvUmVzdWx0Ej8KCmNvbXBhcmlzb24YBCABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb0NvbXBhcmlzb24SMgoObW9kaWZpZWRfZ3JhcGgYBSABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEkUKEmJvdHRsZW5lY2tfY2hhbmdlcxgGIAMoCzIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkJvdHRsZW5lY2tDaGFuZ2USPQoIbWV0YWRhdGEYByABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uTWV0YWRhdGEiwQEKDlNjZW5hcmlvUmVzdWx0EgwKBG5hbWUYASABKAkSEAoIbWF4X2Zsb3cYAiABKAESEgoKdG90YWxfY29zdBgDIAEoARIbChNhdmVyYWdlX3V0aWxpemF0aW9uGAQgASgBEhcKD3NhdHVyYXRlZF9lZGdlcxgFIAEoBRIUCgxhY3RpdmVfcGF0aHMYBiABKAUSLwoGc3RhdHVzGAcgASgOMh8ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93U3RhdHVzIugBChJTY2VuYXJpb0NvbXBhcmlzb24SEwoLZmxvd19jaGFuZ2UYASABKAESGwoTZmxvd19jaGFuZ2VfcGVyY2VudBgCIAEoARITCgtjb3N0X2NoYW5nZRgDIAEoARIbChNjb3N0X2NoYW5nZV9wZXJjZW50GAQgASgBEhoKEnV0aWxpemF0aW9uX2NoYW5nZRgFIAEoARIWCg5pbXBhY3Rfc3VtbWFyeRgGIAEoCRI6CgxpbXBhY3RfbGV2ZWwYByABKA4yJC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5JbXBhY3RMZXZlbCK0AQoQQm90dGxlbmVja0NoYW5nZRIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EkIKC2NoYW5nZV90eXBlGAIgASgOMi0ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQm90dGxlbmVja0NoYW5nZVR5cGUSFwoPb2xkX3V0aWxpemF0aW9uGAMgASgBEhcKD25ld191dGlsaXphdGlvbhgEIAEoASLwAQoXQ29tcGFyZVNjZW5hcmlvc1JlcXVlc3QSMgoOYmFzZWxpbmVfZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjQKCXNjZW5hcmlvcxgCIAMoCzIhLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtEjgKB29wdGlvbnMYBCABKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Db21wYXJlT3B0aW9ucyJrCghTY2VuYXJpbxIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEjwKDW1vZGlmaWNhdGlvbnMYAyADKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb2RpZmljYXRpb24idwoOQ29tcGFyZU9wdGlvbnMSFAoMcmFua19ieV9mbG93GAEgASgIEhQKDHJhbmtfYnlfY29zdBgCIAEoCBIVCg1jYWxjdWxhdGVfcm9pGAMgASgIEiIKGm1vZGlmaWNhdGlvbl9jb3N0X3Blcl91bml0GAQgASgBIo4CChhDb21wYXJlU2NlbmFyaW9zUmVzcG9uc2USOQoIYmFzZWxpbmUYASABKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb1Jlc3VsdBJJChByYW5rZWRfc2NlbmFyaW9zGAIgAygLMi8ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2NlbmFyaW9SZXN1bHRXaXRoUmFuaxIVCg1iZXN0X3NjZW5hcmlvGAMgASgJEhYKDnJlY29tbWVuZGF0aW9uGAQgASgJEj0KCG1ldGFkYXRhGAUgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvbk1ldGFkYXRhIr0BChZTY2VuYXJpb1Jlc3VsdFdpdGhSYW5rEjcKBnJlc3VsdBgBIAEoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvUmVzdWx0EgwKBHJhbmsYAiABKAUSDQoFc2NvcmUYAyABKAESCwoDcm9pGAQgASgBEkAKC3ZzX2Jhc2VsaW5lGAUgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2NlbmFyaW9Db21wYXJpc29uIr4CChhSdW5UaW1lU2ltdWxhdGlvblJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEkIKC3RpbWVfY29uZmlnGAIgASgLMi0ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuVGltZVNpbXVsYXRpb25Db25maWcSPwoNZWRnZV9wYXR0ZXJucxgDIAMoCzIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkVkZ2VUaW1lUGF0dGVybhI/Cg1ub2RlX3BhdHRlcm5zGAQgAygLMigubG9naXN0aWNzLnNpbXVsYXRpb24udjEuTm9kZVRpbWVQYXR0ZXJuEjEKCWFsZ29yaXRobRgFIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtIr0BChRUaW1lU2ltdWxhdGlvbkNvbmZpZxIuCgpzdGFydF90aW1lGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNAoJdGltZV9zdGVwGAMgASgOMiEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuVGltZVN0ZXASEQoJbnVtX3N0ZXBzGAQgASgFInQKD0VkZ2VUaW1lUGF0dGVybhIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EjUKB3BhdHRlcm4YAiABKAsyJC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5UaW1lUGF0dGVybiKRAQoPTm9kZVRpbWVQYXR0ZXJuEg8KB25vZGVfaWQYASABKAMSNQoHcGF0dGVybhgCIAEoCzIkLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlRpbWVQYXR0ZXJuEjYKBnRhcmdldBgDIAEoDjImLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlBhdHRlcm5UYXJnZXQi+AEKC1RpbWVQYXR0ZXJuEjIKBHR5cGUYASABKA4yJC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5QYXR0ZXJuVHlwZRIaChJob3VybHlfbXVsdGlwbGllcnMYAiADKAESGQoRZGFpbHlfbXVsdGlwbGllcnMYAyADKAESOQoNY3VzdG9tX3BvaW50cxgEIAMoCzIiLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlRpbWVQb2ludBIMCgRtZWFuGAUgASgBEg8KB3N0ZF9kZXYYBiABKAESEQoJbWluX3ZhbHVlGAcgASgBEhEKCW1heF92YWx1ZRgIIAEoASItCglUaW1lUG9pbnQSDAoEc3RlcBgBIAEoBRISCgptdWx0aXBsaWVyGAIgASgBIqoCChlSdW5UaW1lU2ltdWxhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSPQoMc3RlcF9yZXN1bHRzGAIgAygLMicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuVGltZVN0ZXBSZXN1bHQSOwoFc3RhdHMYAyABKAsyLC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5UaW1lU2ltdWxhdGlvblN0YXRzEkEKEGNyaXRpY2FsX3BlcmlvZHMYBCADKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Dcml0aWNhbFBlcmlvZBI9CghtZXRhZGF0YRgFIAEoCzIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25NZXRhZGF0YSLcAQoOVGltZVN0ZXBSZXN1bHQSDAoEc3RlcBgBIAEoBRItCgl0aW1lc3RhbXAYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCG1heF9mbG93GAMgASgBEhIKCnRvdGFsX2Nvc3QYBCABKAESGwoTYXZlcmFnZV91dGlsaXphdGlvbhgFIAEoARIXCg9zYXR1cmF0ZWRfZWRnZXMYBiABKAUSMQoLYm90dGxlbmVja3MYByADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkizAEKE1RpbWVTaW11bGF0aW9uU3RhdHMSEAoIbWluX2Zsb3cYASABKAESEAoIbWF4X2Zsb3cYAiABKAESEAoIYXZnX2Zsb3cYAyABKAESFAoMc3RkX2Rldl9mbG93GAQgASgBEhAKCG1pbl9jb3N0GAUgASgBEhAKCG1heF9jb3N0GAYgASgBEhAKCGF2Z19jb3N0GAcgASgBEhMKC3RvdGFsX3N0ZXBzGAggASgFEh4KFnN0ZXBzX3dpdGhfYm90dGxlbmVja3MYCSABKAUi9gEKDkNyaXRpY2FsUGVyaW9kEhIKCnN0YXJ0X3N0ZXAYASABKAUSEAoIZW5kX3N0ZXAYAiABKAUSLgoKc3RhcnRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjkKBHR5cGUYBSABKA4yKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Dcml0aWNhbFBlcmlvZFR5cGUSEAoIc2V2ZXJpdHkYBiABKAESEwoLZGVzY3JpcHRpb24YByABKAki/AEKF1NpbXVsYXRlUGVha0xvYWRSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIZChFkZW1hbmRfbXVsdGlwbGllchgCIAEoARIaChJjYXBhY2l0eV9yZWR1Y3Rpb24YAyABKAESFgoOYWZmZWN0ZWRfbm9kZXMYBCADKAMSNAoOYWZmZWN0ZWRfZWRnZXMYBSADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSMQoJYWxnb3JpdGhtGAYgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0ihQMKGFNpbXVsYXRlUGVha0xvYWRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEj4KDW5vcm1hbF9yZXN1bHQYAiABKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb1Jlc3VsdBI8CgtwZWFrX3Jlc3VsdBgDIAEoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvUmVzdWx0Ej8KCmNvbXBhcmlzb24YBCABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb0NvbXBhcmlzb24SQQoQb3ZlcmxvYWRlZF9lZGdlcxgFIAMoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk92ZXJsb2FkZWRFZGdlEhcKD3JlY29tbWVuZGF0aW9ucxgGIAMoCRI9CghtZXRhZGF0YRgHIAEoCzIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25NZXRhZGF0YSKfAQoOT3ZlcmxvYWRlZEVkZ2USKgoEZWRnZRgBIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIZChFyZXF1aXJlZF9jYXBhY2l0eRgCIAEoARIaChJhdmFpbGFibGVfY2FwYWNpdHkYAyABKAESEAoIc2hvcnRhZ2UYBCABKAESGAoQc2hvcnRhZ2VfcGVyY2VudBgFIAEoASLwAQoUUnVuTW9udGVDYXJsb1JlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjkKBmNvbmZpZxgCIAEoCzIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vbnRlQ2FybG9Db25maWcSPwoNdW5jZXJ0YWludGllcxgDIAMoCzIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlVuY2VydGFpbnR5U3BlYxIxCglhbGdvcml0aG0YBCABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSKAAQoQTW9udGVDYXJsb0NvbmZpZxIWCg5udW1faXRlcmF0aW9ucxgBIAEoBRITCgtyYW5kb21fc2VlZBgCIAEoAxIYChBjb25maWRlbmNlX2xldmVsGAMgASgBEhAKCHBhcmFsbGVsGAQgASgIEhMKC21heF93b3JrZXJzGAUgASgFIoACCg9VbmNlcnRhaW50eVNwZWMSNgoEdHlwZRgBIAEoDjIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlVuY2VydGFpbnR5VHlwZRIqCgRlZGdlGAIgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5Eg8KB25vZGVfaWQYAyABKAMSOwoGdGFyZ2V0GAQgASgOMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuTW9kaWZpY2F0aW9uVGFyZ2V0EjsKDGRpc3RyaWJ1dGlvbhgFIAEoCzIlLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkRpc3RyaWJ1dGlvbiJ3CgxEaXN0cmlidXRpb24SNwoEdHlwZRgBIAEoDjIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkRpc3RyaWJ1dGlvblR5cGUSDgoGcGFyYW0xGAIgASgBEg4KBnBhcmFtMhgDIAEoARIOCgZwYXJhbTMYBCABKAEimAYKFVJ1bk1vbnRlQ2FybG9SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjwKCmZsb3dfc3RhdHMYAiABKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb250ZUNhcmxvU3RhdHMSPAoKY29zdF9zdGF0cxgDIAEoCzIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vbnRlQ2FybG9TdGF0cxJACg5mbG93X2hpc3RvZ3JhbRgEIAMoCzIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkhpc3RvZ3JhbUJ1Y2tldBJACg5jb3N0X2hpc3RvZ3JhbRgFIAMoCzIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkhpc3RvZ3JhbUJ1Y2tldBJdChBmbG93X3BlcmNlbnRpbGVzGAYgAygLMkMubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUnVuTW9udGVDYXJsb1Jlc3BvbnNlLkZsb3dQZXJjZW50aWxlc0VudHJ5El0KEGNvc3RfcGVyY2VudGlsZXMYByADKAsyQy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5Nb250ZUNhcmxvUmVzcG9uc2UuQ29zdFBlcmNlbnRpbGVzRW50cnkSPAoNcmlza19hbmFseXNpcxgIIAEoCzIlLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJpc2tBbmFseXNpcxJDCgxjb3JyZWxhdGlvbnMYCSADKAsyLS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5QYXJhbWV0ZXJDb3JyZWxhdGlvbhI9CghtZXRhZGF0YRgKIAEoCzIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25NZXRhZGF0YRo2ChRGbG93UGVyY2VudGlsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBGjYKFENvc3RQZXJjZW50aWxlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEi0wEKD01vbnRlQ2FybG9TdGF0cxIMCgRtZWFuGAEgASgBEg8KB3N0ZF9kZXYYAiABKAESCwoDbWluGAMgASgBEgsKA21heBgEIAEoARIOCgZtZWRpYW4YBSABKAESEAoIdmFyaWFuY2UYBiABKAESEAoIc2tld25lc3MYByABKAESEAoIa3VydG9zaXMYCCABKAESHwoXY29uZmlkZW5jZV9pbnRlcnZhbF9sb3cYCSABKAESIAoYY29uZmlkZW5jZV9pbnRlcnZhbF9oaWdoGAogASgBIl0KD0hpc3RvZ3JhbUJ1Y2tldBITCgtsb3dlcl9ib3VuZBgBIAEoARITCgt1cHBlcl9ib3VuZBgCIAEoARINCgVjb3VudBgDIAEoBRIRCglmcmVxdWVuY3kYBCABKAEi1gEKDFJpc2tBbmFseXNpcxIjChtwcm9iYWJpbGl0eV9iZWxvd190aHJlc2hvbGQYASABKAESFQoNdmFsdWVfYXRfcmlzaxgCIAEoARIaChJleHBlY3RlZF9zaG9ydGZhbGwYAyABKAESFwoPd29yc3RfY2FzZV9mbG93GAQgASgBEhYKDmJlc3RfY2FzZV9mbG93GAUgASgBEj0KDnJpc2tfc2NlbmFyaW9zGAYgAygLMiUubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUmlza1NjZW5hcmlvImIKDFJpc2tTY2VuYXJpbxITCgtkZXNjcmlwdGlvbhgBIAEoCRITCgtwcm9iYWJpbGl0eRgCIAEoARITCgtmbG93X2ltcGFjdBgDIAEoARITCgtjb3N0X2ltcGFjdBgEIAEoASKGAQoUUGFyYW1ldGVyQ29ycmVsYXRpb24SFgoOcGFyYW1ldGVyX25hbWUYASABKAkSHQoVY29ycmVsYXRpb25fd2l0aF9mbG93GAIgASgBEh0KFWNvcnJlbGF0aW9uX3dpdGhfY29zdBgDIAEoARIYChBpbXBvcnRhbmNlX3Njb3JlGAQgASgBIp8BChJNb250ZUNhcmxvUHJvZ3Jlc3MSEQoJaXRlcmF0aW9uGAEgASgFEhgKEHRvdGFsX2l0ZXJhdGlvbnMYAiABKAUSGAoQcHJvZ3Jlc3NfcGVyY2VudBgDIAEoARIZChFjdXJyZW50X21lYW5fZmxvdxgEIAEoARIXCg9jdXJyZW50X3N0ZF9kZXYYBSABKAESDgoGc3RhdHVzGAYgASgJIvgBChlBbmFseXplU2Vuc2l0aXZpdHlSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBJBCgpwYXJhbWV0ZXJzGAIgAygLMi0ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2Vuc2l0aXZpdHlQYXJhbWV0ZXISOgoGY29uZmlnGAMgASgLMioubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2Vuc2l0aXZpdHlDb25maWcSMQoJYWxnb3JpdGhtGAQgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0i0wEKFFNlbnNpdGl2aXR5UGFyYW1ldGVyEioKBGVkZ2UYASABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSDwoHbm9kZV9pZBgCIAEoAxI7CgZ0YXJnZXQYAyABKA4yKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb2RpZmljYXRpb25UYXJnZXQSFgoObWluX211bHRpcGxpZXIYBCABKAESFgoObWF4X211bHRpcGxpZXIYBSABKAESEQoJbnVtX3N0ZXBzGAYgASgFIoYBChFTZW5zaXRpdml0eUNvbmZpZxI6CgZtZXRob2QYASABKA4yKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TZW5zaXRpdml0eU1ldGhvZBIcChRjYWxjdWxhdGVfZWxhc3RpY2l0eRgCIAEoCBIXCg9maW5kX3RocmVzaG9sZHMYAyABKAgirQIKGkFuYWx5emVTZW5zaXRpdml0eVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSRQoRcGFyYW1ldGVyX3Jlc3VsdHMYAiADKAsyKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TZW5zaXRpdml0eVJlc3VsdBI7CghyYW5raW5ncxgDIAMoCzIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlBhcmFtZXRlclJhbmtpbmcSOwoKdGhyZXNob2xkcxgEIAMoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlRocmVzaG9sZFBvaW50Ej0KCG1ldGFkYXRhGAUgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvbk1ldGFkYXRhIuIBChFTZW5zaXRpdml0eVJlc3VsdBIUCgxwYXJhbWV0ZXJfaWQYASABKAkSOAoFY3VydmUYAiADKAsyKS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TZW5zaXRpdml0eVBvaW50EhIKCmVsYXN0aWNpdHkYAyABKAESGQoRc2Vuc2l0aXZpdHlfaW5kZXgYBCABKAESFAoMaW1wYWN0X3JhbmdlGAUgASgBEjgKBWxldmVsGAYgASgOMikubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2Vuc2l0aXZpdHlMZXZlbCJTChBTZW5zaXRpdml0eVBvaW50EhcKD3BhcmFtZXRlcl92YWx1ZRgBIAEoARISCgpmbG93X3ZhbHVlGAIgASgBEhIKCmNvc3RfdmFsdWUYAyABKAEiZgoQUGFyYW1ldGVyUmFua2luZxIUCgxwYXJhbWV0ZXJfaWQYASABKAkSDAoEcmFuaxgCIAEoBRIZChFzZW5zaXRpdml0eV9pbmRleBgDIAEoARITCgtkZXNjcmlwdGlvbhgEIAEoCSKKAQoOVGhyZXNob2xkUG9pbnQSFAoMcGFyYW1ldGVyX2lkGAEgASgJEhcKD3RocmVzaG9sZF92YWx1ZRgCIAEoARI0CgR0eXBlGAMgASgOMiYubG9naXN0aWNzLnNpbXVsYXRpb24udjEuVGhyZXNob2xkVHlwZRITCgtkZXNjcmlwdGlvbhgEIAEoCSK8AQobRmluZENyaXRpY2FsRWxlbWVudHNSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI/CgZjb25maWcYAiABKAsyLy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Dcml0aWNhbEVsZW1lbnRzQ29uZmlnEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtInAKFkNyaXRpY2FsRWxlbWVudHNDb25maWcSFQoNYW5hbHl6ZV9lZGdlcxgBIAEoCBIVCg1hbmFseXplX25vZGVzGAIgASgIEg0KBXRvcF9uGAMgASgFEhkKEWZhaWx1cmVfdGhyZXNob2xkGAQgASgBIsYCChxGaW5kQ3JpdGljYWxFbGVtZW50c1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSPQoOY3JpdGljYWxfZWRnZXMYAiADKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Dcml0aWNhbEVkZ2USPQoOY3JpdGljYWxfbm9kZXMYAyADKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Dcml0aWNhbE5vZGUSPgoYc2luZ2xlX3BvaW50c19vZl9mYWlsdXJlGAQgAygLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EhgKEHJlc2lsaWVuY2Vfc2NvcmUYBSABKAESPQoIbWV0YWRhdGEYBiABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uTWV0YWRhdGEixwEKDENyaXRpY2FsRWRnZRIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EhkKEWNyaXRpY2FsaXR5X3Njb3JlGAIgASgBEh4KFmZsb3dfaW1wYWN0X2lmX3JlbW92ZWQYAyABKAESHgoWY29zdF9pbXBhY3RfaWZfcmVtb3ZlZBgEIAEoARIMCgRyYW5rGAUgASgFEiIKGmlzX3NpbmdsZV9wb2ludF9vZl9mYWlsdXJlGAYgASgIIqQBCgxDcml0aWNhbE5vZGUSDwoHbm9kZV9pZBgBIAEoAxIZChFjcml0aWNhbGl0eV9zY29yZRgCIAEoARIeChZmbG93X2ltcGFjdF9pZl9yZW1vdmVkGAMgASgBEhYKDmFmZmVjdGVkX2VkZ2VzGAQgASgFEgwKBHJhbmsYBSABKAUSIgoaaXNfc2luZ2xlX3BvaW50X29mX2ZhaWx1cmUYBiABKAgigQIKF1NpbXVsYXRlRmFpbHVyZXNSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBJDChFmYWlsdXJlX3NjZW5hcmlvcxgCIAMoCzIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZhaWx1cmVTY2VuYXJpbxJDCg1yYW5kb21fY29uZmlnGAMgASgLMiwubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUmFuZG9tRmFpbHVyZUNvbmZpZxIxCglhbGdvcml0aG0YBCABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSJ+Cg9GYWlsdXJlU2NlbmFyaW8SDAoEbmFtZRgBIAEoCRIyCgxmYWlsZWRfZWRnZXMYAiADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSFAoMZmFpbGVkX25vZGVzGAMgAygDEhMKC3Byb2JhYmlsaXR5GAQgASgBIrABChNSYW5kb21GYWlsdXJlQ29uZmlnEhUKDW51bV9zY2VuYXJpb3MYASABKAUSIAoYZWRnZV9mYWlsdXJlX3Byb2JhYmlsaXR5GAIgASgBEiAKGG5vZGVfZmFpbHVyZV9wcm9iYWJpbGl0eRgDIAEoARIhChltYXhfc2ltdWx0YW5lb3VzX2ZhaWx1cmVzGAQgASgFEhsKE2NvcnJlbGF0ZWRfZmFpbHVyZXMYBSABKAgi8QIKGFNpbXVsYXRlRmFpbHVyZXNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEjkKCGJhc2VsaW5lGAIgASgLMicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2NlbmFyaW9SZXN1bHQSSAoQc2NlbmFyaW9fcmVzdWx0cxgDIAMoCzIuLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZhaWx1cmVTY2VuYXJpb1Jlc3VsdBI0CgVzdGF0cxgEIAEoCzIlLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZhaWx1cmVTdGF0cxJKCg9yZWNvbW1lbmRhdGlvbnMYBSADKAsyMS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SZXNpbGllbmNlUmVjb21tZW5kYXRpb24SPQoIbWV0YWRhdGEYBiABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uTWV0YWRhdGEi3AEKFUZhaWx1cmVTY2VuYXJpb1Jlc3VsdBIVCg1zY2VuYXJpb19uYW1lGAEgASgJEhMKC3Byb2JhYmlsaXR5GAIgASgBEjcKBnJlc3VsdBgDIAEoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvUmVzdWx0EkAKC3ZzX2Jhc2VsaW5lGAQgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2NlbmFyaW9Db21wYXJpc29uEhwKFG5ldHdvcmtfZGlzY29ubmVjdGVkGAUgASgIIosBCgxGYWlsdXJlU3RhdHMSGgoSZXhwZWN0ZWRfZmxvd19sb3NzGAEgASgBEhUKDW1heF9mbG93X2xvc3MYAiABKAESJAoccHJvYmFiaWxpdHlfb2ZfZGlzY29ubmVjdGlvbhgDIAEoARIiChphdmVyYWdlX3JlY292ZXJ5X3BvdGVudGlhbBgEIAEoASLtAQoYUmVzaWxpZW5jZVJlY29tbWVuZGF0aW9uEjkKBHR5cGUYASABKA4yKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SZWNvbW1lbmRhdGlvblR5cGUSEwoLZGVzY3JpcHRpb24YAiABKAkSMwoNYWZmZWN0ZWRfZWRnZRgDIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIVCg1hZmZlY3RlZF9ub2RlGAQgASgDEh0KFWVzdGltYXRlZF9pbXByb3ZlbWVudBgFIAEoARIWCg5lc3RpbWF0ZWRfY29zdBgGIAEoASKzAQoYQW5hbHl6ZVJlc2lsaWVuY2VSZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI5CgZjb25maWcYAiABKAsyKS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SZXNpbGllbmNlQ29uZmlnEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtImYKEFJlc2lsaWVuY2VDb25maWcSHAoUbWF4X2ZhaWx1cmVzX3RvX3Rlc3QYASABKAUSHwoXdGVzdF9jYXNjYWRpbmdfZmFpbHVyZXMYAiABKAgSEwoLbG9hZF9mYWN0b3IYAyABKAEi6wIKGUFuYWx5emVSZXNpbGllbmNlUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI7CgdtZXRyaWNzGAIgASgLMioubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUmVzaWxpZW5jZU1ldHJpY3MSPwoLbl9taW51c19vbmUYAyABKAsyKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5OTWludXNPbmVBbmFseXNpcxI/CgtuX21pbnVzX3R3bxgEIAEoCzIqLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk5NaW51c1R3b0FuYWx5c2lzEj8KCndlYWtuZXNzZXMYBSADKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SZXNpbGllbmNlV2Vha25lc3MSPQoIbWV0YWRhdGEYBiABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uTWV0YWRhdGEilAEKEVJlc2lsaWVuY2VNZXRyaWNzEhUKDW92ZXJhbGxfc2NvcmUYASABKAESHwoXY29ubmVjdGl2aXR5X3JvYnVzdG5lc3MYAiABKAESFwoPZmxvd19yb2J1c3RuZXNzGAMgASgBEhgKEHJlZHVuZGFuY3lfbGV2ZWwYBCABKAESFAoMbWluX2N1dF9zaXplGAUgASgFIuABChFOTWludXNPbmVBbmFseXNpcxIeChZhbGxfc2NlbmFyaW9zX2ZlYXNpYmxlGAEgASgIEiEKGXdvcnN0X2Nhc2VfZmxvd19yZWR1Y3Rpb24YAiABKAESOAoSbW9zdF9jcml0aWNhbF9lZGdlGAMgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EhoKEm1vc3RfY3JpdGljYWxfbm9kZRgEIAEoAxIYChBzY2VuYXJpb3NfdGVzdGVkGAUgASgFEhgKEHNjZW5hcmlvc19mYWlsZWQYBiABKAUinAEKEU5NaW51c1R3b0FuYWx5c2lzEg8KB2VuYWJsZWQYASABKAgSHgoWcHJvYmFiaWxpdHlfb2ZfZmFpbHVyZRgCIAEoARIWCg5jcml0aWNhbF9wYWlycxgDIAEoBRI+ChNjcml0aWNhbF9lZGdlX3BhaXJzGAQgAygLMiEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuRWRnZVBhaXIifQoIRWRnZVBhaXISKwoFZWRnZTEYASABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSKwoFZWRnZTIYAiABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSFwoPY29tYmluZWRfaW1wYWN0GAMgASgBIt0BChJSZXNpbGllbmNlV2Vha25lc3MSEwoLZGVzY3JpcHRpb24YASABKAkSMwoEdHlwZRgCIAEoDjIlLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLldlYWtuZXNzVHlwZRIQCghzZXZlcml0eRgDIAEoARI0Cg5hZmZlY3RlZF9lZGdlcxgEIAMoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIWCg5hZmZlY3RlZF9ub2RlcxgFIAMoAxIdChVtaXRpZ2F0aW9uX3N1Z2dlc3Rpb24YBiABKAkizwIKFVNhdmVTaW11bGF0aW9uUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSNQoEdHlwZRgEIAEoDjInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25UeXBlEikKBWdyYXBoGAUgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBIUCgxyZXF1ZXN0X2RhdGEYBiABKAwSFQoNcmVzcG9uc2VfZGF0YRgHIAEoDBJGCgR0YWdzGAggAygLMjgubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2F2ZVNpbXVsYXRpb25SZXF1ZXN0LlRhZ3NFbnRyeRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJfChZTYXZlU2ltdWxhdGlvblJlc3BvbnNlEhUKDXNpbXVsYXRpb25faWQYASABKAkSLgoKY3JlYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiPgoUR2V0U2ltdWxhdGlvblJlcXVlc3QSFQoNc2ltdWxhdGlvbl9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJIlIKFUdldFNpbXVsYXRpb25SZXNwb25zZRI5CgZyZWNvcmQYASABKAsyKS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uUmVjb3JkIpwBChZMaXN0U2ltdWxhdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSNQoEdHlwZRgCIAEoDjInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25UeXBlEjoKCnBhZ2luYXRpb24YAyABKAsyJi5sb2dpc3RpY3MuY29tbW9uLnYxLlBhZ2luYXRpb25SZXF1ZXN0IpcBChdMaXN0U2ltdWxhdGlvbnNSZXNwb25zZRI/CgtzaW11bGF0aW9ucxgBIAMoCzIqLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25TdW1tYXJ5EjsKCnBhZ2luYXRpb24YAiABKAsyJy5sb2dpc3RpY3MuY29tbW9uLnYxLlBhZ2luYXRpb25SZXNwb25zZSLWAgoQU2ltdWxhdGlvblJlY29yZBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSNQoEdHlwZRgFIAEoDjInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25UeXBlEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhQKDHJlcXVlc3RfZGF0YRgHIAEoDBIVCg1yZXNwb25zZV9kYXRhGAggASgMEkEKBHRhZ3MYCSADKAsyMy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uUmVjb3JkLlRhZ3NFbnRyeRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKFAgoRU2ltdWxhdGlvblN1bW1hcnkSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRI1CgR0eXBlGAMgASgOMicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvblR5cGUSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASQgoEdGFncxgFIAMoCzI0LmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25TdW1tYXJ5LlRhZ3NFbnRyeRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLBAQoSU2ltdWxhdGlvbk1ldGFkYXRhEhUKDXNpbXVsYXRpb25faWQYASABKAkSGwoTY29tcHV0YXRpb25fdGltZV9tcxgCIAEoARISCgppdGVyYXRpb25zGAMgASgFEhkKEW1lbW9yeV91c2VkX2J5dGVzGAQgASgDEhYKDmFsZ29yaXRobV91c2VkGAUgASgJEjAKDGNvbXBsZXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiDwoNSGVhbHRoUmVxdWVzdCJJCg5IZWFsdGhSZXNwb25zZRIOCgZzdGF0dXMYASABKAkSDwoHdmVyc2lvbhgCIAEoCRIWCg51cHRpbWVfc2Vjb25kcxgDIAEoAyqFAgoQTW9kaWZpY2F0aW9uVHlwZRIhCh1NT0RJRklDQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEiEKHU1PRElGSUNBVElPTl9UWVBFX1VQREFURV9FREdFEAESIQodTU9ESUZJQ0FUSU9OX1RZUEVfUkVNT1ZFX0VER0UQAhIeChpNT0RJRklDQVRJT05fVFlQRV9BRERfRURHRRADEiEKHU1PRElGSUNBVElPTl9UWVBFX1VQREFURV9OT0RFEAQSIQodTU9ESUZJQ0FUSU9OX1RZUEVfUkVNT1ZFX05PREUQBRIiCh5NT0RJRklDQVRJT05fVFlQRV9ESVNBQkxFX05PREUQBirZAQoSTW9kaWZpY2F0aW9uVGFyZ2V0EiMKH01PRElGSUNBVElPTl9UQVJHRVRfVU5TUEVDSUZJRUQQABIgChxNT0RJRklDQVRJT05fVEFSR0VUX0NBUEFDSVRZEAESHAoYTU9ESUZJQ0FUSU9OX1RBUkdFVF9DT1NUEAISHgoaTU9ESUZJQ0FUSU9OX1RBUkdFVF9MRU5HVEgQAxIeChpNT0RJRklDQVRJT05fVEFSR0VUX1NVUFBMWRAEEh4KGk1PRElGSUNBVElPTl9UQVJHRVRfREVNQU5EEAUqowEKC0ltcGFjdExldmVsEhwKGElNUEFDVF9MRVZFTF9VTlNQRUNJRklFRBAAEhUKEUlNUEFDVF9MRVZFTF9OT05FEAESFAoQSU1QQUNUX0xFVkVMX0xPVxACEhcKE0lNUEFDVF9MRVZFTF9NRURJVU0QAxIVChFJTVBBQ1RfTEVWRUxfSElHSBAEEhkKFUlNUEFDVF9MRVZFTF9DUklUSUNBTBAFKs0BChRCb3R0bGVuZWNrQ2hhbmdlVHlwZRImCiJCT1RUTEVORUNLX0NIQU5HRV9UWVBFX1VOU1BFQ0lGSUVEEAASHgoaQk9UVExFTkVDS19DSEFOR0VfVFlQRV9ORVcQARIjCh9CT1RUTEVORUNLX0NIQU5HRV9UWVBFX1JFU09MVkVEEAISIwofQk9UVExFTkVDS19DSEFOR0VfVFlQRV9XT1JTRU5FRBADEiMKH0JPVFRMRU5FQ0tfQ0hBTkdFX1RZUEVfSU1QUk9WRUQQBCp2CghUaW1lU3RlcBIZChVUSU1FX1NURVBfVU5TUEVDSUZJRUQQABIUChBUSU1FX1NURVBfTUlOVVRFEAESEgoOVElNRV9TVEVQX0hPVVIQAhIRCg1USU1FX1NURVBfREFZEAMSEgoOVElNRV9TVEVQX1dFRUsQBCqbAQoNUGF0dGVyblRhcmdldBIeChpQQVRURVJOX1RBUkdFVF9VTlNQRUNJRklFRBAAEhsKF1BBVFRFUk5fVEFSR0VUX0NBUEFDSVRZEAESFwoTUEFUVEVSTl9UQVJHRVRfQ09TVBACEhkKFVBBVFRFUk5fVEFSR0VUX1NVUFBMWRADEhkKFVBBVFRFUk5fVEFSR0VUX0RFTUFORBAEKtEBCgtQYXR0ZXJuVHlwZRIcChhQQVRURVJOX1RZUEVfVU5TUEVDSUZJRUQQABIZChVQQVRURVJOX1RZUEVfQ09OU1RBTlQQARIXChNQQVRURVJOX1RZUEVfSE9VUkxZEAISFgoSUEFUVEVSTl9UWVBFX0RBSUxZEAMSFwoTUEFUVEVSTl9UWVBFX0NVU1RPTRAEEh4KGlBBVFRFUk5fVFlQRV9SQU5ET01fTk9STUFMEAUSHwobUEFUVEVSTl9UWVBFX1JBTkRPTV9VTklGT1JNEAYqzgEKEkNyaXRpY2FsUGVyaW9kVHlwZRIkCiBDUklUSUNBTF9QRVJJT0RfVFlQRV9VTlNQRUNJRklFRBAAEiUKIUNSSVRJQ0FMX1BFUklPRF9UWVBFX0xPV19DQVBBQ0lUWRABEiQKIENSSVRJQ0FMX1BFUklPRF9UWVBFX0hJR0hfREVNQU5EEAISIwofQ1JJVElDQUxfUEVSSU9EX1RZUEVfQ09OR0VTVElPThADEiAKHENSSVRJQ0FMX1BFUklPRF9UWVBFX0ZBSUxVUkUQBCqGAQoPVW5jZXJ0YWludHlUeXBlEiAKHFVOQ0VSVEFJTlRZX1RZUEVfVU5TUEVDSUZJRUQQABIZChVVTkNFUlRBSU5UWV9UWVBFX0VER0UQARIZChVVTkNFUlRBSU5UWV9UWVBFX05PREUQAhIbChdVTkNFUlRBSU5UWV9UWVBFX0dMT0JBTBADKtgBChBEaXN0cmlidXRpb25UeXBlEiEKHURJU1RSSUJVVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASHAoYRElTVFJJQlVUSU9OX1RZUEVfTk9STUFMEAESHQoZRElTVFJJQlVUSU9OX1RZUEVfVU5JRk9STRACEiAKHERJU1RSSUJVVElPTl9UWVBFX1RSSUFOR1VMQVIQAxIfChtESVNUUklCVVRJT05fVFlQRV9MT0dOT1JNQUwQBBIhCh1ESVNUUklCVVRJT05fVFlQRV9FWFBPTkVOVElBTBAFKpoBChFTZW5zaXRpdml0eU1ldGhvZBIiCh5TRU5TSVRJVklUWV9NRVRIT0RfVU5TUEVDSUZJRUQQABIkCiBTRU5TSVRJVklUWV9NRVRIT0RfT05FX0FUX0FfVElNRRABEh0KGVNFTlNJVElWSVRZX01FVEhPRF9NT1JSSVMQAhIcChhTRU5TSVRJVklUWV9NRVRIT0RfU09CT0wQAyrMAQoQU2Vuc2l0aXZpdHlMZXZlbBIhCh1TRU5TSVRJVklUWV9MRVZFTF9VTlNQRUNJRklFRBAAEiAKHFNFTlNJVElWSVRZX0xFVkVMX05FR0xJR0lCTEUQARIZChVTRU5TSVRJVklUWV9MRVZFTF9MT1cQAhIcChhTRU5TSVRJVklUWV9MRVZFTF9NRURJVU0QAxIaChZTRU5TSVRJVklUWV9MRVZFTF9ISUdIEAQSHgoaU0VOU0lUSVZJVFlfTEVWRUxfQ1JJVElDQUwQBSq0AQoNVGhyZXNob2xkVHlwZRIeChpUSFJFU0hPTERfVFlQRV9VTlNQRUNJRklFRBAAEh0KGVRIUkVTSE9MRF9UWVBFX0ZMT1dfRFJPUFMQARIeChpUSFJFU0hPTERfVFlQRV9DT1NUX1NQSUtFUxACEiUKIVRIUkVTSE9MRF9UWVBFX0JPVFRMRU5FQ0tfQVBQRUFSUxADEh0KGVRIUkVTSE9MRF9UWVBFX0lORkVBU0lCTEUQBCriAQoSUmVjb21tZW5kYXRpb25UeXBlEiMKH1JFQ09NTUVOREFUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABImCiJSRUNPTU1FTkRBVElPTl9UWVBFX0FERF9SRURVTkRBTkNZEAESKQolUkVDT01NRU5EQVRJT05fVFlQRV9JTkNSRUFTRV9DQVBBQ0lUWRACEigKJFJFQ09NTUVOREFUSU9OX1RZUEVfQUREX0JBQ0tVUF9ST1VURRADEioKJlJFQ09NTUVOREFUSU9OX1RZUEVfUkVMT0NBVEVfV0FSRUhPVVNFEAQqzAEKDFdlYWtuZXNzVHlwZRIdChlXRUFLTkVTU19UWVBFX1VOU1BFQ0lGSUVEEAASKQolV0VBS05FU1NfVFlQRV9TSU5HTEVfUE9JTlRfT0ZfRkFJTFVSRRABEiUKIVdFQUtORVNTX1RZUEVfQ0FQQUNJVFlfQk9UVExFTkVDSxACEh8KG1dFQUtORVNTX1RZUEVfTk9fUkVEVU5EQU5DWRADEioKJldFQUtORVNTX1RZUEVfR0VPR1JBUEhJQ19DT05DRU5UUkFUSU9OEAQq5wEKDlNpbXVsYXRpb25UeXBlEh8KG1NJTVVMQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEhsKF1NJTVVMQVRJT05fVFlQRV9XSEFUX0lGEAESGAoUU0lNVUxBVElPTl9UWVBFX1RJTUUQAhIfChtTSU1VTEFUSU9OX1RZUEVfTU9OVEVfQ0FSTE8QAxIfChtTSU1VTEFUSU9OX1RZUEVfU0VOU0lUSVZJVFkQBBIbChdTSU1VTEFUSU9OX1RZUEVfRkFJTFVSRRAFEh4KGlNJTVVMQVRJT05fVFlQRV9SRVNJTElFTkNFEAYy+AwKEVNpbXVsYXRpb25TZXJ2aWNlEmIKCVJ1bldoYXRJZhIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1bldoYXRJZlJlcXVlc3QaKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5XaGF0SWZSZXNwb25zZRJ3ChBDb21wYXJlU2NlbmFyaW9zEjAubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQ29tcGFyZVNjZW5hcmlvc1JlcXVlc3QaMS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Db21wYXJlU2NlbmFyaW9zUmVzcG9uc2USegoRUnVuVGltZVNpbXVsYXRpb24SMS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5UaW1lU2ltdWxhdGlvblJlcXVlc3QaMi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5UaW1lU2ltdWxhdGlvblJlc3BvbnNlEncKEFNpbXVsYXRlUGVha0xvYWQSMC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0ZVBlYWtMb2FkUmVxdWVzdBoxLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRlUGVha0xvYWRSZXNwb25zZRJuCg1SdW5Nb250ZUNhcmxvEi0ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUnVuTW9udGVDYXJsb1JlcXVlc3QaLi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5Nb250ZUNhcmxvUmVzcG9uc2UScwoTUnVuTW9udGVDYXJsb1N0cmVhbRItLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1bk1vbnRlQ2FybG9SZXF1ZXN0GisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuTW9udGVDYXJsb1Byb2dyZXNzMAESfQoSQW5hbHl6ZVNlbnNpdGl2aXR5EjIubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVNlbnNpdGl2aXR5UmVxdWVzdBozLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkFuYWx5emVTZW5zaXRpdml0eVJlc3BvbnNlEoMBChRGaW5kQ3JpdGljYWxFbGVtZW50cxI0LmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZpbmRDcml0aWNhbEVsZW1lbnRzUmVxdWVzdBo1LmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZpbmRDcml0aWNhbEVsZW1lbnRzUmVzcG9uc2USdwoQU2ltdWxhdGVGYWlsdXJlcxIwLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRlRmFpbHVyZXNSZXF1ZXN0GjEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGVGYWlsdXJlc1Jlc3BvbnNlEnoKEUFuYWx5emVSZXNpbGllbmNlEjEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVJlc2lsaWVuY2VSZXF1ZXN0GjIubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVJlc2lsaWVuY2VSZXNwb25zZRJxCg5TYXZlU2ltdWxhdGlvbhIuLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNhdmVTaW11bGF0aW9uUmVxdWVzdBovLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNhdmVTaW11bGF0aW9uUmVzcG9uc2USbgoNR2V0U2ltdWxhdGlvbhItLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkdldFNpbXVsYXRpb25SZXF1ZXN0Gi4ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuR2V0U2ltdWxhdGlvblJlc3BvbnNlEnQKD0xpc3RTaW11bGF0aW9ucxIvLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkxpc3RTaW11bGF0aW9uc1JlcXVlc3QaMC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5MaXN0U2ltdWxhdGlvbnNSZXNwb25zZRJZCgZIZWFsdGgSJi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5IZWFsdGhSZXF1ZXN0GicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuSGVhbHRoUmVzcG9uc2VC4wEKG2NvbS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MUIPU2ltdWxhdGlvblByb3RvUAFaNWxvZ2lzdGljcy9nZW4vZ28vbG9naXN0aWNzL3NpbXVsYXRpb24vdjE7c2ltdWxhdGlvbnYxogIDTFNYqgIXTG9naXN0aWNzLlNpbXVsYXRpb24uVjHKAhdMb2dpc3RpY3NcU2ltdWxhdGlvblxWMeICI0xvZ2lzdGljc1xTaW11bGF0aW9uXFYxXEdQQk1ldGFkYXRh6gIZTG9naXN0aWNzOjpTaW11bGF0aW9uOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_logistics_common_v1_common]);
+  fileDesc("Cihsb2dpc3RpY3Mvc2ltdWxhdGlvbi92MS9zaW11bGF0aW9uLnByb3RvEhdsb2dpc3RpY3Muc2ltdWxhdGlvbi52MSLwAQoQUnVuV2hhdElmUmVxdWVzdBIyCg5iYXNlbGluZV9ncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPAoNbW9kaWZpY2F0aW9ucxgCIAMoCzIlLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vZGlmaWNhdGlvbhIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobRI3CgdvcHRpb25zGAQgASgLMiYubG9naXN0aWNzLnNpbXVsYXRpb24udjEuV2hhdElmT3B0aW9ucyKqAgoMTW9kaWZpY2F0aW9uEjcKBHR5cGUYASABKA4yKS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb2RpZmljYXRpb25UeXBlEi4KCGVkZ2Vfa2V5GAIgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5Eg8KB25vZGVfaWQYAyABKAMSGAoOYWJzb2x1dGVfdmFsdWUYCiABKAFIABIZCg9yZWxhdGl2ZV9jaGFuZ2UYCyABKAFIABIPCgVkZWx0YRgMIAEoAUgAEjsKBnRhcmdldBgNIAEoDjIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vZGlmaWNhdGlvblRhcmdldBITCgtkZXNjcmlwdGlvbhgOIAEoCUIICgZjaGFuZ2UiigEKDVdoYXRJZk9wdGlvbnMSHQoVY29tcGFyZV93aXRoX2Jhc2VsaW5lGAEgASgIEh0KFWNhbGN1bGF0ZV9jb3N0X2ltcGFjdBgCIAEoCBIcChRmaW5kX25ld19ib3R0bGVuZWNrcxgDIAEoCBIdChVyZXR1cm5fbW9kaWZpZWRfZ3JhcGgYBCABKAgilQMKEVJ1bldoYXRJZlJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSOQoIYmFzZWxpbmUYAiABKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb1Jlc3VsdBI5Cghtb2RpZmllZBgDIAEoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvUmVzdWx0Ej8KCmNvbXBhcmlzb24YBCABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb0NvbXBhcmlzb24SMgoObW9kaWZpZWRfZ3JhcGgYBSABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEkUKEmJvdHRsZW5lY2tfY2hhbmdlcxgGIAMoCzIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkJvdHRsZW5lY2tDaGFuZ2USPQoIbWV0YWRhdGEYByABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uTWV0YWRhdGEiwQEKDlNjZW5hcmlvUmVzdWx0EgwKBG5hbWUYASABKAkSEAoIbWF4X2Zsb3cYAiABKAESEgoKdG90YWxfY29zdBgDIAEoARIbChNhdmVyYWdlX3V0aWxpemF0aW9uGAQgASgBEhcKD3NhdHVyYXRlZF9lZGdlcxgFIAEoBRIUCgxhY3RpdmVfcGF0aHMYBiABKAUSLwoGc3RhdHVzGAcgASgOMh8ubG9naXN0aWNzLmNvbW1vbi52MS5GbG93U3RhdHVzIugBChJTY2VuYXJpb0NvbXBhcmlzb24SEwoLZmxvd19jaGFuZ2UYASABKAESGwoTZmxvd19jaGFuZ2VfcGVyY2VudBgCIAEoARITCgtjb3N0X2NoYW5nZRgDIAEoARIbChNjb3N0X2NoYW5nZV9wZXJjZW50GAQgASgBEhoKEnV0aWxpemF0aW9uX2NoYW5nZRgFIAEoARIWCg5pbXBhY3Rfc3VtbWFyeRgGIAEoCRI6CgxpbXBhY3RfbGV2ZWwYByABKA4yJC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5JbXBhY3RMZXZlbCK0AQoQQm90dGxlbmVja0NoYW5nZRIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EkIKC2NoYW5nZV90eXBlGAIgASgOMi0ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQm90dGxlbmVja0NoYW5nZVR5cGUSFwoPb2xkX3V0aWxpemF0aW9uGAMgASgBEhcKD25ld191dGlsaXphdGlvbhgEIAEoASLwAQoXQ29tcGFyZVNjZW5hcmlvc1JlcXVlc3QSMgoOYmFzZWxpbmVfZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEjQKCXNjZW5hcmlvcxgCIAMoCzIhLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvEjEKCWFsZ29yaXRobRgDIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtEjgKB29wdGlvbnMYBCABKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Db21wYXJlT3B0aW9ucyJrCghTY2VuYXJpbxIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEjwKDW1vZGlmaWNhdGlvbnMYAyADKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb2RpZmljYXRpb24idwoOQ29tcGFyZU9wdGlvbnMSFAoMcmFua19ieV9mbG93GAEgASgIEhQKDHJhbmtfYnlfY29zdBgCIAEoCBIVCg1jYWxjdWxhdGVfcm9pGAMgASgIEiIKGm1vZGlmaWNhdGlvbl9jb3N0X3Blcl91bml0GAQgASgBIo4CChhDb21wYXJlU2NlbmFyaW9zUmVzcG9uc2USOQoIYmFzZWxpbmUYASABKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb1Jlc3VsdBJJChByYW5rZWRfc2NlbmFyaW9zGAIgAygLMi8ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2NlbmFyaW9SZXN1bHRXaXRoUmFuaxIVCg1iZXN0X3NjZW5hcmlvGAMgASgJEhYKDnJlY29tbWVuZGF0aW9uGAQgASgJEj0KCG1ldGFkYXRhGAUgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvbk1ldGFkYXRhIr0BChZTY2VuYXJpb1Jlc3VsdFdpdGhSYW5rEjcKBnJlc3VsdBgBIAEoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvUmVzdWx0EgwKBHJhbmsYAiABKAUSDQoFc2NvcmUYAyABKAESCwoDcm9pGAQgASgBEkAKC3ZzX2Jhc2VsaW5lGAUgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2NlbmFyaW9Db21wYXJpc29uIqsCCiBPcHRpbWl6ZUNhcGFjaXR5RXhwYW5zaW9uUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSMQoJYWxnb3JpdGhtGAIgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SDgoGYnVkZ2V0GAMgASgBEjwKCHVwZ3JhZGVzGAQgAygLMioubG9naXN0aWNzLnNpbXVsYXRpb24udjEuRWRnZVVwZ3JhZGVPcHRpb24SQwoPZGVmYXVsdF91cGdyYWRlGAUgASgLMioubG9naXN0aWNzLnNpbXVsYXRpb24udjEuRWRnZVVwZ3JhZGVPcHRpb24SFgoObWF4X2l0ZXJhdGlvbnMYBiABKAUijgEKEUVkZ2VVcGdyYWRlT3B0aW9uEioKBGVkZ2UYASABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSEgoKZml4ZWRfY29zdBgCIAEoARIVCg1jb3N0X3Blcl91bml0GAMgASgBEgwKBHN0ZXAYBCABKAESFAoMbWF4X2luY3JlYXNlGAUgASgBIrYDCiFPcHRpbWl6ZUNhcGFjaXR5RXhwYW5zaW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIRCgliYXNlX2Zsb3cYAiABKAESEgoKZmluYWxfZmxvdxgDIAEoARISCgp0b3RhbF9jb3N0GAQgASgBEhgKEHJlbWFpbmluZ19idWRnZXQYBSABKAESPgoKaW5jcmVtZW50cxgGIAMoCzIqLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkNhcGFjaXR5SW5jcmVtZW50EjUKBXN0ZXBzGAcgAygLMiYubG9naXN0aWNzLnNpbXVsYXRpb24udjEuRXhwYW5zaW9uU3RlcBIyCg5leHBhbmRlZF9ncmFwaBgIIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSQQoLc3RvcF9yZWFzb24YCSABKA4yLC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5FeHBhbnNpb25TdG9wUmVhc29uEj0KCG1ldGFkYXRhGAogASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvbk1ldGFkYXRhInsKEUNhcGFjaXR5SW5jcmVtZW50EioKBGVkZ2UYASABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSFgoOYWRkZWRfY2FwYWNpdHkYAiABKAESFAoMbmV3X2NhcGFjaXR5GAMgASgBEgwKBGNvc3QYBCABKAEipAEKDUV4cGFuc2lvblN0ZXASEQoJaXRlcmF0aW9uGAEgASgFEj4KCmluY3JlbWVudHMYAiADKAsyKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5DYXBhY2l0eUluY3JlbWVudBIMCgRjb3N0GAMgASgBEgwKBGZsb3cYBCABKAESEQoJZmxvd19nYWluGAUgASgBEhEKCXdob2xlX2N1dBgGIAEoCCLWAQofT3B0aW1pemVGYWNpbGl0eUxvY2F0aW9uUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPAoLZml4ZWRfY29zdHMYAiABKAsyJy5sb2dpc3RpY3MuYW5hbHl0aWNzLnYxLkZpeGVkQ29zdENvbmZpZxIcChR1bm1ldF9kZW1hbmRfcGVuYWx0eRgDIAEoARIUCgxpbml0aWFsX29wZW4YBCADKAMSFgoObWF4X2l0ZXJhdGlvbnMYBSABKAUivQQKIE9wdGltaXplRmFjaWxpdHlMb2NhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSPQoKZmFjaWxpdGllcxgCIAMoCzIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZhY2lsaXR5RGVjaXNpb24SFwoPb3Blbl93YXJlaG91c2VzGAMgAygDEhkKEWNsb3NlZF93YXJlaG91c2VzGAQgAygDEhIKCnRvdGFsX2Nvc3QYBSABKAESEgoKZml4ZWRfY29zdBgGIAEoARIWCg50cmFuc3BvcnRfY29zdBgHIAEoARIZChF1bm1ldF9kZW1hbmRfY29zdBgIIAEoARIUCgx0b3RhbF9kZW1hbmQYCSABKAESFQoNc2VydmVkX2RlbWFuZBgKIAEoARIaChJpbml0aWFsX3RvdGFsX2Nvc3QYCyABKAESNAoFbW92ZXMYDCADKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5GYWNpbGl0eU1vdmUSSgoPcmVjb21tZW5kYXRpb25zGA0gAygLMjEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUmVzaWxpZW5jZVJlY29tbWVuZGF0aW9uEjAKDHNvbHZlZF9ncmFwaBgOIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPQoIbWV0YWRhdGEYDyABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uTWV0YWRhdGEipQEKEEZhY2lsaXR5RGVjaXNpb24SDwoHbm9kZV9pZBgBIAEoAxIMCgRuYW1lGAIgASgJEgwKBG9wZW4YAyABKAgSFgoOaW5pdGlhbGx5X29wZW4YBCABKAgSFAoMb3BlbmluZ19jb3N0GAUgASgBEhAKCGNhcGFjaXR5GAYgASgBEg8KB3NoaXBwZWQYByABKAESEwoLdXRpbGl6YXRpb24YCCABKAEiqAEKDEZhY2lsaXR5TW92ZRIRCglpdGVyYXRpb24YASABKAUSNwoEdHlwZRgCIAEoDjIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZhY2lsaXR5TW92ZVR5cGUSEwoLb3BlbmVkX25vZGUYAyABKAMSEwoLY2xvc2VkX25vZGUYBCABKAMSEgoKdG90YWxfY29zdBgFIAEoARIOCgZzYXZpbmcYBiABKAEivgIKGFJ1blRpbWVTaW11bGF0aW9uUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSQgoLdGltZV9jb25maWcYAiABKAsyLS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5UaW1lU2ltdWxhdGlvbkNvbmZpZxI/Cg1lZGdlX3BhdHRlcm5zGAMgAygLMigubG9naXN0aWNzLnNpbXVsYXRpb24udjEuRWRnZVRpbWVQYXR0ZXJuEj8KDW5vZGVfcGF0dGVybnMYBCADKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Ob2RlVGltZVBhdHRlcm4SMQoJYWxnb3JpdGhtGAUgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0iyQIKFFRpbWVTaW11bGF0aW9uQ29uZmlnEi4KCnN0YXJ0X3RpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI0Cgl0aW1lX3N0ZXAYAyABKA4yIS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5UaW1lU3RlcBIRCgludW1fc3RlcHMYBCABKAUSOQoEbW9kZRgFIAEoDjIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlRpbWVTaW11bGF0aW9uTW9kZRI7Cgtyb2FkX3NwZWVkcxgGIAMoCzImLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJvYWRUeXBlU3BlZWQSEgoKY2Fycnlfb3ZlchgHIAEoCCJQCg1Sb2FkVHlwZVNwZWVkEjAKCXJvYWRfdHlwZRgBIAEoDjIdLmxvZ2lzdGljcy5jb21tb24udjEuUm9hZFR5cGUSDQoFc3BlZWQYAiABKAEidAoPRWRnZVRpbWVQYXR0ZXJuEioKBGVkZ2UYASABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSNQoHcGF0dGVybhgCIAEoCzIkLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlRpbWVQYXR0ZXJuIpEBCg9Ob2RlVGltZVBhdHRlcm4SDwoHbm9kZV9pZBgBIAEoAxI1CgdwYXR0ZXJuGAIgASgLMiQubG9naXN0aWNzLnNpbXVsYXRpb24udjEuVGltZVBhdHRlcm4SNgoGdGFyZ2V0GAMgASgOMiYubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUGF0dGVyblRhcmdldCL4AQoLVGltZVBhdHRlcm4SMgoEdHlwZRgBIAEoDjIkLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlBhdHRlcm5UeXBlEhoKEmhvdXJseV9tdWx0aXBsaWVycxgCIAMoARIZChFkYWlseV9tdWx0aXBsaWVycxgDIAMoARI5Cg1jdXN0b21fcG9pbnRzGAQgAygLMiIubG9naXN0aWNzLnNpbXVsYXRpb24udjEuVGltZVBvaW50EgwKBG1lYW4YBSABKAESDwoHc3RkX2RldhgGIAEoARIRCgltaW5fdmFsdWUYByABKAESEQoJbWF4X3ZhbHVlGAggASgBIi0KCVRpbWVQb2ludBIMCgRzdGVwGAEgASgFEhIKCm11bHRpcGxpZXIYAiABKAEirAMKGVJ1blRpbWVTaW11bGF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI9CgxzdGVwX3Jlc3VsdHMYAiADKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5UaW1lU3RlcFJlc3VsdBI7CgVzdGF0cxgDIAEoCzIsLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlRpbWVTaW11bGF0aW9uU3RhdHMSQQoQY3JpdGljYWxfcGVyaW9kcxgEIAMoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkNyaXRpY2FsUGVyaW9kEj0KCG1ldGFkYXRhGAUgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvbk1ldGFkYXRhEkEKDGR5bmFtaWNfZmxvdxgGIAEoCzIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkR5bmFtaWNGbG93U3VtbWFyeRI9Cg5zZXJ2aWNlX2xldmVscxgHIAMoCzIlLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNlcnZpY2VMZXZlbCK6AwoOVGltZVN0ZXBSZXN1bHQSDAoEc3RlcBgBIAEoBRItCgl0aW1lc3RhbXAYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCG1heF9mbG93GAMgASgBEhIKCnRvdGFsX2Nvc3QYBCABKAESGwoTYXZlcmFnZV91dGlsaXphdGlvbhgFIAEoARIXCg9zYXR1cmF0ZWRfZWRnZXMYBiABKAUSMQoLYm90dGxlbmVja3MYByADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSEAoIYXJyaXZhbHMYCCABKAESEgoKZGVwYXJ0dXJlcxgJIAEoARISCgppbl90cmFuc2l0GAogASgBEjkKCWludmVudG9yeRgLIAMoCzImLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk5vZGVJbnZlbnRvcnkSNwoNbm9kZV9iYWxhbmNlcxgMIAMoCzIgLmxvZ2lzdGljcy5jb21tb24udjEuTm9kZUJhbGFuY2USFwoPdG90YWxfaW52ZW50b3J5GA0gASgBEhUKDXRvdGFsX2JhY2tsb2cYDiABKAEijgEKDFNlcnZpY2VMZXZlbBIPCgdub2RlX2lkGAEgASgDEhQKDHRvdGFsX2RlbWFuZBgCIAEoARIRCglkZWxpdmVyZWQYAyABKAESEQoJZmlsbF9yYXRlGAQgASgBEhUKDWZpbmFsX2JhY2tsb2cYBSABKAESGgoSc3RlcHNfd2l0aF9iYWNrbG9nGAYgASgFIkkKDU5vZGVJbnZlbnRvcnkSDwoHbm9kZV9pZBgBIAEoAxINCgVsZXZlbBgCIAEoARIYChBzdG9yYWdlX2NhcGFjaXR5GAMgASgBIoACChJEeW5hbWljRmxvd1N1bW1hcnkSEgoKdG90YWxfZmxvdxgBIAEoARIVCg1ob3Jpem9uX3N0ZXBzGAIgASgFEhYKDmV4cGFuZGVkX25vZGVzGAMgASgFEhYKDmV4cGFuZGVkX2VkZ2VzGAQgASgFEhcKD3BlYWtfaW5fdHJhbnNpdBgFIAEoARIWCg5wZWFrX2ludmVudG9yeRgGIAEoARIdChVhdmVyYWdlX3RyYW5zaXRfc3RlcHMYByABKAESPwoNdHJhbnNpdF90aW1lcxgIIAMoCzIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkVkZ2VUcmFuc2l0VGltZSJMCg9FZGdlVHJhbnNpdFRpbWUSKgoEZWRnZRgBIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRINCgVzdGVwcxgCIAEoBSL2AQoTVGltZVNpbXVsYXRpb25TdGF0cxIQCghtaW5fZmxvdxgBIAEoARIQCghtYXhfZmxvdxgCIAEoARIQCghhdmdfZmxvdxgDIAEoARIUCgxzdGRfZGV2X2Zsb3cYBCABKAESEAoIbWluX2Nvc3QYBSABKAESEAoIbWF4X2Nvc3QYBiABKAESEAoIYXZnX2Nvc3QYByABKAESEwoLdG90YWxfc3RlcHMYCCABKAUSHgoWc3RlcHNfd2l0aF9ib3R0bGVuZWNrcxgJIAEoBRIRCglmaWxsX3JhdGUYCiABKAESFQoNZmluYWxfYmFja2xvZxgLIAEoASL2AQoOQ3JpdGljYWxQZXJpb2QSEgoKc3RhcnRfc3RlcBgBIAEoBRIQCghlbmRfc3RlcBgCIAEoBRIuCgpzdGFydF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOQoEdHlwZRgFIAEoDjIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkNyaXRpY2FsUGVyaW9kVHlwZRIQCghzZXZlcml0eRgGIAEoARITCgtkZXNjcmlwdGlvbhgHIAEoCSL8AQoXU2ltdWxhdGVQZWFrTG9hZFJlcXVlc3QSKQoFZ3JhcGgYASABKAsyGi5sb2dpc3RpY3MuY29tbW9uLnYxLkdyYXBoEhkKEWRlbWFuZF9tdWx0aXBsaWVyGAIgASgBEhoKEmNhcGFjaXR5X3JlZHVjdGlvbhgDIAEoARIWCg5hZmZlY3RlZF9ub2RlcxgEIAMoAxI0Cg5hZmZlY3RlZF9lZGdlcxgFIAMoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIxCglhbGdvcml0aG0YBiABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSKFAwoYU2ltdWxhdGVQZWFrTG9hZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSPgoNbm9ybWFsX3Jlc3VsdBgCIAEoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvUmVzdWx0EjwKC3BlYWtfcmVzdWx0GAMgASgLMicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2NlbmFyaW9SZXN1bHQSPwoKY29tcGFyaXNvbhgEIAEoCzIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNjZW5hcmlvQ29tcGFyaXNvbhJBChBvdmVybG9hZGVkX2VkZ2VzGAUgAygLMicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuT3ZlcmxvYWRlZEVkZ2USFwoPcmVjb21tZW5kYXRpb25zGAYgAygJEj0KCG1ldGFkYXRhGAcgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvbk1ldGFkYXRhIp8BCg5PdmVybG9hZGVkRWRnZRIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EhkKEXJlcXVpcmVkX2NhcGFjaXR5GAIgASgBEhoKEmF2YWlsYWJsZV9jYXBhY2l0eRgDIAEoARIQCghzaG9ydGFnZRgEIAEoARIYChBzaG9ydGFnZV9wZXJjZW50GAUgASgBIpMBCiFSdW5EaXNjcmV0ZUV2ZW50U2ltdWxhdGlvblJlcXVlc3QSMAoMc29sdmVkX2dyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI8CgZjb25maWcYAiABKAsyLC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5EaXNjcmV0ZUV2ZW50Q29uZmlnIuQCChNEaXNjcmV0ZUV2ZW50Q29uZmlnEhUKDWhvcml6b25faG91cnMYASABKAESGAoQdmVoaWNsZV9jYXBhY2l0eRgCIAEoARIgChhkZXBhcnR1cmVfaW50ZXJ2YWxfaG91cnMYAyABKAESGgoSbG9hZGluZ190aW1lX2hvdXJzGAQgASgBEhwKFHVubG9hZGluZ190aW1lX2hvdXJzGAUgASgBEhYKDmRvY2tzX3Blcl9ub2RlGAYgASgFEjsKC3JvYWRfc3BlZWRzGAcgAygLMiYubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUm9hZFR5cGVTcGVlZBI7Cgx0cmF2ZWxfZGVsYXkYCCABKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5EaXN0cmlidXRpb24SEwoLcmFuZG9tX3NlZWQYCSABKAMSGQoRaGlzdG9ncmFtX2J1Y2tldHMYCiABKAUitAUKIlJ1bkRpc2NyZXRlRXZlbnRTaW11bGF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI6CgVzdGF0cxgCIAEoCzIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkRpc2NyZXRlRXZlbnRTdGF0cxJBCg9sZWFkX3RpbWVfc3RhdHMYAyABKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb250ZUNhcmxvU3RhdHMSRQoTbGVhZF90aW1lX2hpc3RvZ3JhbRgEIAMoCzIoLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkhpc3RvZ3JhbUJ1Y2tldBJzChVsZWFkX3RpbWVfcGVyY2VudGlsZXMYBSADKAsyVC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5EaXNjcmV0ZUV2ZW50U2ltdWxhdGlvblJlc3BvbnNlLkxlYWRUaW1lUGVyY2VudGlsZXNFbnRyeRI8Cgtub2RlX3F1ZXVlcxgGIAMoCzInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk5vZGVRdWV1ZVN0YXRzEjwKCnRocm91Z2hwdXQYByADKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5UaHJvdWdocHV0UG9pbnQSNgoGcm91dGVzGAggAygLMiYubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2hpcG1lbnRSb3V0ZRITCgtyYW5kb21fc2VlZBgJIAEoAxI9CghtZXRhZGF0YRgKIAEoCzIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25NZXRhZGF0YRo6ChhMZWFkVGltZVBlcmNlbnRpbGVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASKJAgoSRGlzY3JldGVFdmVudFN0YXRzEhsKE3ZlaGljbGVzX2Rpc3BhdGNoZWQYASABKAUSGgoSdmVoaWNsZXNfZGVsaXZlcmVkGAIgASgFEhgKEHVuaXRzX2Rpc3BhdGNoZWQYAyABKAESIgoadW5pdHNfZGVsaXZlcmVkX2luX2hvcml6b24YBCABKAESEgoKdGhyb3VnaHB1dBgFIAEoARIaChJwbGFubmVkX3Rocm91Z2hwdXQYBiABKAESFgoObWFrZXNwYW5faG91cnMYByABKAESGAoQZXZlbnRzX3Byb2Nlc3NlZBgIIAEoAxIaChJhdmVyYWdlX3dhaXRfaG91cnMYCSABKAEiwAEKDk5vZGVRdWV1ZVN0YXRzEg8KB25vZGVfaWQYASABKAMSFwoPdmVoaWNsZXNfc2VydmVkGAIgASgFEhwKFGF2ZXJhZ2VfcXVldWVfbGVuZ3RoGAMgASgBEhgKEG1heF9xdWV1ZV9sZW5ndGgYBCABKAUSGgoSYXZlcmFnZV93YWl0X2hvdXJzGAUgASgBEhYKDm1heF93YWl0X2hvdXJzGAYgASgBEhgKEGRvY2tfdXRpbGl6YXRpb24YByABKAEiVAoPVGhyb3VnaHB1dFBvaW50EgwKBGhvdXIYASABKAUSFwoPdW5pdHNfZGVsaXZlcmVkGAIgASgBEhoKEnZlaGljbGVzX2RlbGl2ZXJlZBgDIAEoBSJiCg1TaGlwbWVudFJvdXRlEhAKCG5vZGVfaWRzGAEgAygDEgwKBGZsb3cYAiABKAESEAoIdmVoaWNsZXMYAyABKAUSHwoXYXZlcmFnZV9sZWFkX3RpbWVfaG91cnMYBCABKAEitgIKFFJ1bk1vbnRlQ2FybG9SZXF1ZXN0EikKBWdyYXBoGAEgASgLMhoubG9naXN0aWNzLmNvbW1vbi52MS5HcmFwaBI5CgZjb25maWcYAiABKAsyKS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb250ZUNhcmxvQ29uZmlnEj8KDXVuY2VydGFpbnRpZXMYAyADKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5VbmNlcnRhaW50eVNwZWMSMQoJYWxnb3JpdGhtGAQgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0SRAoLY29ycmVsYXRpb24YBSABKAsyLy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5VbmNlcnRhaW50eUNvcnJlbGF0aW9uIqEBChZVbmNlcnRhaW50eUNvcnJlbGF0aW9uEjwKB21lYXN1cmUYASABKA4yKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Db3JyZWxhdGlvbk1lYXN1cmUSDgoGbWF0cml4GAIgAygBEjkKBmdyb3VwcxgDIAMoCzIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkNvcnJlbGF0aW9uR3JvdXAiRAoQQ29ycmVsYXRpb25Hcm91cBIbChN1bmNlcnRhaW50eV9pbmRpY2VzGAEgAygFEhMKC2NvcnJlbGF0aW9uGAIgASgBIrkCChBNb250ZUNhcmxvQ29uZmlnEhYKDm51bV9pdGVyYXRpb25zGAEgASgFEhMKC3JhbmRvbV9zZWVkGAIgASgDEhgKEGNvbmZpZGVuY2VfbGV2ZWwYAyABKAESEAoIcGFyYWxsZWwYBCABKAgSEwoLbWF4X3dvcmtlcnMYBSABKAUSFgoOcmV0dXJuX3NhbXBsZXMYBiABKAgSGQoRcmVwbGF5X2l0ZXJhdGlvbnMYByADKAUSQAoPc2FtcGxpbmdfbWV0aG9kGAggASgOMicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2FtcGxpbmdNZXRob2QSJQoddGFyZ2V0X3JlbGF0aXZlX2NpX2hhbGZfd2lkdGgYCSABKAESGwoTdGltZV9idWRnZXRfc2Vjb25kcxgKIAEoASKAAgoPVW5jZXJ0YWludHlTcGVjEjYKBHR5cGUYASABKA4yKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5VbmNlcnRhaW50eVR5cGUSKgoEZWRnZRgCIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIPCgdub2RlX2lkGAMgASgDEjsKBnRhcmdldBgEIAEoDjIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vZGlmaWNhdGlvblRhcmdldBI7CgxkaXN0cmlidXRpb24YBSABKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5EaXN0cmlidXRpb24idwoMRGlzdHJpYnV0aW9uEjcKBHR5cGUYASABKA4yKS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5EaXN0cmlidXRpb25UeXBlEg4KBnBhcmFtMRgCIAEoARIOCgZwYXJhbTIYAyABKAESDgoGcGFyYW0zGAQgASgBIssHChVSdW5Nb250ZUNhcmxvUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBI8CgpmbG93X3N0YXRzGAIgASgLMigubG9naXN0aWNzLnNpbXVsYXRpb24udjEuTW9udGVDYXJsb1N0YXRzEjwKCmNvc3Rfc3RhdHMYAyABKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb250ZUNhcmxvU3RhdHMSQAoOZmxvd19oaXN0b2dyYW0YBCADKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5IaXN0b2dyYW1CdWNrZXQSQAoOY29zdF9oaXN0b2dyYW0YBSADKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5IaXN0b2dyYW1CdWNrZXQSXQoQZmxvd19wZXJjZW50aWxlcxgGIAMoCzJDLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1bk1vbnRlQ2FybG9SZXNwb25zZS5GbG93UGVyY2VudGlsZXNFbnRyeRJdChBjb3N0X3BlcmNlbnRpbGVzGAcgAygLMkMubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUnVuTW9udGVDYXJsb1Jlc3BvbnNlLkNvc3RQZXJjZW50aWxlc0VudHJ5EjwKDXJpc2tfYW5hbHlzaXMYCCABKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SaXNrQW5hbHlzaXMSQwoMY29ycmVsYXRpb25zGAkgAygLMi0ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUGFyYW1ldGVyQ29ycmVsYXRpb24SPQoIbWV0YWRhdGEYCiABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uTWV0YWRhdGESEwoLcmFuZG9tX3NlZWQYCyABKAMSOgoHc2FtcGxlcxgMIAMoCzIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vbnRlQ2FybG9TYW1wbGUSQgoLc3RvcF9yZWFzb24YDSABKA4yLS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Nb250ZUNhcmxvU3RvcFJlYXNvbhIcChRjb21wbGV0ZWRfaXRlcmF0aW9ucxgOIAEoBRo2ChRGbG93UGVyY2VudGlsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBGjYKFENvc3RQZXJjZW50aWxlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoAToCOAEiZQoQTW9udGVDYXJsb1NhbXBsZRIRCglpdGVyYXRpb24YASABKAUSEwoLbXVsdGlwbGllcnMYAiADKAESDAoEZmxvdxgDIAEoARIMCgRjb3N0GAQgASgBEg0KBWVycm9yGAUgASgJIpICCg9Nb250ZUNhcmxvU3RhdHMSDAoEbWVhbhgBIAEoARIPCgdzdGRfZGV2GAIgASgBEgsKA21pbhgDIAEoARILCgNtYXgYBCABKAESDgoGbWVkaWFuGAUgASgBEhAKCHZhcmlhbmNlGAYgASgBEhAKCHNrZXduZXNzGAcgASgBEhAKCGt1cnRvc2lzGAggASgBEh8KF2NvbmZpZGVuY2VfaW50ZXJ2YWxfbG93GAkgASgBEiAKGGNvbmZpZGVuY2VfaW50ZXJ2YWxfaGlnaBgKIAEoARIdChVlZmZlY3RpdmVfc2FtcGxlX3NpemUYCyABKAESHgoWcmVsYXRpdmVfY2lfaGFsZl93aWR0aBgMIAEoASJdCg9IaXN0b2dyYW1CdWNrZXQSEwoLbG93ZXJfYm91bmQYASABKAESEwoLdXBwZXJfYm91bmQYAiABKAESDQoFY291bnQYAyABKAUSEQoJZnJlcXVlbmN5GAQgASgBItYBCgxSaXNrQW5hbHlzaXMSIwobcHJvYmFiaWxpdHlfYmVsb3dfdGhyZXNob2xkGAEgASgBEhUKDXZhbHVlX2F0X3Jpc2sYAiABKAESGgoSZXhwZWN0ZWRfc2hvcnRmYWxsGAMgASgBEhcKD3dvcnN0X2Nhc2VfZmxvdxgEIAEoARIWCg5iZXN0X2Nhc2VfZmxvdxgFIAEoARI9Cg5yaXNrX3NjZW5hcmlvcxgGIAMoCzIlLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJpc2tTY2VuYXJpbyJiCgxSaXNrU2NlbmFyaW8SEwoLZGVzY3JpcHRpb24YASABKAkSEwoLcHJvYmFiaWxpdHkYAiABKAESEwoLZmxvd19pbXBhY3QYAyABKAESEwoLY29zdF9pbXBhY3QYBCABKAEihgEKFFBhcmFtZXRlckNvcnJlbGF0aW9uEhYKDnBhcmFtZXRlcl9uYW1lGAEgASgJEh0KFWNvcnJlbGF0aW9uX3dpdGhfZmxvdxgCIAEoARIdChVjb3JyZWxhdGlvbl93aXRoX2Nvc3QYAyABKAESGAoQaW1wb3J0YW5jZV9zY29yZRgEIAEoASK+AgoSTW9udGVDYXJsb1Byb2dyZXNzEhEKCWl0ZXJhdGlvbhgBIAEoBRIYChB0b3RhbF9pdGVyYXRpb25zGAIgASgFEhgKEHByb2dyZXNzX3BlcmNlbnQYAyABKAESGQoRY3VycmVudF9tZWFuX2Zsb3cYBCABKAESFwoPY3VycmVudF9zdGRfZGV2GAUgASgBEg4KBnN0YXR1cxgGIAEoCRIVCg1jaV9oYWxmX3dpZHRoGAcgASgBEh4KFnJlbGF0aXZlX2NpX2hhbGZfd2lkdGgYCCABKAESJgoeZXN0aW1hdGVkX2l0ZXJhdGlvbnNfcmVtYWluaW5nGAkgASgFEj4KBnJlc3VsdBgKIAEoCzIuLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1bk1vbnRlQ2FybG9SZXNwb25zZSL4AQoZQW5hbHl6ZVNlbnNpdGl2aXR5UmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSQQoKcGFyYW1ldGVycxgCIAMoCzItLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNlbnNpdGl2aXR5UGFyYW1ldGVyEjoKBmNvbmZpZxgDIAEoCzIqLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNlbnNpdGl2aXR5Q29uZmlnEjEKCWFsZ29yaXRobRgEIAEoDjIeLmxvZ2lzdGljcy5jb21tb24udjEuQWxnb3JpdGhtItMBChRTZW5zaXRpdml0eVBhcmFtZXRlchIqCgRlZGdlGAEgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5Eg8KB25vZGVfaWQYAiABKAMSOwoGdGFyZ2V0GAMgASgOMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuTW9kaWZpY2F0aW9uVGFyZ2V0EhYKDm1pbl9tdWx0aXBsaWVyGAQgASgBEhYKDm1heF9tdWx0aXBsaWVyGAUgASgBEhEKCW51bV9zdGVwcxgGIAEoBSLzAQoRU2Vuc2l0aXZpdHlDb25maWcSOgoGbWV0aG9kGAEgASgOMioubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2Vuc2l0aXZpdHlNZXRob2QSHAoUY2FsY3VsYXRlX2VsYXN0aWNpdHkYAiABKAgSFwoPZmluZF90aHJlc2hvbGRzGAMgASgIEhgKEG51bV90cmFqZWN0b3JpZXMYBCABKAUSEgoKbnVtX2xldmVscxgFIAEoBRITCgtudW1fc2FtcGxlcxgGIAEoBRITCgtyYW5kb21fc2VlZBgHIAEoAxITCgttYXhfd29ya2VycxgIIAEoBSKtAgoaQW5hbHl6ZVNlbnNpdGl2aXR5UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBJFChFwYXJhbWV0ZXJfcmVzdWx0cxgCIAMoCzIqLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNlbnNpdGl2aXR5UmVzdWx0EjsKCHJhbmtpbmdzGAMgAygLMikubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUGFyYW1ldGVyUmFua2luZxI7Cgp0aHJlc2hvbGRzGAQgAygLMicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuVGhyZXNob2xkUG9pbnQSPQoIbWV0YWRhdGEYBSABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uTWV0YWRhdGEi0AIKEVNlbnNpdGl2aXR5UmVzdWx0EhQKDHBhcmFtZXRlcl9pZBgBIAEoCRI4CgVjdXJ2ZRgCIAMoCzIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNlbnNpdGl2aXR5UG9pbnQSEgoKZWxhc3RpY2l0eRgDIAEoARIZChFzZW5zaXRpdml0eV9pbmRleBgEIAEoARIUCgxpbXBhY3RfcmFuZ2UYBSABKAESOAoFbGV2ZWwYBiABKA4yKS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TZW5zaXRpdml0eUxldmVsEjYKBm1vcnJpcxgHIAEoCzImLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk1vcnJpc0luZGljZXMSNAoFc29ib2wYCCABKAsyJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Tb2JvbEluZGljZXMiOwoNTW9ycmlzSW5kaWNlcxIKCgJtdRgBIAEoARIPCgdtdV9zdGFyGAIgASgBEg0KBXNpZ21hGAMgASgBImwKDFNvYm9sSW5kaWNlcxITCgtmaXJzdF9vcmRlchgBIAEoARITCgt0b3RhbF9vcmRlchgCIAEoARIYChBmaXJzdF9vcmRlcl9jb25mGAMgASgBEhgKEHRvdGFsX29yZGVyX2NvbmYYBCABKAEiUwoQU2Vuc2l0aXZpdHlQb2ludBIXCg9wYXJhbWV0ZXJfdmFsdWUYASABKAESEgoKZmxvd192YWx1ZRgCIAEoARISCgpjb3N0X3ZhbHVlGAMgASgBImYKEFBhcmFtZXRlclJhbmtpbmcSFAoMcGFyYW1ldGVyX2lkGAEgASgJEgwKBHJhbmsYAiABKAUSGQoRc2Vuc2l0aXZpdHlfaW5kZXgYAyABKAESEwoLZGVzY3JpcHRpb24YBCABKAkiigEKDlRocmVzaG9sZFBvaW50EhQKDHBhcmFtZXRlcl9pZBgBIAEoCRIXCg90aHJlc2hvbGRfdmFsdWUYAiABKAESNAoEdHlwZRgDIAEoDjImLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlRocmVzaG9sZFR5cGUSEwoLZGVzY3JpcHRpb24YBCABKAkivAEKG0ZpbmRDcml0aWNhbEVsZW1lbnRzUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSPwoGY29uZmlnGAIgASgLMi8ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQ3JpdGljYWxFbGVtZW50c0NvbmZpZxIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSJwChZDcml0aWNhbEVsZW1lbnRzQ29uZmlnEhUKDWFuYWx5emVfZWRnZXMYASABKAgSFQoNYW5hbHl6ZV9ub2RlcxgCIAEoCBINCgV0b3BfbhgDIAEoBRIZChFmYWlsdXJlX3RocmVzaG9sZBgEIAEoASLGAgocRmluZENyaXRpY2FsRWxlbWVudHNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEj0KDmNyaXRpY2FsX2VkZ2VzGAIgAygLMiUubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQ3JpdGljYWxFZGdlEj0KDmNyaXRpY2FsX25vZGVzGAMgAygLMiUubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQ3JpdGljYWxOb2RlEj4KGHNpbmdsZV9wb2ludHNfb2ZfZmFpbHVyZRgEIAMoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIYChByZXNpbGllbmNlX3Njb3JlGAUgASgBEj0KCG1ldGFkYXRhGAYgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvbk1ldGFkYXRhIscBCgxDcml0aWNhbEVkZ2USKgoEZWRnZRgBIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIZChFjcml0aWNhbGl0eV9zY29yZRgCIAEoARIeChZmbG93X2ltcGFjdF9pZl9yZW1vdmVkGAMgASgBEh4KFmNvc3RfaW1wYWN0X2lmX3JlbW92ZWQYBCABKAESDAoEcmFuaxgFIAEoBRIiChppc19zaW5nbGVfcG9pbnRfb2ZfZmFpbHVyZRgGIAEoCCKkAQoMQ3JpdGljYWxOb2RlEg8KB25vZGVfaWQYASABKAMSGQoRY3JpdGljYWxpdHlfc2NvcmUYAiABKAESHgoWZmxvd19pbXBhY3RfaWZfcmVtb3ZlZBgDIAEoARIWCg5hZmZlY3RlZF9lZGdlcxgEIAEoBRIMCgRyYW5rGAUgASgFEiIKGmlzX3NpbmdsZV9wb2ludF9vZl9mYWlsdXJlGAYgASgIIoECChdTaW11bGF0ZUZhaWx1cmVzUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSQwoRZmFpbHVyZV9zY2VuYXJpb3MYAiADKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5GYWlsdXJlU2NlbmFyaW8SQwoNcmFuZG9tX2NvbmZpZxgDIAEoCzIsLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJhbmRvbUZhaWx1cmVDb25maWcSMQoJYWxnb3JpdGhtGAQgASgOMh4ubG9naXN0aWNzLmNvbW1vbi52MS5BbGdvcml0aG0ikwEKD0ZhaWx1cmVTY2VuYXJpbxIMCgRuYW1lGAEgASgJEjIKDGZhaWxlZF9lZGdlcxgCIAMoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIUCgxmYWlsZWRfbm9kZXMYAyADKAMSEwoLcHJvYmFiaWxpdHkYBCABKAESEwoLb2NjdXJyZW5jZXMYBSABKAUi6gIKE1JhbmRvbUZhaWx1cmVDb25maWcSFQoNbnVtX3NjZW5hcmlvcxgBIAEoBRIgChhlZGdlX2ZhaWx1cmVfcHJvYmFiaWxpdHkYAiABKAESIAoYbm9kZV9mYWlsdXJlX3Byb2JhYmlsaXR5GAMgASgBEiEKGW1heF9zaW11bHRhbmVvdXNfZmFpbHVyZXMYBCABKAUSGwoTY29ycmVsYXRlZF9mYWlsdXJlcxgFIAEoCBITCgtyYW5kb21fc2VlZBgGIAEoAxJACgtjb3JyZWxhdGlvbhgHIAEoDjIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZhaWx1cmVDb3JyZWxhdGlvbhIgChhjb21tb25fY2F1c2VfcHJvYmFiaWxpdHkYCCABKAESFQoNcmVnaW9uX3JhZGl1cxgJIAEoARIoCiBjb21tb25fY2F1c2VfZmFpbHVyZV9wcm9iYWJpbGl0eRgKIAEoASKGAwoYU2ltdWxhdGVGYWlsdXJlc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSOQoIYmFzZWxpbmUYAiABKAsyJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb1Jlc3VsdBJIChBzY2VuYXJpb19yZXN1bHRzGAMgAygLMi4ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuRmFpbHVyZVNjZW5hcmlvUmVzdWx0EjQKBXN0YXRzGAQgASgLMiUubG9naXN0aWNzLnNpbXVsYXRpb24udjEuRmFpbHVyZVN0YXRzEkoKD3JlY29tbWVuZGF0aW9ucxgFIAMoCzIxLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJlc2lsaWVuY2VSZWNvbW1lbmRhdGlvbhI9CghtZXRhZGF0YRgGIAEoCzIrLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25NZXRhZGF0YRITCgtyYW5kb21fc2VlZBgHIAEoAyLxAQoVRmFpbHVyZVNjZW5hcmlvUmVzdWx0EhUKDXNjZW5hcmlvX25hbWUYASABKAkSEwoLcHJvYmFiaWxpdHkYAiABKAESNwoGcmVzdWx0GAMgASgLMicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2NlbmFyaW9SZXN1bHQSQAoLdnNfYmFzZWxpbmUYBCABKAsyKy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TY2VuYXJpb0NvbXBhcmlzb24SHAoUbmV0d29ya19kaXNjb25uZWN0ZWQYBSABKAgSEwoLb2NjdXJyZW5jZXMYBiABKAUisQEKDEZhaWx1cmVTdGF0cxIaChJleHBlY3RlZF9mbG93X2xvc3MYASABKAESFQoNbWF4X2Zsb3dfbG9zcxgCIAEoARIkChxwcm9iYWJpbGl0eV9vZl9kaXNjb25uZWN0aW9uGAMgASgBEiIKGmF2ZXJhZ2VfcmVjb3ZlcnlfcG90ZW50aWFsGAQgASgBEiQKHGV4cGVjdGVkX2Zsb3dfbG9zc19zdGRfZXJyb3IYBSABKAEi7QEKGFJlc2lsaWVuY2VSZWNvbW1lbmRhdGlvbhI5CgR0eXBlGAEgASgOMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUmVjb21tZW5kYXRpb25UeXBlEhMKC2Rlc2NyaXB0aW9uGAIgASgJEjMKDWFmZmVjdGVkX2VkZ2UYAyABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSFQoNYWZmZWN0ZWRfbm9kZRgEIAEoAxIdChVlc3RpbWF0ZWRfaW1wcm92ZW1lbnQYBSABKAESFgoOZXN0aW1hdGVkX2Nvc3QYBiABKAEiswEKGEFuYWx5emVSZXNpbGllbmNlUmVxdWVzdBIpCgVncmFwaBgBIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSOQoGY29uZmlnGAIgASgLMikubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUmVzaWxpZW5jZUNvbmZpZxIxCglhbGdvcml0aG0YAyABKA4yHi5sb2dpc3RpY3MuY29tbW9uLnYxLkFsZ29yaXRobSKDAQoQUmVzaWxpZW5jZUNvbmZpZxIcChRtYXhfZmFpbHVyZXNfdG9fdGVzdBgBIAEoBRIfChd0ZXN0X2Nhc2NhZGluZ19mYWlsdXJlcxgCIAEoCBITCgtsb2FkX2ZhY3RvchgDIAEoARIbChNtYXhfY2FuZGlkYXRlX2VkZ2VzGAQgASgFIqYDChlBbmFseXplUmVzaWxpZW5jZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSOwoHbWV0cmljcxgCIAEoCzIqLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJlc2lsaWVuY2VNZXRyaWNzEj8KC25fbWludXNfb25lGAMgASgLMioubG9naXN0aWNzLnNpbXVsYXRpb24udjEuTk1pbnVzT25lQW5hbHlzaXMSPwoLbl9taW51c190d28YBCABKAsyKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5OTWludXNUd29BbmFseXNpcxI/Cgp3ZWFrbmVzc2VzGAUgAygLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUmVzaWxpZW5jZVdlYWtuZXNzEj0KCG1ldGFkYXRhGAYgASgLMisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvbk1ldGFkYXRhEjkKB2Nhc2NhZGUYByABKAsyKC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5DYXNjYWRlQW5hbHlzaXMilAEKEVJlc2lsaWVuY2VNZXRyaWNzEhUKDW92ZXJhbGxfc2NvcmUYASABKAESHwoXY29ubmVjdGl2aXR5X3JvYnVzdG5lc3MYAiABKAESFwoPZmxvd19yb2J1c3RuZXNzGAMgASgBEhgKEHJlZHVuZGFuY3lfbGV2ZWwYBCABKAESFAoMbWluX2N1dF9zaXplGAUgASgFIuABChFOTWludXNPbmVBbmFseXNpcxIeChZhbGxfc2NlbmFyaW9zX2ZlYXNpYmxlGAEgASgIEiEKGXdvcnN0X2Nhc2VfZmxvd19yZWR1Y3Rpb24YAiABKAESOAoSbW9zdF9jcml0aWNhbF9lZGdlGAMgASgLMhwubG9naXN0aWNzLmNvbW1vbi52MS5FZGdlS2V5EhoKEm1vc3RfY3JpdGljYWxfbm9kZRgEIAEoAxIYChBzY2VuYXJpb3NfdGVzdGVkGAUgASgFEhgKEHNjZW5hcmlvc19mYWlsZWQYBiABKAUi8wIKEU5NaW51c1R3b0FuYWx5c2lzEg8KB2VuYWJsZWQYASABKAgSHgoWcHJvYmFiaWxpdHlfb2ZfZmFpbHVyZRgCIAEoARIWCg5jcml0aWNhbF9wYWlycxgDIAEoBRI+ChNjcml0aWNhbF9lZGdlX3BhaXJzGAQgAygLMiEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuRWRnZVBhaXISFAoMbWF4X2ZhaWx1cmVzGAUgASgFEhcKD2NhbmRpZGF0ZV9lZGdlcxgGIAEoBRIYChBzY2VuYXJpb3NfdGVzdGVkGAcgASgFEhgKEHNjZW5hcmlvc19mYWlsZWQYCCABKAUSIQoZd29yc3RfY2FzZV9mbG93X3JlZHVjdGlvbhgJIAEoARI8ChJjcml0aWNhbF9lZGdlX3NldHMYCiADKAsyIC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5FZGdlU2V0EhEKCXRydW5jYXRlZBgLIAEoCCJ9CghFZGdlUGFpchIrCgVlZGdlMRgBIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIrCgVlZGdlMhgCIAEoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIXCg9jb21iaW5lZF9pbXBhY3QYAyABKAEiTwoHRWRnZVNldBIrCgVlZGdlcxgBIAMoCzIcLmxvZ2lzdGljcy5jb21tb24udjEuRWRnZUtleRIXCg9jb21iaW5lZF9pbXBhY3QYAiABKAEisQEKD0Nhc2NhZGVBbmFseXNpcxIPCgdlbmFibGVkGAEgASgIEhMKC2xvYWRfZmFjdG9yGAIgASgBEhgKEHNjZW5hcmlvc190ZXN0ZWQYAyABKAUSOwoJc2NlbmFyaW9zGAQgAygLMigubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQ2FzY2FkZVNjZW5hcmlvEiEKGXdvcnN0X2Nhc2VfZmxvd19yZWR1Y3Rpb24YBSABKAEivwEKD0Nhc2NhZGVTY2VuYXJpbxI1Cg9pbml0aWFsX2ZhaWx1cmUYASABKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSMwoFc3RlcHMYAiADKAsyJC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5DYXNjYWRlU3RlcBISCgpmaW5hbF9mbG93GAMgASgBEhYKDmZsb3dfcmVkdWN0aW9uGAQgASgBEhQKDGZhaWxlZF9lZGdlcxgFIAEoBSJkCgtDYXNjYWRlU3RlcBINCgVyb3VuZBgBIAEoBRIyCgxmYWlsZWRfZWRnZXMYAiADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSEgoKZmxvd19hZnRlchgDIAEoASLdAQoSUmVzaWxpZW5jZVdlYWtuZXNzEhMKC2Rlc2NyaXB0aW9uGAEgASgJEjMKBHR5cGUYAiABKA4yJS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5XZWFrbmVzc1R5cGUSEAoIc2V2ZXJpdHkYAyABKAESNAoOYWZmZWN0ZWRfZWRnZXMYBCADKAsyHC5sb2dpc3RpY3MuY29tbW9uLnYxLkVkZ2VLZXkSFgoOYWZmZWN0ZWRfbm9kZXMYBSADKAMSHQoVbWl0aWdhdGlvbl9zdWdnZXN0aW9uGAYgASgJIs8CChVTYXZlU2ltdWxhdGlvblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjUKBHR5cGUYBCABKA4yJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uVHlwZRIpCgVncmFwaBgFIAEoCzIaLmxvZ2lzdGljcy5jb21tb24udjEuR3JhcGgSFAoMcmVxdWVzdF9kYXRhGAYgASgMEhUKDXJlc3BvbnNlX2RhdGEYByABKAwSRgoEdGFncxgIIAMoCzI4LmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNhdmVTaW11bGF0aW9uUmVxdWVzdC5UYWdzRW50cnkaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiXwoWU2F2ZVNpbXVsYXRpb25SZXNwb25zZRIVCg1zaW11bGF0aW9uX2lkGAEgASgJEi4KCmNyZWF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIj4KFEdldFNpbXVsYXRpb25SZXF1ZXN0EhUKDXNpbXVsYXRpb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSJSChVHZXRTaW11bGF0aW9uUmVzcG9uc2USOQoGcmVjb3JkGAEgASgLMikubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvblJlY29yZCKcAQoWTGlzdFNpbXVsYXRpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEjUKBHR5cGUYAiABKA4yJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uVHlwZRI6CgpwYWdpbmF0aW9uGAMgASgLMiYubG9naXN0aWNzLmNvbW1vbi52MS5QYWdpbmF0aW9uUmVxdWVzdCKXAQoXTGlzdFNpbXVsYXRpb25zUmVzcG9uc2USPwoLc2ltdWxhdGlvbnMYASADKAsyKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uU3VtbWFyeRI7CgpwYWdpbmF0aW9uGAIgASgLMicubG9naXN0aWNzLmNvbW1vbi52MS5QYWdpbmF0aW9uUmVzcG9uc2Ui1gIKEFNpbXVsYXRpb25SZWNvcmQSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEjUKBHR5cGUYBSABKA4yJy5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uVHlwZRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxyZXF1ZXN0X2RhdGEYByABKAwSFQoNcmVzcG9uc2VfZGF0YRgIIAEoDBJBCgR0YWdzGAkgAygLMjMubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGlvblJlY29yZC5UYWdzRW50cnkaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEihQIKEVNpbXVsYXRpb25TdW1tYXJ5EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSNQoEdHlwZRgDIAEoDjInLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRpb25UeXBlEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEkIKBHRhZ3MYBSADKAsyNC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0aW9uU3VtbWFyeS5UYWdzRW50cnkaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiwQEKElNpbXVsYXRpb25NZXRhZGF0YRIVCg1zaW11bGF0aW9uX2lkGAEgASgJEhsKE2NvbXB1dGF0aW9uX3RpbWVfbXMYAiABKAESEgoKaXRlcmF0aW9ucxgDIAEoBRIZChFtZW1vcnlfdXNlZF9ieXRlcxgEIAEoAxIWCg5hbGdvcml0aG1fdXNlZBgFIAEoCRIwCgxjb21wbGV0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIg8KDUhlYWx0aFJlcXVlc3QiSQoOSGVhbHRoUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEg8KB3ZlcnNpb24YAiABKAkSFgoOdXB0aW1lX3NlY29uZHMYAyABKAMqhQIKEE1vZGlmaWNhdGlvblR5cGUSIQodTU9ESUZJQ0FUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIhCh1NT0RJRklDQVRJT05fVFlQRV9VUERBVEVfRURHRRABEiEKHU1PRElGSUNBVElPTl9UWVBFX1JFTU9WRV9FREdFEAISHgoaTU9ESUZJQ0FUSU9OX1RZUEVfQUREX0VER0UQAxIhCh1NT0RJRklDQVRJT05fVFlQRV9VUERBVEVfTk9ERRAEEiEKHU1PRElGSUNBVElPTl9UWVBFX1JFTU9WRV9OT0RFEAUSIgoeTU9ESUZJQ0FUSU9OX1RZUEVfRElTQUJMRV9OT0RFEAYq2QEKEk1vZGlmaWNhdGlvblRhcmdldBIjCh9NT0RJRklDQVRJT05fVEFSR0VUX1VOU1BFQ0lGSUVEEAASIAocTU9ESUZJQ0FUSU9OX1RBUkdFVF9DQVBBQ0lUWRABEhwKGE1PRElGSUNBVElPTl9UQVJHRVRfQ09TVBACEh4KGk1PRElGSUNBVElPTl9UQVJHRVRfTEVOR1RIEAMSHgoaTU9ESUZJQ0FUSU9OX1RBUkdFVF9TVVBQTFkQBBIeChpNT0RJRklDQVRJT05fVEFSR0VUX0RFTUFORBAFKqMBCgtJbXBhY3RMZXZlbBIcChhJTVBBQ1RfTEVWRUxfVU5TUEVDSUZJRUQQABIVChFJTVBBQ1RfTEVWRUxfTk9ORRABEhQKEElNUEFDVF9MRVZFTF9MT1cQAhIXChNJTVBBQ1RfTEVWRUxfTUVESVVNEAMSFQoRSU1QQUNUX0xFVkVMX0hJR0gQBBIZChVJTVBBQ1RfTEVWRUxfQ1JJVElDQUwQBSrNAQoUQm90dGxlbmVja0NoYW5nZVR5cGUSJgoiQk9UVExFTkVDS19DSEFOR0VfVFlQRV9VTlNQRUNJRklFRBAAEh4KGkJPVFRMRU5FQ0tfQ0hBTkdFX1RZUEVfTkVXEAESIwofQk9UVExFTkVDS19DSEFOR0VfVFlQRV9SRVNPTFZFRBACEiMKH0JPVFRMRU5FQ0tfQ0hBTkdFX1RZUEVfV09SU0VORUQQAxIjCh9CT1RUTEVORUNLX0NIQU5HRV9UWVBFX0lNUFJPVkVEEAQqqwEKE0V4cGFuc2lvblN0b3BSZWFzb24SJQohRVhQQU5TSU9OX1NUT1BfUkVBU09OX1VOU1BFQ0lGSUVEEAASIAocRVhQQU5TSU9OX1NUT1BfUkVBU09OX0JVREdFVBABEiEKHUVYUEFOU0lPTl9TVE9QX1JFQVNPTl9OT19HQUlOEAISKAokRVhQQU5TSU9OX1NUT1BfUkVBU09OX01BWF9JVEVSQVRJT05TEAMqjgEKEEZhY2lsaXR5TW92ZVR5cGUSIgoeRkFDSUxJVFlfTU9WRV9UWVBFX1VOU1BFQ0lGSUVEEAASGwoXRkFDSUxJVFlfTU9WRV9UWVBFX09QRU4QARIcChhGQUNJTElUWV9NT1ZFX1RZUEVfQ0xPU0UQAhIbChdGQUNJTElUWV9NT1ZFX1RZUEVfU1dBUBADKn0KElRpbWVTaW11bGF0aW9uTW9kZRIkCiBUSU1FX1NJTVVMQVRJT05fTU9ERV9VTlNQRUNJRklFRBAAEh8KG1RJTUVfU0lNVUxBVElPTl9NT0RFX1NUQVRJQxABEiAKHFRJTUVfU0lNVUxBVElPTl9NT0RFX0RZTkFNSUMQAip2CghUaW1lU3RlcBIZChVUSU1FX1NURVBfVU5TUEVDSUZJRUQQABIUChBUSU1FX1NURVBfTUlOVVRFEAESEgoOVElNRV9TVEVQX0hPVVIQAhIRCg1USU1FX1NURVBfREFZEAMSEgoOVElNRV9TVEVQX1dFRUsQBCqbAQoNUGF0dGVyblRhcmdldBIeChpQQVRURVJOX1RBUkdFVF9VTlNQRUNJRklFRBAAEhsKF1BBVFRFUk5fVEFSR0VUX0NBUEFDSVRZEAESFwoTUEFUVEVSTl9UQVJHRVRfQ09TVBACEhkKFVBBVFRFUk5fVEFSR0VUX1NVUFBMWRADEhkKFVBBVFRFUk5fVEFSR0VUX0RFTUFORBAEKtEBCgtQYXR0ZXJuVHlwZRIcChhQQVRURVJOX1RZUEVfVU5TUEVDSUZJRUQQABIZChVQQVRURVJOX1RZUEVfQ09OU1RBTlQQARIXChNQQVRURVJOX1RZUEVfSE9VUkxZEAISFgoSUEFUVEVSTl9UWVBFX0RBSUxZEAMSFwoTUEFUVEVSTl9UWVBFX0NVU1RPTRAEEh4KGlBBVFRFUk5fVFlQRV9SQU5ET01fTk9STUFMEAUSHwobUEFUVEVSTl9UWVBFX1JBTkRPTV9VTklGT1JNEAYqzgEKEkNyaXRpY2FsUGVyaW9kVHlwZRIkCiBDUklUSUNBTF9QRVJJT0RfVFlQRV9VTlNQRUNJRklFRBAAEiUKIUNSSVRJQ0FMX1BFUklPRF9UWVBFX0xPV19DQVBBQ0lUWRABEiQKIENSSVRJQ0FMX1BFUklPRF9UWVBFX0hJR0hfREVNQU5EEAISIwofQ1JJVElDQUxfUEVSSU9EX1RZUEVfQ09OR0VTVElPThADEiAKHENSSVRJQ0FMX1BFUklPRF9UWVBFX0ZBSUxVUkUQBCqeAQoSQ29ycmVsYXRpb25NZWFzdXJlEiMKH0NPUlJFTEFUSU9OX01FQVNVUkVfVU5TUEVDSUZJRUQQABIgChxDT1JSRUxBVElPTl9NRUFTVVJFX0dBVVNTSUFOEAESIAocQ09SUkVMQVRJT05fTUVBU1VSRV9TUEVBUk1BThACEh8KG0NPUlJFTEFUSU9OX01FQVNVUkVfS0VOREFMTBADKtwBChRNb250ZUNhcmxvU3RvcFJlYXNvbhInCiNNT05URV9DQVJMT19TVE9QX1JFQVNPTl9VTlNQRUNJRklFRBAAEiUKIU1PTlRFX0NBUkxPX1NUT1BfUkVBU09OX0NPTVBMRVRFRBABEiUKIU1PTlRFX0NBUkxPX1NUT1BfUkVBU09OX0NPTlZFUkdFRBACEicKI01PTlRFX0NBUkxPX1NUT1BfUkVBU09OX1RJTUVfQlVER0VUEAMSJAogTU9OVEVfQ0FSTE9fU1RPUF9SRUFTT05fQ0FOQ0VMRUQQBCqtAQoOU2FtcGxpbmdNZXRob2QSHwobU0FNUExJTkdfTUVUSE9EX1VOU1BFQ0lGSUVEEAASGgoWU0FNUExJTkdfTUVUSE9EX1JBTkRPTRABEiMKH1NBTVBMSU5HX01FVEhPRF9MQVRJTl9IWVBFUkNVQkUQAhIZChVTQU1QTElOR19NRVRIT0RfU09CT0wQAxIeChpTQU1QTElOR19NRVRIT0RfQU5USVRIRVRJQxAEKoYBCg9VbmNlcnRhaW50eVR5cGUSIAocVU5DRVJUQUlOVFlfVFlQRV9VTlNQRUNJRklFRBAAEhkKFVVOQ0VSVEFJTlRZX1RZUEVfRURHRRABEhkKFVVOQ0VSVEFJTlRZX1RZUEVfTk9ERRACEhsKF1VOQ0VSVEFJTlRZX1RZUEVfR0xPQkFMEAMq2AEKEERpc3RyaWJ1dGlvblR5cGUSIQodRElTVFJJQlVUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIcChhESVNUUklCVVRJT05fVFlQRV9OT1JNQUwQARIdChlESVNUUklCVVRJT05fVFlQRV9VTklGT1JNEAISIAocRElTVFJJQlVUSU9OX1RZUEVfVFJJQU5HVUxBUhADEh8KG0RJU1RSSUJVVElPTl9UWVBFX0xPR05PUk1BTBAEEiEKHURJU1RSSUJVVElPTl9UWVBFX0VYUE9ORU5USUFMEAUqmgEKEVNlbnNpdGl2aXR5TWV0aG9kEiIKHlNFTlNJVElWSVRZX01FVEhPRF9VTlNQRUNJRklFRBAAEiQKIFNFTlNJVElWSVRZX01FVEhPRF9PTkVfQVRfQV9USU1FEAESHQoZU0VOU0lUSVZJVFlfTUVUSE9EX01PUlJJUxACEhwKGFNFTlNJVElWSVRZX01FVEhPRF9TT0JPTBADKswBChBTZW5zaXRpdml0eUxldmVsEiEKHVNFTlNJVElWSVRZX0xFVkVMX1VOU1BFQ0lGSUVEEAASIAocU0VOU0lUSVZJVFlfTEVWRUxfTkVHTElHSUJMRRABEhkKFVNFTlNJVElWSVRZX0xFVkVMX0xPVxACEhwKGFNFTlNJVElWSVRZX0xFVkVMX01FRElVTRADEhoKFlNFTlNJVElWSVRZX0xFVkVMX0hJR0gQBBIeChpTRU5TSVRJVklUWV9MRVZFTF9DUklUSUNBTBAFKrQBCg1UaHJlc2hvbGRUeXBlEh4KGlRIUkVTSE9MRF9UWVBFX1VOU1BFQ0lGSUVEEAASHQoZVEhSRVNIT0xEX1RZUEVfRkxPV19EUk9QUxABEh4KGlRIUkVTSE9MRF9UWVBFX0NPU1RfU1BJS0VTEAISJQohVEhSRVNIT0xEX1RZUEVfQk9UVExFTkVDS19BUFBFQVJTEAMSHQoZVEhSRVNIT0xEX1RZUEVfSU5GRUFTSUJMRRAEKn4KEkZhaWx1cmVDb3JyZWxhdGlvbhIjCh9GQUlMVVJFX0NPUlJFTEFUSU9OX1VOU1BFQ0lGSUVEEAASIAocRkFJTFVSRV9DT1JSRUxBVElPTl9SRUdJT05BTBABEiEKHUZBSUxVUkVfQ09SUkVMQVRJT05fUk9BRF9UWVBFEAIq4gEKElJlY29tbWVuZGF0aW9uVHlwZRIjCh9SRUNPTU1FTkRBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASJgoiUkVDT01NRU5EQVRJT05fVFlQRV9BRERfUkVEVU5EQU5DWRABEikKJVJFQ09NTUVOREFUSU9OX1RZUEVfSU5DUkVBU0VfQ0FQQUNJVFkQAhIoCiRSRUNPTU1FTkRBVElPTl9UWVBFX0FERF9CQUNLVVBfUk9VVEUQAxIqCiZSRUNPTU1FTkRBVElPTl9UWVBFX1JFTE9DQVRFX1dBUkVIT1VTRRAEKuwBCgxXZWFrbmVzc1R5cGUSHQoZV0VBS05FU1NfVFlQRV9VTlNQRUNJRklFRBAAEikKJVdFQUtORVNTX1RZUEVfU0lOR0xFX1BPSU5UX09GX0ZBSUxVUkUQARIlCiFXRUFLTkVTU19UWVBFX0NBUEFDSVRZX0JPVFRMRU5FQ0sQAhIfChtXRUFLTkVTU19UWVBFX05PX1JFRFVOREFOQ1kQAxIqCiZXRUFLTkVTU19UWVBFX0dFT0dSQVBISUNfQ09OQ0VOVFJBVElPThAEEh4KGldFQUtORVNTX1RZUEVfQ0FTQ0FERV9SSVNLEAUq5wEKDlNpbXVsYXRpb25UeXBlEh8KG1NJTVVMQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEhsKF1NJTVVMQVRJT05fVFlQRV9XSEFUX0lGEAESGAoUU0lNVUxBVElPTl9UWVBFX1RJTUUQAhIfChtTSU1VTEFUSU9OX1RZUEVfTU9OVEVfQ0FSTE8QAxIfChtTSU1VTEFUSU9OX1RZUEVfU0VOU0lUSVZJVFkQBBIbChdTSU1VTEFUSU9OX1RZUEVfRkFJTFVSRRAFEh4KGlNJTVVMQVRJT05fVFlQRV9SRVNJTElFTkNFEAYytxAKEVNpbXVsYXRpb25TZXJ2aWNlEmIKCVJ1bldoYXRJZhIpLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1bldoYXRJZlJlcXVlc3QaKi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5XaGF0SWZSZXNwb25zZRJ3ChBDb21wYXJlU2NlbmFyaW9zEjAubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQ29tcGFyZVNjZW5hcmlvc1JlcXVlc3QaMS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5Db21wYXJlU2NlbmFyaW9zUmVzcG9uc2USkgEKGU9wdGltaXplQ2FwYWNpdHlFeHBhbnNpb24SOS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5PcHRpbWl6ZUNhcGFjaXR5RXhwYW5zaW9uUmVxdWVzdBo6LmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk9wdGltaXplQ2FwYWNpdHlFeHBhbnNpb25SZXNwb25zZRKPAQoYT3B0aW1pemVGYWNpbGl0eUxvY2F0aW9uEjgubG9naXN0aWNzLnNpbXVsYXRpb24udjEuT3B0aW1pemVGYWNpbGl0eUxvY2F0aW9uUmVxdWVzdBo5LmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLk9wdGltaXplRmFjaWxpdHlMb2NhdGlvblJlc3BvbnNlEnoKEVJ1blRpbWVTaW11bGF0aW9uEjEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUnVuVGltZVNpbXVsYXRpb25SZXF1ZXN0GjIubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUnVuVGltZVNpbXVsYXRpb25SZXNwb25zZRJ3ChBTaW11bGF0ZVBlYWtMb2FkEjAubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGVQZWFrTG9hZFJlcXVlc3QaMS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5TaW11bGF0ZVBlYWtMb2FkUmVzcG9uc2USlQEKGlJ1bkRpc2NyZXRlRXZlbnRTaW11bGF0aW9uEjoubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUnVuRGlzY3JldGVFdmVudFNpbXVsYXRpb25SZXF1ZXN0GjsubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUnVuRGlzY3JldGVFdmVudFNpbXVsYXRpb25SZXNwb25zZRJuCg1SdW5Nb250ZUNhcmxvEi0ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuUnVuTW9udGVDYXJsb1JlcXVlc3QaLi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5SdW5Nb250ZUNhcmxvUmVzcG9uc2UScwoTUnVuTW9udGVDYXJsb1N0cmVhbRItLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlJ1bk1vbnRlQ2FybG9SZXF1ZXN0GisubG9naXN0aWNzLnNpbXVsYXRpb24udjEuTW9udGVDYXJsb1Byb2dyZXNzMAESfQoSQW5hbHl6ZVNlbnNpdGl2aXR5EjIubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVNlbnNpdGl2aXR5UmVxdWVzdBozLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkFuYWx5emVTZW5zaXRpdml0eVJlc3BvbnNlEoMBChRGaW5kQ3JpdGljYWxFbGVtZW50cxI0LmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZpbmRDcml0aWNhbEVsZW1lbnRzUmVxdWVzdBo1LmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkZpbmRDcml0aWNhbEVsZW1lbnRzUmVzcG9uc2USdwoQU2ltdWxhdGVGYWlsdXJlcxIwLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNpbXVsYXRlRmFpbHVyZXNSZXF1ZXN0GjEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuU2ltdWxhdGVGYWlsdXJlc1Jlc3BvbnNlEnoKEUFuYWx5emVSZXNpbGllbmNlEjEubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVJlc2lsaWVuY2VSZXF1ZXN0GjIubG9naXN0aWNzLnNpbXVsYXRpb24udjEuQW5hbHl6ZVJlc2lsaWVuY2VSZXNwb25zZRJxCg5TYXZlU2ltdWxhdGlvbhIuLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNhdmVTaW11bGF0aW9uUmVxdWVzdBovLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLlNhdmVTaW11bGF0aW9uUmVzcG9uc2USbgoNR2V0U2ltdWxhdGlvbhItLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkdldFNpbXVsYXRpb25SZXF1ZXN0Gi4ubG9naXN0aWNzLnNpbXVsYXRpb24udjEuR2V0U2ltdWxhdGlvblJlc3BvbnNlEnQKD0xpc3RTaW11bGF0aW9ucxIvLmxvZ2lzdGljcy5zaW11bGF0aW9uLnYxLkxpc3RTaW11bGF0aW9uc1JlcXVlc3QaMC5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5MaXN0U2ltdWxhdGlvbnNSZXNwb25zZRJZCgZIZWFsdGgSJi5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MS5IZWFsdGhSZXF1ZXN0GicubG9naXN0aWNzLnNpbXVsYXRpb24udjEuSGVhbHRoUmVzcG9uc2VC4wEKG2NvbS5sb2dpc3RpY3Muc2ltdWxhdGlvbi52MUIPU2ltdWxhdGlvblByb3RvUAFaNWxvZ2lzdGljcy9nZW4vZ28vbG9naXN0aWNzL3NpbXVsYXRpb24vdjE7c2ltdWxhdGlvbnYxogIDTFNYqgIXTG9naXN0aWNzLlNpbXVsYXRpb24uVjHKAhdMb2dpc3RpY3NcU2ltdWxhdGlvblxWMeICI0xvZ2lzdGljc1xTaW11bGF0aW9uXFYxXEdQQk1ldGFkYXRh6gIZTG9naXN0aWNzOjpTaW11bGF0aW9uOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_logistics_analytics_v1_analytics, file_logistics_common_v1_common]);
 
 /**
  * @generated from message logistics.simulation.v1.RunWhatIfRequest
@@ -528,6 +530,522 @@ export type ScenarioResultWithRank = Message<"logistics.simulation.v1.ScenarioRe
 export const ScenarioResultWithRankSchema: GenMessage<ScenarioResultWithRank> = /*@__PURE__*/
   messageDesc(file_logistics_simulation_v1_simulation, 11);
 
+/**
+ * Жадное расширение по минимальному разрезу: на каждой итерации кандидаты —
+ * приращения рёбер текущего минимального разреза по отдельности, всего
+ * разреза сразу и всех насыщенных рёбер самого дешёвого по стоимости
+ * расширения увеличивающего пути (последовательные узкие места расширяются
+ * вместе); выбирается кандидат с наибольшим приростом потока на единицу
+ * стоимости, который укладывается в остаток бюджета. Прирост каждого
+ * кандидата проверяется решением solver'а
+ *
+ * @generated from message logistics.simulation.v1.OptimizeCapacityExpansionRequest
+ */
+export type OptimizeCapacityExpansionRequest = Message<"logistics.simulation.v1.OptimizeCapacityExpansionRequest"> & {
+  /**
+   * @generated from field: logistics.common.v1.Graph graph = 1;
+   */
+  graph?: Graph;
+
+  /**
+   * @generated from field: logistics.common.v1.Algorithm algorithm = 2;
+   */
+  algorithm: Algorithm;
+
+  /**
+   * @generated from field: double budget = 3;
+   */
+  budget: number;
+
+  /**
+   * Варианты расширения отдельных рёбер
+   *
+   * @generated from field: repeated logistics.simulation.v1.EdgeUpgradeOption upgrades = 4;
+   */
+  upgrades: EdgeUpgradeOption[];
+
+  /**
+   * Вариант для рёбер без своего (edge не используется); без него
+   * расширяются только рёбра из upgrades
+   *
+   * @generated from field: logistics.simulation.v1.EdgeUpgradeOption default_upgrade = 5;
+   */
+  defaultUpgrade?: EdgeUpgradeOption;
+
+  /**
+   * По умолчанию 50
+   *
+   * @generated from field: int32 max_iterations = 6;
+   */
+  maxIterations: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.OptimizeCapacityExpansionRequest.
+ * Use `create(OptimizeCapacityExpansionRequestSchema)` to create a new message.
+ */
+export const OptimizeCapacityExpansionRequestSchema: GenMessage<OptimizeCapacityExpansionRequest> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 12);
+
+/**
+ * Стоимость расширения ребра: fixed_cost при первом приращении плюс
+ * cost_per_unit за каждую единицу пропускной способности
+ *
+ * @generated from message logistics.simulation.v1.EdgeUpgradeOption
+ */
+export type EdgeUpgradeOption = Message<"logistics.simulation.v1.EdgeUpgradeOption"> & {
+  /**
+   * @generated from field: logistics.common.v1.EdgeKey edge = 1;
+   */
+  edge?: EdgeKey;
+
+  /**
+   * @generated from field: double fixed_cost = 2;
+   */
+  fixedCost: number;
+
+  /**
+   * @generated from field: double cost_per_unit = 3;
+   */
+  costPerUnit: number;
+
+  /**
+   * Шаг приращения; 0 — исходная пропускная способность ребра (первый шаг
+   * удваивает ребро)
+   *
+   * @generated from field: double step = 4;
+   */
+  step: number;
+
+  /**
+   * Предельное суммарное приращение; 0 — без ограничения
+   *
+   * @generated from field: double max_increase = 5;
+   */
+  maxIncrease: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.EdgeUpgradeOption.
+ * Use `create(EdgeUpgradeOptionSchema)` to create a new message.
+ */
+export const EdgeUpgradeOptionSchema: GenMessage<EdgeUpgradeOption> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 13);
+
+/**
+ * @generated from message logistics.simulation.v1.OptimizeCapacityExpansionResponse
+ */
+export type OptimizeCapacityExpansionResponse = Message<"logistics.simulation.v1.OptimizeCapacityExpansionResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: double base_flow = 2;
+   */
+  baseFlow: number;
+
+  /**
+   * @generated from field: double final_flow = 3;
+   */
+  finalFlow: number;
+
+  /**
+   * Потрачено из бюджета
+   *
+   * @generated from field: double total_cost = 4;
+   */
+  totalCost: number;
+
+  /**
+   * @generated from field: double remaining_budget = 5;
+   */
+  remainingBudget: number;
+
+  /**
+   * Итоговые приращения по рёбрам
+   *
+   * @generated from field: repeated logistics.simulation.v1.CapacityIncrement increments = 6;
+   */
+  increments: CapacityIncrement[];
+
+  /**
+   * Выбранные кандидаты по итерациям
+   *
+   * @generated from field: repeated logistics.simulation.v1.ExpansionStep steps = 7;
+   */
+  steps: ExpansionStep[];
+
+  /**
+   * Решённый граф с расширенными рёбрами
+   *
+   * @generated from field: logistics.common.v1.Graph expanded_graph = 8;
+   */
+  expandedGraph?: Graph;
+
+  /**
+   * @generated from field: logistics.simulation.v1.ExpansionStopReason stop_reason = 9;
+   */
+  stopReason: ExpansionStopReason;
+
+  /**
+   * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 10;
+   */
+  metadata?: SimulationMetadata;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.OptimizeCapacityExpansionResponse.
+ * Use `create(OptimizeCapacityExpansionResponseSchema)` to create a new message.
+ */
+export const OptimizeCapacityExpansionResponseSchema: GenMessage<OptimizeCapacityExpansionResponse> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 14);
+
+/**
+ * @generated from message logistics.simulation.v1.CapacityIncrement
+ */
+export type CapacityIncrement = Message<"logistics.simulation.v1.CapacityIncrement"> & {
+  /**
+   * @generated from field: logistics.common.v1.EdgeKey edge = 1;
+   */
+  edge?: EdgeKey;
+
+  /**
+   * @generated from field: double added_capacity = 2;
+   */
+  addedCapacity: number;
+
+  /**
+   * @generated from field: double new_capacity = 3;
+   */
+  newCapacity: number;
+
+  /**
+   * @generated from field: double cost = 4;
+   */
+  cost: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.CapacityIncrement.
+ * Use `create(CapacityIncrementSchema)` to create a new message.
+ */
+export const CapacityIncrementSchema: GenMessage<CapacityIncrement> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 15);
+
+/**
+ * @generated from message logistics.simulation.v1.ExpansionStep
+ */
+export type ExpansionStep = Message<"logistics.simulation.v1.ExpansionStep"> & {
+  /**
+   * @generated from field: int32 iteration = 1;
+   */
+  iteration: number;
+
+  /**
+   * Приращения шага
+   *
+   * @generated from field: repeated logistics.simulation.v1.CapacityIncrement increments = 2;
+   */
+  increments: CapacityIncrement[];
+
+  /**
+   * @generated from field: double cost = 3;
+   */
+  cost: number;
+
+  /**
+   * Поток после шага
+   *
+   * @generated from field: double flow = 4;
+   */
+  flow: number;
+
+  /**
+   * @generated from field: double flow_gain = 5;
+   */
+  flowGain: number;
+
+  /**
+   * Расширен весь минимальный разрез
+   *
+   * @generated from field: bool whole_cut = 6;
+   */
+  wholeCut: boolean;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.ExpansionStep.
+ * Use `create(ExpansionStepSchema)` to create a new message.
+ */
+export const ExpansionStepSchema: GenMessage<ExpansionStep> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 16);
+
+/**
+ * Ёмкостная задача размещения складов. Кандидаты — узлы NODE_TYPE_WAREHOUSE,
+ * их supply — мощность склада (0 — без ограничения), demand узлов
+ * NODE_TYPE_DELIVERY_POINT — спрос. Для набора открытых складов транспортная
+ * стоимость — стоимость потока минимальной стоимости (ALGORITHM_MIN_COST), в
+ * котором supply закрытых складов обнулён. Локальный поиск начинает с
+ * initial_open и на каждой итерации выполняет лучший из ходов «открыть»,
+ * «закрыть» и «заменить», пока ход уменьшает суммарную стоимость
+ *
+ * @generated from message logistics.simulation.v1.OptimizeFacilityLocationRequest
+ */
+export type OptimizeFacilityLocationRequest = Message<"logistics.simulation.v1.OptimizeFacilityLocationRequest"> & {
+  /**
+   * @generated from field: logistics.common.v1.Graph graph = 1;
+   */
+  graph?: Graph;
+
+  /**
+   * Стоимость открытия склада: warehouse_costs по ID узла, иначе
+   * warehouse_cost; остальные поля не используются
+   *
+   * @generated from field: logistics.analytics.v1.FixedCostConfig fixed_costs = 2;
+   */
+  fixedCosts?: FixedCostConfig;
+
+  /**
+   * Штраф за единицу неудовлетворённого спроса; 0 — решения сравниваются
+   * сначала по обслуженному спросу, затем по стоимости
+   *
+   * @generated from field: double unmet_demand_penalty = 3;
+   */
+  unmetDemandPenalty: number;
+
+  /**
+   * Склады, открытые в начале поиска; пусто — все кандидаты
+   *
+   * @generated from field: repeated int64 initial_open = 4;
+   */
+  initialOpen: bigint[];
+
+  /**
+   * По умолчанию 100
+   *
+   * @generated from field: int32 max_iterations = 5;
+   */
+  maxIterations: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.OptimizeFacilityLocationRequest.
+ * Use `create(OptimizeFacilityLocationRequestSchema)` to create a new message.
+ */
+export const OptimizeFacilityLocationRequestSchema: GenMessage<OptimizeFacilityLocationRequest> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 17);
+
+/**
+ * @generated from message logistics.simulation.v1.OptimizeFacilityLocationResponse
+ */
+export type OptimizeFacilityLocationResponse = Message<"logistics.simulation.v1.OptimizeFacilityLocationResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * Решение по каждому кандидату в порядке узлов графа
+   *
+   * @generated from field: repeated logistics.simulation.v1.FacilityDecision facilities = 2;
+   */
+  facilities: FacilityDecision[];
+
+  /**
+   * @generated from field: repeated int64 open_warehouses = 3;
+   */
+  openWarehouses: bigint[];
+
+  /**
+   * @generated from field: repeated int64 closed_warehouses = 4;
+   */
+  closedWarehouses: bigint[];
+
+  /**
+   * fixed_cost + transport_cost + штраф
+   *
+   * @generated from field: double total_cost = 5;
+   */
+  totalCost: number;
+
+  /**
+   * @generated from field: double fixed_cost = 6;
+   */
+  fixedCost: number;
+
+  /**
+   * @generated from field: double transport_cost = 7;
+   */
+  transportCost: number;
+
+  /**
+   * Штраф за неудовлетворённый спрос
+   *
+   * @generated from field: double unmet_demand_cost = 8;
+   */
+  unmetDemandCost: number;
+
+  /**
+   * @generated from field: double total_demand = 9;
+   */
+  totalDemand: number;
+
+  /**
+   * @generated from field: double served_demand = 10;
+   */
+  servedDemand: number;
+
+  /**
+   * Стоимость набора initial_open
+   *
+   * @generated from field: double initial_total_cost = 11;
+   */
+  initialTotalCost: number;
+
+  /**
+   * Выполненные ходы локального поиска
+   *
+   * @generated from field: repeated logistics.simulation.v1.FacilityMove moves = 12;
+   */
+  moves: FacilityMove[];
+
+  /**
+   * По рекомендации на ход: замена склада — перенос (affected_node —
+   * открываемый склад); estimated_improvement — доля стоимости до хода,
+   * которую он экономит
+   *
+   * @generated from field: repeated logistics.simulation.v1.ResilienceRecommendation recommendations = 13;
+   */
+  recommendations: ResilienceRecommendation[];
+
+  /**
+   * Решённый граф для выбранного набора складов
+   *
+   * @generated from field: logistics.common.v1.Graph solved_graph = 14;
+   */
+  solvedGraph?: Graph;
+
+  /**
+   * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 15;
+   */
+  metadata?: SimulationMetadata;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.OptimizeFacilityLocationResponse.
+ * Use `create(OptimizeFacilityLocationResponseSchema)` to create a new message.
+ */
+export const OptimizeFacilityLocationResponseSchema: GenMessage<OptimizeFacilityLocationResponse> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 18);
+
+/**
+ * @generated from message logistics.simulation.v1.FacilityDecision
+ */
+export type FacilityDecision = Message<"logistics.simulation.v1.FacilityDecision"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool open = 3;
+   */
+  open: boolean;
+
+  /**
+   * @generated from field: bool initially_open = 4;
+   */
+  initiallyOpen: boolean;
+
+  /**
+   * @generated from field: double opening_cost = 5;
+   */
+  openingCost: number;
+
+  /**
+   * 0 — без ограничения
+   *
+   * @generated from field: double capacity = 6;
+   */
+  capacity: number;
+
+  /**
+   * Отгружено со склада
+   *
+   * @generated from field: double shipped = 7;
+   */
+  shipped: number;
+
+  /**
+   * shipped / capacity; 0 без ограничения
+   *
+   * @generated from field: double utilization = 8;
+   */
+  utilization: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.FacilityDecision.
+ * Use `create(FacilityDecisionSchema)` to create a new message.
+ */
+export const FacilityDecisionSchema: GenMessage<FacilityDecision> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 19);
+
+/**
+ * @generated from message logistics.simulation.v1.FacilityMove
+ */
+export type FacilityMove = Message<"logistics.simulation.v1.FacilityMove"> & {
+  /**
+   * @generated from field: int32 iteration = 1;
+   */
+  iteration: number;
+
+  /**
+   * @generated from field: logistics.simulation.v1.FacilityMoveType type = 2;
+   */
+  type: FacilityMoveType;
+
+  /**
+   * 0 для CLOSE
+   *
+   * @generated from field: int64 opened_node = 3;
+   */
+  openedNode: bigint;
+
+  /**
+   * 0 для OPEN
+   *
+   * @generated from field: int64 closed_node = 4;
+   */
+  closedNode: bigint;
+
+  /**
+   * Стоимость после хода
+   *
+   * @generated from field: double total_cost = 5;
+   */
+  totalCost: number;
+
+  /**
+   * @generated from field: double saving = 6;
+   */
+  saving: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.FacilityMove.
+ * Use `create(FacilityMoveSchema)` to create a new message.
+ */
+export const FacilityMoveSchema: GenMessage<FacilityMove> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 20);
+
 /**
  * @generated from message logistics.simulation.v1.RunTimeSimulationRequest
  */
@@ -569,7 +1087,7 @@ export type RunTimeSimulationRequest = Message<"logistics.simulation.v1.RunTimeS
  * Use `create(RunTimeSimulationRequestSchema)` to create a new message.
  */
 export const RunTimeSimulationRequestSchema: GenMessage<RunTimeSimulationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 12);
+  messageDesc(file_logistics_simulation_v1_simulation, 21);
 
 /**
  * @generated from message logistics.simulation.v1.TimeSimulationConfig
@@ -600,6 +1118,33 @@ export type TimeSimulationConfig = Message<"logistics.simulation.v1.TimeSimulati
    * @generated from field: int32 num_steps = 4;
    */
   numSteps: number;
+
+  /**
+   * Режим: независимый статический поток на каждом шаге или динамический
+   * поток в сети, развёрнутой во времени
+   *
+   * @generated from field: logistics.simulation.v1.TimeSimulationMode mode = 5;
+   */
+  mode: TimeSimulationMode;
+
+  /**
+   * Скорости движения по типам дорог (единиц Edge.length в час) для расчёта
+   * времени в пути в динамическом режиме. Не заданные типы — скорости по
+   * умолчанию: HIGHWAY 90, PRIMARY 60, SECONDARY 50, LOCAL 40, URBAN 30,
+   * UNSPECIFIED 50
+   *
+   * @generated from field: repeated logistics.simulation.v1.RoadTypeSpeed road_speeds = 6;
+   */
+  roadSpeeds: RoadTypeSpeed[];
+
+  /**
+   * Перенос состояния между шагами (режим STATIC): невыполненный спрос узла
+   * (demand > 0) переходит в backlog следующего шага, неотгруженное
+   * предложение (supply > 0) — в запас узла
+   *
+   * @generated from field: bool carry_over = 7;
+   */
+  carryOver: boolean;
 };
 
 /**
@@ -607,7 +1152,31 @@ export type TimeSimulationConfig = Message<"logistics.simulation.v1.TimeSimulati
  * Use `create(TimeSimulationConfigSchema)` to create a new message.
  */
 export const TimeSimulationConfigSchema: GenMessage<TimeSimulationConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 13);
+  messageDesc(file_logistics_simulation_v1_simulation, 22);
+
+/**
+ * @generated from message logistics.simulation.v1.RoadTypeSpeed
+ */
+export type RoadTypeSpeed = Message<"logistics.simulation.v1.RoadTypeSpeed"> & {
+  /**
+   * @generated from field: logistics.common.v1.RoadType road_type = 1;
+   */
+  roadType: RoadType;
+
+  /**
+   * Единиц длины в час, > 0
+   *
+   * @generated from field: double speed = 2;
+   */
+  speed: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.RoadTypeSpeed.
+ * Use `create(RoadTypeSpeedSchema)` to create a new message.
+ */
+export const RoadTypeSpeedSchema: GenMessage<RoadTypeSpeed> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 23);
 
 /**
  * @generated from message logistics.simulation.v1.EdgeTimePattern
@@ -629,7 +1198,7 @@ export type EdgeTimePattern = Message<"logistics.simulation.v1.EdgeTimePattern">
  * Use `create(EdgeTimePatternSchema)` to create a new message.
  */
 export const EdgeTimePatternSchema: GenMessage<EdgeTimePattern> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 14);
+  messageDesc(file_logistics_simulation_v1_simulation, 24);
 
 /**
  * @generated from message logistics.simulation.v1.NodeTimePattern
@@ -658,7 +1227,7 @@ export type NodeTimePattern = Message<"logistics.simulation.v1.NodeTimePattern">
  * Use `create(NodeTimePatternSchema)` to create a new message.
  */
 export const NodeTimePatternSchema: GenMessage<NodeTimePattern> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 15);
+  messageDesc(file_logistics_simulation_v1_simulation, 25);
 
 /**
  * @generated from message logistics.simulation.v1.TimePattern
@@ -720,7 +1289,7 @@ export type TimePattern = Message<"logistics.simulation.v1.TimePattern"> & {
  * Use `create(TimePatternSchema)` to create a new message.
  */
 export const TimePatternSchema: GenMessage<TimePattern> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 16);
+  messageDesc(file_logistics_simulation_v1_simulation, 26);
 
 /**
  * @generated from message logistics.simulation.v1.TimePoint
@@ -746,7 +1315,7 @@ export type TimePoint = Message<"logistics.simulation.v1.TimePoint"> & {
  * Use `create(TimePointSchema)` to create a new message.
  */
 export const TimePointSchema: GenMessage<TimePoint> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 17);
+  messageDesc(file_logistics_simulation_v1_simulation, 27);
 
 /**
  * @generated from message logistics.simulation.v1.RunTimeSimulationResponse
@@ -782,6 +1351,20 @@ export type RunTimeSimulationResponse = Message<"logistics.simulation.v1.RunTime
    * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 5;
    */
   metadata?: SimulationMetadata;
+
+  /**
+   * Сводка динамического потока (только в режиме DYNAMIC)
+   *
+   * @generated from field: logistics.simulation.v1.DynamicFlowSummary dynamic_flow = 6;
+   */
+  dynamicFlow?: DynamicFlowSummary;
+
+  /**
+   * Уровни обслуживания точек доставки (только при carry_over)
+   *
+   * @generated from field: repeated logistics.simulation.v1.ServiceLevel service_levels = 7;
+   */
+  serviceLevels: ServiceLevel[];
 };
 
 /**
@@ -789,7 +1372,7 @@ export type RunTimeSimulationResponse = Message<"logistics.simulation.v1.RunTime
  * Use `create(RunTimeSimulationResponseSchema)` to create a new message.
  */
 export const RunTimeSimulationResponseSchema: GenMessage<RunTimeSimulationResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 18);
+  messageDesc(file_logistics_simulation_v1_simulation, 28);
 
 /**
  * @generated from message logistics.simulation.v1.TimeStepResult
@@ -806,6 +1389,8 @@ export type TimeStepResult = Message<"logistics.simulation.v1.TimeStepResult"> &
   timestamp?: Timestamp;
 
   /**
+   * В динамическом режиме — поток, прибывший в сток на шаге
+   *
    * @generated from field: double max_flow = 3;
    */
   maxFlow: number;
@@ -821,22 +1406,234 @@ export type TimeStepResult = Message<"logistics.simulation.v1.TimeStepResult"> &
   averageUtilization: number;
 
   /**
-   * @generated from field: int32 saturated_edges = 6;
+   * @generated from field: int32 saturated_edges = 6;
+   */
+  saturatedEdges: number;
+
+  /**
+   * @generated from field: repeated logistics.common.v1.EdgeKey bottlenecks = 7;
+   */
+  bottlenecks: EdgeKey[];
+
+  /**
+   * Динамический режим
+   *
+   * Прибыло в сток на шаге
+   *
+   * @generated from field: double arrivals = 8;
+   */
+  arrivals: number;
+
+  /**
+   * Выпущено из истока на шаге
+   *
+   * @generated from field: double departures = 9;
+   */
+  departures: number;
+
+  /**
+   * В пути в конце шага
+   *
+   * @generated from field: double in_transit = 10;
+   */
+  inTransit: number;
+
+  /**
+   * Запасы в узлах в конце шага
+   *
+   * @generated from field: repeated logistics.simulation.v1.NodeInventory inventory = 11;
+   */
+  inventory: NodeInventory[];
+
+  /**
+   * Перенос состояния (carry_over): supply и demand — предложение и спрос
+   * шага с учётом запаса и backlog, unused_supply — запас в конце шага,
+   * unmet_demand — backlog в конце шага
+   *
+   * @generated from field: repeated logistics.common.v1.NodeBalance node_balances = 12;
+   */
+  nodeBalances: NodeBalance[];
+
+  /**
+   * Неотгруженное предложение в конце шага
+   *
+   * @generated from field: double total_inventory = 13;
+   */
+  totalInventory: number;
+
+  /**
+   * Невыполненный спрос в конце шага
+   *
+   * @generated from field: double total_backlog = 14;
+   */
+  totalBacklog: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.TimeStepResult.
+ * Use `create(TimeStepResultSchema)` to create a new message.
+ */
+export const TimeStepResultSchema: GenMessage<TimeStepResult> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 29);
+
+/**
+ * ServiceLevel уровень обслуживания точки доставки за горизонт
+ *
+ * @generated from message logistics.simulation.v1.ServiceLevel
+ */
+export type ServiceLevel = Message<"logistics.simulation.v1.ServiceLevel"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * Спрос за горизонт без учёта переноса
+   *
+   * @generated from field: double total_demand = 2;
+   */
+  totalDemand: number;
+
+  /**
+   * @generated from field: double delivered = 3;
+   */
+  delivered: number;
+
+  /**
+   * delivered / total_demand
+   *
+   * @generated from field: double fill_rate = 4;
+   */
+  fillRate: number;
+
+  /**
+   * @generated from field: double final_backlog = 5;
+   */
+  finalBacklog: number;
+
+  /**
+   * @generated from field: int32 steps_with_backlog = 6;
+   */
+  stepsWithBacklog: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.ServiceLevel.
+ * Use `create(ServiceLevelSchema)` to create a new message.
+ */
+export const ServiceLevelSchema: GenMessage<ServiceLevel> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 30);
+
+/**
+ * @generated from message logistics.simulation.v1.NodeInventory
+ */
+export type NodeInventory = Message<"logistics.simulation.v1.NodeInventory"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * @generated from field: double level = 2;
+   */
+  level: number;
+
+  /**
+   * @generated from field: double storage_capacity = 3;
+   */
+  storageCapacity: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.NodeInventory.
+ * Use `create(NodeInventorySchema)` to create a new message.
+ */
+export const NodeInventorySchema: GenMessage<NodeInventory> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 31);
+
+/**
+ * @generated from message logistics.simulation.v1.DynamicFlowSummary
+ */
+export type DynamicFlowSummary = Message<"logistics.simulation.v1.DynamicFlowSummary"> & {
+  /**
+   * Суммарно доставлено за горизонт
+   *
+   * @generated from field: double total_flow = 1;
+   */
+  totalFlow: number;
+
+  /**
+   * @generated from field: int32 horizon_steps = 2;
+   */
+  horizonSteps: number;
+
+  /**
+   * Размер развёрнутой во времени сети
+   *
+   * @generated from field: int32 expanded_nodes = 3;
+   */
+  expandedNodes: number;
+
+  /**
+   * @generated from field: int32 expanded_edges = 4;
+   */
+  expandedEdges: number;
+
+  /**
+   * @generated from field: double peak_in_transit = 5;
+   */
+  peakInTransit: number;
+
+  /**
+   * Максимальный суммарный запас за шаг
+   *
+   * @generated from field: double peak_inventory = 6;
+   */
+  peakInventory: number;
+
+  /**
+   * Среднее время в пути единицы доставленного груза, шагов
+   *
+   * @generated from field: double average_transit_steps = 7;
+   */
+  averageTransitSteps: number;
+
+  /**
+   * Время в пути по рёбрам, шагов (в порядке рёбер графа)
+   *
+   * @generated from field: repeated logistics.simulation.v1.EdgeTransitTime transit_times = 8;
+   */
+  transitTimes: EdgeTransitTime[];
+};
+
+/**
+ * Describes the message logistics.simulation.v1.DynamicFlowSummary.
+ * Use `create(DynamicFlowSummarySchema)` to create a new message.
+ */
+export const DynamicFlowSummarySchema: GenMessage<DynamicFlowSummary> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 32);
+
+/**
+ * @generated from message logistics.simulation.v1.EdgeTransitTime
+ */
+export type EdgeTransitTime = Message<"logistics.simulation.v1.EdgeTransitTime"> & {
+  /**
+   * @generated from field: logistics.common.v1.EdgeKey edge = 1;
    */
-  saturatedEdges: number;
+  edge?: EdgeKey;
 
   /**
-   * @generated from field: repeated logistics.common.v1.EdgeKey bottlenecks = 7;
+   * @generated from field: int32 steps = 2;
    */
-  bottlenecks: EdgeKey[];
+  steps: number;
 };
 
 /**
- * Describes the message logistics.simulation.v1.TimeStepResult.
- * Use `create(TimeStepResultSchema)` to create a new message.
+ * Describes the message logistics.simulation.v1.EdgeTransitTime.
+ * Use `create(EdgeTransitTimeSchema)` to create a new message.
  */
-export const TimeStepResultSchema: GenMessage<TimeStepResult> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 19);
+export const EdgeTransitTimeSchema: GenMessage<EdgeTransitTime> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 33);
 
 /**
  * @generated from message logistics.simulation.v1.TimeSimulationStats
@@ -886,6 +1683,19 @@ export type TimeSimulationStats = Message<"logistics.simulation.v1.TimeSimulatio
    * @generated from field: int32 steps_with_bottlenecks = 9;
    */
   stepsWithBottlenecks: number;
+
+  /**
+   * При carry_over: доля спроса за горизонт, доставленная к его концу, и
+   * невыполненный спрос в конце горизонта
+   *
+   * @generated from field: double fill_rate = 10;
+   */
+  fillRate: number;
+
+  /**
+   * @generated from field: double final_backlog = 11;
+   */
+  finalBacklog: number;
 };
 
 /**
@@ -893,7 +1703,7 @@ export type TimeSimulationStats = Message<"logistics.simulation.v1.TimeSimulatio
  * Use `create(TimeSimulationStatsSchema)` to create a new message.
  */
 export const TimeSimulationStatsSchema: GenMessage<TimeSimulationStats> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 20);
+  messageDesc(file_logistics_simulation_v1_simulation, 34);
 
 /**
  * @generated from message logistics.simulation.v1.CriticalPeriod
@@ -940,7 +1750,7 @@ export type CriticalPeriod = Message<"logistics.simulation.v1.CriticalPeriod"> &
  * Use `create(CriticalPeriodSchema)` to create a new message.
  */
 export const CriticalPeriodSchema: GenMessage<CriticalPeriod> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 21);
+  messageDesc(file_logistics_simulation_v1_simulation, 35);
 
 /**
  * @generated from message logistics.simulation.v1.SimulatePeakLoadRequest
@@ -994,7 +1804,7 @@ export type SimulatePeakLoadRequest = Message<"logistics.simulation.v1.SimulateP
  * Use `create(SimulatePeakLoadRequestSchema)` to create a new message.
  */
 export const SimulatePeakLoadRequestSchema: GenMessage<SimulatePeakLoadRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 22);
+  messageDesc(file_logistics_simulation_v1_simulation, 36);
 
 /**
  * @generated from message logistics.simulation.v1.SimulatePeakLoadResponse
@@ -1045,7 +1855,7 @@ export type SimulatePeakLoadResponse = Message<"logistics.simulation.v1.Simulate
  * Use `create(SimulatePeakLoadResponseSchema)` to create a new message.
  */
 export const SimulatePeakLoadResponseSchema: GenMessage<SimulatePeakLoadResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 23);
+  messageDesc(file_logistics_simulation_v1_simulation, 37);
 
 /**
  * @generated from message logistics.simulation.v1.OverloadedEdge
@@ -1057,32 +1867,398 @@ export type OverloadedEdge = Message<"logistics.simulation.v1.OverloadedEdge"> &
   edge?: EdgeKey;
 
   /**
-   * @generated from field: double required_capacity = 2;
+   * @generated from field: double required_capacity = 2;
+   */
+  requiredCapacity: number;
+
+  /**
+   * @generated from field: double available_capacity = 3;
+   */
+  availableCapacity: number;
+
+  /**
+   * @generated from field: double shortage = 4;
+   */
+  shortage: number;
+
+  /**
+   * @generated from field: double shortage_percent = 5;
+   */
+  shortagePercent: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.OverloadedEdge.
+ * Use `create(OverloadedEdgeSchema)` to create a new message.
+ */
+export const OverloadedEdgeSchema: GenMessage<OverloadedEdge> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 38);
+
+/**
+ * @generated from message logistics.simulation.v1.RunDiscreteEventSimulationRequest
+ */
+export type RunDiscreteEventSimulationRequest = Message<"logistics.simulation.v1.RunDiscreteEventSimulationRequest"> & {
+  /**
+   * Решённый граф (SolveResponse.solved_graph): current_flow рёбер — объём
+   * перевозок в единицах в час. Поток раскладывается на маршруты от узлов с
+   * избытком (исток, поставщики) к узлам с недостатком (сток, точки доставки)
+   *
+   * @generated from field: logistics.common.v1.Graph solved_graph = 1;
+   */
+  solvedGraph?: Graph;
+
+  /**
+   * @generated from field: logistics.simulation.v1.DiscreteEventConfig config = 2;
+   */
+  config?: DiscreteEventConfig;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.RunDiscreteEventSimulationRequest.
+ * Use `create(RunDiscreteEventSimulationRequestSchema)` to create a new message.
+ */
+export const RunDiscreteEventSimulationRequestSchema: GenMessage<RunDiscreteEventSimulationRequest> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 39);
+
+/**
+ * @generated from message logistics.simulation.v1.DiscreteEventConfig
+ */
+export type DiscreteEventConfig = Message<"logistics.simulation.v1.DiscreteEventConfig"> & {
+  /**
+   * Окно отправлений, часов (по умолчанию 24). Симуляция продолжается, пока
+   * не завершатся все отправленные рейсы
+   *
+   * @generated from field: double horizon_hours = 1;
+   */
+  horizonHours: number;
+
+  /**
+   * Вместимость транспортного средства, единиц потока (по умолчанию 10)
+   *
+   * @generated from field: double vehicle_capacity = 2;
+   */
+  vehicleCapacity: number;
+
+  /**
+   * Интервал отправлений по маршруту, часов. 0 — рейс уходит, как только
+   * накопится полная загрузка: vehicle_capacity / поток маршрута
+   *
+   * @generated from field: double departure_interval_hours = 3;
+   */
+  departureIntervalHours: number;
+
+  /**
+   * Время погрузки и разгрузки одного рейса, часов (по умолчанию 0.5).
+   * Погрузка — в начале маршрута, разгрузка — в конце, на промежуточных
+   * складах (NODE_TYPE_WAREHOUSE) — перегрузка: разгрузка и погрузка
+   *
+   * @generated from field: double loading_time_hours = 4;
+   */
+  loadingTimeHours: number;
+
+  /**
+   * @generated from field: double unloading_time_hours = 5;
+   */
+  unloadingTimeHours: number;
+
+  /**
+   * Число параллельных постов погрузки-разгрузки в узле (по умолчанию 1)
+   *
+   * @generated from field: int32 docks_per_node = 6;
+   */
+  docksPerNode: number;
+
+  /**
+   * Скорости по типам дорог; по умолчанию — как в TimeSimulationConfig
+   *
+   * @generated from field: repeated logistics.simulation.v1.RoadTypeSpeed road_speeds = 7;
+   */
+  roadSpeeds: RoadTypeSpeed[];
+
+  /**
+   * Случайная задержка на каждом ребре, часов (отрицательные значения — 0)
+   *
+   * @generated from field: logistics.simulation.v1.Distribution travel_delay = 8;
+   */
+  travelDelay?: Distribution;
+
+  /**
+   * 0 — случайный seed
+   *
+   * @generated from field: int64 random_seed = 9;
+   */
+  randomSeed: bigint;
+
+  /**
+   * Число интервалов гистограммы времени доставки (по умолчанию 20)
+   *
+   * @generated from field: int32 histogram_buckets = 10;
+   */
+  histogramBuckets: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.DiscreteEventConfig.
+ * Use `create(DiscreteEventConfigSchema)` to create a new message.
+ */
+export const DiscreteEventConfigSchema: GenMessage<DiscreteEventConfig> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 40);
+
+/**
+ * @generated from message logistics.simulation.v1.RunDiscreteEventSimulationResponse
+ */
+export type RunDiscreteEventSimulationResponse = Message<"logistics.simulation.v1.RunDiscreteEventSimulationResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: logistics.simulation.v1.DiscreteEventStats stats = 2;
+   */
+  stats?: DiscreteEventStats;
+
+  /**
+   * Время доставки рейса (от готовности груза к отправке до окончания
+   * разгрузки), часов
+   *
+   * @generated from field: logistics.simulation.v1.MonteCarloStats lead_time_stats = 3;
+   */
+  leadTimeStats?: MonteCarloStats;
+
+  /**
+   * @generated from field: repeated logistics.simulation.v1.HistogramBucket lead_time_histogram = 4;
+   */
+  leadTimeHistogram: HistogramBucket[];
+
+  /**
+   * "p5" … "p99"
+   *
+   * @generated from field: map<string, double> lead_time_percentiles = 5;
+   */
+  leadTimePercentiles: { [key: string]: number };
+
+  /**
+   * Очереди на погрузку-разгрузку по узлам (только узлы с обслуживанием)
+   *
+   * @generated from field: repeated logistics.simulation.v1.NodeQueueStats node_queues = 6;
+   */
+  nodeQueues: NodeQueueStats[];
+
+  /**
+   * Доставлено по часам окна отправлений и после него
+   *
+   * @generated from field: repeated logistics.simulation.v1.ThroughputPoint throughput = 7;
+   */
+  throughput: ThroughputPoint[];
+
+  /**
+   * Маршруты, на которые разложен поток
+   *
+   * @generated from field: repeated logistics.simulation.v1.ShipmentRoute routes = 8;
+   */
+  routes: ShipmentRoute[];
+
+  /**
+   * Использованный seed
+   *
+   * @generated from field: int64 random_seed = 9;
+   */
+  randomSeed: bigint;
+
+  /**
+   * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 10;
+   */
+  metadata?: SimulationMetadata;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.RunDiscreteEventSimulationResponse.
+ * Use `create(RunDiscreteEventSimulationResponseSchema)` to create a new message.
+ */
+export const RunDiscreteEventSimulationResponseSchema: GenMessage<RunDiscreteEventSimulationResponse> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 41);
+
+/**
+ * @generated from message logistics.simulation.v1.DiscreteEventStats
+ */
+export type DiscreteEventStats = Message<"logistics.simulation.v1.DiscreteEventStats"> & {
+  /**
+   * @generated from field: int32 vehicles_dispatched = 1;
+   */
+  vehiclesDispatched: number;
+
+  /**
+   * @generated from field: int32 vehicles_delivered = 2;
+   */
+  vehiclesDelivered: number;
+
+  /**
+   * @generated from field: double units_dispatched = 3;
+   */
+  unitsDispatched: number;
+
+  /**
+   * Доставлено до конца окна отправлений
+   *
+   * @generated from field: double units_delivered_in_horizon = 4;
+   */
+  unitsDeliveredInHorizon: number;
+
+  /**
+   * units_delivered_in_horizon / horizon_hours
+   *
+   * @generated from field: double throughput = 5;
+   */
+  throughput: number;
+
+  /**
+   * Поток решения, единиц в час
+   *
+   * @generated from field: double planned_throughput = 6;
+   */
+  plannedThroughput: number;
+
+  /**
+   * Окончание последней разгрузки
+   *
+   * @generated from field: double makespan_hours = 7;
+   */
+  makespanHours: number;
+
+  /**
+   * @generated from field: int64 events_processed = 8;
+   */
+  eventsProcessed: bigint;
+
+  /**
+   * Среднее ожидание поста за рейс
+   *
+   * @generated from field: double average_wait_hours = 9;
+   */
+  averageWaitHours: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.DiscreteEventStats.
+ * Use `create(DiscreteEventStatsSchema)` to create a new message.
+ */
+export const DiscreteEventStatsSchema: GenMessage<DiscreteEventStats> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 42);
+
+/**
+ * @generated from message logistics.simulation.v1.NodeQueueStats
+ */
+export type NodeQueueStats = Message<"logistics.simulation.v1.NodeQueueStats"> & {
+  /**
+   * @generated from field: int64 node_id = 1;
+   */
+  nodeId: bigint;
+
+  /**
+   * @generated from field: int32 vehicles_served = 2;
+   */
+  vehiclesServed: number;
+
+  /**
+   * Средняя по времени длина очереди
+   *
+   * @generated from field: double average_queue_length = 3;
+   */
+  averageQueueLength: number;
+
+  /**
+   * @generated from field: int32 max_queue_length = 4;
+   */
+  maxQueueLength: number;
+
+  /**
+   * @generated from field: double average_wait_hours = 5;
+   */
+  averageWaitHours: number;
+
+  /**
+   * @generated from field: double max_wait_hours = 6;
+   */
+  maxWaitHours: number;
+
+  /**
+   * Доля времени занятости постов
+   *
+   * @generated from field: double dock_utilization = 7;
+   */
+  dockUtilization: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.NodeQueueStats.
+ * Use `create(NodeQueueStatsSchema)` to create a new message.
+ */
+export const NodeQueueStatsSchema: GenMessage<NodeQueueStats> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 43);
+
+/**
+ * @generated from message logistics.simulation.v1.ThroughputPoint
+ */
+export type ThroughputPoint = Message<"logistics.simulation.v1.ThroughputPoint"> & {
+  /**
+   * Начало часа от старта симуляции
+   *
+   * @generated from field: int32 hour = 1;
+   */
+  hour: number;
+
+  /**
+   * @generated from field: double units_delivered = 2;
+   */
+  unitsDelivered: number;
+
+  /**
+   * @generated from field: int32 vehicles_delivered = 3;
+   */
+  vehiclesDelivered: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.ThroughputPoint.
+ * Use `create(ThroughputPointSchema)` to create a new message.
+ */
+export const ThroughputPointSchema: GenMessage<ThroughputPoint> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 44);
+
+/**
+ * @generated from message logistics.simulation.v1.ShipmentRoute
+ */
+export type ShipmentRoute = Message<"logistics.simulation.v1.ShipmentRoute"> & {
+  /**
+   * @generated from field: repeated int64 node_ids = 1;
    */
-  requiredCapacity: number;
+  nodeIds: bigint[];
 
   /**
-   * @generated from field: double available_capacity = 3;
+   * Единиц в час
+   *
+   * @generated from field: double flow = 2;
    */
-  availableCapacity: number;
+  flow: number;
 
   /**
-   * @generated from field: double shortage = 4;
+   * @generated from field: int32 vehicles = 3;
    */
-  shortage: number;
+  vehicles: number;
 
   /**
-   * @generated from field: double shortage_percent = 5;
+   * @generated from field: double average_lead_time_hours = 4;
    */
-  shortagePercent: number;
+  averageLeadTimeHours: number;
 };
 
 /**
- * Describes the message logistics.simulation.v1.OverloadedEdge.
- * Use `create(OverloadedEdgeSchema)` to create a new message.
+ * Describes the message logistics.simulation.v1.ShipmentRoute.
+ * Use `create(ShipmentRouteSchema)` to create a new message.
  */
-export const OverloadedEdgeSchema: GenMessage<OverloadedEdge> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 24);
+export const ShipmentRouteSchema: GenMessage<ShipmentRoute> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 45);
 
 /**
  * @generated from message logistics.simulation.v1.RunMonteCarloRequest
@@ -1111,6 +2287,13 @@ export type RunMonteCarloRequest = Message<"logistics.simulation.v1.RunMonteCarl
    * @generated from field: logistics.common.v1.Algorithm algorithm = 4;
    */
   algorithm: Algorithm;
+
+  /**
+   * Корреляции между неопределённостями (без него неопределённости независимы)
+   *
+   * @generated from field: logistics.simulation.v1.UncertaintyCorrelation correlation = 5;
+   */
+  correlation?: UncertaintyCorrelation;
 };
 
 /**
@@ -1118,7 +2301,68 @@ export type RunMonteCarloRequest = Message<"logistics.simulation.v1.RunMonteCarl
  * Use `create(RunMonteCarloRequestSchema)` to create a new message.
  */
 export const RunMonteCarloRequestSchema: GenMessage<RunMonteCarloRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 25);
+  messageDesc(file_logistics_simulation_v1_simulation, 46);
+
+/**
+ * Зависимость неопределённостей задаётся гауссовой копулой: маргинальные
+ * распределения Distribution сохраняются, а совместные отклонения (в том числе
+ * хвосты, определяющие VaR и CVaR) коррелируют. Задаётся полной матрицей или группами
+ *
+ * @generated from message logistics.simulation.v1.UncertaintyCorrelation
+ */
+export type UncertaintyCorrelation = Message<"logistics.simulation.v1.UncertaintyCorrelation"> & {
+  /**
+   * @generated from field: logistics.simulation.v1.CorrelationMeasure measure = 1;
+   */
+  measure: CorrelationMeasure;
+
+  /**
+   * Полная матрица n×n по строкам, n = len(uncertainties): симметричная,
+   * с единицами на диагонали, положительно определённая
+   *
+   * @generated from field: repeated double matrix = 2;
+   */
+  matrix: number[];
+
+  /**
+   * Группы: неопределённости группы попарно коррелируют с одним коэффициентом,
+   * между группами корреляции нет. Неопределённость входит не более чем в одну группу
+   *
+   * @generated from field: repeated logistics.simulation.v1.CorrelationGroup groups = 3;
+   */
+  groups: CorrelationGroup[];
+};
+
+/**
+ * Describes the message logistics.simulation.v1.UncertaintyCorrelation.
+ * Use `create(UncertaintyCorrelationSchema)` to create a new message.
+ */
+export const UncertaintyCorrelationSchema: GenMessage<UncertaintyCorrelation> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 47);
+
+/**
+ * @generated from message logistics.simulation.v1.CorrelationGroup
+ */
+export type CorrelationGroup = Message<"logistics.simulation.v1.CorrelationGroup"> & {
+  /**
+   * Индексы в RunMonteCarloRequest.uncertainties
+   *
+   * @generated from field: repeated int32 uncertainty_indices = 1;
+   */
+  uncertaintyIndices: number[];
+
+  /**
+   * @generated from field: double correlation = 2;
+   */
+  correlation: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.CorrelationGroup.
+ * Use `create(CorrelationGroupSchema)` to create a new message.
+ */
+export const CorrelationGroupSchema: GenMessage<CorrelationGroup> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 48);
 
 /**
  * @generated from message logistics.simulation.v1.MonteCarloConfig
@@ -1158,6 +2402,48 @@ export type MonteCarloConfig = Message<"logistics.simulation.v1.MonteCarloConfig
    * @generated from field: int32 max_workers = 5;
    */
   maxWorkers: number;
+
+  /**
+   * Возвращать сэмплы каждой итерации в RunMonteCarloResponse.samples
+   *
+   * @generated from field: bool return_samples = 6;
+   */
+  returnSamples: boolean;
+
+  /**
+   * Выполнить только указанные итерации (воспроизведение прогона с тем же random_seed,
+   * random_seed обязателен). Каждая итерация использует собственный поток случайных
+   * чисел из seed и своего номера, поэтому результат не зависит от parallel и max_workers
+   *
+   * @generated from field: repeated int32 replay_iterations = 7;
+   */
+  replayIterations: number[];
+
+  /**
+   * Метод сэмплирования неопределённостей. Для LATIN_HYPERCUBE и SOBOL итерации делятся
+   * на независимые реплики, по разбросу которых считаются доверительный интервал и
+   * effective_sample_size; воспроизведение итераций требует того же num_iterations
+   *
+   * @generated from field: logistics.simulation.v1.SamplingMethod sampling_method = 8;
+   */
+  samplingMethod: SamplingMethod;
+
+  /**
+   * Адаптивная остановка: прогон завершается, как только относительная полуширина
+   * доверительного интервала (MonteCarloStats.relative_ci_half_width) и потока, и
+   * стоимости не превышает этого значения (0.01 = ±1% от среднего). num_iterations
+   * задаёт верхнюю границу. 0 — выполнить все итерации. Не применяется к replay_iterations
+   *
+   * @generated from field: double target_relative_ci_half_width = 9;
+   */
+  targetRelativeCiHalfWidth: number;
+
+  /**
+   * Ограничение времени прогона в секундах (0 — без ограничения)
+   *
+   * @generated from field: double time_budget_seconds = 10;
+   */
+  timeBudgetSeconds: number;
 };
 
 /**
@@ -1165,7 +2451,7 @@ export type MonteCarloConfig = Message<"logistics.simulation.v1.MonteCarloConfig
  * Use `create(MonteCarloConfigSchema)` to create a new message.
  */
 export const MonteCarloConfigSchema: GenMessage<MonteCarloConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 26);
+  messageDesc(file_logistics_simulation_v1_simulation, 49);
 
 /**
  * @generated from message logistics.simulation.v1.UncertaintySpec
@@ -1210,7 +2496,7 @@ export type UncertaintySpec = Message<"logistics.simulation.v1.UncertaintySpec">
  * Use `create(UncertaintySpecSchema)` to create a new message.
  */
 export const UncertaintySpecSchema: GenMessage<UncertaintySpec> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 27);
+  messageDesc(file_logistics_simulation_v1_simulation, 50);
 
 /**
  * @generated from message logistics.simulation.v1.Distribution
@@ -1250,7 +2536,7 @@ export type Distribution = Message<"logistics.simulation.v1.Distribution"> & {
  * Use `create(DistributionSchema)` to create a new message.
  */
 export const DistributionSchema: GenMessage<Distribution> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 28);
+  messageDesc(file_logistics_simulation_v1_simulation, 51);
 
 /**
  * @generated from message logistics.simulation.v1.RunMonteCarloResponse
@@ -1317,6 +2603,32 @@ export type RunMonteCarloResponse = Message<"logistics.simulation.v1.RunMonteCar
    * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 10;
    */
   metadata?: SimulationMetadata;
+
+  /**
+   * Использованный seed (сгенерированный, если в запросе 0)
+   *
+   * @generated from field: int64 random_seed = 11;
+   */
+  randomSeed: bigint;
+
+  /**
+   * Только при return_samples, по возрастанию iteration
+   *
+   * @generated from field: repeated logistics.simulation.v1.MonteCarloSample samples = 12;
+   */
+  samples: MonteCarloSample[];
+
+  /**
+   * @generated from field: logistics.simulation.v1.MonteCarloStopReason stop_reason = 13;
+   */
+  stopReason: MonteCarloStopReason;
+
+  /**
+   * Число итераций, вошедших в статистику
+   *
+   * @generated from field: int32 completed_iterations = 14;
+   */
+  completedIterations: number;
 };
 
 /**
@@ -1324,7 +2636,50 @@ export type RunMonteCarloResponse = Message<"logistics.simulation.v1.RunMonteCar
  * Use `create(RunMonteCarloResponseSchema)` to create a new message.
  */
 export const RunMonteCarloResponseSchema: GenMessage<RunMonteCarloResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 29);
+  messageDesc(file_logistics_simulation_v1_simulation, 52);
+
+/**
+ * Сэмпл одной итерации Monte Carlo
+ *
+ * @generated from message logistics.simulation.v1.MonteCarloSample
+ */
+export type MonteCarloSample = Message<"logistics.simulation.v1.MonteCarloSample"> & {
+  /**
+   * @generated from field: int32 iteration = 1;
+   */
+  iteration: number;
+
+  /**
+   * Множители в порядке RunMonteCarloRequest.uncertainties
+   *
+   * @generated from field: repeated double multipliers = 2;
+   */
+  multipliers: number[];
+
+  /**
+   * @generated from field: double flow = 3;
+   */
+  flow: number;
+
+  /**
+   * @generated from field: double cost = 4;
+   */
+  cost: number;
+
+  /**
+   * Ошибка solver (flow и cost тогда 0)
+   *
+   * @generated from field: string error = 5;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.MonteCarloSample.
+ * Use `create(MonteCarloSampleSchema)` to create a new message.
+ */
+export const MonteCarloSampleSchema: GenMessage<MonteCarloSample> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 53);
 
 /**
  * @generated from message logistics.simulation.v1.MonteCarloStats
@@ -1371,6 +2726,8 @@ export type MonteCarloStats = Message<"logistics.simulation.v1.MonteCarloStats">
   kurtosis: number;
 
   /**
+   * Доверительный интервал среднего с учётом метода сэмплирования
+   *
    * @generated from field: double confidence_interval_low = 9;
    */
   confidenceIntervalLow: number;
@@ -1379,6 +2736,21 @@ export type MonteCarloStats = Message<"logistics.simulation.v1.MonteCarloStats">
    * @generated from field: double confidence_interval_high = 10;
    */
   confidenceIntervalHigh: number;
+
+  /**
+   * Эффективный размер выборки: число независимых случайных сэмплов,
+   * дающих ту же точность среднего
+   *
+   * @generated from field: double effective_sample_size = 11;
+   */
+  effectiveSampleSize: number;
+
+  /**
+   * Полуширина доверительного интервала, делённая на |mean|
+   *
+   * @generated from field: double relative_ci_half_width = 12;
+   */
+  relativeCiHalfWidth: number;
 };
 
 /**
@@ -1386,7 +2758,7 @@ export type MonteCarloStats = Message<"logistics.simulation.v1.MonteCarloStats">
  * Use `create(MonteCarloStatsSchema)` to create a new message.
  */
 export const MonteCarloStatsSchema: GenMessage<MonteCarloStats> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 30);
+  messageDesc(file_logistics_simulation_v1_simulation, 54);
 
 /**
  * @generated from message logistics.simulation.v1.HistogramBucket
@@ -1418,7 +2790,7 @@ export type HistogramBucket = Message<"logistics.simulation.v1.HistogramBucket">
  * Use `create(HistogramBucketSchema)` to create a new message.
  */
 export const HistogramBucketSchema: GenMessage<HistogramBucket> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 31);
+  messageDesc(file_logistics_simulation_v1_simulation, 55);
 
 /**
  * @generated from message logistics.simulation.v1.RiskAnalysis
@@ -1466,7 +2838,7 @@ export type RiskAnalysis = Message<"logistics.simulation.v1.RiskAnalysis"> & {
  * Use `create(RiskAnalysisSchema)` to create a new message.
  */
 export const RiskAnalysisSchema: GenMessage<RiskAnalysis> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 32);
+  messageDesc(file_logistics_simulation_v1_simulation, 56);
 
 /**
  * @generated from message logistics.simulation.v1.RiskScenario
@@ -1498,7 +2870,7 @@ export type RiskScenario = Message<"logistics.simulation.v1.RiskScenario"> & {
  * Use `create(RiskScenarioSchema)` to create a new message.
  */
 export const RiskScenarioSchema: GenMessage<RiskScenario> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 33);
+  messageDesc(file_logistics_simulation_v1_simulation, 57);
 
 /**
  * @generated from message logistics.simulation.v1.ParameterCorrelation
@@ -1530,7 +2902,7 @@ export type ParameterCorrelation = Message<"logistics.simulation.v1.ParameterCor
  * Use `create(ParameterCorrelationSchema)` to create a new message.
  */
 export const ParameterCorrelationSchema: GenMessage<ParameterCorrelation> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 34);
+  messageDesc(file_logistics_simulation_v1_simulation, 58);
 
 /**
  * @generated from message logistics.simulation.v1.MonteCarloProgress
@@ -1562,9 +2934,40 @@ export type MonteCarloProgress = Message<"logistics.simulation.v1.MonteCarloProg
   currentStdDev: number;
 
   /**
+   * "running" или "completed" (финальное сообщение)
+   *
    * @generated from field: string status = 6;
    */
   status: string;
+
+  /**
+   * Текущая полуширина доверительного интервала среднего потока
+   *
+   * @generated from field: double ci_half_width = 7;
+   */
+  ciHalfWidth: number;
+
+  /**
+   * Наибольшая относительная полуширина по потоку и стоимости
+   *
+   * @generated from field: double relative_ci_half_width = 8;
+   */
+  relativeCiHalfWidth: number;
+
+  /**
+   * Оценка оставшихся итераций: до target_relative_ci_half_width при адаптивной
+   * остановке (не больше num_iterations), иначе до num_iterations
+   *
+   * @generated from field: int32 estimated_iterations_remaining = 9;
+   */
+  estimatedIterationsRemaining: number;
+
+  /**
+   * Только в финальном сообщении
+   *
+   * @generated from field: logistics.simulation.v1.RunMonteCarloResponse result = 10;
+   */
+  result?: RunMonteCarloResponse;
 };
 
 /**
@@ -1572,7 +2975,7 @@ export type MonteCarloProgress = Message<"logistics.simulation.v1.MonteCarloProg
  * Use `create(MonteCarloProgressSchema)` to create a new message.
  */
 export const MonteCarloProgressSchema: GenMessage<MonteCarloProgress> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 35);
+  messageDesc(file_logistics_simulation_v1_simulation, 59);
 
 /**
  * @generated from message logistics.simulation.v1.AnalyzeSensitivityRequest
@@ -1608,7 +3011,7 @@ export type AnalyzeSensitivityRequest = Message<"logistics.simulation.v1.Analyze
  * Use `create(AnalyzeSensitivityRequestSchema)` to create a new message.
  */
 export const AnalyzeSensitivityRequestSchema: GenMessage<AnalyzeSensitivityRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 36);
+  messageDesc(file_logistics_simulation_v1_simulation, 60);
 
 /**
  * @generated from message logistics.simulation.v1.SensitivityParameter
@@ -1660,7 +3063,7 @@ export type SensitivityParameter = Message<"logistics.simulation.v1.SensitivityP
  * Use `create(SensitivityParameterSchema)` to create a new message.
  */
 export const SensitivityParameterSchema: GenMessage<SensitivityParameter> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 37);
+  messageDesc(file_logistics_simulation_v1_simulation, 61);
 
 /**
  * @generated from message logistics.simulation.v1.SensitivityConfig
@@ -1684,6 +3087,41 @@ export type SensitivityConfig = Message<"logistics.simulation.v1.SensitivityConf
    * @generated from field: bool find_thresholds = 3;
    */
   findThresholds: boolean;
+
+  /**
+   * Morris: число траекторий r (по умолчанию 10) и уровней сетки p — чётное,
+   * по умолчанию 4. Требует r·(k+1) решений для k параметров
+   *
+   * @generated from field: int32 num_trajectories = 4;
+   */
+  numTrajectories: number;
+
+  /**
+   * @generated from field: int32 num_levels = 5;
+   */
+  numLevels: number;
+
+  /**
+   * Sobol: базовый размер выборки N (по умолчанию 256, лучше степень двойки).
+   * Требует N·(k+2) решений
+   *
+   * @generated from field: int32 num_samples = 6;
+   */
+  numSamples: number;
+
+  /**
+   * 0 = случайный seed
+   *
+   * @generated from field: int64 random_seed = 7;
+   */
+  randomSeed: bigint;
+
+  /**
+   * Параллельных решений (по умолчанию 4)
+   *
+   * @generated from field: int32 max_workers = 8;
+   */
+  maxWorkers: number;
 };
 
 /**
@@ -1691,7 +3129,7 @@ export type SensitivityConfig = Message<"logistics.simulation.v1.SensitivityConf
  * Use `create(SensitivityConfigSchema)` to create a new message.
  */
 export const SensitivityConfigSchema: GenMessage<SensitivityConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 38);
+  messageDesc(file_logistics_simulation_v1_simulation, 62);
 
 /**
  * @generated from message logistics.simulation.v1.AnalyzeSensitivityResponse
@@ -1717,76 +3155,166 @@ export type AnalyzeSensitivityResponse = Message<"logistics.simulation.v1.Analyz
   rankings: ParameterRanking[];
 
   /**
-   * Найденные пороги
+   * Найденные пороги
+   *
+   * @generated from field: repeated logistics.simulation.v1.ThresholdPoint thresholds = 4;
+   */
+  thresholds: ThresholdPoint[];
+
+  /**
+   * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 5;
+   */
+  metadata?: SimulationMetadata;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.AnalyzeSensitivityResponse.
+ * Use `create(AnalyzeSensitivityResponseSchema)` to create a new message.
+ */
+export const AnalyzeSensitivityResponseSchema: GenMessage<AnalyzeSensitivityResponse> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 63);
+
+/**
+ * @generated from message logistics.simulation.v1.SensitivityResult
+ */
+export type SensitivityResult = Message<"logistics.simulation.v1.SensitivityResult"> & {
+  /**
+   * @generated from field: string parameter_id = 1;
+   */
+  parameterId: string;
+
+  /**
+   * Кривая чувствительности
+   *
+   * @generated from field: repeated logistics.simulation.v1.SensitivityPoint curve = 2;
+   */
+  curve: SensitivityPoint[];
+
+  /**
+   * Метрики
+   *
+   * % изменения output / % изменения input
+   *
+   * @generated from field: double elasticity = 3;
+   */
+  elasticity: number;
+
+  /**
+   * Нормализованный индекс 0-1
+   *
+   * @generated from field: double sensitivity_index = 4;
+   */
+  sensitivityIndex: number;
+
+  /**
+   * max_flow - min_flow
+   *
+   * @generated from field: double impact_range = 5;
+   */
+  impactRange: number;
+
+  /**
+   * @generated from field: logistics.simulation.v1.SensitivityLevel level = 6;
+   */
+  level: SensitivityLevel;
+
+  /**
+   * Индексы глобальных методов (Morris / Sobol)
    *
-   * @generated from field: repeated logistics.simulation.v1.ThresholdPoint thresholds = 4;
+   * @generated from field: logistics.simulation.v1.MorrisIndices morris = 7;
    */
-  thresholds: ThresholdPoint[];
+  morris?: MorrisIndices;
 
   /**
-   * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 5;
+   * @generated from field: logistics.simulation.v1.SobolIndices sobol = 8;
    */
-  metadata?: SimulationMetadata;
+  sobol?: SobolIndices;
 };
 
 /**
- * Describes the message logistics.simulation.v1.AnalyzeSensitivityResponse.
- * Use `create(AnalyzeSensitivityResponseSchema)` to create a new message.
+ * Describes the message logistics.simulation.v1.SensitivityResult.
+ * Use `create(SensitivityResultSchema)` to create a new message.
  */
-export const AnalyzeSensitivityResponseSchema: GenMessage<AnalyzeSensitivityResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 39);
+export const SensitivityResultSchema: GenMessage<SensitivityResult> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 64);
 
 /**
- * @generated from message logistics.simulation.v1.SensitivityResult
+ * MorrisIndices статистики элементарных эффектов параметра. Эффект —
+ * изменение потока при проходе всего диапазона [min_multiplier, max_multiplier]
+ *
+ * @generated from message logistics.simulation.v1.MorrisIndices
  */
-export type SensitivityResult = Message<"logistics.simulation.v1.SensitivityResult"> & {
+export type MorrisIndices = Message<"logistics.simulation.v1.MorrisIndices"> & {
   /**
-   * @generated from field: string parameter_id = 1;
+   * Среднее эффектов (знак — направление влияния)
+   *
+   * @generated from field: double mu = 1;
    */
-  parameterId: string;
+  mu: number;
 
   /**
-   * Кривая чувствительности
+   * Среднее модулей эффектов — общая важность
    *
-   * @generated from field: repeated logistics.simulation.v1.SensitivityPoint curve = 2;
+   * @generated from field: double mu_star = 2;
    */
-  curve: SensitivityPoint[];
+  muStar: number;
 
   /**
-   * Метрики
+   * СКО эффектов — нелинейность и взаимодействия
    *
-   * % изменения output / % изменения input
+   * @generated from field: double sigma = 3;
+   */
+  sigma: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.MorrisIndices.
+ * Use `create(MorrisIndicesSchema)` to create a new message.
+ */
+export const MorrisIndicesSchema: GenMessage<MorrisIndices> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 65);
+
+/**
+ * SobolIndices доли дисперсии потока, объясняемые параметром
+ *
+ * @generated from message logistics.simulation.v1.SobolIndices
+ */
+export type SobolIndices = Message<"logistics.simulation.v1.SobolIndices"> & {
+  /**
+   * S_i: вклад параметра в одиночку
    *
-   * @generated from field: double elasticity = 3;
+   * @generated from field: double first_order = 1;
    */
-  elasticity: number;
+  firstOrder: number;
 
   /**
-   * Нормализованный индекс 0-1
+   * S_Ti: вклад вместе со взаимодействиями
    *
-   * @generated from field: double sensitivity_index = 4;
+   * @generated from field: double total_order = 2;
    */
-  sensitivityIndex: number;
+  totalOrder: number;
 
   /**
-   * max_flow - min_flow
+   * Полуширина 95% бутстрэп-интервала S_i
    *
-   * @generated from field: double impact_range = 5;
+   * @generated from field: double first_order_conf = 3;
    */
-  impactRange: number;
+  firstOrderConf: number;
 
   /**
-   * @generated from field: logistics.simulation.v1.SensitivityLevel level = 6;
+   * Полуширина 95% бутстрэп-интервала S_Ti
+   *
+   * @generated from field: double total_order_conf = 4;
    */
-  level: SensitivityLevel;
+  totalOrderConf: number;
 };
 
 /**
- * Describes the message logistics.simulation.v1.SensitivityResult.
- * Use `create(SensitivityResultSchema)` to create a new message.
+ * Describes the message logistics.simulation.v1.SobolIndices.
+ * Use `create(SobolIndicesSchema)` to create a new message.
  */
-export const SensitivityResultSchema: GenMessage<SensitivityResult> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 40);
+export const SobolIndicesSchema: GenMessage<SobolIndices> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 66);
 
 /**
  * @generated from message logistics.simulation.v1.SensitivityPoint
@@ -1815,7 +3343,7 @@ export type SensitivityPoint = Message<"logistics.simulation.v1.SensitivityPoint
  * Use `create(SensitivityPointSchema)` to create a new message.
  */
 export const SensitivityPointSchema: GenMessage<SensitivityPoint> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 41);
+  messageDesc(file_logistics_simulation_v1_simulation, 67);
 
 /**
  * @generated from message logistics.simulation.v1.ParameterRanking
@@ -1847,7 +3375,7 @@ export type ParameterRanking = Message<"logistics.simulation.v1.ParameterRanking
  * Use `create(ParameterRankingSchema)` to create a new message.
  */
 export const ParameterRankingSchema: GenMessage<ParameterRanking> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 42);
+  messageDesc(file_logistics_simulation_v1_simulation, 68);
 
 /**
  * @generated from message logistics.simulation.v1.ThresholdPoint
@@ -1879,7 +3407,7 @@ export type ThresholdPoint = Message<"logistics.simulation.v1.ThresholdPoint"> &
  * Use `create(ThresholdPointSchema)` to create a new message.
  */
 export const ThresholdPointSchema: GenMessage<ThresholdPoint> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 43);
+  messageDesc(file_logistics_simulation_v1_simulation, 69);
 
 /**
  * @generated from message logistics.simulation.v1.FindCriticalElementsRequest
@@ -1906,7 +3434,7 @@ export type FindCriticalElementsRequest = Message<"logistics.simulation.v1.FindC
  * Use `create(FindCriticalElementsRequestSchema)` to create a new message.
  */
 export const FindCriticalElementsRequestSchema: GenMessage<FindCriticalElementsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 44);
+  messageDesc(file_logistics_simulation_v1_simulation, 70);
 
 /**
  * @generated from message logistics.simulation.v1.CriticalElementsConfig
@@ -1942,7 +3470,7 @@ export type CriticalElementsConfig = Message<"logistics.simulation.v1.CriticalEl
  * Use `create(CriticalElementsConfigSchema)` to create a new message.
  */
 export const CriticalElementsConfigSchema: GenMessage<CriticalElementsConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 45);
+  messageDesc(file_logistics_simulation_v1_simulation, 71);
 
 /**
  * @generated from message logistics.simulation.v1.FindCriticalElementsResponse
@@ -1990,7 +3518,7 @@ export type FindCriticalElementsResponse = Message<"logistics.simulation.v1.Find
  * Use `create(FindCriticalElementsResponseSchema)` to create a new message.
  */
 export const FindCriticalElementsResponseSchema: GenMessage<FindCriticalElementsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 46);
+  messageDesc(file_logistics_simulation_v1_simulation, 72);
 
 /**
  * @generated from message logistics.simulation.v1.CriticalEdge
@@ -2038,7 +3566,7 @@ export type CriticalEdge = Message<"logistics.simulation.v1.CriticalEdge"> & {
  * Use `create(CriticalEdgeSchema)` to create a new message.
  */
 export const CriticalEdgeSchema: GenMessage<CriticalEdge> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 47);
+  messageDesc(file_logistics_simulation_v1_simulation, 73);
 
 /**
  * @generated from message logistics.simulation.v1.CriticalNode
@@ -2080,7 +3608,7 @@ export type CriticalNode = Message<"logistics.simulation.v1.CriticalNode"> & {
  * Use `create(CriticalNodeSchema)` to create a new message.
  */
 export const CriticalNodeSchema: GenMessage<CriticalNode> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 48);
+  messageDesc(file_logistics_simulation_v1_simulation, 74);
 
 /**
  * @generated from message logistics.simulation.v1.SimulateFailuresRequest
@@ -2116,7 +3644,7 @@ export type SimulateFailuresRequest = Message<"logistics.simulation.v1.SimulateF
  * Use `create(SimulateFailuresRequestSchema)` to create a new message.
  */
 export const SimulateFailuresRequestSchema: GenMessage<SimulateFailuresRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 49);
+  messageDesc(file_logistics_simulation_v1_simulation, 75);
 
 /**
  * @generated from message logistics.simulation.v1.FailureScenario
@@ -2143,6 +3671,13 @@ export type FailureScenario = Message<"logistics.simulation.v1.FailureScenario">
    * @generated from field: double probability = 4;
    */
   probability: number;
+
+  /**
+   * Сколько раз сценарий выпал при случайной генерации (0 — задан вручную)
+   *
+   * @generated from field: int32 occurrences = 5;
+   */
+  occurrences: number;
 };
 
 /**
@@ -2150,19 +3685,26 @@ export type FailureScenario = Message<"logistics.simulation.v1.FailureScenario">
  * Use `create(FailureScenarioSchema)` to create a new message.
  */
 export const FailureScenarioSchema: GenMessage<FailureScenario> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 50);
+  messageDesc(file_logistics_simulation_v1_simulation, 76);
 
 /**
+ * RandomFailureConfig модель случайных отказов. Элементы отказывают
+ * независимо; при correlated_failures с вероятностью common_cause_probability
+ * в сценарии действует общая причина, повышающая вероятность отказа задетых
+ * элементов до common_cause_failure_probability. Исток и сток не отказывают.
+ *
  * @generated from message logistics.simulation.v1.RandomFailureConfig
  */
 export type RandomFailureConfig = Message<"logistics.simulation.v1.RandomFailureConfig"> & {
   /**
+   * Число сэмплов модели (по умолчанию 10)
+   *
    * @generated from field: int32 num_scenarios = 1;
    */
   numScenarios: number;
 
   /**
-   * P(edge fails)
+   * P(edge fails), по умолчанию 0.1
    *
    * @generated from field: double edge_failure_probability = 2;
    */
@@ -2174,6 +3716,8 @@ export type RandomFailureConfig = Message<"logistics.simulation.v1.RandomFailure
   nodeFailureProbability: number;
 
   /**
+   * Сэмплы обусловлены числом отказов ≤ N (по умолчанию 3)
+   *
    * @generated from field: int32 max_simultaneous_failures = 4;
    */
   maxSimultaneousFailures: number;
@@ -2184,6 +3728,39 @@ export type RandomFailureConfig = Message<"logistics.simulation.v1.RandomFailure
    * @generated from field: bool correlated_failures = 5;
    */
   correlatedFailures: boolean;
+
+  /**
+   * 0 = случайный seed
+   *
+   * @generated from field: int64 random_seed = 6;
+   */
+  randomSeed: bigint;
+
+  /**
+   * @generated from field: logistics.simulation.v1.FailureCorrelation correlation = 7;
+   */
+  correlation: FailureCorrelation;
+
+  /**
+   * По умолчанию 0.1
+   *
+   * @generated from field: double common_cause_probability = 8;
+   */
+  commonCauseProbability: number;
+
+  /**
+   * В единицах координат; 0 = 10% диагонали охвата узлов
+   *
+   * @generated from field: double region_radius = 9;
+   */
+  regionRadius: number;
+
+  /**
+   * По умолчанию 0.5
+   *
+   * @generated from field: double common_cause_failure_probability = 10;
+   */
+  commonCauseFailureProbability: number;
 };
 
 /**
@@ -2191,7 +3768,7 @@ export type RandomFailureConfig = Message<"logistics.simulation.v1.RandomFailure
  * Use `create(RandomFailureConfigSchema)` to create a new message.
  */
 export const RandomFailureConfigSchema: GenMessage<RandomFailureConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 51);
+  messageDesc(file_logistics_simulation_v1_simulation, 77);
 
 /**
  * @generated from message logistics.simulation.v1.SimulateFailuresResponse
@@ -2230,6 +3807,13 @@ export type SimulateFailuresResponse = Message<"logistics.simulation.v1.Simulate
    * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 6;
    */
   metadata?: SimulationMetadata;
+
+  /**
+   * Seed случайной генерации сценариев
+   *
+   * @generated from field: int64 random_seed = 7;
+   */
+  randomSeed: bigint;
 };
 
 /**
@@ -2237,7 +3821,7 @@ export type SimulateFailuresResponse = Message<"logistics.simulation.v1.Simulate
  * Use `create(SimulateFailuresResponseSchema)` to create a new message.
  */
 export const SimulateFailuresResponseSchema: GenMessage<SimulateFailuresResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 52);
+  messageDesc(file_logistics_simulation_v1_simulation, 78);
 
 /**
  * @generated from message logistics.simulation.v1.FailureScenarioResult
@@ -2267,6 +3851,11 @@ export type FailureScenarioResult = Message<"logistics.simulation.v1.FailureScen
    * @generated from field: bool network_disconnected = 5;
    */
   networkDisconnected: boolean;
+
+  /**
+   * @generated from field: int32 occurrences = 6;
+   */
+  occurrences: number;
 };
 
 /**
@@ -2274,9 +3863,12 @@ export type FailureScenarioResult = Message<"logistics.simulation.v1.FailureScen
  * Use `create(FailureScenarioResultSchema)` to create a new message.
  */
 export const FailureScenarioResultSchema: GenMessage<FailureScenarioResult> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 53);
+  messageDesc(file_logistics_simulation_v1_simulation, 79);
 
 /**
+ * FailureStats для случайных сценариев — оценки Монте-Карло по сэмплам модели
+ * (веса — доли выпадений), для заданных вручную — взвешенные по probability
+ *
  * @generated from message logistics.simulation.v1.FailureStats
  */
 export type FailureStats = Message<"logistics.simulation.v1.FailureStats"> & {
@@ -2301,6 +3893,13 @@ export type FailureStats = Message<"logistics.simulation.v1.FailureStats"> & {
    * @generated from field: double average_recovery_potential = 4;
    */
   averageRecoveryPotential: number;
+
+  /**
+   * Только для случайных сценариев
+   *
+   * @generated from field: double expected_flow_loss_std_error = 5;
+   */
+  expectedFlowLossStdError: number;
 };
 
 /**
@@ -2308,7 +3907,7 @@ export type FailureStats = Message<"logistics.simulation.v1.FailureStats"> & {
  * Use `create(FailureStatsSchema)` to create a new message.
  */
 export const FailureStatsSchema: GenMessage<FailureStats> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 54);
+  messageDesc(file_logistics_simulation_v1_simulation, 80);
 
 /**
  * @generated from message logistics.simulation.v1.ResilienceRecommendation
@@ -2350,7 +3949,7 @@ export type ResilienceRecommendation = Message<"logistics.simulation.v1.Resilien
  * Use `create(ResilienceRecommendationSchema)` to create a new message.
  */
 export const ResilienceRecommendationSchema: GenMessage<ResilienceRecommendation> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 55);
+  messageDesc(file_logistics_simulation_v1_simulation, 81);
 
 /**
  * @generated from message logistics.simulation.v1.AnalyzeResilienceRequest
@@ -2377,7 +3976,7 @@ export type AnalyzeResilienceRequest = Message<"logistics.simulation.v1.AnalyzeR
  * Use `create(AnalyzeResilienceRequestSchema)` to create a new message.
  */
 export const AnalyzeResilienceRequestSchema: GenMessage<AnalyzeResilienceRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 56);
+  messageDesc(file_logistics_simulation_v1_simulation, 82);
 
 /**
  * @generated from message logistics.simulation.v1.ResilienceConfig
@@ -2398,11 +3997,19 @@ export type ResilienceConfig = Message<"logistics.simulation.v1.ResilienceConfig
   testCascadingFailures: boolean;
 
   /**
-   * Коэффициент загрузки
+   * Коэффициент загрузки: ребро, загрузка которого после отказа выросла и
+   * превысила этот порог, отказывает в следующем раунде каскада (по умолчанию 0.9)
    *
    * @generated from field: double load_factor = 3;
    */
   loadFactor: number;
+
+  /**
+   * Рёбер-кандидатов для перебора N-k и запуска каскадов (по умолчанию 20)
+   *
+   * @generated from field: int32 max_candidate_edges = 4;
+   */
+  maxCandidateEdges: number;
 };
 
 /**
@@ -2410,7 +4017,7 @@ export type ResilienceConfig = Message<"logistics.simulation.v1.ResilienceConfig
  * Use `create(ResilienceConfigSchema)` to create a new message.
  */
 export const ResilienceConfigSchema: GenMessage<ResilienceConfig> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 57);
+  messageDesc(file_logistics_simulation_v1_simulation, 83);
 
 /**
  * @generated from message logistics.simulation.v1.AnalyzeResilienceResponse
@@ -2453,6 +4060,13 @@ export type AnalyzeResilienceResponse = Message<"logistics.simulation.v1.Analyze
    * @generated from field: logistics.simulation.v1.SimulationMetadata metadata = 6;
    */
   metadata?: SimulationMetadata;
+
+  /**
+   * Каскадные отказы (при test_cascading_failures)
+   *
+   * @generated from field: logistics.simulation.v1.CascadeAnalysis cascade = 7;
+   */
+  cascade?: CascadeAnalysis;
 };
 
 /**
@@ -2460,7 +4074,7 @@ export type AnalyzeResilienceResponse = Message<"logistics.simulation.v1.Analyze
  * Use `create(AnalyzeResilienceResponseSchema)` to create a new message.
  */
 export const AnalyzeResilienceResponseSchema: GenMessage<AnalyzeResilienceResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 58);
+  messageDesc(file_logistics_simulation_v1_simulation, 84);
 
 /**
  * @generated from message logistics.simulation.v1.ResilienceMetrics
@@ -2507,7 +4121,7 @@ export type ResilienceMetrics = Message<"logistics.simulation.v1.ResilienceMetri
  * Use `create(ResilienceMetricsSchema)` to create a new message.
  */
 export const ResilienceMetricsSchema: GenMessage<ResilienceMetrics> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 59);
+  messageDesc(file_logistics_simulation_v1_simulation, 85);
 
 /**
  * @generated from message logistics.simulation.v1.NMinusOneAnalysis
@@ -2534,81 +4148,268 @@ export type NMinusOneAnalysis = Message<"logistics.simulation.v1.NMinusOneAnalys
   mostCriticalNode: bigint;
 
   /**
-   * @generated from field: int32 scenarios_tested = 5;
+   * @generated from field: int32 scenarios_tested = 5;
+   */
+  scenariosTested: number;
+
+  /**
+   * @generated from field: int32 scenarios_failed = 6;
+   */
+  scenariosFailed: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.NMinusOneAnalysis.
+ * Use `create(NMinusOneAnalysisSchema)` to create a new message.
+ */
+export const NMinusOneAnalysisSchema: GenMessage<NMinusOneAnalysis> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 86);
+
+/**
+ * NMinusTwoAnalysis перебор одновременных отказов от 2 до max_failures_to_test
+ * рёбер-кандидатов. Набор критичен, если его отказ обрывает поток или снижает
+ * его сильнее суммы одиночных отказов его рёбер (рёбра резервируют друг
+ * друга); в отчёт попадают только минимальные критические наборы.
+ *
+ * @generated from message logistics.simulation.v1.NMinusTwoAnalysis
+ */
+export type NMinusTwoAnalysis = Message<"logistics.simulation.v1.NMinusTwoAnalysis"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * Доля сценариев с полной потерей потока
+   *
+   * @generated from field: double probability_of_failure = 2;
+   */
+  probabilityOfFailure: number;
+
+  /**
+   * @generated from field: int32 critical_pairs = 3;
+   */
+  criticalPairs: number;
+
+  /**
+   * @generated from field: repeated logistics.simulation.v1.EdgePair critical_edge_pairs = 4;
+   */
+  criticalEdgePairs: EdgePair[];
+
+  /**
+   * Наибольший размер проверенных наборов
+   *
+   * @generated from field: int32 max_failures = 5;
+   */
+  maxFailures: number;
+
+  /**
+   * Рёбер-кандидатов после отсечения
+   *
+   * @generated from field: int32 candidate_edges = 6;
+   */
+  candidateEdges: number;
+
+  /**
+   * @generated from field: int32 scenarios_tested = 7;
+   */
+  scenariosTested: number;
+
+  /**
+   * @generated from field: int32 scenarios_failed = 8;
+   */
+  scenariosFailed: number;
+
+  /**
+   * @generated from field: double worst_case_flow_reduction = 9;
+   */
+  worstCaseFlowReduction: number;
+
+  /**
+   * Критические наборы из 3+ рёбер
+   *
+   * @generated from field: repeated logistics.simulation.v1.EdgeSet critical_edge_sets = 10;
+   */
+  criticalEdgeSets: EdgeSet[];
+
+  /**
+   * Перебор остановлен на лимите сценариев
+   *
+   * @generated from field: bool truncated = 11;
+   */
+  truncated: boolean;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.NMinusTwoAnalysis.
+ * Use `create(NMinusTwoAnalysisSchema)` to create a new message.
+ */
+export const NMinusTwoAnalysisSchema: GenMessage<NMinusTwoAnalysis> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 87);
+
+/**
+ * @generated from message logistics.simulation.v1.EdgePair
+ */
+export type EdgePair = Message<"logistics.simulation.v1.EdgePair"> & {
+  /**
+   * @generated from field: logistics.common.v1.EdgeKey edge1 = 1;
+   */
+  edge1?: EdgeKey;
+
+  /**
+   * @generated from field: logistics.common.v1.EdgeKey edge2 = 2;
+   */
+  edge2?: EdgeKey;
+
+  /**
+   * @generated from field: double combined_impact = 3;
+   */
+  combinedImpact: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.EdgePair.
+ * Use `create(EdgePairSchema)` to create a new message.
+ */
+export const EdgePairSchema: GenMessage<EdgePair> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 88);
+
+/**
+ * @generated from message logistics.simulation.v1.EdgeSet
+ */
+export type EdgeSet = Message<"logistics.simulation.v1.EdgeSet"> & {
+  /**
+   * @generated from field: repeated logistics.common.v1.EdgeKey edges = 1;
+   */
+  edges: EdgeKey[];
+
+  /**
+   * @generated from field: double combined_impact = 2;
+   */
+  combinedImpact: number;
+};
+
+/**
+ * Describes the message logistics.simulation.v1.EdgeSet.
+ * Use `create(EdgeSetSchema)` to create a new message.
+ */
+export const EdgeSetSchema: GenMessage<EdgeSet> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 89);
+
+/**
+ * CascadeAnalysis моделирует каскады перегрузок: после исходного отказа
+ * поток перераспределяется, и рёбра, загрузка которых выросла выше
+ * load_factor, отказывают в следующем раунде — до устойчивого состояния
+ *
+ * @generated from message logistics.simulation.v1.CascadeAnalysis
+ */
+export type CascadeAnalysis = Message<"logistics.simulation.v1.CascadeAnalysis"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * Применённый порог загрузки
+   *
+   * @generated from field: double load_factor = 2;
+   */
+  loadFactor: number;
+
+  /**
+   * @generated from field: int32 scenarios_tested = 3;
    */
   scenariosTested: number;
 
   /**
-   * @generated from field: int32 scenarios_failed = 6;
+   * Каскады, вышедшие за исходный отказ, по убыванию потери потока
+   *
+   * @generated from field: repeated logistics.simulation.v1.CascadeScenario scenarios = 4;
    */
-  scenariosFailed: number;
+  scenarios: CascadeScenario[];
+
+  /**
+   * @generated from field: double worst_case_flow_reduction = 5;
+   */
+  worstCaseFlowReduction: number;
 };
 
 /**
- * Describes the message logistics.simulation.v1.NMinusOneAnalysis.
- * Use `create(NMinusOneAnalysisSchema)` to create a new message.
+ * Describes the message logistics.simulation.v1.CascadeAnalysis.
+ * Use `create(CascadeAnalysisSchema)` to create a new message.
  */
-export const NMinusOneAnalysisSchema: GenMessage<NMinusOneAnalysis> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 60);
+export const CascadeAnalysisSchema: GenMessage<CascadeAnalysis> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 90);
 
 /**
- * @generated from message logistics.simulation.v1.NMinusTwoAnalysis
+ * @generated from message logistics.simulation.v1.CascadeScenario
  */
-export type NMinusTwoAnalysis = Message<"logistics.simulation.v1.NMinusTwoAnalysis"> & {
+export type CascadeScenario = Message<"logistics.simulation.v1.CascadeScenario"> & {
   /**
-   * @generated from field: bool enabled = 1;
+   * @generated from field: logistics.common.v1.EdgeKey initial_failure = 1;
    */
-  enabled: boolean;
+  initialFailure?: EdgeKey;
 
   /**
-   * @generated from field: double probability_of_failure = 2;
+   * Раунды, начиная с исходного отказа
+   *
+   * @generated from field: repeated logistics.simulation.v1.CascadeStep steps = 2;
    */
-  probabilityOfFailure: number;
+  steps: CascadeStep[];
 
   /**
-   * @generated from field: int32 critical_pairs = 3;
+   * Поток по уцелевшим рёбрам
+   *
+   * @generated from field: double final_flow = 3;
    */
-  criticalPairs: number;
+  finalFlow: number;
 
   /**
-   * @generated from field: repeated logistics.simulation.v1.EdgePair critical_edge_pairs = 4;
+   * @generated from field: double flow_reduction = 4;
    */
-  criticalEdgePairs: EdgePair[];
+  flowReduction: number;
+
+  /**
+   * @generated from field: int32 failed_edges = 5;
+   */
+  failedEdges: number;
 };
 
 /**
- * Describes the message logistics.simulation.v1.NMinusTwoAnalysis.
- * Use `create(NMinusTwoAnalysisSchema)` to create a new message.
+ * Describes the message logistics.simulation.v1.CascadeScenario.
+ * Use `create(CascadeScenarioSchema)` to create a new message.
  */
-export const NMinusTwoAnalysisSchema: GenMessage<NMinusTwoAnalysis> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 61);
+export const CascadeScenarioSchema: GenMessage<CascadeScenario> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 91);
 
 /**
- * @generated from message logistics.simulation.v1.EdgePair
+ * @generated from message logistics.simulation.v1.CascadeStep
  */
-export type EdgePair = Message<"logistics.simulation.v1.EdgePair"> & {
+export type CascadeStep = Message<"logistics.simulation.v1.CascadeStep"> & {
   /**
-   * @generated from field: logistics.common.v1.EdgeKey edge1 = 1;
+   * @generated from field: int32 round = 1;
    */
-  edge1?: EdgeKey;
+  round: number;
 
   /**
-   * @generated from field: logistics.common.v1.EdgeKey edge2 = 2;
+   * @generated from field: repeated logistics.common.v1.EdgeKey failed_edges = 2;
    */
-  edge2?: EdgeKey;
+  failedEdges: EdgeKey[];
 
   /**
-   * @generated from field: double combined_impact = 3;
+   * Поток после отказа рёбер раунда
+   *
+   * @generated from field: double flow_after = 3;
    */
-  combinedImpact: number;
+  flowAfter: number;
 };
 
 /**
- * Describes the message logistics.simulation.v1.EdgePair.
- * Use `create(EdgePairSchema)` to create a new message.
+ * Describes the message logistics.simulation.v1.CascadeStep.
+ * Use `create(CascadeStepSchema)` to create a new message.
  */
-export const EdgePairSchema: GenMessage<EdgePair> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 62);
+export const CascadeStepSchema: GenMessage<CascadeStep> = /*@__PURE__*/
+  messageDesc(file_logistics_simulation_v1_simulation, 92);
 
 /**
  * @generated from message logistics.simulation.v1.ResilienceWeakness
@@ -2650,7 +4451,7 @@ export type ResilienceWeakness = Message<"logistics.simulation.v1.ResilienceWeak
  * Use `create(ResilienceWeaknessSchema)` to create a new message.
  */
 export const ResilienceWeaknessSchema: GenMessage<ResilienceWeakness> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 63);
+  messageDesc(file_logistics_simulation_v1_simulation, 93);
 
 /**
  * @generated from message logistics.simulation.v1.SaveSimulationRequest
@@ -2710,7 +4511,7 @@ export type SaveSimulationRequest = Message<"logistics.simulation.v1.SaveSimulat
  * Use `create(SaveSimulationRequestSchema)` to create a new message.
  */
 export const SaveSimulationRequestSchema: GenMessage<SaveSimulationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 64);
+  messageDesc(file_logistics_simulation_v1_simulation, 94);
 
 /**
  * @generated from message logistics.simulation.v1.SaveSimulationResponse
@@ -2732,7 +4533,7 @@ export type SaveSimulationResponse = Message<"logistics.simulation.v1.SaveSimula
  * Use `create(SaveSimulationResponseSchema)` to create a new message.
  */
 export const SaveSimulationResponseSchema: GenMessage<SaveSimulationResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 65);
+  messageDesc(file_logistics_simulation_v1_simulation, 95);
 
 /**
  * @generated from message logistics.simulation.v1.GetSimulationRequest
@@ -2754,7 +4555,7 @@ export type GetSimulationRequest = Message<"logistics.simulation.v1.GetSimulatio
  * Use `create(GetSimulationRequestSchema)` to create a new message.
  */
 export const GetSimulationRequestSchema: GenMessage<GetSimulationRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 66);
+  messageDesc(file_logistics_simulation_v1_simulation, 96);
 
 /**
  * @generated from message logistics.simulation.v1.GetSimulationResponse
@@ -2771,7 +4572,7 @@ export type GetSimulationResponse = Message<"logistics.simulation.v1.GetSimulati
  * Use `create(GetSimulationResponseSchema)` to create a new message.
  */
 export const GetSimulationResponseSchema: GenMessage<GetSimulationResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 67);
+  messageDesc(file_logistics_simulation_v1_simulation, 97);
 
 /**
  * @generated from message logistics.simulation.v1.ListSimulationsRequest
@@ -2798,7 +4599,7 @@ export type ListSimulationsRequest = Message<"logistics.simulation.v1.ListSimula
  * Use `create(ListSimulationsRequestSchema)` to create a new message.
  */
 export const ListSimulationsRequestSchema: GenMessage<ListSimulationsRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 68);
+  messageDesc(file_logistics_simulation_v1_simulation, 98);
 
 /**
  * @generated from message logistics.simulation.v1.ListSimulationsResponse
@@ -2820,7 +4621,7 @@ export type ListSimulationsResponse = Message<"logistics.simulation.v1.ListSimul
  * Use `create(ListSimulationsResponseSchema)` to create a new message.
  */
 export const ListSimulationsResponseSchema: GenMessage<ListSimulationsResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 69);
+  messageDesc(file_logistics_simulation_v1_simulation, 99);
 
 /**
  * @generated from message logistics.simulation.v1.SimulationRecord
@@ -2877,7 +4678,7 @@ export type SimulationRecord = Message<"logistics.simulation.v1.SimulationRecord
  * Use `create(SimulationRecordSchema)` to create a new message.
  */
 export const SimulationRecordSchema: GenMessage<SimulationRecord> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 70);
+  messageDesc(file_logistics_simulation_v1_simulation, 100);
 
 /**
  * @generated from message logistics.simulation.v1.SimulationSummary
@@ -2914,7 +4715,7 @@ export type SimulationSummary = Message<"logistics.simulation.v1.SimulationSumma
  * Use `create(SimulationSummarySchema)` to create a new message.
  */
 export const SimulationSummarySchema: GenMessage<SimulationSummary> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 71);
+  messageDesc(file_logistics_simulation_v1_simulation, 101);
 
 /**
  * @generated from message logistics.simulation.v1.SimulationMetadata
@@ -2956,7 +4757,7 @@ export type SimulationMetadata = Message<"logistics.simulation.v1.SimulationMeta
  * Use `create(SimulationMetadataSchema)` to create a new message.
  */
 export const SimulationMetadataSchema: GenMessage<SimulationMetadata> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 72);
+  messageDesc(file_logistics_simulation_v1_simulation, 102);
 
 /**
  * @generated from message logistics.simulation.v1.HealthRequest
@@ -2969,7 +4770,7 @@ export type HealthRequest = Message<"logistics.simulation.v1.HealthRequest"> & {
  * Use `create(HealthRequestSchema)` to create a new message.
  */
 export const HealthRequestSchema: GenMessage<HealthRequest> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 73);
+  messageDesc(file_logistics_simulation_v1_simulation, 103);
 
 /**
  * @generated from message logistics.simulation.v1.HealthResponse
@@ -2996,7 +4797,7 @@ export type HealthResponse = Message<"logistics.simulation.v1.HealthResponse"> &
  * Use `create(HealthResponseSchema)` to create a new message.
  */
 export const HealthResponseSchema: GenMessage<HealthResponse> = /*@__PURE__*/
-  messageDesc(file_logistics_simulation_v1_simulation, 74);
+  messageDesc(file_logistics_simulation_v1_simulation, 104);
 
 /**
  * @generated from enum logistics.simulation.v1.ModificationType
@@ -3192,6 +4993,106 @@ export enum BottleneckChangeType {
 export const BottleneckChangeTypeSchema: GenEnum<BottleneckChangeType> = /*@__PURE__*/
   enumDesc(file_logistics_simulation_v1_simulation, 3);
 
+/**
+ * @generated from enum logistics.simulation.v1.ExpansionStopReason
+ */
+export enum ExpansionStopReason {
+  /**
+   * @generated from enum value: EXPANSION_STOP_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Ни один кандидат не укладывается в бюджет
+   *
+   * @generated from enum value: EXPANSION_STOP_REASON_BUDGET = 1;
+   */
+  BUDGET = 1,
+
+  /**
+   * Разрез нельзя расширить или расширение не даёт потока
+   *
+   * @generated from enum value: EXPANSION_STOP_REASON_NO_GAIN = 2;
+   */
+  NO_GAIN = 2,
+
+  /**
+   * @generated from enum value: EXPANSION_STOP_REASON_MAX_ITERATIONS = 3;
+   */
+  MAX_ITERATIONS = 3,
+}
+
+/**
+ * Describes the enum logistics.simulation.v1.ExpansionStopReason.
+ */
+export const ExpansionStopReasonSchema: GenEnum<ExpansionStopReason> = /*@__PURE__*/
+  enumDesc(file_logistics_simulation_v1_simulation, 4);
+
+/**
+ * @generated from enum logistics.simulation.v1.FacilityMoveType
+ */
+export enum FacilityMoveType {
+  /**
+   * @generated from enum value: FACILITY_MOVE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FACILITY_MOVE_TYPE_OPEN = 1;
+   */
+  OPEN = 1,
+
+  /**
+   * @generated from enum value: FACILITY_MOVE_TYPE_CLOSE = 2;
+   */
+  CLOSE = 2,
+
+  /**
+   * @generated from enum value: FACILITY_MOVE_TYPE_SWAP = 3;
+   */
+  SWAP = 3,
+}
+
+/**
+ * Describes the enum logistics.simulation.v1.FacilityMoveType.
+ */
+export const FacilityMoveTypeSchema: GenEnum<FacilityMoveType> = /*@__PURE__*/
+  enumDesc(file_logistics_simulation_v1_simulation, 5);
+
+/**
+ * @generated from enum logistics.simulation.v1.TimeSimulationMode
+ */
+export enum TimeSimulationMode {
+  /**
+   * = STATIC
+   *
+   * @generated from enum value: TIME_SIMULATION_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Каждый шаг — независимый максимальный поток
+   *
+   * @generated from enum value: TIME_SIMULATION_MODE_STATIC = 1;
+   */
+  STATIC = 1,
+
+  /**
+   * Один поток в сети, развёрнутой во времени: ребро ведёт из узла на шаге t
+   * в узел на шаге t + время в пути, груз может ждать в узле в пределах
+   * Node.storage_capacity
+   *
+   * @generated from enum value: TIME_SIMULATION_MODE_DYNAMIC = 2;
+   */
+  DYNAMIC = 2,
+}
+
+/**
+ * Describes the enum logistics.simulation.v1.TimeSimulationMode.
+ */
+export const TimeSimulationModeSchema: GenEnum<TimeSimulationMode> = /*@__PURE__*/
+  enumDesc(file_logistics_simulation_v1_simulation, 6);
+
 /**
  * @generated from enum logistics.simulation.v1.TimeStep
  */
@@ -3226,7 +5127,7 @@ export enum TimeStep {
  * Describes the enum logistics.simulation.v1.TimeStep.
  */
 export const TimeStepSchema: GenEnum<TimeStep> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 4);
+  enumDesc(file_logistics_simulation_v1_simulation, 7);
 
 /**
  * @generated from enum logistics.simulation.v1.PatternTarget
@@ -3262,7 +5163,7 @@ export enum PatternTarget {
  * Describes the enum logistics.simulation.v1.PatternTarget.
  */
 export const PatternTargetSchema: GenEnum<PatternTarget> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 5);
+  enumDesc(file_logistics_simulation_v1_simulation, 8);
 
 /**
  * @generated from enum logistics.simulation.v1.PatternType
@@ -3320,7 +5221,7 @@ export enum PatternType {
  * Describes the enum logistics.simulation.v1.PatternType.
  */
 export const PatternTypeSchema: GenEnum<PatternType> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 6);
+  enumDesc(file_logistics_simulation_v1_simulation, 9);
 
 /**
  * @generated from enum logistics.simulation.v1.CriticalPeriodType
@@ -3356,7 +5257,136 @@ export enum CriticalPeriodType {
  * Describes the enum logistics.simulation.v1.CriticalPeriodType.
  */
 export const CriticalPeriodTypeSchema: GenEnum<CriticalPeriodType> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 7);
+  enumDesc(file_logistics_simulation_v1_simulation, 10);
+
+/**
+ * @generated from enum logistics.simulation.v1.CorrelationMeasure
+ */
+export enum CorrelationMeasure {
+  /**
+   * То же, что CORRELATION_MEASURE_GAUSSIAN
+   *
+   * @generated from enum value: CORRELATION_MEASURE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Корреляции нормальных величин копулы
+   *
+   * @generated from enum value: CORRELATION_MEASURE_GAUSSIAN = 1;
+   */
+  GAUSSIAN = 1,
+
+  /**
+   * Ранговая корреляция Спирмена
+   *
+   * @generated from enum value: CORRELATION_MEASURE_SPEARMAN = 2;
+   */
+  SPEARMAN = 2,
+
+  /**
+   * Ранговая корреляция тау Кендалла
+   *
+   * @generated from enum value: CORRELATION_MEASURE_KENDALL = 3;
+   */
+  KENDALL = 3,
+}
+
+/**
+ * Describes the enum logistics.simulation.v1.CorrelationMeasure.
+ */
+export const CorrelationMeasureSchema: GenEnum<CorrelationMeasure> = /*@__PURE__*/
+  enumDesc(file_logistics_simulation_v1_simulation, 11);
+
+/**
+ * @generated from enum logistics.simulation.v1.MonteCarloStopReason
+ */
+export enum MonteCarloStopReason {
+  /**
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Выполнены все итерации (адаптивный прогон не сошёлся)
+   *
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_COMPLETED = 1;
+   */
+  COMPLETED = 1,
+
+  /**
+   * Достигнута target_relative_ci_half_width
+   *
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_CONVERGED = 2;
+   */
+  CONVERGED = 2,
+
+  /**
+   * Исчерпан time_budget_seconds
+   *
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_TIME_BUDGET = 3;
+   */
+  TIME_BUDGET = 3,
+
+  /**
+   * Запрос отменён
+   *
+   * @generated from enum value: MONTE_CARLO_STOP_REASON_CANCELED = 4;
+   */
+  CANCELED = 4,
+}
+
+/**
+ * Describes the enum logistics.simulation.v1.MonteCarloStopReason.
+ */
+export const MonteCarloStopReasonSchema: GenEnum<MonteCarloStopReason> = /*@__PURE__*/
+  enumDesc(file_logistics_simulation_v1_simulation, 12);
+
+/**
+ * @generated from enum logistics.simulation.v1.SamplingMethod
+ */
+export enum SamplingMethod {
+  /**
+   * То же, что SAMPLING_METHOD_RANDOM
+   *
+   * @generated from enum value: SAMPLING_METHOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Независимые случайные сэмплы
+   *
+   * @generated from enum value: SAMPLING_METHOD_RANDOM = 1;
+   */
+  RANDOM = 1,
+
+  /**
+   * Латинский гиперкуб: каждое измерение стратифицировано
+   *
+   * @generated from enum value: SAMPLING_METHOD_LATIN_HYPERCUBE = 2;
+   */
+  LATIN_HYPERCUBE = 2,
+
+  /**
+   * Квазислучайная последовательность Соболя со случайным цифровым сдвигом
+   *
+   * @generated from enum value: SAMPLING_METHOD_SOBOL = 3;
+   */
+  SOBOL = 3,
+
+  /**
+   * Антитетические пары: итерации 2k и 2k+1 используют u и 1-u
+   *
+   * @generated from enum value: SAMPLING_METHOD_ANTITHETIC = 4;
+   */
+  ANTITHETIC = 4,
+}
+
+/**
+ * Describes the enum logistics.simulation.v1.SamplingMethod.
+ */
+export const SamplingMethodSchema: GenEnum<SamplingMethod> = /*@__PURE__*/
+  enumDesc(file_logistics_simulation_v1_simulation, 13);
 
 /**
  * @generated from enum logistics.simulation.v1.UncertaintyType
@@ -3389,7 +5419,7 @@ export enum UncertaintyType {
  * Describes the enum logistics.simulation.v1.UncertaintyType.
  */
 export const UncertaintyTypeSchema: GenEnum<UncertaintyType> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 8);
+  enumDesc(file_logistics_simulation_v1_simulation, 14);
 
 /**
  * @generated from enum logistics.simulation.v1.DistributionType
@@ -3430,7 +5460,7 @@ export enum DistributionType {
  * Describes the enum logistics.simulation.v1.DistributionType.
  */
 export const DistributionTypeSchema: GenEnum<DistributionType> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 9);
+  enumDesc(file_logistics_simulation_v1_simulation, 15);
 
 /**
  * @generated from enum logistics.simulation.v1.SensitivityMethod
@@ -3467,7 +5497,7 @@ export enum SensitivityMethod {
  * Describes the enum logistics.simulation.v1.SensitivityMethod.
  */
 export const SensitivityMethodSchema: GenEnum<SensitivityMethod> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 10);
+  enumDesc(file_logistics_simulation_v1_simulation, 16);
 
 /**
  * @generated from enum logistics.simulation.v1.SensitivityLevel
@@ -3508,7 +5538,7 @@ export enum SensitivityLevel {
  * Describes the enum logistics.simulation.v1.SensitivityLevel.
  */
 export const SensitivityLevelSchema: GenEnum<SensitivityLevel> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 11);
+  enumDesc(file_logistics_simulation_v1_simulation, 17);
 
 /**
  * @generated from enum logistics.simulation.v1.ThresholdType
@@ -3550,7 +5580,40 @@ export enum ThresholdType {
  * Describes the enum logistics.simulation.v1.ThresholdType.
  */
 export const ThresholdTypeSchema: GenEnum<ThresholdType> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 12);
+  enumDesc(file_logistics_simulation_v1_simulation, 18);
+
+/**
+ * @generated from enum logistics.simulation.v1.FailureCorrelation
+ */
+export enum FailureCorrelation {
+  /**
+   * Как FAILURE_CORRELATION_REGIONAL
+   *
+   * @generated from enum value: FAILURE_CORRELATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Авария в радиусе region_radius от случайного узла: задеты узлы в радиусе
+   * и рёбра, хотя бы один конец которых в радиусе
+   *
+   * @generated from enum value: FAILURE_CORRELATION_REGIONAL = 1;
+   */
+  REGIONAL = 1,
+
+  /**
+   * Общий отказ дорог одного случайного типа (road_type рёбер)
+   *
+   * @generated from enum value: FAILURE_CORRELATION_ROAD_TYPE = 2;
+   */
+  ROAD_TYPE = 2,
+}
+
+/**
+ * Describes the enum logistics.simulation.v1.FailureCorrelation.
+ */
+export const FailureCorrelationSchema: GenEnum<FailureCorrelation> = /*@__PURE__*/
+  enumDesc(file_logistics_simulation_v1_simulation, 19);
 
 /**
  * @generated from enum logistics.simulation.v1.RecommendationType
@@ -3586,7 +5649,7 @@ export enum RecommendationType {
  * Describes the enum logistics.simulation.v1.RecommendationType.
  */
 export const RecommendationTypeSchema: GenEnum<RecommendationType> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 13);
+  enumDesc(file_logistics_simulation_v1_simulation, 20);
 
 /**
  * @generated from enum logistics.simulation.v1.WeaknessType
@@ -3616,13 +5679,20 @@ export enum WeaknessType {
    * @generated from enum value: WEAKNESS_TYPE_GEOGRAPHIC_CONCENTRATION = 4;
    */
   GEOGRAPHIC_CONCENTRATION = 4,
+
+  /**
+   * Отказ запускает каскад перегрузок
+   *
+   * @generated from enum value: WEAKNESS_TYPE_CASCADE_RISK = 5;
+   */
+  CASCADE_RISK = 5,
 }
 
 /**
  * Describes the enum logistics.simulation.v1.WeaknessType.
  */
 export const WeaknessTypeSchema: GenEnum<WeaknessType> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 14);
+  enumDesc(file_logistics_simulation_v1_simulation, 21);
 
 /**
  * @generated from enum logistics.simulation.v1.SimulationType
@@ -3668,7 +5738,7 @@ export enum SimulationType {
  * Describes the enum logistics.simulation.v1.SimulationType.
  */
 export const SimulationTypeSchema: GenEnum<SimulationType> = /*@__PURE__*/
-  enumDesc(file_logistics_simulation_v1_simulation, 15);
+  enumDesc(file_logistics_simulation_v1_simulation, 22);
 
 /**
  * ============ WHAT-IF ANALYSIS ============
@@ -3696,6 +5766,26 @@ export const SimulationService: GenService<{
     input: typeof CompareScenariosRequestSchema;
     output: typeof CompareScenariosResponseSchema;
   },
+  /**
+   * Выбор расширений пропускной способности в пределах бюджета
+   *
+   * @generated from rpc logistics.simulation.v1.SimulationService.OptimizeCapacityExpansion
+   */
+  optimizeCapacityExpansion: {
+    methodKind: "unary";
+    input: typeof OptimizeCapacityExpansionRequestSchema;
+    output: typeof OptimizeCapacityExpansionResponseSchema;
+  },
+  /**
+   * Выбор складов, которые стоит открыть или закрыть
+   *
+   * @generated from rpc logistics.simulation.v1.SimulationService.OptimizeFacilityLocation
+   */
+  optimizeFacilityLocation: {
+    methodKind: "unary";
+    input: typeof OptimizeFacilityLocationRequestSchema;
+    output: typeof OptimizeFacilityLocationResponseSchema;
+  },
   /**
    * Симуляция с временными параметрами
    *
@@ -3716,6 +5806,16 @@ export const SimulationService: GenService<{
     input: typeof SimulatePeakLoadRequestSchema;
     output: typeof SimulatePeakLoadResponseSchema;
   },
+  /**
+   * Дискретно-событийная симуляция рейсов по решённому потоку
+   *
+   * @generated from rpc logistics.simulation.v1.SimulationService.RunDiscreteEventSimulation
+   */
+  runDiscreteEventSimulation: {
+    methodKind: "unary";
+    input: typeof RunDiscreteEventSimulationRequestSchema;
+    output: typeof RunDiscreteEventSimulationResponseSchema;
+  },
   /**
    * Запуск Monte Carlo симуляции
    *
@@ -65,6 +65,12 @@ const ALGORITHM_DISPLAY: Record<
     gradient: "from-emerald-500 to-emerald-600",
     tagline: "Когда важна минимизация затрат",
   },
+  [Algorithm.NETWORK_SIMPLEX]: {
+    icon: ScaleIcon,
+    color: "text-teal-600",
+    gradient: "from-teal-500 to-teal-600",
+    tagline: "Минимальная стоимость для плотных сетей",
+  },
 };
 
 const BEST_FOR_LABELS: Record<string, { label: string; icon: string }> = {
@@ -26,6 +26,7 @@ const ALGORITHM_NAMES: Record<number, string> = {
   [Algorithm.MIN_COST]: "Min-Cost",
   [Algorithm.PUSH_RELABEL]: "Push-Relabel",
   [Algorithm.FORD_FULKERSON]: "Ford-Fulkerson",
+  [Algorithm.NETWORK_SIMPLEX]: "Network Simplex",
 };
 
 export default function History() {
@@ -102,6 +102,12 @@ const ALGORITHMS = [
     description: "Минимизация стоимости доставки",
     supportsCost: true,
   },
+  {
+    value: Algorithm.NETWORK_SIMPLEX,
+    label: "Network Simplex",
+    description: "Минимальная стоимость для плотных сетей",
+    supportsCost: true,
+  },
   {
     value: Algorithm.FORD_FULKERSON,
     label: "Ford-Fulkerson",